@@ -0,0 +1,83 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IngressGroupMember selects Ingresses that are members of the IngressGroup.
+type IngressGroupMember struct {
+	// namespace is the namespace of the selected Ingresses, it must be one of the permitted namespaces.
+	// If namespace is unspecified, Ingresses are selected from all permitted namespaces.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// selector selects Ingresses by labels.
+	// If selector is unspecified, all Ingresses of the namespace are selected.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// order is the order of the selected Ingresses within the IngressGroup, which takes precedence over the group.order annotation.
+	// +kubebuilder:validation:Minimum=-1000
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	Order *int64 `json:"order,omitempty"`
+}
+
+// IngressGroupSpec defines the desired state of IngressGroup
+type IngressGroupSpec struct {
+	// permittedNamespaces are the namespaces whose Ingresses are allowed to join the IngressGroup.
+	// Ingresses from other namespaces are rejected even if they specify the group.name annotation.
+	// +kubebuilder:validation:MinItems=1
+	PermittedNamespaces []string `json:"permittedNamespaces"`
+
+	// members select the Ingresses that belong to the IngressGroup.
+	// Ingresses from permitted namespaces can also join the IngressGroup with the group.name annotation.
+	// +optional
+	Members []IngressGroupMember `json:"members,omitempty"`
+
+	// defaultAnnotations are the annotations applied to all members of the IngressGroup,
+	// unless the member Ingress specifies the same annotation.
+	// +optional
+	DefaultAnnotations map[string]string `json:"defaultAnnotations,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="NAMESPACES",type="string",JSONPath=".spec.permittedNamespaces",description="The permitted namespaces"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// IngressGroup is the Schema for the IngressGroup API
+type IngressGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec IngressGroupSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IngressGroupList contains a list of IngressGroup
+type IngressGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IngressGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&IngressGroup{}, &IngressGroupList{})
+}
@@ -0,0 +1,72 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BlocklistSpec defines the desired state of Blocklist
+type BlocklistSpec struct {
+	// cidrs are the IPv4 or IPv6 CIDRs blocked from reaching the load balancers opened to the internet.
+	// +kubebuilder:validation:MinItems=1
+	CIDRs []string `json:"cidrs"`
+
+	// reason describes why the CIDRs are blocked.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// BlocklistStatus defines the observed state of Blocklist
+type BlocklistStatus struct {
+	// The generation observed by the Blocklist controller.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// prefixListIDs are the IDs of the managed prefix lists the CIDRs are excluded from.
+	// +optional
+	PrefixListIDs []string `json:"prefixListIDs,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="CIDRS",type="string",JSONPath=".spec.cidrs",description="The blocked CIDRs"
+// +kubebuilder:printcolumn:name="REASON",type="string",JSONPath=".spec.reason",description="The reason of the block"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// Blocklist is the Schema for the Blocklist API
+type Blocklist struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BlocklistSpec   `json:"spec,omitempty"`
+	Status BlocklistStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BlocklistList contains a list of Blocklist
+type BlocklistList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Blocklist `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Blocklist{}, &BlocklistList{})
+}
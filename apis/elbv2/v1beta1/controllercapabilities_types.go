@@ -0,0 +1,116 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ControllerDefaults are the default values the controller applies when they aren't specified on resources.
+type ControllerDefaults struct {
+	// sslPolicy is the default SSL policy for load balancers.
+	// +optional
+	SSLPolicy string `json:"sslPolicy,omitempty"`
+
+	// targetType is the default target type for target groups of Ingresses and Services.
+	// +optional
+	TargetType TargetType `json:"targetType,omitempty"`
+}
+
+// ControllerLimits are the AWS limits the controller validates resources against.
+type ControllerLimits struct {
+	// loadBalancerNameMaxLength is the max number of characters in load balancer names.
+	LoadBalancerNameMaxLength int32 `json:"loadBalancerNameMaxLength"`
+
+	// listenerRulePriorityMin is the min priority of listener rules.
+	ListenerRulePriorityMin int32 `json:"listenerRulePriorityMin"`
+
+	// listenerRulePriorityMax is the max priority of listener rules.
+	ListenerRulePriorityMax int32 `json:"listenerRulePriorityMax"`
+
+	// listenerRuleConditionValuesMax is the max number of condition values in a listener rule.
+	ListenerRuleConditionValuesMax int32 `json:"listenerRuleConditionValuesMax"`
+
+	// listenerRulesDefaultQuota is the default quota of listener rules per ALB, excluding the default rules.
+	ListenerRulesDefaultQuota int32 `json:"listenerRulesDefaultQuota"`
+}
+
+// ControllerCapabilitiesStatus defines the observed state of ControllerCapabilities
+type ControllerCapabilitiesStatus struct {
+	// version is the version of the controller.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// ingressClass is the name of the IngressClass the controller satisfies.
+	// +optional
+	IngressClass string `json:"ingressClass,omitempty"`
+
+	// loadBalancerClass is the load balancer class of the Services the controller reconciles.
+	// +optional
+	LoadBalancerClass string `json:"loadBalancerClass,omitempty"`
+
+	// ingressAnnotations are the keys of the supported Ingress annotations.
+	// keys ending with "*" have a variable part, e.g. the name of the action of "alb.ingress.kubernetes.io/actions.*".
+	// +optional
+	IngressAnnotations []string `json:"ingressAnnotations,omitempty"`
+
+	// serviceAnnotations are the keys of the supported Service annotations.
+	// keys ending with "*" have a variable part.
+	// +optional
+	ServiceAnnotations []string `json:"serviceAnnotations,omitempty"`
+
+	// featureGates is the state of the feature gates in effect.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// defaults are the default values in effect.
+	// +optional
+	Defaults ControllerDefaults `json:"defaults,omitempty"`
+
+	// limits are the AWS limits known by the controller.
+	// +optional
+	Limits ControllerLimits `json:"limits,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.version",description="The version of the controller"
+// +kubebuilder:printcolumn:name="INGRESS-CLASS",type="string",JSONPath=".status.ingressClass",description="The IngressClass the controller satisfies"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// ControllerCapabilities is the Schema for the ControllerCapabilities API, it's published by the controller for
+// policy engines and UIs to discover the annotations, feature gates, defaults and limits of the running controller.
+type ControllerCapabilities struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Status ControllerCapabilitiesStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ControllerCapabilitiesList contains a list of ControllerCapabilities
+type ControllerCapabilitiesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ControllerCapabilities `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ControllerCapabilities{}, &ControllerCapabilitiesList{})
+}
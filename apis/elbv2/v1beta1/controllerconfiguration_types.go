@@ -0,0 +1,100 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ControllerConfigurationSpec defines the desired state of ControllerConfiguration
+type ControllerConfigurationSpec struct {
+	// defaultTags are the AWS tags applied to all AWS resources managed by the controller.
+	// They replace the default tags specified via the controller flags.
+	// +optional
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+
+	// defaultSSLPolicy is the default SSL policy for load balancers.
+	// It replaces the default SSL policy specified via the controller flags.
+	// +optional
+	DefaultSSLPolicy string `json:"defaultSSLPolicy,omitempty"`
+
+	// defaultTargetType is the default target type for target groups of Ingresses and Services.
+	// It replaces the default target type specified via the controller flags.
+	// +kubebuilder:validation:Enum=instance;ip
+	// +optional
+	DefaultTargetType TargetType `json:"defaultTargetType,omitempty"`
+
+	// featureGates enables or disables the features that can be changed without restarting the controller.
+	// They override the feature gates specified via the controller flags.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
+
+// EffectiveControllerConfiguration is the configuration in effect, combining the ControllerConfiguration with the controller flags.
+type EffectiveControllerConfiguration struct {
+	// defaultTags are the AWS tags applied to all AWS resources managed by the controller.
+	// +optional
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+
+	// defaultSSLPolicy is the default SSL policy for load balancers.
+	DefaultSSLPolicy string `json:"defaultSSLPolicy"`
+
+	// defaultTargetType is the default target type for target groups of Ingresses and Services.
+	DefaultTargetType TargetType `json:"defaultTargetType"`
+
+	// featureGates is the state of the features that can be changed without restarting the controller.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
+
+// ControllerConfigurationStatus defines the observed state of ControllerConfiguration
+type ControllerConfigurationStatus struct {
+	// The generation observed by the ControllerConfiguration controller.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// The configuration in effect, it isn't updated while the ControllerConfiguration is invalid.
+	// +optional
+	EffectiveConfiguration *EffectiveControllerConfiguration `json:"effectiveConfiguration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// ControllerConfiguration is the Schema for the ControllerConfiguration API
+type ControllerConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ControllerConfigurationSpec   `json:"spec,omitempty"`
+	Status ControllerConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ControllerConfigurationList contains a list of ControllerConfiguration
+type ControllerConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ControllerConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ControllerConfiguration{}, &ControllerConfigurationList{})
+}
@@ -0,0 +1,99 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FrontendSecurityGroupIngressRule defines an inbound rule added to the managed frontend SecurityGroup.
+type FrontendSecurityGroupIngressRule struct {
+	// The protocol which traffic must match.
+	// If protocol is unspecified, it defaults to TCP.
+	// +optional
+	Protocol *NetworkingProtocol `json:"protocol,omitempty"`
+
+	// port is the port which traffic must match.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// endPort is the last port of the port range which traffic must match.
+	// If endPort is unspecified, only the port is matched.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	EndPort *int64 `json:"endPort,omitempty"`
+
+	// cidrs are the IPv4 or IPv6 CIDRs allowed to access the port.
+	// +optional
+	CIDRs []string `json:"cidrs,omitempty"`
+
+	// prefixListIDs are the IDs of the managed prefix lists allowed to access the port.
+	// +optional
+	PrefixListIDs []string `json:"prefixListIDs,omitempty"`
+
+	// description is appended to the description of the SecurityGroup rules.
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9. _:/()#,@\[\]+=&;{}!$*-]*$`
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// FrontendSecurityGroupRuleSpec defines the desired state of FrontendSecurityGroupRule
+type FrontendSecurityGroupRuleSpec struct {
+	// group is the explicit IngressGroup whose managed frontend SecurityGroup the rules are added to.
+	// Exactly one of group and ingressName must be specified.
+	// +optional
+	Group *IngressGroup `json:"group,omitempty"`
+
+	// ingressName is the name of an Ingress in the same namespace that doesn't belong to an explicit IngressGroup,
+	// whose managed frontend SecurityGroup the rules are added to.
+	// Exactly one of group and ingressName must be specified.
+	// +optional
+	IngressName string `json:"ingressName,omitempty"`
+
+	// rules are the inbound rules added to the managed frontend SecurityGroup.
+	// +kubebuilder:validation:MinItems=1
+	Rules []FrontendSecurityGroupIngressRule `json:"rules"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.group.name",description="The IngressGroup's name"
+// +kubebuilder:printcolumn:name="INGRESS",type="string",JSONPath=".spec.ingressName",description="The Kubernetes Ingress's name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// FrontendSecurityGroupRule is the Schema for the FrontendSecurityGroupRule API
+type FrontendSecurityGroupRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec FrontendSecurityGroupRuleSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FrontendSecurityGroupRuleList contains a list of FrontendSecurityGroupRule
+type FrontendSecurityGroupRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FrontendSecurityGroupRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FrontendSecurityGroupRule{}, &FrontendSecurityGroupRuleList{})
+}
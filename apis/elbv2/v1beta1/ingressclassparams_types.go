@@ -18,15 +18,17 @@ package v1beta1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-// +kubebuilder:validation:Enum=ipv4;dualstack
+// +kubebuilder:validation:Enum=ipv4;dualstack;dualstack-without-public-ipv4
 // IPAddressType is the ip address type of load balancer.
 type IPAddressType string
 
 const (
-	IPAddressTypeIPV4      IPAddressType = "ipv4"
-	IPAddressTypeDualStack IPAddressType = "dualstack"
+	IPAddressTypeIPV4                       IPAddressType = "ipv4"
+	IPAddressTypeDualStack                  IPAddressType = "dualstack"
+	IPAddressTypeDualStackWithoutPublicIPV4 IPAddressType = "dualstack-without-public-ipv4"
 )
 
 // +kubebuilder:validation:Enum=internal;internet-facing
@@ -47,7 +49,7 @@ type SubnetID string
 
 // SubnetSelector selects one or more existing subnets.
 type SubnetSelector struct {
-	// IDs specify the resource IDs of subnets. Exactly one of this or `tags` must be specified.
+	// IDs specify the resource IDs of subnets. Exactly one of this, `tags` or `selector` must be specified.
 	// +kubebuilder:validation:MinItems=1
 	// +optional
 	IDs []SubnetID `json:"ids,omitempty"`
@@ -55,9 +57,36 @@ type SubnetSelector struct {
 	// Tags specifies subnets in the load balancer's VPC where each
 	// tag specified in the map key contains one of the values in the corresponding
 	// value list.
-	// Exactly one of this or `ids` must be specified.
+	// Exactly one of this, `ids` or `selector` must be specified.
 	// +optional
 	Tags map[string][]string `json:"tags,omitempty"`
+
+	// Selector refines the auto-discovery of subnets, it overrides the subnets discovery flags of the controller.
+	// Exactly one of this, `ids` or `tags` must be specified.
+	// +optional
+	Selector *SubnetDiscoverySelector `json:"selector,omitempty"`
+}
+
+// SubnetDiscoverySelector refines which auto-discovered subnets are chosen.
+type SubnetDiscoverySelector struct {
+	// Tags specifies tags the discovered subnets must have in addition to the tags of the subnets
+	// discovery strategy, where each tag specified in the map key contains one of the values in the
+	// corresponding value list. Values may contain the `*` and `?` wildcards.
+	// +optional
+	Tags map[string][]string `json:"tags,omitempty"`
+
+	// IncludeZones specifies the names or IDs of the only zones to choose subnets in.
+	// +optional
+	IncludeZones []string `json:"includeZones,omitempty"`
+
+	// ExcludeZones specifies the names or IDs of zones, including local zones, to never choose subnets in.
+	// +optional
+	ExcludeZones []string `json:"excludeZones,omitempty"`
+
+	// MinFreeIPAddressCount specifies the minimum count of free IP addresses a subnet must have to be chosen.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinFreeIPAddressCount *int64 `json:"minFreeIPAddressCount,omitempty"`
 }
 
 // IngressGroup defines IngressGroup configuration.
@@ -66,6 +95,20 @@ type IngressGroup struct {
 	Name string `json:"name"`
 }
 
+// IPAMConfiguration defines the IPAM pools to source the IP addresses of LoadBalancers from.
+type IPAMConfiguration struct {
+	// IPv4IPAMPoolId defines the ID of the public IPv4 IPAM pool to source the IPv4 addresses of internet-facing LoadBalancers from.
+	// +optional
+	IPv4IPAMPoolId *string `json:"ipv4IPAMPoolId,omitempty"`
+}
+
+// MinimumLoadBalancerCapacity defines the minimum capacity reserved for LoadBalancers.
+type MinimumLoadBalancerCapacity struct {
+	// CapacityUnits is the number of load balancer capacity units (LCUs) reserved.
+	// +kubebuilder:validation:Minimum=1
+	CapacityUnits int64 `json:"capacityUnits"`
+}
+
 // Tag defines a AWS Tag on resources.
 type Tag struct {
 	// The key of the tag.
@@ -84,6 +127,215 @@ type Attribute struct {
 	Value string `json:"value"`
 }
 
+// WAFv2LoggingConfiguration defines the logging configuration for WAFv2 WebACLs associated with LoadBalancers.
+type WAFv2LoggingConfiguration struct {
+	// LogDestinationARN is the ARN of the Amazon CloudWatch Logs log group, Amazon Kinesis Data Firehose delivery stream
+	// or Amazon S3 bucket that WAFv2 logs are delivered to.
+	LogDestinationARN string `json:"logDestinationARN"`
+}
+
+// +kubebuilder:validation:Enum=allow;block
+// WAFv2WebACLDefaultAction is the action of a WAFv2 WebACL for requests that don't match any rule.
+type WAFv2WebACLDefaultAction string
+
+const (
+	WAFv2WebACLDefaultActionAllow WAFv2WebACLDefaultAction = "allow"
+	WAFv2WebACLDefaultActionBlock WAFv2WebACLDefaultAction = "block"
+)
+
+// WAFv2ManagedRuleGroup defines a managed rule group evaluated by a WAFv2 WebACL.
+type WAFv2ManagedRuleGroup struct {
+	// VendorName is the name of the managed rule group vendor, e.g. AWS.
+	VendorName string `json:"vendorName"`
+
+	// Name is the name of the managed rule group, e.g. AWSManagedRulesCommonRuleSet.
+	Name string `json:"name"`
+
+	// Version is the version of the managed rule group, the default version is used if absent.
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// WAFv2RateLimit defines a rate-based rule evaluated by a WAFv2 WebACL, which blocks requests from IP addresses exceeding the limit.
+type WAFv2RateLimit struct {
+	// Name is the name of the rate-based rule.
+	Name string `json:"name"`
+
+	// Limit is the maximum number of requests from a single IP address in any 5-minute period.
+	// +kubebuilder:validation:Minimum=100
+	Limit int64 `json:"limit"`
+}
+
+// WAFv2WebACL defines a WAFv2 WebACL managed by the controller.
+type WAFv2WebACL struct {
+	// DefaultAction is the action for requests that don't match any rule, defaults to allow.
+	// +optional
+	DefaultAction WAFv2WebACLDefaultAction `json:"defaultAction,omitempty"`
+
+	// RateLimits are the rate-based rules, which are evaluated before managed rule groups.
+	// +optional
+	RateLimits []WAFv2RateLimit `json:"rateLimits,omitempty"`
+
+	// ManagedRuleGroups are the managed rule groups, which are evaluated in order.
+	// +optional
+	ManagedRuleGroups []WAFv2ManagedRuleGroup `json:"managedRuleGroups,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=off;passthrough;verify
+// MutualAuthenticationMode is the client certificate handling mode of an HTTPS listener.
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// MutualAuthenticationAttributes defines the mutual TLS configuration of an HTTPS listener.
+type MutualAuthenticationAttributes struct {
+	// Port is the HTTPS listener port this configuration applies to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// Mode is the client certificate handling mode.
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// TrustStore is the ARN of the trust store used to verify client certificates in verify mode.
+	// If absent in verify mode, the trust store managed from TrustStoreBundle is used.
+	// +optional
+	TrustStore string `json:"trustStore,omitempty"`
+
+	// IgnoreClientCertificateExpiry indicates whether expired client certificates are accepted in verify mode.
+	// +optional
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
+}
+
+// TrustStoreBundle defines the location of a CA certificates bundle, from which the controller manages a trust store.
+type TrustStoreBundle struct {
+	// S3Bucket is the Amazon S3 bucket of the CA certificates bundle.
+	S3Bucket string `json:"s3Bucket"`
+
+	// S3Key is the Amazon S3 key of the CA certificates bundle.
+	S3Key string `json:"s3Key"`
+
+	// S3ObjectVersion is the Amazon S3 object version of the CA certificates bundle.
+	// +optional
+	S3ObjectVersion string `json:"s3ObjectVersion,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=not-found;misdirected-request;redirect;forward
+// DefaultActionType is the type of listener default action, which handles requests that don't match any rule, e.g. requests to unknown hosts.
+//
+// * not-found responds with fixed 404.
+// * misdirected-request responds with fixed 421.
+// * redirect redirects requests to a canonical host.
+// * forward forwards requests to a default Service.
+type DefaultActionType string
+
+const (
+	DefaultActionTypeNotFound           DefaultActionType = "not-found"
+	DefaultActionTypeMisdirectedRequest DefaultActionType = "misdirected-request"
+	DefaultActionTypeRedirect           DefaultActionType = "redirect"
+	DefaultActionTypeForward            DefaultActionType = "forward"
+)
+
+// DefaultActionRedirectConfig defines the redirect of requests to a canonical host.
+type DefaultActionRedirectConfig struct {
+	// Host is the canonical host that requests are redirected to.
+	Host string `json:"host"`
+
+	// StatusCode is the HTTP redirect code, defaults to HTTP_301.
+	// +kubebuilder:validation:Enum=HTTP_301;HTTP_302
+	// +optional
+	StatusCode string `json:"statusCode,omitempty"`
+}
+
+// DefaultActionForwardConfig defines the forward of requests to a default Service.
+type DefaultActionForwardConfig struct {
+	// ServiceNamespace is the namespace of the default Service.
+	ServiceNamespace string `json:"serviceNamespace"`
+
+	// ServiceName is the name of the default Service.
+	ServiceName string `json:"serviceName"`
+
+	// ServicePort is the port of the default Service.
+	ServicePort intstr.IntOrString `json:"servicePort"`
+}
+
+// DefaultAction defines the listener default action of IngressGroup.
+type DefaultAction struct {
+	// Type is the type of default action.
+	Type DefaultActionType `json:"type"`
+
+	// Redirect defines the redirect of requests, required for the redirect type.
+	// +optional
+	Redirect *DefaultActionRedirectConfig `json:"redirect,omitempty"`
+
+	// Forward defines the forward of requests, required for the forward type.
+	// +optional
+	Forward *DefaultActionForwardConfig `json:"forward,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=HTTP;HTTPS
+// FrontendNlbHealthCheckProtocol is the protocol used by the frontend NLB to health check the ALB.
+type FrontendNlbHealthCheckProtocol string
+
+const (
+	FrontendNlbHealthCheckProtocolHTTP  FrontendNlbHealthCheckProtocol = "HTTP"
+	FrontendNlbHealthCheckProtocolHTTPS FrontendNlbHealthCheckProtocol = "HTTPS"
+)
+
+// FrontendNlbHealthCheckConfiguration defines the health check of the ALB by the frontend NLB.
+type FrontendNlbHealthCheckConfiguration struct {
+	// Protocol is the protocol of the health check, defaults to HTTP.
+	// +optional
+	Protocol FrontendNlbHealthCheckProtocol `json:"protocol,omitempty"`
+
+	// Path is the HTTP path of the health check, defaults to /.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// SuccessCodes are the HTTP status codes expected from the health check, defaults to 200.
+	// +optional
+	SuccessCodes string `json:"successCodes,omitempty"`
+}
+
+// FrontendNlbConfiguration defines the NLB provisioned in front of the ALB, which provides static IP addresses for ALB workloads.
+type FrontendNlbConfiguration struct {
+	// Enabled specifies whether the frontend NLB is provisioned.
+	Enabled bool `json:"enabled"`
+
+	// Scheme is the scheme of the frontend NLB, defaults to the scheme of the ALB.
+	// +optional
+	Scheme *LoadBalancerScheme `json:"scheme,omitempty"`
+
+	// Subnets are the names or IDs of the subnets of the frontend NLB.
+	// If absent, the subnets of the ALB are used when both have the same scheme, otherwise subnets are auto-discovered.
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// EIPAllocations are the Elastic IP allocations of an internet-facing frontend NLB, one per subnet in the same order as the subnets.
+	// +optional
+	EIPAllocations []string `json:"eipAllocations,omitempty"`
+
+	// HealthCheck defines the health check of the ALB by the frontend NLB.
+	// +optional
+	HealthCheck *FrontendNlbHealthCheckConfiguration `json:"healthCheck,omitempty"`
+}
+
+// InboundCIDRsPolicy restricts the inbound CIDRs of Ingresses.
+type InboundCIDRsPolicy struct {
+	// NamespaceSelector selects the namespaces of Ingresses that the policy applies to.
+	// * if absent or present but empty, it selects all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// AllowedCIDRs are the CIDRs that the inbound CIDRs of Ingresses must be within.
+	// Ingresses without inbound CIDRs or prefix lists are considered to allow 0.0.0.0/0 and ::/0.
+	AllowedCIDRs []string `json:"allowedCIDRs"`
+}
+
 // IngressClassParamsSpec defines the desired state of IngressClassParams
 type IngressClassParamsSpec struct {
 	// NamespaceSelector restrict the namespaces of Ingresses that are allowed to specify the IngressClass with this IngressClassParams.
@@ -103,6 +355,15 @@ type IngressClassParamsSpec struct {
 	// +optional
 	InboundCIDRs []string `json:"inboundCIDRs,omitempty"`
 
+	// InboundCIDRsPolicy restricts the CIDRs that Ingresses that belong to IngressClass with this IngressClassParams
+	// are allowed to open their LoadBalancers to via annotation. It doesn't apply when InboundCIDRs is specified.
+	// +optional
+	InboundCIDRsPolicy *InboundCIDRsPolicy `json:"inboundCIDRsPolicy,omitempty"`
+
+	// PrefixListsIDs specifies the managed prefix lists that are allowed to access the Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	PrefixListsIDs []string `json:"prefixListsIDs,omitempty"`
+
 	// SSLPolicy specifies the SSL Policy for all Ingresses that belong to IngressClass with this IngressClassParams.
 	// +optional
 	SSLPolicy string `json:"sslPolicy,omitEmpty"`
@@ -115,12 +376,82 @@ type IngressClassParamsSpec struct {
 	// +optional
 	IPAddressType *IPAddressType `json:"ipAddressType,omitempty"`
 
+	// IPAMConfiguration defines the IPAM pools to source the IP addresses of LoadBalancers from for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// If specified, Ingresses cannot override it via annotation.
+	// +optional
+	IPAMConfiguration *IPAMConfiguration `json:"ipamConfiguration,omitempty"`
+
+	// MinimumLoadBalancerCapacity defines the minimum capacity reserved for LoadBalancers of all Ingresses that belong to IngressClass with this IngressClassParams.
+	// If specified, Ingresses cannot override it via annotation.
+	// +optional
+	MinimumLoadBalancerCapacity *MinimumLoadBalancerCapacity `json:"minimumLoadBalancerCapacity,omitempty"`
+
 	// Tags defines list of Tags on AWS resources provisioned for Ingresses that belong to IngressClass with this IngressClassParams.
 	Tags []Tag `json:"tags,omitempty"`
 
 	// LoadBalancerAttributes define the custom attributes to LoadBalancers for all Ingress that that belong to IngressClass with this IngressClassParams.
 	// +optional
 	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// WAFFailOpen specifies whether the LoadBalancers for all Ingresses that belong to IngressClass with this IngressClassParams
+	// route requests to targets when they are unable to forward the request to AWS WAF.
+	// If specified, Ingresses cannot override it via annotation.
+	// +optional
+	WAFFailOpen *bool `json:"wafFailOpen,omitempty"`
+
+	// AWSRoleARN specifies the IAM role to assume when provisioning AWS resources for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// If specified, Ingresses cannot override it via annotation.
+	// +optional
+	AWSRoleARN string `json:"awsRoleARN,omitempty"`
+
+	// WAFv2LoggingConfiguration defines the logging configuration to ensure on WAFv2 WebACLs associated with
+	// LoadBalancers for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	WAFv2LoggingConfiguration *WAFv2LoggingConfiguration `json:"wafv2LoggingConfiguration,omitempty"`
+
+	// WAFv2WebACL defines the WAFv2 WebACL that the controller creates and associates with LoadBalancers
+	// for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// The WebACL is deleted together with the IngressGroup. If specified, Ingresses cannot override it via annotation.
+	// +optional
+	WAFv2WebACL *WAFv2WebACL `json:"wafv2WebACL,omitempty"`
+
+	// MutualAuthentication defines the mutual TLS configuration of HTTPS listeners for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// If specified, Ingresses cannot override it via annotation.
+	// +optional
+	MutualAuthentication []MutualAuthenticationAttributes `json:"mutualAuthentication,omitempty"`
+
+	// TrustStoreBundle defines the CA certificates bundle of the controller managed trust store for all Ingresses that belong to IngressClass with this IngressClassParams.
+	// If specified, Ingresses cannot override it via annotation.
+	// +optional
+	TrustStoreBundle *TrustStoreBundle `json:"trustStoreBundle,omitempty"`
+
+	// AllowedServiceNamespaces specifies the namespaces whose Services can be referenced via the serviceNamespace field in actions
+	// of Ingresses that belong to IngressClass with this IngressClassParams.
+	// +optional
+	AllowedServiceNamespaces []string `json:"allowedServiceNamespaces,omitempty"`
+
+	// DefaultAction defines the listener default action for IngressGroups of Ingresses that belong to IngressClass with this IngressClassParams,
+	// which is used when no Ingress of the IngressGroup specifies a default backend.
+	// +optional
+	DefaultAction *DefaultAction `json:"defaultAction,omitempty"`
+
+	// GlobalAcceleratorEnabled specifies whether the controller creates an AWS Global Accelerator with the LoadBalancers
+	// for all Ingresses that belong to IngressClass with this IngressClassParams as endpoint.
+	// The accelerator is deleted together with the IngressGroup. If specified, Ingresses cannot override it via annotation.
+	// +optional
+	GlobalAcceleratorEnabled *bool `json:"globalAcceleratorEnabled,omitempty"`
+
+	// ExternallyManagedNetworking specifies whether the security groups of LoadBalancers for all Ingresses that belong to IngressClass
+	// with this IngressClassParams are managed externally, e.g. by Terraform. The controller never creates nor mutates any security group
+	// or security group rule, and only validates that the security groups specified via annotation exist.
+	// If specified, Ingresses cannot override it via annotation.
+	// +optional
+	ExternallyManagedNetworking *bool `json:"externallyManagedNetworking,omitempty"`
+
+	// FrontendNlb defines the NLB that the controller provisions in front of the ALB for all Ingresses that belong to IngressClass
+	// with this IngressClassParams. If specified, Ingresses cannot override it via annotation.
+	// +optional
+	FrontendNlb *FrontendNlbConfiguration `json:"frontendNlb,omitempty"`
 }
 
 // +kubebuilder:object:root=true
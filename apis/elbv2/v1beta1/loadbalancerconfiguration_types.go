@@ -0,0 +1,93 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LoadBalancerConfigurationSpec defines the desired state of LoadBalancerConfiguration
+type LoadBalancerConfigurationSpec struct {
+	// NamespaceSelector restrict the namespaces of Services that are allowed to reference this LoadBalancerConfiguration.
+	// * if absent or present but empty, it selects all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Scheme defines the scheme for all Services that reference this LoadBalancerConfiguration.
+	// If specified, Services cannot override it via annotation.
+	// +optional
+	Scheme *LoadBalancerScheme `json:"scheme,omitempty"`
+
+	// Subnets specifies the IDs or names of the subnets for all Services that reference this LoadBalancerConfiguration.
+	// If specified, Services cannot override it via annotation.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// SecurityGroups specifies the IDs or names of the frontend securityGroups for all Services that reference this LoadBalancerConfiguration.
+	// If specified, Services cannot override it via annotation.
+	// +kubebuilder:validation:MinItems=1
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+
+	// LoadBalancerAttributes define the custom attributes to LoadBalancers for all Services that reference this LoadBalancerConfiguration.
+	// They're merged with the attributes of the Service annotation, Services cannot override the attributes specified here.
+	// +optional
+	LoadBalancerAttributes []Attribute `json:"loadBalancerAttributes,omitempty"`
+
+	// TargetType defines the default target type of TargetGroups for all Services that reference this LoadBalancerConfiguration.
+	// Services can override it via annotation.
+	// +optional
+	TargetType *TargetType `json:"targetType,omitempty"`
+
+	// TargetGroupAttributes define the default attributes to TargetGroups for all Services that reference this LoadBalancerConfiguration.
+	// They're merged with the attributes of the Service annotation, which take precedence.
+	// +optional
+	TargetGroupAttributes []Attribute `json:"targetGroupAttributes,omitempty"`
+
+	// Tags defines list of Tags on AWS resources provisioned for Services that reference this LoadBalancerConfiguration.
+	// They're merged with the tags of the Service annotation, Services cannot override the tags specified here.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="SCHEME",type="string",JSONPath=".spec.scheme",description="The AWS Load Balancer scheme"
+// +kubebuilder:printcolumn:name="TARGET-TYPE",type="string",JSONPath=".spec.targetType",description="The AWS TargetGroup target type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// LoadBalancerConfiguration is the Schema for the LoadBalancerConfiguration API
+type LoadBalancerConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LoadBalancerConfigurationSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerConfigurationList contains a list of LoadBalancerConfiguration
+type LoadBalancerConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancerConfiguration{}, &LoadBalancerConfigurationList{})
+}
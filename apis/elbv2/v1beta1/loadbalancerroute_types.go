@@ -0,0 +1,255 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +kubebuilder:validation:Enum=Exact;Prefix;PathPattern
+// LoadBalancerRoutePathMatchType is the type of path match.
+type LoadBalancerRoutePathMatchType string
+
+const (
+	LoadBalancerRoutePathMatchTypeExact       LoadBalancerRoutePathMatchType = "Exact"
+	LoadBalancerRoutePathMatchTypePrefix      LoadBalancerRoutePathMatchType = "Prefix"
+	LoadBalancerRoutePathMatchTypePathPattern LoadBalancerRoutePathMatchType = "PathPattern"
+)
+
+// LoadBalancerRoutePathMatch defines the path a request must match.
+type LoadBalancerRoutePathMatch struct {
+	// type is the type of path match.
+	// * Exact matches the path exactly.
+	// * Prefix matches the path by its elements, e.g. "/foo" matches "/foo" and "/foo/bar" but not "/foobar".
+	// * PathPattern matches the path with the ALB path pattern, which supports "*" and "?" wildcards.
+	// If type is unspecified, it defaults to Prefix.
+	// +optional
+	Type *LoadBalancerRoutePathMatchType `json:"type,omitempty"`
+
+	// value is the path to match.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	Value string `json:"value"`
+}
+
+// LoadBalancerRouteHeaderMatch defines a HTTP header a request must match.
+type LoadBalancerRouteHeaderMatch struct {
+	// name is the name of HTTP header.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	Name string `json:"name"`
+
+	// values are the values to match, the request matches if the header matches any of them.
+	// Values support "*" and "?" wildcards.
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// LoadBalancerRouteQueryParamMatch defines a query parameter a request must match.
+type LoadBalancerRouteQueryParamMatch struct {
+	// key is the key of query parameter.
+	// If key is unspecified, requests with any query parameter of the value match.
+	// +optional
+	Key *string `json:"key,omitempty"`
+
+	// value is the value of query parameter.
+	// Value supports "*" and "?" wildcards.
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+}
+
+// LoadBalancerRouteMatch defines the conditions a request must match, all conditions of a match are ANDed.
+type LoadBalancerRouteMatch struct {
+	// path is the path a request must match.
+	// +optional
+	Path *LoadBalancerRoutePathMatch `json:"path,omitempty"`
+
+	// headers are the HTTP headers a request must match.
+	// +optional
+	Headers []LoadBalancerRouteHeaderMatch `json:"headers,omitempty"`
+
+	// queryParams are the query parameters a request must match, the request matches if any of them matches.
+	// +optional
+	QueryParams []LoadBalancerRouteQueryParamMatch `json:"queryParams,omitempty"`
+
+	// methods are the HTTP request methods a request must match, the request matches if any of them matches.
+	// +optional
+	Methods []string `json:"methods,omitempty"`
+
+	// sourceIPs are the CIDRs the source IP of a request must match, the request matches if any of them matches.
+	// +optional
+	SourceIPs []string `json:"sourceIPs,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=RequestRedirect;FixedResponse
+// LoadBalancerRouteFilterType is the type of filter.
+type LoadBalancerRouteFilterType string
+
+const (
+	LoadBalancerRouteFilterTypeRequestRedirect LoadBalancerRouteFilterType = "RequestRedirect"
+	LoadBalancerRouteFilterTypeFixedResponse   LoadBalancerRouteFilterType = "FixedResponse"
+)
+
+// LoadBalancerRouteRequestRedirect defines the redirect responded to matching requests.
+// Unspecified components of the redirect URL keep the values of the original request.
+type LoadBalancerRouteRequestRedirect struct {
+	// scheme is the protocol of the redirect URL.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS
+	// +optional
+	Scheme *string `json:"scheme,omitempty"`
+
+	// hostname is the hostname of the redirect URL.
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// path is the absolute path of the redirect URL.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// port is the port of the redirect URL.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// query is the query parameters of the redirect URL, without the leading "?".
+	// +optional
+	Query *string `json:"query,omitempty"`
+
+	// statusCode is the HTTP status code of the redirect.
+	// If statusCode is unspecified, it defaults to 301.
+	// +kubebuilder:validation:Enum=301;302
+	// +optional
+	StatusCode *int64 `json:"statusCode,omitempty"`
+}
+
+// LoadBalancerRouteFixedResponse defines the fixed response responded to matching requests.
+type LoadBalancerRouteFixedResponse struct {
+	// statusCode is the HTTP status code of the response.
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode int64 `json:"statusCode"`
+
+	// contentType is the content type of the response.
+	// +kubebuilder:validation:Enum=text/plain;text/css;text/html;application/javascript;application/json
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// messageBody is the body of the response.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	MessageBody *string `json:"messageBody,omitempty"`
+}
+
+// LoadBalancerRouteFilter defines how matching requests are responded to instead of being forwarded to backends.
+type LoadBalancerRouteFilter struct {
+	// type is the type of filter.
+	Type LoadBalancerRouteFilterType `json:"type"`
+
+	// requestRedirect defines the redirect when type is RequestRedirect.
+	// +optional
+	RequestRedirect *LoadBalancerRouteRequestRedirect `json:"requestRedirect,omitempty"`
+
+	// fixedResponse defines the fixed response when type is FixedResponse.
+	// +optional
+	FixedResponse *LoadBalancerRouteFixedResponse `json:"fixedResponse,omitempty"`
+}
+
+// LoadBalancerRouteBackend defines a Service in the same namespace matching requests are forwarded to.
+type LoadBalancerRouteBackend struct {
+	// name is the name of Service.
+	Name string `json:"name"`
+
+	// port is the name or number of the Service port.
+	Port intstr.IntOrString `json:"port"`
+
+	// weight is the relative weight of the traffic forwarded to this backend.
+	// If weight is unspecified, it defaults to 1.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=999
+	// +optional
+	Weight *int64 `json:"weight,omitempty"`
+}
+
+// LoadBalancerRouteRule defines the requests to match and how they're handled.
+type LoadBalancerRouteRule struct {
+	// matches are the conditions a request must match, the request matches if any of them matches.
+	// If matches is unspecified, all requests to the hostnames of route match.
+	// +optional
+	Matches []LoadBalancerRouteMatch `json:"matches,omitempty"`
+
+	// filters define how matching requests are responded to.
+	// Exactly one of filters and backends must be specified.
+	// +kubebuilder:validation:MaxItems=1
+	// +optional
+	Filters []LoadBalancerRouteFilter `json:"filters,omitempty"`
+
+	// backends are the Services matching requests are forwarded to.
+	// Exactly one of filters and backends must be specified.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	Backends []LoadBalancerRouteBackend `json:"backends,omitempty"`
+}
+
+// LoadBalancerRouteSpec defines the desired state of LoadBalancerRoute
+type LoadBalancerRouteSpec struct {
+	// group is the explicit IngressGroup whose ALB listeners the rules are added to.
+	Group IngressGroup `json:"group"`
+
+	// port is the port of the IngressGroup's listener the rules are added to.
+	// If port is unspecified, the rules are added to all listeners of the IngressGroup.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// hostnames are the hostnames requests must match, the request matches if any of them matches.
+	// If hostnames is unspecified, requests of any hostname match.
+	// +optional
+	Hostnames []string `json:"hostnames,omitempty"`
+
+	// rules are the rules of route, evaluated in order.
+	// +kubebuilder:validation:MinItems=1
+	Rules []LoadBalancerRouteRule `json:"rules"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.group.name",description="The IngressGroup's name"
+// +kubebuilder:printcolumn:name="PORT",type="integer",JSONPath=".spec.port",description="The listener's port"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// LoadBalancerRoute is the Schema for the LoadBalancerRoute API
+type LoadBalancerRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LoadBalancerRouteSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerRouteList contains a list of LoadBalancerRoute
+type LoadBalancerRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadBalancerRoute{}, &LoadBalancerRouteList{})
+}
@@ -42,6 +42,27 @@ const (
 	TargetGroupIPAddressTypeIPv6 TargetGroupIPAddressType = "ipv6"
 )
 
+// +kubebuilder:validation:Enum=prefer-ipv4;prefer-ipv6
+// TargetIPFamilyPolicy specifies the IP address family of the pod IPs registered as targets.
+type TargetIPFamilyPolicy string
+
+const (
+	TargetIPFamilyPolicyPreferIPv4 TargetIPFamilyPolicy = "prefer-ipv4"
+	TargetIPFamilyPolicyPreferIPv6 TargetIPFamilyPolicy = "prefer-ipv6"
+)
+
+// +kubebuilder:validation:Enum=Delete;Retain
+// DeletionPolicy specifies what happens to the AWS resources once the Kubernetes resource managing them is deleted.
+//
+// * with `Delete` DeletionPolicy, the AWS resources are cleaned up, e.g. targets are deregistered
+// * with `Retain` DeletionPolicy, the AWS resources are left intact, so that they can be handed over to another owner
+type DeletionPolicy string
+
+const (
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
 // ServiceReference defines reference to a Kubernetes Service and its ServicePort.
 type ServiceReference struct {
 	// Name is the name of the Service.
@@ -64,6 +85,12 @@ type SecurityGroup struct {
 	GroupID string `json:"groupID"`
 }
 
+// PrefixList defines reference to an AWS EC2 managed prefix list.
+type PrefixList struct {
+	// PrefixListID is the EC2 managed prefix list ID.
+	PrefixListID string `json:"prefixListID"`
+}
+
 // NetworkingPeer defines the source/destination peer for networking rules.
 type NetworkingPeer struct {
 	// IPBlock defines an IPBlock peer.
@@ -75,6 +102,11 @@ type NetworkingPeer struct {
 	// If specified, none of the other fields can be set.
 	// +optional
 	SecurityGroup *SecurityGroup `json:"securityGroup,omitempty"`
+
+	// PrefixList defines a managed prefix list peer.
+	// If specified, none of the other fields can be set.
+	// +optional
+	PrefixList *PrefixList `json:"prefixList,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=TCP;UDP
@@ -121,6 +153,147 @@ type TargetGroupBindingNetworking struct {
 	Ingress []NetworkingIngressRule `json:"ingress,omitempty"`
 }
 
+// ExternalTarget defines a target outside of the cluster to be registered into TargetGroup.
+type ExternalTarget struct {
+	// id is the IP address of the target for TargetGroup with ip TargetType,
+	// or the EC2 instance ID of the target for TargetGroup with instance TargetType.
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id"`
+
+	// port is the port on which the target is listening.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int64 `json:"port"`
+
+	// availabilityZone is the Availability Zone where the target is registered, use "all" for IP addresses outside the VPC.
+	// If unspecified, it will be automatically inferred.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+}
+
+// ManagedTargetGroupHealthCheck defines the health check settings of TargetGroup.
+type ManagedTargetGroupHealthCheck struct {
+	// path is the destination on the targets for HTTP/HTTPS health checks.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// port is the port for health checks, either a port number or "traffic-port" for the port of targets.
+	// +optional
+	Port *intstr.IntOrString `json:"port,omitempty"`
+
+	// intervalSeconds is the approximate amount of time, in seconds, between health checks of an individual target.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	IntervalSeconds *int64 `json:"intervalSeconds,omitempty"`
+
+	// timeoutSeconds is the amount of time, in seconds, during which no response from a target means a failed health check.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty"`
+
+	// healthyThresholdCount is the number of consecutive health check successes required before considering a target healthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	HealthyThresholdCount *int64 `json:"healthyThresholdCount,omitempty"`
+
+	// unhealthyThresholdCount is the number of consecutive health check failures required before considering a target unhealthy.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	UnhealthyThresholdCount *int64 `json:"unhealthyThresholdCount,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=lb_cookie;app_cookie;source_ip;source_ip_dest_ip;source_ip_dest_ip_proto
+// ManagedTargetGroupStickinessType is the type of sticky sessions.
+type ManagedTargetGroupStickinessType string
+
+const (
+	ManagedTargetGroupStickinessTypeLBCookie  ManagedTargetGroupStickinessType = "lb_cookie"
+	ManagedTargetGroupStickinessTypeAppCookie ManagedTargetGroupStickinessType = "app_cookie"
+)
+
+// ManagedTargetGroupStickiness defines the sticky sessions settings of TargetGroup.
+type ManagedTargetGroupStickiness struct {
+	// enabled indicates whether sticky sessions are enabled.
+	Enabled bool `json:"enabled"`
+
+	// type is the type of sticky sessions.
+	// +optional
+	Type *ManagedTargetGroupStickinessType `json:"type,omitempty"`
+
+	// durationSeconds is the time period, in seconds, during which requests from a client are routed to the same target.
+	// It's only supported by the lb_cookie and app_cookie types.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=604800
+	// +optional
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+}
+
+// ManagedTargetGroupUnhealthyTargetState defines how the connections to unhealthy targets of TargetGroup are handled.
+type ManagedTargetGroupUnhealthyTargetState struct {
+	// connectionTermination indicates whether the connections to unhealthy targets are terminated.
+	// +optional
+	ConnectionTermination *bool `json:"connectionTermination,omitempty"`
+
+	// drainingIntervalSeconds is the amount of time, in seconds, to wait before terminating the connections to unhealthy targets.
+	// It's only supported if connectionTermination is disabled.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=360000
+	// +optional
+	DrainingIntervalSeconds *int64 `json:"drainingIntervalSeconds,omitempty"`
+}
+
+// ManagedTargetGroupMinimumHealthyTargets defines the minimum healthy targets of TargetGroup, either as count or percentage.
+// The action is taken once the healthy targets fall below either of them.
+type ManagedTargetGroupMinimumHealthyTargets struct {
+	// count is the minimum number of healthy targets, or "off".
+	// +optional
+	Count *intstr.IntOrString `json:"count,omitempty"`
+
+	// percentage is the minimum percentage of healthy targets, or "off".
+	// +optional
+	Percentage *intstr.IntOrString `json:"percentage,omitempty"`
+}
+
+// ManagedTargetGroupHealth defines the health requirements of TargetGroup.
+type ManagedTargetGroupHealth struct {
+	// dnsFailover defines the minimum healthy targets, below which the load balancer zone fails over in DNS.
+	// +optional
+	DNSFailover *ManagedTargetGroupMinimumHealthyTargets `json:"dnsFailover,omitempty"`
+
+	// unhealthyStateRouting defines the minimum healthy targets, below which the load balancer routes traffic to all targets.
+	// +optional
+	UnhealthyStateRouting *ManagedTargetGroupMinimumHealthyTargets `json:"unhealthyStateRouting,omitempty"`
+}
+
+// ManagedTargetGroupConfig defines the settings of TargetGroup enforced by the controller.
+type ManagedTargetGroupConfig struct {
+	// healthCheck defines the health check settings of TargetGroup.
+	// +optional
+	HealthCheck *ManagedTargetGroupHealthCheck `json:"healthCheck,omitempty"`
+
+	// deregistrationDelaySeconds is the amount of time, in seconds, to wait before changing the state of a deregistering target from draining to unused.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	DeregistrationDelaySeconds *int64 `json:"deregistrationDelaySeconds,omitempty"`
+
+	// stickiness defines the sticky sessions settings of TargetGroup.
+	// +optional
+	Stickiness *ManagedTargetGroupStickiness `json:"stickiness,omitempty"`
+
+	// unhealthyTargetState defines how the connections to unhealthy targets of TargetGroup are handled.
+	// +optional
+	UnhealthyTargetState *ManagedTargetGroupUnhealthyTargetState `json:"unhealthyTargetState,omitempty"`
+
+	// targetGroupHealth defines the health requirements of TargetGroup.
+	// +optional
+	TargetGroupHealth *ManagedTargetGroupHealth `json:"targetGroupHealth,omitempty"`
+}
+
 // TargetGroupBindingSpec defines the desired state of TargetGroupBinding
 type TargetGroupBindingSpec struct {
 	// targetGroupARN is the Amazon Resource Name (ARN) for the TargetGroup.
@@ -142,9 +315,63 @@ type TargetGroupBindingSpec struct {
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
 
+	// maxTargetNodes is the maximum number, or percentage e.g. "10%", of selected nodes registered as targets of instance type target groups.
+	// Nodes are chosen deterministically per TargetGroup and spread across availability zones, so that each TargetGroup only has a subset of nodes as targets.
+	// By default, all selected nodes are registered.
+	// +optional
+	MaxTargetNodes *intstr.IntOrString `json:"maxTargetNodes,omitempty"`
+
 	// ipAddressType specifies whether the target group is of type IPv4 or IPv6. If unspecified, it will be automatically inferred.
 	// +optional
 	IPAddressType *TargetGroupIPAddressType `json:"ipAddressType,omitempty"`
+
+	// targetIPFamilyPolicy specifies the IP address family of the pod IPs registered as targets of ip type target groups, which must match the ipAddressType.
+	// When specified, the pod IPs of the family are registered regardless of the IP families of the Service, so that dual-stack pods behind single-stack Services can be registered.
+	// Pods without an IP of the family aren't registered. If unspecified, the endpoint addresses of the Service matching the ipAddressType are registered.
+	// +optional
+	TargetIPFamilyPolicy *TargetIPFamilyPolicy `json:"targetIPFamilyPolicy,omitempty"`
+
+	// multiClusterTargetGroup denotes if the TargetGroup is shared across multiple clusters.
+	// When enabled, the controller only deregisters targets registered by itself, and leaves targets registered by others intact.
+	// +optional
+	MultiClusterTargetGroup bool `json:"multiClusterTargetGroup,omitempty"`
+
+	// connectionTermination denotes if the TargetGroup terminates connections at the end of the deregistration delay.
+	// When enabled, targets of terminating pods are deregistered right away, so that their long-lived connections are terminated before the pods exit.
+	// Otherwise, targets of terminating pods stay registered while the pods are still serving, so that long-lived connections drain naturally.
+	// +optional
+	ConnectionTermination bool `json:"connectionTermination,omitempty"`
+
+	// externalTargets is a list of targets outside of the cluster, which will be registered alongside the endpoints of serviceRef.
+	// +optional
+	ExternalTargets []ExternalTarget `json:"externalTargets,omitempty"`
+
+	// awsRoleARN is the IAM role to assume when managing targets of the TargetGroup, which is owned by another AWS account.
+	// +optional
+	AWSRoleARN string `json:"awsRoleARN,omitempty"`
+
+	// targetGroupAttributes defines the attributes of TargetGroup, e.g. load_balancing.cross_zone.enabled.
+	// The controller reconciles the specified attributes to prevent them from drifting, and leaves unspecified attributes intact.
+	// +optional
+	TargetGroupAttributes []Attribute `json:"targetGroupAttributes,omitempty"`
+
+	// manageTargetGroupConfig defines the settings of TargetGroup, e.g. health check, the controller reconciles to prevent them from drifting.
+	// Settings left unspecified are left intact, so that TargetGroups created outside the cluster can be partially managed.
+	// +optional
+	ManageTargetGroupConfig *ManagedTargetGroupConfig `json:"manageTargetGroupConfig,omitempty"`
+
+	// podDeregistrationWaitSeconds is the maximum time in seconds the deletion of a pod is delayed, until its targets are deregistered and draining.
+	// It requires the PodDeregistrationCoordination feature gate, and only applies to TargetGroups of ip TargetType.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=25
+	// +optional
+	PodDeregistrationWaitSeconds *int64 `json:"podDeregistrationWaitSeconds,omitempty"`
+
+	// deletionPolicy specifies whether the targets of TargetGroup are deregistered once the TargetGroupBinding is deleted.
+	// With Retain, the targets and networking rules are left intact, so that the TargetGroup can be handed over to another owner.
+	// By default, Delete is used.
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
 }
 
 // TargetGroupBindingStatus defines the observed state of TargetGroupBinding
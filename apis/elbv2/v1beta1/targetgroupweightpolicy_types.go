@@ -0,0 +1,105 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WeightedServiceBackend defines a Kubernetes Service backend with its forward weight.
+type WeightedServiceBackend struct {
+	// serviceRef is a reference to a Kubernetes Service and ServicePort in the same namespace.
+	ServiceRef ServiceReference `json:"serviceRef"`
+
+	// weight is the desired forward weight of the backend.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=999
+	Weight int64 `json:"weight"`
+}
+
+// WeightStepping defines how the forward weights are shifted over time.
+type WeightStepping struct {
+	// stepWeight is the maximum weight shifted for each backend per step.
+	// +kubebuilder:validation:Minimum=1
+	StepWeight int64 `json:"stepWeight"`
+
+	// intervalSeconds is the interval in seconds between two steps.
+	// +kubebuilder:validation:Minimum=1
+	IntervalSeconds int64 `json:"intervalSeconds"`
+}
+
+// TargetGroupWeightPolicySpec defines the desired state of TargetGroupWeightPolicy
+type TargetGroupWeightPolicySpec struct {
+	// ingressName is the name of the Ingress in the same namespace whose action is managed.
+	IngressName string `json:"ingressName"`
+
+	// actionName is the name of the action that is referenced as backend service name with the `use-annotation` port in Ingress rules.
+	ActionName string `json:"actionName"`
+
+	// backends are the Kubernetes Service backends to forward traffic to.
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=2
+	Backends []WeightedServiceBackend `json:"backends"`
+
+	// stepping shifts the forward weights gradually towards the desired weights.
+	// If omitted, the desired weights are applied immediately.
+	// +optional
+	Stepping *WeightStepping `json:"stepping,omitempty"`
+}
+
+// TargetGroupWeightPolicyStatus defines the observed state of TargetGroupWeightPolicy
+type TargetGroupWeightPolicyStatus struct {
+	// The generation observed by the TargetGroupWeightPolicy controller.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// weights are the forward weights currently applied, in the same order as backends.
+	// +optional
+	Weights []int64 `json:"weights,omitempty"`
+
+	// lastStepTime is the time when the weights were last shifted.
+	// +optional
+	LastStepTime *metav1.Time `json:"lastStepTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="INGRESS",type="string",JSONPath=".spec.ingressName",description="The Kubernetes Ingress's name"
+// +kubebuilder:printcolumn:name="ACTION",type="string",JSONPath=".spec.actionName",description="The Ingress action's name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// TargetGroupWeightPolicy is the Schema for the TargetGroupWeightPolicy API
+type TargetGroupWeightPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetGroupWeightPolicySpec   `json:"spec,omitempty"`
+	Status TargetGroupWeightPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetGroupWeightPolicyList contains a list of TargetGroupWeightPolicy
+type TargetGroupWeightPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetGroupWeightPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TargetGroupWeightPolicy{}, &TargetGroupWeightPolicyList{})
+}
@@ -43,40 +43,222 @@ func (in *Attribute) DeepCopy() *Attribute {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPBlock) DeepCopyInto(out *IPBlock) {
+func (in *Blocklist) DeepCopyInto(out *Blocklist) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPBlock.
-func (in *IPBlock) DeepCopy() *IPBlock {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Blocklist.
+func (in *Blocklist) DeepCopy() *Blocklist {
 	if in == nil {
 		return nil
 	}
-	out := new(IPBlock)
+	out := new(Blocklist)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Blocklist) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParams) DeepCopyInto(out *IngressClassParams) {
+func (in *BlocklistList) DeepCopyInto(out *BlocklistList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Blocklist, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlocklistList.
+func (in *BlocklistList) DeepCopy() *BlocklistList {
+	if in == nil {
+		return nil
+	}
+	out := new(BlocklistList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlocklistList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlocklistSpec) DeepCopyInto(out *BlocklistSpec) {
+	*out = *in
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlocklistSpec.
+func (in *BlocklistSpec) DeepCopy() *BlocklistSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlocklistSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlocklistStatus) DeepCopyInto(out *BlocklistStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PrefixListIDs != nil {
+		in, out := &in.PrefixListIDs, &out.PrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlocklistStatus.
+func (in *BlocklistStatus) DeepCopy() *BlocklistStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BlocklistStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerCapabilities) DeepCopyInto(out *ControllerCapabilities) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerCapabilities.
+func (in *ControllerCapabilities) DeepCopy() *ControllerCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControllerCapabilities) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerCapabilitiesList) DeepCopyInto(out *ControllerCapabilitiesList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ControllerCapabilities, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerCapabilitiesList.
+func (in *ControllerCapabilitiesList) DeepCopy() *ControllerCapabilitiesList {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerCapabilitiesList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ControllerCapabilitiesList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerCapabilitiesStatus) DeepCopyInto(out *ControllerCapabilitiesStatus) {
+	*out = *in
+	if in.IngressAnnotations != nil {
+		in, out := &in.IngressAnnotations, &out.IngressAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Defaults = in.Defaults
+	out.Limits = in.Limits
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerCapabilitiesStatus.
+func (in *ControllerCapabilitiesStatus) DeepCopy() *ControllerCapabilitiesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerCapabilitiesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParams.
-func (in *IngressClassParams) DeepCopy() *IngressClassParams {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerConfiguration.
+func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressClassParams)
+	out := new(ControllerConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressClassParams) DeepCopyObject() runtime.Object {
+func (in *ControllerConfiguration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -84,31 +266,31 @@ func (in *IngressClassParams) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParamsList) DeepCopyInto(out *IngressClassParamsList) {
+func (in *ControllerConfigurationList) DeepCopyInto(out *ControllerConfigurationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IngressClassParams, len(*in))
+		*out = make([]ControllerConfiguration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsList.
-func (in *IngressClassParamsList) DeepCopy() *IngressClassParamsList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerConfigurationList.
+func (in *ControllerConfigurationList) DeepCopy() *ControllerConfigurationList {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressClassParamsList)
+	out := new(ControllerConfigurationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
+func (in *ControllerConfigurationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -116,71 +298,1299 @@ func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
+func (in *ControllerConfigurationSpec) DeepCopyInto(out *ControllerConfigurationSpec) {
 	*out = *in
-	if in.NamespaceSelector != nil {
-		in, out := &in.NamespaceSelector, &out.NamespaceSelector
-		*out = new(v1.LabelSelector)
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerConfigurationSpec.
+func (in *ControllerConfigurationSpec) DeepCopy() *ControllerConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerConfigurationStatus) DeepCopyInto(out *ControllerConfigurationStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EffectiveConfiguration != nil {
+		in, out := &in.EffectiveConfiguration, &out.EffectiveConfiguration
+		*out = new(EffectiveControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Group != nil {
-		in, out := &in.Group, &out.Group
-		*out = new(IngressGroup)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerConfigurationStatus.
+func (in *ControllerConfigurationStatus) DeepCopy() *ControllerConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerDefaults) DeepCopyInto(out *ControllerDefaults) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerDefaults.
+func (in *ControllerDefaults) DeepCopy() *ControllerDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerLimits) DeepCopyInto(out *ControllerLimits) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerLimits.
+func (in *ControllerLimits) DeepCopy() *ControllerLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultAction) DeepCopyInto(out *DefaultAction) {
+	*out = *in
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(DefaultActionRedirectConfig)
 		**out = **in
 	}
-	if in.Scheme != nil {
-		in, out := &in.Scheme, &out.Scheme
-		*out = new(LoadBalancerScheme)
+	if in.Forward != nil {
+		in, out := &in.Forward, &out.Forward
+		*out = new(DefaultActionForwardConfig)
 		**out = **in
 	}
-	if in.InboundCIDRs != nil {
-		in, out := &in.InboundCIDRs, &out.InboundCIDRs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultAction.
+func (in *DefaultAction) DeepCopy() *DefaultAction {
+	if in == nil {
+		return nil
 	}
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = new(SubnetSelector)
-		(*in).DeepCopyInto(*out)
+	out := new(DefaultAction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultActionForwardConfig) DeepCopyInto(out *DefaultActionForwardConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultActionForwardConfig.
+func (in *DefaultActionForwardConfig) DeepCopy() *DefaultActionForwardConfig {
+	if in == nil {
+		return nil
 	}
-	if in.IPAddressType != nil {
-		in, out := &in.IPAddressType, &out.IPAddressType
-		*out = new(IPAddressType)
-		**out = **in
+	out := new(DefaultActionForwardConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultActionRedirectConfig) DeepCopyInto(out *DefaultActionRedirectConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultActionRedirectConfig.
+func (in *DefaultActionRedirectConfig) DeepCopy() *DefaultActionRedirectConfig {
+	if in == nil {
+		return nil
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
+	out := new(DefaultActionRedirectConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveControllerConfiguration) DeepCopyInto(out *EffectiveControllerConfiguration) {
+	*out = *in
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveControllerConfiguration.
+func (in *EffectiveControllerConfiguration) DeepCopy() *EffectiveControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalTarget) DeepCopyInto(out *ExternalTarget) {
+	*out = *in
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalTarget.
+func (in *ExternalTarget) DeepCopy() *ExternalTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontendNlbConfiguration) DeepCopyInto(out *FrontendNlbConfiguration) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(LoadBalancerScheme)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EIPAllocations != nil {
+		in, out := &in.EIPAllocations, &out.EIPAllocations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(FrontendNlbHealthCheckConfiguration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendNlbConfiguration.
+func (in *FrontendNlbConfiguration) DeepCopy() *FrontendNlbConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontendNlbConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontendNlbHealthCheckConfiguration) DeepCopyInto(out *FrontendNlbHealthCheckConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendNlbHealthCheckConfiguration.
+func (in *FrontendNlbHealthCheckConfiguration) DeepCopy() *FrontendNlbHealthCheckConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontendNlbHealthCheckConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontendSecurityGroupIngressRule) DeepCopyInto(out *FrontendSecurityGroupIngressRule) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(NetworkingProtocol)
+		**out = **in
+	}
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int64)
+		**out = **in
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrefixListIDs != nil {
+		in, out := &in.PrefixListIDs, &out.PrefixListIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendSecurityGroupIngressRule.
+func (in *FrontendSecurityGroupIngressRule) DeepCopy() *FrontendSecurityGroupIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontendSecurityGroupIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontendSecurityGroupRule) DeepCopyInto(out *FrontendSecurityGroupRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendSecurityGroupRule.
+func (in *FrontendSecurityGroupRule) DeepCopy() *FrontendSecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontendSecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FrontendSecurityGroupRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontendSecurityGroupRuleList) DeepCopyInto(out *FrontendSecurityGroupRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FrontendSecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendSecurityGroupRuleList.
+func (in *FrontendSecurityGroupRuleList) DeepCopy() *FrontendSecurityGroupRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontendSecurityGroupRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FrontendSecurityGroupRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrontendSecurityGroupRuleSpec) DeepCopyInto(out *FrontendSecurityGroupRuleSpec) {
+	*out = *in
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(IngressGroup)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]FrontendSecurityGroupIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrontendSecurityGroupRuleSpec.
+func (in *FrontendSecurityGroupRuleSpec) DeepCopy() *FrontendSecurityGroupRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FrontendSecurityGroupRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMConfiguration) DeepCopyInto(out *IPAMConfiguration) {
+	*out = *in
+	if in.IPv4IPAMPoolId != nil {
+		in, out := &in.IPv4IPAMPoolId, &out.IPv4IPAMPoolId
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMConfiguration.
+func (in *IPAMConfiguration) DeepCopy() *IPAMConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPBlock) DeepCopyInto(out *IPBlock) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPBlock.
+func (in *IPBlock) DeepCopy() *IPBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(IPBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InboundCIDRsPolicy) DeepCopyInto(out *InboundCIDRsPolicy) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InboundCIDRsPolicy.
+func (in *InboundCIDRsPolicy) DeepCopy() *InboundCIDRsPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InboundCIDRsPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParams) DeepCopyInto(out *IngressClassParams) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParams.
+func (in *IngressClassParams) DeepCopy() *IngressClassParams {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParams)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressClassParams) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParamsList) DeepCopyInto(out *IngressClassParamsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IngressClassParams, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsList.
+func (in *IngressClassParamsList) DeepCopy() *IngressClassParamsList {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParamsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressClassParamsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressClassParamsSpec) DeepCopyInto(out *IngressClassParamsSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(IngressGroup)
+		**out = **in
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(LoadBalancerScheme)
+		**out = **in
+	}
+	if in.InboundCIDRs != nil {
+		in, out := &in.InboundCIDRs, &out.InboundCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InboundCIDRsPolicy != nil {
+		in, out := &in.InboundCIDRsPolicy, &out.InboundCIDRsPolicy
+		*out = new(InboundCIDRsPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrefixListsIDs != nil {
+		in, out := &in.PrefixListsIDs, &out.PrefixListsIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = new(SubnetSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPAddressType != nil {
+		in, out := &in.IPAddressType, &out.IPAddressType
+		*out = new(IPAddressType)
+		**out = **in
+	}
+	if in.IPAMConfiguration != nil {
+		in, out := &in.IPAMConfiguration, &out.IPAMConfiguration
+		*out = new(IPAMConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinimumLoadBalancerCapacity != nil {
+		in, out := &in.MinimumLoadBalancerCapacity, &out.MinimumLoadBalancerCapacity
+		*out = new(MinimumLoadBalancerCapacity)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerAttributes != nil {
+		in, out := &in.LoadBalancerAttributes, &out.LoadBalancerAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.WAFFailOpen != nil {
+		in, out := &in.WAFFailOpen, &out.WAFFailOpen
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WAFv2LoggingConfiguration != nil {
+		in, out := &in.WAFv2LoggingConfiguration, &out.WAFv2LoggingConfiguration
+		*out = new(WAFv2LoggingConfiguration)
+		**out = **in
+	}
+	if in.WAFv2WebACL != nil {
+		in, out := &in.WAFv2WebACL, &out.WAFv2WebACL
+		*out = new(WAFv2WebACL)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MutualAuthentication != nil {
+		in, out := &in.MutualAuthentication, &out.MutualAuthentication
+		*out = make([]MutualAuthenticationAttributes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TrustStoreBundle != nil {
+		in, out := &in.TrustStoreBundle, &out.TrustStoreBundle
+		*out = new(TrustStoreBundle)
+		**out = **in
+	}
+	if in.AllowedServiceNamespaces != nil {
+		in, out := &in.AllowedServiceNamespaces, &out.AllowedServiceNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(DefaultAction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GlobalAcceleratorEnabled != nil {
+		in, out := &in.GlobalAcceleratorEnabled, &out.GlobalAcceleratorEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExternallyManagedNetworking != nil {
+		in, out := &in.ExternallyManagedNetworking, &out.ExternallyManagedNetworking
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FrontendNlb != nil {
+		in, out := &in.FrontendNlb, &out.FrontendNlb
+		*out = new(FrontendNlbConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
+func (in *IngressClassParamsSpec) DeepCopy() *IngressClassParamsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressClassParamsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressGroup) DeepCopyInto(out *IngressGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGroup.
+func (in *IngressGroup) DeepCopy() *IngressGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfiguration) DeepCopyInto(out *LoadBalancerConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfiguration.
+func (in *LoadBalancerConfiguration) DeepCopy() *LoadBalancerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigurationList) DeepCopyInto(out *LoadBalancerConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationList.
+func (in *LoadBalancerConfigurationList) DeepCopy() *LoadBalancerConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigurationSpec) DeepCopyInto(out *LoadBalancerConfigurationSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(LoadBalancerScheme)
+		**out = **in
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LoadBalancerAttributes != nil {
+		in, out := &in.LoadBalancerAttributes, &out.LoadBalancerAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetType != nil {
+		in, out := &in.TargetType, &out.TargetType
+		*out = new(TargetType)
+		**out = **in
+	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
-	if in.LoadBalancerAttributes != nil {
-		in, out := &in.LoadBalancerAttributes, &out.LoadBalancerAttributes
-		*out = make([]Attribute, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigurationSpec.
+func (in *LoadBalancerConfigurationSpec) DeepCopy() *LoadBalancerConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRoute) DeepCopyInto(out *LoadBalancerRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRoute.
+func (in *LoadBalancerRoute) DeepCopy() *LoadBalancerRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteBackend) DeepCopyInto(out *LoadBalancerRouteBackend) {
+	*out = *in
+	out.Port = in.Port
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteBackend.
+func (in *LoadBalancerRouteBackend) DeepCopy() *LoadBalancerRouteBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteFilter) DeepCopyInto(out *LoadBalancerRouteFilter) {
+	*out = *in
+	if in.RequestRedirect != nil {
+		in, out := &in.RequestRedirect, &out.RequestRedirect
+		*out = new(LoadBalancerRouteRequestRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FixedResponse != nil {
+		in, out := &in.FixedResponse, &out.FixedResponse
+		*out = new(LoadBalancerRouteFixedResponse)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteFilter.
+func (in *LoadBalancerRouteFilter) DeepCopy() *LoadBalancerRouteFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteFixedResponse) DeepCopyInto(out *LoadBalancerRouteFixedResponse) {
+	*out = *in
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MessageBody != nil {
+		in, out := &in.MessageBody, &out.MessageBody
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteFixedResponse.
+func (in *LoadBalancerRouteFixedResponse) DeepCopy() *LoadBalancerRouteFixedResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteFixedResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteHeaderMatch) DeepCopyInto(out *LoadBalancerRouteHeaderMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteHeaderMatch.
+func (in *LoadBalancerRouteHeaderMatch) DeepCopy() *LoadBalancerRouteHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteList) DeepCopyInto(out *LoadBalancerRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteList.
+func (in *LoadBalancerRouteList) DeepCopy() *LoadBalancerRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteMatch) DeepCopyInto(out *LoadBalancerRouteMatch) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(LoadBalancerRoutePathMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]LoadBalancerRouteHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]LoadBalancerRouteQueryParamMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceIPs != nil {
+		in, out := &in.SourceIPs, &out.SourceIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteMatch.
+func (in *LoadBalancerRouteMatch) DeepCopy() *LoadBalancerRouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRoutePathMatch) DeepCopyInto(out *LoadBalancerRoutePathMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(LoadBalancerRoutePathMatchType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRoutePathMatch.
+func (in *LoadBalancerRoutePathMatch) DeepCopy() *LoadBalancerRoutePathMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRoutePathMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteQueryParamMatch) DeepCopyInto(out *LoadBalancerRouteQueryParamMatch) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteQueryParamMatch.
+func (in *LoadBalancerRouteQueryParamMatch) DeepCopy() *LoadBalancerRouteQueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteQueryParamMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteRequestRedirect) DeepCopyInto(out *LoadBalancerRouteRequestRedirect) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteRequestRedirect.
+func (in *LoadBalancerRouteRequestRedirect) DeepCopy() *LoadBalancerRouteRequestRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteRequestRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteRule) DeepCopyInto(out *LoadBalancerRouteRule) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]LoadBalancerRouteMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]LoadBalancerRouteFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]LoadBalancerRouteBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteRule.
+func (in *LoadBalancerRouteRule) DeepCopy() *LoadBalancerRouteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerRouteSpec) DeepCopyInto(out *LoadBalancerRouteSpec) {
+	*out = *in
+	out.Group = in.Group
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]LoadBalancerRouteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerRouteSpec.
+func (in *LoadBalancerRouteSpec) DeepCopy() *LoadBalancerRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedTargetGroupConfig) DeepCopyInto(out *ManagedTargetGroupConfig) {
+	*out = *in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(ManagedTargetGroupHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeregistrationDelaySeconds != nil {
+		in, out := &in.DeregistrationDelaySeconds, &out.DeregistrationDelaySeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Stickiness != nil {
+		in, out := &in.Stickiness, &out.Stickiness
+		*out = new(ManagedTargetGroupStickiness)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnhealthyTargetState != nil {
+		in, out := &in.UnhealthyTargetState, &out.UnhealthyTargetState
+		*out = new(ManagedTargetGroupUnhealthyTargetState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetGroupHealth != nil {
+		in, out := &in.TargetGroupHealth, &out.TargetGroupHealth
+		*out = new(ManagedTargetGroupHealth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedTargetGroupConfig.
+func (in *ManagedTargetGroupConfig) DeepCopy() *ManagedTargetGroupConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedTargetGroupConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedTargetGroupHealth) DeepCopyInto(out *ManagedTargetGroupHealth) {
+	*out = *in
+	if in.DNSFailover != nil {
+		in, out := &in.DNSFailover, &out.DNSFailover
+		*out = new(ManagedTargetGroupMinimumHealthyTargets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnhealthyStateRouting != nil {
+		in, out := &in.UnhealthyStateRouting, &out.UnhealthyStateRouting
+		*out = new(ManagedTargetGroupMinimumHealthyTargets)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedTargetGroupHealth.
+func (in *ManagedTargetGroupHealth) DeepCopy() *ManagedTargetGroupHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedTargetGroupHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedTargetGroupHealthCheck) DeepCopyInto(out *ManagedTargetGroupHealthCheck) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HealthyThresholdCount != nil {
+		in, out := &in.HealthyThresholdCount, &out.HealthyThresholdCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.UnhealthyThresholdCount != nil {
+		in, out := &in.UnhealthyThresholdCount, &out.UnhealthyThresholdCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedTargetGroupHealthCheck.
+func (in *ManagedTargetGroupHealthCheck) DeepCopy() *ManagedTargetGroupHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedTargetGroupHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedTargetGroupMinimumHealthyTargets) DeepCopyInto(out *ManagedTargetGroupMinimumHealthyTargets) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedTargetGroupMinimumHealthyTargets.
+func (in *ManagedTargetGroupMinimumHealthyTargets) DeepCopy() *ManagedTargetGroupMinimumHealthyTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedTargetGroupMinimumHealthyTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedTargetGroupStickiness) DeepCopyInto(out *ManagedTargetGroupStickiness) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(ManagedTargetGroupStickinessType)
+		**out = **in
+	}
+	if in.DurationSeconds != nil {
+		in, out := &in.DurationSeconds, &out.DurationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedTargetGroupStickiness.
+func (in *ManagedTargetGroupStickiness) DeepCopy() *ManagedTargetGroupStickiness {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedTargetGroupStickiness)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedTargetGroupUnhealthyTargetState) DeepCopyInto(out *ManagedTargetGroupUnhealthyTargetState) {
+	*out = *in
+	if in.ConnectionTermination != nil {
+		in, out := &in.ConnectionTermination, &out.ConnectionTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DrainingIntervalSeconds != nil {
+		in, out := &in.DrainingIntervalSeconds, &out.DrainingIntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedTargetGroupUnhealthyTargetState.
+func (in *ManagedTargetGroupUnhealthyTargetState) DeepCopy() *ManagedTargetGroupUnhealthyTargetState {
+	if in == nil {
+		return nil
 	}
+	out := new(ManagedTargetGroupUnhealthyTargetState)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressClassParamsSpec.
-func (in *IngressClassParamsSpec) DeepCopy() *IngressClassParamsSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinimumLoadBalancerCapacity) DeepCopyInto(out *MinimumLoadBalancerCapacity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinimumLoadBalancerCapacity.
+func (in *MinimumLoadBalancerCapacity) DeepCopy() *MinimumLoadBalancerCapacity {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressClassParamsSpec)
+	out := new(MinimumLoadBalancerCapacity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressGroup) DeepCopyInto(out *IngressGroup) {
+func (in *MutualAuthenticationAttributes) DeepCopyInto(out *MutualAuthenticationAttributes) {
 	*out = *in
+	if in.IgnoreClientCertificateExpiry != nil {
+		in, out := &in.IgnoreClientCertificateExpiry, &out.IgnoreClientCertificateExpiry
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressGroup.
-func (in *IngressGroup) DeepCopy() *IngressGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutualAuthenticationAttributes.
+func (in *MutualAuthenticationAttributes) DeepCopy() *MutualAuthenticationAttributes {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressGroup)
+	out := new(MutualAuthenticationAttributes)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -227,6 +1637,11 @@ func (in *NetworkingPeer) DeepCopyInto(out *NetworkingPeer) {
 		*out = new(SecurityGroup)
 		**out = **in
 	}
+	if in.PrefixList != nil {
+		in, out := &in.PrefixList, &out.PrefixList
+		*out = new(PrefixList)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkingPeer.
@@ -264,6 +1679,21 @@ func (in *NetworkingPort) DeepCopy() *NetworkingPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixList) DeepCopyInto(out *PrefixList) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixList.
+func (in *PrefixList) DeepCopy() *PrefixList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -295,6 +1725,51 @@ func (in *ServiceReference) DeepCopy() *ServiceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetDiscoverySelector) DeepCopyInto(out *SubnetDiscoverySelector) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.IncludeZones != nil {
+		in, out := &in.IncludeZones, &out.IncludeZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeZones != nil {
+		in, out := &in.ExcludeZones, &out.ExcludeZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinFreeIPAddressCount != nil {
+		in, out := &in.MinFreeIPAddressCount, &out.MinFreeIPAddressCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetDiscoverySelector.
+func (in *SubnetDiscoverySelector) DeepCopy() *SubnetDiscoverySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetDiscoverySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSelector) DeepCopyInto(out *SubnetSelector) {
 	*out = *in
@@ -318,6 +1793,11 @@ func (in *SubnetSelector) DeepCopyInto(out *SubnetSelector) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(SubnetDiscoverySelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSelector.
@@ -445,11 +1925,48 @@ func (in *TargetGroupBindingSpec) DeepCopyInto(out *TargetGroupBindingSpec) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxTargetNodes != nil {
+		in, out := &in.MaxTargetNodes, &out.MaxTargetNodes
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	if in.IPAddressType != nil {
 		in, out := &in.IPAddressType, &out.IPAddressType
 		*out = new(TargetGroupIPAddressType)
 		**out = **in
 	}
+	if in.TargetIPFamilyPolicy != nil {
+		in, out := &in.TargetIPFamilyPolicy, &out.TargetIPFamilyPolicy
+		*out = new(TargetIPFamilyPolicy)
+		**out = **in
+	}
+	if in.ExternalTargets != nil {
+		in, out := &in.ExternalTargets, &out.ExternalTargets
+		*out = make([]ExternalTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetGroupAttributes != nil {
+		in, out := &in.TargetGroupAttributes, &out.TargetGroupAttributes
+		*out = make([]Attribute, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManageTargetGroupConfig != nil {
+		in, out := &in.ManageTargetGroupConfig, &out.ManageTargetGroupConfig
+		*out = new(ManagedTargetGroupConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDeregistrationWaitSeconds != nil {
+		in, out := &in.PodDeregistrationWaitSeconds, &out.PodDeregistrationWaitSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(DeletionPolicy)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupBindingSpec.
@@ -481,3 +1998,232 @@ func (in *TargetGroupBindingStatus) DeepCopy() *TargetGroupBindingStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupWeightPolicy) DeepCopyInto(out *TargetGroupWeightPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupWeightPolicy.
+func (in *TargetGroupWeightPolicy) DeepCopy() *TargetGroupWeightPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupWeightPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroupWeightPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupWeightPolicyList) DeepCopyInto(out *TargetGroupWeightPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetGroupWeightPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupWeightPolicyList.
+func (in *TargetGroupWeightPolicyList) DeepCopy() *TargetGroupWeightPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupWeightPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetGroupWeightPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupWeightPolicySpec) DeepCopyInto(out *TargetGroupWeightPolicySpec) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]WeightedServiceBackend, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stepping != nil {
+		in, out := &in.Stepping, &out.Stepping
+		*out = new(WeightStepping)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupWeightPolicySpec.
+func (in *TargetGroupWeightPolicySpec) DeepCopy() *TargetGroupWeightPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupWeightPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetGroupWeightPolicyStatus) DeepCopyInto(out *TargetGroupWeightPolicyStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastStepTime != nil {
+		in, out := &in.LastStepTime, &out.LastStepTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetGroupWeightPolicyStatus.
+func (in *TargetGroupWeightPolicyStatus) DeepCopy() *TargetGroupWeightPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetGroupWeightPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustStoreBundle) DeepCopyInto(out *TrustStoreBundle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustStoreBundle.
+func (in *TrustStoreBundle) DeepCopy() *TrustStoreBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustStoreBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFv2LoggingConfiguration) DeepCopyInto(out *WAFv2LoggingConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFv2LoggingConfiguration.
+func (in *WAFv2LoggingConfiguration) DeepCopy() *WAFv2LoggingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFv2LoggingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFv2ManagedRuleGroup) DeepCopyInto(out *WAFv2ManagedRuleGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFv2ManagedRuleGroup.
+func (in *WAFv2ManagedRuleGroup) DeepCopy() *WAFv2ManagedRuleGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFv2ManagedRuleGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFv2RateLimit) DeepCopyInto(out *WAFv2RateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFv2RateLimit.
+func (in *WAFv2RateLimit) DeepCopy() *WAFv2RateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFv2RateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WAFv2WebACL) DeepCopyInto(out *WAFv2WebACL) {
+	*out = *in
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make([]WAFv2RateLimit, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedRuleGroups != nil {
+		in, out := &in.ManagedRuleGroups, &out.ManagedRuleGroups
+		*out = make([]WAFv2ManagedRuleGroup, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WAFv2WebACL.
+func (in *WAFv2WebACL) DeepCopy() *WAFv2WebACL {
+	if in == nil {
+		return nil
+	}
+	out := new(WAFv2WebACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightStepping) DeepCopyInto(out *WeightStepping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightStepping.
+func (in *WeightStepping) DeepCopy() *WeightStepping {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightStepping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedServiceBackend) DeepCopyInto(out *WeightedServiceBackend) {
+	*out = *in
+	out.ServiceRef = in.ServiceRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedServiceBackend.
+func (in *WeightedServiceBackend) DeepCopy() *WeightedServiceBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedServiceBackend)
+	in.DeepCopyInto(out)
+	return out
+}
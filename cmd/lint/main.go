@@ -0,0 +1,124 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// lint validates Ingress and Service manifests against the rules of the controller, without access to a cluster or AWS.
+//
+// Usage:
+//
+//	lint [controller flags] <file or directory>...
+//
+// The controller flags, e.g. --ingress-class and --feature-gates, are the ones of the controller the manifests are deployed to.
+// Findings are printed as "file:line: severity: object: message", the exit code is 1 if any error is found.
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/lint"
+)
+
+func main() {
+	hasErrors, err := run(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if hasErrors {
+		os.Exit(1)
+	}
+}
+
+// run lints the manifests in args, and returns whether any error is found.
+func run(args []string) (bool, error) {
+	scheme := k8sruntime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = elbv2api.AddToScheme(scheme)
+
+	// the flags aren't validated, so that manifests can be linted without settings like cluster name.
+	controllerCFG := config.ControllerConfig{
+		FeatureGates: config.NewFeatureGates(),
+	}
+	flagSet := pflag.NewFlagSet("lint", pflag.ContinueOnError)
+	controllerCFG.BindFlags(flagSet)
+	if err := flagSet.Parse(args); err != nil {
+		return false, err
+	}
+	if flagSet.NArg() == 0 {
+		return false, errors.New("usage: lint [controller flags] <file or directory>...")
+	}
+
+	var manifests []lint.Manifest
+	for _, path := range flagSet.Args() {
+		files, err := listManifestFiles(path)
+		if err != nil {
+			return false, err
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return false, err
+			}
+			fileManifests, err := lint.LoadManifests(file, data, scheme)
+			if err != nil {
+				return false, err
+			}
+			manifests = append(manifests, fileManifests...)
+		}
+	}
+
+	linter := lint.NewLinter(controllerCFG, scheme, logr.Discard())
+	hasErrors := false
+	for _, finding := range linter.Lint(context.Background(), manifests) {
+		if finding.Severity == lint.SeverityError {
+			hasErrors = true
+		}
+		fmt.Fprintln(os.Stdout, finding.String())
+	}
+	return hasErrors, nil
+}
+
+// listManifestFiles returns path if it's a file, or the YAML files under path if it's a directory.
+func listManifestFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ext := filepath.Ext(file); !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, file)
+		}
+		return nil
+	})
+	return files, err
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	blocklistControllerName = "blocklist"
+)
+
+// NewBlocklistReconciler constructs new blocklistReconciler
+func NewBlocklistReconciler(k8sClient client.Client, eventRecorder record.EventRecorder,
+	prefixListProvider networking.BlocklistPrefixListProvider, logger logr.Logger) *blocklistReconciler {
+	return &blocklistReconciler{
+		k8sClient:          k8sClient,
+		eventRecorder:      eventRecorder,
+		prefixListProvider: prefixListProvider,
+		logger:             logger,
+	}
+}
+
+// blocklistReconciler reconciles the blocklist prefix lists upon changes of Blocklist objects.
+// The load balancer security groups reference the prefix lists, so they don't need to be reconciled.
+type blocklistReconciler struct {
+	k8sClient          client.Client
+	eventRecorder      record.EventRecorder
+	prefixListProvider networking.BlocklistPrefixListProvider
+	logger             logr.Logger
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=blocklists,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=blocklists/status,verbs=update;patch
+
+func (r *blocklistReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *blocklistReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	// the prefix lists are synced even if the Blocklist is deleted, so that its CIDRs are no longer excluded.
+	blocklist := &elbv2api.Blocklist{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, blocklist); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		blocklist = nil
+	}
+	if blocklist != nil {
+		if _, err := networking.ParseCIDRs(blocklist.Spec.CIDRs); err != nil {
+			r.eventRecorder.Event(blocklist, corev1.EventTypeWarning, k8s.BlocklistEventReasonInvalidCIDR, fmt.Sprintf("Invalid CIDR ignored due to %v", err))
+		}
+	}
+
+	prefixListIDs, err := r.prefixListProvider.Sync(ctx)
+	if err != nil {
+		if blocklist != nil {
+			r.eventRecorder.Event(blocklist, corev1.EventTypeWarning, k8s.BlocklistEventReasonFailedSync, fmt.Sprintf("Failed sync prefix lists due to %v", err))
+		}
+		return err
+	}
+	if blocklist == nil {
+		return nil
+	}
+	if err := r.updateBlocklistStatus(ctx, blocklist, prefixListIDs); err != nil {
+		r.eventRecorder.Event(blocklist, corev1.EventTypeWarning, k8s.BlocklistEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	return nil
+}
+
+func (r *blocklistReconciler) updateBlocklistStatus(ctx context.Context, blocklist *elbv2api.Blocklist, prefixListIDs []string) error {
+	if aws.Int64Value(blocklist.Status.ObservedGeneration) == blocklist.Generation &&
+		equality.Semantic.DeepEqual(blocklist.Status.PrefixListIDs, prefixListIDs) {
+		return nil
+	}
+	blocklistOld := blocklist.DeepCopy()
+	blocklist.Status.ObservedGeneration = aws.Int64(blocklist.Generation)
+	blocklist.Status.PrefixListIDs = prefixListIDs
+	if err := r.k8sClient.Status().Patch(ctx, blocklist, client.MergeFrom(blocklistOld)); err != nil {
+		return errors.Wrapf(err, "failed to update blocklist status: %v", k8s.NamespacedName(blocklist))
+	}
+	return nil
+}
+
+func (r *blocklistReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&elbv2api.Blocklist{}).
+		Named(blocklistControllerName).
+		Complete(r)
+}
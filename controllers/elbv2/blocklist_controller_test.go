@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_blocklistReconciler_reconcile(t *testing.T) {
+	blocklist := &elbv2api.Blocklist{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "awesome-blocklist",
+			Generation: 2,
+		},
+		Spec: elbv2api.BlocklistSpec{
+			CIDRs: []string{"192.0.2.0/24"},
+		},
+	}
+	tests := []struct {
+		name       string
+		blocklist  *elbv2api.Blocklist
+		syncErr    error
+		wantStatus *elbv2api.BlocklistStatus
+		wantErr    string
+	}{
+		{
+			name:      "prefix lists are synced and reported in status",
+			blocklist: blocklist,
+			wantStatus: &elbv2api.BlocklistStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				PrefixListIDs:      []string{"pl-ipv4", "pl-ipv6"},
+			},
+		},
+		{
+			name: "prefix lists are synced for deleted blocklist",
+		},
+		{
+			name:       "prefix lists failed to sync",
+			blocklist:  blocklist,
+			syncErr:    errors.New("some error"),
+			wantStatus: &elbv2api.BlocklistStatus{},
+			wantErr:    "some error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			prefixListProvider := networking.NewMockBlocklistPrefixListProvider(mockCtrl)
+			if tt.syncErr != nil {
+				prefixListProvider.EXPECT().Sync(gomock.Any()).Return(nil, tt.syncErr)
+			} else {
+				prefixListProvider.EXPECT().Sync(gomock.Any()).Return([]string{"pl-ipv4", "pl-ipv6"}, nil)
+			}
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			var objs []client.Object
+			if tt.blocklist != nil {
+				objs = append(objs, tt.blocklist.DeepCopy())
+			}
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(objs...).Build()
+			r := NewBlocklistReconciler(k8sClient, record.NewFakeRecorder(10), prefixListProvider, logr.New(&log.NullLogSink{}))
+
+			blocklistKey := types.NamespacedName{Name: "awesome-blocklist"}
+			err := r.reconcile(context.Background(), ctrl.Request{NamespacedName: blocklistKey})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.wantStatus != nil {
+				gotBlocklist := &elbv2api.Blocklist{}
+				assert.NoError(t, k8sClient.Get(context.Background(), blocklistKey, gotBlocklist))
+				assert.Equal(t, *tt.wantStatus, gotBlocklist.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	controllerConfigurationControllerName = "controllerConfiguration"
+)
+
+// NewControllerConfigurationReconciler constructs new controllerConfigurationReconciler
+func NewControllerConfigurationReconciler(k8sClient client.Client, eventRecorder record.EventRecorder,
+	defaultTagsProvider networking.DefaultTagsProvider, dynamicConfigProvider config.DynamicConfigProvider,
+	controllerConfig config.ControllerConfig, flagDynamicConfig config.DynamicConfig, logger logr.Logger) *controllerConfigurationReconciler {
+	return &controllerConfigurationReconciler{
+		k8sClient:             k8sClient,
+		eventRecorder:         eventRecorder,
+		defaultTagsProvider:   defaultTagsProvider,
+		dynamicConfigProvider: dynamicConfigProvider,
+		controllerConfig:      controllerConfig,
+		flagDynamicConfig:     flagDynamicConfig,
+		logger:                logger,
+	}
+}
+
+// controllerConfigurationReconciler propagates the default tags and DynamicConfig of the ControllerConfiguration object named via controller flag.
+// The Ingresses, Services and Gateways are notified via the defaultTagsProvider to reconcile their AWS resources.
+type controllerConfigurationReconciler struct {
+	k8sClient             client.Client
+	eventRecorder         record.EventRecorder
+	defaultTagsProvider   networking.DefaultTagsProvider
+	dynamicConfigProvider config.DynamicConfigProvider
+	controllerConfig      config.ControllerConfig
+	// flagDynamicConfig is the DynamicConfig specified via controller flags, it applies if the ControllerConfiguration doesn't exist.
+	flagDynamicConfig config.DynamicConfig
+	logger            logr.Logger
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=controllerconfigurations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=controllerconfigurations/status,verbs=update;patch
+
+func (r *controllerConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *controllerConfigurationReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	if req.Name != r.controllerConfig.ControllerConfigurationName {
+		return nil
+	}
+	controllerConfiguration := &elbv2api.ControllerConfiguration{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, controllerConfiguration); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		// the configuration specified via the controller flags applies again once the ControllerConfiguration is deleted.
+		if r.dynamicConfigProvider.Update(r.flagDynamicConfig) {
+			r.defaultTagsProvider.RequestResync()
+		}
+		return r.defaultTagsProvider.Update(ctx, r.controllerConfig.DefaultTags)
+	}
+	// invalid configuration is ignored, so that the AWS resources keep the last valid configuration.
+	if err := r.controllerConfig.ValidateDefaultTags(controllerConfiguration.Spec.DefaultTags); err != nil {
+		r.eventRecorder.Event(controllerConfiguration, corev1.EventTypeWarning, k8s.ControllerConfigurationEventReasonInvalidDefaultTags,
+			fmt.Sprintf("Invalid default tags ignored due to %v", err))
+		return nil
+	}
+	dynamicConfig := buildDynamicConfig(r.flagDynamicConfig, controllerConfiguration.Spec)
+	if err := r.controllerConfig.ValidateDynamicConfig(dynamicConfig); err != nil {
+		r.eventRecorder.Event(controllerConfiguration, corev1.EventTypeWarning, k8s.ControllerConfigurationEventReasonInvalidDynamicConfig,
+			fmt.Sprintf("Invalid configuration ignored due to %v", err))
+		return nil
+	}
+	if r.dynamicConfigProvider.Update(dynamicConfig) {
+		r.logger.Info("dynamic config changed", "dynamicConfig", dynamicConfig)
+		r.defaultTagsProvider.RequestResync()
+	}
+	if err := r.defaultTagsProvider.Update(ctx, controllerConfiguration.Spec.DefaultTags); err != nil {
+		return err
+	}
+	// in shadow mode, the status is left to the active controller.
+	if r.controllerConfig.ShadowMode {
+		return nil
+	}
+	if err := r.updateControllerConfigurationStatus(ctx, controllerConfiguration, dynamicConfig); err != nil {
+		r.eventRecorder.Event(controllerConfiguration, corev1.EventTypeWarning, k8s.ControllerConfigurationEventReasonFailedUpdateStatus,
+			fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	return nil
+}
+
+func (r *controllerConfigurationReconciler) updateControllerConfigurationStatus(ctx context.Context, controllerConfiguration *elbv2api.ControllerConfiguration,
+	dynamicConfig config.DynamicConfig) error {
+	effectiveConfiguration := buildEffectiveControllerConfiguration(controllerConfiguration.Spec.DefaultTags, dynamicConfig)
+	if aws.Int64Value(controllerConfiguration.Status.ObservedGeneration) == controllerConfiguration.Generation &&
+		equality.Semantic.DeepEqual(controllerConfiguration.Status.EffectiveConfiguration, effectiveConfiguration) {
+		return nil
+	}
+	controllerConfigurationOld := controllerConfiguration.DeepCopy()
+	controllerConfiguration.Status.ObservedGeneration = aws.Int64(controllerConfiguration.Generation)
+	controllerConfiguration.Status.EffectiveConfiguration = effectiveConfiguration
+	if err := r.k8sClient.Status().Patch(ctx, controllerConfiguration, client.MergeFrom(controllerConfigurationOld)); err != nil {
+		return errors.Wrapf(err, "failed to update controllerConfiguration status: %v", k8s.NamespacedName(controllerConfiguration))
+	}
+	return nil
+}
+
+func (r *controllerConfigurationReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&elbv2api.ControllerConfiguration{}).
+		Named(controllerConfigurationControllerName).
+		Complete(r)
+}
+
+// LoadControllerConfiguration loads the default tags and DynamicConfig of the ControllerConfiguration object named via controller flag,
+// so that the first reconciliation of resources applies them instead of the configuration specified via the controller flags.
+// The configuration specified via the controller flags is returned if the ControllerConfiguration doesn't exist.
+func LoadControllerConfiguration(ctx context.Context, k8sReader client.Reader, controllerConfig config.ControllerConfig,
+	flagDynamicConfig config.DynamicConfig) (map[string]string, config.DynamicConfig, error) {
+	controllerConfiguration := &elbv2api.ControllerConfiguration{}
+	controllerConfigurationKey := types.NamespacedName{Name: controllerConfig.ControllerConfigurationName}
+	if err := k8sReader.Get(ctx, controllerConfigurationKey, controllerConfiguration); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return nil, config.DynamicConfig{}, err
+		}
+		return controllerConfig.DefaultTags, flagDynamicConfig, nil
+	}
+	if err := controllerConfig.ValidateDefaultTags(controllerConfiguration.Spec.DefaultTags); err != nil {
+		return nil, config.DynamicConfig{}, errors.Wrapf(err, "invalid default tags in controllerConfiguration: %v", controllerConfigurationKey.Name)
+	}
+	dynamicConfig := buildDynamicConfig(flagDynamicConfig, controllerConfiguration.Spec)
+	if err := controllerConfig.ValidateDynamicConfig(dynamicConfig); err != nil {
+		return nil, config.DynamicConfig{}, errors.Wrapf(err, "invalid configuration in controllerConfiguration: %v", controllerConfigurationKey.Name)
+	}
+	return controllerConfiguration.Spec.DefaultTags, dynamicConfig, nil
+}
+
+// buildDynamicConfig merges the DynamicConfig specified via the ControllerConfiguration spec over the one specified via controller flags.
+func buildDynamicConfig(flagDynamicConfig config.DynamicConfig, spec elbv2api.ControllerConfigurationSpec) config.DynamicConfig {
+	dynamicConfig := config.DynamicConfig{
+		DefaultSSLPolicy:  flagDynamicConfig.DefaultSSLPolicy,
+		DefaultTargetType: flagDynamicConfig.DefaultTargetType,
+		FeatureGates:      make(map[config.Feature]bool, len(flagDynamicConfig.FeatureGates)),
+	}
+	for feature, enabled := range flagDynamicConfig.FeatureGates {
+		dynamicConfig.FeatureGates[feature] = enabled
+	}
+	if spec.DefaultSSLPolicy != "" {
+		dynamicConfig.DefaultSSLPolicy = spec.DefaultSSLPolicy
+	}
+	if spec.DefaultTargetType != "" {
+		dynamicConfig.DefaultTargetType = string(spec.DefaultTargetType)
+	}
+	for feature, enabled := range spec.FeatureGates {
+		dynamicConfig.FeatureGates[config.Feature(feature)] = enabled
+	}
+	return dynamicConfig
+}
+
+func buildEffectiveControllerConfiguration(defaultTags map[string]string, dynamicConfig config.DynamicConfig) *elbv2api.EffectiveControllerConfiguration {
+	featureGates := make(map[string]bool, len(dynamicConfig.FeatureGates))
+	for feature, enabled := range dynamicConfig.FeatureGates {
+		featureGates[string(feature)] = enabled
+	}
+	return &elbv2api.EffectiveControllerConfiguration{
+		DefaultTags:       defaultTags,
+		DefaultSSLPolicy:  dynamicConfig.DefaultSSLPolicy,
+		DefaultTargetType: elbv2api.TargetType(dynamicConfig.DefaultTargetType),
+		FeatureGates:      featureGates,
+	}
+}
@@ -0,0 +1,232 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_controllerConfigurationReconciler_reconcile(t *testing.T) {
+	controllerConfig := config.ControllerConfig{
+		ControllerConfigurationName: "awesome-config",
+		DefaultTags:                 map[string]string{"team": "flag"},
+		ExternalManagedTags:         []string{"external"},
+	}
+	flagDynamicConfig := config.DynamicConfig{
+		DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+		DefaultTargetType: "instance",
+		FeatureGates:      map[config.Feature]bool{config.WeightedTargetGroups: true},
+	}
+	tests := []struct {
+		name                    string
+		controllerConfiguration *elbv2api.ControllerConfiguration
+		requestName             string
+		// initialDynamicConfig is the DynamicConfig in effect before reconciliation, defaults to flagDynamicConfig.
+		initialDynamicConfig *config.DynamicConfig
+		wantDefaultTags      map[string]string
+		wantResync           bool
+		updateErr            error
+		wantDynamicConfig    config.DynamicConfig
+		wantStatus           *elbv2api.ControllerConfigurationStatus
+		wantErr              string
+	}{
+		{
+			name: "default tags are propagated and generation is reported in status",
+			controllerConfiguration: &elbv2api.ControllerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "awesome-config",
+					Generation: 2,
+				},
+				Spec: elbv2api.ControllerConfigurationSpec{
+					DefaultTags: map[string]string{"team": "awesome"},
+				},
+			},
+			requestName:       "awesome-config",
+			wantDefaultTags:   map[string]string{"team": "awesome"},
+			wantDynamicConfig: flagDynamicConfig,
+			wantStatus: &elbv2api.ControllerConfigurationStatus{
+				ObservedGeneration: awssdk.Int64(2),
+				EffectiveConfiguration: &elbv2api.EffectiveControllerConfiguration{
+					DefaultTags:       map[string]string{"team": "awesome"},
+					DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+					DefaultTargetType: elbv2api.TargetTypeInstance,
+					FeatureGates:      map[string]bool{"WeightedTargetGroups": true},
+				},
+			},
+		},
+		{
+			name: "dynamic config is applied and resources are resynced",
+			controllerConfiguration: &elbv2api.ControllerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "awesome-config",
+					Generation: 3,
+				},
+				Spec: elbv2api.ControllerConfigurationSpec{
+					DefaultTags:       map[string]string{"team": "awesome"},
+					DefaultSSLPolicy:  "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					DefaultTargetType: elbv2api.TargetTypeIP,
+					FeatureGates:      map[string]bool{"WeightedTargetGroups": false, "ALBSingleSubnet": true},
+				},
+			},
+			requestName:     "awesome-config",
+			wantDefaultTags: map[string]string{"team": "awesome"},
+			wantResync:      true,
+			wantDynamicConfig: config.DynamicConfig{
+				DefaultSSLPolicy:  "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				DefaultTargetType: "ip",
+				FeatureGates:      map[config.Feature]bool{config.WeightedTargetGroups: false, config.ALBSingleSubnet: true},
+			},
+			wantStatus: &elbv2api.ControllerConfigurationStatus{
+				ObservedGeneration: awssdk.Int64(3),
+				EffectiveConfiguration: &elbv2api.EffectiveControllerConfiguration{
+					DefaultTags:       map[string]string{"team": "awesome"},
+					DefaultSSLPolicy:  "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					DefaultTargetType: elbv2api.TargetTypeIP,
+					FeatureGates:      map[string]bool{"WeightedTargetGroups": false, "ALBSingleSubnet": true},
+				},
+			},
+		},
+		{
+			name:              "default tags from flags are restored for deleted controllerConfiguration",
+			requestName:       "awesome-config",
+			wantDefaultTags:   map[string]string{"team": "flag"},
+			wantDynamicConfig: flagDynamicConfig,
+		},
+		{
+			name:        "dynamic config from flags is restored for deleted controllerConfiguration",
+			requestName: "awesome-config",
+			initialDynamicConfig: &config.DynamicConfig{
+				DefaultSSLPolicy:  "ELBSecurityPolicy-TLS13-1-2-2021-06",
+				DefaultTargetType: "ip",
+				FeatureGates:      map[config.Feature]bool{config.WeightedTargetGroups: false},
+			},
+			wantDefaultTags:   map[string]string{"team": "flag"},
+			wantResync:        true,
+			wantDynamicConfig: flagDynamicConfig,
+		},
+		{
+			name: "invalid default tags are ignored",
+			controllerConfiguration: &elbv2api.ControllerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "awesome-config",
+					Generation: 2,
+				},
+				Spec: elbv2api.ControllerConfigurationSpec{
+					DefaultTags: map[string]string{"external": "awesome"},
+				},
+			},
+			requestName:       "awesome-config",
+			wantDynamicConfig: flagDynamicConfig,
+			wantStatus:        &elbv2api.ControllerConfigurationStatus{},
+		},
+		{
+			name: "invalid dynamic config is ignored",
+			controllerConfiguration: &elbv2api.ControllerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "awesome-config",
+					Generation: 2,
+				},
+				Spec: elbv2api.ControllerConfigurationSpec{
+					DefaultSSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+					FeatureGates:     map[string]bool{"EnableServiceController": false},
+				},
+			},
+			requestName:       "awesome-config",
+			wantDynamicConfig: flagDynamicConfig,
+			wantStatus:        &elbv2api.ControllerConfigurationStatus{},
+		},
+		{
+			name: "other controllerConfigurations are ignored",
+			controllerConfiguration: &elbv2api.ControllerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "other-config",
+					Generation: 2,
+				},
+				Spec: elbv2api.ControllerConfigurationSpec{
+					DefaultTags: map[string]string{"team": "other"},
+				},
+			},
+			requestName:       "other-config",
+			wantDynamicConfig: flagDynamicConfig,
+			wantStatus:        &elbv2api.ControllerConfigurationStatus{},
+		},
+		{
+			name: "default tags failed to propagate",
+			controllerConfiguration: &elbv2api.ControllerConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "awesome-config",
+					Generation: 2,
+				},
+				Spec: elbv2api.ControllerConfigurationSpec{
+					DefaultTags: map[string]string{"team": "awesome"},
+				},
+			},
+			requestName:       "awesome-config",
+			wantDefaultTags:   map[string]string{"team": "awesome"},
+			updateErr:         errors.New("some error"),
+			wantDynamicConfig: flagDynamicConfig,
+			wantStatus:        &elbv2api.ControllerConfigurationStatus{},
+			wantErr:           "some error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			defaultTagsProvider := networking.NewMockDefaultTagsProvider(mockCtrl)
+			if tt.wantDefaultTags != nil {
+				defaultTagsProvider.EXPECT().Update(gomock.Any(), tt.wantDefaultTags).Return(tt.updateErr)
+			}
+			if tt.wantResync {
+				defaultTagsProvider.EXPECT().RequestResync()
+			}
+			initialDynamicConfig := flagDynamicConfig
+			if tt.initialDynamicConfig != nil {
+				initialDynamicConfig = *tt.initialDynamicConfig
+			}
+			dynamicConfigProvider := config.NewDynamicConfigProvider(initialDynamicConfig, config.NewFeatureGates())
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			var objs []client.Object
+			if tt.controllerConfiguration != nil {
+				objs = append(objs, tt.controllerConfiguration.DeepCopy())
+			}
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(objs...).Build()
+			r := NewControllerConfigurationReconciler(k8sClient, record.NewFakeRecorder(10), defaultTagsProvider, dynamicConfigProvider,
+				controllerConfig, flagDynamicConfig, logr.New(&log.NullLogSink{}))
+
+			controllerConfigurationKey := types.NamespacedName{Name: tt.requestName}
+			err := r.reconcile(context.Background(), ctrl.Request{NamespacedName: controllerConfigurationKey})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDynamicConfig, dynamicConfigProvider.DynamicConfig())
+			if tt.wantStatus != nil {
+				gotControllerConfiguration := &elbv2api.ControllerConfiguration{}
+				assert.NoError(t, k8sClient.Get(context.Background(), controllerConfigurationKey, gotControllerConfiguration))
+				assert.Equal(t, *tt.wantStatus, gotControllerConfiguration.Status)
+			}
+		})
+	}
+}
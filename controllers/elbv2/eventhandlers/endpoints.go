@@ -17,18 +17,21 @@ import (
 )
 
 // NewEnqueueRequestsForEndpointsEvent constructs new enqueueRequestsForEndpointsEvent.
-func NewEnqueueRequestsForEndpointsEvent(k8sClient client.Client, logger logr.Logger) handler.EventHandler {
+func NewEnqueueRequestsForEndpointsEvent(k8sClient client.Client, changeAggregator targetgroupbinding.EndpointChangeAggregator,
+	logger logr.Logger) handler.EventHandler {
 	return &enqueueRequestsForEndpointsEvent{
-		k8sClient: k8sClient,
-		logger:    logger,
+		k8sClient:        k8sClient,
+		changeAggregator: changeAggregator,
+		logger:           logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForEndpointsEvent)(nil)
 
 type enqueueRequestsForEndpointsEvent struct {
-	k8sClient client.Client
-	logger    logr.Logger
+	k8sClient        client.Client
+	changeAggregator targetgroupbinding.EndpointChangeAggregator
+	logger           logr.Logger
 }
 
 // Create is called in response to an create event - e.g. Pod Creation.
@@ -76,7 +79,7 @@ func (h *enqueueRequestsForEndpointsEvent) enqueueImpactedTargetGroupBindings(qu
 			"endpoints", epKey,
 			"targetGroupBinding", k8s.NamespacedName(&tgb),
 		)
-		queue.Add(reconcile.Request{
+		h.changeAggregator.Enqueue(queue, reconcile.Request{
 			NamespacedName: types.NamespacedName{
 				Namespace: tgb.Namespace,
 				Name:      tgb.Name,
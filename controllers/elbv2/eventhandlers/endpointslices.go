@@ -21,18 +21,21 @@ import (
 const svcNameLabel = "kubernetes.io/service-name"
 
 // NewEnqueueRequestsForEndpointSlicesEvent constructs new enqueueRequestsForEndpointSlicesEvent.
-func NewEnqueueRequestsForEndpointSlicesEvent(k8sClient client.Client, logger logr.Logger) handler.EventHandler {
+func NewEnqueueRequestsForEndpointSlicesEvent(k8sClient client.Client, changeAggregator targetgroupbinding.EndpointChangeAggregator,
+	logger logr.Logger) handler.EventHandler {
 	return &enqueueRequestsForEndpointSlicesEvent{
-		k8sClient: k8sClient,
-		logger:    logger,
+		k8sClient:        k8sClient,
+		changeAggregator: changeAggregator,
+		logger:           logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForEndpointSlicesEvent)(nil)
 
 type enqueueRequestsForEndpointSlicesEvent struct {
-	k8sClient client.Client
-	logger    logr.Logger
+	k8sClient        client.Client
+	changeAggregator targetgroupbinding.EndpointChangeAggregator
+	logger           logr.Logger
 }
 
 // Create is called in response to an create event - e.g. EndpointSlice Creation.
@@ -90,7 +93,7 @@ func (h *enqueueRequestsForEndpointSlicesEvent) enqueueImpactedTargetGroupBindin
 			"endpointslices", epSliceKey,
 			"targetGroupBinding", k8s.NamespacedName(&tgb),
 		)
-		queue.Add(reconcile.Request{
+		h.changeAggregator.Enqueue(queue, reconcile.Request{
 			NamespacedName: types.NamespacedName{
 				Namespace: tgb.Namespace,
 				Name:      tgb.Name,
@@ -29,7 +29,9 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -49,15 +51,20 @@ const (
 
 // NewTargetGroupBindingReconciler constructs new targetGroupBindingReconciler
 func NewTargetGroupBindingReconciler(k8sClient client.Client, eventRecorder record.EventRecorder, finalizerManager k8s.FinalizerManager,
-	tgbResourceManager targetgroupbinding.ResourceManager, config config.ControllerConfig,
+	tgbResourceManager targetgroupbinding.ResourceManager, endpointChangeAggregator targetgroupbinding.EndpointChangeAggregator, config config.ControllerConfig,
+	reconcileMetrics *lbcmetrics.ReconcileMetrics, apiCallReporter *lbcmetrics.ReconcileAPICallReporter, debugLoggingTargets *runtime.DebugLoggingTargets,
 	logger logr.Logger) *targetGroupBindingReconciler {
 
 	return &targetGroupBindingReconciler{
-		k8sClient:          k8sClient,
-		eventRecorder:      eventRecorder,
-		finalizerManager:   finalizerManager,
-		tgbResourceManager: tgbResourceManager,
-		logger:             logger,
+		k8sClient:                k8sClient,
+		eventRecorder:            eventRecorder,
+		finalizerManager:         finalizerManager,
+		tgbResourceManager:       tgbResourceManager,
+		endpointChangeAggregator: endpointChangeAggregator,
+		reconcileMetrics:         reconcileMetrics,
+		apiCallReporter:          apiCallReporter,
+		debugLoggingTargets:      debugLoggingTargets,
+		logger:                   logger,
 
 		maxConcurrentReconciles:    config.TargetGroupBindingMaxConcurrentReconciles,
 		maxExponentialBackoffDelay: config.TargetGroupBindingMaxExponentialBackoffDelay,
@@ -71,7 +78,14 @@ type targetGroupBindingReconciler struct {
 	eventRecorder      record.EventRecorder
 	finalizerManager   k8s.FinalizerManager
 	tgbResourceManager targetgroupbinding.ResourceManager
-	logger             logr.Logger
+	// endpointChangeAggregator aggregates the reconciles for endpoint changes.
+	endpointChangeAggregator targetgroupbinding.EndpointChangeAggregator
+	reconcileMetrics         *lbcmetrics.ReconcileMetrics
+	// apiCallReporter reports the AWS API calls per reconcile if enabled, it's nil otherwise.
+	apiCallReporter *lbcmetrics.ReconcileAPICallReporter
+	// debugLoggingTargets raises the log verbosity of the resources requesting debug logging if enabled, it's nil otherwise.
+	debugLoggingTargets *runtime.DebugLoggingTargets
+	logger              logr.Logger
 
 	maxConcurrentReconciles    int
 	maxExponentialBackoffDelay time.Duration
@@ -80,17 +94,23 @@ type targetGroupBindingReconciler struct {
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=get;list;watch;update;patch;create;delete
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupbindings/status,verbs=update;patch
-// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
 // +kubebuilder:rbac:groups="",resources=pods/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;patch;delete
 // +kubebuilder:rbac:groups="discovery.k8s.io",resources=endpointslices,verbs=get;list;watch
 
 func (r *targetGroupBindingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	r.logger.V(1).Info("Reconcile request", "name", req.Name)
-	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+	startTime := time.Now()
+	ctx, apiCallCounter := r.apiCallReporter.StartReconcile(ctx)
+	err := r.reconcile(ctx, req)
+	r.reconcileMetrics.ObserveReconcile(controllerName, startTime, err)
+	r.apiCallReporter.ReportReconcile(controllerName, req.NamespacedName, apiCallCounter)
+	return runtime.HandleReconcileError(err, r.logger)
 }
 
 func (r *targetGroupBindingReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
@@ -98,7 +118,11 @@ func (r *targetGroupBindingReconciler) reconcile(ctx context.Context, req ctrl.R
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, tgb); err != nil {
 		return client.IgnoreNotFound(err)
 	}
+	if err := r.debugLoggingTargets.Configure(tgb.Annotations, k8s.NamespacedName(tgb).String()); err != nil {
+		r.logger.Info("ignoring invalid debug logging annotation", "targetGroupBinding", k8s.NamespacedName(tgb), "error", err)
+	}
 
+	ctx = audit.ContextWithMutationRecorder(ctx, audit.NewEventMutationRecorder(r.eventRecorder, tgb))
 	if !tgb.DeletionTimestamp.IsZero() {
 		return r.cleanupTargetGroupBinding(ctx, tgb)
 	}
@@ -162,7 +186,7 @@ func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr
 
 	// Use the config flag to decide whether to use and watch an Endpoints event handler or an EndpointSlices event handler
 	if r.enableEndpointSlices {
-		epSliceEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointSlicesEvent(r.k8sClient,
+		epSliceEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointSlicesEvent(r.k8sClient, r.endpointChangeAggregator,
 			r.logger.WithName("eventHandlers").WithName("endpointslices"))
 		return ctrl.NewControllerManagedBy(mgr).
 			For(&elbv2api.TargetGroupBinding{}).
@@ -175,7 +199,7 @@ func (r *targetGroupBindingReconciler) SetupWithManager(ctx context.Context, mgr
 				RateLimiter:             workqueue.NewItemExponentialFailureRateLimiter(5*time.Millisecond, r.maxExponentialBackoffDelay)}).
 			Complete(r)
 	} else {
-		epsEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointsEvent(r.k8sClient,
+		epsEventsHandler := eventhandlers.NewEnqueueRequestsForEndpointsEvent(r.k8sClient, r.endpointChangeAggregator,
 			r.logger.WithName("eventHandlers").WithName("endpoints"))
 		return ctrl.NewControllerManagedBy(mgr).
 			For(&elbv2api.TargetGroupBinding{}).
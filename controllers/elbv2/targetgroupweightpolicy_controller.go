@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	targetGroupWeightPolicyControllerName = "targetGroupWeightPolicy"
+)
+
+// NewTargetGroupWeightPolicyReconciler constructs new targetGroupWeightPolicyReconciler
+func NewTargetGroupWeightPolicyReconciler(k8sClient client.Client, eventRecorder record.EventRecorder,
+	logger logr.Logger) *targetGroupWeightPolicyReconciler {
+	return &targetGroupWeightPolicyReconciler{
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+		now:           time.Now,
+	}
+}
+
+// targetGroupWeightPolicyReconciler reconciles the applied weights of a TargetGroupWeightPolicy object.
+// The Ingress controller consumes the applied weights from status to build the forward action.
+type targetGroupWeightPolicyReconciler struct {
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+
+	now func() time.Time
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupweightpolicies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=targetgroupweightpolicies/status,verbs=update;patch
+
+func (r *targetGroupWeightPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *targetGroupWeightPolicyReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	policy := &elbv2api.TargetGroupWeightPolicy{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, policy); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !policy.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	desiredWeights := make([]int64, 0, len(policy.Spec.Backends))
+	for _, backend := range policy.Spec.Backends {
+		desiredWeights = append(desiredWeights, backend.Weight)
+	}
+	currentWeights := policy.Status.Weights
+	now := r.now()
+
+	var nextWeights []int64
+	stepping := policy.Spec.Stepping
+	switch {
+	case stepping == nil || len(currentWeights) != len(desiredWeights):
+		nextWeights = desiredWeights
+	case equality.Semantic.DeepEqual(currentWeights, desiredWeights):
+		nextWeights = currentWeights
+	default:
+		interval := time.Duration(stepping.IntervalSeconds) * time.Second
+		if policy.Status.LastStepTime != nil {
+			if elapsed := now.Sub(policy.Status.LastStepTime.Time); elapsed < interval {
+				return runtime.NewRequeueNeededAfter("waiting for next weight step", interval-elapsed)
+			}
+		}
+		nextWeights = computeNextWeights(currentWeights, desiredWeights, stepping.StepWeight)
+	}
+
+	if err := r.updateTargetGroupWeightPolicyStatus(ctx, policy, nextWeights, now); err != nil {
+		r.eventRecorder.Event(policy, corev1.EventTypeWarning, k8s.TargetGroupWeightPolicyEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	if stepping != nil && !equality.Semantic.DeepEqual(nextWeights, desiredWeights) {
+		return runtime.NewRequeueNeededAfter("shifting weights", time.Duration(stepping.IntervalSeconds)*time.Second)
+	}
+	return nil
+}
+
+func (r *targetGroupWeightPolicyReconciler) updateTargetGroupWeightPolicyStatus(ctx context.Context, policy *elbv2api.TargetGroupWeightPolicy, weights []int64, now time.Time) error {
+	weightsChanged := !equality.Semantic.DeepEqual(policy.Status.Weights, weights)
+	if !weightsChanged && aws.Int64Value(policy.Status.ObservedGeneration) == policy.Generation {
+		return nil
+	}
+	policyOld := policy.DeepCopy()
+	policy.Status.ObservedGeneration = aws.Int64(policy.Generation)
+	if weightsChanged {
+		policy.Status.Weights = weights
+		policy.Status.LastStepTime = &metav1.Time{Time: now}
+	}
+	if err := r.k8sClient.Status().Patch(ctx, policy, client.MergeFrom(policyOld)); err != nil {
+		return errors.Wrapf(err, "failed to update targetGroupWeightPolicy status: %v", k8s.NamespacedName(policy))
+	}
+	if weightsChanged {
+		r.eventRecorder.Event(policy, corev1.EventTypeNormal, k8s.TargetGroupWeightPolicyEventReasonWeightsShifted, fmt.Sprintf("Weights shifted to %v", weights))
+	}
+	return nil
+}
+
+func (r *targetGroupWeightPolicyReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&elbv2api.TargetGroupWeightPolicy{}).
+		Named(targetGroupWeightPolicyControllerName).
+		Complete(r)
+}
+
+// computeNextWeights shifts each current weight towards its desired weight by at most stepWeight.
+func computeNextWeights(currentWeights []int64, desiredWeights []int64, stepWeight int64) []int64 {
+	nextWeights := make([]int64, 0, len(desiredWeights))
+	for i, desiredWeight := range desiredWeights {
+		currentWeight := currentWeights[i]
+		switch {
+		case desiredWeight > currentWeight+stepWeight:
+			nextWeights = append(nextWeights, currentWeight+stepWeight)
+		case desiredWeight < currentWeight-stepWeight:
+			nextWeights = append(nextWeights, currentWeight-stepWeight)
+		default:
+			nextWeights = append(nextWeights, desiredWeight)
+		}
+	}
+	return nextWeights
+}
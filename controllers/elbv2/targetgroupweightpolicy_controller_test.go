@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_computeNextWeights(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentWeights []int64
+		desiredWeights []int64
+		stepWeight     int64
+		want           []int64
+	}{
+		{
+			name:           "shift by step weight",
+			currentWeights: []int64{100, 0},
+			desiredWeights: []int64{0, 100},
+			stepWeight:     20,
+			want:           []int64{80, 20},
+		},
+		{
+			name:           "reach desired weights within step weight",
+			currentWeights: []int64{10, 90},
+			desiredWeights: []int64{0, 100},
+			stepWeight:     20,
+			want:           []int64{0, 100},
+		},
+		{
+			name:           "already at desired weights",
+			currentWeights: []int64{50, 50},
+			desiredWeights: []int64{50, 50},
+			stepWeight:     20,
+			want:           []int64{50, 50},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := computeNextWeights(tt.currentWeights, tt.desiredWeights, tt.stepWeight)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_targetGroupWeightPolicyReconciler_reconcile(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	policyWithWeights := func(stepping *elbv2api.WeightStepping, status elbv2api.TargetGroupWeightPolicyStatus) *elbv2api.TargetGroupWeightPolicy {
+		return &elbv2api.TargetGroupWeightPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-policy",
+			},
+			Spec: elbv2api.TargetGroupWeightPolicySpec{
+				IngressName: "awesome-ing",
+				ActionName:  "blue-green",
+				Backends: []elbv2api.WeightedServiceBackend{
+					{
+						ServiceRef: elbv2api.ServiceReference{Name: "blue", Port: intstr.FromInt(80)},
+						Weight:     0,
+					},
+					{
+						ServiceRef: elbv2api.ServiceReference{Name: "green", Port: intstr.FromInt(80)},
+						Weight:     100,
+					},
+				},
+				Stepping: stepping,
+			},
+			Status: status,
+		}
+	}
+	stepping := &elbv2api.WeightStepping{StepWeight: 25, IntervalSeconds: 60}
+	tests := []struct {
+		name        string
+		policy      *elbv2api.TargetGroupWeightPolicy
+		wantWeights []int64
+		wantErr     string
+	}{
+		{
+			name:        "apply desired weights immediately without stepping",
+			policy:      policyWithWeights(nil, elbv2api.TargetGroupWeightPolicyStatus{Weights: []int64{100, 0}}),
+			wantWeights: []int64{0, 100},
+		},
+		{
+			name:        "apply desired weights initially with stepping",
+			policy:      policyWithWeights(stepping, elbv2api.TargetGroupWeightPolicyStatus{}),
+			wantWeights: []int64{0, 100},
+		},
+		{
+			name: "shift weights by one step",
+			policy: policyWithWeights(stepping, elbv2api.TargetGroupWeightPolicyStatus{
+				Weights:      []int64{100, 0},
+				LastStepTime: &metav1.Time{Time: now.Add(-2 * time.Minute)},
+			}),
+			wantWeights: []int64{75, 25},
+			wantErr:     "requeue needed after 1m0s: shifting weights",
+		},
+		{
+			name: "wait for next step",
+			policy: policyWithWeights(stepping, elbv2api.TargetGroupWeightPolicyStatus{
+				Weights:      []int64{75, 25},
+				LastStepTime: &metav1.Time{Time: now.Add(-30 * time.Second)},
+			}),
+			wantWeights: []int64{75, 25},
+			wantErr:     "requeue needed after 30s: waiting for next weight step",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(tt.policy.DeepCopy()).Build()
+			r := NewTargetGroupWeightPolicyReconciler(k8sClient, record.NewFakeRecorder(10), logr.New(&log.NullLogSink{}))
+			r.now = func() time.Time { return now }
+
+			policyKey := types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-policy"}
+			err := r.reconcile(context.Background(), ctrl.Request{NamespacedName: policyKey})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			gotPolicy := &elbv2api.TargetGroupWeightPolicy{}
+			assert.NoError(t, k8sClient.Get(context.Background(), policyKey, gotPolicy))
+			assert.Equal(t, tt.wantWeights, gotPolicy.Status.Weights)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	gatewaypkg "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForL4RouteEvent constructs new enqueueRequestsForL4RouteEvent.
+func NewEnqueueRequestsForL4RouteEvent(logger logr.Logger) *enqueueRequestsForL4RouteEvent {
+	return &enqueueRequestsForL4RouteEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForL4RouteEvent)(nil)
+
+// enqueueRequestsForL4RouteEvent handles events for TCPRoute, UDPRoute and TLSRoute.
+type enqueueRequestsForL4RouteEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForL4RouteEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(queue, e.Object)
+}
+
+func (h *enqueueRequestsForL4RouteEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	routeOld, okOld := gatewaypkg.NewL4Route(e.ObjectOld)
+	routeNew, okNew := gatewaypkg.NewL4Route(e.ObjectNew)
+	if !okOld || !okNew {
+		return
+	}
+
+	// we only care below update event:
+	//	1. route spec updates
+	//	2. route deletions
+	if equality.Semantic.DeepEqual(routeOld.ParentRefs, routeNew.ParentRefs) &&
+		equality.Semantic.DeepEqual(routeOld.Hostnames, routeNew.Hostnames) &&
+		equality.Semantic.DeepEqual(routeOld.BackendRefs, routeNew.BackendRefs) &&
+		equality.Semantic.DeepEqual(e.ObjectOld.GetDeletionTimestamp().IsZero(), e.ObjectNew.GetDeletionTimestamp().IsZero()) {
+		return
+	}
+	// both old and new parent gateways are enqueued, so that detached gateways can remove the route's listener.
+	h.enqueueParentGateways(queue, e.ObjectOld)
+	h.enqueueParentGateways(queue, e.ObjectNew)
+}
+
+func (h *enqueueRequestsForL4RouteEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(queue, e.Object)
+}
+
+func (h *enqueueRequestsForL4RouteEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueParentGateways(queue, e.Object)
+}
+
+func (h *enqueueRequestsForL4RouteEvent) enqueueParentGateways(queue workqueue.RateLimitingInterface, obj client.Object) {
+	route, ok := gatewaypkg.NewL4Route(obj)
+	if !ok {
+		return
+	}
+	for _, gwKey := range parentGatewaysForRoute(obj.GetNamespace(), route.ParentRefs) {
+		h.logger.V(1).Info("enqueue gateway for route event",
+			"route", route.String(),
+			"gateway", gwKey)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
@@ -0,0 +1,133 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	gatewaypkg "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// NewEnqueueRequestsForServiceEvent constructs new enqueueRequestsForServiceEvent.
+// l4RouteLists specifies the list types of L4 routes installed in the cluster, e.g. &gwv1alpha2.TCPRouteList{}.
+func NewEnqueueRequestsForServiceEvent(k8sClient client.Client, l4RouteLists []client.ObjectList, logger logr.Logger) *enqueueRequestsForServiceEvent {
+	return &enqueueRequestsForServiceEvent{
+		k8sClient:    k8sClient,
+		l4RouteLists: l4RouteLists,
+		logger:       logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
+
+type enqueueRequestsForServiceEvent struct {
+	k8sClient    client.Client
+	l4RouteLists []client.ObjectList
+	logger       logr.Logger
+}
+
+func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	svcOld := e.ObjectOld.(*corev1.Service)
+	svcNew := e.ObjectNew.(*corev1.Service)
+
+	// we only care below update event:
+	//	1. Service annotation updates
+	//	2. Service spec updates
+	//	3. Service deletions
+	if equality.Semantic.DeepEqual(svcOld.Annotations, svcNew.Annotations) &&
+		equality.Semantic.DeepEqual(svcOld.Spec, svcNew.Spec) &&
+		equality.Semantic.DeepEqual(svcOld.DeletionTimestamp.IsZero(), svcNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueImpactedGateways(queue, svcNew)
+}
+
+func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueImpactedGateways(queue, e.Object.(*corev1.Service))
+}
+
+func (h *enqueueRequestsForServiceEvent) enqueueImpactedGateways(queue workqueue.RateLimitingInterface, svc *corev1.Service) {
+	routeList := &gwv1beta1.HTTPRouteList{}
+	if err := h.k8sClient.List(context.Background(), routeList); err != nil {
+		h.logger.Error(err, "failed to fetch httpRoutes")
+		return
+	}
+	for index := range routeList.Items {
+		route := &routeList.Items[index]
+		var backendRefs []gwv1beta1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				backendRefs = append(backendRefs, backendRef.BackendRef)
+			}
+		}
+		h.enqueueGatewaysForRoute(queue, svc, route, route.Spec.ParentRefs, backendRefs)
+	}
+	for _, l4RouteList := range h.l4RouteLists {
+		routeList := l4RouteList.DeepCopyObject().(client.ObjectList)
+		if err := h.k8sClient.List(context.Background(), routeList); err != nil {
+			h.logger.Error(err, "failed to fetch routes")
+			return
+		}
+		_ = meta.EachListItem(routeList, func(obj runtime.Object) error {
+			routeObj, ok := obj.(client.Object)
+			if !ok {
+				return nil
+			}
+			if route, ok := gatewaypkg.NewL4Route(routeObj); ok {
+				h.enqueueGatewaysForRoute(queue, svc, routeObj, route.ParentRefs, route.BackendRefs)
+			}
+			return nil
+		})
+	}
+}
+
+func (h *enqueueRequestsForServiceEvent) enqueueGatewaysForRoute(queue workqueue.RateLimitingInterface, svc *corev1.Service,
+	route client.Object, parentRefs []gwv1beta1.ParentReference, backendRefs []gwv1beta1.BackendRef) {
+	if !isServiceReferredByBackendRefs(route.GetNamespace(), backendRefs, svc) {
+		return
+	}
+	for _, gwKey := range parentGatewaysForRoute(route.GetNamespace(), parentRefs) {
+		h.logger.V(1).Info("enqueue gateway for service event",
+			"service", k8s.NamespacedName(svc),
+			"route", k8s.NamespacedName(route),
+			"gateway", gwKey)
+		queue.Add(reconcile.Request{NamespacedName: gwKey})
+	}
+}
+
+func isServiceReferredByBackendRefs(routeNamespace string, backendRefs []gwv1beta1.BackendRef, svc *corev1.Service) bool {
+	for _, backendRef := range backendRefs {
+		if backendRef.Group != nil && len(*backendRef.Group) != 0 {
+			continue
+		}
+		if backendRef.Kind != nil && string(*backendRef.Kind) != "Service" {
+			continue
+		}
+		backendNamespace := routeNamespace
+		if backendRef.Namespace != nil {
+			backendNamespace = string(*backendRef.Namespace)
+		}
+		if backendNamespace == svc.Namespace && string(backendRef.Name) == svc.Name {
+			return true
+		}
+	}
+	return false
+}
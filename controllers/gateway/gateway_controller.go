@@ -0,0 +1,588 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway/eventhandlers"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/dryrun"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	gatewaypkg "sigs.k8s.io/aws-load-balancer-controller/pkg/gateway"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	gatewayFinalizer = "gateway.k8s.aws/resources"
+	gatewayTagPrefix = "gateway.k8s.aws"
+	controllerName   = "gateway"
+
+	// gatewayConditionTypeDryRunPlan is the type of Gateway condition that reports the changes planned by dry run.
+	gatewayConditionTypeDryRunPlan = "gateway.k8s.aws/DryRunPlan"
+	gatewayConditionReasonPlanned  = "Planned"
+
+	// divergenceKindGateway is the kind of Gateways in the shadow mode divergence report.
+	divergenceKindGateway = "Gateway"
+)
+
+// NewGatewayReconciler constructs new gatewayReconciler
+func NewGatewayReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	finalizerManager k8s.FinalizerManager, networkingSGManager networking.SecurityGroupManager,
+	networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver,
+	controllerConfig config.ControllerConfig, backendSGProvider networking.BackendSGProvider, defaultTagsProvider networking.DefaultTagsProvider,
+	dynamicConfigProvider config.DynamicConfigProvider,
+	certDiscoveryMetrics *ingress.CertDiscoveryMetrics, listenerRulesFetchMetrics *elbv2.ListenerRulesFetchMetrics,
+	mutationVerificationMetrics *elbv2.MutationVerificationMetrics, describeCacheMetrics *elbv2.DescribeCacheMetrics, divergenceReporter audit.DivergenceReporter, logger logr.Logger) *gatewayReconciler {
+
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixGateway)
+	trackingProvider := tracking.NewDefaultProvider(gatewayTagPrefix, controllerConfig.ClusterName, controllerConfig.DeniedTagKeyPrefixes)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), controllerConfig.StrictTagEnforcement(), logger)
+	certDiscovery := ingress.NewACMCertDiscovery(cloud.ACM(), controllerConfig.IngressConfig.CertDiscoveryTags, certDiscoveryMetrics, logger)
+	routeLoader := gatewaypkg.NewDefaultRouteLoader(k8sClient)
+	buildModelBuilder := func(backendSGProvider networking.BackendSGProvider) gatewaypkg.ModelBuilder {
+		return gatewaypkg.NewDefaultModelBuilder(k8sClient, annotationParser, subnetsResolver, certDiscovery,
+			trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
+			cloud.VpcID(), controllerConfig.ClusterName, defaultTagsProvider, controllerConfig.ExternalManagedTags,
+			dynamicConfigProvider, backendSGProvider,
+			controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), logger)
+	}
+	modelBuilder := buildModelBuilder(backendSGProvider)
+	stackMarshaller := deploy.NewDefaultStackMarshaller()
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, controllerConfig, gatewayTagPrefix,
+		listenerRulesFetchMetrics, mutationVerificationMetrics, describeCacheMetrics, logger)
+	// the dry run model builder plans the backend securityGroup with a dedicated provider,
+	// so that the backend securityGroup planned to be created isn't shared with the default model builder.
+	dryRunBackendSGProvider := networking.NewBackendSGProvider(controllerConfig.ClusterName, controllerConfig.BackendSecurityGroup, controllerConfig.BackendSecurityGroupShareKey,
+		cloud.VpcID(), dryrun.NewCloud(cloud).EC2(), k8sClient, defaultTagsProvider, controllerConfig.ExternalManagedTags,
+		controllerConfig.StrictTagEnforcement(), controllerConfig.BackendSecurityGroupReleaseGracePeriod, eventRecorder, logger)
+	dryRunModelBuilder := buildModelBuilder(dryRunBackendSGProvider)
+	dryRunStackDeployer := deploy.NewDryRunStackDeployer(cloud, k8sClient, controllerConfig, gatewayTagPrefix, logger)
+	var modelDiffLogger *deploy.ModelDiffLogger
+	if controllerConfig.LogModelDiff {
+		modelDiffLogger = deploy.NewModelDiffLogger(logger)
+	}
+	return &gatewayReconciler{
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
+		finalizerManager:    finalizerManager,
+		annotationParser:    annotationParser,
+		backendSGProvider:   backendSGProvider,
+		defaultTagsProvider: defaultTagsProvider,
+		routeLoader:         routeLoader,
+
+		modelBuilder:    modelBuilder,
+		stackMarshaller: stackMarshaller,
+		modelDiffLogger: modelDiffLogger,
+		stackDeployer:   stackDeployer,
+		logger:          logger,
+
+		dryRunModelBuilder:  dryRunModelBuilder,
+		dryRunStackDeployer: dryRunStackDeployer,
+		divergenceReporter:  divergenceReporter,
+
+		maxConcurrentReconciles: controllerConfig.GatewayMaxConcurrentReconciles,
+		dryRun:                  controllerConfig.DryRun || controllerConfig.ShadowMode,
+	}
+}
+
+// gatewayReconciler reconciles Gateways whose GatewayClass is managed by this controller.
+type gatewayReconciler struct {
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
+	finalizerManager    k8s.FinalizerManager
+	annotationParser    annotations.Parser
+	backendSGProvider   networking.BackendSGProvider
+	defaultTagsProvider networking.DefaultTagsProvider
+	routeLoader         gatewaypkg.RouteLoader
+
+	modelBuilder    gatewaypkg.ModelBuilder
+	stackMarshaller deploy.StackMarshaller
+	// modelDiffLogger logs the diff of the model of Gateways between reconciles if enabled, it's nil otherwise.
+	modelDiffLogger *deploy.ModelDiffLogger
+	stackDeployer   deploy.StackDeployer
+	logger          logr.Logger
+
+	// dryRunModelBuilder and dryRunStackDeployer plan the changes without applying them.
+	dryRunModelBuilder  gatewaypkg.ModelBuilder
+	dryRunStackDeployer deploy.StackDeployer
+	// divergenceReporter reports the planned changes instead of events and conditions in shadow mode, it's nil otherwise.
+	divergenceReporter audit.DivergenceReporter
+
+	maxConcurrentReconciles int
+	// dryRun specifies whether to plan the changes for all Gateways without applying them
+	dryRun bool
+}
+
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gatewayclasses/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes;udproutes;tlsroutes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=tcproutes/status;udproutes/status;tlsroutes/status,verbs=update;patch
+// +kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=referencegrants,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+func (r *gatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+}
+
+func (r *gatewayReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
+	gw := &gwv1beta1.Gateway{}
+	if err := r.k8sClient.Get(ctx, req.NamespacedName, gw); err != nil {
+		if apierrors.IsNotFound(err) && r.divergenceReporter != nil {
+			return r.divergenceReporter.ReportDivergence(ctx, divergenceKindGateway, req.NamespacedName, nil)
+		}
+		return client.IgnoreNotFound(err)
+	}
+	gwClass, err := r.loadManagedGatewayClass(ctx, gw)
+	if err != nil {
+		return err
+	}
+	dryRun, err := r.isDryRun(gw)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if dryRun {
+		return r.planGatewayResources(ctx, gw, gwClass)
+	}
+	if gwClass == nil || !gw.DeletionTimestamp.IsZero() {
+		return r.cleanupGatewayResources(ctx, gw)
+	}
+	if err := r.updateGatewayClassStatus(ctx, gwClass); err != nil {
+		return err
+	}
+
+	routes, err := r.routeLoader.Load(ctx, gw)
+	if err != nil {
+		return err
+	}
+	lbType, _ := gatewaypkg.LoadBalancerTypeForGatewayController(gwClass.Spec.ControllerName)
+	stack, lb, backendSGAllocated, err := r.buildModel(ctx, gw, lbType, routes)
+	if err != nil {
+		return err
+	}
+	if lb == nil {
+		return r.cleanupGatewayResources(ctx, gw)
+	}
+	return r.reconcileGatewayResources(ctx, gw, gwClass, routes, stack, lb, backendSGAllocated)
+}
+
+// loadManagedGatewayClass loads the GatewayClass of Gateway, returns nil if the GatewayClass isn't managed by this controller.
+func (r *gatewayReconciler) loadManagedGatewayClass(ctx context.Context, gw *gwv1beta1.Gateway) (*gwv1beta1.GatewayClass, error) {
+	gwClass := &gwv1beta1.GatewayClass{}
+	if err := r.k8sClient.Get(ctx, types.NamespacedName{Name: string(gw.Spec.GatewayClassName)}, gwClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if _, managed := gatewaypkg.LoadBalancerTypeForGatewayController(gwClass.Spec.ControllerName); !managed {
+		return nil, nil
+	}
+	return gwClass, nil
+}
+
+func (r *gatewayReconciler) buildModel(ctx context.Context, gw *gwv1beta1.Gateway, lbType elbv2model.LoadBalancerType, routes gatewaypkg.ListenerRoutes) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+	stack, lb, backendSGAllocated, err := r.modelBuilder.Build(ctx, gw, lbType, routes)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, false, err
+	}
+	stackJSON, err := r.stackMarshaller.Marshal(stack)
+	if err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, false, err
+	}
+	r.logger.Info("successfully built model", "model", stackJSON)
+	r.modelDiffLogger.Log(stack.StackID(), stackJSON)
+	return stack, lb, backendSGAllocated, nil
+}
+
+func (r *gatewayReconciler) deployModel(ctx context.Context, gw *gwv1beta1.Gateway, stack core.Stack) error {
+	ctx = audit.ContextWithMutationRecorder(ctx, audit.NewEventMutationRecorder(r.eventRecorder, gw))
+	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully deployed model", "gateway", k8s.NamespacedName(gw))
+	return nil
+}
+
+// planGatewayResources builds the model for Gateway and reports the changes to deploy it without applying them.
+// the planned changes are reported via event and the DryRunPlan condition, finalizers and the status of Gateway and routes are left untouched.
+func (r *gatewayReconciler) planGatewayResources(ctx context.Context, gw *gwv1beta1.Gateway, gwClass *gwv1beta1.GatewayClass) error {
+	planRecorder := audit.NewPlanRecorder()
+	planCtx := audit.ContextWithMutationRecorder(ctx, planRecorder)
+	var stack core.Stack = core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
+	var lb *elbv2model.LoadBalancer
+	if gwClass != nil && gw.DeletionTimestamp.IsZero() {
+		routes, err := r.routeLoader.Load(ctx, gw)
+		if err != nil {
+			return err
+		}
+		lbType, _ := gatewaypkg.LoadBalancerTypeForGatewayController(gwClass.Spec.ControllerName)
+		stack, lb, _, err = r.dryRunModelBuilder.Build(planCtx, gw, lbType, routes)
+		if err != nil {
+			r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+			return err
+		}
+	}
+	// without load balancer, there is nothing to plan unless the resources are provisioned already.
+	if lb == nil && !k8s.HasFinalizer(gw, gatewayFinalizer) {
+		if r.divergenceReporter != nil {
+			return r.divergenceReporter.ReportDivergence(ctx, divergenceKindGateway, k8s.NamespacedName(gw), nil)
+		}
+		return nil
+	}
+	if err := r.dryRunStackDeployer.Deploy(planCtx, stack); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	plan := planRecorder.Plan()
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("successfully planned model", "gateway", k8s.NamespacedName(gw), "plan", string(planJSON))
+	if r.divergenceReporter != nil {
+		return r.divergenceReporter.ReportDivergence(ctx, divergenceKindGateway, k8s.NamespacedName(gw), plan)
+	}
+	planMessage := audit.RenderPlanMessage(plan)
+	r.eventRecorder.Event(gw, corev1.EventTypeNormal, audit.EventReasonDryRunPlan, planMessage)
+	if err := r.updateGatewayDryRunPlanCondition(ctx, gw, planMessage); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	return nil
+}
+
+// isDryRun checks whether the changes for Gateway should be planned without applying them.
+func (r *gatewayReconciler) isDryRun(gw *gwv1beta1.Gateway) (bool, error) {
+	if r.dryRun {
+		return true, nil
+	}
+	dryRun := false
+	if _, err := r.annotationParser.ParseBoolAnnotation(annotations.GatewaySuffixDryRun, &dryRun, gw.Annotations); err != nil {
+		return false, err
+	}
+	return dryRun, nil
+}
+
+func (r *gatewayReconciler) reconcileGatewayResources(ctx context.Context, gw *gwv1beta1.Gateway, gwClass *gwv1beta1.GatewayClass, routes gatewaypkg.ListenerRoutes,
+	stack core.Stack, lb *elbv2model.LoadBalancer, backendSGAllocated bool) error {
+	if err := r.finalizerManager.AddFinalizers(ctx, gw, gatewayFinalizer); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
+		return err
+	}
+	if err := r.deployModel(ctx, gw, stack); err != nil {
+		return err
+	}
+	lbDNS, err := lb.DNSName().Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	if !backendSGAllocated {
+		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeGateway, []types.NamespacedName{k8s.NamespacedName(gw)}); err != nil {
+			return err
+		}
+	}
+	if err := r.updateGatewayStatus(ctx, gw, routes, lbDNS); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	if err := r.updateRoutesStatus(ctx, gw, gwClass.Spec.ControllerName, routes); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	r.eventRecorder.Event(gw, corev1.EventTypeNormal, k8s.GatewayEventReasonSuccessfullyReconciled, "Successfully reconciled")
+	return nil
+}
+
+func (r *gatewayReconciler) cleanupGatewayResources(ctx context.Context, gw *gwv1beta1.Gateway) error {
+	if !k8s.HasFinalizer(gw, gatewayFinalizer) {
+		return nil
+	}
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
+	if err := r.deployModel(ctx, gw, stack); err != nil {
+		return err
+	}
+	r.modelDiffLogger.Forget(stack.StackID())
+	if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeGateway, []types.NamespacedName{k8s.NamespacedName(gw)}); err != nil {
+		return err
+	}
+	if err := r.finalizerManager.RemoveFinalizers(ctx, gw, gatewayFinalizer); err != nil {
+		r.eventRecorder.Event(gw, corev1.EventTypeWarning, k8s.GatewayEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
+		return err
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) updateGatewayClassStatus(ctx context.Context, gwClass *gwv1beta1.GatewayClass) error {
+	gwClassOld := gwClass.DeepCopy()
+	meta.SetStatusCondition(&gwClass.Status.Conditions, metav1.Condition{
+		Type:               string(gwv1beta1.GatewayClassConditionStatusAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1beta1.GatewayClassReasonAccepted),
+		ObservedGeneration: gwClass.Generation,
+	})
+	if equality.Semantic.DeepEqual(gwClassOld.Status, gwClass.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gwClass, client.MergeFrom(gwClassOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gatewayClass status: %v", gwClass.Name)
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) updateGatewayStatus(ctx context.Context, gw *gwv1beta1.Gateway, routes gatewaypkg.ListenerRoutes, lbDNS string) error {
+	gwOld := gw.DeepCopy()
+	addressType := gwv1beta1.HostnameAddressType
+	gw.Status.Addresses = []gwv1beta1.GatewayAddress{
+		{
+			Type:  &addressType,
+			Value: lbDNS,
+		},
+	}
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               string(gwv1beta1.GatewayConditionAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1beta1.GatewayReasonAccepted),
+		ObservedGeneration: gw.Generation,
+	})
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               string(gwv1beta1.GatewayConditionProgrammed),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gwv1beta1.GatewayReasonProgrammed),
+		ObservedGeneration: gw.Generation,
+	})
+
+	existingListenerStatuses := make(map[gwv1beta1.SectionName]gwv1beta1.ListenerStatus, len(gw.Status.Listeners))
+	for _, listenerStatus := range gw.Status.Listeners {
+		existingListenerStatuses[listenerStatus.Name] = listenerStatus
+	}
+	routeGroup := gwv1beta1.Group(gwv1beta1.GroupName)
+	listenerStatuses := make([]gwv1beta1.ListenerStatus, 0, len(gw.Spec.Listeners))
+	for _, listener := range gw.Spec.Listeners {
+		listenerStatus := existingListenerStatuses[listener.Name]
+		listenerStatus.Name = listener.Name
+		listenerStatus.SupportedKinds = []gwv1beta1.RouteGroupKind{
+			{
+				Group: &routeGroup,
+				Kind:  gwv1beta1.Kind(gatewaypkg.RouteKindForListener(listener)),
+			},
+		}
+		listenerStatus.AttachedRoutes = int32(len(routes.HTTPRoutes[listener.Name]))
+		if _, exists := routes.L4Routes[listener.Name]; exists {
+			listenerStatus.AttachedRoutes = 1
+		}
+		meta.SetStatusCondition(&listenerStatus.Conditions, metav1.Condition{
+			Type:               string(gwv1beta1.ListenerConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gwv1beta1.ListenerReasonAccepted),
+			ObservedGeneration: gw.Generation,
+		})
+		meta.SetStatusCondition(&listenerStatus.Conditions, metav1.Condition{
+			Type:               string(gwv1beta1.ListenerConditionProgrammed),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gwv1beta1.ListenerReasonProgrammed),
+			ObservedGeneration: gw.Generation,
+		})
+		listenerStatuses = append(listenerStatuses, listenerStatus)
+	}
+	gw.Status.Listeners = listenerStatuses
+	// the changes are applied, thus the plan of previous dry run is stale.
+	meta.RemoveStatusCondition(&gw.Status.Conditions, gatewayConditionTypeDryRunPlan)
+
+	if equality.Semantic.DeepEqual(gwOld.Status, gw.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gateway status: %v", k8s.NamespacedName(gw))
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) updateGatewayDryRunPlanCondition(ctx context.Context, gw *gwv1beta1.Gateway, planMessage string) error {
+	gwOld := gw.DeepCopy()
+	meta.SetStatusCondition(&gw.Status.Conditions, metav1.Condition{
+		Type:               gatewayConditionTypeDryRunPlan,
+		Status:             metav1.ConditionTrue,
+		Reason:             gatewayConditionReasonPlanned,
+		Message:            planMessage,
+		ObservedGeneration: gw.Generation,
+	})
+	if equality.Semantic.DeepEqual(gwOld.Status, gw.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, gw, client.MergeFrom(gwOld)); err != nil {
+		return errors.Wrapf(err, "failed to update gateway status: %v", k8s.NamespacedName(gw))
+	}
+	return nil
+}
+
+// updateRoutesStatus updates the parent status of routes that references the Gateway.
+func (r *gatewayReconciler) updateRoutesStatus(ctx context.Context, gw *gwv1beta1.Gateway, controllerName gwv1beta1.GatewayController, routes gatewaypkg.ListenerRoutes) error {
+	acceptedCondition := metav1.Condition{
+		Type:   string(gwv1beta1.RouteConditionAccepted),
+		Status: metav1.ConditionTrue,
+		Reason: string(gwv1beta1.RouteReasonAccepted),
+	}
+	notAllowedCondition := metav1.Condition{
+		Type:    string(gwv1beta1.RouteConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(gwv1beta1.RouteReasonNotAllowedByListeners),
+		Message: "route is not allowed by any listener of the gateway",
+	}
+	for _, route := range routes.AttachedHTTPRoutes() {
+		if err := r.updateRouteStatus(ctx, gw, controllerName, route, &route.Status.RouteStatus, route.Spec.ParentRefs, acceptedCondition); err != nil {
+			return err
+		}
+	}
+	for _, route := range routes.UnattachedHTTPRoutes {
+		if err := r.updateRouteStatus(ctx, gw, controllerName, route, &route.Status.RouteStatus, route.Spec.ParentRefs, notAllowedCondition); err != nil {
+			return err
+		}
+	}
+	for _, route := range routes.AttachedL4Routes() {
+		if err := r.updateRouteStatus(ctx, gw, controllerName, route.Object, route.Status, route.ParentRefs, acceptedCondition); err != nil {
+			return err
+		}
+	}
+	for _, route := range routes.UnattachedL4Routes {
+		if err := r.updateRouteStatus(ctx, gw, controllerName, route.Object, route.Status, route.ParentRefs, notAllowedCondition); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateRouteStatus updates the parent status of route, where routeStatus points to the status of route object.
+func (r *gatewayReconciler) updateRouteStatus(ctx context.Context, gw *gwv1beta1.Gateway, controllerName gwv1beta1.GatewayController,
+	route client.Object, routeStatus *gwv1beta1.RouteStatus, parentRefs []gwv1beta1.ParentReference, acceptedCondition metav1.Condition) error {
+	routeOld := route.DeepCopyObject().(client.Object)
+	routeStatusOld := routeStatus.DeepCopy()
+	acceptedCondition.ObservedGeneration = route.GetGeneration()
+	for _, parentRef := range gatewaypkg.ParentRefsForGateway(gw, route.GetNamespace(), parentRefs) {
+		parentStatusIndex := -1
+		for i, parentStatus := range routeStatus.Parents {
+			if parentStatus.ControllerName == controllerName && equality.Semantic.DeepEqual(parentStatus.ParentRef, parentRef) {
+				parentStatusIndex = i
+				break
+			}
+		}
+		if parentStatusIndex == -1 {
+			routeStatus.Parents = append(routeStatus.Parents, gwv1beta1.RouteParentStatus{
+				ParentRef:      parentRef,
+				ControllerName: controllerName,
+			})
+			parentStatusIndex = len(routeStatus.Parents) - 1
+		}
+		meta.SetStatusCondition(&routeStatus.Parents[parentStatusIndex].Conditions, acceptedCondition)
+	}
+	if equality.Semantic.DeepEqual(routeStatusOld, routeStatus) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, route, client.MergeFrom(routeOld)); err != nil {
+		return errors.Wrapf(err, "failed to update route status: %v", k8s.NamespacedName(route))
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
+	c, err := controller.New(controllerName, mgr, controller.Options{
+		MaxConcurrentReconciles: r.maxConcurrentReconciles,
+		Reconciler:              r,
+	})
+	if err != nil {
+		return err
+	}
+	if err := r.setupWatches(ctx, c, mgr.GetRESTMapper()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *gatewayReconciler) setupWatches(_ context.Context, c controller.Controller, restMapper meta.RESTMapper) error {
+	// L4 routes are only watched if their CRDs are installed, since they are in the experimental channel of Gateway API.
+	var l4Routes []client.Object
+	var l4RouteLists []client.ObjectList
+	for _, l4Route := range []struct {
+		kind string
+		obj  client.Object
+		list client.ObjectList
+	}{
+		{kind: "TCPRoute", obj: &gwv1alpha2.TCPRoute{}, list: &gwv1alpha2.TCPRouteList{}},
+		{kind: "UDPRoute", obj: &gwv1alpha2.UDPRoute{}, list: &gwv1alpha2.UDPRouteList{}},
+		{kind: "TLSRoute", obj: &gwv1alpha2.TLSRoute{}, list: &gwv1alpha2.TLSRouteList{}},
+	} {
+		gk := schema.GroupKind{Group: gwv1alpha2.GroupName, Kind: l4Route.kind}
+		if _, err := restMapper.RESTMapping(gk, gwv1alpha2.GroupVersion.Version); err != nil {
+			if meta.IsNoMatchError(err) {
+				r.logger.Info("skipped watching routes since CRD isn't installed", "kind", l4Route.kind)
+				continue
+			}
+			return err
+		}
+		l4Routes = append(l4Routes, l4Route.obj)
+		l4RouteLists = append(l4RouteLists, l4Route.list)
+	}
+
+	gwEventHandler := eventhandlers.NewEnqueueRequestsForGatewayEvent(r.logger.WithName("eventHandlers").WithName("gateway"))
+	gwClassEventHandler := eventhandlers.NewEnqueueRequestsForGatewayClassEvent(r.k8sClient,
+		r.logger.WithName("eventHandlers").WithName("gatewayClass"))
+	httpRouteEventHandler := eventhandlers.NewEnqueueRequestsForHTTPRouteEvent(r.logger.WithName("eventHandlers").WithName("httpRoute"))
+	l4RouteEventHandler := eventhandlers.NewEnqueueRequestsForL4RouteEvent(r.logger.WithName("eventHandlers").WithName("l4Route"))
+	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(r.k8sClient, l4RouteLists,
+		r.logger.WithName("eventHandlers").WithName("service"))
+	if err := c.Watch(&source.Kind{Type: &gwv1beta1.Gateway{}}, gwEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Channel{Source: r.defaultTagsProvider.ChangeEvents(networking.ResourceTypeGateway)}, gwEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &gwv1beta1.GatewayClass{}}, gwClassEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Kind{Type: &gwv1beta1.HTTPRoute{}}, httpRouteEventHandler); err != nil {
+		return err
+	}
+	for _, l4Route := range l4Routes {
+		if err := c.Watch(&source.Kind{Type: l4Route}, l4RouteEventHandler); err != nil {
+			return err
+		}
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
+		return err
+	}
+	return nil
+}
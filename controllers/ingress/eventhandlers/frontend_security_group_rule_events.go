@@ -0,0 +1,72 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForFrontendSecurityGroupRuleEvent constructs new enqueueRequestsForFrontendSecurityGroupRuleEvent.
+func NewEnqueueRequestsForFrontendSecurityGroupRuleEvent(logger logr.Logger) *enqueueRequestsForFrontendSecurityGroupRuleEvent {
+	return &enqueueRequestsForFrontendSecurityGroupRuleEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForFrontendSecurityGroupRuleEvent)(nil)
+
+// enqueueRequestsForFrontendSecurityGroupRuleEvent enqueues the IngressGroup referenced by FrontendSecurityGroupRules.
+type enqueueRequestsForFrontendSecurityGroupRuleEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForFrontendSecurityGroupRuleEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	fsgrNew := e.Object.(*elbv2api.FrontendSecurityGroupRule)
+	h.enqueueImpactedIngressGroup(queue, fsgrNew)
+}
+
+func (h *enqueueRequestsForFrontendSecurityGroupRuleEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	fsgrOld := e.ObjectOld.(*elbv2api.FrontendSecurityGroupRule)
+	fsgrNew := e.ObjectNew.(*elbv2api.FrontendSecurityGroupRule)
+
+	// we only care below update event:
+	//	1. FrontendSecurityGroupRule spec updates
+	if equality.Semantic.DeepEqual(fsgrOld.Spec, fsgrNew.Spec) {
+		return
+	}
+
+	h.enqueueImpactedIngressGroup(queue, fsgrOld)
+	h.enqueueImpactedIngressGroup(queue, fsgrNew)
+}
+
+func (h *enqueueRequestsForFrontendSecurityGroupRuleEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	fsgrOld := e.Object.(*elbv2api.FrontendSecurityGroupRule)
+	h.enqueueImpactedIngressGroup(queue, fsgrOld)
+}
+
+func (h *enqueueRequestsForFrontendSecurityGroupRuleEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for frontendSecurityGroupRules.
+}
+
+func (h *enqueueRequestsForFrontendSecurityGroupRuleEvent) enqueueImpactedIngressGroup(queue workqueue.RateLimitingInterface, fsgr *elbv2api.FrontendSecurityGroupRule) {
+	var groupID ingress.GroupID
+	switch {
+	case fsgr.Spec.Group != nil:
+		groupID = ingress.NewGroupIDForExplicitGroup(fsgr.Spec.Group.Name)
+	case fsgr.Spec.IngressName != "":
+		groupID = ingress.NewGroupIDForImplicitGroup(types.NamespacedName{Namespace: fsgr.Namespace, Name: fsgr.Spec.IngressName})
+	default:
+		return
+	}
+
+	h.logger.V(1).Info("enqueue ingressGroup for frontendSecurityGroupRule event",
+		"frontendSecurityGroupRule", k8s.NamespacedName(fsgr),
+		"ingressGroup", groupID.String())
+	queue.Add(ingress.EncodeGroupIDToReconcileRequest(groupID))
+}
@@ -65,16 +65,13 @@ func (h *enqueueRequestsForIngressClassEvent) Generic(e event.GenericEvent, _ wo
 }
 
 func (h *enqueueRequestsForIngressClassEvent) enqueueImpactedIngresses(ingClass *networking.IngressClass) {
-	ingList := &networking.IngressList{}
-	if err := h.k8sClient.List(context.Background(), ingList,
-		client.MatchingFields{ingress.IndexKeyIngressClassRefName: ingClass.GetName()}); err != nil {
+	ingList, err := listImpactedIngresses(context.Background(), h.k8sClient, ingClass)
+	if err != nil {
 		h.logger.Error(err, "failed to fetch ingresses")
 		return
 	}
 
-	for index := range ingList.Items {
-		ing := &ingList.Items[index]
-
+	for _, ing := range ingList {
 		h.logger.V(1).Info("enqueue ingress for ingressClass event",
 			"ingressClass", ingClass.GetName(),
 			"ingress", k8s.NamespacedName(ing))
@@ -83,3 +80,31 @@ func (h *enqueueRequestsForIngressClassEvent) enqueueImpactedIngresses(ingClass
 		}
 	}
 }
+
+// listImpactedIngresses returns the Ingresses that belong to IngressClass.
+// Ingresses without IngressClassName belong to IngressClass as well if it's marked as the default.
+func listImpactedIngresses(ctx context.Context, k8sClient client.Client, ingClass *networking.IngressClass) ([]*networking.Ingress, error) {
+	ingList := &networking.IngressList{}
+	if err := k8sClient.List(ctx, ingList,
+		client.MatchingFields{ingress.IndexKeyIngressClassRefName: ingClass.GetName()}); err != nil {
+		return nil, err
+	}
+	impactedIngs := make([]*networking.Ingress, 0, len(ingList.Items))
+	for index := range ingList.Items {
+		impactedIngs = append(impactedIngs, &ingList.Items[index])
+	}
+	if !ingress.IsDefaultIngressClass(ingClass) {
+		return impactedIngs, nil
+	}
+
+	allIngList := &networking.IngressList{}
+	if err := k8sClient.List(ctx, allIngList); err != nil {
+		return nil, err
+	}
+	for index := range allIngList.Items {
+		if allIngList.Items[index].Spec.IngressClassName == nil {
+			impactedIngs = append(impactedIngs, &allIngList.Items[index])
+		}
+	}
+	return impactedIngs, nil
+}
@@ -2,41 +2,45 @@ package eventhandlers
 
 import (
 	"context"
+	"fmt"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
 
 // NewEnqueueRequestsForIngressClassParamsEvent constructs new enqueueRequestsForIngressClassParamsEvent.
-func NewEnqueueRequestsForIngressClassParamsEvent(ingClassEventChan chan<- event.GenericEvent,
+func NewEnqueueRequestsForIngressClassParamsEvent(ingEventChan chan<- event.GenericEvent,
 	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForIngressClassParamsEvent {
 	return &enqueueRequestsForIngressClassParamsEvent{
-		ingClassEventChan: ingClassEventChan,
-		k8sClient:         k8sClient,
-		eventRecorder:     eventRecorder,
-		logger:            logger,
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForIngressClassParamsEvent)(nil)
 
+// enqueueRequestsForIngressClassParamsEvent enqueues all Ingresses of the IngressClasses that reference IngressClassParams.
 type enqueueRequestsForIngressClassParamsEvent struct {
-	ingClassEventChan chan<- event.GenericEvent
-	k8sClient         client.Client
-	eventRecorder     record.EventRecorder
-	logger            logr.Logger
+	ingEventChan  chan<- event.GenericEvent
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
 }
 
 func (h *enqueueRequestsForIngressClassParamsEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
 	ingClassParamsNew := e.Object.(*elbv2api.IngressClassParams)
-	h.enqueueImpactedIngressClasses(ingClassParamsNew)
+	h.enqueueImpactedIngresses(ingClassParamsNew)
 }
 
 func (h *enqueueRequestsForIngressClassParamsEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
@@ -51,33 +55,49 @@ func (h *enqueueRequestsForIngressClassParamsEvent) Update(e event.UpdateEvent,
 		return
 	}
 
-	h.enqueueImpactedIngressClasses(ingClassParamsNew)
+	h.enqueueImpactedIngresses(ingClassParamsNew)
 }
 
 func (h *enqueueRequestsForIngressClassParamsEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
 	ingClassParamsOld := e.Object.(*elbv2api.IngressClassParams)
-	h.enqueueImpactedIngressClasses(ingClassParamsOld)
+	h.enqueueImpactedIngresses(ingClassParamsOld)
 }
 
 func (h *enqueueRequestsForIngressClassParamsEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
 	// we don't have any generic event for secrets.
 }
 
-func (h *enqueueRequestsForIngressClassParamsEvent) enqueueImpactedIngressClasses(ingClassParams *elbv2api.IngressClassParams) {
+func (h *enqueueRequestsForIngressClassParamsEvent) enqueueImpactedIngresses(ingClassParams *elbv2api.IngressClassParams) {
 	ingClassList := &networking.IngressClassList{}
 	if err := h.k8sClient.List(context.Background(), ingClassList,
 		client.MatchingFields{ingress.IndexKeyIngressClassParamsRefName: ingClassParams.GetName()}); err != nil {
 		h.logger.Error(err, "failed to fetch ingressClasses")
 		return
 	}
+
+	var impactedIngs []*networking.Ingress
 	for index := range ingClassList.Items {
 		ingClass := &ingClassList.Items[index]
+		ingList, err := listImpactedIngresses(context.Background(), h.k8sClient, ingClass)
+		if err != nil {
+			h.logger.Error(err, "failed to fetch ingresses",
+				"ingressClass", ingClass.GetName())
+			return
+		}
+		impactedIngs = append(impactedIngs, ingList...)
+	}
+	if len(impactedIngs) == 0 {
+		return
+	}
 
-		h.logger.V(1).Info("enqueue ingressClass for ingressClassParams event",
+	for _, ing := range impactedIngs {
+		h.logger.V(1).Info("enqueue ingress for ingressClassParams event",
 			"ingressClassParams", ingClassParams.GetName(),
-			"ingressClass", ingClass.GetName())
-		h.ingClassEventChan <- event.GenericEvent{
-			Object: ingClass,
+			"ingress", k8s.NamespacedName(ing))
+		h.ingEventChan <- event.GenericEvent{
+			Object: ing,
 		}
 	}
+	h.eventRecorder.Event(ingClassParams, corev1.EventTypeNormal, k8s.IngressClassParamsEventReasonIngressesRequeued,
+		fmt.Sprintf("Requeued %d Ingresses of %d IngressClasses", len(impactedIngs), len(ingClassList.Items)))
 }
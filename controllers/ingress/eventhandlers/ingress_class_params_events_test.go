@@ -0,0 +1,202 @@
+package eventhandlers
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	mock_client "sigs.k8s.io/aws-load-balancer-controller/mocks/controller-runtime/client"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/testutils"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_enqueueRequestsForIngressClassParamsEvent_enqueueImpactedIngresses(t *testing.T) {
+	type ingClassListCall struct {
+		opts       []client.ListOption
+		ingClasses []*networking.IngressClass
+	}
+	type ingListCall struct {
+		opts []client.ListOption
+		ings []*networking.Ingress
+	}
+	type fields struct {
+		ingClassListCalls []ingClassListCall
+		ingListCalls      []ingListCall
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-params",
+		},
+	}
+	newIngress := func(namespace string, name string, ingClassName *string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: ingClassName,
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		fields       fields
+		wantIngKeys  []types.NamespacedName
+		wantEventMsg string
+	}{
+		{
+			name: "enqueue ingresses of all ingressClasses referencing ingressClassParams",
+			fields: fields{
+				ingClassListCalls: []ingClassListCall{
+					{
+						opts: []client.ListOption{client.MatchingFields{"ingressClass.ingressClassParamsRef.name": "awesome-params"}},
+						ingClasses: []*networking.IngressClass{
+							{ObjectMeta: metav1.ObjectMeta{Name: "class-a"}},
+							{ObjectMeta: metav1.ObjectMeta{Name: "class-b"}},
+						},
+					},
+				},
+				ingListCalls: []ingListCall{
+					{
+						opts: []client.ListOption{client.MatchingFields{"ingress.ingressClassRef.name": "class-a"}},
+						ings: []*networking.Ingress{
+							newIngress("ns-1", "ing-1", awssdk.String("class-a")),
+							newIngress("ns-2", "ing-2", awssdk.String("class-a")),
+						},
+					},
+					{
+						opts: []client.ListOption{client.MatchingFields{"ingress.ingressClassRef.name": "class-b"}},
+						ings: []*networking.Ingress{
+							newIngress("ns-1", "ing-3", awssdk.String("class-b")),
+						},
+					},
+				},
+			},
+			wantIngKeys: []types.NamespacedName{
+				{Namespace: "ns-1", Name: "ing-1"},
+				{Namespace: "ns-2", Name: "ing-2"},
+				{Namespace: "ns-1", Name: "ing-3"},
+			},
+			wantEventMsg: "Normal IngressesRequeued Requeued 3 Ingresses of 2 IngressClasses",
+		},
+		{
+			name: "enqueue ingresses without ingressClassName for default ingressClass",
+			fields: fields{
+				ingClassListCalls: []ingClassListCall{
+					{
+						opts: []client.ListOption{client.MatchingFields{"ingressClass.ingressClassParamsRef.name": "awesome-params"}},
+						ingClasses: []*networking.IngressClass{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "class-a",
+									Annotations: map[string]string{
+										"ingressclass.kubernetes.io/is-default-class": "true",
+									},
+								},
+							},
+						},
+					},
+				},
+				ingListCalls: []ingListCall{
+					{
+						opts: []client.ListOption{client.MatchingFields{"ingress.ingressClassRef.name": "class-a"}},
+						ings: []*networking.Ingress{
+							newIngress("ns-1", "ing-1", awssdk.String("class-a")),
+						},
+					},
+					{
+						opts: nil,
+						ings: []*networking.Ingress{
+							newIngress("ns-1", "ing-1", awssdk.String("class-a")),
+							newIngress("ns-1", "ing-2", nil),
+							newIngress("ns-1", "ing-3", awssdk.String("class-b")),
+						},
+					},
+				},
+			},
+			wantIngKeys: []types.NamespacedName{
+				{Namespace: "ns-1", Name: "ing-1"},
+				{Namespace: "ns-1", Name: "ing-2"},
+			},
+			wantEventMsg: "Normal IngressesRequeued Requeued 2 Ingresses of 1 IngressClasses",
+		},
+		{
+			name: "no ingressClass references ingressClassParams",
+			fields: fields{
+				ingClassListCalls: []ingClassListCall{
+					{
+						opts: []client.ListOption{client.MatchingFields{"ingressClass.ingressClassParamsRef.name": "awesome-params"}},
+					},
+				},
+			},
+			wantIngKeys: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			k8sClient := mock_client.NewMockClient(ctrl)
+			for _, call := range tt.fields.ingClassListCalls {
+				call := call
+				var extraMatchers []interface{}
+				for _, opt := range call.opts {
+					extraMatchers = append(extraMatchers, testutils.NewListOptionEquals(opt))
+				}
+				k8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&networking.IngressClassList{}), extraMatchers...).DoAndReturn(
+					func(ctx context.Context, ingClassList *networking.IngressClassList, opts ...client.ListOption) error {
+						for _, ingClass := range call.ingClasses {
+							ingClassList.Items = append(ingClassList.Items, *(ingClass.DeepCopy()))
+						}
+						return nil
+					},
+				)
+			}
+			for _, call := range tt.fields.ingListCalls {
+				call := call
+				var extraMatchers []interface{}
+				for _, opt := range call.opts {
+					extraMatchers = append(extraMatchers, testutils.NewListOptionEquals(opt))
+				}
+				k8sClient.EXPECT().List(gomock.Any(), gomock.AssignableToTypeOf(&networking.IngressList{}), extraMatchers...).DoAndReturn(
+					func(ctx context.Context, ingList *networking.IngressList, opts ...client.ListOption) error {
+						for _, ing := range call.ings {
+							ingList.Items = append(ingList.Items, *(ing.DeepCopy()))
+						}
+						return nil
+					},
+				)
+			}
+
+			ingEventChan := make(chan event.GenericEvent, 10)
+			eventRecorder := record.NewFakeRecorder(10)
+			h := NewEnqueueRequestsForIngressClassParamsEvent(ingEventChan, k8sClient, eventRecorder, logr.New(&log.NullLogSink{}))
+			h.enqueueImpactedIngresses(ingClassParams)
+			close(ingEventChan)
+
+			var gotIngKeys []types.NamespacedName
+			for e := range ingEventChan {
+				gotIngKeys = append(gotIngKeys, k8s.NamespacedName(e.Object))
+			}
+			assert.Equal(t, tt.wantIngKeys, gotIngKeys)
+			if tt.wantEventMsg != "" {
+				assert.Equal(t, tt.wantEventMsg, <-eventRecorder.Events)
+			} else {
+				assert.Len(t, eventRecorder.Events, 0)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/status"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -41,11 +42,12 @@ func (h *enqueueRequestsForIngressEvent) Update(e event.UpdateEvent, queue workq
 	ingNew := e.ObjectNew.(*networking.Ingress)
 
 	// we only care below update event:
-	//	1. Ingress annotation updates
+	//	1. Ingress annotation updates, except the reconcile status annotations
 	//	2. Ingress spec updates
 	//	3. Ingress deletion
 	if !equality.Semantic.DeepEqual(ingOld.ResourceVersion, ingNew.ResourceVersion) {
-		if equality.Semantic.DeepEqual(ingOld.Annotations, ingNew.Annotations) &&
+		if equality.Semantic.DeepEqual(status.StripAnnotations(status.AnnotationPrefixIngress, ingOld.Annotations),
+			status.StripAnnotations(status.AnnotationPrefixIngress, ingNew.Annotations)) &&
 			equality.Semantic.DeepEqual(ingOld.Spec, ingNew.Spec) &&
 			equality.Semantic.DeepEqual(ingOld.DeletionTimestamp.IsZero(), ingNew.DeletionTimestamp.IsZero()) {
 			return
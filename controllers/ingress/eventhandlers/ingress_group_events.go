@@ -0,0 +1,79 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	elbv2v1alpha1 "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1alpha1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForIngressGroupEvent constructs new enqueueRequestsForIngressGroupEvent.
+func NewEnqueueRequestsForIngressGroupEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, logger logr.Logger) *enqueueRequestsForIngressGroupEvent {
+	return &enqueueRequestsForIngressGroupEvent{
+		ingEventChan: ingEventChan,
+		k8sClient:    k8sClient,
+		logger:       logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForIngressGroupEvent)(nil)
+
+// enqueueRequestsForIngressGroupEvent enqueues all Ingresses within the permitted namespaces of IngressGroup resources.
+type enqueueRequestsForIngressGroupEvent struct {
+	ingEventChan chan<- event.GenericEvent
+	k8sClient    client.Client
+	logger       logr.Logger
+}
+
+func (h *enqueueRequestsForIngressGroupEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	ingGroupNew := e.Object.(*elbv2v1alpha1.IngressGroup)
+	h.enqueueImpactedIngresses(ingGroupNew, sets.NewString(ingGroupNew.Spec.PermittedNamespaces...))
+}
+
+func (h *enqueueRequestsForIngressGroupEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	ingGroupOld := e.ObjectOld.(*elbv2v1alpha1.IngressGroup)
+	ingGroupNew := e.ObjectNew.(*elbv2v1alpha1.IngressGroup)
+	if equality.Semantic.DeepEqual(ingGroupOld.Spec, ingGroupNew.Spec) {
+		return
+	}
+	// Ingresses within namespaces that are no longer permitted must leave the group as well.
+	namespaces := sets.NewString(ingGroupOld.Spec.PermittedNamespaces...).Insert(ingGroupNew.Spec.PermittedNamespaces...)
+	h.enqueueImpactedIngresses(ingGroupNew, namespaces)
+}
+
+func (h *enqueueRequestsForIngressGroupEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	ingGroupOld := e.Object.(*elbv2v1alpha1.IngressGroup)
+	h.enqueueImpactedIngresses(ingGroupOld, sets.NewString(ingGroupOld.Spec.PermittedNamespaces...))
+}
+
+func (h *enqueueRequestsForIngressGroupEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for IngressGroups.
+}
+
+func (h *enqueueRequestsForIngressGroupEvent) enqueueImpactedIngresses(ingGroup *elbv2v1alpha1.IngressGroup, namespaces sets.String) {
+	for _, namespace := range namespaces.List() {
+		ingList := &networking.IngressList{}
+		if err := h.k8sClient.List(context.Background(), ingList, client.InNamespace(namespace)); err != nil {
+			h.logger.Error(err, "failed to fetch ingresses", "namespace", namespace)
+			continue
+		}
+		for index := range ingList.Items {
+			ing := &ingList.Items[index]
+			h.logger.V(1).Info("enqueue ingress for ingressGroup event",
+				"ingressGroup", ingGroup.GetName(),
+				"ingress", k8s.NamespacedName(ing))
+			h.ingEventChan <- event.GenericEvent{
+				Object: ing,
+			}
+		}
+	}
+}
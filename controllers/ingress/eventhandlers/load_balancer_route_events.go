@@ -0,0 +1,67 @@
+package eventhandlers
+
+import (
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForLoadBalancerRouteEvent constructs new enqueueRequestsForLoadBalancerRouteEvent.
+func NewEnqueueRequestsForLoadBalancerRouteEvent(logger logr.Logger) *enqueueRequestsForLoadBalancerRouteEvent {
+	return &enqueueRequestsForLoadBalancerRouteEvent{
+		logger: logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForLoadBalancerRouteEvent)(nil)
+
+// enqueueRequestsForLoadBalancerRouteEvent enqueues the IngressGroup referenced by LoadBalancerRoutes.
+type enqueueRequestsForLoadBalancerRouteEvent struct {
+	logger logr.Logger
+}
+
+func (h *enqueueRequestsForLoadBalancerRouteEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	routeNew := e.Object.(*elbv2api.LoadBalancerRoute)
+	h.enqueueImpactedIngressGroup(queue, routeNew)
+}
+
+func (h *enqueueRequestsForLoadBalancerRouteEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	routeOld := e.ObjectOld.(*elbv2api.LoadBalancerRoute)
+	routeNew := e.ObjectNew.(*elbv2api.LoadBalancerRoute)
+
+	// we only care below update event:
+	//	1. LoadBalancerRoute spec updates
+	if equality.Semantic.DeepEqual(routeOld.Spec, routeNew.Spec) {
+		return
+	}
+
+	h.enqueueImpactedIngressGroup(queue, routeOld)
+	if routeNew.Spec.Group != routeOld.Spec.Group {
+		h.enqueueImpactedIngressGroup(queue, routeNew)
+	}
+}
+
+func (h *enqueueRequestsForLoadBalancerRouteEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	routeOld := e.Object.(*elbv2api.LoadBalancerRoute)
+	h.enqueueImpactedIngressGroup(queue, routeOld)
+}
+
+func (h *enqueueRequestsForLoadBalancerRouteEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for loadBalancerRoutes.
+}
+
+func (h *enqueueRequestsForLoadBalancerRouteEvent) enqueueImpactedIngressGroup(queue workqueue.RateLimitingInterface, route *elbv2api.LoadBalancerRoute) {
+	if route.Spec.Group.Name == "" {
+		return
+	}
+	groupID := ingress.NewGroupIDForExplicitGroup(route.Spec.Group.Name)
+	h.logger.V(1).Info("enqueue ingressGroup for loadBalancerRoute event",
+		"loadBalancerRoute", k8s.NamespacedName(route),
+		"ingressGroup", groupID.String())
+	queue.Add(ingress.EncodeGroupIDToReconcileRequest(groupID))
+}
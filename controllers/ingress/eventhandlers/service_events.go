@@ -8,6 +8,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/status"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,57 +19,65 @@ import (
 
 // NewEnqueueRequestsForServiceEvent constructs new enqueueRequestsForServiceEvent.
 func NewEnqueueRequestsForServiceEvent(ingEventChan chan<- event.GenericEvent,
-	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForServiceEvent {
+	k8sClient client.Client, eventRecorder record.EventRecorder, enableLoadBalancerRoute bool, logger logr.Logger) *enqueueRequestsForServiceEvent {
 	return &enqueueRequestsForServiceEvent{
-		ingEventChan:  ingEventChan,
-		k8sClient:     k8sClient,
-		eventRecorder: eventRecorder,
-		logger:        logger,
+		ingEventChan:            ingEventChan,
+		k8sClient:               k8sClient,
+		eventRecorder:           eventRecorder,
+		enableLoadBalancerRoute: enableLoadBalancerRoute,
+		logger:                  logger,
 	}
 }
 
 var _ handler.EventHandler = (*enqueueRequestsForServiceEvent)(nil)
 
 type enqueueRequestsForServiceEvent struct {
-	ingEventChan  chan<- event.GenericEvent
-	k8sClient     client.Client
-	eventRecorder record.EventRecorder
-	logger        logr.Logger
+	ingEventChan            chan<- event.GenericEvent
+	k8sClient               client.Client
+	eventRecorder           record.EventRecorder
+	enableLoadBalancerRoute bool
+	logger                  logr.Logger
 }
 
-func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+func (h *enqueueRequestsForServiceEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
 	svcNew := e.Object.(*corev1.Service)
 	h.enqueueImpactedIngresses(svcNew)
+	h.enqueueImpactedLoadBalancerRouteGroups(queue, svcNew)
 }
 
-func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
 	svcOld := e.ObjectOld.(*corev1.Service)
 	svcNew := e.ObjectNew.(*corev1.Service)
 
 	// we only care below update event:
-	//	1. Service annotation updates
+	//	1. Service annotation updates, except the reconcile status annotations
 	//	2. Service spec updates
 	//	3. Service deletions
-	if equality.Semantic.DeepEqual(svcOld.Annotations, svcNew.Annotations) &&
+	if equality.Semantic.DeepEqual(status.StripAnnotations(status.AnnotationPrefixService, svcOld.Annotations),
+		status.StripAnnotations(status.AnnotationPrefixService, svcNew.Annotations)) &&
 		equality.Semantic.DeepEqual(svcOld.Spec, svcNew.Spec) &&
 		equality.Semantic.DeepEqual(svcOld.DeletionTimestamp.IsZero(), svcNew.DeletionTimestamp.IsZero()) {
 		return
 	}
 
 	h.enqueueImpactedIngresses(svcNew)
+	h.enqueueImpactedLoadBalancerRouteGroups(queue, svcNew)
 }
 
-func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
 	svcOld := e.Object.(*corev1.Service)
 	h.enqueueImpactedIngresses(svcOld)
+	h.enqueueImpactedLoadBalancerRouteGroups(queue, svcOld)
 }
 
-func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
 	svc := e.Object.(*corev1.Service)
 	h.enqueueImpactedIngresses(svc)
+	h.enqueueImpactedLoadBalancerRouteGroups(queue, svc)
 }
 
 func (h *enqueueRequestsForServiceEvent) enqueueImpactedIngresses(svc *corev1.Service) {
+	svcKey := k8s.NamespacedName(svc)
 	ingList := &networking.IngressList{}
 	if err := h.k8sClient.List(context.Background(), ingList,
 		client.InNamespace(svc.GetNamespace()),
@@ -75,8 +85,15 @@ func (h *enqueueRequestsForServiceEvent) enqueueImpactedIngresses(svc *corev1.Se
 		h.logger.Error(err, "failed to fetch ingresses")
 		return
 	}
+	// Ingresses in other namespaces reference this service by its namespaced name.
+	crossNamespaceIngList := &networking.IngressList{}
+	if err := h.k8sClient.List(context.Background(), crossNamespaceIngList,
+		client.MatchingFields{ingress.IndexKeyServiceRefName: svcKey.String()}); err != nil {
+		h.logger.Error(err, "failed to fetch ingresses")
+		return
+	}
+	ingList.Items = append(ingList.Items, crossNamespaceIngList.Items...)
 
-	svcKey := k8s.NamespacedName(svc)
 	for index := range ingList.Items {
 		ing := &ingList.Items[index]
 
@@ -88,3 +105,38 @@ func (h *enqueueRequestsForServiceEvent) enqueueImpactedIngresses(svc *corev1.Se
 		}
 	}
 }
+
+// enqueueImpactedLoadBalancerRouteGroups enqueues the IngressGroups of LoadBalancerRoutes that forward to the service.
+func (h *enqueueRequestsForServiceEvent) enqueueImpactedLoadBalancerRouteGroups(queue workqueue.RateLimitingInterface, svc *corev1.Service) {
+	if !h.enableLoadBalancerRoute {
+		return
+	}
+	routeList := &elbv2api.LoadBalancerRouteList{}
+	if err := h.k8sClient.List(context.Background(), routeList, client.InNamespace(svc.GetNamespace())); err != nil {
+		h.logger.Error(err, "failed to fetch loadBalancerRoutes")
+		return
+	}
+	for index := range routeList.Items {
+		route := &routeList.Items[index]
+		if route.Spec.Group.Name == "" || !loadBalancerRouteReferencesService(route, svc.GetName()) {
+			continue
+		}
+		groupID := ingress.NewGroupIDForExplicitGroup(route.Spec.Group.Name)
+		h.logger.V(1).Info("enqueue ingressGroup for service event",
+			"service", k8s.NamespacedName(svc),
+			"loadBalancerRoute", k8s.NamespacedName(route),
+			"ingressGroup", groupID.String())
+		queue.Add(ingress.EncodeGroupIDToReconcileRequest(groupID))
+	}
+}
+
+func loadBalancerRouteReferencesService(route *elbv2api.LoadBalancerRoute, svcName string) bool {
+	for _, rule := range route.Spec.Rules {
+		for _, backend := range rule.Backends {
+			if backend.Name == svcName {
+				return true
+			}
+		}
+	}
+	return false
+}
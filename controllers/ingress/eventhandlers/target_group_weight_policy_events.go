@@ -0,0 +1,87 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// NewEnqueueRequestsForTargetGroupWeightPolicyEvent constructs new enqueueRequestsForTargetGroupWeightPolicyEvent.
+func NewEnqueueRequestsForTargetGroupWeightPolicyEvent(ingEventChan chan<- event.GenericEvent,
+	k8sClient client.Client, eventRecorder record.EventRecorder, logger logr.Logger) *enqueueRequestsForTargetGroupWeightPolicyEvent {
+	return &enqueueRequestsForTargetGroupWeightPolicyEvent{
+		ingEventChan:  ingEventChan,
+		k8sClient:     k8sClient,
+		eventRecorder: eventRecorder,
+		logger:        logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForTargetGroupWeightPolicyEvent)(nil)
+
+type enqueueRequestsForTargetGroupWeightPolicyEvent struct {
+	ingEventChan  chan<- event.GenericEvent
+	k8sClient     client.Client
+	eventRecorder record.EventRecorder
+	logger        logr.Logger
+}
+
+func (h *enqueueRequestsForTargetGroupWeightPolicyEvent) Create(e event.CreateEvent, _ workqueue.RateLimitingInterface) {
+	policyNew := e.Object.(*elbv2api.TargetGroupWeightPolicy)
+	h.enqueueImpactedIngress(policyNew)
+}
+
+func (h *enqueueRequestsForTargetGroupWeightPolicyEvent) Update(e event.UpdateEvent, _ workqueue.RateLimitingInterface) {
+	policyOld := e.ObjectOld.(*elbv2api.TargetGroupWeightPolicy)
+	policyNew := e.ObjectNew.(*elbv2api.TargetGroupWeightPolicy)
+
+	// we only care below update event:
+	//	1. TargetGroupWeightPolicy spec updates
+	//	2. TargetGroupWeightPolicy applied weights updates
+	if equality.Semantic.DeepEqual(policyOld.Spec, policyNew.Spec) &&
+		equality.Semantic.DeepEqual(policyOld.Status.Weights, policyNew.Status.Weights) {
+		return
+	}
+
+	if policyOld.Spec.IngressName != policyNew.Spec.IngressName {
+		h.enqueueImpactedIngress(policyOld)
+	}
+	h.enqueueImpactedIngress(policyNew)
+}
+
+func (h *enqueueRequestsForTargetGroupWeightPolicyEvent) Delete(e event.DeleteEvent, _ workqueue.RateLimitingInterface) {
+	policyOld := e.Object.(*elbv2api.TargetGroupWeightPolicy)
+	h.enqueueImpactedIngress(policyOld)
+}
+
+func (h *enqueueRequestsForTargetGroupWeightPolicyEvent) Generic(e event.GenericEvent, _ workqueue.RateLimitingInterface) {
+	// we don't have any generic event for targetGroupWeightPolicies.
+}
+
+func (h *enqueueRequestsForTargetGroupWeightPolicyEvent) enqueueImpactedIngress(policy *elbv2api.TargetGroupWeightPolicy) {
+	ingKey := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Spec.IngressName}
+	ing := &networking.Ingress{}
+	if err := h.k8sClient.Get(context.Background(), ingKey, ing); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			h.logger.Error(err, "failed to fetch ingress", "ingress", ingKey)
+		}
+		return
+	}
+
+	h.logger.V(1).Info("enqueue ingress for targetGroupWeightPolicy event",
+		"targetGroupWeightPolicy", k8s.NamespacedName(policy),
+		"ingress", ingKey)
+	h.ingEventChan <- event.GenericEvent{
+		Object: ing,
+	}
+}
@@ -2,30 +2,46 @@ package ingress
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
+	elbv2v1alpha1 "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1alpha1"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/ingress/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/dryrun"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/status"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/inventory"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/sharding"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -40,73 +56,228 @@ const (
 	// the groupVersion of used Ingress & IngressClass resource.
 	ingressResourcesGroupVersion = "networking.k8s.io/v1"
 	ingressClassKind             = "IngressClass"
+
+	// divergenceKindIngressGroup is the kind of IngressGroups in the shadow mode divergence report.
+	divergenceKindIngressGroup = "IngressGroup"
+
+	// eniReleasePollInterval is the interval to check whether the network interfaces of deleted load balancers are released.
+	eniReleasePollInterval = 30 * time.Second
 )
 
 // NewGroupReconciler constructs new GroupReconciler
 func NewGroupReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networkingpkg.SecurityGroupManager,
-	networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
+	networkingSGReconciler networkingpkg.SecurityGroupReconciler, sgDriftReporter networkingpkg.SecurityGroupDriftReporter,
+	subnetsResolver networkingpkg.SubnetsResolver, subnetsDiscoveryStrategyFactory networkingpkg.SubnetsDiscoveryStrategyFactory,
 	controllerConfig config.ControllerConfig, backendSGProvider networkingpkg.BackendSGProvider,
-	sgResolver networkingpkg.SecurityGroupResolver, logger logr.Logger) *groupReconciler {
+	sgResolver networkingpkg.SecurityGroupResolver, blocklistPrefixListProvider networkingpkg.BlocklistPrefixListProvider,
+	defaultTagsProvider networkingpkg.DefaultTagsProvider, dynamicConfigProvider config.DynamicConfigProvider,
+	certDiscoveryMetrics *ingress.CertDiscoveryMetrics, reconcileMetrics *lbcmetrics.ReconcileMetrics, apiCallReporter *lbcmetrics.ReconcileAPICallReporter, ruleMetricsExporter ingress.RuleMetricsExporter,
+	listenerRulesFetchMetrics *elbv2deploy.ListenerRulesFetchMetrics,
+	mutationVerificationMetrics *elbv2deploy.MutationVerificationMetrics, describeCacheMetrics *elbv2deploy.DescribeCacheMetrics,
+	stackENIMetrics *deploy.StackENIMetrics, modelHashMetrics *ingress.ModelHashMetrics, awsSecretsProvider ingress.AWSSecretsProvider, divergenceReporter audit.DivergenceReporter,
+	shardCoordinator sharding.Coordinator, ownershipPublisher inventory.OwnershipPublisher, debugLoggingTargets *runtime.DebugLoggingTargets,
+	logger logr.Logger) *groupReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
 	authConfigBuilder := ingress.NewDefaultAuthConfigBuilder(annotationParser)
-	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, controllerConfig.IngressConfig.TolerateNonExistentBackendService, controllerConfig.IngressConfig.TolerateNonExistentBackendAction)
+	enhancedBackendBuilder := ingress.NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, controllerConfig.IngressConfig.TolerateNonExistentBackendService, controllerConfig.IngressConfig.TolerateNonExistentBackendAction, controllerConfig.FeatureGates.Enabled(config.TargetGroupWeightPolicy))
 	referenceIndexer := ingress.NewDefaultReferenceIndexer(enhancedBackendBuilder, authConfigBuilder, logger)
-	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, controllerConfig.ClusterName)
-	elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), logger)
-	modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
-		cloud.EC2(), cloud.ACM(),
-		annotationParser, subnetsResolver,
-		authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
-		cloud.VpcID(), controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
-		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, backendSGProvider, sgResolver,
-		controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), logger)
+	trackingProvider := tracking.NewDefaultProvider(ingressTagPrefix, controllerConfig.ClusterName, controllerConfig.DeniedTagKeyPrefixes)
+	buildDeployer := func(cloud aws.Cloud, networkingSGManager networkingpkg.SecurityGroupManager,
+		networkingSGReconciler networkingpkg.SecurityGroupReconciler, subnetsResolver networkingpkg.SubnetsResolver,
+		backendSGProvider networkingpkg.BackendSGProvider, sgResolver networkingpkg.SecurityGroupResolver,
+		blocklistPrefixListProvider networkingpkg.BlocklistPrefixListProvider) *groupDeployer {
+		elbv2TaggingManager := elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), controllerConfig.StrictTagEnforcement(), logger)
+		modelBuilder := ingress.NewDefaultModelBuilder(k8sClient, eventRecorder,
+			cloud.EC2(), cloud.ACM(), controllerConfig.IngressConfig.CertDiscoveryTags, certDiscoveryMetrics,
+			controllerConfig.IngressConfig.CertRotationLeadtime, cloud.Route53(), controllerConfig.IngressConfig.CertRequestHostedZoneID,
+			annotationParser, subnetsResolver,
+			authConfigBuilder, enhancedBackendBuilder, trackingProvider, elbv2TaggingManager, controllerConfig.FeatureGates,
+			cloud.VpcID(), controllerConfig.ClusterName, defaultTagsProvider, controllerConfig.ExternalManagedTags, controllerConfig.AllowedAWSRoleARNs,
+			dynamicConfigProvider, backendSGProvider, sgResolver, blocklistPrefixListProvider,
+			awsSecretsProvider, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), logger)
+		stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
+			controllerConfig, ingressTagPrefix, listenerRulesFetchMetrics, mutationVerificationMetrics, describeCacheMetrics, logger)
+		stackAbandoner := deploy.NewDefaultStackAbandoner(cloud, k8sClient, networkingSGManager, controllerConfig, ingressTagPrefix, logger)
+		var stackENITracker deploy.StackENITracker
+		if controllerConfig.FeatureGates.Enabled(config.LoadBalancerENITracking) {
+			stackENITracker = deploy.NewDefaultStackENITracker(cloud, controllerConfig, ingressTagPrefix, divergenceKindIngressGroup, stackENIMetrics, logger)
+		}
+		return &groupDeployer{
+			modelBuilder:         modelBuilder,
+			stackDeployer:        stackDeployer,
+			stackAbandoner:       stackAbandoner,
+			stackDeletionDelayer: deploy.NewDefaultStackDeletionDelayer(cloud, controllerConfig, ingressTagPrefix, logger),
+			stackENITracker:      stackENITracker,
+			backendSGProvider:    backendSGProvider,
+			cloudWatchClient:     cloud.CloudWatch(),
+			ec2Client:            cloud.EC2(),
+		}
+	}
+	// the dry run deployer plans the changes with a dedicated backend securityGroup provider,
+	// so that the backend securityGroup planned to be created isn't shared with the default deployer.
+	buildDryRunDeployer := func(cloud aws.Cloud, subnetsResolver networkingpkg.SubnetsResolver,
+		backendSG string, sgResolver networkingpkg.SecurityGroupResolver,
+		blocklistPrefixListProvider networkingpkg.BlocklistPrefixListProvider) *groupDeployer {
+		dryRunCloud := dryrun.NewCloud(cloud)
+		backendSGProvider := networkingpkg.NewBackendSGProvider(controllerConfig.ClusterName, backendSG, controllerConfig.BackendSecurityGroupShareKey,
+			dryRunCloud.VpcID(), dryRunCloud.EC2(), k8sClient, defaultTagsProvider, controllerConfig.ExternalManagedTags,
+			controllerConfig.StrictTagEnforcement(), controllerConfig.BackendSecurityGroupReleaseGracePeriod, eventRecorder, logger)
+		deployer := buildDeployer(dryRunCloud, nil, nil, subnetsResolver, backendSGProvider, sgResolver, blocklistPrefixListProvider)
+		deployer.stackDeployer = deploy.NewDryRunStackDeployer(cloud, k8sClient, controllerConfig, ingressTagPrefix, logger)
+		return deployer
+	}
+	// the networking components are rebuilt with EC2 client of the assumed IAM role,
+	// so that subnets and securityGroups are resolved and managed within the target account.
+	// the backend securityGroup is always auto-generated within the target account.
+	// the blocklist prefix lists aren't applied, as they're owned by the controller's account.
+	buildAssumedRoleDeployer := func(roleARN string) *groupDeployer {
+		assumedRoleCloud := cloud.AssumeRole(roleARN)
+		ec2Client := assumedRoleCloud.EC2()
+		sgManager := networkingpkg.NewDefaultSecurityGroupManager(ec2Client, logger)
+		sgReconciler := networkingpkg.NewDefaultSecurityGroupReconciler(sgManager, sgDriftReporter, logger)
+		azInfoProvider := networkingpkg.NewDefaultAZInfoProvider(ec2Client, logger)
+		subnetsDiscoveryStrategy := subnetsDiscoveryStrategyFactory(ec2Client, assumedRoleCloud.VpcID(), controllerConfig.ClusterName, logger)
+		subnetsResolver := networkingpkg.NewDefaultSubnetsResolver(azInfoProvider, ec2Client, assumedRoleCloud.VpcID(), controllerConfig.ClusterName, subnetsDiscoveryStrategy,
+			controllerConfig.SubnetDiscoverySelector(), controllerConfig.AllowedAvailabilityZones, logger)
+		backendSGProvider := networkingpkg.NewBackendSGProvider(controllerConfig.ClusterName, "", controllerConfig.BackendSecurityGroupShareKey,
+			assumedRoleCloud.VpcID(), ec2Client, k8sClient, defaultTagsProvider, controllerConfig.ExternalManagedTags,
+			controllerConfig.StrictTagEnforcement(), controllerConfig.BackendSecurityGroupReleaseGracePeriod, eventRecorder, logger)
+		sgResolver := networkingpkg.NewDefaultSecurityGroupResolver(ec2Client, assumedRoleCloud.VpcID())
+		deployer := buildDeployer(assumedRoleCloud, sgManager, sgReconciler, subnetsResolver, backendSGProvider, sgResolver, nil)
+		deployer.dryRunDeployer = buildDryRunDeployer(assumedRoleCloud, subnetsResolver, "", sgResolver, nil)
+		return deployer
+	}
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler,
-		controllerConfig, ingressTagPrefix, logger)
 	classLoader := ingress.NewDefaultClassLoader(k8sClient, true)
 	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(controllerConfig.IngressConfig.IngressClass)
 	manageIngressesWithoutIngressClass := controllerConfig.IngressConfig.IngressClass == ""
-	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass)
+	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass,
+		controllerConfig.FeatureGates.Enabled(config.IngressGroupResource))
 	groupFinalizerManager := ingress.NewDefaultFinalizerManager(finalizerManager)
+	var resourceARNsExporter ingress.ResourceARNsExporter
+	if controllerConfig.IngressConfig.EnableResourceARNsConfigMap {
+		resourceARNsExporter = ingress.NewDefaultResourceARNsExporter(k8sClient, logger)
+	}
+	var modelDiffLogger *deploy.ModelDiffLogger
+	if controllerConfig.LogModelDiff {
+		modelDiffLogger = deploy.NewModelDiffLogger(logger)
+	}
+	defaultDeployer := buildDeployer(cloud, networkingSGManager, networkingSGReconciler, subnetsResolver,
+		backendSGProvider, sgResolver, blocklistPrefixListProvider)
+	defaultDeployer.dryRunDeployer = buildDryRunDeployer(cloud, subnetsResolver, controllerConfig.BackendSecurityGroup, sgResolver, blocklistPrefixListProvider)
 
 	return &groupReconciler{
-		k8sClient:         k8sClient,
-		eventRecorder:     eventRecorder,
-		referenceIndexer:  referenceIndexer,
-		modelBuilder:      modelBuilder,
-		stackMarshaller:   stackMarshaller,
-		stackDeployer:     stackDeployer,
-		backendSGProvider: backendSGProvider,
+		k8sClient:                k8sClient,
+		eventRecorder:            eventRecorder,
+		referenceIndexer:         referenceIndexer,
+		annotationParser:         annotationParser,
+		defaultDeployer:          defaultDeployer,
+		allowedAWSRoleARNs:       sets.NewString(controllerConfig.AllowedAWSRoleARNs...),
+		assumedRoleDeployers:     make(map[string]*groupDeployer),
+		assumedRoleARNByGroup:    make(map[ingress.GroupID]string),
+		buildAssumedRoleDeployer: buildAssumedRoleDeployer,
+		stackMarshaller:          stackMarshaller,
+		modelDiffLogger:          modelDiffLogger,
+		defaultTagsProvider:      defaultTagsProvider,
 
 		groupLoader:           groupLoader,
 		groupFinalizerManager: groupFinalizerManager,
+		resourceARNsExporter:  resourceARNsExporter,
+		ruleMetricsExporter:   ruleMetricsExporter,
+		awsSecretsProvider:    awsSecretsProvider,
+		reconcileMetrics:      reconcileMetrics,
+		modelHashMetrics:      modelHashMetrics,
+		apiCallReporter:       apiCallReporter,
+		divergenceReporter:    divergenceReporter,
+		shardCoordinator:      shardCoordinator,
+		ownershipPublisher:    ownershipPublisher,
+		debugLoggingTargets:   debugLoggingTargets,
 		logger:                logger,
 
-		maxConcurrentReconciles: controllerConfig.IngressConfig.MaxConcurrentReconciles,
+		maxConcurrentReconciles:       controllerConfig.IngressConfig.MaxConcurrentReconciles,
+		enableTargetGroupWeightPolicy: controllerConfig.FeatureGates.Enabled(config.TargetGroupWeightPolicy),
+		enableFrontendSGRule:          controllerConfig.FeatureGates.Enabled(config.FrontendSecurityGroupRule),
+		enableIngressGroupResource:    controllerConfig.FeatureGates.Enabled(config.IngressGroupResource),
+		enableLoadBalancerRoute:       controllerConfig.FeatureGates.Enabled(config.LoadBalancerRoute),
+		dryRun:                        controllerConfig.DryRun || controllerConfig.ShadowMode,
+		certDiscoveryResyncPeriod:     controllerConfig.IngressConfig.CertDiscoveryResyncPeriod,
 	}
 }
 
 // GroupReconciler reconciles a IngressGroup
 type groupReconciler struct {
-	k8sClient         client.Client
-	eventRecorder     record.EventRecorder
-	referenceIndexer  ingress.ReferenceIndexer
-	modelBuilder      ingress.ModelBuilder
-	stackMarshaller   deploy.StackMarshaller
-	stackDeployer     deploy.StackDeployer
-	backendSGProvider networkingpkg.BackendSGProvider
-	secretsManager    k8s.SecretsManager
+	k8sClient        client.Client
+	eventRecorder    record.EventRecorder
+	referenceIndexer ingress.ReferenceIndexer
+	annotationParser annotations.Parser
+	defaultDeployer  *groupDeployer
+	// allowedAWSRoleARNs are the IAM roles the aws-role-arn annotation can specify.
+	allowedAWSRoleARNs sets.String
+	// assumedRoleDeployers caches the groupDeployer per assumed IAM role ARN,
+	// they're evicted once no IngressGroup is provisioned with the IAM role anymore.
+	assumedRoleDeployers map[string]*groupDeployer
+	// assumedRoleARNByGroup tracks the IAM role each IngressGroup is provisioned with, if any.
+	assumedRoleARNByGroup     map[ingress.GroupID]string
+	assumedRoleDeployersMutex sync.Mutex
+	buildAssumedRoleDeployer  func(roleARN string) *groupDeployer
+	stackMarshaller           deploy.StackMarshaller
+	// modelDiffLogger logs the diff of the model of IngressGroups between reconciles if enabled, it's nil otherwise.
+	modelDiffLogger     *deploy.ModelDiffLogger
+	secretsManager      k8s.SecretsManager
+	defaultTagsProvider networkingpkg.DefaultTagsProvider
 
 	groupLoader           ingress.GroupLoader
 	groupFinalizerManager ingress.FinalizerManager
-	logger                logr.Logger
+	resourceARNsExporter  ingress.ResourceARNsExporter
+	ruleMetricsExporter   ingress.RuleMetricsExporter
+	awsSecretsProvider    ingress.AWSSecretsProvider
+	reconcileMetrics      *lbcmetrics.ReconcileMetrics
+	modelHashMetrics      *ingress.ModelHashMetrics
+	// apiCallReporter reports the AWS API calls per reconcile if enabled, it's nil otherwise.
+	apiCallReporter *lbcmetrics.ReconcileAPICallReporter
+	// divergenceReporter reports the planned changes instead of events in shadow mode, it's nil otherwise.
+	divergenceReporter audit.DivergenceReporter
+	// shardCoordinator decides the IngressGroups reconciled by this replica if sharding is enabled, it's nil otherwise.
+	shardCoordinator sharding.Coordinator
+	// ownershipPublisher publishes the ownership of managed resources if ownership events are enabled, it's nil otherwise.
+	ownershipPublisher inventory.OwnershipPublisher
+	// debugLoggingTargets raises the log verbosity of the resources requesting debug logging if enabled, it's nil otherwise.
+	debugLoggingTargets *runtime.DebugLoggingTargets
+	logger              logr.Logger
 
-	maxConcurrentReconciles int
+	maxConcurrentReconciles       int
+	enableTargetGroupWeightPolicy bool
+	enableFrontendSGRule          bool
+	enableIngressGroupResource    bool
+	enableLoadBalancerRoute       bool
+	// dryRun specifies whether to plan the changes for all IngressGroups without applying them
+	dryRun bool
+	// certDiscoveryResyncPeriod specifies the period to re-discover certificates for IngressGroups relying on certificate auto-discovery
+	certDiscoveryResyncPeriod time.Duration
+}
+
+// groupDeployer builds and deploys the model for IngressGroups within an AWS account.
+type groupDeployer struct {
+	modelBuilder   ingress.ModelBuilder
+	stackDeployer  deploy.StackDeployer
+	stackAbandoner deploy.StackAbandoner
+	// stackDeletionDelayer delays the deletion of internet-facing load balancers for DNS caches to expire.
+	stackDeletionDelayer deploy.StackDeletionDelayer
+	// stackENITracker tracks the network interfaces of load balancers if enabled, it's nil otherwise.
+	stackENITracker   deploy.StackENITracker
+	backendSGProvider networkingpkg.BackendSGProvider
+	cloudWatchClient  services.CloudWatch
+	ec2Client         services.EC2
+	// dryRunDeployer plans the changes within the same AWS account without applying them.
+	dryRunDeployer *groupDeployer
 }
 
 // +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=frontendsecuritygrouprules,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=ingressgroups,verbs=get;list;watch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerroutes,verbs=get;list;watch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingressclasses,verbs=get;list;watch
@@ -114,9 +285,18 @@ type groupReconciler struct {
 // +kubebuilder:rbac:groups=extensions,resources=ingresses/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;patch;delete
 
 func (r *groupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+	if r.shardCoordinator != nil && !r.shardCoordinator.Owns(req.NamespacedName) {
+		return ctrl.Result{}, nil
+	}
+	startTime := time.Now()
+	ctx, apiCallCounter := r.apiCallReporter.StartReconcile(ctx)
+	err := r.reconcile(ctx, req)
+	r.reconcileMetrics.ObserveReconcile(controllerName, startTime, err)
+	r.apiCallReporter.ReportReconcile(controllerName, req.NamespacedName, apiCallCounter)
+	return runtime.HandleReconcileError(err, r.logger)
 }
 
 func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
@@ -125,28 +305,112 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 	if err != nil {
 		return err
 	}
+	r.configureDebugLogging(ingGroup)
+	dryRun, err := r.isDryRun(ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if dryRun {
+		return r.planModel(ctx, ingGroup)
+	}
+	ctx = ingress.ContextWithCertDiscoveryUsage(ctx)
+	ctx = ingress.ContextWithCertRequestTracking(ctx)
+	if err := r.reconcileIngressGroup(ctx, ingGroup); err != nil {
+		r.updateIngressGroupReconcileStatus(ctx, ingGroup, status.NewFailedReconcileStatus(err, time.Now()))
+		return err
+	}
+	if len(ingGroup.Members) == 0 {
+		r.releaseGroupDeployer(ingGroup.ID)
+	}
+	// requested certificates are attached once they're issued.
+	if ingress.CertRequestPending(ctx) {
+		return runtime.NewRequeueNeededAfter("certificate validation", ingress.CertValidationPollInterval)
+	}
+	// newly issued certificates are only attached once the certificates are re-discovered.
+	if r.certDiscoveryResyncPeriod > 0 && ingress.CertDiscoveryUsed(ctx) {
+		return runtime.NewRequeueNeededAfter("certificate re-discovery", r.certDiscoveryResyncPeriod)
+	}
+	return nil
+}
 
+func (r *groupReconciler) reconcileIngressGroup(ctx context.Context, ingGroup ingress.Group) error {
+	ingGroupID := ingGroup.ID
 	if err := r.groupFinalizerManager.AddGroupFinalizer(ctx, ingGroupID, ingGroup.Members); err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAddFinalizer, fmt.Sprintf("Failed add finalizer due to %v", err))
 		return err
 	}
-	_, lb, err := r.buildAndDeployModel(ctx, ingGroup)
-	if err != nil {
-		return err
+	retain := false
+	if len(ingGroup.Members) == 0 {
+		var err error
+		if retain, err = ingress.IsRetainRequested(r.annotationParser, ingGroup); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+			return err
+		}
+	}
+	var stack core.Stack
+	var lb *elbv2model.LoadBalancer
+	if retain {
+		if err := r.abandonModel(ctx, ingGroup); err != nil {
+			return err
+		}
+	} else {
+		if len(ingGroup.Members) == 0 {
+			if err := r.delayModelDeletion(ctx, ingGroup); err != nil {
+				return err
+			}
+		}
+		var err error
+		if stack, lb, err = r.buildAndDeployModel(ctx, ingGroup); err != nil {
+			return err
+		}
 	}
 
 	if len(ingGroup.Members) > 0 && lb != nil {
-		lbDNS, err := lb.DNSName().Resolve(ctx)
+		lbDNSs, err := r.resolveLoadBalancerDNSNames(ctx, stack, lb)
+		if err != nil {
+			return err
+		}
+		lbCoIPs, err := r.resolveLoadBalancerCustomerOwnedIPs(ctx, ingGroup, lb)
 		if err != nil {
 			return err
 		}
-		if err := r.updateIngressGroupStatus(ctx, ingGroup, lbDNS); err != nil {
+		if err := r.updateIngressGroupStatus(ctx, ingGroup, lbDNSs, lbCoIPs); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+			return err
+		}
+		if r.resourceARNsExporter != nil {
+			if err := r.resourceARNsExporter.Export(ctx, ingGroup, stack); err != nil {
+				r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedExportResourceARNs, fmt.Sprintf("Failed export resource ARNs due to %v", err))
+				return err
+			}
+		}
+	}
+	if len(ingGroup.Members) > 0 {
+		reconcileStatus, err := status.NewSucceededReconcileStatus(ctx, stack, lb, time.Now())
+		if err != nil {
+			return err
+		}
+		if reconcileStatus.NetworkInterfaceIDs, err = r.trackModelENIs(ctx, ingGroup, stack); err != nil {
+			return err
+		}
+		if err := r.updateIngressGroupReconcileStatus(ctx, ingGroup, reconcileStatus); err != nil {
 			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
 		}
 	}
 
 	if len(ingGroup.InactiveMembers) > 0 {
+		if r.resourceARNsExporter != nil {
+			if err := r.resourceARNsExporter.Cleanup(ctx, ingGroup.InactiveMembers); err != nil {
+				return err
+			}
+		}
+		for _, inactiveMember := range ingGroup.InactiveMembers {
+			if err := status.RemoveAnnotations(ctx, r.k8sClient, inactiveMember, status.AnnotationPrefixIngress); err != nil {
+				return err
+			}
+		}
 		if err := r.groupFinalizerManager.RemoveGroupFinalizer(ctx, ingGroupID, ingGroup.InactiveMembers); err != nil {
 			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedRemoveFinalizer, fmt.Sprintf("Failed remove finalizer due to %v", err))
 			return err
@@ -158,7 +422,12 @@ func (r *groupReconciler) reconcile(ctx context.Context, req ctrl.Request) error
 }
 
 func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingress.Group) (core.Stack, *elbv2model.LoadBalancer, error) {
-	stack, lb, secrets, backendSGRequired, err := r.modelBuilder.Build(ctx, ingGroup)
+	deployer, err := r.getGroupDeployer(ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return nil, nil, err
+	}
+	stack, lb, secrets, backendSGRequired, err := deployer.modelBuilder.Build(ctx, ingGroup)
 	if err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
 		return nil, nil, err
@@ -169,49 +438,339 @@ func (r *groupReconciler) buildAndDeployModel(ctx context.Context, ingGroup ingr
 		return nil, nil, err
 	}
 	r.logger.Info("successfully built model", "model", stackJSON)
+	r.modelDiffLogger.Log(stack.StackID(), stackJSON)
+	// the network interfaces of the load balancer are tracked before it's deleted, so that their release can be verified afterwards.
+	if len(ingGroup.Members) == 0 && deployer.stackENITracker != nil {
+		if _, err := deployer.stackENITracker.Track(ctx, stack); err != nil {
+			r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+			return nil, nil, err
+		}
+	}
 
-	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
+	deployCtx := audit.ContextWithMutationRecorder(ctx, r.buildIngressGroupMutationRecorder(ingGroup))
+	if err := deployer.stackDeployer.Deploy(deployCtx, stack); err != nil {
 		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
 		return nil, nil, err
 	}
 	r.logger.Info("successfully deployed model", "ingressGroup", ingGroup.ID)
+	if len(ingGroup.Members) == 0 {
+		r.modelDiffLogger.Forget(stack.StackID())
+		r.modelHashMetrics.Forget(ingGroup.ID)
+	} else if modelHash, err := deploy.HashModelJSON(stackJSON); err != nil {
+		r.logger.Error(err, "failed to hash model", "ingressGroup", ingGroup.ID)
+	} else {
+		r.modelHashMetrics.Observe(ingGroup.ID, modelHash)
+	}
+	if len(ingGroup.Members) == 0 {
+		if err := r.awaitModelENIsRelease(ctx, ingGroup, deployer, stack); err != nil {
+			return nil, nil, err
+		}
+	}
 	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), secrets)
 	var inactiveResources []types.NamespacedName
 	inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(ingGroup.InactiveMembers)...)
 	if !backendSGRequired {
 		inactiveResources = append(inactiveResources, k8s.ToSliceOfNamespacedNames(ingGroup.Members)...)
 	}
-	if err := r.backendSGProvider.Release(ctx, networkingpkg.ResourceTypeIngress, inactiveResources); err != nil {
+	if err := deployer.backendSGProvider.Release(ctx, networkingpkg.ResourceTypeIngress, inactiveResources); err != nil {
 		return nil, nil, err
 	}
+	if r.ruleMetricsExporter != nil {
+		if err := r.ruleMetricsExporter.Track(ctx, ingGroup, stack, deployer.cloudWatchClient); err != nil {
+			return nil, nil, err
+		}
+	}
+	if r.ownershipPublisher != nil {
+		mappings, err := inventory.BuildOwnershipMappings(ctx, stack, inventory.OwnerKindIngress, k8s.ToSliceOfNamespacedNames(ingGroup.Members))
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := r.ownershipPublisher.Publish(ctx, stack.StackID(), mappings); err != nil {
+			return nil, nil, err
+		}
+	}
 	return stack, lb, nil
 }
 
+// abandonModel leaves the AWS resources of IngressGroup without members intact, and stops managing them.
+// the shared backend securityGroup isn't released, as it's still attached to the retained load balancer.
+func (r *groupReconciler) abandonModel(ctx context.Context, ingGroup ingress.Group) error {
+	deployer, err := r.getGroupDeployer(ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAbandonModel, fmt.Sprintf("Failed abandon model due to %v", err))
+		return err
+	}
+	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
+	if err := deployer.stackAbandoner.Abandon(ctx, stack); err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedAbandonModel, fmt.Sprintf("Failed abandon model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully abandoned model", "ingressGroup", ingGroup.ID)
+	r.modelDiffLogger.Forget(stack.StackID())
+	r.modelHashMetrics.Forget(ingGroup.ID)
+	r.secretsManager.MonitorSecrets(ingGroup.ID.String(), nil)
+	if r.ruleMetricsExporter != nil {
+		if err := r.ruleMetricsExporter.Track(ctx, ingGroup, stack, deployer.cloudWatchClient); err != nil {
+			return err
+		}
+	}
+	if r.ownershipPublisher != nil {
+		if err := r.ownershipPublisher.Publish(ctx, stack.StackID(), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delayModelDeletion requeues the IngressGroup without members until its internet-facing load balancer can be deleted,
+// the finalizers of its inactive members are held meanwhile.
+func (r *groupReconciler) delayModelDeletion(ctx context.Context, ingGroup ingress.Group) error {
+	deletionTime, deleted := ingress.LatestDeletionTime(ingGroup)
+	if !deleted {
+		return nil
+	}
+	deployer, err := r.getGroupDeployer(ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
+	delayedUntil, err := deployer.stackDeletionDelayer.DelayedUntil(ctx, stack, deletionTime)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	if delayedUntil.IsZero() {
+		return nil
+	}
+	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonDeletionDelayed,
+		fmt.Sprintf("Delaying deletion of internet-facing load balancer until %v for DNS caches to expire", delayedUntil.UTC().Format(time.RFC3339)))
+	return runtime.NewRequeueNeededAfter("load balancer deletion delayed", time.Until(delayedUntil))
+}
+
+// trackModelENIs returns the IDs of the network interfaces of the load balancer of IngressGroup if enabled.
+func (r *groupReconciler) trackModelENIs(ctx context.Context, ingGroup ingress.Group, stack core.Stack) ([]string, error) {
+	deployer, err := r.getGroupDeployer(ingGroup)
+	if err != nil {
+		return nil, err
+	}
+	if deployer.stackENITracker == nil {
+		return nil, nil
+	}
+	return deployer.stackENITracker.Track(ctx, stack)
+}
+
+// awaitModelENIsRelease requeues the IngressGroup without members until the network interfaces of its deleted load balancer are released if enabled,
+// the finalizers of its inactive members are held meanwhile.
+func (r *groupReconciler) awaitModelENIsRelease(ctx context.Context, ingGroup ingress.Group, deployer *groupDeployer, stack core.Stack) error {
+	if deployer.stackENITracker == nil {
+		return nil
+	}
+	eniIDs, err := deployer.stackENITracker.PendingRelease(ctx, stack)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	if len(eniIDs) == 0 {
+		return nil
+	}
+	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, k8s.IngressEventReasonENIReleasePending,
+		fmt.Sprintf("Waiting for network interfaces of deleted load balancer to be released: %v", strings.Join(eniIDs, ",")))
+	return runtime.NewRequeueNeededAfter("load balancer network interfaces not released", eniReleasePollInterval)
+}
+
+// planModel builds the model for IngressGroup and reports the changes to deploy it without applying them.
+// finalizers, status and backend securityGroup of IngressGroup are left untouched.
+func (r *groupReconciler) planModel(ctx context.Context, ingGroup ingress.Group) error {
+	deployer, err := r.getGroupDeployer(ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	planRecorder := audit.NewPlanRecorder()
+	planCtx := audit.ContextWithMutationRecorder(ctx, planRecorder)
+	stack, _, _, _, err := deployer.dryRunDeployer.modelBuilder.Build(planCtx, ingGroup)
+	if err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if err := deployer.dryRunDeployer.stackDeployer.Deploy(planCtx, stack); err != nil {
+		r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeWarning, k8s.IngressEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	plan := planRecorder.Plan()
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("successfully planned model", "ingressGroup", ingGroup.ID, "plan", string(planJSON))
+	if r.divergenceReporter != nil {
+		return r.divergenceReporter.ReportDivergence(ctx, divergenceKindIngressGroup, types.NamespacedName(ingGroup.ID), plan)
+	}
+	r.recordIngressGroupEvent(ctx, ingGroup, corev1.EventTypeNormal, audit.EventReasonDryRunPlan, audit.RenderPlanMessage(plan))
+	return nil
+}
+
+// isDryRun checks whether the changes for IngressGroup should be planned without applying them.
+func (r *groupReconciler) isDryRun(ingGroup ingress.Group) (bool, error) {
+	if r.dryRun {
+		return true, nil
+	}
+	return ingress.IsDryRunRequested(r.annotationParser, ingGroup.WithDefaultAnnotations())
+}
+
+// configureDebugLogging raises the log verbosity of the Ingresses requesting debug logging,
+// along with their IngressGroup once any of its members requests it.
+func (r *groupReconciler) configureDebugLogging(ingGroup ingress.Group) {
+	if r.debugLoggingTargets == nil {
+		return
+	}
+	var groupAnnotations map[string]string
+	for _, member := range ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		if err := r.debugLoggingTargets.Configure(member.Ing.Annotations, ingKey.String()); err != nil {
+			r.logger.Info("ignoring invalid debug logging annotation", "ingress", ingKey, "error", err)
+			continue
+		}
+		if _, exists := member.Ing.Annotations[runtime.AnnotationKeyDebugLoggingUntil]; exists && groupAnnotations == nil {
+			groupAnnotations = member.Ing.Annotations
+		}
+	}
+	_ = r.debugLoggingTargets.Configure(groupAnnotations, ingGroup.ID.String())
+}
+
+// getGroupDeployer returns the groupDeployer for the AWS account that IngressGroup is provisioned into.
+func (r *groupReconciler) getGroupDeployer(ingGroup ingress.Group) (*groupDeployer, error) {
+	roleARN, err := ingress.ResolveAWSRoleARN(r.annotationParser, ingGroup.WithDefaultAnnotations(), r.allowedAWSRoleARNs)
+	if err != nil {
+		return nil, err
+	}
+	r.assumedRoleDeployersMutex.Lock()
+	defer r.assumedRoleDeployersMutex.Unlock()
+	r.trackAssumedRoleLocked(ingGroup.ID, roleARN)
+	if roleARN == "" {
+		return r.defaultDeployer, nil
+	}
+	if deployer, exists := r.assumedRoleDeployers[roleARN]; exists {
+		return deployer, nil
+	}
+	deployer := r.buildAssumedRoleDeployer(roleARN)
+	r.assumedRoleDeployers[roleARN] = deployer
+	return deployer, nil
+}
+
+// releaseGroupDeployer stops tracking the IAM role of IngressGroup once it's deleted.
+func (r *groupReconciler) releaseGroupDeployer(ingGroupID ingress.GroupID) {
+	r.assumedRoleDeployersMutex.Lock()
+	defer r.assumedRoleDeployersMutex.Unlock()
+	r.trackAssumedRoleLocked(ingGroupID, "")
+}
+
+// trackAssumedRoleLocked records the IAM role IngressGroup is provisioned with, empty if none,
+// and evicts the groupDeployers of IAM roles no IngressGroup is provisioned with anymore.
+func (r *groupReconciler) trackAssumedRoleLocked(ingGroupID ingress.GroupID, roleARN string) {
+	if roleARN == "" {
+		delete(r.assumedRoleARNByGroup, ingGroupID)
+	} else {
+		r.assumedRoleARNByGroup[ingGroupID] = roleARN
+	}
+	referencedRoleARNs := sets.NewString()
+	for _, referencedRoleARN := range r.assumedRoleARNByGroup {
+		referencedRoleARNs.Insert(referencedRoleARN)
+	}
+	for cachedRoleARN := range r.assumedRoleDeployers {
+		if !referencedRoleARNs.Has(cachedRoleARN) {
+			delete(r.assumedRoleDeployers, cachedRoleARN)
+		}
+	}
+}
+
 func (r *groupReconciler) recordIngressGroupEvent(_ context.Context, ingGroup ingress.Group, eventType string, reason string, message string) {
 	for _, member := range ingGroup.Members {
 		r.eventRecorder.Event(member.Ing, eventType, reason, message)
 	}
 }
 
-func (r *groupReconciler) updateIngressGroupStatus(ctx context.Context, ingGroup ingress.Group, lbDNS string) error {
+// buildIngressGroupMutationRecorder builds the recorder that publishes AWS mutations as Events on active and inactive members of ingGroup.
+func (r *groupReconciler) buildIngressGroupMutationRecorder(ingGroup ingress.Group) audit.MutationRecorder {
+	owners := make([]k8sruntime.Object, 0, len(ingGroup.Members)+len(ingGroup.InactiveMembers))
 	for _, member := range ingGroup.Members {
-		if err := r.updateIngressStatus(ctx, lbDNS, member.Ing); err != nil {
+		owners = append(owners, member.Ing)
+	}
+	for _, inactiveMember := range ingGroup.InactiveMembers {
+		owners = append(owners, inactiveMember)
+	}
+	return audit.NewEventMutationRecorder(r.eventRecorder, owners...)
+}
+
+// resolveLoadBalancerDNSNames resolves the DNS names to report in Ingress status.
+// The primary ALB always comes first, followed by additional LoadBalancers within stack(e.g. the frontend NLB).
+func (r *groupReconciler) resolveLoadBalancerDNSNames(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer) ([]string, error) {
+	lbDNS, err := lb.DNSName().Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lbDNSs := []string{lbDNS}
+	var resLBs []*elbv2model.LoadBalancer
+	if err := stack.ListResources(&resLBs); err != nil {
+		return nil, err
+	}
+	sort.Slice(resLBs, func(i, j int) bool {
+		return resLBs[i].ID() < resLBs[j].ID()
+	})
+	for _, resLB := range resLBs {
+		if resLB.ID() == lb.ID() {
+			continue
+		}
+		resLBDNS, err := resLB.DNSName().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lbDNSs = append(lbDNSs, resLBDNS)
+	}
+	return lbDNSs, nil
+}
+
+// resolveLoadBalancerCustomerOwnedIPs resolves the customer-owned IPs to report in Ingress status, for ALB on Outposts with a CoIP pool.
+func (r *groupReconciler) resolveLoadBalancerCustomerOwnedIPs(ctx context.Context, ingGroup ingress.Group, lb *elbv2model.LoadBalancer) ([]string, error) {
+	if lb.Spec.CustomerOwnedIPv4Pool == nil {
+		return nil, nil
+	}
+	deployer, err := r.getGroupDeployer(ingGroup)
+	if err != nil {
+		return nil, err
+	}
+	lbARN, err := lb.LoadBalancerARN().Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ingress.ResolveCustomerOwnedIPv4Addresses(ctx, deployer.ec2Client, lbARN)
+}
+
+func (r *groupReconciler) updateIngressGroupStatus(ctx context.Context, ingGroup ingress.Group, lbDNSs []string, lbCoIPs []string) error {
+	for _, member := range ingGroup.Members {
+		if err := r.updateIngressStatus(ctx, lbDNSs, lbCoIPs, member.Ing); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (r *groupReconciler) updateIngressStatus(ctx context.Context, lbDNS string, ing *networking.Ingress) error {
-	if len(ing.Status.LoadBalancer.Ingress) != 1 ||
-		ing.Status.LoadBalancer.Ingress[0].IP != "" ||
-		ing.Status.LoadBalancer.Ingress[0].Hostname != lbDNS {
+func (r *groupReconciler) updateIngressStatus(ctx context.Context, lbDNSs []string, lbCoIPs []string, ing *networking.Ingress) error {
+	desiredLBIngresses := make([]networking.IngressLoadBalancerIngress, 0, len(lbDNSs)+len(lbCoIPs))
+	for _, lbDNS := range lbDNSs {
+		desiredLBIngresses = append(desiredLBIngresses, networking.IngressLoadBalancerIngress{
+			Hostname: lbDNS,
+		})
+	}
+	for _, lbCoIP := range lbCoIPs {
+		desiredLBIngresses = append(desiredLBIngresses, networking.IngressLoadBalancerIngress{
+			IP: lbCoIP,
+		})
+	}
+	if !equality.Semantic.DeepEqual(ing.Status.LoadBalancer.Ingress, desiredLBIngresses) {
 		ingOld := ing.DeepCopy()
-		ing.Status.LoadBalancer.Ingress = []networking.IngressLoadBalancerIngress{
-			{
-				Hostname: lbDNS,
-			},
-		}
+		ing.Status.LoadBalancer.Ingress = desiredLBIngresses
 		if err := r.k8sClient.Status().Patch(ctx, ing, client.MergeFrom(ingOld)); err != nil {
 			return errors.Wrapf(err, "failed to update ingress status: %v", k8s.NamespacedName(ing))
 		}
@@ -219,11 +778,26 @@ func (r *groupReconciler) updateIngressStatus(ctx context.Context, lbDNS string,
 	return nil
 }
 
+// updateIngressGroupReconcileStatus updates the reconcile status annotations of IngressGroup members.
+// failures to record a failed reconcile are only logged, so that the original error is reported.
+func (r *groupReconciler) updateIngressGroupReconcileStatus(ctx context.Context, ingGroup ingress.Group, reconcileStatus status.ReconcileStatus) error {
+	for _, member := range ingGroup.Members {
+		if err := status.UpdateAnnotations(ctx, r.k8sClient, member.Ing, status.AnnotationPrefixIngress, reconcileStatus); err != nil {
+			if reconcileStatus.Err != nil {
+				r.logger.Error(err, "failed to record reconcile failure", "ingress", k8s.NamespacedName(member.Ing))
+				continue
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *groupReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, clientSet *kubernetes.Clientset) error {
-	c, err := controller.New(controllerName, mgr, controller.Options{
+	c, err := sharding.NewController(controllerName, mgr, controller.Options{
 		MaxConcurrentReconciles: r.maxConcurrentReconciles,
 		Reconciler:              r,
-	})
+	}, r.shardCoordinator)
 	if err != nil {
 		return err
 	}
@@ -289,7 +863,7 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	secretEventsChan := make(chan event.GenericEvent)
 	ingEventHandler := eventhandlers.NewEnqueueRequestsForIngressEvent(r.groupLoader, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("ingress"))
-	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+	svcEventHandler := eventhandlers.NewEnqueueRequestsForServiceEvent(ingEventChan, r.k8sClient, r.eventRecorder, r.enableLoadBalancerRoute,
 		r.logger.WithName("eventHandlers").WithName("service"))
 	secretEventHandler := eventhandlers.NewEnqueueRequestsForSecretEvent(ingEventChan, svcEventChan, r.k8sClient, r.eventRecorder,
 		r.logger.WithName("eventHandlers").WithName("secret"))
@@ -302,21 +876,59 @@ func (r *groupReconciler) setupWatches(_ context.Context, c controller.Controlle
 	if err := c.Watch(&source.Kind{Type: &networking.Ingress{}}, ingEventHandler); err != nil {
 		return err
 	}
+	if err := c.Watch(&source.Channel{Source: r.defaultDeployer.backendSGProvider.RecoveryEvents(networkingpkg.ResourceTypeIngress)}, ingEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Channel{Source: r.defaultTagsProvider.ChangeEvents(networkingpkg.ResourceTypeIngress)}, ingEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Channel{Source: r.awsSecretsProvider.RotationEvents()}, ingEventHandler); err != nil {
+		return err
+	}
+	if r.shardCoordinator != nil {
+		if err := c.Watch(&source.Channel{Source: r.shardCoordinator.AcquisitionEvents(networkingpkg.ResourceTypeIngress)}, ingEventHandler); err != nil {
+			return err
+		}
+	}
 	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
 		return err
 	}
 	if err := c.Watch(&source.Channel{Source: secretEventsChan}, secretEventHandler); err != nil {
 		return err
 	}
+	if r.enableTargetGroupWeightPolicy {
+		tgWeightPolicyEventHandler := eventhandlers.NewEnqueueRequestsForTargetGroupWeightPolicyEvent(ingEventChan, r.k8sClient, r.eventRecorder,
+			r.logger.WithName("eventHandlers").WithName("targetGroupWeightPolicy"))
+		if err := c.Watch(&source.Kind{Type: &elbv2api.TargetGroupWeightPolicy{}}, tgWeightPolicyEventHandler); err != nil {
+			return err
+		}
+	}
+	if r.enableFrontendSGRule {
+		fsgrEventHandler := eventhandlers.NewEnqueueRequestsForFrontendSecurityGroupRuleEvent(
+			r.logger.WithName("eventHandlers").WithName("frontendSecurityGroupRule"))
+		if err := c.Watch(&source.Kind{Type: &elbv2api.FrontendSecurityGroupRule{}}, fsgrEventHandler); err != nil {
+			return err
+		}
+	}
+	if r.enableIngressGroupResource {
+		ingGroupEventHandler := eventhandlers.NewEnqueueRequestsForIngressGroupEvent(ingEventChan, r.k8sClient,
+			r.logger.WithName("eventHandlers").WithName("ingressGroup"))
+		if err := c.Watch(&source.Kind{Type: &elbv2v1alpha1.IngressGroup{}}, ingGroupEventHandler); err != nil {
+			return err
+		}
+	}
+	if r.enableLoadBalancerRoute {
+		lbRouteEventHandler := eventhandlers.NewEnqueueRequestsForLoadBalancerRouteEvent(
+			r.logger.WithName("eventHandlers").WithName("loadBalancerRoute"))
+		if err := c.Watch(&source.Kind{Type: &elbv2api.LoadBalancerRoute{}}, lbRouteEventHandler); err != nil {
+			return err
+		}
+	}
 	if ingressClassResourceAvailable {
-		ingClassEventChan := make(chan event.GenericEvent)
-		ingClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassParamsEvent(ingClassEventChan, r.k8sClient, r.eventRecorder,
+		ingClassParamsEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassParamsEvent(ingEventChan, r.k8sClient, r.eventRecorder,
 			r.logger.WithName("eventHandlers").WithName("ingressClassParams"))
 		ingClassEventHandler := eventhandlers.NewEnqueueRequestsForIngressClassEvent(ingEventChan, r.k8sClient, r.eventRecorder,
 			r.logger.WithName("eventHandlers").WithName("ingressClass"))
-		if err := c.Watch(&source.Channel{Source: ingClassEventChan}, ingClassEventHandler); err != nil {
-			return err
-		}
 		if err := c.Watch(&source.Kind{Type: &elbv2api.IngressClassParams{}}, ingClassParamsEventHandler); err != nil {
 			return err
 		}
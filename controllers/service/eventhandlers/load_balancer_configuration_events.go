@@ -0,0 +1,90 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForLoadBalancerConfigurationEvent constructs new enqueueRequestsForLoadBalancerConfigurationEvent.
+// Services referencing a LoadBalancerConfiguration are re-enqueued when it changes.
+func NewEnqueueRequestsForLoadBalancerConfigurationEvent(k8sClient client.Client, annotationParser annotations.Parser,
+	serviceUtils svcpkg.ServiceUtils, logger logr.Logger) *enqueueRequestsForLoadBalancerConfigurationEvent {
+	return &enqueueRequestsForLoadBalancerConfigurationEvent{
+		k8sClient:        k8sClient,
+		annotationParser: annotationParser,
+		serviceUtils:     serviceUtils,
+		logger:           logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForLoadBalancerConfigurationEvent)(nil)
+
+type enqueueRequestsForLoadBalancerConfigurationEvent struct {
+	k8sClient        client.Client
+	annotationParser annotations.Parser
+	serviceUtils     svcpkg.ServiceUtils
+	logger           logr.Logger
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	lbConfigurationNew := e.Object.(*elbv2api.LoadBalancerConfiguration)
+	h.enqueueImpactedServices(lbConfigurationNew, queue)
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	lbConfigurationOld := e.ObjectOld.(*elbv2api.LoadBalancerConfiguration)
+	lbConfigurationNew := e.ObjectNew.(*elbv2api.LoadBalancerConfiguration)
+
+	// we only care below update event:
+	//	1. LoadBalancerConfiguration spec updates
+	//	2. LoadBalancerConfiguration deletions
+	if equality.Semantic.DeepEqual(lbConfigurationOld.Spec, lbConfigurationNew.Spec) &&
+		equality.Semantic.DeepEqual(lbConfigurationOld.DeletionTimestamp.IsZero(), lbConfigurationNew.DeletionTimestamp.IsZero()) {
+		return
+	}
+	h.enqueueImpactedServices(lbConfigurationNew, queue)
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	lbConfigurationOld := e.Object.(*elbv2api.LoadBalancerConfiguration)
+	h.enqueueImpactedServices(lbConfigurationOld, queue)
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	// we don't have any generic event for LoadBalancerConfigurations.
+}
+
+func (h *enqueueRequestsForLoadBalancerConfigurationEvent) enqueueImpactedServices(lbConfiguration *elbv2api.LoadBalancerConfiguration, queue workqueue.RateLimitingInterface) {
+	svcList := &corev1.ServiceList{}
+	if err := h.k8sClient.List(context.Background(), svcList); err != nil {
+		h.logger.Error(err, "failed to fetch services")
+		return
+	}
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		lbConfigurationName := ""
+		if exists := h.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerConfiguration, &lbConfigurationName, svc.Annotations); !exists ||
+			lbConfigurationName != lbConfiguration.Name {
+			continue
+		}
+		if !h.serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		h.logger.V(1).Info("enqueue service for loadBalancerConfiguration event",
+			"loadBalancerConfiguration", lbConfiguration.Name,
+			"service", k8s.NamespacedName(svc))
+		queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(svc)})
+	}
+}
@@ -0,0 +1,73 @@
+package eventhandlers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewEnqueueRequestsForNodeEvent constructs new enqueueRequestsForNodeEvent.
+// Services are re-enqueued when the set of traffic proxy nodes might have changed, so that security group rules scoped
+// to node subnets are recomputed.
+func NewEnqueueRequestsForNodeEvent(k8sClient client.Client, serviceUtils svcpkg.ServiceUtils,
+	logger logr.Logger) *enqueueRequestsForNodeEvent {
+	return &enqueueRequestsForNodeEvent{
+		k8sClient:    k8sClient,
+		serviceUtils: serviceUtils,
+		logger:       logger,
+	}
+}
+
+var _ handler.EventHandler = (*enqueueRequestsForNodeEvent)(nil)
+
+type enqueueRequestsForNodeEvent struct {
+	k8sClient    client.Client
+	serviceUtils svcpkg.ServiceUtils
+	logger       logr.Logger
+}
+
+func (h *enqueueRequestsForNodeEvent) Create(e event.CreateEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueManagedServices(queue)
+}
+
+func (h *enqueueRequestsForNodeEvent) Update(e event.UpdateEvent, queue workqueue.RateLimitingInterface) {
+	nodeOld := e.ObjectOld.(*corev1.Node)
+	nodeNew := e.ObjectNew.(*corev1.Node)
+	if equality.Semantic.DeepEqual(nodeOld.Labels, nodeNew.Labels) &&
+		backend.IsNodeSuitableAsTrafficProxy(nodeOld) == backend.IsNodeSuitableAsTrafficProxy(nodeNew) {
+		return
+	}
+	h.enqueueManagedServices(queue)
+}
+
+func (h *enqueueRequestsForNodeEvent) Delete(e event.DeleteEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueManagedServices(queue)
+}
+
+func (h *enqueueRequestsForNodeEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+}
+
+func (h *enqueueRequestsForNodeEvent) enqueueManagedServices(queue workqueue.RateLimitingInterface) {
+	svcList := &corev1.ServiceList{}
+	if err := h.k8sClient.List(context.Background(), svcList); err != nil {
+		h.logger.Error(err, "failed to fetch services")
+		return
+	}
+	for i := range svcList.Items {
+		svc := &svcList.Items[i]
+		if !h.serviceUtils.IsServiceSupported(svc) {
+			continue
+		}
+		queue.Add(reconcile.Request{NamespacedName: k8s.NamespacedName(svc)})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/status"
 	svcpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/service"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -40,7 +41,9 @@ func (h *enqueueRequestsForServiceEvent) Update(e event.UpdateEvent, queue workq
 	newSvc := e.ObjectNew.(*corev1.Service)
 
 	if !equality.Semantic.DeepEqual(oldSvc.ResourceVersion, newSvc.ResourceVersion) {
-		if equality.Semantic.DeepEqual(oldSvc.Annotations, newSvc.Annotations) &&
+		// the reconcile status annotations are written by the reconcile itself, thus ignored.
+		if equality.Semantic.DeepEqual(status.StripAnnotations(status.AnnotationPrefixService, oldSvc.Annotations),
+			status.StripAnnotations(status.AnnotationPrefixService, newSvc.Annotations)) &&
 			equality.Semantic.DeepEqual(oldSvc.Spec, newSvc.Spec) &&
 			equality.Semantic.DeepEqual(oldSvc.DeletionTimestamp.IsZero(), newSvc.DeletionTimestamp.IsZero()) {
 			return
@@ -57,6 +60,7 @@ func (h *enqueueRequestsForServiceEvent) Delete(e event.DeleteEvent, queue workq
 }
 
 func (h *enqueueRequestsForServiceEvent) Generic(e event.GenericEvent, queue workqueue.RateLimitingInterface) {
+	h.enqueueManagedService(queue, e.Object.(*corev1.Service))
 }
 
 func (h *enqueueRequestsForServiceEvent) enqueueManagedService(queue workqueue.RateLimitingInterface, service *corev1.Service) {
@@ -2,26 +2,41 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service/eventhandlers"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/dryrun"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/status"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/inventory"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/sharding"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -33,72 +48,211 @@ const (
 	serviceTagPrefix        = "service.k8s.aws"
 	serviceAnnotationPrefix = "service.beta.kubernetes.io"
 	controllerName          = "service"
+
+	// serviceConditionTypeDryRunPlan is the type of Service condition that reports the changes planned by dry run.
+	serviceConditionTypeDryRunPlan = "service.k8s.aws/DryRunPlan"
+	serviceConditionReasonPlanned  = "Planned"
+
+	// serviceConditionTypeReconciled is the type of Service condition that reports whether the last reconcile succeeded.
+	serviceConditionTypeReconciled        = "service.k8s.aws/Reconciled"
+	serviceConditionReasonReconciled      = "Reconciled"
+	serviceConditionReasonReconcileFailed = "ReconcileFailed"
+
+	// divergenceKindService is the kind of Services in the shadow mode divergence report.
+	divergenceKindService = "Service"
+
+	// eniReleasePollInterval is the interval to check whether the network interfaces of deleted load balancers are released.
+	eniReleasePollInterval = 30 * time.Second
 )
 
 func NewServiceReconciler(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
 	finalizerManager k8s.FinalizerManager, networkingSGManager networking.SecurityGroupManager,
 	networkingSGReconciler networking.SecurityGroupReconciler, subnetsResolver networking.SubnetsResolver,
-	vpcInfoProvider networking.VPCInfoProvider, controllerConfig config.ControllerConfig,
-	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, logger logr.Logger) *serviceReconciler {
+	vpcInfoProvider networking.VPCInfoProvider, nodeInfoProvider networking.NodeInfoProvider, controllerConfig config.ControllerConfig,
+	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver,
+	blocklistPrefixListProvider networking.BlocklistPrefixListProvider, defaultTagsProvider networking.DefaultTagsProvider,
+	dynamicConfigProvider config.DynamicConfigProvider,
+	reconcileMetrics *lbcmetrics.ReconcileMetrics, apiCallReporter *lbcmetrics.ReconcileAPICallReporter, listenerRulesFetchMetrics *elbv2.ListenerRulesFetchMetrics,
+	mutationVerificationMetrics *elbv2.MutationVerificationMetrics, describeCacheMetrics *elbv2.DescribeCacheMetrics,
+	stackENIMetrics *deploy.StackENIMetrics, divergenceReporter audit.DivergenceReporter,
+	shardCoordinator sharding.Coordinator, ownershipPublisher inventory.OwnershipPublisher, debugLoggingTargets *runtime.DebugLoggingTargets,
+	logger logr.Logger) *serviceReconciler {
 
 	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
-	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, controllerConfig.ClusterName)
-	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), logger)
+	trackingProvider := tracking.NewDefaultProvider(serviceTagPrefix, controllerConfig.ClusterName, controllerConfig.DeniedTagKeyPrefixes)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), controllerConfig.StrictTagEnforcement(), logger)
 	serviceUtils := service.NewServiceUtils(annotationParser, serviceFinalizer, controllerConfig.ServiceConfig.LoadBalancerClass, controllerConfig.FeatureGates)
-	modelBuilder := service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
-		elbv2TaggingManager, cloud.EC2(), controllerConfig.FeatureGates, controllerConfig.ClusterName, controllerConfig.DefaultTags, controllerConfig.ExternalManagedTags,
-		controllerConfig.DefaultSSLPolicy, controllerConfig.DefaultTargetType, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
-		backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules)
+	nodeSubnetsResolver := networking.NewDefaultNodeSubnetsResolver(k8sClient, nodeInfoProvider, cloud.EC2(), logger)
+	var lbConfigurationLoader service.LoadBalancerConfigurationLoader
+	if controllerConfig.FeatureGates.Enabled(config.LoadBalancerConfiguration) {
+		lbConfigurationLoader = service.NewDefaultLoadBalancerConfigurationLoader(k8sClient, annotationParser)
+	}
+	buildModelBuilder := func(ec2Client services.EC2, backendSGProvider networking.BackendSGProvider,
+		nodeSubnetsPrefixListProvider networking.NodeSubnetsPrefixListProvider) service.ModelBuilder {
+		return service.NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, cloud.VpcID(), trackingProvider,
+			elbv2TaggingManager, ec2Client, controllerConfig.FeatureGates, controllerConfig.ClusterName, defaultTagsProvider, controllerConfig.ExternalManagedTags,
+			dynamicConfigProvider, controllerConfig.FeatureGates.Enabled(config.EnableIPTargetType), serviceUtils,
+			backendSGProvider, sgResolver, controllerConfig.EnableBackendSecurityGroup, controllerConfig.DisableRestrictedSGRules,
+			nodeSubnetsResolver, controllerConfig.RestrictSGRulesToNodeSubnets, nodeSubnetsPrefixListProvider, blocklistPrefixListProvider, lbConfigurationLoader)
+	}
+	enableNodeSubnetsPrefixList := controllerConfig.RestrictSGRulesToNodeSubnets && controllerConfig.FeatureGates.Enabled(config.NodeSubnetsPrefixList)
+	var nodeSubnetsPrefixListProvider networking.NodeSubnetsPrefixListProvider
+	if enableNodeSubnetsPrefixList {
+		nodeSubnetsPrefixListProvider = networking.NewDefaultNodeSubnetsPrefixListProvider(controllerConfig.ClusterName, cloud.EC2(),
+			nodeSubnetsResolver, defaultTagsProvider, false, logger)
+	}
+	modelBuilder := buildModelBuilder(cloud.EC2(), backendSGProvider, nodeSubnetsPrefixListProvider)
 	stackMarshaller := deploy.NewDefaultStackMarshaller()
-	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, controllerConfig, serviceTagPrefix, logger)
+	stackAbandoner := deploy.NewDefaultStackAbandoner(cloud, k8sClient, networkingSGManager, controllerConfig, serviceTagPrefix, logger)
+	stackDeletionDelayer := deploy.NewDefaultStackDeletionDelayer(cloud, controllerConfig, serviceTagPrefix, logger)
+	var stackENITracker deploy.StackENITracker
+	if controllerConfig.FeatureGates.Enabled(config.LoadBalancerENITracking) {
+		stackENITracker = deploy.NewDefaultStackENITracker(cloud, controllerConfig, serviceTagPrefix, divergenceKindService, stackENIMetrics, logger)
+	}
+	stackDeployer := deploy.NewDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, controllerConfig, serviceTagPrefix,
+		listenerRulesFetchMetrics, mutationVerificationMetrics, describeCacheMetrics, logger)
+	// the dry run model builder plans the backend securityGroup with a dedicated provider,
+	// so that the backend securityGroup planned to be created isn't shared with the default model builder.
+	dryRunEC2Client := dryrun.NewCloud(cloud).EC2()
+	dryRunBackendSGProvider := networking.NewBackendSGProvider(controllerConfig.ClusterName, controllerConfig.BackendSecurityGroup, controllerConfig.BackendSecurityGroupShareKey,
+		cloud.VpcID(), dryRunEC2Client, k8sClient, defaultTagsProvider, controllerConfig.ExternalManagedTags,
+		controllerConfig.StrictTagEnforcement(), controllerConfig.BackendSecurityGroupReleaseGracePeriod, eventRecorder, logger)
+	// the dry run model builder looks up the node subnets prefix list without modifying it.
+	var dryRunNodeSubnetsPrefixListProvider networking.NodeSubnetsPrefixListProvider
+	if enableNodeSubnetsPrefixList {
+		dryRunNodeSubnetsPrefixListProvider = networking.NewDefaultNodeSubnetsPrefixListProvider(controllerConfig.ClusterName, dryRunEC2Client,
+			nodeSubnetsResolver, defaultTagsProvider, true, logger)
+	}
+	dryRunModelBuilder := buildModelBuilder(dryRunEC2Client, dryRunBackendSGProvider, dryRunNodeSubnetsPrefixListProvider)
+	dryRunStackDeployer := deploy.NewDryRunStackDeployer(cloud, k8sClient, controllerConfig, serviceTagPrefix, logger)
+	var modelDiffLogger *deploy.ModelDiffLogger
+	if controllerConfig.LogModelDiff {
+		modelDiffLogger = deploy.NewModelDiffLogger(logger)
+	}
 	return &serviceReconciler{
-		k8sClient:         k8sClient,
-		eventRecorder:     eventRecorder,
-		finalizerManager:  finalizerManager,
-		annotationParser:  annotationParser,
-		loadBalancerClass: controllerConfig.ServiceConfig.LoadBalancerClass,
-		serviceUtils:      serviceUtils,
-		backendSGProvider: backendSGProvider,
+		k8sClient:           k8sClient,
+		eventRecorder:       eventRecorder,
+		finalizerManager:    finalizerManager,
+		annotationParser:    annotationParser,
+		loadBalancerClass:   controllerConfig.ServiceConfig.LoadBalancerClass,
+		serviceUtils:        serviceUtils,
+		backendSGProvider:   backendSGProvider,
+		defaultTagsProvider: defaultTagsProvider,
 
-		modelBuilder:    modelBuilder,
-		stackMarshaller: stackMarshaller,
-		stackDeployer:   stackDeployer,
-		logger:          logger,
+		modelBuilder:         modelBuilder,
+		stackMarshaller:      stackMarshaller,
+		modelDiffLogger:      modelDiffLogger,
+		stackDeployer:        stackDeployer,
+		stackAbandoner:       stackAbandoner,
+		stackDeletionDelayer: stackDeletionDelayer,
+		stackENITracker:      stackENITracker,
+		reconcileMetrics:     reconcileMetrics,
+		apiCallReporter:      apiCallReporter,
+		logger:               logger,
 
-		maxConcurrentReconciles: controllerConfig.ServiceMaxConcurrentReconciles,
+		dryRunModelBuilder:  dryRunModelBuilder,
+		dryRunStackDeployer: dryRunStackDeployer,
+		divergenceReporter:  divergenceReporter,
+		shardCoordinator:    shardCoordinator,
+		ownershipPublisher:  ownershipPublisher,
+		debugLoggingTargets: debugLoggingTargets,
+
+		maxConcurrentReconciles:      controllerConfig.ServiceMaxConcurrentReconciles,
+		restrictSGRulesToNodeSubnets: controllerConfig.RestrictSGRulesToNodeSubnets,
+		enableLBConfiguration:        controllerConfig.FeatureGates.Enabled(config.LoadBalancerConfiguration),
+		dryRun:                       controllerConfig.DryRun || controllerConfig.ShadowMode,
 	}
 }
 
 type serviceReconciler struct {
-	k8sClient         client.Client
-	eventRecorder     record.EventRecorder
-	finalizerManager  k8s.FinalizerManager
-	annotationParser  annotations.Parser
-	loadBalancerClass string
-	serviceUtils      service.ServiceUtils
-	backendSGProvider networking.BackendSGProvider
+	k8sClient           client.Client
+	eventRecorder       record.EventRecorder
+	finalizerManager    k8s.FinalizerManager
+	annotationParser    annotations.Parser
+	loadBalancerClass   string
+	serviceUtils        service.ServiceUtils
+	backendSGProvider   networking.BackendSGProvider
+	defaultTagsProvider networking.DefaultTagsProvider
 
 	modelBuilder    service.ModelBuilder
 	stackMarshaller deploy.StackMarshaller
+	// modelDiffLogger logs the diff of the model of Services between reconciles if enabled, it's nil otherwise.
+	modelDiffLogger *deploy.ModelDiffLogger
 	stackDeployer   deploy.StackDeployer
+	stackAbandoner  deploy.StackAbandoner
+	// stackDeletionDelayer delays the deletion of internet-facing load balancers for DNS caches to expire.
+	stackDeletionDelayer deploy.StackDeletionDelayer
+	// stackENITracker tracks the network interfaces of load balancers if enabled, it's nil otherwise.
+	stackENITracker  deploy.StackENITracker
+	reconcileMetrics *lbcmetrics.ReconcileMetrics
+	// apiCallReporter reports the AWS API calls per reconcile if enabled, it's nil otherwise.
+	apiCallReporter *lbcmetrics.ReconcileAPICallReporter
 	logger          logr.Logger
 
-	maxConcurrentReconciles int
+	// dryRunModelBuilder and dryRunStackDeployer plan the changes without applying them.
+	dryRunModelBuilder  service.ModelBuilder
+	dryRunStackDeployer deploy.StackDeployer
+	// divergenceReporter reports the planned changes instead of events and conditions in shadow mode, it's nil otherwise.
+	divergenceReporter audit.DivergenceReporter
+	// shardCoordinator decides the Services reconciled by this replica if sharding is enabled, it's nil otherwise.
+	shardCoordinator sharding.Coordinator
+	// ownershipPublisher publishes the ownership of managed resources if ownership events are enabled, it's nil otherwise.
+	ownershipPublisher inventory.OwnershipPublisher
+	// debugLoggingTargets raises the log verbosity of the resources requesting debug logging if enabled, it's nil otherwise.
+	debugLoggingTargets *runtime.DebugLoggingTargets
+
+	maxConcurrentReconciles      int
+	restrictSGRulesToNodeSubnets bool
+	// enableLBConfiguration specifies whether Services can reference LoadBalancerConfigurations
+	enableLBConfiguration bool
+	// dryRun specifies whether to plan the changes for all Services without applying them
+	dryRun bool
 }
 
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=services/status,verbs=update;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=loadbalancerconfigurations,verbs=get;list;watch
 
 func (r *serviceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return runtime.HandleReconcileError(r.reconcile(ctx, req), r.logger)
+	if r.shardCoordinator != nil && !r.shardCoordinator.Owns(req.NamespacedName) {
+		return ctrl.Result{}, nil
+	}
+	startTime := time.Now()
+	ctx, apiCallCounter := r.apiCallReporter.StartReconcile(ctx)
+	err := r.reconcile(ctx, req)
+	r.reconcileMetrics.ObserveReconcile(controllerName, startTime, err)
+	r.apiCallReporter.ReportReconcile(controllerName, req.NamespacedName, apiCallCounter)
+	return runtime.HandleReconcileError(err, r.logger)
 }
 
 func (r *serviceReconciler) reconcile(ctx context.Context, req ctrl.Request) error {
 	svc := &corev1.Service{}
 	if err := r.k8sClient.Get(ctx, req.NamespacedName, svc); err != nil {
+		if apierrors.IsNotFound(err) && r.divergenceReporter != nil {
+			return r.divergenceReporter.ReportDivergence(ctx, divergenceKindService, req.NamespacedName, nil)
+		}
 		return client.IgnoreNotFound(err)
 	}
+	if err := r.debugLoggingTargets.Configure(svc.Annotations, k8s.NamespacedName(svc).String()); err != nil {
+		r.logger.Info("ignoring invalid debug logging annotation", "service", k8s.NamespacedName(svc), "error", err)
+	}
+	dryRun, err := r.isDryRun(svc)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	if dryRun {
+		return r.planModel(ctx, svc)
+	}
+	if err := r.reconcileService(ctx, svc); err != nil {
+		r.updateServiceReconcileFailure(ctx, svc, err)
+		return err
+	}
+	return nil
+}
+
+func (r *serviceReconciler) reconcileService(ctx context.Context, svc *corev1.Service) error {
 	stack, lb, backendSGRequired, err := r.buildModel(ctx, svc)
 	if err != nil {
 		return err
@@ -121,19 +275,95 @@ func (r *serviceReconciler) buildModel(ctx context.Context, svc *corev1.Service)
 		return nil, nil, false, err
 	}
 	r.logger.Info("successfully built model", "model", stackJSON)
+	r.modelDiffLogger.Log(stack.StackID(), stackJSON)
 	return stack, lb, backendSGRequired, nil
 }
 
 func (r *serviceReconciler) deployModel(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
+	ctx = audit.ContextWithMutationRecorder(ctx, audit.NewEventMutationRecorder(r.eventRecorder, svc))
 	if err := r.stackDeployer.Deploy(ctx, stack); err != nil {
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
 		return err
 	}
 	r.logger.Info("successfully deployed model", "service", k8s.NamespacedName(svc))
+	if r.ownershipPublisher != nil {
+		mappings, err := inventory.BuildOwnershipMappings(ctx, stack, inventory.OwnerKindService, []types.NamespacedName{k8s.NamespacedName(svc)})
+		if err != nil {
+			return err
+		}
+		if err := r.ownershipPublisher.Publish(ctx, stack.StackID(), mappings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+// abandonModel leaves the AWS resources of Service intact, and stops managing them.
+func (r *serviceReconciler) abandonModel(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
+	if err := r.stackAbandoner.Abandon(ctx, stack); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedAbandonModel, fmt.Sprintf("Failed abandon model due to %v", err))
+		return err
+	}
+	r.logger.Info("successfully abandoned model", "service", k8s.NamespacedName(svc))
+	if r.ownershipPublisher != nil {
+		if err := r.ownershipPublisher.Publish(ctx, stack.StackID(), nil); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// planModel builds the model for Service and reports the changes to deploy it without applying them.
+// the planned changes are reported via event and the DryRunPlan condition, finalizers and load balancer status are left untouched.
+func (r *serviceReconciler) planModel(ctx context.Context, svc *corev1.Service) error {
+	planRecorder := audit.NewPlanRecorder()
+	planCtx := audit.ContextWithMutationRecorder(ctx, planRecorder)
+	stack, lb, _, err := r.dryRunModelBuilder.Build(planCtx, svc)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
+		return err
+	}
+	// without load balancer, there is nothing to plan unless the resources are provisioned already.
+	if lb == nil && !k8s.HasFinalizer(svc, serviceFinalizer) {
+		if r.divergenceReporter != nil {
+			return r.divergenceReporter.ReportDivergence(ctx, divergenceKindService, k8s.NamespacedName(svc), nil)
+		}
+		return nil
+	}
+	if err := r.dryRunStackDeployer.Deploy(planCtx, stack); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed plan model due to %v", err))
+		return err
+	}
+	plan := planRecorder.Plan()
+	planJSON, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("successfully planned model", "service", k8s.NamespacedName(svc), "plan", string(planJSON))
+	if r.divergenceReporter != nil {
+		return r.divergenceReporter.ReportDivergence(ctx, divergenceKindService, k8s.NamespacedName(svc), plan)
+	}
+	planMessage := audit.RenderPlanMessage(plan)
+	r.eventRecorder.Event(svc, corev1.EventTypeNormal, audit.EventReasonDryRunPlan, planMessage)
+	if err := r.updateServiceDryRunPlanCondition(ctx, svc, planMessage); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
+	return nil
+}
+
+// isDryRun checks whether the changes for Service should be planned without applying them.
+func (r *serviceReconciler) isDryRun(svc *corev1.Service) (bool, error) {
+	if r.dryRun {
+		return true, nil
+	}
+	dryRun := false
+	if _, err := r.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixDryRun, &dryRun, svc.Annotations); err != nil {
+		return false, err
+	}
+	return dryRun, nil
+}
+
 func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack,
 	lb *elbv2model.LoadBalancer, backendSGRequired bool) error {
 	if err := r.finalizerManager.AddFinalizers(ctx, svc, serviceFinalizer); err != nil {
@@ -159,19 +389,56 @@ func (r *serviceReconciler) reconcileLoadBalancerResources(ctx context.Context,
 		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
 		return err
 	}
+	reconcileStatus, err := status.NewSucceededReconcileStatus(ctx, stack, lb, time.Now())
+	if err != nil {
+		return err
+	}
+	if r.stackENITracker != nil {
+		if reconcileStatus.NetworkInterfaceIDs, err = r.stackENITracker.Track(ctx, stack); err != nil {
+			return err
+		}
+	}
+	if err := status.UpdateAnnotations(ctx, r.k8sClient, svc, status.AnnotationPrefixService, reconcileStatus); err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedUpdateStatus, fmt.Sprintf("Failed update status due to %v", err))
+		return err
+	}
 	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonSuccessfullyReconciled, "Successfully reconciled")
 	return nil
 }
 
 func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
 	if k8s.HasFinalizer(svc, serviceFinalizer) {
-		err := r.deployModel(ctx, svc, stack)
+		deletionPolicy, err := deploy.ParseDeletionPolicyAnnotation(r.annotationParser, annotations.SvcLBSuffixDeletionPolicy, svc.Annotations)
 		if err != nil {
+			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedBuildModel, fmt.Sprintf("Failed build model due to %v", err))
 			return err
 		}
-		if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
-			return err
+		if deletionPolicy == elbv2api.DeletionPolicyRetain {
+			// the shared backend securityGroup isn't released, as it's still attached to the retained load balancer.
+			if err := r.abandonModel(ctx, svc, stack); err != nil {
+				return err
+			}
+		} else {
+			if err := r.delayModelDeletion(ctx, svc, stack); err != nil {
+				return err
+			}
+			// the network interfaces of the load balancer are tracked before it's deleted, so that their release can be verified afterwards.
+			if r.stackENITracker != nil {
+				if _, err := r.stackENITracker.Track(ctx, stack); err != nil {
+					return err
+				}
+			}
+			if err := r.deployModel(ctx, svc, stack); err != nil {
+				return err
+			}
+			if err := r.awaitModelENIsRelease(ctx, svc, stack); err != nil {
+				return err
+			}
+			if err := r.backendSGProvider.Release(ctx, networking.ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}); err != nil {
+				return err
+			}
 		}
+		r.modelDiffLogger.Forget(stack.StackID())
 		if err = r.cleanupServiceStatus(ctx, svc); err != nil {
 			r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedCleanupStatus, fmt.Sprintf("Failed update status due to %v", err))
 			return err
@@ -184,19 +451,85 @@ func (r *serviceReconciler) cleanupLoadBalancerResources(ctx context.Context, sv
 	return nil
 }
 
+// delayModelDeletion requeues the deleted Service until its internet-facing load balancer can be deleted,
+// the finalizer of Service is held meanwhile.
+func (r *serviceReconciler) delayModelDeletion(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
+	if svc.DeletionTimestamp == nil {
+		return nil
+	}
+	delayedUntil, err := r.stackDeletionDelayer.DelayedUntil(ctx, stack, svc.DeletionTimestamp.Time)
+	if err != nil {
+		r.eventRecorder.Event(svc, corev1.EventTypeWarning, k8s.ServiceEventReasonFailedDeployModel, fmt.Sprintf("Failed deploy model due to %v", err))
+		return err
+	}
+	if delayedUntil.IsZero() {
+		return nil
+	}
+	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonDeletionDelayed,
+		fmt.Sprintf("Delaying deletion of internet-facing load balancer until %v for DNS caches to expire", delayedUntil.UTC().Format(time.RFC3339)))
+	return runtime.NewRequeueNeededAfter("load balancer deletion delayed", time.Until(delayedUntil))
+}
+
+// awaitModelENIsRelease requeues the deleted Service until the network interfaces of its deleted load balancer are released if enabled,
+// the finalizer of Service is held meanwhile.
+func (r *serviceReconciler) awaitModelENIsRelease(ctx context.Context, svc *corev1.Service, stack core.Stack) error {
+	if r.stackENITracker == nil {
+		return nil
+	}
+	eniIDs, err := r.stackENITracker.PendingRelease(ctx, stack)
+	if err != nil {
+		return err
+	}
+	if len(eniIDs) == 0 {
+		return nil
+	}
+	r.eventRecorder.Event(svc, corev1.EventTypeNormal, k8s.ServiceEventReasonENIReleasePending,
+		fmt.Sprintf("Waiting for network interfaces of deleted load balancer to be released: %v", strings.Join(eniIDs, ",")))
+	return runtime.NewRequeueNeededAfter("load balancer network interfaces not released", eniReleasePollInterval)
+}
+
 func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS string, svc *corev1.Service) error {
+	svcOld := svc.DeepCopy()
 	if len(svc.Status.LoadBalancer.Ingress) != 1 ||
 		svc.Status.LoadBalancer.Ingress[0].IP != "" ||
 		svc.Status.LoadBalancer.Ingress[0].Hostname != lbDNS {
-		svcOld := svc.DeepCopy()
 		svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{
 			{
 				Hostname: lbDNS,
 			},
 		}
-		if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
-			return errors.Wrapf(err, "failed to update service status: %v", k8s.NamespacedName(svc))
-		}
+	}
+	meta.SetStatusCondition(&svc.Status.Conditions, metav1.Condition{
+		Type:               serviceConditionTypeReconciled,
+		Status:             metav1.ConditionTrue,
+		Reason:             serviceConditionReasonReconciled,
+		ObservedGeneration: svc.Generation,
+	})
+	// the changes are applied, thus the plan of previous dry run is stale.
+	meta.RemoveStatusCondition(&svc.Status.Conditions, serviceConditionTypeDryRunPlan)
+	if equality.Semantic.DeepEqual(svcOld.Status, svc.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
+		return errors.Wrapf(err, "failed to update service status: %v", k8s.NamespacedName(svc))
+	}
+	return nil
+}
+
+func (r *serviceReconciler) updateServiceDryRunPlanCondition(ctx context.Context, svc *corev1.Service, planMessage string) error {
+	svcOld := svc.DeepCopy()
+	meta.SetStatusCondition(&svc.Status.Conditions, metav1.Condition{
+		Type:               serviceConditionTypeDryRunPlan,
+		Status:             metav1.ConditionTrue,
+		Reason:             serviceConditionReasonPlanned,
+		Message:            planMessage,
+		ObservedGeneration: svc.Generation,
+	})
+	if equality.Semantic.DeepEqual(svcOld.Status, svc.Status) {
+		return nil
+	}
+	if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
+		return errors.Wrapf(err, "failed to update service status: %v", k8s.NamespacedName(svc))
 	}
 	return nil
 }
@@ -204,17 +537,42 @@ func (r *serviceReconciler) updateServiceStatus(ctx context.Context, lbDNS strin
 func (r *serviceReconciler) cleanupServiceStatus(ctx context.Context, svc *corev1.Service) error {
 	svcOld := svc.DeepCopy()
 	svc.Status.LoadBalancer = corev1.LoadBalancerStatus{}
+	meta.RemoveStatusCondition(&svc.Status.Conditions, serviceConditionTypeReconciled)
+	meta.RemoveStatusCondition(&svc.Status.Conditions, serviceConditionTypeDryRunPlan)
 	if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
 		return errors.Wrapf(err, "failed to cleanup service status: %v", k8s.NamespacedName(svc))
 	}
-	return nil
+	return status.RemoveAnnotations(ctx, r.k8sClient, svc, status.AnnotationPrefixService)
+}
+
+// updateServiceReconcileFailure records the reconcile failure via the Reconciled condition and reconcile status annotations.
+// failures to record are only logged, so that the original error is reported.
+func (r *serviceReconciler) updateServiceReconcileFailure(ctx context.Context, svc *corev1.Service, reconcileErr error) {
+	svcOld := svc.DeepCopy()
+	meta.SetStatusCondition(&svc.Status.Conditions, metav1.Condition{
+		Type:               serviceConditionTypeReconciled,
+		Status:             metav1.ConditionFalse,
+		Reason:             serviceConditionReasonReconcileFailed,
+		Message:            reconcileErr.Error(),
+		ObservedGeneration: svc.Generation,
+	})
+	if !equality.Semantic.DeepEqual(svcOld.Status, svc.Status) {
+		if err := r.k8sClient.Status().Patch(ctx, svc, client.MergeFrom(svcOld)); err != nil {
+			r.logger.Error(err, "failed to record reconcile failure", "service", k8s.NamespacedName(svc))
+			return
+		}
+	}
+	reconcileStatus := status.NewFailedReconcileStatus(reconcileErr, time.Now())
+	if err := status.UpdateAnnotations(ctx, r.k8sClient, svc, status.AnnotationPrefixService, reconcileStatus); err != nil {
+		r.logger.Error(err, "failed to record reconcile failure", "service", k8s.NamespacedName(svc))
+	}
 }
 
 func (r *serviceReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	c, err := controller.New(controllerName, mgr, controller.Options{
+	c, err := sharding.NewController(controllerName, mgr, controller.Options{
 		MaxConcurrentReconciles: r.maxConcurrentReconciles,
 		Reconciler:              r,
-	})
+	}, r.shardCoordinator)
 	if err != nil {
 		return err
 	}
@@ -230,5 +588,30 @@ func (r *serviceReconciler) setupWatches(_ context.Context, c controller.Control
 	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, svcEventHandler); err != nil {
 		return err
 	}
+	if err := c.Watch(&source.Channel{Source: r.backendSGProvider.RecoveryEvents(networking.ResourceTypeService)}, svcEventHandler); err != nil {
+		return err
+	}
+	if err := c.Watch(&source.Channel{Source: r.defaultTagsProvider.ChangeEvents(networking.ResourceTypeService)}, svcEventHandler); err != nil {
+		return err
+	}
+	if r.shardCoordinator != nil {
+		if err := c.Watch(&source.Channel{Source: r.shardCoordinator.AcquisitionEvents(networking.ResourceTypeService)}, svcEventHandler); err != nil {
+			return err
+		}
+	}
+	if r.restrictSGRulesToNodeSubnets {
+		nodeEventHandler := eventhandlers.NewEnqueueRequestsForNodeEvent(r.k8sClient,
+			r.serviceUtils, r.logger.WithName("eventHandlers").WithName("node"))
+		if err := c.Watch(&source.Kind{Type: &corev1.Node{}}, nodeEventHandler); err != nil {
+			return err
+		}
+	}
+	if r.enableLBConfiguration {
+		lbConfigurationEventHandler := eventhandlers.NewEnqueueRequestsForLoadBalancerConfigurationEvent(r.k8sClient,
+			r.annotationParser, r.serviceUtils, r.logger.WithName("eventHandlers").WithName("loadBalancerConfiguration"))
+		if err := c.Watch(&source.Kind{Type: &elbv2api.LoadBalancerConfiguration{}}, lbConfigurationEventHandler); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -105,6 +105,9 @@ clientKey: {{ .Values.webhookTLS.key | b64enc }}
 caCert: {{ index $secret.data "ca.crt" }}
 clientCert: {{ index $secret.data "tls.crt" }}
 clientKey: {{ index $secret.data "tls.key" }}
+{{- if hasKey $secret.data "ca.key" }}
+caKey: {{ index $secret.data "ca.key" }}
+{{- end }}
 {{- else -}}
 {{- $altNames := list (printf "%s.%s" $serviceName .Release.Namespace) (printf "%s.%s.svc" $serviceName .Release.Namespace) (printf "%s.%s.svc.%s" $serviceName .Release.Namespace .Values.cluster.dnsDomain) -}}
 {{- $ca := genCA "aws-load-balancer-controller-ca" 3650 -}}
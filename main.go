@@ -17,38 +17,65 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"os"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/pflag"
 	zapraw "go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/tools/record"
+	elbv2v1alpha1 "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1alpha1"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	elbv2controller "sigs.k8s.io/aws-load-balancer-controller/controllers/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/controllers/gateway"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/controllers/service"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/iampolicy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/retry"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/capabilities"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certrotation"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/gc"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/recoveryreadiness"
+	ingresspkg "sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/inject"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/inventory"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	lbcmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/metrics"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/sharding"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/version"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	corewebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/core"
 	elbv2webhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/elbv2"
 	networkingwebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/networking"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	// +kubebuilder:scaffold:imports
 )
 
+// subcommandIAMPolicy prints the IAM policy required by the controller running with specified flags.
+const subcommandIAMPolicy = "iam-policy"
+
 var (
 	scheme   = k8sruntime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
@@ -58,11 +85,21 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 
 	_ = elbv2api.AddToScheme(scheme)
+	_ = elbv2v1alpha1.AddToScheme(scheme)
+	_ = gwv1alpha2.AddToScheme(scheme)
+	_ = gwv1beta1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
 func main() {
-	infoLogger := getLoggerWithLogLevel("info")
+	if len(os.Args) > 1 && os.Args[1] == subcommandIAMPolicy {
+		if err := printIAMPolicy(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	infoLogger := getLoggerWithLogLevel("info", nil)
 	infoLogger.Info("version",
 		"GitVersion", version.GitVersion,
 		"GitCommit", version.GitCommit,
@@ -73,7 +110,11 @@ func main() {
 		infoLogger.Error(err, "unable to load controller config")
 		os.Exit(1)
 	}
-	ctrl.SetLogger(getLoggerWithLogLevel(controllerCFG.LogLevel))
+	var debugLoggingTargets *runtime.DebugLoggingTargets
+	if controllerCFG.FeatureGates.Enabled(config.TargetedDebugLogging) {
+		debugLoggingTargets = runtime.NewDebugLoggingTargets()
+	}
+	ctrl.SetLogger(getLoggerWithLogLevel(controllerCFG.LogLevel, debugLoggingTargets))
 
 	cloud, err := aws.NewCloud(controllerCFG.AWSConfig, metrics.Registry)
 	if err != nil {
@@ -85,42 +126,265 @@ func main() {
 		setupLog.Error(err, "unable to build REST config")
 		os.Exit(1)
 	}
-	rtOpts := config.BuildRuntimeOptions(controllerCFG.RuntimeConfig, scheme)
+	clientSet, err := kubernetes.NewForConfig(restCFG)
+	if err != nil {
+		setupLog.Error(err, "unable to obtain clientSet")
+		os.Exit(1)
+	}
+	watchNamespaces, err := config.ResolveWatchNamespaces(context.Background(), controllerCFG.RuntimeConfig, clientSet.CoreV1().Namespaces())
+	if err != nil {
+		setupLog.Error(err, "unable to resolve namespaces to watch")
+		os.Exit(1)
+	}
+	rtOpts := config.BuildRuntimeOptions(controllerCFG.RuntimeConfig, watchNamespaces, scheme)
+	// the shadow controller runs alongside the active controller, thus elects its leader separately.
+	if controllerCFG.ShadowMode {
+		rtOpts.LeaderElectionID = rtOpts.LeaderElectionID + "-shadow"
+	}
 	mgr, err := ctrl.NewManager(restCFG, rtOpts)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 	config.ConfigureWebhookServer(controllerCFG.RuntimeConfig, mgr)
-	clientSet, err := kubernetes.NewForConfig(mgr.GetConfig())
-	if err != nil {
-		setupLog.Error(err, "unable to obtain clientSet")
-		os.Exit(1)
+	// events are throttled so that AWS outages don't flood the API server with identical failure events.
+	newEventRecorder := func(name string) record.EventRecorder {
+		return k8s.NewThrottledEventRecorder(mgr.GetEventRecorderFor(name), controllerCFG.EventConfig.DedupeWindow,
+			controllerCFG.EventConfig.RateLimitQPS, controllerCFG.EventConfig.RateLimitBurst)
 	}
 
-	podInfoRepo := k8s.NewDefaultPodInfoRepo(clientSet.CoreV1().RESTClient(), rtOpts.Namespace, ctrl.Log)
+	podInfoRepo := k8s.NewDefaultPodInfoRepo(clientSet.CoreV1().RESTClient(), watchNamespaces, ctrl.Log)
 	finalizerManager := k8s.NewDefaultFinalizerManager(mgr.GetClient(), ctrl.Log)
 	sgManager := networking.NewDefaultSecurityGroupManager(cloud.EC2(), ctrl.Log)
-	sgReconciler := networking.NewDefaultSecurityGroupReconciler(sgManager, ctrl.Log)
+	var sgDriftReporter networking.SecurityGroupDriftReporter
+	if controllerCFG.SecurityGroupDriftReportMode {
+		sgDriftReportConfigMapKey, err := controllerCFG.SecurityGroupDriftReportConfigMapKey()
+		if err != nil {
+			setupLog.Error(err, "invalid security group drift report configMap")
+			os.Exit(1)
+		}
+		sgDriftReporter, err = networking.NewDefaultSecurityGroupDriftReporter(mgr.GetClient(), newEventRecorder("securityGroup"),
+			sgDriftReportConfigMapKey, metrics.Registry, ctrl.Log.WithName("sg-drift-reporter"))
+		if err != nil {
+			setupLog.Error(err, "unable to initialize security group drift reporter")
+			os.Exit(1)
+		}
+	}
+	sgReconciler := networking.NewDefaultSecurityGroupReconciler(sgManager, sgDriftReporter, ctrl.Log)
 	azInfoProvider := networking.NewDefaultAZInfoProvider(cloud.EC2(), ctrl.Log.WithName("az-info-provider"))
 	vpcInfoProvider := networking.NewDefaultVPCInfoProvider(cloud.EC2(), ctrl.Log.WithName("vpc-info-provider"))
-	subnetResolver := networking.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerCFG.ClusterName, ctrl.Log.WithName("subnets-resolver"))
-	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), cloud.ELBV2(), cloud.EC2(),
-		podInfoRepo, sgManager, sgReconciler, vpcInfoProvider,
+	subnetsDiscoveryStrategyFactory, err := networking.GetSubnetsDiscoveryStrategyFactory(controllerCFG.SubnetsDiscoveryStrategy)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize subnets discovery strategy")
+		os.Exit(1)
+	}
+	subnetsDiscoveryStrategy := subnetsDiscoveryStrategyFactory(cloud.EC2(), cloud.VpcID(), controllerCFG.ClusterName, ctrl.Log.WithName("subnets-discovery-strategy"))
+	subnetResolver := networking.NewDefaultSubnetsResolver(azInfoProvider, cloud.EC2(), cloud.VpcID(), controllerCFG.ClusterName, subnetsDiscoveryStrategy,
+		controllerCFG.SubnetDiscoverySelector(), controllerCFG.AllowedAvailabilityZones, ctrl.Log.WithName("subnets-resolver"))
+	var tgbAZAdvisor targetgroupbinding.AZAdvisor
+	if controllerCFG.FeatureGates.Enabled(config.AZTargetDistributionAdvisory) {
+		tgbAZAdvisor, err = targetgroupbinding.NewDefaultAZAdvisor(mgr.GetClient(), cloud.ELBV2(), newEventRecorder("targetGroupBinding"),
+			metrics.Registry, ctrl.Log.WithName("az-advisor"))
+		if err != nil {
+			setupLog.Error(err, "unable to initialize AZ advisor")
+			os.Exit(1)
+		}
+	}
+	targetsBatchMetrics, err := targetgroupbinding.NewTargetsBatchMetrics(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize targets batch metrics")
+		os.Exit(1)
+	}
+	var targetHealthStreamer *targetgroupbinding.TargetHealthStreamer
+	if controllerCFG.FeatureGates.Enabled(config.TargetHealthStreaming) {
+		targetHealthStreamer, err = targetgroupbinding.NewTargetHealthStreamer(metrics.Registry)
+		if err != nil {
+			setupLog.Error(err, "unable to initialize target health streamer")
+			os.Exit(1)
+		}
+	}
+	var nodeInfoProvider networking.NodeInfoProvider = networking.NewDefaultNodeInfoProvider(cloud.EC2(), ctrl.Log.WithName("node-info-provider"))
+	if controllerCFG.NodeInstanceCacheVerifyInterval > 0 {
+		cachedNodeInfoProvider := networking.NewCachedNodeInfoProvider(nodeInfoProvider, controllerCFG.NodeInstanceCacheVerifyInterval, ctrl.Log.WithName("node-info-provider"))
+		if err := cachedNodeInfoProvider.SetupWithManager(context.Background(), mgr); err != nil {
+			setupLog.Error(err, "unable to setup node instance cache")
+			os.Exit(1)
+		}
+		nodeInfoProvider = cachedNodeInfoProvider
+	}
+	tgbResManager := targetgroupbinding.NewDefaultResourceManager(mgr.GetClient(), mgr.GetAPIReader(), cloud,
+		podInfoRepo, sgManager, sgReconciler, vpcInfoProvider, nodeInfoProvider,
 		cloud.VpcID(), controllerCFG.ClusterName, controllerCFG.FeatureGates.Enabled(config.EndpointsFailOpen), controllerCFG.EnableEndpointSlices, controllerCFG.DisableRestrictedSGRules,
-		controllerCFG.ServiceTargetENISGTags, mgr.GetEventRecorderFor("targetGroupBinding"), ctrl.Log)
-	backendSGProvider := networking.NewBackendSGProvider(controllerCFG.ClusterName, controllerCFG.BackendSecurityGroup,
-		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), controllerCFG.DefaultTags, ctrl.Log.WithName("backend-sg-provider"))
+		controllerCFG.ServiceTargetENISGTags, tgbAZAdvisor,
+		controllerCFG.TargetGroupBindingTargetsBatchWindow, controllerCFG.TargetGroupBindingTargetsBatchMaxConcurrency, targetsBatchMetrics,
+		controllerCFG.TargetGroupBindingTargetHealthPollInterval, targetHealthStreamer,
+		newEventRecorder("targetGroupBinding"), ctrl.Log)
+	endpointChangeMetrics, err := targetgroupbinding.NewEndpointChangeMetrics(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize endpoint change metrics")
+		os.Exit(1)
+	}
+	endpointChangeAggregator := targetgroupbinding.NewDefaultEndpointChangeAggregator(controllerCFG.TargetGroupBindingEndpointChangeDebounceWindow,
+		controllerCFG.TargetGroupBindingEndpointChangeMaxStaleness, endpointChangeMetrics)
+	// the ControllerConfiguration is loaded beforehand, so that resources aren't reconciled with the configuration of the flags first.
+	defaultTags := controllerCFG.DefaultTags
+	flagDynamicConfig := controllerCFG.DynamicConfig()
+	dynamicConfig := flagDynamicConfig
+	if controllerCFG.ControllerConfigurationName != "" {
+		defaultTags, dynamicConfig, err = elbv2controller.LoadControllerConfiguration(context.Background(), mgr.GetAPIReader(), controllerCFG, flagDynamicConfig)
+		if err != nil {
+			setupLog.Error(err, "unable to load controllerConfiguration")
+			os.Exit(1)
+		}
+	}
+	defaultTagsProvider := networking.NewDefaultTagsProvider(mgr.GetClient(), defaultTags, ctrl.Log.WithName("default-tags-provider"))
+	dynamicConfigProvider := config.NewDynamicConfigProvider(dynamicConfig, controllerCFG.FeatureGates)
+	backendSGProvider := networking.NewBackendSGProvider(controllerCFG.ClusterName, controllerCFG.BackendSecurityGroup, controllerCFG.BackendSecurityGroupShareKey,
+		cloud.VpcID(), cloud.EC2(), mgr.GetClient(), defaultTagsProvider, controllerCFG.ExternalManagedTags, controllerCFG.StrictTagEnforcement(),
+		controllerCFG.BackendSecurityGroupReleaseGracePeriod, newEventRecorder("backendSecurityGroup"), ctrl.Log.WithName("backend-sg-provider"))
 	sgResolver := networking.NewDefaultSecurityGroupResolver(cloud.EC2(), cloud.VpcID())
-	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("ingress"),
+	// the blocklist prefix lists are only modified if not in dry run or shadow mode, otherwise they're looked up only.
+	var blocklistPrefixListProvider networking.BlocklistPrefixListProvider
+	if controllerCFG.FeatureGates.Enabled(config.Blocklist) {
+		defaultBlocklistPrefixListProvider := networking.NewDefaultBlocklistPrefixListProvider(controllerCFG.ClusterName, cloud.EC2(), mgr.GetClient(),
+			defaultTagsProvider, ctrl.Log.WithName("blocklist-prefix-list-provider"))
+		if !controllerCFG.DryRun && !controllerCFG.ShadowMode {
+			if err := mgr.Add(defaultBlocklistPrefixListProvider); err != nil {
+				setupLog.Error(err, "unable to add blocklist prefix list provider")
+				os.Exit(1)
+			}
+		}
+		blocklistPrefixListProvider = defaultBlocklistPrefixListProvider
+	}
+	certDiscoveryMetrics, err := ingresspkg.NewCertDiscoveryMetrics(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize certificate discovery metrics")
+		os.Exit(1)
+	}
+	reconcileMetrics, err := lbcmetrics.NewReconcileMetrics(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize reconcile metrics")
+		os.Exit(1)
+	}
+	var reconcileAPICallReporter *lbcmetrics.ReconcileAPICallReporter
+	if controllerCFG.ReportAWSAPICalls {
+		reconcileAPICallReporter, err = lbcmetrics.NewReconcileAPICallReporter(metrics.Registry, ctrl.Log.WithName("aws-api-calls"))
+		if err != nil {
+			setupLog.Error(err, "unable to initialize reconcile AWS API call reporter")
+			os.Exit(1)
+		}
+	}
+	listenerRulesFetchMetrics, err := elbv2deploy.NewListenerRulesFetchMetrics(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize listener rules fetch metrics")
+		os.Exit(1)
+	}
+	mutationVerificationMetrics, err := elbv2deploy.NewMutationVerificationMetrics(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize mutation verification metrics")
+		os.Exit(1)
+	}
+	describeCacheMetrics, err := elbv2deploy.NewDescribeCacheMetrics(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize describe cache metrics")
+		os.Exit(1)
+	}
+	modelHashMetrics, err := ingresspkg.NewModelHashMetrics(metrics.Registry)
+	if err != nil {
+		setupLog.Error(err, "unable to initialize model hash metrics")
+		os.Exit(1)
+	}
+	var stackENIMetrics *deploy.StackENIMetrics
+	if controllerCFG.FeatureGates.Enabled(config.LoadBalancerENITracking) {
+		stackENIMetrics, err = deploy.NewStackENIMetrics(metrics.Registry)
+		if err != nil {
+			setupLog.Error(err, "unable to initialize load balancer ENI metrics")
+			os.Exit(1)
+		}
+	}
+	// when sharding is enabled, IngressGroups and Services are reconciled by the replica owning their shard,
+	// so that the components tracking them per replica run on every replica as well.
+	var shardCoordinator sharding.Coordinator
+	addShardedRunnable := mgr.Add
+	if controllerCFG.ShardingConfig.Enabled() {
+		identity, err := os.Hostname()
+		if err != nil {
+			setupLog.Error(err, "unable to determine shard identity")
+			os.Exit(1)
+		}
+		leaseCoordinator, err := sharding.NewLeaseCoordinator(mgr.GetClient(), mgr.GetAPIReader(), controllerCFG.ShardingConfig,
+			rtOpts.LeaderElectionID, identity, metrics.Registry, ctrl.Log.WithName("shard-coordinator"))
+		if err != nil {
+			setupLog.Error(err, "unable to initialize shard coordinator")
+			os.Exit(1)
+		}
+		if err := mgr.Add(leaseCoordinator); err != nil {
+			setupLog.Error(err, "unable to add shard coordinator")
+			os.Exit(1)
+		}
+		shardCoordinator = leaseCoordinator
+		addShardedRunnable = func(runnable manager.Runnable) error {
+			return mgr.Add(sharding.NewUnelectedRunnable(runnable))
+		}
+	}
+	var ruleMetricsExporter ingresspkg.RuleMetricsExporter
+	if controllerCFG.IngressConfig.RuleMetricsPollInterval > 0 {
+		defaultRuleMetricsExporter, err := ingresspkg.NewDefaultRuleMetricsExporter(controllerCFG.IngressConfig.RuleMetricsPollInterval,
+			metrics.Registry, ctrl.Log.WithName("ingress-rule-metrics-exporter"))
+		if err != nil {
+			setupLog.Error(err, "unable to initialize ingress rule metrics exporter")
+			os.Exit(1)
+		}
+		if err := addShardedRunnable(defaultRuleMetricsExporter); err != nil {
+			setupLog.Error(err, "unable to add ingress rule metrics exporter")
+			os.Exit(1)
+		}
+		ruleMetricsExporter = defaultRuleMetricsExporter
+	}
+	awsSecretsProvider := ingresspkg.NewDefaultAWSSecretsProvider(cloud.SecretsManager(), ctrl.Log.WithName("aws-secrets-provider"))
+	if err := addShardedRunnable(awsSecretsProvider); err != nil {
+		setupLog.Error(err, "unable to add AWS secrets provider")
+		os.Exit(1)
+	}
+	var ownershipPublisher inventory.OwnershipPublisher
+	if controllerCFG.OwnershipEventsConfig.Enabled() {
+		ownershipPublisher = inventory.NewDefaultOwnershipPublisher(controllerCFG.ClusterName,
+			cloud.EventBridge(), controllerCFG.OwnershipEventsConfig.EventBus,
+			cloud.SNS(), controllerCFG.OwnershipEventsConfig.SNSTopicARN, ctrl.Log.WithName("ownership-publisher"))
+	}
+	// in shadow mode, the planned changes are reported as divergence, and Kubernetes objects including events are left to the active controller.
+	var divergenceReporter audit.DivergenceReporter
+	getEventRecorderFor := newEventRecorder
+	if controllerCFG.ShadowMode {
+		shadowReportConfigMapKey, err := controllerCFG.ShadowReportConfigMapKey()
+		if err != nil {
+			setupLog.Error(err, "invalid shadow report configMap")
+			os.Exit(1)
+		}
+		divergenceReporter, err = audit.NewDefaultDivergenceReporter(mgr.GetClient(), shadowReportConfigMapKey, metrics.Registry,
+			ctrl.Log.WithName("divergence-reporter"))
+		if err != nil {
+			setupLog.Error(err, "unable to initialize divergence reporter")
+			os.Exit(1)
+		}
+		getEventRecorderFor = func(_ string) record.EventRecorder {
+			return k8s.NewDiscardingEventRecorder()
+		}
+	}
+	ingGroupReconciler := ingress.NewGroupReconciler(cloud, mgr.GetClient(), getEventRecorderFor("ingress"),
+		finalizerManager, sgManager, sgReconciler, sgDriftReporter, subnetResolver, subnetsDiscoveryStrategyFactory,
+		controllerCFG, backendSGProvider, sgResolver, blocklistPrefixListProvider, defaultTagsProvider, dynamicConfigProvider, certDiscoveryMetrics, reconcileMetrics, reconcileAPICallReporter, ruleMetricsExporter, listenerRulesFetchMetrics, mutationVerificationMetrics, describeCacheMetrics, stackENIMetrics, modelHashMetrics, awsSecretsProvider, divergenceReporter,
+		shardCoordinator, ownershipPublisher, debugLoggingTargets, ctrl.Log.WithName("controllers").WithName("ingress"))
+	svcReconciler := service.NewServiceReconciler(cloud, mgr.GetClient(), getEventRecorderFor("service"),
+		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider, nodeInfoProvider,
+		controllerCFG, backendSGProvider, sgResolver, blocklistPrefixListProvider, defaultTagsProvider, dynamicConfigProvider, reconcileMetrics, reconcileAPICallReporter, listenerRulesFetchMetrics, mutationVerificationMetrics, describeCacheMetrics, stackENIMetrics, divergenceReporter,
+		shardCoordinator, ownershipPublisher, debugLoggingTargets, ctrl.Log.WithName("controllers").WithName("service"))
+	tgbReconciler := elbv2controller.NewTargetGroupBindingReconciler(mgr.GetClient(), newEventRecorder("targetGroupBinding"),
+		finalizerManager, tgbResManager, endpointChangeAggregator,
+		controllerCFG, reconcileMetrics, reconcileAPICallReporter, debugLoggingTargets, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+	gwReconciler := gateway.NewGatewayReconciler(cloud, mgr.GetClient(), getEventRecorderFor("gateway"),
 		finalizerManager, sgManager, sgReconciler, subnetResolver,
-		controllerCFG, backendSGProvider, sgResolver, ctrl.Log.WithName("controllers").WithName("ingress"))
-	svcReconciler := service.NewServiceReconciler(cloud, mgr.GetClient(), mgr.GetEventRecorderFor("service"),
-		finalizerManager, sgManager, sgReconciler, subnetResolver, vpcInfoProvider,
-		controllerCFG, backendSGProvider, sgResolver, ctrl.Log.WithName("controllers").WithName("service"))
-	tgbReconciler := elbv2controller.NewTargetGroupBindingReconciler(mgr.GetClient(), mgr.GetEventRecorderFor("targetGroupBinding"),
-		finalizerManager, tgbResManager,
-		controllerCFG, ctrl.Log.WithName("controllers").WithName("targetGroupBinding"))
+		controllerCFG, backendSGProvider, defaultTagsProvider, dynamicConfigProvider, certDiscoveryMetrics, listenerRulesFetchMetrics, mutationVerificationMetrics, describeCacheMetrics, divergenceReporter, ctrl.Log.WithName("controllers").WithName("gateway"))
 
 	ctx := ctrl.SetupSignalHandler()
 	if err = ingGroupReconciler.SetupWithManager(ctx, mgr, clientSet); err != nil {
@@ -136,9 +400,50 @@ func main() {
 		}
 	}
 
-	if err := tgbReconciler.SetupWithManager(ctx, mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
-		os.Exit(1)
+	// Setup targetGroupBinding reconciler only if not in dry run or shadow mode, since it registers targets into AWS directly.
+	if !controllerCFG.DryRun && !controllerCFG.ShadowMode {
+		if err := tgbReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "TargetGroupBinding")
+			os.Exit(1)
+		}
+	}
+
+	// Setup gateway reconciler only if GatewayAPI is enabled, the Gateway API CRDs must be installed beforehand.
+	if controllerCFG.FeatureGates.Enabled(config.GatewayAPI) {
+		if err := gwReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Gateway")
+			os.Exit(1)
+		}
+	}
+
+	// Setup targetGroupWeightPolicy reconciler only if not in shadow mode, since it updates the status of policies.
+	if controllerCFG.FeatureGates.Enabled(config.TargetGroupWeightPolicy) && !controllerCFG.ShadowMode {
+		tgWeightPolicyReconciler := elbv2controller.NewTargetGroupWeightPolicyReconciler(mgr.GetClient(), newEventRecorder("targetGroupWeightPolicy"),
+			ctrl.Log.WithName("controllers").WithName("targetGroupWeightPolicy"))
+		if err := tgWeightPolicyReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "TargetGroupWeightPolicy")
+			os.Exit(1)
+		}
+	}
+
+	// Setup blocklist reconciler only if not in dry run or shadow mode, since it modifies the blocklist prefix lists.
+	if controllerCFG.FeatureGates.Enabled(config.Blocklist) && !controllerCFG.DryRun && !controllerCFG.ShadowMode {
+		blocklistReconciler := elbv2controller.NewBlocklistReconciler(mgr.GetClient(), newEventRecorder("blocklist"),
+			blocklistPrefixListProvider, ctrl.Log.WithName("controllers").WithName("blocklist"))
+		if err := blocklistReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Blocklist")
+			os.Exit(1)
+		}
+	}
+
+	// Setup controllerConfiguration reconciler only if the ControllerConfiguration name is specified.
+	if controllerCFG.ControllerConfigurationName != "" {
+		controllerConfigurationReconciler := elbv2controller.NewControllerConfigurationReconciler(mgr.GetClient(), getEventRecorderFor("controllerConfiguration"),
+			defaultTagsProvider, dynamicConfigProvider, controllerCFG, flagDynamicConfig, ctrl.Log.WithName("controllers").WithName("controllerConfiguration"))
+		if err := controllerConfigurationReconciler.SetupWithManager(ctx, mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "ControllerConfiguration")
+			os.Exit(1)
+		}
 	}
 
 	// Add liveness probe
@@ -149,14 +454,88 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Add readiness probes, the controller only becomes ready once the webhook server serves a valid certificate.
+	if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+		setupLog.Error(err, "unable add a readiness check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("webhook-serving-cert", certrotation.NewServingCertChecker(
+		mgr.GetWebhookServer().Host, mgr.GetWebhookServer().Port)); err != nil {
+		setupLog.Error(err, "unable add a readiness check")
+		os.Exit(1)
+	}
+
+	if err := mgr.Add(backendSGProvider); err != nil {
+		setupLog.Error(err, "unable to add backend SG provider")
+		os.Exit(1)
+	}
+	// the EventBridge rule of the deletion events is only managed if not in dry run or shadow mode.
+	if controllerCFG.BackendSecurityGroupDeletionEventsQueueURL != "" && !controllerCFG.DryRun && !controllerCFG.ShadowMode {
+		backendSGDeletionEventWatcher := networking.NewBackendSGDeletionEventWatcher(controllerCFG.ClusterName,
+			controllerCFG.BackendSecurityGroupDeletionEventsQueueURL, cloud.EventBridge(), cloud.SQS(), backendSGProvider.HandleSGDeleted,
+			ctrl.Log.WithName("backend-sg-deletion-event-watcher"))
+		if err := mgr.Add(backendSGDeletionEventWatcher); err != nil {
+			setupLog.Error(err, "unable to add backend SG deletion event watcher")
+			os.Exit(1)
+		}
+	}
+
+	if controllerCFG.WebhookCertRotationConfig.EnableWebhookCertRotation {
+		certRotator := certrotation.NewCertRotator(mgr.GetClient(), mgr.GetAPIReader(),
+			controllerCFG.WebhookCertRotationConfig, ctrl.Log.WithName("webhook-cert-rotator"))
+		if err := mgr.Add(certRotator); err != nil {
+			setupLog.Error(err, "unable to add webhook cert rotator")
+			os.Exit(1)
+		}
+	}
+
+	if controllerCFG.OrphanedResourcesGCConfig.Interval > 0 {
+		orphanedResourcesCollector := gc.NewDefaultOrphanedResourcesCollector(cloud, mgr.GetClient(), newEventRecorder("ingress"),
+			controllerCFG, ctrl.Log.WithName("orphaned-resources-collector"))
+		if err := mgr.Add(orphanedResourcesCollector); err != nil {
+			setupLog.Error(err, "unable to add orphaned resources collector")
+			os.Exit(1)
+		}
+	}
+
+	// the shadow controller doesn't update Kubernetes objects, thus the capabilities are only published by the active controller.
+	if controllerCFG.ControllerCapabilitiesName != "" && !controllerCFG.ShadowMode {
+		capabilitiesPublisher := capabilities.NewDefaultPublisher(mgr.GetClient(), controllerCFG, dynamicConfigProvider,
+			ctrl.Log.WithName("controller-capabilities-publisher"))
+		if err := mgr.Add(capabilitiesPublisher); err != nil {
+			setupLog.Error(err, "unable to add controller capabilities publisher")
+			os.Exit(1)
+		}
+	}
+
+	if controllerCFG.RecoveryReadinessConfig.ResourceSet != "" {
+		resourceSetSyncer := recoveryreadiness.NewDefaultResourceSetSyncer(cloud, controllerCFG, ctrl.Log.WithName("recovery-readiness-resource-set-syncer"))
+		if err := mgr.Add(resourceSetSyncer); err != nil {
+			setupLog.Error(err, "unable to add recovery readiness resource set syncer")
+			os.Exit(1)
+		}
+	}
+
 	podReadinessGateInjector := inject.NewPodReadinessGate(controllerCFG.PodWebhookConfig,
 		mgr.GetClient(), ctrl.Log.WithName("pod-readiness-gate-injector"))
 	corewebhook.NewPodMutator(podReadinessGateInjector).SetupWithManager(mgr)
-	corewebhook.NewServiceMutator(controllerCFG.ServiceConfig.LoadBalancerClass, ctrl.Log).SetupWithManager(mgr)
+	corewebhook.NewServiceMutator(controllerCFG.ServiceConfig.LoadBalancerClass, controllerCFG.DeniedTagKeyPrefixes, ctrl.Log).SetupWithManager(mgr)
+	var privilegedAnnotationAuthorizer *webhook.PrivilegedAnnotationAuthorizer
+	if controllerCFG.FeatureGates.Enabled(config.PrivilegedAnnotationsAuthz) {
+		privilegedAnnotationAuthorizer = webhook.NewPrivilegedAnnotationAuthorizer(clientSet.AuthorizationV1().SubjectAccessReviews())
+	}
+	corewebhook.NewServiceValidator(mgr.GetClient(), privilegedAnnotationAuthorizer, ctrl.Log).SetupWithManager(mgr)
+	if controllerCFG.FeatureGates.Enabled(config.PodDeregistrationCoordination) && !controllerCFG.DryRun && !controllerCFG.ShadowMode {
+		podDeregistrationCoordinator := targetgroupbinding.NewDefaultPodDeregistrationCoordinator(mgr.GetClient(), cloud,
+			ctrl.Log.WithName("pod-deregistration-coordinator"))
+		corewebhook.NewPodValidator(podDeregistrationCoordinator, ctrl.Log).SetupWithManager(mgr)
+	}
 	elbv2webhook.NewIngressClassParamsValidator().SetupWithManager(mgr)
-	elbv2webhook.NewTargetGroupBindingMutator(cloud.ELBV2(), ctrl.Log).SetupWithManager(mgr)
-	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), cloud.ELBV2(), ctrl.Log).SetupWithManager(mgr)
-	networkingwebhook.NewIngressValidator(mgr.GetClient(), controllerCFG.IngressConfig, ctrl.Log).SetupWithManager(mgr)
+	elbv2webhook.NewLoadBalancerConfigurationValidator().SetupWithManager(mgr)
+	elbv2webhook.NewTargetGroupBindingMutator(cloud, ctrl.Log).SetupWithManager(mgr)
+	elbv2webhook.NewTargetGroupBindingValidator(mgr.GetClient(), cloud, ctrl.Log).SetupWithManager(mgr)
+	networkingwebhook.NewIngressValidator(mgr.GetClient(), controllerCFG.IngressConfig, controllerCFG.DeniedTagKeyPrefixes,
+		privilegedAnnotationAuthorizer, ctrl.Log).SetupWithManager(mgr)
 	//+kubebuilder:scaffold:builder
 
 	go func() {
@@ -182,6 +561,7 @@ func loadControllerConfig() (config.ControllerConfig, error) {
 	controllerCFG := config.ControllerConfig{
 		AWSConfig: aws.CloudConfig{
 			ThrottleConfig: defaultAWSThrottleCFG,
+			BudgetConfig:   retry.NewDefaultReadWriteBudgetConfig(),
 		},
 		FeatureGates: config.NewFeatureGates(),
 	}
@@ -199,8 +579,35 @@ func loadControllerConfig() (config.ControllerConfig, error) {
 	return controllerCFG, nil
 }
 
+// printIAMPolicy prints the IAM policy required by the controller running with flags in args.
+// The flags aren't validated, so that the policy can be computed without settings like cluster name.
+func printIAMPolicy(args []string) error {
+	controllerCFG := config.ControllerConfig{
+		AWSConfig: aws.CloudConfig{
+			ThrottleConfig: throttle.NewDefaultServiceOperationsThrottleConfig(),
+			BudgetConfig:   retry.NewDefaultReadWriteBudgetConfig(),
+		},
+		FeatureGates: config.NewFeatureGates(),
+	}
+
+	fs := pflag.NewFlagSet(subcommandIAMPolicy, pflag.ContinueOnError)
+	controllerCFG.BindFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	policy := iampolicy.BuildPolicy(controllerCFG)
+	payload, err := json.MarshalIndent(policy, "", "    ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(payload))
+	return err
+}
+
 // getLoggerWithLogLevel returns logger with specific log level.
-func getLoggerWithLogLevel(logLevel string) logr.Logger {
+// the log lines carrying the correlation IDs of debugLoggingTargets are logged up to the max debug logging verbosity regardless of log level.
+func getLoggerWithLogLevel(logLevel string, debugLoggingTargets *runtime.DebugLoggingTargets) logr.Logger {
 	var zapLevel zapraw.AtomicLevel
 	switch logLevel {
 	case "info":
@@ -210,9 +617,16 @@ func getLoggerWithLogLevel(logLevel string) logr.Logger {
 	default:
 		zapLevel = zapraw.NewAtomicLevelAt(zapraw.InfoLevel)
 	}
+	baseVerbosity := -int(zapLevel.Level())
+	if debugLoggingTargets != nil {
+		zapLevel = zapraw.NewAtomicLevelAt(zapcore.Level(-runtime.MaxDebugLoggingVerbosity))
+	}
 
 	logger := zap.New(zap.UseDevMode(false),
 		zap.Level(zapLevel),
 		zap.StacktraceLevel(zapraw.NewAtomicLevelAt(zapraw.FatalLevel)))
+	if debugLoggingTargets != nil {
+		return runtime.NewTargetedDebugLogger(runtime.NewConciseLogger(logger), baseVerbosity, debugLoggingTargets)
+	}
 	return runtime.NewConciseLogger(logger)
 }
@@ -14,21 +14,28 @@ const (
 	IngressSuffixScheme                       = "scheme"
 	IngressSuffixSubnets                      = "subnets"
 	IngressSuffixCustomerOwnedIPv4Pool        = "customer-owned-ipv4-pool"
+	IngressSuffixIPAMIPv4PoolID               = "ipam-ipv4-pool-id"
 	IngressSuffixLoadBalancerAttributes       = "load-balancer-attributes"
+	IngressSuffixMinimumLoadBalancerCapacity  = "minimum-load-balancer-capacity"
 	IngressSuffixWAFv2ACLARN                  = "wafv2-acl-arn"
 	IngressSuffixWAFACLID                     = "waf-acl-id"
 	IngressSuffixWebACLID                     = "web-acl-id" // deprecated, use "waf-acl-id" instead.
+	IngressSuffixWAFFailOpen                  = "waf-fail-open"
 	IngressSuffixShieldAdvancedProtection     = "shield-advanced-protection"
+	IngressSuffixGlobalAcceleratorEnabled     = "global-accelerator-enabled"
 	IngressSuffixSecurityGroups               = "security-groups"
 	IngressSuffixListenPorts                  = "listen-ports"
 	IngressSuffixSSLRedirect                  = "ssl-redirect"
 	IngressSuffixInboundCIDRs                 = "inbound-cidrs"
+	IngressSuffixSecurityGroupPrefixLists     = "security-group-prefix-lists"
 	IngressSuffixCertificateARN               = "certificate-arn"
 	IngressSuffixSSLPolicy                    = "ssl-policy"
 	IngressSuffixTargetType                   = "target-type"
+	IngressSuffixTargetIPFamilyPolicy         = "target-ip-family-policy"
 	IngressSuffixBackendProtocol              = "backend-protocol"
 	IngressSuffixBackendProtocolVersion       = "backend-protocol-version"
 	IngressSuffixTargetGroupAttributes        = "target-group-attributes"
+	IngressSuffixTargetGroupSplitByAttributes = "target-group-split-by-attributes"
 	IngressSuffixHealthCheckPort              = "healthcheck-port"
 	IngressSuffixHealthCheckProtocol          = "healthcheck-protocol"
 	IngressSuffixHealthCheckPath              = "healthcheck-path"
@@ -46,12 +53,33 @@ const (
 	IngressSuffixAuthSessionTimeout           = "auth-session-timeout"
 	IngressSuffixTargetNodeLabels             = "target-node-labels"
 	IngressSuffixManageSecurityGroupRules     = "manage-backend-security-group-rules"
+	IngressSuffixExternallyManagedNetworking  = "externally-managed-networking"
+	IngressSuffixMultiClusterTargetGroup      = "multi-cluster-target-group"
+	IngressSuffixAWSRoleARN                   = "aws-role-arn"
+	IngressSuffixMutualAuthentication         = "mutual-authentication"
+	IngressSuffixTrustStoreBundle             = "mutual-authentication-trust-store-bundle"
+	IngressSuffixListenerAttributes           = "listener-attributes"
+	IngressSuffixDryRun                       = "dry-run"
+	IngressSuffixDeletionPolicy               = "deletion-policy"
+	IngressSuffixRecreateResources            = "recreate-resources"
+	IngressSuffixRulePriorityBase             = "rule-priority-base"
+	IngressSuffixRulePriorities               = "rule-priorities"
+
+	// Ingress frontend NLB annotation suffixes
+	IngressSuffixEnableFrontendNLB              = "enable-frontend-nlb"
+	IngressSuffixFrontendNLBScheme              = "frontend-nlb-scheme"
+	IngressSuffixFrontendNLBSubnets             = "frontend-nlb-subnets"
+	IngressSuffixFrontendNLBEIPAllocations      = "frontend-nlb-eip-allocations"
+	IngressSuffixFrontendNLBHealthCheckProtocol = "frontend-nlb-healthcheck-protocol"
+	IngressSuffixFrontendNLBHealthCheckPath     = "frontend-nlb-healthcheck-path"
+	IngressSuffixFrontendNLBSuccessCodes        = "frontend-nlb-success-codes"
 
 	// NLB annotation suffixes
 	// prefixes service.beta.kubernetes.io, service.kubernetes.io
 	SvcLBSuffixSourceRanges                  = "load-balancer-source-ranges"
 	SvcLBSuffixLoadBalancerType              = "aws-load-balancer-type"
 	SvcLBSuffixTargetType                    = "aws-load-balancer-nlb-target-type"
+	SvcLBSuffixTargetIPFamilyPolicy          = "aws-load-balancer-target-ip-family-policy"
 	SvcLBSuffixLoadBalancerName              = "aws-load-balancer-name"
 	SvcLBSuffixScheme                        = "aws-load-balancer-scheme"
 	SvcLBSuffixInternal                      = "aws-load-balancer-internal"
@@ -77,6 +105,7 @@ const (
 	SvcLBSuffixTargetGroupAttributes         = "aws-load-balancer-target-group-attributes"
 	SvcLBSuffixSubnets                       = "aws-load-balancer-subnets"
 	SvcLBSuffixEIPAllocations                = "aws-load-balancer-eip-allocations"
+	SvcLBSuffixEIPPool                       = "aws-load-balancer-eip-pool"
 	SvcLBSuffixPrivateIpv4Addresses          = "aws-load-balancer-private-ipv4-addresses"
 	SvcLBSuffixIpv6Addresses                 = "aws-load-balancer-ipv6-addresses"
 	SvcLBSuffixALPNPolicy                    = "aws-load-balancer-alpn-policy"
@@ -84,4 +113,38 @@ const (
 	SvcLBSuffixLoadBalancerAttributes        = "aws-load-balancer-attributes"
 	SvcLBSuffixLoadBalancerSecurityGroups    = "aws-load-balancer-security-groups"
 	SvcLBSuffixManageSGRules                 = "aws-load-balancer-manage-backend-security-group-rules"
+	SvcLBSuffixExternallyManagedNetworking   = "aws-load-balancer-externally-managed-networking"
+	SvcLBSuffixSecurityGroupPrefixLists      = "aws-load-balancer-security-group-prefix-lists"
+	SvcLBSuffixMultiClusterTargetGroup       = "aws-load-balancer-multi-cluster-target-group"
+	SvcLBSuffixConnectionTermination         = "aws-load-balancer-connection-termination"
+	SvcLBSuffixListenerAttributes            = "aws-load-balancer-listener-attributes"
+	SvcLBSuffixEndpointServiceEnabled        = "aws-load-balancer-endpoint-service-enabled"
+	SvcLBSuffixEndpointServiceAcceptance     = "aws-load-balancer-endpoint-service-acceptance-required"
+	SvcLBSuffixEndpointServicePrincipals     = "aws-load-balancer-endpoint-service-allowed-principals"
+	SvcLBSuffixGlobalAcceleratorEnabled      = "aws-load-balancer-global-accelerator-enabled"
+	SvcLBSuffixHostname                      = "aws-load-balancer-hostname"
+	SvcLBSuffixDryRun                        = "aws-load-balancer-dry-run"
+	SvcLBSuffixDeletionPolicy                = "aws-load-balancer-deletion-policy"
+	SvcLBSuffixRecreateResources             = "aws-load-balancer-recreate-resources"
+	SvcLBSuffixLoadBalancerConfiguration     = "aws-load-balancer-configuration"
+
+	// Gateway annotation suffixes
+	// prefix gateway.k8s.aws
+	AnnotationPrefixGateway              = "gateway.k8s.aws"
+	GatewaySuffixLoadBalancerName        = "load-balancer-name"
+	GatewaySuffixScheme                  = "scheme"
+	GatewaySuffixIPAddressType           = "ip-address-type"
+	GatewaySuffixSubnets                 = "subnets"
+	GatewaySuffixTags                    = "tags"
+	GatewaySuffixLoadBalancerAttributes  = "load-balancer-attributes"
+	GatewaySuffixInboundCIDRs            = "inbound-cidrs"
+	GatewaySuffixCertificateARN          = "certificate-arn"
+	GatewaySuffixSSLPolicy               = "ssl-policy"
+	GatewaySuffixTargetType              = "target-type"
+	GatewaySuffixBackendProtocol         = "backend-protocol"
+	GatewaySuffixBackendProtocolVersion  = "backend-protocol-version"
+	GatewaySuffixTargetGroupAttributes   = "target-group-attributes"
+	GatewaySuffixHealthCheckPath         = "healthcheck-path"
+	GatewaySuffixHealthCheckSuccessCodes = "success-codes"
+	GatewaySuffixDryRun                  = "dry-run"
 )
@@ -1,24 +1,45 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
 	"strings"
-
+	"sync"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	stscredsv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	ec2v2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/smithy-go/middleware"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/cache"
 	amerrors "k8s.io/apimachinery/pkg/util/errors"
 	epresolver "sigs.k8s.io/aws-load-balancer-controller/pkg/aws/endpoints"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/metrics"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/retry"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
 )
 
+const (
+	// maxAssumedRoleClouds is the max count of cached Clouds of assumed IAM roles.
+	maxAssumedRoleClouds = 64
+	// assumedRoleCloudTTL is the duration a Cloud of assumed IAM role is cached for.
+	assumedRoleCloudTTL = time.Hour
+	// ec2MetadataEndpointID is the ID the custom EC2 instance metadata endpoint is configured with.
+	ec2MetadataEndpointID = "ec2metadata"
+)
+
 type Cloud interface {
 	// EC2 provides API to AWS EC2
 	EC2() services.EC2
@@ -41,11 +62,38 @@ type Cloud interface {
 	// RGT provides API to AWS RGT
 	RGT() services.RGT
 
+	// CloudWatch provides API to AWS CloudWatch
+	CloudWatch() services.CloudWatch
+
+	// SecretsManager provides API to AWS SecretsManager
+	SecretsManager() services.SecretsManager
+
+	// Route53RecoveryReadiness provides API to AWS Route53RecoveryReadiness
+	Route53RecoveryReadiness() services.Route53RecoveryReadiness
+
+	// GlobalAccelerator provides API to AWS GlobalAccelerator
+	GlobalAccelerator() services.GlobalAccelerator
+
+	// Route53 provides API to AWS Route53
+	Route53() services.Route53
+
+	// EventBridge provides API to AWS EventBridge
+	EventBridge() services.EventBridge
+
+	// SNS provides API to AWS SNS
+	SNS() services.SNS
+
+	// SQS provides API to AWS SQS
+	SQS() services.SQS
+
 	// Region for the kubernetes cluster
 	Region() string
 
 	// VpcID for the LoadBalancer resources.
 	VpcID() string
+
+	// AssumeRole returns a Cloud whose AWS APIs are invoked with credentials from assuming the IAM role.
+	AssumeRole(roleARN string) Cloud
 }
 
 // NewCloud constructs new Cloud implementation.
@@ -63,16 +111,25 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 		}
 	}
 
+	if err := epresolver.ValidateConfiguration(cfg.AWSEndpoints); err != nil {
+		return nil, err
+	}
 	endpointsResolver := epresolver.NewResolver(cfg.AWSEndpoints)
-	metadataCFG := aws.NewConfig().WithEndpointResolver(endpointsResolver)
-	opts := session.Options{}
-	opts.Config.MergeIn(metadataCFG)
+	// sdkV2LoadOptions configure the aws-sdk-go-v2 config the same way as the aws-sdk-go session.
+	sdkV2LoadOptions := []func(*config.LoadOptions) error{
+		config.WithEndpointResolverWithOptions(endpointsResolver),
+	}
+	if ec2MetadataEndpoint := cfg.AWSEndpoints[ec2MetadataEndpointID]; len(ec2MetadataEndpoint) != 0 {
+		sdkV2LoadOptions = append(sdkV2LoadOptions, config.WithEC2IMDSEndpoint(endpoints.AddScheme(ec2MetadataEndpoint, false)))
+	}
 	if !hasIPv4 {
-		opts.EC2IMDSEndpointMode = endpoints.EC2IMDSEndpointModeStateIPv6
+		sdkV2LoadOptions = append(sdkV2LoadOptions, config.WithEC2IMDSEndpointMode(imds.EndpointModeStateIPv6))
 	}
-
-	metadataSess := session.Must(session.NewSessionWithOptions(opts))
-	metadata := services.NewEC2Metadata(metadataSess)
+	metadataCFG, err := config.LoadDefaultConfig(context.Background(), sdkV2LoadOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load AWS config")
+	}
+	metadata := services.NewEC2Metadata(metadataCFG)
 
 	if len(cfg.Region) == 0 {
 		region := os.Getenv("AWS_DEFAULT_REGION")
@@ -89,28 +146,73 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 		}
 		cfg.Region = region
 	}
-	awsCFG := aws.NewConfig().WithRegion(cfg.Region).WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint).WithMaxRetries(cfg.MaxRetries).WithEndpointResolver(endpointsResolver)
-	opts = session.Options{}
+	awsCFG := aws.NewConfig().WithRegion(cfg.Region).WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint).WithEndpointResolver(endpointsResolver)
+	awsCFG.Retryer = retry.NewRetryer(cfg.MaxRetries)
+	if cfg.UseFIPSEndpoint {
+		awsCFG.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+		sdkV2LoadOptions = append(sdkV2LoadOptions, config.WithUseFIPSEndpoint(awsv2.FIPSEndpointStateEnabled))
+	}
+	if cfg.UseDualStackEndpoint {
+		awsCFG.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+		sdkV2LoadOptions = append(sdkV2LoadOptions, config.WithUseDualStackEndpoint(awsv2.DualStackEndpointStateEnabled))
+	}
+	opts := session.Options{}
 	opts.Config.MergeIn(awsCFG)
 	if !hasIPv4 {
 		opts.EC2IMDSEndpointMode = endpoints.EC2IMDSEndpointModeStateIPv6
 	}
 	sess := session.Must(session.NewSessionWithOptions(opts))
 	injectUserAgent(&sess.Handlers)
+	// sdkV2APIOptions inject the equivalent of the session handlers into the aws-sdk-go-v2 clients.
+	// The middleware inserted right after the retry middleware run in the reverse order of sdkV2APIOptions.
+	sdkV2APIOptions := []func(*middleware.Stack) error{injectUserAgentMiddleware}
 
+	inFlightLimiter, err := retry.NewInFlightLimiter(cfg.InFlightConfig, metricsRegisterer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to initialize in-flight requests limiter")
+	}
+	inFlightLimiter.InjectHandlers(&sess.Handlers)
+	sdkV2APIOptions = append(sdkV2APIOptions, inFlightLimiter.InjectMiddleware)
+	if cfg.RetryMode == retry.ModeAdaptive {
+		adaptiveRateLimiter, err := retry.NewAdaptiveRateLimiter(metricsRegisterer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to initialize adaptive rate limiter")
+		}
+		adaptiveRateLimiter.InjectHandlers(&sess.Handlers)
+		sdkV2APIOptions = append(sdkV2APIOptions, adaptiveRateLimiter.InjectMiddleware)
+	}
+	readWriteBudget := retry.NewReadWriteBudget(cfg.BudgetConfig)
+	readWriteBudget.InjectHandlers(&sess.Handlers)
+	sdkV2APIOptions = append(sdkV2APIOptions, readWriteBudget.InjectMiddleware)
 	if cfg.ThrottleConfig != nil {
 		throttler := throttle.NewThrottler(cfg.ThrottleConfig)
 		throttler.InjectHandlers(&sess.Handlers)
+		sdkV2APIOptions = append(sdkV2APIOptions, throttler.InjectMiddleware)
 	}
+	metrics.InjectAPICallCounterHandlers(&sess.Handlers)
+	sdkV2APIOptions = append(sdkV2APIOptions, metrics.InjectAPICallCounterMiddleware)
 	if metricsRegisterer != nil {
 		metricsCollector, err := metrics.NewCollector(metricsRegisterer)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to initialize sdk metrics collector")
 		}
 		metricsCollector.InjectHandlers(&sess.Handlers)
+		sdkV2APIOptions = append(sdkV2APIOptions, metricsCollector.InjectMiddleware)
 	}
 
-	ec2Service := services.NewEC2(sess)
+	sdkV2LoadOptions = append(sdkV2LoadOptions,
+		config.WithRegion(cfg.Region),
+		config.WithRetryer(func() awsv2.Retryer {
+			return readWriteBudget.WrapRetryer(retry.NewSDKV2Retryer(cfg.MaxRetries))
+		}),
+		config.WithAPIOptions(sdkV2APIOptions),
+	)
+	sdkV2CFG, err := config.LoadDefaultConfig(context.Background(), sdkV2LoadOptions...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load AWS config")
+	}
+
+	ec2Service := services.NewEC2(sdkV2CFG)
 
 	if len(cfg.VpcID) == 0 {
 		vpcID, err := inferVPCID(metadata, ec2Service)
@@ -120,16 +222,31 @@ func NewCloud(cfg CloudConfig, metricsRegisterer prometheus.Registerer) (Cloud,
 		cfg.VpcID = vpcID
 	}
 
+	return newDefaultCloud(cfg, sess, sdkV2CFG, ec2Service), nil
+}
+
+func newDefaultCloud(cfg CloudConfig, sess *session.Session, sdkV2CFG awsv2.Config, ec2Service services.EC2) *defaultCloud {
 	return &defaultCloud{
-		cfg:         cfg,
-		ec2:         ec2Service,
-		elbv2:       services.NewELBV2(sess),
-		acm:         services.NewACM(sess),
-		wafv2:       services.NewWAFv2(sess),
-		wafRegional: services.NewWAFRegional(sess, cfg.Region),
-		shield:      services.NewShield(sess),
-		rgt:         services.NewRGT(sess),
-	}, nil
+		cfg:               cfg,
+		sess:              sess,
+		sdkV2CFG:          sdkV2CFG,
+		ec2:               ec2Service,
+		elbv2:             services.NewELBV2(sdkV2CFG),
+		acm:               services.NewACM(sdkV2CFG),
+		wafv2:             services.NewWAFv2(sdkV2CFG),
+		wafRegional:       services.NewWAFRegional(sess, cfg.Region),
+		shield:            services.NewShield(sess),
+		rgt:               services.NewRGT(sdkV2CFG),
+		cloudWatch:        services.NewCloudWatch(sdkV2CFG),
+		secretsManager:    services.NewSecretsManager(sdkV2CFG),
+		recoveryReadiness: services.NewRoute53RecoveryReadiness(sess),
+		globalAccelerator: services.NewGlobalAccelerator(sess),
+		route53:           services.NewRoute53(sdkV2CFG),
+		eventBridge:       services.NewEventBridge(sdkV2CFG),
+		sns:               services.NewSNS(sdkV2CFG),
+		sqs:               services.NewSQS(sdkV2CFG),
+		assumedRoleClouds: cache.NewLRUExpireCache(maxAssumedRoleClouds),
+	}
 }
 
 func inferVPCID(metadata services.EC2Metadata, ec2Service services.EC2) (string, error) {
@@ -143,8 +260,8 @@ func inferVPCID(metadata services.EC2Metadata, ec2Service services.EC2) (string,
 
 	nodeName := os.Getenv("NODENAME")
 	if strings.HasPrefix(nodeName, "i-") {
-		output, err := ec2Service.DescribeInstances(&ec2.DescribeInstancesInput{
-			InstanceIds: []*string{&nodeName},
+		output, err := ec2Service.DescribeInstancesWithContext(context.Background(), &ec2v2.DescribeInstancesInput{
+			InstanceIds: []string{nodeName},
 		})
 		if err != nil {
 			errList = append(errList, errors.Wrapf(err, "failed to describe instance %q", nodeName))
@@ -171,7 +288,10 @@ func inferVPCID(metadata services.EC2Metadata, ec2Service services.EC2) (string,
 var _ Cloud = &defaultCloud{}
 
 type defaultCloud struct {
-	cfg CloudConfig
+	cfg  CloudConfig
+	sess *session.Session
+	// sdkV2CFG is the aws-sdk-go-v2 config, with the equivalent of the handlers of sess.
+	sdkV2CFG awsv2.Config
 
 	ec2   services.EC2
 	elbv2 services.ELBV2
@@ -181,6 +301,19 @@ type defaultCloud struct {
 	wafRegional services.WAFRegional
 	shield      services.Shield
 	rgt         services.RGT
+	cloudWatch  services.CloudWatch
+
+	secretsManager    services.SecretsManager
+	recoveryReadiness services.Route53RecoveryReadiness
+	globalAccelerator services.GlobalAccelerator
+	route53           services.Route53
+	eventBridge       services.EventBridge
+	sns               services.SNS
+	sqs               services.SQS
+
+	// assumedRoleClouds caches the Cloud per assumed IAM role ARN, the least recently used ones are evicted beyond its size.
+	assumedRoleClouds      *cache.LRUExpireCache
+	assumedRoleCloudsMutex sync.Mutex
 }
 
 func (c *defaultCloud) EC2() services.EC2 {
@@ -211,6 +344,38 @@ func (c *defaultCloud) RGT() services.RGT {
 	return c.rgt
 }
 
+func (c *defaultCloud) CloudWatch() services.CloudWatch {
+	return c.cloudWatch
+}
+
+func (c *defaultCloud) SecretsManager() services.SecretsManager {
+	return c.secretsManager
+}
+
+func (c *defaultCloud) Route53RecoveryReadiness() services.Route53RecoveryReadiness {
+	return c.recoveryReadiness
+}
+
+func (c *defaultCloud) GlobalAccelerator() services.GlobalAccelerator {
+	return c.globalAccelerator
+}
+
+func (c *defaultCloud) Route53() services.Route53 {
+	return c.route53
+}
+
+func (c *defaultCloud) EventBridge() services.EventBridge {
+	return c.eventBridge
+}
+
+func (c *defaultCloud) SNS() services.SNS {
+	return c.sns
+}
+
+func (c *defaultCloud) SQS() services.SQS {
+	return c.sqs
+}
+
 func (c *defaultCloud) Region() string {
 	return c.cfg.Region
 }
@@ -218,3 +383,27 @@ func (c *defaultCloud) Region() string {
 func (c *defaultCloud) VpcID() string {
 	return c.cfg.VpcID
 }
+
+// ELBV2ForRole returns the ELBV2 client that's invoked with credentials from assuming the IAM role roleARN via cloud,
+// elbv2Client is returned as is if roleARN is empty.
+func ELBV2ForRole(cloud Cloud, elbv2Client services.ELBV2, roleARN string) services.ELBV2 {
+	if roleARN == "" {
+		return elbv2Client
+	}
+	return cloud.AssumeRole(roleARN).ELBV2()
+}
+
+func (c *defaultCloud) AssumeRole(roleARN string) Cloud {
+	c.assumedRoleCloudsMutex.Lock()
+	defer c.assumedRoleCloudsMutex.Unlock()
+	if cloud, exists := c.assumedRoleClouds.Get(roleARN); exists {
+		return cloud.(Cloud)
+	}
+	// the copied session and config retain the handlers and middleware for user agent, throttling, retry budget and metrics.
+	sess := c.sess.Copy(aws.NewConfig().WithCredentials(stscreds.NewCredentials(c.sess, roleARN)))
+	sdkV2CFG := c.sdkV2CFG.Copy()
+	sdkV2CFG.Credentials = awsv2.NewCredentialsCache(stscredsv2.NewAssumeRoleProvider(sts.NewFromConfig(c.sdkV2CFG), roleARN))
+	cloud := newDefaultCloud(c.cfg, sess, sdkV2CFG, services.NewEC2(sdkV2CFG))
+	c.assumedRoleClouds.Add(roleARN, cloud, assumedRoleCloudTTL)
+	return cloud
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/spf13/pflag"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/retry"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/throttle"
 )
 
@@ -14,6 +15,15 @@ const (
 	flagAWSVpcID         = "aws-vpc-id"
 	flagAWSVpcCacheTTL   = "aws-vpc-cache-ttl"
 	flagAWSMaxRetries    = "aws-max-retries"
+	flagAWSAPIReadLimit  = "aws-api-read-limit"
+	flagAWSAPIReadRetry  = "aws-api-read-retry-budget"
+	flagAWSAPIWriteLimit = "aws-api-write-limit"
+	flagAWSAPIWriteRetry = "aws-api-write-retry-budget"
+	flagAWSAPIMaxReads   = "aws-api-max-inflight-reads"
+	flagAWSAPIMaxWrites  = "aws-api-max-inflight-writes"
+	flagAWSRetryMode     = "aws-retry-mode"
+	flagAWSUseFIPS       = "aws-use-fips-endpoint"
+	flagAWSUseDualStack  = "aws-use-dualstack-endpoint"
 	defaultVpcID         = ""
 	defaultRegion        = ""
 	defaultAPIMaxRetries = 10
@@ -35,8 +45,23 @@ type CloudConfig struct {
 	// Max retries configuration for AWS APIs
 	MaxRetries int
 
+	// Request and retry budget settings for read and write AWS APIs
+	BudgetConfig retry.ReadWriteBudgetConfig
+
+	// Maximum number of in-flight read and write AWS API requests
+	InFlightConfig retry.InFlightConfig
+
+	// Retry mode for AWS APIs
+	RetryMode retry.Mode
+
 	// AWS endpoints configuration
 	AWSEndpoints map[string]string
+
+	// UseFIPSEndpoint specifies whether to resolve FIPS endpoints for AWS APIs without custom endpoint configured.
+	UseFIPSEndpoint bool
+
+	// UseDualStackEndpoint specifies whether to resolve dualstack endpoints for AWS APIs without custom endpoint configured.
+	UseDualStackEndpoint bool
 }
 
 func (cfg *CloudConfig) BindFlags(fs *pflag.FlagSet) {
@@ -44,5 +69,15 @@ func (cfg *CloudConfig) BindFlags(fs *pflag.FlagSet) {
 	fs.Var(cfg.ThrottleConfig, flagAWSAPIThrottle, "throttle settings for AWS APIs, format: serviceID1:operationRegex1=rate:burst,serviceID2:operationRegex2=rate:burst")
 	fs.StringVar(&cfg.VpcID, flagAWSVpcID, defaultVpcID, "AWS VpcID for the LoadBalancer resources")
 	fs.IntVar(&cfg.MaxRetries, flagAWSMaxRetries, defaultAPIMaxRetries, "Maximum retries for AWS APIs")
+	fs.Var(&cfg.BudgetConfig.ReadRequests, flagAWSAPIReadLimit, "rate limit for read(Describe*, Get*, List*) AWS API requests including retries, format: rate:burst, 0:0 to disable")
+	fs.Var(&cfg.BudgetConfig.ReadRetries, flagAWSAPIReadRetry, "retry budget for read(Describe*, Get*, List*) AWS API requests, format: rate:burst, disabled by default or with 0:0, e.g. 5:20")
+	fs.Var(&cfg.BudgetConfig.WriteRequests, flagAWSAPIWriteLimit, "rate limit for write AWS API requests including retries, format: rate:burst, 0:0 to disable")
+	fs.Var(&cfg.BudgetConfig.WriteRetries, flagAWSAPIWriteRetry, "retry budget for write AWS API requests, format: rate:burst, disabled by default or with 0:0, e.g. 5:20")
+	fs.IntVar(&cfg.InFlightConfig.MaxReads, flagAWSAPIMaxReads, 0, "maximum number of in-flight read(Describe*, Get*, List*) AWS API requests, 0 for unlimited")
+	fs.IntVar(&cfg.InFlightConfig.MaxWrites, flagAWSAPIMaxWrites, 0, "maximum number of in-flight write AWS API requests, 0 for unlimited")
+	cfg.RetryMode = retry.ModeStandard
+	fs.Var(&cfg.RetryMode, flagAWSRetryMode, "retry mode for AWS APIs, either standard or adaptive. adaptive additionally limits the request rate to AWS services that throttle requests")
 	fs.StringToStringVar(&cfg.AWSEndpoints, flagAWSAPIEndpoints, nil, "Custom AWS endpoint configuration, format: serviceID1=URL1,serviceID2=URL2")
+	fs.BoolVar(&cfg.UseFIPSEndpoint, flagAWSUseFIPS, false, "Use FIPS endpoints for AWS APIs")
+	fs.BoolVar(&cfg.UseDualStackEndpoint, flagAWSUseDualStack, false, "Use dualstack endpoints for AWS APIs")
 }
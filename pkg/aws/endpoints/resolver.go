@@ -1,9 +1,29 @@
 package endpoints
 
 import (
+	"net/url"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	awsendpoints "github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/pkg/errors"
 )
 
+// sdkV2ServiceEndpointIDs maps the service IDs of aws-sdk-go-v2 clients to the endpoint IDs the custom endpoints are configured with.
+var sdkV2ServiceEndpointIDs = map[string]string{
+	"Elastic Load Balancing v2":   "elasticloadbalancing",
+	"EC2":                         "ec2",
+	"ACM":                         "acm",
+	"WAFV2":                       "wafv2",
+	"Resource Groups Tagging API": "tagging",
+	"CloudWatch":                  "monitoring",
+	"Secrets Manager":             "secretsmanager",
+	"Route 53":                    "route53",
+	"EventBridge":                 "events",
+	"SNS":                         "sns",
+	"SQS":                         "sqs",
+	"STS":                         "sts",
+}
+
 func NewResolver(configuration map[string]string) *resolver {
 	return &resolver{
 		configuration: configuration,
@@ -11,6 +31,7 @@ func NewResolver(configuration map[string]string) *resolver {
 }
 
 var _ awsendpoints.Resolver = &resolver{}
+var _ awsv2.EndpointResolverWithOptions = &resolver{}
 
 // resolver is an AWS endpoints.Resolver that allows to customize AWS API endpoints.
 // It can be configured using the following format "${AWSServiceID}=${URL}"
@@ -23,8 +44,37 @@ func (c *resolver) EndpointFor(service, region string, opts ...func(*awsendpoint
 	customEndpoint := c.configuration[service]
 	if len(customEndpoint) != 0 {
 		return awsendpoints.ResolvedEndpoint{
-			URL: customEndpoint,
+			URL: awsendpoints.AddScheme(customEndpoint, false),
 		}, nil
 	}
 	return awsendpoints.DefaultResolver().EndpointFor(service, region, opts...)
 }
+
+// ResolveEndpoint resolves the custom endpoint of aws-sdk-go-v2 clients by their service ID.
+// The endpoints of services without custom endpoint are left to the SDK, so that FIPS and dualstack endpoints apply to them.
+func (c *resolver) ResolveEndpoint(service, region string, _ ...interface{}) (awsv2.Endpoint, error) {
+	customEndpoint := c.configuration[sdkV2ServiceEndpointIDs[service]]
+	if len(customEndpoint) != 0 {
+		return awsv2.Endpoint{
+			URL:           awsendpoints.AddScheme(customEndpoint, false),
+			SigningRegion: region,
+			Source:        awsv2.EndpointSourceCustom,
+		}, nil
+	}
+	return awsv2.Endpoint{}, &awsv2.EndpointNotFoundError{}
+}
+
+// ValidateConfiguration checks the custom AWS API endpoints are URLs with host,
+// the endpoints without scheme default to https like the AWS SDK does.
+func ValidateConfiguration(configuration map[string]string) error {
+	for service, endpoint := range configuration {
+		u, err := url.Parse(awsendpoints.AddScheme(endpoint, false))
+		if err != nil {
+			return errors.Wrapf(err, "invalid endpoint for %v", service)
+		}
+		if u.Host == "" {
+			return errors.Errorf("invalid endpoint for %v: %v, must have a host", service, endpoint)
+		}
+	}
+	return nil
+}
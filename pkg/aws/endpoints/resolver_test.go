@@ -1,8 +1,10 @@
 package endpoints
 
 import (
+	"errors"
 	"testing"
 
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
 	awsendpoints "github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/stretchr/testify/assert"
 )
@@ -10,7 +12,7 @@ import (
 func TestAWSEndpointResolver_EndpointFor(t *testing.T) {
 	configuration := map[string]string{
 		awsendpoints.Ec2ServiceID:                  "https://ec2.domain.com",
-		awsendpoints.ElasticloadbalancingServiceID: "https://elbv2.domain.com",
+		awsendpoints.ElasticloadbalancingServiceID: "elbv2.domain.com:8443",
 	}
 	c := &resolver{
 		configuration: configuration,
@@ -37,6 +39,15 @@ func TestAWSEndpointResolver_EndpointFor(t *testing.T) {
 				URL: configuration[awsendpoints.Ec2ServiceID],
 			},
 		},
+		{
+			name: "when custom endpoint is configured without scheme",
+			args: args{
+				val: awsendpoints.ElasticloadbalancingServiceID,
+			},
+			want: &awsendpoints.ResolvedEndpoint{
+				URL: "https://elbv2.domain.com:8443",
+			},
+		},
 		{
 			name: "when custom endpoint is unconfigured",
 			args: args{
@@ -64,3 +75,98 @@ func TestAWSEndpointResolver_EndpointFor(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSEndpointResolver_ResolveEndpoint(t *testing.T) {
+	c := &resolver{
+		configuration: map[string]string{
+			awsendpoints.Ec2ServiceID:                  "https://ec2.domain.com",
+			awsendpoints.ElasticloadbalancingServiceID: "elbv2.domain.com:8443",
+		},
+	}
+	tests := []struct {
+		name    string
+		service string
+		want    awsv2.Endpoint
+		wantErr bool
+	}{
+		{
+			name:    "when custom endpoint is configured",
+			service: "EC2",
+			want: awsv2.Endpoint{
+				URL:           "https://ec2.domain.com",
+				SigningRegion: "region",
+				Source:        awsv2.EndpointSourceCustom,
+			},
+		},
+		{
+			name:    "when custom endpoint is configured without scheme",
+			service: "Elastic Load Balancing v2",
+			want: awsv2.Endpoint{
+				URL:           "https://elbv2.domain.com:8443",
+				SigningRegion: "region",
+				Source:        awsv2.EndpointSourceCustom,
+			},
+		},
+		{
+			name:    "when custom endpoint is unconfigured",
+			service: "WAFV2",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.ResolveEndpoint(tt.service, "region")
+			if tt.wantErr {
+				var notFoundErr *awsv2.EndpointNotFoundError
+				assert.True(t, errors.As(err, &notFoundErr))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateConfiguration(t *testing.T) {
+	tests := []struct {
+		name          string
+		configuration map[string]string
+		wantErr       error
+	}{
+		{
+			name: "valid endpoints",
+			configuration: map[string]string{
+				awsendpoints.Ec2ServiceID:                  "https://ec2.domain.com",
+				awsendpoints.ElasticloadbalancingServiceID: "https://vpce-0123.elasticloadbalancing.us-gov-west-1.vpce.amazonaws.com",
+			},
+		},
+		{
+			name:          "no endpoints",
+			configuration: nil,
+		},
+		{
+			name: "endpoints without scheme",
+			configuration: map[string]string{
+				awsendpoints.Ec2ServiceID:                  "ec2.domain.com",
+				awsendpoints.ElasticloadbalancingServiceID: "elbv2.domain.com:8443",
+			},
+		},
+		{
+			name: "endpoint without host",
+			configuration: map[string]string{
+				awsendpoints.Ec2ServiceID: "https:///ec2",
+			},
+			wantErr: errors.New("invalid endpoint for ec2: https:///ec2, must have a host"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateConfiguration(tt.configuration)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,576 @@
+package iampolicy
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+)
+
+// partitionPlaceholder is replaced with the AWS partition in resource ARNs.
+const partitionPlaceholder = "${Partition}"
+
+const (
+	clusterRequestTagKey  = "aws:RequestTag/elbv2.k8s.aws/cluster"
+	clusterResourceTagKey = "aws:ResourceTag/elbv2.k8s.aws/cluster"
+)
+
+// requirement determines whether a permission is required by the controller running with specified configuration.
+type requirement func(cfg config.ControllerConfig) bool
+
+// permission is a set of IAM actions the controller requires, together with the resources and condition they're scoped to.
+type permission struct {
+	actions   []string
+	resources []string
+	condition Condition
+	// mutating is whether the actions change AWS resources.
+	mutating bool
+	// requirement is nil if the permission is always required.
+	requirement requirement
+}
+
+func featureEnabled(feature config.Feature) requirement {
+	return func(cfg config.ControllerConfig) bool {
+		return cfg.FeatureGates.Enabled(feature)
+	}
+}
+
+func wafEnabled(cfg config.ControllerConfig) bool {
+	return cfg.AddonsConfig.WAFEnabled
+}
+
+func wafv2Enabled(cfg config.ControllerConfig) bool {
+	return cfg.AddonsConfig.WAFV2Enabled
+}
+
+func wafOrWAFv2Enabled(cfg config.ControllerConfig) bool {
+	return cfg.AddonsConfig.WAFEnabled || cfg.AddonsConfig.WAFV2Enabled
+}
+
+func shieldEnabled(cfg config.ControllerConfig) bool {
+	return cfg.AddonsConfig.ShieldEnabled
+}
+
+func ruleMetricsEnabled(cfg config.ControllerConfig) bool {
+	return cfg.IngressConfig.RuleMetricsPollInterval > 0
+}
+
+func recoveryReadinessEnabled(cfg config.ControllerConfig) bool {
+	return cfg.RecoveryReadinessConfig.ResourceSet != ""
+}
+
+func ownershipEventBusEnabled(cfg config.ControllerConfig) bool {
+	return cfg.OwnershipEventsConfig.EventBus != ""
+}
+
+func ownershipSNSTopicEnabled(cfg config.ControllerConfig) bool {
+	return cfg.OwnershipEventsConfig.SNSTopicARN != ""
+}
+
+func backendSGDeletionEventsEnabled(cfg config.ControllerConfig) bool {
+	return cfg.BackendSecurityGroupDeletionEventsQueueURL != "" && !cfg.DryRun && !cfg.ShadowMode
+}
+
+func route53RecordsEnabled(cfg config.ControllerConfig) bool {
+	return cfg.FeatureGates.Enabled(config.Route53AliasRecords) || cfg.FeatureGates.Enabled(config.ACMCertRequests)
+}
+
+// clusterRequestTagged restricts the actions to requests tagging the resource with cluster tag.
+var clusterRequestTagged = Condition{
+	"Null": {clusterRequestTagKey: "false"},
+}
+
+// clusterResourceTagged restricts the actions to resources tagged with cluster tag.
+var clusterResourceTagged = Condition{
+	"Null": {clusterResourceTagKey: "false"},
+}
+
+// clusterResourceTaggedWithoutRequestTag restricts the actions to resources tagged with cluster tag,
+// and the cluster tag itself cannot be changed.
+var clusterResourceTaggedWithoutRequestTag = Condition{
+	"Null": {
+		clusterRequestTagKey:  "true",
+		clusterResourceTagKey: "false",
+	},
+}
+
+// ec2CreateActionTagged restricts tagging to the creation of resources by ec2 action, with cluster tag.
+func ec2CreateActionTagged(createAction string) Condition {
+	return Condition{
+		"StringEquals": {"ec2:CreateAction": createAction},
+		"Null":         {clusterRequestTagKey: "false"},
+	}
+}
+
+// permissions is the mapping from the features of controller to the IAM permissions they require.
+// Every AWS API operation invoked by the controller must be covered, which is verified by tests.
+var permissions = []permission{
+	{
+		actions: []string{
+			"iam:CreateServiceLinkedRole",
+		},
+		condition: Condition{
+			"StringEquals": {"iam:AWSServiceName": "elasticloadbalancing.amazonaws.com"},
+		},
+		mutating: true,
+	},
+	{
+		actions: []string{
+			"ec2:DescribeAddresses",
+			"ec2:DescribeAvailabilityZones",
+			"ec2:DescribeVpcs",
+			"ec2:DescribeSubnets",
+			"ec2:DescribeRouteTables",
+			"ec2:DescribeSecurityGroups",
+			"ec2:DescribeInstances",
+			"ec2:DescribeNetworkInterfaces",
+			"ec2:GetCoipPoolUsage",
+			"ec2:DescribeCoipPools",
+			"ec2:DescribeLocalGatewayRouteTables",
+			"elasticloadbalancing:DescribeLoadBalancers",
+			"elasticloadbalancing:DescribeLoadBalancerAttributes",
+			"elasticloadbalancing:DescribeListeners",
+			"elasticloadbalancing:DescribeListenerCertificates",
+			"elasticloadbalancing:DescribeListenerAttributes",
+			"elasticloadbalancing:DescribeCapacityReservation",
+			"elasticloadbalancing:DescribeRules",
+			"elasticloadbalancing:DescribeTargetGroups",
+			"elasticloadbalancing:DescribeTargetGroupAttributes",
+			"elasticloadbalancing:DescribeTargetHealth",
+			"elasticloadbalancing:DescribeTags",
+			"elasticloadbalancing:DescribeTrustStores",
+			"acm:ListCertificates",
+			"acm:DescribeCertificate",
+			"acm:ListTagsForCertificate",
+			"secretsmanager:GetSecretValue",
+		},
+	},
+	{
+		actions: []string{
+			"ec2:DescribeVpcEndpointServiceConfigurations",
+			"ec2:DescribeVpcEndpointServicePermissions",
+		},
+		requirement: featureEnabled(config.EndpointServices),
+	},
+	{
+		actions: []string{
+			"ec2:DescribeManagedPrefixLists",
+			"ec2:GetManagedPrefixListEntries",
+		},
+		requirement: featureEnabled(config.Blocklist),
+	},
+	{
+		actions: []string{
+			"tag:GetResources",
+		},
+		requirement: featureEnabled(config.EnableRGTAPI),
+	},
+	{
+		actions: []string{
+			"cloudwatch:GetMetricData",
+		},
+		requirement: ruleMetricsEnabled,
+	},
+	{
+		actions: []string{
+			"route53-recovery-readiness:GetResourceSet",
+			"route53-recovery-readiness:GetReadinessCheck",
+		},
+		requirement: recoveryReadinessEnabled,
+	},
+	{
+		actions: []string{
+			"globalaccelerator:ListAccelerators",
+			"globalaccelerator:ListListeners",
+			"globalaccelerator:ListEndpointGroups",
+			"globalaccelerator:ListTagsForResource",
+		},
+		requirement: featureEnabled(config.GlobalAccelerator),
+	},
+	{
+		actions: []string{
+			"route53:GetHostedZone",
+		},
+		requirement: route53RecordsEnabled,
+	},
+	{
+		actions: []string{
+			"route53:ListResourceRecordSets",
+		},
+		requirement: featureEnabled(config.Route53AliasRecords),
+	},
+	{
+		actions: []string{
+			"waf-regional:GetWebACLForResource",
+		},
+		requirement: wafEnabled,
+	},
+	{
+		actions: []string{
+			"waf-regional:AssociateWebACL",
+			"waf-regional:DisassociateWebACL",
+		},
+		mutating:    true,
+		requirement: wafEnabled,
+	},
+	{
+		actions: []string{
+			"wafv2:GetWebACL",
+			"wafv2:GetWebACLForResource",
+			"wafv2:GetLoggingConfiguration",
+			"wafv2:ListWebACLs",
+			"wafv2:ListTagsForResource",
+		},
+		requirement: wafv2Enabled,
+	},
+	{
+		actions: []string{
+			"wafv2:AssociateWebACL",
+			"wafv2:DisassociateWebACL",
+			"wafv2:PutLoggingConfiguration",
+			"wafv2:CreateWebACL",
+			"wafv2:UpdateWebACL",
+			"wafv2:DeleteWebACL",
+			"wafv2:TagResource",
+			"wafv2:UntagResource",
+		},
+		mutating:    true,
+		requirement: wafv2Enabled,
+	},
+	{
+		// webACL logs are delivered to CloudWatch Logs or Firehose with the permissions of the caller of wafv2:PutLoggingConfiguration.
+		actions: []string{
+			"logs:CreateLogDelivery",
+			"logs:PutResourcePolicy",
+			"logs:DescribeResourcePolicies",
+			"logs:DescribeLogGroups",
+		},
+		mutating:    true,
+		requirement: wafv2Enabled,
+	},
+	{
+		actions: []string{
+			"iam:CreateServiceLinkedRole",
+		},
+		condition: Condition{
+			"StringEquals": {"iam:AWSServiceName": "wafv2.amazonaws.com"},
+		},
+		mutating:    true,
+		requirement: wafv2Enabled,
+	},
+	{
+		actions: []string{
+			"elasticloadbalancing:SetWebAcl",
+		},
+		mutating:    true,
+		requirement: wafOrWAFv2Enabled,
+	},
+	{
+		actions: []string{
+			"shield:GetSubscriptionState",
+			"shield:DescribeProtection",
+		},
+		requirement: shieldEnabled,
+	},
+	{
+		actions: []string{
+			"shield:CreateProtection",
+			"shield:DeleteProtection",
+		},
+		mutating:    true,
+		requirement: shieldEnabled,
+	},
+	{
+		actions: []string{
+			"globalaccelerator:CreateAccelerator",
+		},
+		condition:   clusterRequestTagged,
+		mutating:    true,
+		requirement: featureEnabled(config.GlobalAccelerator),
+	},
+	{
+		actions: []string{
+			"globalaccelerator:UpdateAccelerator",
+			"globalaccelerator:DeleteAccelerator",
+			"globalaccelerator:CreateListener",
+			"globalaccelerator:UpdateListener",
+			"globalaccelerator:DeleteListener",
+			"globalaccelerator:CreateEndpointGroup",
+			"globalaccelerator:UpdateEndpointGroup",
+			"globalaccelerator:DeleteEndpointGroup",
+			"globalaccelerator:TagResource",
+			"globalaccelerator:UntagResource",
+		},
+		mutating:    true,
+		requirement: featureEnabled(config.GlobalAccelerator),
+	},
+	{
+		actions: []string{
+			"route53:ChangeResourceRecordSets",
+		},
+		mutating:    true,
+		requirement: route53RecordsEnabled,
+	},
+	{
+		actions: []string{
+			"acm:RequestCertificate",
+			"acm:AddTagsToCertificate",
+		},
+		condition:   clusterRequestTagged,
+		mutating:    true,
+		requirement: featureEnabled(config.ACMCertRequests),
+	},
+	{
+		actions: []string{
+			"route53-recovery-readiness:CreateResourceSet",
+			"route53-recovery-readiness:UpdateResourceSet",
+			"route53-recovery-readiness:CreateReadinessCheck",
+			"route53-recovery-readiness:TagResource",
+		},
+		mutating:    true,
+		requirement: recoveryReadinessEnabled,
+	},
+	{
+		actions: []string{
+			"events:PutEvents",
+		},
+		mutating:    true,
+		requirement: ownershipEventBusEnabled,
+	},
+	{
+		actions: []string{
+			"sns:Publish",
+		},
+		mutating:    true,
+		requirement: ownershipSNSTopicEnabled,
+	},
+	{
+		actions: []string{
+			"events:PutRule",
+			"events:PutTargets",
+			"events:TagResource",
+		},
+		mutating:    true,
+		requirement: backendSGDeletionEventsEnabled,
+	},
+	{
+		actions: []string{
+			"sqs:GetQueueAttributes",
+			"sqs:ReceiveMessage",
+			"sqs:DeleteMessage",
+		},
+		requirement: backendSGDeletionEventsEnabled,
+	},
+	{
+		actions: []string{
+			"ec2:AuthorizeSecurityGroupIngress",
+			"ec2:RevokeSecurityGroupIngress",
+			"ec2:CreateSecurityGroup",
+		},
+		mutating: true,
+	},
+	{
+		actions: []string{
+			"ec2:CreateTags",
+		},
+		resources: []string{"arn:${Partition}:ec2:*:*:security-group/*"},
+		condition: ec2CreateActionTagged("CreateSecurityGroup"),
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+		},
+		resources: []string{"arn:${Partition}:ec2:*:*:security-group/*"},
+		condition: clusterResourceTaggedWithoutRequestTag,
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"ec2:DeleteSecurityGroup",
+		},
+		condition: clusterResourceTagged,
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"ec2:CreateManagedPrefixList",
+		},
+		condition:   clusterRequestTagged,
+		mutating:    true,
+		requirement: featureEnabled(config.Blocklist),
+	},
+	{
+		actions: []string{
+			"ec2:CreateTags",
+		},
+		resources:   []string{"arn:${Partition}:ec2:*:*:prefix-list/*"},
+		condition:   ec2CreateActionTagged("CreateManagedPrefixList"),
+		mutating:    true,
+		requirement: featureEnabled(config.Blocklist),
+	},
+	{
+		actions: []string{
+			"ec2:ModifyManagedPrefixList",
+		},
+		condition:   clusterResourceTagged,
+		mutating:    true,
+		requirement: featureEnabled(config.Blocklist),
+	},
+	{
+		actions: []string{
+			"ec2:CreateVpcEndpointServiceConfiguration",
+		},
+		condition:   clusterRequestTagged,
+		mutating:    true,
+		requirement: featureEnabled(config.EndpointServices),
+	},
+	{
+		actions: []string{
+			"ec2:CreateTags",
+		},
+		resources:   []string{"arn:${Partition}:ec2:*:*:vpc-endpoint-service/*"},
+		condition:   ec2CreateActionTagged("CreateVpcEndpointServiceConfiguration"),
+		mutating:    true,
+		requirement: featureEnabled(config.EndpointServices),
+	},
+	{
+		actions: []string{
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+		},
+		resources:   []string{"arn:${Partition}:ec2:*:*:vpc-endpoint-service/*"},
+		condition:   clusterResourceTaggedWithoutRequestTag,
+		mutating:    true,
+		requirement: featureEnabled(config.EndpointServices),
+	},
+	{
+		actions: []string{
+			"ec2:ModifyVpcEndpointServiceConfiguration",
+			"ec2:ModifyVpcEndpointServicePermissions",
+			"ec2:DeleteVpcEndpointServiceConfigurations",
+		},
+		condition:   clusterResourceTagged,
+		mutating:    true,
+		requirement: featureEnabled(config.EndpointServices),
+	},
+	{
+		actions: []string{
+			"ec2:AllocateAddress",
+		},
+		condition: clusterRequestTagged,
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"ec2:CreateTags",
+		},
+		resources: []string{"arn:${Partition}:ec2:*:*:elastic-ip/*"},
+		condition: ec2CreateActionTagged("AllocateAddress"),
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"ec2:CreateTags",
+			"ec2:DeleteTags",
+		},
+		resources: []string{"arn:${Partition}:ec2:*:*:elastic-ip/*"},
+		condition: clusterResourceTaggedWithoutRequestTag,
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"ec2:ReleaseAddress",
+		},
+		condition: clusterResourceTagged,
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"elasticloadbalancing:CreateLoadBalancer",
+			"elasticloadbalancing:CreateTargetGroup",
+		},
+		condition: clusterRequestTagged,
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"elasticloadbalancing:CreateListener",
+			"elasticloadbalancing:DeleteListener",
+			"elasticloadbalancing:ModifyListener",
+			"elasticloadbalancing:ModifyListenerAttributes",
+			"elasticloadbalancing:AddListenerCertificates",
+			"elasticloadbalancing:RemoveListenerCertificates",
+			"elasticloadbalancing:CreateRule",
+			"elasticloadbalancing:DeleteRule",
+			"elasticloadbalancing:ModifyRule",
+			"elasticloadbalancing:CreateTrustStore",
+			"elasticloadbalancing:DeleteTrustStore",
+		},
+		mutating: true,
+	},
+	{
+		actions: []string{
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:RemoveTags",
+		},
+		resources: []string{
+			"arn:${Partition}:elasticloadbalancing:*:*:targetgroup/*/*",
+			"arn:${Partition}:elasticloadbalancing:*:*:loadbalancer/net/*/*",
+			"arn:${Partition}:elasticloadbalancing:*:*:loadbalancer/app/*/*",
+		},
+		condition: clusterResourceTaggedWithoutRequestTag,
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"elasticloadbalancing:AddTags",
+			"elasticloadbalancing:RemoveTags",
+		},
+		resources: []string{
+			"arn:${Partition}:elasticloadbalancing:*:*:listener/net/*/*/*",
+			"arn:${Partition}:elasticloadbalancing:*:*:listener/app/*/*/*",
+			"arn:${Partition}:elasticloadbalancing:*:*:listener-rule/net/*/*/*",
+			"arn:${Partition}:elasticloadbalancing:*:*:listener-rule/app/*/*/*",
+			"arn:${Partition}:elasticloadbalancing:*:*:truststore/*/*",
+		},
+		mutating: true,
+	},
+	{
+		actions: []string{
+			"elasticloadbalancing:AddTags",
+		},
+		resources: []string{
+			"arn:${Partition}:elasticloadbalancing:*:*:targetgroup/*/*",
+			"arn:${Partition}:elasticloadbalancing:*:*:loadbalancer/net/*/*",
+			"arn:${Partition}:elasticloadbalancing:*:*:loadbalancer/app/*/*",
+		},
+		condition: Condition{
+			"StringEquals": {"elasticloadbalancing:CreateAction": []string{"CreateTargetGroup", "CreateLoadBalancer"}},
+			"Null":         {clusterRequestTagKey: "false"},
+		},
+		mutating: true,
+	},
+	{
+		actions: []string{
+			"elasticloadbalancing:ModifyLoadBalancerAttributes",
+			"elasticloadbalancing:SetIpAddressType",
+			"elasticloadbalancing:SetSecurityGroups",
+			"elasticloadbalancing:SetSubnets",
+			"elasticloadbalancing:ModifyIpPools",
+			"elasticloadbalancing:ModifyCapacityReservation",
+			"elasticloadbalancing:DeleteLoadBalancer",
+			"elasticloadbalancing:ModifyTargetGroup",
+			"elasticloadbalancing:ModifyTargetGroupAttributes",
+			"elasticloadbalancing:DeleteTargetGroup",
+		},
+		condition: clusterResourceTagged,
+		mutating:  true,
+	},
+	{
+		actions: []string{
+			"elasticloadbalancing:RegisterTargets",
+			"elasticloadbalancing:DeregisterTargets",
+		},
+		resources: []string{"arn:${Partition}:elasticloadbalancing:*:*:targetgroup/*/*"},
+		mutating:  true,
+	},
+}
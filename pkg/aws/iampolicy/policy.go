@@ -0,0 +1,98 @@
+package iampolicy
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+)
+
+const (
+	policyVersion    = "2012-10-17"
+	effectAllow      = "Allow"
+	defaultPartition = endpoints.AwsPartitionID
+)
+
+// PolicyDocument is an IAM policy document.
+type PolicyDocument struct {
+	Version   string      `json:"Version"`
+	Statement []Statement `json:"Statement"`
+}
+
+// Statement is a statement of IAM policy document.
+type Statement struct {
+	Effect    string    `json:"Effect"`
+	Action    []string  `json:"Action"`
+	Resource  []string  `json:"Resource"`
+	Condition Condition `json:"Condition,omitempty"`
+}
+
+// Condition is the condition block of IAM policy statement, keyed by condition operator and then condition key.
+// The condition values are either a string or a list of strings.
+type Condition map[string]map[string]interface{}
+
+// BuildPolicy computes the minimal IAM policy required by the controller running with specified configuration.
+func BuildPolicy(cfg config.ControllerConfig) PolicyDocument {
+	partition := buildPartition(cfg.AWSConfig.Region)
+	// the mutating actions are never invoked when changes are only planned.
+	readOnly := cfg.DryRun || cfg.ShadowMode
+
+	var statements []Statement
+	statementIndexByKey := make(map[string]int)
+	for _, perm := range permissions {
+		if perm.mutating && readOnly {
+			continue
+		}
+		if perm.requirement != nil && !perm.requirement(cfg) {
+			continue
+		}
+		resources := make([]string, 0, len(perm.resources))
+		for _, resource := range perm.resources {
+			resources = append(resources, strings.ReplaceAll(resource, partitionPlaceholder, partition))
+		}
+		if len(resources) == 0 {
+			resources = []string{"*"}
+		}
+		// permissions sharing the same resources and condition are merged into single statement.
+		key := buildStatementKey(resources, perm.condition)
+		if index, exists := statementIndexByKey[key]; exists {
+			statements[index].Action = append(statements[index].Action, perm.actions...)
+			continue
+		}
+		statementIndexByKey[key] = len(statements)
+		statements = append(statements, Statement{
+			Effect:    effectAllow,
+			Action:    append([]string(nil), perm.actions...),
+			Resource:  resources,
+			Condition: perm.condition,
+		})
+	}
+	return PolicyDocument{
+		Version:   policyVersion,
+		Statement: statements,
+	}
+}
+
+// buildPartition returns the AWS partition of region, the aws partition is assumed if region is unknown.
+func buildPartition(region string) string {
+	if region == "" {
+		return defaultPartition
+	}
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	if !ok {
+		return defaultPartition
+	}
+	return partition.ID()
+}
+
+func buildStatementKey(resources []string, condition Condition) string {
+	payload, _ := json.Marshal(struct {
+		Resources []string
+		Condition Condition
+	}{
+		Resources: resources,
+		Condition: condition,
+	})
+	return string(payload)
+}
@@ -0,0 +1,268 @@
+package iampolicy
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+)
+
+func newControllerConfig(region string, featureGates map[config.Feature]bool) config.ControllerConfig {
+	cfg := config.ControllerConfig{
+		AWSConfig: aws.CloudConfig{Region: region},
+		AddonsConfig: config.AddonsConfig{
+			WAFEnabled:    true,
+			WAFV2Enabled:  true,
+			ShieldEnabled: true,
+		},
+		FeatureGates: config.NewFeatureGates(),
+	}
+	for feature, enabled := range featureGates {
+		if enabled {
+			cfg.FeatureGates.Enable(feature)
+		} else {
+			cfg.FeatureGates.Disable(feature)
+		}
+	}
+	return cfg
+}
+
+func policyActions(policy PolicyDocument) sets.String {
+	actions := sets.NewString()
+	for _, statement := range policy.Statement {
+		actions.Insert(statement.Action...)
+	}
+	return actions
+}
+
+func policyResources(policy PolicyDocument) sets.String {
+	resources := sets.NewString()
+	for _, statement := range policy.Statement {
+		resources.Insert(statement.Resource...)
+	}
+	return resources
+}
+
+func TestBuildPolicy(t *testing.T) {
+	tests := []struct {
+		name              string
+		cfg               func() config.ControllerConfig
+		wantActions       []string
+		wantNoActions     []string
+		wantResource      string
+		wantStatementsLen int
+	}{
+		{
+			name: "default configuration",
+			cfg: func() config.ControllerConfig {
+				return newControllerConfig("us-west-2", nil)
+			},
+			wantActions: []string{
+				"iam:CreateServiceLinkedRole",
+				"elasticloadbalancing:CreateLoadBalancer",
+				"wafv2:AssociateWebACL",
+				"logs:CreateLogDelivery",
+				"waf-regional:AssociateWebACL",
+				"shield:CreateProtection",
+			},
+			wantNoActions: []string{
+				"tag:GetResources",
+				"cloudwatch:GetMetricData",
+				"ec2:CreateVpcEndpointServiceConfiguration",
+				"ec2:CreateManagedPrefixList",
+				"globalaccelerator:CreateAccelerator",
+				"route53-recovery-readiness:UpdateResourceSet",
+				"route53:ChangeResourceRecordSets",
+				"acm:RequestCertificate",
+				"events:PutEvents",
+				"sns:Publish",
+				"events:PutRule",
+				"sqs:ReceiveMessage",
+			},
+			wantResource: "arn:aws:elasticloadbalancing:*:*:targetgroup/*/*",
+		},
+		{
+			name: "optional features enabled",
+			cfg: func() config.ControllerConfig {
+				cfg := newControllerConfig("us-west-2", map[config.Feature]bool{
+					config.EnableRGTAPI:        true,
+					config.EndpointServices:    true,
+					config.Blocklist:           true,
+					config.GlobalAccelerator:   true,
+					config.Route53AliasRecords: true,
+					config.ACMCertRequests:     true,
+				})
+				cfg.IngressConfig.RuleMetricsPollInterval = time.Minute
+				cfg.RecoveryReadinessConfig.ResourceSet = "my-cluster"
+				cfg.OwnershipEventsConfig.EventBus = "my-bus"
+				cfg.OwnershipEventsConfig.SNSTopicARN = "arn:aws:sns:us-west-2:123456789012:my-topic"
+				cfg.BackendSecurityGroupDeletionEventsQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/my-queue"
+				return cfg
+			},
+			wantActions: []string{
+				"tag:GetResources",
+				"events:PutEvents",
+				"sns:Publish",
+				"events:PutRule",
+				"sqs:ReceiveMessage",
+				"cloudwatch:GetMetricData",
+				"route53-recovery-readiness:UpdateResourceSet",
+				"ec2:CreateVpcEndpointServiceConfiguration",
+				"ec2:CreateManagedPrefixList",
+				"globalaccelerator:CreateAccelerator",
+				"route53:ChangeResourceRecordSets",
+				"acm:RequestCertificate",
+			},
+		},
+		{
+			name: "addons disabled",
+			cfg: func() config.ControllerConfig {
+				cfg := newControllerConfig("us-west-2", nil)
+				cfg.AddonsConfig = config.AddonsConfig{}
+				return cfg
+			},
+			wantNoActions: []string{
+				"elasticloadbalancing:SetWebAcl",
+				"wafv2:AssociateWebACL",
+				"wafv2:GetWebACLForResource",
+				"logs:CreateLogDelivery",
+				"waf-regional:GetWebACLForResource",
+				"shield:GetSubscriptionState",
+			},
+		},
+		{
+			name: "dry-run only requires read access",
+			cfg: func() config.ControllerConfig {
+				cfg := newControllerConfig("us-west-2", nil)
+				cfg.DryRun = true
+				return cfg
+			},
+			wantActions: []string{
+				"elasticloadbalancing:DescribeLoadBalancers",
+				"wafv2:GetWebACLForResource",
+			},
+			wantNoActions: []string{
+				"iam:CreateServiceLinkedRole",
+				"elasticloadbalancing:CreateLoadBalancer",
+				"ec2:CreateTags",
+				"wafv2:AssociateWebACL",
+			},
+			wantStatementsLen: 1,
+		},
+		{
+			name: "china region",
+			cfg: func() config.ControllerConfig {
+				return newControllerConfig("cn-north-1", nil)
+			},
+			wantResource: "arn:aws-cn:elasticloadbalancing:*:*:targetgroup/*/*",
+		},
+		{
+			name: "us-gov region",
+			cfg: func() config.ControllerConfig {
+				return newControllerConfig("us-gov-west-1", nil)
+			},
+			wantResource: "arn:aws-us-gov:elasticloadbalancing:*:*:targetgroup/*/*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildPolicy(tt.cfg())
+			assert.Equal(t, "2012-10-17", got.Version)
+			actions := policyActions(got)
+			for _, action := range tt.wantActions {
+				assert.True(t, actions.Has(action), "missing action: %v", action)
+			}
+			for _, action := range tt.wantNoActions {
+				assert.False(t, actions.Has(action), "unexpected action: %v", action)
+			}
+			if tt.wantResource != "" {
+				assert.True(t, policyResources(got).Has(tt.wantResource), "missing resource: %v", tt.wantResource)
+			}
+			if tt.wantStatementsLen != 0 {
+				assert.Len(t, got.Statement, tt.wantStatementsLen)
+			}
+			for _, statement := range got.Statement {
+				assert.Equal(t, len(statement.Action), sets.NewString(statement.Action...).Len(), "duplicate actions in statement: %v", statement.Action)
+			}
+		})
+	}
+}
+
+func Test_permissions_noWildcardActions(t *testing.T) {
+	for _, perm := range permissions {
+		for _, action := range perm.actions {
+			assert.NotContains(t, action, "*")
+		}
+	}
+}
+
+// awsOperationCallPattern matches the invocations of AWS API operations on the SDK clients, e.g. elbv2Client.CreateRuleWithContext(.
+var awsOperationCallPattern = regexp.MustCompile(`(\w+)\.([A-Z]\w*?)(PagesWithContext|WithContext|AsList)\(`)
+
+// nonAWSOperationReceivers are packages or fields that provide functions matching awsOperationCallPattern.
+var nonAWSOperationReceivers = sets.NewString("wait", "credentials")
+
+// operationAliases are the operations defined by the controller, or authorized by another IAM action, mapped to the IAM action.
+var operationAliases = map[string]string{
+	"DescribeLoadBalancerIpamPools": "DescribeLoadBalancers",
+	"PublishBatch":                  "Publish",
+}
+
+// Test_permissions_coverInvokedOperations verifies every AWS API operation invoked by the controller is granted
+// once all features are enabled, so that the mapping tables are kept in sync with the code.
+func Test_permissions_coverInvokedOperations(t *testing.T) {
+	cfg := newControllerConfig("us-west-2", map[config.Feature]bool{
+		config.EnableRGTAPI:        true,
+		config.EndpointServices:    true,
+		config.Blocklist:           true,
+		config.GlobalAccelerator:   true,
+		config.Route53AliasRecords: true,
+		config.ACMCertRequests:     true,
+	})
+	cfg.IngressConfig.RuleMetricsPollInterval = time.Minute
+	cfg.RecoveryReadinessConfig.ResourceSet = "my-cluster"
+	cfg.OwnershipEventsConfig.EventBus = "my-bus"
+	cfg.OwnershipEventsConfig.SNSTopicARN = "arn:aws:sns:us-west-2:123456789012:my-topic"
+	cfg.BackendSecurityGroupDeletionEventsQueueURL = "https://sqs.us-west-2.amazonaws.com/123456789012/my-queue"
+	grantedOperations := sets.NewString()
+	for _, action := range policyActions(BuildPolicy(cfg)).List() {
+		grantedOperations.Insert(action[strings.Index(action, ":")+1:])
+	}
+
+	invokedOperations := sets.NewString()
+	for _, dir := range []string{"../../../pkg", "../../../controllers", "../../../webhooks"} {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") || strings.HasSuffix(path, "_mocks.go") {
+				return nil
+			}
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			for _, match := range awsOperationCallPattern.FindAllStringSubmatch(string(content), -1) {
+				if nonAWSOperationReceivers.Has(match[1]) {
+					continue
+				}
+				operation := match[2]
+				if alias, ok := operationAliases[operation]; ok {
+					operation = alias
+				}
+				invokedOperations.Insert(operation)
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+	}
+	assert.NotEmpty(t, invokedOperations.List())
+	assert.Empty(t, invokedOperations.Difference(grantedOperations).List(), "operations invoked without IAM permissions")
+}
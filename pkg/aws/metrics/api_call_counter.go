@@ -0,0 +1,161 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	sdkHandlerCountAPICall    = "countAPICall"
+	sdkHandlerCountAPIRequest = "countAPIRequest"
+)
+
+type contextKey string
+
+const (
+	contextKeyAPICallCounter contextKey = "apiCallCounter"
+)
+
+// APICall identifies an AWS API operation.
+type APICall struct {
+	Service   string
+	Operation string
+}
+
+// String returns the API call in "service/operation" format, e.g. "Elastic Load Balancing v2/DescribeLoadBalancers".
+func (c APICall) String() string {
+	return c.Service + "/" + c.Operation
+}
+
+// APICallCount is the number of calls and requests of an AWS API operation.
+type APICallCount struct {
+	// Calls is the number of API calls, regardless of retries.
+	Calls int
+	// Requests is the number of HTTP requests sent, including retries.
+	Requests int
+}
+
+// APICallCounter counts the AWS API calls made with a context that carries it.
+type APICallCounter struct {
+	mutex  sync.Mutex
+	counts map[APICall]APICallCount
+}
+
+// NewAPICallCounter constructs new APICallCounter.
+func NewAPICallCounter() *APICallCounter {
+	return &APICallCounter{
+		counts: make(map[APICall]APICallCount),
+	}
+}
+
+// Counts returns the count per AWS API operation.
+func (c *APICallCounter) Counts() map[APICall]APICallCount {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	counts := make(map[APICall]APICallCount, len(c.counts))
+	for apiCall, count := range c.counts {
+		counts[apiCall] = count
+	}
+	return counts
+}
+
+func (c *APICallCounter) countCall(apiCall APICall) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	count := c.counts[apiCall]
+	count.Calls++
+	c.counts[apiCall] = count
+}
+
+func (c *APICallCounter) countRequest(apiCall APICall) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	count := c.counts[apiCall]
+	count.Requests++
+	c.counts[apiCall] = count
+}
+
+// ContextWithAPICallCounter returns a copy of ctx that carries the APICallCounter.
+func ContextWithAPICallCounter(ctx context.Context, counter *APICallCounter) context.Context {
+	return context.WithValue(ctx, contextKeyAPICallCounter, counter)
+}
+
+// ContextGetAPICallCounter returns the APICallCounter carried by ctx, or nil if there is none.
+func ContextGetAPICallCounter(ctx context.Context) *APICallCounter {
+	if v := ctx.Value(contextKeyAPICallCounter); v != nil {
+		return v.(*APICallCounter)
+	}
+	return nil
+}
+
+// InjectAPICallCounterHandlers injects the handlers that count the AWS API calls into the APICallCounter carried by
+// the context of requests. the requests without context, or whose context doesn't carry an APICallCounter aren't counted.
+func InjectAPICallCounterHandlers(handlers *request.Handlers) {
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: sdkHandlerCountAPIRequest,
+		Fn:   countAPIRequest,
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: sdkHandlerCountAPICall,
+		Fn:   countAPICall,
+	})
+}
+
+// InjectAPICallCounterMiddleware injects the middleware that count the AWS API calls of aws-sdk-go-v2 clients into the
+// APICallCounter carried by the context of calls. the calls whose context doesn't carry an APICallCounter aren't counted.
+func InjectAPICallCounterMiddleware(stack *middleware.Stack) error {
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc(sdkHandlerCountAPICall, aroundAPICall), middleware.After); err != nil {
+		return err
+	}
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(sdkHandlerCountAPIRequest, aroundAPIRequest), "Retry", middleware.After)
+}
+
+// aroundAPICall is added to the Initialize step of aws-sdk-go-v2 clients; called for each API call
+func aroundAPICall(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	middleware.InitializeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleInitialize(ctx, in)
+	if counter := ContextGetAPICallCounter(ctx); counter != nil {
+		counter.countCall(apiCallForContext(ctx))
+	}
+	return out, metadata, err
+}
+
+// aroundAPIRequest is added to the Finalize step of aws-sdk-go-v2 clients; called for each request attempt
+func aroundAPIRequest(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	middleware.FinalizeOutput, middleware.Metadata, error) {
+	out, metadata, err := next.HandleFinalize(ctx, in)
+	if counter := ContextGetAPICallCounter(ctx); counter != nil {
+		counter.countRequest(apiCallForContext(ctx))
+	}
+	return out, metadata, err
+}
+
+func apiCallForContext(ctx context.Context) APICall {
+	return APICall{
+		Service:   awsmiddleware.GetServiceID(ctx),
+		Operation: awsmiddleware.GetOperationName(ctx),
+	}
+}
+
+func countAPICall(r *request.Request) {
+	if counter := ContextGetAPICallCounter(r.Context()); counter != nil {
+		counter.countCall(apiCallForRequest(r))
+	}
+}
+
+func countAPIRequest(r *request.Request) {
+	if counter := ContextGetAPICallCounter(r.Context()); counter != nil {
+		counter.countRequest(apiCallForRequest(r))
+	}
+}
+
+func apiCallForRequest(r *request.Request) APICall {
+	return APICall{
+		Service:   r.ClientInfo.ServiceID,
+		Operation: r.Operation.Name,
+	}
+}
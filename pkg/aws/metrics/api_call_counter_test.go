@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_APICallCounter(t *testing.T) {
+	handlers := request.Handlers{}
+	InjectAPICallCounterHandlers(&handlers)
+	newRequest := func(ctx context.Context, service string, operation string) *request.Request {
+		r := &request.Request{
+			ClientInfo:  metadata.ClientInfo{ServiceID: service},
+			Operation:   &request.Operation{Name: operation},
+			HTTPRequest: &http.Request{},
+		}
+		r.SetContext(ctx)
+		return r
+	}
+
+	counter := NewAPICallCounter()
+	ctx := ContextWithAPICallCounter(context.Background(), counter)
+
+	// a call that's retried once.
+	describeLBs := newRequest(ctx, "Elastic Load Balancing v2", "DescribeLoadBalancers")
+	handlers.CompleteAttempt.Run(describeLBs)
+	handlers.CompleteAttempt.Run(describeLBs)
+	handlers.Complete.Run(describeLBs)
+
+	describeSubnets := newRequest(ctx, "EC2", "DescribeSubnets")
+	handlers.CompleteAttempt.Run(describeSubnets)
+	handlers.Complete.Run(describeSubnets)
+
+	// calls with context without counter aren't counted.
+	uncounted := newRequest(context.Background(), "EC2", "DescribeSubnets")
+	handlers.CompleteAttempt.Run(uncounted)
+	handlers.Complete.Run(uncounted)
+
+	assert.Equal(t, map[APICall]APICallCount{
+		{Service: "Elastic Load Balancing v2", Operation: "DescribeLoadBalancers"}: {Calls: 1, Requests: 2},
+		{Service: "EC2", Operation: "DescribeSubnets"}:                             {Calls: 1, Requests: 1},
+	}, counter.Counts())
+}
+
+func Test_InjectAPICallCounterMiddleware(t *testing.T) {
+	counter := NewAPICallCounter()
+	ctx := ContextWithAPICallCounter(context.Background(), counter)
+
+	// a call that's retried once.
+	_, _, err := newTestHandler(t, "Elastic Load Balancing v2", "DescribeLoadBalancers",
+		[]error{newTestResponseError(http.StatusServiceUnavailable, "ServiceUnavailable")}, InjectAPICallCounterMiddleware).Handle(ctx, nil)
+	require.NoError(t, err)
+
+	_, _, err = newTestHandler(t, "EC2", "DescribeSubnets", nil, InjectAPICallCounterMiddleware).Handle(ctx, nil)
+	require.NoError(t, err)
+
+	// calls with context without counter aren't counted.
+	_, _, err = newTestHandler(t, "EC2", "DescribeSubnets", nil, InjectAPICallCounterMiddleware).Handle(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[APICall]APICallCount{
+		{Service: "Elastic Load Balancing v2", Operation: "DescribeLoadBalancers"}: {Calls: 1, Requests: 2},
+		{Service: "EC2", Operation: "DescribeSubnets"}:                             {Calls: 1, Requests: 1},
+	}, counter.Counts())
+}
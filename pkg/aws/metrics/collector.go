@@ -1,18 +1,37 @@
 package metrics
 
 import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retryv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/prometheus/client_golang/prometheus"
-	"strconv"
-	"time"
 )
 
 const (
 	sdkHandlerCollectAPICallMetric    = "collectAPICallMetric"
 	sdkHandlerCollectAPIRequestMetric = "collectAPIRequestMetric"
+	sdkHandlerCollectAPIRetryMetric   = "collectAPIRetryMetric"
 )
 
+// callStateKey is the context key of the callState of aws-sdk-go-v2 API calls.
+type callStateKey struct{}
+
+// callState tracks the request attempts of an aws-sdk-go-v2 API call.
+type callState struct {
+	// lastAttemptEnd is the time the last request attempt ended, zero before the first attempt.
+	lastAttemptEnd time.Time
+}
+
 type collector struct {
 	instruments *instruments
 }
@@ -36,6 +55,88 @@ func (c *collector) InjectHandlers(handlers *request.Handlers) {
 		Name: sdkHandlerCollectAPICallMetric,
 		Fn:   c.collectAPICallMetric,
 	})
+	handlers.AfterRetry.PushBackNamed(request.NamedHandler{
+		Name: sdkHandlerCollectAPIRetryMetric,
+		Fn:   c.collectAPIRetryMetric,
+	})
+}
+
+// InjectMiddleware injects the metrics collector into the middleware stack of aws-sdk-go-v2 clients.
+// The API call metrics are collected in the Initialize step, and the API request metrics right after the retry middleware.
+// It should be injected after the other middleware placed after the retry middleware, so that the request duration covers them.
+func (c *collector) InjectMiddleware(stack *middleware.Stack) error {
+	if err := stack.Initialize.Add(middleware.InitializeMiddlewareFunc(sdkHandlerCollectAPICallMetric, c.aroundCall), middleware.After); err != nil {
+		return err
+	}
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(sdkHandlerCollectAPIRequestMetric, c.aroundAttempt), "Retry", middleware.After)
+}
+
+// aroundCall is added to the Initialize step of aws-sdk-go-v2 clients; called for each API call
+func (c *collector) aroundCall(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (
+	middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+	ctx = context.WithValue(ctx, callStateKey{}, &callState{})
+	out, metadata, err := next.HandleInitialize(ctx, in)
+	service := awsmiddleware.GetServiceID(ctx)
+	operation := awsmiddleware.GetOperationName(ctx)
+	retryCount := 0
+	if attemptResults, ok := retryv2.GetAttemptResults(metadata); ok && len(attemptResults.Results) > 0 {
+		retryCount = len(attemptResults.Results) - 1
+	}
+
+	c.instruments.apiCallsTotal.With(map[string]string{
+		labelService:    service,
+		labelOperation:  operation,
+		labelStatusCode: statusCodeForResult(metadata, err),
+		labelErrorCode:  errorCodeForError(err),
+	}).Inc()
+	c.instruments.apiCallDurationSeconds.With(map[string]string{
+		labelService:   service,
+		labelOperation: operation,
+	}).Observe(time.Since(start).Seconds())
+	c.instruments.apiCallRetries.With(map[string]string{
+		labelService:   service,
+		labelOperation: operation,
+	}).Observe(float64(retryCount))
+	return out, metadata, err
+}
+
+// aroundAttempt is added to the Finalize step of aws-sdk-go-v2 clients; called for each request attempt.
+// The retry delay is measured as the time between the end of the previous attempt and the start of this one.
+func (c *collector) aroundAttempt(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	middleware.FinalizeOutput, middleware.Metadata, error) {
+	service := awsmiddleware.GetServiceID(ctx)
+	operation := awsmiddleware.GetOperationName(ctx)
+	state, _ := ctx.Value(callStateKey{}).(*callState)
+	start := time.Now()
+	if state != nil && !state.lastAttemptEnd.IsZero() {
+		c.instruments.apiRetryDelaySeconds.With(map[string]string{
+			labelService:   service,
+			labelOperation: operation,
+		}).Observe(start.Sub(state.lastAttemptEnd).Seconds())
+	}
+	out, metadata, err := next.HandleFinalize(ctx, in)
+	if state != nil {
+		state.lastAttemptEnd = time.Now()
+	}
+
+	c.instruments.apiRequestsTotal.With(map[string]string{
+		labelService:    service,
+		labelOperation:  operation,
+		labelStatusCode: statusCodeForResult(metadata, err),
+		labelErrorCode:  errorCodeForError(err),
+	}).Inc()
+	c.instruments.apiRequestDurationSecond.With(map[string]string{
+		labelService:   service,
+		labelOperation: operation,
+	}).Observe(time.Since(start).Seconds())
+	if err != nil && retryv2.IsErrorThrottles(retryv2.DefaultThrottles).IsErrorThrottle(err) == awsv2.TrueTernary {
+		c.instruments.apiRequestThrottlesTotal.With(map[string]string{
+			labelService:   service,
+			labelOperation: operation,
+		}).Inc()
+	}
+	return out, metadata, err
 }
 
 func (c *collector) collectAPIRequestMetric(r *request.Request) {
@@ -55,6 +156,12 @@ func (c *collector) collectAPIRequestMetric(r *request.Request) {
 		labelService:   service,
 		labelOperation: operation,
 	}).Observe(duration.Seconds())
+	if request.IsErrorThrottle(r.Error) {
+		c.instruments.apiRequestThrottlesTotal.With(map[string]string{
+			labelService:   service,
+			labelOperation: operation,
+		}).Inc()
+	}
 }
 
 func (c *collector) collectAPICallMetric(r *request.Request) {
@@ -80,6 +187,17 @@ func (c *collector) collectAPICallMetric(r *request.Request) {
 	}).Observe(float64(r.RetryCount))
 }
 
+// collectAPIRetryMetric is added to the back of AfterRetry chain; the request is retried after the delay if no error is left.
+func (c *collector) collectAPIRetryMetric(r *request.Request) {
+	if r.Error != nil {
+		return
+	}
+	c.instruments.apiRetryDelaySeconds.With(map[string]string{
+		labelService:   r.ClientInfo.ServiceID,
+		labelOperation: r.Operation.Name,
+	}).Observe(r.RetryDelay.Seconds())
+}
+
 // statusCodeForRequest returns the http status code for request.
 // if there is no http response, returns "0".
 func statusCodeForRequest(r *request.Request) string {
@@ -101,6 +219,36 @@ func errorCodeForRequest(r *request.Request) string {
 	return ""
 }
 
+// statusCodeForResult returns the http status code for the result of aws-sdk-go-v2 API calls or request attempts.
+// if there is no http response, returns "0".
+func statusCodeForResult(metadata middleware.Metadata, err error) string {
+	var respErr *smithyhttp.ResponseError
+	if errors.As(err, &respErr) {
+		return strconv.Itoa(respErr.HTTPStatusCode())
+	}
+	if resp, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && resp != nil {
+		return strconv.Itoa(resp.StatusCode)
+	}
+	return "0"
+}
+
+// errorCodeForError returns the error code for the error of aws-sdk-go-v2 API calls or request attempts.
+// if no error happened, returns "".
+func errorCodeForError(err error) string {
+	if err == nil {
+		return ""
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	var canceledErr *awsv2.RequestCanceledError
+	if errors.As(err, &canceledErr) {
+		return request.CanceledErrorCode
+	}
+	return "internal"
+}
+
 // operationForRequest returns the operation for request.
 func operationForRequest(r *request.Request) string {
 	if r.Operation != nil {
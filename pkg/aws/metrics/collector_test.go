@@ -1,14 +1,68 @@
 package metrics
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	retryv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
-	"net/http"
-	"testing"
+	"github.com/stretchr/testify/require"
 )
 
+// newTestHandler returns the handler of an aws-sdk-go-v2 operation with the retry middleware and the injected middleware.
+// Each request attempt fails with the next error of attemptErrs, and succeeds once there is none left.
+func newTestHandler(t *testing.T, service string, operation string, attemptErrs []error, inject func(*middleware.Stack) error) middleware.Handler {
+	stack := middleware.NewStack(operation, smithyhttp.NewStackRequest)
+	require.NoError(t, stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     service,
+		OperationName: operation,
+	}, middleware.Before))
+	require.NoError(t, stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("Signing", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		return next.HandleFinalize(ctx, in)
+	}), middleware.After))
+	require.NoError(t, retryv2.AddRetryMiddlewares(stack, retryv2.AddRetryMiddlewaresOptions{
+		Retryer: retryv2.NewStandard(func(o *retryv2.StandardOptions) {
+			o.Backoff = retryv2.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 10 * time.Millisecond, nil })
+			o.RateLimiter = ratelimit.None
+		}),
+	}))
+	require.NoError(t, awsmiddleware.AddRawResponseToMetadata(stack))
+	require.NoError(t, inject(stack))
+	return middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+		if len(attemptErrs) > 0 {
+			err := attemptErrs[0]
+			attemptErrs = attemptErrs[1:]
+			return nil, middleware.Metadata{}, err
+		}
+		return &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusOK}}, middleware.Metadata{}, nil
+	}), stack)
+}
+
+// newTestResponseError returns the error of an aws-sdk-go-v2 request attempt that got response with statusCode and errorCode.
+func newTestResponseError(statusCode int, errorCode string) error {
+	return &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: &http.Response{StatusCode: statusCode, Header: http.Header{}}},
+			Err:      &smithy.GenericAPIError{Code: errorCode},
+		},
+	}
+}
+
 func Test_statusCodeForRequest(t *testing.T) {
 	type args struct {
 		r *request.Request
@@ -123,3 +177,114 @@ func Test_operationForRequest(t *testing.T) {
 		})
 	}
 }
+
+func Test_collector_collectAPIRequestMetric_throttles(t *testing.T) {
+	c, err := NewCollector(prometheus.NewRegistry())
+	assert.NoError(t, err)
+	newRequest := func(err error) *request.Request {
+		return &request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceID: "Elastic Load Balancing v2"},
+			Operation:  &request.Operation{Name: "DescribeTargetHealth"},
+			Error:      err,
+		}
+	}
+	c.collectAPIRequestMetric(newRequest(nil))
+	c.collectAPIRequestMetric(newRequest(awserr.New("Throttling", "Rate exceeded", nil)))
+	c.collectAPIRequestMetric(newRequest(awserr.New("ValidationError", "invalid", nil)))
+
+	assert.Equal(t, 3, testutil.CollectAndCount(c.instruments.apiRequestsTotal))
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.instruments.apiRequestThrottlesTotal.WithLabelValues("Elastic Load Balancing v2", "DescribeTargetHealth")))
+}
+
+func Test_statusCodeForResult(t *testing.T) {
+	tests := []struct {
+		name          string
+		handler       func(t *testing.T) middleware.Handler
+		want          string
+		wantErrorCode string
+	}{
+		{
+			name: "calls with response",
+			handler: func(t *testing.T) middleware.Handler {
+				return newTestHandler(t, "EC2", "DescribeSubnets", nil, func(*middleware.Stack) error { return nil })
+			},
+			want:          "200",
+			wantErrorCode: "",
+		},
+		{
+			name: "calls with error response",
+			handler: func(t *testing.T) middleware.Handler {
+				return newTestHandler(t, "EC2", "DescribeSubnets", []error{newTestResponseError(http.StatusBadRequest, "InvalidSubnetID.NotFound")},
+					func(*middleware.Stack) error { return nil })
+			},
+			want:          "400",
+			wantErrorCode: "InvalidSubnetID.NotFound",
+		},
+		{
+			name: "calls without response",
+			handler: func(t *testing.T) middleware.Handler {
+				return newTestHandler(t, "EC2", "DescribeSubnets", []error{errors.New("oops, some internal error")},
+					func(*middleware.Stack) error { return nil })
+			},
+			want:          "0",
+			wantErrorCode: "internal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, metadata, err := tt.handler(t).Handle(context.Background(), nil)
+			assert.Equal(t, tt.want, statusCodeForResult(metadata, err))
+			assert.Equal(t, tt.wantErrorCode, errorCodeForError(err))
+		})
+	}
+}
+
+func Test_errorCodeForError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "calls without error",
+			err:  nil,
+			want: "",
+		},
+		{
+			name: "calls with internal error",
+			err:  errors.New("oops, some internal error"),
+			want: "internal",
+		},
+		{
+			name: "calls with api error",
+			err:  &smithy.OperationError{ServiceID: "ACM", OperationName: "DescribeCertificate", Err: &smithy.GenericAPIError{Code: "ResourceNotFoundException"}},
+			want: "ResourceNotFoundException",
+		},
+		{
+			name: "calls canceled",
+			err:  &awsv2.RequestCanceledError{Err: context.Canceled},
+			want: "RequestCanceled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorCodeForError(tt.err))
+		})
+	}
+}
+
+func Test_collector_InjectMiddleware(t *testing.T) {
+	c, err := NewCollector(prometheus.NewRegistry())
+	require.NoError(t, err)
+	handler := newTestHandler(t, "Elastic Load Balancing v2", "DescribeTargetHealth",
+		[]error{newTestResponseError(http.StatusBadRequest, "Throttling")}, c.InjectMiddleware)
+	_, _, err = handler.Handle(context.Background(), nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.instruments.apiCallsTotal.WithLabelValues("Elastic Load Balancing v2", "DescribeTargetHealth", "200", "")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.instruments.apiRequestsTotal.WithLabelValues("Elastic Load Balancing v2", "DescribeTargetHealth", "400", "Throttling")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.instruments.apiRequestsTotal.WithLabelValues("Elastic Load Balancing v2", "DescribeTargetHealth", "200", "")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(c.instruments.apiRequestThrottlesTotal.WithLabelValues("Elastic Load Balancing v2", "DescribeTargetHealth")))
+	assert.Equal(t, 1, testutil.CollectAndCount(c.instruments.apiCallRetries))
+	assert.Equal(t, 1, testutil.CollectAndCount(c.instruments.apiRetryDelaySeconds))
+}
@@ -13,6 +13,8 @@ const (
 
 	metricAPIRequestsTotal          = "api_requests_total"
 	metricAPIRequestDurationSeconds = "api_request_duration_seconds"
+	metricAPIRequestThrottlesTotal  = "api_request_throttles_total"
+	metricAPIRetryDelaySeconds      = "api_retry_delay_seconds"
 )
 
 const (
@@ -28,6 +30,8 @@ type instruments struct {
 	apiCallRetries           *prometheus.HistogramVec
 	apiRequestsTotal         *prometheus.CounterVec
 	apiRequestDurationSecond *prometheus.HistogramVec
+	apiRequestThrottlesTotal *prometheus.CounterVec
+	apiRetryDelaySeconds     *prometheus.HistogramVec
 }
 
 // newInstruments allocates and register new metrics to registerer
@@ -59,6 +63,18 @@ func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
 		Name:      metricAPIRequestDurationSeconds,
 		Help:      "Latency of an individual HTTP request to the service endpoint",
 	}, []string{labelService, labelOperation})
+	apiRequestThrottlesTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemAWS,
+		Name:      metricAPIRequestThrottlesTotal,
+		Help:      "Total number of HTTP requests that the SDK made which are throttled by the service endpoint",
+	}, []string{labelService, labelOperation})
+
+	apiRetryDelaySeconds := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemAWS,
+		Name:      metricAPIRetryDelaySeconds,
+		Help:      "Delay the SDK waited before retrying requests to AWS services",
+		Buckets:   []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300},
+	}, []string{labelService, labelOperation})
 
 	if err := registerer.Register(apiCallsTotal); err != nil {
 		return nil, err
@@ -75,11 +91,19 @@ func newInstruments(registerer prometheus.Registerer) (*instruments, error) {
 	if err := registerer.Register(apiRequestDurationSecond); err != nil {
 		return nil, err
 	}
+	if err := registerer.Register(apiRequestThrottlesTotal); err != nil {
+		return nil, err
+	}
+	if err := registerer.Register(apiRetryDelaySeconds); err != nil {
+		return nil, err
+	}
 	return &instruments{
 		apiCallsTotal:            apiCallsTotal,
 		apiCallDurationSeconds:   apiCallDurationSeconds,
 		apiCallRetries:           apiCallRetries,
 		apiRequestsTotal:         apiRequestsTotal,
 		apiRequestDurationSecond: apiRequestDurationSecond,
+		apiRequestThrottlesTotal: apiRequestThrottlesTotal,
+		apiRetryDelaySeconds:     apiRetryDelaySeconds,
 	}, nil
 }
@@ -0,0 +1,198 @@
+package retry
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retryv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	sdkHandlerAdaptiveRateLimit   = "adaptiveRateLimit"
+	sdkHandlerAdaptiveRateMeasure = "adaptiveRateMeasure"
+
+	metricClientRateLimit = "api_client_rate_limit"
+	labelService          = "service"
+
+	// the minimum rate in requests per second that a throttled service is limited to.
+	adaptiveMinRate = 0.5
+	// the factor the rate is multiplied by each time a request is throttled.
+	adaptiveRateDecreaseFactor = 0.7
+	// the rate in requests per second added for each succeeded request once throttled.
+	adaptiveRateIncrease = 0.1
+	// the window in which the sending rate is measured.
+	adaptiveMeasureWindow = time.Second
+)
+
+// serviceRateState is the adaptive rate limit state of an AWS service.
+type serviceRateState struct {
+	// limiter for requests, nil means unlimited.
+	limiter *rate.Limiter
+	// sending rate when the service started to throttle, the limiter is removed once the rate recovers to it.
+	ceiling float64
+
+	windowStart    time.Time
+	windowAttempts int
+	lastWindowRate float64
+}
+
+// adaptiveRateLimiter limits the requests to each AWS service based on throttling responses.
+// A service isn't limited until it throttles a request, then its rate is decreased multiplicatively on each throttled request,
+// and increased additively on each succeeded request until it recovers to the sending rate when it started to throttle.
+// This way, the controller backs off as a whole instead of each request retrying independently, e.g. after restarts.
+type adaptiveRateLimiter struct {
+	mutex          sync.Mutex
+	stateByService map[string]*serviceRateState
+	now            func() time.Time
+
+	clientRateLimit *prometheus.GaugeVec
+}
+
+// NewAdaptiveRateLimiter constructs new adaptive request rate limiter, its metrics are registered to registerer if not nil.
+func NewAdaptiveRateLimiter(registerer prometheus.Registerer) (*adaptiveRateLimiter, error) {
+	clientRateLimit := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemAWS,
+		Name:      metricClientRateLimit,
+		Help:      "Client side rate limit in requests per second of AWS services that throttled requests",
+	}, []string{labelService})
+	if registerer != nil {
+		if err := registerer.Register(clientRateLimit); err != nil {
+			return nil, err
+		}
+	}
+	return &adaptiveRateLimiter{
+		stateByService:  make(map[string]*serviceRateState),
+		now:             time.Now,
+		clientRateLimit: clientRateLimit,
+	}, nil
+}
+
+func (l *adaptiveRateLimiter) InjectHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: sdkHandlerAdaptiveRateLimit,
+		Fn:   l.beforeSign,
+	})
+	handlers.CompleteAttempt.PushFrontNamed(request.NamedHandler{
+		Name: sdkHandlerAdaptiveRateMeasure,
+		Fn:   l.afterAttempt,
+	})
+}
+
+// InjectMiddleware injects the adaptive rate limit into the middleware stack of aws-sdk-go-v2 clients.
+// It's added right after the retry middleware, so that each attempt is limited and measured along with the requests of aws-sdk-go.
+func (l *adaptiveRateLimiter) InjectMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(sdkHandlerAdaptiveRateLimit, l.aroundAttempt), "Retry", middleware.After)
+}
+
+// beforeSign is added to the Sign chain; called before each request attempt
+func (l *adaptiveRateLimiter) beforeSign(r *request.Request) {
+	if err := l.wait(r.Context(), r.ClientInfo.ServiceID); err != nil {
+		r.Error = awserr.New(request.CanceledErrorCode, "adaptive rate limit wait canceled", err)
+	}
+}
+
+// afterAttempt is added to the CompleteAttempt chain; called after each request attempt
+func (l *adaptiveRateLimiter) afterAttempt(r *request.Request) {
+	l.recordAttemptResult(r.ClientInfo.ServiceID, r.IsErrorThrottle(), r.Error == nil)
+}
+
+// aroundAttempt is added to the Finalize step of aws-sdk-go-v2 clients; called for each request attempt
+func (l *adaptiveRateLimiter) aroundAttempt(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	middleware.FinalizeOutput, middleware.Metadata, error) {
+	serviceID := awsmiddleware.GetServiceID(ctx)
+	if err := l.wait(ctx, serviceID); err != nil {
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+	}
+	out, metadata, err := next.HandleFinalize(ctx, in)
+	throttled := err != nil && retryv2.IsErrorThrottles(retryv2.DefaultThrottles).IsErrorThrottle(err) == awsv2.TrueTernary
+	l.recordAttemptResult(serviceID, throttled, err == nil)
+	return out, metadata, err
+}
+
+// wait blocks until the service allows a request attempt, or ctx is done.
+func (l *adaptiveRateLimiter) wait(ctx context.Context, serviceID string) error {
+	l.mutex.Lock()
+	limiter := l.stateForService(serviceID).limiter
+	l.mutex.Unlock()
+	if limiter == nil {
+		return nil
+	}
+	return limiter.Wait(ctx)
+}
+
+// recordAttemptResult adjusts the rate of the service based on the result of a request attempt.
+func (l *adaptiveRateLimiter) recordAttemptResult(serviceID string, throttled bool, succeeded bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	state := l.stateForService(serviceID)
+	now := l.now()
+	state.recordAttempt(now)
+
+	switch {
+	case throttled:
+		newRate := 0.0
+		if state.limiter == nil {
+			state.ceiling = state.sendingRate(now)
+			newRate = state.ceiling * adaptiveRateDecreaseFactor
+		} else {
+			newRate = float64(state.limiter.Limit()) * adaptiveRateDecreaseFactor
+		}
+		l.setRate(serviceID, state, math.Max(newRate, adaptiveMinRate))
+	case succeeded && state.limiter != nil:
+		newRate := float64(state.limiter.Limit()) + adaptiveRateIncrease
+		if newRate >= state.ceiling {
+			state.limiter = nil
+			l.clientRateLimit.DeleteLabelValues(serviceID)
+			return
+		}
+		l.setRate(serviceID, state, newRate)
+	}
+}
+
+func (l *adaptiveRateLimiter) setRate(serviceID string, state *serviceRateState, newRate float64) {
+	burst := int(math.Max(1, newRate))
+	if state.limiter == nil {
+		state.limiter = rate.NewLimiter(rate.Limit(newRate), burst)
+	} else {
+		state.limiter.SetLimit(rate.Limit(newRate))
+		state.limiter.SetBurst(burst)
+	}
+	l.clientRateLimit.WithLabelValues(serviceID).Set(newRate)
+}
+
+func (l *adaptiveRateLimiter) stateForService(serviceID string) *serviceRateState {
+	state, ok := l.stateByService[serviceID]
+	if !ok {
+		state = &serviceRateState{windowStart: l.now()}
+		l.stateByService[serviceID] = state
+	}
+	return state
+}
+
+// recordAttempt records a request attempt at now to measure the sending rate.
+func (s *serviceRateState) recordAttempt(now time.Time) {
+	if elapsed := now.Sub(s.windowStart); elapsed >= adaptiveMeasureWindow {
+		s.lastWindowRate = float64(s.windowAttempts) / elapsed.Seconds()
+		s.windowStart = now
+		s.windowAttempts = 0
+	}
+	s.windowAttempts++
+}
+
+// sendingRate returns the sending rate in requests per second, as the higher of the last and current measure window.
+func (s *serviceRateState) sendingRate(now time.Time) float64 {
+	elapsed := now.Sub(s.windowStart)
+	if elapsed < adaptiveMeasureWindow {
+		elapsed = adaptiveMeasureWindow
+	}
+	return math.Max(s.lastWindowRate, float64(s.windowAttempts)/elapsed.Seconds())
+}
@@ -0,0 +1,246 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_adaptiveRateLimiter_afterAttempt(t *testing.T) {
+	const serviceID = "Elastic Load Balancing v2"
+	type attempt struct {
+		// offset of the attempt from the start.
+		offset time.Duration
+		err    error
+	}
+	tests := []struct {
+		name     string
+		attempts []attempt
+		// wantRate is the limited rate after attempts, zero means unlimited.
+		wantRate float64
+	}{
+		{
+			name: "unlimited until throttled",
+			attempts: []attempt{
+				{offset: 0},
+				{offset: 10 * time.Millisecond, err: errors.New("some error")},
+			},
+			wantRate: 0,
+		},
+		{
+			name: "limited to fraction of sending rate once throttled",
+			attempts: []attempt{
+				{offset: 0},
+				{offset: 100 * time.Millisecond},
+				{offset: 200 * time.Millisecond},
+				{offset: 300 * time.Millisecond},
+				{offset: 400 * time.Millisecond},
+				{offset: 500 * time.Millisecond},
+				{offset: 600 * time.Millisecond},
+				{offset: 700 * time.Millisecond},
+				{offset: 800 * time.Millisecond},
+				{offset: 900 * time.Millisecond, err: awserr.New("Throttling", "", nil)},
+			},
+			wantRate: 7,
+		},
+		{
+			name: "decreased on each throttled request",
+			attempts: []attempt{
+				{offset: 0},
+				{offset: 100 * time.Millisecond},
+				{offset: 200 * time.Millisecond},
+				{offset: 300 * time.Millisecond},
+				{offset: 400 * time.Millisecond},
+				{offset: 500 * time.Millisecond},
+				{offset: 600 * time.Millisecond},
+				{offset: 700 * time.Millisecond},
+				{offset: 800 * time.Millisecond},
+				{offset: 900 * time.Millisecond, err: awserr.New("Throttling", "", nil)},
+				{offset: 1000 * time.Millisecond, err: awserr.New("Throttling", "", nil)},
+			},
+			wantRate: 4.9,
+		},
+		{
+			name: "never limited under min rate",
+			attempts: []attempt{
+				{offset: 0, err: awserr.New("Throttling", "", nil)},
+				{offset: time.Second, err: awserr.New("Throttling", "", nil)},
+				{offset: 2 * time.Second, err: awserr.New("Throttling", "", nil)},
+			},
+			wantRate: 0.5,
+		},
+		{
+			name: "increased on each succeeded request",
+			attempts: []attempt{
+				{offset: 0},
+				{offset: 100 * time.Millisecond},
+				{offset: 200 * time.Millisecond},
+				{offset: 300 * time.Millisecond},
+				{offset: 400 * time.Millisecond},
+				{offset: 500 * time.Millisecond},
+				{offset: 600 * time.Millisecond},
+				{offset: 700 * time.Millisecond},
+				{offset: 800 * time.Millisecond},
+				{offset: 900 * time.Millisecond, err: awserr.New("Throttling", "", nil)},
+				{offset: 1000 * time.Millisecond},
+				{offset: 1100 * time.Millisecond},
+			},
+			wantRate: 7.2,
+		},
+		{
+			name: "unlimited once recovered to sending rate when throttled",
+			attempts: append([]attempt{
+				{offset: 0},
+				{offset: 500 * time.Millisecond, err: awserr.New("Throttling", "", nil)},
+			}, func() []attempt {
+				// throttled at 2 requests per second, limited to 1.4, recovers after 6 succeeded requests.
+				var attempts []attempt
+				for i := 0; i < 6; i++ {
+					attempts = append(attempts, attempt{offset: time.Second + time.Duration(i)*time.Second})
+				}
+				return attempts
+			}()...),
+			wantRate: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			now := start
+			registry := prometheus.NewRegistry()
+			l, err := NewAdaptiveRateLimiter(registry)
+			require.NoError(t, err)
+			l.now = func() time.Time { return now }
+			for _, attempt := range tt.attempts {
+				now = start.Add(attempt.offset)
+				l.afterAttempt(&request.Request{
+					ClientInfo:   metadata.ClientInfo{ServiceID: serviceID},
+					Error:        attempt.err,
+					HTTPResponse: &http.Response{StatusCode: 400},
+				})
+			}
+			limiter := l.stateByService[serviceID].limiter
+			if tt.wantRate == 0 {
+				assert.Nil(t, limiter)
+				assert.Equal(t, 0, testutil.CollectAndCount(l.clientRateLimit))
+			} else {
+				require.NotNil(t, limiter)
+				assert.InDelta(t, tt.wantRate, float64(limiter.Limit()), 0.0001)
+				assert.InDelta(t, tt.wantRate, testutil.ToFloat64(l.clientRateLimit.WithLabelValues(serviceID)), 0.0001)
+			}
+		})
+	}
+}
+
+func Test_adaptiveRateLimiter_beforeSign(t *testing.T) {
+	const serviceID = "Elastic Load Balancing v2"
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name        string
+		limitedRate float64
+		ctx         context.Context
+		wantErrCode string
+	}{
+		{
+			name:        "unlimited requests are sent",
+			ctx:         canceledCtx,
+			wantErrCode: "",
+		},
+		{
+			name:        "limited requests are sent within the rate",
+			limitedRate: 10,
+			ctx:         context.Background(),
+			wantErrCode: "",
+		},
+		{
+			name:        "limited requests fail once context canceled",
+			limitedRate: 10,
+			ctx:         canceledCtx,
+			wantErrCode: request.CanceledErrorCode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewAdaptiveRateLimiter(nil)
+			require.NoError(t, err)
+			if tt.limitedRate > 0 {
+				l.setRate(serviceID, l.stateForService(serviceID), tt.limitedRate)
+			}
+			r := &request.Request{
+				ClientInfo:  metadata.ClientInfo{ServiceID: serviceID},
+				Operation:   &request.Operation{Name: "DescribeTargetHealth"},
+				HTTPRequest: &http.Request{},
+			}
+			r.SetContext(tt.ctx)
+			l.beforeSign(r)
+			var gotErrCode string
+			if awsErr, ok := r.Error.(awserr.Error); ok {
+				gotErrCode = awsErr.Code()
+			}
+			assert.Equal(t, tt.wantErrCode, gotErrCode)
+		})
+	}
+}
+
+func Test_adaptiveRateLimiter_aroundAttempt(t *testing.T) {
+	const serviceID = "Elastic Load Balancing v2"
+	tests := []struct {
+		name     string
+		errs     []error
+		wantRate float64
+	}{
+		{
+			name:     "unlimited until throttled",
+			errs:     []error{nil, errors.New("some error")},
+			wantRate: 0,
+		},
+		{
+			name:     "limited to fraction of sending rate once throttled",
+			errs:     []error{&smithy.GenericAPIError{Code: "Throttling"}},
+			wantRate: 0.7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewAdaptiveRateLimiter(nil)
+			require.NoError(t, err)
+			now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			l.now = func() time.Time { return now }
+			stack := middleware.NewStack("DescribeListenerAttributes", smithyhttp.NewStackRequest)
+			require.NoError(t, stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     serviceID,
+				OperationName: "DescribeListenerAttributes",
+			}, middleware.Before))
+			require.NoError(t, stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(sdkHandlerAdaptiveRateLimit, l.aroundAttempt), middleware.After))
+			for _, attemptErr := range tt.errs {
+				handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+					return nil, middleware.Metadata{}, attemptErr
+				}), stack)
+				_, _, err := handler.Handle(context.Background(), nil)
+				assert.Equal(t, attemptErr, err)
+			}
+			limiter := l.stateByService[serviceID].limiter
+			if tt.wantRate == 0 {
+				assert.Nil(t, limiter)
+			} else {
+				require.NotNil(t, limiter)
+				assert.InDelta(t, tt.wantRate, float64(limiter.Limit()), 0.0001)
+			}
+		})
+	}
+}
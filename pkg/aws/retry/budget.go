@@ -0,0 +1,171 @@
+package retry
+
+import (
+	"context"
+	"regexp"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
+)
+
+const (
+	sdkHandlerRequestBudget = "requestBudget"
+	sdkHandlerRetryBudget   = "retryBudget"
+)
+
+// OperationClass is the class of an AWS API operation.
+type OperationClass string
+
+const (
+	// OperationClassRead represents operations that only read AWS resources, e.g. Describe*, Get*, List*.
+	OperationClassRead OperationClass = "read"
+	// OperationClassWrite represents operations that create, modify or delete AWS resources.
+	OperationClassWrite OperationClass = "write"
+)
+
+var readOperationPtn = regexp.MustCompile("^(Describe|Get|List)")
+
+// ClassifyOperation returns the OperationClass of operation with specified name.
+func ClassifyOperation(operationName string) OperationClass {
+	if readOperationPtn.MatchString(operationName) {
+		return OperationClassRead
+	}
+	return OperationClassWrite
+}
+
+type operationBudget struct {
+	// limiter for requests(including retries), nil means unlimited.
+	requestLimiter *rate.Limiter
+	// limiter for retries, nil means unlimited.
+	retryLimiter *rate.Limiter
+}
+
+type readWriteBudget struct {
+	budgetByClass map[OperationClass]operationBudget
+}
+
+// NewReadWriteBudget constructs new request and retry budget that isolates read and write operations.
+func NewReadWriteBudget(config ReadWriteBudgetConfig) *readWriteBudget {
+	return &readWriteBudget{
+		budgetByClass: map[OperationClass]operationBudget{
+			OperationClassRead: {
+				requestLimiter: config.ReadRequests.newLimiter(),
+				retryLimiter:   config.ReadRetries.newLimiter(),
+			},
+			OperationClassWrite: {
+				requestLimiter: config.WriteRequests.newLimiter(),
+				retryLimiter:   config.WriteRetries.newLimiter(),
+			},
+		},
+	}
+}
+
+func (b *readWriteBudget) InjectHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: sdkHandlerRequestBudget,
+		Fn:   b.beforeSign,
+	})
+	handlers.AfterRetry.PushFrontNamed(request.NamedHandler{
+		Name: sdkHandlerRetryBudget,
+		Fn:   b.beforeAfterRetry,
+	})
+}
+
+// InjectMiddleware injects the request budget into the middleware stack of aws-sdk-go-v2 clients.
+// It's added right after the retry middleware so that each attempt is limited, while the retry budget applies via WrapRetryer.
+func (b *readWriteBudget) InjectMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(sdkHandlerRequestBudget, b.beforeAttempt), "Retry", middleware.After)
+}
+
+// WrapRetryer wraps the retryer of aws-sdk-go-v2 clients, so that failed requests aren't retried
+// once the retry budget of their operation class is exhausted.
+func (b *readWriteBudget) WrapRetryer(retryer awsv2.RetryerV2) awsv2.RetryerV2 {
+	return &budgetRetryer{
+		RetryerV2: retryer,
+		budget:    b,
+	}
+}
+
+// beforeSign is added to the Sign chain; called before each request attempt.
+// The request fails without being sent if its context is done before the request budget allows it.
+func (b *readWriteBudget) beforeSign(r *request.Request) {
+	if r.Error != nil {
+		return
+	}
+	budget, ok := b.budgetForRequest(r)
+	if !ok || budget.requestLimiter == nil {
+		return
+	}
+	if err := budget.requestLimiter.Wait(r.Context()); err != nil {
+		r.Error = awserr.New(request.CanceledErrorCode, "request budget wait canceled", err)
+		return
+	}
+}
+
+// beforeAfterRetry is added to the front of AfterRetry chain; called before the SDK decides whether to retry a failed request.
+// If the retry budget of request's operation class is exhausted, the request is marked as not retryable.
+// Note: this has no effect if EnforceShouldRetryCheck is set in the SDK config.
+func (b *readWriteBudget) beforeAfterRetry(r *request.Request) {
+	budget, ok := b.budgetForRequest(r)
+	if !ok || budget.retryLimiter == nil {
+		return
+	}
+	if r.Retryable == nil {
+		r.Retryable = aws.Bool(r.ShouldRetry(r))
+	}
+	if !r.WillRetry() {
+		return
+	}
+	if !budget.retryLimiter.Allow() {
+		r.Retryable = aws.Bool(false)
+	}
+}
+
+// beforeAttempt is added to the Finalize step of aws-sdk-go-v2 clients; called before each request attempt.
+// The request fails without being sent if its context is done before the request budget allows it.
+func (b *readWriteBudget) beforeAttempt(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	middleware.FinalizeOutput, middleware.Metadata, error) {
+	budget, ok := b.budgetForOperation(awsmiddleware.GetOperationName(ctx))
+	if ok && budget.requestLimiter != nil {
+		if err := budget.requestLimiter.Wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, &awsv2.RequestCanceledError{Err: err}
+		}
+	}
+	return next.HandleFinalize(ctx, in)
+}
+
+func (b *readWriteBudget) budgetForRequest(r *request.Request) (operationBudget, bool) {
+	if r.Operation == nil {
+		return operationBudget{}, false
+	}
+	return b.budgetForOperation(r.Operation.Name)
+}
+
+func (b *readWriteBudget) budgetForOperation(operationName string) (operationBudget, bool) {
+	if operationName == "" {
+		return operationBudget{}, false
+	}
+	budget, ok := b.budgetByClass[ClassifyOperation(operationName)]
+	return budget, ok
+}
+
+// budgetRetryer is an aws-sdk-go-v2 retryer that limits retries by the retry budget of their operation class.
+type budgetRetryer struct {
+	awsv2.RetryerV2
+	budget *readWriteBudget
+}
+
+// GetRetryToken is called once the retryer decided to retry the failed attempt.
+// If the retry budget is exhausted, the error of the attempt is returned as is, so that the request fails with it.
+func (r *budgetRetryer) GetRetryToken(ctx context.Context, opErr error) (func(error) error, error) {
+	budget, ok := r.budget.budgetForOperation(awsmiddleware.GetOperationName(ctx))
+	if ok && budget.retryLimiter != nil && !budget.retryLimiter.Allow() {
+		return nil, opErr
+	}
+	return r.RetryerV2.GetRetryToken(ctx, opErr)
+}
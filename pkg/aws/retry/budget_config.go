@@ -0,0 +1,120 @@
+package retry
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"golang.org/x/time/rate"
+)
+
+var _ pflag.Value = &RateLimitConfig{}
+
+// RateLimitConfig is the rate limit for requests or retries of a class of AWS API operations.
+// It supports to be configured using flags with format like "${rate}:${burst}"
+// e.g. "10:40"
+// Note: the rate limit is disabled if rate is zero.
+type RateLimitConfig struct {
+	// rate in operations per second.
+	Rate rate.Limit
+	// maximum burst of operations.
+	Burst int
+}
+
+func (c *RateLimitConfig) String() string {
+	if c == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v:%d", c.Rate, c.Burst)
+}
+
+func (c *RateLimitConfig) Set(val string) error {
+	rateBurstPair := strings.Split(val, ":")
+	if len(rateBurstPair) != 2 {
+		return errors.Errorf("%s must be formatted as rate:burst", val)
+	}
+	r, err := strconv.ParseFloat(rateBurstPair[0], 64)
+	if err != nil {
+		return errors.Errorf("%s must be valid float number as rate for operations per second", rateBurstPair[0])
+	}
+	burst, err := strconv.Atoi(rateBurstPair[1])
+	if err != nil {
+		return errors.Errorf("%s must be valid integer as burst for operations", rateBurstPair[1])
+	}
+	if r < 0 || burst < 0 {
+		return errors.Errorf("%s must be non-negative rate and burst", val)
+	}
+	if r > 0 && burst == 0 {
+		return errors.Errorf("%s must have positive burst when rate is set", val)
+	}
+	c.Rate = rate.Limit(r)
+	c.Burst = burst
+	return nil
+}
+
+func (c *RateLimitConfig) Type() string {
+	return "rateLimitConfig"
+}
+
+// newLimiter constructs the rate limiter for this config, returns nil if rate limit is disabled.
+func (c *RateLimitConfig) newLimiter() *rate.Limiter {
+	if c.Rate == 0 {
+		return nil
+	}
+	return rate.NewLimiter(c.Rate, c.Burst)
+}
+
+// ReadWriteBudgetConfig is the budget configuration for read and write AWS API operations.
+// Read and write operations are given independent budgets, so that excessive read operations during resync
+// won't starve write operations, and vice versa.
+type ReadWriteBudgetConfig struct {
+	// ReadRequests limits the requests(including retries) of read operations.
+	ReadRequests RateLimitConfig
+	// ReadRetries limits the retries of read operations.
+	ReadRetries RateLimitConfig
+	// WriteRequests limits the requests(including retries) of write operations.
+	WriteRequests RateLimitConfig
+	// WriteRetries limits the retries of write operations.
+	WriteRetries RateLimitConfig
+}
+
+// NewDefaultReadWriteBudgetConfig returns a ReadWriteBudgetConfig with default settings.
+// The budgets are disabled by default, so that requests are retried up to the max retries of the SDK as before they were introduced.
+func NewDefaultReadWriteBudgetConfig() ReadWriteBudgetConfig {
+	return ReadWriteBudgetConfig{}
+}
+
+var _ pflag.Value = new(Mode)
+
+// Mode is the retry mode for AWS API requests.
+type Mode string
+
+const (
+	// ModeStandard retries each failed request independently with exponential backoff.
+	ModeStandard Mode = "standard"
+	// ModeAdaptive additionally limits the request rate to each AWS service once it throttles requests.
+	ModeAdaptive Mode = "adaptive"
+)
+
+func (m *Mode) String() string {
+	if m == nil {
+		return ""
+	}
+	return string(*m)
+}
+
+func (m *Mode) Set(val string) error {
+	switch Mode(val) {
+	case ModeStandard, ModeAdaptive:
+		*m = Mode(val)
+		return nil
+	default:
+		return errors.Errorf("%s must be either %s or %s", val, ModeStandard, ModeAdaptive)
+	}
+}
+
+func (m *Mode) Type() string {
+	return "retryMode"
+}
@@ -0,0 +1,379 @@
+package retry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	retryv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// newTestStack returns the middleware stack of an aws-sdk-go-v2 operation with the retry middleware of retryer.
+func newTestStack(t *testing.T, operationName string, retryer awsv2.RetryerV2) *middleware.Stack {
+	stack := middleware.NewStack(operationName, smithyhttp.NewStackRequest)
+	require.NoError(t, stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "Elastic Load Balancing v2",
+		OperationName: operationName,
+	}, middleware.Before))
+	require.NoError(t, stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("Signing", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		return next.HandleFinalize(ctx, in)
+	}), middleware.After))
+	require.NoError(t, retryv2.AddRetryMiddlewares(stack, retryv2.AddRetryMiddlewaresOptions{Retryer: retryer}))
+	return stack
+}
+
+func TestClassifyOperation(t *testing.T) {
+	tests := []struct {
+		name          string
+		operationName string
+		want          OperationClass
+	}{
+		{
+			name:          "describe operation",
+			operationName: "DescribeLoadBalancers",
+			want:          OperationClassRead,
+		},
+		{
+			name:          "get operation",
+			operationName: "GetWebACLForResource",
+			want:          OperationClassRead,
+		},
+		{
+			name:          "list operation",
+			operationName: "ListResourcesForWebACL",
+			want:          OperationClassRead,
+		},
+		{
+			name:          "create operation",
+			operationName: "CreateLoadBalancer",
+			want:          OperationClassWrite,
+		},
+		{
+			name:          "modify operation",
+			operationName: "ModifyTargetGroupAttributes",
+			want:          OperationClassWrite,
+		},
+		{
+			name:          "register operation",
+			operationName: "RegisterTargets",
+			want:          OperationClassWrite,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ClassifyOperation(tt.operationName)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_readWriteBudget_beforeAfterRetry(t *testing.T) {
+	newFailedRequest := func(operationName string) *request.Request {
+		return &request.Request{
+			Operation: &request.Operation{Name: operationName},
+			Retryer:   client.DefaultRetryer{NumMaxRetries: 3},
+			Retryable: aws.Bool(true),
+			Error:     errors.New("some error"),
+			Body:      bytes.NewReader(nil),
+		}
+	}
+	tests := []struct {
+		name          string
+		config        ReadWriteBudgetConfig
+		operationName string
+		attempts      int
+		wantRetryable []bool
+	}{
+		{
+			name: "read retries are limited by read retry budget",
+			config: ReadWriteBudgetConfig{
+				ReadRetries: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 2},
+			},
+			operationName: "DescribeTargetHealth",
+			attempts:      3,
+			wantRetryable: []bool{true, true, false},
+		},
+		{
+			name: "write retries are not limited by read retry budget",
+			config: ReadWriteBudgetConfig{
+				ReadRetries: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 1},
+			},
+			operationName: "RegisterTargets",
+			attempts:      3,
+			wantRetryable: []bool{true, true, true},
+		},
+		{
+			name: "write retries are limited by write retry budget",
+			config: ReadWriteBudgetConfig{
+				ReadRetries:  RateLimitConfig{Rate: rate.Limit(0.001), Burst: 5},
+				WriteRetries: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 1},
+			},
+			operationName: "RegisterTargets",
+			attempts:      2,
+			wantRetryable: []bool{true, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewReadWriteBudget(tt.config)
+			var gotRetryable []bool
+			for i := 0; i < tt.attempts; i++ {
+				r := newFailedRequest(tt.operationName)
+				b.beforeAfterRetry(r)
+				gotRetryable = append(gotRetryable, aws.BoolValue(r.Retryable))
+			}
+			assert.Equal(t, tt.wantRetryable, gotRetryable)
+		})
+	}
+}
+
+func Test_readWriteBudget_WrapRetryer(t *testing.T) {
+	throttlingErr := &smithy.GenericAPIError{Code: "Throttling"}
+	tests := []struct {
+		name          string
+		config        ReadWriteBudgetConfig
+		operationName string
+		calls         int
+		wantAttempts  int
+	}{
+		{
+			name: "read retries are limited by read retry budget",
+			config: ReadWriteBudgetConfig{
+				ReadRetries: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 2},
+			},
+			operationName: "DescribeTargetHealth",
+			calls:         2,
+			wantAttempts:  4,
+		},
+		{
+			name: "write retries are not limited by read retry budget",
+			config: ReadWriteBudgetConfig{
+				ReadRetries: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 1},
+			},
+			operationName: "RegisterTargets",
+			calls:         2,
+			wantAttempts:  6,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewReadWriteBudget(tt.config)
+			retryer := b.WrapRetryer(retryv2.NewStandard(func(o *retryv2.StandardOptions) {
+				o.MaxAttempts = 3
+				o.Backoff = retryv2.BackoffDelayerFunc(func(int, error) (time.Duration, error) { return 0, nil })
+				o.RateLimiter = ratelimit.None
+			}))
+			stack := newTestStack(t, tt.operationName, retryer)
+			attempts := 0
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+				attempts++
+				return nil, middleware.Metadata{}, throttlingErr
+			}), stack)
+			for i := 0; i < tt.calls; i++ {
+				_, _, err := handler.Handle(context.Background(), nil)
+				var apiErr smithy.APIError
+				assert.True(t, errors.As(err, &apiErr))
+				assert.Equal(t, "Throttling", apiErr.ErrorCode())
+			}
+			assert.Equal(t, tt.wantAttempts, attempts)
+		})
+	}
+}
+
+func Test_readWriteBudget_beforeAttempt(t *testing.T) {
+	shortDeadlineCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	tests := []struct {
+		name          string
+		config        ReadWriteBudgetConfig
+		operationName string
+		ctx           context.Context
+		attempts      int
+		wantCanceled  []bool
+	}{
+		{
+			name: "requests within the request budget are sent",
+			config: ReadWriteBudgetConfig{
+				ReadRequests: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 2},
+			},
+			operationName: "DescribeTargetHealth",
+			ctx:           context.Background(),
+			attempts:      2,
+			wantCanceled:  []bool{false, false},
+		},
+		{
+			name: "requests beyond the request budget fail if context deadline is earlier than the budget allows",
+			config: ReadWriteBudgetConfig{
+				ReadRequests: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 1},
+			},
+			operationName: "DescribeTargetHealth",
+			ctx:           shortDeadlineCtx,
+			attempts:      2,
+			wantCanceled:  []bool{false, true},
+		},
+		{
+			name: "requests without request budget are sent",
+			config: ReadWriteBudgetConfig{
+				ReadRequests: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 1},
+			},
+			operationName: "RegisterTargets",
+			ctx:           shortDeadlineCtx,
+			attempts:      2,
+			wantCanceled:  []bool{false, false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewReadWriteBudget(tt.config)
+			stack := middleware.NewStack(tt.operationName, smithyhttp.NewStackRequest)
+			require.NoError(t, stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     "Elastic Load Balancing v2",
+				OperationName: tt.operationName,
+			}, middleware.Before))
+			require.NoError(t, stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(sdkHandlerRequestBudget, b.beforeAttempt), middleware.After))
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+			var gotCanceled []bool
+			for i := 0; i < tt.attempts; i++ {
+				_, _, err := handler.Handle(tt.ctx, nil)
+				var canceledErr *awsv2.RequestCanceledError
+				gotCanceled = append(gotCanceled, errors.As(err, &canceledErr))
+			}
+			assert.Equal(t, tt.wantCanceled, gotCanceled)
+		})
+	}
+}
+
+func Test_readWriteBudget_beforeSign(t *testing.T) {
+	shortDeadlineCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	tests := []struct {
+		name          string
+		config        ReadWriteBudgetConfig
+		operationName string
+		ctx           context.Context
+		attempts      int
+		wantErrCodes  []string
+	}{
+		{
+			name: "requests within the request budget are sent",
+			config: ReadWriteBudgetConfig{
+				ReadRequests: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 2},
+			},
+			operationName: "DescribeTargetHealth",
+			ctx:           context.Background(),
+			attempts:      2,
+			wantErrCodes:  []string{"", ""},
+		},
+		{
+			name: "requests beyond the request budget fail if context deadline is earlier than the budget allows",
+			config: ReadWriteBudgetConfig{
+				ReadRequests: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 1},
+			},
+			operationName: "DescribeTargetHealth",
+			ctx:           shortDeadlineCtx,
+			attempts:      2,
+			wantErrCodes:  []string{"", request.CanceledErrorCode},
+		},
+		{
+			name: "requests without request budget are sent",
+			config: ReadWriteBudgetConfig{
+				ReadRequests: RateLimitConfig{Rate: rate.Limit(0.001), Burst: 1},
+			},
+			operationName: "RegisterTargets",
+			ctx:           shortDeadlineCtx,
+			attempts:      2,
+			wantErrCodes:  []string{"", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewReadWriteBudget(tt.config)
+			var gotErrCodes []string
+			for i := 0; i < tt.attempts; i++ {
+				r := &request.Request{
+					Operation:   &request.Operation{Name: tt.operationName},
+					HTTPRequest: &http.Request{},
+				}
+				r.SetContext(tt.ctx)
+				b.beforeSign(r)
+				var errCode string
+				if awsErr, ok := r.Error.(awserr.Error); ok {
+					errCode = awsErr.Code()
+				}
+				gotErrCodes = append(gotErrCodes, errCode)
+			}
+			assert.Equal(t, tt.wantErrCodes, gotErrCodes)
+		})
+	}
+}
+
+func TestRateLimitConfig_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		val     string
+		want    RateLimitConfig
+		wantErr error
+	}{
+		{
+			name: "valid rate and burst",
+			val:  "4.2:5",
+			want: RateLimitConfig{Rate: rate.Limit(4.2), Burst: 5},
+		},
+		{
+			name: "disabled",
+			val:  "0:0",
+			want: RateLimitConfig{Rate: rate.Limit(0), Burst: 0},
+		},
+		{
+			name:    "missing burst",
+			val:     "4.2",
+			wantErr: errors.New("4.2 must be formatted as rate:burst"),
+		},
+		{
+			name:    "invalid rate",
+			val:     "a:5",
+			wantErr: errors.New("a must be valid float number as rate for operations per second"),
+		},
+		{
+			name:    "invalid burst",
+			val:     "4.2:b",
+			wantErr: errors.New("b must be valid integer as burst for operations"),
+		},
+		{
+			name:    "zero burst with rate",
+			val:     "4.2:0",
+			wantErr: errors.New("4.2:0 must have positive burst when rate is set"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &RateLimitConfig{}
+			err := c.Set(tt.val)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, *c)
+				assert.Equal(t, tt.val, c.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package retry
+
+import (
+	"context"
+	"sync"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	sdkHandlerInFlightAcquire        = "inFlightAcquire"
+	sdkHandlerInFlightAttemptRelease = "inFlightAttemptRelease"
+	sdkHandlerInFlightRelease        = "inFlightRelease"
+
+	metricSubsystemAWS     = "aws"
+	metricInFlightRequests = "api_inflight_requests"
+	labelOperationClass    = "operation_class"
+)
+
+// InFlightConfig is the maximum number of concurrent requests of read and write AWS API operations, zero means unlimited.
+// Limiting in-flight read operations keeps the bursts of Describe* calls after restarts from starving mutations, and vice versa.
+type InFlightConfig struct {
+	// MaxReads is the maximum number of in-flight requests of read operations.
+	MaxReads int
+	// MaxWrites is the maximum number of in-flight requests of write operations.
+	MaxWrites int
+}
+
+type inFlightLimiter struct {
+	// semaphore by operation class, classes without semaphore are unlimited.
+	semaphoreByClass map[OperationClass]chan struct{}
+	// requests that hold a slot of semaphore, mapped to their operation class.
+	acquired sync.Map
+
+	inFlightRequests *prometheus.GaugeVec
+}
+
+// NewInFlightLimiter constructs new limiter for concurrent requests of read and write operations,
+// its metrics are registered to registerer if not nil.
+func NewInFlightLimiter(config InFlightConfig, registerer prometheus.Registerer) (*inFlightLimiter, error) {
+	inFlightRequests := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemAWS,
+		Name:      metricInFlightRequests,
+		Help:      "Number of in-flight HTTP requests to AWS services",
+	}, []string{labelOperationClass})
+	if registerer != nil {
+		if err := registerer.Register(inFlightRequests); err != nil {
+			return nil, err
+		}
+	}
+	semaphoreByClass := make(map[OperationClass]chan struct{})
+	if config.MaxReads > 0 {
+		semaphoreByClass[OperationClassRead] = make(chan struct{}, config.MaxReads)
+	}
+	if config.MaxWrites > 0 {
+		semaphoreByClass[OperationClassWrite] = make(chan struct{}, config.MaxWrites)
+	}
+	return &inFlightLimiter{
+		semaphoreByClass: semaphoreByClass,
+		inFlightRequests: inFlightRequests,
+	}, nil
+}
+
+// InjectHandlers injects the handlers that limit concurrent requests.
+// The slot is acquired at the end of the Sign chain so that requests don't hold it while waiting for rate limits,
+// and released after each attempt, or after the call if the attempt fails before sent.
+func (l *inFlightLimiter) InjectHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushBackNamed(request.NamedHandler{
+		Name: sdkHandlerInFlightAcquire,
+		Fn:   l.acquire,
+	})
+	handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+		Name: sdkHandlerInFlightAttemptRelease,
+		Fn:   l.release,
+	})
+	handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: sdkHandlerInFlightRelease,
+		Fn:   l.release,
+	})
+}
+
+// InjectMiddleware injects the limit of concurrent requests into the middleware stack of aws-sdk-go-v2 clients.
+// It's added to the end of Finalize step so that requests don't hold the slot while waiting for rate limits,
+// and the slot is released after each attempt.
+func (l *inFlightLimiter) InjectMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(sdkHandlerInFlightAcquire, l.aroundAttempt), middleware.After)
+}
+
+// aroundAttempt is added to the Finalize step of aws-sdk-go-v2 clients; called for each request attempt
+func (l *inFlightLimiter) aroundAttempt(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	middleware.FinalizeOutput, middleware.Metadata, error) {
+	class := ClassifyOperation(awsmiddleware.GetOperationName(ctx))
+	semaphore, ok := l.semaphoreByClass[class]
+	if !ok {
+		return next.HandleFinalize(ctx, in)
+	}
+	select {
+	case semaphore <- struct{}{}:
+	case <-ctx.Done():
+		return middleware.FinalizeOutput{}, middleware.Metadata{}, &awsv2.RequestCanceledError{Err: ctx.Err()}
+	}
+	l.inFlightRequests.WithLabelValues(string(class)).Inc()
+	defer func() {
+		<-semaphore
+		l.inFlightRequests.WithLabelValues(string(class)).Dec()
+	}()
+	return next.HandleFinalize(ctx, in)
+}
+
+func (l *inFlightLimiter) acquire(r *request.Request) {
+	if r.Error != nil || r.Operation == nil {
+		return
+	}
+	class := ClassifyOperation(r.Operation.Name)
+	semaphore, ok := l.semaphoreByClass[class]
+	if !ok {
+		return
+	}
+	select {
+	case semaphore <- struct{}{}:
+		l.acquired.Store(r, class)
+		l.inFlightRequests.WithLabelValues(string(class)).Inc()
+	case <-r.Context().Done():
+		r.Error = awserr.New(request.CanceledErrorCode, "request context canceled", r.Context().Err())
+	}
+}
+
+func (l *inFlightLimiter) release(r *request.Request) {
+	rawClass, ok := l.acquired.LoadAndDelete(r)
+	if !ok {
+		return
+	}
+	class := rawClass.(OperationClass)
+	<-l.semaphoreByClass[class]
+	l.inFlightRequests.WithLabelValues(string(class)).Dec()
+}
@@ -0,0 +1,108 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_inFlightLimiter(t *testing.T) {
+	l, err := NewInFlightLimiter(InFlightConfig{MaxReads: 1}, nil)
+	require.NoError(t, err)
+	newRequest := func(ctx context.Context, operationName string) *request.Request {
+		r := &request.Request{
+			Operation:   &request.Operation{Name: operationName},
+			HTTPRequest: &http.Request{},
+		}
+		r.SetContext(ctx)
+		return r
+	}
+
+	describe := newRequest(context.Background(), "DescribeLoadBalancers")
+	l.acquire(describe)
+	assert.NoError(t, describe.Error)
+	assert.Equal(t, 1.0, testutil.ToFloat64(l.inFlightRequests.WithLabelValues(string(OperationClassRead))))
+
+	// write operations are unlimited.
+	for i := 0; i < 3; i++ {
+		register := newRequest(context.Background(), "RegisterTargets")
+		l.acquire(register)
+		assert.NoError(t, register.Error)
+	}
+
+	// read operations wait for the in-flight one.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	canceled := newRequest(ctx, "DescribeTargetHealth")
+	l.acquire(canceled)
+	assert.EqualError(t, canceled.Error, "RequestCanceled: request context canceled\ncaused by: context canceled")
+	l.release(canceled)
+
+	// the slot is released once, after the attempt or the call.
+	l.release(describe)
+	l.release(describe)
+	assert.Equal(t, 0.0, testutil.ToFloat64(l.inFlightRequests.WithLabelValues(string(OperationClassRead))))
+	next := newRequest(context.Background(), "DescribeTargetHealth")
+	l.acquire(next)
+	assert.NoError(t, next.Error)
+}
+
+func Test_inFlightLimiter_aroundAttempt(t *testing.T) {
+	l, err := NewInFlightLimiter(InFlightConfig{MaxReads: 1}, nil)
+	require.NoError(t, err)
+	newHandler := func(operationName string, attempt func()) middleware.Handler {
+		stack := middleware.NewStack(operationName, smithyhttp.NewStackRequest)
+		require.NoError(t, stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+			ServiceID:     "Elastic Load Balancing v2",
+			OperationName: operationName,
+		}, middleware.Before))
+		require.NoError(t, l.InjectMiddleware(stack))
+		return middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+			attempt()
+			return nil, middleware.Metadata{}, nil
+		}), stack)
+	}
+
+	sent := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, _, err := newHandler("DescribeLoadBalancers", func() {
+			close(sent)
+			<-release
+		}).Handle(context.Background(), nil)
+		done <- err
+	}()
+	<-sent
+	assert.Equal(t, 1.0, testutil.ToFloat64(l.inFlightRequests.WithLabelValues(string(OperationClassRead))))
+
+	// write operations are unlimited.
+	for i := 0; i < 3; i++ {
+		_, _, err := newHandler("RegisterTargets", func() {}).Handle(context.Background(), nil)
+		assert.NoError(t, err)
+	}
+
+	// read operations wait for the in-flight one.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err = newHandler("DescribeTargetHealth", func() {}).Handle(ctx, nil)
+	var canceledErr *awsv2.RequestCanceledError
+	assert.True(t, errors.As(err, &canceledErr))
+
+	// the slot is released after the attempt.
+	close(release)
+	assert.NoError(t, <-done)
+	assert.Equal(t, 0.0, testutil.ToFloat64(l.inFlightRequests.WithLabelValues(string(OperationClassRead))))
+	_, _, err = newHandler("DescribeTargetHealth", func() {}).Handle(context.Background(), nil)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,140 @@
+package retry
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	retryv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const headerRetryAfter = "Retry-After"
+
+var _ request.Retryer = &Retryer{}
+var _ retryv2.BackoffDelayer = &Retryer{}
+
+// Retryer retries failed AWS API requests with exponential backoff and full jitter.
+// Unlike the SDK's DefaultRetryer, it waits at least the delay the service asks for via Retry-After header,
+// and backs off from a larger base delay when the service throttles the request.
+type Retryer struct {
+	client.DefaultRetryer
+
+	// now returns the current time, it's time.Now unless in tests.
+	now func() time.Time
+	// randMutex protects rand, which isn't safe for concurrent use.
+	randMutex *sync.Mutex
+	rand      *rand.Rand
+}
+
+// NewRetryer constructs new Retryer that retries failed requests at most maxRetries times.
+func NewRetryer(maxRetries int) *Retryer {
+	return &Retryer{
+		DefaultRetryer: client.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MinRetryDelay:    client.DefaultRetryerMinRetryDelay,
+			MinThrottleDelay: client.DefaultRetryerMinThrottleDelay,
+			MaxRetryDelay:    client.DefaultRetryerMaxRetryDelay,
+			MaxThrottleDelay: client.DefaultRetryerMaxThrottleDelay,
+		},
+		now:       time.Now,
+		randMutex: &sync.Mutex{},
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// NewSDKV2Retryer constructs new aws-sdk-go-v2 retryer that retries failed requests at most maxRetries times,
+// with the same backoff as Retryer. Like aws-sdk-go, retries aren't limited by a client side retry quota.
+func NewSDKV2Retryer(maxRetries int) awsv2.RetryerV2 {
+	return retryv2.NewStandard(func(o *retryv2.StandardOptions) {
+		o.MaxAttempts = maxRetries + 1
+		o.Backoff = NewRetryer(maxRetries)
+		o.RateLimiter = ratelimit.None
+	})
+}
+
+// RetryRules returns the delay before retrying the request.
+func (r *Retryer) RetryRules(req *request.Request) time.Duration {
+	if r.NumMaxRetries == 0 {
+		return 0
+	}
+	return r.delay(req.RetryCount, req.IsErrorThrottle(), req.HTTPResponse)
+}
+
+// BackoffDelay returns the delay before retrying the aws-sdk-go-v2 request whose attempt failed with err.
+func (r *Retryer) BackoffDelay(attempt int, err error) (time.Duration, error) {
+	throttled := retryv2.IsErrorThrottles(retryv2.DefaultThrottles).IsErrorThrottle(err) == awsv2.TrueTernary
+	var httpResp *http.Response
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) && respErr.Response != nil {
+		httpResp = respErr.Response.Response
+	}
+	return r.delay(attempt-1, throttled, httpResp), nil
+}
+
+// delay returns the delay before the retry after retryCount retries, based on whether the request is throttled
+// and the HTTP response of its last attempt, if any.
+func (r *Retryer) delay(retryCount int, throttled bool, httpResp *http.Response) time.Duration {
+	baseDelay, maxDelay := r.MinRetryDelay, r.MaxRetryDelay
+	if throttled {
+		baseDelay, maxDelay = r.MinThrottleDelay, r.MaxThrottleDelay
+	}
+	backoff := baseDelay
+	for i := 0; i < retryCount && backoff < maxDelay; i++ {
+		backoff *= 2
+	}
+	if backoff > maxDelay {
+		backoff = maxDelay
+	}
+	delay := baseDelay + r.jitter(backoff-baseDelay)
+	if retryAfter, ok := r.retryAfterDelay(httpResp); ok && retryAfter > delay {
+		delay = retryAfter
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// retryAfterDelay returns the delay the service asks for via Retry-After header, which is either seconds or a HTTP date.
+func (r *Retryer) retryAfterDelay(httpResp *http.Response) (time.Duration, bool) {
+	if httpResp == nil {
+		return 0, false
+	}
+	retryAfter := httpResp.Header.Get(headerRetryAfter)
+	if retryAfter == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	retryTime, err := http.ParseTime(retryAfter)
+	if err != nil {
+		return 0, false
+	}
+	delay := retryTime.Sub(r.now())
+	if delay < 0 {
+		return 0, true
+	}
+	return delay, true
+}
+
+// jitter returns a random duration in [0, d).
+func (r *Retryer) jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	r.randMutex.Lock()
+	defer r.randMutex.Unlock()
+	return time.Duration(r.rand.Int63n(int64(d)))
+}
@@ -0,0 +1,199 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryer_RetryRules(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newRequest := func(errCode string, statusCode int, retryAfter string, retryCount int) *request.Request {
+		header := http.Header{}
+		if retryAfter != "" {
+			header.Set(headerRetryAfter, retryAfter)
+		}
+		return &request.Request{
+			Error:        awserr.New(errCode, "", nil),
+			HTTPResponse: &http.Response{StatusCode: statusCode, Header: header},
+			RetryCount:   retryCount,
+		}
+	}
+	tests := []struct {
+		name       string
+		maxRetries int
+		req        *request.Request
+		wantMin    time.Duration
+		wantMax    time.Duration
+	}{
+		{
+			name:       "retries disabled",
+			maxRetries: 0,
+			req:        newRequest("InternalFailure", 500, "", 0),
+			wantMin:    0,
+			wantMax:    0,
+		},
+		{
+			name:       "first retry of error",
+			maxRetries: 3,
+			req:        newRequest("InternalFailure", 500, "", 0),
+			wantMin:    30 * time.Millisecond,
+			wantMax:    30 * time.Millisecond,
+		},
+		{
+			name:       "third retry of error",
+			maxRetries: 3,
+			req:        newRequest("InternalFailure", 500, "", 2),
+			wantMin:    30 * time.Millisecond,
+			wantMax:    120 * time.Millisecond,
+		},
+		{
+			name:       "retry of throttled request backs off from larger delay",
+			maxRetries: 3,
+			req:        newRequest("Throttling", 400, "", 1),
+			wantMin:    500 * time.Millisecond,
+			wantMax:    time.Second,
+		},
+		{
+			name:       "backoff is capped by max delay",
+			maxRetries: 100,
+			req:        newRequest("Throttling", 400, "", 99),
+			wantMin:    500 * time.Millisecond,
+			wantMax:    300 * time.Second,
+		},
+		{
+			name:       "waits at least Retry-After seconds",
+			maxRetries: 3,
+			req:        newRequest("TooManyRequestsException", 429, "7", 0),
+			wantMin:    7 * time.Second,
+			wantMax:    7 * time.Second,
+		},
+		{
+			name:       "waits until Retry-After date",
+			maxRetries: 3,
+			req:        newRequest("ServiceUnavailable", 503, now.Add(20*time.Second).Format(http.TimeFormat), 0),
+			wantMin:    20 * time.Second,
+			wantMax:    20 * time.Second,
+		},
+		{
+			name:       "Retry-After is capped by max delay",
+			maxRetries: 3,
+			req:        newRequest("Throttling", 429, "3600", 0),
+			wantMin:    300 * time.Second,
+			wantMax:    300 * time.Second,
+		},
+		{
+			name:       "invalid Retry-After is ignored",
+			maxRetries: 3,
+			req:        newRequest("InternalFailure", 500, "soon", 0),
+			wantMin:    30 * time.Millisecond,
+			wantMax:    30 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRetryer(tt.maxRetries)
+			r.now = func() time.Time { return now }
+			got := r.RetryRules(tt.req)
+			assert.GreaterOrEqual(t, got, tt.wantMin)
+			assert.LessOrEqual(t, got, tt.wantMax)
+		})
+	}
+}
+
+func TestRetryer_BackoffDelay(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	newError := func(errCode string, statusCode int, retryAfter string) error {
+		header := http.Header{}
+		if retryAfter != "" {
+			header.Set(headerRetryAfter, retryAfter)
+		}
+		return &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: statusCode, Header: header}},
+				Err:      &smithy.GenericAPIError{Code: errCode},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		attempt int
+		err     error
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			name:    "first attempt failed with error",
+			attempt: 1,
+			err:     newError("InternalFailure", 500, ""),
+			wantMin: 30 * time.Millisecond,
+			wantMax: 30 * time.Millisecond,
+		},
+		{
+			name:    "third attempt failed with error",
+			attempt: 3,
+			err:     newError("InternalFailure", 500, ""),
+			wantMin: 30 * time.Millisecond,
+			wantMax: 120 * time.Millisecond,
+		},
+		{
+			name:    "throttled attempt backs off from larger delay",
+			attempt: 2,
+			err:     newError("Throttling", 400, ""),
+			wantMin: 500 * time.Millisecond,
+			wantMax: time.Second,
+		},
+		{
+			name:    "waits at least Retry-After seconds",
+			attempt: 1,
+			err:     newError("TooManyRequestsException", 429, "7"),
+			wantMin: 7 * time.Second,
+			wantMax: 7 * time.Second,
+		},
+		{
+			name:    "waits until Retry-After date",
+			attempt: 1,
+			err:     newError("ServiceUnavailable", 503, now.Add(20*time.Second).Format(http.TimeFormat)),
+			wantMin: 20 * time.Second,
+			wantMax: 20 * time.Second,
+		},
+		{
+			name:    "attempt failed without response",
+			attempt: 1,
+			err:     errors.New("connection reset"),
+			wantMin: 30 * time.Millisecond,
+			wantMax: 30 * time.Millisecond,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRetryer(3)
+			r.now = func() time.Time { return now }
+			got, err := r.BackoffDelay(tt.attempt, tt.err)
+			assert.NoError(t, err)
+			assert.GreaterOrEqual(t, got, tt.wantMin)
+			assert.LessOrEqual(t, got, tt.wantMax)
+		})
+	}
+}
+
+func TestNewSDKV2Retryer(t *testing.T) {
+	r := NewSDKV2Retryer(3)
+	assert.Equal(t, 4, r.MaxAttempts())
+	assert.True(t, r.IsErrorRetryable(&smithy.GenericAPIError{Code: "Throttling"}))
+	assert.False(t, r.IsErrorRetryable(&smithy.GenericAPIError{Code: "ValidationError"}))
+	// retries aren't limited by retry quota.
+	for i := 0; i < 1000; i++ {
+		_, err := r.GetRetryToken(context.Background(), &smithy.GenericAPIError{Code: "Throttling"})
+		assert.NoError(t, err)
+	}
+}
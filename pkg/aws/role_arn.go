@@ -0,0 +1,27 @@
+package aws
+
+import (
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
+)
+
+// roleResourcePattern matches the resource of IAM role ARNs, i.e. role/ followed by the optional path and the role name.
+var roleResourcePattern = regexp.MustCompile(`^role/([\x21-\x7E]+/)?[\w+=,.@-]{1,64}$`)
+
+// accountIDPattern matches AWS account IDs.
+var accountIDPattern = regexp.MustCompile(`^\d{12}$`)
+
+// ValidateRoleARN validates roleARN is the ARN of an IAM role, before it's assumed.
+func ValidateRoleARN(roleARN string) error {
+	parsedARN, err := arn.Parse(roleARN)
+	if err != nil {
+		return errors.Errorf("invalid IAM role ARN %v", roleARN)
+	}
+	if parsedARN.Service != "iam" || parsedARN.Region != "" || !accountIDPattern.MatchString(parsedARN.AccountID) ||
+		!roleResourcePattern.MatchString(parsedARN.Resource) {
+		return errors.Errorf("invalid IAM role ARN %v", roleARN)
+	}
+	return nil
+}
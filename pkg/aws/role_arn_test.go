@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRoleARN(t *testing.T) {
+	tests := []struct {
+		name    string
+		roleARN string
+		wantErr error
+	}{
+		{
+			name:    "role",
+			roleARN: "arn:aws:iam::123456789012:role/alb-provisioner",
+		},
+		{
+			name:    "role with path in other partition",
+			roleARN: "arn:aws-cn:iam::123456789012:role/k8s/alb-provisioner",
+		},
+		{
+			name:    "not an ARN",
+			roleARN: "alb-provisioner",
+			wantErr: errors.New("invalid IAM role ARN alb-provisioner"),
+		},
+		{
+			name:    "user",
+			roleARN: "arn:aws:iam::123456789012:user/alb-provisioner",
+			wantErr: errors.New("invalid IAM role ARN arn:aws:iam::123456789012:user/alb-provisioner"),
+		},
+		{
+			name:    "other service",
+			roleARN: "arn:aws:sts::123456789012:role/alb-provisioner",
+			wantErr: errors.New("invalid IAM role ARN arn:aws:sts::123456789012:role/alb-provisioner"),
+		},
+		{
+			name:    "invalid account ID",
+			roleARN: "arn:aws:iam::aws:role/alb-provisioner",
+			wantErr: errors.New("invalid IAM role ARN arn:aws:iam::aws:role/alb-provisioner"),
+		},
+		{
+			name:    "empty role name",
+			roleARN: "arn:aws:iam::123456789012:role/",
+			wantErr: errors.New("invalid IAM role ARN arn:aws:iam::123456789012:role/"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRoleARN(tt.roleARN)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
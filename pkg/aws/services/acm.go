@@ -2,36 +2,56 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/acm"
-	"github.com/aws/aws-sdk-go/service/acm/acmiface"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
 )
 
 type ACM interface {
-	acmiface.ACMAPI
+	DescribeCertificateWithContext(ctx context.Context, input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error)
+	ListTagsForCertificateWithContext(ctx context.Context, input *acm.ListTagsForCertificateInput) (*acm.ListTagsForCertificateOutput, error)
+	RequestCertificateWithContext(ctx context.Context, input *acm.RequestCertificateInput) (*acm.RequestCertificateOutput, error)
 
-	// wrapper to ListCertificatesPagesWithContext API, which aggregates paged results into list.
-	ListCertificatesAsList(ctx context.Context, input *acm.ListCertificatesInput) ([]*acm.CertificateSummary, error)
+	// wrapper to ListCertificates API, which aggregates paged results into list.
+	ListCertificatesAsList(ctx context.Context, input *acm.ListCertificatesInput) ([]acmtypes.CertificateSummary, error)
 }
 
 // NewACM constructs new ACM implementation.
-func NewACM(session *session.Session) *defaultACM {
+func NewACM(cfg aws.Config) *defaultACM {
 	return &defaultACM{
-		ACMAPI: acm.New(session),
+		client: acm.NewFromConfig(cfg),
 	}
 }
 
+var _ ACM = (*defaultACM)(nil)
+
+// default implementation for ACM.
 type defaultACM struct {
-	acmiface.ACMAPI
+	client *acm.Client
+}
+
+func (c *defaultACM) DescribeCertificateWithContext(ctx context.Context, input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+	return c.client.DescribeCertificate(ctx, input)
+}
+
+func (c *defaultACM) ListTagsForCertificateWithContext(ctx context.Context, input *acm.ListTagsForCertificateInput) (*acm.ListTagsForCertificateOutput, error) {
+	return c.client.ListTagsForCertificate(ctx, input)
+}
+
+func (c *defaultACM) RequestCertificateWithContext(ctx context.Context, input *acm.RequestCertificateInput) (*acm.RequestCertificateOutput, error) {
+	return c.client.RequestCertificate(ctx, input)
 }
 
-func (c *defaultACM) ListCertificatesAsList(ctx context.Context, input *acm.ListCertificatesInput) ([]*acm.CertificateSummary, error) {
-	var result []*acm.CertificateSummary
-	if err := c.ListCertificatesPagesWithContext(ctx, input, func(output *acm.ListCertificatesOutput, _ bool) bool {
+func (c *defaultACM) ListCertificatesAsList(ctx context.Context, input *acm.ListCertificatesInput) ([]acmtypes.CertificateSummary, error) {
+	var result []acmtypes.CertificateSummary
+	paginator := acm.NewListCertificatesPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		result = append(result, output.CertificateSummaryList...)
-		return true
-	}); err != nil {
-		return nil, err
 	}
 	return result, nil
 }
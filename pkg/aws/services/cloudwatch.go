@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+type CloudWatch interface {
+	// wrapper to GetMetricData API, which aggregates paged results into list.
+	// the values of a query spread across pages are merged into a single result.
+	GetMetricDataAsList(ctx context.Context, input *cloudwatch.GetMetricDataInput) ([]cloudwatchtypes.MetricDataResult, error)
+}
+
+// NewCloudWatch constructs new CloudWatch implementation.
+func NewCloudWatch(cfg aws.Config) CloudWatch {
+	return &defaultCloudWatch{
+		client: cloudwatch.NewFromConfig(cfg),
+	}
+}
+
+var _ CloudWatch = (*defaultCloudWatch)(nil)
+
+// default implementation for CloudWatch.
+type defaultCloudWatch struct {
+	client *cloudwatch.Client
+}
+
+func (c *defaultCloudWatch) GetMetricDataAsList(ctx context.Context, input *cloudwatch.GetMetricDataInput) ([]cloudwatchtypes.MetricDataResult, error) {
+	var result []cloudwatchtypes.MetricDataResult
+	indexByID := make(map[string]int)
+	paginator := cloudwatch.NewGetMetricDataPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range output.MetricDataResults {
+			id := aws.ToString(item.Id)
+			if index, ok := indexByID[id]; ok {
+				result[index].Timestamps = append(result[index].Timestamps, item.Timestamps...)
+				result[index].Values = append(result[index].Values, item.Values...)
+				continue
+			}
+			indexByID[id] = len(result)
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
@@ -2,80 +2,258 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
 
 type EC2 interface {
-	ec2iface.EC2API
+	AllocateAddressWithContext(ctx context.Context, input *ec2.AllocateAddressInput) (*ec2.AllocateAddressOutput, error)
+	AuthorizeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error)
+	CreateManagedPrefixListWithContext(ctx context.Context, input *ec2.CreateManagedPrefixListInput) (*ec2.CreateManagedPrefixListOutput, error)
+	CreateSecurityGroupWithContext(ctx context.Context, input *ec2.CreateSecurityGroupInput) (*ec2.CreateSecurityGroupOutput, error)
+	CreateTagsWithContext(ctx context.Context, input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error)
+	CreateVpcEndpointServiceConfigurationWithContext(ctx context.Context, input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error)
+	DeleteSecurityGroupWithContext(ctx context.Context, input *ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error)
+	DeleteTagsWithContext(ctx context.Context, input *ec2.DeleteTagsInput) (*ec2.DeleteTagsOutput, error)
+	DeleteVpcEndpointServiceConfigurationsWithContext(ctx context.Context, input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error)
+	DescribeAddressesWithContext(ctx context.Context, input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error)
+	DescribeAvailabilityZonesWithContext(ctx context.Context, input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error)
+	DescribeCoipPoolsWithContext(ctx context.Context, input *ec2.DescribeCoipPoolsInput) (*ec2.DescribeCoipPoolsOutput, error)
+	DescribeInstancesWithContext(ctx context.Context, input *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error)
+	DescribeLocalGatewayRouteTablesWithContext(ctx context.Context, input *ec2.DescribeLocalGatewayRouteTablesInput) (*ec2.DescribeLocalGatewayRouteTablesOutput, error)
+	DescribeManagedPrefixListsWithContext(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error)
+	DescribeVpcsWithContext(ctx context.Context, input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error)
+	ModifyManagedPrefixListWithContext(ctx context.Context, input *ec2.ModifyManagedPrefixListInput) (*ec2.ModifyManagedPrefixListOutput, error)
+	ModifyVpcEndpointServiceConfigurationWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServiceConfigurationInput) (*ec2.ModifyVpcEndpointServiceConfigurationOutput, error)
+	ModifyVpcEndpointServicePermissionsWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error)
+	ReleaseAddressWithContext(ctx context.Context, input *ec2.ReleaseAddressInput) (*ec2.ReleaseAddressOutput, error)
+	RevokeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error)
+
+	// wrapper to DescribeInstances API, which aggregates paged results into list.
+	DescribeInstancesAsList(ctx context.Context, input *ec2.DescribeInstancesInput) ([]ec2types.Instance, error)
+
+	// wrapper to DescribeNetworkInterfaces API, which aggregates paged results into list.
+	DescribeNetworkInterfacesAsList(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput) ([]ec2types.NetworkInterface, error)
+
+	// wrapper to DescribeRouteTables API, which aggregates paged results into list.
+	DescribeRouteTablesAsList(ctx context.Context, input *ec2.DescribeRouteTablesInput) ([]ec2types.RouteTable, error)
+
+	// wrapper to DescribeSecurityGroups API, which aggregates paged results into list.
+	DescribeSecurityGroupsAsList(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]ec2types.SecurityGroup, error)
 
-	// wrapper to DescribeInstancesPagesWithContext API, which aggregates paged results into list.
-	DescribeInstancesAsList(ctx context.Context, input *ec2.DescribeInstancesInput) ([]*ec2.Instance, error)
+	// wrapper to DescribeSubnets API, which aggregates paged results into list.
+	DescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]ec2types.Subnet, error)
 
-	// wrapper to DescribeNetworkInterfacesPagesWithContext API, which aggregates paged results into list.
-	DescribeNetworkInterfacesAsList(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput) ([]*ec2.NetworkInterface, error)
+	// wrapper to DescribeVpcEndpointServiceConfigurations API, which aggregates paged results into list.
+	DescribeVpcEndpointServiceConfigurationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]ec2types.ServiceConfiguration, error)
 
-	// wrapper to DescribeSecurityGroupsPagesWithContext API, which aggregates paged results into list.
-	DescribeSecurityGroupsAsList(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error)
+	// wrapper to DescribeVpcEndpointServicePermissions API, which aggregates paged results into list.
+	DescribeVpcEndpointServicePermissionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServicePermissionsInput) ([]ec2types.AllowedPrincipal, error)
 
-	// wrapper to DescribeSubnetsPagesWithContext API, which aggregates paged results into list.
-	DescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error)
+	// wrapper to GetManagedPrefixListEntries API, which aggregates paged results into list.
+	GetManagedPrefixListEntriesAsList(ctx context.Context, input *ec2.GetManagedPrefixListEntriesInput) ([]ec2types.PrefixListEntry, error)
 }
 
 // NewEC2 constructs new EC2 implementation.
-func NewEC2(session *session.Session) EC2 {
+func NewEC2(cfg aws.Config) EC2 {
 	return &defaultEC2{
-		EC2API: ec2.New(session),
+		client: ec2.NewFromConfig(cfg),
 	}
 }
 
+// default implementation for EC2.
 type defaultEC2 struct {
-	ec2iface.EC2API
+	client *ec2.Client
+}
+
+func (c *defaultEC2) AllocateAddressWithContext(ctx context.Context, input *ec2.AllocateAddressInput) (*ec2.AllocateAddressOutput, error) {
+	return c.client.AllocateAddress(ctx, input)
+}
+
+func (c *defaultEC2) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.AuthorizeSecurityGroupIngressInput) (*ec2.AuthorizeSecurityGroupIngressOutput, error) {
+	return c.client.AuthorizeSecurityGroupIngress(ctx, input)
+}
+
+func (c *defaultEC2) CreateManagedPrefixListWithContext(ctx context.Context, input *ec2.CreateManagedPrefixListInput) (*ec2.CreateManagedPrefixListOutput, error) {
+	return c.client.CreateManagedPrefixList(ctx, input)
+}
+
+func (c *defaultEC2) CreateSecurityGroupWithContext(ctx context.Context, input *ec2.CreateSecurityGroupInput) (*ec2.CreateSecurityGroupOutput, error) {
+	return c.client.CreateSecurityGroup(ctx, input)
+}
+
+func (c *defaultEC2) CreateTagsWithContext(ctx context.Context, input *ec2.CreateTagsInput) (*ec2.CreateTagsOutput, error) {
+	return c.client.CreateTags(ctx, input)
+}
+
+func (c *defaultEC2) CreateVpcEndpointServiceConfigurationWithContext(ctx context.Context, input *ec2.CreateVpcEndpointServiceConfigurationInput) (*ec2.CreateVpcEndpointServiceConfigurationOutput, error) {
+	return c.client.CreateVpcEndpointServiceConfiguration(ctx, input)
+}
+
+func (c *defaultEC2) DeleteSecurityGroupWithContext(ctx context.Context, input *ec2.DeleteSecurityGroupInput) (*ec2.DeleteSecurityGroupOutput, error) {
+	return c.client.DeleteSecurityGroup(ctx, input)
+}
+
+func (c *defaultEC2) DeleteTagsWithContext(ctx context.Context, input *ec2.DeleteTagsInput) (*ec2.DeleteTagsOutput, error) {
+	return c.client.DeleteTags(ctx, input)
+}
+
+func (c *defaultEC2) DeleteVpcEndpointServiceConfigurationsWithContext(ctx context.Context, input *ec2.DeleteVpcEndpointServiceConfigurationsInput) (*ec2.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	return c.client.DeleteVpcEndpointServiceConfigurations(ctx, input)
+}
+
+func (c *defaultEC2) DescribeAddressesWithContext(ctx context.Context, input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error) {
+	return c.client.DescribeAddresses(ctx, input)
+}
+
+func (c *defaultEC2) DescribeAvailabilityZonesWithContext(ctx context.Context, input *ec2.DescribeAvailabilityZonesInput) (*ec2.DescribeAvailabilityZonesOutput, error) {
+	return c.client.DescribeAvailabilityZones(ctx, input)
 }
 
-func (c *defaultEC2) DescribeInstancesAsList(ctx context.Context, input *ec2.DescribeInstancesInput) ([]*ec2.Instance, error) {
-	var result []*ec2.Instance
-	if err := c.DescribeInstancesPagesWithContext(ctx, input, func(output *ec2.DescribeInstancesOutput, _ bool) bool {
+func (c *defaultEC2) DescribeCoipPoolsWithContext(ctx context.Context, input *ec2.DescribeCoipPoolsInput) (*ec2.DescribeCoipPoolsOutput, error) {
+	return c.client.DescribeCoipPools(ctx, input)
+}
+
+func (c *defaultEC2) DescribeInstancesWithContext(ctx context.Context, input *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+	return c.client.DescribeInstances(ctx, input)
+}
+
+func (c *defaultEC2) DescribeLocalGatewayRouteTablesWithContext(ctx context.Context, input *ec2.DescribeLocalGatewayRouteTablesInput) (*ec2.DescribeLocalGatewayRouteTablesOutput, error) {
+	return c.client.DescribeLocalGatewayRouteTables(ctx, input)
+}
+
+func (c *defaultEC2) DescribeManagedPrefixListsWithContext(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error) {
+	return c.client.DescribeManagedPrefixLists(ctx, input)
+}
+
+func (c *defaultEC2) DescribeVpcsWithContext(ctx context.Context, input *ec2.DescribeVpcsInput) (*ec2.DescribeVpcsOutput, error) {
+	return c.client.DescribeVpcs(ctx, input)
+}
+
+func (c *defaultEC2) ModifyManagedPrefixListWithContext(ctx context.Context, input *ec2.ModifyManagedPrefixListInput) (*ec2.ModifyManagedPrefixListOutput, error) {
+	return c.client.ModifyManagedPrefixList(ctx, input)
+}
+
+func (c *defaultEC2) ModifyVpcEndpointServiceConfigurationWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServiceConfigurationInput) (*ec2.ModifyVpcEndpointServiceConfigurationOutput, error) {
+	return c.client.ModifyVpcEndpointServiceConfiguration(ctx, input)
+}
+
+func (c *defaultEC2) ModifyVpcEndpointServicePermissionsWithContext(ctx context.Context, input *ec2.ModifyVpcEndpointServicePermissionsInput) (*ec2.ModifyVpcEndpointServicePermissionsOutput, error) {
+	return c.client.ModifyVpcEndpointServicePermissions(ctx, input)
+}
+
+func (c *defaultEC2) ReleaseAddressWithContext(ctx context.Context, input *ec2.ReleaseAddressInput) (*ec2.ReleaseAddressOutput, error) {
+	return c.client.ReleaseAddress(ctx, input)
+}
+
+func (c *defaultEC2) RevokeSecurityGroupIngressWithContext(ctx context.Context, input *ec2.RevokeSecurityGroupIngressInput) (*ec2.RevokeSecurityGroupIngressOutput, error) {
+	return c.client.RevokeSecurityGroupIngress(ctx, input)
+}
+
+func (c *defaultEC2) DescribeInstancesAsList(ctx context.Context, input *ec2.DescribeInstancesInput) ([]ec2types.Instance, error) {
+	var result []ec2types.Instance
+	paginator := ec2.NewDescribeInstancesPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		for _, reservation := range output.Reservations {
 			result = append(result, reservation.Instances...)
 		}
-		return true
-	}); err != nil {
-		return nil, err
 	}
 	return result, nil
 }
 
-func (c *defaultEC2) DescribeNetworkInterfacesAsList(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput) ([]*ec2.NetworkInterface, error) {
-	var result []*ec2.NetworkInterface
-	if err := c.DescribeNetworkInterfacesPagesWithContext(ctx, input, func(output *ec2.DescribeNetworkInterfacesOutput, _ bool) bool {
+func (c *defaultEC2) DescribeNetworkInterfacesAsList(ctx context.Context, input *ec2.DescribeNetworkInterfacesInput) ([]ec2types.NetworkInterface, error) {
+	var result []ec2types.NetworkInterface
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		result = append(result, output.NetworkInterfaces...)
-		return true
-	}); err != nil {
-		return nil, err
 	}
 	return result, nil
 }
 
-func (c *defaultEC2) DescribeSecurityGroupsAsList(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]*ec2.SecurityGroup, error) {
-	var result []*ec2.SecurityGroup
-	if err := c.DescribeSecurityGroupsPagesWithContext(ctx, input, func(output *ec2.DescribeSecurityGroupsOutput, _ bool) bool {
+func (c *defaultEC2) DescribeRouteTablesAsList(ctx context.Context, input *ec2.DescribeRouteTablesInput) ([]ec2types.RouteTable, error) {
+	var result []ec2types.RouteTable
+	paginator := ec2.NewDescribeRouteTablesPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.RouteTables...)
+	}
+	return result, nil
+}
+
+func (c *defaultEC2) DescribeSecurityGroupsAsList(ctx context.Context, input *ec2.DescribeSecurityGroupsInput) ([]ec2types.SecurityGroup, error) {
+	var result []ec2types.SecurityGroup
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		result = append(result, output.SecurityGroups...)
-		return true
-	}); err != nil {
-		return nil, err
 	}
 	return result, nil
 }
 
-func (c *defaultEC2) DescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error) {
-	var result []*ec2.Subnet
-	if err := c.DescribeSubnetsPagesWithContext(ctx, input, func(output *ec2.DescribeSubnetsOutput, _ bool) bool {
+func (c *defaultEC2) DescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]ec2types.Subnet, error) {
+	var result []ec2types.Subnet
+	paginator := ec2.NewDescribeSubnetsPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		result = append(result, output.Subnets...)
-		return true
-	}); err != nil {
-		return nil, err
+	}
+	return result, nil
+}
+
+func (c *defaultEC2) DescribeVpcEndpointServiceConfigurationsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServiceConfigurationsInput) ([]ec2types.ServiceConfiguration, error) {
+	var result []ec2types.ServiceConfiguration
+	paginator := ec2.NewDescribeVpcEndpointServiceConfigurationsPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.ServiceConfigurations...)
+	}
+	return result, nil
+}
+
+func (c *defaultEC2) DescribeVpcEndpointServicePermissionsAsList(ctx context.Context, input *ec2.DescribeVpcEndpointServicePermissionsInput) ([]ec2types.AllowedPrincipal, error) {
+	var result []ec2types.AllowedPrincipal
+	paginator := ec2.NewDescribeVpcEndpointServicePermissionsPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.AllowedPrincipals...)
+	}
+	return result, nil
+}
+
+func (c *defaultEC2) GetManagedPrefixListEntriesAsList(ctx context.Context, input *ec2.GetManagedPrefixListEntriesInput) ([]ec2types.PrefixListEntry, error) {
+	var result []ec2types.PrefixListEntry
+	paginator := ec2.NewGetManagedPrefixListEntriesPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.Entries...)
 	}
 	return result, nil
 }
@@ -1,9 +1,12 @@
 package services
 
 import (
+	"context"
 	"fmt"
-	"github.com/aws/aws-sdk-go/aws/ec2metadata"
-	"github.com/aws/aws-sdk-go/aws/session"
+	"io"
+
+	awsv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 )
 
 type EC2Metadata interface {
@@ -12,24 +15,45 @@ type EC2Metadata interface {
 }
 
 // NewEC2Metadata constructs new EC2Metadata implementation.
-func NewEC2Metadata(session *session.Session) EC2Metadata {
+func NewEC2Metadata(cfg awsv2.Config) EC2Metadata {
 	return &defaultEC2Metadata{
-		EC2Metadata: ec2metadata.New(session),
+		client: imds.NewFromConfig(cfg),
 	}
 }
 
 type defaultEC2Metadata struct {
-	*ec2metadata.EC2Metadata
+	client *imds.Client
+}
+
+func (c *defaultEC2Metadata) Region() (string, error) {
+	output, err := c.client.GetRegion(context.Background(), &imds.GetRegionInput{})
+	if err != nil {
+		return "", err
+	}
+	return output.Region, nil
 }
 
 func (c *defaultEC2Metadata) VpcID() (string, error) {
-	mac, err := c.GetMetadata("mac")
+	mac, err := c.getMetadata("mac")
 	if err != nil {
 		return "", err
 	}
-	vpcID, err := c.GetMetadata(fmt.Sprintf("network/interfaces/macs/%s/vpc-id", mac))
+	vpcID, err := c.getMetadata(fmt.Sprintf("network/interfaces/macs/%s/vpc-id", mac))
 	if err != nil {
 		return "", err
 	}
 	return vpcID, nil
 }
+
+func (c *defaultEC2Metadata) getMetadata(path string) (string, error) {
+	output, err := c.client.GetMetadata(context.Background(), &imds.GetMetadataInput{Path: path})
+	if err != nil {
+		return "", err
+	}
+	defer output.Content.Close()
+	content, err := io.ReadAll(output.Content)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
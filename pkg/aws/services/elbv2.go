@@ -2,106 +2,330 @@ package services
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+const (
+	// describeRulesPageSize is the maximum page size supported by DescribeRules API.
+	describeRulesPageSize = 400
+	// loadBalancerAvailableMaxWaitTime is the maximum time to wait for a load balancer to become available.
+	loadBalancerAvailableMaxWaitTime = 10 * time.Minute
 )
 
 type ELBV2 interface {
-	elbv2iface.ELBV2API
+	AddListenerCertificatesWithContext(ctx context.Context, input *elbv2.AddListenerCertificatesInput) (*elbv2.AddListenerCertificatesOutput, error)
+	AddTagsWithContext(ctx context.Context, input *elbv2.AddTagsInput) (*elbv2.AddTagsOutput, error)
+	CreateListenerWithContext(ctx context.Context, input *elbv2.CreateListenerInput) (*elbv2.CreateListenerOutput, error)
+
+	// CreateLoadBalancerWithContext creates a load balancer, optFns customize the request, e.g. WithIpamPools.
+	CreateLoadBalancerWithContext(ctx context.Context, input *elbv2.CreateLoadBalancerInput, optFns ...func(*elbv2.Options)) (*elbv2.CreateLoadBalancerOutput, error)
+
+	CreateRuleWithContext(ctx context.Context, input *elbv2.CreateRuleInput) (*elbv2.CreateRuleOutput, error)
+	CreateTargetGroupWithContext(ctx context.Context, input *elbv2.CreateTargetGroupInput) (*elbv2.CreateTargetGroupOutput, error)
+	CreateTrustStoreWithContext(ctx context.Context, input *elbv2.CreateTrustStoreInput) (*elbv2.CreateTrustStoreOutput, error)
+	DeleteListenerWithContext(ctx context.Context, input *elbv2.DeleteListenerInput) (*elbv2.DeleteListenerOutput, error)
+	DeleteLoadBalancerWithContext(ctx context.Context, input *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error)
+	DeleteRuleWithContext(ctx context.Context, input *elbv2.DeleteRuleInput) (*elbv2.DeleteRuleOutput, error)
+	DeleteTargetGroupWithContext(ctx context.Context, input *elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error)
+	DeleteTrustStoreWithContext(ctx context.Context, input *elbv2.DeleteTrustStoreInput) (*elbv2.DeleteTrustStoreOutput, error)
+	DeregisterTargetsWithContext(ctx context.Context, input *elbv2.DeregisterTargetsInput) (*elbv2.DeregisterTargetsOutput, error)
+	DescribeCapacityReservationWithContext(ctx context.Context, input *elbv2.DescribeCapacityReservationInput) (*elbv2.DescribeCapacityReservationOutput, error)
+	DescribeListenerAttributesWithContext(ctx context.Context, input *elbv2.DescribeListenerAttributesInput) (*elbv2.DescribeListenerAttributesOutput, error)
+	DescribeListenersWithContext(ctx context.Context, input *elbv2.DescribeListenersInput) (*elbv2.DescribeListenersOutput, error)
+	DescribeLoadBalancerAttributesWithContext(ctx context.Context, input *elbv2.DescribeLoadBalancerAttributesInput) (*elbv2.DescribeLoadBalancerAttributesOutput, error)
+	DescribeLoadBalancersWithContext(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error)
+	DescribeRulesWithContext(ctx context.Context, input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error)
+	DescribeTagsWithContext(ctx context.Context, input *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error)
+	DescribeTargetGroupAttributesWithContext(ctx context.Context, input *elbv2.DescribeTargetGroupAttributesInput) (*elbv2.DescribeTargetGroupAttributesOutput, error)
+	DescribeTargetGroupsWithContext(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error)
+	DescribeTargetHealthWithContext(ctx context.Context, input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error)
+	ModifyCapacityReservationWithContext(ctx context.Context, input *elbv2.ModifyCapacityReservationInput) (*elbv2.ModifyCapacityReservationOutput, error)
+	ModifyListenerWithContext(ctx context.Context, input *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error)
+	ModifyListenerAttributesWithContext(ctx context.Context, input *elbv2.ModifyListenerAttributesInput) (*elbv2.ModifyListenerAttributesOutput, error)
+	ModifyLoadBalancerAttributesWithContext(ctx context.Context, input *elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error)
+	ModifyRuleWithContext(ctx context.Context, input *elbv2.ModifyRuleInput) (*elbv2.ModifyRuleOutput, error)
+	ModifyTargetGroupWithContext(ctx context.Context, input *elbv2.ModifyTargetGroupInput) (*elbv2.ModifyTargetGroupOutput, error)
+	ModifyTargetGroupAttributesWithContext(ctx context.Context, input *elbv2.ModifyTargetGroupAttributesInput) (*elbv2.ModifyTargetGroupAttributesOutput, error)
+	RegisterTargetsWithContext(ctx context.Context, input *elbv2.RegisterTargetsInput) (*elbv2.RegisterTargetsOutput, error)
+	RemoveListenerCertificatesWithContext(ctx context.Context, input *elbv2.RemoveListenerCertificatesInput) (*elbv2.RemoveListenerCertificatesOutput, error)
+	RemoveTagsWithContext(ctx context.Context, input *elbv2.RemoveTagsInput) (*elbv2.RemoveTagsOutput, error)
+	SetIpAddressTypeWithContext(ctx context.Context, input *elbv2.SetIpAddressTypeInput) (*elbv2.SetIpAddressTypeOutput, error)
+	SetSecurityGroupsWithContext(ctx context.Context, input *elbv2.SetSecurityGroupsInput) (*elbv2.SetSecurityGroupsOutput, error)
+	SetSubnetsWithContext(ctx context.Context, input *elbv2.SetSubnetsInput) (*elbv2.SetSubnetsOutput, error)
+
+	// wrapper to DescribeLoadBalancers API, which aggregates paged results into list.
+	DescribeLoadBalancersAsList(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]elbv2types.LoadBalancer, error)
 
-	// wrapper to DescribeLoadBalancersPagesWithContext API, which aggregates paged results into list.
-	DescribeLoadBalancersAsList(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error)
+	// wrapper to DescribeTargetGroups API, which aggregates paged results into list.
+	DescribeTargetGroupsAsList(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) ([]elbv2types.TargetGroup, error)
 
-	// wrapper to DescribeTargetGroupsPagesWithContext API, which aggregates paged results into list.
-	DescribeTargetGroupsAsList(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) ([]*elbv2.TargetGroup, error)
+	// wrapper to DescribeListeners API, which aggregates paged results into list.
+	DescribeListenersAsList(ctx context.Context, input *elbv2.DescribeListenersInput) ([]elbv2types.Listener, error)
 
-	// wrapper to DescribeListenersPagesWithContext API, which aggregates paged results into list.
-	DescribeListenersAsList(ctx context.Context, input *elbv2.DescribeListenersInput) ([]*elbv2.Listener, error)
+	// wrapper to DescribeListenerCertificates API, which aggregates paged results into list.
+	DescribeListenerCertificatesAsList(ctx context.Context, input *elbv2.DescribeListenerCertificatesInput) ([]elbv2types.Certificate, error)
 
-	// wrapper to DescribeListenerCertificatesWithContext API, which aggregates paged results into list.
-	DescribeListenerCertificatesAsList(ctx context.Context, input *elbv2.DescribeListenerCertificatesInput) ([]*elbv2.Certificate, error)
+	// wrapper to DescribeRules API, which aggregates paged results into list.
+	DescribeRulesAsList(ctx context.Context, input *elbv2.DescribeRulesInput) ([]elbv2types.Rule, error)
 
-	// wrapper to DescribeRulesWithContext API, which aggregates paged results into list.
-	DescribeRulesAsList(ctx context.Context, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error)
+	// wrapper to DescribeTrustStores API, which aggregates paged results into list.
+	DescribeTrustStoresAsList(ctx context.Context, input *elbv2.DescribeTrustStoresInput) ([]elbv2types.TrustStore, error)
+
+	// WaitUntilLoadBalancerAvailableWithContext waits until the load balancers described by input are active.
+	WaitUntilLoadBalancerAvailableWithContext(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) error
+
+	// ModifyIpPoolsWithContext modifies the IPAM pools of a load balancer.
+	ModifyIpPoolsWithContext(ctx context.Context, input *ModifyIpPoolsInput) (*ModifyIpPoolsOutput, error)
+
+	// DescribeLoadBalancerIpamPoolsWithContext describes the IPAM pools of load balancers.
+	DescribeLoadBalancerIpamPoolsWithContext(ctx context.Context, input *DescribeLoadBalancerIpamPoolsInput) (*DescribeLoadBalancerIpamPoolsOutput, error)
 }
 
 // NewELBV2 constructs new ELBV2 implementation.
-func NewELBV2(session *session.Session) ELBV2 {
+func NewELBV2(cfg aws.Config) ELBV2 {
 	return &defaultELBV2{
-		ELBV2API: elbv2.New(session),
+		client: elbv2.NewFromConfig(cfg),
 	}
 }
 
 // default implementation for ELBV2.
 type defaultELBV2 struct {
-	elbv2iface.ELBV2API
+	client *elbv2.Client
+}
+
+func (c *defaultELBV2) AddListenerCertificatesWithContext(ctx context.Context, input *elbv2.AddListenerCertificatesInput) (*elbv2.AddListenerCertificatesOutput, error) {
+	return c.client.AddListenerCertificates(ctx, input)
+}
+
+func (c *defaultELBV2) AddTagsWithContext(ctx context.Context, input *elbv2.AddTagsInput) (*elbv2.AddTagsOutput, error) {
+	return c.client.AddTags(ctx, input)
+}
+
+func (c *defaultELBV2) CreateListenerWithContext(ctx context.Context, input *elbv2.CreateListenerInput) (*elbv2.CreateListenerOutput, error) {
+	return c.client.CreateListener(ctx, input)
+}
+
+func (c *defaultELBV2) CreateLoadBalancerWithContext(ctx context.Context, input *elbv2.CreateLoadBalancerInput, optFns ...func(*elbv2.Options)) (*elbv2.CreateLoadBalancerOutput, error) {
+	return c.client.CreateLoadBalancer(ctx, input, optFns...)
+}
+
+func (c *defaultELBV2) CreateRuleWithContext(ctx context.Context, input *elbv2.CreateRuleInput) (*elbv2.CreateRuleOutput, error) {
+	return c.client.CreateRule(ctx, input)
+}
+
+func (c *defaultELBV2) CreateTargetGroupWithContext(ctx context.Context, input *elbv2.CreateTargetGroupInput) (*elbv2.CreateTargetGroupOutput, error) {
+	return c.client.CreateTargetGroup(ctx, input)
+}
+
+func (c *defaultELBV2) CreateTrustStoreWithContext(ctx context.Context, input *elbv2.CreateTrustStoreInput) (*elbv2.CreateTrustStoreOutput, error) {
+	return c.client.CreateTrustStore(ctx, input)
+}
+
+func (c *defaultELBV2) DeleteListenerWithContext(ctx context.Context, input *elbv2.DeleteListenerInput) (*elbv2.DeleteListenerOutput, error) {
+	return c.client.DeleteListener(ctx, input)
+}
+
+func (c *defaultELBV2) DeleteLoadBalancerWithContext(ctx context.Context, input *elbv2.DeleteLoadBalancerInput) (*elbv2.DeleteLoadBalancerOutput, error) {
+	return c.client.DeleteLoadBalancer(ctx, input)
+}
+
+func (c *defaultELBV2) DeleteRuleWithContext(ctx context.Context, input *elbv2.DeleteRuleInput) (*elbv2.DeleteRuleOutput, error) {
+	return c.client.DeleteRule(ctx, input)
+}
+
+func (c *defaultELBV2) DeleteTargetGroupWithContext(ctx context.Context, input *elbv2.DeleteTargetGroupInput) (*elbv2.DeleteTargetGroupOutput, error) {
+	return c.client.DeleteTargetGroup(ctx, input)
+}
+
+func (c *defaultELBV2) DeleteTrustStoreWithContext(ctx context.Context, input *elbv2.DeleteTrustStoreInput) (*elbv2.DeleteTrustStoreOutput, error) {
+	return c.client.DeleteTrustStore(ctx, input)
+}
+
+func (c *defaultELBV2) DeregisterTargetsWithContext(ctx context.Context, input *elbv2.DeregisterTargetsInput) (*elbv2.DeregisterTargetsOutput, error) {
+	return c.client.DeregisterTargets(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeCapacityReservationWithContext(ctx context.Context, input *elbv2.DescribeCapacityReservationInput) (*elbv2.DescribeCapacityReservationOutput, error) {
+	return c.client.DescribeCapacityReservation(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeListenerAttributesWithContext(ctx context.Context, input *elbv2.DescribeListenerAttributesInput) (*elbv2.DescribeListenerAttributesOutput, error) {
+	return c.client.DescribeListenerAttributes(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeListenersWithContext(ctx context.Context, input *elbv2.DescribeListenersInput) (*elbv2.DescribeListenersOutput, error) {
+	return c.client.DescribeListeners(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeLoadBalancerAttributesWithContext(ctx context.Context, input *elbv2.DescribeLoadBalancerAttributesInput) (*elbv2.DescribeLoadBalancerAttributesOutput, error) {
+	return c.client.DescribeLoadBalancerAttributes(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeLoadBalancersWithContext(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) (*elbv2.DescribeLoadBalancersOutput, error) {
+	return c.client.DescribeLoadBalancers(ctx, input)
 }
 
-func (c *defaultELBV2) DescribeLoadBalancersAsList(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]*elbv2.LoadBalancer, error) {
-	var result []*elbv2.LoadBalancer
-	if err := c.DescribeLoadBalancersPagesWithContext(ctx, input, func(output *elbv2.DescribeLoadBalancersOutput, _ bool) bool {
+func (c *defaultELBV2) DescribeRulesWithContext(ctx context.Context, input *elbv2.DescribeRulesInput) (*elbv2.DescribeRulesOutput, error) {
+	return c.client.DescribeRules(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeTagsWithContext(ctx context.Context, input *elbv2.DescribeTagsInput) (*elbv2.DescribeTagsOutput, error) {
+	return c.client.DescribeTags(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeTargetGroupAttributesWithContext(ctx context.Context, input *elbv2.DescribeTargetGroupAttributesInput) (*elbv2.DescribeTargetGroupAttributesOutput, error) {
+	return c.client.DescribeTargetGroupAttributes(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeTargetGroupsWithContext(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) (*elbv2.DescribeTargetGroupsOutput, error) {
+	return c.client.DescribeTargetGroups(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeTargetHealthWithContext(ctx context.Context, input *elbv2.DescribeTargetHealthInput) (*elbv2.DescribeTargetHealthOutput, error) {
+	return c.client.DescribeTargetHealth(ctx, input)
+}
+
+func (c *defaultELBV2) ModifyCapacityReservationWithContext(ctx context.Context, input *elbv2.ModifyCapacityReservationInput) (*elbv2.ModifyCapacityReservationOutput, error) {
+	return c.client.ModifyCapacityReservation(ctx, input)
+}
+
+func (c *defaultELBV2) ModifyListenerWithContext(ctx context.Context, input *elbv2.ModifyListenerInput) (*elbv2.ModifyListenerOutput, error) {
+	return c.client.ModifyListener(ctx, input)
+}
+
+func (c *defaultELBV2) ModifyListenerAttributesWithContext(ctx context.Context, input *elbv2.ModifyListenerAttributesInput) (*elbv2.ModifyListenerAttributesOutput, error) {
+	return c.client.ModifyListenerAttributes(ctx, input)
+}
+
+func (c *defaultELBV2) ModifyLoadBalancerAttributesWithContext(ctx context.Context, input *elbv2.ModifyLoadBalancerAttributesInput) (*elbv2.ModifyLoadBalancerAttributesOutput, error) {
+	return c.client.ModifyLoadBalancerAttributes(ctx, input)
+}
+
+func (c *defaultELBV2) ModifyRuleWithContext(ctx context.Context, input *elbv2.ModifyRuleInput) (*elbv2.ModifyRuleOutput, error) {
+	return c.client.ModifyRule(ctx, input)
+}
+
+func (c *defaultELBV2) ModifyTargetGroupWithContext(ctx context.Context, input *elbv2.ModifyTargetGroupInput) (*elbv2.ModifyTargetGroupOutput, error) {
+	return c.client.ModifyTargetGroup(ctx, input)
+}
+
+func (c *defaultELBV2) ModifyTargetGroupAttributesWithContext(ctx context.Context, input *elbv2.ModifyTargetGroupAttributesInput) (*elbv2.ModifyTargetGroupAttributesOutput, error) {
+	return c.client.ModifyTargetGroupAttributes(ctx, input)
+}
+
+func (c *defaultELBV2) RegisterTargetsWithContext(ctx context.Context, input *elbv2.RegisterTargetsInput) (*elbv2.RegisterTargetsOutput, error) {
+	return c.client.RegisterTargets(ctx, input)
+}
+
+func (c *defaultELBV2) RemoveListenerCertificatesWithContext(ctx context.Context, input *elbv2.RemoveListenerCertificatesInput) (*elbv2.RemoveListenerCertificatesOutput, error) {
+	return c.client.RemoveListenerCertificates(ctx, input)
+}
+
+func (c *defaultELBV2) RemoveTagsWithContext(ctx context.Context, input *elbv2.RemoveTagsInput) (*elbv2.RemoveTagsOutput, error) {
+	return c.client.RemoveTags(ctx, input)
+}
+
+func (c *defaultELBV2) SetIpAddressTypeWithContext(ctx context.Context, input *elbv2.SetIpAddressTypeInput) (*elbv2.SetIpAddressTypeOutput, error) {
+	return c.client.SetIpAddressType(ctx, input)
+}
+
+func (c *defaultELBV2) SetSecurityGroupsWithContext(ctx context.Context, input *elbv2.SetSecurityGroupsInput) (*elbv2.SetSecurityGroupsOutput, error) {
+	return c.client.SetSecurityGroups(ctx, input)
+}
+
+func (c *defaultELBV2) SetSubnetsWithContext(ctx context.Context, input *elbv2.SetSubnetsInput) (*elbv2.SetSubnetsOutput, error) {
+	return c.client.SetSubnets(ctx, input)
+}
+
+func (c *defaultELBV2) DescribeLoadBalancersAsList(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) ([]elbv2types.LoadBalancer, error) {
+	var result []elbv2types.LoadBalancer
+	paginator := elbv2.NewDescribeLoadBalancersPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		result = append(result, output.LoadBalancers...)
-		return true
-	}); err != nil {
-		return nil, err
 	}
 	return result, nil
 }
 
-func (c *defaultELBV2) DescribeTargetGroupsAsList(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) ([]*elbv2.TargetGroup, error) {
-	var result []*elbv2.TargetGroup
-	if err := c.DescribeTargetGroupsPagesWithContext(ctx, input, func(output *elbv2.DescribeTargetGroupsOutput, _ bool) bool {
+func (c *defaultELBV2) DescribeTargetGroupsAsList(ctx context.Context, input *elbv2.DescribeTargetGroupsInput) ([]elbv2types.TargetGroup, error) {
+	var result []elbv2types.TargetGroup
+	paginator := elbv2.NewDescribeTargetGroupsPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		result = append(result, output.TargetGroups...)
-		return true
-	}); err != nil {
-		return nil, err
 	}
 	return result, nil
 }
 
-func (c *defaultELBV2) DescribeListenersAsList(ctx context.Context, input *elbv2.DescribeListenersInput) ([]*elbv2.Listener, error) {
-	var result []*elbv2.Listener
-	if err := c.DescribeListenersPagesWithContext(ctx, input, func(output *elbv2.DescribeListenersOutput, _ bool) bool {
+func (c *defaultELBV2) DescribeListenersAsList(ctx context.Context, input *elbv2.DescribeListenersInput) ([]elbv2types.Listener, error) {
+	var result []elbv2types.Listener
+	paginator := elbv2.NewDescribeListenersPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
 		result = append(result, output.Listeners...)
-		return true
-	}); err != nil {
-		return nil, err
 	}
 	return result, nil
 }
 
-func (c *defaultELBV2) DescribeListenerCertificatesAsList(ctx context.Context, input *elbv2.DescribeListenerCertificatesInput) ([]*elbv2.Certificate, error) {
-	var certificates []*elbv2.Certificate
-	p := request.Pagination{
-		EndPageOnSameToken: true,
-		NewRequest: func() (*request.Request, error) {
-			req, _ := c.DescribeListenerCertificatesRequest(input)
-			req.SetContext(ctx)
-			return req, nil
-		},
+func (c *defaultELBV2) DescribeListenerCertificatesAsList(ctx context.Context, input *elbv2.DescribeListenerCertificatesInput) ([]elbv2types.Certificate, error) {
+	var result []elbv2types.Certificate
+	paginator := elbv2.NewDescribeListenerCertificatesPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.Certificates...)
 	}
-	for p.Next() {
-		page := p.Page().(*elbv2.DescribeListenerCertificatesOutput)
-		certificates = append(certificates, page.Certificates...)
+	return result, nil
+}
+
+func (c *defaultELBV2) DescribeRulesAsList(ctx context.Context, input *elbv2.DescribeRulesInput) ([]elbv2types.Rule, error) {
+	// the rules of large listeners are fetched with fewer pages.
+	if input.PageSize == nil {
+		inputWithPageSize := *input
+		inputWithPageSize.PageSize = aws.Int32(describeRulesPageSize)
+		input = &inputWithPageSize
 	}
-	return certificates, p.Err()
-}
-
-func (c *defaultELBV2) DescribeRulesAsList(ctx context.Context, input *elbv2.DescribeRulesInput) ([]*elbv2.Rule, error) {
-	var rules []*elbv2.Rule
-	p := request.Pagination{
-		EndPageOnSameToken: true,
-		NewRequest: func() (*request.Request, error) {
-			req, _ := c.DescribeRulesRequest(input)
-			req.SetContext(ctx)
-			return req, nil
-		},
+	var result []elbv2types.Rule
+	paginator := elbv2.NewDescribeRulesPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.Rules...)
 	}
-	for p.Next() {
-		page := p.Page().(*elbv2.DescribeRulesOutput)
-		rules = append(rules, page.Rules...)
+	return result, nil
+}
+
+func (c *defaultELBV2) DescribeTrustStoresAsList(ctx context.Context, input *elbv2.DescribeTrustStoresInput) ([]elbv2types.TrustStore, error) {
+	var result []elbv2types.TrustStore
+	paginator := elbv2.NewDescribeTrustStoresPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.TrustStores...)
 	}
-	return rules, p.Err()
+	return result, nil
+}
+
+func (c *defaultELBV2) WaitUntilLoadBalancerAvailableWithContext(ctx context.Context, input *elbv2.DescribeLoadBalancersInput) error {
+	waiter := elbv2.NewLoadBalancerAvailableWaiter(c.client)
+	return waiter.Wait(ctx, input, loadBalancerAvailableMaxWaitTime)
 }
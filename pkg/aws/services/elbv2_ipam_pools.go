@@ -0,0 +1,263 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awsxml "github.com/aws/aws-sdk-go-v2/aws/protocol/xml"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// The IPAM pools of load balancers aren't modeled by aws-sdk-go-v2, so their shapes are defined here following the ELBv2
+// API reference. The operations are sent through the ELBV2 client with the serializer and deserializer of a modeled
+// operation swapped, so that they use the same retries, limits and metrics.
+
+const (
+	opModifyIpPools         = "ModifyIpPools"
+	opDescribeLoadBalancers = "DescribeLoadBalancers"
+
+	elbv2APIVersion = "2015-12-01"
+)
+
+type RemoveIpamPoolEnum string
+
+const (
+	// RemoveIpamPoolEnumIpv4 is a RemoveIpamPoolEnum enum value
+	RemoveIpamPoolEnumIpv4 RemoveIpamPoolEnum = "ipv4"
+)
+
+// IpamPools is information about the IPAM pools of a load balancer.
+type IpamPools struct {
+	// The ID of the IPv4 IPAM pool.
+	Ipv4IpamPoolId *string
+}
+
+type ModifyIpPoolsInput struct {
+	// The IPAM pools to be modified.
+	IpamPools *IpamPools
+
+	// The Amazon Resource Name (ARN) of the load balancer.
+	LoadBalancerArn *string
+
+	// Remove the IP pools in use by the load balancer.
+	RemoveIpamPools []RemoveIpamPoolEnum
+}
+
+type ModifyIpPoolsOutput struct {
+	// The IPAM pool ID.
+	IpamPools *IpamPools
+}
+
+type DescribeLoadBalancerIpamPoolsInput struct {
+	// The Amazon Resource Names (ARN) of the load balancers.
+	LoadBalancerArns []string
+}
+
+// LoadBalancerIpamPools is the IPAM pools of a load balancer, as the IpamPools field of LoadBalancer isn't modeled by aws-sdk-go-v2.
+type LoadBalancerIpamPools struct {
+	// The Amazon Resource Name (ARN) of the load balancer.
+	LoadBalancerArn *string
+
+	// The IPAM pools of the load balancer.
+	IpamPools *IpamPools
+}
+
+type DescribeLoadBalancerIpamPoolsOutput struct {
+	// Information about the IPAM pools of the load balancers.
+	LoadBalancers []LoadBalancerIpamPools `xml:"LoadBalancers>member"`
+}
+
+func (c *defaultELBV2) ModifyIpPoolsWithContext(ctx context.Context, input *ModifyIpPoolsInput) (*ModifyIpPoolsOutput, error) {
+	params := url.Values{}
+	params.Set("LoadBalancerArn", aws.ToString(input.LoadBalancerArn))
+	encodeIpamPools(params, input.IpamPools)
+	for i, ipamPool := range input.RemoveIpamPools {
+		params.Set(fmt.Sprintf("RemoveIpamPools.member.%d", i+1), string(ipamPool))
+	}
+	output := &ModifyIpPoolsOutput{}
+	if err := c.invokeUnmodeledOperation(ctx, opModifyIpPools, params, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (c *defaultELBV2) DescribeLoadBalancerIpamPoolsWithContext(ctx context.Context, input *DescribeLoadBalancerIpamPoolsInput) (*DescribeLoadBalancerIpamPoolsOutput, error) {
+	params := url.Values{}
+	for i, lbARN := range input.LoadBalancerArns {
+		params.Set(fmt.Sprintf("LoadBalancerArns.member.%d", i+1), lbARN)
+	}
+	output := &DescribeLoadBalancerIpamPoolsOutput{}
+	if err := c.invokeUnmodeledOperation(ctx, opDescribeLoadBalancers, params, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// WithIpamPools is a request option for CreateLoadBalancer that sets the IpamPools parameter,
+// it's appended to the request body built from CreateLoadBalancerInput.
+func WithIpamPools(ipamPools *IpamPools) func(*elbv2.Options) {
+	return func(o *elbv2.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Serialize.Insert(middleware.SerializeMiddlewareFunc("IpamPools", func(
+				ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler,
+			) (middleware.SerializeOutput, middleware.Metadata, error) {
+				req, ok := in.Request.(*smithyhttp.Request)
+				if !ok {
+					return middleware.SerializeOutput{}, middleware.Metadata{}, &smithy.SerializationError{Err: fmt.Errorf("unknown transport type %T", in.Request)}
+				}
+				body, err := io.ReadAll(req.GetStream())
+				if err != nil {
+					return middleware.SerializeOutput{}, middleware.Metadata{}, &smithy.SerializationError{Err: err}
+				}
+				params := url.Values{}
+				encodeIpamPools(params, ipamPools)
+				if req, err = req.SetStream(strings.NewReader(string(body) + "&" + params.Encode())); err != nil {
+					return middleware.SerializeOutput{}, middleware.Metadata{}, &smithy.SerializationError{Err: err}
+				}
+				in.Request = req
+				return next.HandleSerialize(ctx, in)
+			}), "OperationSerializer", middleware.After)
+		})
+	}
+}
+
+// invokeUnmodeledOperation sends the operation with params in the awsquery protocol, and decodes the result into output.
+// it's sent as DescribeLoadBalancers with the serializer and deserializer swapped, and the operation name overridden.
+func (c *defaultELBV2) invokeUnmodeledOperation(ctx context.Context, operation string, params url.Values, output interface{}) error {
+	_, err := c.client.DescribeLoadBalancers(ctx, &elbv2.DescribeLoadBalancersInput{}, func(o *elbv2.Options) {
+		region := o.Region
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			if _, err := stack.Initialize.Swap("RegisterServiceMetadata", &awsmiddleware.RegisterServiceMetadata{
+				Region:        region,
+				ServiceID:     elbv2.ServiceID,
+				OperationName: operation,
+			}); err != nil {
+				return err
+			}
+			if _, err := stack.Serialize.Swap("OperationSerializer", &unmodeledOperationSerializer{
+				operation: operation,
+				params:    params,
+			}); err != nil {
+				return err
+			}
+			_, err := stack.Deserialize.Swap("OperationDeserializer", &unmodeledOperationDeserializer{
+				operation: operation,
+				output:    output,
+			})
+			return err
+		})
+	})
+	return err
+}
+
+func encodeIpamPools(params url.Values, ipamPools *IpamPools) {
+	if ipamPools != nil && ipamPools.Ipv4IpamPoolId != nil {
+		params.Set("IpamPools.Ipv4IpamPoolId", aws.ToString(ipamPools.Ipv4IpamPoolId))
+	}
+}
+
+// unmodeledOperationSerializer encodes the params of an unmodeled operation into the request body.
+type unmodeledOperationSerializer struct {
+	operation string
+	params    url.Values
+}
+
+func (*unmodeledOperationSerializer) ID() string {
+	return "OperationSerializer"
+}
+
+func (m *unmodeledOperationSerializer) HandleSerialize(ctx context.Context, in middleware.SerializeInput, next middleware.SerializeHandler) (
+	out middleware.SerializeOutput, metadata middleware.Metadata, err error,
+) {
+	req, ok := in.Request.(*smithyhttp.Request)
+	if !ok {
+		return out, metadata, &smithy.SerializationError{Err: fmt.Errorf("unknown transport type %T", in.Request)}
+	}
+	if len(req.URL.Path) == 0 {
+		req.URL.Path = "/"
+	}
+	req.Method = http.MethodPost
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	params := url.Values{}
+	for key, values := range m.params {
+		params[key] = values
+	}
+	params.Set("Action", m.operation)
+	params.Set("Version", elbv2APIVersion)
+	if req, err = req.SetStream(strings.NewReader(params.Encode())); err != nil {
+		return out, metadata, &smithy.SerializationError{Err: err}
+	}
+	in.Request = req
+	return next.HandleSerialize(ctx, in)
+}
+
+// unmodeledOperationDeserializer decodes the result of an unmodeled operation into output.
+type unmodeledOperationDeserializer struct {
+	operation string
+	output    interface{}
+}
+
+func (*unmodeledOperationDeserializer) ID() string {
+	return "OperationDeserializer"
+}
+
+func (m *unmodeledOperationDeserializer) HandleDeserialize(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (
+	out middleware.DeserializeOutput, metadata middleware.Metadata, err error,
+) {
+	out, metadata, err = next.HandleDeserialize(ctx, in)
+	if err != nil {
+		return out, metadata, err
+	}
+	resp, ok := out.RawResponse.(*smithyhttp.Response)
+	if !ok {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("unknown transport type %T", out.RawResponse)}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("failed to read response body, %w", err)}
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errorComponents, err := awsxml.GetErrorResponseComponents(bytes.NewReader(body), false)
+		if err != nil {
+			return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("failed to decode error response body, %w", err)}
+		}
+		if len(errorComponents.RequestID) != 0 {
+			awsmiddleware.SetRequestIDMetadata(&metadata, errorComponents.RequestID)
+		}
+		return out, metadata, &smithy.GenericAPIError{
+			Code:    errorComponents.Code,
+			Message: errorComponents.Message,
+		}
+	}
+	// the invoked operation expects its own output type as result.
+	out.Result = &elbv2.DescribeLoadBalancersOutput{}
+	if err := decodeQueryResult(body, m.operation+"Result", m.output); err != nil {
+		return out, metadata, &smithy.DeserializationError{Err: fmt.Errorf("failed to decode response body, %w", err), Snapshot: body}
+	}
+	return out, metadata, nil
+}
+
+// decodeQueryResult decodes the result element of an awsquery protocol response into v.
+func decodeQueryResult(body []byte, resultElement string, v interface{}) error {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local == resultElement {
+			return decoder.DecodeElement(v, &start)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_defaultELBV2_ModifyIpPoolsWithContext(t *testing.T) {
+	var gotForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotForm, _ = url.ParseQuery(string(body))
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = io.WriteString(w, `<ModifyIpPoolsResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <ModifyIpPoolsResult>
+    <IpamPools>
+      <Ipv4IpamPoolId>ipam-pool-1</Ipv4IpamPoolId>
+    </IpamPools>
+  </ModifyIpPoolsResult>
+  <ResponseMetadata>
+    <RequestId>request-id</RequestId>
+  </ResponseMetadata>
+</ModifyIpPoolsResponse>`)
+	}))
+	defer server.Close()
+
+	elbv2Client := newTestELBV2(server.URL)
+	got, err := elbv2Client.ModifyIpPoolsWithContext(context.Background(), &ModifyIpPoolsInput{
+		LoadBalancerArn: awssdk.String("my-lb"),
+		IpamPools: &IpamPools{
+			Ipv4IpamPoolId: awssdk.String("ipam-pool-1"),
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ModifyIpPools", gotForm.Get("Action"))
+	assert.Equal(t, "my-lb", gotForm.Get("LoadBalancerArn"))
+	assert.Equal(t, "ipam-pool-1", gotForm.Get("IpamPools.Ipv4IpamPoolId"))
+	assert.Equal(t, &ModifyIpPoolsOutput{
+		IpamPools: &IpamPools{
+			Ipv4IpamPoolId: awssdk.String("ipam-pool-1"),
+		},
+	}, got)
+}
+
+func Test_defaultELBV2_DescribeLoadBalancerIpamPoolsWithContext(t *testing.T) {
+	var gotForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotForm, _ = url.ParseQuery(string(body))
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = io.WriteString(w, `<DescribeLoadBalancersResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <DescribeLoadBalancersResult>
+    <LoadBalancers>
+      <member>
+        <LoadBalancerArn>my-lb</LoadBalancerArn>
+        <LoadBalancerName>my-lb-name</LoadBalancerName>
+        <IpamPools>
+          <Ipv4IpamPoolId>ipam-pool-1</Ipv4IpamPoolId>
+        </IpamPools>
+      </member>
+    </LoadBalancers>
+  </DescribeLoadBalancersResult>
+  <ResponseMetadata>
+    <RequestId>request-id</RequestId>
+  </ResponseMetadata>
+</DescribeLoadBalancersResponse>`)
+	}))
+	defer server.Close()
+
+	elbv2Client := newTestELBV2(server.URL)
+	got, err := elbv2Client.DescribeLoadBalancerIpamPoolsWithContext(context.Background(), &DescribeLoadBalancerIpamPoolsInput{
+		LoadBalancerArns: []string{"my-lb"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "DescribeLoadBalancers", gotForm.Get("Action"))
+	assert.Equal(t, "my-lb", gotForm.Get("LoadBalancerArns.member.1"))
+	assert.Equal(t, &DescribeLoadBalancerIpamPoolsOutput{
+		LoadBalancers: []LoadBalancerIpamPools{
+			{
+				LoadBalancerArn: awssdk.String("my-lb"),
+				IpamPools: &IpamPools{
+					Ipv4IpamPoolId: awssdk.String("ipam-pool-1"),
+				},
+			},
+		},
+	}, got)
+}
+
+func Test_WithIpamPools(t *testing.T) {
+	var gotForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotForm, _ = url.ParseQuery(string(body))
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = io.WriteString(w, `<CreateLoadBalancerResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <CreateLoadBalancerResult>
+    <LoadBalancers>
+      <member>
+        <LoadBalancerArn>my-lb</LoadBalancerArn>
+      </member>
+    </LoadBalancers>
+  </CreateLoadBalancerResult>
+  <ResponseMetadata>
+    <RequestId>request-id</RequestId>
+  </ResponseMetadata>
+</CreateLoadBalancerResponse>`)
+	}))
+	defer server.Close()
+
+	elbv2Client := newTestELBV2(server.URL)
+	got, err := elbv2Client.CreateLoadBalancerWithContext(context.Background(), &elbv2sdk.CreateLoadBalancerInput{
+		Name: awssdk.String("my-lb-name"),
+	}, WithIpamPools(&IpamPools{
+		Ipv4IpamPoolId: awssdk.String("ipam-pool-1"),
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "CreateLoadBalancer", gotForm.Get("Action"))
+	assert.Equal(t, "my-lb-name", gotForm.Get("Name"))
+	assert.Equal(t, "ipam-pool-1", gotForm.Get("IpamPools.Ipv4IpamPoolId"))
+	assert.Equal(t, "my-lb", awssdk.ToString(got.LoadBalancers[0].LoadBalancerArn))
+}
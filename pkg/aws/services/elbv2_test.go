@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_defaultELBV2_DescribeListenerAttributesWithContext(t *testing.T) {
+	var gotForm url.Values
+	var gotAuthorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotForm, _ = url.ParseQuery(string(body))
+		gotAuthorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = io.WriteString(w, `<DescribeListenerAttributesResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <DescribeListenerAttributesResult>
+    <Attributes>
+      <member>
+        <Key>tcp.idle_timeout.seconds</Key>
+        <Value>350</Value>
+      </member>
+    </Attributes>
+  </DescribeListenerAttributesResult>
+  <ResponseMetadata>
+    <RequestId>request-id</RequestId>
+  </ResponseMetadata>
+</DescribeListenerAttributesResponse>`)
+	}))
+	defer server.Close()
+
+	elbv2Client := newTestELBV2(server.URL)
+	got, err := elbv2Client.DescribeListenerAttributesWithContext(context.Background(), &elbv2sdk.DescribeListenerAttributesInput{
+		ListenerArn: awssdk.String("my-listener"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "DescribeListenerAttributes", gotForm.Get("Action"))
+	assert.Equal(t, "my-listener", gotForm.Get("ListenerArn"))
+	assert.Contains(t, gotAuthorization, "Credential=AKID/")
+	assert.Contains(t, gotAuthorization, "/us-west-2/elasticloadbalancing/aws4_request")
+	assert.Equal(t, []elbv2types.ListenerAttribute{
+		{
+			Key:   awssdk.String("tcp.idle_timeout.seconds"),
+			Value: awssdk.String("350"),
+		},
+	}, got.Attributes)
+}
+
+func Test_defaultELBV2_ModifyListenerAttributesWithContext(t *testing.T) {
+	var gotForm url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotForm, _ = url.ParseQuery(string(body))
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = io.WriteString(w, `<ModifyListenerAttributesResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <ModifyListenerAttributesResult>
+    <Attributes>
+      <member>
+        <Key>routing.http.response.server.enabled</Key>
+        <Value>false</Value>
+      </member>
+    </Attributes>
+  </ModifyListenerAttributesResult>
+  <ResponseMetadata>
+    <RequestId>request-id</RequestId>
+  </ResponseMetadata>
+</ModifyListenerAttributesResponse>`)
+	}))
+	defer server.Close()
+
+	elbv2Client := newTestELBV2(server.URL)
+	got, err := elbv2Client.ModifyListenerAttributesWithContext(context.Background(), &elbv2sdk.ModifyListenerAttributesInput{
+		ListenerArn: awssdk.String("my-listener"),
+		Attributes: []elbv2types.ListenerAttribute{
+			{
+				Key:   awssdk.String("routing.http.response.server.enabled"),
+				Value: awssdk.String("false"),
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "ModifyListenerAttributes", gotForm.Get("Action"))
+	assert.Equal(t, "my-listener", gotForm.Get("ListenerArn"))
+	assert.Equal(t, "routing.http.response.server.enabled", gotForm.Get("Attributes.member.1.Key"))
+	assert.Equal(t, "false", gotForm.Get("Attributes.member.1.Value"))
+	assert.Equal(t, []elbv2types.ListenerAttribute{
+		{
+			Key:   awssdk.String("routing.http.response.server.enabled"),
+			Value: awssdk.String("false"),
+		},
+	}, got.Attributes)
+}
+
+func Test_defaultELBV2_retriesAndAPIOptions(t *testing.T) {
+	attempts := 0
+	var gotUserAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		gotUserAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = io.WriteString(w, `<ErrorResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <Error>
+    <Type>Receiver</Type>
+    <Code>ServiceUnavailable</Code>
+    <Message>service unavailable</Message>
+  </Error>
+  <RequestId>request-id</RequestId>
+</ErrorResponse>`)
+	}))
+	defer server.Close()
+
+	cfg := newTestSDKV2Config(server.URL)
+	cfg.Retryer = func() awssdk.Retryer {
+		return retry.NewStandard(func(o *retry.StandardOptions) {
+			o.MaxAttempts = 2
+		})
+	}
+	cfg.APIOptions = append(cfg.APIOptions, awsmiddleware.AddUserAgentKeyValue("elbv2.k8s.aws", "v0.0.0"))
+	elbv2Client := NewELBV2(cfg)
+	_, err := elbv2Client.DescribeListenerAttributesWithContext(context.Background(), &elbv2sdk.DescribeListenerAttributesInput{
+		ListenerArn: awssdk.String("my-listener"),
+	})
+	assert.ErrorContains(t, err, "ServiceUnavailable")
+	assert.Equal(t, 2, attempts)
+	assert.Contains(t, gotUserAgent, "elbv2.k8s.aws/v0.0.0")
+}
+
+func newTestELBV2(endpoint string) ELBV2 {
+	return NewELBV2(newTestSDKV2Config(endpoint))
+}
+
+func newTestSDKV2Config(endpoint string) awssdk.Config {
+	return awssdk.Config{
+		BaseEndpoint: awssdk.String(endpoint),
+		Region:       "us-west-2",
+		Credentials:  credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+	}
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+)
+
+type EventBridge interface {
+	PutEventsWithContext(ctx context.Context, input *eventbridge.PutEventsInput) (*eventbridge.PutEventsOutput, error)
+	PutRuleWithContext(ctx context.Context, input *eventbridge.PutRuleInput) (*eventbridge.PutRuleOutput, error)
+	PutTargetsWithContext(ctx context.Context, input *eventbridge.PutTargetsInput) (*eventbridge.PutTargetsOutput, error)
+}
+
+// NewEventBridge constructs new EventBridge implementation.
+func NewEventBridge(cfg aws.Config) EventBridge {
+	return &defaultEventBridge{
+		client: eventbridge.NewFromConfig(cfg),
+	}
+}
+
+// default implementation for EventBridge.
+type defaultEventBridge struct {
+	client *eventbridge.Client
+}
+
+func (c *defaultEventBridge) PutEventsWithContext(ctx context.Context, input *eventbridge.PutEventsInput) (*eventbridge.PutEventsOutput, error) {
+	return c.client.PutEvents(ctx, input)
+}
+
+func (c *defaultEventBridge) PutRuleWithContext(ctx context.Context, input *eventbridge.PutRuleInput) (*eventbridge.PutRuleOutput, error) {
+	return c.client.PutRule(ctx, input)
+}
+
+func (c *defaultEventBridge) PutTargetsWithContext(ctx context.Context, input *eventbridge.PutTargetsInput) (*eventbridge.PutTargetsOutput, error) {
+	return c.client.PutTargets(ctx, input)
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/globalaccelerator/globalacceleratoriface"
+)
+
+type GlobalAccelerator interface {
+	globalacceleratoriface.GlobalAcceleratorAPI
+
+	// wrapper to ListAcceleratorsPagesWithContext API, which aggregates paged results into list.
+	ListAcceleratorsAsList(ctx context.Context, input *globalaccelerator.ListAcceleratorsInput) ([]*globalaccelerator.Accelerator, error)
+
+	// wrapper to ListListenersPagesWithContext API, which aggregates paged results into list.
+	ListListenersAsList(ctx context.Context, input *globalaccelerator.ListListenersInput) ([]*globalaccelerator.Listener, error)
+
+	// wrapper to ListEndpointGroupsPagesWithContext API, which aggregates paged results into list.
+	ListEndpointGroupsAsList(ctx context.Context, input *globalaccelerator.ListEndpointGroupsInput) ([]*globalaccelerator.EndpointGroup, error)
+}
+
+// NewGlobalAccelerator constructs new GlobalAccelerator implementation.
+func NewGlobalAccelerator(session *session.Session) GlobalAccelerator {
+	return &defaultGlobalAccelerator{
+		// global accelerator is only available as a global API in us-west-2.
+		GlobalAcceleratorAPI: globalaccelerator.New(session, aws.NewConfig().WithRegion("us-west-2")),
+	}
+}
+
+// default implementation for GlobalAccelerator.
+type defaultGlobalAccelerator struct {
+	globalacceleratoriface.GlobalAcceleratorAPI
+}
+
+func (c *defaultGlobalAccelerator) ListAcceleratorsAsList(ctx context.Context, input *globalaccelerator.ListAcceleratorsInput) ([]*globalaccelerator.Accelerator, error) {
+	var result []*globalaccelerator.Accelerator
+	if err := c.ListAcceleratorsPagesWithContext(ctx, input, func(output *globalaccelerator.ListAcceleratorsOutput, _ bool) bool {
+		result = append(result, output.Accelerators...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *defaultGlobalAccelerator) ListListenersAsList(ctx context.Context, input *globalaccelerator.ListListenersInput) ([]*globalaccelerator.Listener, error) {
+	var result []*globalaccelerator.Listener
+	if err := c.ListListenersPagesWithContext(ctx, input, func(output *globalaccelerator.ListListenersOutput, _ bool) bool {
+		result = append(result, output.Listeners...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *defaultGlobalAccelerator) ListEndpointGroupsAsList(ctx context.Context, input *globalaccelerator.ListEndpointGroupsInput) ([]*globalaccelerator.EndpointGroup, error) {
+	var result []*globalaccelerator.EndpointGroup
+	if err := c.ListEndpointGroupsPagesWithContext(ctx, input, func(output *globalaccelerator.ListEndpointGroupsOutput, _ bool) bool {
+		result = append(result, output.EndpointGroups...)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,3245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services (interfaces: GlobalAccelerator)
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+
+	request "github.com/aws/aws-sdk-go/aws/request"
+	globalaccelerator "github.com/aws/aws-sdk-go/service/globalaccelerator"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockGlobalAccelerator is a mock of GlobalAccelerator interface.
+type MockGlobalAccelerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockGlobalAcceleratorMockRecorder
+}
+
+// MockGlobalAcceleratorMockRecorder is the mock recorder for MockGlobalAccelerator.
+type MockGlobalAcceleratorMockRecorder struct {
+	mock *MockGlobalAccelerator
+}
+
+// NewMockGlobalAccelerator creates a new mock instance.
+func NewMockGlobalAccelerator(ctrl *gomock.Controller) *MockGlobalAccelerator {
+	mock := &MockGlobalAccelerator{ctrl: ctrl}
+	mock.recorder = &MockGlobalAcceleratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGlobalAccelerator) EXPECT() *MockGlobalAcceleratorMockRecorder {
+	return m.recorder
+}
+
+// AddCustomRoutingEndpoints mocks base method.
+func (m *MockGlobalAccelerator) AddCustomRoutingEndpoints(arg0 *globalaccelerator.AddCustomRoutingEndpointsInput) (*globalaccelerator.AddCustomRoutingEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCustomRoutingEndpoints", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.AddCustomRoutingEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCustomRoutingEndpoints indicates an expected call of AddCustomRoutingEndpoints.
+func (mr *MockGlobalAcceleratorMockRecorder) AddCustomRoutingEndpoints(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomRoutingEndpoints", reflect.TypeOf((*MockGlobalAccelerator)(nil).AddCustomRoutingEndpoints), arg0)
+}
+
+// AddCustomRoutingEndpointsRequest mocks base method.
+func (m *MockGlobalAccelerator) AddCustomRoutingEndpointsRequest(arg0 *globalaccelerator.AddCustomRoutingEndpointsInput) (*request.Request, *globalaccelerator.AddCustomRoutingEndpointsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCustomRoutingEndpointsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.AddCustomRoutingEndpointsOutput)
+	return ret0, ret1
+}
+
+// AddCustomRoutingEndpointsRequest indicates an expected call of AddCustomRoutingEndpointsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) AddCustomRoutingEndpointsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomRoutingEndpointsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).AddCustomRoutingEndpointsRequest), arg0)
+}
+
+// AddCustomRoutingEndpointsWithContext mocks base method.
+func (m *MockGlobalAccelerator) AddCustomRoutingEndpointsWithContext(arg0 context.Context, arg1 *globalaccelerator.AddCustomRoutingEndpointsInput, arg2 ...request.Option) (*globalaccelerator.AddCustomRoutingEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddCustomRoutingEndpointsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.AddCustomRoutingEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCustomRoutingEndpointsWithContext indicates an expected call of AddCustomRoutingEndpointsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) AddCustomRoutingEndpointsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomRoutingEndpointsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).AddCustomRoutingEndpointsWithContext), varargs...)
+}
+
+// AddEndpoints mocks base method.
+func (m *MockGlobalAccelerator) AddEndpoints(arg0 *globalaccelerator.AddEndpointsInput) (*globalaccelerator.AddEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEndpoints", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.AddEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEndpoints indicates an expected call of AddEndpoints.
+func (mr *MockGlobalAcceleratorMockRecorder) AddEndpoints(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEndpoints", reflect.TypeOf((*MockGlobalAccelerator)(nil).AddEndpoints), arg0)
+}
+
+// AddEndpointsRequest mocks base method.
+func (m *MockGlobalAccelerator) AddEndpointsRequest(arg0 *globalaccelerator.AddEndpointsInput) (*request.Request, *globalaccelerator.AddEndpointsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddEndpointsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.AddEndpointsOutput)
+	return ret0, ret1
+}
+
+// AddEndpointsRequest indicates an expected call of AddEndpointsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) AddEndpointsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEndpointsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).AddEndpointsRequest), arg0)
+}
+
+// AddEndpointsWithContext mocks base method.
+func (m *MockGlobalAccelerator) AddEndpointsWithContext(arg0 context.Context, arg1 *globalaccelerator.AddEndpointsInput, arg2 ...request.Option) (*globalaccelerator.AddEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddEndpointsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.AddEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddEndpointsWithContext indicates an expected call of AddEndpointsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) AddEndpointsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEndpointsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).AddEndpointsWithContext), varargs...)
+}
+
+// AdvertiseByoipCidr mocks base method.
+func (m *MockGlobalAccelerator) AdvertiseByoipCidr(arg0 *globalaccelerator.AdvertiseByoipCidrInput) (*globalaccelerator.AdvertiseByoipCidrOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvertiseByoipCidr", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.AdvertiseByoipCidrOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvertiseByoipCidr indicates an expected call of AdvertiseByoipCidr.
+func (mr *MockGlobalAcceleratorMockRecorder) AdvertiseByoipCidr(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvertiseByoipCidr", reflect.TypeOf((*MockGlobalAccelerator)(nil).AdvertiseByoipCidr), arg0)
+}
+
+// AdvertiseByoipCidrRequest mocks base method.
+func (m *MockGlobalAccelerator) AdvertiseByoipCidrRequest(arg0 *globalaccelerator.AdvertiseByoipCidrInput) (*request.Request, *globalaccelerator.AdvertiseByoipCidrOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvertiseByoipCidrRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.AdvertiseByoipCidrOutput)
+	return ret0, ret1
+}
+
+// AdvertiseByoipCidrRequest indicates an expected call of AdvertiseByoipCidrRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) AdvertiseByoipCidrRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvertiseByoipCidrRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).AdvertiseByoipCidrRequest), arg0)
+}
+
+// AdvertiseByoipCidrWithContext mocks base method.
+func (m *MockGlobalAccelerator) AdvertiseByoipCidrWithContext(arg0 context.Context, arg1 *globalaccelerator.AdvertiseByoipCidrInput, arg2 ...request.Option) (*globalaccelerator.AdvertiseByoipCidrOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AdvertiseByoipCidrWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.AdvertiseByoipCidrOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvertiseByoipCidrWithContext indicates an expected call of AdvertiseByoipCidrWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) AdvertiseByoipCidrWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvertiseByoipCidrWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).AdvertiseByoipCidrWithContext), varargs...)
+}
+
+// AllowCustomRoutingTraffic mocks base method.
+func (m *MockGlobalAccelerator) AllowCustomRoutingTraffic(arg0 *globalaccelerator.AllowCustomRoutingTrafficInput) (*globalaccelerator.AllowCustomRoutingTrafficOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowCustomRoutingTraffic", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.AllowCustomRoutingTrafficOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowCustomRoutingTraffic indicates an expected call of AllowCustomRoutingTraffic.
+func (mr *MockGlobalAcceleratorMockRecorder) AllowCustomRoutingTraffic(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowCustomRoutingTraffic", reflect.TypeOf((*MockGlobalAccelerator)(nil).AllowCustomRoutingTraffic), arg0)
+}
+
+// AllowCustomRoutingTrafficRequest mocks base method.
+func (m *MockGlobalAccelerator) AllowCustomRoutingTrafficRequest(arg0 *globalaccelerator.AllowCustomRoutingTrafficInput) (*request.Request, *globalaccelerator.AllowCustomRoutingTrafficOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowCustomRoutingTrafficRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.AllowCustomRoutingTrafficOutput)
+	return ret0, ret1
+}
+
+// AllowCustomRoutingTrafficRequest indicates an expected call of AllowCustomRoutingTrafficRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) AllowCustomRoutingTrafficRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowCustomRoutingTrafficRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).AllowCustomRoutingTrafficRequest), arg0)
+}
+
+// AllowCustomRoutingTrafficWithContext mocks base method.
+func (m *MockGlobalAccelerator) AllowCustomRoutingTrafficWithContext(arg0 context.Context, arg1 *globalaccelerator.AllowCustomRoutingTrafficInput, arg2 ...request.Option) (*globalaccelerator.AllowCustomRoutingTrafficOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AllowCustomRoutingTrafficWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.AllowCustomRoutingTrafficOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowCustomRoutingTrafficWithContext indicates an expected call of AllowCustomRoutingTrafficWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) AllowCustomRoutingTrafficWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowCustomRoutingTrafficWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).AllowCustomRoutingTrafficWithContext), varargs...)
+}
+
+// CreateAccelerator mocks base method.
+func (m *MockGlobalAccelerator) CreateAccelerator(arg0 *globalaccelerator.CreateAcceleratorInput) (*globalaccelerator.CreateAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccelerator", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.CreateAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccelerator indicates an expected call of CreateAccelerator.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccelerator", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateAccelerator), arg0)
+}
+
+// CreateAcceleratorRequest mocks base method.
+func (m *MockGlobalAccelerator) CreateAcceleratorRequest(arg0 *globalaccelerator.CreateAcceleratorInput) (*request.Request, *globalaccelerator.CreateAcceleratorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAcceleratorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.CreateAcceleratorOutput)
+	return ret0, ret1
+}
+
+// CreateAcceleratorRequest indicates an expected call of CreateAcceleratorRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateAcceleratorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAcceleratorRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateAcceleratorRequest), arg0)
+}
+
+// CreateAcceleratorWithContext mocks base method.
+func (m *MockGlobalAccelerator) CreateAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.CreateAcceleratorInput, arg2 ...request.Option) (*globalaccelerator.CreateAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateAcceleratorWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.CreateAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAcceleratorWithContext indicates an expected call of CreateAcceleratorWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateAcceleratorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAcceleratorWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateAcceleratorWithContext), varargs...)
+}
+
+// CreateCrossAccountAttachment mocks base method.
+func (m *MockGlobalAccelerator) CreateCrossAccountAttachment(arg0 *globalaccelerator.CreateCrossAccountAttachmentInput) (*globalaccelerator.CreateCrossAccountAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCrossAccountAttachment", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.CreateCrossAccountAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCrossAccountAttachment indicates an expected call of CreateCrossAccountAttachment.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCrossAccountAttachment(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCrossAccountAttachment", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCrossAccountAttachment), arg0)
+}
+
+// CreateCrossAccountAttachmentRequest mocks base method.
+func (m *MockGlobalAccelerator) CreateCrossAccountAttachmentRequest(arg0 *globalaccelerator.CreateCrossAccountAttachmentInput) (*request.Request, *globalaccelerator.CreateCrossAccountAttachmentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCrossAccountAttachmentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.CreateCrossAccountAttachmentOutput)
+	return ret0, ret1
+}
+
+// CreateCrossAccountAttachmentRequest indicates an expected call of CreateCrossAccountAttachmentRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCrossAccountAttachmentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCrossAccountAttachmentRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCrossAccountAttachmentRequest), arg0)
+}
+
+// CreateCrossAccountAttachmentWithContext mocks base method.
+func (m *MockGlobalAccelerator) CreateCrossAccountAttachmentWithContext(arg0 context.Context, arg1 *globalaccelerator.CreateCrossAccountAttachmentInput, arg2 ...request.Option) (*globalaccelerator.CreateCrossAccountAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCrossAccountAttachmentWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.CreateCrossAccountAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCrossAccountAttachmentWithContext indicates an expected call of CreateCrossAccountAttachmentWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCrossAccountAttachmentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCrossAccountAttachmentWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCrossAccountAttachmentWithContext), varargs...)
+}
+
+// CreateCustomRoutingAccelerator mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingAccelerator(arg0 *globalaccelerator.CreateCustomRoutingAcceleratorInput) (*globalaccelerator.CreateCustomRoutingAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRoutingAccelerator", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.CreateCustomRoutingAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingAccelerator indicates an expected call of CreateCustomRoutingAccelerator.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingAccelerator", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingAccelerator), arg0)
+}
+
+// CreateCustomRoutingAcceleratorRequest mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingAcceleratorRequest(arg0 *globalaccelerator.CreateCustomRoutingAcceleratorInput) (*request.Request, *globalaccelerator.CreateCustomRoutingAcceleratorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRoutingAcceleratorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.CreateCustomRoutingAcceleratorOutput)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingAcceleratorRequest indicates an expected call of CreateCustomRoutingAcceleratorRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingAcceleratorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingAcceleratorRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingAcceleratorRequest), arg0)
+}
+
+// CreateCustomRoutingAcceleratorWithContext mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.CreateCustomRoutingAcceleratorInput, arg2 ...request.Option) (*globalaccelerator.CreateCustomRoutingAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCustomRoutingAcceleratorWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.CreateCustomRoutingAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingAcceleratorWithContext indicates an expected call of CreateCustomRoutingAcceleratorWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingAcceleratorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingAcceleratorWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingAcceleratorWithContext), varargs...)
+}
+
+// CreateCustomRoutingEndpointGroup mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingEndpointGroup(arg0 *globalaccelerator.CreateCustomRoutingEndpointGroupInput) (*globalaccelerator.CreateCustomRoutingEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRoutingEndpointGroup", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.CreateCustomRoutingEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingEndpointGroup indicates an expected call of CreateCustomRoutingEndpointGroup.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingEndpointGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingEndpointGroup", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingEndpointGroup), arg0)
+}
+
+// CreateCustomRoutingEndpointGroupRequest mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingEndpointGroupRequest(arg0 *globalaccelerator.CreateCustomRoutingEndpointGroupInput) (*request.Request, *globalaccelerator.CreateCustomRoutingEndpointGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRoutingEndpointGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.CreateCustomRoutingEndpointGroupOutput)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingEndpointGroupRequest indicates an expected call of CreateCustomRoutingEndpointGroupRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingEndpointGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingEndpointGroupRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingEndpointGroupRequest), arg0)
+}
+
+// CreateCustomRoutingEndpointGroupWithContext mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingEndpointGroupWithContext(arg0 context.Context, arg1 *globalaccelerator.CreateCustomRoutingEndpointGroupInput, arg2 ...request.Option) (*globalaccelerator.CreateCustomRoutingEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCustomRoutingEndpointGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.CreateCustomRoutingEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingEndpointGroupWithContext indicates an expected call of CreateCustomRoutingEndpointGroupWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingEndpointGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingEndpointGroupWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingEndpointGroupWithContext), varargs...)
+}
+
+// CreateCustomRoutingListener mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingListener(arg0 *globalaccelerator.CreateCustomRoutingListenerInput) (*globalaccelerator.CreateCustomRoutingListenerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRoutingListener", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.CreateCustomRoutingListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingListener indicates an expected call of CreateCustomRoutingListener.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingListener", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingListener), arg0)
+}
+
+// CreateCustomRoutingListenerRequest mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingListenerRequest(arg0 *globalaccelerator.CreateCustomRoutingListenerInput) (*request.Request, *globalaccelerator.CreateCustomRoutingListenerOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomRoutingListenerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.CreateCustomRoutingListenerOutput)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingListenerRequest indicates an expected call of CreateCustomRoutingListenerRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingListenerRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingListenerRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingListenerRequest), arg0)
+}
+
+// CreateCustomRoutingListenerWithContext mocks base method.
+func (m *MockGlobalAccelerator) CreateCustomRoutingListenerWithContext(arg0 context.Context, arg1 *globalaccelerator.CreateCustomRoutingListenerInput, arg2 ...request.Option) (*globalaccelerator.CreateCustomRoutingListenerOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCustomRoutingListenerWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.CreateCustomRoutingListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomRoutingListenerWithContext indicates an expected call of CreateCustomRoutingListenerWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateCustomRoutingListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomRoutingListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateCustomRoutingListenerWithContext), varargs...)
+}
+
+// CreateEndpointGroup mocks base method.
+func (m *MockGlobalAccelerator) CreateEndpointGroup(arg0 *globalaccelerator.CreateEndpointGroupInput) (*globalaccelerator.CreateEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpointGroup", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.CreateEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEndpointGroup indicates an expected call of CreateEndpointGroup.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateEndpointGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpointGroup", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateEndpointGroup), arg0)
+}
+
+// CreateEndpointGroupRequest mocks base method.
+func (m *MockGlobalAccelerator) CreateEndpointGroupRequest(arg0 *globalaccelerator.CreateEndpointGroupInput) (*request.Request, *globalaccelerator.CreateEndpointGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpointGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.CreateEndpointGroupOutput)
+	return ret0, ret1
+}
+
+// CreateEndpointGroupRequest indicates an expected call of CreateEndpointGroupRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateEndpointGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpointGroupRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateEndpointGroupRequest), arg0)
+}
+
+// CreateEndpointGroupWithContext mocks base method.
+func (m *MockGlobalAccelerator) CreateEndpointGroupWithContext(arg0 context.Context, arg1 *globalaccelerator.CreateEndpointGroupInput, arg2 ...request.Option) (*globalaccelerator.CreateEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEndpointGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.CreateEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEndpointGroupWithContext indicates an expected call of CreateEndpointGroupWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateEndpointGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpointGroupWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateEndpointGroupWithContext), varargs...)
+}
+
+// CreateListener mocks base method.
+func (m *MockGlobalAccelerator) CreateListener(arg0 *globalaccelerator.CreateListenerInput) (*globalaccelerator.CreateListenerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListener", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.CreateListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListener indicates an expected call of CreateListener.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListener", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateListener), arg0)
+}
+
+// CreateListenerRequest mocks base method.
+func (m *MockGlobalAccelerator) CreateListenerRequest(arg0 *globalaccelerator.CreateListenerInput) (*request.Request, *globalaccelerator.CreateListenerOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateListenerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.CreateListenerOutput)
+	return ret0, ret1
+}
+
+// CreateListenerRequest indicates an expected call of CreateListenerRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateListenerRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListenerRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateListenerRequest), arg0)
+}
+
+// CreateListenerWithContext mocks base method.
+func (m *MockGlobalAccelerator) CreateListenerWithContext(arg0 context.Context, arg1 *globalaccelerator.CreateListenerInput, arg2 ...request.Option) (*globalaccelerator.CreateListenerOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateListenerWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.CreateListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateListenerWithContext indicates an expected call of CreateListenerWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) CreateListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).CreateListenerWithContext), varargs...)
+}
+
+// DeleteAccelerator mocks base method.
+func (m *MockGlobalAccelerator) DeleteAccelerator(arg0 *globalaccelerator.DeleteAcceleratorInput) (*globalaccelerator.DeleteAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccelerator", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccelerator indicates an expected call of DeleteAccelerator.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccelerator", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteAccelerator), arg0)
+}
+
+// DeleteAcceleratorRequest mocks base method.
+func (m *MockGlobalAccelerator) DeleteAcceleratorRequest(arg0 *globalaccelerator.DeleteAcceleratorInput) (*request.Request, *globalaccelerator.DeleteAcceleratorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAcceleratorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DeleteAcceleratorOutput)
+	return ret0, ret1
+}
+
+// DeleteAcceleratorRequest indicates an expected call of DeleteAcceleratorRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteAcceleratorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAcceleratorRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteAcceleratorRequest), arg0)
+}
+
+// DeleteAcceleratorWithContext mocks base method.
+func (m *MockGlobalAccelerator) DeleteAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.DeleteAcceleratorInput, arg2 ...request.Option) (*globalaccelerator.DeleteAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteAcceleratorWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAcceleratorWithContext indicates an expected call of DeleteAcceleratorWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteAcceleratorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAcceleratorWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteAcceleratorWithContext), varargs...)
+}
+
+// DeleteCrossAccountAttachment mocks base method.
+func (m *MockGlobalAccelerator) DeleteCrossAccountAttachment(arg0 *globalaccelerator.DeleteCrossAccountAttachmentInput) (*globalaccelerator.DeleteCrossAccountAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCrossAccountAttachment", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteCrossAccountAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCrossAccountAttachment indicates an expected call of DeleteCrossAccountAttachment.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCrossAccountAttachment(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCrossAccountAttachment", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCrossAccountAttachment), arg0)
+}
+
+// DeleteCrossAccountAttachmentRequest mocks base method.
+func (m *MockGlobalAccelerator) DeleteCrossAccountAttachmentRequest(arg0 *globalaccelerator.DeleteCrossAccountAttachmentInput) (*request.Request, *globalaccelerator.DeleteCrossAccountAttachmentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCrossAccountAttachmentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DeleteCrossAccountAttachmentOutput)
+	return ret0, ret1
+}
+
+// DeleteCrossAccountAttachmentRequest indicates an expected call of DeleteCrossAccountAttachmentRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCrossAccountAttachmentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCrossAccountAttachmentRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCrossAccountAttachmentRequest), arg0)
+}
+
+// DeleteCrossAccountAttachmentWithContext mocks base method.
+func (m *MockGlobalAccelerator) DeleteCrossAccountAttachmentWithContext(arg0 context.Context, arg1 *globalaccelerator.DeleteCrossAccountAttachmentInput, arg2 ...request.Option) (*globalaccelerator.DeleteCrossAccountAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCrossAccountAttachmentWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteCrossAccountAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCrossAccountAttachmentWithContext indicates an expected call of DeleteCrossAccountAttachmentWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCrossAccountAttachmentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCrossAccountAttachmentWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCrossAccountAttachmentWithContext), varargs...)
+}
+
+// DeleteCustomRoutingAccelerator mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingAccelerator(arg0 *globalaccelerator.DeleteCustomRoutingAcceleratorInput) (*globalaccelerator.DeleteCustomRoutingAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingAccelerator", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteCustomRoutingAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingAccelerator indicates an expected call of DeleteCustomRoutingAccelerator.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingAccelerator", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingAccelerator), arg0)
+}
+
+// DeleteCustomRoutingAcceleratorRequest mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingAcceleratorRequest(arg0 *globalaccelerator.DeleteCustomRoutingAcceleratorInput) (*request.Request, *globalaccelerator.DeleteCustomRoutingAcceleratorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingAcceleratorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DeleteCustomRoutingAcceleratorOutput)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingAcceleratorRequest indicates an expected call of DeleteCustomRoutingAcceleratorRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingAcceleratorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingAcceleratorRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingAcceleratorRequest), arg0)
+}
+
+// DeleteCustomRoutingAcceleratorWithContext mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.DeleteCustomRoutingAcceleratorInput, arg2 ...request.Option) (*globalaccelerator.DeleteCustomRoutingAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingAcceleratorWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteCustomRoutingAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingAcceleratorWithContext indicates an expected call of DeleteCustomRoutingAcceleratorWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingAcceleratorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingAcceleratorWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingAcceleratorWithContext), varargs...)
+}
+
+// DeleteCustomRoutingEndpointGroup mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingEndpointGroup(arg0 *globalaccelerator.DeleteCustomRoutingEndpointGroupInput) (*globalaccelerator.DeleteCustomRoutingEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingEndpointGroup", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteCustomRoutingEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingEndpointGroup indicates an expected call of DeleteCustomRoutingEndpointGroup.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingEndpointGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingEndpointGroup", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingEndpointGroup), arg0)
+}
+
+// DeleteCustomRoutingEndpointGroupRequest mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingEndpointGroupRequest(arg0 *globalaccelerator.DeleteCustomRoutingEndpointGroupInput) (*request.Request, *globalaccelerator.DeleteCustomRoutingEndpointGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingEndpointGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DeleteCustomRoutingEndpointGroupOutput)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingEndpointGroupRequest indicates an expected call of DeleteCustomRoutingEndpointGroupRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingEndpointGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingEndpointGroupRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingEndpointGroupRequest), arg0)
+}
+
+// DeleteCustomRoutingEndpointGroupWithContext mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingEndpointGroupWithContext(arg0 context.Context, arg1 *globalaccelerator.DeleteCustomRoutingEndpointGroupInput, arg2 ...request.Option) (*globalaccelerator.DeleteCustomRoutingEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingEndpointGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteCustomRoutingEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingEndpointGroupWithContext indicates an expected call of DeleteCustomRoutingEndpointGroupWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingEndpointGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingEndpointGroupWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingEndpointGroupWithContext), varargs...)
+}
+
+// DeleteCustomRoutingListener mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingListener(arg0 *globalaccelerator.DeleteCustomRoutingListenerInput) (*globalaccelerator.DeleteCustomRoutingListenerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingListener", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteCustomRoutingListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingListener indicates an expected call of DeleteCustomRoutingListener.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingListener", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingListener), arg0)
+}
+
+// DeleteCustomRoutingListenerRequest mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingListenerRequest(arg0 *globalaccelerator.DeleteCustomRoutingListenerInput) (*request.Request, *globalaccelerator.DeleteCustomRoutingListenerOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingListenerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DeleteCustomRoutingListenerOutput)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingListenerRequest indicates an expected call of DeleteCustomRoutingListenerRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingListenerRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingListenerRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingListenerRequest), arg0)
+}
+
+// DeleteCustomRoutingListenerWithContext mocks base method.
+func (m *MockGlobalAccelerator) DeleteCustomRoutingListenerWithContext(arg0 context.Context, arg1 *globalaccelerator.DeleteCustomRoutingListenerInput, arg2 ...request.Option) (*globalaccelerator.DeleteCustomRoutingListenerOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCustomRoutingListenerWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteCustomRoutingListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomRoutingListenerWithContext indicates an expected call of DeleteCustomRoutingListenerWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteCustomRoutingListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomRoutingListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteCustomRoutingListenerWithContext), varargs...)
+}
+
+// DeleteEndpointGroup mocks base method.
+func (m *MockGlobalAccelerator) DeleteEndpointGroup(arg0 *globalaccelerator.DeleteEndpointGroupInput) (*globalaccelerator.DeleteEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpointGroup", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEndpointGroup indicates an expected call of DeleteEndpointGroup.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteEndpointGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpointGroup", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteEndpointGroup), arg0)
+}
+
+// DeleteEndpointGroupRequest mocks base method.
+func (m *MockGlobalAccelerator) DeleteEndpointGroupRequest(arg0 *globalaccelerator.DeleteEndpointGroupInput) (*request.Request, *globalaccelerator.DeleteEndpointGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpointGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DeleteEndpointGroupOutput)
+	return ret0, ret1
+}
+
+// DeleteEndpointGroupRequest indicates an expected call of DeleteEndpointGroupRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteEndpointGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpointGroupRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteEndpointGroupRequest), arg0)
+}
+
+// DeleteEndpointGroupWithContext mocks base method.
+func (m *MockGlobalAccelerator) DeleteEndpointGroupWithContext(arg0 context.Context, arg1 *globalaccelerator.DeleteEndpointGroupInput, arg2 ...request.Option) (*globalaccelerator.DeleteEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEndpointGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEndpointGroupWithContext indicates an expected call of DeleteEndpointGroupWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteEndpointGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpointGroupWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteEndpointGroupWithContext), varargs...)
+}
+
+// DeleteListener mocks base method.
+func (m *MockGlobalAccelerator) DeleteListener(arg0 *globalaccelerator.DeleteListenerInput) (*globalaccelerator.DeleteListenerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteListener", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteListener indicates an expected call of DeleteListener.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListener", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteListener), arg0)
+}
+
+// DeleteListenerRequest mocks base method.
+func (m *MockGlobalAccelerator) DeleteListenerRequest(arg0 *globalaccelerator.DeleteListenerInput) (*request.Request, *globalaccelerator.DeleteListenerOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteListenerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DeleteListenerOutput)
+	return ret0, ret1
+}
+
+// DeleteListenerRequest indicates an expected call of DeleteListenerRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteListenerRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListenerRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteListenerRequest), arg0)
+}
+
+// DeleteListenerWithContext mocks base method.
+func (m *MockGlobalAccelerator) DeleteListenerWithContext(arg0 context.Context, arg1 *globalaccelerator.DeleteListenerInput, arg2 ...request.Option) (*globalaccelerator.DeleteListenerOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteListenerWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DeleteListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteListenerWithContext indicates an expected call of DeleteListenerWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DeleteListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeleteListenerWithContext), varargs...)
+}
+
+// DenyCustomRoutingTraffic mocks base method.
+func (m *MockGlobalAccelerator) DenyCustomRoutingTraffic(arg0 *globalaccelerator.DenyCustomRoutingTrafficInput) (*globalaccelerator.DenyCustomRoutingTrafficOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyCustomRoutingTraffic", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DenyCustomRoutingTrafficOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenyCustomRoutingTraffic indicates an expected call of DenyCustomRoutingTraffic.
+func (mr *MockGlobalAcceleratorMockRecorder) DenyCustomRoutingTraffic(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyCustomRoutingTraffic", reflect.TypeOf((*MockGlobalAccelerator)(nil).DenyCustomRoutingTraffic), arg0)
+}
+
+// DenyCustomRoutingTrafficRequest mocks base method.
+func (m *MockGlobalAccelerator) DenyCustomRoutingTrafficRequest(arg0 *globalaccelerator.DenyCustomRoutingTrafficInput) (*request.Request, *globalaccelerator.DenyCustomRoutingTrafficOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DenyCustomRoutingTrafficRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DenyCustomRoutingTrafficOutput)
+	return ret0, ret1
+}
+
+// DenyCustomRoutingTrafficRequest indicates an expected call of DenyCustomRoutingTrafficRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DenyCustomRoutingTrafficRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyCustomRoutingTrafficRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DenyCustomRoutingTrafficRequest), arg0)
+}
+
+// DenyCustomRoutingTrafficWithContext mocks base method.
+func (m *MockGlobalAccelerator) DenyCustomRoutingTrafficWithContext(arg0 context.Context, arg1 *globalaccelerator.DenyCustomRoutingTrafficInput, arg2 ...request.Option) (*globalaccelerator.DenyCustomRoutingTrafficOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DenyCustomRoutingTrafficWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DenyCustomRoutingTrafficOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DenyCustomRoutingTrafficWithContext indicates an expected call of DenyCustomRoutingTrafficWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DenyCustomRoutingTrafficWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DenyCustomRoutingTrafficWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DenyCustomRoutingTrafficWithContext), varargs...)
+}
+
+// DeprovisionByoipCidr mocks base method.
+func (m *MockGlobalAccelerator) DeprovisionByoipCidr(arg0 *globalaccelerator.DeprovisionByoipCidrInput) (*globalaccelerator.DeprovisionByoipCidrOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeprovisionByoipCidr", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DeprovisionByoipCidrOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeprovisionByoipCidr indicates an expected call of DeprovisionByoipCidr.
+func (mr *MockGlobalAcceleratorMockRecorder) DeprovisionByoipCidr(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprovisionByoipCidr", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeprovisionByoipCidr), arg0)
+}
+
+// DeprovisionByoipCidrRequest mocks base method.
+func (m *MockGlobalAccelerator) DeprovisionByoipCidrRequest(arg0 *globalaccelerator.DeprovisionByoipCidrInput) (*request.Request, *globalaccelerator.DeprovisionByoipCidrOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeprovisionByoipCidrRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DeprovisionByoipCidrOutput)
+	return ret0, ret1
+}
+
+// DeprovisionByoipCidrRequest indicates an expected call of DeprovisionByoipCidrRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DeprovisionByoipCidrRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprovisionByoipCidrRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeprovisionByoipCidrRequest), arg0)
+}
+
+// DeprovisionByoipCidrWithContext mocks base method.
+func (m *MockGlobalAccelerator) DeprovisionByoipCidrWithContext(arg0 context.Context, arg1 *globalaccelerator.DeprovisionByoipCidrInput, arg2 ...request.Option) (*globalaccelerator.DeprovisionByoipCidrOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeprovisionByoipCidrWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DeprovisionByoipCidrOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeprovisionByoipCidrWithContext indicates an expected call of DeprovisionByoipCidrWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DeprovisionByoipCidrWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprovisionByoipCidrWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DeprovisionByoipCidrWithContext), varargs...)
+}
+
+// DescribeAccelerator mocks base method.
+func (m *MockGlobalAccelerator) DescribeAccelerator(arg0 *globalaccelerator.DescribeAcceleratorInput) (*globalaccelerator.DescribeAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAccelerator", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAccelerator indicates an expected call of DescribeAccelerator.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAccelerator", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeAccelerator), arg0)
+}
+
+// DescribeAcceleratorAttributes mocks base method.
+func (m *MockGlobalAccelerator) DescribeAcceleratorAttributes(arg0 *globalaccelerator.DescribeAcceleratorAttributesInput) (*globalaccelerator.DescribeAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAcceleratorAttributes", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAcceleratorAttributes indicates an expected call of DescribeAcceleratorAttributes.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeAcceleratorAttributes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAcceleratorAttributes", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeAcceleratorAttributes), arg0)
+}
+
+// DescribeAcceleratorAttributesRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeAcceleratorAttributesRequest(arg0 *globalaccelerator.DescribeAcceleratorAttributesInput) (*request.Request, *globalaccelerator.DescribeAcceleratorAttributesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAcceleratorAttributesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeAcceleratorAttributesOutput)
+	return ret0, ret1
+}
+
+// DescribeAcceleratorAttributesRequest indicates an expected call of DescribeAcceleratorAttributesRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeAcceleratorAttributesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAcceleratorAttributesRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeAcceleratorAttributesRequest), arg0)
+}
+
+// DescribeAcceleratorAttributesWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeAcceleratorAttributesWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeAcceleratorAttributesInput, arg2 ...request.Option) (*globalaccelerator.DescribeAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAcceleratorAttributesWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAcceleratorAttributesWithContext indicates an expected call of DescribeAcceleratorAttributesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeAcceleratorAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAcceleratorAttributesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeAcceleratorAttributesWithContext), varargs...)
+}
+
+// DescribeAcceleratorRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeAcceleratorRequest(arg0 *globalaccelerator.DescribeAcceleratorInput) (*request.Request, *globalaccelerator.DescribeAcceleratorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeAcceleratorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeAcceleratorOutput)
+	return ret0, ret1
+}
+
+// DescribeAcceleratorRequest indicates an expected call of DescribeAcceleratorRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeAcceleratorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAcceleratorRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeAcceleratorRequest), arg0)
+}
+
+// DescribeAcceleratorWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeAcceleratorInput, arg2 ...request.Option) (*globalaccelerator.DescribeAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAcceleratorWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAcceleratorWithContext indicates an expected call of DescribeAcceleratorWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeAcceleratorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAcceleratorWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeAcceleratorWithContext), varargs...)
+}
+
+// DescribeCrossAccountAttachment mocks base method.
+func (m *MockGlobalAccelerator) DescribeCrossAccountAttachment(arg0 *globalaccelerator.DescribeCrossAccountAttachmentInput) (*globalaccelerator.DescribeCrossAccountAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCrossAccountAttachment", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCrossAccountAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCrossAccountAttachment indicates an expected call of DescribeCrossAccountAttachment.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCrossAccountAttachment(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCrossAccountAttachment", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCrossAccountAttachment), arg0)
+}
+
+// DescribeCrossAccountAttachmentRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeCrossAccountAttachmentRequest(arg0 *globalaccelerator.DescribeCrossAccountAttachmentInput) (*request.Request, *globalaccelerator.DescribeCrossAccountAttachmentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCrossAccountAttachmentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeCrossAccountAttachmentOutput)
+	return ret0, ret1
+}
+
+// DescribeCrossAccountAttachmentRequest indicates an expected call of DescribeCrossAccountAttachmentRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCrossAccountAttachmentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCrossAccountAttachmentRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCrossAccountAttachmentRequest), arg0)
+}
+
+// DescribeCrossAccountAttachmentWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeCrossAccountAttachmentWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeCrossAccountAttachmentInput, arg2 ...request.Option) (*globalaccelerator.DescribeCrossAccountAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeCrossAccountAttachmentWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCrossAccountAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCrossAccountAttachmentWithContext indicates an expected call of DescribeCrossAccountAttachmentWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCrossAccountAttachmentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCrossAccountAttachmentWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCrossAccountAttachmentWithContext), varargs...)
+}
+
+// DescribeCustomRoutingAccelerator mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingAccelerator(arg0 *globalaccelerator.DescribeCustomRoutingAcceleratorInput) (*globalaccelerator.DescribeCustomRoutingAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingAccelerator", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCustomRoutingAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingAccelerator indicates an expected call of DescribeCustomRoutingAccelerator.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingAccelerator", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingAccelerator), arg0)
+}
+
+// DescribeCustomRoutingAcceleratorAttributes mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingAcceleratorAttributes(arg0 *globalaccelerator.DescribeCustomRoutingAcceleratorAttributesInput) (*globalaccelerator.DescribeCustomRoutingAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingAcceleratorAttributes", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCustomRoutingAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingAcceleratorAttributes indicates an expected call of DescribeCustomRoutingAcceleratorAttributes.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingAcceleratorAttributes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingAcceleratorAttributes", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingAcceleratorAttributes), arg0)
+}
+
+// DescribeCustomRoutingAcceleratorAttributesRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingAcceleratorAttributesRequest(arg0 *globalaccelerator.DescribeCustomRoutingAcceleratorAttributesInput) (*request.Request, *globalaccelerator.DescribeCustomRoutingAcceleratorAttributesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingAcceleratorAttributesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeCustomRoutingAcceleratorAttributesOutput)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingAcceleratorAttributesRequest indicates an expected call of DescribeCustomRoutingAcceleratorAttributesRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingAcceleratorAttributesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingAcceleratorAttributesRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingAcceleratorAttributesRequest), arg0)
+}
+
+// DescribeCustomRoutingAcceleratorAttributesWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingAcceleratorAttributesWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeCustomRoutingAcceleratorAttributesInput, arg2 ...request.Option) (*globalaccelerator.DescribeCustomRoutingAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingAcceleratorAttributesWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCustomRoutingAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingAcceleratorAttributesWithContext indicates an expected call of DescribeCustomRoutingAcceleratorAttributesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingAcceleratorAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingAcceleratorAttributesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingAcceleratorAttributesWithContext), varargs...)
+}
+
+// DescribeCustomRoutingAcceleratorRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingAcceleratorRequest(arg0 *globalaccelerator.DescribeCustomRoutingAcceleratorInput) (*request.Request, *globalaccelerator.DescribeCustomRoutingAcceleratorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingAcceleratorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeCustomRoutingAcceleratorOutput)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingAcceleratorRequest indicates an expected call of DescribeCustomRoutingAcceleratorRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingAcceleratorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingAcceleratorRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingAcceleratorRequest), arg0)
+}
+
+// DescribeCustomRoutingAcceleratorWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeCustomRoutingAcceleratorInput, arg2 ...request.Option) (*globalaccelerator.DescribeCustomRoutingAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingAcceleratorWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCustomRoutingAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingAcceleratorWithContext indicates an expected call of DescribeCustomRoutingAcceleratorWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingAcceleratorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingAcceleratorWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingAcceleratorWithContext), varargs...)
+}
+
+// DescribeCustomRoutingEndpointGroup mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingEndpointGroup(arg0 *globalaccelerator.DescribeCustomRoutingEndpointGroupInput) (*globalaccelerator.DescribeCustomRoutingEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingEndpointGroup", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCustomRoutingEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingEndpointGroup indicates an expected call of DescribeCustomRoutingEndpointGroup.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingEndpointGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingEndpointGroup", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingEndpointGroup), arg0)
+}
+
+// DescribeCustomRoutingEndpointGroupRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingEndpointGroupRequest(arg0 *globalaccelerator.DescribeCustomRoutingEndpointGroupInput) (*request.Request, *globalaccelerator.DescribeCustomRoutingEndpointGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingEndpointGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeCustomRoutingEndpointGroupOutput)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingEndpointGroupRequest indicates an expected call of DescribeCustomRoutingEndpointGroupRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingEndpointGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingEndpointGroupRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingEndpointGroupRequest), arg0)
+}
+
+// DescribeCustomRoutingEndpointGroupWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingEndpointGroupWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeCustomRoutingEndpointGroupInput, arg2 ...request.Option) (*globalaccelerator.DescribeCustomRoutingEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingEndpointGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCustomRoutingEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingEndpointGroupWithContext indicates an expected call of DescribeCustomRoutingEndpointGroupWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingEndpointGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingEndpointGroupWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingEndpointGroupWithContext), varargs...)
+}
+
+// DescribeCustomRoutingListener mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingListener(arg0 *globalaccelerator.DescribeCustomRoutingListenerInput) (*globalaccelerator.DescribeCustomRoutingListenerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingListener", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCustomRoutingListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingListener indicates an expected call of DescribeCustomRoutingListener.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingListener", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingListener), arg0)
+}
+
+// DescribeCustomRoutingListenerRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingListenerRequest(arg0 *globalaccelerator.DescribeCustomRoutingListenerInput) (*request.Request, *globalaccelerator.DescribeCustomRoutingListenerOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingListenerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeCustomRoutingListenerOutput)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingListenerRequest indicates an expected call of DescribeCustomRoutingListenerRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingListenerRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingListenerRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingListenerRequest), arg0)
+}
+
+// DescribeCustomRoutingListenerWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeCustomRoutingListenerWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeCustomRoutingListenerInput, arg2 ...request.Option) (*globalaccelerator.DescribeCustomRoutingListenerOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeCustomRoutingListenerWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeCustomRoutingListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeCustomRoutingListenerWithContext indicates an expected call of DescribeCustomRoutingListenerWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeCustomRoutingListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCustomRoutingListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeCustomRoutingListenerWithContext), varargs...)
+}
+
+// DescribeEndpointGroup mocks base method.
+func (m *MockGlobalAccelerator) DescribeEndpointGroup(arg0 *globalaccelerator.DescribeEndpointGroupInput) (*globalaccelerator.DescribeEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEndpointGroup", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEndpointGroup indicates an expected call of DescribeEndpointGroup.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeEndpointGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEndpointGroup", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeEndpointGroup), arg0)
+}
+
+// DescribeEndpointGroupRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeEndpointGroupRequest(arg0 *globalaccelerator.DescribeEndpointGroupInput) (*request.Request, *globalaccelerator.DescribeEndpointGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeEndpointGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeEndpointGroupOutput)
+	return ret0, ret1
+}
+
+// DescribeEndpointGroupRequest indicates an expected call of DescribeEndpointGroupRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeEndpointGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEndpointGroupRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeEndpointGroupRequest), arg0)
+}
+
+// DescribeEndpointGroupWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeEndpointGroupWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeEndpointGroupInput, arg2 ...request.Option) (*globalaccelerator.DescribeEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeEndpointGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEndpointGroupWithContext indicates an expected call of DescribeEndpointGroupWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeEndpointGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEndpointGroupWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeEndpointGroupWithContext), varargs...)
+}
+
+// DescribeListener mocks base method.
+func (m *MockGlobalAccelerator) DescribeListener(arg0 *globalaccelerator.DescribeListenerInput) (*globalaccelerator.DescribeListenerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeListener", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeListener indicates an expected call of DescribeListener.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeListener", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeListener), arg0)
+}
+
+// DescribeListenerRequest mocks base method.
+func (m *MockGlobalAccelerator) DescribeListenerRequest(arg0 *globalaccelerator.DescribeListenerInput) (*request.Request, *globalaccelerator.DescribeListenerOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeListenerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.DescribeListenerOutput)
+	return ret0, ret1
+}
+
+// DescribeListenerRequest indicates an expected call of DescribeListenerRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeListenerRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeListenerRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeListenerRequest), arg0)
+}
+
+// DescribeListenerWithContext mocks base method.
+func (m *MockGlobalAccelerator) DescribeListenerWithContext(arg0 context.Context, arg1 *globalaccelerator.DescribeListenerInput, arg2 ...request.Option) (*globalaccelerator.DescribeListenerOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeListenerWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.DescribeListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeListenerWithContext indicates an expected call of DescribeListenerWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) DescribeListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).DescribeListenerWithContext), varargs...)
+}
+
+// ListAccelerators mocks base method.
+func (m *MockGlobalAccelerator) ListAccelerators(arg0 *globalaccelerator.ListAcceleratorsInput) (*globalaccelerator.ListAcceleratorsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccelerators", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListAcceleratorsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccelerators indicates an expected call of ListAccelerators.
+func (mr *MockGlobalAcceleratorMockRecorder) ListAccelerators(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccelerators", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListAccelerators), arg0)
+}
+
+// ListAcceleratorsAsList mocks base method.
+func (m *MockGlobalAccelerator) ListAcceleratorsAsList(arg0 context.Context, arg1 *globalaccelerator.ListAcceleratorsInput) ([]*globalaccelerator.Accelerator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAcceleratorsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]*globalaccelerator.Accelerator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAcceleratorsAsList indicates an expected call of ListAcceleratorsAsList.
+func (mr *MockGlobalAcceleratorMockRecorder) ListAcceleratorsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcceleratorsAsList", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListAcceleratorsAsList), arg0, arg1)
+}
+
+// ListAcceleratorsPages mocks base method.
+func (m *MockGlobalAccelerator) ListAcceleratorsPages(arg0 *globalaccelerator.ListAcceleratorsInput, arg1 func(*globalaccelerator.ListAcceleratorsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAcceleratorsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAcceleratorsPages indicates an expected call of ListAcceleratorsPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListAcceleratorsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcceleratorsPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListAcceleratorsPages), arg0, arg1)
+}
+
+// ListAcceleratorsPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListAcceleratorsPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListAcceleratorsInput, arg2 func(*globalaccelerator.ListAcceleratorsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAcceleratorsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListAcceleratorsPagesWithContext indicates an expected call of ListAcceleratorsPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListAcceleratorsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcceleratorsPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListAcceleratorsPagesWithContext), varargs...)
+}
+
+// ListAcceleratorsRequest mocks base method.
+func (m *MockGlobalAccelerator) ListAcceleratorsRequest(arg0 *globalaccelerator.ListAcceleratorsInput) (*request.Request, *globalaccelerator.ListAcceleratorsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAcceleratorsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListAcceleratorsOutput)
+	return ret0, ret1
+}
+
+// ListAcceleratorsRequest indicates an expected call of ListAcceleratorsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListAcceleratorsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcceleratorsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListAcceleratorsRequest), arg0)
+}
+
+// ListAcceleratorsWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListAcceleratorsWithContext(arg0 context.Context, arg1 *globalaccelerator.ListAcceleratorsInput, arg2 ...request.Option) (*globalaccelerator.ListAcceleratorsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAcceleratorsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListAcceleratorsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAcceleratorsWithContext indicates an expected call of ListAcceleratorsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListAcceleratorsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcceleratorsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListAcceleratorsWithContext), varargs...)
+}
+
+// ListByoipCidrs mocks base method.
+func (m *MockGlobalAccelerator) ListByoipCidrs(arg0 *globalaccelerator.ListByoipCidrsInput) (*globalaccelerator.ListByoipCidrsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByoipCidrs", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListByoipCidrsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByoipCidrs indicates an expected call of ListByoipCidrs.
+func (mr *MockGlobalAcceleratorMockRecorder) ListByoipCidrs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByoipCidrs", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListByoipCidrs), arg0)
+}
+
+// ListByoipCidrsPages mocks base method.
+func (m *MockGlobalAccelerator) ListByoipCidrsPages(arg0 *globalaccelerator.ListByoipCidrsInput, arg1 func(*globalaccelerator.ListByoipCidrsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByoipCidrsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListByoipCidrsPages indicates an expected call of ListByoipCidrsPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListByoipCidrsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByoipCidrsPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListByoipCidrsPages), arg0, arg1)
+}
+
+// ListByoipCidrsPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListByoipCidrsPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListByoipCidrsInput, arg2 func(*globalaccelerator.ListByoipCidrsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByoipCidrsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListByoipCidrsPagesWithContext indicates an expected call of ListByoipCidrsPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListByoipCidrsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByoipCidrsPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListByoipCidrsPagesWithContext), varargs...)
+}
+
+// ListByoipCidrsRequest mocks base method.
+func (m *MockGlobalAccelerator) ListByoipCidrsRequest(arg0 *globalaccelerator.ListByoipCidrsInput) (*request.Request, *globalaccelerator.ListByoipCidrsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByoipCidrsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListByoipCidrsOutput)
+	return ret0, ret1
+}
+
+// ListByoipCidrsRequest indicates an expected call of ListByoipCidrsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListByoipCidrsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByoipCidrsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListByoipCidrsRequest), arg0)
+}
+
+// ListByoipCidrsWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListByoipCidrsWithContext(arg0 context.Context, arg1 *globalaccelerator.ListByoipCidrsInput, arg2 ...request.Option) (*globalaccelerator.ListByoipCidrsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByoipCidrsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListByoipCidrsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByoipCidrsWithContext indicates an expected call of ListByoipCidrsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListByoipCidrsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByoipCidrsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListByoipCidrsWithContext), varargs...)
+}
+
+// ListCrossAccountAttachments mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountAttachments(arg0 *globalaccelerator.ListCrossAccountAttachmentsInput) (*globalaccelerator.ListCrossAccountAttachmentsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountAttachments", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListCrossAccountAttachmentsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountAttachments indicates an expected call of ListCrossAccountAttachments.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountAttachments(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAttachments", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountAttachments), arg0)
+}
+
+// ListCrossAccountAttachmentsPages mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountAttachmentsPages(arg0 *globalaccelerator.ListCrossAccountAttachmentsInput, arg1 func(*globalaccelerator.ListCrossAccountAttachmentsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountAttachmentsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCrossAccountAttachmentsPages indicates an expected call of ListCrossAccountAttachmentsPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountAttachmentsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAttachmentsPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountAttachmentsPages), arg0, arg1)
+}
+
+// ListCrossAccountAttachmentsPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountAttachmentsPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCrossAccountAttachmentsInput, arg2 func(*globalaccelerator.ListCrossAccountAttachmentsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCrossAccountAttachmentsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCrossAccountAttachmentsPagesWithContext indicates an expected call of ListCrossAccountAttachmentsPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountAttachmentsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAttachmentsPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountAttachmentsPagesWithContext), varargs...)
+}
+
+// ListCrossAccountAttachmentsRequest mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountAttachmentsRequest(arg0 *globalaccelerator.ListCrossAccountAttachmentsInput) (*request.Request, *globalaccelerator.ListCrossAccountAttachmentsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountAttachmentsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListCrossAccountAttachmentsOutput)
+	return ret0, ret1
+}
+
+// ListCrossAccountAttachmentsRequest indicates an expected call of ListCrossAccountAttachmentsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountAttachmentsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAttachmentsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountAttachmentsRequest), arg0)
+}
+
+// ListCrossAccountAttachmentsWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountAttachmentsWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCrossAccountAttachmentsInput, arg2 ...request.Option) (*globalaccelerator.ListCrossAccountAttachmentsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCrossAccountAttachmentsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListCrossAccountAttachmentsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountAttachmentsWithContext indicates an expected call of ListCrossAccountAttachmentsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountAttachmentsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAttachmentsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountAttachmentsWithContext), varargs...)
+}
+
+// ListCrossAccountResourceAccounts mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResourceAccounts(arg0 *globalaccelerator.ListCrossAccountResourceAccountsInput) (*globalaccelerator.ListCrossAccountResourceAccountsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountResourceAccounts", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListCrossAccountResourceAccountsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountResourceAccounts indicates an expected call of ListCrossAccountResourceAccounts.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResourceAccounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResourceAccounts", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResourceAccounts), arg0)
+}
+
+// ListCrossAccountResourceAccountsRequest mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResourceAccountsRequest(arg0 *globalaccelerator.ListCrossAccountResourceAccountsInput) (*request.Request, *globalaccelerator.ListCrossAccountResourceAccountsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountResourceAccountsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListCrossAccountResourceAccountsOutput)
+	return ret0, ret1
+}
+
+// ListCrossAccountResourceAccountsRequest indicates an expected call of ListCrossAccountResourceAccountsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResourceAccountsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResourceAccountsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResourceAccountsRequest), arg0)
+}
+
+// ListCrossAccountResourceAccountsWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResourceAccountsWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCrossAccountResourceAccountsInput, arg2 ...request.Option) (*globalaccelerator.ListCrossAccountResourceAccountsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCrossAccountResourceAccountsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListCrossAccountResourceAccountsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountResourceAccountsWithContext indicates an expected call of ListCrossAccountResourceAccountsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResourceAccountsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResourceAccountsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResourceAccountsWithContext), varargs...)
+}
+
+// ListCrossAccountResources mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResources(arg0 *globalaccelerator.ListCrossAccountResourcesInput) (*globalaccelerator.ListCrossAccountResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountResources", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListCrossAccountResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountResources indicates an expected call of ListCrossAccountResources.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResources", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResources), arg0)
+}
+
+// ListCrossAccountResourcesPages mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResourcesPages(arg0 *globalaccelerator.ListCrossAccountResourcesInput, arg1 func(*globalaccelerator.ListCrossAccountResourcesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountResourcesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCrossAccountResourcesPages indicates an expected call of ListCrossAccountResourcesPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResourcesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResourcesPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResourcesPages), arg0, arg1)
+}
+
+// ListCrossAccountResourcesPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResourcesPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCrossAccountResourcesInput, arg2 func(*globalaccelerator.ListCrossAccountResourcesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCrossAccountResourcesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCrossAccountResourcesPagesWithContext indicates an expected call of ListCrossAccountResourcesPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResourcesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResourcesPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResourcesPagesWithContext), varargs...)
+}
+
+// ListCrossAccountResourcesRequest mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResourcesRequest(arg0 *globalaccelerator.ListCrossAccountResourcesInput) (*request.Request, *globalaccelerator.ListCrossAccountResourcesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountResourcesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListCrossAccountResourcesOutput)
+	return ret0, ret1
+}
+
+// ListCrossAccountResourcesRequest indicates an expected call of ListCrossAccountResourcesRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResourcesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResourcesRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResourcesRequest), arg0)
+}
+
+// ListCrossAccountResourcesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCrossAccountResourcesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCrossAccountResourcesInput, arg2 ...request.Option) (*globalaccelerator.ListCrossAccountResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCrossAccountResourcesWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListCrossAccountResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountResourcesWithContext indicates an expected call of ListCrossAccountResourcesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCrossAccountResourcesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountResourcesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCrossAccountResourcesWithContext), varargs...)
+}
+
+// ListCustomRoutingAccelerators mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingAccelerators(arg0 *globalaccelerator.ListCustomRoutingAcceleratorsInput) (*globalaccelerator.ListCustomRoutingAcceleratorsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingAccelerators", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingAcceleratorsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingAccelerators indicates an expected call of ListCustomRoutingAccelerators.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingAccelerators(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingAccelerators", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingAccelerators), arg0)
+}
+
+// ListCustomRoutingAcceleratorsPages mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingAcceleratorsPages(arg0 *globalaccelerator.ListCustomRoutingAcceleratorsInput, arg1 func(*globalaccelerator.ListCustomRoutingAcceleratorsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingAcceleratorsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingAcceleratorsPages indicates an expected call of ListCustomRoutingAcceleratorsPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingAcceleratorsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingAcceleratorsPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingAcceleratorsPages), arg0, arg1)
+}
+
+// ListCustomRoutingAcceleratorsPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingAcceleratorsPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingAcceleratorsInput, arg2 func(*globalaccelerator.ListCustomRoutingAcceleratorsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingAcceleratorsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingAcceleratorsPagesWithContext indicates an expected call of ListCustomRoutingAcceleratorsPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingAcceleratorsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingAcceleratorsPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingAcceleratorsPagesWithContext), varargs...)
+}
+
+// ListCustomRoutingAcceleratorsRequest mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingAcceleratorsRequest(arg0 *globalaccelerator.ListCustomRoutingAcceleratorsInput) (*request.Request, *globalaccelerator.ListCustomRoutingAcceleratorsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingAcceleratorsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListCustomRoutingAcceleratorsOutput)
+	return ret0, ret1
+}
+
+// ListCustomRoutingAcceleratorsRequest indicates an expected call of ListCustomRoutingAcceleratorsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingAcceleratorsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingAcceleratorsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingAcceleratorsRequest), arg0)
+}
+
+// ListCustomRoutingAcceleratorsWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingAcceleratorsWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingAcceleratorsInput, arg2 ...request.Option) (*globalaccelerator.ListCustomRoutingAcceleratorsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingAcceleratorsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingAcceleratorsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingAcceleratorsWithContext indicates an expected call of ListCustomRoutingAcceleratorsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingAcceleratorsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingAcceleratorsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingAcceleratorsWithContext), varargs...)
+}
+
+// ListCustomRoutingEndpointGroups mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingEndpointGroups(arg0 *globalaccelerator.ListCustomRoutingEndpointGroupsInput) (*globalaccelerator.ListCustomRoutingEndpointGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingEndpointGroups", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingEndpointGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingEndpointGroups indicates an expected call of ListCustomRoutingEndpointGroups.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingEndpointGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingEndpointGroups", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingEndpointGroups), arg0)
+}
+
+// ListCustomRoutingEndpointGroupsPages mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingEndpointGroupsPages(arg0 *globalaccelerator.ListCustomRoutingEndpointGroupsInput, arg1 func(*globalaccelerator.ListCustomRoutingEndpointGroupsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingEndpointGroupsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingEndpointGroupsPages indicates an expected call of ListCustomRoutingEndpointGroupsPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingEndpointGroupsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingEndpointGroupsPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingEndpointGroupsPages), arg0, arg1)
+}
+
+// ListCustomRoutingEndpointGroupsPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingEndpointGroupsPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingEndpointGroupsInput, arg2 func(*globalaccelerator.ListCustomRoutingEndpointGroupsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingEndpointGroupsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingEndpointGroupsPagesWithContext indicates an expected call of ListCustomRoutingEndpointGroupsPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingEndpointGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingEndpointGroupsPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingEndpointGroupsPagesWithContext), varargs...)
+}
+
+// ListCustomRoutingEndpointGroupsRequest mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingEndpointGroupsRequest(arg0 *globalaccelerator.ListCustomRoutingEndpointGroupsInput) (*request.Request, *globalaccelerator.ListCustomRoutingEndpointGroupsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingEndpointGroupsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListCustomRoutingEndpointGroupsOutput)
+	return ret0, ret1
+}
+
+// ListCustomRoutingEndpointGroupsRequest indicates an expected call of ListCustomRoutingEndpointGroupsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingEndpointGroupsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingEndpointGroupsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingEndpointGroupsRequest), arg0)
+}
+
+// ListCustomRoutingEndpointGroupsWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingEndpointGroupsWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingEndpointGroupsInput, arg2 ...request.Option) (*globalaccelerator.ListCustomRoutingEndpointGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingEndpointGroupsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingEndpointGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingEndpointGroupsWithContext indicates an expected call of ListCustomRoutingEndpointGroupsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingEndpointGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingEndpointGroupsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingEndpointGroupsWithContext), varargs...)
+}
+
+// ListCustomRoutingListeners mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingListeners(arg0 *globalaccelerator.ListCustomRoutingListenersInput) (*globalaccelerator.ListCustomRoutingListenersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingListeners", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingListenersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingListeners indicates an expected call of ListCustomRoutingListeners.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingListeners(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingListeners", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingListeners), arg0)
+}
+
+// ListCustomRoutingListenersPages mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingListenersPages(arg0 *globalaccelerator.ListCustomRoutingListenersInput, arg1 func(*globalaccelerator.ListCustomRoutingListenersOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingListenersPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingListenersPages indicates an expected call of ListCustomRoutingListenersPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingListenersPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingListenersPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingListenersPages), arg0, arg1)
+}
+
+// ListCustomRoutingListenersPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingListenersPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingListenersInput, arg2 func(*globalaccelerator.ListCustomRoutingListenersOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingListenersPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingListenersPagesWithContext indicates an expected call of ListCustomRoutingListenersPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingListenersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingListenersPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingListenersPagesWithContext), varargs...)
+}
+
+// ListCustomRoutingListenersRequest mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingListenersRequest(arg0 *globalaccelerator.ListCustomRoutingListenersInput) (*request.Request, *globalaccelerator.ListCustomRoutingListenersOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingListenersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListCustomRoutingListenersOutput)
+	return ret0, ret1
+}
+
+// ListCustomRoutingListenersRequest indicates an expected call of ListCustomRoutingListenersRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingListenersRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingListenersRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingListenersRequest), arg0)
+}
+
+// ListCustomRoutingListenersWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingListenersWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingListenersInput, arg2 ...request.Option) (*globalaccelerator.ListCustomRoutingListenersOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingListenersWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingListenersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingListenersWithContext indicates an expected call of ListCustomRoutingListenersWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingListenersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingListenersWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingListenersWithContext), varargs...)
+}
+
+// ListCustomRoutingPortMappings mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappings(arg0 *globalaccelerator.ListCustomRoutingPortMappingsInput) (*globalaccelerator.ListCustomRoutingPortMappingsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappings", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingPortMappingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingPortMappings indicates an expected call of ListCustomRoutingPortMappings.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappings(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappings", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappings), arg0)
+}
+
+// ListCustomRoutingPortMappingsByDestination mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsByDestination(arg0 *globalaccelerator.ListCustomRoutingPortMappingsByDestinationInput) (*globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsByDestination", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingPortMappingsByDestination indicates an expected call of ListCustomRoutingPortMappingsByDestination.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsByDestination(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsByDestination", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsByDestination), arg0)
+}
+
+// ListCustomRoutingPortMappingsByDestinationPages mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsByDestinationPages(arg0 *globalaccelerator.ListCustomRoutingPortMappingsByDestinationInput, arg1 func(*globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsByDestinationPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingPortMappingsByDestinationPages indicates an expected call of ListCustomRoutingPortMappingsByDestinationPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsByDestinationPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsByDestinationPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsByDestinationPages), arg0, arg1)
+}
+
+// ListCustomRoutingPortMappingsByDestinationPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsByDestinationPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingPortMappingsByDestinationInput, arg2 func(*globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsByDestinationPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingPortMappingsByDestinationPagesWithContext indicates an expected call of ListCustomRoutingPortMappingsByDestinationPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsByDestinationPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsByDestinationPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsByDestinationPagesWithContext), varargs...)
+}
+
+// ListCustomRoutingPortMappingsByDestinationRequest mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsByDestinationRequest(arg0 *globalaccelerator.ListCustomRoutingPortMappingsByDestinationInput) (*request.Request, *globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsByDestinationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput)
+	return ret0, ret1
+}
+
+// ListCustomRoutingPortMappingsByDestinationRequest indicates an expected call of ListCustomRoutingPortMappingsByDestinationRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsByDestinationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsByDestinationRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsByDestinationRequest), arg0)
+}
+
+// ListCustomRoutingPortMappingsByDestinationWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsByDestinationWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingPortMappingsByDestinationInput, arg2 ...request.Option) (*globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsByDestinationWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingPortMappingsByDestinationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingPortMappingsByDestinationWithContext indicates an expected call of ListCustomRoutingPortMappingsByDestinationWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsByDestinationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsByDestinationWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsByDestinationWithContext), varargs...)
+}
+
+// ListCustomRoutingPortMappingsPages mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsPages(arg0 *globalaccelerator.ListCustomRoutingPortMappingsInput, arg1 func(*globalaccelerator.ListCustomRoutingPortMappingsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingPortMappingsPages indicates an expected call of ListCustomRoutingPortMappingsPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsPages), arg0, arg1)
+}
+
+// ListCustomRoutingPortMappingsPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingPortMappingsInput, arg2 func(*globalaccelerator.ListCustomRoutingPortMappingsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCustomRoutingPortMappingsPagesWithContext indicates an expected call of ListCustomRoutingPortMappingsPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsPagesWithContext), varargs...)
+}
+
+// ListCustomRoutingPortMappingsRequest mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsRequest(arg0 *globalaccelerator.ListCustomRoutingPortMappingsInput) (*request.Request, *globalaccelerator.ListCustomRoutingPortMappingsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListCustomRoutingPortMappingsOutput)
+	return ret0, ret1
+}
+
+// ListCustomRoutingPortMappingsRequest indicates an expected call of ListCustomRoutingPortMappingsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsRequest), arg0)
+}
+
+// ListCustomRoutingPortMappingsWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListCustomRoutingPortMappingsWithContext(arg0 context.Context, arg1 *globalaccelerator.ListCustomRoutingPortMappingsInput, arg2 ...request.Option) (*globalaccelerator.ListCustomRoutingPortMappingsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomRoutingPortMappingsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListCustomRoutingPortMappingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomRoutingPortMappingsWithContext indicates an expected call of ListCustomRoutingPortMappingsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListCustomRoutingPortMappingsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomRoutingPortMappingsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListCustomRoutingPortMappingsWithContext), varargs...)
+}
+
+// ListEndpointGroups mocks base method.
+func (m *MockGlobalAccelerator) ListEndpointGroups(arg0 *globalaccelerator.ListEndpointGroupsInput) (*globalaccelerator.ListEndpointGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpointGroups", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListEndpointGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndpointGroups indicates an expected call of ListEndpointGroups.
+func (mr *MockGlobalAcceleratorMockRecorder) ListEndpointGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointGroups", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListEndpointGroups), arg0)
+}
+
+// ListEndpointGroupsAsList mocks base method.
+func (m *MockGlobalAccelerator) ListEndpointGroupsAsList(arg0 context.Context, arg1 *globalaccelerator.ListEndpointGroupsInput) ([]*globalaccelerator.EndpointGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpointGroupsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]*globalaccelerator.EndpointGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndpointGroupsAsList indicates an expected call of ListEndpointGroupsAsList.
+func (mr *MockGlobalAcceleratorMockRecorder) ListEndpointGroupsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointGroupsAsList", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListEndpointGroupsAsList), arg0, arg1)
+}
+
+// ListEndpointGroupsPages mocks base method.
+func (m *MockGlobalAccelerator) ListEndpointGroupsPages(arg0 *globalaccelerator.ListEndpointGroupsInput, arg1 func(*globalaccelerator.ListEndpointGroupsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpointGroupsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListEndpointGroupsPages indicates an expected call of ListEndpointGroupsPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListEndpointGroupsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointGroupsPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListEndpointGroupsPages), arg0, arg1)
+}
+
+// ListEndpointGroupsPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListEndpointGroupsPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListEndpointGroupsInput, arg2 func(*globalaccelerator.ListEndpointGroupsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEndpointGroupsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListEndpointGroupsPagesWithContext indicates an expected call of ListEndpointGroupsPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListEndpointGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointGroupsPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListEndpointGroupsPagesWithContext), varargs...)
+}
+
+// ListEndpointGroupsRequest mocks base method.
+func (m *MockGlobalAccelerator) ListEndpointGroupsRequest(arg0 *globalaccelerator.ListEndpointGroupsInput) (*request.Request, *globalaccelerator.ListEndpointGroupsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpointGroupsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListEndpointGroupsOutput)
+	return ret0, ret1
+}
+
+// ListEndpointGroupsRequest indicates an expected call of ListEndpointGroupsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListEndpointGroupsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointGroupsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListEndpointGroupsRequest), arg0)
+}
+
+// ListEndpointGroupsWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListEndpointGroupsWithContext(arg0 context.Context, arg1 *globalaccelerator.ListEndpointGroupsInput, arg2 ...request.Option) (*globalaccelerator.ListEndpointGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEndpointGroupsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListEndpointGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndpointGroupsWithContext indicates an expected call of ListEndpointGroupsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListEndpointGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointGroupsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListEndpointGroupsWithContext), varargs...)
+}
+
+// ListListeners mocks base method.
+func (m *MockGlobalAccelerator) ListListeners(arg0 *globalaccelerator.ListListenersInput) (*globalaccelerator.ListListenersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListListeners", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListListenersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListListeners indicates an expected call of ListListeners.
+func (mr *MockGlobalAcceleratorMockRecorder) ListListeners(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListListeners", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListListeners), arg0)
+}
+
+// ListListenersAsList mocks base method.
+func (m *MockGlobalAccelerator) ListListenersAsList(arg0 context.Context, arg1 *globalaccelerator.ListListenersInput) ([]*globalaccelerator.Listener, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListListenersAsList", arg0, arg1)
+	ret0, _ := ret[0].([]*globalaccelerator.Listener)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListListenersAsList indicates an expected call of ListListenersAsList.
+func (mr *MockGlobalAcceleratorMockRecorder) ListListenersAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListListenersAsList", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListListenersAsList), arg0, arg1)
+}
+
+// ListListenersPages mocks base method.
+func (m *MockGlobalAccelerator) ListListenersPages(arg0 *globalaccelerator.ListListenersInput, arg1 func(*globalaccelerator.ListListenersOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListListenersPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListListenersPages indicates an expected call of ListListenersPages.
+func (mr *MockGlobalAcceleratorMockRecorder) ListListenersPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListListenersPages", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListListenersPages), arg0, arg1)
+}
+
+// ListListenersPagesWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListListenersPagesWithContext(arg0 context.Context, arg1 *globalaccelerator.ListListenersInput, arg2 func(*globalaccelerator.ListListenersOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListListenersPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListListenersPagesWithContext indicates an expected call of ListListenersPagesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListListenersPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListListenersPagesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListListenersPagesWithContext), varargs...)
+}
+
+// ListListenersRequest mocks base method.
+func (m *MockGlobalAccelerator) ListListenersRequest(arg0 *globalaccelerator.ListListenersInput) (*request.Request, *globalaccelerator.ListListenersOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListListenersRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListListenersOutput)
+	return ret0, ret1
+}
+
+// ListListenersRequest indicates an expected call of ListListenersRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListListenersRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListListenersRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListListenersRequest), arg0)
+}
+
+// ListListenersWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListListenersWithContext(arg0 context.Context, arg1 *globalaccelerator.ListListenersInput, arg2 ...request.Option) (*globalaccelerator.ListListenersOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListListenersWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListListenersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListListenersWithContext indicates an expected call of ListListenersWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListListenersWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListListenersWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListListenersWithContext), varargs...)
+}
+
+// ListTagsForResource mocks base method.
+func (m *MockGlobalAccelerator) ListTagsForResource(arg0 *globalaccelerator.ListTagsForResourceInput) (*globalaccelerator.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResource", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResource indicates an expected call of ListTagsForResource.
+func (mr *MockGlobalAcceleratorMockRecorder) ListTagsForResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResource", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListTagsForResource), arg0)
+}
+
+// ListTagsForResourceRequest mocks base method.
+func (m *MockGlobalAccelerator) ListTagsForResourceRequest(arg0 *globalaccelerator.ListTagsForResourceInput) (*request.Request, *globalaccelerator.ListTagsForResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ListTagsForResourceOutput)
+	return ret0, ret1
+}
+
+// ListTagsForResourceRequest indicates an expected call of ListTagsForResourceRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ListTagsForResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListTagsForResourceRequest), arg0)
+}
+
+// ListTagsForResourceWithContext mocks base method.
+func (m *MockGlobalAccelerator) ListTagsForResourceWithContext(arg0 context.Context, arg1 *globalaccelerator.ListTagsForResourceInput, arg2 ...request.Option) (*globalaccelerator.ListTagsForResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsForResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ListTagsForResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResourceWithContext indicates an expected call of ListTagsForResourceWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ListTagsForResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourceWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ListTagsForResourceWithContext), varargs...)
+}
+
+// ProvisionByoipCidr mocks base method.
+func (m *MockGlobalAccelerator) ProvisionByoipCidr(arg0 *globalaccelerator.ProvisionByoipCidrInput) (*globalaccelerator.ProvisionByoipCidrOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionByoipCidr", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.ProvisionByoipCidrOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionByoipCidr indicates an expected call of ProvisionByoipCidr.
+func (mr *MockGlobalAcceleratorMockRecorder) ProvisionByoipCidr(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionByoipCidr", reflect.TypeOf((*MockGlobalAccelerator)(nil).ProvisionByoipCidr), arg0)
+}
+
+// ProvisionByoipCidrRequest mocks base method.
+func (m *MockGlobalAccelerator) ProvisionByoipCidrRequest(arg0 *globalaccelerator.ProvisionByoipCidrInput) (*request.Request, *globalaccelerator.ProvisionByoipCidrOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionByoipCidrRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.ProvisionByoipCidrOutput)
+	return ret0, ret1
+}
+
+// ProvisionByoipCidrRequest indicates an expected call of ProvisionByoipCidrRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) ProvisionByoipCidrRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionByoipCidrRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).ProvisionByoipCidrRequest), arg0)
+}
+
+// ProvisionByoipCidrWithContext mocks base method.
+func (m *MockGlobalAccelerator) ProvisionByoipCidrWithContext(arg0 context.Context, arg1 *globalaccelerator.ProvisionByoipCidrInput, arg2 ...request.Option) (*globalaccelerator.ProvisionByoipCidrOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProvisionByoipCidrWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.ProvisionByoipCidrOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionByoipCidrWithContext indicates an expected call of ProvisionByoipCidrWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) ProvisionByoipCidrWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionByoipCidrWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).ProvisionByoipCidrWithContext), varargs...)
+}
+
+// RemoveCustomRoutingEndpoints mocks base method.
+func (m *MockGlobalAccelerator) RemoveCustomRoutingEndpoints(arg0 *globalaccelerator.RemoveCustomRoutingEndpointsInput) (*globalaccelerator.RemoveCustomRoutingEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCustomRoutingEndpoints", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.RemoveCustomRoutingEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCustomRoutingEndpoints indicates an expected call of RemoveCustomRoutingEndpoints.
+func (mr *MockGlobalAcceleratorMockRecorder) RemoveCustomRoutingEndpoints(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCustomRoutingEndpoints", reflect.TypeOf((*MockGlobalAccelerator)(nil).RemoveCustomRoutingEndpoints), arg0)
+}
+
+// RemoveCustomRoutingEndpointsRequest mocks base method.
+func (m *MockGlobalAccelerator) RemoveCustomRoutingEndpointsRequest(arg0 *globalaccelerator.RemoveCustomRoutingEndpointsInput) (*request.Request, *globalaccelerator.RemoveCustomRoutingEndpointsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCustomRoutingEndpointsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.RemoveCustomRoutingEndpointsOutput)
+	return ret0, ret1
+}
+
+// RemoveCustomRoutingEndpointsRequest indicates an expected call of RemoveCustomRoutingEndpointsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) RemoveCustomRoutingEndpointsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCustomRoutingEndpointsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).RemoveCustomRoutingEndpointsRequest), arg0)
+}
+
+// RemoveCustomRoutingEndpointsWithContext mocks base method.
+func (m *MockGlobalAccelerator) RemoveCustomRoutingEndpointsWithContext(arg0 context.Context, arg1 *globalaccelerator.RemoveCustomRoutingEndpointsInput, arg2 ...request.Option) (*globalaccelerator.RemoveCustomRoutingEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveCustomRoutingEndpointsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.RemoveCustomRoutingEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCustomRoutingEndpointsWithContext indicates an expected call of RemoveCustomRoutingEndpointsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) RemoveCustomRoutingEndpointsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCustomRoutingEndpointsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).RemoveCustomRoutingEndpointsWithContext), varargs...)
+}
+
+// RemoveEndpoints mocks base method.
+func (m *MockGlobalAccelerator) RemoveEndpoints(arg0 *globalaccelerator.RemoveEndpointsInput) (*globalaccelerator.RemoveEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEndpoints", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.RemoveEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEndpoints indicates an expected call of RemoveEndpoints.
+func (mr *MockGlobalAcceleratorMockRecorder) RemoveEndpoints(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEndpoints", reflect.TypeOf((*MockGlobalAccelerator)(nil).RemoveEndpoints), arg0)
+}
+
+// RemoveEndpointsRequest mocks base method.
+func (m *MockGlobalAccelerator) RemoveEndpointsRequest(arg0 *globalaccelerator.RemoveEndpointsInput) (*request.Request, *globalaccelerator.RemoveEndpointsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEndpointsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.RemoveEndpointsOutput)
+	return ret0, ret1
+}
+
+// RemoveEndpointsRequest indicates an expected call of RemoveEndpointsRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) RemoveEndpointsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEndpointsRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).RemoveEndpointsRequest), arg0)
+}
+
+// RemoveEndpointsWithContext mocks base method.
+func (m *MockGlobalAccelerator) RemoveEndpointsWithContext(arg0 context.Context, arg1 *globalaccelerator.RemoveEndpointsInput, arg2 ...request.Option) (*globalaccelerator.RemoveEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveEndpointsWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.RemoveEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEndpointsWithContext indicates an expected call of RemoveEndpointsWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) RemoveEndpointsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEndpointsWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).RemoveEndpointsWithContext), varargs...)
+}
+
+// TagResource mocks base method.
+func (m *MockGlobalAccelerator) TagResource(arg0 *globalaccelerator.TagResourceInput) (*globalaccelerator.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResource", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResource indicates an expected call of TagResource.
+func (mr *MockGlobalAcceleratorMockRecorder) TagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResource", reflect.TypeOf((*MockGlobalAccelerator)(nil).TagResource), arg0)
+}
+
+// TagResourceRequest mocks base method.
+func (m *MockGlobalAccelerator) TagResourceRequest(arg0 *globalaccelerator.TagResourceInput) (*request.Request, *globalaccelerator.TagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.TagResourceOutput)
+	return ret0, ret1
+}
+
+// TagResourceRequest indicates an expected call of TagResourceRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) TagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).TagResourceRequest), arg0)
+}
+
+// TagResourceWithContext mocks base method.
+func (m *MockGlobalAccelerator) TagResourceWithContext(arg0 context.Context, arg1 *globalaccelerator.TagResourceInput, arg2 ...request.Option) (*globalaccelerator.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResourceWithContext indicates an expected call of TagResourceWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) TagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).TagResourceWithContext), varargs...)
+}
+
+// UntagResource mocks base method.
+func (m *MockGlobalAccelerator) UntagResource(arg0 *globalaccelerator.UntagResourceInput) (*globalaccelerator.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResource", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResource indicates an expected call of UntagResource.
+func (mr *MockGlobalAcceleratorMockRecorder) UntagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockGlobalAccelerator)(nil).UntagResource), arg0)
+}
+
+// UntagResourceRequest mocks base method.
+func (m *MockGlobalAccelerator) UntagResourceRequest(arg0 *globalaccelerator.UntagResourceInput) (*request.Request, *globalaccelerator.UntagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UntagResourceOutput)
+	return ret0, ret1
+}
+
+// UntagResourceRequest indicates an expected call of UntagResourceRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UntagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UntagResourceRequest), arg0)
+}
+
+// UntagResourceWithContext mocks base method.
+func (m *MockGlobalAccelerator) UntagResourceWithContext(arg0 context.Context, arg1 *globalaccelerator.UntagResourceInput, arg2 ...request.Option) (*globalaccelerator.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UntagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResourceWithContext indicates an expected call of UntagResourceWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UntagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UntagResourceWithContext), varargs...)
+}
+
+// UpdateAccelerator mocks base method.
+func (m *MockGlobalAccelerator) UpdateAccelerator(arg0 *globalaccelerator.UpdateAcceleratorInput) (*globalaccelerator.UpdateAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccelerator", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccelerator indicates an expected call of UpdateAccelerator.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccelerator", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateAccelerator), arg0)
+}
+
+// UpdateAcceleratorAttributes mocks base method.
+func (m *MockGlobalAccelerator) UpdateAcceleratorAttributes(arg0 *globalaccelerator.UpdateAcceleratorAttributesInput) (*globalaccelerator.UpdateAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAcceleratorAttributes", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAcceleratorAttributes indicates an expected call of UpdateAcceleratorAttributes.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateAcceleratorAttributes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAcceleratorAttributes", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateAcceleratorAttributes), arg0)
+}
+
+// UpdateAcceleratorAttributesRequest mocks base method.
+func (m *MockGlobalAccelerator) UpdateAcceleratorAttributesRequest(arg0 *globalaccelerator.UpdateAcceleratorAttributesInput) (*request.Request, *globalaccelerator.UpdateAcceleratorAttributesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAcceleratorAttributesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UpdateAcceleratorAttributesOutput)
+	return ret0, ret1
+}
+
+// UpdateAcceleratorAttributesRequest indicates an expected call of UpdateAcceleratorAttributesRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateAcceleratorAttributesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAcceleratorAttributesRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateAcceleratorAttributesRequest), arg0)
+}
+
+// UpdateAcceleratorAttributesWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateAcceleratorAttributesWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateAcceleratorAttributesInput, arg2 ...request.Option) (*globalaccelerator.UpdateAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAcceleratorAttributesWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAcceleratorAttributesWithContext indicates an expected call of UpdateAcceleratorAttributesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateAcceleratorAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAcceleratorAttributesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateAcceleratorAttributesWithContext), varargs...)
+}
+
+// UpdateAcceleratorRequest mocks base method.
+func (m *MockGlobalAccelerator) UpdateAcceleratorRequest(arg0 *globalaccelerator.UpdateAcceleratorInput) (*request.Request, *globalaccelerator.UpdateAcceleratorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAcceleratorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UpdateAcceleratorOutput)
+	return ret0, ret1
+}
+
+// UpdateAcceleratorRequest indicates an expected call of UpdateAcceleratorRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateAcceleratorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAcceleratorRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateAcceleratorRequest), arg0)
+}
+
+// UpdateAcceleratorWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateAcceleratorInput, arg2 ...request.Option) (*globalaccelerator.UpdateAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAcceleratorWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAcceleratorWithContext indicates an expected call of UpdateAcceleratorWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateAcceleratorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAcceleratorWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateAcceleratorWithContext), varargs...)
+}
+
+// UpdateCrossAccountAttachment mocks base method.
+func (m *MockGlobalAccelerator) UpdateCrossAccountAttachment(arg0 *globalaccelerator.UpdateCrossAccountAttachmentInput) (*globalaccelerator.UpdateCrossAccountAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCrossAccountAttachment", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateCrossAccountAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCrossAccountAttachment indicates an expected call of UpdateCrossAccountAttachment.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCrossAccountAttachment(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCrossAccountAttachment", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCrossAccountAttachment), arg0)
+}
+
+// UpdateCrossAccountAttachmentRequest mocks base method.
+func (m *MockGlobalAccelerator) UpdateCrossAccountAttachmentRequest(arg0 *globalaccelerator.UpdateCrossAccountAttachmentInput) (*request.Request, *globalaccelerator.UpdateCrossAccountAttachmentOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCrossAccountAttachmentRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UpdateCrossAccountAttachmentOutput)
+	return ret0, ret1
+}
+
+// UpdateCrossAccountAttachmentRequest indicates an expected call of UpdateCrossAccountAttachmentRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCrossAccountAttachmentRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCrossAccountAttachmentRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCrossAccountAttachmentRequest), arg0)
+}
+
+// UpdateCrossAccountAttachmentWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateCrossAccountAttachmentWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateCrossAccountAttachmentInput, arg2 ...request.Option) (*globalaccelerator.UpdateCrossAccountAttachmentOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCrossAccountAttachmentWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateCrossAccountAttachmentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCrossAccountAttachmentWithContext indicates an expected call of UpdateCrossAccountAttachmentWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCrossAccountAttachmentWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCrossAccountAttachmentWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCrossAccountAttachmentWithContext), varargs...)
+}
+
+// UpdateCustomRoutingAccelerator mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingAccelerator(arg0 *globalaccelerator.UpdateCustomRoutingAcceleratorInput) (*globalaccelerator.UpdateCustomRoutingAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingAccelerator", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateCustomRoutingAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingAccelerator indicates an expected call of UpdateCustomRoutingAccelerator.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingAccelerator(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingAccelerator", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingAccelerator), arg0)
+}
+
+// UpdateCustomRoutingAcceleratorAttributes mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingAcceleratorAttributes(arg0 *globalaccelerator.UpdateCustomRoutingAcceleratorAttributesInput) (*globalaccelerator.UpdateCustomRoutingAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingAcceleratorAttributes", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateCustomRoutingAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingAcceleratorAttributes indicates an expected call of UpdateCustomRoutingAcceleratorAttributes.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingAcceleratorAttributes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingAcceleratorAttributes", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingAcceleratorAttributes), arg0)
+}
+
+// UpdateCustomRoutingAcceleratorAttributesRequest mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingAcceleratorAttributesRequest(arg0 *globalaccelerator.UpdateCustomRoutingAcceleratorAttributesInput) (*request.Request, *globalaccelerator.UpdateCustomRoutingAcceleratorAttributesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingAcceleratorAttributesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UpdateCustomRoutingAcceleratorAttributesOutput)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingAcceleratorAttributesRequest indicates an expected call of UpdateCustomRoutingAcceleratorAttributesRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingAcceleratorAttributesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingAcceleratorAttributesRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingAcceleratorAttributesRequest), arg0)
+}
+
+// UpdateCustomRoutingAcceleratorAttributesWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingAcceleratorAttributesWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateCustomRoutingAcceleratorAttributesInput, arg2 ...request.Option) (*globalaccelerator.UpdateCustomRoutingAcceleratorAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingAcceleratorAttributesWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateCustomRoutingAcceleratorAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingAcceleratorAttributesWithContext indicates an expected call of UpdateCustomRoutingAcceleratorAttributesWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingAcceleratorAttributesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingAcceleratorAttributesWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingAcceleratorAttributesWithContext), varargs...)
+}
+
+// UpdateCustomRoutingAcceleratorRequest mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingAcceleratorRequest(arg0 *globalaccelerator.UpdateCustomRoutingAcceleratorInput) (*request.Request, *globalaccelerator.UpdateCustomRoutingAcceleratorOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingAcceleratorRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UpdateCustomRoutingAcceleratorOutput)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingAcceleratorRequest indicates an expected call of UpdateCustomRoutingAcceleratorRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingAcceleratorRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingAcceleratorRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingAcceleratorRequest), arg0)
+}
+
+// UpdateCustomRoutingAcceleratorWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingAcceleratorWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateCustomRoutingAcceleratorInput, arg2 ...request.Option) (*globalaccelerator.UpdateCustomRoutingAcceleratorOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingAcceleratorWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateCustomRoutingAcceleratorOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingAcceleratorWithContext indicates an expected call of UpdateCustomRoutingAcceleratorWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingAcceleratorWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingAcceleratorWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingAcceleratorWithContext), varargs...)
+}
+
+// UpdateCustomRoutingListener mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingListener(arg0 *globalaccelerator.UpdateCustomRoutingListenerInput) (*globalaccelerator.UpdateCustomRoutingListenerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingListener", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateCustomRoutingListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingListener indicates an expected call of UpdateCustomRoutingListener.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingListener", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingListener), arg0)
+}
+
+// UpdateCustomRoutingListenerRequest mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingListenerRequest(arg0 *globalaccelerator.UpdateCustomRoutingListenerInput) (*request.Request, *globalaccelerator.UpdateCustomRoutingListenerOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingListenerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UpdateCustomRoutingListenerOutput)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingListenerRequest indicates an expected call of UpdateCustomRoutingListenerRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingListenerRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingListenerRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingListenerRequest), arg0)
+}
+
+// UpdateCustomRoutingListenerWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateCustomRoutingListenerWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateCustomRoutingListenerInput, arg2 ...request.Option) (*globalaccelerator.UpdateCustomRoutingListenerOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCustomRoutingListenerWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateCustomRoutingListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomRoutingListenerWithContext indicates an expected call of UpdateCustomRoutingListenerWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateCustomRoutingListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomRoutingListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateCustomRoutingListenerWithContext), varargs...)
+}
+
+// UpdateEndpointGroup mocks base method.
+func (m *MockGlobalAccelerator) UpdateEndpointGroup(arg0 *globalaccelerator.UpdateEndpointGroupInput) (*globalaccelerator.UpdateEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEndpointGroup", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEndpointGroup indicates an expected call of UpdateEndpointGroup.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateEndpointGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEndpointGroup", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateEndpointGroup), arg0)
+}
+
+// UpdateEndpointGroupRequest mocks base method.
+func (m *MockGlobalAccelerator) UpdateEndpointGroupRequest(arg0 *globalaccelerator.UpdateEndpointGroupInput) (*request.Request, *globalaccelerator.UpdateEndpointGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEndpointGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UpdateEndpointGroupOutput)
+	return ret0, ret1
+}
+
+// UpdateEndpointGroupRequest indicates an expected call of UpdateEndpointGroupRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateEndpointGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEndpointGroupRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateEndpointGroupRequest), arg0)
+}
+
+// UpdateEndpointGroupWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateEndpointGroupWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateEndpointGroupInput, arg2 ...request.Option) (*globalaccelerator.UpdateEndpointGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateEndpointGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateEndpointGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEndpointGroupWithContext indicates an expected call of UpdateEndpointGroupWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateEndpointGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEndpointGroupWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateEndpointGroupWithContext), varargs...)
+}
+
+// UpdateListener mocks base method.
+func (m *MockGlobalAccelerator) UpdateListener(arg0 *globalaccelerator.UpdateListenerInput) (*globalaccelerator.UpdateListenerOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListener", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateListener indicates an expected call of UpdateListener.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateListener(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListener", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateListener), arg0)
+}
+
+// UpdateListenerRequest mocks base method.
+func (m *MockGlobalAccelerator) UpdateListenerRequest(arg0 *globalaccelerator.UpdateListenerInput) (*request.Request, *globalaccelerator.UpdateListenerOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateListenerRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.UpdateListenerOutput)
+	return ret0, ret1
+}
+
+// UpdateListenerRequest indicates an expected call of UpdateListenerRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateListenerRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListenerRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateListenerRequest), arg0)
+}
+
+// UpdateListenerWithContext mocks base method.
+func (m *MockGlobalAccelerator) UpdateListenerWithContext(arg0 context.Context, arg1 *globalaccelerator.UpdateListenerInput, arg2 ...request.Option) (*globalaccelerator.UpdateListenerOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateListenerWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.UpdateListenerOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateListenerWithContext indicates an expected call of UpdateListenerWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) UpdateListenerWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListenerWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).UpdateListenerWithContext), varargs...)
+}
+
+// WithdrawByoipCidr mocks base method.
+func (m *MockGlobalAccelerator) WithdrawByoipCidr(arg0 *globalaccelerator.WithdrawByoipCidrInput) (*globalaccelerator.WithdrawByoipCidrOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawByoipCidr", arg0)
+	ret0, _ := ret[0].(*globalaccelerator.WithdrawByoipCidrOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawByoipCidr indicates an expected call of WithdrawByoipCidr.
+func (mr *MockGlobalAcceleratorMockRecorder) WithdrawByoipCidr(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawByoipCidr", reflect.TypeOf((*MockGlobalAccelerator)(nil).WithdrawByoipCidr), arg0)
+}
+
+// WithdrawByoipCidrRequest mocks base method.
+func (m *MockGlobalAccelerator) WithdrawByoipCidrRequest(arg0 *globalaccelerator.WithdrawByoipCidrInput) (*request.Request, *globalaccelerator.WithdrawByoipCidrOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawByoipCidrRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*globalaccelerator.WithdrawByoipCidrOutput)
+	return ret0, ret1
+}
+
+// WithdrawByoipCidrRequest indicates an expected call of WithdrawByoipCidrRequest.
+func (mr *MockGlobalAcceleratorMockRecorder) WithdrawByoipCidrRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawByoipCidrRequest", reflect.TypeOf((*MockGlobalAccelerator)(nil).WithdrawByoipCidrRequest), arg0)
+}
+
+// WithdrawByoipCidrWithContext mocks base method.
+func (m *MockGlobalAccelerator) WithdrawByoipCidrWithContext(arg0 context.Context, arg1 *globalaccelerator.WithdrawByoipCidrInput, arg2 ...request.Option) (*globalaccelerator.WithdrawByoipCidrOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WithdrawByoipCidrWithContext", varargs...)
+	ret0, _ := ret[0].(*globalaccelerator.WithdrawByoipCidrOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawByoipCidrWithContext indicates an expected call of WithdrawByoipCidrWithContext.
+func (mr *MockGlobalAcceleratorMockRecorder) WithdrawByoipCidrWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawByoipCidrWithContext", reflect.TypeOf((*MockGlobalAccelerator)(nil).WithdrawByoipCidrWithContext), varargs...)
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+type Route53 interface {
+	GetHostedZoneWithContext(ctx context.Context, input *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error)
+	ChangeResourceRecordSetsWithContext(ctx context.Context, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error)
+
+	// wrapper to ListResourceRecordSets API, which aggregates paged results into list.
+	ListResourceRecordSetsAsList(ctx context.Context, input *route53.ListResourceRecordSetsInput) ([]route53types.ResourceRecordSet, error)
+}
+
+// NewRoute53 constructs new Route53 implementation.
+func NewRoute53(cfg aws.Config) Route53 {
+	return &defaultRoute53{
+		client: route53.NewFromConfig(cfg),
+	}
+}
+
+// default implementation for Route53.
+type defaultRoute53 struct {
+	client *route53.Client
+}
+
+func (c *defaultRoute53) GetHostedZoneWithContext(ctx context.Context, input *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error) {
+	return c.client.GetHostedZone(ctx, input)
+}
+
+func (c *defaultRoute53) ChangeResourceRecordSetsWithContext(ctx context.Context, input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	return c.client.ChangeResourceRecordSets(ctx, input)
+}
+
+func (c *defaultRoute53) ListResourceRecordSetsAsList(ctx context.Context, input *route53.ListResourceRecordSetsInput) ([]route53types.ResourceRecordSet, error) {
+	var result []route53types.ResourceRecordSet
+	paginator := route53.NewListResourceRecordSetsPaginator(c.client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, output.ResourceRecordSets...)
+	}
+	return result, nil
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services (interfaces: Route53)
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+
+	route53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRoute53 is a mock of Route53 interface.
+type MockRoute53 struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoute53MockRecorder
+}
+
+// MockRoute53MockRecorder is the mock recorder for MockRoute53.
+type MockRoute53MockRecorder struct {
+	mock *MockRoute53
+}
+
+// NewMockRoute53 creates a new mock instance.
+func NewMockRoute53(ctrl *gomock.Controller) *MockRoute53 {
+	mock := &MockRoute53{ctrl: ctrl}
+	mock.recorder = &MockRoute53MockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoute53) EXPECT() *MockRoute53MockRecorder {
+	return m.recorder
+}
+
+// ChangeResourceRecordSetsWithContext mocks base method.
+func (m *MockRoute53) ChangeResourceRecordSetsWithContext(arg0 context.Context, arg1 *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeResourceRecordSetsWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*route53.ChangeResourceRecordSetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeResourceRecordSetsWithContext indicates an expected call of ChangeResourceRecordSetsWithContext.
+func (mr *MockRoute53MockRecorder) ChangeResourceRecordSetsWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeResourceRecordSetsWithContext", reflect.TypeOf((*MockRoute53)(nil).ChangeResourceRecordSetsWithContext), arg0, arg1)
+}
+
+// GetHostedZoneWithContext mocks base method.
+func (m *MockRoute53) GetHostedZoneWithContext(arg0 context.Context, arg1 *route53.GetHostedZoneInput) (*route53.GetHostedZoneOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostedZoneWithContext", arg0, arg1)
+	ret0, _ := ret[0].(*route53.GetHostedZoneOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostedZoneWithContext indicates an expected call of GetHostedZoneWithContext.
+func (mr *MockRoute53MockRecorder) GetHostedZoneWithContext(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostedZoneWithContext", reflect.TypeOf((*MockRoute53)(nil).GetHostedZoneWithContext), arg0, arg1)
+}
+
+// ListResourceRecordSetsAsList mocks base method.
+func (m *MockRoute53) ListResourceRecordSetsAsList(arg0 context.Context, arg1 *route53.ListResourceRecordSetsInput) ([]types.ResourceRecordSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceRecordSetsAsList", arg0, arg1)
+	ret0, _ := ret[0].([]types.ResourceRecordSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceRecordSetsAsList indicates an expected call of ListResourceRecordSetsAsList.
+func (mr *MockRoute53MockRecorder) ListResourceRecordSetsAsList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceRecordSetsAsList", reflect.TypeOf((*MockRoute53)(nil).ListResourceRecordSetsAsList), arg0, arg1)
+}
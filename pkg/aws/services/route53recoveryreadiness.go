@@ -0,0 +1,25 @@
+package services
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness/route53recoveryreadinessiface"
+)
+
+type Route53RecoveryReadiness interface {
+	route53recoveryreadinessiface.Route53RecoveryReadinessAPI
+}
+
+// NewRoute53RecoveryReadiness constructs new Route53RecoveryReadiness implementation.
+func NewRoute53RecoveryReadiness(session *session.Session) Route53RecoveryReadiness {
+	return &defaultRoute53RecoveryReadiness{
+		// route53 recovery readiness is only available as a global API in us-west-2.
+		Route53RecoveryReadinessAPI: route53recoveryreadiness.New(session, aws.NewConfig().WithRegion("us-west-2")),
+	}
+}
+
+// default implementation for Route53RecoveryReadiness.
+type defaultRoute53RecoveryReadiness struct {
+	route53recoveryreadinessiface.Route53RecoveryReadinessAPI
+}
@@ -0,0 +1,1967 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services (interfaces: Route53RecoveryReadiness)
+
+// Package services is a generated GoMock package.
+package services
+
+import (
+	context "context"
+	reflect "reflect"
+
+	request "github.com/aws/aws-sdk-go/aws/request"
+	route53recoveryreadiness "github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRoute53RecoveryReadiness is a mock of Route53RecoveryReadiness interface.
+type MockRoute53RecoveryReadiness struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoute53RecoveryReadinessMockRecorder
+}
+
+// MockRoute53RecoveryReadinessMockRecorder is the mock recorder for MockRoute53RecoveryReadiness.
+type MockRoute53RecoveryReadinessMockRecorder struct {
+	mock *MockRoute53RecoveryReadiness
+}
+
+// NewMockRoute53RecoveryReadiness creates a new mock instance.
+func NewMockRoute53RecoveryReadiness(ctrl *gomock.Controller) *MockRoute53RecoveryReadiness {
+	mock := &MockRoute53RecoveryReadiness{ctrl: ctrl}
+	mock.recorder = &MockRoute53RecoveryReadinessMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoute53RecoveryReadiness) EXPECT() *MockRoute53RecoveryReadinessMockRecorder {
+	return m.recorder
+}
+
+// CreateCell mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateCell(arg0 *route53recoveryreadiness.CreateCellInput) (*route53recoveryreadiness.CreateCellOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCell", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateCellOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCell indicates an expected call of CreateCell.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateCell(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCell", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateCell), arg0)
+}
+
+// CreateCellRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateCellRequest(arg0 *route53recoveryreadiness.CreateCellInput) (*request.Request, *route53recoveryreadiness.CreateCellOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCellRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.CreateCellOutput)
+	return ret0, ret1
+}
+
+// CreateCellRequest indicates an expected call of CreateCellRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateCellRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCellRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateCellRequest), arg0)
+}
+
+// CreateCellWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateCellWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.CreateCellInput, arg2 ...request.Option) (*route53recoveryreadiness.CreateCellOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCellWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateCellOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCellWithContext indicates an expected call of CreateCellWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateCellWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCellWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateCellWithContext), varargs...)
+}
+
+// CreateCrossAccountAuthorization mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateCrossAccountAuthorization(arg0 *route53recoveryreadiness.CreateCrossAccountAuthorizationInput) (*route53recoveryreadiness.CreateCrossAccountAuthorizationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCrossAccountAuthorization", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateCrossAccountAuthorizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCrossAccountAuthorization indicates an expected call of CreateCrossAccountAuthorization.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateCrossAccountAuthorization(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCrossAccountAuthorization", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateCrossAccountAuthorization), arg0)
+}
+
+// CreateCrossAccountAuthorizationRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateCrossAccountAuthorizationRequest(arg0 *route53recoveryreadiness.CreateCrossAccountAuthorizationInput) (*request.Request, *route53recoveryreadiness.CreateCrossAccountAuthorizationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCrossAccountAuthorizationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.CreateCrossAccountAuthorizationOutput)
+	return ret0, ret1
+}
+
+// CreateCrossAccountAuthorizationRequest indicates an expected call of CreateCrossAccountAuthorizationRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateCrossAccountAuthorizationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCrossAccountAuthorizationRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateCrossAccountAuthorizationRequest), arg0)
+}
+
+// CreateCrossAccountAuthorizationWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateCrossAccountAuthorizationWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.CreateCrossAccountAuthorizationInput, arg2 ...request.Option) (*route53recoveryreadiness.CreateCrossAccountAuthorizationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateCrossAccountAuthorizationWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateCrossAccountAuthorizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCrossAccountAuthorizationWithContext indicates an expected call of CreateCrossAccountAuthorizationWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateCrossAccountAuthorizationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCrossAccountAuthorizationWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateCrossAccountAuthorizationWithContext), varargs...)
+}
+
+// CreateReadinessCheck mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateReadinessCheck(arg0 *route53recoveryreadiness.CreateReadinessCheckInput) (*route53recoveryreadiness.CreateReadinessCheckOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReadinessCheck", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateReadinessCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReadinessCheck indicates an expected call of CreateReadinessCheck.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateReadinessCheck(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReadinessCheck", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateReadinessCheck), arg0)
+}
+
+// CreateReadinessCheckRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateReadinessCheckRequest(arg0 *route53recoveryreadiness.CreateReadinessCheckInput) (*request.Request, *route53recoveryreadiness.CreateReadinessCheckOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReadinessCheckRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.CreateReadinessCheckOutput)
+	return ret0, ret1
+}
+
+// CreateReadinessCheckRequest indicates an expected call of CreateReadinessCheckRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateReadinessCheckRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReadinessCheckRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateReadinessCheckRequest), arg0)
+}
+
+// CreateReadinessCheckWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateReadinessCheckWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.CreateReadinessCheckInput, arg2 ...request.Option) (*route53recoveryreadiness.CreateReadinessCheckOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateReadinessCheckWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateReadinessCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReadinessCheckWithContext indicates an expected call of CreateReadinessCheckWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateReadinessCheckWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReadinessCheckWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateReadinessCheckWithContext), varargs...)
+}
+
+// CreateRecoveryGroup mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateRecoveryGroup(arg0 *route53recoveryreadiness.CreateRecoveryGroupInput) (*route53recoveryreadiness.CreateRecoveryGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryGroup", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateRecoveryGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecoveryGroup indicates an expected call of CreateRecoveryGroup.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateRecoveryGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryGroup", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateRecoveryGroup), arg0)
+}
+
+// CreateRecoveryGroupRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateRecoveryGroupRequest(arg0 *route53recoveryreadiness.CreateRecoveryGroupInput) (*request.Request, *route53recoveryreadiness.CreateRecoveryGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.CreateRecoveryGroupOutput)
+	return ret0, ret1
+}
+
+// CreateRecoveryGroupRequest indicates an expected call of CreateRecoveryGroupRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateRecoveryGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryGroupRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateRecoveryGroupRequest), arg0)
+}
+
+// CreateRecoveryGroupWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateRecoveryGroupWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.CreateRecoveryGroupInput, arg2 ...request.Option) (*route53recoveryreadiness.CreateRecoveryGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateRecoveryGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateRecoveryGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRecoveryGroupWithContext indicates an expected call of CreateRecoveryGroupWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateRecoveryGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryGroupWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateRecoveryGroupWithContext), varargs...)
+}
+
+// CreateResourceSet mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateResourceSet(arg0 *route53recoveryreadiness.CreateResourceSetInput) (*route53recoveryreadiness.CreateResourceSetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResourceSet", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateResourceSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResourceSet indicates an expected call of CreateResourceSet.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateResourceSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceSet", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateResourceSet), arg0)
+}
+
+// CreateResourceSetRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateResourceSetRequest(arg0 *route53recoveryreadiness.CreateResourceSetInput) (*request.Request, *route53recoveryreadiness.CreateResourceSetOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateResourceSetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.CreateResourceSetOutput)
+	return ret0, ret1
+}
+
+// CreateResourceSetRequest indicates an expected call of CreateResourceSetRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateResourceSetRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceSetRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateResourceSetRequest), arg0)
+}
+
+// CreateResourceSetWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) CreateResourceSetWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.CreateResourceSetInput, arg2 ...request.Option) (*route53recoveryreadiness.CreateResourceSetOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateResourceSetWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.CreateResourceSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateResourceSetWithContext indicates an expected call of CreateResourceSetWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) CreateResourceSetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateResourceSetWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).CreateResourceSetWithContext), varargs...)
+}
+
+// DeleteCell mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteCell(arg0 *route53recoveryreadiness.DeleteCellInput) (*route53recoveryreadiness.DeleteCellOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCell", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteCellOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCell indicates an expected call of DeleteCell.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteCell(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCell", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteCell), arg0)
+}
+
+// DeleteCellRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteCellRequest(arg0 *route53recoveryreadiness.DeleteCellInput) (*request.Request, *route53recoveryreadiness.DeleteCellOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCellRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.DeleteCellOutput)
+	return ret0, ret1
+}
+
+// DeleteCellRequest indicates an expected call of DeleteCellRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteCellRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCellRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteCellRequest), arg0)
+}
+
+// DeleteCellWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteCellWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.DeleteCellInput, arg2 ...request.Option) (*route53recoveryreadiness.DeleteCellOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCellWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteCellOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCellWithContext indicates an expected call of DeleteCellWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteCellWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCellWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteCellWithContext), varargs...)
+}
+
+// DeleteCrossAccountAuthorization mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteCrossAccountAuthorization(arg0 *route53recoveryreadiness.DeleteCrossAccountAuthorizationInput) (*route53recoveryreadiness.DeleteCrossAccountAuthorizationOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCrossAccountAuthorization", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteCrossAccountAuthorizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCrossAccountAuthorization indicates an expected call of DeleteCrossAccountAuthorization.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteCrossAccountAuthorization(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCrossAccountAuthorization", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteCrossAccountAuthorization), arg0)
+}
+
+// DeleteCrossAccountAuthorizationRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteCrossAccountAuthorizationRequest(arg0 *route53recoveryreadiness.DeleteCrossAccountAuthorizationInput) (*request.Request, *route53recoveryreadiness.DeleteCrossAccountAuthorizationOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCrossAccountAuthorizationRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.DeleteCrossAccountAuthorizationOutput)
+	return ret0, ret1
+}
+
+// DeleteCrossAccountAuthorizationRequest indicates an expected call of DeleteCrossAccountAuthorizationRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteCrossAccountAuthorizationRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCrossAccountAuthorizationRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteCrossAccountAuthorizationRequest), arg0)
+}
+
+// DeleteCrossAccountAuthorizationWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteCrossAccountAuthorizationWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.DeleteCrossAccountAuthorizationInput, arg2 ...request.Option) (*route53recoveryreadiness.DeleteCrossAccountAuthorizationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteCrossAccountAuthorizationWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteCrossAccountAuthorizationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCrossAccountAuthorizationWithContext indicates an expected call of DeleteCrossAccountAuthorizationWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteCrossAccountAuthorizationWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCrossAccountAuthorizationWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteCrossAccountAuthorizationWithContext), varargs...)
+}
+
+// DeleteReadinessCheck mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteReadinessCheck(arg0 *route53recoveryreadiness.DeleteReadinessCheckInput) (*route53recoveryreadiness.DeleteReadinessCheckOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReadinessCheck", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteReadinessCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReadinessCheck indicates an expected call of DeleteReadinessCheck.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteReadinessCheck(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReadinessCheck", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteReadinessCheck), arg0)
+}
+
+// DeleteReadinessCheckRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteReadinessCheckRequest(arg0 *route53recoveryreadiness.DeleteReadinessCheckInput) (*request.Request, *route53recoveryreadiness.DeleteReadinessCheckOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReadinessCheckRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.DeleteReadinessCheckOutput)
+	return ret0, ret1
+}
+
+// DeleteReadinessCheckRequest indicates an expected call of DeleteReadinessCheckRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteReadinessCheckRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReadinessCheckRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteReadinessCheckRequest), arg0)
+}
+
+// DeleteReadinessCheckWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteReadinessCheckWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.DeleteReadinessCheckInput, arg2 ...request.Option) (*route53recoveryreadiness.DeleteReadinessCheckOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteReadinessCheckWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteReadinessCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReadinessCheckWithContext indicates an expected call of DeleteReadinessCheckWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteReadinessCheckWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReadinessCheckWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteReadinessCheckWithContext), varargs...)
+}
+
+// DeleteRecoveryGroup mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteRecoveryGroup(arg0 *route53recoveryreadiness.DeleteRecoveryGroupInput) (*route53recoveryreadiness.DeleteRecoveryGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecoveryGroup", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteRecoveryGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecoveryGroup indicates an expected call of DeleteRecoveryGroup.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteRecoveryGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryGroup", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteRecoveryGroup), arg0)
+}
+
+// DeleteRecoveryGroupRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteRecoveryGroupRequest(arg0 *route53recoveryreadiness.DeleteRecoveryGroupInput) (*request.Request, *route53recoveryreadiness.DeleteRecoveryGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecoveryGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.DeleteRecoveryGroupOutput)
+	return ret0, ret1
+}
+
+// DeleteRecoveryGroupRequest indicates an expected call of DeleteRecoveryGroupRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteRecoveryGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryGroupRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteRecoveryGroupRequest), arg0)
+}
+
+// DeleteRecoveryGroupWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteRecoveryGroupWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.DeleteRecoveryGroupInput, arg2 ...request.Option) (*route53recoveryreadiness.DeleteRecoveryGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteRecoveryGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteRecoveryGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRecoveryGroupWithContext indicates an expected call of DeleteRecoveryGroupWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteRecoveryGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryGroupWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteRecoveryGroupWithContext), varargs...)
+}
+
+// DeleteResourceSet mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteResourceSet(arg0 *route53recoveryreadiness.DeleteResourceSetInput) (*route53recoveryreadiness.DeleteResourceSetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceSet", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteResourceSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceSet indicates an expected call of DeleteResourceSet.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteResourceSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceSet", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteResourceSet), arg0)
+}
+
+// DeleteResourceSetRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteResourceSetRequest(arg0 *route53recoveryreadiness.DeleteResourceSetInput) (*request.Request, *route53recoveryreadiness.DeleteResourceSetOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceSetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.DeleteResourceSetOutput)
+	return ret0, ret1
+}
+
+// DeleteResourceSetRequest indicates an expected call of DeleteResourceSetRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteResourceSetRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceSetRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteResourceSetRequest), arg0)
+}
+
+// DeleteResourceSetWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) DeleteResourceSetWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.DeleteResourceSetInput, arg2 ...request.Option) (*route53recoveryreadiness.DeleteResourceSetOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteResourceSetWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.DeleteResourceSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceSetWithContext indicates an expected call of DeleteResourceSetWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) DeleteResourceSetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceSetWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).DeleteResourceSetWithContext), varargs...)
+}
+
+// GetArchitectureRecommendations mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetArchitectureRecommendations(arg0 *route53recoveryreadiness.GetArchitectureRecommendationsInput) (*route53recoveryreadiness.GetArchitectureRecommendationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchitectureRecommendations", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetArchitectureRecommendationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchitectureRecommendations indicates an expected call of GetArchitectureRecommendations.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetArchitectureRecommendations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchitectureRecommendations", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetArchitectureRecommendations), arg0)
+}
+
+// GetArchitectureRecommendationsRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetArchitectureRecommendationsRequest(arg0 *route53recoveryreadiness.GetArchitectureRecommendationsInput) (*request.Request, *route53recoveryreadiness.GetArchitectureRecommendationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchitectureRecommendationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetArchitectureRecommendationsOutput)
+	return ret0, ret1
+}
+
+// GetArchitectureRecommendationsRequest indicates an expected call of GetArchitectureRecommendationsRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetArchitectureRecommendationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchitectureRecommendationsRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetArchitectureRecommendationsRequest), arg0)
+}
+
+// GetArchitectureRecommendationsWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetArchitectureRecommendationsWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetArchitectureRecommendationsInput, arg2 ...request.Option) (*route53recoveryreadiness.GetArchitectureRecommendationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetArchitectureRecommendationsWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetArchitectureRecommendationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchitectureRecommendationsWithContext indicates an expected call of GetArchitectureRecommendationsWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetArchitectureRecommendationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchitectureRecommendationsWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetArchitectureRecommendationsWithContext), varargs...)
+}
+
+// GetCell mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetCell(arg0 *route53recoveryreadiness.GetCellInput) (*route53recoveryreadiness.GetCellOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCell", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetCellOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCell indicates an expected call of GetCell.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetCell(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCell", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetCell), arg0)
+}
+
+// GetCellReadinessSummary mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetCellReadinessSummary(arg0 *route53recoveryreadiness.GetCellReadinessSummaryInput) (*route53recoveryreadiness.GetCellReadinessSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCellReadinessSummary", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetCellReadinessSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCellReadinessSummary indicates an expected call of GetCellReadinessSummary.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetCellReadinessSummary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCellReadinessSummary", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetCellReadinessSummary), arg0)
+}
+
+// GetCellReadinessSummaryPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetCellReadinessSummaryPages(arg0 *route53recoveryreadiness.GetCellReadinessSummaryInput, arg1 func(*route53recoveryreadiness.GetCellReadinessSummaryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCellReadinessSummaryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetCellReadinessSummaryPages indicates an expected call of GetCellReadinessSummaryPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetCellReadinessSummaryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCellReadinessSummaryPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetCellReadinessSummaryPages), arg0, arg1)
+}
+
+// GetCellReadinessSummaryPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetCellReadinessSummaryPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetCellReadinessSummaryInput, arg2 func(*route53recoveryreadiness.GetCellReadinessSummaryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCellReadinessSummaryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetCellReadinessSummaryPagesWithContext indicates an expected call of GetCellReadinessSummaryPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetCellReadinessSummaryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCellReadinessSummaryPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetCellReadinessSummaryPagesWithContext), varargs...)
+}
+
+// GetCellReadinessSummaryRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetCellReadinessSummaryRequest(arg0 *route53recoveryreadiness.GetCellReadinessSummaryInput) (*request.Request, *route53recoveryreadiness.GetCellReadinessSummaryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCellReadinessSummaryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetCellReadinessSummaryOutput)
+	return ret0, ret1
+}
+
+// GetCellReadinessSummaryRequest indicates an expected call of GetCellReadinessSummaryRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetCellReadinessSummaryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCellReadinessSummaryRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetCellReadinessSummaryRequest), arg0)
+}
+
+// GetCellReadinessSummaryWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetCellReadinessSummaryWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetCellReadinessSummaryInput, arg2 ...request.Option) (*route53recoveryreadiness.GetCellReadinessSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCellReadinessSummaryWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetCellReadinessSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCellReadinessSummaryWithContext indicates an expected call of GetCellReadinessSummaryWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetCellReadinessSummaryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCellReadinessSummaryWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetCellReadinessSummaryWithContext), varargs...)
+}
+
+// GetCellRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetCellRequest(arg0 *route53recoveryreadiness.GetCellInput) (*request.Request, *route53recoveryreadiness.GetCellOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCellRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetCellOutput)
+	return ret0, ret1
+}
+
+// GetCellRequest indicates an expected call of GetCellRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetCellRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCellRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetCellRequest), arg0)
+}
+
+// GetCellWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetCellWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetCellInput, arg2 ...request.Option) (*route53recoveryreadiness.GetCellOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCellWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetCellOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCellWithContext indicates an expected call of GetCellWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetCellWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCellWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetCellWithContext), varargs...)
+}
+
+// GetReadinessCheck mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheck(arg0 *route53recoveryreadiness.GetReadinessCheckInput) (*route53recoveryreadiness.GetReadinessCheckOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadinessCheck", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetReadinessCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadinessCheck indicates an expected call of GetReadinessCheck.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheck(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheck", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheck), arg0)
+}
+
+// GetReadinessCheckRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckRequest(arg0 *route53recoveryreadiness.GetReadinessCheckInput) (*request.Request, *route53recoveryreadiness.GetReadinessCheckOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadinessCheckRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetReadinessCheckOutput)
+	return ret0, ret1
+}
+
+// GetReadinessCheckRequest indicates an expected call of GetReadinessCheckRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckRequest), arg0)
+}
+
+// GetReadinessCheckResourceStatus mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckResourceStatus(arg0 *route53recoveryreadiness.GetReadinessCheckResourceStatusInput) (*route53recoveryreadiness.GetReadinessCheckResourceStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadinessCheckResourceStatus", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetReadinessCheckResourceStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadinessCheckResourceStatus indicates an expected call of GetReadinessCheckResourceStatus.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckResourceStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckResourceStatus", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckResourceStatus), arg0)
+}
+
+// GetReadinessCheckResourceStatusPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckResourceStatusPages(arg0 *route53recoveryreadiness.GetReadinessCheckResourceStatusInput, arg1 func(*route53recoveryreadiness.GetReadinessCheckResourceStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadinessCheckResourceStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetReadinessCheckResourceStatusPages indicates an expected call of GetReadinessCheckResourceStatusPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckResourceStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckResourceStatusPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckResourceStatusPages), arg0, arg1)
+}
+
+// GetReadinessCheckResourceStatusPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckResourceStatusPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetReadinessCheckResourceStatusInput, arg2 func(*route53recoveryreadiness.GetReadinessCheckResourceStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReadinessCheckResourceStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetReadinessCheckResourceStatusPagesWithContext indicates an expected call of GetReadinessCheckResourceStatusPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckResourceStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckResourceStatusPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckResourceStatusPagesWithContext), varargs...)
+}
+
+// GetReadinessCheckResourceStatusRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckResourceStatusRequest(arg0 *route53recoveryreadiness.GetReadinessCheckResourceStatusInput) (*request.Request, *route53recoveryreadiness.GetReadinessCheckResourceStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadinessCheckResourceStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetReadinessCheckResourceStatusOutput)
+	return ret0, ret1
+}
+
+// GetReadinessCheckResourceStatusRequest indicates an expected call of GetReadinessCheckResourceStatusRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckResourceStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckResourceStatusRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckResourceStatusRequest), arg0)
+}
+
+// GetReadinessCheckResourceStatusWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckResourceStatusWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetReadinessCheckResourceStatusInput, arg2 ...request.Option) (*route53recoveryreadiness.GetReadinessCheckResourceStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReadinessCheckResourceStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetReadinessCheckResourceStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadinessCheckResourceStatusWithContext indicates an expected call of GetReadinessCheckResourceStatusWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckResourceStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckResourceStatusWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckResourceStatusWithContext), varargs...)
+}
+
+// GetReadinessCheckStatus mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckStatus(arg0 *route53recoveryreadiness.GetReadinessCheckStatusInput) (*route53recoveryreadiness.GetReadinessCheckStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadinessCheckStatus", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetReadinessCheckStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadinessCheckStatus indicates an expected call of GetReadinessCheckStatus.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckStatus(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckStatus", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckStatus), arg0)
+}
+
+// GetReadinessCheckStatusPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckStatusPages(arg0 *route53recoveryreadiness.GetReadinessCheckStatusInput, arg1 func(*route53recoveryreadiness.GetReadinessCheckStatusOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadinessCheckStatusPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetReadinessCheckStatusPages indicates an expected call of GetReadinessCheckStatusPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckStatusPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckStatusPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckStatusPages), arg0, arg1)
+}
+
+// GetReadinessCheckStatusPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckStatusPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetReadinessCheckStatusInput, arg2 func(*route53recoveryreadiness.GetReadinessCheckStatusOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReadinessCheckStatusPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetReadinessCheckStatusPagesWithContext indicates an expected call of GetReadinessCheckStatusPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckStatusPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckStatusPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckStatusPagesWithContext), varargs...)
+}
+
+// GetReadinessCheckStatusRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckStatusRequest(arg0 *route53recoveryreadiness.GetReadinessCheckStatusInput) (*request.Request, *route53recoveryreadiness.GetReadinessCheckStatusOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadinessCheckStatusRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetReadinessCheckStatusOutput)
+	return ret0, ret1
+}
+
+// GetReadinessCheckStatusRequest indicates an expected call of GetReadinessCheckStatusRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckStatusRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckStatusRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckStatusRequest), arg0)
+}
+
+// GetReadinessCheckStatusWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckStatusWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetReadinessCheckStatusInput, arg2 ...request.Option) (*route53recoveryreadiness.GetReadinessCheckStatusOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReadinessCheckStatusWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetReadinessCheckStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadinessCheckStatusWithContext indicates an expected call of GetReadinessCheckStatusWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckStatusWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckStatusWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckStatusWithContext), varargs...)
+}
+
+// GetReadinessCheckWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetReadinessCheckWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetReadinessCheckInput, arg2 ...request.Option) (*route53recoveryreadiness.GetReadinessCheckOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReadinessCheckWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetReadinessCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadinessCheckWithContext indicates an expected call of GetReadinessCheckWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetReadinessCheckWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadinessCheckWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetReadinessCheckWithContext), varargs...)
+}
+
+// GetRecoveryGroup mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetRecoveryGroup(arg0 *route53recoveryreadiness.GetRecoveryGroupInput) (*route53recoveryreadiness.GetRecoveryGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryGroup", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetRecoveryGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecoveryGroup indicates an expected call of GetRecoveryGroup.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetRecoveryGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryGroup", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetRecoveryGroup), arg0)
+}
+
+// GetRecoveryGroupReadinessSummary mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetRecoveryGroupReadinessSummary(arg0 *route53recoveryreadiness.GetRecoveryGroupReadinessSummaryInput) (*route53recoveryreadiness.GetRecoveryGroupReadinessSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryGroupReadinessSummary", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetRecoveryGroupReadinessSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecoveryGroupReadinessSummary indicates an expected call of GetRecoveryGroupReadinessSummary.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetRecoveryGroupReadinessSummary(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryGroupReadinessSummary", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetRecoveryGroupReadinessSummary), arg0)
+}
+
+// GetRecoveryGroupReadinessSummaryPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetRecoveryGroupReadinessSummaryPages(arg0 *route53recoveryreadiness.GetRecoveryGroupReadinessSummaryInput, arg1 func(*route53recoveryreadiness.GetRecoveryGroupReadinessSummaryOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryGroupReadinessSummaryPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetRecoveryGroupReadinessSummaryPages indicates an expected call of GetRecoveryGroupReadinessSummaryPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetRecoveryGroupReadinessSummaryPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryGroupReadinessSummaryPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetRecoveryGroupReadinessSummaryPages), arg0, arg1)
+}
+
+// GetRecoveryGroupReadinessSummaryPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetRecoveryGroupReadinessSummaryPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetRecoveryGroupReadinessSummaryInput, arg2 func(*route53recoveryreadiness.GetRecoveryGroupReadinessSummaryOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecoveryGroupReadinessSummaryPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetRecoveryGroupReadinessSummaryPagesWithContext indicates an expected call of GetRecoveryGroupReadinessSummaryPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetRecoveryGroupReadinessSummaryPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryGroupReadinessSummaryPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetRecoveryGroupReadinessSummaryPagesWithContext), varargs...)
+}
+
+// GetRecoveryGroupReadinessSummaryRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetRecoveryGroupReadinessSummaryRequest(arg0 *route53recoveryreadiness.GetRecoveryGroupReadinessSummaryInput) (*request.Request, *route53recoveryreadiness.GetRecoveryGroupReadinessSummaryOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryGroupReadinessSummaryRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetRecoveryGroupReadinessSummaryOutput)
+	return ret0, ret1
+}
+
+// GetRecoveryGroupReadinessSummaryRequest indicates an expected call of GetRecoveryGroupReadinessSummaryRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetRecoveryGroupReadinessSummaryRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryGroupReadinessSummaryRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetRecoveryGroupReadinessSummaryRequest), arg0)
+}
+
+// GetRecoveryGroupReadinessSummaryWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetRecoveryGroupReadinessSummaryWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetRecoveryGroupReadinessSummaryInput, arg2 ...request.Option) (*route53recoveryreadiness.GetRecoveryGroupReadinessSummaryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecoveryGroupReadinessSummaryWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetRecoveryGroupReadinessSummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecoveryGroupReadinessSummaryWithContext indicates an expected call of GetRecoveryGroupReadinessSummaryWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetRecoveryGroupReadinessSummaryWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryGroupReadinessSummaryWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetRecoveryGroupReadinessSummaryWithContext), varargs...)
+}
+
+// GetRecoveryGroupRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetRecoveryGroupRequest(arg0 *route53recoveryreadiness.GetRecoveryGroupInput) (*request.Request, *route53recoveryreadiness.GetRecoveryGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetRecoveryGroupOutput)
+	return ret0, ret1
+}
+
+// GetRecoveryGroupRequest indicates an expected call of GetRecoveryGroupRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetRecoveryGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryGroupRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetRecoveryGroupRequest), arg0)
+}
+
+// GetRecoveryGroupWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetRecoveryGroupWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetRecoveryGroupInput, arg2 ...request.Option) (*route53recoveryreadiness.GetRecoveryGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRecoveryGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetRecoveryGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecoveryGroupWithContext indicates an expected call of GetRecoveryGroupWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetRecoveryGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryGroupWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetRecoveryGroupWithContext), varargs...)
+}
+
+// GetResourceSet mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetResourceSet(arg0 *route53recoveryreadiness.GetResourceSetInput) (*route53recoveryreadiness.GetResourceSetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceSet", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetResourceSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceSet indicates an expected call of GetResourceSet.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetResourceSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSet", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetResourceSet), arg0)
+}
+
+// GetResourceSetRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetResourceSetRequest(arg0 *route53recoveryreadiness.GetResourceSetInput) (*request.Request, *route53recoveryreadiness.GetResourceSetOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceSetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.GetResourceSetOutput)
+	return ret0, ret1
+}
+
+// GetResourceSetRequest indicates an expected call of GetResourceSetRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetResourceSetRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSetRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetResourceSetRequest), arg0)
+}
+
+// GetResourceSetWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) GetResourceSetWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.GetResourceSetInput, arg2 ...request.Option) (*route53recoveryreadiness.GetResourceSetOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetResourceSetWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.GetResourceSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceSetWithContext indicates an expected call of GetResourceSetWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) GetResourceSetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceSetWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).GetResourceSetWithContext), varargs...)
+}
+
+// ListCells mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCells(arg0 *route53recoveryreadiness.ListCellsInput) (*route53recoveryreadiness.ListCellsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCells", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListCellsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCells indicates an expected call of ListCells.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCells(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCells", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCells), arg0)
+}
+
+// ListCellsPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCellsPages(arg0 *route53recoveryreadiness.ListCellsInput, arg1 func(*route53recoveryreadiness.ListCellsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCellsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCellsPages indicates an expected call of ListCellsPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCellsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCellsPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCellsPages), arg0, arg1)
+}
+
+// ListCellsPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCellsPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListCellsInput, arg2 func(*route53recoveryreadiness.ListCellsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCellsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCellsPagesWithContext indicates an expected call of ListCellsPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCellsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCellsPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCellsPagesWithContext), varargs...)
+}
+
+// ListCellsRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCellsRequest(arg0 *route53recoveryreadiness.ListCellsInput) (*request.Request, *route53recoveryreadiness.ListCellsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCellsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.ListCellsOutput)
+	return ret0, ret1
+}
+
+// ListCellsRequest indicates an expected call of ListCellsRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCellsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCellsRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCellsRequest), arg0)
+}
+
+// ListCellsWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCellsWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListCellsInput, arg2 ...request.Option) (*route53recoveryreadiness.ListCellsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCellsWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListCellsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCellsWithContext indicates an expected call of ListCellsWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCellsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCellsWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCellsWithContext), varargs...)
+}
+
+// ListCrossAccountAuthorizations mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCrossAccountAuthorizations(arg0 *route53recoveryreadiness.ListCrossAccountAuthorizationsInput) (*route53recoveryreadiness.ListCrossAccountAuthorizationsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountAuthorizations", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListCrossAccountAuthorizationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountAuthorizations indicates an expected call of ListCrossAccountAuthorizations.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCrossAccountAuthorizations(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAuthorizations", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCrossAccountAuthorizations), arg0)
+}
+
+// ListCrossAccountAuthorizationsPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCrossAccountAuthorizationsPages(arg0 *route53recoveryreadiness.ListCrossAccountAuthorizationsInput, arg1 func(*route53recoveryreadiness.ListCrossAccountAuthorizationsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountAuthorizationsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCrossAccountAuthorizationsPages indicates an expected call of ListCrossAccountAuthorizationsPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCrossAccountAuthorizationsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAuthorizationsPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCrossAccountAuthorizationsPages), arg0, arg1)
+}
+
+// ListCrossAccountAuthorizationsPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCrossAccountAuthorizationsPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListCrossAccountAuthorizationsInput, arg2 func(*route53recoveryreadiness.ListCrossAccountAuthorizationsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCrossAccountAuthorizationsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListCrossAccountAuthorizationsPagesWithContext indicates an expected call of ListCrossAccountAuthorizationsPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCrossAccountAuthorizationsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAuthorizationsPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCrossAccountAuthorizationsPagesWithContext), varargs...)
+}
+
+// ListCrossAccountAuthorizationsRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCrossAccountAuthorizationsRequest(arg0 *route53recoveryreadiness.ListCrossAccountAuthorizationsInput) (*request.Request, *route53recoveryreadiness.ListCrossAccountAuthorizationsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCrossAccountAuthorizationsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.ListCrossAccountAuthorizationsOutput)
+	return ret0, ret1
+}
+
+// ListCrossAccountAuthorizationsRequest indicates an expected call of ListCrossAccountAuthorizationsRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCrossAccountAuthorizationsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAuthorizationsRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCrossAccountAuthorizationsRequest), arg0)
+}
+
+// ListCrossAccountAuthorizationsWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListCrossAccountAuthorizationsWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListCrossAccountAuthorizationsInput, arg2 ...request.Option) (*route53recoveryreadiness.ListCrossAccountAuthorizationsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCrossAccountAuthorizationsWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListCrossAccountAuthorizationsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCrossAccountAuthorizationsWithContext indicates an expected call of ListCrossAccountAuthorizationsWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListCrossAccountAuthorizationsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCrossAccountAuthorizationsWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListCrossAccountAuthorizationsWithContext), varargs...)
+}
+
+// ListReadinessChecks mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListReadinessChecks(arg0 *route53recoveryreadiness.ListReadinessChecksInput) (*route53recoveryreadiness.ListReadinessChecksOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReadinessChecks", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListReadinessChecksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReadinessChecks indicates an expected call of ListReadinessChecks.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListReadinessChecks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReadinessChecks", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListReadinessChecks), arg0)
+}
+
+// ListReadinessChecksPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListReadinessChecksPages(arg0 *route53recoveryreadiness.ListReadinessChecksInput, arg1 func(*route53recoveryreadiness.ListReadinessChecksOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReadinessChecksPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListReadinessChecksPages indicates an expected call of ListReadinessChecksPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListReadinessChecksPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReadinessChecksPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListReadinessChecksPages), arg0, arg1)
+}
+
+// ListReadinessChecksPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListReadinessChecksPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListReadinessChecksInput, arg2 func(*route53recoveryreadiness.ListReadinessChecksOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReadinessChecksPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListReadinessChecksPagesWithContext indicates an expected call of ListReadinessChecksPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListReadinessChecksPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReadinessChecksPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListReadinessChecksPagesWithContext), varargs...)
+}
+
+// ListReadinessChecksRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListReadinessChecksRequest(arg0 *route53recoveryreadiness.ListReadinessChecksInput) (*request.Request, *route53recoveryreadiness.ListReadinessChecksOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReadinessChecksRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.ListReadinessChecksOutput)
+	return ret0, ret1
+}
+
+// ListReadinessChecksRequest indicates an expected call of ListReadinessChecksRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListReadinessChecksRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReadinessChecksRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListReadinessChecksRequest), arg0)
+}
+
+// ListReadinessChecksWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListReadinessChecksWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListReadinessChecksInput, arg2 ...request.Option) (*route53recoveryreadiness.ListReadinessChecksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReadinessChecksWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListReadinessChecksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReadinessChecksWithContext indicates an expected call of ListReadinessChecksWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListReadinessChecksWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReadinessChecksWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListReadinessChecksWithContext), varargs...)
+}
+
+// ListRecoveryGroups mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRecoveryGroups(arg0 *route53recoveryreadiness.ListRecoveryGroupsInput) (*route53recoveryreadiness.ListRecoveryGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecoveryGroups", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListRecoveryGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecoveryGroups indicates an expected call of ListRecoveryGroups.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRecoveryGroups(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecoveryGroups", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRecoveryGroups), arg0)
+}
+
+// ListRecoveryGroupsPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRecoveryGroupsPages(arg0 *route53recoveryreadiness.ListRecoveryGroupsInput, arg1 func(*route53recoveryreadiness.ListRecoveryGroupsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecoveryGroupsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListRecoveryGroupsPages indicates an expected call of ListRecoveryGroupsPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRecoveryGroupsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecoveryGroupsPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRecoveryGroupsPages), arg0, arg1)
+}
+
+// ListRecoveryGroupsPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRecoveryGroupsPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListRecoveryGroupsInput, arg2 func(*route53recoveryreadiness.ListRecoveryGroupsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRecoveryGroupsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListRecoveryGroupsPagesWithContext indicates an expected call of ListRecoveryGroupsPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRecoveryGroupsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecoveryGroupsPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRecoveryGroupsPagesWithContext), varargs...)
+}
+
+// ListRecoveryGroupsRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRecoveryGroupsRequest(arg0 *route53recoveryreadiness.ListRecoveryGroupsInput) (*request.Request, *route53recoveryreadiness.ListRecoveryGroupsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRecoveryGroupsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.ListRecoveryGroupsOutput)
+	return ret0, ret1
+}
+
+// ListRecoveryGroupsRequest indicates an expected call of ListRecoveryGroupsRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRecoveryGroupsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecoveryGroupsRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRecoveryGroupsRequest), arg0)
+}
+
+// ListRecoveryGroupsWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRecoveryGroupsWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListRecoveryGroupsInput, arg2 ...request.Option) (*route53recoveryreadiness.ListRecoveryGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRecoveryGroupsWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListRecoveryGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRecoveryGroupsWithContext indicates an expected call of ListRecoveryGroupsWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRecoveryGroupsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRecoveryGroupsWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRecoveryGroupsWithContext), varargs...)
+}
+
+// ListResourceSets mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListResourceSets(arg0 *route53recoveryreadiness.ListResourceSetsInput) (*route53recoveryreadiness.ListResourceSetsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceSets", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListResourceSetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceSets indicates an expected call of ListResourceSets.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListResourceSets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceSets", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListResourceSets), arg0)
+}
+
+// ListResourceSetsPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListResourceSetsPages(arg0 *route53recoveryreadiness.ListResourceSetsInput, arg1 func(*route53recoveryreadiness.ListResourceSetsOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceSetsPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListResourceSetsPages indicates an expected call of ListResourceSetsPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListResourceSetsPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceSetsPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListResourceSetsPages), arg0, arg1)
+}
+
+// ListResourceSetsPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListResourceSetsPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListResourceSetsInput, arg2 func(*route53recoveryreadiness.ListResourceSetsOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceSetsPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListResourceSetsPagesWithContext indicates an expected call of ListResourceSetsPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListResourceSetsPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceSetsPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListResourceSetsPagesWithContext), varargs...)
+}
+
+// ListResourceSetsRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListResourceSetsRequest(arg0 *route53recoveryreadiness.ListResourceSetsInput) (*request.Request, *route53recoveryreadiness.ListResourceSetsOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceSetsRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.ListResourceSetsOutput)
+	return ret0, ret1
+}
+
+// ListResourceSetsRequest indicates an expected call of ListResourceSetsRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListResourceSetsRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceSetsRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListResourceSetsRequest), arg0)
+}
+
+// ListResourceSetsWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListResourceSetsWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListResourceSetsInput, arg2 ...request.Option) (*route53recoveryreadiness.ListResourceSetsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceSetsWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListResourceSetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceSetsWithContext indicates an expected call of ListResourceSetsWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListResourceSetsWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceSetsWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListResourceSetsWithContext), varargs...)
+}
+
+// ListRules mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRules(arg0 *route53recoveryreadiness.ListRulesInput) (*route53recoveryreadiness.ListRulesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRules(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRules), arg0)
+}
+
+// ListRulesPages mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRulesPages(arg0 *route53recoveryreadiness.ListRulesInput, arg1 func(*route53recoveryreadiness.ListRulesOutput, bool) bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRulesPages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListRulesPages indicates an expected call of ListRulesPages.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRulesPages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesPages", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRulesPages), arg0, arg1)
+}
+
+// ListRulesPagesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRulesPagesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListRulesInput, arg2 func(*route53recoveryreadiness.ListRulesOutput, bool) bool, arg3 ...request.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRulesPagesWithContext", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListRulesPagesWithContext indicates an expected call of ListRulesPagesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRulesPagesWithContext(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesPagesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRulesPagesWithContext), varargs...)
+}
+
+// ListRulesRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRulesRequest(arg0 *route53recoveryreadiness.ListRulesInput) (*request.Request, *route53recoveryreadiness.ListRulesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRulesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.ListRulesOutput)
+	return ret0, ret1
+}
+
+// ListRulesRequest indicates an expected call of ListRulesRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRulesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRulesRequest), arg0)
+}
+
+// ListRulesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListRulesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListRulesInput, arg2 ...request.Option) (*route53recoveryreadiness.ListRulesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRulesWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListRulesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRulesWithContext indicates an expected call of ListRulesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListRulesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRulesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListRulesWithContext), varargs...)
+}
+
+// ListTagsForResources mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListTagsForResources(arg0 *route53recoveryreadiness.ListTagsForResourcesInput) (*route53recoveryreadiness.ListTagsForResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResources", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListTagsForResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResources indicates an expected call of ListTagsForResources.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListTagsForResources(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResources", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListTagsForResources), arg0)
+}
+
+// ListTagsForResourcesRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListTagsForResourcesRequest(arg0 *route53recoveryreadiness.ListTagsForResourcesInput) (*request.Request, *route53recoveryreadiness.ListTagsForResourcesOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsForResourcesRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.ListTagsForResourcesOutput)
+	return ret0, ret1
+}
+
+// ListTagsForResourcesRequest indicates an expected call of ListTagsForResourcesRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListTagsForResourcesRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourcesRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListTagsForResourcesRequest), arg0)
+}
+
+// ListTagsForResourcesWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) ListTagsForResourcesWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.ListTagsForResourcesInput, arg2 ...request.Option) (*route53recoveryreadiness.ListTagsForResourcesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsForResourcesWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.ListTagsForResourcesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsForResourcesWithContext indicates an expected call of ListTagsForResourcesWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) ListTagsForResourcesWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResourcesWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).ListTagsForResourcesWithContext), varargs...)
+}
+
+// TagResource mocks base method.
+func (m *MockRoute53RecoveryReadiness) TagResource(arg0 *route53recoveryreadiness.TagResourceInput) (*route53recoveryreadiness.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResource", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResource indicates an expected call of TagResource.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) TagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResource", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).TagResource), arg0)
+}
+
+// TagResourceRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) TagResourceRequest(arg0 *route53recoveryreadiness.TagResourceInput) (*request.Request, *route53recoveryreadiness.TagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.TagResourceOutput)
+	return ret0, ret1
+}
+
+// TagResourceRequest indicates an expected call of TagResourceRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) TagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).TagResourceRequest), arg0)
+}
+
+// TagResourceWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) TagResourceWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.TagResourceInput, arg2 ...request.Option) (*route53recoveryreadiness.TagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.TagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TagResourceWithContext indicates an expected call of TagResourceWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) TagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TagResourceWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).TagResourceWithContext), varargs...)
+}
+
+// UntagResource mocks base method.
+func (m *MockRoute53RecoveryReadiness) UntagResource(arg0 *route53recoveryreadiness.UntagResourceInput) (*route53recoveryreadiness.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResource", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResource indicates an expected call of UntagResource.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UntagResource(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResource", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UntagResource), arg0)
+}
+
+// UntagResourceRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) UntagResourceRequest(arg0 *route53recoveryreadiness.UntagResourceInput) (*request.Request, *route53recoveryreadiness.UntagResourceOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UntagResourceRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.UntagResourceOutput)
+	return ret0, ret1
+}
+
+// UntagResourceRequest indicates an expected call of UntagResourceRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UntagResourceRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UntagResourceRequest), arg0)
+}
+
+// UntagResourceWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) UntagResourceWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.UntagResourceInput, arg2 ...request.Option) (*route53recoveryreadiness.UntagResourceOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UntagResourceWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UntagResourceOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UntagResourceWithContext indicates an expected call of UntagResourceWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UntagResourceWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UntagResourceWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UntagResourceWithContext), varargs...)
+}
+
+// UpdateCell mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateCell(arg0 *route53recoveryreadiness.UpdateCellInput) (*route53recoveryreadiness.UpdateCellOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCell", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UpdateCellOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCell indicates an expected call of UpdateCell.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateCell(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCell", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateCell), arg0)
+}
+
+// UpdateCellRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateCellRequest(arg0 *route53recoveryreadiness.UpdateCellInput) (*request.Request, *route53recoveryreadiness.UpdateCellOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCellRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.UpdateCellOutput)
+	return ret0, ret1
+}
+
+// UpdateCellRequest indicates an expected call of UpdateCellRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateCellRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCellRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateCellRequest), arg0)
+}
+
+// UpdateCellWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateCellWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.UpdateCellInput, arg2 ...request.Option) (*route53recoveryreadiness.UpdateCellOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateCellWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UpdateCellOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCellWithContext indicates an expected call of UpdateCellWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateCellWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCellWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateCellWithContext), varargs...)
+}
+
+// UpdateReadinessCheck mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateReadinessCheck(arg0 *route53recoveryreadiness.UpdateReadinessCheckInput) (*route53recoveryreadiness.UpdateReadinessCheckOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReadinessCheck", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UpdateReadinessCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReadinessCheck indicates an expected call of UpdateReadinessCheck.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateReadinessCheck(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReadinessCheck", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateReadinessCheck), arg0)
+}
+
+// UpdateReadinessCheckRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateReadinessCheckRequest(arg0 *route53recoveryreadiness.UpdateReadinessCheckInput) (*request.Request, *route53recoveryreadiness.UpdateReadinessCheckOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReadinessCheckRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.UpdateReadinessCheckOutput)
+	return ret0, ret1
+}
+
+// UpdateReadinessCheckRequest indicates an expected call of UpdateReadinessCheckRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateReadinessCheckRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReadinessCheckRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateReadinessCheckRequest), arg0)
+}
+
+// UpdateReadinessCheckWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateReadinessCheckWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.UpdateReadinessCheckInput, arg2 ...request.Option) (*route53recoveryreadiness.UpdateReadinessCheckOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateReadinessCheckWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UpdateReadinessCheckOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReadinessCheckWithContext indicates an expected call of UpdateReadinessCheckWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateReadinessCheckWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReadinessCheckWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateReadinessCheckWithContext), varargs...)
+}
+
+// UpdateRecoveryGroup mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateRecoveryGroup(arg0 *route53recoveryreadiness.UpdateRecoveryGroupInput) (*route53recoveryreadiness.UpdateRecoveryGroupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecoveryGroup", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UpdateRecoveryGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecoveryGroup indicates an expected call of UpdateRecoveryGroup.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateRecoveryGroup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecoveryGroup", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateRecoveryGroup), arg0)
+}
+
+// UpdateRecoveryGroupRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateRecoveryGroupRequest(arg0 *route53recoveryreadiness.UpdateRecoveryGroupInput) (*request.Request, *route53recoveryreadiness.UpdateRecoveryGroupOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecoveryGroupRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.UpdateRecoveryGroupOutput)
+	return ret0, ret1
+}
+
+// UpdateRecoveryGroupRequest indicates an expected call of UpdateRecoveryGroupRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateRecoveryGroupRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecoveryGroupRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateRecoveryGroupRequest), arg0)
+}
+
+// UpdateRecoveryGroupWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateRecoveryGroupWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.UpdateRecoveryGroupInput, arg2 ...request.Option) (*route53recoveryreadiness.UpdateRecoveryGroupOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateRecoveryGroupWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UpdateRecoveryGroupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRecoveryGroupWithContext indicates an expected call of UpdateRecoveryGroupWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateRecoveryGroupWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecoveryGroupWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateRecoveryGroupWithContext), varargs...)
+}
+
+// UpdateResourceSet mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateResourceSet(arg0 *route53recoveryreadiness.UpdateResourceSetInput) (*route53recoveryreadiness.UpdateResourceSetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResourceSet", arg0)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UpdateResourceSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResourceSet indicates an expected call of UpdateResourceSet.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateResourceSet(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceSet", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateResourceSet), arg0)
+}
+
+// UpdateResourceSetRequest mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateResourceSetRequest(arg0 *route53recoveryreadiness.UpdateResourceSetInput) (*request.Request, *route53recoveryreadiness.UpdateResourceSetOutput) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResourceSetRequest", arg0)
+	ret0, _ := ret[0].(*request.Request)
+	ret1, _ := ret[1].(*route53recoveryreadiness.UpdateResourceSetOutput)
+	return ret0, ret1
+}
+
+// UpdateResourceSetRequest indicates an expected call of UpdateResourceSetRequest.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateResourceSetRequest(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceSetRequest", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateResourceSetRequest), arg0)
+}
+
+// UpdateResourceSetWithContext mocks base method.
+func (m *MockRoute53RecoveryReadiness) UpdateResourceSetWithContext(arg0 context.Context, arg1 *route53recoveryreadiness.UpdateResourceSetInput, arg2 ...request.Option) (*route53recoveryreadiness.UpdateResourceSetOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateResourceSetWithContext", varargs...)
+	ret0, _ := ret[0].(*route53recoveryreadiness.UpdateResourceSetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResourceSetWithContext indicates an expected call of UpdateResourceSetWithContext.
+func (mr *MockRoute53RecoveryReadinessMockRecorder) UpdateResourceSetWithContext(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceSetWithContext", reflect.TypeOf((*MockRoute53RecoveryReadiness)(nil).UpdateResourceSetWithContext), varargs...)
+}
@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+type SecretsManager interface {
+	GetSecretValueWithContext(ctx context.Context, input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// NewSecretsManager constructs new SecretsManager implementation.
+func NewSecretsManager(cfg aws.Config) SecretsManager {
+	return &defaultSecretsManager{
+		client: secretsmanager.NewFromConfig(cfg),
+	}
+}
+
+// default implementation for SecretsManager.
+type defaultSecretsManager struct {
+	client *secretsmanager.Client
+}
+
+func (c *defaultSecretsManager) GetSecretValueWithContext(ctx context.Context, input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	return c.client.GetSecretValue(ctx, input)
+}
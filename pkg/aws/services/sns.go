@@ -0,0 +1,28 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+)
+
+type SNS interface {
+	PublishBatchWithContext(ctx context.Context, input *sns.PublishBatchInput) (*sns.PublishBatchOutput, error)
+}
+
+// NewSNS constructs new SNS implementation.
+func NewSNS(cfg aws.Config) SNS {
+	return &defaultSNS{
+		client: sns.NewFromConfig(cfg),
+	}
+}
+
+// default implementation for SNS.
+type defaultSNS struct {
+	client *sns.Client
+}
+
+func (c *defaultSNS) PublishBatchWithContext(ctx context.Context, input *sns.PublishBatchInput) (*sns.PublishBatchOutput, error) {
+	return c.client.PublishBatch(ctx, input)
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+)
+
+type SQS interface {
+	DeleteMessageWithContext(ctx context.Context, input *sqs.DeleteMessageInput) (*sqs.DeleteMessageOutput, error)
+	GetQueueAttributesWithContext(ctx context.Context, input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error)
+	ReceiveMessageWithContext(ctx context.Context, input *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error)
+}
+
+// NewSQS constructs new SQS implementation.
+func NewSQS(cfg aws.Config) SQS {
+	return &defaultSQS{
+		client: sqs.NewFromConfig(cfg),
+	}
+}
+
+// default implementation for SQS.
+type defaultSQS struct {
+	client *sqs.Client
+}
+
+func (c *defaultSQS) DeleteMessageWithContext(ctx context.Context, input *sqs.DeleteMessageInput) (*sqs.DeleteMessageOutput, error) {
+	return c.client.DeleteMessage(ctx, input)
+}
+
+func (c *defaultSQS) GetQueueAttributesWithContext(ctx context.Context, input *sqs.GetQueueAttributesInput) (*sqs.GetQueueAttributesOutput, error) {
+	return c.client.GetQueueAttributes(ctx, input)
+}
+
+func (c *defaultSQS) ReceiveMessageWithContext(ctx context.Context, input *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error) {
+	return c.client.ReceiveMessage(ctx, input)
+}
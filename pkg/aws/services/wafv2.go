@@ -1,23 +1,88 @@
 package services
 
 import (
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/wafv2"
-	"github.com/aws/aws-sdk-go/service/wafv2/wafv2iface"
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 )
 
 type WAFv2 interface {
-	wafv2iface.WAFV2API
+	AssociateWebACLWithContext(ctx context.Context, input *wafv2.AssociateWebACLInput) (*wafv2.AssociateWebACLOutput, error)
+	CreateWebACLWithContext(ctx context.Context, input *wafv2.CreateWebACLInput) (*wafv2.CreateWebACLOutput, error)
+	DeleteWebACLWithContext(ctx context.Context, input *wafv2.DeleteWebACLInput) (*wafv2.DeleteWebACLOutput, error)
+	DisassociateWebACLWithContext(ctx context.Context, input *wafv2.DisassociateWebACLInput) (*wafv2.DisassociateWebACLOutput, error)
+	GetLoggingConfigurationWithContext(ctx context.Context, input *wafv2.GetLoggingConfigurationInput) (*wafv2.GetLoggingConfigurationOutput, error)
+	GetWebACLWithContext(ctx context.Context, input *wafv2.GetWebACLInput) (*wafv2.GetWebACLOutput, error)
+	GetWebACLForResourceWithContext(ctx context.Context, input *wafv2.GetWebACLForResourceInput) (*wafv2.GetWebACLForResourceOutput, error)
+	ListTagsForResourceWithContext(ctx context.Context, input *wafv2.ListTagsForResourceInput) (*wafv2.ListTagsForResourceOutput, error)
+	ListWebACLsWithContext(ctx context.Context, input *wafv2.ListWebACLsInput) (*wafv2.ListWebACLsOutput, error)
+	PutLoggingConfigurationWithContext(ctx context.Context, input *wafv2.PutLoggingConfigurationInput) (*wafv2.PutLoggingConfigurationOutput, error)
+	TagResourceWithContext(ctx context.Context, input *wafv2.TagResourceInput) (*wafv2.TagResourceOutput, error)
+	UntagResourceWithContext(ctx context.Context, input *wafv2.UntagResourceInput) (*wafv2.UntagResourceOutput, error)
+	UpdateWebACLWithContext(ctx context.Context, input *wafv2.UpdateWebACLInput) (*wafv2.UpdateWebACLOutput, error)
 }
 
 // NewWAFv2 constructs new WAFv2 implementation.
-func NewWAFv2(session *session.Session) WAFv2 {
+func NewWAFv2(cfg aws.Config) WAFv2 {
 	return &defaultWAFv2{
-		WAFV2API: wafv2.New(session),
+		client: wafv2.NewFromConfig(cfg),
 	}
 }
 
 // default implementation for WAFv2.
 type defaultWAFv2 struct {
-	wafv2iface.WAFV2API
+	client *wafv2.Client
+}
+
+func (c *defaultWAFv2) AssociateWebACLWithContext(ctx context.Context, input *wafv2.AssociateWebACLInput) (*wafv2.AssociateWebACLOutput, error) {
+	return c.client.AssociateWebACL(ctx, input)
+}
+
+func (c *defaultWAFv2) CreateWebACLWithContext(ctx context.Context, input *wafv2.CreateWebACLInput) (*wafv2.CreateWebACLOutput, error) {
+	return c.client.CreateWebACL(ctx, input)
+}
+
+func (c *defaultWAFv2) DeleteWebACLWithContext(ctx context.Context, input *wafv2.DeleteWebACLInput) (*wafv2.DeleteWebACLOutput, error) {
+	return c.client.DeleteWebACL(ctx, input)
+}
+
+func (c *defaultWAFv2) DisassociateWebACLWithContext(ctx context.Context, input *wafv2.DisassociateWebACLInput) (*wafv2.DisassociateWebACLOutput, error) {
+	return c.client.DisassociateWebACL(ctx, input)
+}
+
+func (c *defaultWAFv2) GetLoggingConfigurationWithContext(ctx context.Context, input *wafv2.GetLoggingConfigurationInput) (*wafv2.GetLoggingConfigurationOutput, error) {
+	return c.client.GetLoggingConfiguration(ctx, input)
+}
+
+func (c *defaultWAFv2) GetWebACLWithContext(ctx context.Context, input *wafv2.GetWebACLInput) (*wafv2.GetWebACLOutput, error) {
+	return c.client.GetWebACL(ctx, input)
+}
+
+func (c *defaultWAFv2) GetWebACLForResourceWithContext(ctx context.Context, input *wafv2.GetWebACLForResourceInput) (*wafv2.GetWebACLForResourceOutput, error) {
+	return c.client.GetWebACLForResource(ctx, input)
+}
+
+func (c *defaultWAFv2) ListTagsForResourceWithContext(ctx context.Context, input *wafv2.ListTagsForResourceInput) (*wafv2.ListTagsForResourceOutput, error) {
+	return c.client.ListTagsForResource(ctx, input)
+}
+
+func (c *defaultWAFv2) ListWebACLsWithContext(ctx context.Context, input *wafv2.ListWebACLsInput) (*wafv2.ListWebACLsOutput, error) {
+	return c.client.ListWebACLs(ctx, input)
+}
+
+func (c *defaultWAFv2) PutLoggingConfigurationWithContext(ctx context.Context, input *wafv2.PutLoggingConfigurationInput) (*wafv2.PutLoggingConfigurationOutput, error) {
+	return c.client.PutLoggingConfiguration(ctx, input)
+}
+
+func (c *defaultWAFv2) TagResourceWithContext(ctx context.Context, input *wafv2.TagResourceInput) (*wafv2.TagResourceOutput, error) {
+	return c.client.TagResource(ctx, input)
+}
+
+func (c *defaultWAFv2) UntagResourceWithContext(ctx context.Context, input *wafv2.UntagResourceInput) (*wafv2.UntagResourceOutput, error) {
+	return c.client.UntagResource(ctx, input)
+}
+
+func (c *defaultWAFv2) UpdateWebACLWithContext(ctx context.Context, input *wafv2.UpdateWebACLInput) (*wafv2.UpdateWebACLOutput, error) {
+	return c.client.UpdateWebACL(ctx, input)
 }
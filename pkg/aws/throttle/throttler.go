@@ -1,9 +1,14 @@
 package throttle
 
 import (
+	"context"
+	"regexp"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
 	"golang.org/x/time/rate"
-	"regexp"
 )
 
 const sdkHandlerRequestThrottle = "requestThrottle"
@@ -60,6 +65,12 @@ func (t *throttler) InjectHandlers(handlers *request.Handlers) {
 	})
 }
 
+// InjectMiddleware injects the request throttle into the middleware stack of aws-sdk-go-v2 clients.
+// It's added right after the retry middleware, so that each attempt is throttled like the Sign chain of aws-sdk-go.
+func (t *throttler) InjectMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(sdkHandlerRequestThrottle, t.beforeAttempt), "Retry", middleware.After)
+}
+
 // beforeSign is added to the Sign chain; called before each request
 func (t *throttler) beforeSign(r *request.Request) {
 	for _, conditionLimiter := range t.conditionLimiters {
@@ -68,3 +79,21 @@ func (t *throttler) beforeSign(r *request.Request) {
 		}
 	}
 }
+
+// beforeAttempt is added to the Finalize step of aws-sdk-go-v2 clients; called before each request attempt
+func (t *throttler) beforeAttempt(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+	middleware.FinalizeOutput, middleware.Metadata, error) {
+	// the conditions only match the service and operation of the request.
+	r := &request.Request{
+		ClientInfo: metadata.ClientInfo{ServiceID: awsmiddleware.GetServiceID(ctx)},
+		Operation:  &request.Operation{Name: awsmiddleware.GetOperationName(ctx)},
+	}
+	for _, conditionLimiter := range t.conditionLimiters {
+		if conditionLimiter.condition(r) {
+			if err := conditionLimiter.limiter.Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+		}
+	}
+	return next.HandleFinalize(ctx, in)
+}
@@ -2,10 +2,13 @@ package throttle
 
 import (
 	"context"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/time/rate"
 	"net/http"
@@ -284,3 +287,64 @@ func Test_throttler_beforeSign(t *testing.T) {
 		})
 	}
 }
+
+func Test_throttler_InjectMiddleware(t *testing.T) {
+	throttler := &throttler{}
+	stack := middleware.NewStack("CreateMesh", smithyhttp.NewStackRequest)
+	assert.NoError(t, stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("Retry", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (
+		middleware.FinalizeOutput, middleware.Metadata, error) {
+		return next.HandleFinalize(ctx, in)
+	}), middleware.After))
+	assert.NoError(t, throttler.InjectMiddleware(stack))
+	assert.Equal(t, []string{"Retry", sdkHandlerRequestThrottle}, stack.Finalize.List())
+}
+
+func Test_throttler_beforeAttempt(t *testing.T) {
+	tests := []struct {
+		name        string
+		operation   string
+		wantHandled bool
+		wantErr     bool
+	}{
+		{
+			name:        "throttled operation",
+			operation:   "CreateMesh",
+			wantHandled: false,
+			wantErr:     true,
+		},
+		{
+			name:        "other operation",
+			operation:   "DescribeMesh",
+			wantHandled: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttler := &throttler{}
+			throttler.WithOperationThrottle(appmesh.ServiceID, "CreateMesh", rate.Every(time.Hour), 1)
+			throttler.conditionLimiters[0].limiter.Allow()
+
+			stack := middleware.NewStack(tt.operation, smithyhttp.NewStackRequest)
+			assert.NoError(t, stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+				ServiceID:     appmesh.ServiceID,
+				OperationName: tt.operation,
+			}, middleware.Before))
+			assert.NoError(t, stack.Finalize.Add(middleware.FinalizeMiddlewareFunc(sdkHandlerRequestThrottle, throttler.beforeAttempt), middleware.After))
+			handled := false
+			handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+				handled = true
+				return nil, middleware.Metadata{}, nil
+			}), stack)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			_, _, err := handler.Handle(ctx, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantHandled, handled)
+		})
+	}
+}
@@ -2,7 +2,10 @@ package aws
 
 import (
 	"fmt"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/version"
 )
 
@@ -15,3 +18,8 @@ func injectUserAgent(handlers *request.Handlers) {
 		Fn:   request.MakeAddToUserAgentHandler(appName, version.GitVersion),
 	})
 }
+
+// injectUserAgentMiddleware will inject app specific user-agent into awsSDK v2 clients
+func injectUserAgentMiddleware(stack *middleware.Stack) error {
+	return awsmiddleware.AddUserAgentKeyValue(appName, version.GitVersion)(stack)
+}
@@ -3,6 +3,8 @@ package backend
 import (
 	"context"
 	"fmt"
+	"net"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
@@ -12,6 +14,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -72,7 +75,10 @@ func (r *defaultEndpointResolver) ResolvePodEndpoints(ctx context.Context, svcKe
 	if err != nil {
 		return nil, false, err
 	}
-	return r.resolvePodEndpointsWithEndpointsData(ctx, svcKey, svcPort, endpointsDataList, resolveOpts.PodReadinessGates)
+	if resolveOpts.AddressType != "" {
+		endpointsDataList = filterEndpointsDataByAddressType(endpointsDataList, resolveOpts.AddressType)
+	}
+	return r.resolvePodEndpointsWithEndpointsData(ctx, svcKey, svcPort, endpointsDataList, resolveOpts.PodReadinessGates, resolveOpts.IncludeTerminatingEndpoints)
 }
 
 func (r *defaultEndpointResolver) ResolveNodePortEndpoints(ctx context.Context, svcKey types.NamespacedName, port intstr.IntOrString, opts ...EndpointResolveOption) ([]NodePortEndpoint, error) {
@@ -134,15 +140,20 @@ func (r *defaultEndpointResolver) computeServiceEndpointsData(ctx context.Contex
 			}
 			return nil, err
 		}
+		// Endpoints are truncated to 1000 addresses, the endpoints beyond are only resolved from EndpointSlices.
+		if eps.Annotations[corev1.EndpointsOverCapacity] == "truncated" {
+			r.logger.Info("endpoints truncated, enable EndpointSlices to resolve all pod endpoints", "service", svcKey.String())
+		}
 		endpointsDataList = buildEndpointsDataFromEndpoints(eps)
 	}
 
 	return endpointsDataList, nil
 }
 
-func (r *defaultEndpointResolver) resolvePodEndpointsWithEndpointsData(ctx context.Context, svcKey types.NamespacedName, svcPort corev1.ServicePort, endpointsDataList []EndpointsData, podReadinessGates []corev1.PodConditionType) ([]PodEndpoint, bool, error) {
+func (r *defaultEndpointResolver) resolvePodEndpointsWithEndpointsData(ctx context.Context, svcKey types.NamespacedName, svcPort corev1.ServicePort, endpointsDataList []EndpointsData, podReadinessGates []corev1.PodConditionType, includeTerminatingEndpoints bool) ([]PodEndpoint, bool, error) {
 	var readyPodEndpoints []PodEndpoint
 	var unknownPodEndpoints []PodEndpoint
+	var terminatingPodEndpoints []PodEndpoint
 	containsPotentialReadyEndpoints := false
 
 	for _, epsData := range endpointsDataList {
@@ -170,7 +181,16 @@ func (r *defaultEndpointResolver) resolvePodEndpointsWithEndpointsData(ctx conte
 					containsPotentialReadyEndpoints = true
 					continue
 				}
+				// the targets of pod are deregistered ahead of its deletion, they shouldn't be registered again.
+				if pod.IsDeregistrationRequested(time.Now()) {
+					continue
+				}
 				podEndpoint := buildPodEndpoint(pod, epAddr, epPort)
+				if includeTerminatingEndpoints && isTerminatingServingEndpoint(ep) {
+					podEndpoint.Terminating = true
+					terminatingPodEndpoints = append(terminatingPodEndpoints, podEndpoint)
+					continue
+				}
 				if ep.Conditions.Ready != nil && *ep.Conditions.Ready {
 					readyPodEndpoints = append(readyPodEndpoints, podEndpoint)
 					continue
@@ -210,7 +230,30 @@ func (r *defaultEndpointResolver) resolvePodEndpointsWithEndpointsData(ctx conte
 	if r.failOpenEnabled && len(podEndpoints) == 0 {
 		podEndpoints = unknownPodEndpoints
 	}
-	return podEndpoints, containsPotentialReadyEndpoints, nil
+	podEndpoints = append(podEndpoints, terminatingPodEndpoints...)
+	return deduplicatePodEndpoints(podEndpoints), containsPotentialReadyEndpoints, nil
+}
+
+// deduplicatePodEndpoints removes the pod endpoints with the same IP and port as a previous one.
+// an endpoint can be listed by multiple EndpointSlices of a service while the EndpointSlice controller moves it between them,
+// the ready endpoints are kept over the terminating ones as they come first.
+func deduplicatePodEndpoints(podEndpoints []PodEndpoint) []PodEndpoint {
+	var deduplicatedPodEndpoints []PodEndpoint
+	seenPodEndpoints := sets.NewString()
+	for _, podEndpoint := range podEndpoints {
+		podEndpointKey := fmt.Sprintf("%v:%v", podEndpoint.IP, podEndpoint.Port)
+		if seenPodEndpoints.Has(podEndpointKey) {
+			continue
+		}
+		seenPodEndpoints.Insert(podEndpointKey)
+		deduplicatedPodEndpoints = append(deduplicatedPodEndpoints, podEndpoint)
+	}
+	return deduplicatedPodEndpoints
+}
+
+// isTerminatingServingEndpoint checks whether the endpoint belongs to a terminating pod that is still serving traffic.
+func isTerminatingServingEndpoint(ep discovery.Endpoint) bool {
+	return awssdk.BoolValue(ep.Conditions.Terminating) && awssdk.BoolValue(ep.Conditions.Serving)
 }
 
 func (r *defaultEndpointResolver) findServiceAndServicePort(ctx context.Context, svcKey types.NamespacedName, port intstr.IntOrString) (*corev1.Service, corev1.ServicePort, error) {
@@ -273,6 +316,10 @@ func buildEndpointsDataFromEndpoints(eps *corev1.Endpoints) []EndpointsData {
 func buildEndpointsDataFromEndpointSliceList(epsList *discovery.EndpointSliceList) []EndpointsData {
 	var endpointsDataList []EndpointsData
 	for _, epSlice := range epsList.Items {
+		// FQDN endpoints aren't backed by pods.
+		if epSlice.AddressType == discovery.AddressTypeFQDN {
+			continue
+		}
 		endpointsDataList = append(endpointsDataList, EndpointsData{
 			Ports:     epSlice.Ports,
 			Endpoints: epSlice.Endpoints,
@@ -281,6 +328,36 @@ func buildEndpointsDataFromEndpointSliceList(epsList *discovery.EndpointSliceLis
 	return endpointsDataList
 }
 
+// filterEndpointsDataByAddressType filters the endpoints whose address is of specific address type.
+// in dual-stack clusters, a Service has endpoints of both address types, while a TargetGroup only accepts targets of one address type.
+func filterEndpointsDataByAddressType(endpointsDataList []EndpointsData, addressType discovery.AddressType) []EndpointsData {
+	var filteredEndpointsDataList []EndpointsData
+	for _, epsData := range endpointsDataList {
+		var endpoints []discovery.Endpoint
+		for _, ep := range epsData.Endpoints {
+			if len(ep.Addresses) == 0 {
+				continue
+			}
+			if endpointAddressType(ep.Addresses[0]) == addressType {
+				endpoints = append(endpoints, ep)
+			}
+		}
+		filteredEndpointsDataList = append(filteredEndpointsDataList, EndpointsData{
+			Ports:     epsData.Ports,
+			Endpoints: endpoints,
+		})
+	}
+	return filteredEndpointsDataList
+}
+
+// endpointAddressType returns the address type of endpoint address.
+func endpointAddressType(addr string) discovery.AddressType {
+	if ip := net.ParseIP(addr); ip != nil && ip.To4() == nil {
+		return discovery.AddressTypeIPv6
+	}
+	return discovery.AddressTypeIPv4
+}
+
 func buildPodEndpoint(pod k8s.PodInfo, epAddr string, port int32) PodEndpoint {
 	return PodEndpoint{
 		IP:   epAddr,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
@@ -681,6 +682,24 @@ func Test_defaultEndpointResolver_ResolvePodEndpoints(t *testing.T) {
 		},
 	}
 
+	eps3Moved := &discovery.EndpointSlice{ // endpoints of eps3 being moved into another endpointSlice
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNS,
+			Name:      "svc-1-b",
+			Labels: map[string]string{
+				"kubernetes.io/service-name": "svc-1",
+			},
+		},
+		Ports: eps3.Ports,
+		Endpoints: []discovery.Endpoint{
+			eps3.Endpoints[0],
+			eps3.Endpoints[len(eps3.Endpoints)-1],
+		},
+	}
+
+	pod1DeregistrationRequested := pod1 // pod1 being deleted, with its targets deregistered
+	pod1DeregistrationRequested.DeregistrationRequestedAt = time.Now()
+
 	type podInfoRepoGetCall struct {
 		key    types.NamespacedName
 		pod    k8s.PodInfo
@@ -851,6 +870,73 @@ func Test_defaultEndpointResolver_ResolvePodEndpoints(t *testing.T) {
 			},
 			wantContainsPotentialReadyEndpoints: false,
 		},
+		{
+			name: "[with endpointSlices][with failOpen] ignore pods with deregistration requested",
+			env: env{
+				nodes:          []*corev1.Node{nodeA, nodeB, nodeC},
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{eps1},
+			},
+			fields: fields{
+				failOpenEnabled:      true,
+				endpointSliceEnabled: true,
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    pod1DeregistrationRequested,
+						exists: true,
+					},
+					{
+						key:    pod2.Key,
+						pod:    pod2,
+						exists: true,
+					},
+					{
+						key:    pod3.Key,
+						pod:    pod3,
+						exists: true,
+					},
+					{
+						key:    pod4.Key,
+						pod:    pod4,
+						exists: true,
+					},
+					{
+						key:    pod5.Key,
+						pod:    pod5,
+						exists: true,
+					},
+					{
+						key:    pod6.Key,
+						pod:    pod6,
+						exists: true,
+					},
+					{
+						key:    pod7.Key,
+						pod:    pod7,
+						exists: true,
+					},
+					{
+						key:    pod8.Key,
+						pod:    pod8,
+						exists: true,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   nil,
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "192.168.1.4",
+					Port: 8080,
+					Pod:  pod4,
+				},
+			},
+			wantContainsPotentialReadyEndpoints: false,
+		},
 		{
 			name: "[with endpointSlices][without failOpen] choose every ready pod only when there are ready pods",
 			env: env{
@@ -1180,6 +1266,162 @@ func Test_defaultEndpointResolver_ResolvePodEndpoints(t *testing.T) {
 			},
 			wantContainsPotentialReadyEndpoints: false,
 		},
+		{
+			name: "[with endpointSlices][with failOpen] choose every ready pod only when there are ready pods - include terminating pods that are still serving",
+			env: env{
+				nodes:          []*corev1.Node{nodeA, nodeB, nodeC},
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{eps3},
+			},
+			fields: fields{
+				failOpenEnabled:      true,
+				endpointSliceEnabled: true,
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    pod1,
+						exists: true,
+					},
+					{
+						key:    pod2.Key,
+						pod:    pod2,
+						exists: true,
+					},
+					{
+						key:    pod3.Key,
+						pod:    pod3,
+						exists: true,
+					},
+					{
+						key:    pod4.Key,
+						pod:    pod4,
+						exists: true,
+					},
+					{
+						key:    pod5.Key,
+						pod:    pod5,
+						exists: true,
+					},
+					{
+						key:    pod7.Key,
+						pod:    pod7,
+						exists: true,
+					},
+					{
+						key:    pod8.Key,
+						pod:    pod8,
+						exists: true,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   []EndpointResolveOption{WithPodReadinessGate("custom-condition"), WithTerminatingEndpoints()},
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "192.168.1.1",
+					Port: 8080,
+					Pod:  pod1,
+				},
+				{
+					IP:   "192.168.1.4",
+					Port: 8080,
+					Pod:  pod4,
+				},
+				{
+					IP:          "192.168.1.8",
+					Port:        8080,
+					Pod:         pod8,
+					Terminating: true,
+				},
+			},
+			wantContainsPotentialReadyEndpoints: false,
+		},
+		{
+			name: "[with endpointSlices][with failOpen] choose every ready pod only when there are ready pods - deduplicate endpoints listed by multiple endpointSlices",
+			env: env{
+				nodes:          []*corev1.Node{nodeA, nodeB, nodeC},
+				services:       []*corev1.Service{svc1},
+				endpointSlices: []*discovery.EndpointSlice{eps3, eps3Moved},
+			},
+			fields: fields{
+				failOpenEnabled:      true,
+				endpointSliceEnabled: true,
+				podInfoRepoGetCalls: []podInfoRepoGetCall{
+					{
+						key:    pod1.Key,
+						pod:    pod1,
+						exists: true,
+					},
+					{
+						key:    pod1.Key,
+						pod:    pod1,
+						exists: true,
+					},
+					{
+						key:    pod2.Key,
+						pod:    pod2,
+						exists: true,
+					},
+					{
+						key:    pod3.Key,
+						pod:    pod3,
+						exists: true,
+					},
+					{
+						key:    pod4.Key,
+						pod:    pod4,
+						exists: true,
+					},
+					{
+						key:    pod5.Key,
+						pod:    pod5,
+						exists: true,
+					},
+					{
+						key:    pod7.Key,
+						pod:    pod7,
+						exists: true,
+					},
+					{
+						key:    pod8.Key,
+						pod:    pod8,
+						exists: true,
+					},
+					{
+						key:    pod8.Key,
+						pod:    pod8,
+						exists: true,
+					},
+				},
+			},
+			args: args{
+				svcKey: k8s.NamespacedName(svc1),
+				port:   intstr.FromString("http"),
+				opts:   []EndpointResolveOption{WithPodReadinessGate("custom-condition"), WithTerminatingEndpoints()},
+			},
+			want: []PodEndpoint{
+				{
+					IP:   "192.168.1.1",
+					Port: 8080,
+					Pod:  pod1,
+				},
+				{
+					IP:   "192.168.1.4",
+					Port: 8080,
+					Pod:  pod4,
+				},
+				{
+					IP:          "192.168.1.8",
+					Port:        8080,
+					Pod:         pod8,
+					Terminating: true,
+				},
+			},
+			wantContainsPotentialReadyEndpoints: false,
+		},
 		{
 			name: "[with endpoints][with failOpen] choose every ready pod only when there are ready pods - ignore pods don't exists",
 			env: env{
@@ -2571,6 +2813,30 @@ func Test_buildEndpointsDataFromEndpointSliceList(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "FQDN endpointSlices are ignored",
+			args: args{
+				epsList: &discovery.EndpointSliceList{
+					Items: []discovery.EndpointSlice{
+						{
+							AddressType: discovery.AddressTypeFQDN,
+							Ports: []discovery.EndpointPort{
+								{
+									Name: awssdk.String("http"),
+									Port: awssdk.Int32(80),
+								},
+							},
+							Endpoints: []discovery.Endpoint{
+								{
+									Addresses: []string{"app.example.com"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
 		{
 			name: "no endpointSlices",
 			args: args{
@@ -2587,6 +2853,140 @@ func Test_buildEndpointsDataFromEndpointSliceList(t *testing.T) {
 	}
 }
 
+func Test_deduplicatePodEndpoints(t *testing.T) {
+	tests := []struct {
+		name         string
+		podEndpoints []PodEndpoint
+		want         []PodEndpoint
+	}{
+		{
+			name: "no duplicates",
+			podEndpoints: []PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080},
+				{IP: "192.168.1.1", Port: 8443},
+				{IP: "192.168.1.2", Port: 8080},
+			},
+			want: []PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080},
+				{IP: "192.168.1.1", Port: 8443},
+				{IP: "192.168.1.2", Port: 8080},
+			},
+		},
+		{
+			name: "duplicates keep the first pod endpoint",
+			podEndpoints: []PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080},
+				{IP: "192.168.1.2", Port: 8080},
+				{IP: "192.168.1.1", Port: 8080, Terminating: true},
+			},
+			want: []PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080},
+				{IP: "192.168.1.2", Port: 8080},
+			},
+		},
+		{
+			name:         "no pod endpoints",
+			podEndpoints: nil,
+			want:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := deduplicatePodEndpoints(tt.podEndpoints)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_filterEndpointsDataByAddressType(t *testing.T) {
+	type args struct {
+		endpointsDataList []EndpointsData
+		addressType       discovery.AddressType
+	}
+	dualStackEndpointsDataList := []EndpointsData{
+		{
+			Ports: []discovery.EndpointPort{
+				{
+					Name: awssdk.String("http"),
+					Port: awssdk.Int32(80),
+				},
+			},
+			Endpoints: []discovery.Endpoint{
+				{
+					Addresses: []string{"192.168.1.1"},
+				},
+				{
+					Addresses: []string{"2600:1f14:f8c:2701::1"},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name string
+		args args
+		want []EndpointsData
+	}{
+		{
+			name: "IPv4 endpoints",
+			args: args{
+				endpointsDataList: dualStackEndpointsDataList,
+				addressType:       discovery.AddressTypeIPv4,
+			},
+			want: []EndpointsData{
+				{
+					Ports: []discovery.EndpointPort{
+						{
+							Name: awssdk.String("http"),
+							Port: awssdk.Int32(80),
+						},
+					},
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"192.168.1.1"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IPv6 endpoints",
+			args: args{
+				endpointsDataList: dualStackEndpointsDataList,
+				addressType:       discovery.AddressTypeIPv6,
+			},
+			want: []EndpointsData{
+				{
+					Ports: []discovery.EndpointPort{
+						{
+							Name: awssdk.String("http"),
+							Port: awssdk.Int32(80),
+						},
+					},
+					Endpoints: []discovery.Endpoint{
+						{
+							Addresses: []string{"2600:1f14:f8c:2701::1"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no endpoints",
+			args: args{
+				endpointsDataList: nil,
+				addressType:       discovery.AddressTypeIPv6,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterEndpointsDataByAddressType(tt.args.endpointsDataList, tt.args.addressType)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_buildPodEndpoint(t *testing.T) {
 	type args struct {
 		pod    k8s.PodInfo
@@ -15,6 +15,8 @@ type PodEndpoint struct {
 	Port int64
 	// Pod that provides this endpoint.
 	Pod k8s.PodInfo
+	// Whether the pod is terminating but still serving, such endpoint should stay registered while draining.
+	Terminating bool
 }
 
 // An endpoint provided by nodePort as traffic proxy.
@@ -41,6 +43,15 @@ type EndpointResolveOptions struct {
 	// [Pod Endpoint] if pod readinessGates is defined, then pods from unready addresses with any of these readinessGates and containersReady condition will be included as well.
 	// By default, no readinessGate is specified.
 	PodReadinessGates []corev1.PodConditionType
+
+	// [Pod Endpoint] if enabled, endpoints of terminating pods that are still serving will be included with Terminating set.
+	// This requires EndpointSlices, as Endpoints don't expose the terminating condition.
+	// By default, terminating endpoints are not included.
+	IncludeTerminatingEndpoints bool
+
+	// [Pod Endpoint] if specified, only endpoints with addresses of this address type will be included.
+	// By default, endpoints of any address type are included.
+	AddressType discv1.AddressType
 }
 
 func (opts *EndpointResolveOptions) ApplyOptions(options []EndpointResolveOption) {
@@ -65,10 +76,26 @@ func WithPodReadinessGate(cond corev1.PodConditionType) EndpointResolveOption {
 	}
 }
 
+// WithTerminatingEndpoints is a option that includes terminating but serving pod endpoints.
+func WithTerminatingEndpoints() EndpointResolveOption {
+	return func(opts *EndpointResolveOptions) {
+		opts.IncludeTerminatingEndpoints = true
+	}
+}
+
+// WithAddressType is a option that only includes pod endpoints with addresses of specific address type.
+func WithAddressType(addressType discv1.AddressType) EndpointResolveOption {
+	return func(opts *EndpointResolveOptions) {
+		opts.AddressType = addressType
+	}
+}
+
 // defaultEndpointResolveOptions returns the default value for EndpointResolveOptions.
 func defaultEndpointResolveOptions() EndpointResolveOptions {
 	return EndpointResolveOptions{
-		NodeSelector:      labels.Nothing(),
-		PodReadinessGates: nil,
+		NodeSelector:                labels.Nothing(),
+		PodReadinessGates:           nil,
+		IncludeTerminatingEndpoints: false,
+		AddressType:                 "",
 	}
 }
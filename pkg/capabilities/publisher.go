@@ -0,0 +1,138 @@
+package capabilities
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/lint"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// defaultPublishInterval is the interval to publish the capabilities, so that the feature gates and defaults
+	// changed via the ControllerConfiguration are reflected.
+	defaultPublishInterval = 1 * time.Minute
+)
+
+// Publisher publishes the capabilities of the controller into a ControllerCapabilities object,
+// so that policy engines and UIs can discover them instead of hard-coding them per controller version.
+type Publisher interface {
+	manager.Runnable
+	manager.LeaderElectionRunnable
+}
+
+// NewDefaultPublisher constructs new defaultPublisher.
+func NewDefaultPublisher(k8sClient client.Client, controllerConfig config.ControllerConfig,
+	dynamicConfigProvider config.DynamicConfigProvider, logger logr.Logger) *defaultPublisher {
+	return &defaultPublisher{
+		k8sClient:             k8sClient,
+		name:                  controllerConfig.ControllerCapabilitiesName,
+		ingressClass:          controllerConfig.IngressConfig.IngressClass,
+		loadBalancerClass:     controllerConfig.ServiceConfig.LoadBalancerClass,
+		featureGates:          controllerConfig.FeatureGates,
+		dynamicConfigProvider: dynamicConfigProvider,
+		interval:              defaultPublishInterval,
+		logger:                logger,
+	}
+}
+
+var _ Publisher = &defaultPublisher{}
+
+// default implementation for Publisher, which publishes the capabilities periodically.
+// the ControllerCapabilities object is created when it doesn't exist, and its status is only updated on change.
+type defaultPublisher struct {
+	k8sClient             client.Client
+	name                  string
+	ingressClass          string
+	loadBalancerClass     string
+	featureGates          config.FeatureGates
+	dynamicConfigProvider config.DynamicConfigProvider
+	interval              time.Duration
+	logger                logr.Logger
+}
+
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=controllercapabilities,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=elbv2.k8s.aws,resources=controllercapabilities/status,verbs=update;patch
+
+// Start publishes the capabilities periodically until ctx is done.
+func (p *defaultPublisher) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, p.publishOnce, p.interval)
+	return nil
+}
+
+// NeedLeaderElection returns true, as the ControllerCapabilities object must only be updated by the leader.
+func (p *defaultPublisher) NeedLeaderElection() bool {
+	return true
+}
+
+func (p *defaultPublisher) publishOnce(ctx context.Context) {
+	if err := p.publish(ctx); err != nil {
+		p.logger.Error(err, "failed to publish controller capabilities", "name", p.name)
+	}
+}
+
+func (p *defaultPublisher) publish(ctx context.Context) error {
+	capabilities := &elbv2api.ControllerCapabilities{}
+	if err := p.k8sClient.Get(ctx, client.ObjectKey{Name: p.name}, capabilities); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		capabilities = &elbv2api.ControllerCapabilities{
+			ObjectMeta: metav1.ObjectMeta{Name: p.name},
+		}
+		if err := p.k8sClient.Create(ctx, capabilities); err != nil {
+			return errors.Wrap(err, "failed to create controllerCapabilities")
+		}
+		p.logger.Info("created controllerCapabilities", "name", p.name)
+	}
+
+	desiredStatus := p.buildStatus()
+	if equality.Semantic.DeepEqual(capabilities.Status, desiredStatus) {
+		return nil
+	}
+	capabilitiesOld := capabilities.DeepCopy()
+	capabilities.Status = desiredStatus
+	if err := p.k8sClient.Status().Patch(ctx, capabilities, client.MergeFrom(capabilitiesOld)); err != nil {
+		return errors.Wrap(err, "failed to update controllerCapabilities status")
+	}
+	p.logger.Info("published controller capabilities", "name", p.name)
+	return nil
+}
+
+func (p *defaultPublisher) buildStatus() elbv2api.ControllerCapabilitiesStatus {
+	featureGates := make(map[string]bool)
+	for feature, enabled := range p.featureGates.States() {
+		featureGates[string(feature)] = enabled
+	}
+	return elbv2api.ControllerCapabilitiesStatus{
+		Version:            version.GitVersion,
+		IngressClass:       p.ingressClass,
+		LoadBalancerClass:  p.loadBalancerClass,
+		IngressAnnotations: lint.SupportedIngressAnnotations(),
+		ServiceAnnotations: lint.SupportedServiceAnnotations(),
+		FeatureGates:       featureGates,
+		Defaults: elbv2api.ControllerDefaults{
+			SSLPolicy:  p.dynamicConfigProvider.DefaultSSLPolicy(),
+			TargetType: elbv2api.TargetType(p.dynamicConfigProvider.DefaultTargetType()),
+		},
+		Limits: elbv2api.ControllerLimits{
+			LoadBalancerNameMaxLength:      elbv2model.LoadBalancerNameMaxLength,
+			ListenerRulePriorityMin:        ingress.MinListenerRulePriority,
+			ListenerRulePriorityMax:        ingress.MaxListenerRulePriority,
+			ListenerRuleConditionValuesMax: ingress.MaxConditionValuesPerRule,
+			ListenerRulesDefaultQuota:      ingress.DefaultListenerRulesQuota,
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package capabilities
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultPublisher_publish(t *testing.T) {
+	tests := []struct {
+		name             string
+		existingObjects  []client.Object
+		defaultSSLPolicy string
+	}{
+		{
+			name:             "controllerCapabilities doesn't exist",
+			defaultSSLPolicy: "ELBSecurityPolicy-2016-08",
+		},
+		{
+			name: "controllerCapabilities exists with stale status",
+			existingObjects: []client.Object{
+				&elbv2api.ControllerCapabilities{
+					ObjectMeta: metav1.ObjectMeta{Name: "alb"},
+					Status: elbv2api.ControllerCapabilitiesStatus{
+						Defaults: elbv2api.ControllerDefaults{SSLPolicy: "ELBSecurityPolicy-2016-08"},
+					},
+				},
+			},
+			defaultSSLPolicy: "ELBSecurityPolicy-TLS13-1-2-2021-06",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(tt.existingObjects...).Build()
+			featureGates := config.NewFeatureGates()
+			featureGates.Enable(config.Blocklist)
+			controllerConfig := config.ControllerConfig{
+				ControllerCapabilitiesName: "alb",
+				IngressConfig:              config.IngressConfig{IngressClass: "alb"},
+				ServiceConfig:              config.ServiceConfig{LoadBalancerClass: "service.k8s.aws/nlb"},
+				FeatureGates:               featureGates,
+			}
+			dynamicConfigProvider := config.NewDynamicConfigProvider(config.DynamicConfig{
+				DefaultSSLPolicy:  tt.defaultSSLPolicy,
+				DefaultTargetType: "ip",
+			}, featureGates)
+
+			p := NewDefaultPublisher(k8sClient, controllerConfig, dynamicConfigProvider, log.Log)
+			err := p.publish(context.Background())
+			assert.NoError(t, err)
+
+			capabilities := &elbv2api.ControllerCapabilities{}
+			assert.NoError(t, k8sClient.Get(context.Background(), client.ObjectKey{Name: "alb"}, capabilities))
+			assert.Equal(t, "alb", capabilities.Status.IngressClass)
+			assert.Equal(t, "service.k8s.aws/nlb", capabilities.Status.LoadBalancerClass)
+			assert.Equal(t, elbv2api.ControllerDefaults{SSLPolicy: tt.defaultSSLPolicy, TargetType: elbv2api.TargetTypeIP}, capabilities.Status.Defaults)
+			assert.True(t, capabilities.Status.FeatureGates[string(config.Blocklist)])
+			assert.Contains(t, capabilities.Status.IngressAnnotations, "alb.ingress.kubernetes.io/scheme")
+			assert.Contains(t, capabilities.Status.IngressAnnotations, "alb.ingress.kubernetes.io/actions.*")
+			assert.Contains(t, capabilities.Status.ServiceAnnotations, "service.beta.kubernetes.io/aws-load-balancer-scheme")
+			assert.Equal(t, int32(32), capabilities.Status.Limits.LoadBalancerNameMaxLength)
+			assert.Equal(t, int32(50000), capabilities.Status.Limits.ListenerRulePriorityMax)
+		})
+	}
+}
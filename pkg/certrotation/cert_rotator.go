@@ -0,0 +1,233 @@
+package certrotation
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	secretKeyTLSCert = "tls.crt"
+	secretKeyTLSKey  = "tls.key"
+	secretKeyCACert  = "ca.crt"
+	secretKeyCAKey   = "ca.key"
+
+	caValidity               = 10 * 365 * 24 * time.Hour
+	defaultReconcileInterval = 10 * time.Minute
+	serviceDomainLabel       = "svc"
+)
+
+// NewCertRotator constructs new CertRotator
+func NewCertRotator(k8sClient client.Client, apiReader client.Reader, cfg Config, logger logr.Logger) *CertRotator {
+	return &CertRotator{
+		k8sClient:         k8sClient,
+		apiReader:         apiReader,
+		cfg:               cfg,
+		logger:            logger,
+		reconcileInterval: defaultReconcileInterval,
+		now:               time.Now,
+	}
+}
+
+var _ manager.Runnable = &CertRotator{}
+var _ manager.LeaderElectionRunnable = &CertRotator{}
+
+// CertRotator rotates the webhook serving certificate before expiry.
+// The serving certificate and its CA are stored in a secret, which is mounted into the controller pods, and the
+// webhook server reloads the serving certificate once the mounted files change.
+// To rotate without dropping admissions, a new CA is always published to the caBundle of webhook configurations before
+// serving certificates signed by it are issued, and previous CAs stay in the caBundle until they expire.
+type CertRotator struct {
+	k8sClient client.Client
+	// apiReader is used for reads, so that no informers are needed for secrets and webhook configurations.
+	apiReader client.Reader
+	cfg       Config
+	logger    logr.Logger
+
+	reconcileInterval time.Duration
+	now               func() time.Time
+}
+
+// Start runs the rotation periodically until ctx is done.
+func (r *CertRotator) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.Reconcile(ctx); err != nil {
+			r.logger.Error(err, "failed to rotate webhook certificate")
+		}
+	}, r.reconcileInterval)
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, only the leader rotates certificates.
+func (r *CertRotator) NeedLeaderElection() bool {
+	return true
+}
+
+// Reconcile ensures the webhook serving certificate is valid and trusted by the webhook configurations.
+func (r *CertRotator) Reconcile(ctx context.Context) error {
+	now := r.now()
+	secret, err := r.getOrCreateSecret(ctx)
+	if err != nil {
+		return err
+	}
+	ca, err := r.reconcileCA(ctx, secret, now)
+	if err != nil {
+		return err
+	}
+	if err := r.reconcileCABundle(ctx, secret.Data[secretKeyCACert]); err != nil {
+		return err
+	}
+	return r.reconcileServingCert(ctx, secret, ca, now)
+}
+
+func (r *CertRotator) getOrCreateSecret(ctx context.Context) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	secretKey := types.NamespacedName{Namespace: r.cfg.WebhookCertSecretNamespace, Name: r.cfg.WebhookCertSecretName}
+	if err := r.apiReader.Get(ctx, secretKey, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: secretKey.Namespace,
+				Name:      secretKey.Name,
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				secretKeyTLSCert: {},
+				secretKeyTLSKey:  {},
+			},
+		}
+		if err := r.k8sClient.Create(ctx, secret); err != nil {
+			return nil, err
+		}
+		r.logger.Info("created webhook certificate secret", "secret", secretKey)
+	}
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	return secret, nil
+}
+
+// reconcileCA returns the current CA, generating a new one if it cannot sign a serving certificate for its full validity.
+func (r *CertRotator) reconcileCA(ctx context.Context, secret *corev1.Secret, now time.Time) (*keyPair, error) {
+	var caCerts []*x509.Certificate
+	if bundle, err := parseCertificates(secret.Data[secretKeyCACert]); err != nil {
+		r.logger.Info("discarding invalid caBundle in webhook certificate secret", "error", err.Error())
+	} else {
+		caCerts = bundle
+	}
+	ca, err := parseKeyPair(secret.Data[secretKeyCACert], secret.Data[secretKeyCAKey])
+	if err != nil || !now.Add(r.cfg.WebhookCertValidity).Before(ca.cert.NotAfter) {
+		ca, err = generateCA(now, caValidity)
+		if err != nil {
+			return nil, err
+		}
+		r.logger.Info("generated webhook CA", "notAfter", ca.cert.NotAfter)
+	}
+
+	// the current CA always comes first, previous CAs are kept until they expire so that serving certificates signed
+	// by them remain trusted until every controller pod reloads the new one.
+	bundle := []*x509.Certificate{ca.cert}
+	for _, caCert := range caCerts {
+		if caCert.Equal(ca.cert) || !now.Before(caCert.NotAfter) {
+			continue
+		}
+		bundle = append(bundle, caCert)
+	}
+	caBundlePEM := encodeCertificates(bundle)
+	if bytes.Equal(secret.Data[secretKeyCACert], caBundlePEM) && bytes.Equal(secret.Data[secretKeyCAKey], ca.keyPEM) {
+		return ca, nil
+	}
+	secret.Data[secretKeyCACert] = caBundlePEM
+	secret.Data[secretKeyCAKey] = ca.keyPEM
+	if err := r.k8sClient.Update(ctx, secret); err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// reconcileCABundle publishes the caBundle to all webhooks within the managed webhook configurations.
+func (r *CertRotator) reconcileCABundle(ctx context.Context, caBundle []byte) error {
+	if r.cfg.MutatingWebhookConfigurationName != "" {
+		mwc := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		if err := r.apiReader.Get(ctx, types.NamespacedName{Name: r.cfg.MutatingWebhookConfigurationName}, mwc); err != nil {
+			return err
+		}
+		oldMWC := mwc.DeepCopy()
+		changed := false
+		for i := range mwc.Webhooks {
+			if !bytes.Equal(mwc.Webhooks[i].ClientConfig.CABundle, caBundle) {
+				mwc.Webhooks[i].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if err := r.k8sClient.Patch(ctx, mwc, client.MergeFrom(oldMWC)); err != nil {
+				return err
+			}
+			r.logger.Info("updated caBundle", "mutatingWebhookConfiguration", mwc.Name)
+		}
+	}
+	if r.cfg.ValidatingWebhookConfigurationName != "" {
+		vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		if err := r.apiReader.Get(ctx, types.NamespacedName{Name: r.cfg.ValidatingWebhookConfigurationName}, vwc); err != nil {
+			return err
+		}
+		oldVWC := vwc.DeepCopy()
+		changed := false
+		for i := range vwc.Webhooks {
+			if !bytes.Equal(vwc.Webhooks[i].ClientConfig.CABundle, caBundle) {
+				vwc.Webhooks[i].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			if err := r.k8sClient.Patch(ctx, vwc, client.MergeFrom(oldVWC)); err != nil {
+				return err
+			}
+			r.logger.Info("updated caBundle", "validatingWebhookConfiguration", vwc.Name)
+		}
+	}
+	return nil
+}
+
+// reconcileServingCert issues a new serving certificate if the current one is invalid or about to expire.
+func (r *CertRotator) reconcileServingCert(ctx context.Context, secret *corev1.Secret, ca *keyPair, now time.Time) error {
+	dnsNames := r.servingCertDNSNames()
+	if certs, err := parseCertificates(secret.Data[secretKeyTLSCert]); err == nil && len(certs) != 0 &&
+		isServingCertUsable(certs[0], ca.cert, dnsNames, now, r.cfg.WebhookCertRenewBefore) {
+		return nil
+	}
+	servingCert, err := generateServingCert(ca, dnsNames, now, r.cfg.WebhookCertValidity)
+	if err != nil {
+		return err
+	}
+	secret.Data[secretKeyTLSCert] = servingCert.certPEM
+	secret.Data[secretKeyTLSKey] = servingCert.keyPEM
+	if err := r.k8sClient.Update(ctx, secret); err != nil {
+		return errors.Wrap(err, "failed to update webhook certificate secret")
+	}
+	r.logger.Info("issued webhook serving certificate", "notAfter", servingCert.cert.NotAfter)
+	return nil
+}
+
+func (r *CertRotator) servingCertDNSNames() []string {
+	return []string{
+		fmt.Sprintf("%s.%s", r.cfg.WebhookServiceName, r.cfg.WebhookCertSecretNamespace),
+		fmt.Sprintf("%s.%s.%s", r.cfg.WebhookServiceName, r.cfg.WebhookCertSecretNamespace, serviceDomainLabel),
+	}
+}
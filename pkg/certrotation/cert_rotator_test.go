@@ -0,0 +1,174 @@
+package certrotation
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_CertRotator_Reconcile(t *testing.T) {
+	cfg := Config{
+		EnableWebhookCertRotation:          true,
+		WebhookCertSecretNamespace:         "kube-system",
+		WebhookCertSecretName:              "aws-load-balancer-tls",
+		WebhookServiceName:                 "aws-load-balancer-webhook-service",
+		MutatingWebhookConfigurationName:   "aws-load-balancer-webhook",
+		ValidatingWebhookConfigurationName: "aws-load-balancer-webhook",
+		WebhookCertValidity:                365 * 24 * time.Hour,
+		WebhookCertRenewBefore:             30 * 24 * time.Hour,
+	}
+	secretKey := types.NamespacedName{Namespace: "kube-system", Name: "aws-load-balancer-tls"}
+	wantDNSNames := []string{
+		"aws-load-balancer-webhook-service.kube-system",
+		"aws-load-balancer-webhook-service.kube-system.svc",
+	}
+	startTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := fake.NewClientBuilder().WithScheme(k8sSchema).WithObjects(
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-load-balancer-webhook"},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{
+				{Name: "mpod.elbv2.k8s.aws"},
+				{Name: "mservice.elbv2.k8s.aws"},
+			},
+		},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-load-balancer-webhook"},
+			Webhooks: []admissionregistrationv1.ValidatingWebhook{
+				{Name: "vingress.elbv2.k8s.aws"},
+			},
+		},
+	).Build()
+	r := NewCertRotator(k8sClient, k8sClient, cfg, logr.New(&log.NullLogSink{}))
+
+	reconcileAt := func(now time.Time) *corev1.Secret {
+		r.now = func() time.Time { return now }
+		assert.NoError(t, r.Reconcile(context.Background()))
+		secret := &corev1.Secret{}
+		assert.NoError(t, k8sClient.Get(context.Background(), secretKey, secret))
+		return secret
+	}
+	verifyServingCert := func(secret *corev1.Secret, now time.Time) *x509.Certificate {
+		caCerts, err := parseCertificates(secret.Data[secretKeyCACert])
+		assert.NoError(t, err)
+		roots := poolOf(caCerts)
+		servingCerts, err := parseCertificates(secret.Data[secretKeyTLSCert])
+		assert.NoError(t, err)
+		assert.Len(t, servingCerts, 1)
+		assert.Equal(t, wantDNSNames, servingCerts[0].DNSNames)
+		for _, dnsName := range wantDNSNames {
+			_, err := servingCerts[0].Verify(x509.VerifyOptions{DNSName: dnsName, Roots: roots, CurrentTime: now})
+			assert.NoError(t, err)
+		}
+
+		mwc := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		assert.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Name: "aws-load-balancer-webhook"}, mwc))
+		for _, webhook := range mwc.Webhooks {
+			assert.Equal(t, secret.Data[secretKeyCACert], webhook.ClientConfig.CABundle)
+		}
+		vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		assert.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Name: "aws-load-balancer-webhook"}, vwc))
+		for _, webhook := range vwc.Webhooks {
+			assert.Equal(t, secret.Data[secretKeyCACert], webhook.ClientConfig.CABundle)
+		}
+		return servingCerts[0]
+	}
+
+	// initial reconcile creates the secret, CA and serving certificate.
+	secret := reconcileAt(startTime)
+	initialServingCert := verifyServingCert(secret, startTime)
+	initialCACert := secret.Data[secretKeyCACert]
+
+	// serving certificate isn't rotated when not due for renewal.
+	secret = reconcileAt(startTime.Add(24 * time.Hour))
+	assert.True(t, initialServingCert.Equal(verifyServingCert(secret, startTime.Add(24*time.Hour))))
+
+	// serving certificate is rotated once due for renewal, with the CA unchanged.
+	renewTime := startTime.Add(cfg.WebhookCertValidity - cfg.WebhookCertRenewBefore + time.Hour)
+	secret = reconcileAt(renewTime)
+	renewedServingCert := verifyServingCert(secret, renewTime)
+	assert.False(t, initialServingCert.Equal(renewedServingCert))
+	assert.Equal(t, initialCACert, secret.Data[secretKeyCACert])
+
+	// CA is rotated once it cannot sign serving certificates for their full validity, while the previous CA stays
+	// trusted until it expires.
+	caRotateTime := startTime.Add(caValidity - cfg.WebhookCertValidity + time.Hour)
+	secret = reconcileAt(caRotateTime)
+	verifyServingCert(secret, caRotateTime)
+	caCerts, err := parseCertificates(secret.Data[secretKeyCACert])
+	assert.NoError(t, err)
+	assert.Len(t, caCerts, 2)
+	assert.Equal(t, initialCACert, encodeCertificates(caCerts[1:]))
+
+	// expired CAs are pruned from caBundle.
+	pruneTime := startTime.Add(caValidity + time.Hour)
+	secret = reconcileAt(pruneTime)
+	verifyServingCert(secret, pruneTime)
+	caCerts, err = parseCertificates(secret.Data[secretKeyCACert])
+	assert.NoError(t, err)
+	assert.Len(t, caCerts, 1)
+}
+
+func Test_CertRotator_Reconcile_replacesForeignCertificate(t *testing.T) {
+	cfg := Config{
+		EnableWebhookCertRotation:  true,
+		WebhookCertSecretNamespace: "kube-system",
+		WebhookCertSecretName:      "aws-load-balancer-tls",
+		WebhookServiceName:         "aws-load-balancer-webhook-service",
+		WebhookCertValidity:        365 * 24 * time.Hour,
+		WebhookCertRenewBefore:     30 * 24 * time.Hour,
+	}
+	now := time.Now()
+	foreignCA, err := generateCA(now, caValidity)
+	assert.NoError(t, err)
+	foreignServingCert, err := generateServingCert(foreignCA, []string{"aws-load-balancer-webhook-service.kube-system.svc"}, now, cfg.WebhookCertValidity)
+	assert.NoError(t, err)
+
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	k8sClient := fake.NewClientBuilder().WithScheme(k8sSchema).WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "aws-load-balancer-tls"},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				secretKeyCACert:  foreignCA.certPEM,
+				secretKeyTLSCert: foreignServingCert.certPEM,
+				secretKeyTLSKey:  foreignServingCert.keyPEM,
+			},
+		},
+	).Build()
+	r := NewCertRotator(k8sClient, k8sClient, cfg, logr.New(&log.NullLogSink{}))
+	assert.NoError(t, r.Reconcile(context.Background()))
+
+	secret := &corev1.Secret{}
+	assert.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: "kube-system", Name: "aws-load-balancer-tls"}, secret))
+	caCerts, err := parseCertificates(secret.Data[secretKeyCACert])
+	assert.NoError(t, err)
+	assert.Len(t, caCerts, 2)
+	assert.True(t, caCerts[1].Equal(foreignCA.cert))
+	servingCert, err := parseKeyPair(secret.Data[secretKeyTLSCert], secret.Data[secretKeyTLSKey])
+	assert.NoError(t, err)
+	assert.NoError(t, servingCert.cert.CheckSignatureFrom(caCerts[0]))
+}
+
+func poolOf(certs []*x509.Certificate) *x509.CertPool {
+	pool := x509.NewCertPool()
+	for _, cert := range certs {
+		pool.AddCert(cert)
+	}
+	return pool
+}
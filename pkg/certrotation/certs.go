@@ -0,0 +1,157 @@
+package certrotation
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	pemTypeCertificate  = "CERTIFICATE"
+	pemTypeECPrivateKey = "EC PRIVATE KEY"
+	certNotBeforeSkew   = 5 * time.Minute
+	caCommonName        = "aws-load-balancer-controller-webhook-ca"
+)
+
+// keyPair is a certificate along with its private key.
+type keyPair struct {
+	cert    *x509.Certificate
+	certPEM []byte
+	keyPEM  []byte
+	key     *ecdsa.PrivateKey
+}
+
+// generateCA generates a self-signed CA valid from now until now + validity.
+func generateCA(now time.Time, validity time.Duration) (*keyPair, error) {
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: caCommonName},
+		NotBefore:             now.Add(-certNotBeforeSkew),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	return generateKeyPair(template, nil)
+}
+
+// generateServingCert generates a serving certificate for dnsNames signed by ca.
+func generateServingCert(ca *keyPair, dnsNames []string, now time.Time, validity time.Duration) (*keyPair, error) {
+	template := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:    dnsNames,
+		NotBefore:   now.Add(-certNotBeforeSkew),
+		NotAfter:    now.Add(validity),
+		KeyUsage:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	return generateKeyPair(template, ca)
+}
+
+func generateKeyPair(template *x509.Certificate, parent *keyPair) (*keyPair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate private key")
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate serial number")
+	}
+	template.SerialNumber = serialNumber
+
+	parentCert, signerKey := template, key
+	if parent != nil {
+		parentCert, signerKey = parent.cert, parent.key
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, signerKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create certificate")
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &keyPair{
+		cert:    cert,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: certDER}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: pemTypeECPrivateKey, Bytes: keyDER}),
+		key:     key,
+	}, nil
+}
+
+// parseKeyPair parses a PEM encoded certificate and ECDSA private key generated by generateKeyPair.
+func parseKeyPair(certPEM []byte, keyPEM []byte) (*keyPair, error) {
+	certs, err := parseCertificates(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil || keyBlock.Type != pemTypeECPrivateKey {
+		return nil, errors.New("no EC private key found")
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &keyPair{
+		cert:    certs[0],
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: pemTypeCertificate, Bytes: certs[0].Raw}),
+		keyPEM:  keyPEM,
+		key:     key,
+	}, nil
+}
+
+// parseCertificates parses all certificates from PEM encoded data.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs, nil
+		}
+		if block.Type != pemTypeCertificate {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+}
+
+// encodeCertificates encodes certificates into PEM encoded data.
+func encodeCertificates(certs []*x509.Certificate) []byte {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		_ = pem.Encode(&buf, &pem.Block{Type: pemTypeCertificate, Bytes: cert.Raw})
+	}
+	return buf.Bytes()
+}
+
+// isServingCertUsable checks whether the serving cert is signed by ca, covers dnsNames and doesn't need renewal at now.
+func isServingCertUsable(cert *x509.Certificate, ca *x509.Certificate, dnsNames []string, now time.Time, renewBefore time.Duration) bool {
+	if cert.CheckSignatureFrom(ca) != nil {
+		return false
+	}
+	if !sets.NewString(cert.DNSNames...).HasAll(dnsNames...) {
+		return false
+	}
+	return now.Add(renewBefore).Before(cert.NotAfter)
+}
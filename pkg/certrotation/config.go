@@ -0,0 +1,76 @@
+package certrotation
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagEnableWebhookCertRotation          = "enable-webhook-cert-rotation"
+	flagWebhookCertSecretNamespace         = "webhook-cert-secret-namespace"
+	flagWebhookCertSecretName              = "webhook-cert-secret-name"
+	flagWebhookServiceName                 = "webhook-service-name"
+	flagMutatingWebhookConfigurationName   = "mutating-webhook-configuration-name"
+	flagValidatingWebhookConfigurationName = "validating-webhook-configuration-name"
+	flagWebhookCertValidity                = "webhook-cert-validity"
+	flagWebhookCertRenewBefore             = "webhook-cert-renew-before"
+
+	defaultWebhookCertValidity    = 365 * 24 * time.Hour
+	defaultWebhookCertRenewBefore = 30 * 24 * time.Hour
+)
+
+// Config contains the configuration for webhook serving certificate rotation.
+type Config struct {
+	// EnableWebhookCertRotation specifies whether the controller manages the webhook serving certificate.
+	EnableWebhookCertRotation bool
+	// WebhookCertSecretNamespace is the namespace of the secret that stores the webhook serving certificate.
+	WebhookCertSecretNamespace string
+	// WebhookCertSecretName is the name of the secret that stores the webhook serving certificate.
+	WebhookCertSecretName string
+	// WebhookServiceName is the name of the webhook service, which must be in the same namespace as the secret.
+	WebhookServiceName string
+	// MutatingWebhookConfigurationName is the name of the MutatingWebhookConfiguration whose caBundle is managed.
+	MutatingWebhookConfigurationName string
+	// ValidatingWebhookConfigurationName is the name of the ValidatingWebhookConfiguration whose caBundle is managed.
+	ValidatingWebhookConfigurationName string
+	// WebhookCertValidity is the validity of issued webhook serving certificates.
+	WebhookCertValidity time.Duration
+	// WebhookCertRenewBefore is the duration before expiry when webhook serving certificates are renewed.
+	WebhookCertRenewBefore time.Duration
+}
+
+func (cfg *Config) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&cfg.EnableWebhookCertRotation, flagEnableWebhookCertRotation, false,
+		`If enabled, the controller rotates the webhook serving certificate before expiry and updates the caBundle of webhook configurations`)
+	fs.StringVar(&cfg.WebhookCertSecretNamespace, flagWebhookCertSecretNamespace, "",
+		`Namespace of the secret that stores the webhook serving certificate`)
+	fs.StringVar(&cfg.WebhookCertSecretName, flagWebhookCertSecretName, "",
+		`Name of the secret that stores the webhook serving certificate`)
+	fs.StringVar(&cfg.WebhookServiceName, flagWebhookServiceName, "",
+		`Name of the webhook service, in the namespace of the webhook cert secret`)
+	fs.StringVar(&cfg.MutatingWebhookConfigurationName, flagMutatingWebhookConfigurationName, "",
+		`Name of the MutatingWebhookConfiguration whose caBundle is managed`)
+	fs.StringVar(&cfg.ValidatingWebhookConfigurationName, flagValidatingWebhookConfigurationName, "",
+		`Name of the ValidatingWebhookConfiguration whose caBundle is managed`)
+	fs.DurationVar(&cfg.WebhookCertValidity, flagWebhookCertValidity, defaultWebhookCertValidity,
+		`Validity of the webhook serving certificates issued by the controller`)
+	fs.DurationVar(&cfg.WebhookCertRenewBefore, flagWebhookCertRenewBefore, defaultWebhookCertRenewBefore,
+		`Duration before expiry when the webhook serving certificate is renewed`)
+}
+
+// Validate the webhook cert rotation configuration
+func (cfg *Config) Validate() error {
+	if !cfg.EnableWebhookCertRotation {
+		return nil
+	}
+	if cfg.WebhookCertSecretNamespace == "" || cfg.WebhookCertSecretName == "" || cfg.WebhookServiceName == "" {
+		return errors.Errorf("%v, %v and %v flags must be specified when %v is enabled",
+			flagWebhookCertSecretNamespace, flagWebhookCertSecretName, flagWebhookServiceName, flagEnableWebhookCertRotation)
+	}
+	if cfg.WebhookCertRenewBefore <= 0 || cfg.WebhookCertRenewBefore >= cfg.WebhookCertValidity {
+		return errors.Errorf("%v must be positive and shorter than %v", flagWebhookCertRenewBefore, flagWebhookCertValidity)
+	}
+	return nil
+}
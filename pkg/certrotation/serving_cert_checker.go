@@ -0,0 +1,45 @@
+package certrotation
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+const (
+	servingCertCheckerDialTimeout = 10 * time.Second
+)
+
+// NewServingCertChecker constructs a healthz.Checker that probes the webhook server at host:port, and fails once
+// the serving certificate it presents has expired, e.g. because rotated certificates weren't reloaded.
+func NewServingCertChecker(host string, port int) healthz.Checker {
+	return newServingCertChecker(host, port, time.Now)
+}
+
+func newServingCertChecker(host string, port int, now func() time.Time) healthz.Checker {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: true, //nolint:gosec // config is used to connect to our own webhook port.
+	}
+	return func(req *http.Request) error {
+		d := &net.Dialer{Timeout: servingCertCheckerDialTimeout}
+		conn, err := tls.DialWithDialer(d, "tcp", net.JoinHostPort(host, strconv.Itoa(port)), tlsConfig)
+		if err != nil {
+			return errors.Wrap(err, "webhook server is not reachable")
+		}
+		defer conn.Close()
+
+		peerCerts := conn.ConnectionState().PeerCertificates
+		if len(peerCerts) == 0 {
+			return errors.New("webhook server presented no serving certificate")
+		}
+		if notAfter := peerCerts[0].NotAfter; !now().Before(notAfter) {
+			return errors.Errorf("webhook serving certificate expired at %v", notAfter)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,52 @@
+package certrotation
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_servingCertChecker(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	host, portStr, err := net.SplitHostPort(server.Listener.Addr().String())
+	assert.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	assert.NoError(t, err)
+	notAfter := server.Certificate().NotAfter
+
+	tests := []struct {
+		name    string
+		port    int
+		now     time.Time
+		wantErr bool
+	}{
+		{
+			name: "serving certificate not expired",
+			port: port,
+			now:  notAfter.Add(-time.Hour),
+		},
+		{
+			name:    "serving certificate expired",
+			port:    port,
+			now:     notAfter.Add(time.Hour),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := newServingCertChecker(host, tt.port, func() time.Time { return tt.now })
+			err := checker(nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,39 +1,110 @@
 package config
 
 import (
+	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certrotation"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/inject"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
 const (
-	flagLogLevel                                     = "log-level"
-	flagK8sClusterName                               = "cluster-name"
-	flagDefaultTags                                  = "default-tags"
-	flagDefaultTargetType                            = "default-target-type"
-	flagExternalManagedTags                          = "external-managed-tags"
-	flagServiceTargetENISGTags                       = "service-target-eni-security-group-tags"
-	flagServiceMaxConcurrentReconciles               = "service-max-concurrent-reconciles"
-	flagTargetGroupBindingMaxConcurrentReconciles    = "targetgroupbinding-max-concurrent-reconciles"
-	flagTargetGroupBindingMaxExponentialBackoffDelay = "targetgroupbinding-max-exponential-backoff-delay"
-	flagDefaultSSLPolicy                             = "default-ssl-policy"
-	flagEnableBackendSG                              = "enable-backend-security-group"
-	flagBackendSecurityGroup                         = "backend-security-group"
-	flagEnableEndpointSlices                         = "enable-endpoint-slices"
-	flagDisableRestrictedSGRules                     = "disable-restricted-sg-rules"
-	defaultLogLevel                                  = "info"
-	defaultMaxConcurrentReconciles                   = 3
-	defaultMaxExponentialBackoffDelay                = time.Second * 1000
-	defaultSSLPolicy                                 = "ELBSecurityPolicy-2016-08"
-	defaultEnableBackendSG                           = true
-	defaultEnableEndpointSlices                      = false
-	defaultDisableRestrictedSGRules                  = false
+	flagLogLevel                                       = "log-level"
+	flagK8sClusterName                                 = "cluster-name"
+	flagDefaultTags                                    = "default-tags"
+	flagDefaultTargetType                              = "default-target-type"
+	flagExternalManagedTags                            = "external-managed-tags"
+	flagDeniedTagKeyPrefixes                           = "denied-tag-key-prefixes"
+	flagTagEnforcementMode                             = "tag-enforcement-mode"
+	flagCrossZoneDisableValidationMode                 = "cross-zone-disable-validation-mode"
+	flagServiceTargetENISGTags                         = "service-target-eni-security-group-tags"
+	flagServiceMaxConcurrentReconciles                 = "service-max-concurrent-reconciles"
+	flagTargetGroupBindingMaxConcurrentReconciles      = "targetgroupbinding-max-concurrent-reconciles"
+	flagGatewayMaxConcurrentReconciles                 = "gateway-max-concurrent-reconciles"
+	flagTargetGroupBindingMaxExponentialBackoffDelay   = "targetgroupbinding-max-exponential-backoff-delay"
+	flagTargetGroupBindingTargetsBatchWindow           = "targetgroupbinding-targets-batch-window"
+	flagTargetGroupBindingTargetsBatchConcurrency      = "targetgroupbinding-targets-batch-max-concurrency"
+	flagTargetGroupBindingTargetHealthPollInterval     = "targetgroupbinding-target-health-poll-interval"
+	flagTargetGroupBindingEndpointChangeDebounceWindow = "targetgroupbinding-endpoint-change-debounce-window"
+	flagTargetGroupBindingEndpointChangeMaxStaleness   = "targetgroupbinding-endpoint-change-max-staleness"
+	flagDefaultSSLPolicy                               = "default-ssl-policy"
+	flagEnableBackendSG                                = "enable-backend-security-group"
+	flagBackendSecurityGroup                           = "backend-security-group"
+	flagBackendSecurityGroupReleaseGracePeriod         = "backend-security-group-release-grace-period"
+	flagBackendSecurityGroupShareKey                   = "backend-security-group-share-key"
+	flagBackendSecurityGroupDeletionEventsQueueURL     = "backend-security-group-deletion-events-queue-url"
+	flagEnableEndpointSlices                           = "enable-endpoint-slices"
+	flagDisableRestrictedSGRules                       = "disable-restricted-sg-rules"
+	flagRestrictSGRulesToNodeSubnets                   = "restrict-sg-rules-to-node-subnets"
+	flagSubnetsDiscoveryStrategy                       = "subnets-discovery-strategy"
+	flagSubnetsDiscoveryTags                           = "subnets-discovery-tags"
+	flagSubnetsDiscoveryIncludeZones                   = "subnets-discovery-include-zones"
+	flagSubnetsDiscoveryExcludeZones                   = "subnets-discovery-exclude-zones"
+	flagSubnetsDiscoveryMinFreeIPs                     = "subnets-discovery-min-free-ips"
+	flagAllowedAvailabilityZones                       = "allowed-availability-zones"
+	flagSecurityGroupDriftReportMode                   = "security-group-drift-report-mode"
+	flagSecurityGroupDriftReportConfigMap              = "security-group-drift-report-configmap"
+	flagDryRun                                         = "dry-run"
+	flagShadowMode                                     = "shadow-mode"
+	flagShadowReportConfigMap                          = "shadow-report-configmap"
+	flagControllerConfigurationName                    = "controller-configuration-name"
+	flagControllerCapabilitiesName                     = "controller-capabilities-name"
+	flagRoute53HostedZoneIDs                           = "route53-hosted-zone-ids"
+	flagAllowedAWSRoleARNs                             = "allowed-aws-role-arns"
+	flagLBDeleteDNSGracePeriod                         = "lb-delete-dns-grace-period"
+	flagLogModelDiff                                   = "log-model-diff"
+	flagNodeInstanceCacheVerifyInterval                = "node-instance-cache-verify-interval"
+	flagReportAWSAPICalls                              = "report-aws-api-calls"
+	defaultLogLevel                                    = "info"
+	defaultMaxConcurrentReconciles                     = 3
+	defaultMaxExponentialBackoffDelay                  = time.Second * 1000
+	defaultSSLPolicy                                   = "ELBSecurityPolicy-2016-08"
+	defaultEnableBackendSG                             = true
+	defaultBackendSGReleaseGracePeriod                 = 0
+	defaultEnableEndpointSlices                        = true
+	defaultDisableRestrictedSGRules                    = false
+	defaultRestrictSGRulesToNodeSubnets                = false
+	defaultSubnetsDiscoveryStrategy                    = "tag"
+	defaultTargetsBatchWindow                          = 0
+	defaultTargetsBatchMaxConcurrency                  = 2
+	defaultTargetHealthPollInterval                    = 15 * time.Second
+	defaultEndpointChangeDebounceWindow                = 0
+	defaultEndpointChangeMaxStaleness                  = 10 * time.Second
+	defaultSecurityGroupDriftReportMode                = false
+	defaultDryRun                                      = false
+	defaultShadowMode                                  = false
+	defaultTagEnforcementMode                          = TagEnforcementModeStrict
+	defaultCrossZoneDisableValidationMode              = CrossZoneDisableValidationModeNone
+	defaultLBDeleteDNSGracePeriod                      = 0
+	defaultLogModelDiff                                = false
+	defaultNodeInstanceCacheVerifyInterval             = 10 * time.Minute
+	defaultReportAWSAPICalls                           = false
+	maxLBDeleteDNSGracePeriod                          = 24 * time.Hour
+)
+
+const (
+	// TagEnforcementModeStrict reconciles the tags of AWS resources to exactly the desired tags, removing the others.
+	TagEnforcementModeStrict = "strict"
+	// TagEnforcementModeAdditive reconciles the desired tags on AWS resources, leaving the others untouched.
+	TagEnforcementModeAdditive = "additive"
+)
+
+const (
+	// CrossZoneDisableValidationModeEnforce refuses to disable cross-zone load balancing when an enabled zone would have no healthy targets.
+	CrossZoneDisableValidationModeEnforce = "enforce"
+	// CrossZoneDisableValidationModeWarn logs a warning when disabling cross-zone load balancing leaves an enabled zone without healthy targets.
+	CrossZoneDisableValidationModeWarn = "warn"
+	// CrossZoneDisableValidationModeNone disables cross-zone load balancing without validation.
+	CrossZoneDisableValidationModeNone = "none"
 )
 
 var (
@@ -59,12 +130,26 @@ type ControllerConfig struct {
 	RuntimeConfig RuntimeConfig
 	// Configurations for Pod inject webhook
 	PodWebhookConfig inject.Config
+	// Configurations for webhook serving certificate rotation
+	WebhookCertRotationConfig certrotation.Config
 	// Configurations for the Ingress controller
 	IngressConfig IngressConfig
 	// Configurations for Addons feature
 	AddonsConfig AddonsConfig
 	// Configurations for the Service controller
 	ServiceConfig ServiceConfig
+	// Configurations for the garbage collector of orphaned AWS resources
+	OrphanedResourcesGCConfig OrphanedResourcesGCConfig
+	// Configurations for registering the managed load balancers into Route 53 ARC readiness checks
+	RecoveryReadinessConfig RecoveryReadinessConfig
+	// Configurations for publishing the ownership of managed AWS resources
+	OwnershipEventsConfig OwnershipEventsConfig
+	// Configurations for sharding IngressGroups and Services across replicas
+	ShardingConfig ShardingConfig
+	// Configurations for throttling the Kubernetes events emitted by the controller
+	EventConfig EventConfig
+	// Configurations for deploying the models of Ingress groups, Services and Gateways
+	DeployConfig DeployConfig
 
 	// Default AWS Tags that will be applied to all AWS resources managed by this controller.
 	DefaultTags map[string]string
@@ -75,6 +160,15 @@ type ControllerConfig struct {
 	// List of Tag keys on AWS resources that will be managed externally.
 	ExternalManagedTags []string
 
+	// List of Tag key prefixes that this controller must never apply on AWS resources.
+	DeniedTagKeyPrefixes []string
+
+	// TagEnforcementMode specifies whether tags other than the desired ones are removed from AWS resources - strict, additive
+	TagEnforcementMode string
+
+	// CrossZoneDisableValidationMode specifies how disabling cross-zone load balancing is validated against the zones of healthy targets - enforce, warn, none
+	CrossZoneDisableValidationMode string
+
 	// ServiceTargetENISGTags are AWS tags, in addition to the cluster tags, for finding the target ENI security group to which to add inbound rules from NLBs.
 	ServiceTargetENISGTags map[string]string
 
@@ -91,6 +185,18 @@ type ControllerConfig struct {
 	TargetGroupBindingMaxConcurrentReconciles int
 	// Max exponential backoff delay for reconcile failures of TargetGroupBinding
 	TargetGroupBindingMaxExponentialBackoffDelay time.Duration
+	// Window to coalesce targets registrations and deregistrations per TargetGroup, batching is disabled when zero
+	TargetGroupBindingTargetsBatchWindow time.Duration
+	// Max concurrent register or deregister targets API calls per TargetGroup when batching is enabled
+	TargetGroupBindingTargetsBatchMaxConcurrency int
+	// Interval to poll the health of targets for pods with readiness gate, polled health is cached for the interval
+	TargetGroupBindingTargetHealthPollInterval time.Duration
+	// Window to aggregate endpoint changes per TargetGroupBinding into a single reconcile, aggregation is disabled when zero
+	TargetGroupBindingEndpointChangeDebounceWindow time.Duration
+	// Max duration endpoint changes of a TargetGroupBinding are aggregated before being reconciled
+	TargetGroupBindingEndpointChangeMaxStaleness time.Duration
+	// Max concurrent reconcile loops for Gateway objects
+	GatewayMaxConcurrentReconciles int
 
 	// EnableBackendSecurityGroup specifies whether to use optimized security group rules
 	EnableBackendSecurityGroup bool
@@ -99,9 +205,85 @@ type ControllerConfig struct {
 	// for optimized security group rules
 	BackendSecurityGroup string
 
+	// BackendSecurityGroupReleaseGracePeriod specifies the period to wait after the last resource released the auto-generated
+	// backend security group before deleting it, it's deleted immediately when zero
+	BackendSecurityGroupReleaseGracePeriod time.Duration
+
+	// BackendSecurityGroupShareKey specifies the key to share the auto-generated backend security group with the other clusters
+	// in the VPC using the same key, it's owned by this cluster only when empty
+	BackendSecurityGroupShareKey string
+
+	// BackendSecurityGroupDeletionEventsQueueURL specifies the URL of the SQS queue to receive the deletions of security groups from,
+	// so that the auto-generated backend security group is recovered as soon as it's deleted externally, disabled when empty
+	BackendSecurityGroupDeletionEventsQueueURL string
+
 	// DisableRestrictedSGRules specifies whether to use restricted security group rules
 	DisableRestrictedSGRules bool
 
+	// RestrictSGRulesToNodeSubnets specifies whether to scope the CIDR based security group rules for instance targets
+	// to the subnets of the nodes, instead of the VPC CIDRs
+	RestrictSGRulesToNodeSubnets bool
+
+	// SubnetsDiscoveryStrategy specifies the name of the strategy to discover subnets for Load Balancers
+	SubnetsDiscoveryStrategy string
+
+	// SubnetsDiscoveryTags are AWS Tags, in addition to the ones of the subnets discovery strategy, the discovered subnets must have
+	SubnetsDiscoveryTags map[string]string
+
+	// SubnetsDiscoveryIncludeZones specifies the names or IDs of the only zones to choose discovered subnets in
+	SubnetsDiscoveryIncludeZones []string
+
+	// SubnetsDiscoveryExcludeZones specifies the names or IDs of the zones to never choose discovered subnets in
+	SubnetsDiscoveryExcludeZones []string
+
+	// SubnetsDiscoveryMinFreeIPs specifies the minimum count of free IP addresses a discovered subnet must have to be chosen
+	SubnetsDiscoveryMinFreeIPs int64
+
+	// AllowedAvailabilityZones specifies the names or IDs of the only zones load balancers can be placed in, regardless of
+	// whether their subnets are discovered or explicitly configured
+	AllowedAvailabilityZones []string
+
+	// SecurityGroupDriftReportMode specifies whether to report security group permission drift instead of remediating it
+	SecurityGroupDriftReportMode bool
+
+	// SecurityGroupDriftReportConfigMap specifies the namespace/name of the ConfigMap to write the security group drift report into
+	SecurityGroupDriftReportConfigMap string
+
+	// DryRun specifies whether to plan the changes to AWS resources without applying them
+	DryRun bool
+
+	// ShadowMode specifies whether to plan the changes to AWS resources without applying them nor updating Kubernetes objects,
+	// and report them as divergence from the AWS resources deployed by the active controller
+	ShadowMode bool
+
+	// ShadowReportConfigMap specifies the namespace/name of the ConfigMap to write the shadow mode divergence report into
+	ShadowReportConfigMap string
+
+	// ControllerConfigurationName specifies the name of the ControllerConfiguration object to source the default tags from live
+	ControllerConfigurationName string
+
+	// ControllerCapabilitiesName specifies the name of the ControllerCapabilities object to publish the capabilities of the controller into
+	ControllerCapabilitiesName string
+
+	// Route53HostedZoneIDs specifies the IDs of the Route 53 hosted zones the alias records for load balancers are managed in
+	Route53HostedZoneIDs []string
+
+	// AllowedAWSRoleARNs specifies the IAM roles the aws-role-arn annotation of Ingresses is allowed to specify
+	AllowedAWSRoleARNs []string
+
+	// LBDeleteDNSGracePeriod specifies the period to delay the deletion of internet-facing load balancers after their Ingresses
+	// or Services are deleted, so that DNS caches of their names expire beforehand, they're deleted immediately when zero
+	LBDeleteDNSGracePeriod time.Duration
+
+	// LogModelDiff specifies whether to log the diff of the model of Ingress groups, Services and Gateways between reconciles
+	LogModelDiff bool
+
+	// NodeInstanceCacheVerifyInterval specifies the interval to describe the cached EC2 instances of nodes again, caching is disabled when zero
+	NodeInstanceCacheVerifyInterval time.Duration
+
+	// ReportAWSAPICalls specifies whether to report the AWS API calls made by each reconcile
+	ReportAWSAPICalls bool
+
 	FeatureGates FeatureGates
 }
 
@@ -116,22 +298,86 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 		"Default target type for Ingresses and Services - ip, instance")
 	fs.StringSliceVar(&cfg.ExternalManagedTags, flagExternalManagedTags, nil,
 		"List of Tag keys on AWS resources that will be managed externally")
+	fs.StringSliceVar(&cfg.DeniedTagKeyPrefixes, flagDeniedTagKeyPrefixes, nil,
+		"List of Tag key prefixes that will never be applied to AWS resources, tags with these prefixes specified via annotations are ignored")
+	fs.StringVar(&cfg.TagEnforcementMode, flagTagEnforcementMode, defaultTagEnforcementMode,
+		"Tag enforcement mode on AWS resources - strict removes tags not desired by the controller, additive only adds and updates the desired tags")
+	fs.StringVar(&cfg.CrossZoneDisableValidationMode, flagCrossZoneDisableValidationMode, defaultCrossZoneDisableValidationMode,
+		"Validation before disabling cross-zone load balancing when an enabled zone would have no healthy targets - enforce refuses to disable it, warn logs a warning, none skips the validation")
 	fs.IntVar(&cfg.ServiceMaxConcurrentReconciles, flagServiceMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for service")
 	fs.IntVar(&cfg.TargetGroupBindingMaxConcurrentReconciles, flagTargetGroupBindingMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
 		"Maximum number of concurrently running reconcile loops for targetGroupBinding")
 	fs.DurationVar(&cfg.TargetGroupBindingMaxExponentialBackoffDelay, flagTargetGroupBindingMaxExponentialBackoffDelay, defaultMaxExponentialBackoffDelay,
 		"Maximum duration of exponential backoff for targetGroupBinding reconcile failures")
+	fs.DurationVar(&cfg.TargetGroupBindingTargetsBatchWindow, flagTargetGroupBindingTargetsBatchWindow, defaultTargetsBatchWindow,
+		"Window to coalesce targets registrations and deregistrations per targetGroup, batching is disabled when zero")
+	fs.IntVar(&cfg.TargetGroupBindingTargetsBatchMaxConcurrency, flagTargetGroupBindingTargetsBatchConcurrency, defaultTargetsBatchMaxConcurrency,
+		"Maximum number of concurrent register or deregister targets API calls per targetGroup when batching is enabled")
+	fs.DurationVar(&cfg.TargetGroupBindingTargetHealthPollInterval, flagTargetGroupBindingTargetHealthPollInterval, defaultTargetHealthPollInterval,
+		"Interval to poll the health of targets for pods with readiness gate, polled health is shared and cached for the interval")
+	fs.DurationVar(&cfg.TargetGroupBindingEndpointChangeDebounceWindow, flagTargetGroupBindingEndpointChangeDebounceWindow, defaultEndpointChangeDebounceWindow,
+		"Window to aggregate endpoint changes per targetGroupBinding into a single reconcile, aggregation is disabled when zero")
+	fs.DurationVar(&cfg.TargetGroupBindingEndpointChangeMaxStaleness, flagTargetGroupBindingEndpointChangeMaxStaleness, defaultEndpointChangeMaxStaleness,
+		"Maximum duration endpoint changes of a targetGroupBinding are aggregated before being reconciled")
+	fs.IntVar(&cfg.GatewayMaxConcurrentReconciles, flagGatewayMaxConcurrentReconciles, defaultMaxConcurrentReconciles,
+		"Maximum number of concurrently running reconcile loops for gateway")
 	fs.StringVar(&cfg.DefaultSSLPolicy, flagDefaultSSLPolicy, defaultSSLPolicy,
 		"Default SSL policy for load balancers listeners")
 	fs.BoolVar(&cfg.EnableBackendSecurityGroup, flagEnableBackendSG, defaultEnableBackendSG,
 		"Enable sharing of security groups for backend traffic")
 	fs.StringVar(&cfg.BackendSecurityGroup, flagBackendSecurityGroup, "",
 		"Backend security group id to use for the ingress rules on the worker node SG")
+	fs.DurationVar(&cfg.BackendSecurityGroupReleaseGracePeriod, flagBackendSecurityGroupReleaseGracePeriod, defaultBackendSGReleaseGracePeriod,
+		"Period to wait after the last resource released the auto-generated backend security group before deleting it, deleted immediately when zero")
+	fs.BoolVar(&cfg.LogModelDiff, flagLogModelDiff, defaultLogModelDiff,
+		"Log the diff of the model of Ingress groups, Services and Gateways between reconciles, in addition to the full model")
+	fs.DurationVar(&cfg.NodeInstanceCacheVerifyInterval, flagNodeInstanceCacheVerifyInterval, defaultNodeInstanceCacheVerifyInterval,
+		"Interval to describe the cached EC2 instances of nodes again, instances are cached by node providerID and evicted once nodes are deleted, caching is disabled when zero")
+	fs.BoolVar(&cfg.ReportAWSAPICalls, flagReportAWSAPICalls, defaultReportAWSAPICalls,
+		"Log and export the number of AWS API calls made by each reconcile of Ingress groups, Services and TargetGroupBindings, per AWS API operation")
+	fs.StringVar(&cfg.BackendSecurityGroupShareKey, flagBackendSecurityGroupShareKey, "",
+		"Key to share the auto-generated backend security group with the other clusters in the VPC using the same key")
+	fs.StringVar(&cfg.BackendSecurityGroupDeletionEventsQueueURL, flagBackendSecurityGroupDeletionEventsQueueURL, "",
+		"URL of the SQS queue to route the security group deletions of the account to via an EventBridge rule, to recover the auto-generated backend security group as soon as it's deleted externally, disabled if empty")
 	fs.BoolVar(&cfg.EnableEndpointSlices, flagEnableEndpointSlices, defaultEnableEndpointSlices,
 		"Enable EndpointSlices for IP targets instead of Endpoints")
 	fs.BoolVar(&cfg.DisableRestrictedSGRules, flagDisableRestrictedSGRules, defaultDisableRestrictedSGRules,
 		"Disable the usage of restricted security group rules")
+	fs.BoolVar(&cfg.RestrictSGRulesToNodeSubnets, flagRestrictSGRulesToNodeSubnets, defaultRestrictSGRulesToNodeSubnets,
+		"Restrict the CIDR based security group rules for instance targets to the subnets of the nodes")
+	fs.StringVar(&cfg.SubnetsDiscoveryStrategy, flagSubnetsDiscoveryStrategy, defaultSubnetsDiscoveryStrategy,
+		"Strategy to discover subnets for load balancers without explicit subnets configuration")
+	fs.StringToStringVar(&cfg.SubnetsDiscoveryTags, flagSubnetsDiscoveryTags, nil,
+		"AWS Tags, in addition to the ones of the subnets discovery strategy, the discovered subnets must have, the value * matches any value")
+	fs.StringSliceVar(&cfg.SubnetsDiscoveryIncludeZones, flagSubnetsDiscoveryIncludeZones, nil,
+		"Names or IDs of the only zones to choose discovered subnets in")
+	fs.StringSliceVar(&cfg.SubnetsDiscoveryExcludeZones, flagSubnetsDiscoveryExcludeZones, nil,
+		"Names or IDs of the zones, including local zones, to never choose discovered subnets in")
+	fs.Int64Var(&cfg.SubnetsDiscoveryMinFreeIPs, flagSubnetsDiscoveryMinFreeIPs, 0,
+		"Minimum count of free IP addresses a discovered subnet must have to be chosen")
+	fs.StringSliceVar(&cfg.AllowedAvailabilityZones, flagAllowedAvailabilityZones, nil,
+		"Names or IDs of the only zones load balancers can be placed in, applies to both discovered and explicitly configured subnets")
+	fs.BoolVar(&cfg.SecurityGroupDriftReportMode, flagSecurityGroupDriftReportMode, defaultSecurityGroupDriftReportMode,
+		"Report security group permission drift via events and metrics instead of remediating it")
+	fs.StringVar(&cfg.SecurityGroupDriftReportConfigMap, flagSecurityGroupDriftReportConfigMap, "",
+		"The namespace/name of the ConfigMap to write the security group drift report into in drift report mode")
+	fs.BoolVar(&cfg.DryRun, flagDryRun, defaultDryRun,
+		"Plan the changes to AWS resources for Ingresses, Services and Gateways and report them via events instead of applying them")
+	fs.BoolVar(&cfg.ShadowMode, flagShadowMode, defaultShadowMode,
+		"Run alongside the active controller, plan the changes to AWS resources without applying them nor updating Kubernetes objects, and report them as divergence via logs and metrics")
+	fs.StringVar(&cfg.ShadowReportConfigMap, flagShadowReportConfigMap, "",
+		"The namespace/name of the ConfigMap to write the divergence report into in shadow mode")
+	fs.StringVar(&cfg.ControllerConfigurationName, flagControllerConfigurationName, "",
+		"Name of the ControllerConfiguration object whose default tags override the default-tags flag and are propagated to AWS resources on change, disabled if empty")
+	fs.StringVar(&cfg.ControllerCapabilitiesName, flagControllerCapabilitiesName, "",
+		"Name of the ControllerCapabilities object to publish the supported annotations, feature gates, defaults and AWS limits of the controller into, disabled if empty")
+	fs.StringSliceVar(&cfg.Route53HostedZoneIDs, flagRoute53HostedZoneIDs, nil,
+		"IDs of the Route 53 hosted zones to manage the alias records for Ingress hosts and Service hostnames in, requires the Route53AliasRecords feature gate")
+	fs.StringSliceVar(&cfg.AllowedAWSRoleARNs, flagAllowedAWSRoleARNs, nil,
+		"ARNs of the only IAM roles the aws-role-arn annotation of Ingresses can specify, requires the AWSRoleARNAnnotation feature gate")
+	fs.DurationVar(&cfg.LBDeleteDNSGracePeriod, flagLBDeleteDNSGracePeriod, defaultLBDeleteDNSGracePeriod,
+		"Period to delay the deletion of internet-facing load balancers after their Ingresses or Services are deleted, so that DNS caches of their names expire beforehand, deleted immediately when zero")
 	fs.StringToStringVar(&cfg.ServiceTargetENISGTags, flagServiceTargetENISGTags, nil,
 		"AWS Tags, in addition to cluster tags, for finding the target ENI security group to which to add inbound rules from NLBs")
 	cfg.FeatureGates.BindFlags(fs)
@@ -139,9 +385,16 @@ func (cfg *ControllerConfig) BindFlags(fs *pflag.FlagSet) {
 	cfg.RuntimeConfig.BindFlags(fs)
 
 	cfg.PodWebhookConfig.BindFlags(fs)
+	cfg.WebhookCertRotationConfig.BindFlags(fs)
 	cfg.IngressConfig.BindFlags(fs)
 	cfg.AddonsConfig.BindFlags(fs)
 	cfg.ServiceConfig.BindFlags(fs)
+	cfg.OrphanedResourcesGCConfig.BindFlags(fs)
+	cfg.RecoveryReadinessConfig.BindFlags(fs)
+	cfg.OwnershipEventsConfig.BindFlags(fs)
+	cfg.ShardingConfig.BindFlags(fs)
+	cfg.EventConfig.BindFlags(fs)
+	cfg.DeployConfig.BindFlags(fs)
 }
 
 // Validate the controller configuration
@@ -149,6 +402,9 @@ func (cfg *ControllerConfig) Validate() error {
 	if len(cfg.ClusterName) == 0 {
 		return errors.New("kubernetes cluster name must be specified")
 	}
+	if err := cfg.RuntimeConfig.Validate(); err != nil {
+		return err
+	}
 
 	if err := cfg.validateDefaultTagsCollisionWithTrackingTags(); err != nil {
 		return err
@@ -159,12 +415,84 @@ func (cfg *ControllerConfig) Validate() error {
 	if err := cfg.validateExternalManagedTagsCollisionWithDefaultTags(); err != nil {
 		return err
 	}
+	if err := cfg.validateDeniedTagKeyPrefixes(); err != nil {
+		return err
+	}
+	if err := cfg.validateTagEnforcementMode(); err != nil {
+		return err
+	}
+	if err := cfg.validateCrossZoneDisableValidationMode(); err != nil {
+		return err
+	}
 	if err := cfg.validateDefaultTargetType(); err != nil {
 		return err
 	}
 	if err := cfg.validateBackendSecurityGroupConfiguration(); err != nil {
 		return err
 	}
+	if err := cfg.validateTargetsBatchConfiguration(); err != nil {
+		return err
+	}
+	if err := cfg.validateTargetHealthPollInterval(); err != nil {
+		return err
+	}
+	if err := cfg.validateEndpointChangeAggregationConfiguration(); err != nil {
+		return err
+	}
+	if err := cfg.validateSubnetsDiscoveryConfiguration(); err != nil {
+		return err
+	}
+	if err := cfg.validateSecurityGroupDriftReportConfiguration(); err != nil {
+		return err
+	}
+	if err := cfg.validateIngressRuleMetricsPollInterval(); err != nil {
+		return err
+	}
+	if err := cfg.validateCertDiscoveryResyncPeriod(); err != nil {
+		return err
+	}
+	if err := cfg.validateShadowModeConfiguration(); err != nil {
+		return err
+	}
+	if err := cfg.validateRoute53RecordsConfiguration(); err != nil {
+		return err
+	}
+	if err := cfg.validateCertRequestsConfiguration(); err != nil {
+		return err
+	}
+	if err := cfg.validateAWSRoleARNAnnotationConfiguration(); err != nil {
+		return err
+	}
+	if err := cfg.validateLBDeleteDNSGracePeriod(); err != nil {
+		return err
+	}
+	if err := cfg.validateNodeInstanceCacheVerifyInterval(); err != nil {
+		return err
+	}
+	if err := cfg.OrphanedResourcesGCConfig.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.RecoveryReadinessConfig.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.OwnershipEventsConfig.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.ShardingConfig.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.EventConfig.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.DeployConfig.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.PodWebhookConfig.Validate(); err != nil {
+		return err
+	}
+	if err := cfg.WebhookCertRotationConfig.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -177,6 +505,27 @@ func (cfg *ControllerConfig) validateDefaultTagsCollisionWithTrackingTags() erro
 	return nil
 }
 
+// ValidateDefaultTags validates the default tags specified via the ControllerConfiguration object.
+func (cfg *ControllerConfig) ValidateDefaultTags(defaultTags map[string]string) error {
+	externalManagedTags := sets.NewString(cfg.ExternalManagedTags...)
+	for _, tagKey := range sets.StringKeySet(defaultTags).List() {
+		if trackingTagKeys.Has(tagKey) {
+			return errors.Errorf("tag key %v cannot be specified in default tags, it's used to track resources", tagKey)
+		}
+		if externalManagedTags.Has(tagKey) {
+			return errors.Errorf("tag key %v cannot be specified in default tags, it's specified in %v flag",
+				tagKey, flagExternalManagedTags)
+		}
+		for _, prefix := range cfg.DeniedTagKeyPrefixes {
+			if strings.HasPrefix(tagKey, prefix) {
+				return errors.Errorf("tag key %v cannot be specified in default tags, it has prefix %v specified in %v flag",
+					tagKey, prefix, flagDeniedTagKeyPrefixes)
+			}
+		}
+	}
+	return nil
+}
+
 func (cfg *ControllerConfig) validateExternalManagedTagsCollisionWithTrackingTags() error {
 	for _, tagKey := range cfg.ExternalManagedTags {
 		if trackingTagKeys.Has(tagKey) {
@@ -196,6 +545,52 @@ func (cfg *ControllerConfig) validateExternalManagedTagsCollisionWithDefaultTags
 	return nil
 }
 
+func (cfg *ControllerConfig) validateDeniedTagKeyPrefixes() error {
+	for _, prefix := range cfg.DeniedTagKeyPrefixes {
+		if len(prefix) == 0 {
+			return errors.Errorf("empty tag key prefix cannot be specified in %v flag", flagDeniedTagKeyPrefixes)
+		}
+		for _, tagKey := range trackingTagKeys.List() {
+			if strings.HasPrefix(tagKey, prefix) {
+				return errors.Errorf("tag key prefix %v cannot be specified in %v flag, it denies tag key %v used to track resources",
+					prefix, flagDeniedTagKeyPrefixes, tagKey)
+			}
+		}
+		for tagKey := range cfg.DefaultTags {
+			if strings.HasPrefix(tagKey, prefix) {
+				return errors.Errorf("tag key %v cannot be specified in %v flag, it has prefix %v specified in %v flag",
+					tagKey, flagDefaultTags, prefix, flagDeniedTagKeyPrefixes)
+			}
+		}
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateTagEnforcementMode() error {
+	switch cfg.TagEnforcementMode {
+	case TagEnforcementModeStrict, TagEnforcementModeAdditive:
+		return nil
+	default:
+		return errors.Errorf("invalid value %v for %v flag, expects %v or %v",
+			cfg.TagEnforcementMode, flagTagEnforcementMode, TagEnforcementModeStrict, TagEnforcementModeAdditive)
+	}
+}
+
+// StrictTagEnforcement returns whether tags other than the desired ones are removed from AWS resources.
+func (cfg *ControllerConfig) StrictTagEnforcement() bool {
+	return cfg.TagEnforcementMode != TagEnforcementModeAdditive
+}
+
+func (cfg *ControllerConfig) validateCrossZoneDisableValidationMode() error {
+	switch cfg.CrossZoneDisableValidationMode {
+	case CrossZoneDisableValidationModeEnforce, CrossZoneDisableValidationModeWarn, CrossZoneDisableValidationModeNone:
+		return nil
+	default:
+		return errors.Errorf("invalid value %v for %v flag, expects %v, %v or %v", cfg.CrossZoneDisableValidationMode,
+			flagCrossZoneDisableValidationMode, CrossZoneDisableValidationModeEnforce, CrossZoneDisableValidationModeWarn, CrossZoneDisableValidationModeNone)
+	}
+}
+
 func (cfg *ControllerConfig) validateDefaultTargetType() error {
 	switch cfg.DefaultTargetType {
 	case string(elbv2.TargetTypeInstance), string(elbv2.TargetTypeIP):
@@ -205,7 +600,31 @@ func (cfg *ControllerConfig) validateDefaultTargetType() error {
 	}
 }
 
+// backendSecurityGroupShareKeyPattern is the pattern of backend security group share keys, which are used as tag value.
+var backendSecurityGroupShareKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`)
+
 func (cfg *ControllerConfig) validateBackendSecurityGroupConfiguration() error {
+	if cfg.BackendSecurityGroupReleaseGracePeriod < 0 {
+		return errors.Errorf("invalid value %v for backend security group release grace period, must be non-negative", cfg.BackendSecurityGroupReleaseGracePeriod)
+	}
+	if len(cfg.BackendSecurityGroupShareKey) > 0 {
+		if len(cfg.BackendSecurityGroup) > 0 {
+			return errors.Errorf("backend security group share key cannot be specified together with backend security group")
+		}
+		if !backendSecurityGroupShareKeyPattern.MatchString(cfg.BackendSecurityGroupShareKey) {
+			return errors.Errorf("invalid value %v for backend security group share key, must match %v",
+				cfg.BackendSecurityGroupShareKey, backendSecurityGroupShareKeyPattern.String())
+		}
+	}
+	if len(cfg.BackendSecurityGroupDeletionEventsQueueURL) > 0 {
+		if len(cfg.BackendSecurityGroup) > 0 {
+			return errors.Errorf("backend security group deletion events queue URL cannot be specified together with backend security group")
+		}
+		if queueURL, err := url.Parse(cfg.BackendSecurityGroupDeletionEventsQueueURL); err != nil || queueURL.Scheme != "https" || len(queueURL.Host) == 0 {
+			return errors.Errorf("invalid value %v for backend security group deletion events queue URL, expects an SQS queue URL",
+				cfg.BackendSecurityGroupDeletionEventsQueueURL)
+		}
+	}
 	if len(cfg.BackendSecurityGroup) == 0 {
 		return nil
 	}
@@ -214,3 +633,217 @@ func (cfg *ControllerConfig) validateBackendSecurityGroupConfiguration() error {
 	}
 	return nil
 }
+
+func (cfg *ControllerConfig) validateTargetsBatchConfiguration() error {
+	if cfg.TargetGroupBindingTargetsBatchWindow < 0 {
+		return errors.Errorf("invalid value %v for targets batch window", cfg.TargetGroupBindingTargetsBatchWindow)
+	}
+	if cfg.TargetGroupBindingTargetsBatchMaxConcurrency < 1 {
+		return errors.Errorf("invalid value %v for targets batch max concurrency", cfg.TargetGroupBindingTargetsBatchMaxConcurrency)
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateSubnetsDiscoveryConfiguration() error {
+	if cfg.SubnetsDiscoveryMinFreeIPs < 0 {
+		return errors.Errorf("invalid value %v for %v flag, expects non-negative value", cfg.SubnetsDiscoveryMinFreeIPs, flagSubnetsDiscoveryMinFreeIPs)
+	}
+	excludeZones := sets.NewString(cfg.SubnetsDiscoveryExcludeZones...)
+	for _, zone := range cfg.SubnetsDiscoveryIncludeZones {
+		if excludeZones.Has(zone) {
+			return errors.Errorf("zone %v cannot be specified in both %v and %v flag",
+				zone, flagSubnetsDiscoveryIncludeZones, flagSubnetsDiscoveryExcludeZones)
+		}
+	}
+	for _, zone := range cfg.AllowedAvailabilityZones {
+		if len(zone) == 0 {
+			return errors.Errorf("invalid empty zone for %v flag", flagAllowedAvailabilityZones)
+		}
+	}
+	return nil
+}
+
+// SubnetDiscoverySelector returns the default selector refining the auto-discovery of subnets.
+func (cfg *ControllerConfig) SubnetDiscoverySelector() elbv2api.SubnetDiscoverySelector {
+	var selector elbv2api.SubnetDiscoverySelector
+	if len(cfg.SubnetsDiscoveryTags) != 0 {
+		selector.Tags = make(map[string][]string, len(cfg.SubnetsDiscoveryTags))
+		for key, value := range cfg.SubnetsDiscoveryTags {
+			selector.Tags[key] = []string{value}
+		}
+	}
+	selector.IncludeZones = cfg.SubnetsDiscoveryIncludeZones
+	selector.ExcludeZones = cfg.SubnetsDiscoveryExcludeZones
+	if cfg.SubnetsDiscoveryMinFreeIPs > 0 {
+		minFreeIPs := cfg.SubnetsDiscoveryMinFreeIPs
+		selector.MinFreeIPAddressCount = &minFreeIPs
+	}
+	return selector
+}
+
+func (cfg *ControllerConfig) validateEndpointChangeAggregationConfiguration() error {
+	if cfg.TargetGroupBindingEndpointChangeDebounceWindow < 0 {
+		return errors.Errorf("invalid value %v for %v flag, expects non-negative value",
+			cfg.TargetGroupBindingEndpointChangeDebounceWindow, flagTargetGroupBindingEndpointChangeDebounceWindow)
+	}
+	if cfg.TargetGroupBindingEndpointChangeDebounceWindow > 0 &&
+		cfg.TargetGroupBindingEndpointChangeMaxStaleness < cfg.TargetGroupBindingEndpointChangeDebounceWindow {
+		return errors.Errorf("invalid value %v for %v flag, expects value no less than %v flag",
+			cfg.TargetGroupBindingEndpointChangeMaxStaleness, flagTargetGroupBindingEndpointChangeMaxStaleness, flagTargetGroupBindingEndpointChangeDebounceWindow)
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateTargetHealthPollInterval() error {
+	if cfg.TargetGroupBindingTargetHealthPollInterval <= 0 {
+		return errors.Errorf("invalid value %v for target health poll interval", cfg.TargetGroupBindingTargetHealthPollInterval)
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateSecurityGroupDriftReportConfiguration() error {
+	if len(cfg.SecurityGroupDriftReportConfigMap) == 0 {
+		return nil
+	}
+	if !cfg.SecurityGroupDriftReportMode {
+		return errors.Errorf("%v flag requires %v flag", flagSecurityGroupDriftReportConfigMap, flagSecurityGroupDriftReportMode)
+	}
+	if _, err := cfg.SecurityGroupDriftReportConfigMapKey(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CloudWatch metrics of load balancers are aggregated per minute, polling them more often is pointless.
+func (cfg *ControllerConfig) validateIngressRuleMetricsPollInterval() error {
+	pollInterval := cfg.IngressConfig.RuleMetricsPollInterval
+	if pollInterval != 0 && pollInterval < time.Minute {
+		return errors.Errorf("invalid value %v for %v flag, expects 0 or at least 1m", pollInterval, flagRuleMetricsPollInterval)
+	}
+	return nil
+}
+
+// the certificates in AWS account are cached for 1 minute during discovery, re-discovering them more often is pointless.
+func (cfg *ControllerConfig) validateCertDiscoveryResyncPeriod() error {
+	resyncPeriod := cfg.IngressConfig.CertDiscoveryResyncPeriod
+	if resyncPeriod != 0 && resyncPeriod < time.Minute {
+		return errors.Errorf("invalid value %v for %v flag, expects 0 or at least 1m", resyncPeriod, flagCertDiscoveryResyncPeriod)
+	}
+	return nil
+}
+
+// the shadow controller must leave the webhook serving certificate to the active controller.
+func (cfg *ControllerConfig) validateShadowModeConfiguration() error {
+	if !cfg.ShadowMode {
+		if len(cfg.ShadowReportConfigMap) != 0 {
+			return errors.Errorf("%v flag requires %v flag", flagShadowReportConfigMap, flagShadowMode)
+		}
+		return nil
+	}
+	if cfg.WebhookCertRotationConfig.EnableWebhookCertRotation {
+		return errors.Errorf("%v flag cannot be combined with %v flag", flagShadowMode, "enable-webhook-cert-rotation")
+	}
+	if _, err := cfg.ShadowReportConfigMapKey(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// the alias records are only managed in the hosted zones explicitly configured.
+func (cfg *ControllerConfig) validateRoute53RecordsConfiguration() error {
+	if !cfg.FeatureGates.Enabled(Route53AliasRecords) {
+		if len(cfg.Route53HostedZoneIDs) != 0 {
+			return errors.Errorf("%v flag requires the %v feature gate", flagRoute53HostedZoneIDs, Route53AliasRecords)
+		}
+		return nil
+	}
+	if len(cfg.Route53HostedZoneIDs) == 0 {
+		return errors.Errorf("%v feature gate requires %v flag", Route53AliasRecords, flagRoute53HostedZoneIDs)
+	}
+	for _, hostedZoneID := range cfg.Route53HostedZoneIDs {
+		if !strings.HasPrefix(hostedZoneID, "Z") {
+			return errors.Errorf("invalid value %v for %v flag, expects hosted zone IDs", hostedZoneID, flagRoute53HostedZoneIDs)
+		}
+	}
+	return nil
+}
+
+// the aws-role-arn annotation can only specify the IAM roles explicitly configured, as anyone who can create Ingresses can set it.
+func (cfg *ControllerConfig) validateAWSRoleARNAnnotationConfiguration() error {
+	if !cfg.FeatureGates.Enabled(AWSRoleARNAnnotation) {
+		if len(cfg.AllowedAWSRoleARNs) != 0 {
+			return errors.Errorf("%v flag requires the %v feature gate", flagAllowedAWSRoleARNs, AWSRoleARNAnnotation)
+		}
+		return nil
+	}
+	if len(cfg.AllowedAWSRoleARNs) == 0 {
+		return errors.Errorf("%v feature gate requires %v flag", AWSRoleARNAnnotation, flagAllowedAWSRoleARNs)
+	}
+	for _, roleARN := range cfg.AllowedAWSRoleARNs {
+		if err := aws.ValidateRoleARN(roleARN); err != nil {
+			return errors.Wrapf(err, "invalid value for %v flag", flagAllowedAWSRoleARNs)
+		}
+	}
+	return nil
+}
+
+// the DNS validation records of requested certificates are only created in the hosted zone explicitly configured.
+func (cfg *ControllerConfig) validateCertRequestsConfiguration() error {
+	hostedZoneID := cfg.IngressConfig.CertRequestHostedZoneID
+	if !cfg.FeatureGates.Enabled(ACMCertRequests) {
+		if hostedZoneID != "" {
+			return errors.Errorf("%v flag requires the %v feature gate", flagCertRequestHostedZoneID, ACMCertRequests)
+		}
+		return nil
+	}
+	if hostedZoneID == "" {
+		return errors.Errorf("%v feature gate requires %v flag", ACMCertRequests, flagCertRequestHostedZoneID)
+	}
+	if !strings.HasPrefix(hostedZoneID, "Z") {
+		return errors.Errorf("invalid value %v for %v flag, expects hosted zone ID", hostedZoneID, flagCertRequestHostedZoneID)
+	}
+	return nil
+}
+
+// the load balancers are kept for at most a day, as the finalizers of deleted Ingresses and Services are held meanwhile.
+func (cfg *ControllerConfig) validateLBDeleteDNSGracePeriod() error {
+	if cfg.LBDeleteDNSGracePeriod < 0 || cfg.LBDeleteDNSGracePeriod > maxLBDeleteDNSGracePeriod {
+		return errors.Errorf("invalid value %v for %v flag, must be within [0, %v]", cfg.LBDeleteDNSGracePeriod, flagLBDeleteDNSGracePeriod, maxLBDeleteDNSGracePeriod)
+	}
+	return nil
+}
+
+func (cfg *ControllerConfig) validateNodeInstanceCacheVerifyInterval() error {
+	if cfg.NodeInstanceCacheVerifyInterval < 0 {
+		return errors.Errorf("invalid value %v for %v flag, expects non-negative value", cfg.NodeInstanceCacheVerifyInterval, flagNodeInstanceCacheVerifyInterval)
+	}
+	return nil
+}
+
+// SecurityGroupDriftReportConfigMapKey returns the key of the ConfigMap to write the security group drift report into.
+// nil is returned if it's not configured.
+func (cfg *ControllerConfig) SecurityGroupDriftReportConfigMapKey() (*types.NamespacedName, error) {
+	if len(cfg.SecurityGroupDriftReportConfigMap) == 0 {
+		return nil, nil
+	}
+	parts := strings.Split(cfg.SecurityGroupDriftReportConfigMap, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return nil, errors.Errorf("invalid value %v for %v flag, expects namespace/name",
+			cfg.SecurityGroupDriftReportConfigMap, flagSecurityGroupDriftReportConfigMap)
+	}
+	return &types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
+}
+
+// ShadowReportConfigMapKey returns the key of the ConfigMap to write the shadow mode divergence report into.
+// nil is returned if it's not configured.
+func (cfg *ControllerConfig) ShadowReportConfigMapKey() (*types.NamespacedName, error) {
+	if len(cfg.ShadowReportConfigMap) == 0 {
+		return nil, nil
+	}
+	parts := strings.Split(cfg.ShadowReportConfigMap, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return nil, errors.Errorf("invalid value %v for %v flag, expects namespace/name",
+			cfg.ShadowReportConfigMap, flagShadowReportConfigMap)
+	}
+	return &types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
+}
@@ -3,7 +3,9 @@ package config
 import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/certrotation"
 	"testing"
+	"time"
 )
 
 func TestControllerConfig_validateDefaultTagsCollisionWithTrackingTags(t *testing.T) {
@@ -164,3 +166,915 @@ func TestControllerConfig_validateExternalManagedTagsCollisionWithDefaultTags(t
 		})
 	}
 }
+
+func TestControllerConfig_validateDeniedTagKeyPrefixes(t *testing.T) {
+	type fields struct {
+		DefaultTags          map[string]string
+		DeniedTagKeyPrefixes []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "default tags don't have denied prefixes",
+			fields: fields{
+				DefaultTags: map[string]string{
+					"tag-a": "value-a",
+				},
+				DeniedTagKeyPrefixes: []string{"security/"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "default tags have denied prefixes",
+			fields: fields{
+				DefaultTags: map[string]string{
+					"security/tag-a": "value-a",
+				},
+				DeniedTagKeyPrefixes: []string{"security/"},
+			},
+			wantErr: errors.New("tag key security/tag-a cannot be specified in default-tags flag, it has prefix security/ specified in denied-tag-key-prefixes flag"),
+		},
+		{
+			name: "denied prefixes cover tracking tags",
+			fields: fields{
+				DeniedTagKeyPrefixes: []string{"elbv2.k8s.aws/"},
+			},
+			wantErr: errors.New("tag key prefix elbv2.k8s.aws/ cannot be specified in denied-tag-key-prefixes flag, it denies tag key elbv2.k8s.aws/cluster used to track resources"),
+		},
+		{
+			name: "empty denied prefix",
+			fields: fields{
+				DeniedTagKeyPrefixes: []string{""},
+			},
+			wantErr: errors.New("empty tag key prefix cannot be specified in denied-tag-key-prefixes flag"),
+		},
+		{
+			name: "empty denied prefixes",
+			fields: fields{
+				DefaultTags: map[string]string{
+					"tag-a": "value-a",
+				},
+				DeniedTagKeyPrefixes: nil,
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				DefaultTags:          tt.fields.DefaultTags,
+				DeniedTagKeyPrefixes: tt.fields.DeniedTagKeyPrefixes,
+			}
+			err := cfg.validateDeniedTagKeyPrefixes()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_ValidateDefaultTags(t *testing.T) {
+	type fields struct {
+		ExternalManagedTags  []string
+		DeniedTagKeyPrefixes []string
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		defaultTags map[string]string
+		wantErr     error
+	}{
+		{
+			name: "valid default tags",
+			fields: fields{
+				ExternalManagedTags:  []string{"tag-b"},
+				DeniedTagKeyPrefixes: []string{"security/"},
+			},
+			defaultTags: map[string]string{
+				"tag-a": "value-a",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "default tags collide with tracking tags",
+			defaultTags: map[string]string{
+				"elbv2.k8s.aws/cluster": "value-a",
+			},
+			wantErr: errors.New("tag key elbv2.k8s.aws/cluster cannot be specified in default tags, it's used to track resources"),
+		},
+		{
+			name: "default tags collide with external managed tags",
+			fields: fields{
+				ExternalManagedTags: []string{"tag-a"},
+			},
+			defaultTags: map[string]string{
+				"tag-a": "value-a",
+			},
+			wantErr: errors.New("tag key tag-a cannot be specified in default tags, it's specified in external-managed-tags flag"),
+		},
+		{
+			name: "default tags have denied prefixes",
+			fields: fields{
+				DeniedTagKeyPrefixes: []string{"security/"},
+			},
+			defaultTags: map[string]string{
+				"security/tag-a": "value-a",
+			},
+			wantErr: errors.New("tag key security/tag-a cannot be specified in default tags, it has prefix security/ specified in denied-tag-key-prefixes flag"),
+		},
+		{
+			name:        "empty default tags",
+			defaultTags: nil,
+			wantErr:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				ExternalManagedTags:  tt.fields.ExternalManagedTags,
+				DeniedTagKeyPrefixes: tt.fields.DeniedTagKeyPrefixes,
+			}
+			err := cfg.ValidateDefaultTags(tt.defaultTags)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateTagEnforcementMode(t *testing.T) {
+	tests := []struct {
+		name               string
+		tagEnforcementMode string
+		wantErr            error
+	}{
+		{
+			name:               "strict",
+			tagEnforcementMode: "strict",
+			wantErr:            nil,
+		},
+		{
+			name:               "additive",
+			tagEnforcementMode: "additive",
+			wantErr:            nil,
+		},
+		{
+			name:               "unknown",
+			tagEnforcementMode: "lenient",
+			wantErr:            errors.New("invalid value lenient for tag-enforcement-mode flag, expects strict or additive"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				TagEnforcementMode: tt.tagEnforcementMode,
+			}
+			err := cfg.validateTagEnforcementMode()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateCrossZoneDisableValidationMode(t *testing.T) {
+	tests := []struct {
+		name                           string
+		crossZoneDisableValidationMode string
+		wantErr                        error
+	}{
+		{
+			name:                           "enforce",
+			crossZoneDisableValidationMode: "enforce",
+			wantErr:                        nil,
+		},
+		{
+			name:                           "warn",
+			crossZoneDisableValidationMode: "warn",
+			wantErr:                        nil,
+		},
+		{
+			name:                           "none",
+			crossZoneDisableValidationMode: "none",
+			wantErr:                        nil,
+		},
+		{
+			name:                           "unknown",
+			crossZoneDisableValidationMode: "refuse",
+			wantErr:                        errors.New("invalid value refuse for cross-zone-disable-validation-mode flag, expects enforce, warn or none"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				CrossZoneDisableValidationMode: tt.crossZoneDisableValidationMode,
+			}
+			err := cfg.validateCrossZoneDisableValidationMode()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateTargetsBatchConfiguration(t *testing.T) {
+	type fields struct {
+		TargetsBatchWindow         time.Duration
+		TargetsBatchMaxConcurrency int
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "batching disabled",
+			fields: fields{
+				TargetsBatchWindow:         0,
+				TargetsBatchMaxConcurrency: 2,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "batching enabled",
+			fields: fields{
+				TargetsBatchWindow:         500 * time.Millisecond,
+				TargetsBatchMaxConcurrency: 4,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "negative batch window",
+			fields: fields{
+				TargetsBatchWindow:         -time.Second,
+				TargetsBatchMaxConcurrency: 2,
+			},
+			wantErr: errors.New("invalid value -1s for targets batch window"),
+		},
+		{
+			name: "zero max concurrency",
+			fields: fields{
+				TargetsBatchWindow:         time.Second,
+				TargetsBatchMaxConcurrency: 0,
+			},
+			wantErr: errors.New("invalid value 0 for targets batch max concurrency"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				TargetGroupBindingTargetsBatchWindow:         tt.fields.TargetsBatchWindow,
+				TargetGroupBindingTargetsBatchMaxConcurrency: tt.fields.TargetsBatchMaxConcurrency,
+			}
+			err := cfg.validateTargetsBatchConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateSubnetsDiscoveryConfiguration(t *testing.T) {
+	type fields struct {
+		SubnetsDiscoveryIncludeZones []string
+		SubnetsDiscoveryExcludeZones []string
+		SubnetsDiscoveryMinFreeIPs   int64
+		AllowedAvailabilityZones     []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name:    "default configuration",
+			fields:  fields{},
+			wantErr: nil,
+		},
+		{
+			name: "zones and min free IPs",
+			fields: fields{
+				SubnetsDiscoveryIncludeZones: []string{"us-west-2a", "us-west-2b"},
+				SubnetsDiscoveryExcludeZones: []string{"us-west-2-lax-1a"},
+				SubnetsDiscoveryMinFreeIPs:   32,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "negative min free IPs",
+			fields: fields{
+				SubnetsDiscoveryMinFreeIPs: -1,
+			},
+			wantErr: errors.New("invalid value -1 for subnets-discovery-min-free-ips flag, expects non-negative value"),
+		},
+		{
+			name: "zone both included and excluded",
+			fields: fields{
+				SubnetsDiscoveryIncludeZones: []string{"us-west-2a", "us-west-2b"},
+				SubnetsDiscoveryExcludeZones: []string{"us-west-2b"},
+			},
+			wantErr: errors.New("zone us-west-2b cannot be specified in both subnets-discovery-include-zones and subnets-discovery-exclude-zones flag"),
+		},
+		{
+			name: "allowed availability zones",
+			fields: fields{
+				AllowedAvailabilityZones: []string{"us-west-2a", "usw2-az2"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "empty allowed availability zone",
+			fields: fields{
+				AllowedAvailabilityZones: []string{"us-west-2a", ""},
+			},
+			wantErr: errors.New("invalid empty zone for allowed-availability-zones flag"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				SubnetsDiscoveryIncludeZones: tt.fields.SubnetsDiscoveryIncludeZones,
+				SubnetsDiscoveryExcludeZones: tt.fields.SubnetsDiscoveryExcludeZones,
+				SubnetsDiscoveryMinFreeIPs:   tt.fields.SubnetsDiscoveryMinFreeIPs,
+				AllowedAvailabilityZones:     tt.fields.AllowedAvailabilityZones,
+			}
+			err := cfg.validateSubnetsDiscoveryConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateTargetHealthPollInterval(t *testing.T) {
+	tests := []struct {
+		name         string
+		pollInterval time.Duration
+		wantErr      error
+	}{
+		{
+			name:         "positive poll interval",
+			pollInterval: 15 * time.Second,
+			wantErr:      nil,
+		},
+		{
+			name:         "zero poll interval",
+			pollInterval: 0,
+			wantErr:      errors.New("invalid value 0s for target health poll interval"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				TargetGroupBindingTargetHealthPollInterval: tt.pollInterval,
+			}
+			err := cfg.validateTargetHealthPollInterval()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateEndpointChangeAggregationConfiguration(t *testing.T) {
+	tests := []struct {
+		name           string
+		debounceWindow time.Duration
+		maxStaleness   time.Duration
+		wantErr        error
+	}{
+		{
+			name:           "aggregation disabled",
+			debounceWindow: 0,
+			maxStaleness:   0,
+			wantErr:        nil,
+		},
+		{
+			name:           "max staleness exceeds debounce window",
+			debounceWindow: time.Second,
+			maxStaleness:   10 * time.Second,
+			wantErr:        nil,
+		},
+		{
+			name:           "negative debounce window",
+			debounceWindow: -time.Second,
+			maxStaleness:   10 * time.Second,
+			wantErr:        errors.New("invalid value -1s for targetgroupbinding-endpoint-change-debounce-window flag, expects non-negative value"),
+		},
+		{
+			name:           "max staleness below debounce window",
+			debounceWindow: 10 * time.Second,
+			maxStaleness:   time.Second,
+			wantErr:        errors.New("invalid value 1s for targetgroupbinding-endpoint-change-max-staleness flag, expects value no less than targetgroupbinding-endpoint-change-debounce-window flag"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				TargetGroupBindingEndpointChangeDebounceWindow: tt.debounceWindow,
+				TargetGroupBindingEndpointChangeMaxStaleness:   tt.maxStaleness,
+			}
+			err := cfg.validateEndpointChangeAggregationConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateIngressRuleMetricsPollInterval(t *testing.T) {
+	tests := []struct {
+		name         string
+		pollInterval time.Duration
+		wantErr      error
+	}{
+		{
+			name:         "disabled",
+			pollInterval: 0,
+			wantErr:      nil,
+		},
+		{
+			name:         "poll every minute",
+			pollInterval: time.Minute,
+			wantErr:      nil,
+		},
+		{
+			name:         "poll more often than every minute",
+			pollInterval: 30 * time.Second,
+			wantErr:      errors.New("invalid value 30s for ingress-rule-metrics-poll-interval flag, expects 0 or at least 1m"),
+		},
+		{
+			name:         "negative poll interval",
+			pollInterval: -time.Minute,
+			wantErr:      errors.New("invalid value -1m0s for ingress-rule-metrics-poll-interval flag, expects 0 or at least 1m"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				IngressConfig: IngressConfig{
+					RuleMetricsPollInterval: tt.pollInterval,
+				},
+			}
+			err := cfg.validateIngressRuleMetricsPollInterval()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateCertDiscoveryResyncPeriod(t *testing.T) {
+	tests := []struct {
+		name         string
+		resyncPeriod time.Duration
+		wantErr      error
+	}{
+		{
+			name:         "disabled",
+			resyncPeriod: 0,
+			wantErr:      nil,
+		},
+		{
+			name:         "re-discover every 10 minutes",
+			resyncPeriod: 10 * time.Minute,
+			wantErr:      nil,
+		},
+		{
+			name:         "re-discover more often than every minute",
+			resyncPeriod: 30 * time.Second,
+			wantErr:      errors.New("invalid value 30s for cert-discovery-resync-period flag, expects 0 or at least 1m"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				IngressConfig: IngressConfig{
+					CertDiscoveryResyncPeriod: tt.resyncPeriod,
+				},
+			}
+			err := cfg.validateCertDiscoveryResyncPeriod()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateSecurityGroupDriftReportConfiguration(t *testing.T) {
+	tests := []struct {
+		name                 string
+		driftReportMode      bool
+		driftReportConfigMap string
+		wantErr              error
+	}{
+		{
+			name:            "drift report mode without configMap",
+			driftReportMode: true,
+			wantErr:         nil,
+		},
+		{
+			name:                 "drift report mode with configMap",
+			driftReportMode:      true,
+			driftReportConfigMap: "kube-system/sg-drift-report",
+			wantErr:              nil,
+		},
+		{
+			name:                 "configMap without drift report mode",
+			driftReportConfigMap: "kube-system/sg-drift-report",
+			wantErr:              errors.New("security-group-drift-report-configmap flag requires security-group-drift-report-mode flag"),
+		},
+		{
+			name:                 "configMap without namespace",
+			driftReportMode:      true,
+			driftReportConfigMap: "sg-drift-report",
+			wantErr:              errors.New("invalid value sg-drift-report for security-group-drift-report-configmap flag, expects namespace/name"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				SecurityGroupDriftReportMode:      tt.driftReportMode,
+				SecurityGroupDriftReportConfigMap: tt.driftReportConfigMap,
+			}
+			err := cfg.validateSecurityGroupDriftReportConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateShadowModeConfiguration(t *testing.T) {
+	tests := []struct {
+		name                      string
+		shadowMode                bool
+		shadowReportConfigMap     string
+		enableWebhookCertRotation bool
+		wantErr                   error
+	}{
+		{
+			name:       "shadow mode without configMap",
+			shadowMode: true,
+			wantErr:    nil,
+		},
+		{
+			name:                  "shadow mode with configMap",
+			shadowMode:            true,
+			shadowReportConfigMap: "kube-system/shadow-report",
+			wantErr:               nil,
+		},
+		{
+			name:                  "configMap without shadow mode",
+			shadowReportConfigMap: "kube-system/shadow-report",
+			wantErr:               errors.New("shadow-report-configmap flag requires shadow-mode flag"),
+		},
+		{
+			name:                  "configMap without namespace",
+			shadowMode:            true,
+			shadowReportConfigMap: "shadow-report",
+			wantErr:               errors.New("invalid value shadow-report for shadow-report-configmap flag, expects namespace/name"),
+		},
+		{
+			name:                      "shadow mode with webhook cert rotation",
+			shadowMode:                true,
+			enableWebhookCertRotation: true,
+			wantErr:                   errors.New("shadow-mode flag cannot be combined with enable-webhook-cert-rotation flag"),
+		},
+		{
+			name:                      "webhook cert rotation without shadow mode",
+			enableWebhookCertRotation: true,
+			wantErr:                   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				ShadowMode:            tt.shadowMode,
+				ShadowReportConfigMap: tt.shadowReportConfigMap,
+				WebhookCertRotationConfig: certrotation.Config{
+					EnableWebhookCertRotation: tt.enableWebhookCertRotation,
+				},
+			}
+			err := cfg.validateShadowModeConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateRoute53RecordsConfiguration(t *testing.T) {
+	tests := []struct {
+		name                 string
+		route53AliasRecords  bool
+		route53HostedZoneIDs []string
+		wantErr              error
+	}{
+		{
+			name:    "disabled",
+			wantErr: nil,
+		},
+		{
+			name:                 "enabled with hosted zones",
+			route53AliasRecords:  true,
+			route53HostedZoneIDs: []string{"Z0123456789ABCDEFGHIJ", "Z9876543210ABCDEFGHIJ"},
+			wantErr:              nil,
+		},
+		{
+			name:                "enabled without hosted zones",
+			route53AliasRecords: true,
+			wantErr:             errors.New("Route53AliasRecords feature gate requires route53-hosted-zone-ids flag"),
+		},
+		{
+			name:                 "hosted zones without feature gate",
+			route53HostedZoneIDs: []string{"Z0123456789ABCDEFGHIJ"},
+			wantErr:              errors.New("route53-hosted-zone-ids flag requires the Route53AliasRecords feature gate"),
+		},
+		{
+			name:                 "invalid hosted zone ID",
+			route53AliasRecords:  true,
+			route53HostedZoneIDs: []string{"/hostedzone/Z0123456789ABCDEFGHIJ"},
+			wantErr:              errors.New("invalid value /hostedzone/Z0123456789ABCDEFGHIJ for route53-hosted-zone-ids flag, expects hosted zone IDs"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := NewFeatureGates()
+			if tt.route53AliasRecords {
+				featureGates.Enable(Route53AliasRecords)
+			}
+			cfg := &ControllerConfig{
+				Route53HostedZoneIDs: tt.route53HostedZoneIDs,
+				FeatureGates:         featureGates,
+			}
+			err := cfg.validateRoute53RecordsConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateAWSRoleARNAnnotationConfiguration(t *testing.T) {
+	tests := []struct {
+		name                 string
+		awsRoleARNAnnotation bool
+		allowedAWSRoleARNs   []string
+		wantErr              error
+	}{
+		{
+			name:    "disabled",
+			wantErr: nil,
+		},
+		{
+			name:                 "enabled with allowed roles",
+			awsRoleARNAnnotation: true,
+			allowedAWSRoleARNs:   []string{"arn:aws:iam::123456789012:role/alb-provisioner", "arn:aws:iam::210987654321:role/alb-provisioner"},
+			wantErr:              nil,
+		},
+		{
+			name:                 "enabled without allowed roles",
+			awsRoleARNAnnotation: true,
+			wantErr:              errors.New("AWSRoleARNAnnotation feature gate requires allowed-aws-role-arns flag"),
+		},
+		{
+			name:               "allowed roles without feature gate",
+			allowedAWSRoleARNs: []string{"arn:aws:iam::123456789012:role/alb-provisioner"},
+			wantErr:            errors.New("allowed-aws-role-arns flag requires the AWSRoleARNAnnotation feature gate"),
+		},
+		{
+			name:                 "invalid role ARN",
+			awsRoleARNAnnotation: true,
+			allowedAWSRoleARNs:   []string{"arn:aws:iam::123456789012:user/alb-provisioner"},
+			wantErr:              errors.New("invalid value for allowed-aws-role-arns flag: invalid IAM role ARN arn:aws:iam::123456789012:user/alb-provisioner"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := NewFeatureGates()
+			if tt.awsRoleARNAnnotation {
+				featureGates.Enable(AWSRoleARNAnnotation)
+			}
+			cfg := &ControllerConfig{
+				AllowedAWSRoleARNs: tt.allowedAWSRoleARNs,
+				FeatureGates:       featureGates,
+			}
+			err := cfg.validateAWSRoleARNAnnotationConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateCertRequestsConfiguration(t *testing.T) {
+	tests := []struct {
+		name                    string
+		acmCertRequests         bool
+		certRequestHostedZoneID string
+		wantErr                 error
+	}{
+		{
+			name:    "disabled",
+			wantErr: nil,
+		},
+		{
+			name:                    "enabled with hosted zone",
+			acmCertRequests:         true,
+			certRequestHostedZoneID: "Z0123456789ABCDEFGHIJ",
+			wantErr:                 nil,
+		},
+		{
+			name:            "enabled without hosted zone",
+			acmCertRequests: true,
+			wantErr:         errors.New("ACMCertRequests feature gate requires cert-request-hosted-zone-id flag"),
+		},
+		{
+			name:                    "hosted zone without feature gate",
+			certRequestHostedZoneID: "Z0123456789ABCDEFGHIJ",
+			wantErr:                 errors.New("cert-request-hosted-zone-id flag requires the ACMCertRequests feature gate"),
+		},
+		{
+			name:                    "invalid hosted zone ID",
+			acmCertRequests:         true,
+			certRequestHostedZoneID: "example.com",
+			wantErr:                 errors.New("invalid value example.com for cert-request-hosted-zone-id flag, expects hosted zone ID"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := NewFeatureGates()
+			if tt.acmCertRequests {
+				featureGates.Enable(ACMCertRequests)
+			}
+			cfg := &ControllerConfig{
+				IngressConfig: IngressConfig{
+					CertRequestHostedZoneID: tt.certRequestHostedZoneID,
+				},
+				FeatureGates: featureGates,
+			}
+			err := cfg.validateCertRequestsConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateLBDeleteDNSGracePeriod(t *testing.T) {
+	tests := []struct {
+		name        string
+		gracePeriod time.Duration
+		wantErr     error
+	}{
+		{
+			name:        "zero grace period",
+			gracePeriod: 0,
+			wantErr:     nil,
+		},
+		{
+			name:        "positive grace period",
+			gracePeriod: 5 * time.Minute,
+			wantErr:     nil,
+		},
+		{
+			name:        "negative grace period",
+			gracePeriod: -time.Minute,
+			wantErr:     errors.New("invalid value -1m0s for lb-delete-dns-grace-period flag, must be within [0, 24h0m0s]"),
+		},
+		{
+			name:        "grace period exceeds a day",
+			gracePeriod: 25 * time.Hour,
+			wantErr:     errors.New("invalid value 25h0m0s for lb-delete-dns-grace-period flag, must be within [0, 24h0m0s]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				LBDeleteDNSGracePeriod: tt.gracePeriod,
+			}
+			err := cfg.validateLBDeleteDNSGracePeriod()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateNodeInstanceCacheVerifyInterval(t *testing.T) {
+	tests := []struct {
+		name           string
+		verifyInterval time.Duration
+		wantErr        error
+	}{
+		{
+			name:           "caching disabled",
+			verifyInterval: 0,
+			wantErr:        nil,
+		},
+		{
+			name:           "positive verify interval",
+			verifyInterval: 10 * time.Minute,
+			wantErr:        nil,
+		},
+		{
+			name:           "negative verify interval",
+			verifyInterval: -time.Minute,
+			wantErr:        errors.New("invalid value -1m0s for node-instance-cache-verify-interval flag, expects non-negative value"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				NodeInstanceCacheVerifyInterval: tt.verifyInterval,
+			}
+			err := cfg.validateNodeInstanceCacheVerifyInterval()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestControllerConfig_validateBackendSecurityGroupDeletionEventsQueueURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		backendSG string
+		queueURL  string
+		wantErr   error
+	}{
+		{
+			name:     "deletion events disabled",
+			queueURL: "",
+			wantErr:  nil,
+		},
+		{
+			name:     "valid queue URL",
+			queueURL: "https://sqs.us-west-2.amazonaws.com/123456789012/backend-sg-deletions",
+			wantErr:  nil,
+		},
+		{
+			name:     "invalid queue URL",
+			queueURL: "backend-sg-deletions",
+			wantErr:  errors.New("invalid value backend-sg-deletions for backend security group deletion events queue URL, expects an SQS queue URL"),
+		},
+		{
+			name:      "queue URL with backend security group",
+			backendSG: "sg-xxxx",
+			queueURL:  "https://sqs.us-west-2.amazonaws.com/123456789012/backend-sg-deletions",
+			wantErr:   errors.New("backend security group deletion events queue URL cannot be specified together with backend security group"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{
+				BackendSecurityGroup:                       tt.backendSG,
+				BackendSecurityGroupDeletionEventsQueueURL: tt.queueURL,
+			}
+			err := cfg.validateBackendSecurityGroupConfiguration()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
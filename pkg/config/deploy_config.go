@@ -0,0 +1,97 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	flagDeployLoadBalancerTimeout = "deploy-load-balancer-timeout"
+	flagDeployListenerTimeout     = "deploy-listener-timeout"
+	flagDeployListenerRuleTimeout = "deploy-listener-rule-timeout"
+	flagDeployTargetGroupTimeout  = "deploy-target-group-timeout"
+	flagDeployProgressConfigMap   = "deploy-progress-configmap"
+	flagDeployProgressMaxAge      = "deploy-progress-max-age"
+
+	defaultDeployProgressMaxAge = 10 * time.Minute
+)
+
+// DeployConfig contains the configurations for deploying the models of Ingress groups, Services and Gateways,
+// so that hung AWS API calls don't pin the reconcile workers for the full timeout of the AWS client.
+type DeployConfig struct {
+	// LoadBalancerTimeout specifies the timeout of creating, updating and deleting load balancers, zero disables it.
+	LoadBalancerTimeout time.Duration
+
+	// ListenerTimeout specifies the timeout of reconciling listeners, zero disables it.
+	ListenerTimeout time.Duration
+
+	// ListenerRuleTimeout specifies the timeout of reconciling listener rules, zero disables it.
+	ListenerRuleTimeout time.Duration
+
+	// TargetGroupTimeout specifies the timeout of reconciling target groups, zero disables it.
+	TargetGroupTimeout time.Duration
+
+	// ProgressConfigMap specifies the namespace/name of the ConfigMap to persist the progress of failed deploys into,
+	// so that they're resumed across controller restarts. The progress is only kept in memory if empty.
+	ProgressConfigMap string
+
+	// ProgressMaxAge specifies how long the progress of a completed deploy stage can be resumed from,
+	// the stage is reconciled again afterwards so that out-of-band changes are corrected while later stages keep failing.
+	ProgressMaxAge time.Duration
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *DeployConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&cfg.LoadBalancerTimeout, flagDeployLoadBalancerTimeout, 0,
+		"Timeout of creating, updating and deleting load balancers during a reconcile, disabled if zero")
+	fs.DurationVar(&cfg.ListenerTimeout, flagDeployListenerTimeout, 0,
+		"Timeout of reconciling listeners during a reconcile, disabled if zero")
+	fs.DurationVar(&cfg.ListenerRuleTimeout, flagDeployListenerRuleTimeout, 0,
+		"Timeout of reconciling listener rules during a reconcile, disabled if zero")
+	fs.DurationVar(&cfg.TargetGroupTimeout, flagDeployTargetGroupTimeout, 0,
+		"Timeout of reconciling target groups during a reconcile, disabled if zero")
+	fs.StringVar(&cfg.ProgressConfigMap, flagDeployProgressConfigMap, "",
+		"The namespace/name of the ConfigMap to persist the progress of failed deploys into when the ResumableDeploy feature is enabled, kept in memory only if empty")
+	fs.DurationVar(&cfg.ProgressMaxAge, flagDeployProgressMaxAge, defaultDeployProgressMaxAge,
+		"Maximum age of the progress of a completed deploy stage to be resumed from when the ResumableDeploy feature is enabled, the stage is reconciled again afterwards")
+}
+
+// Validate the deploy configuration
+func (cfg *DeployConfig) Validate() error {
+	timeoutByFlag := []struct {
+		flag    string
+		timeout time.Duration
+	}{
+		{flag: flagDeployLoadBalancerTimeout, timeout: cfg.LoadBalancerTimeout},
+		{flag: flagDeployListenerTimeout, timeout: cfg.ListenerTimeout},
+		{flag: flagDeployListenerRuleTimeout, timeout: cfg.ListenerRuleTimeout},
+		{flag: flagDeployTargetGroupTimeout, timeout: cfg.TargetGroupTimeout},
+		{flag: flagDeployProgressMaxAge, timeout: cfg.ProgressMaxAge},
+	}
+	for _, entry := range timeoutByFlag {
+		if entry.timeout < 0 {
+			return errors.Errorf("invalid value %v for %v flag, expects non-negative value", entry.timeout, entry.flag)
+		}
+	}
+	if _, err := cfg.ProgressConfigMapKey(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ProgressConfigMapKey returns the key of the ConfigMap to persist the progress of failed deploys into, nil if not specified.
+func (cfg *DeployConfig) ProgressConfigMapKey() (*types.NamespacedName, error) {
+	if len(cfg.ProgressConfigMap) == 0 {
+		return nil, nil
+	}
+	parts := strings.Split(cfg.ProgressConfigMap, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return nil, errors.Errorf("invalid value %v for %v flag, expects namespace/name",
+			cfg.ProgressConfigMap, flagDeployProgressConfigMap)
+	}
+	return &types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeployConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     DeployConfig
+		wantErr error
+	}{
+		{
+			name:    "disabled",
+			cfg:     DeployConfig{},
+			wantErr: nil,
+		},
+		{
+			name: "all stages bounded",
+			cfg: DeployConfig{
+				LoadBalancerTimeout: 3 * time.Minute,
+				ListenerTimeout:     time.Minute,
+				ListenerRuleTimeout: 2 * time.Minute,
+				TargetGroupTimeout:  time.Minute,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "negative listener rule timeout",
+			cfg: DeployConfig{
+				ListenerRuleTimeout: -time.Minute,
+			},
+			wantErr: errors.New("invalid value -1m0s for deploy-listener-rule-timeout flag, expects non-negative value"),
+		},
+		{
+			name: "progress configMap",
+			cfg: DeployConfig{
+				ProgressConfigMap: "kube-system/aws-load-balancer-controller-deploy-progress",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "progress configMap without namespace",
+			cfg: DeployConfig{
+				ProgressConfigMap: "aws-load-balancer-controller-deploy-progress",
+			},
+			wantErr: errors.New("invalid value aws-load-balancer-controller-deploy-progress for deploy-progress-configmap flag, expects namespace/name"),
+		},
+		{
+			name: "negative progress max age",
+			cfg: DeployConfig{
+				ProgressMaxAge: -time.Minute,
+			},
+			wantErr: errors.New("invalid value -1m0s for deploy-progress-max-age flag, expects non-negative value"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package config
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// HotReloadableFeatures are the features that can be enabled or disabled without restarting the controller.
+// They're only consulted when reconciling resources, instead of when setting up controllers and caches.
+var HotReloadableFeatures = []Feature{
+	WeightedTargetGroups,
+	SubnetsClusterTagCheck,
+	NLBHealthCheckAdvancedConfig,
+	ALBSingleSubnet,
+}
+
+// DynamicConfig is the part of the controller configuration that can be changed without restarting the controller,
+// via the ControllerConfiguration object.
+type DynamicConfig struct {
+	// DefaultSSLPolicy is the default SSL policy for load balancers.
+	DefaultSSLPolicy string
+	// DefaultTargetType is the default target type for target groups.
+	DefaultTargetType string
+	// FeatureGates is the state of the HotReloadableFeatures.
+	FeatureGates map[Feature]bool
+}
+
+// DynamicConfig returns the DynamicConfig specified via controller flags.
+func (cfg *ControllerConfig) DynamicConfig() DynamicConfig {
+	featureGates := make(map[Feature]bool, len(HotReloadableFeatures))
+	for _, feature := range HotReloadableFeatures {
+		featureGates[feature] = cfg.FeatureGates.Enabled(feature)
+	}
+	return DynamicConfig{
+		DefaultSSLPolicy:  cfg.DefaultSSLPolicy,
+		DefaultTargetType: cfg.DefaultTargetType,
+		FeatureGates:      featureGates,
+	}
+}
+
+// ValidateDynamicConfig validates the DynamicConfig specified via the ControllerConfiguration object.
+func (cfg *ControllerConfig) ValidateDynamicConfig(dynamicConfig DynamicConfig) error {
+	if dynamicConfig.DefaultSSLPolicy == "" {
+		return errors.New("default SSL policy must not be empty")
+	}
+	switch dynamicConfig.DefaultTargetType {
+	case string(elbv2.TargetTypeInstance), string(elbv2.TargetTypeIP):
+	default:
+		return errors.Errorf("invalid value %v for default target type", dynamicConfig.DefaultTargetType)
+	}
+	hotReloadableFeatures := make(map[Feature]struct{}, len(HotReloadableFeatures))
+	for _, feature := range HotReloadableFeatures {
+		hotReloadableFeatures[feature] = struct{}{}
+	}
+	for feature := range dynamicConfig.FeatureGates {
+		if _, ok := hotReloadableFeatures[feature]; !ok {
+			return errors.Errorf("feature gate %v cannot be changed without restarting the controller", feature)
+		}
+	}
+	return nil
+}
+
+// DynamicConfigProvider is responsible for providing the DynamicConfig in effect.
+type DynamicConfigProvider interface {
+	// DefaultSSLPolicy returns the default SSL policy for load balancers.
+	DefaultSSLPolicy() string
+	// DefaultTargetType returns the default target type for target groups.
+	DefaultTargetType() string
+	// DynamicConfig returns the DynamicConfig in effect.
+	DynamicConfig() DynamicConfig
+	// Update replaces the DynamicConfig, and returns whether it changed.
+	Update(dynamicConfig DynamicConfig) bool
+}
+
+// NewDynamicConfigProvider constructs new dynamicConfigProvider with the initial DynamicConfig.
+// The states of hot reloadable features in the initial DynamicConfig are applied to featureGates as well.
+func NewDynamicConfigProvider(dynamicConfig DynamicConfig, featureGates FeatureGates) *dynamicConfigProvider {
+	p := &dynamicConfigProvider{
+		dynamicConfig: dynamicConfig,
+		featureGates:  featureGates,
+	}
+	p.applyFeatureGates(dynamicConfig)
+	return p
+}
+
+var _ DynamicConfigProvider = &dynamicConfigProvider{}
+
+// default implementation for DynamicConfigProvider.
+type dynamicConfigProvider struct {
+	featureGates FeatureGates
+
+	mutex         sync.RWMutex
+	dynamicConfig DynamicConfig
+}
+
+func (p *dynamicConfigProvider) DefaultSSLPolicy() string {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.dynamicConfig.DefaultSSLPolicy
+}
+
+func (p *dynamicConfigProvider) DefaultTargetType() string {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.dynamicConfig.DefaultTargetType
+}
+
+func (p *dynamicConfigProvider) DynamicConfig() DynamicConfig {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.dynamicConfig
+}
+
+func (p *dynamicConfigProvider) Update(dynamicConfig DynamicConfig) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if equality.Semantic.DeepEqual(p.dynamicConfig, dynamicConfig) {
+		return false
+	}
+	p.applyFeatureGates(dynamicConfig)
+	p.dynamicConfig = dynamicConfig
+	return true
+}
+
+func (p *dynamicConfigProvider) applyFeatureGates(dynamicConfig DynamicConfig) {
+	for feature, enabled := range dynamicConfig.FeatureGates {
+		if enabled {
+			p.featureGates.Enable(feature)
+		} else {
+			p.featureGates.Disable(feature)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestControllerConfig_ValidateDynamicConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		dynamicConfig DynamicConfig
+		wantErr       error
+	}{
+		{
+			name: "valid dynamic config",
+			dynamicConfig: DynamicConfig{
+				DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+				DefaultTargetType: "ip",
+				FeatureGates:      map[Feature]bool{WeightedTargetGroups: false, ALBSingleSubnet: true},
+			},
+		},
+		{
+			name: "empty SSL policy",
+			dynamicConfig: DynamicConfig{
+				DefaultTargetType: "instance",
+			},
+			wantErr: errors.New("default SSL policy must not be empty"),
+		},
+		{
+			name: "invalid target type",
+			dynamicConfig: DynamicConfig{
+				DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+				DefaultTargetType: "alb",
+			},
+			wantErr: errors.New("invalid value alb for default target type"),
+		},
+		{
+			name: "feature gate requiring restart",
+			dynamicConfig: DynamicConfig{
+				DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+				DefaultTargetType: "instance",
+				FeatureGates:      map[Feature]bool{EnableServiceController: false},
+			},
+			wantErr: errors.New("feature gate EnableServiceController cannot be changed without restarting the controller"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &ControllerConfig{}
+			err := cfg.ValidateDynamicConfig(tt.dynamicConfig)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_dynamicConfigProvider_Update(t *testing.T) {
+	featureGates := NewFeatureGates()
+	initialDynamicConfig := DynamicConfig{
+		DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+		DefaultTargetType: "instance",
+		FeatureGates:      map[Feature]bool{WeightedTargetGroups: false},
+	}
+	p := NewDynamicConfigProvider(initialDynamicConfig, featureGates)
+	assert.False(t, featureGates.Enabled(WeightedTargetGroups))
+
+	assert.False(t, p.Update(initialDynamicConfig))
+
+	updatedDynamicConfig := DynamicConfig{
+		DefaultSSLPolicy:  "ELBSecurityPolicy-TLS13-1-2-2021-06",
+		DefaultTargetType: "ip",
+		FeatureGates:      map[Feature]bool{WeightedTargetGroups: true, ALBSingleSubnet: true},
+	}
+	assert.True(t, p.Update(updatedDynamicConfig))
+	assert.Equal(t, "ELBSecurityPolicy-TLS13-1-2-2021-06", p.DefaultSSLPolicy())
+	assert.Equal(t, "ip", p.DefaultTargetType())
+	assert.Equal(t, updatedDynamicConfig, p.DynamicConfig())
+	assert.True(t, featureGates.Enabled(WeightedTargetGroups))
+	assert.True(t, featureGates.Enabled(ALBSingleSubnet))
+}
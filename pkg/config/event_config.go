@@ -0,0 +1,57 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagEventDedupeWindow       = "event-dedupe-window"
+	flagEventRateLimitQPS       = "event-rate-limit-qps"
+	flagEventRateLimitBurst     = "event-rate-limit-burst"
+	defaultEventDedupeWindow    = 5 * time.Minute
+	defaultEventRateLimitQPS    = 0.05
+	defaultEventRateLimitBurst  = 20
+	maxEventDedupeWindowMinutes = 60
+)
+
+// EventConfig contains the configurations for throttling the Kubernetes events emitted by the controller,
+// so that AWS outages don't flood the API server with identical failure events.
+type EventConfig struct {
+	// DedupeWindow specifies the window in which identical events for the same object are emitted once, zero disables it.
+	DedupeWindow time.Duration
+
+	// RateLimitQPS specifies the rate of events emitted per reason, zero disables it.
+	RateLimitQPS float64
+
+	// RateLimitBurst specifies the burst of events emitted per reason.
+	RateLimitBurst int
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *EventConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&cfg.DedupeWindow, flagEventDedupeWindow, defaultEventDedupeWindow,
+		"Window in which identical events for the same object are emitted once, disabled if zero")
+	fs.Float64Var(&cfg.RateLimitQPS, flagEventRateLimitQPS, defaultEventRateLimitQPS,
+		"Rate of events emitted per reason in events per second, disabled if zero")
+	fs.IntVar(&cfg.RateLimitBurst, flagEventRateLimitBurst, defaultEventRateLimitBurst,
+		"Burst of events emitted per reason")
+}
+
+// Validate the event configuration
+func (cfg *EventConfig) Validate() error {
+	if cfg.DedupeWindow < 0 || cfg.DedupeWindow > maxEventDedupeWindowMinutes*time.Minute {
+		return errors.Errorf("invalid value %v for %v flag, expects between 0 and %v",
+			cfg.DedupeWindow, flagEventDedupeWindow, maxEventDedupeWindowMinutes*time.Minute)
+	}
+	if cfg.RateLimitQPS < 0 {
+		return errors.Errorf("invalid value %v for %v flag, expects non-negative value", cfg.RateLimitQPS, flagEventRateLimitQPS)
+	}
+	if cfg.RateLimitQPS > 0 && cfg.RateLimitBurst < 1 {
+		return errors.Errorf("invalid value %v for %v flag, expects positive value when %v is set",
+			cfg.RateLimitBurst, flagEventRateLimitBurst, flagEventRateLimitQPS)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     EventConfig
+		wantErr error
+	}{
+		{
+			name: "default configuration",
+			cfg: EventConfig{
+				DedupeWindow:   5 * time.Minute,
+				RateLimitQPS:   0.05,
+				RateLimitBurst: 20,
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "disabled",
+			cfg:     EventConfig{},
+			wantErr: nil,
+		},
+		{
+			name: "dedupe window beyond an hour",
+			cfg: EventConfig{
+				DedupeWindow: 2 * time.Hour,
+			},
+			wantErr: errors.New("invalid value 2h0m0s for event-dedupe-window flag, expects between 0 and 1h0m0s"),
+		},
+		{
+			name: "negative rate limit",
+			cfg: EventConfig{
+				RateLimitQPS:   -1,
+				RateLimitBurst: 20,
+			},
+			wantErr: errors.New("invalid value -1 for event-rate-limit-qps flag, expects non-negative value"),
+		},
+		{
+			name: "rate limit without burst",
+			cfg: EventConfig{
+				RateLimitQPS: 0.05,
+			},
+			wantErr: errors.New("invalid value 0 for event-rate-limit-burst flag, expects positive value when event-rate-limit-qps is set"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
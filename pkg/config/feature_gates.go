@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/spf13/pflag"
 )
@@ -11,17 +12,41 @@ import (
 type Feature string
 
 const (
-	ListenerRulesTagging         Feature = "ListenerRulesTagging"
-	WeightedTargetGroups         Feature = "WeightedTargetGroups"
-	ServiceTypeLoadBalancerOnly  Feature = "ServiceTypeLoadBalancerOnly"
-	EndpointsFailOpen            Feature = "EndpointsFailOpen"
-	EnableServiceController      Feature = "EnableServiceController"
-	EnableIPTargetType           Feature = "EnableIPTargetType"
-	EnableRGTAPI                 Feature = "EnableRGTAPI"
-	SubnetsClusterTagCheck       Feature = "SubnetsClusterTagCheck"
-	NLBHealthCheckAdvancedConfig Feature = "NLBHealthCheckAdvancedConfig"
-	NLBSecurityGroup             Feature = "NLBSecurityGroup"
-	ALBSingleSubnet              Feature = "ALBSingleSubnet"
+	ListenerRulesTagging          Feature = "ListenerRulesTagging"
+	WeightedTargetGroups          Feature = "WeightedTargetGroups"
+	ServiceTypeLoadBalancerOnly   Feature = "ServiceTypeLoadBalancerOnly"
+	EndpointsFailOpen             Feature = "EndpointsFailOpen"
+	EnableServiceController       Feature = "EnableServiceController"
+	EnableIPTargetType            Feature = "EnableIPTargetType"
+	EnableRGTAPI                  Feature = "EnableRGTAPI"
+	SubnetsClusterTagCheck        Feature = "SubnetsClusterTagCheck"
+	NLBHealthCheckAdvancedConfig  Feature = "NLBHealthCheckAdvancedConfig"
+	NLBSecurityGroup              Feature = "NLBSecurityGroup"
+	ALBSingleSubnet               Feature = "ALBSingleSubnet"
+	GatewayAPI                    Feature = "GatewayAPI"
+	AZTargetDistributionAdvisory  Feature = "AZTargetDistributionAdvisory"
+	TargetGroupWeightPolicy       Feature = "TargetGroupWeightPolicy"
+	EndpointServices              Feature = "EndpointServices"
+	Blocklist                     Feature = "Blocklist"
+	IPv6Only                      Feature = "IPv6Only"
+	FrontendSecurityGroupRule     Feature = "FrontendSecurityGroupRule"
+	IngressGroupResource          Feature = "IngressGroupResource"
+	ListenerRulesConditionalFetch Feature = "ListenerRulesConditionalFetch"
+	MutationVerification          Feature = "MutationVerification"
+	LoadBalancerConfiguration     Feature = "LoadBalancerConfiguration"
+	LoadBalancerRoute             Feature = "LoadBalancerRoute"
+	PodDeregistrationCoordination Feature = "PodDeregistrationCoordination"
+	GlobalAccelerator             Feature = "GlobalAccelerator"
+	Route53AliasRecords           Feature = "Route53AliasRecords"
+	ELBV2DescribeCache            Feature = "ELBV2DescribeCache"
+	PrivilegedAnnotationsAuthz    Feature = "PrivilegedAnnotationsAuthz"
+	LoadBalancerENITracking       Feature = "LoadBalancerENITracking"
+	NodeSubnetsPrefixList         Feature = "NodeSubnetsPrefixList"
+	ACMCertRequests               Feature = "ACMCertRequests"
+	TargetedDebugLogging          Feature = "TargetedDebugLogging"
+	TargetHealthStreaming         Feature = "TargetHealthStreaming"
+	ResumableDeploy               Feature = "ResumableDeploy"
+	AWSRoleARNAnnotation          Feature = "AWSRoleARNAnnotation"
 )
 
 type FeatureGates interface {
@@ -34,6 +59,9 @@ type FeatureGates interface {
 	// Disable will disable a feature
 	Disable(feature Feature)
 
+	// States returns the state of all features
+	States() map[Feature]bool
+
 	// BindFlags bind featureGates flags
 	BindFlags(fs *pflag.FlagSet)
 }
@@ -42,6 +70,8 @@ var _ FeatureGates = (*defaultFeatureGates)(nil)
 var _ pflag.Value = (*defaultFeatureGates)(nil)
 
 type defaultFeatureGates struct {
+	// mutex protects featureState, as hot reloadable features are changed at runtime.
+	mutex        sync.RWMutex
 	featureState map[Feature]bool
 }
 
@@ -49,17 +79,41 @@ type defaultFeatureGates struct {
 func NewFeatureGates() FeatureGates {
 	return &defaultFeatureGates{
 		featureState: map[Feature]bool{
-			ListenerRulesTagging:         true,
-			WeightedTargetGroups:         true,
-			ServiceTypeLoadBalancerOnly:  false,
-			EndpointsFailOpen:            true,
-			EnableServiceController:      true,
-			EnableIPTargetType:           true,
-			EnableRGTAPI:                 false,
-			SubnetsClusterTagCheck:       true,
-			NLBHealthCheckAdvancedConfig: true,
-			NLBSecurityGroup:             true,
-			ALBSingleSubnet:              false,
+			ListenerRulesTagging:          true,
+			WeightedTargetGroups:          true,
+			ServiceTypeLoadBalancerOnly:   false,
+			EndpointsFailOpen:             true,
+			EnableServiceController:       true,
+			EnableIPTargetType:            true,
+			EnableRGTAPI:                  false,
+			SubnetsClusterTagCheck:        true,
+			NLBHealthCheckAdvancedConfig:  true,
+			NLBSecurityGroup:              true,
+			ALBSingleSubnet:               false,
+			GatewayAPI:                    false,
+			AZTargetDistributionAdvisory:  false,
+			TargetGroupWeightPolicy:       false,
+			EndpointServices:              false,
+			Blocklist:                     false,
+			IPv6Only:                      false,
+			FrontendSecurityGroupRule:     false,
+			IngressGroupResource:          false,
+			ListenerRulesConditionalFetch: false,
+			MutationVerification:          false,
+			LoadBalancerConfiguration:     false,
+			LoadBalancerRoute:             false,
+			PodDeregistrationCoordination: false,
+			GlobalAccelerator:             false,
+			Route53AliasRecords:           false,
+			ELBV2DescribeCache:            false,
+			PrivilegedAnnotationsAuthz:    false,
+			LoadBalancerENITracking:       false,
+			NodeSubnetsPrefixList:         false,
+			ACMCertRequests:               false,
+			TargetedDebugLogging:          false,
+			TargetHealthStreaming:         false,
+			ResumableDeploy:               false,
+			AWSRoleARNAnnotation:          false,
 		},
 	}
 }
@@ -69,18 +123,36 @@ func (f *defaultFeatureGates) BindFlags(fs *pflag.FlagSet) {
 }
 
 func (f *defaultFeatureGates) Enabled(feature Feature) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
 	return f.featureState[feature]
 }
 
 func (f *defaultFeatureGates) Enable(feature Feature) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	f.featureState[feature] = true
 }
 
 func (f *defaultFeatureGates) Disable(feature Feature) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
 	f.featureState[feature] = false
 }
 
+func (f *defaultFeatureGates) States() map[Feature]bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	states := make(map[Feature]bool, len(f.featureState))
+	for feature, enabled := range f.featureState {
+		states[feature] = enabled
+	}
+	return states
+}
+
 func (f *defaultFeatureGates) String() string {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
 	var featureSettings []string
 	for feature, enabled := range f.featureState {
 		featureSettings = append(featureSettings, fmt.Sprintf("%v=%v", feature, enabled))
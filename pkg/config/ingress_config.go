@@ -1,6 +1,10 @@
 package config
 
-import "github.com/spf13/pflag"
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+)
 
 const (
 	flagIngressClass                         = "ingress-class"
@@ -9,12 +13,22 @@ const (
 	flagIngressMaxConcurrentReconciles       = "ingress-max-concurrent-reconciles"
 	flagTolerateNonExistentBackendService    = "tolerate-non-existent-backend-service"
 	flagTolerateNonExistentBackendAction     = "tolerate-non-existent-backend-action"
+	flagEnableResourceARNsConfigMap          = "enable-ingress-resource-arns-configmap"
+	flagRuleMetricsPollInterval              = "ingress-rule-metrics-poll-interval"
+	flagCertDiscoveryResyncPeriod            = "cert-discovery-resync-period"
+	flagCertDiscoveryTags                    = "cert-discovery-tags"
+	flagCertRotationLeadtime                 = "cert-rotation-leadtime"
+	flagCertRequestHostedZoneID              = "cert-request-hosted-zone-id"
 	defaultIngressClass                      = "alb"
 	defaultDisableIngressClassAnnotation     = false
 	defaultDisableIngressGroupNameAnnotation = false
 	defaultMaxIngressConcurrentReconciles    = 3
 	defaultTolerateNonExistentBackendService = true
 	defaultTolerateNonExistentBackendAction  = true
+	defaultEnableResourceARNsConfigMap       = false
+	defaultRuleMetricsPollInterval           = 0
+	defaultCertDiscoveryResyncPeriod         = 0
+	defaultCertRotationLeadtime              = 0
 )
 
 // IngressConfig contains the configurations for the Ingress controller
@@ -42,6 +56,29 @@ type IngressConfig struct {
 	// TolerateNonExistentBackendAction specifies whether to allow rules that reference a backend action that does not
 	// exist. In this case, requests to that rule will result in a 503 error.
 	TolerateNonExistentBackendAction bool
+
+	// EnableResourceARNsConfigMap specifies whether to write the ARNs of the load balancer, listeners and target groups
+	// into a ConfigMap per Ingress.
+	EnableResourceARNsConfigMap bool
+
+	// RuleMetricsPollInterval specifies the interval to poll the CloudWatch metrics of listener rules,
+	// and re-export them as Prometheus metrics labeled with the owning Ingress and path. 0 disables it.
+	RuleMetricsPollInterval time.Duration
+
+	// CertDiscoveryResyncPeriod specifies the period to re-discover the certificates of Ingresses relying on
+	// certificate auto-discovery, so that newly issued certificates get attached. 0 disables it.
+	CertDiscoveryResyncPeriod time.Duration
+
+	// CertDiscoveryTags are AWS Tags the ACM certificates must have to be auto-discovered, the value * matches any value.
+	CertDiscoveryTags map[string]string
+
+	// CertRotationLeadtime specifies how long before their expiry the listener certificates get rotated to a replacement
+	// ACM certificate covering the same domains. 0 disables it.
+	CertRotationLeadtime time.Duration
+
+	// CertRequestHostedZoneID specifies the ID of the Route 53 hosted zone to create the DNS validation records of
+	// the ACM certificates requested for Ingress hosts without certificate in.
+	CertRequestHostedZoneID string
 }
 
 // BindFlags binds the command line flags to the fields in the config object
@@ -58,4 +95,16 @@ func (cfg *IngressConfig) BindFlags(fs *pflag.FlagSet) {
 		"Tolerate rules that specify a non-existent backend service")
 	fs.BoolVar(&cfg.TolerateNonExistentBackendAction, flagTolerateNonExistentBackendAction, defaultTolerateNonExistentBackendAction,
 		"Tolerate rules that specify a non-existent backend action")
+	fs.BoolVar(&cfg.EnableResourceARNsConfigMap, flagEnableResourceARNsConfigMap, defaultEnableResourceARNsConfigMap,
+		"Enable writing the ELBv2 resource ARNs into a ConfigMap per Ingress")
+	fs.DurationVar(&cfg.RuleMetricsPollInterval, flagRuleMetricsPollInterval, defaultRuleMetricsPollInterval,
+		"Interval to poll CloudWatch metrics of Ingress listener rules and re-export them as Prometheus metrics, 0 disables it")
+	fs.DurationVar(&cfg.CertDiscoveryResyncPeriod, flagCertDiscoveryResyncPeriod, defaultCertDiscoveryResyncPeriod,
+		"Period to re-discover the certificates of Ingresses relying on certificate auto-discovery, 0 disables it")
+	fs.StringToStringVar(&cfg.CertDiscoveryTags, flagCertDiscoveryTags, nil,
+		"AWS Tags the ACM certificates must have to be auto-discovered, the value * matches any value")
+	fs.DurationVar(&cfg.CertRotationLeadtime, flagCertRotationLeadtime, defaultCertRotationLeadtime,
+		"Duration before the expiry of Ingress listener certificates to rotate them to replacement ACM certificates, 0 disables it")
+	fs.StringVar(&cfg.CertRequestHostedZoneID, flagCertRequestHostedZoneID, "",
+		"ID of the Route 53 hosted zone to validate the ACM certificates requested for Ingress hosts without certificate in, requires the ACMCertRequests feature gate")
 }
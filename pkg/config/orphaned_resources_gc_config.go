@@ -0,0 +1,51 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagOrphanedResourcesGCInterval    = "orphaned-resources-gc-interval"
+	flagOrphanedResourcesGCMinAge      = "orphaned-resources-gc-min-age"
+	flagOrphanedResourcesGCDryRun      = "orphaned-resources-gc-dry-run"
+	defaultOrphanedResourcesGCInterval = 0
+	defaultOrphanedResourcesGCMinAge   = time.Hour
+	defaultOrphanedResourcesGCDryRun   = false
+)
+
+// OrphanedResourcesGCConfig contains the configurations for the garbage collector of orphaned AWS resources
+type OrphanedResourcesGCConfig struct {
+	// Interval specifies the interval to sweep AWS resources tagged with the cluster name
+	// and delete the ones whose owning Kubernetes resource no longer exists. 0 disables it.
+	Interval time.Duration
+
+	// MinAge specifies how long an AWS resource must have been continuously observed as orphaned before it's deleted.
+	MinAge time.Duration
+
+	// DryRun specifies whether to only report the orphaned AWS resources instead of deleting them.
+	DryRun bool
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *OrphanedResourcesGCConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.DurationVar(&cfg.Interval, flagOrphanedResourcesGCInterval, defaultOrphanedResourcesGCInterval,
+		"Interval to sweep AWS resources tagged with the cluster name and delete the ones whose owning Kubernetes resource no longer exists, 0 disables it")
+	fs.DurationVar(&cfg.MinAge, flagOrphanedResourcesGCMinAge, defaultOrphanedResourcesGCMinAge,
+		"Minimum duration an AWS resource must have been observed as orphaned before it's deleted")
+	fs.BoolVar(&cfg.DryRun, flagOrphanedResourcesGCDryRun, defaultOrphanedResourcesGCDryRun,
+		"Report orphaned AWS resources via logs instead of deleting them")
+}
+
+// Validate the orphaned resources garbage collector configuration
+func (cfg *OrphanedResourcesGCConfig) Validate() error {
+	if cfg.Interval != 0 && cfg.Interval < time.Minute {
+		return errors.Errorf("invalid value %v for %v flag, expects 0 or at least 1m", cfg.Interval, flagOrphanedResourcesGCInterval)
+	}
+	if cfg.MinAge < 0 {
+		return errors.Errorf("invalid value %v for %v flag, expects a non-negative duration", cfg.MinAge, flagOrphanedResourcesGCMinAge)
+	}
+	return nil
+}
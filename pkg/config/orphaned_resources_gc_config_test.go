@@ -0,0 +1,66 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrphanedResourcesGCConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     OrphanedResourcesGCConfig
+		wantErr error
+	}{
+		{
+			name: "disabled",
+			cfg: OrphanedResourcesGCConfig{
+				MinAge: time.Hour,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "sweep every 10 minutes",
+			cfg: OrphanedResourcesGCConfig{
+				Interval: 10 * time.Minute,
+				MinAge:   time.Hour,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "sweep more often than every minute",
+			cfg: OrphanedResourcesGCConfig{
+				Interval: 30 * time.Second,
+				MinAge:   time.Hour,
+			},
+			wantErr: errors.New("invalid value 30s for orphaned-resources-gc-interval flag, expects 0 or at least 1m"),
+		},
+		{
+			name: "no min age",
+			cfg: OrphanedResourcesGCConfig{
+				Interval: 10 * time.Minute,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "negative min age",
+			cfg: OrphanedResourcesGCConfig{
+				Interval: 10 * time.Minute,
+				MinAge:   -time.Hour,
+			},
+			wantErr: errors.New("invalid value -1h0m0s for orphaned-resources-gc-min-age flag, expects a non-negative duration"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
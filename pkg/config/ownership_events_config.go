@@ -0,0 +1,42 @@
+package config
+
+import (
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagOwnershipEventsEventBus    = "ownership-events-event-bus"
+	flagOwnershipEventsSNSTopicARN = "ownership-events-sns-topic-arn"
+)
+
+// OwnershipEventsConfig contains the configurations for publishing the mapping between managed AWS resources and the Kubernetes objects owning them
+type OwnershipEventsConfig struct {
+	// EventBus specifies the name or ARN of the EventBridge event bus to publish ownership changes to. Empty disables it.
+	EventBus string
+
+	// SNSTopicARN specifies the ARN of the SNS topic to publish ownership changes to. Empty disables it.
+	SNSTopicARN string
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *OwnershipEventsConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&cfg.EventBus, flagOwnershipEventsEventBus, "",
+		"Name or ARN of the EventBridge event bus to publish the ownership changes of managed load balancers and target groups to, disabled if empty")
+	fs.StringVar(&cfg.SNSTopicARN, flagOwnershipEventsSNSTopicARN, "",
+		"ARN of the SNS topic to publish the ownership changes of managed load balancers and target groups to, disabled if empty")
+}
+
+// Enabled returns whether ownership changes are published to any destination.
+func (cfg *OwnershipEventsConfig) Enabled() bool {
+	return cfg.EventBus != "" || cfg.SNSTopicARN != ""
+}
+
+// Validate the ownership events configuration
+func (cfg *OwnershipEventsConfig) Validate() error {
+	if cfg.SNSTopicARN != "" && !arn.IsARN(cfg.SNSTopicARN) {
+		return errors.Errorf("invalid value %v for %v flag, expects an SNS topic ARN", cfg.SNSTopicARN, flagOwnershipEventsSNSTopicARN)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagRecoveryReadinessResourceSet     = "recovery-readiness-resource-set"
+	flagRecoveryReadinessSyncInterval    = "recovery-readiness-sync-interval"
+	defaultRecoveryReadinessResourceSet  = ""
+	defaultRecoveryReadinessSyncInterval = 5 * time.Minute
+	minRecoveryReadinessSyncInterval     = time.Minute
+	// recoveryReadinessResourceNameMaxLength is the max length of Route 53 ARC readiness resource set names (64),
+	// minus the "-target-groups" suffix of the resource set of target groups.
+	recoveryReadinessResourceNameMaxLength = 50
+)
+
+// recoveryReadinessResourceNamePattern matches the names allowed for Route 53 ARC readiness resource sets and checks.
+var recoveryReadinessResourceNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// RecoveryReadinessConfig contains the configurations for registering the managed load balancers and target groups into Route 53 Application Recovery Controller readiness checks
+type RecoveryReadinessConfig struct {
+	// ResourceSet specifies the name of the Route 53 ARC resource set whose members are kept in sync with the load balancers
+	// managed by this controller, together with a readiness check of the same name. The target groups are kept in sync with
+	// the resource set and readiness check of the name suffixed by "-target-groups". Empty disables it.
+	ResourceSet string
+
+	// SyncInterval specifies the interval to sync the members of the resource set.
+	SyncInterval time.Duration
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *RecoveryReadinessConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&cfg.ResourceSet, flagRecoveryReadinessResourceSet, defaultRecoveryReadinessResourceSet,
+		"Name of the Route 53 Application Recovery Controller resource set and readiness check to register the managed load balancers into, the managed target groups are registered into the ones suffixed by -target-groups, disabled if empty")
+	fs.DurationVar(&cfg.SyncInterval, flagRecoveryReadinessSyncInterval, defaultRecoveryReadinessSyncInterval,
+		"Interval to sync the managed load balancers and target groups into the Route 53 Application Recovery Controller resource sets")
+}
+
+// Validate the recovery readiness configuration
+func (cfg *RecoveryReadinessConfig) Validate() error {
+	if cfg.ResourceSet == "" {
+		return nil
+	}
+	if len(cfg.ResourceSet) > recoveryReadinessResourceNameMaxLength || !recoveryReadinessResourceNamePattern.MatchString(cfg.ResourceSet) {
+		return errors.Errorf("invalid value %v for %v flag, expects up to %v alphanumeric characters, hyphens or underscores",
+			cfg.ResourceSet, flagRecoveryReadinessResourceSet, recoveryReadinessResourceNameMaxLength)
+	}
+	if cfg.SyncInterval < minRecoveryReadinessSyncInterval {
+		return errors.Errorf("invalid value %v for %v flag, expects at least %v",
+			cfg.SyncInterval, flagRecoveryReadinessSyncInterval, minRecoveryReadinessSyncInterval)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoveryReadinessConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     RecoveryReadinessConfig
+		wantErr error
+	}{
+		{
+			name: "disabled",
+			cfg: RecoveryReadinessConfig{
+				SyncInterval: 5 * time.Minute,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "sync every 5 minutes",
+			cfg: RecoveryReadinessConfig{
+				ResourceSet:  "my-cluster_lbs",
+				SyncInterval: 5 * time.Minute,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "invalid resource set name",
+			cfg: RecoveryReadinessConfig{
+				ResourceSet:  "my cluster",
+				SyncInterval: 5 * time.Minute,
+			},
+			wantErr: errors.New("invalid value my cluster for recovery-readiness-resource-set flag, expects up to 50 alphanumeric characters, hyphens or underscores"),
+		},
+		{
+			name: "sync more often than every minute",
+			cfg: RecoveryReadinessConfig{
+				ResourceSet:  "my-cluster",
+				SyncInterval: 30 * time.Second,
+			},
+			wantErr: errors.New("invalid value 30s for recovery-readiness-sync-interval flag, expects at least 1m0s"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,16 +1,23 @@
 package config
 
 import (
+	"context"
 	"crypto/tls"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -22,6 +29,7 @@ const (
 	flagLeaderElectionID        = "leader-election-id"
 	flagLeaderElectionNamespace = "leader-election-namespace"
 	flagWatchNamespace          = "watch-namespace"
+	flagWatchNamespaceSelector  = "watch-namespace-selector"
 	flagSyncPeriod              = "sync-period"
 	flagKubeconfig              = "kubeconfig"
 	flagWebhookCertDir          = "webhook-cert-dir"
@@ -31,7 +39,6 @@ const (
 	defaultKubeconfig              = ""
 	defaultLeaderElectionID        = "aws-load-balancer-controller-leader"
 	defaultLeaderElectionNamespace = ""
-	defaultWatchNamespace          = corev1.NamespaceAll
 	defaultMetricsAddr             = ":8080"
 	defaultHealthProbeBindAddress  = ":61779"
 	defaultSyncPeriod              = 10 * time.Hour
@@ -57,7 +64,8 @@ type RuntimeConfig struct {
 	EnableLeaderElection    bool
 	LeaderElectionID        string
 	LeaderElectionNamespace string
-	WatchNamespace          string
+	WatchNamespaces         []string
+	WatchNamespaceSelector  string
 	SyncPeriod              time.Duration
 	WebhookCertDir          string
 	WebhookCertName         string
@@ -81,8 +89,10 @@ func (c *RuntimeConfig) BindFlags(fs *pflag.FlagSet) {
 		"Name of the leader election ID to use for this controller")
 	fs.StringVar(&c.LeaderElectionNamespace, flagLeaderElectionNamespace, defaultLeaderElectionNamespace,
 		"Name of the leader election ID to use for this controller")
-	fs.StringVar(&c.WatchNamespace, flagWatchNamespace, defaultWatchNamespace,
-		"Namespace the controller watches for updates to Kubernetes objects, If empty, all namespaces are watched.")
+	fs.StringSliceVar(&c.WatchNamespaces, flagWatchNamespace, nil,
+		"Comma separated list of namespaces the controller watches for updates to Kubernetes objects, If empty, all namespaces are watched.")
+	fs.StringVar(&c.WatchNamespaceSelector, flagWatchNamespaceSelector, "",
+		"Label selector of the namespaces the controller watches in addition to the namespaces specified in watch-namespace flag, resolved at startup.")
 	fs.DurationVar(&c.SyncPeriod, flagSyncPeriod, defaultSyncPeriod,
 		"Period at which the controller forces the repopulation of its local object stores.")
 	fs.StringVar(&c.WebhookCertDir, flagWebhookCertDir, defaultWebhookCertDir, "WebhookCertDir is the directory that contains the webhook server key and certificate.")
@@ -91,6 +101,45 @@ func (c *RuntimeConfig) BindFlags(fs *pflag.FlagSet) {
 
 }
 
+// Validate the runtime configuration
+func (c *RuntimeConfig) Validate() error {
+	for _, namespace := range c.WatchNamespaces {
+		if len(namespace) == 0 {
+			return errors.Errorf("invalid value for %v flag, expects non-empty namespaces", flagWatchNamespace)
+		}
+	}
+	if _, err := labels.Parse(c.WatchNamespaceSelector); err != nil {
+		return errors.Wrapf(err, "invalid value for %v flag", flagWatchNamespaceSelector)
+	}
+	return nil
+}
+
+// ResolveWatchNamespaces resolves the namespaces the controller watches, which are the namespaces specified explicitly
+// along with the namespaces matching the namespace selector at the time of the call.
+// An empty result denotes all namespaces are watched.
+func ResolveWatchNamespaces(ctx context.Context, rtCfg RuntimeConfig, namespacesClient corev1client.NamespaceInterface) ([]string, error) {
+	watchNamespaces := sets.NewString(rtCfg.WatchNamespaces...)
+	if len(rtCfg.WatchNamespaceSelector) == 0 {
+		return watchNamespaces.List(), nil
+	}
+	selector, err := labels.Parse(rtCfg.WatchNamespaceSelector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid value for %v flag", flagWatchNamespaceSelector)
+	}
+	namespaceList, err := namespacesClient.List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list namespaces to watch")
+	}
+	for _, namespace := range namespaceList.Items {
+		watchNamespaces.Insert(namespace.Name)
+	}
+	// an empty result would watch all namespaces, which contradicts the selector.
+	if watchNamespaces.Len() == 0 {
+		return nil, errors.Errorf("no namespaces match %v flag %q", flagWatchNamespaceSelector, rtCfg.WatchNamespaceSelector)
+	}
+	return watchNamespaces.List(), nil
+}
+
 // BuildRestConfig builds the REST config for the controller runtime
 func BuildRestConfig(rtCfg RuntimeConfig) (*rest.Config, error) {
 	var restCFG *rest.Config
@@ -111,8 +160,9 @@ func BuildRestConfig(rtCfg RuntimeConfig) (*rest.Config, error) {
 }
 
 // BuildRuntimeOptions builds the options for the controller runtime based on config
-func BuildRuntimeOptions(rtCfg RuntimeConfig, scheme *runtime.Scheme) ctrl.Options {
-	return ctrl.Options{
+// * watchNamespaces are the namespaces the caches of the controllers are scoped to, all namespaces if empty.
+func BuildRuntimeOptions(rtCfg RuntimeConfig, watchNamespaces []string, scheme *runtime.Scheme) ctrl.Options {
+	opts := ctrl.Options{
 		Scheme:                     scheme,
 		Port:                       rtCfg.WebhookBindPort,
 		CertDir:                    rtCfg.WebhookCertDir,
@@ -122,10 +172,18 @@ func BuildRuntimeOptions(rtCfg RuntimeConfig, scheme *runtime.Scheme) ctrl.Optio
 		LeaderElectionResourceLock: resourcelock.ConfigMapsLeasesResourceLock,
 		LeaderElectionID:           rtCfg.LeaderElectionID,
 		LeaderElectionNamespace:    rtCfg.LeaderElectionNamespace,
-		Namespace:                  rtCfg.WatchNamespace,
 		SyncPeriod:                 &rtCfg.SyncPeriod,
 		ClientDisableCacheFor:      []client.Object{&corev1.Secret{}},
 	}
+	switch len(watchNamespaces) {
+	case 0:
+		opts.Namespace = corev1.NamespaceAll
+	case 1:
+		opts.Namespace = watchNamespaces[0]
+	default:
+		opts.NewCache = cache.MultiNamespacedCacheBuilder(watchNamespaces)
+	}
+	return opts
 }
 
 // ConfigureWebhookServer set up the server cert for the webhook server.
@@ -0,0 +1,153 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRuntimeConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     RuntimeConfig
+		wantErr error
+	}{
+		{
+			name:    "all namespaces",
+			cfg:     RuntimeConfig{},
+			wantErr: nil,
+		},
+		{
+			name: "namespaces and selector",
+			cfg: RuntimeConfig{
+				WatchNamespaces:        []string{"team-a", "team-b"},
+				WatchNamespaceSelector: "team in (c, d)",
+			},
+			wantErr: nil,
+		},
+		{
+			name: "empty namespace",
+			cfg: RuntimeConfig{
+				WatchNamespaces: []string{"team-a", ""},
+			},
+			wantErr: errors.New("invalid value for watch-namespace flag, expects non-empty namespaces"),
+		},
+		{
+			name: "invalid selector",
+			cfg: RuntimeConfig{
+				WatchNamespaceSelector: "team in (c",
+			},
+			wantErr: errors.New("invalid value for watch-namespace-selector flag: unable to parse requirement: found '', expected: ',' or ')'"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResolveWatchNamespaces(t *testing.T) {
+	namespaces := []runtime.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+	}
+	tests := []struct {
+		name    string
+		cfg     RuntimeConfig
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "all namespaces",
+			cfg:  RuntimeConfig{},
+			want: []string{},
+		},
+		{
+			name: "namespaces only",
+			cfg: RuntimeConfig{
+				WatchNamespaces: []string{"team-b", "team-a", "team-b"},
+			},
+			want: []string{"team-a", "team-b"},
+		},
+		{
+			name: "selector only",
+			cfg: RuntimeConfig{
+				WatchNamespaceSelector: "team",
+			},
+			want: []string{"team-a", "team-b"},
+		},
+		{
+			name: "namespaces and selector",
+			cfg: RuntimeConfig{
+				WatchNamespaces:        []string{"kube-system"},
+				WatchNamespaceSelector: "team=b",
+			},
+			want: []string{"kube-system", "team-b"},
+		},
+		{
+			name: "selector matches no namespaces",
+			cfg: RuntimeConfig{
+				WatchNamespaceSelector: "team=c",
+			},
+			wantErr: errors.New("no namespaces match watch-namespace-selector flag \"team=c\""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientSet := fake.NewSimpleClientset(namespaces...)
+			got, err := ResolveWatchNamespaces(context.Background(), tt.cfg, clientSet.CoreV1().Namespaces())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuildRuntimeOptions(t *testing.T) {
+	tests := []struct {
+		name            string
+		watchNamespaces []string
+		wantNamespace   string
+		wantMultiCache  bool
+	}{
+		{
+			name:            "all namespaces",
+			watchNamespaces: nil,
+			wantNamespace:   corev1.NamespaceAll,
+		},
+		{
+			name:            "single namespace",
+			watchNamespaces: []string{"team-a"},
+			wantNamespace:   "team-a",
+		},
+		{
+			name:            "multiple namespaces",
+			watchNamespaces: []string{"team-a", "team-b"},
+			wantNamespace:   corev1.NamespaceAll,
+			wantMultiCache:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildRuntimeOptions(RuntimeConfig{}, tt.watchNamespaces, runtime.NewScheme())
+			assert.Equal(t, tt.wantNamespace, got.Namespace)
+			assert.Equal(t, tt.wantMultiCache, got.NewCache != nil)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+const (
+	flagShardCount            = "shard-count"
+	flagShardLeaseNamespace   = "shard-lease-namespace"
+	flagShardLeaseDuration    = "shard-lease-duration"
+	defaultShardCount         = 0
+	defaultShardLeaseDuration = 15 * time.Second
+	minShardLeaseDuration     = 5 * time.Second
+)
+
+// ShardingConfig contains the configurations for sharding IngressGroups and Services across controller replicas
+type ShardingConfig struct {
+	// ShardCount specifies the number of shards IngressGroups and Services are hashed into,
+	// each replica reconciles the shards it holds the lease of. 0 disables sharding.
+	ShardCount int
+
+	// LeaseNamespace specifies the namespace of the leases to claim shards and register replicas with.
+	LeaseNamespace string
+
+	// LeaseDuration specifies the duration a shard stays owned by a replica without renewal.
+	LeaseDuration time.Duration
+}
+
+// BindFlags binds the command line flags to the fields in the config object
+func (cfg *ShardingConfig) BindFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&cfg.ShardCount, flagShardCount, defaultShardCount,
+		"Number of shards IngressGroups and Services are hashed into, so that every replica reconciles the shards it claimed, 0 disables sharding")
+	fs.StringVar(&cfg.LeaseNamespace, flagShardLeaseNamespace, "",
+		"Namespace of the leases to claim shards with, required if sharding is enabled")
+	fs.DurationVar(&cfg.LeaseDuration, flagShardLeaseDuration, defaultShardLeaseDuration,
+		"Duration a shard stays claimed by a replica without renewal")
+}
+
+// Enabled returns whether IngressGroups and Services are sharded across replicas.
+func (cfg *ShardingConfig) Enabled() bool {
+	return cfg.ShardCount > 0
+}
+
+// Validate the sharding configuration
+func (cfg *ShardingConfig) Validate() error {
+	if cfg.ShardCount < 0 {
+		return errors.Errorf("invalid value %v for %v flag, expects a non-negative value", cfg.ShardCount, flagShardCount)
+	}
+	if !cfg.Enabled() {
+		return nil
+	}
+	if len(cfg.LeaseNamespace) == 0 {
+		return errors.Errorf("%v flag requires %v flag", flagShardCount, flagShardLeaseNamespace)
+	}
+	if cfg.LeaseDuration < minShardLeaseDuration {
+		return errors.Errorf("invalid value %v for %v flag, expects at least %v", cfg.LeaseDuration, flagShardLeaseDuration, minShardLeaseDuration)
+	}
+	return nil
+}
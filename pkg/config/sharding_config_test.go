@@ -0,0 +1,69 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardingConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     ShardingConfig
+		wantErr error
+	}{
+		{
+			name: "disabled",
+			cfg: ShardingConfig{
+				LeaseDuration: 15 * time.Second,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "16 shards",
+			cfg: ShardingConfig{
+				ShardCount:     16,
+				LeaseNamespace: "kube-system",
+				LeaseDuration:  15 * time.Second,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "negative shard count",
+			cfg: ShardingConfig{
+				ShardCount:    -1,
+				LeaseDuration: 15 * time.Second,
+			},
+			wantErr: errors.New("invalid value -1 for shard-count flag, expects a non-negative value"),
+		},
+		{
+			name: "lease namespace missing",
+			cfg: ShardingConfig{
+				ShardCount:    16,
+				LeaseDuration: 15 * time.Second,
+			},
+			wantErr: errors.New("shard-count flag requires shard-lease-namespace flag"),
+		},
+		{
+			name: "lease duration too short",
+			cfg: ShardingConfig{
+				ShardCount:     16,
+				LeaseNamespace: "kube-system",
+				LeaseDuration:  time.Second,
+			},
+			wantErr: errors.New("invalid value 1s for shard-lease-duration flag, expects at least 5s"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
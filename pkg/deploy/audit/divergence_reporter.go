@@ -0,0 +1,150 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	metricSubsystemShadow          = "shadow"
+	metricShadowDivergentMutations = "divergent_mutations"
+
+	labelKind      = "kind"
+	labelNamespace = "namespace"
+	labelName      = "name"
+)
+
+// DivergenceReporter reports the changes planned in shadow mode, which is the divergence between the model built by this
+// controller version and the AWS resources deployed by the active controller version.
+type DivergenceReporter interface {
+	// ReportDivergence reports the changes planned for an object, an empty plan clears previously reported divergence.
+	// kind is the kind of object that owns the stack, e.g. IngressGroup, Service or Gateway.
+	ReportDivergence(ctx context.Context, kind string, objKey types.NamespacedName, plan []PlannedMutation) error
+}
+
+// NewDefaultDivergenceReporter constructs new defaultDivergenceReporter.
+// reportConfigMapKey is optional, the divergence report is written into that ConfigMap when specified.
+func NewDefaultDivergenceReporter(k8sClient client.Client, reportConfigMapKey *types.NamespacedName,
+	metricsRegisterer prometheus.Registerer, logger logr.Logger) (*defaultDivergenceReporter, error) {
+	divergentMutations := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemShadow,
+		Name:      metricShadowDivergentMutations,
+		Help:      "Number of changes to AWS resources planned in shadow mode per object",
+	}, []string{labelKind, labelNamespace, labelName})
+	if metricsRegisterer != nil {
+		if err := metricsRegisterer.Register(divergentMutations); err != nil {
+			return nil, errors.Wrapf(err, "failed to register metric: %v", metricShadowDivergentMutations)
+		}
+	}
+	return &defaultDivergenceReporter{
+		k8sClient:          k8sClient,
+		reportConfigMapKey: reportConfigMapKey,
+		divergentMutations: divergentMutations,
+		reportByObject:     make(map[string]string),
+		logger:             logger,
+	}, nil
+}
+
+var _ DivergenceReporter = &defaultDivergenceReporter{}
+
+// default implementation for DivergenceReporter.
+// divergence is reported via logs and metrics, and optionally a ConfigMap report keyed by object.
+type defaultDivergenceReporter struct {
+	k8sClient          client.Client
+	reportConfigMapKey *types.NamespacedName
+	divergentMutations *prometheus.GaugeVec
+	logger             logr.Logger
+
+	// reportByObject is the rendered plan per object with divergence, keyed by the ConfigMap key of object.
+	reportByObject map[string]string
+	// reportMutex protects reportByObject and serializes ConfigMap report writes.
+	reportMutex sync.Mutex
+}
+
+func (r *defaultDivergenceReporter) ReportDivergence(ctx context.Context, kind string, objKey types.NamespacedName, plan []PlannedMutation) error {
+	reportKey := buildDivergenceReportKey(kind, objKey)
+	payload, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+
+	r.reportMutex.Lock()
+	defer r.reportMutex.Unlock()
+	if len(plan) == 0 {
+		r.divergentMutations.DeleteLabelValues(kind, objKey.Namespace, objKey.Name)
+		if _, reported := r.reportByObject[reportKey]; !reported {
+			return nil
+		}
+		delete(r.reportByObject, reportKey)
+		r.logger.Info("divergence cleared", "kind", kind, "object", objKey)
+		return r.writeReportConfigMap(ctx)
+	}
+	r.divergentMutations.WithLabelValues(kind, objKey.Namespace, objKey.Name).Set(float64(len(plan)))
+	if r.reportByObject[reportKey] == string(payload) {
+		return nil
+	}
+	r.reportByObject[reportKey] = string(payload)
+	r.logger.Info("divergence detected", "kind", kind, "object", objKey, "plan", string(payload))
+	return r.writeReportConfigMap(ctx)
+}
+
+// writeReportConfigMap writes the divergence report of all objects into the report ConfigMap if configured.
+func (r *defaultDivergenceReporter) writeReportConfigMap(ctx context.Context) error {
+	if r.reportConfigMapKey == nil {
+		return nil
+	}
+	cmKey := *r.reportConfigMapKey
+	data := make(map[string]string, len(r.reportByObject))
+	for key, report := range r.reportByObject {
+		data[key] = report
+	}
+	cm := &corev1.ConfigMap{}
+	if err := r.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cmKey.Namespace,
+				Name:      cmKey.Name,
+			},
+			Data: data,
+		}
+		if err := r.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to create divergence report configMap: %v", cmKey)
+		}
+		return nil
+	}
+
+	dataToUpdate, dataToRemove := algorithm.DiffStringMap(data, cm.Data)
+	if len(dataToUpdate) == 0 && len(dataToRemove) == 0 {
+		return nil
+	}
+	oldCM := cm.DeepCopy()
+	cm.Data = data
+	if err := r.k8sClient.Patch(ctx, cm, client.MergeFrom(oldCM)); err != nil {
+		return errors.Wrapf(err, "failed to update divergence report configMap: %v", cmKey)
+	}
+	return nil
+}
+
+// buildDivergenceReportKey builds the ConfigMap key for object, as "/" isn't allowed in ConfigMap keys,
+// the parts are joined by "_", which isn't allowed in names of Kubernetes objects.
+func buildDivergenceReportKey(kind string, objKey types.NamespacedName) string {
+	if objKey.Namespace == "" {
+		return strings.Join([]string{kind, objKey.Name}, "_")
+	}
+	return strings.Join([]string{kind, objKey.Namespace, objKey.Name}, "_")
+}
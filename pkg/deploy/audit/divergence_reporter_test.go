@@ -0,0 +1,101 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultDivergenceReporter_ReportDivergence(t *testing.T) {
+	type reportCall struct {
+		kind   string
+		objKey types.NamespacedName
+		plan   []PlannedMutation
+	}
+	svcKey := types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}
+	ingGroupKey := types.NamespacedName{Name: "awesome-group"}
+	plan := []PlannedMutation{
+		{
+			Action:       ActionModify,
+			ResourceType: "targetGroup",
+			ResourceID:   "tg-1",
+			Summary:      "health check",
+		},
+	}
+	tests := []struct {
+		name          string
+		withConfigMap bool
+		reportCalls   []reportCall
+		wantSvcGauge  float64
+		wantCMData    map[string]string
+	}{
+		{
+			name:          "divergence is reported into metrics and configMap",
+			withConfigMap: true,
+			reportCalls: []reportCall{
+				{kind: "Service", objKey: svcKey, plan: plan},
+				{kind: "IngressGroup", objKey: ingGroupKey, plan: plan},
+			},
+			wantSvcGauge: 1,
+			wantCMData: map[string]string{
+				"Service_awesome-ns_svc-1":   `[{"action":"Modify","resourceType":"targetGroup","resourceID":"tg-1","summary":"health check"}]`,
+				"IngressGroup_awesome-group": `[{"action":"Modify","resourceType":"targetGroup","resourceID":"tg-1","summary":"health check"}]`,
+			},
+		},
+		{
+			name:          "divergence is cleared by empty plan",
+			withConfigMap: true,
+			reportCalls: []reportCall{
+				{kind: "Service", objKey: svcKey, plan: plan},
+				{kind: "IngressGroup", objKey: ingGroupKey, plan: plan},
+				{kind: "Service", objKey: svcKey, plan: nil},
+			},
+			wantSvcGauge: 0,
+			wantCMData: map[string]string{
+				"IngressGroup_awesome-group": `[{"action":"Modify","resourceType":"targetGroup","resourceID":"tg-1","summary":"health check"}]`,
+			},
+		},
+		{
+			name: "divergence is reported into metrics without configMap",
+			reportCalls: []reportCall{
+				{kind: "Service", objKey: svcKey, plan: plan},
+			},
+			wantSvcGauge: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			var cmKey *types.NamespacedName
+			if tt.withConfigMap {
+				cmKey = &types.NamespacedName{Namespace: "kube-system", Name: "shadow-report"}
+			}
+			r, err := NewDefaultDivergenceReporter(k8sClient, cmKey, prometheus.NewRegistry(), logr.New(&log.NullLogSink{}))
+			assert.NoError(t, err)
+
+			ctx := context.Background()
+			for _, call := range tt.reportCalls {
+				assert.NoError(t, r.ReportDivergence(ctx, call.kind, call.objKey, call.plan))
+			}
+
+			assert.Equal(t, tt.wantSvcGauge, testutil.ToFloat64(r.divergentMutations.WithLabelValues("Service", svcKey.Namespace, svcKey.Name)))
+			if cmKey != nil {
+				cm := &corev1.ConfigMap{}
+				assert.NoError(t, k8sClient.Get(ctx, *cmKey, cm))
+				assert.Equal(t, tt.wantCMData, cm.Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// Action is the kind of mutation performed on an AWS resource.
+type Action string
+
+const (
+	ActionCreate Action = "Create"
+	ActionModify Action = "Modify"
+	ActionDelete Action = "Delete"
+)
+
+const (
+	EventReasonCreatedAWSResource  = "CreatedAWSResource"
+	EventReasonModifiedAWSResource = "ModifiedAWSResource"
+	EventReasonDeletedAWSResource  = "DeletedAWSResource"
+)
+
+type contextKey string
+
+const (
+	contextKeyMutationRecorder contextKey = "mutationRecorder"
+)
+
+// MutationRecorder records the AWS mutations performed on behalf of Kubernetes objects.
+type MutationRecorder interface {
+	// RecordMutation records a mutation of AWS resource.
+	// resourceID is the ARN or ID of AWS resource, summary is optional and describes the change.
+	RecordMutation(action Action, resourceType string, resourceID string, summary string)
+}
+
+// NewEventMutationRecorder constructs new eventMutationRecorder.
+func NewEventMutationRecorder(eventRecorder record.EventRecorder, owners ...runtime.Object) *eventMutationRecorder {
+	return &eventMutationRecorder{
+		eventRecorder: eventRecorder,
+		owners:        owners,
+	}
+}
+
+var _ MutationRecorder = &eventMutationRecorder{}
+
+// eventMutationRecorder publishes mutations as Events on the owning objects.
+type eventMutationRecorder struct {
+	eventRecorder record.EventRecorder
+	owners        []runtime.Object
+}
+
+func (r *eventMutationRecorder) RecordMutation(action Action, resourceType string, resourceID string, summary string) {
+	var reason, verb string
+	switch action {
+	case ActionCreate:
+		reason, verb = EventReasonCreatedAWSResource, "Created"
+	case ActionModify:
+		reason, verb = EventReasonModifiedAWSResource, "Modified"
+	case ActionDelete:
+		reason, verb = EventReasonDeletedAWSResource, "Deleted"
+	default:
+		return
+	}
+	message := fmt.Sprintf("%v %v %v", verb, resourceType, resourceID)
+	if summary != "" {
+		message = fmt.Sprintf("%v: %v", message, summary)
+	}
+	for _, owner := range r.owners {
+		r.eventRecorder.Event(owner, corev1.EventTypeNormal, reason, message)
+	}
+}
+
+// ContextWithMutationRecorder returns a copy of ctx that carries the MutationRecorder.
+func ContextWithMutationRecorder(ctx context.Context, recorder MutationRecorder) context.Context {
+	return context.WithValue(ctx, contextKeyMutationRecorder, recorder)
+}
+
+// ContextGetMutationRecorder returns the MutationRecorder carried by ctx, or nil if there is none.
+func ContextGetMutationRecorder(ctx context.Context) MutationRecorder {
+	if v := ctx.Value(contextKeyMutationRecorder); v != nil {
+		return v.(MutationRecorder)
+	}
+	return nil
+}
+
+// RecordMutation records a mutation with the MutationRecorder carried by ctx, it's a no-op if there is none.
+func RecordMutation(ctx context.Context, action Action, resourceType string, resourceID string, summary string) {
+	if recorder := ContextGetMutationRecorder(ctx); recorder != nil {
+		recorder.RecordMutation(action, resourceType, resourceID, summary)
+	}
+}
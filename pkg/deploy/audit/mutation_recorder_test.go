@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+func Test_eventMutationRecorder_RecordMutation(t *testing.T) {
+	type args struct {
+		action       Action
+		resourceType string
+		resourceID   string
+		summary      string
+	}
+	tests := []struct {
+		name       string
+		ownerCount int
+		args       args
+		wantEvents []string
+	}{
+		{
+			name:       "create without summary",
+			ownerCount: 1,
+			args: args{
+				action:       ActionCreate,
+				resourceType: "loadBalancer",
+				resourceID:   "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-lb/1234567890abcdef",
+			},
+			wantEvents: []string{
+				"Normal CreatedAWSResource Created loadBalancer arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-lb/1234567890abcdef",
+			},
+		},
+		{
+			name:       "modify with summary",
+			ownerCount: 1,
+			args: args{
+				action:       ActionModify,
+				resourceType: "securityGroup",
+				resourceID:   "sg-xxxx",
+				summary:      "revoked ingress [tcp-80-80-0.0.0.0/0]",
+			},
+			wantEvents: []string{
+				"Normal ModifiedAWSResource Modified securityGroup sg-xxxx: revoked ingress [tcp-80-80-0.0.0.0/0]",
+			},
+		},
+		{
+			name:       "delete is recorded on all owners",
+			ownerCount: 2,
+			args: args{
+				action:       ActionDelete,
+				resourceType: "targetGroup",
+				resourceID:   "my-tg",
+			},
+			wantEvents: []string{
+				"Normal DeletedAWSResource Deleted targetGroup my-tg",
+				"Normal DeletedAWSResource Deleted targetGroup my-tg",
+			},
+		},
+		{
+			name:       "unknown action is ignored",
+			ownerCount: 1,
+			args: args{
+				action:       Action("Tag"),
+				resourceType: "targetGroup",
+				resourceID:   "my-tg",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRecorder := record.NewFakeRecorder(10)
+			var owners []runtime.Object
+			for i := 0; i < tt.ownerCount; i++ {
+				owners = append(owners, &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc"}})
+			}
+			r := NewEventMutationRecorder(eventRecorder, owners...)
+			r.RecordMutation(tt.args.action, tt.args.resourceType, tt.args.resourceID, tt.args.summary)
+			close(eventRecorder.Events)
+
+			var gotEvents []string
+			for event := range eventRecorder.Events {
+				gotEvents = append(gotEvents, event)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+		})
+	}
+}
+
+func Test_RecordMutation(t *testing.T) {
+	t.Run("with mutationRecorder", func(t *testing.T) {
+		eventRecorder := record.NewFakeRecorder(10)
+		svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "awesome-svc"}}
+		ctx := ContextWithMutationRecorder(context.Background(), NewEventMutationRecorder(eventRecorder, svc))
+		RecordMutation(ctx, ActionDelete, "listener", "my-ls", "")
+		assert.Equal(t, "Normal DeletedAWSResource Deleted listener my-ls", <-eventRecorder.Events)
+	})
+	t.Run("without mutationRecorder", func(t *testing.T) {
+		assert.Nil(t, ContextGetMutationRecorder(context.Background()))
+		assert.NotPanics(t, func() {
+			RecordMutation(context.Background(), ActionDelete, "listener", "my-ls", "")
+		})
+	})
+}
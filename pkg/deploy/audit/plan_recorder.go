@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	EventReasonDryRunPlan = "DryRunPlan"
+
+	// maxPlanMessageMutations is the maximum number of mutations rendered into a plan message.
+	maxPlanMessageMutations = 10
+)
+
+// PlannedMutation is a mutation of AWS resource that would be performed when deploying a stack.
+type PlannedMutation struct {
+	Action       Action `json:"action"`
+	ResourceType string `json:"resourceType"`
+	ResourceID   string `json:"resourceID"`
+	Summary      string `json:"summary,omitempty"`
+}
+
+// String renders the mutation as human-readable text.
+func (m PlannedMutation) String() string {
+	text := fmt.Sprintf("%v %v %v", m.Action, m.ResourceType, m.ResourceID)
+	if m.Summary != "" {
+		text = fmt.Sprintf("%v: %v", text, m.Summary)
+	}
+	return text
+}
+
+// NewPlanRecorder constructs new planRecorder.
+func NewPlanRecorder() *planRecorder {
+	return &planRecorder{}
+}
+
+var _ MutationRecorder = &planRecorder{}
+
+// planRecorder collects mutations into a plan instead of publishing them.
+type planRecorder struct {
+	mutations []PlannedMutation
+	// mutationsMutex protects mutations
+	mutationsMutex sync.Mutex
+}
+
+func (r *planRecorder) RecordMutation(action Action, resourceType string, resourceID string, summary string) {
+	r.mutationsMutex.Lock()
+	defer r.mutationsMutex.Unlock()
+	r.mutations = append(r.mutations, PlannedMutation{
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Summary:      summary,
+	})
+}
+
+// Plan returns the collected mutations in the order they are recorded.
+func (r *planRecorder) Plan() []PlannedMutation {
+	r.mutationsMutex.Lock()
+	defer r.mutationsMutex.Unlock()
+	return append([]PlannedMutation(nil), r.mutations...)
+}
+
+// RenderPlanMessage renders plan as a message that fits into an Event.
+// only the first few mutations are listed, the full plan should be logged instead.
+func RenderPlanMessage(plan []PlannedMutation) string {
+	if len(plan) == 0 {
+		return "Dry run planned no changes"
+	}
+	var mutations []string
+	for i, mutation := range plan {
+		if i == maxPlanMessageMutations {
+			mutations = append(mutations, fmt.Sprintf("and %d more", len(plan)-maxPlanMessageMutations))
+			break
+		}
+		mutations = append(mutations, mutation.String())
+	}
+	return fmt.Sprintf("Dry run planned %d changes: %v", len(plan), strings.Join(mutations, "; "))
+}
@@ -0,0 +1,81 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_planRecorder_Plan(t *testing.T) {
+	recorder := NewPlanRecorder()
+	ctx := ContextWithMutationRecorder(context.Background(), recorder)
+	RecordMutation(ctx, ActionCreate, "loadBalancer", "dry-run:loadbalancer/my-lb", "")
+	RecordMutation(ctx, ActionModify, "securityGroup", "sg-xxxx", "authorized ingress [tcp-80-80-0.0.0.0/0]")
+
+	assert.Equal(t, []PlannedMutation{
+		{
+			Action:       ActionCreate,
+			ResourceType: "loadBalancer",
+			ResourceID:   "dry-run:loadbalancer/my-lb",
+		},
+		{
+			Action:       ActionModify,
+			ResourceType: "securityGroup",
+			ResourceID:   "sg-xxxx",
+			Summary:      "authorized ingress [tcp-80-80-0.0.0.0/0]",
+		},
+	}, recorder.Plan())
+}
+
+func Test_RenderPlanMessage(t *testing.T) {
+	var manyMutations []PlannedMutation
+	for i := 0; i < 12; i++ {
+		manyMutations = append(manyMutations, PlannedMutation{
+			Action:       ActionDelete,
+			ResourceType: "targetGroup",
+			ResourceID:   fmt.Sprintf("tg-%d", i),
+		})
+	}
+	tests := []struct {
+		name string
+		plan []PlannedMutation
+		want string
+	}{
+		{
+			name: "empty plan",
+			plan: nil,
+			want: "Dry run planned no changes",
+		},
+		{
+			name: "all mutations are rendered",
+			plan: []PlannedMutation{
+				{
+					Action:       ActionCreate,
+					ResourceType: "loadBalancer",
+					ResourceID:   "dry-run:loadbalancer/my-lb",
+				},
+				{
+					Action:       ActionModify,
+					ResourceType: "listener",
+					ResourceID:   "my-ls",
+					Summary:      "settings",
+				},
+			},
+			want: "Dry run planned 2 changes: Create loadBalancer dry-run:loadbalancer/my-lb; Modify listener my-ls: settings",
+		},
+		{
+			name: "excess mutations are truncated",
+			plan: manyMutations,
+			want: "Dry run planned 12 changes: Delete targetGroup tg-0; Delete targetGroup tg-1; Delete targetGroup tg-2; " +
+				"Delete targetGroup tg-3; Delete targetGroup tg-4; Delete targetGroup tg-5; Delete targetGroup tg-6; " +
+				"Delete targetGroup tg-7; Delete targetGroup tg-8; Delete targetGroup tg-9; and 2 more",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RenderPlanMessage(tt.plan))
+		})
+	}
+}
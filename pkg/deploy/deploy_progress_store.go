@@ -0,0 +1,172 @@
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// stackProgress is the progress of the last failed deploy of a stack.
+type stackProgress struct {
+	Stages []stageProgress `json:"stages"`
+}
+
+// stageProgress is the progress marker of a deploy stage completed by a failed deploy.
+type stageProgress struct {
+	Stage DeployStage `json:"stage"`
+	// Checksum is the checksum of stack before the stage, i.e. the model and the status of resources synthesized beforehand.
+	Checksum string `json:"checksum"`
+	// Statuses is the status of the stage resources by resource ID.
+	Statuses map[string]json.RawMessage `json:"statuses"`
+	// SynthesizedAt is when the stage was last synthesized, it's retained when the stage is resumed.
+	SynthesizedAt time.Time `json:"synthesizedAt"`
+}
+
+// newDeployProgressStore constructs new deployProgressStore.
+// configMapKey is optional, the progress is persisted into that ConfigMap when specified.
+func newDeployProgressStore(k8sClient client.Client, configMapKey *types.NamespacedName, keyPrefix string, logger logr.Logger) *deployProgressStore {
+	return &deployProgressStore{
+		k8sClient:       k8sClient,
+		configMapKey:    configMapKey,
+		keyPrefix:       keyPrefix,
+		progressByStack: make(map[string]stackProgress),
+		logger:          logger,
+	}
+}
+
+// deployProgressStore stores the progress of failed deploys per stack, so that their retries resume from the failed stage.
+// the progress is kept in memory, and optionally persisted into a ConfigMap shared by the stack deployers of all controllers,
+// so that deploys are resumed after the controller restarts or the leadership changes.
+type deployProgressStore struct {
+	k8sClient    client.Client
+	configMapKey *types.NamespacedName
+	// keyPrefix distinguishes the stacks of different controllers in the ConfigMap, e.g. Ingress and Service stacks of the same name.
+	keyPrefix string
+	logger    logr.Logger
+
+	// mutex protects below fields.
+	mutex sync.Mutex
+	// loaded is whether progressByStack is loaded from the ConfigMap.
+	loaded bool
+	// progressByStack is the progress of failed deploys, keyed by the ConfigMap key of stack.
+	progressByStack map[string]stackProgress
+}
+
+// Load returns the progress of the last failed deploy of stack, empty if the last deploy succeeded.
+func (s *deployProgressStore) Load(ctx context.Context, stackID core.StackID) (stackProgress, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.loadConfigMap(ctx); err != nil {
+		return stackProgress{}, err
+	}
+	return s.progressByStack[s.buildProgressKey(stackID)], nil
+}
+
+// Save stores the progress of the last deploy of stack, an empty progress forgets the stack.
+func (s *deployProgressStore) Save(ctx context.Context, stackID core.StackID, progress stackProgress) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.loadConfigMap(ctx); err != nil {
+		return err
+	}
+	progressKey := s.buildProgressKey(stackID)
+	existingProgress, exists := s.progressByStack[progressKey]
+	if len(progress.Stages) == 0 {
+		if !exists {
+			return nil
+		}
+		delete(s.progressByStack, progressKey)
+		return s.patchConfigMap(ctx, progressKey, nil)
+	}
+	if exists && reflect.DeepEqual(existingProgress, progress) {
+		return nil
+	}
+	s.progressByStack[progressKey] = progress
+	payload, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	payloadStr := string(payload)
+	return s.patchConfigMap(ctx, progressKey, &payloadStr)
+}
+
+// loadConfigMap loads the progress of this controller's stacks from the ConfigMap once if configured.
+func (s *deployProgressStore) loadConfigMap(ctx context.Context) error {
+	if s.loaded || s.configMapKey == nil {
+		return nil
+	}
+	cm := &corev1.ConfigMap{}
+	if err := s.k8sClient.Get(ctx, *s.configMapKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to load deploy progress configMap: %v", *s.configMapKey)
+		}
+	}
+	for key, payload := range cm.Data {
+		if !strings.HasPrefix(key, s.keyPrefix+"_") {
+			continue
+		}
+		var progress stackProgress
+		// the malformed progress is ignored, so that the stack is deployed from scratch.
+		if err := json.Unmarshal([]byte(payload), &progress); err != nil {
+			s.logger.Error(err, "ignoring malformed deploy progress", "key", key)
+			continue
+		}
+		s.progressByStack[key] = progress
+	}
+	s.loaded = true
+	return nil
+}
+
+// patchConfigMap sets the progress of a single stack in the ConfigMap if configured, a nil payload removes it.
+// only the key of stack is patched, so that the stack deployers of different controllers don't override each other.
+func (s *deployProgressStore) patchConfigMap(ctx context.Context, progressKey string, payload *string) error {
+	if s.configMapKey == nil {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"data": map[string]*string{progressKey: payload},
+	})
+	if err != nil {
+		return err
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: s.configMapKey.Namespace,
+			Name:      s.configMapKey.Name,
+		},
+	}
+	if err := s.k8sClient.Patch(ctx, cm, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to update deploy progress configMap: %v", *s.configMapKey)
+		}
+		if payload == nil {
+			return nil
+		}
+		cm.Data = map[string]string{progressKey: *payload}
+		if err := s.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to create deploy progress configMap: %v", *s.configMapKey)
+		}
+	}
+	return nil
+}
+
+// buildProgressKey builds the ConfigMap key for stack, as "/" isn't allowed in ConfigMap keys,
+// the parts are joined by "_", which isn't allowed in names of Kubernetes objects.
+func (s *deployProgressStore) buildProgressKey(stackID core.StackID) string {
+	if stackID.Namespace == "" {
+		return strings.Join([]string{s.keyPrefix, stackID.Name}, "_")
+	}
+	return strings.Join([]string{s.keyPrefix, stackID.Namespace, stackID.Name}, "_")
+}
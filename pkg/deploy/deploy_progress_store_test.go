@@ -0,0 +1,94 @@
+package deploy
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_deployProgressStore(t *testing.T) {
+	progress := stackProgress{Stages: []stageProgress{
+		{
+			Stage:    DeployStageLoadBalancer,
+			Checksum: "checksum-1",
+			Statuses: map[string]json.RawMessage{"LoadBalancer": json.RawMessage(`{"loadBalancerARN":"lb-arn"}`)},
+		},
+	}}
+	ingStackID := core.StackID{Namespace: "awesome-ns", Name: "ing-1"}
+	groupStackID := core.StackID{Name: "awesome-group"}
+
+	tests := []struct {
+		name          string
+		withConfigMap bool
+	}{
+		{
+			name:          "kept in memory",
+			withConfigMap: false,
+		},
+		{
+			name:          "persisted into configMap",
+			withConfigMap: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			var cmKey *types.NamespacedName
+			if tt.withConfigMap {
+				cmKey = &types.NamespacedName{Namespace: "kube-system", Name: "deploy-progress"}
+			}
+			logger := logr.New(&log.NullLogSink{})
+			ctx := context.Background()
+
+			ingStore := newDeployProgressStore(k8sClient, cmKey, "ingress.k8s.aws", logger)
+			svcStore := newDeployProgressStore(k8sClient, cmKey, "service.k8s.aws", logger)
+			assert.NoError(t, ingStore.Save(ctx, ingStackID, progress))
+			assert.NoError(t, ingStore.Save(ctx, groupStackID, progress))
+			assert.NoError(t, svcStore.Save(ctx, ingStackID, stackProgress{}))
+
+			got, err := ingStore.Load(ctx, ingStackID)
+			assert.NoError(t, err)
+			assert.Equal(t, progress, got)
+			got, err = svcStore.Load(ctx, ingStackID)
+			assert.NoError(t, err)
+			assert.Empty(t, got.Stages)
+
+			assert.NoError(t, ingStore.Save(ctx, groupStackID, stackProgress{}))
+			if !tt.withConfigMap {
+				return
+			}
+			cm := &corev1.ConfigMap{}
+			assert.NoError(t, k8sClient.Get(ctx, *cmKey, cm))
+			assert.Equal(t, []string{"ingress.k8s.aws_awesome-ns_ing-1"}, keysOfStringMap(cm.Data))
+
+			// the progress is loaded by the store of a restarted controller.
+			restartedStore := newDeployProgressStore(k8sClient, cmKey, "ingress.k8s.aws", logger)
+			got, err = restartedStore.Load(ctx, ingStackID)
+			assert.NoError(t, err)
+			assert.Equal(t, progress, got)
+			got, err = restartedStore.Load(ctx, groupStackID)
+			assert.NoError(t, err)
+			assert.Empty(t, got.Stages)
+		})
+	}
+}
+
+func keysOfStringMap(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,39 @@
+package dryrun
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	acmsdk "github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+)
+
+var _ services.ACM = &dryRunACM{}
+
+// dryRunACM is an ACM client that plans the certificate requests for Ingress hosts instead of applying them.
+type dryRunACM struct {
+	services.ACM
+	ids *plannedResourceIDGenerator
+}
+
+func (c *dryRunACM) RequestCertificateWithContext(ctx context.Context, input *acmsdk.RequestCertificateInput) (*acmsdk.RequestCertificateOutput, error) {
+	certARN := c.ids.next("certificate", awssdk.ToString(input.DomainName))
+	audit.RecordMutation(ctx, audit.ActionCreate, "certificate", certARN, awssdk.ToString(input.DomainName))
+	return &acmsdk.RequestCertificateOutput{
+		CertificateArn: awssdk.String(certARN),
+	}, nil
+}
+
+func (c *dryRunACM) DescribeCertificateWithContext(ctx context.Context, input *acmsdk.DescribeCertificateInput) (*acmsdk.DescribeCertificateOutput, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.CertificateArn)) {
+		return &acmsdk.DescribeCertificateOutput{
+			Certificate: &acmtypes.CertificateDetail{
+				CertificateArn: input.CertificateArn,
+				Status:         acmtypes.CertificateStatusPendingValidation,
+			},
+		}, nil
+	}
+	return c.ACM.DescribeCertificateWithContext(ctx, input)
+}
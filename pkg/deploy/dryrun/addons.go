@@ -0,0 +1,141 @@
+package dryrun
+
+import (
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	gasdk "github.com/aws/aws-sdk-go/service/globalaccelerator"
+	shieldsdk "github.com/aws/aws-sdk-go/service/shield"
+	wafregionalsdk "github.com/aws/aws-sdk-go/service/wafregional"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+)
+
+var _ services.WAFRegional = &dryRunWAFRegional{}
+
+// dryRunWAFRegional is a WAFRegional client that plans the mutations used while deploying stacks instead of applying them.
+type dryRunWAFRegional struct {
+	services.WAFRegional
+}
+
+func (c *dryRunWAFRegional) GetWebACLForResourceWithContext(ctx awssdk.Context, input *wafregionalsdk.GetWebACLForResourceInput, opts ...request.Option) (*wafregionalsdk.GetWebACLForResourceOutput, error) {
+	if IsPlannedResourceID(awssdk.StringValue(input.ResourceArn)) {
+		return &wafregionalsdk.GetWebACLForResourceOutput{}, nil
+	}
+	return c.WAFRegional.GetWebACLForResourceWithContext(ctx, input, opts...)
+}
+
+func (c *dryRunWAFRegional) AssociateWebACLWithContext(ctx awssdk.Context, input *wafregionalsdk.AssociateWebACLInput, _ ...request.Option) (*wafregionalsdk.AssociateWebACLOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.StringValue(input.ResourceArn),
+		"associated webACL "+awssdk.StringValue(input.WebACLId))
+	return &wafregionalsdk.AssociateWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFRegional) DisassociateWebACLWithContext(ctx awssdk.Context, input *wafregionalsdk.DisassociateWebACLInput, _ ...request.Option) (*wafregionalsdk.DisassociateWebACLOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.StringValue(input.ResourceArn), "disassociated webACL")
+	return &wafregionalsdk.DisassociateWebACLOutput{}, nil
+}
+
+var _ services.Shield = &dryRunShield{}
+
+// dryRunShield is a Shield client that plans the mutations used while deploying stacks instead of applying them.
+type dryRunShield struct {
+	services.Shield
+}
+
+func (c *dryRunShield) DescribeProtectionWithContext(ctx awssdk.Context, input *shieldsdk.DescribeProtectionInput, opts ...request.Option) (*shieldsdk.DescribeProtectionOutput, error) {
+	if IsPlannedResourceID(awssdk.StringValue(input.ResourceArn)) {
+		return &shieldsdk.DescribeProtectionOutput{}, awserr.New(shieldsdk.ErrCodeResourceNotFoundException, "protection planned to be created", nil)
+	}
+	return c.Shield.DescribeProtectionWithContext(ctx, input, opts...)
+}
+
+func (c *dryRunShield) CreateProtectionWithContext(ctx awssdk.Context, input *shieldsdk.CreateProtectionInput, _ ...request.Option) (*shieldsdk.CreateProtectionOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionCreate, "shieldProtection", awssdk.StringValue(input.ResourceArn), awssdk.StringValue(input.Name))
+	return &shieldsdk.CreateProtectionOutput{
+		ProtectionId: awssdk.String(plannedResourceIDPrefix + "protection/" + awssdk.StringValue(input.Name)),
+	}, nil
+}
+
+func (c *dryRunShield) DeleteProtectionWithContext(ctx awssdk.Context, input *shieldsdk.DeleteProtectionInput, _ ...request.Option) (*shieldsdk.DeleteProtectionOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionDelete, "shieldProtection", awssdk.StringValue(input.ProtectionId), "")
+	return &shieldsdk.DeleteProtectionOutput{}, nil
+}
+
+var _ services.GlobalAccelerator = &dryRunGlobalAccelerator{}
+
+// dryRunGlobalAccelerator is a GlobalAccelerator client that plans the mutations used while deploying stacks instead of applying them.
+type dryRunGlobalAccelerator struct {
+	services.GlobalAccelerator
+	ids *plannedResourceIDGenerator
+}
+
+func (c *dryRunGlobalAccelerator) CreateAcceleratorWithContext(_ awssdk.Context, input *gasdk.CreateAcceleratorInput, _ ...request.Option) (*gasdk.CreateAcceleratorOutput, error) {
+	return &gasdk.CreateAcceleratorOutput{
+		Accelerator: &gasdk.Accelerator{
+			AcceleratorArn: awssdk.String(c.ids.next("accelerator", awssdk.StringValue(input.Name))),
+			Name:           input.Name,
+			Enabled:        input.Enabled,
+		},
+	}, nil
+}
+
+func (c *dryRunGlobalAccelerator) UpdateAcceleratorWithContext(_ awssdk.Context, _ *gasdk.UpdateAcceleratorInput, _ ...request.Option) (*gasdk.UpdateAcceleratorOutput, error) {
+	return &gasdk.UpdateAcceleratorOutput{}, nil
+}
+
+func (c *dryRunGlobalAccelerator) DeleteAcceleratorWithContext(_ awssdk.Context, _ *gasdk.DeleteAcceleratorInput, _ ...request.Option) (*gasdk.DeleteAcceleratorOutput, error) {
+	return &gasdk.DeleteAcceleratorOutput{}, nil
+}
+
+func (c *dryRunGlobalAccelerator) CreateListenerWithContext(_ awssdk.Context, input *gasdk.CreateListenerInput, _ ...request.Option) (*gasdk.CreateListenerOutput, error) {
+	return &gasdk.CreateListenerOutput{
+		Listener: &gasdk.Listener{
+			ListenerArn: awssdk.String(c.ids.next("acceleratorListener", "")),
+			Protocol:    input.Protocol,
+			PortRanges:  input.PortRanges,
+		},
+	}, nil
+}
+
+func (c *dryRunGlobalAccelerator) UpdateListenerWithContext(_ awssdk.Context, _ *gasdk.UpdateListenerInput, _ ...request.Option) (*gasdk.UpdateListenerOutput, error) {
+	return &gasdk.UpdateListenerOutput{}, nil
+}
+
+func (c *dryRunGlobalAccelerator) DeleteListenerWithContext(_ awssdk.Context, _ *gasdk.DeleteListenerInput, _ ...request.Option) (*gasdk.DeleteListenerOutput, error) {
+	return &gasdk.DeleteListenerOutput{}, nil
+}
+
+func (c *dryRunGlobalAccelerator) CreateEndpointGroupWithContext(_ awssdk.Context, input *gasdk.CreateEndpointGroupInput, _ ...request.Option) (*gasdk.CreateEndpointGroupOutput, error) {
+	return &gasdk.CreateEndpointGroupOutput{
+		EndpointGroup: &gasdk.EndpointGroup{
+			EndpointGroupArn:    awssdk.String(c.ids.next("acceleratorEndpointGroup", "")),
+			EndpointGroupRegion: input.EndpointGroupRegion,
+		},
+	}, nil
+}
+
+func (c *dryRunGlobalAccelerator) UpdateEndpointGroupWithContext(_ awssdk.Context, _ *gasdk.UpdateEndpointGroupInput, _ ...request.Option) (*gasdk.UpdateEndpointGroupOutput, error) {
+	return &gasdk.UpdateEndpointGroupOutput{}, nil
+}
+
+func (c *dryRunGlobalAccelerator) DeleteEndpointGroupWithContext(_ awssdk.Context, _ *gasdk.DeleteEndpointGroupInput, _ ...request.Option) (*gasdk.DeleteEndpointGroupOutput, error) {
+	return &gasdk.DeleteEndpointGroupOutput{}, nil
+}
+
+func (c *dryRunGlobalAccelerator) TagResourceWithContext(ctx awssdk.Context, input *gasdk.TagResourceInput, _ ...request.Option) (*gasdk.TagResourceOutput, error) {
+	var tagKeys []string
+	for _, tag := range input.Tags {
+		tagKeys = append(tagKeys, awssdk.StringValue(tag.Key))
+	}
+	audit.RecordMutation(ctx, audit.ActionModify, "accelerator", awssdk.StringValue(input.ResourceArn), fmt.Sprintf("added tags %v", tagKeys))
+	return &gasdk.TagResourceOutput{}, nil
+}
+
+func (c *dryRunGlobalAccelerator) UntagResourceWithContext(ctx awssdk.Context, input *gasdk.UntagResourceInput, _ ...request.Option) (*gasdk.UntagResourceOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "accelerator", awssdk.StringValue(input.ResourceArn),
+		fmt.Sprintf("removed tags %v", awssdk.StringValueSlice(input.TagKeys)))
+	return &gasdk.UntagResourceOutput{}, nil
+}
@@ -0,0 +1,113 @@
+package dryrun
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// plannedResourceIDPrefix is the prefix of IDs assigned to resources that are planned to be created.
+const plannedResourceIDPrefix = "dry-run:"
+
+// IsPlannedResourceID returns whether id is assigned to a resource that is planned to be created.
+func IsPlannedResourceID(id string) bool {
+	return strings.HasPrefix(id, plannedResourceIDPrefix)
+}
+
+// NewCloud constructs a Cloud that plans the mutations of ELBV2, EC2, WAF, Shield, GlobalAccelerator, Route53 and ACM resources instead of applying them.
+// Describe APIs are served by the wrapped cloud, except for resources that are planned to be created, which are described as empty.
+// the mutations are expected to be recorded by the deploy managers via audit.RecordMutation,
+// mutations that are not recorded by managers(e.g. tags and targets) are recorded by the planning clients.
+func NewCloud(cloud aws.Cloud) aws.Cloud {
+	ids := &plannedResourceIDGenerator{}
+	return &dryRunCloud{
+		Cloud:       cloud,
+		ec2:         &dryRunEC2{EC2: cloud.EC2(), ids: ids},
+		elbv2:       &dryRunELBV2{ELBV2: cloud.ELBV2(), ids: ids},
+		wafv2:       &dryRunWAFv2{WAFv2: cloud.WAFv2(), ids: ids},
+		wafRegional: &dryRunWAFRegional{WAFRegional: cloud.WAFRegional()},
+		shield:      &dryRunShield{Shield: cloud.Shield()},
+		ga:          &dryRunGlobalAccelerator{GlobalAccelerator: cloud.GlobalAccelerator(), ids: ids},
+		route53:     &dryRunRoute53{Route53: cloud.Route53()},
+		acm:         &dryRunACM{ACM: cloud.ACM(), ids: ids},
+	}
+}
+
+var _ aws.Cloud = &dryRunCloud{}
+
+type dryRunCloud struct {
+	aws.Cloud
+
+	ec2         services.EC2
+	elbv2       services.ELBV2
+	wafv2       services.WAFv2
+	wafRegional services.WAFRegional
+	shield      services.Shield
+	ga          services.GlobalAccelerator
+	route53     services.Route53
+	acm         services.ACM
+}
+
+func (c *dryRunCloud) EC2() services.EC2 {
+	return c.ec2
+}
+
+func (c *dryRunCloud) ELBV2() services.ELBV2 {
+	return c.elbv2
+}
+
+func (c *dryRunCloud) WAFv2() services.WAFv2 {
+	return c.wafv2
+}
+
+func (c *dryRunCloud) WAFRegional() services.WAFRegional {
+	return c.wafRegional
+}
+
+func (c *dryRunCloud) Shield() services.Shield {
+	return c.shield
+}
+
+func (c *dryRunCloud) GlobalAccelerator() services.GlobalAccelerator {
+	return c.ga
+}
+
+func (c *dryRunCloud) Route53() services.Route53 {
+	return c.route53
+}
+
+func (c *dryRunCloud) ACM() services.ACM {
+	return c.acm
+}
+
+func (c *dryRunCloud) AssumeRole(roleARN string) aws.Cloud {
+	return NewCloud(c.Cloud.AssumeRole(roleARN))
+}
+
+// plannedResourceIDGenerator generates unique IDs for resources that are planned to be created.
+type plannedResourceIDGenerator struct {
+	seq int64
+}
+
+// next returns an unique ID for resource, name is optional and makes the ID human-readable.
+func (g *plannedResourceIDGenerator) next(resourceType string, name string) string {
+	seq := atomic.AddInt64(&g.seq, 1)
+	if name == "" {
+		return fmt.Sprintf("%s%s/%d", plannedResourceIDPrefix, resourceType, seq)
+	}
+	return fmt.Sprintf("%s%s/%s/%d", plannedResourceIDPrefix, resourceType, name, seq)
+}
+
+// filterExistingResourceIDs returns the IDs that are not assigned to resources planned to be created.
+func filterExistingResourceIDs(ids []string) []string {
+	var existingIDs []string
+	for _, id := range ids {
+		if !IsPlannedResourceID(id) {
+			existingIDs = append(existingIDs, id)
+		}
+	}
+	return existingIDs
+}
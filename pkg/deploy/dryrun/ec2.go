@@ -0,0 +1,142 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+)
+
+var _ services.EC2 = &dryRunEC2{}
+
+// dryRunEC2 is an EC2 client that plans the mutations used while deploying stacks instead of applying them.
+type dryRunEC2 struct {
+	services.EC2
+	ids *plannedResourceIDGenerator
+}
+
+func (c *dryRunEC2) CreateSecurityGroupWithContext(_ context.Context, input *ec2sdk.CreateSecurityGroupInput) (*ec2sdk.CreateSecurityGroupOutput, error) {
+	return &ec2sdk.CreateSecurityGroupOutput{
+		GroupId: awssdk.String(c.ids.next("security-group", awssdk.ToString(input.GroupName))),
+	}, nil
+}
+
+func (c *dryRunEC2) DeleteSecurityGroupWithContext(_ context.Context, _ *ec2sdk.DeleteSecurityGroupInput) (*ec2sdk.DeleteSecurityGroupOutput, error) {
+	return &ec2sdk.DeleteSecurityGroupOutput{}, nil
+}
+
+func (c *dryRunEC2) AuthorizeSecurityGroupIngressWithContext(_ context.Context, _ *ec2sdk.AuthorizeSecurityGroupIngressInput) (*ec2sdk.AuthorizeSecurityGroupIngressOutput, error) {
+	return &ec2sdk.AuthorizeSecurityGroupIngressOutput{Return: awssdk.Bool(true)}, nil
+}
+
+func (c *dryRunEC2) RevokeSecurityGroupIngressWithContext(_ context.Context, _ *ec2sdk.RevokeSecurityGroupIngressInput) (*ec2sdk.RevokeSecurityGroupIngressOutput, error) {
+	return &ec2sdk.RevokeSecurityGroupIngressOutput{Return: awssdk.Bool(true)}, nil
+}
+
+// DescribeSecurityGroupsAsList describes securityGroups planned to be created as ones without permissions.
+func (c *dryRunEC2) DescribeSecurityGroupsAsList(ctx context.Context, input *ec2sdk.DescribeSecurityGroupsInput) ([]ec2types.SecurityGroup, error) {
+	existingSGIDs := filterExistingResourceIDs(input.GroupIds)
+	var plannedSGs []ec2types.SecurityGroup
+	for _, sgID := range input.GroupIds {
+		if IsPlannedResourceID(sgID) {
+			plannedSGs = append(plannedSGs, ec2types.SecurityGroup{GroupId: awssdk.String(sgID)})
+		}
+	}
+	if len(plannedSGs) == 0 {
+		return c.EC2.DescribeSecurityGroupsAsList(ctx, input)
+	}
+	if len(existingSGIDs) == 0 {
+		return plannedSGs, nil
+	}
+	existingInput := *input
+	existingInput.GroupIds = existingSGIDs
+	sgs, err := c.EC2.DescribeSecurityGroupsAsList(ctx, &existingInput)
+	if err != nil {
+		return nil, err
+	}
+	return append(sgs, plannedSGs...), nil
+}
+
+func (c *dryRunEC2) CreateVpcEndpointServiceConfigurationWithContext(_ context.Context, input *ec2sdk.CreateVpcEndpointServiceConfigurationInput) (*ec2sdk.CreateVpcEndpointServiceConfigurationOutput, error) {
+	esID := c.ids.next("vpc-endpoint-service", "")
+	return &ec2sdk.CreateVpcEndpointServiceConfigurationOutput{
+		ServiceConfiguration: &ec2types.ServiceConfiguration{
+			ServiceId:               awssdk.String(esID),
+			ServiceName:             awssdk.String(esID),
+			AcceptanceRequired:      input.AcceptanceRequired,
+			NetworkLoadBalancerArns: input.NetworkLoadBalancerArns,
+		},
+	}, nil
+}
+
+func (c *dryRunEC2) ModifyVpcEndpointServiceConfigurationWithContext(_ context.Context, _ *ec2sdk.ModifyVpcEndpointServiceConfigurationInput) (*ec2sdk.ModifyVpcEndpointServiceConfigurationOutput, error) {
+	return &ec2sdk.ModifyVpcEndpointServiceConfigurationOutput{Return: awssdk.Bool(true)}, nil
+}
+
+func (c *dryRunEC2) DeleteVpcEndpointServiceConfigurationsWithContext(_ context.Context, _ *ec2sdk.DeleteVpcEndpointServiceConfigurationsInput) (*ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput, error) {
+	return &ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput{}, nil
+}
+
+func (c *dryRunEC2) DescribeVpcEndpointServicePermissionsAsList(ctx context.Context, input *ec2sdk.DescribeVpcEndpointServicePermissionsInput) ([]ec2types.AllowedPrincipal, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.ServiceId)) {
+		return nil, nil
+	}
+	return c.EC2.DescribeVpcEndpointServicePermissionsAsList(ctx, input)
+}
+
+func (c *dryRunEC2) ModifyVpcEndpointServicePermissionsWithContext(_ context.Context, _ *ec2sdk.ModifyVpcEndpointServicePermissionsInput) (*ec2sdk.ModifyVpcEndpointServicePermissionsOutput, error) {
+	return &ec2sdk.ModifyVpcEndpointServicePermissionsOutput{ReturnValue: awssdk.Bool(true)}, nil
+}
+
+func (c *dryRunEC2) AllocateAddressWithContext(_ context.Context, input *ec2sdk.AllocateAddressInput) (*ec2sdk.AllocateAddressOutput, error) {
+	return &ec2sdk.AllocateAddressOutput{
+		AllocationId:   awssdk.String(c.ids.next("elastic-ip", "")),
+		Domain:         input.Domain,
+		PublicIpv4Pool: input.PublicIpv4Pool,
+	}, nil
+}
+
+func (c *dryRunEC2) ReleaseAddressWithContext(_ context.Context, _ *ec2sdk.ReleaseAddressInput) (*ec2sdk.ReleaseAddressOutput, error) {
+	return &ec2sdk.ReleaseAddressOutput{}, nil
+}
+
+func (c *dryRunEC2) CreateTagsWithContext(ctx context.Context, input *ec2sdk.CreateTagsInput) (*ec2sdk.CreateTagsOutput, error) {
+	var tagKeys []string
+	for _, tag := range input.Tags {
+		tagKeys = append(tagKeys, awssdk.ToString(tag.Key))
+	}
+	for _, resID := range input.Resources {
+		audit.RecordMutation(ctx, audit.ActionModify, ec2ResourceType(resID), resID, fmt.Sprintf("added tags %v", tagKeys))
+	}
+	return &ec2sdk.CreateTagsOutput{}, nil
+}
+
+func (c *dryRunEC2) DeleteTagsWithContext(ctx context.Context, input *ec2sdk.DeleteTagsInput) (*ec2sdk.DeleteTagsOutput, error) {
+	var tagKeys []string
+	for _, tag := range input.Tags {
+		tagKeys = append(tagKeys, awssdk.ToString(tag.Key))
+	}
+	for _, resID := range input.Resources {
+		audit.RecordMutation(ctx, audit.ActionModify, ec2ResourceType(resID), resID, fmt.Sprintf("removed tags %v", tagKeys))
+	}
+	return &ec2sdk.DeleteTagsOutput{}, nil
+}
+
+// ec2ResourceType returns the type of EC2 resource by its ID.
+func ec2ResourceType(resID string) string {
+	switch {
+	case strings.HasPrefix(resID, "sg-"):
+		return "securityGroup"
+	case strings.HasPrefix(resID, "vpce-svc-"):
+		return "vpcEndpointService"
+	case strings.HasPrefix(resID, "eipalloc-"):
+		return "elasticIP"
+	default:
+		return "resource"
+	}
+}
@@ -0,0 +1,106 @@
+package dryrun
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+)
+
+func Test_dryRunEC2_DescribeSecurityGroupsAsList(t *testing.T) {
+	type describeSecurityGroupsAsListCall struct {
+		req  *ec2sdk.DescribeSecurityGroupsInput
+		resp []ec2types.SecurityGroup
+	}
+	tests := []struct {
+		name                              string
+		describeSecurityGroupsAsListCalls []describeSecurityGroupsAsListCall
+		groupIDs                          []string
+		want                              []ec2types.SecurityGroup
+	}{
+		{
+			name: "only existing securityGroups",
+			describeSecurityGroupsAsListCalls: []describeSecurityGroupsAsListCall{
+				{
+					req:  &ec2sdk.DescribeSecurityGroupsInput{GroupIds: []string{"sg-a"}},
+					resp: []ec2types.SecurityGroup{{GroupId: awssdk.String("sg-a")}},
+				},
+			},
+			groupIDs: []string{"sg-a"},
+			want:     []ec2types.SecurityGroup{{GroupId: awssdk.String("sg-a")}},
+		},
+		{
+			name:     "only planned securityGroups",
+			groupIDs: []string{"dry-run:security-group/k8s-awesome/1"},
+			want:     []ec2types.SecurityGroup{{GroupId: awssdk.String("dry-run:security-group/k8s-awesome/1")}},
+		},
+		{
+			name: "both existing and planned securityGroups",
+			describeSecurityGroupsAsListCalls: []describeSecurityGroupsAsListCall{
+				{
+					req:  &ec2sdk.DescribeSecurityGroupsInput{GroupIds: []string{"sg-a"}},
+					resp: []ec2types.SecurityGroup{{GroupId: awssdk.String("sg-a")}},
+				},
+			},
+			groupIDs: []string{"dry-run:security-group/k8s-awesome/1", "sg-a"},
+			want: []ec2types.SecurityGroup{
+				{GroupId: awssdk.String("sg-a")},
+				{GroupId: awssdk.String("dry-run:security-group/k8s-awesome/1")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.describeSecurityGroupsAsListCalls {
+				ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), call.req).Return(call.resp, nil)
+			}
+			c := &dryRunEC2{EC2: ec2Client, ids: &plannedResourceIDGenerator{}}
+			got, err := c.DescribeSecurityGroupsAsList(context.Background(), &ec2sdk.DescribeSecurityGroupsInput{
+				GroupIds: tt.groupIDs,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_dryRunEC2_CreateSecurityGroupWithContext(t *testing.T) {
+	c := &dryRunEC2{ids: &plannedResourceIDGenerator{}}
+	resp, err := c.CreateSecurityGroupWithContext(context.Background(), &ec2sdk.CreateSecurityGroupInput{
+		GroupName: awssdk.String("k8s-awesome"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "dry-run:security-group/k8s-awesome/1", awssdk.ToString(resp.GroupId))
+	assert.True(t, IsPlannedResourceID(awssdk.ToString(resp.GroupId)))
+}
+
+func Test_dryRunEC2_CreateTagsWithContext(t *testing.T) {
+	planRecorder := audit.NewPlanRecorder()
+	ctx := audit.ContextWithMutationRecorder(context.Background(), planRecorder)
+	c := &dryRunEC2{ids: &plannedResourceIDGenerator{}}
+	_, err := c.CreateTagsWithContext(ctx, &ec2sdk.CreateTagsInput{
+		Resources: []string{"sg-a"},
+		Tags: []ec2types.Tag{
+			{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("awesome-cluster")},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []audit.PlannedMutation{
+		{
+			Action:       audit.ActionModify,
+			ResourceType: "securityGroup",
+			ResourceID:   "sg-a",
+			Summary:      "added tags [elbv2.k8s.aws/cluster]",
+		},
+	}, planRecorder.Plan())
+}
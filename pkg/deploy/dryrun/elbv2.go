@@ -0,0 +1,307 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+)
+
+var _ services.ELBV2 = &dryRunELBV2{}
+
+// dryRunELBV2 is an ELBV2 client that plans the mutations used while deploying stacks instead of applying them.
+type dryRunELBV2 struct {
+	services.ELBV2
+	ids *plannedResourceIDGenerator
+}
+
+func (c *dryRunELBV2) CreateLoadBalancerWithContext(_ context.Context, input *elbv2sdk.CreateLoadBalancerInput, _ ...func(*elbv2sdk.Options)) (*elbv2sdk.CreateLoadBalancerOutput, error) {
+	return &elbv2sdk.CreateLoadBalancerOutput{
+		LoadBalancers: []elbv2types.LoadBalancer{
+			{
+				LoadBalancerArn:       awssdk.String(c.ids.next("loadbalancer", awssdk.ToString(input.Name))),
+				LoadBalancerName:      input.Name,
+				Type:                  input.Type,
+				Scheme:                input.Scheme,
+				IpAddressType:         input.IpAddressType,
+				SecurityGroups:        input.SecurityGroups,
+				CustomerOwnedIpv4Pool: input.CustomerOwnedIpv4Pool,
+			},
+		},
+	}, nil
+}
+
+func (c *dryRunELBV2) DeleteLoadBalancerWithContext(_ context.Context, _ *elbv2sdk.DeleteLoadBalancerInput) (*elbv2sdk.DeleteLoadBalancerOutput, error) {
+	return &elbv2sdk.DeleteLoadBalancerOutput{}, nil
+}
+
+func (c *dryRunELBV2) SetIpAddressTypeWithContext(_ context.Context, input *elbv2sdk.SetIpAddressTypeInput) (*elbv2sdk.SetIpAddressTypeOutput, error) {
+	return &elbv2sdk.SetIpAddressTypeOutput{IpAddressType: input.IpAddressType}, nil
+}
+
+func (c *dryRunELBV2) SetSubnetsWithContext(_ context.Context, input *elbv2sdk.SetSubnetsInput) (*elbv2sdk.SetSubnetsOutput, error) {
+	return &elbv2sdk.SetSubnetsOutput{IpAddressType: input.IpAddressType}, nil
+}
+
+func (c *dryRunELBV2) SetSecurityGroupsWithContext(_ context.Context, input *elbv2sdk.SetSecurityGroupsInput) (*elbv2sdk.SetSecurityGroupsOutput, error) {
+	return &elbv2sdk.SetSecurityGroupsOutput{SecurityGroupIds: input.SecurityGroups}, nil
+}
+
+func (c *dryRunELBV2) DescribeLoadBalancerIpamPoolsWithContext(ctx context.Context, input *services.DescribeLoadBalancerIpamPoolsInput) (*services.DescribeLoadBalancerIpamPoolsOutput, error) {
+	for _, lbARN := range input.LoadBalancerArns {
+		if IsPlannedResourceID(lbARN) {
+			return &services.DescribeLoadBalancerIpamPoolsOutput{}, nil
+		}
+	}
+	return c.ELBV2.DescribeLoadBalancerIpamPoolsWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) ModifyIpPoolsWithContext(_ context.Context, input *services.ModifyIpPoolsInput) (*services.ModifyIpPoolsOutput, error) {
+	return &services.ModifyIpPoolsOutput{IpamPools: input.IpamPools}, nil
+}
+
+func (c *dryRunELBV2) DescribeCapacityReservationWithContext(ctx context.Context, input *elbv2sdk.DescribeCapacityReservationInput) (*elbv2sdk.DescribeCapacityReservationOutput, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.LoadBalancerArn)) {
+		return &elbv2sdk.DescribeCapacityReservationOutput{}, nil
+	}
+	return c.ELBV2.DescribeCapacityReservationWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) ModifyCapacityReservationWithContext(_ context.Context, input *elbv2sdk.ModifyCapacityReservationInput) (*elbv2sdk.ModifyCapacityReservationOutput, error) {
+	return &elbv2sdk.ModifyCapacityReservationOutput{MinimumLoadBalancerCapacity: input.MinimumLoadBalancerCapacity}, nil
+}
+
+func (c *dryRunELBV2) DescribeLoadBalancerAttributesWithContext(ctx context.Context, input *elbv2sdk.DescribeLoadBalancerAttributesInput) (*elbv2sdk.DescribeLoadBalancerAttributesOutput, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.LoadBalancerArn)) {
+		return &elbv2sdk.DescribeLoadBalancerAttributesOutput{}, nil
+	}
+	return c.ELBV2.DescribeLoadBalancerAttributesWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) ModifyLoadBalancerAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyLoadBalancerAttributesInput) (*elbv2sdk.ModifyLoadBalancerAttributesOutput, error) {
+	return &elbv2sdk.ModifyLoadBalancerAttributesOutput{Attributes: input.Attributes}, nil
+}
+
+func (c *dryRunELBV2) CreateListenerWithContext(_ context.Context, input *elbv2sdk.CreateListenerInput) (*elbv2sdk.CreateListenerOutput, error) {
+	return &elbv2sdk.CreateListenerOutput{
+		Listeners: []elbv2types.Listener{
+			{
+				ListenerArn:     awssdk.String(c.ids.next("listener", fmt.Sprintf("%v", awssdk.ToInt32(input.Port)))),
+				LoadBalancerArn: input.LoadBalancerArn,
+				Port:            input.Port,
+				Protocol:        input.Protocol,
+				Certificates:    input.Certificates,
+				SslPolicy:       input.SslPolicy,
+				AlpnPolicy:      input.AlpnPolicy,
+				DefaultActions:  input.DefaultActions,
+			},
+		},
+	}, nil
+}
+
+func (c *dryRunELBV2) ModifyListenerWithContext(_ context.Context, _ *elbv2sdk.ModifyListenerInput) (*elbv2sdk.ModifyListenerOutput, error) {
+	return &elbv2sdk.ModifyListenerOutput{}, nil
+}
+
+func (c *dryRunELBV2) DeleteListenerWithContext(_ context.Context, _ *elbv2sdk.DeleteListenerInput) (*elbv2sdk.DeleteListenerOutput, error) {
+	return &elbv2sdk.DeleteListenerOutput{}, nil
+}
+
+func (c *dryRunELBV2) DescribeListenersAsList(ctx context.Context, input *elbv2sdk.DescribeListenersInput) ([]elbv2types.Listener, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.LoadBalancerArn)) {
+		return nil, nil
+	}
+	return c.ELBV2.DescribeListenersAsList(ctx, input)
+}
+
+func (c *dryRunELBV2) DescribeListenerCertificatesAsList(ctx context.Context, input *elbv2sdk.DescribeListenerCertificatesInput) ([]elbv2types.Certificate, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.ListenerArn)) {
+		return nil, nil
+	}
+	return c.ELBV2.DescribeListenerCertificatesAsList(ctx, input)
+}
+
+func (c *dryRunELBV2) AddListenerCertificatesWithContext(_ context.Context, input *elbv2sdk.AddListenerCertificatesInput) (*elbv2sdk.AddListenerCertificatesOutput, error) {
+	return &elbv2sdk.AddListenerCertificatesOutput{Certificates: input.Certificates}, nil
+}
+
+func (c *dryRunELBV2) RemoveListenerCertificatesWithContext(_ context.Context, _ *elbv2sdk.RemoveListenerCertificatesInput) (*elbv2sdk.RemoveListenerCertificatesOutput, error) {
+	return &elbv2sdk.RemoveListenerCertificatesOutput{}, nil
+}
+
+func (c *dryRunELBV2) DescribeListenerAttributesWithContext(ctx context.Context, input *elbv2sdk.DescribeListenerAttributesInput) (*elbv2sdk.DescribeListenerAttributesOutput, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.ListenerArn)) {
+		return &elbv2sdk.DescribeListenerAttributesOutput{}, nil
+	}
+	return c.ELBV2.DescribeListenerAttributesWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) ModifyListenerAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyListenerAttributesInput) (*elbv2sdk.ModifyListenerAttributesOutput, error) {
+	return &elbv2sdk.ModifyListenerAttributesOutput{Attributes: input.Attributes}, nil
+}
+
+func (c *dryRunELBV2) CreateRuleWithContext(_ context.Context, input *elbv2sdk.CreateRuleInput) (*elbv2sdk.CreateRuleOutput, error) {
+	return &elbv2sdk.CreateRuleOutput{
+		Rules: []elbv2types.Rule{
+			{
+				RuleArn:    awssdk.String(c.ids.next("listener-rule", fmt.Sprintf("%v", awssdk.ToInt32(input.Priority)))),
+				Priority:   awssdk.String(fmt.Sprintf("%v", awssdk.ToInt32(input.Priority))),
+				Actions:    input.Actions,
+				Conditions: input.Conditions,
+			},
+		},
+	}, nil
+}
+
+func (c *dryRunELBV2) ModifyRuleWithContext(_ context.Context, _ *elbv2sdk.ModifyRuleInput) (*elbv2sdk.ModifyRuleOutput, error) {
+	return &elbv2sdk.ModifyRuleOutput{}, nil
+}
+
+func (c *dryRunELBV2) DeleteRuleWithContext(_ context.Context, _ *elbv2sdk.DeleteRuleInput) (*elbv2sdk.DeleteRuleOutput, error) {
+	return &elbv2sdk.DeleteRuleOutput{}, nil
+}
+
+func (c *dryRunELBV2) DescribeRulesAsList(ctx context.Context, input *elbv2sdk.DescribeRulesInput) ([]elbv2types.Rule, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.ListenerArn)) {
+		return nil, nil
+	}
+	return c.ELBV2.DescribeRulesAsList(ctx, input)
+}
+
+func (c *dryRunELBV2) CreateTargetGroupWithContext(_ context.Context, input *elbv2sdk.CreateTargetGroupInput) (*elbv2sdk.CreateTargetGroupOutput, error) {
+	return &elbv2sdk.CreateTargetGroupOutput{
+		TargetGroups: []elbv2types.TargetGroup{
+			{
+				TargetGroupArn:  awssdk.String(c.ids.next("targetgroup", awssdk.ToString(input.Name))),
+				TargetGroupName: input.Name,
+				TargetType:      input.TargetType,
+				Port:            input.Port,
+				Protocol:        input.Protocol,
+				ProtocolVersion: input.ProtocolVersion,
+				IpAddressType:   input.IpAddressType,
+				VpcId:           input.VpcId,
+			},
+		},
+	}, nil
+}
+
+func (c *dryRunELBV2) ModifyTargetGroupWithContext(_ context.Context, _ *elbv2sdk.ModifyTargetGroupInput) (*elbv2sdk.ModifyTargetGroupOutput, error) {
+	return &elbv2sdk.ModifyTargetGroupOutput{}, nil
+}
+
+func (c *dryRunELBV2) DeleteTargetGroupWithContext(_ context.Context, _ *elbv2sdk.DeleteTargetGroupInput) (*elbv2sdk.DeleteTargetGroupOutput, error) {
+	return &elbv2sdk.DeleteTargetGroupOutput{}, nil
+}
+
+func (c *dryRunELBV2) DescribeTargetGroupsAsList(ctx context.Context, input *elbv2sdk.DescribeTargetGroupsInput) ([]elbv2types.TargetGroup, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.LoadBalancerArn)) {
+		return nil, nil
+	}
+	return c.ELBV2.DescribeTargetGroupsAsList(ctx, input)
+}
+
+func (c *dryRunELBV2) DescribeTargetGroupAttributesWithContext(ctx context.Context, input *elbv2sdk.DescribeTargetGroupAttributesInput) (*elbv2sdk.DescribeTargetGroupAttributesOutput, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.TargetGroupArn)) {
+		return &elbv2sdk.DescribeTargetGroupAttributesOutput{}, nil
+	}
+	return c.ELBV2.DescribeTargetGroupAttributesWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) ModifyTargetGroupAttributesWithContext(_ context.Context, input *elbv2sdk.ModifyTargetGroupAttributesInput) (*elbv2sdk.ModifyTargetGroupAttributesOutput, error) {
+	return &elbv2sdk.ModifyTargetGroupAttributesOutput{Attributes: input.Attributes}, nil
+}
+
+func (c *dryRunELBV2) DescribeTargetHealthWithContext(ctx context.Context, input *elbv2sdk.DescribeTargetHealthInput) (*elbv2sdk.DescribeTargetHealthOutput, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.TargetGroupArn)) {
+		return &elbv2sdk.DescribeTargetHealthOutput{}, nil
+	}
+	return c.ELBV2.DescribeTargetHealthWithContext(ctx, input)
+}
+
+func (c *dryRunELBV2) RegisterTargetsWithContext(ctx context.Context, input *elbv2sdk.RegisterTargetsInput) (*elbv2sdk.RegisterTargetsOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "targetGroup", awssdk.ToString(input.TargetGroupArn),
+		fmt.Sprintf("registered targets %v", renderTargets(input.Targets)))
+	return &elbv2sdk.RegisterTargetsOutput{}, nil
+}
+
+func (c *dryRunELBV2) DeregisterTargetsWithContext(ctx context.Context, input *elbv2sdk.DeregisterTargetsInput) (*elbv2sdk.DeregisterTargetsOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "targetGroup", awssdk.ToString(input.TargetGroupArn),
+		fmt.Sprintf("deregistered targets %v", renderTargets(input.Targets)))
+	return &elbv2sdk.DeregisterTargetsOutput{}, nil
+}
+
+func (c *dryRunELBV2) CreateTrustStoreWithContext(_ context.Context, input *elbv2sdk.CreateTrustStoreInput) (*elbv2sdk.CreateTrustStoreOutput, error) {
+	return &elbv2sdk.CreateTrustStoreOutput{
+		TrustStores: []elbv2types.TrustStore{
+			{
+				TrustStoreArn: awssdk.String(c.ids.next("truststore", awssdk.ToString(input.Name))),
+				Name:          input.Name,
+			},
+		},
+	}, nil
+}
+
+func (c *dryRunELBV2) DeleteTrustStoreWithContext(_ context.Context, _ *elbv2sdk.DeleteTrustStoreInput) (*elbv2sdk.DeleteTrustStoreOutput, error) {
+	return &elbv2sdk.DeleteTrustStoreOutput{}, nil
+}
+
+func (c *dryRunELBV2) DescribeTagsWithContext(ctx context.Context, input *elbv2sdk.DescribeTagsInput) (*elbv2sdk.DescribeTagsOutput, error) {
+	existingARNs := filterExistingResourceIDs(input.ResourceArns)
+	if len(existingARNs) == 0 {
+		return &elbv2sdk.DescribeTagsOutput{}, nil
+	}
+	existingInput := *input
+	existingInput.ResourceArns = existingARNs
+	return c.ELBV2.DescribeTagsWithContext(ctx, &existingInput)
+}
+
+func (c *dryRunELBV2) AddTagsWithContext(ctx context.Context, input *elbv2sdk.AddTagsInput) (*elbv2sdk.AddTagsOutput, error) {
+	var tagKeys []string
+	for _, tag := range input.Tags {
+		tagKeys = append(tagKeys, awssdk.ToString(tag.Key))
+	}
+	for _, arn := range input.ResourceArns {
+		audit.RecordMutation(ctx, audit.ActionModify, elbv2ResourceType(arn), arn, fmt.Sprintf("added tags %v", tagKeys))
+	}
+	return &elbv2sdk.AddTagsOutput{}, nil
+}
+
+func (c *dryRunELBV2) RemoveTagsWithContext(ctx context.Context, input *elbv2sdk.RemoveTagsInput) (*elbv2sdk.RemoveTagsOutput, error) {
+	for _, arn := range input.ResourceArns {
+		audit.RecordMutation(ctx, audit.ActionModify, elbv2ResourceType(arn), arn,
+			fmt.Sprintf("removed tags %v", input.TagKeys))
+	}
+	return &elbv2sdk.RemoveTagsOutput{}, nil
+}
+
+// elbv2ResourceType returns the type of ELBV2 resource by its ARN.
+func elbv2ResourceType(arn string) string {
+	for _, resourceType := range []struct {
+		arnInfix string
+		name     string
+	}{
+		{arnInfix: ":loadbalancer/", name: "loadBalancer"},
+		{arnInfix: ":listener/", name: "listener"},
+		{arnInfix: ":listener-rule/", name: "listenerRule"},
+		{arnInfix: ":targetgroup/", name: "targetGroup"},
+		{arnInfix: ":truststore/", name: "trustStore"},
+	} {
+		if strings.Contains(arn, resourceType.arnInfix) {
+			return resourceType.name
+		}
+	}
+	return "resource"
+}
+
+func renderTargets(targets []elbv2types.TargetDescription) []string {
+	rendered := make([]string, 0, len(targets))
+	for _, target := range targets {
+		rendered = append(rendered, fmt.Sprintf("%v:%v", awssdk.ToString(target.Id), awssdk.ToInt32(target.Port)))
+	}
+	return rendered
+}
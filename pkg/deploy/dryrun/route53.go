@@ -0,0 +1,19 @@
+package dryrun
+
+import (
+	"context"
+
+	route53sdk "github.com/aws/aws-sdk-go-v2/service/route53"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+var _ services.Route53 = &dryRunRoute53{}
+
+// dryRunRoute53 is a Route53 client that plans the mutations used while deploying stacks instead of applying them.
+type dryRunRoute53 struct {
+	services.Route53
+}
+
+func (c *dryRunRoute53) ChangeResourceRecordSetsWithContext(_ context.Context, _ *route53sdk.ChangeResourceRecordSetsInput) (*route53sdk.ChangeResourceRecordSetsOutput, error) {
+	return &route53sdk.ChangeResourceRecordSetsOutput{}, nil
+}
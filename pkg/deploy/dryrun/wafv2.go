@@ -0,0 +1,85 @@
+package dryrun
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	wafv2sdk "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+)
+
+var _ services.WAFv2 = &dryRunWAFv2{}
+
+// dryRunWAFv2 is a WAFv2 client that plans the mutations used while deploying stacks instead of applying them.
+type dryRunWAFv2 struct {
+	services.WAFv2
+	ids *plannedResourceIDGenerator
+}
+
+func (c *dryRunWAFv2) GetWebACLForResourceWithContext(ctx context.Context, input *wafv2sdk.GetWebACLForResourceInput) (*wafv2sdk.GetWebACLForResourceOutput, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.ResourceArn)) {
+		return &wafv2sdk.GetWebACLForResourceOutput{}, nil
+	}
+	return c.WAFv2.GetWebACLForResourceWithContext(ctx, input)
+}
+
+func (c *dryRunWAFv2) AssociateWebACLWithContext(ctx context.Context, input *wafv2sdk.AssociateWebACLInput) (*wafv2sdk.AssociateWebACLOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.ToString(input.ResourceArn),
+		"associated webACL "+awssdk.ToString(input.WebACLArn))
+	return &wafv2sdk.AssociateWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFv2) DisassociateWebACLWithContext(ctx context.Context, input *wafv2sdk.DisassociateWebACLInput) (*wafv2sdk.DisassociateWebACLOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.ToString(input.ResourceArn), "disassociated webACL")
+	return &wafv2sdk.DisassociateWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFv2) PutLoggingConfigurationWithContext(ctx context.Context, input *wafv2sdk.PutLoggingConfigurationInput) (*wafv2sdk.PutLoggingConfigurationOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "webACL", awssdk.ToString(input.LoggingConfiguration.ResourceArn),
+		"set log destination "+input.LoggingConfiguration.LogDestinationConfigs[0])
+	return &wafv2sdk.PutLoggingConfigurationOutput{LoggingConfiguration: input.LoggingConfiguration}, nil
+}
+
+func (c *dryRunWAFv2) GetLoggingConfigurationWithContext(ctx context.Context, input *wafv2sdk.GetLoggingConfigurationInput) (*wafv2sdk.GetLoggingConfigurationOutput, error) {
+	if IsPlannedResourceID(awssdk.ToString(input.ResourceArn)) {
+		return nil, &wafv2types.WAFNonexistentItemException{Message: awssdk.String("webACL planned to be created")}
+	}
+	return c.WAFv2.GetLoggingConfigurationWithContext(ctx, input)
+}
+
+func (c *dryRunWAFv2) CreateWebACLWithContext(_ context.Context, input *wafv2sdk.CreateWebACLInput) (*wafv2sdk.CreateWebACLOutput, error) {
+	webACLID := c.ids.next("webacl", awssdk.ToString(input.Name))
+	return &wafv2sdk.CreateWebACLOutput{
+		Summary: &wafv2types.WebACLSummary{
+			ARN:  awssdk.String(webACLID),
+			Id:   awssdk.String(webACLID),
+			Name: input.Name,
+		},
+	}, nil
+}
+
+func (c *dryRunWAFv2) UpdateWebACLWithContext(_ context.Context, _ *wafv2sdk.UpdateWebACLInput) (*wafv2sdk.UpdateWebACLOutput, error) {
+	return &wafv2sdk.UpdateWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFv2) DeleteWebACLWithContext(_ context.Context, _ *wafv2sdk.DeleteWebACLInput) (*wafv2sdk.DeleteWebACLOutput, error) {
+	return &wafv2sdk.DeleteWebACLOutput{}, nil
+}
+
+func (c *dryRunWAFv2) TagResourceWithContext(ctx context.Context, input *wafv2sdk.TagResourceInput) (*wafv2sdk.TagResourceOutput, error) {
+	var tagKeys []string
+	for _, tag := range input.Tags {
+		tagKeys = append(tagKeys, awssdk.ToString(tag.Key))
+	}
+	audit.RecordMutation(ctx, audit.ActionModify, "webACL", awssdk.ToString(input.ResourceARN), fmt.Sprintf("added tags %v", tagKeys))
+	return &wafv2sdk.TagResourceOutput{}, nil
+}
+
+func (c *dryRunWAFv2) UntagResourceWithContext(ctx context.Context, input *wafv2sdk.UntagResourceInput) (*wafv2sdk.UntagResourceOutput, error) {
+	audit.RecordMutation(ctx, audit.ActionModify, "webACL", awssdk.ToString(input.ResourceARN),
+		fmt.Sprintf("removed tags %v", input.TagKeys))
+	return &wafv2sdk.UntagResourceOutput{}, nil
+}
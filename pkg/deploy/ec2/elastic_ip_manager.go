@@ -0,0 +1,137 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+const (
+	defaultWaitEIPReleasePollInterval = 2 * time.Second
+	defaultWaitEIPReleaseTimeout      = 2 * time.Minute
+)
+
+// ElasticIPManager is responsible for create/update/delete ElasticIP resources.
+type ElasticIPManager interface {
+	Create(ctx context.Context, resEIP *ec2model.ElasticIP) (ec2model.ElasticIPStatus, error)
+
+	Update(ctx context.Context, resEIP *ec2model.ElasticIP, sdkEIP ElasticIPWithTags) (ec2model.ElasticIPStatus, error)
+
+	Delete(ctx context.Context, sdkEIP ElasticIPWithTags) error
+}
+
+// NewDefaultElasticIPManager constructs new defaultElasticIPManager.
+func NewDefaultElasticIPManager(ec2Client services.EC2, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	externalManagedTags []string, logger logr.Logger) *defaultElasticIPManager {
+	return &defaultElasticIPManager{
+		ec2Client:           ec2Client,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitEIPReleasePollInterval: defaultWaitEIPReleasePollInterval,
+		waitEIPReleaseTimeout:      defaultWaitEIPReleaseTimeout,
+	}
+}
+
+var _ ElasticIPManager = &defaultElasticIPManager{}
+
+// default implementation for ElasticIPManager.
+type defaultElasticIPManager struct {
+	ec2Client           services.EC2
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+
+	waitEIPReleasePollInterval time.Duration
+	waitEIPReleaseTimeout      time.Duration
+}
+
+func (m *defaultElasticIPManager) Create(ctx context.Context, resEIP *ec2model.ElasticIP) (ec2model.ElasticIPStatus, error) {
+	eipTags := m.trackingProvider.ResourceTags(resEIP.Stack(), resEIP, resEIP.Spec.Tags)
+	req := &ec2sdk.AllocateAddressInput{
+		Domain:         ec2types.DomainTypeVpc,
+		PublicIpv4Pool: resEIP.Spec.PublicIPv4Pool,
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeElasticIp,
+				Tags:         convertTagsToSDKTags(eipTags),
+			},
+		},
+	}
+	m.logger.Info("allocating elasticIP",
+		"resourceID", resEIP.ID())
+	resp, err := m.ec2Client.AllocateAddressWithContext(ctx, req)
+	if err != nil {
+		return ec2model.ElasticIPStatus{}, err
+	}
+	allocationID := awssdk.ToString(resp.AllocationId)
+	m.logger.Info("allocated elasticIP",
+		"resourceID", resEIP.ID(),
+		"allocationID", allocationID)
+	audit.RecordMutation(ctx, audit.ActionCreate, "elasticIP", allocationID, awssdk.ToString(resp.PublicIp))
+	return ec2model.ElasticIPStatus{
+		AllocationID: allocationID,
+		PublicIP:     awssdk.ToString(resp.PublicIp),
+	}, nil
+}
+
+func (m *defaultElasticIPManager) Update(ctx context.Context, resEIP *ec2model.ElasticIP, sdkEIP ElasticIPWithTags) (ec2model.ElasticIPStatus, error) {
+	if err := m.updateSDKElasticIPWithTags(ctx, resEIP, sdkEIP); err != nil {
+		return ec2model.ElasticIPStatus{}, err
+	}
+	return ec2model.ElasticIPStatus{
+		AllocationID: awssdk.ToString(sdkEIP.Address.AllocationId),
+		PublicIP:     awssdk.ToString(sdkEIP.Address.PublicIp),
+	}, nil
+}
+
+func (m *defaultElasticIPManager) Delete(ctx context.Context, sdkEIP ElasticIPWithTags) error {
+	allocationID := awssdk.ToString(sdkEIP.Address.AllocationId)
+	req := &ec2sdk.ReleaseAddressInput{
+		AllocationId: awssdk.String(allocationID),
+	}
+	m.logger.Info("releasing elasticIP",
+		"allocationID", allocationID)
+	// the address stays associated with the LoadBalancer's network interfaces for a while after the LoadBalancer is deleted.
+	if err := runtime.RetryImmediateOnError(m.waitEIPReleasePollInterval, m.waitEIPReleaseTimeout, isElasticIPInUseError, func() error {
+		_, err := m.ec2Client.ReleaseAddressWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to release elasticIP")
+	}
+	m.logger.Info("released elasticIP",
+		"allocationID", allocationID)
+	audit.RecordMutation(ctx, audit.ActionDelete, "elasticIP", allocationID, "")
+	return nil
+}
+
+func (m *defaultElasticIPManager) updateSDKElasticIPWithTags(ctx context.Context, resEIP *ec2model.ElasticIP, sdkEIP ElasticIPWithTags) error {
+	desiredEIPTags := m.trackingProvider.ResourceTags(resEIP.Stack(), resEIP, resEIP.Spec.Tags)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkEIP.Address.AllocationId), desiredEIPTags,
+		WithCurrentTags(sdkEIP.Tags),
+		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
+		WithIgnoredTagKeys(m.externalManagedTags))
+}
+
+func isElasticIPInUseError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "InvalidIPAddress.InUse"
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultElasticIPManager_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ec2model.ElasticIPSpec
+		wantReq *ec2sdk.AllocateAddressInput
+	}{
+		{
+			name: "allocate from amazon pool",
+			spec: ec2model.ElasticIPSpec{
+				Tags: map[string]string{"team": "payments"},
+			},
+			wantReq: &ec2sdk.AllocateAddressInput{
+				Domain: ec2types.DomainTypeVpc,
+				TagSpecifications: []ec2types.TagSpecification{
+					{
+						ResourceType: ec2types.ResourceTypeElasticIp,
+						Tags: []ec2types.Tag{
+							{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("my-cluster")},
+							{Key: awssdk.String("service.k8s.aws/resource"), Value: awssdk.String("ElasticIP-subnet-1")},
+							{Key: awssdk.String("service.k8s.aws/stack"), Value: awssdk.String("awesome-ns/awesome-svc")},
+							{Key: awssdk.String("team"), Value: awssdk.String("payments")},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "allocate from BYOIP pool",
+			spec: ec2model.ElasticIPSpec{
+				PublicIPv4Pool: awssdk.String("ipv4pool-ec2-012345abcdef"),
+			},
+			wantReq: &ec2sdk.AllocateAddressInput{
+				Domain:         ec2types.DomainTypeVpc,
+				PublicIpv4Pool: awssdk.String("ipv4pool-ec2-012345abcdef"),
+				TagSpecifications: []ec2types.TagSpecification{
+					{
+						ResourceType: ec2types.ResourceTypeElasticIp,
+						Tags: []ec2types.Tag{
+							{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String("my-cluster")},
+							{Key: awssdk.String("service.k8s.aws/resource"), Value: awssdk.String("ElasticIP-subnet-1")},
+							{Key: awssdk.String("service.k8s.aws/stack"), Value: awssdk.String("awesome-ns/awesome-svc")},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			ec2Client.EXPECT().AllocateAddressWithContext(gomock.Any(), tt.wantReq).Return(&ec2sdk.AllocateAddressOutput{
+				AllocationId: awssdk.String("eipalloc-1"),
+				PublicIp:     awssdk.String("1.2.3.4"),
+			}, nil)
+
+			stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-svc"}))
+			resEIP := ec2model.NewElasticIP(stack, "ElasticIP-subnet-1", tt.spec)
+			trackingProvider := tracking.NewDefaultProvider("service.k8s.aws", "my-cluster", nil)
+			m := NewDefaultElasticIPManager(ec2Client, trackingProvider, nil, nil, log.Log)
+			got, err := m.Create(context.Background(), resEIP)
+			assert.NoError(t, err)
+			assert.Equal(t, ec2model.ElasticIPStatus{AllocationID: "eipalloc-1", PublicIP: "1.2.3.4"}, got)
+		})
+	}
+}
+
+func Test_defaultElasticIPManager_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		releaseErrs []error
+		wantErr     error
+	}{
+		{
+			name:        "release succeeded",
+			releaseErrs: []error{nil},
+		},
+		{
+			name: "release succeeded after address is disassociated",
+			releaseErrs: []error{
+				&smithy.GenericAPIError{Code: "InvalidIPAddress.InUse", Message: "Address eipalloc-1 is in use."},
+				nil,
+			},
+		},
+		{
+			name:        "release failed",
+			releaseErrs: []error{errors.New("some error")},
+			wantErr:     errors.New("failed to release elasticIP: some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, releaseErr := range tt.releaseErrs {
+				ec2Client.EXPECT().ReleaseAddressWithContext(gomock.Any(), &ec2sdk.ReleaseAddressInput{
+					AllocationId: awssdk.String("eipalloc-1"),
+				}).Return(&ec2sdk.ReleaseAddressOutput{}, releaseErr)
+			}
+
+			m := &defaultElasticIPManager{
+				ec2Client:                  ec2Client,
+				logger:                     log.Log,
+				waitEIPReleasePollInterval: time.Millisecond,
+				waitEIPReleaseTimeout:      time.Second,
+			}
+			err := m.Delete(context.Background(), ElasticIPWithTags{
+				Address: &ec2types.Address{AllocationId: awssdk.String("eipalloc-1")},
+			})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,12 +2,16 @@ package ec2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
@@ -63,7 +67,7 @@ type defaultSecurityGroupManager struct {
 func (m *defaultSecurityGroupManager) Create(ctx context.Context, resSG *ec2model.SecurityGroup) (ec2model.SecurityGroupStatus, error) {
 	sgTags := m.trackingProvider.ResourceTags(resSG.Stack(), resSG, resSG.Spec.Tags)
 	sdkTags := convertTagsToSDKTags(sgTags)
-	permissionInfos, err := buildIPPermissionInfos(resSG.Spec.Ingress)
+	permissionInfos, err := buildIPPermissionInfos(ctx, resSG.Spec.Ingress)
 	if err != nil {
 		return ec2model.SecurityGroupStatus{}, err
 	}
@@ -72,9 +76,9 @@ func (m *defaultSecurityGroupManager) Create(ctx context.Context, resSG *ec2mode
 		VpcId:       awssdk.String(m.vpcID),
 		GroupName:   awssdk.String(resSG.Spec.GroupName),
 		Description: awssdk.String(resSG.Spec.Description),
-		TagSpecifications: []*ec2sdk.TagSpecification{
+		TagSpecifications: []ec2types.TagSpecification{
 			{
-				ResourceType: awssdk.String("security-group"),
+				ResourceType: ec2types.ResourceTypeSecurityGroup,
 				Tags:         sdkTags,
 			},
 		},
@@ -85,10 +89,11 @@ func (m *defaultSecurityGroupManager) Create(ctx context.Context, resSG *ec2mode
 	if err != nil {
 		return ec2model.SecurityGroupStatus{}, err
 	}
-	sgID := awssdk.StringValue(resp.GroupId)
+	sgID := awssdk.ToString(resp.GroupId)
 	m.logger.Info("created securityGroup",
 		"resourceID", resSG.ID(),
 		"securityGroupID", sgID)
+	audit.RecordMutation(ctx, audit.ActionCreate, "securityGroup", sgID, resSG.Spec.GroupName)
 
 	if err := m.networkingSGReconciler.ReconcileIngress(ctx, sgID, permissionInfos); err != nil {
 		return ec2model.SecurityGroupStatus{}, err
@@ -100,7 +105,7 @@ func (m *defaultSecurityGroupManager) Create(ctx context.Context, resSG *ec2mode
 }
 
 func (m *defaultSecurityGroupManager) Update(ctx context.Context, resSG *ec2model.SecurityGroup, sdkSG networking.SecurityGroupInfo) (ec2model.SecurityGroupStatus, error) {
-	permissionInfos, err := buildIPPermissionInfos(resSG.Spec.Ingress)
+	permissionInfos, err := buildIPPermissionInfos(ctx, resSG.Spec.Ingress)
 	if err != nil {
 		return ec2model.SecurityGroupStatus{}, err
 	}
@@ -130,6 +135,7 @@ func (m *defaultSecurityGroupManager) Delete(ctx context.Context, sdkSG networki
 	}
 	m.logger.Info("deleted securityGroup",
 		"securityGroupID", sdkSG.SecurityGroupID)
+	audit.RecordMutation(ctx, audit.ActionDelete, "securityGroup", sdkSG.SecurityGroupID, "")
 
 	return nil
 }
@@ -142,10 +148,10 @@ func (m *defaultSecurityGroupManager) updateSDKSecurityGroupGroupWithTags(ctx co
 		WithIgnoredTagKeys(m.externalManagedTags))
 }
 
-func buildIPPermissionInfos(permissions []ec2model.IPPermission) ([]networking.IPPermissionInfo, error) {
+func buildIPPermissionInfos(ctx context.Context, permissions []ec2model.IPPermission) ([]networking.IPPermissionInfo, error) {
 	permissionInfos := make([]networking.IPPermissionInfo, 0, len(permissions))
 	for _, permission := range permissions {
-		permissionInfo, err := buildIPPermissionInfo(permission)
+		permissionInfo, err := buildIPPermissionInfo(ctx, permission)
 		if err != nil {
 			return nil, err
 		}
@@ -154,27 +160,42 @@ func buildIPPermissionInfos(permissions []ec2model.IPPermission) ([]networking.I
 	return permissionInfos, nil
 }
 
-func buildIPPermissionInfo(permission ec2model.IPPermission) (networking.IPPermissionInfo, error) {
+func buildIPPermissionInfo(ctx context.Context, permission ec2model.IPPermission) (networking.IPPermissionInfo, error) {
 	protocol := permission.IPProtocol
+	var fromPort, toPort *int32
+	if permission.FromPort != nil {
+		fromPort = awssdk.Int32(int32(*permission.FromPort))
+	}
+	if permission.ToPort != nil {
+		toPort = awssdk.Int32(int32(*permission.ToPort))
+	}
 	if len(permission.IPRanges) == 1 {
 		labels := networking.NewIPPermissionLabelsForRawDescription(permission.IPRanges[0].Description)
-		return networking.NewCIDRIPPermission(protocol, permission.FromPort, permission.ToPort, permission.IPRanges[0].CIDRIP, labels), nil
+		return networking.NewCIDRIPPermission(protocol, fromPort, toPort, permission.IPRanges[0].CIDRIP, labels), nil
 	}
 	if len(permission.IPv6Range) == 1 {
 		labels := networking.NewIPPermissionLabelsForRawDescription(permission.IPv6Range[0].Description)
-		return networking.NewCIDRv6IPPermission(protocol, permission.FromPort, permission.ToPort, permission.IPv6Range[0].CIDRIPv6, labels), nil
+		return networking.NewCIDRv6IPPermission(protocol, fromPort, toPort, permission.IPv6Range[0].CIDRIPv6, labels), nil
 	}
 	if len(permission.UserIDGroupPairs) == 1 {
+		groupID, err := permission.UserIDGroupPairs[0].GroupID.Resolve(ctx)
+		if err != nil {
+			return networking.IPPermissionInfo{}, err
+		}
 		labels := networking.NewIPPermissionLabelsForRawDescription(permission.UserIDGroupPairs[0].Description)
-		return networking.NewGroupIDIPPermission(protocol, permission.FromPort, permission.ToPort, permission.UserIDGroupPairs[0].GroupID, labels), nil
+		return networking.NewGroupIDIPPermission(protocol, fromPort, toPort, groupID, labels), nil
+	}
+	if len(permission.PrefixLists) == 1 {
+		labels := networking.NewIPPermissionLabelsForRawDescription(permission.PrefixLists[0].Description)
+		return networking.NewPrefixListIDPermission(protocol, fromPort, toPort, permission.PrefixLists[0].ListID, labels), nil
 	}
 	return networking.IPPermissionInfo{}, errors.New("invalid ipPermission")
 }
 
 func isSecurityGroupDependencyViolationError(err error) bool {
-	var awsErr awserr.Error
-	if errors.As(err, &awsErr) {
-		return awsErr.Code() == "DependencyViolation"
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "DependencyViolation"
 	}
 	return false
 }
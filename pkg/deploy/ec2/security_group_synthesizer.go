@@ -54,23 +54,43 @@ func (s *securityGroupSynthesizer) Synthesize(ctx context.Context) error {
 	// For SecurityGroup, we delete unmatched ones during post synthesize.
 	s.unmatchedSDKSGs = unmatchedSDKSGs
 
-	for _, resSG := range unmatchedResSGs {
-		sgStatus, err := s.sgManager.Create(ctx, resSG)
-		if err != nil {
-			return err
+	// SecurityGroups referencing other SecurityGroups are synthesized last,
+	// so that the referenced SecurityGroups within stack are fulfilled already.
+	for _, referencing := range []bool{false, true} {
+		for _, resSG := range unmatchedResSGs {
+			if isSecurityGroupReferencingOthers(resSG) != referencing {
+				continue
+			}
+			sgStatus, err := s.sgManager.Create(ctx, resSG)
+			if err != nil {
+				return err
+			}
+			resSG.SetStatus(sgStatus)
 		}
-		resSG.SetStatus(sgStatus)
-	}
-	for _, resAndSDKSG := range matchedResAndSDKSGs {
-		sgStatus, err := s.sgManager.Update(ctx, resAndSDKSG.resSG, resAndSDKSG.sdkSG)
-		if err != nil {
-			return err
+		for _, resAndSDKSG := range matchedResAndSDKSGs {
+			if isSecurityGroupReferencingOthers(resAndSDKSG.resSG) != referencing {
+				continue
+			}
+			sgStatus, err := s.sgManager.Update(ctx, resAndSDKSG.resSG, resAndSDKSG.sdkSG)
+			if err != nil {
+				return err
+			}
+			resAndSDKSG.resSG.SetStatus(sgStatus)
 		}
-		resAndSDKSG.resSG.SetStatus(sgStatus)
 	}
 	return nil
 }
 
+// isSecurityGroupReferencingOthers checks whether the ingress permissions of SecurityGroup references other SecurityGroups.
+func isSecurityGroupReferencingOthers(resSG *ec2model.SecurityGroup) bool {
+	for _, permission := range resSG.Spec.Ingress {
+		if len(permission.UserIDGroupPairs) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *securityGroupSynthesizer) PostSynthesize(ctx context.Context) error {
 	for _, sdkSG := range s.unmatchedSDKSGs {
 		if err := s.sgManager.Delete(ctx, sdkSG); err != nil {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
@@ -54,15 +56,36 @@ type TaggingManager interface {
 
 	// ListSecurityGroups returns SecurityGroups that matches any of the tagging requirements.
 	ListSecurityGroups(ctx context.Context, tagFilters ...tracking.TagFilter) ([]networking.SecurityGroupInfo, error)
+
+	// ListVPCEndpointServices returns VPC Endpoint Services that matches any of the tagging requirements.
+	ListVPCEndpointServices(ctx context.Context, tagFilters ...tracking.TagFilter) ([]VPCEndpointServiceWithTags, error)
+
+	// ListElasticIPs returns Elastic IP addresses that matches any of the tagging requirements.
+	ListElasticIPs(ctx context.Context, tagFilters ...tracking.TagFilter) ([]ElasticIPWithTags, error)
+}
+
+// VPCEndpointServiceWithTags contains a VPC Endpoint Service configuration with its tags.
+type VPCEndpointServiceWithTags struct {
+	ServiceConfiguration *ec2types.ServiceConfiguration
+	Tags                 map[string]string
+}
+
+// ElasticIPWithTags contains an Elastic IP address with its tags.
+type ElasticIPWithTags struct {
+	Address *ec2types.Address
+	Tags    map[string]string
 }
 
 // NewDefaultTaggingManager constructs new defaultTaggingManager.
-func NewDefaultTaggingManager(ec2Client services.EC2, networkingSGManager networking.SecurityGroupManager, vpcID string, logger logr.Logger) *defaultTaggingManager {
+// strictTagEnforcement specifies whether ReconcileTags removes the tags not desired, otherwise they're left untouched.
+func NewDefaultTaggingManager(ec2Client services.EC2, networkingSGManager networking.SecurityGroupManager, vpcID string,
+	strictTagEnforcement bool, logger logr.Logger) *defaultTaggingManager {
 	return &defaultTaggingManager{
-		ec2Client:           ec2Client,
-		networkingSGManager: networkingSGManager,
-		vpcID:               vpcID,
-		logger:              logger,
+		ec2Client:            ec2Client,
+		networkingSGManager:  networkingSGManager,
+		vpcID:                vpcID,
+		strictTagEnforcement: strictTagEnforcement,
+		logger:               logger,
 	}
 }
 
@@ -70,10 +93,11 @@ var _ TaggingManager = &defaultTaggingManager{}
 
 // default implementation for TaggingManager.
 type defaultTaggingManager struct {
-	ec2Client           services.EC2
-	networkingSGManager networking.SecurityGroupManager
-	vpcID               string
-	logger              logr.Logger
+	ec2Client            services.EC2
+	networkingSGManager  networking.SecurityGroupManager
+	vpcID                string
+	strictTagEnforcement bool
+	logger               logr.Logger
 }
 
 func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, resID string, desiredTags map[string]string, opts ...ReconcileTagsOption) error {
@@ -93,10 +117,13 @@ func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, resID string,
 		delete(tagsToUpdate, ignoredTagKey)
 		delete(tagsToRemove, ignoredTagKey)
 	}
+	if !m.strictTagEnforcement {
+		tagsToRemove = nil
+	}
 
 	if len(tagsToUpdate) > 0 {
 		req := &ec2sdk.CreateTagsInput{
-			Resources: []*string{awssdk.String(resID)},
+			Resources: []string{resID},
 			Tags:      convertTagsToSDKTags(tagsToUpdate),
 		}
 
@@ -112,7 +139,7 @@ func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, resID string,
 
 	if len(tagsToRemove) > 0 {
 		req := &ec2sdk.DeleteTagsInput{
-			Resources: []*string{awssdk.String(resID)},
+			Resources: []string{resID},
 			Tags:      convertTagsToSDKTags(tagsToRemove),
 		}
 
@@ -149,41 +176,108 @@ func (m *defaultTaggingManager) ListSecurityGroups(ctx context.Context, tagFilte
 
 func (m *defaultTaggingManager) listSecurityGroupsWithTagFilter(ctx context.Context, tagFilter tracking.TagFilter) (map[string]networking.SecurityGroupInfo, error) {
 	req := &ec2sdk.DescribeSecurityGroupsInput{
-		Filters: []*ec2sdk.Filter{
+		Filters: []ec2types.Filter{
 			{
 				Name:   awssdk.String("vpc-id"),
-				Values: awssdk.StringSlice([]string{m.vpcID}),
+				Values: []string{m.vpcID},
 			},
 		},
 	}
+	req.Filters = append(req.Filters, convertTagFilterToSDKFilters(tagFilter)...)
+
+	return m.networkingSGManager.FetchSGInfosByRequest(ctx, req)
+}
+
+func (m *defaultTaggingManager) ListVPCEndpointServices(ctx context.Context, tagFilters ...tracking.TagFilter) ([]VPCEndpointServiceWithTags, error) {
+	esByID := make(map[string]VPCEndpointServiceWithTags)
+	for _, tagFilter := range tagFilters {
+		req := &ec2sdk.DescribeVpcEndpointServiceConfigurationsInput{
+			Filters: convertTagFilterToSDKFilters(tagFilter),
+		}
+		sdkESs, err := m.ec2Client.DescribeVpcEndpointServiceConfigurationsAsList(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range sdkESs {
+			sdkES := &sdkESs[i]
+			esByID[awssdk.ToString(sdkES.ServiceId)] = VPCEndpointServiceWithTags{
+				ServiceConfiguration: sdkES,
+				Tags:                 convertSDKTagsToTags(sdkES.Tags),
+			}
+		}
+	}
+
+	esList := make([]VPCEndpointServiceWithTags, 0, len(esByID))
+	for _, esID := range sets.StringKeySet(esByID).List() {
+		esList = append(esList, esByID[esID])
+	}
+	return esList, nil
+}
+
+func (m *defaultTaggingManager) ListElasticIPs(ctx context.Context, tagFilters ...tracking.TagFilter) ([]ElasticIPWithTags, error) {
+	eipByAllocationID := make(map[string]ElasticIPWithTags)
+	for _, tagFilter := range tagFilters {
+		req := &ec2sdk.DescribeAddressesInput{
+			Filters: convertTagFilterToSDKFilters(tagFilter),
+		}
+		resp, err := m.ec2Client.DescribeAddressesWithContext(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.Addresses {
+			sdkAddress := &resp.Addresses[i]
+			eipByAllocationID[awssdk.ToString(sdkAddress.AllocationId)] = ElasticIPWithTags{
+				Address: sdkAddress,
+				Tags:    convertSDKTagsToTags(sdkAddress.Tags),
+			}
+		}
+	}
 
+	eipList := make([]ElasticIPWithTags, 0, len(eipByAllocationID))
+	for _, allocationID := range sets.StringKeySet(eipByAllocationID).List() {
+		eipList = append(eipList, eipByAllocationID[allocationID])
+	}
+	return eipList, nil
+}
+
+// convert tagFilter into AWS SDK filter presentation.
+func convertTagFilterToSDKFilters(tagFilter tracking.TagFilter) []ec2types.Filter {
+	var filters []ec2types.Filter
 	for _, tagKey := range sets.StringKeySet(tagFilter).List() {
 		tagValues := tagFilter[tagKey]
-		var filter ec2sdk.Filter
+		var filter ec2types.Filter
 		if len(tagValues) == 0 {
 			tagFilterName := "tag-key"
 			filter.Name = awssdk.String(tagFilterName)
-			filter.Values = awssdk.StringSlice([]string{tagKey})
+			filter.Values = []string{tagKey}
 		} else {
 			tagFilterName := fmt.Sprintf("tag:%v", tagKey)
 			filter.Name = awssdk.String(tagFilterName)
-			filter.Values = awssdk.StringSlice(tagValues)
+			filter.Values = tagValues
 		}
-		req.Filters = append(req.Filters, &filter)
+		filters = append(filters, filter)
 	}
+	return filters
+}
 
-	return m.networkingSGManager.FetchSGInfosByRequest(ctx, req)
+// convert AWS SDK tag presentation into tags.
+func convertSDKTagsToTags(sdkTags []ec2types.Tag) map[string]string {
+	tags := make(map[string]string, len(sdkTags))
+	for _, sdkTag := range sdkTags {
+		tags[awssdk.ToString(sdkTag.Key)] = awssdk.ToString(sdkTag.Value)
+	}
+	return tags
 }
 
 // convert tags into AWS SDK tag presentation.
-func convertTagsToSDKTags(tags map[string]string) []*ec2sdk.Tag {
+func convertTagsToSDKTags(tags map[string]string) []ec2types.Tag {
 	if len(tags) == 0 {
 		return nil
 	}
-	sdkTags := make([]*ec2sdk.Tag, 0, len(tags))
+	sdkTags := make([]ec2types.Tag, 0, len(tags))
 
 	for _, key := range sets.StringKeySet(tags).List() {
-		sdkTags = append(sdkTags, &ec2sdk.Tag{
+		sdkTags = append(sdkTags, ec2types.Tag{
 			Key:   awssdk.String(key),
 			Value: awssdk.String(tags[key]),
 		})
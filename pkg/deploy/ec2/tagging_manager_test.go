@@ -4,8 +4,9 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
@@ -39,10 +40,11 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 		opts        []ReconcileTagsOption
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr error
+		name     string
+		fields   fields
+		args     args
+		additive bool
+		wantErr  error
 	}{
 		{
 			name: "standard case - add/update/remove tags",
@@ -50,8 +52,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				createTagsWithContextCalls: []createTagsWithContextCall{
 					{
 						req: &ec2sdk.CreateTagsInput{
-							Resources: awssdk.StringSlice([]string{"sg-a"}),
-							Tags: []*ec2sdk.Tag{
+							Resources: []string{"sg-a"},
+							Tags: []ec2types.Tag{
 								{
 									Key:   awssdk.String("keyB"),
 									Value: awssdk.String("valueB2"),
@@ -67,8 +69,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				deleteTagsWithContextCalls: []deleteTagsWithContextCall{
 					{
 						req: &ec2sdk.DeleteTagsInput{
-							Resources: awssdk.StringSlice([]string{"sg-a"}),
-							Tags: []*ec2sdk.Tag{
+							Resources: []string{"sg-a"},
+							Tags: []ec2types.Tag{
 								{
 									Key:   awssdk.String("keyC"),
 									Value: awssdk.String("valueC"),
@@ -101,8 +103,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				createTagsWithContextCalls: []createTagsWithContextCall{
 					{
 						req: &ec2sdk.CreateTagsInput{
-							Resources: awssdk.StringSlice([]string{"sg-a"}),
-							Tags: []*ec2sdk.Tag{
+							Resources: []string{"sg-a"},
+							Tags: []ec2types.Tag{
 								{
 									Key:   awssdk.String("keyC"),
 									Value: awssdk.String("valueC2"),
@@ -118,8 +120,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				deleteTagsWithContextCalls: []deleteTagsWithContextCall{
 					{
 						req: &ec2sdk.DeleteTagsInput{
-							Resources: awssdk.StringSlice([]string{"sg-a"}),
-							Tags: []*ec2sdk.Tag{
+							Resources: []string{"sg-a"},
+							Tags: []ec2types.Tag{
 								{
 									Key:   awssdk.String("keyF"),
 									Value: awssdk.String("valueF"),
@@ -150,6 +152,46 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "additive tag enforcement - add/update tags only",
+			fields: fields{
+				createTagsWithContextCalls: []createTagsWithContextCall{
+					{
+						req: &ec2sdk.CreateTagsInput{
+							Resources: []string{"sg-a"},
+							Tags: []ec2types.Tag{
+								{
+									Key:   awssdk.String("keyB"),
+									Value: awssdk.String("valueB2"),
+								},
+								{
+									Key:   awssdk.String("keyD"),
+									Value: awssdk.String("valueD"),
+								},
+							},
+						},
+					},
+				},
+				deleteTagsWithContextCalls: nil,
+			},
+			args: args{
+				resID: "sg-a",
+				desiredTags: map[string]string{
+					"keyA": "valueA",
+					"keyB": "valueB2",
+					"keyD": "valueD",
+				},
+				opts: []ReconcileTagsOption{
+					WithCurrentTags(map[string]string{
+						"keyA": "valueA",
+						"keyB": "valueB",
+						"keyC": "valueC",
+					}),
+				},
+			},
+			additive: true,
+			wantErr:  nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -164,8 +206,9 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 			}
 
 			m := &defaultTaggingManager{
-				ec2Client: ec2Client,
-				logger:    logr.New(&log.NullLogSink{}),
+				ec2Client:            ec2Client,
+				strictTagEnforcement: !tt.additive,
+				logger:               logr.New(&log.NullLogSink{}),
 			}
 			err := m.ReconcileTags(context.Background(), tt.args.resID, tt.args.desiredTags, tt.args.opts...)
 			if tt.wantErr != nil {
@@ -200,22 +243,22 @@ func Test_defaultTaggingManager_ListSecurityGroups(t *testing.T) {
 				fetchSGInfosByRequestCalls: []fetchSGInfosByRequestCall{
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-xxxxxxx"}),
+									Values: []string{"vpc-xxxxxxx"},
 								},
 								{
 									Name:   awssdk.String("tag:keyA"),
-									Values: awssdk.StringSlice([]string{"valueA"}),
+									Values: []string{"valueA"},
 								},
 								{
 									Name:   awssdk.String("tag:keyB"),
-									Values: awssdk.StringSlice([]string{"valueB1", "valueB2"}),
+									Values: []string{"valueB1", "valueB2"},
 								},
 								{
 									Name:   awssdk.String("tag-key"),
-									Values: awssdk.StringSlice([]string{"keyC"}),
+									Values: []string{"keyC"},
 								},
 							},
 						},
@@ -278,22 +321,22 @@ func Test_defaultTaggingManager_ListSecurityGroups(t *testing.T) {
 				fetchSGInfosByRequestCalls: []fetchSGInfosByRequestCall{
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-xxxxxxx"}),
+									Values: []string{"vpc-xxxxxxx"},
 								},
 								{
 									Name:   awssdk.String("tag:keyA"),
-									Values: awssdk.StringSlice([]string{"valueA"}),
+									Values: []string{"valueA"},
 								},
 								{
 									Name:   awssdk.String("tag:keyB"),
-									Values: awssdk.StringSlice([]string{"valueB1", "valueB2"}),
+									Values: []string{"valueB1", "valueB2"},
 								},
 								{
 									Name:   awssdk.String("tag-key"),
-									Values: awssdk.StringSlice([]string{"keyC"}),
+									Values: []string{"keyC"},
 								},
 							},
 						},
@@ -320,22 +363,22 @@ func Test_defaultTaggingManager_ListSecurityGroups(t *testing.T) {
 					},
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-xxxxxxx"}),
+									Values: []string{"vpc-xxxxxxx"},
 								},
 								{
 									Name:   awssdk.String("tag:keyA"),
-									Values: awssdk.StringSlice([]string{"valueA"}),
+									Values: []string{"valueA"},
 								},
 								{
 									Name:   awssdk.String("tag:keyB"),
-									Values: awssdk.StringSlice([]string{"valueB2", "valueB3"}),
+									Values: []string{"valueB2", "valueB3"},
 								},
 								{
 									Name:   awssdk.String("tag-key"),
-									Values: awssdk.StringSlice([]string{"keyC"}),
+									Values: []string{"keyC"},
 								},
 							},
 						},
@@ -441,7 +484,7 @@ func Test_convertTagsToSDKTags(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want []*ec2sdk.Tag
+		want []ec2types.Tag
 	}{
 		{
 			name: "non-empty tags",
@@ -451,7 +494,7 @@ func Test_convertTagsToSDKTags(t *testing.T) {
 					"keyB": "valueB",
 				},
 			},
-			want: []*ec2sdk.Tag{
+			want: []ec2types.Tag{
 				{
 					Key:   awssdk.String("keyA"),
 					Value: awssdk.String("valueA"),
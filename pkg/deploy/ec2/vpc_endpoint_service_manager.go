@@ -0,0 +1,231 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+)
+
+// VPCEndpointServiceManager is responsible for create/update/delete VPCEndpointService resources.
+type VPCEndpointServiceManager interface {
+	Create(ctx context.Context, resES *ec2model.VPCEndpointService) (ec2model.VPCEndpointServiceStatus, error)
+
+	Update(ctx context.Context, resES *ec2model.VPCEndpointService, sdkES VPCEndpointServiceWithTags) (ec2model.VPCEndpointServiceStatus, error)
+
+	Delete(ctx context.Context, sdkES VPCEndpointServiceWithTags) error
+}
+
+// NewDefaultVPCEndpointServiceManager constructs new defaultVPCEndpointServiceManager.
+func NewDefaultVPCEndpointServiceManager(ec2Client services.EC2, trackingProvider tracking.Provider, taggingManager TaggingManager,
+	externalManagedTags []string, logger logr.Logger) *defaultVPCEndpointServiceManager {
+	return &defaultVPCEndpointServiceManager{
+		ec2Client:           ec2Client,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+	}
+}
+
+var _ VPCEndpointServiceManager = &defaultVPCEndpointServiceManager{}
+
+// default implementation for VPCEndpointServiceManager.
+type defaultVPCEndpointServiceManager struct {
+	ec2Client           services.EC2
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+	logger              logr.Logger
+}
+
+func (m *defaultVPCEndpointServiceManager) Create(ctx context.Context, resES *ec2model.VPCEndpointService) (ec2model.VPCEndpointServiceStatus, error) {
+	esTags := m.trackingProvider.ResourceTags(resES.Stack(), resES, resES.Spec.Tags)
+	nlbARNs, err := resolveNetworkLoadBalancerARNs(ctx, resES)
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	req := &ec2sdk.CreateVpcEndpointServiceConfigurationInput{
+		AcceptanceRequired:      awssdk.Bool(resES.Spec.AcceptanceRequired),
+		NetworkLoadBalancerArns: nlbARNs,
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeVpcEndpointService,
+				Tags:         convertTagsToSDKTags(esTags),
+			},
+		},
+	}
+	m.logger.Info("creating vpcEndpointService",
+		"resourceID", resES.ID())
+	resp, err := m.ec2Client.CreateVpcEndpointServiceConfigurationWithContext(ctx, req)
+	if err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	esID := awssdk.ToString(resp.ServiceConfiguration.ServiceId)
+	m.logger.Info("created vpcEndpointService",
+		"resourceID", resES.ID(),
+		"serviceID", esID)
+	audit.RecordMutation(ctx, audit.ActionCreate, "vpcEndpointService", esID, "")
+
+	if err := m.reconcileAllowedPrincipals(ctx, esID, resES.Spec.AllowedPrincipals); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	return ec2model.VPCEndpointServiceStatus{
+		ServiceID: esID,
+	}, nil
+}
+
+func (m *defaultVPCEndpointServiceManager) Update(ctx context.Context, resES *ec2model.VPCEndpointService, sdkES VPCEndpointServiceWithTags) (ec2model.VPCEndpointServiceStatus, error) {
+	esID := awssdk.ToString(sdkES.ServiceConfiguration.ServiceId)
+	if err := m.updateSDKVPCEndpointServiceWithTags(ctx, resES, sdkES); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	if err := m.updateSDKVPCEndpointServiceConfiguration(ctx, resES, sdkES); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	if err := m.reconcileAllowedPrincipals(ctx, esID, resES.Spec.AllowedPrincipals); err != nil {
+		return ec2model.VPCEndpointServiceStatus{}, err
+	}
+	return ec2model.VPCEndpointServiceStatus{
+		ServiceID: esID,
+	}, nil
+}
+
+func (m *defaultVPCEndpointServiceManager) Delete(ctx context.Context, sdkES VPCEndpointServiceWithTags) error {
+	esID := awssdk.ToString(sdkES.ServiceConfiguration.ServiceId)
+	req := &ec2sdk.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: []string{esID},
+	}
+	m.logger.Info("deleting vpcEndpointService",
+		"serviceID", esID)
+	resp, err := m.ec2Client.DeleteVpcEndpointServiceConfigurationsWithContext(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete vpcEndpointService")
+	}
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return errors.Errorf("failed to delete vpcEndpointService %v: %v: %v", esID,
+				awssdk.ToString(item.Error.Code), awssdk.ToString(item.Error.Message))
+		}
+	}
+	m.logger.Info("deleted vpcEndpointService",
+		"serviceID", esID)
+	audit.RecordMutation(ctx, audit.ActionDelete, "vpcEndpointService", esID, "")
+	return nil
+}
+
+func (m *defaultVPCEndpointServiceManager) updateSDKVPCEndpointServiceWithTags(ctx context.Context, resES *ec2model.VPCEndpointService, sdkES VPCEndpointServiceWithTags) error {
+	desiredESTags := m.trackingProvider.ResourceTags(resES.Stack(), resES, resES.Spec.Tags)
+	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkES.ServiceConfiguration.ServiceId), desiredESTags,
+		WithCurrentTags(sdkES.Tags),
+		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
+		WithIgnoredTagKeys(m.externalManagedTags))
+}
+
+func (m *defaultVPCEndpointServiceManager) updateSDKVPCEndpointServiceConfiguration(ctx context.Context, resES *ec2model.VPCEndpointService, sdkES VPCEndpointServiceWithTags) error {
+	esID := awssdk.ToString(sdkES.ServiceConfiguration.ServiceId)
+	desiredNLBARNs, err := resolveNetworkLoadBalancerARNs(ctx, resES)
+	if err != nil {
+		return err
+	}
+	desiredNLBARNSet := sets.NewString(desiredNLBARNs...)
+	currentNLBARNSet := sets.NewString(sdkES.ServiceConfiguration.NetworkLoadBalancerArns...)
+	nlbARNsToAdd := desiredNLBARNSet.Difference(currentNLBARNSet)
+	nlbARNsToRemove := currentNLBARNSet.Difference(desiredNLBARNSet)
+	acceptanceRequiredDrifted := awssdk.ToBool(sdkES.ServiceConfiguration.AcceptanceRequired) != resES.Spec.AcceptanceRequired
+	if nlbARNsToAdd.Len() == 0 && nlbARNsToRemove.Len() == 0 && !acceptanceRequiredDrifted {
+		return nil
+	}
+
+	req := &ec2sdk.ModifyVpcEndpointServiceConfigurationInput{
+		ServiceId: awssdk.String(esID),
+	}
+	if acceptanceRequiredDrifted {
+		req.AcceptanceRequired = awssdk.Bool(resES.Spec.AcceptanceRequired)
+	}
+	if nlbARNsToAdd.Len() != 0 {
+		req.AddNetworkLoadBalancerArns = nlbARNsToAdd.List()
+	}
+	if nlbARNsToRemove.Len() != 0 {
+		req.RemoveNetworkLoadBalancerArns = nlbARNsToRemove.List()
+	}
+	m.logger.Info("modifying vpcEndpointService",
+		"serviceID", esID,
+		"acceptanceRequired", resES.Spec.AcceptanceRequired,
+		"addNetworkLoadBalancerARNs", nlbARNsToAdd.List(),
+		"removeNetworkLoadBalancerARNs", nlbARNsToRemove.List())
+	if _, err := m.ec2Client.ModifyVpcEndpointServiceConfigurationWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified vpcEndpointService",
+		"serviceID", esID)
+	audit.RecordMutation(ctx, audit.ActionModify, "vpcEndpointService", esID,
+		fmt.Sprintf("acceptanceRequired %v, added networkLoadBalancers %v, removed networkLoadBalancers %v",
+			resES.Spec.AcceptanceRequired, nlbARNsToAdd.List(), nlbARNsToRemove.List()))
+	return nil
+}
+
+// reconcileAllowedPrincipals reconciles the principals allowed to discover and connect to the VPC Endpoint Service.
+// principals that are allowed outside of controller are revoked, so that the allowlist never drifts from the desired one.
+func (m *defaultVPCEndpointServiceManager) reconcileAllowedPrincipals(ctx context.Context, esID string, allowedPrincipals []string) error {
+	req := &ec2sdk.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: awssdk.String(esID),
+	}
+	sdkAllowedPrincipals, err := m.ec2Client.DescribeVpcEndpointServicePermissionsAsList(ctx, req)
+	if err != nil {
+		return err
+	}
+	currentPrincipals := sets.NewString()
+	for _, sdkAllowedPrincipal := range sdkAllowedPrincipals {
+		currentPrincipals.Insert(awssdk.ToString(sdkAllowedPrincipal.Principal))
+	}
+	desiredPrincipals := sets.NewString(allowedPrincipals...)
+	principalsToAdd := desiredPrincipals.Difference(currentPrincipals)
+	principalsToRemove := currentPrincipals.Difference(desiredPrincipals)
+	if principalsToAdd.Len() == 0 && principalsToRemove.Len() == 0 {
+		return nil
+	}
+
+	modifyReq := &ec2sdk.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId: awssdk.String(esID),
+	}
+	if principalsToAdd.Len() != 0 {
+		modifyReq.AddAllowedPrincipals = principalsToAdd.List()
+	}
+	if principalsToRemove.Len() != 0 {
+		modifyReq.RemoveAllowedPrincipals = principalsToRemove.List()
+	}
+	m.logger.Info("modifying vpcEndpointService permissions",
+		"serviceID", esID,
+		"addAllowedPrincipals", principalsToAdd.List(),
+		"removeAllowedPrincipals", principalsToRemove.List())
+	if _, err := m.ec2Client.ModifyVpcEndpointServicePermissionsWithContext(ctx, modifyReq); err != nil {
+		return err
+	}
+	m.logger.Info("modified vpcEndpointService permissions",
+		"serviceID", esID)
+	audit.RecordMutation(ctx, audit.ActionModify, "vpcEndpointService", esID,
+		fmt.Sprintf("allowed principals %v, revoked principals %v", principalsToAdd.List(), principalsToRemove.List()))
+	return nil
+}
+
+func resolveNetworkLoadBalancerARNs(ctx context.Context, resES *ec2model.VPCEndpointService) ([]string, error) {
+	nlbARNs := make([]string, 0, len(resES.Spec.NetworkLoadBalancerARNs))
+	for _, nlbARNToken := range resES.Spec.NetworkLoadBalancerARNs {
+		nlbARN, err := nlbARNToken.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		nlbARNs = append(nlbARNs, nlbARN)
+	}
+	return nlbARNs, nil
+}
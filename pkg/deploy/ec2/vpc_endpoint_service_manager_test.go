@@ -0,0 +1,237 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultVPCEndpointServiceManager_reconcileAllowedPrincipals(t *testing.T) {
+	type describeVpcEndpointServicePermissionsAsListCall struct {
+		resp []ec2types.AllowedPrincipal
+		err  error
+	}
+	type modifyVpcEndpointServicePermissionsWithContextCall struct {
+		req *ec2sdk.ModifyVpcEndpointServicePermissionsInput
+		err error
+	}
+	type args struct {
+		esID              string
+		allowedPrincipals []string
+	}
+	tests := []struct {
+		name                                                string
+		describeVpcEndpointServicePermissionsAsListCalls    []describeVpcEndpointServicePermissionsAsListCall
+		modifyVpcEndpointServicePermissionsWithContextCalls []modifyVpcEndpointServicePermissionsWithContextCall
+		args                                                args
+		wantErr                                             error
+	}{
+		{
+			name: "allowed principals are in sync",
+			describeVpcEndpointServicePermissionsAsListCalls: []describeVpcEndpointServicePermissionsAsListCall{
+				{
+					resp: []ec2types.AllowedPrincipal{
+						{Principal: awssdk.String("arn:aws:iam::123456789012:root")},
+					},
+				},
+			},
+			args: args{
+				esID:              "vpce-svc-xxxx",
+				allowedPrincipals: []string{"arn:aws:iam::123456789012:root"},
+			},
+		},
+		{
+			name: "missing principals are allowed and extra principals are revoked",
+			describeVpcEndpointServicePermissionsAsListCalls: []describeVpcEndpointServicePermissionsAsListCall{
+				{
+					resp: []ec2types.AllowedPrincipal{
+						{Principal: awssdk.String("arn:aws:iam::123456789012:root")},
+						{Principal: awssdk.String("arn:aws:iam::210987654321:root")},
+					},
+				},
+			},
+			modifyVpcEndpointServicePermissionsWithContextCalls: []modifyVpcEndpointServicePermissionsWithContextCall{
+				{
+					req: &ec2sdk.ModifyVpcEndpointServicePermissionsInput{
+						ServiceId:               awssdk.String("vpce-svc-xxxx"),
+						AddAllowedPrincipals:    []string{"arn:aws:iam::111122223333:role/awesome-role"},
+						RemoveAllowedPrincipals: []string{"arn:aws:iam::210987654321:root"},
+					},
+				},
+			},
+			args: args{
+				esID:              "vpce-svc-xxxx",
+				allowedPrincipals: []string{"arn:aws:iam::123456789012:root", "arn:aws:iam::111122223333:role/awesome-role"},
+			},
+		},
+		{
+			name: "all principals are revoked",
+			describeVpcEndpointServicePermissionsAsListCalls: []describeVpcEndpointServicePermissionsAsListCall{
+				{
+					resp: []ec2types.AllowedPrincipal{
+						{Principal: awssdk.String("*")},
+					},
+				},
+			},
+			modifyVpcEndpointServicePermissionsWithContextCalls: []modifyVpcEndpointServicePermissionsWithContextCall{
+				{
+					req: &ec2sdk.ModifyVpcEndpointServicePermissionsInput{
+						ServiceId:               awssdk.String("vpce-svc-xxxx"),
+						RemoveAllowedPrincipals: []string{"*"},
+					},
+				},
+			},
+			args: args{
+				esID: "vpce-svc-xxxx",
+			},
+		},
+		{
+			name: "describe permissions failed",
+			describeVpcEndpointServicePermissionsAsListCalls: []describeVpcEndpointServicePermissionsAsListCall{
+				{
+					err: errors.New("some error"),
+				},
+			},
+			args: args{
+				esID:              "vpce-svc-xxxx",
+				allowedPrincipals: []string{"*"},
+			},
+			wantErr: errors.New("some error"),
+		},
+		{
+			name: "modify permissions failed",
+			describeVpcEndpointServicePermissionsAsListCalls: []describeVpcEndpointServicePermissionsAsListCall{
+				{
+					resp: nil,
+				},
+			},
+			modifyVpcEndpointServicePermissionsWithContextCalls: []modifyVpcEndpointServicePermissionsWithContextCall{
+				{
+					req: &ec2sdk.ModifyVpcEndpointServicePermissionsInput{
+						ServiceId:            awssdk.String("vpce-svc-xxxx"),
+						AddAllowedPrincipals: []string{"*"},
+					},
+					err: errors.New("some error"),
+				},
+			},
+			args: args{
+				esID:              "vpce-svc-xxxx",
+				allowedPrincipals: []string{"*"},
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.describeVpcEndpointServicePermissionsAsListCalls {
+				ec2Client.EXPECT().DescribeVpcEndpointServicePermissionsAsList(gomock.Any(), &ec2sdk.DescribeVpcEndpointServicePermissionsInput{
+					ServiceId: awssdk.String(tt.args.esID),
+				}).Return(call.resp, call.err)
+			}
+			for _, call := range tt.modifyVpcEndpointServicePermissionsWithContextCalls {
+				ec2Client.EXPECT().ModifyVpcEndpointServicePermissionsWithContext(gomock.Any(), call.req).Return(&ec2sdk.ModifyVpcEndpointServicePermissionsOutput{}, call.err)
+			}
+
+			m := &defaultVPCEndpointServiceManager{
+				ec2Client: ec2Client,
+				logger:    logr.New(&log.NullLogSink{}),
+			}
+			err := m.reconcileAllowedPrincipals(context.Background(), tt.args.esID, tt.args.allowedPrincipals)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_defaultVPCEndpointServiceManager_Delete(t *testing.T) {
+	type deleteVpcEndpointServiceConfigurationsWithContextCall struct {
+		resp *ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput
+		err  error
+	}
+	tests := []struct {
+		name                                                   string
+		deleteVpcEndpointServiceConfigurationsWithContextCalls []deleteVpcEndpointServiceConfigurationsWithContextCall
+		wantErr                                                error
+	}{
+		{
+			name: "delete succeeded",
+			deleteVpcEndpointServiceConfigurationsWithContextCalls: []deleteVpcEndpointServiceConfigurationsWithContextCall{
+				{
+					resp: &ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput{},
+				},
+			},
+		},
+		{
+			name: "delete failed",
+			deleteVpcEndpointServiceConfigurationsWithContextCalls: []deleteVpcEndpointServiceConfigurationsWithContextCall{
+				{
+					err: errors.New("some error"),
+				},
+			},
+			wantErr: errors.New("failed to delete vpcEndpointService: some error"),
+		},
+		{
+			name: "delete unsuccessful",
+			deleteVpcEndpointServiceConfigurationsWithContextCalls: []deleteVpcEndpointServiceConfigurationsWithContextCall{
+				{
+					resp: &ec2sdk.DeleteVpcEndpointServiceConfigurationsOutput{
+						Unsuccessful: []ec2types.UnsuccessfulItem{
+							{
+								ResourceId: awssdk.String("vpce-svc-xxxx"),
+								Error: &ec2types.UnsuccessfulItemError{
+									Code:    awssdk.String("ExistingVpcEndpointConnections"),
+									Message: awssdk.String("Service has existing active VPC Endpoint connections"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("failed to delete vpcEndpointService vpce-svc-xxxx: ExistingVpcEndpointConnections: Service has existing active VPC Endpoint connections"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.deleteVpcEndpointServiceConfigurationsWithContextCalls {
+				ec2Client.EXPECT().DeleteVpcEndpointServiceConfigurationsWithContext(gomock.Any(), &ec2sdk.DeleteVpcEndpointServiceConfigurationsInput{
+					ServiceIds: []string{"vpce-svc-xxxx"},
+				}).Return(call.resp, call.err)
+			}
+
+			m := &defaultVPCEndpointServiceManager{
+				ec2Client: ec2Client,
+				logger:    logr.New(&log.NullLogSink{}),
+			}
+			err := m.Delete(context.Background(), VPCEndpointServiceWithTags{
+				ServiceConfiguration: &ec2types.ServiceConfiguration{
+					ServiceId: awssdk.String("vpce-svc-xxxx"),
+				},
+			})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package ec2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+)
+
+// NewVPCEndpointServiceSynthesizer constructs new vpcEndpointServiceSynthesizer.
+func NewVPCEndpointServiceSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	esManager VPCEndpointServiceManager, logger logr.Logger, stack core.Stack) *vpcEndpointServiceSynthesizer {
+	return &vpcEndpointServiceSynthesizer{
+		trackingProvider: trackingProvider,
+		taggingManager:   taggingManager,
+		esManager:        esManager,
+		logger:           logger,
+		stack:            stack,
+	}
+}
+
+type vpcEndpointServiceSynthesizer struct {
+	trackingProvider tracking.Provider
+	taggingManager   TaggingManager
+	esManager        VPCEndpointServiceManager
+	logger           logr.Logger
+
+	stack               core.Stack
+	matchedResAndSDKESs []resAndSDKVPCEndpointServicePair
+	unmatchedResESs     []*ec2model.VPCEndpointService
+}
+
+func (s *vpcEndpointServiceSynthesizer) Synthesize(ctx context.Context) error {
+	var resESs []*ec2model.VPCEndpointService
+	s.stack.ListResources(&resESs)
+	sdkESs, err := s.findSDKVPCEndpointServices(ctx)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKESs, unmatchedResESs, unmatchedSDKESs, err := matchResAndSDKVPCEndpointServices(resESs, sdkESs, s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For VPCEndpointService, we delete unmatched ones during synthesize,
+	// since a LoadBalancer cannot be deleted while it's used by a VPCEndpointService.
+	for _, sdkES := range unmatchedSDKESs {
+		if err := s.esManager.Delete(ctx, sdkES); err != nil {
+			return err
+		}
+	}
+	// For VPCEndpointService, we create and update matched ones during post synthesize,
+	// since the LoadBalancers are fulfilled by then.
+	s.matchedResAndSDKESs = matchedResAndSDKESs
+	s.unmatchedResESs = unmatchedResESs
+	return nil
+}
+
+func (s *vpcEndpointServiceSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, resES := range s.unmatchedResESs {
+		esStatus, err := s.esManager.Create(ctx, resES)
+		if err != nil {
+			return err
+		}
+		resES.SetStatus(esStatus)
+	}
+	for _, resAndSDKES := range s.matchedResAndSDKESs {
+		esStatus, err := s.esManager.Update(ctx, resAndSDKES.resES, resAndSDKES.sdkES)
+		if err != nil {
+			return err
+		}
+		resAndSDKES.resES.SetStatus(esStatus)
+	}
+	return nil
+}
+
+// findSDKVPCEndpointServices will find all AWS VPCEndpointServices created for stack.
+func (s *vpcEndpointServiceSynthesizer) findSDKVPCEndpointServices(ctx context.Context) ([]VPCEndpointServiceWithTags, error) {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	return s.taggingManager.ListVPCEndpointServices(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKVPCEndpointServicePair struct {
+	resES *ec2model.VPCEndpointService
+	sdkES VPCEndpointServiceWithTags
+}
+
+func matchResAndSDKVPCEndpointServices(resESs []*ec2model.VPCEndpointService, sdkESs []VPCEndpointServiceWithTags,
+	resourceIDTagKey string) ([]resAndSDKVPCEndpointServicePair, []*ec2model.VPCEndpointService, []VPCEndpointServiceWithTags, error) {
+	var matchedResAndSDKESs []resAndSDKVPCEndpointServicePair
+	var unmatchedResESs []*ec2model.VPCEndpointService
+	var unmatchedSDKESs []VPCEndpointServiceWithTags
+
+	resESsByID := make(map[string]*ec2model.VPCEndpointService, len(resESs))
+	for _, resES := range resESs {
+		resESsByID[resES.ID()] = resES
+	}
+	sdkESsByID := make(map[string][]VPCEndpointServiceWithTags, len(sdkESs))
+	for _, sdkES := range sdkESs {
+		resourceID, ok := sdkES.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, nil, nil, errors.Errorf("unexpected vpcEndpointService with no resourceID: %v",
+				awssdk.StringValue(sdkES.ServiceConfiguration.ServiceId))
+		}
+		sdkESsByID[resourceID] = append(sdkESsByID[resourceID], sdkES)
+	}
+
+	resESIDs := sets.StringKeySet(resESsByID)
+	sdkESIDs := sets.StringKeySet(sdkESsByID)
+	for _, resID := range resESIDs.Intersection(sdkESIDs).List() {
+		resES := resESsByID[resID]
+		sdkESs := sdkESsByID[resID]
+		matchedResAndSDKESs = append(matchedResAndSDKESs, resAndSDKVPCEndpointServicePair{
+			resES: resES,
+			sdkES: sdkESs[0],
+		})
+		unmatchedSDKESs = append(unmatchedSDKESs, sdkESs[1:]...)
+	}
+	for _, resID := range resESIDs.Difference(sdkESIDs).List() {
+		unmatchedResESs = append(unmatchedResESs, resESsByID[resID])
+	}
+	for _, resID := range sdkESIDs.Difference(resESIDs).List() {
+		unmatchedSDKESs = append(unmatchedSDKESs, sdkESsByID[resID]...)
+	}
+	return matchedResAndSDKESs, unmatchedResESs, unmatchedSDKESs, nil
+}
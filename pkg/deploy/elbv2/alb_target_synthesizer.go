@@ -0,0 +1,113 @@
+package elbv2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewALBTargetSynthesizer constructs albTargetSynthesizer
+func NewALBTargetSynthesizer(elbv2Client services.ELBV2, logger logr.Logger, stack core.Stack) *albTargetSynthesizer {
+	return &albTargetSynthesizer{
+		elbv2Client: elbv2Client,
+		logger:      logger,
+		stack:       stack,
+	}
+}
+
+// albTargetSynthesizer is responsible for synthesize ALBTarget resources types for certain stack.
+// The TargetGroups with alb target type are owned by stack, so targets other than the desired one are deregistered.
+type albTargetSynthesizer struct {
+	elbv2Client services.ELBV2
+	logger      logr.Logger
+
+	stack core.Stack
+}
+
+func (s *albTargetSynthesizer) Synthesize(ctx context.Context) error {
+	var resALBTargets []*elbv2model.ALBTarget
+	s.stack.ListResources(&resALBTargets)
+	for _, resALBTarget := range resALBTargets {
+		if err := s.synthesizeALBTarget(ctx, resALBTarget); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *albTargetSynthesizer) PostSynthesize(ctx context.Context) error {
+	// nothing to do here.
+	return nil
+}
+
+func (s *albTargetSynthesizer) synthesizeALBTarget(ctx context.Context, resALBTarget *elbv2model.ALBTarget) error {
+	tgARN, err := resALBTarget.Spec.TargetGroupARN.Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	lbARN, err := resALBTarget.Spec.LoadBalancerARN.Resolve(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := s.elbv2Client.DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	})
+	if err != nil {
+		return err
+	}
+
+	desiredTargetRegistered := false
+	var targetsToDeregister []elbv2types.TargetDescription
+	for _, targetHealth := range resp.TargetHealthDescriptions {
+		target := targetHealth.Target
+		if awssdk.ToString(target.Id) == lbARN && int64(awssdk.ToInt32(target.Port)) == resALBTarget.Spec.Port {
+			desiredTargetRegistered = true
+			continue
+		}
+		targetsToDeregister = append(targetsToDeregister, elbv2types.TargetDescription{
+			Id:   target.Id,
+			Port: target.Port,
+		})
+	}
+
+	// a TargetGroup with alb target type can only have a single target registered.
+	if len(targetsToDeregister) != 0 {
+		s.logger.Info("deregistering targets",
+			"arn", tgARN,
+			"targets", targetsToDeregister)
+		if _, err := s.elbv2Client.DeregisterTargetsWithContext(ctx, &elbv2sdk.DeregisterTargetsInput{
+			TargetGroupArn: awssdk.String(tgARN),
+			Targets:        targetsToDeregister,
+		}); err != nil {
+			return err
+		}
+		s.logger.Info("deregistered targets",
+			"arn", tgARN)
+	}
+	if !desiredTargetRegistered {
+		s.logger.Info("registering ALB target",
+			"arn", tgARN,
+			"loadBalancerARN", lbARN,
+			"port", resALBTarget.Spec.Port)
+		if _, err := s.elbv2Client.RegisterTargetsWithContext(ctx, &elbv2sdk.RegisterTargetsInput{
+			TargetGroupArn: awssdk.String(tgARN),
+			Targets: []elbv2types.TargetDescription{
+				{
+					Id:   awssdk.String(lbARN),
+					Port: awssdk.Int32(int32(resALBTarget.Spec.Port)),
+				},
+			},
+		}); err != nil {
+			return err
+		}
+		s.logger.Info("registered ALB target",
+			"arn", tgARN)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_albTargetSynthesizer_Synthesize(t *testing.T) {
+	type registerTargetsCall struct {
+		req *elbv2sdk.RegisterTargetsInput
+	}
+	type deregisterTargetsCall struct {
+		req *elbv2sdk.DeregisterTargetsInput
+	}
+	tests := []struct {
+		name                   string
+		currentTargets         []elbv2types.TargetHealthDescription
+		registerTargetsCalls   []registerTargetsCall
+		deregisterTargetsCalls []deregisterTargetsCall
+	}{
+		{
+			name: "register ALB target",
+			registerTargetsCalls: []registerTargetsCall{
+				{
+					req: &elbv2sdk.RegisterTargetsInput{
+						TargetGroupArn: awssdk.String("tg-arn"),
+						Targets: []elbv2types.TargetDescription{
+							{
+								Id:   awssdk.String("alb-arn"),
+								Port: awssdk.Int32(443),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ALB target already registered",
+			currentTargets: []elbv2types.TargetHealthDescription{
+				{
+					Target: &elbv2types.TargetDescription{
+						Id:   awssdk.String("alb-arn"),
+						Port: awssdk.Int32(443),
+					},
+				},
+			},
+		},
+		{
+			name: "replace ALB target registered with different port",
+			currentTargets: []elbv2types.TargetHealthDescription{
+				{
+					Target: &elbv2types.TargetDescription{
+						Id:   awssdk.String("alb-arn"),
+						Port: awssdk.Int32(80),
+					},
+				},
+			},
+			deregisterTargetsCalls: []deregisterTargetsCall{
+				{
+					req: &elbv2sdk.DeregisterTargetsInput{
+						TargetGroupArn: awssdk.String("tg-arn"),
+						Targets: []elbv2types.TargetDescription{
+							{
+								Id:   awssdk.String("alb-arn"),
+								Port: awssdk.Int32(80),
+							},
+						},
+					},
+				},
+			},
+			registerTargetsCalls: []registerTargetsCall{
+				{
+					req: &elbv2sdk.RegisterTargetsInput{
+						TargetGroupArn: awssdk.String("tg-arn"),
+						Targets: []elbv2types.TargetDescription{
+							{
+								Id:   awssdk.String("alb-arn"),
+								Port: awssdk.Int32(443),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), &elbv2sdk.DescribeTargetHealthInput{
+				TargetGroupArn: awssdk.String("tg-arn"),
+			}).Return(&elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: tt.currentTargets}, nil)
+			for _, call := range tt.deregisterTargetsCalls {
+				elbv2Client.EXPECT().DeregisterTargetsWithContext(gomock.Any(), call.req).Return(&elbv2sdk.DeregisterTargetsOutput{}, nil)
+			}
+			for _, call := range tt.registerTargetsCalls {
+				elbv2Client.EXPECT().RegisterTargetsWithContext(gomock.Any(), call.req).Return(&elbv2sdk.RegisterTargetsOutput{}, nil)
+			}
+
+			stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Name: "awesome-group"}))
+			elbv2model.NewALBTarget(stack, "FrontendNlb-443", elbv2model.ALBTargetSpec{
+				TargetGroupARN:  core.LiteralStringToken("tg-arn"),
+				LoadBalancerARN: core.LiteralStringToken("alb-arn"),
+				Port:            443,
+			})
+			s := NewALBTargetSynthesizer(elbv2Client, logr.New(&log.NullLogSink{}), stack)
+			assert.NoError(t, s.Synthesize(context.Background()))
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+)
+
+const (
+	attrCrossZoneEnabled = "load_balancing.cross_zone.enabled"
+	// TargetGroup level cross-zone attribute value to inherit the LoadBalancer level setting.
+	crossZoneUseLoadBalancerConfiguration = "use_load_balancer_configuration"
+	// target availability zone value denoting the target receives traffic from all availability zones.
+	targetAZAll = "all"
+)
+
+// CrossZoneValidator validates that disabling cross-zone load balancing won't leave an availability zone enabled on a LoadBalancer
+// without healthy targets, traffic routed to such availability zone would be dropped.
+type CrossZoneValidator interface {
+	// ValidateLoadBalancer validates disabling cross-zone load balancing on LoadBalancer.
+	ValidateLoadBalancer(ctx context.Context, sdkLB *elbv2types.LoadBalancer) error
+
+	// ValidateTargetGroup validates changing the cross-zone load balancing attribute of TargetGroup from currentValue to desiredValue.
+	ValidateTargetGroup(ctx context.Context, tgARN string, currentValue string, desiredValue string) error
+}
+
+// NewDefaultCrossZoneValidator constructs new defaultCrossZoneValidator.
+func NewDefaultCrossZoneValidator(elbv2Client services.ELBV2, ec2Client services.EC2, mode string, logger logr.Logger) *defaultCrossZoneValidator {
+	return &defaultCrossZoneValidator{
+		elbv2Client: elbv2Client,
+		ec2Client:   ec2Client,
+		mode:        mode,
+		logger:      logger,
+	}
+}
+
+var _ CrossZoneValidator = &defaultCrossZoneValidator{}
+
+// default implementation for CrossZoneValidator.
+type defaultCrossZoneValidator struct {
+	elbv2Client services.ELBV2
+	ec2Client   services.EC2
+	// mode is one of config.CrossZoneDisableValidationModeXXX.
+	mode   string
+	logger logr.Logger
+}
+
+func (v *defaultCrossZoneValidator) ValidateLoadBalancer(ctx context.Context, sdkLB *elbv2types.LoadBalancer) error {
+	if v.mode == config.CrossZoneDisableValidationModeNone {
+		return nil
+	}
+	tgs, err := v.elbv2Client.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		LoadBalancerArn: sdkLB.LoadBalancerArn,
+	})
+	if err != nil {
+		return err
+	}
+	var violations []string
+	for i := range tgs {
+		tg := &tgs[i]
+		tgCrossZone, err := v.fetchTargetGroupCrossZone(ctx, tg)
+		if err != nil {
+			return err
+		}
+		// TargetGroups with their own cross-zone setting are unaffected by the LoadBalancer level setting.
+		if tgCrossZone != crossZoneUseLoadBalancerConfiguration {
+			continue
+		}
+		violation, err := v.validateTargetGroupAZs(ctx, tg, []elbv2types.LoadBalancer{*sdkLB})
+		if err != nil {
+			return err
+		}
+		violations = append(violations, violation...)
+	}
+	return v.handleViolations(fmt.Sprintf("load balancer %v", awssdk.ToString(sdkLB.LoadBalancerArn)), violations)
+}
+
+func (v *defaultCrossZoneValidator) ValidateTargetGroup(ctx context.Context, tgARN string, currentValue string, desiredValue string) error {
+	if v.mode == config.CrossZoneDisableValidationModeNone || desiredValue == "true" || currentValue == desiredValue {
+		return nil
+	}
+	tgs, err := v.elbv2Client.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: []string{tgARN},
+	})
+	if err != nil {
+		return err
+	}
+	if len(tgs) != 1 || len(tgs[0].LoadBalancerArns) == 0 {
+		return nil
+	}
+	lbs, err := v.elbv2Client.DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: tgs[0].LoadBalancerArns,
+	})
+	if err != nil {
+		return err
+	}
+	// cross-zone load balancing is disabled for the LoadBalancers on which it's currently enabled for TargetGroup.
+	var affectedLBs []elbv2types.LoadBalancer
+	for i := range lbs {
+		lb := &lbs[i]
+		lbCrossZone, err := v.isLoadBalancerCrossZoneEnabled(ctx, lb)
+		if err != nil {
+			return err
+		}
+		currentlyEnabled := currentValue == "true" || (currentValue != "false" && lbCrossZone)
+		desiredEnabled := desiredValue == crossZoneUseLoadBalancerConfiguration && lbCrossZone
+		if currentlyEnabled && !desiredEnabled {
+			affectedLBs = append(affectedLBs, *lb)
+		}
+	}
+	if len(affectedLBs) == 0 {
+		return nil
+	}
+	violations, err := v.validateTargetGroupAZs(ctx, &tgs[0], affectedLBs)
+	if err != nil {
+		return err
+	}
+	return v.handleViolations(fmt.Sprintf("target group %v", tgARN), violations)
+}
+
+// validateTargetGroupAZs returns the violations of availability zones enabled on LoadBalancers without healthy targets of TargetGroup.
+// TargetGroups without any healthy target are skipped, since disabling cross-zone load balancing won't make them worse.
+func (v *defaultCrossZoneValidator) validateTargetGroupAZs(ctx context.Context, tg *elbv2types.TargetGroup, lbs []elbv2types.LoadBalancer) ([]string, error) {
+	tgARN := awssdk.ToString(tg.TargetGroupArn)
+	healthyAZs, known, err := v.fetchHealthyTargetAZs(ctx, tg)
+	if err != nil {
+		return nil, err
+	}
+	if !known {
+		v.logger.V(1).Info("unable to determine availability zones of targets, skipped cross-zone validation",
+			"targetGroup", tgARN)
+		return nil, nil
+	}
+	if healthyAZs.Len() == 0 || healthyAZs.Has(targetAZAll) {
+		return nil, nil
+	}
+	var violations []string
+	for _, lb := range lbs {
+		lbAZs := sets.NewString()
+		for _, az := range lb.AvailabilityZones {
+			lbAZs.Insert(awssdk.ToString(az.ZoneName))
+		}
+		azsWithoutTargets := lbAZs.Difference(healthyAZs)
+		if azsWithoutTargets.Len() == 0 {
+			continue
+		}
+		violations = append(violations, fmt.Sprintf("availability zones [%s] enabled on load balancer %s have no healthy targets in target group %s",
+			strings.Join(azsWithoutTargets.List(), ", "), awssdk.ToString(lb.LoadBalancerArn), tgARN))
+	}
+	return violations, nil
+}
+
+// handleViolations refuses or warns about disabling cross-zone load balancing per the validation mode.
+func (v *defaultCrossZoneValidator) handleViolations(resource string, violations []string) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	if v.mode == config.CrossZoneDisableValidationModeWarn {
+		v.logger.Info("disabling cross-zone load balancing will drop traffic routed to availability zones without healthy targets",
+			"resource", resource, "violations", violations)
+		return nil
+	}
+	return errors.Errorf("refusing to disable cross-zone load balancing on %v: %v", resource, strings.Join(violations, "; "))
+}
+
+// fetchHealthyTargetAZs returns the availability zones of healthy targets in TargetGroup.
+// returns false if the availability zone of any healthy target cannot be determined.
+func (v *defaultCrossZoneValidator) fetchHealthyTargetAZs(ctx context.Context, tg *elbv2types.TargetGroup) (sets.String, bool, error) {
+	resp, err := v.elbv2Client.DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: tg.TargetGroupArn,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	healthyAZs := sets.NewString()
+	var instanceIDs []string
+	for _, desc := range resp.TargetHealthDescriptions {
+		if desc.TargetHealth == nil || desc.TargetHealth.State != elbv2types.TargetHealthStateEnumHealthy {
+			continue
+		}
+		if az := awssdk.ToString(desc.Target.AvailabilityZone); az != "" {
+			healthyAZs.Insert(az)
+			continue
+		}
+		if tg.TargetType != elbv2types.TargetTypeEnumInstance {
+			return nil, false, nil
+		}
+		instanceIDs = append(instanceIDs, awssdk.ToString(desc.Target.Id))
+	}
+	if len(instanceIDs) == 0 {
+		return healthyAZs, true, nil
+	}
+	instances, err := v.ec2Client.DescribeInstancesAsList(ctx, &ec2sdk.DescribeInstancesInput{
+		InstanceIds: sets.NewString(instanceIDs...).List(),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	for _, instance := range instances {
+		if instance.Placement == nil || awssdk.ToString(instance.Placement.AvailabilityZone) == "" {
+			return nil, false, nil
+		}
+		healthyAZs.Insert(awssdk.ToString(instance.Placement.AvailabilityZone))
+	}
+	return healthyAZs, true, nil
+}
+
+// fetchTargetGroupCrossZone returns the cross-zone load balancing attribute of TargetGroup.
+func (v *defaultCrossZoneValidator) fetchTargetGroupCrossZone(ctx context.Context, tg *elbv2types.TargetGroup) (string, error) {
+	resp, err := v.elbv2Client.DescribeTargetGroupAttributesWithContext(ctx, &elbv2sdk.DescribeTargetGroupAttributesInput{
+		TargetGroupArn: tg.TargetGroupArn,
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, attr := range resp.Attributes {
+		if awssdk.ToString(attr.Key) == attrCrossZoneEnabled {
+			return awssdk.ToString(attr.Value), nil
+		}
+	}
+	return crossZoneUseLoadBalancerConfiguration, nil
+}
+
+// isLoadBalancerCrossZoneEnabled checks whether cross-zone load balancing is enabled on LoadBalancer.
+// cross-zone load balancing is always enabled on ALB, and is configurable on NLB.
+func (v *defaultCrossZoneValidator) isLoadBalancerCrossZoneEnabled(ctx context.Context, lb *elbv2types.LoadBalancer) (bool, error) {
+	if lb.Type == elbv2types.LoadBalancerTypeEnumApplication {
+		return true, nil
+	}
+	resp, err := v.elbv2Client.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2sdk.DescribeLoadBalancerAttributesInput{
+		LoadBalancerArn: lb.LoadBalancerArn,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, attr := range resp.Attributes {
+		if awssdk.ToString(attr.Key) == attrCrossZoneEnabled {
+			return awssdk.ToString(attr.Value) == "true", nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2 (interfaces: CrossZoneValidator)
+
+// Package elbv2 is a generated GoMock package.
+package elbv2
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCrossZoneValidator is a mock of CrossZoneValidator interface.
+type MockCrossZoneValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCrossZoneValidatorMockRecorder
+}
+
+// MockCrossZoneValidatorMockRecorder is the mock recorder for MockCrossZoneValidator.
+type MockCrossZoneValidatorMockRecorder struct {
+	mock *MockCrossZoneValidator
+}
+
+// NewMockCrossZoneValidator creates a new mock instance.
+func NewMockCrossZoneValidator(ctrl *gomock.Controller) *MockCrossZoneValidator {
+	mock := &MockCrossZoneValidator{ctrl: ctrl}
+	mock.recorder = &MockCrossZoneValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCrossZoneValidator) EXPECT() *MockCrossZoneValidatorMockRecorder {
+	return m.recorder
+}
+
+// ValidateLoadBalancer mocks base method.
+func (m *MockCrossZoneValidator) ValidateLoadBalancer(arg0 context.Context, arg1 *types.LoadBalancer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateLoadBalancer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateLoadBalancer indicates an expected call of ValidateLoadBalancer.
+func (mr *MockCrossZoneValidatorMockRecorder) ValidateLoadBalancer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateLoadBalancer", reflect.TypeOf((*MockCrossZoneValidator)(nil).ValidateLoadBalancer), arg0, arg1)
+}
+
+// ValidateTargetGroup mocks base method.
+func (m *MockCrossZoneValidator) ValidateTargetGroup(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateTargetGroup", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateTargetGroup indicates an expected call of ValidateTargetGroup.
+func (mr *MockCrossZoneValidatorMockRecorder) ValidateTargetGroup(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateTargetGroup", reflect.TypeOf((*MockCrossZoneValidator)(nil).ValidateTargetGroup), arg0, arg1, arg2, arg3)
+}
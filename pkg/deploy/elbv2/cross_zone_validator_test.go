@@ -0,0 +1,238 @@
+package elbv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultCrossZoneValidator_ValidateLoadBalancer(t *testing.T) {
+	nlb := &elbv2types.LoadBalancer{
+		LoadBalancerArn: awssdk.String("lb-arn"),
+		Type:            elbv2types.LoadBalancerTypeEnumNetwork,
+		AvailabilityZones: []elbv2types.AvailabilityZone{
+			{ZoneName: awssdk.String("us-west-2a")},
+			{ZoneName: awssdk.String("us-west-2b")},
+		},
+	}
+	instanceTG := &elbv2types.TargetGroup{
+		TargetGroupArn: awssdk.String("tg-arn"),
+		TargetType:     elbv2types.TargetTypeEnumInstance,
+	}
+	tests := []struct {
+		name          string
+		mode          string
+		tgCrossZone   string
+		targetHealths []elbv2types.TargetHealthDescription
+		instances     []ec2types.Instance
+		wantErr       error
+	}{
+		{
+			name:        "zone without healthy targets is refused",
+			mode:        "enforce",
+			tgCrossZone: "use_load_balancer_configuration",
+			targetHealths: []elbv2types.TargetHealthDescription{
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("i-1")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+				},
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("i-2")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumUnhealthy},
+				},
+			},
+			instances: []ec2types.Instance{
+				{InstanceId: awssdk.String("i-1"), Placement: &ec2types.Placement{AvailabilityZone: awssdk.String("us-west-2a")}},
+			},
+			wantErr: errors.New("refusing to disable cross-zone load balancing on load balancer lb-arn: availability zones [us-west-2b] enabled on load balancer lb-arn have no healthy targets in target group tg-arn"),
+		},
+		{
+			name:        "zone without healthy targets is warned",
+			mode:        "warn",
+			tgCrossZone: "use_load_balancer_configuration",
+			targetHealths: []elbv2types.TargetHealthDescription{
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("i-1")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+				},
+			},
+			instances: []ec2types.Instance{
+				{InstanceId: awssdk.String("i-1"), Placement: &ec2types.Placement{AvailabilityZone: awssdk.String("us-west-2a")}},
+			},
+		},
+		{
+			name:        "all zones have healthy targets",
+			mode:        "enforce",
+			tgCrossZone: "use_load_balancer_configuration",
+			targetHealths: []elbv2types.TargetHealthDescription{
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("i-1")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+				},
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("i-2")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+				},
+			},
+			instances: []ec2types.Instance{
+				{InstanceId: awssdk.String("i-1"), Placement: &ec2types.Placement{AvailabilityZone: awssdk.String("us-west-2a")}},
+				{InstanceId: awssdk.String("i-2"), Placement: &ec2types.Placement{AvailabilityZone: awssdk.String("us-west-2b")}},
+			},
+		},
+		{
+			name:        "target group without healthy targets is skipped",
+			mode:        "enforce",
+			tgCrossZone: "use_load_balancer_configuration",
+			targetHealths: []elbv2types.TargetHealthDescription{
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("i-1")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumUnhealthy},
+				},
+			},
+		},
+		{
+			name:        "target group with its own cross-zone setting is skipped",
+			mode:        "enforce",
+			tgCrossZone: "true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			ec2Client := services.NewMockEC2(ctrl)
+			elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), &elbv2sdk.DescribeTargetGroupsInput{
+				LoadBalancerArn: awssdk.String("lb-arn"),
+			}).Return([]elbv2types.TargetGroup{*instanceTG}, nil)
+			elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), &elbv2sdk.DescribeTargetGroupAttributesInput{
+				TargetGroupArn: awssdk.String("tg-arn"),
+			}).Return(&elbv2sdk.DescribeTargetGroupAttributesOutput{
+				Attributes: []elbv2types.TargetGroupAttribute{
+					{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String(tt.tgCrossZone)},
+				},
+			}, nil)
+			if tt.targetHealths != nil {
+				elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), &elbv2sdk.DescribeTargetHealthInput{
+					TargetGroupArn: awssdk.String("tg-arn"),
+				}).Return(&elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: tt.targetHealths}, nil)
+			}
+			if tt.instances != nil {
+				var instanceIDs []string
+				for _, instance := range tt.instances {
+					instanceIDs = append(instanceIDs, awssdk.ToString(instance.InstanceId))
+				}
+				ec2Client.EXPECT().DescribeInstancesAsList(gomock.Any(), &ec2sdk.DescribeInstancesInput{
+					InstanceIds: instanceIDs,
+				}).Return(tt.instances, nil)
+			}
+
+			v := NewDefaultCrossZoneValidator(elbv2Client, ec2Client, tt.mode, log.Log)
+			err := v.ValidateLoadBalancer(context.Background(), nlb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_defaultCrossZoneValidator_ValidateTargetGroup(t *testing.T) {
+	alb := &elbv2types.LoadBalancer{
+		LoadBalancerArn: awssdk.String("lb-arn"),
+		Type:            elbv2types.LoadBalancerTypeEnumApplication,
+		AvailabilityZones: []elbv2types.AvailabilityZone{
+			{ZoneName: awssdk.String("us-west-2a")},
+			{ZoneName: awssdk.String("us-west-2b")},
+		},
+	}
+	ipTG := &elbv2types.TargetGroup{
+		TargetGroupArn:   awssdk.String("tg-arn"),
+		TargetType:       elbv2types.TargetTypeEnumIp,
+		LoadBalancerArns: []string{"lb-arn"},
+	}
+	tests := []struct {
+		name          string
+		mode          string
+		currentValue  string
+		desiredValue  string
+		targetHealths []elbv2types.TargetHealthDescription
+		wantErr       error
+	}{
+		{
+			name:         "zone without healthy targets is refused",
+			mode:         "enforce",
+			currentValue: "use_load_balancer_configuration",
+			desiredValue: "false",
+			targetHealths: []elbv2types.TargetHealthDescription{
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("10.0.0.1"), AvailabilityZone: awssdk.String("us-west-2a")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+				},
+			},
+			wantErr: errors.New("refusing to disable cross-zone load balancing on target group tg-arn: availability zones [us-west-2b] enabled on load balancer lb-arn have no healthy targets in target group tg-arn"),
+		},
+		{
+			name:         "all zones have healthy targets",
+			mode:         "enforce",
+			currentValue: "true",
+			desiredValue: "false",
+			targetHealths: []elbv2types.TargetHealthDescription{
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("10.0.0.1"), AvailabilityZone: awssdk.String("us-west-2a")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+				},
+				{
+					Target:       &elbv2types.TargetDescription{Id: awssdk.String("10.0.1.1"), AvailabilityZone: awssdk.String("us-west-2b")},
+					TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+				},
+			},
+		},
+		{
+			name:         "cross-zone stays enabled via load balancer configuration",
+			mode:         "enforce",
+			currentValue: "true",
+			desiredValue: "use_load_balancer_configuration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), &elbv2sdk.DescribeTargetGroupsInput{
+				TargetGroupArns: []string{"tg-arn"},
+			}).Return([]elbv2types.TargetGroup{*ipTG}, nil)
+			elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), &elbv2sdk.DescribeLoadBalancersInput{
+				LoadBalancerArns: []string{"lb-arn"},
+			}).Return([]elbv2types.LoadBalancer{*alb}, nil)
+			if tt.targetHealths != nil {
+				elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), &elbv2sdk.DescribeTargetHealthInput{
+					TargetGroupArn: awssdk.String("tg-arn"),
+				}).Return(&elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: tt.targetHealths}, nil)
+			}
+
+			v := NewDefaultCrossZoneValidator(elbv2Client, nil, tt.mode, log.Log)
+			err := v.ValidateTargetGroup(context.Background(), "tg-arn", tt.currentValue, tt.desiredValue)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,360 @@
+package elbv2
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	// defaultDescribeCacheRefreshPeriod is the period after which the cached resources are described again even if unchanged,
+	// so that the resources modified out-of-band are corrected eventually.
+	defaultDescribeCacheRefreshPeriod = 10 * time.Minute
+
+	describeCacheOperationListeners            = "DescribeListeners"
+	describeCacheOperationRules                = "DescribeRules"
+	describeCacheOperationListenerCertificates = "DescribeListenerCertificates"
+)
+
+// NewDescribeCache constructs new DescribeCache.
+func NewDescribeCache(metrics *DescribeCacheMetrics) *DescribeCache {
+	return &DescribeCache{
+		refreshPeriod:        defaultDescribeCacheRefreshPeriod,
+		metrics:              metrics,
+		listenersByLBARN:     make(map[string]describeCacheEntry),
+		rulesByLSARN:         make(map[string]describeCacheEntry),
+		listenerCertsByLSARN: make(map[string]describeCacheEntry),
+		now:                  time.Now,
+	}
+}
+
+// DescribeCache caches the listeners per load balancer, and the listener rules and certificates per listener,
+// so that the unchanged resources aren't described again on every reconcile.
+// the cached resources are invalidated when they're mutated via the ELBV2 client wrapped by WrapELBV2,
+// and described again after the refresh period.
+type DescribeCache struct {
+	refreshPeriod time.Duration
+	metrics       *DescribeCacheMetrics
+
+	listenersByLBARN     map[string]describeCacheEntry
+	rulesByLSARN         map[string]describeCacheEntry
+	listenerCertsByLSARN map[string]describeCacheEntry
+	// generation is incremented on every invalidation, so that describes concurrent to mutations aren't cached.
+	generation int64
+	// mutex protects listenersByLBARN, rulesByLSARN, listenerCertsByLSARN and generation.
+	mutex sync.Mutex
+	now   func() time.Time
+}
+
+type describeCacheEntry struct {
+	value       interface{}
+	describedAt time.Time
+}
+
+// WrapELBV2 wraps elbv2Client so that its describes are served from cache and its mutations invalidate the cache.
+func (c *DescribeCache) WrapELBV2(elbv2Client services.ELBV2) services.ELBV2 {
+	return &cachedELBV2{ELBV2: elbv2Client, cache: c}
+}
+
+// DescribeCacheUsage counts the describes served from cache or not within a stack deployment.
+type DescribeCacheUsage struct {
+	hits   int64
+	misses int64
+}
+
+type describeCacheUsageKey struct{}
+
+// WithDescribeCacheUsage returns a copy of ctx that counts the describes served from cache into the returned DescribeCacheUsage.
+func WithDescribeCacheUsage(ctx context.Context) (context.Context, *DescribeCacheUsage) {
+	usage := &DescribeCacheUsage{}
+	return context.WithValue(ctx, describeCacheUsageKey{}, usage), usage
+}
+
+// ObserveDeploy observes the latency of a stack deployment by whether any describe is served from cache,
+// so that the latency of cached deployments can be compared with fresh ones.
+func (c *DescribeCache) ObserveDeploy(usage *DescribeCacheUsage, latency time.Duration) {
+	if c == nil {
+		return
+	}
+	result := describeCacheResultMiss
+	if atomic.LoadInt64(&usage.hits) > 0 {
+		result = describeCacheResultHit
+	}
+	c.metrics.observeDeploy(result, latency)
+}
+
+func (c *DescribeCache) lookup(ctx context.Context, operation string, key string) (interface{}, bool) {
+	c.mutex.Lock()
+	entry, exists := c.entriesOf(operation)[key]
+	c.mutex.Unlock()
+	hit := exists && c.now().Sub(entry.describedAt) < c.refreshPeriod
+	usage, _ := ctx.Value(describeCacheUsageKey{}).(*DescribeCacheUsage)
+	if hit {
+		c.metrics.observeLookup(operation, describeCacheResultHit)
+		if usage != nil {
+			atomic.AddInt64(&usage.hits, 1)
+		}
+		return entry.value, true
+	}
+	c.metrics.observeLookup(operation, describeCacheResultMiss)
+	if usage != nil {
+		atomic.AddInt64(&usage.misses, 1)
+	}
+	return nil, false
+}
+
+// begin returns the time and generation to store the result of a describe with.
+func (c *DescribeCache) begin() (time.Time, int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now(), c.generation
+}
+
+// store caches the value described at describedAt, unless the cache is invalidated since generation.
+func (c *DescribeCache) store(operation string, key string, value interface{}, describedAt time.Time, generation int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.generation != generation {
+		return
+	}
+	c.entriesOf(operation)[key] = describeCacheEntry{value: value, describedAt: describedAt}
+}
+
+// entriesOf returns the cached entries of describe operation, the mutex must be held.
+func (c *DescribeCache) entriesOf(operation string) map[string]describeCacheEntry {
+	switch operation {
+	case describeCacheOperationListeners:
+		return c.listenersByLBARN
+	case describeCacheOperationRules:
+		return c.rulesByLSARN
+	default:
+		return c.listenerCertsByLSARN
+	}
+}
+
+func (c *DescribeCache) invalidateListeners(lbARN string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	delete(c.listenersByLBARN, lbARN)
+}
+
+// invalidateListener invalidates the listener along with its rules and certificates.
+func (c *DescribeCache) invalidateListener(lsARN string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	if lbARN, ok := lbARNFromListenerARN(lsARN); ok {
+		delete(c.listenersByLBARN, lbARN)
+	} else {
+		c.listenersByLBARN = make(map[string]describeCacheEntry)
+	}
+	delete(c.rulesByLSARN, lsARN)
+	delete(c.listenerCertsByLSARN, lsARN)
+}
+
+// invalidateLoadBalancer invalidates the listeners of load balancer along with their rules and certificates.
+func (c *DescribeCache) invalidateLoadBalancer(lbARN string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	delete(c.listenersByLBARN, lbARN)
+	for lsARN := range c.rulesByLSARN {
+		if listenerLBARN, ok := lbARNFromListenerARN(lsARN); !ok || listenerLBARN == lbARN {
+			delete(c.rulesByLSARN, lsARN)
+		}
+	}
+	for lsARN := range c.listenerCertsByLSARN {
+		if listenerLBARN, ok := lbARNFromListenerARN(lsARN); !ok || listenerLBARN == lbARN {
+			delete(c.listenerCertsByLSARN, lsARN)
+		}
+	}
+}
+
+func (c *DescribeCache) invalidateRules(lsARN string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	delete(c.rulesByLSARN, lsARN)
+}
+
+func (c *DescribeCache) invalidateRule(ruleARN string) {
+	if lsARN, ok := lsARNFromRuleARN(ruleARN); ok {
+		c.invalidateRules(lsARN)
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	c.rulesByLSARN = make(map[string]describeCacheEntry)
+}
+
+func (c *DescribeCache) invalidateListenerCerts(lsARN string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	delete(c.listenerCertsByLSARN, lsARN)
+}
+
+// lbARNFromListenerARN derives the load balancer ARN from listener ARN like
+// arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2
+func lbARNFromListenerARN(lsARN string) (string, bool) {
+	return trimARNResourceID(lsARN, ":listener/", ":loadbalancer/")
+}
+
+// lsARNFromRuleARN derives the listener ARN from listener rule ARN like
+// arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee
+func lsARNFromRuleARN(ruleARN string) (string, bool) {
+	return trimARNResourceID(ruleARN, ":listener-rule/", ":listener/")
+}
+
+func trimARNResourceID(arn string, resourceType string, parentResourceType string) (string, bool) {
+	idx := strings.LastIndex(arn, "/")
+	if !strings.Contains(arn, resourceType) || idx < 0 {
+		return "", false
+	}
+	return strings.Replace(arn[:idx], resourceType, parentResourceType, 1), true
+}
+
+// the cached resources are copied shallowly on the way in and out of cache, callers mustn't mutate their nested fields.
+
+func copyListeners(listeners []elbv2types.Listener) []elbv2types.Listener {
+	copied := make([]elbv2types.Listener, len(listeners))
+	copy(copied, listeners)
+	return copied
+}
+
+func copyRules(rules []elbv2types.Rule) []elbv2types.Rule {
+	copied := make([]elbv2types.Rule, len(rules))
+	copy(copied, rules)
+	return copied
+}
+
+func copyCertificates(certs []elbv2types.Certificate) []elbv2types.Certificate {
+	copied := make([]elbv2types.Certificate, len(certs))
+	copy(copied, certs)
+	return copied
+}
+
+var _ services.ELBV2 = &cachedELBV2{}
+
+// cachedELBV2 is an ELBV2 client that serves the describes of listeners, listener rules and listener certificates from DescribeCache.
+// only describes of all listeners of a load balancer, or all rules or certificates of a listener are cached.
+type cachedELBV2 struct {
+	services.ELBV2
+	cache *DescribeCache
+}
+
+func (c *cachedELBV2) DescribeListenersAsList(ctx context.Context, input *elbv2sdk.DescribeListenersInput) ([]elbv2types.Listener, error) {
+	lbARN := awssdk.ToString(input.LoadBalancerArn)
+	if lbARN == "" || len(input.ListenerArns) != 0 || input.Marker != nil {
+		return c.ELBV2.DescribeListenersAsList(ctx, input)
+	}
+	if cached, ok := c.cache.lookup(ctx, describeCacheOperationListeners, lbARN); ok {
+		return copyListeners(cached.([]elbv2types.Listener)), nil
+	}
+	describedAt, generation := c.cache.begin()
+	listeners, err := c.ELBV2.DescribeListenersAsList(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.store(describeCacheOperationListeners, lbARN, copyListeners(listeners), describedAt, generation)
+	return listeners, nil
+}
+
+func (c *cachedELBV2) DescribeRulesAsList(ctx context.Context, input *elbv2sdk.DescribeRulesInput) ([]elbv2types.Rule, error) {
+	lsARN := awssdk.ToString(input.ListenerArn)
+	if lsARN == "" || len(input.RuleArns) != 0 || input.Marker != nil {
+		return c.ELBV2.DescribeRulesAsList(ctx, input)
+	}
+	if cached, ok := c.cache.lookup(ctx, describeCacheOperationRules, lsARN); ok {
+		return copyRules(cached.([]elbv2types.Rule)), nil
+	}
+	describedAt, generation := c.cache.begin()
+	rules, err := c.ELBV2.DescribeRulesAsList(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.store(describeCacheOperationRules, lsARN, copyRules(rules), describedAt, generation)
+	return rules, nil
+}
+
+func (c *cachedELBV2) DescribeListenerCertificatesAsList(ctx context.Context, input *elbv2sdk.DescribeListenerCertificatesInput) ([]elbv2types.Certificate, error) {
+	lsARN := awssdk.ToString(input.ListenerArn)
+	if lsARN == "" || input.Marker != nil {
+		return c.ELBV2.DescribeListenerCertificatesAsList(ctx, input)
+	}
+	if cached, ok := c.cache.lookup(ctx, describeCacheOperationListenerCertificates, lsARN); ok {
+		return copyCertificates(cached.([]elbv2types.Certificate)), nil
+	}
+	describedAt, generation := c.cache.begin()
+	certs, err := c.ELBV2.DescribeListenerCertificatesAsList(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.store(describeCacheOperationListenerCertificates, lsARN, copyCertificates(certs), describedAt, generation)
+	return certs, nil
+}
+
+// the cache is invalidated once mutations finish, even if they fail, as the resources might be partially mutated.
+
+func (c *cachedELBV2) DeleteLoadBalancerWithContext(ctx context.Context, input *elbv2sdk.DeleteLoadBalancerInput) (*elbv2sdk.DeleteLoadBalancerOutput, error) {
+	lbARN := awssdk.ToString(input.LoadBalancerArn)
+	defer c.cache.invalidateLoadBalancer(lbARN)
+	return c.ELBV2.DeleteLoadBalancerWithContext(ctx, input)
+}
+
+func (c *cachedELBV2) CreateListenerWithContext(ctx context.Context, input *elbv2sdk.CreateListenerInput) (*elbv2sdk.CreateListenerOutput, error) {
+	lbARN := awssdk.ToString(input.LoadBalancerArn)
+	defer c.cache.invalidateListeners(lbARN)
+	return c.ELBV2.CreateListenerWithContext(ctx, input)
+}
+
+func (c *cachedELBV2) ModifyListenerWithContext(ctx context.Context, input *elbv2sdk.ModifyListenerInput) (*elbv2sdk.ModifyListenerOutput, error) {
+	lsARN := awssdk.ToString(input.ListenerArn)
+	defer c.cache.invalidateListener(lsARN)
+	return c.ELBV2.ModifyListenerWithContext(ctx, input)
+}
+
+func (c *cachedELBV2) DeleteListenerWithContext(ctx context.Context, input *elbv2sdk.DeleteListenerInput) (*elbv2sdk.DeleteListenerOutput, error) {
+	lsARN := awssdk.ToString(input.ListenerArn)
+	defer c.cache.invalidateListener(lsARN)
+	return c.ELBV2.DeleteListenerWithContext(ctx, input)
+}
+
+func (c *cachedELBV2) AddListenerCertificatesWithContext(ctx context.Context, input *elbv2sdk.AddListenerCertificatesInput) (*elbv2sdk.AddListenerCertificatesOutput, error) {
+	lsARN := awssdk.ToString(input.ListenerArn)
+	defer c.cache.invalidateListenerCerts(lsARN)
+	return c.ELBV2.AddListenerCertificatesWithContext(ctx, input)
+}
+
+func (c *cachedELBV2) RemoveListenerCertificatesWithContext(ctx context.Context, input *elbv2sdk.RemoveListenerCertificatesInput) (*elbv2sdk.RemoveListenerCertificatesOutput, error) {
+	lsARN := awssdk.ToString(input.ListenerArn)
+	defer c.cache.invalidateListenerCerts(lsARN)
+	return c.ELBV2.RemoveListenerCertificatesWithContext(ctx, input)
+}
+
+func (c *cachedELBV2) CreateRuleWithContext(ctx context.Context, input *elbv2sdk.CreateRuleInput) (*elbv2sdk.CreateRuleOutput, error) {
+	lsARN := awssdk.ToString(input.ListenerArn)
+	defer c.cache.invalidateRules(lsARN)
+	return c.ELBV2.CreateRuleWithContext(ctx, input)
+}
+
+func (c *cachedELBV2) ModifyRuleWithContext(ctx context.Context, input *elbv2sdk.ModifyRuleInput) (*elbv2sdk.ModifyRuleOutput, error) {
+	ruleARN := awssdk.ToString(input.RuleArn)
+	defer c.cache.invalidateRule(ruleARN)
+	return c.ELBV2.ModifyRuleWithContext(ctx, input)
+}
+
+func (c *cachedELBV2) DeleteRuleWithContext(ctx context.Context, input *elbv2sdk.DeleteRuleInput) (*elbv2sdk.DeleteRuleOutput, error) {
+	ruleARN := awssdk.ToString(input.RuleArn)
+	defer c.cache.invalidateRule(ruleARN)
+	return c.ELBV2.DeleteRuleWithContext(ctx, input)
+}
@@ -0,0 +1,69 @@
+package elbv2
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemDescribeCache = "elbv2_describe_cache"
+
+	metricDescribeCacheLookupsTotal  = "lookups_total"
+	metricDescribeCacheDeployLatency = "deploy_duration_seconds"
+)
+
+const (
+	labelDescribeCacheOperation = "operation"
+	labelDescribeCacheResult    = "result"
+
+	describeCacheResultHit  = "hit"
+	describeCacheResultMiss = "miss"
+)
+
+// DescribeCacheMetrics contains the metrics for the ELBV2 describe cache.
+type DescribeCacheMetrics struct {
+	lookupsTotal  *prometheus.CounterVec
+	deployLatency *prometheus.HistogramVec
+}
+
+// NewDescribeCacheMetrics allocates and register new DescribeCacheMetrics to registerer.
+func NewDescribeCacheMetrics(registerer prometheus.Registerer) (*DescribeCacheMetrics, error) {
+	lookupsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemDescribeCache,
+		Name:      metricDescribeCacheLookupsTotal,
+		Help:      "Total number of describe cache lookups by operation and whether they're served from cache",
+	}, []string{labelDescribeCacheOperation, labelDescribeCacheResult})
+	deployLatency := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemDescribeCache,
+		Name:      metricDescribeCacheDeployLatency,
+		Help:      "Latency of stack deployments by whether any describe is served from cache",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 20, 40, 80},
+	}, []string{labelDescribeCacheResult})
+
+	if err := registerer.Register(lookupsTotal); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricDescribeCacheLookupsTotal)
+	}
+	if err := registerer.Register(deployLatency); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricDescribeCacheDeployLatency)
+	}
+	return &DescribeCacheMetrics{
+		lookupsTotal:  lookupsTotal,
+		deployLatency: deployLatency,
+	}, nil
+}
+
+func (m *DescribeCacheMetrics) observeLookup(operation string, result string) {
+	if m == nil {
+		return
+	}
+	m.lookupsTotal.WithLabelValues(operation, result).Inc()
+}
+
+func (m *DescribeCacheMetrics) observeDeploy(result string, latency time.Duration) {
+	if m == nil {
+		return
+	}
+	m.deployLatency.WithLabelValues(result).Observe(latency.Seconds())
+}
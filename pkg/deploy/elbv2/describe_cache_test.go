@@ -0,0 +1,194 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+func Test_cachedELBV2(t *testing.T) {
+	const (
+		lbARN   = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"
+		lsARN   = "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2"
+		ruleARN = "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee"
+	)
+	startTime := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	describeListeners := func(ctx context.Context, elbv2Client services.ELBV2) {
+		listeners, err := elbv2Client.DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{LoadBalancerArn: awssdk.String(lbARN)})
+		assert.NoError(t, err)
+		assert.Equal(t, []elbv2types.Listener{{ListenerArn: awssdk.String(lsARN)}}, listeners)
+	}
+	describeRules := func(ctx context.Context, elbv2Client services.ELBV2) {
+		rules, err := elbv2Client.DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{ListenerArn: awssdk.String(lsARN)})
+		assert.NoError(t, err)
+		assert.Equal(t, []elbv2types.Rule{{RuleArn: awssdk.String(ruleARN)}}, rules)
+	}
+	tests := []struct {
+		name                   string
+		steps                  func(ctx context.Context, elbv2Client services.ELBV2, cache *DescribeCache)
+		wantListenersDescribes int
+		wantRulesDescribes     int
+		wantCertsDescribes     int
+		wantHits               float64
+	}{
+		{
+			name: "unchanged resources are served from cache",
+			steps: func(ctx context.Context, elbv2Client services.ELBV2, _ *DescribeCache) {
+				describeListeners(ctx, elbv2Client)
+				describeListeners(ctx, elbv2Client)
+				describeRules(ctx, elbv2Client)
+				describeRules(ctx, elbv2Client)
+			},
+			wantListenersDescribes: 1,
+			wantRulesDescribes:     1,
+			wantHits:               2,
+		},
+		{
+			name: "cached resources are described again after refresh period",
+			steps: func(ctx context.Context, elbv2Client services.ELBV2, cache *DescribeCache) {
+				describeListeners(ctx, elbv2Client)
+				cache.now = func() time.Time { return startTime.Add(defaultDescribeCacheRefreshPeriod) }
+				describeListeners(ctx, elbv2Client)
+			},
+			wantListenersDescribes: 2,
+		},
+		{
+			name: "rule mutation invalidates the rules of its listener",
+			steps: func(ctx context.Context, elbv2Client services.ELBV2, _ *DescribeCache) {
+				describeListeners(ctx, elbv2Client)
+				describeRules(ctx, elbv2Client)
+				_, err := elbv2Client.ModifyRuleWithContext(ctx, &elbv2sdk.ModifyRuleInput{RuleArn: awssdk.String(ruleARN)})
+				assert.NoError(t, err)
+				describeListeners(ctx, elbv2Client)
+				describeRules(ctx, elbv2Client)
+			},
+			wantListenersDescribes: 1,
+			wantRulesDescribes:     2,
+			wantHits:               1,
+		},
+		{
+			name: "listener mutation invalidates the listeners of its load balancer along with its rules and certificates",
+			steps: func(ctx context.Context, elbv2Client services.ELBV2, _ *DescribeCache) {
+				describeListeners(ctx, elbv2Client)
+				describeRules(ctx, elbv2Client)
+				_, err := elbv2Client.DescribeListenerCertificatesAsList(ctx, &elbv2sdk.DescribeListenerCertificatesInput{ListenerArn: awssdk.String(lsARN)})
+				assert.NoError(t, err)
+				_, err = elbv2Client.ModifyListenerWithContext(ctx, &elbv2sdk.ModifyListenerInput{ListenerArn: awssdk.String(lsARN)})
+				assert.NoError(t, err)
+				describeListeners(ctx, elbv2Client)
+				describeRules(ctx, elbv2Client)
+				_, err = elbv2Client.DescribeListenerCertificatesAsList(ctx, &elbv2sdk.DescribeListenerCertificatesInput{ListenerArn: awssdk.String(lsARN)})
+				assert.NoError(t, err)
+			},
+			wantListenersDescribes: 2,
+			wantRulesDescribes:     2,
+			wantCertsDescribes:     2,
+		},
+		{
+			name: "load balancer deletion invalidates its listeners and their rules",
+			steps: func(ctx context.Context, elbv2Client services.ELBV2, _ *DescribeCache) {
+				describeListeners(ctx, elbv2Client)
+				describeRules(ctx, elbv2Client)
+				_, err := elbv2Client.DeleteLoadBalancerWithContext(ctx, &elbv2sdk.DeleteLoadBalancerInput{LoadBalancerArn: awssdk.String(lbARN)})
+				assert.NoError(t, err)
+				describeListeners(ctx, elbv2Client)
+				describeRules(ctx, elbv2Client)
+			},
+			wantListenersDescribes: 2,
+			wantRulesDescribes:     2,
+		},
+		{
+			name: "describes of specific rules aren't cached",
+			steps: func(ctx context.Context, elbv2Client services.ELBV2, _ *DescribeCache) {
+				for i := 0; i < 2; i++ {
+					_, err := elbv2Client.DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{RuleArns: []string{ruleARN}})
+					assert.NoError(t, err)
+				}
+			},
+			wantRulesDescribes: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeListenersAsList(gomock.Any(), gomock.Any()).
+				Return([]elbv2types.Listener{{ListenerArn: awssdk.String(lsARN)}}, nil).Times(tt.wantListenersDescribes)
+			elbv2Client.EXPECT().DescribeRulesAsList(gomock.Any(), gomock.Any()).
+				Return([]elbv2types.Rule{{RuleArn: awssdk.String(ruleARN)}}, nil).Times(tt.wantRulesDescribes)
+			elbv2Client.EXPECT().DescribeListenerCertificatesAsList(gomock.Any(), gomock.Any()).
+				Return(nil, nil).Times(tt.wantCertsDescribes)
+			elbv2Client.EXPECT().ModifyRuleWithContext(gomock.Any(), gomock.Any()).Return(&elbv2sdk.ModifyRuleOutput{}, nil).AnyTimes()
+			elbv2Client.EXPECT().ModifyListenerWithContext(gomock.Any(), gomock.Any()).Return(&elbv2sdk.ModifyListenerOutput{}, nil).AnyTimes()
+			elbv2Client.EXPECT().DeleteLoadBalancerWithContext(gomock.Any(), gomock.Any()).Return(&elbv2sdk.DeleteLoadBalancerOutput{}, nil).AnyTimes()
+
+			metrics, err := NewDescribeCacheMetrics(prometheus.NewPedanticRegistry())
+			assert.NoError(t, err)
+			cache := NewDescribeCache(metrics)
+			cache.now = func() time.Time { return startTime }
+			ctx, usage := WithDescribeCacheUsage(context.Background())
+			tt.steps(ctx, cache.WrapELBV2(elbv2Client), cache)
+
+			gotHits := testutil.ToFloat64(metrics.lookupsTotal.WithLabelValues(describeCacheOperationListeners, describeCacheResultHit)) +
+				testutil.ToFloat64(metrics.lookupsTotal.WithLabelValues(describeCacheOperationRules, describeCacheResultHit)) +
+				testutil.ToFloat64(metrics.lookupsTotal.WithLabelValues(describeCacheOperationListenerCertificates, describeCacheResultHit))
+			assert.Equal(t, tt.wantHits, gotHits)
+			assert.Equal(t, int64(tt.wantHits), usage.hits)
+		})
+	}
+}
+
+func Test_cachedELBV2_cachedValueIsCopied(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := services.NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().DescribeListenersAsList(gomock.Any(), gomock.Any()).
+		Return([]elbv2types.Listener{{Port: awssdk.Int32(80)}}, nil)
+
+	cachedClient := NewDescribeCache(nil).WrapELBV2(elbv2Client)
+	req := &elbv2sdk.DescribeListenersInput{LoadBalancerArn: awssdk.String("lb-arn")}
+	listeners, err := cachedClient.DescribeListenersAsList(context.Background(), req)
+	assert.NoError(t, err)
+	listeners[0].Port = awssdk.Int32(443)
+	listeners, err = cachedClient.DescribeListenersAsList(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, []elbv2types.Listener{{Port: awssdk.Int32(80)}}, listeners)
+}
+
+func Test_lsARNFromRuleARN(t *testing.T) {
+	tests := []struct {
+		name      string
+		ruleARN   string
+		wantLSARN string
+		wantOK    bool
+	}{
+		{
+			name:      "listener rule ARN",
+			ruleARN:   "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee",
+			wantLSARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-lb/50dc6c495c0c9188/f2f7dc8efc522ab2",
+			wantOK:    true,
+		},
+		{
+			name:    "unknown ARN",
+			ruleARN: "rule-arn",
+			wantOK:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotLSARN, gotOK := lsARNFromRuleARN(tt.ruleARN)
+			assert.Equal(t, tt.wantLSARN, gotLSARN)
+			assert.Equal(t, tt.wantOK, gotOK)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+
+	elbv2sdkv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2typesv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// reconciler for Listener attributes
+type ListenerAttributesReconciler interface {
+	// Reconcile listener attributes
+	Reconcile(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) error
+}
+
+// NewDefaultListenerAttributesReconciler constructs new defaultListenerAttributesReconciler.
+func NewDefaultListenerAttributesReconciler(elbv2Client services.ELBV2, logger logr.Logger) *defaultListenerAttributesReconciler {
+	return &defaultListenerAttributesReconciler{
+		elbv2Client: elbv2Client,
+		logger:      logger,
+	}
+}
+
+var _ ListenerAttributesReconciler = &defaultListenerAttributesReconciler{}
+
+// default implementation for ListenerAttributesReconciler
+type defaultListenerAttributesReconciler struct {
+	elbv2Client services.ELBV2
+	logger      logr.Logger
+}
+
+func (r *defaultListenerAttributesReconciler) Reconcile(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) error {
+	desiredAttrs := r.getDesiredListenerAttributes(ctx, resLS)
+	// listeners without desired attributes are left untouched, so that the listener attributes APIs are only required
+	// when the feature is used.
+	if len(desiredAttrs) == 0 {
+		return nil
+	}
+	currentAttrs, err := r.getCurrentListenerAttributes(ctx, sdkLS)
+	if err != nil {
+		return err
+	}
+
+	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
+	if len(attributesToUpdate) > 0 {
+		req := &elbv2sdkv2.ModifyListenerAttributesInput{
+			ListenerArn: sdkLS.Listener.ListenerArn,
+			Attributes:  nil,
+		}
+		for _, attrKey := range sets.StringKeySet(attributesToUpdate).List() {
+			req.Attributes = append(req.Attributes, elbv2typesv2.ListenerAttribute{
+				Key:   awssdk.String(attrKey),
+				Value: awssdk.String(attributesToUpdate[attrKey]),
+			})
+		}
+
+		r.logger.Info("modifying listener attributes",
+			"stackID", resLS.Stack().StackID(),
+			"resourceID", resLS.ID(),
+			"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn),
+			"change", attributesToUpdate)
+		if _, err := r.elbv2Client.ModifyListenerAttributesWithContext(ctx, req); err != nil {
+			return err
+		}
+		r.logger.Info("modified listener attributes",
+			"stackID", resLS.Stack().StackID(),
+			"resourceID", resLS.ID(),
+			"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn))
+		audit.RecordMutation(ctx, audit.ActionModify, "listener", awssdk.StringValue(sdkLS.Listener.ListenerArn),
+			fmt.Sprintf("attributes %v", attributesToUpdate))
+	}
+	return nil
+}
+
+func (r *defaultListenerAttributesReconciler) getDesiredListenerAttributes(ctx context.Context, resLS *elbv2model.Listener) map[string]string {
+	lsAttributes := make(map[string]string, len(resLS.Spec.ListenerAttributes))
+	for _, attr := range resLS.Spec.ListenerAttributes {
+		lsAttributes[attr.Key] = attr.Value
+	}
+	return lsAttributes
+}
+
+func (r *defaultListenerAttributesReconciler) getCurrentListenerAttributes(ctx context.Context, sdkLS ListenerWithTags) (map[string]string, error) {
+	req := &elbv2sdkv2.DescribeListenerAttributesInput{
+		ListenerArn: sdkLS.Listener.ListenerArn,
+	}
+	resp, err := r.elbv2Client.DescribeListenerAttributesWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	lsAttributes := make(map[string]string, len(resp.Attributes))
+	for _, attr := range resp.Attributes {
+		lsAttributes[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+	}
+	return lsAttributes, nil
+}
@@ -0,0 +1,220 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	elbv2sdkv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2typesv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultListenerAttributesReconciler_Reconcile(t *testing.T) {
+	type describeListenerAttributesWithContextCall struct {
+		req  *elbv2sdkv2.DescribeListenerAttributesInput
+		resp *elbv2sdkv2.DescribeListenerAttributesOutput
+		err  error
+	}
+
+	type modifyListenerAttributesWithContextCall struct {
+		req  *elbv2sdkv2.ModifyListenerAttributesInput
+		resp *elbv2sdkv2.ModifyListenerAttributesOutput
+		err  error
+	}
+
+	type fields struct {
+		describeListenerAttributesWithContextCalls []describeListenerAttributesWithContextCall
+		modifyListenerAttributesWithContextCalls   []modifyListenerAttributesWithContextCall
+	}
+	type args struct {
+		sdkLS ListenerWithTags
+		resLS *elbv2model.Listener
+	}
+
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	sdkLS := ListenerWithTags{
+		Listener: &elbv2types.Listener{
+			ListenerArn: awssdk.String("my-arn"),
+		},
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "drifted attributes should be updated",
+			fields: fields{
+				describeListenerAttributesWithContextCalls: []describeListenerAttributesWithContextCall{
+					{
+						req: &elbv2sdkv2.DescribeListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+						},
+						resp: &elbv2sdkv2.DescribeListenerAttributesOutput{
+							Attributes: []elbv2typesv2.ListenerAttribute{
+								{
+									Key:   awssdk.String("routing.http.response.server.enabled"),
+									Value: awssdk.String("true"),
+								},
+								{
+									Key:   awssdk.String("routing.http.response.strict_transport_security.header_value"),
+									Value: awssdk.String(""),
+								},
+								{
+									Key:   awssdk.String("routing.http.request.x_amzn_tls_version.header_name"),
+									Value: awssdk.String("X-Amzn-Tls-Version"),
+								},
+							},
+						},
+					},
+				},
+				modifyListenerAttributesWithContextCalls: []modifyListenerAttributesWithContextCall{
+					{
+						req: &elbv2sdkv2.ModifyListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+							Attributes: []elbv2typesv2.ListenerAttribute{
+								{
+									Key:   awssdk.String("routing.http.response.server.enabled"),
+									Value: awssdk.String("false"),
+								},
+								{
+									Key:   awssdk.String("routing.http.response.strict_transport_security.header_value"),
+									Value: awssdk.String("max-age=31536000"),
+								},
+							},
+						},
+						resp: &elbv2sdkv2.ModifyListenerAttributesOutput{},
+					},
+				},
+			},
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "443"),
+					Spec: elbv2model.ListenerSpec{
+						ListenerAttributes: []elbv2model.ListenerAttribute{
+							{
+								Key:   "routing.http.response.server.enabled",
+								Value: "false",
+							},
+							{
+								Key:   "routing.http.response.strict_transport_security.header_value",
+								Value: "max-age=31536000",
+							},
+							{
+								Key:   "routing.http.request.x_amzn_tls_version.header_name",
+								Value: "X-Amzn-Tls-Version",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no attributes should be updated",
+			fields: fields{
+				describeListenerAttributesWithContextCalls: []describeListenerAttributesWithContextCall{
+					{
+						req: &elbv2sdkv2.DescribeListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+						},
+						resp: &elbv2sdkv2.DescribeListenerAttributesOutput{
+							Attributes: []elbv2typesv2.ListenerAttribute{
+								{
+									Key:   awssdk.String("tcp.idle_timeout.seconds"),
+									Value: awssdk.String("400"),
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "80"),
+					Spec: elbv2model.ListenerSpec{
+						ListenerAttributes: []elbv2model.ListenerAttribute{
+							{
+								Key:   "tcp.idle_timeout.seconds",
+								Value: "400",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "attributes aren't described when none are desired",
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "80"),
+					Spec:         elbv2model.ListenerSpec{},
+				},
+			},
+		},
+		{
+			name: "describe attributes fails",
+			fields: fields{
+				describeListenerAttributesWithContextCalls: []describeListenerAttributesWithContextCall{
+					{
+						req: &elbv2sdkv2.DescribeListenerAttributesInput{
+							ListenerArn: awssdk.String("my-arn"),
+						},
+						err: errors.New("some error"),
+					},
+				},
+			},
+			args: args{
+				sdkLS: sdkLS,
+				resLS: &elbv2model.Listener{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::Listener", "80"),
+					Spec: elbv2model.ListenerSpec{
+						ListenerAttributes: []elbv2model.ListenerAttribute{
+							{
+								Key:   "tcp.idle_timeout.seconds",
+								Value: "400",
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.describeListenerAttributesWithContextCalls {
+				elbv2Client.EXPECT().DescribeListenerAttributesWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.modifyListenerAttributesWithContextCalls {
+				elbv2Client.EXPECT().ModifyListenerAttributesWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			r := &defaultListenerAttributesReconciler{
+				elbv2Client: elbv2Client,
+				logger:      logr.New(&log.NullLogSink{}),
+			}
+			err := r.Reconcile(context.Background(), tt.args.resLS, tt.args.sdkLS)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package elbv2
 
 import (
 	"context"
+	"fmt"
 	"time"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -13,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -29,14 +32,17 @@ type ListenerManager interface {
 }
 
 func NewDefaultListenerManager(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
-	taggingManager TaggingManager, externalManagedTags []string, featureGates config.FeatureGates, logger logr.Logger) *defaultListenerManager {
+	taggingManager TaggingManager, externalManagedTags []string, featureGates config.FeatureGates, mutationVerifier *MutationVerifier,
+	logger logr.Logger) *defaultListenerManager {
 	return &defaultListenerManager{
 		elbv2Client:                 elbv2Client,
 		trackingProvider:            trackingProvider,
 		taggingManager:              taggingManager,
 		externalManagedTags:         externalManagedTags,
 		featureGates:                featureGates,
+		mutationVerifier:            mutationVerifier,
 		logger:                      logger,
+		attributesReconciler:        NewDefaultListenerAttributesReconciler(elbv2Client, logger),
 		waitLSExistencePollInterval: defaultWaitLSExistencePollInterval,
 		waitLSExistenceTimeout:      defaultWaitLSExistenceTimeout,
 	}
@@ -53,6 +59,10 @@ type defaultListenerManager struct {
 	featureGates        config.FeatureGates
 	logger              logr.Logger
 
+	attributesReconciler ListenerAttributesReconciler
+	// mutationVerifier verifies the modifications are visible, nil disables the verification.
+	mutationVerifier *MutationVerifier
+
 	waitLSExistencePollInterval time.Duration
 	waitLSExistenceTimeout      time.Duration
 }
@@ -76,19 +86,26 @@ func (m *defaultListenerManager) Create(ctx context.Context, resLS *elbv2model.L
 		return elbv2model.ListenerStatus{}, err
 	}
 	sdkLS := ListenerWithTags{
-		Listener: resp.Listeners[0],
+		Listener: &resp.Listeners[0],
 		Tags:     lsTags,
 	}
 	m.logger.Info("created listener",
 		"stackID", resLS.Stack().StackID(),
 		"resourceID", resLS.ID(),
-		"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn))
+		"arn", awssdk.ToString(sdkLS.Listener.ListenerArn))
+	audit.RecordMutation(ctx, audit.ActionCreate, "listener", awssdk.ToString(sdkLS.Listener.ListenerArn),
+		fmt.Sprintf("%v:%v", resLS.Spec.Protocol, resLS.Spec.Port))
 
 	if err := runtime.RetryImmediateOnError(m.waitLSExistencePollInterval, m.waitLSExistenceTimeout, isListenerNotFoundError, func() error {
 		return m.updateSDKListenerWithExtraCertificates(ctx, resLS, sdkLS, true)
 	}); err != nil {
 		return elbv2model.ListenerStatus{}, errors.Wrap(err, "failed to update extra certificates on listener")
 	}
+	if err := runtime.RetryImmediateOnError(m.waitLSExistencePollInterval, m.waitLSExistenceTimeout, isListenerNotFoundError, func() error {
+		return m.attributesReconciler.Reconcile(ctx, resLS, sdkLS)
+	}); err != nil {
+		return elbv2model.ListenerStatus{}, errors.Wrap(err, "failed to update listener attributes")
+	}
 	return buildResListenerStatus(sdkLS), nil
 }
 
@@ -104,6 +121,9 @@ func (m *defaultListenerManager) Update(ctx context.Context, resLS *elbv2model.L
 	if err := m.updateSDKListenerWithExtraCertificates(ctx, resLS, sdkLS, false); err != nil {
 		return elbv2model.ListenerStatus{}, err
 	}
+	if err := m.attributesReconciler.Reconcile(ctx, resLS, sdkLS); err != nil {
+		return elbv2model.ListenerStatus{}, err
+	}
 	return buildResListenerStatus(sdkLS), nil
 }
 
@@ -112,20 +132,22 @@ func (m *defaultListenerManager) Delete(ctx context.Context, sdkLS ListenerWithT
 		ListenerArn: sdkLS.Listener.ListenerArn,
 	}
 	m.logger.Info("deleting listener",
-		"arn", awssdk.StringValue(req.ListenerArn))
+		"arn", awssdk.ToString(req.ListenerArn))
 	if _, err := m.elbv2Client.DeleteListenerWithContext(ctx, req); err != nil {
 		return err
 	}
 	m.logger.Info("deleted listener",
-		"arn", awssdk.StringValue(req.ListenerArn))
+		"arn", awssdk.ToString(req.ListenerArn))
+	audit.RecordMutation(ctx, audit.ActionDelete, "listener", awssdk.ToString(req.ListenerArn), "")
 	return nil
 }
 
 func (m *defaultListenerManager) updateSDKListenerWithTags(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) error {
 	desiredLSTags := m.trackingProvider.ResourceTags(resLS.Stack(), resLS, resLS.Spec.Tags)
-	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkLS.Listener.ListenerArn), desiredLSTags,
+	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkLS.Listener.ListenerArn), desiredLSTags,
 		WithCurrentTags(sdkLS.Tags),
-		WithIgnoredTagKeys(m.externalManagedTags))
+		WithIgnoredTagKeys(m.externalManagedTags),
+		WithIgnoredTagKeys([]string{ListenerRulesChecksumTagKey}))
 }
 
 func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Context, resLS *elbv2model.Listener, sdkLS ListenerWithTags) error {
@@ -134,22 +156,37 @@ func (m *defaultListenerManager) updateSDKListenerWithSettings(ctx context.Conte
 		return err
 	}
 	desiredDefaultCerts, _ := buildSDKCertificates(resLS.Spec.Certificates)
-	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts) {
+	desiredMutualAuthentication, err := buildSDKMutualAuthenticationAttributes(ctx, resLS.Spec.MutualAuthentication)
+	if err != nil {
+		return err
+	}
+	if !isSDKListenerSettingsDrifted(resLS.Spec, sdkLS, desiredDefaultActions, desiredDefaultCerts, desiredMutualAuthentication) {
 		return nil
 	}
-	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts)
+	req := buildSDKModifyListenerInput(resLS.Spec, desiredDefaultActions, desiredDefaultCerts, desiredMutualAuthentication)
 	req.ListenerArn = sdkLS.Listener.ListenerArn
 	m.logger.Info("modifying listener",
 		"stackID", resLS.Stack().StackID(),
 		"resourceID", resLS.ID(),
-		"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn))
-	if _, err := m.elbv2Client.ModifyListenerWithContext(ctx, req); err != nil {
+		"arn", awssdk.ToString(sdkLS.Listener.ListenerArn))
+	lsARN := awssdk.ToString(sdkLS.Listener.ListenerArn)
+	if err := m.mutationVerifier.MutateAndVerify(ctx, verifiedResourceListener, lsARN, func() error {
+		_, err := m.elbv2Client.ModifyListenerWithContext(ctx, req)
+		return err
+	}, func() (bool, error) {
+		currentLS, err := m.mutationVerifier.describeListener(ctx, lsARN)
+		if err != nil {
+			return false, err
+		}
+		return !isSDKListenerSettingsDrifted(resLS.Spec, currentLS, desiredDefaultActions, desiredDefaultCerts, desiredMutualAuthentication), nil
+	}); err != nil {
 		return err
 	}
 	m.logger.Info("modified listener",
 		"stackID", resLS.Stack().StackID(),
 		"resourceID", resLS.ID(),
-		"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn))
+		"arn", awssdk.ToString(sdkLS.Listener.ListenerArn))
+	audit.RecordMutation(ctx, audit.ActionModify, "listener", awssdk.ToString(sdkLS.Listener.ListenerArn), "settings")
 	return nil
 }
 
@@ -166,7 +203,7 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 	desiredExtraCertARNs := sets.NewString()
 	_, desiredExtraCerts := buildSDKCertificates(resLS.Spec.Certificates)
 	for _, cert := range desiredExtraCerts {
-		desiredExtraCertARNs.Insert(awssdk.StringValue(cert.CertificateArn))
+		desiredExtraCertARNs.Insert(awssdk.ToString(cert.CertificateArn))
 	}
 	currentExtraCertARNs := sets.NewString()
 	if !isNewSDKListener {
@@ -180,7 +217,7 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 	for _, certARN := range currentExtraCertARNs.Difference(desiredExtraCertARNs).List() {
 		req := &elbv2sdk.RemoveListenerCertificatesInput{
 			ListenerArn: sdkLS.Listener.ListenerArn,
-			Certificates: []*elbv2sdk.Certificate{
+			Certificates: []elbv2types.Certificate{
 				{
 					CertificateArn: awssdk.String(certARN),
 				},
@@ -189,7 +226,7 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 		m.logger.Info("removing certificate from listener",
 			"stackID", resLS.Stack().StackID(),
 			"resourceID", resLS.ID(),
-			"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn),
+			"arn", awssdk.ToString(sdkLS.Listener.ListenerArn),
 			"certificateARN", certARN)
 		if _, err := m.elbv2Client.RemoveListenerCertificatesWithContext(ctx, req); err != nil {
 			return err
@@ -197,14 +234,16 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 		m.logger.Info("removed certificate from listener",
 			"stackID", resLS.Stack().StackID(),
 			"resourceID", resLS.ID(),
-			"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn),
+			"arn", awssdk.ToString(sdkLS.Listener.ListenerArn),
 			"certificateARN", certARN)
+		audit.RecordMutation(ctx, audit.ActionModify, "listener", awssdk.ToString(sdkLS.Listener.ListenerArn),
+			fmt.Sprintf("removed certificate %v", certARN))
 	}
 
 	for _, certARN := range desiredExtraCertARNs.Difference(currentExtraCertARNs).List() {
 		req := &elbv2sdk.AddListenerCertificatesInput{
 			ListenerArn: sdkLS.Listener.ListenerArn,
-			Certificates: []*elbv2sdk.Certificate{
+			Certificates: []elbv2types.Certificate{
 				{
 					CertificateArn: awssdk.String(certARN),
 				},
@@ -213,7 +252,7 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 		m.logger.Info("adding certificate to listener",
 			"stackID", resLS.Stack().StackID(),
 			"resourceID", resLS.ID(),
-			"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn),
+			"arn", awssdk.ToString(sdkLS.Listener.ListenerArn),
 			"certificateARN", certARN)
 		if _, err := m.elbv2Client.AddListenerCertificatesWithContext(ctx, req); err != nil {
 			return err
@@ -221,8 +260,10 @@ func (m *defaultListenerManager) updateSDKListenerWithExtraCertificates(ctx cont
 		m.logger.Info("added certificate to listener",
 			"stackID", resLS.Stack().StackID(),
 			"resourceID", resLS.ID(),
-			"arn", awssdk.StringValue(sdkLS.Listener.ListenerArn),
+			"arn", awssdk.ToString(sdkLS.Listener.ListenerArn),
 			"certificateARN", certARN)
+		audit.RecordMutation(ctx, audit.ActionModify, "listener", awssdk.ToString(sdkLS.Listener.ListenerArn),
+			fmt.Sprintf("added certificate %v", certARN))
 	}
 
 	return nil
@@ -238,19 +279,20 @@ func (m *defaultListenerManager) fetchSDKListenerExtraCertificateARNs(ctx contex
 	}
 	extraCertARNs := make([]string, 0, len(sdkCerts))
 	for _, cert := range sdkCerts {
-		if !awssdk.BoolValue(cert.IsDefault) {
-			extraCertARNs = append(extraCertARNs, awssdk.StringValue(cert.CertificateArn))
+		if !awssdk.ToBool(cert.IsDefault) {
+			extraCertARNs = append(extraCertARNs, awssdk.ToString(cert.CertificateArn))
 		}
 	}
 	return extraCertARNs, nil
 }
 
 func isSDKListenerSettingsDrifted(lsSpec elbv2model.ListenerSpec, sdkLS ListenerWithTags,
-	desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate) bool {
-	if lsSpec.Port != awssdk.Int64Value(sdkLS.Listener.Port) {
+	desiredDefaultActions []elbv2types.Action, desiredDefaultCerts []elbv2types.Certificate,
+	desiredMutualAuthentication *elbv2types.MutualAuthenticationAttributes) bool {
+	if lsSpec.Port != int64(awssdk.ToInt32(sdkLS.Listener.Port)) {
 		return true
 	}
-	if string(lsSpec.Protocol) != awssdk.StringValue(sdkLS.Listener.Protocol) {
+	if string(lsSpec.Protocol) != string(sdkLS.Listener.Protocol) {
 		return true
 	}
 	if !cmp.Equal(desiredDefaultActions, sdkLS.Listener.DefaultActions, elbv2equality.CompareOptionForActions()) {
@@ -259,16 +301,35 @@ func isSDKListenerSettingsDrifted(lsSpec elbv2model.ListenerSpec, sdkLS Listener
 	if !cmp.Equal(desiredDefaultCerts, sdkLS.Listener.Certificates, elbv2equality.CompareOptionForCertificates()) {
 		return true
 	}
-	if lsSpec.SSLPolicy != nil && awssdk.StringValue(lsSpec.SSLPolicy) != awssdk.StringValue(sdkLS.Listener.SslPolicy) {
+	if lsSpec.SSLPolicy != nil && awssdk.ToString(lsSpec.SSLPolicy) != awssdk.ToString(sdkLS.Listener.SslPolicy) {
+		return true
+	}
+	if len(lsSpec.ALPNPolicy) != 0 && !cmp.Equal(lsSpec.ALPNPolicy, sdkLS.Listener.AlpnPolicy, cmpopts.EquateEmpty()) {
 		return true
 	}
-	if len(lsSpec.ALPNPolicy) != 0 && !cmp.Equal(lsSpec.ALPNPolicy, awssdk.StringValueSlice(sdkLS.Listener.AlpnPolicy), cmpopts.EquateEmpty()) {
+	if desiredMutualAuthentication != nil && !isSDKMutualAuthenticationAttributesEqual(desiredMutualAuthentication, sdkLS.Listener.MutualAuthentication) {
 		return true
 	}
 
 	return false
 }
 
+// isSDKMutualAuthenticationAttributesEqual checks whether the mutual authentication settings of listener matches the desired one.
+// the ignoreClientCertificateExpiry setting is only meaningful in verify mode, which defaults to false.
+func isSDKMutualAuthenticationAttributesEqual(desired *elbv2types.MutualAuthenticationAttributes, current *elbv2types.MutualAuthenticationAttributes) bool {
+	if current == nil {
+		return awssdk.ToString(desired.Mode) == string(elbv2model.MutualAuthenticationModeOff)
+	}
+	if awssdk.ToString(desired.Mode) != awssdk.ToString(current.Mode) {
+		return false
+	}
+	if awssdk.ToString(desired.Mode) != string(elbv2model.MutualAuthenticationModeVerify) {
+		return true
+	}
+	return awssdk.ToString(desired.TrustStoreArn) == awssdk.ToString(current.TrustStoreArn) &&
+		awssdk.ToBool(desired.IgnoreClientCertificateExpiry) == awssdk.ToBool(current.IgnoreClientCertificateExpiry)
+}
+
 func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates config.FeatureGates) (*elbv2sdk.CreateListenerInput, error) {
 	ctx := context.Background()
 	lbARN, err := lsSpec.LoadBalancerARN.Resolve(ctx)
@@ -277,8 +338,8 @@ func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates co
 	}
 	sdkObj := &elbv2sdk.CreateListenerInput{}
 	sdkObj.LoadBalancerArn = awssdk.String(lbARN)
-	sdkObj.Port = awssdk.Int64(lsSpec.Port)
-	sdkObj.Protocol = awssdk.String(string(lsSpec.Protocol))
+	sdkObj.Port = awssdk.Int32(int32(lsSpec.Port))
+	sdkObj.Protocol = elbv2types.ProtocolEnum(lsSpec.Protocol)
 	defaultActions, err := buildSDKActions(lsSpec.DefaultActions, featureGates)
 	if err != nil {
 		return nil, err
@@ -287,33 +348,62 @@ func buildSDKCreateListenerInput(lsSpec elbv2model.ListenerSpec, featureGates co
 	sdkObj.Certificates, _ = buildSDKCertificates(lsSpec.Certificates)
 	sdkObj.SslPolicy = lsSpec.SSLPolicy
 	if len(lsSpec.ALPNPolicy) != 0 {
-		sdkObj.AlpnPolicy = awssdk.StringSlice(lsSpec.ALPNPolicy)
+		sdkObj.AlpnPolicy = lsSpec.ALPNPolicy
+	}
+	mutualAuthentication, err := buildSDKMutualAuthenticationAttributes(ctx, lsSpec.MutualAuthentication)
+	if err != nil {
+		return nil, err
 	}
+	sdkObj.MutualAuthentication = mutualAuthentication
 	return sdkObj, nil
 }
 
-func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultActions []*elbv2sdk.Action, desiredDefaultCerts []*elbv2sdk.Certificate) *elbv2sdk.ModifyListenerInput {
+func buildSDKModifyListenerInput(lsSpec elbv2model.ListenerSpec, desiredDefaultActions []elbv2types.Action, desiredDefaultCerts []elbv2types.Certificate,
+	desiredMutualAuthentication *elbv2types.MutualAuthenticationAttributes) *elbv2sdk.ModifyListenerInput {
 	sdkObj := &elbv2sdk.ModifyListenerInput{}
-	sdkObj.Port = awssdk.Int64(lsSpec.Port)
-	sdkObj.Protocol = awssdk.String(string(lsSpec.Protocol))
+	sdkObj.Port = awssdk.Int32(int32(lsSpec.Port))
+	sdkObj.Protocol = elbv2types.ProtocolEnum(lsSpec.Protocol)
 	sdkObj.DefaultActions = desiredDefaultActions
 	sdkObj.Certificates = desiredDefaultCerts
 	sdkObj.SslPolicy = lsSpec.SSLPolicy
 	if len(lsSpec.ALPNPolicy) != 0 {
-		sdkObj.AlpnPolicy = awssdk.StringSlice(lsSpec.ALPNPolicy)
+		sdkObj.AlpnPolicy = lsSpec.ALPNPolicy
 	}
+	sdkObj.MutualAuthentication = desiredMutualAuthentication
 	return sdkObj
 }
 
+// buildSDKMutualAuthenticationAttributes builds the mutual authentication settings for listener.
+// returns nil if mutual authentication isn't configured.
+func buildSDKMutualAuthenticationAttributes(ctx context.Context, modelAttrs *elbv2model.MutualAuthenticationAttributes) (*elbv2types.MutualAuthenticationAttributes, error) {
+	if modelAttrs == nil {
+		return nil, nil
+	}
+	sdkObj := &elbv2types.MutualAuthenticationAttributes{
+		Mode: awssdk.String(string(modelAttrs.Mode)),
+	}
+	if modelAttrs.Mode == elbv2model.MutualAuthenticationModeVerify {
+		if modelAttrs.TrustStoreARN != nil {
+			trustStoreARN, err := modelAttrs.TrustStoreARN.Resolve(ctx)
+			if err != nil {
+				return nil, err
+			}
+			sdkObj.TrustStoreArn = awssdk.String(trustStoreARN)
+		}
+		sdkObj.IgnoreClientCertificateExpiry = modelAttrs.IgnoreClientCertificateExpiry
+	}
+	return sdkObj, nil
+}
+
 // buildSDKCertificates builds the certificate list for listener.
 // returns the default certificates and extra certificates.
-func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]*elbv2sdk.Certificate, []*elbv2sdk.Certificate) {
+func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]elbv2types.Certificate, []elbv2types.Certificate) {
 	if len(modelCerts) == 0 {
 		return nil, nil
 	}
 
-	var defaultSDKCerts []*elbv2sdk.Certificate
-	var extraSDKCerts []*elbv2sdk.Certificate
+	var defaultSDKCerts []elbv2types.Certificate
+	var extraSDKCerts []elbv2types.Certificate
 	defaultSDKCerts = append(defaultSDKCerts, buildSDKCertificate(modelCerts[0]))
 	for _, cert := range modelCerts[1:] {
 		extraSDKCerts = append(extraSDKCerts, buildSDKCertificate(cert))
@@ -321,14 +411,14 @@ func buildSDKCertificates(modelCerts []elbv2model.Certificate) ([]*elbv2sdk.Cert
 	return defaultSDKCerts, extraSDKCerts
 }
 
-func buildSDKCertificate(modelCert elbv2model.Certificate) *elbv2sdk.Certificate {
-	return &elbv2sdk.Certificate{
+func buildSDKCertificate(modelCert elbv2model.Certificate) elbv2types.Certificate {
+	return elbv2types.Certificate{
 		CertificateArn: modelCert.CertificateARN,
 	}
 }
 
 func buildResListenerStatus(sdkLS ListenerWithTags) elbv2model.ListenerStatus {
 	return elbv2model.ListenerStatus{
-		ListenerARN: awssdk.StringValue(sdkLS.Listener.ListenerArn),
+		ListenerARN: awssdk.ToString(sdkLS.Listener.ListenerArn),
 	}
 }
@@ -1,9 +1,12 @@
 package elbv2
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
@@ -12,8 +15,9 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 	type args struct {
 		lsSpec                elbv2model.ListenerSpec
 		sdkLS                 ListenerWithTags
-		desiredDefaultActions []*elbv2sdk.Action
-		desiredDefaultCerts   []*elbv2sdk.Certificate
+		desiredDefaultActions []elbv2types.Action
+		desiredDefaultCerts   []elbv2types.Certificate
+		desiredMutualAuth     *elbv2types.MutualAuthenticationAttributes
 	}
 	tests := []struct {
 		name string
@@ -30,37 +34,37 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 					ALPNPolicy: []string{"HTTP2Preferred"},
 				},
 				sdkLS: ListenerWithTags{
-					Listener: &elbv2sdk.Listener{
-						Port:     awssdk.Int64(80),
-						Protocol: awssdk.String("HTTPS"),
-						Certificates: []*elbv2sdk.Certificate{
+					Listener: &elbv2types.Listener{
+						Port:     awssdk.Int32(80),
+						Protocol: elbv2types.ProtocolEnumHttps,
+						Certificates: []elbv2types.Certificate{
 							{
 								CertificateArn: awssdk.String("cert-arn1"),
 								IsDefault:      awssdk.Bool(true),
 							},
 						},
-						DefaultActions: []*elbv2sdk.Action{
+						DefaultActions: []elbv2types.Action{
 							{
-								Type: awssdk.String("fixed-response"),
-								FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+								Type: elbv2types.ActionTypeEnumFixedResponse,
+								FixedResponseConfig: &elbv2types.FixedResponseActionConfig{
 									StatusCode: awssdk.String("404"),
 								},
 							},
 						},
 						SslPolicy:  awssdk.String("ELBSecurityPolicy-FS-1-2-Res-2019-08"),
-						AlpnPolicy: awssdk.StringSlice([]string{"HTTP2Preferred"}),
+						AlpnPolicy: []string{"HTTP2Preferred"},
 					},
 				},
-				desiredDefaultCerts: []*elbv2sdk.Certificate{
+				desiredDefaultCerts: []elbv2types.Certificate{
 					{
 						CertificateArn: awssdk.String("cert-arn1"),
 						IsDefault:      awssdk.Bool(true),
 					},
 				},
-				desiredDefaultActions: []*elbv2sdk.Action{
+				desiredDefaultActions: []elbv2types.Action{
 					{
-						Type: awssdk.String("fixed-response"),
-						FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+						Type: elbv2types.ActionTypeEnumFixedResponse,
+						FixedResponseConfig: &elbv2types.FixedResponseActionConfig{
 							StatusCode: awssdk.String("404"),
 						},
 					},
@@ -85,37 +89,37 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 					},
 				},
 				sdkLS: ListenerWithTags{
-					Listener: &elbv2sdk.Listener{
-						Port:     awssdk.Int64(80),
-						Protocol: awssdk.String("HTTPS"),
-						Certificates: []*elbv2sdk.Certificate{
+					Listener: &elbv2types.Listener{
+						Port:     awssdk.Int32(80),
+						Protocol: elbv2types.ProtocolEnumHttps,
+						Certificates: []elbv2types.Certificate{
 							{
 								CertificateArn: awssdk.String("cert-arn1"),
 								IsDefault:      awssdk.Bool(true),
 							},
 						},
-						DefaultActions: []*elbv2sdk.Action{
+						DefaultActions: []elbv2types.Action{
 							{
-								Type: awssdk.String("fixed-response"),
-								FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+								Type: elbv2types.ActionTypeEnumFixedResponse,
+								FixedResponseConfig: &elbv2types.FixedResponseActionConfig{
 									StatusCode: awssdk.String("404"),
 								},
 							},
 						},
 						SslPolicy:  awssdk.String("ELBSecurityPolicy-FS-1-2-Res-2019-08"),
-						AlpnPolicy: awssdk.StringSlice([]string{"HTTP2Preferred"}),
+						AlpnPolicy: []string{"HTTP2Preferred"},
 					},
 				},
-				desiredDefaultCerts: []*elbv2sdk.Certificate{
+				desiredDefaultCerts: []elbv2types.Certificate{
 					{
 						CertificateArn: awssdk.String("cert-arn1"),
 						IsDefault:      awssdk.Bool(true),
 					},
 				},
-				desiredDefaultActions: []*elbv2sdk.Action{
+				desiredDefaultActions: []elbv2types.Action{
 					{
-						Type: awssdk.String("fixed-response"),
-						FixedResponseConfig: &elbv2sdk.FixedResponseActionConfig{
+						Type: elbv2types.ActionTypeEnumFixedResponse,
+						FixedResponseConfig: &elbv2types.FixedResponseActionConfig{
 							StatusCode: awssdk.String("404"),
 						},
 					},
@@ -131,20 +135,20 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 					SSLPolicy: awssdk.String("ELBSecurityPolicy-FS-1-2-Res-2019-08"),
 				},
 				sdkLS: ListenerWithTags{
-					Listener: &elbv2sdk.Listener{
-						Port:     awssdk.Int64(80),
-						Protocol: awssdk.String("HTTPS"),
-						Certificates: []*elbv2sdk.Certificate{
+					Listener: &elbv2types.Listener{
+						Port:     awssdk.Int32(80),
+						Protocol: elbv2types.ProtocolEnumHttps,
+						Certificates: []elbv2types.Certificate{
 							{
 								CertificateArn: awssdk.String("cert-arn1"),
 								IsDefault:      awssdk.Bool(true),
 							},
 						},
-						DefaultActions: []*elbv2sdk.Action{
+						DefaultActions: []elbv2types.Action{
 							{
-								Type: awssdk.String("forward-config"),
-								ForwardConfig: &elbv2sdk.ForwardActionConfig{
-									TargetGroups: []*elbv2sdk.TargetGroupTuple{
+								Type: elbv2types.ActionTypeEnum("forward-config"),
+								ForwardConfig: &elbv2types.ForwardActionConfig{
+									TargetGroups: []elbv2types.TargetGroupTuple{
 										{
 											TargetGroupArn: awssdk.String("target-group"),
 										},
@@ -153,20 +157,20 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 							},
 						},
 						SslPolicy:  awssdk.String("ELBSecurityPolicy-FS-1-2-Res-2019-08"),
-						AlpnPolicy: awssdk.StringSlice([]string{"HTTP2Preferred"}),
+						AlpnPolicy: []string{"HTTP2Preferred"},
 					},
 				},
-				desiredDefaultCerts: []*elbv2sdk.Certificate{
+				desiredDefaultCerts: []elbv2types.Certificate{
 					{
 						CertificateArn: awssdk.String("cert-arn1"),
 						IsDefault:      awssdk.Bool(true),
 					},
 				},
-				desiredDefaultActions: []*elbv2sdk.Action{
+				desiredDefaultActions: []elbv2types.Action{
 					{
-						Type: awssdk.String("forward-config"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnum("forward-config"),
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("target-group"),
 								},
@@ -176,10 +180,144 @@ func Test_isSDKListenerSettingsDrifted(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "listener hasn't drifted - mutual authentication off",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Port:     443,
+					Protocol: elbv2model.ProtocolHTTPS,
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2types.Listener{
+						Port:     awssdk.Int32(443),
+						Protocol: elbv2types.ProtocolEnumHttps,
+					},
+				},
+				desiredMutualAuth: &elbv2types.MutualAuthenticationAttributes{
+					Mode: awssdk.String("off"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "listener hasn't drifted - mutual authentication verify",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Port:     443,
+					Protocol: elbv2model.ProtocolHTTPS,
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2types.Listener{
+						Port:     awssdk.Int32(443),
+						Protocol: elbv2types.ProtocolEnumHttps,
+						MutualAuthentication: &elbv2types.MutualAuthenticationAttributes{
+							Mode:                          awssdk.String("verify"),
+							TrustStoreArn:                 awssdk.String("trust-store-arn"),
+							IgnoreClientCertificateExpiry: awssdk.Bool(false),
+						},
+					},
+				},
+				desiredMutualAuth: &elbv2types.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("trust-store-arn"),
+				},
+			},
+			want: false,
+		},
+		{
+			name: "listener has drifted - mutual authentication trust store changed",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Port:     443,
+					Protocol: elbv2model.ProtocolHTTPS,
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2types.Listener{
+						Port:     awssdk.Int32(443),
+						Protocol: elbv2types.ProtocolEnumHttps,
+						MutualAuthentication: &elbv2types.MutualAuthenticationAttributes{
+							Mode:          awssdk.String("verify"),
+							TrustStoreArn: awssdk.String("trust-store-arn"),
+						},
+					},
+				},
+				desiredMutualAuth: &elbv2types.MutualAuthenticationAttributes{
+					Mode:          awssdk.String("verify"),
+					TrustStoreArn: awssdk.String("another-trust-store-arn"),
+				},
+			},
+			want: true,
+		},
+		{
+			name: "listener has drifted - mutual authentication mode changed",
+			args: args{
+				lsSpec: elbv2model.ListenerSpec{
+					Port:     443,
+					Protocol: elbv2model.ProtocolHTTPS,
+				},
+				sdkLS: ListenerWithTags{
+					Listener: &elbv2types.Listener{
+						Port:     awssdk.Int32(443),
+						Protocol: elbv2types.ProtocolEnumHttps,
+						MutualAuthentication: &elbv2types.MutualAuthenticationAttributes{
+							Mode: awssdk.String("off"),
+						},
+					},
+				},
+				desiredMutualAuth: &elbv2types.MutualAuthenticationAttributes{
+					Mode: awssdk.String("passthrough"),
+				},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isSDKListenerSettingsDrifted(tt.args.lsSpec, tt.args.sdkLS, tt.args.desiredDefaultActions, tt.args.desiredDefaultCerts,
+				tt.args.desiredMutualAuth)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_buildSDKMutualAuthenticationAttributes(t *testing.T) {
+	tests := []struct {
+		name       string
+		modelAttrs *elbv2model.MutualAuthenticationAttributes
+		want       *elbv2types.MutualAuthenticationAttributes
+	}{
+		{
+			name:       "mutual authentication not configured",
+			modelAttrs: nil,
+			want:       nil,
+		},
+		{
+			name: "verify mode",
+			modelAttrs: &elbv2model.MutualAuthenticationAttributes{
+				Mode:                          elbv2model.MutualAuthenticationModeVerify,
+				TrustStoreARN:                 core.LiteralStringToken("trust-store-arn"),
+				IgnoreClientCertificateExpiry: awssdk.Bool(true),
+			},
+			want: &elbv2types.MutualAuthenticationAttributes{
+				Mode:                          awssdk.String("verify"),
+				TrustStoreArn:                 awssdk.String("trust-store-arn"),
+				IgnoreClientCertificateExpiry: awssdk.Bool(true),
+			},
+		},
+		{
+			name: "passthrough mode",
+			modelAttrs: &elbv2model.MutualAuthenticationAttributes{
+				Mode: elbv2model.MutualAuthenticationModePassthrough,
+			},
+			want: &elbv2types.MutualAuthenticationAttributes{
+				Mode: awssdk.String("passthrough"),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := isSDKListenerSettingsDrifted(tt.args.lsSpec, tt.args.sdkLS, tt.args.desiredDefaultActions, tt.args.desiredDefaultCerts)
+			got, err := buildSDKMutualAuthenticationAttributes(context.Background(), tt.modelAttrs)
+			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
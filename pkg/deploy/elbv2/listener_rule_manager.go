@@ -2,13 +2,17 @@ package elbv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	elbv2equality "sigs.k8s.io/aws-load-balancer-controller/pkg/equality/elbv2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -27,13 +31,15 @@ type ListenerRuleManager interface {
 
 // NewDefaultListenerRuleManager constructs new defaultListenerRuleManager.
 func NewDefaultListenerRuleManager(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
-	taggingManager TaggingManager, externalManagedTags []string, featureGates config.FeatureGates, logger logr.Logger) *defaultListenerRuleManager {
+	taggingManager TaggingManager, externalManagedTags []string, featureGates config.FeatureGates, mutationVerifier *MutationVerifier,
+	logger logr.Logger) *defaultListenerRuleManager {
 	return &defaultListenerRuleManager{
 		elbv2Client:                 elbv2Client,
 		trackingProvider:            trackingProvider,
 		taggingManager:              taggingManager,
 		externalManagedTags:         externalManagedTags,
 		featureGates:                featureGates,
+		mutationVerifier:            mutationVerifier,
 		logger:                      logger,
 		waitLSExistencePollInterval: defaultWaitLSExistencePollInterval,
 		waitLSExistenceTimeout:      defaultWaitLSExistenceTimeout,
@@ -49,6 +55,9 @@ type defaultListenerRuleManager struct {
 	featureGates        config.FeatureGates
 	logger              logr.Logger
 
+	// mutationVerifier verifies the modifications are visible, nil disables the verification.
+	mutationVerifier *MutationVerifier
+
 	waitLSExistencePollInterval time.Duration
 	waitLSExistenceTimeout      time.Duration
 }
@@ -74,7 +83,7 @@ func (m *defaultListenerRuleManager) Create(ctx context.Context, resLR *elbv2mod
 			return err
 		}
 		sdkLR = ListenerRuleWithTags{
-			ListenerRule: resp.Rules[0],
+			ListenerRule: &resp.Rules[0],
 			Tags:         ruleTags,
 		}
 		return nil
@@ -84,7 +93,9 @@ func (m *defaultListenerRuleManager) Create(ctx context.Context, resLR *elbv2mod
 	m.logger.Info("created listener rule",
 		"stackID", resLR.Stack().StackID(),
 		"resourceID", resLR.ID(),
-		"arn", awssdk.StringValue(sdkLR.ListenerRule.RuleArn))
+		"arn", awssdk.ToString(sdkLR.ListenerRule.RuleArn))
+	audit.RecordMutation(ctx, audit.ActionCreate, "listenerRule", awssdk.ToString(sdkLR.ListenerRule.RuleArn),
+		fmt.Sprintf("priority %v", resLR.Spec.Priority))
 
 	return buildResListenerRuleStatus(sdkLR), nil
 }
@@ -106,12 +117,13 @@ func (m *defaultListenerRuleManager) Delete(ctx context.Context, sdkLR ListenerR
 		RuleArn: sdkLR.ListenerRule.RuleArn,
 	}
 	m.logger.Info("deleting listener rule",
-		"arn", awssdk.StringValue(req.RuleArn))
+		"arn", awssdk.ToString(req.RuleArn))
 	if _, err := m.elbv2Client.DeleteRuleWithContext(ctx, req); err != nil {
 		return err
 	}
 	m.logger.Info("deleted listener rule",
-		"arn", awssdk.StringValue(req.RuleArn))
+		"arn", awssdk.ToString(req.RuleArn))
+	audit.RecordMutation(ctx, audit.ActionDelete, "listenerRule", awssdk.ToString(req.RuleArn), "")
 	return nil
 }
 
@@ -130,26 +142,38 @@ func (m *defaultListenerRuleManager) updateSDKListenerRuleWithSettings(ctx conte
 	m.logger.Info("modifying listener rule",
 		"stackID", resLR.Stack().StackID(),
 		"resourceID", resLR.ID(),
-		"arn", awssdk.StringValue(sdkLR.ListenerRule.RuleArn))
-	if _, err := m.elbv2Client.ModifyRuleWithContext(ctx, req); err != nil {
+		"arn", awssdk.ToString(sdkLR.ListenerRule.RuleArn))
+	ruleARN := awssdk.ToString(sdkLR.ListenerRule.RuleArn)
+	if err := m.mutationVerifier.MutateAndVerify(ctx, verifiedResourceListenerRule, ruleARN, func() error {
+		_, err := m.elbv2Client.ModifyRuleWithContext(ctx, req)
+		return err
+	}, func() (bool, error) {
+		currentLR, err := m.mutationVerifier.describeListenerRule(ctx, ruleARN)
+		if err != nil {
+			return false, err
+		}
+		return !isSDKListenerRuleSettingsDrifted(resLR.Spec, currentLR, desiredActions, desiredConditions), nil
+	}); err != nil {
 		return err
 	}
 	m.logger.Info("modified listener rule",
 		"stackID", resLR.Stack().StackID(),
 		"resourceID", resLR.ID(),
-		"arn", awssdk.StringValue(sdkLR.ListenerRule.RuleArn))
+		"arn", awssdk.ToString(sdkLR.ListenerRule.RuleArn))
+	audit.RecordMutation(ctx, audit.ActionModify, "listenerRule", awssdk.ToString(sdkLR.ListenerRule.RuleArn),
+		"actions and conditions")
 	return nil
 }
 
 func (m *defaultListenerRuleManager) updateSDKListenerRuleWithTags(ctx context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) error {
 	desiredTags := m.trackingProvider.ResourceTags(resLR.Stack(), resLR, resLR.Spec.Tags)
-	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkLR.ListenerRule.RuleArn), desiredTags,
+	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkLR.ListenerRule.RuleArn), desiredTags,
 		WithCurrentTags(sdkLR.Tags),
 		WithIgnoredTagKeys(m.externalManagedTags))
 }
 
 func isSDKListenerRuleSettingsDrifted(lrSpec elbv2model.ListenerRuleSpec, sdkLR ListenerRuleWithTags,
-	desiredActions []*elbv2sdk.Action, desiredConditions []*elbv2sdk.RuleCondition) bool {
+	desiredActions []elbv2types.Action, desiredConditions []elbv2types.RuleCondition) bool {
 
 	if !cmp.Equal(desiredActions, sdkLR.ListenerRule.Actions, elbv2equality.CompareOptionForActions()) {
 		return true
@@ -169,7 +193,7 @@ func buildSDKCreateListenerRuleInput(lrSpec elbv2model.ListenerRuleSpec, feature
 	}
 	sdkObj := &elbv2sdk.CreateRuleInput{}
 	sdkObj.ListenerArn = awssdk.String(lsARN)
-	sdkObj.Priority = awssdk.Int64(lrSpec.Priority)
+	sdkObj.Priority = awssdk.Int32(int32(lrSpec.Priority))
 	actions, err := buildSDKActions(lrSpec.Actions, featureGates)
 	if err != nil {
 		return nil, err
@@ -179,7 +203,7 @@ func buildSDKCreateListenerRuleInput(lrSpec elbv2model.ListenerRuleSpec, feature
 	return sdkObj, nil
 }
 
-func buildSDKModifyListenerRuleInput(_ elbv2model.ListenerRuleSpec, desiredActions []*elbv2sdk.Action, desiredConditions []*elbv2sdk.RuleCondition) *elbv2sdk.ModifyRuleInput {
+func buildSDKModifyListenerRuleInput(_ elbv2model.ListenerRuleSpec, desiredActions []elbv2types.Action, desiredConditions []elbv2types.RuleCondition) *elbv2sdk.ModifyRuleInput {
 	sdkObj := &elbv2sdk.ModifyRuleInput{}
 	sdkObj.Actions = desiredActions
 	sdkObj.Conditions = desiredConditions
@@ -188,6 +212,6 @@ func buildSDKModifyListenerRuleInput(_ elbv2model.ListenerRuleSpec, desiredActio
 
 func buildResListenerRuleStatus(sdkLR ListenerRuleWithTags) elbv2model.ListenerRuleStatus {
 	return elbv2model.ListenerRuleStatus{
-		RuleARN: awssdk.StringValue(sdkLR.ListenerRule.RuleArn),
+		RuleARN: awssdk.ToString(sdkLR.ListenerRule.RuleArn),
 	}
 }
@@ -2,23 +2,30 @@ package elbv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"strconv"
 )
 
 // NewListenerRuleSynthesizer constructs new listenerRuleSynthesizer.
+// fetchTracker is optional, the listener rules are always fetched if it's nil.
 func NewListenerRuleSynthesizer(elbv2Client services.ELBV2, taggingManager TaggingManager,
-	lrManager ListenerRuleManager, logger logr.Logger, stack core.Stack) *listenerRuleSynthesizer {
+	lrManager ListenerRuleManager, featureGates config.FeatureGates, fetchTracker *ListenerRulesFetchTracker,
+	logger logr.Logger, stack core.Stack) *listenerRuleSynthesizer {
 	return &listenerRuleSynthesizer{
 		elbv2Client:    elbv2Client,
 		lrManager:      lrManager,
 		logger:         logger,
 		taggingManager: taggingManager,
+		featureGates:   featureGates,
+		fetchTracker:   fetchTracker,
 		stack:          stack,
 	}
 }
@@ -28,6 +35,8 @@ type listenerRuleSynthesizer struct {
 	lrManager      ListenerRuleManager
 	logger         logr.Logger
 	taggingManager TaggingManager
+	featureGates   config.FeatureGates
+	fetchTracker   *ListenerRulesFetchTracker
 
 	stack core.Stack
 }
@@ -48,6 +57,12 @@ func (s *listenerRuleSynthesizer) Synthesize(ctx context.Context) error {
 			return err
 		}
 		resLRs := resLRsByLSARN[lsARN]
+		if s.fetchTracker != nil {
+			if err := s.synthesizeListenerRulesOnListenerIfChanged(ctx, lsARN, resLRs); err != nil {
+				return err
+			}
+			continue
+		}
 		if err := s.synthesizeListenerRulesOnListener(ctx, lsARN, resLRs); err != nil {
 			return err
 		}
@@ -60,11 +75,56 @@ func (s *listenerRuleSynthesizer) PostSynthesize(ctx context.Context) error {
 	return nil
 }
 
+// synthesizeListenerRulesOnListenerIfChanged synthesizes the listenerRules on Listener only if they're changed since last synthesize.
+// the checksum of synthesized listenerRules is tagged on the Listener, so that unchanged listenerRules are not fetched.
+func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListenerIfChanged(ctx context.Context, lsARN string, resLRs []*elbv2model.ListenerRule) error {
+	checksum, err := computeListenerRulesChecksum(resLRs, s.featureGates)
+	if err != nil {
+		return err
+	}
+	sdkChecksum, err := s.findSDKListenerRulesChecksum(ctx, lsARN)
+	if err != nil {
+		return err
+	}
+	if sdkChecksum == checksum {
+		if ruleARNsByPriority, ok := s.fetchTracker.lookup(lsARN, checksum); ok {
+			for _, resLR := range resLRs {
+				resLR.SetStatus(elbv2model.ListenerRuleStatus{RuleARN: ruleARNsByPriority[resLR.Spec.Priority]})
+			}
+			s.fetchTracker.metrics.observeSkippedFetch()
+			return nil
+		}
+	}
+
+	s.fetchTracker.forget(lsARN)
+	fullFetchTime := s.fetchTracker.now()
+	if err := s.synthesizeListenerRulesOnListener(ctx, lsARN, resLRs); err != nil {
+		return err
+	}
+	ruleARNsByPriority := make(map[int64]string, len(resLRs))
+	for _, resLR := range resLRs {
+		if resLR.Status != nil {
+			ruleARNsByPriority[resLR.Spec.Priority] = resLR.Status.RuleARN
+		}
+	}
+	if sdkChecksum != checksum {
+		if err := s.taggingManager.ReconcileTags(ctx, lsARN, map[string]string{ListenerRulesChecksumTagKey: checksum},
+			WithCurrentTags(map[string]string{ListenerRulesChecksumTagKey: sdkChecksum})); err != nil {
+			return err
+		}
+	}
+	s.fetchTracker.record(lsARN, checksum, ruleARNsByPriority, fullFetchTime)
+	return nil
+}
+
 func (s *listenerRuleSynthesizer) synthesizeListenerRulesOnListener(ctx context.Context, lsARN string, resLRs []*elbv2model.ListenerRule) error {
 	sdkLRs, err := s.findSDKListenersRulesOnLS(ctx, lsARN)
 	if err != nil {
 		return err
 	}
+	if s.fetchTracker != nil {
+		s.fetchTracker.metrics.observeFullFetch(len(sdkLRs))
+	}
 
 	matchedResAndSDKLRs, unmatchedResLRs, unmatchedSDKLRs := matchResAndSDKListenerRules(resLRs, sdkLRs)
 	for _, sdkLR := range unmatchedSDKLRs {
@@ -97,7 +157,7 @@ func (s *listenerRuleSynthesizer) findSDKListenersRulesOnLS(ctx context.Context,
 	}
 	nonDefaultRules := make([]ListenerRuleWithTags, 0, len(sdkLRs))
 	for _, rule := range sdkLRs {
-		if awssdk.BoolValue(rule.ListenerRule.IsDefault) {
+		if awssdk.ToBool(rule.ListenerRule.IsDefault) {
 			continue
 		}
 		nonDefaultRules = append(nonDefaultRules, rule)
@@ -105,6 +165,25 @@ func (s *listenerRuleSynthesizer) findSDKListenersRulesOnLS(ctx context.Context,
 	return nonDefaultRules, nil
 }
 
+// findSDKListenerRulesChecksum returns the checksum of listenerRules tagged on Listener, it's empty if not tagged.
+func (s *listenerRuleSynthesizer) findSDKListenerRulesChecksum(ctx context.Context, lsARN string) (string, error) {
+	req := &elbv2sdk.DescribeTagsInput{
+		ResourceArns: []string{lsARN},
+	}
+	resp, err := s.elbv2Client.DescribeTagsWithContext(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	for _, tagDescription := range resp.TagDescriptions {
+		for _, tag := range tagDescription.Tags {
+			if awssdk.ToString(tag.Key) == ListenerRulesChecksumTagKey {
+				return awssdk.ToString(tag.Value), nil
+			}
+		}
+	}
+	return "", nil
+}
+
 type resAndSDKListenerRulePair struct {
 	resLR *elbv2model.ListenerRule
 	sdkLR ListenerRuleWithTags
@@ -148,7 +227,7 @@ func mapResListenerRuleByPriority(resLRs []*elbv2model.ListenerRule) map[int64]*
 func mapSDKListenerRuleByPriority(sdkLRs []ListenerRuleWithTags) map[int64]ListenerRuleWithTags {
 	sdkLRByPriority := make(map[int64]ListenerRuleWithTags, len(sdkLRs))
 	for _, sdkLR := range sdkLRs {
-		priority, _ := strconv.ParseInt(awssdk.StringValue(sdkLR.ListenerRule.Priority), 10, 64)
+		priority, _ := strconv.ParseInt(awssdk.ToString(sdkLR.ListenerRule.Priority), 10, 64)
 		sdkLRByPriority[priority] = sdkLR
 	}
 	return sdkLRByPriority
@@ -0,0 +1,67 @@
+package elbv2
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemListenerRules = "listener_rules"
+
+	metricListenerRulesFetchesTotal = "fetches_total"
+	metricListenerRulesFetched      = "fetched"
+)
+
+const (
+	labelFetchResult = "result"
+
+	fetchResultFull    = "full"
+	fetchResultSkipped = "skipped"
+)
+
+// ListenerRulesFetchMetrics contains the metrics for fetching listener rules during synthesize.
+type ListenerRulesFetchMetrics struct {
+	fetchesTotal *prometheus.CounterVec
+	rulesFetched prometheus.Histogram
+}
+
+// NewListenerRulesFetchMetrics allocates and register new ListenerRulesFetchMetrics to registerer.
+func NewListenerRulesFetchMetrics(registerer prometheus.Registerer) (*ListenerRulesFetchMetrics, error) {
+	fetchesTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemListenerRules,
+		Name:      metricListenerRulesFetchesTotal,
+		Help:      "Total number of listener synthesizes by whether the listener rules are fully fetched or skipped as unchanged",
+	}, []string{labelFetchResult})
+	rulesFetched := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemListenerRules,
+		Name:      metricListenerRulesFetched,
+		Help:      "Number of listener rules fetched per listener synthesize",
+		Buckets:   []float64{5, 10, 25, 50, 100, 200, 400},
+	})
+
+	if err := registerer.Register(fetchesTotal); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricListenerRulesFetchesTotal)
+	}
+	if err := registerer.Register(rulesFetched); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricListenerRulesFetched)
+	}
+	return &ListenerRulesFetchMetrics{
+		fetchesTotal: fetchesTotal,
+		rulesFetched: rulesFetched,
+	}, nil
+}
+
+func (m *ListenerRulesFetchMetrics) observeFullFetch(rulesCount int) {
+	if m == nil {
+		return
+	}
+	m.fetchesTotal.WithLabelValues(fetchResultFull).Inc()
+	m.rulesFetched.Observe(float64(rulesCount))
+}
+
+func (m *ListenerRulesFetchMetrics) observeSkippedFetch() {
+	if m == nil {
+		return
+	}
+	m.fetchesTotal.WithLabelValues(fetchResultSkipped).Inc()
+}
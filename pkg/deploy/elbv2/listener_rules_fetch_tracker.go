@@ -0,0 +1,109 @@
+package elbv2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// ListenerRulesChecksumTagKey is the tag key on listeners for the checksum of listener rules synthesized last.
+	ListenerRulesChecksumTagKey = "elbv2.k8s.aws/rules-checksum"
+
+	// defaultListenerRulesFullFetchPeriod is the period after which listener rules are fetched even if unchanged,
+	// so that the listener rules modified out-of-band are corrected eventually.
+	defaultListenerRulesFullFetchPeriod = 1 * time.Hour
+)
+
+// NewListenerRulesFetchTracker constructs new ListenerRulesFetchTracker.
+func NewListenerRulesFetchTracker(metrics *ListenerRulesFetchMetrics) *ListenerRulesFetchTracker {
+	return &ListenerRulesFetchTracker{
+		fullFetchPeriod: defaultListenerRulesFullFetchPeriod,
+		metrics:         metrics,
+		entriesByLSARN:  make(map[string]listenerRulesFetchEntry),
+		now:             time.Now,
+	}
+}
+
+// ListenerRulesFetchTracker tracks the listener rules synthesized per listener,
+// so that fetching the listener rules can be skipped when they're unchanged since last synthesize.
+type ListenerRulesFetchTracker struct {
+	fullFetchPeriod time.Duration
+	metrics         *ListenerRulesFetchMetrics
+
+	entriesByLSARN      map[string]listenerRulesFetchEntry
+	entriesByLSARNMutex sync.Mutex
+	now                 func() time.Time
+}
+
+type listenerRulesFetchEntry struct {
+	checksum           string
+	ruleARNsByPriority map[int64]string
+	fullFetchTime      time.Time
+}
+
+// lookup returns the rule ARNs by priority if listener rules with checksum were synthesized within the full fetch period.
+func (t *ListenerRulesFetchTracker) lookup(lsARN string, checksum string) (map[int64]string, bool) {
+	t.entriesByLSARNMutex.Lock()
+	defer t.entriesByLSARNMutex.Unlock()
+	entry, exists := t.entriesByLSARN[lsARN]
+	if !exists || entry.checksum != checksum || t.now().Sub(entry.fullFetchTime) >= t.fullFetchPeriod {
+		return nil, false
+	}
+	return entry.ruleARNsByPriority, true
+}
+
+// record records the listener rules with checksum are synthesized after fetched at fullFetchTime.
+func (t *ListenerRulesFetchTracker) record(lsARN string, checksum string, ruleARNsByPriority map[int64]string, fullFetchTime time.Time) {
+	t.entriesByLSARNMutex.Lock()
+	defer t.entriesByLSARNMutex.Unlock()
+	t.entriesByLSARN[lsARN] = listenerRulesFetchEntry{
+		checksum:           checksum,
+		ruleARNsByPriority: ruleARNsByPriority,
+		fullFetchTime:      fullFetchTime,
+	}
+}
+
+// forget forgets the listener rules synthesized on listener, it must be invoked before listener rules are modified.
+func (t *ListenerRulesFetchTracker) forget(lsARN string) {
+	t.entriesByLSARNMutex.Lock()
+	defer t.entriesByLSARNMutex.Unlock()
+	delete(t.entriesByLSARN, lsARN)
+}
+
+// computeListenerRulesChecksum computes the checksum of listener rules, in format of "<rulesCount>.<sha256>".
+// the checksum is computed over the resolved API inputs, so that replaced target groups change the checksum.
+func computeListenerRulesChecksum(resLRs []*elbv2model.ListenerRule, featureGates config.FeatureGates) (string, error) {
+	type listenerRuleDigest struct {
+		Input interface{}
+		Tags  map[string]string
+	}
+	sortedResLRs := append([]*elbv2model.ListenerRule(nil), resLRs...)
+	sort.Slice(sortedResLRs, func(i, j int) bool {
+		return sortedResLRs[i].Spec.Priority < sortedResLRs[j].Spec.Priority
+	})
+	digests := make([]listenerRuleDigest, 0, len(sortedResLRs))
+	for _, resLR := range sortedResLRs {
+		input, err := buildSDKCreateListenerRuleInput(resLR.Spec, featureGates)
+		if err != nil {
+			return "", err
+		}
+		digests = append(digests, listenerRuleDigest{
+			Input: input,
+			Tags:  resLR.Spec.Tags,
+		})
+	}
+	payload, err := json.Marshal(digests)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(payload)
+	return fmt.Sprintf("%d.%s", len(digests), hex.EncodeToString(hash[:])), nil
+}
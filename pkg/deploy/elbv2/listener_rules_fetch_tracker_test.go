@@ -0,0 +1,224 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// recordingListenerRuleManager is a ListenerRuleManager that records the invoked operations in order.
+type recordingListenerRuleManager struct {
+	operations []string
+}
+
+func (m *recordingListenerRuleManager) Create(_ context.Context, resLR *elbv2model.ListenerRule) (elbv2model.ListenerRuleStatus, error) {
+	m.operations = append(m.operations, fmt.Sprintf("create:%v", resLR.Spec.Priority))
+	return elbv2model.ListenerRuleStatus{RuleARN: fmt.Sprintf("rule-arn-%v", resLR.Spec.Priority)}, nil
+}
+
+func (m *recordingListenerRuleManager) Update(_ context.Context, resLR *elbv2model.ListenerRule, sdkLR ListenerRuleWithTags) (elbv2model.ListenerRuleStatus, error) {
+	m.operations = append(m.operations, fmt.Sprintf("update:%v", resLR.Spec.Priority))
+	return elbv2model.ListenerRuleStatus{RuleARN: awssdk.ToString(sdkLR.ListenerRule.RuleArn)}, nil
+}
+
+func (m *recordingListenerRuleManager) Delete(_ context.Context, sdkLR ListenerRuleWithTags) error {
+	m.operations = append(m.operations, fmt.Sprintf("delete:%v", awssdk.ToString(sdkLR.ListenerRule.Priority)))
+	return nil
+}
+
+func newForwardListenerRule(stack coremodel.Stack, priority int64, tgARN string) *elbv2model.ListenerRule {
+	return elbv2model.NewListenerRule(stack, fmt.Sprintf("rule-%v", priority), elbv2model.ListenerRuleSpec{
+		ListenerARN: coremodel.LiteralStringToken("ls-arn"),
+		Priority:    priority,
+		Actions: []elbv2model.Action{
+			{
+				Type: elbv2model.ActionTypeForward,
+				ForwardConfig: &elbv2model.ForwardActionConfig{
+					TargetGroups: []elbv2model.TargetGroupTuple{
+						{TargetGroupARN: coremodel.LiteralStringToken(tgARN)},
+					},
+				},
+			},
+		},
+		Conditions: []elbv2model.RuleCondition{
+			{
+				Field: elbv2model.RuleConditionFieldPathPattern,
+				PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+					Values: []string{fmt.Sprintf("/path-%v", priority)},
+				},
+			},
+		},
+	})
+}
+
+func Test_computeListenerRulesChecksum(t *testing.T) {
+	featureGates := config.NewFeatureGates()
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Name: "awesome-group"})
+	lr1 := newForwardListenerRule(stack, 1, "tg-arn-1")
+	lr2 := newForwardListenerRule(stack, 2, "tg-arn-2")
+	lr2WithNewTG := newForwardListenerRule(stack, 2, "tg-arn-3")
+
+	checksum, err := computeListenerRulesChecksum([]*elbv2model.ListenerRule{lr1, lr2}, featureGates)
+	assert.NoError(t, err)
+	assert.Regexp(t, "^2\\.[0-9a-f]{64}$", checksum)
+
+	reorderedChecksum, err := computeListenerRulesChecksum([]*elbv2model.ListenerRule{lr2, lr1}, featureGates)
+	assert.NoError(t, err)
+	assert.Equal(t, checksum, reorderedChecksum)
+
+	changedChecksum, err := computeListenerRulesChecksum([]*elbv2model.ListenerRule{lr1, lr2WithNewTG}, featureGates)
+	assert.NoError(t, err)
+	assert.NotEqual(t, checksum, changedChecksum)
+
+	emptyChecksum, err := computeListenerRulesChecksum(nil, featureGates)
+	assert.NoError(t, err)
+	assert.Regexp(t, "^0\\.", emptyChecksum)
+}
+
+func TestListenerRulesFetchTracker_lookup(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ruleARNsByPriority := map[int64]string{1: "rule-arn-1"}
+	tests := []struct {
+		name      string
+		record    bool
+		forget    bool
+		checksum  string
+		elapsed   time.Duration
+		wantFound bool
+	}{
+		{
+			name:      "recorded with same checksum",
+			record:    true,
+			checksum:  "1.abc",
+			elapsed:   time.Minute,
+			wantFound: true,
+		},
+		{
+			name:     "recorded with different checksum",
+			record:   true,
+			checksum: "1.def",
+			elapsed:  time.Minute,
+		},
+		{
+			name:     "full fetch period elapsed",
+			record:   true,
+			checksum: "1.abc",
+			elapsed:  defaultListenerRulesFullFetchPeriod,
+		},
+		{
+			name:     "forgotten",
+			record:   true,
+			forget:   true,
+			checksum: "1.abc",
+			elapsed:  time.Minute,
+		},
+		{
+			name:     "never recorded",
+			checksum: "1.abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewListenerRulesFetchTracker(nil)
+			tracker.now = func() time.Time { return now.Add(tt.elapsed) }
+			if tt.record {
+				tracker.record("ls-arn", "1.abc", ruleARNsByPriority, now)
+			}
+			if tt.forget {
+				tracker.forget("ls-arn")
+			}
+			got, found := tracker.lookup("ls-arn", tt.checksum)
+			assert.Equal(t, tt.wantFound, found)
+			if tt.wantFound {
+				assert.Equal(t, ruleARNsByPriority, got)
+			}
+		})
+	}
+}
+
+func Test_listenerRuleSynthesizer_synthesizeListenerRulesOnListenerIfChanged(t *testing.T) {
+	featureGates := config.NewFeatureGates()
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Name: "awesome-group"})
+	resLRs := []*elbv2model.ListenerRule{newForwardListenerRule(stack, 1, "tg-arn-1")}
+	checksum, err := computeListenerRulesChecksum(resLRs, featureGates)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		taggedChecksum string
+		recorded       bool
+		wantFetched    bool
+		wantOperations []string
+	}{
+		{
+			name:           "unchanged listener rules are not fetched",
+			taggedChecksum: checksum,
+			recorded:       true,
+		},
+		{
+			name:           "listener rules are fetched when checksum not tracked",
+			taggedChecksum: checksum,
+			wantFetched:    true,
+			wantOperations: []string{"create:1"},
+		},
+		{
+			name:           "listener rules are fetched when tagged checksum differs",
+			taggedChecksum: "1.outdated",
+			recorded:       true,
+			wantFetched:    true,
+			wantOperations: []string{"create:1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeTagsWithContext(gomock.Any(), &elbv2sdk.DescribeTagsInput{
+				ResourceArns: []string{"ls-arn"},
+			}).Return(&elbv2sdk.DescribeTagsOutput{
+				TagDescriptions: []elbv2types.TagDescription{
+					{
+						ResourceArn: awssdk.String("ls-arn"),
+						Tags: []elbv2types.Tag{
+							{Key: awssdk.String(ListenerRulesChecksumTagKey), Value: awssdk.String(tt.taggedChecksum)},
+						},
+					},
+				},
+			}, nil)
+			taggingManager := NewMockTaggingManager(ctrl)
+			if tt.wantFetched {
+				taggingManager.EXPECT().ListListenerRules(gomock.Any(), "ls-arn").Return(nil, nil)
+			}
+			if tt.taggedChecksum != checksum {
+				taggingManager.EXPECT().ReconcileTags(gomock.Any(), "ls-arn", map[string]string{ListenerRulesChecksumTagKey: checksum}, gomock.Any()).Return(nil)
+			}
+			lrManager := &recordingListenerRuleManager{}
+			tracker := NewListenerRulesFetchTracker(nil)
+			if tt.recorded {
+				tracker.record("ls-arn", checksum, map[int64]string{1: "rule-arn-1"}, time.Now())
+			}
+
+			synthesizer := NewListenerRuleSynthesizer(elbv2Client, taggingManager, lrManager, featureGates, tracker, logr.Discard(), stack)
+			err := synthesizer.synthesizeListenerRulesOnListenerIfChanged(context.Background(), "ls-arn", resLRs)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOperations, lrManager.operations)
+			assert.Equal(t, "rule-arn-1", resLRs[0].Status.RuleARN)
+			_, found := tracker.lookup("ls-arn", checksum)
+			assert.True(t, found)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package elbv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -56,12 +59,14 @@ func (s *listenerSynthesizer) synthesizeListenersOnLB(ctx context.Context, lbARN
 	if err != nil {
 		return err
 	}
-	matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs := matchResAndSDKListeners(resLSs, sdkLSs)
-	for _, sdkLS := range unmatchedSDKLSs {
+	matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs, recreatedSDKLSs := matchResAndSDKListeners(resLSs, sdkLSs)
+	// listeners requested to be recreated must be deleted first, as there cannot be two listeners on the same port.
+	for _, sdkLS := range recreatedSDKLSs {
 		if err := s.lsManager.Delete(ctx, sdkLS); err != nil {
 			return err
 		}
 	}
+	// new listeners are created before removing the old ones, so that traffic keeps being served when listen ports changes.
 	for _, resLS := range unmatchedResLSs {
 		lsStatus, err := s.lsManager.Create(ctx, resLS)
 		if err != nil {
@@ -76,6 +81,45 @@ func (s *listenerSynthesizer) synthesizeListenersOnLB(ctx context.Context, lbARN
 		}
 		resAndSDKLS.resLS.SetStatus(lsStatus)
 	}
+	if len(unmatchedSDKLSs) == 0 {
+		return nil
+	}
+	for _, resLS := range unmatchedResLSs {
+		if err := s.verifyListenerCertificates(ctx, resLS); err != nil {
+			return err
+		}
+	}
+	for _, sdkLS := range unmatchedSDKLSs {
+		if err := s.lsManager.Delete(ctx, sdkLS); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyListenerCertificates verifies all certificates of listener are attached to the created listener.
+// The old listeners are kept until the new listeners are ready to terminate TLS connections.
+func (s *listenerSynthesizer) verifyListenerCertificates(ctx context.Context, resLS *elbv2model.Listener) error {
+	if len(resLS.Spec.Certificates) == 0 || resLS.Status == nil {
+		return nil
+	}
+	req := &elbv2sdk.DescribeListenerCertificatesInput{
+		ListenerArn: awssdk.String(resLS.Status.ListenerARN),
+	}
+	sdkCerts, err := s.elbv2Client.DescribeListenerCertificatesAsList(ctx, req)
+	if err != nil {
+		return err
+	}
+	attachedCertARNs := sets.NewString()
+	for _, cert := range sdkCerts {
+		attachedCertARNs.Insert(awssdk.ToString(cert.CertificateArn))
+	}
+	for _, cert := range resLS.Spec.Certificates {
+		if !attachedCertARNs.Has(awssdk.ToString(cert.CertificateARN)) {
+			return errors.Errorf("certificate %v not attached to listener %v yet, keeping existing listeners",
+				awssdk.ToString(cert.CertificateARN), resLS.Status.ListenerARN)
+		}
+	}
 	return nil
 }
 
@@ -89,10 +133,13 @@ type resAndSDKListenerPair struct {
 	sdkLS ListenerWithTags
 }
 
-func matchResAndSDKListeners(resLSs []*elbv2model.Listener, sdkLSs []ListenerWithTags) ([]resAndSDKListenerPair, []*elbv2model.Listener, []ListenerWithTags) {
+// matchResAndSDKListeners matches the listeners by port, the sdk listeners requested to be recreated are returned separately,
+// and their listeners are unmatched.
+func matchResAndSDKListeners(resLSs []*elbv2model.Listener, sdkLSs []ListenerWithTags) ([]resAndSDKListenerPair, []*elbv2model.Listener, []ListenerWithTags, []ListenerWithTags) {
 	var matchedResAndSDKLSs []resAndSDKListenerPair
 	var unmatchedResLSs []*elbv2model.Listener
 	var unmatchedSDKLSs []ListenerWithTags
+	var recreatedSDKLSs []ListenerWithTags
 
 	resLSByPort := mapResListenerByPort(resLSs)
 	sdkLSByPort := mapSDKListenerByPort(sdkLSs)
@@ -101,6 +148,11 @@ func matchResAndSDKListeners(resLSs []*elbv2model.Listener, sdkLSs []ListenerWit
 	for _, port := range resLSPorts.Intersection(sdkLSPorts).List() {
 		resLS := resLSByPort[port]
 		sdkLS := sdkLSByPort[port]
+		if isRecreationRequested(resLS.Spec.Tags, sdkLS.Tags) {
+			unmatchedResLSs = append(unmatchedResLSs, resLS)
+			recreatedSDKLSs = append(recreatedSDKLSs, sdkLS)
+			continue
+		}
 		matchedResAndSDKLSs = append(matchedResAndSDKLSs, resAndSDKListenerPair{
 			resLS: resLS,
 			sdkLS: sdkLS,
@@ -112,7 +164,7 @@ func matchResAndSDKListeners(resLSs []*elbv2model.Listener, sdkLSs []ListenerWit
 	for _, port := range sdkLSPorts.Difference(resLSPorts).List() {
 		unmatchedSDKLSs = append(unmatchedSDKLSs, sdkLSByPort[port])
 	}
-	return matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs
+	return matchedResAndSDKLSs, unmatchedResLSs, unmatchedSDKLSs, recreatedSDKLSs
 }
 
 func mapResListenerByPort(resLSs []*elbv2model.Listener) map[int64]*elbv2model.Listener {
@@ -126,7 +178,7 @@ func mapResListenerByPort(resLSs []*elbv2model.Listener) map[int64]*elbv2model.L
 func mapSDKListenerByPort(sdkLSs []ListenerWithTags) map[int64]ListenerWithTags {
 	sdkLSByPort := make(map[int64]ListenerWithTags, len(sdkLSs))
 	for _, ls := range sdkLSs {
-		sdkLSByPort[awssdk.Int64Value(ls.Listener.Port)] = ls
+		sdkLSByPort[int64(awssdk.ToInt32(ls.Listener.Port))] = ls
 	}
 	return sdkLSByPort
 }
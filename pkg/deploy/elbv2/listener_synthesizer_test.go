@@ -0,0 +1,207 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// recordingListenerManager is a ListenerManager that records the invoked operations in order.
+type recordingListenerManager struct {
+	operations []string
+	createErr  error
+}
+
+func (m *recordingListenerManager) Create(_ context.Context, resLS *elbv2model.Listener) (elbv2model.ListenerStatus, error) {
+	m.operations = append(m.operations, fmt.Sprintf("create:%v", resLS.Spec.Port))
+	if m.createErr != nil {
+		return elbv2model.ListenerStatus{}, m.createErr
+	}
+	return elbv2model.ListenerStatus{ListenerARN: fmt.Sprintf("ls-arn-%v", resLS.Spec.Port)}, nil
+}
+
+func (m *recordingListenerManager) Update(_ context.Context, resLS *elbv2model.Listener, _ ListenerWithTags) (elbv2model.ListenerStatus, error) {
+	m.operations = append(m.operations, fmt.Sprintf("update:%v", resLS.Spec.Port))
+	return elbv2model.ListenerStatus{ListenerARN: fmt.Sprintf("ls-arn-%v", resLS.Spec.Port)}, nil
+}
+
+func (m *recordingListenerManager) Delete(_ context.Context, sdkLS ListenerWithTags) error {
+	m.operations = append(m.operations, fmt.Sprintf("delete:%v", awssdk.ToInt32(sdkLS.Listener.Port)))
+	return nil
+}
+
+func Test_listenerSynthesizer_Synthesize(t *testing.T) {
+	type describeListenerCertificatesCall struct {
+		req  *elbv2sdk.DescribeListenerCertificatesInput
+		resp []elbv2types.Certificate
+		err  error
+	}
+	type resListener struct {
+		port            int64
+		protocol        elbv2model.Protocol
+		certificates    []string
+		recreationToken string
+	}
+	tests := []struct {
+		name                              string
+		resLSs                            []resListener
+		sdkLSPorts                        []int64
+		sdkLSRecreationTokens             map[int64]string
+		createErr                         error
+		describeListenerCertificatesCalls []describeListenerCertificatesCall
+		wantOperations                    []string
+		wantErr                           error
+	}{
+		{
+			name: "listener replaced by new port",
+			resLSs: []resListener{
+				{port: 443, protocol: elbv2model.ProtocolHTTPS, certificates: []string{"cert-1", "cert-2"}},
+			},
+			sdkLSPorts: []int64{8443},
+			describeListenerCertificatesCalls: []describeListenerCertificatesCall{
+				{
+					req: &elbv2sdk.DescribeListenerCertificatesInput{
+						ListenerArn: awssdk.String("ls-arn-443"),
+					},
+					resp: []elbv2types.Certificate{
+						{CertificateArn: awssdk.String("cert-1"), IsDefault: awssdk.Bool(true)},
+						{CertificateArn: awssdk.String("cert-2"), IsDefault: awssdk.Bool(false)},
+					},
+				},
+			},
+			wantOperations: []string{"create:443", "delete:8443"},
+		},
+		{
+			name: "new listeners are created and existing ones updated before removing old ones",
+			resLSs: []resListener{
+				{port: 80, protocol: elbv2model.ProtocolHTTP},
+				{port: 8080, protocol: elbv2model.ProtocolHTTP},
+			},
+			sdkLSPorts:     []int64{80, 81},
+			wantOperations: []string{"create:8080", "update:80", "delete:81"},
+		},
+		{
+			name: "old listener kept when certificate not attached to new listener yet",
+			resLSs: []resListener{
+				{port: 443, protocol: elbv2model.ProtocolHTTPS, certificates: []string{"cert-1", "cert-2"}},
+			},
+			sdkLSPorts: []int64{8443},
+			describeListenerCertificatesCalls: []describeListenerCertificatesCall{
+				{
+					req: &elbv2sdk.DescribeListenerCertificatesInput{
+						ListenerArn: awssdk.String("ls-arn-443"),
+					},
+					resp: []elbv2types.Certificate{
+						{CertificateArn: awssdk.String("cert-1"), IsDefault: awssdk.Bool(true)},
+					},
+				},
+			},
+			wantOperations: []string{"create:443"},
+			wantErr:        errors.New("certificate cert-2 not attached to listener ls-arn-443 yet, keeping existing listeners"),
+		},
+		{
+			name: "old listener kept when failed to create new listener",
+			resLSs: []resListener{
+				{port: 443, protocol: elbv2model.ProtocolHTTPS, certificates: []string{"cert-1"}},
+			},
+			sdkLSPorts:     []int64{80},
+			createErr:      errors.New("some error"),
+			wantOperations: []string{"create:443"},
+			wantErr:        errors.New("some error"),
+		},
+		{
+			name: "certificates are not verified when no listener is removed",
+			resLSs: []resListener{
+				{port: 80, protocol: elbv2model.ProtocolHTTP},
+				{port: 443, protocol: elbv2model.ProtocolHTTPS, certificates: []string{"cert-1"}},
+			},
+			sdkLSPorts:     []int64{80},
+			wantOperations: []string{"create:443", "update:80"},
+		},
+		{
+			name: "listener recreated on new recreation token",
+			resLSs: []resListener{
+				{port: 80, protocol: elbv2model.ProtocolHTTP, recreationToken: "token-2"},
+				{port: 8080, protocol: elbv2model.ProtocolHTTP},
+			},
+			sdkLSPorts:            []int64{80, 8080},
+			sdkLSRecreationTokens: map[int64]string{80: "token-1"},
+			wantOperations:        []string{"delete:80", "create:80", "update:8080"},
+		},
+		{
+			name: "listener recreated only once per recreation token",
+			resLSs: []resListener{
+				{port: 80, protocol: elbv2model.ProtocolHTTP, recreationToken: "token-1"},
+			},
+			sdkLSPorts:            []int64{80},
+			sdkLSRecreationTokens: map[int64]string{80: "token-1"},
+			wantOperations:        []string{"update:80"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.describeListenerCertificatesCalls {
+				elbv2Client.EXPECT().DescribeListenerCertificatesAsList(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			var sdkLSs []ListenerWithTags
+			for _, port := range tt.sdkLSPorts {
+				sdkLS := ListenerWithTags{
+					Listener: &elbv2types.Listener{
+						ListenerArn: awssdk.String(fmt.Sprintf("ls-arn-%v", port)),
+						Port:        awssdk.Int32(int32(port)),
+					},
+				}
+				if token, ok := tt.sdkLSRecreationTokens[port]; ok {
+					sdkLS.Tags = map[string]string{elbv2model.RecreationTokenTagKey: token}
+				}
+				sdkLSs = append(sdkLSs, sdkLS)
+			}
+			taggingManager := NewMockTaggingManager(ctrl)
+			taggingManager.EXPECT().ListListeners(gomock.Any(), "lb-arn").Return(sdkLSs, nil)
+			lsManager := &recordingListenerManager{createErr: tt.createErr}
+
+			stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+			for _, resLS := range tt.resLSs {
+				var certs []elbv2model.Certificate
+				for _, certARN := range resLS.certificates {
+					certs = append(certs, elbv2model.Certificate{CertificateARN: awssdk.String(certARN)})
+				}
+				var tags map[string]string
+				if resLS.recreationToken != "" {
+					tags = map[string]string{elbv2model.RecreationTokenTagKey: resLS.recreationToken}
+				}
+				elbv2model.NewListener(stack, fmt.Sprintf("%v", resLS.port), elbv2model.ListenerSpec{
+					LoadBalancerARN: coremodel.LiteralStringToken("lb-arn"),
+					Port:            resLS.port,
+					Protocol:        resLS.protocol,
+					Certificates:    certs,
+					Tags:            tags,
+				})
+			}
+
+			synthesizer := NewListenerSynthesizer(elbv2Client, taggingManager, lsManager, logr.Discard(), stack)
+			err := synthesizer.Synthesize(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOperations, lsManager.operations)
+		})
+	}
+}
@@ -2,9 +2,11 @@ package elbv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -16,25 +18,25 @@ const (
 	defaultWaitLSExistenceTimeout      = 20 * time.Second
 )
 
-func buildSDKActions(modelActions []elbv2model.Action, featureGates config.FeatureGates) ([]*elbv2sdk.Action, error) {
-	var sdkActions []*elbv2sdk.Action
+func buildSDKActions(modelActions []elbv2model.Action, featureGates config.FeatureGates) ([]elbv2types.Action, error) {
+	var sdkActions []elbv2types.Action
 	if len(modelActions) != 0 {
-		sdkActions = make([]*elbv2sdk.Action, 0, len(modelActions))
+		sdkActions = make([]elbv2types.Action, 0, len(modelActions))
 		for index, modelAction := range modelActions {
 			sdkAction, err := buildSDKAction(modelAction, featureGates)
 			if err != nil {
 				return nil, err
 			}
-			sdkAction.Order = awssdk.Int64(int64(index) + 1)
+			sdkAction.Order = awssdk.Int32(int32(index) + 1)
 			sdkActions = append(sdkActions, sdkAction)
 		}
 	}
 	return sdkActions, nil
 }
 
-func buildSDKAction(modelAction elbv2model.Action, featureGates config.FeatureGates) (*elbv2sdk.Action, error) {
-	sdkObj := &elbv2sdk.Action{}
-	sdkObj.Type = awssdk.String(string(modelAction.Type))
+func buildSDKAction(modelAction elbv2model.Action, featureGates config.FeatureGates) (elbv2types.Action, error) {
+	sdkObj := elbv2types.Action{}
+	sdkObj.Type = elbv2types.ActionTypeEnum(modelAction.Type)
 	if modelAction.AuthenticateCognitoConfig != nil {
 		sdkObj.AuthenticateCognitoConfig = buildSDKAuthenticateCognitoActionConfig(*modelAction.AuthenticateCognitoConfig)
 	}
@@ -50,13 +52,13 @@ func buildSDKAction(modelAction elbv2model.Action, featureGates config.FeatureGa
 	if modelAction.ForwardConfig != nil {
 		forwardConfig, err := buildSDKForwardActionConfig(*modelAction.ForwardConfig)
 		if err != nil {
-			return nil, err
+			return elbv2types.Action{}, err
 		}
 		if !featureGates.Enabled(config.WeightedTargetGroups) {
 			if len(forwardConfig.TargetGroups) == 1 {
 				sdkObj.TargetGroupArn = forwardConfig.TargetGroups[0].TargetGroupArn
 			} else {
-				return nil, errors.New("weighted target groups feature is disabled")
+				return elbv2types.Action{}, errors.New("weighted target groups feature is disabled")
 			}
 		} else {
 			sdkObj.ForwardConfig = forwardConfig
@@ -65,10 +67,10 @@ func buildSDKAction(modelAction elbv2model.Action, featureGates config.FeatureGa
 	return sdkObj, nil
 }
 
-func buildSDKAuthenticateCognitoActionConfig(modelCfg elbv2model.AuthenticateCognitoActionConfig) *elbv2sdk.AuthenticateCognitoActionConfig {
-	return &elbv2sdk.AuthenticateCognitoActionConfig{
-		AuthenticationRequestExtraParams: awssdk.StringMap(modelCfg.AuthenticationRequestExtraParams),
-		OnUnauthenticatedRequest:         (*string)(modelCfg.OnUnauthenticatedRequest),
+func buildSDKAuthenticateCognitoActionConfig(modelCfg elbv2model.AuthenticateCognitoActionConfig) *elbv2types.AuthenticateCognitoActionConfig {
+	return &elbv2types.AuthenticateCognitoActionConfig{
+		AuthenticationRequestExtraParams: modelCfg.AuthenticationRequestExtraParams,
+		OnUnauthenticatedRequest:         elbv2types.AuthenticateCognitoActionConditionalBehaviorEnum(awssdk.ToString((*string)(modelCfg.OnUnauthenticatedRequest))),
 		Scope:                            modelCfg.Scope,
 		SessionCookieName:                modelCfg.SessionCookieName,
 		SessionTimeout:                   modelCfg.SessionTimeout,
@@ -78,10 +80,10 @@ func buildSDKAuthenticateCognitoActionConfig(modelCfg elbv2model.AuthenticateCog
 	}
 }
 
-func buildSDKAuthenticateOidcActionConfig(modelCfg elbv2model.AuthenticateOIDCActionConfig) *elbv2sdk.AuthenticateOidcActionConfig {
-	return &elbv2sdk.AuthenticateOidcActionConfig{
-		AuthenticationRequestExtraParams: awssdk.StringMap(modelCfg.AuthenticationRequestExtraParams),
-		OnUnauthenticatedRequest:         (*string)(modelCfg.OnUnauthenticatedRequest),
+func buildSDKAuthenticateOidcActionConfig(modelCfg elbv2model.AuthenticateOIDCActionConfig) *elbv2types.AuthenticateOidcActionConfig {
+	return &elbv2types.AuthenticateOidcActionConfig{
+		AuthenticationRequestExtraParams: modelCfg.AuthenticationRequestExtraParams,
+		OnUnauthenticatedRequest:         elbv2types.AuthenticateOidcActionConditionalBehaviorEnum(awssdk.ToString((*string)(modelCfg.OnUnauthenticatedRequest))),
 		Scope:                            modelCfg.Scope,
 		SessionCookieName:                modelCfg.SessionCookieName,
 		SessionTimeout:                   modelCfg.SessionTimeout,
@@ -94,43 +96,43 @@ func buildSDKAuthenticateOidcActionConfig(modelCfg elbv2model.AuthenticateOIDCAc
 	}
 }
 
-func buildSDKFixedResponseActionConfig(modelCfg elbv2model.FixedResponseActionConfig) *elbv2sdk.FixedResponseActionConfig {
-	return &elbv2sdk.FixedResponseActionConfig{
+func buildSDKFixedResponseActionConfig(modelCfg elbv2model.FixedResponseActionConfig) *elbv2types.FixedResponseActionConfig {
+	return &elbv2types.FixedResponseActionConfig{
 		ContentType: modelCfg.ContentType,
 		MessageBody: modelCfg.MessageBody,
 		StatusCode:  awssdk.String(modelCfg.StatusCode),
 	}
 }
 
-func buildSDKRedirectActionConfig(modelCfg elbv2model.RedirectActionConfig) *elbv2sdk.RedirectActionConfig {
-	return &elbv2sdk.RedirectActionConfig{
+func buildSDKRedirectActionConfig(modelCfg elbv2model.RedirectActionConfig) *elbv2types.RedirectActionConfig {
+	return &elbv2types.RedirectActionConfig{
 		Host:       modelCfg.Host,
 		Path:       modelCfg.Path,
 		Port:       modelCfg.Port,
 		Protocol:   modelCfg.Protocol,
 		Query:      modelCfg.Query,
-		StatusCode: awssdk.String(modelCfg.StatusCode),
+		StatusCode: elbv2types.RedirectActionStatusCodeEnum(modelCfg.StatusCode),
 	}
 }
 
-func buildSDKForwardActionConfig(modelCfg elbv2model.ForwardActionConfig) (*elbv2sdk.ForwardActionConfig, error) {
+func buildSDKForwardActionConfig(modelCfg elbv2model.ForwardActionConfig) (*elbv2types.ForwardActionConfig, error) {
 	ctx := context.Background()
-	sdkObj := &elbv2sdk.ForwardActionConfig{}
-	var tgTuples []*elbv2sdk.TargetGroupTuple
+	sdkObj := &elbv2types.ForwardActionConfig{}
+	var tgTuples []elbv2types.TargetGroupTuple
 	for _, tgt := range modelCfg.TargetGroups {
 		tgARN, err := tgt.TargetGroupARN.Resolve(ctx)
 		if err != nil {
 			return nil, err
 		}
-		tgTuples = append(tgTuples, &elbv2sdk.TargetGroupTuple{
+		tgTuples = append(tgTuples, elbv2types.TargetGroupTuple{
 			TargetGroupArn: awssdk.String(tgARN),
-			Weight:         tgt.Weight,
+			Weight:         int32PtrFromInt64Ptr(tgt.Weight),
 		})
 	}
 	sdkObj.TargetGroups = tgTuples
 	if modelCfg.TargetGroupStickinessConfig != nil {
-		sdkObj.TargetGroupStickinessConfig = &elbv2sdk.TargetGroupStickinessConfig{
-			DurationSeconds: modelCfg.TargetGroupStickinessConfig.DurationSeconds,
+		sdkObj.TargetGroupStickinessConfig = &elbv2types.TargetGroupStickinessConfig{
+			DurationSeconds: int32PtrFromInt64Ptr(modelCfg.TargetGroupStickinessConfig.DurationSeconds),
 			Enabled:         modelCfg.TargetGroupStickinessConfig.Enabled,
 		}
 	}
@@ -138,10 +140,10 @@ func buildSDKForwardActionConfig(modelCfg elbv2model.ForwardActionConfig) (*elbv
 	return sdkObj, nil
 }
 
-func buildSDKRuleConditions(modelConditions []elbv2model.RuleCondition) []*elbv2sdk.RuleCondition {
-	var sdkConditions []*elbv2sdk.RuleCondition
+func buildSDKRuleConditions(modelConditions []elbv2model.RuleCondition) []elbv2types.RuleCondition {
+	var sdkConditions []elbv2types.RuleCondition
 	if len(modelConditions) != 0 {
-		sdkConditions = make([]*elbv2sdk.RuleCondition, 0, len(modelConditions))
+		sdkConditions = make([]elbv2types.RuleCondition, 0, len(modelConditions))
 		for _, modelCondition := range modelConditions {
 			sdkCondition := buildSDKRuleCondition(modelCondition)
 			sdkConditions = append(sdkConditions, sdkCondition)
@@ -150,8 +152,8 @@ func buildSDKRuleConditions(modelConditions []elbv2model.RuleCondition) []*elbv2
 	return sdkConditions
 }
 
-func buildSDKRuleCondition(modelCondition elbv2model.RuleCondition) *elbv2sdk.RuleCondition {
-	sdkObj := &elbv2sdk.RuleCondition{}
+func buildSDKRuleCondition(modelCondition elbv2model.RuleCondition) elbv2types.RuleCondition {
+	sdkObj := elbv2types.RuleCondition{}
 	sdkObj.Field = awssdk.String(string(modelCondition.Field))
 	if modelCondition.HostHeaderConfig != nil {
 		sdkObj.HostHeaderConfig = buildSDKHostHeaderConditionConfig(*modelCondition.HostHeaderConfig)
@@ -174,54 +176,62 @@ func buildSDKRuleCondition(modelCondition elbv2model.RuleCondition) *elbv2sdk.Ru
 	return sdkObj
 }
 
-func buildSDKHostHeaderConditionConfig(modelCfg elbv2model.HostHeaderConditionConfig) *elbv2sdk.HostHeaderConditionConfig {
-	return &elbv2sdk.HostHeaderConditionConfig{
-		Values: awssdk.StringSlice(modelCfg.Values),
+func buildSDKHostHeaderConditionConfig(modelCfg elbv2model.HostHeaderConditionConfig) *elbv2types.HostHeaderConditionConfig {
+	return &elbv2types.HostHeaderConditionConfig{
+		Values: modelCfg.Values,
 	}
 }
 
-func buildSDKHTTPHeaderConditionConfig(modelCfg elbv2model.HTTPHeaderConditionConfig) *elbv2sdk.HttpHeaderConditionConfig {
-	return &elbv2sdk.HttpHeaderConditionConfig{
+func buildSDKHTTPHeaderConditionConfig(modelCfg elbv2model.HTTPHeaderConditionConfig) *elbv2types.HttpHeaderConditionConfig {
+	return &elbv2types.HttpHeaderConditionConfig{
 		HttpHeaderName: awssdk.String(modelCfg.HTTPHeaderName),
-		Values:         awssdk.StringSlice(modelCfg.Values),
+		Values:         modelCfg.Values,
 	}
 }
 
-func buildSDKHTTPRequestMethodConditionConfig(modelCfg elbv2model.HTTPRequestMethodConditionConfig) *elbv2sdk.HttpRequestMethodConditionConfig {
-	return &elbv2sdk.HttpRequestMethodConditionConfig{
-		Values: awssdk.StringSlice(modelCfg.Values),
+func buildSDKHTTPRequestMethodConditionConfig(modelCfg elbv2model.HTTPRequestMethodConditionConfig) *elbv2types.HttpRequestMethodConditionConfig {
+	return &elbv2types.HttpRequestMethodConditionConfig{
+		Values: modelCfg.Values,
 	}
 }
 
-func buildSDKPathPatternConditionConfig(modelCfg elbv2model.PathPatternConditionConfig) *elbv2sdk.PathPatternConditionConfig {
-	return &elbv2sdk.PathPatternConditionConfig{
-		Values: awssdk.StringSlice(modelCfg.Values),
+func buildSDKPathPatternConditionConfig(modelCfg elbv2model.PathPatternConditionConfig) *elbv2types.PathPatternConditionConfig {
+	return &elbv2types.PathPatternConditionConfig{
+		Values: modelCfg.Values,
 	}
 }
 
-func buildSDKQueryStringConditionConfig(modelCfg elbv2model.QueryStringConditionConfig) *elbv2sdk.QueryStringConditionConfig {
-	kvPairs := make([]*elbv2sdk.QueryStringKeyValuePair, 0, len(modelCfg.Values))
+func buildSDKQueryStringConditionConfig(modelCfg elbv2model.QueryStringConditionConfig) *elbv2types.QueryStringConditionConfig {
+	kvPairs := make([]elbv2types.QueryStringKeyValuePair, 0, len(modelCfg.Values))
 	for _, value := range modelCfg.Values {
-		kvPairs = append(kvPairs, &elbv2sdk.QueryStringKeyValuePair{
+		kvPairs = append(kvPairs, elbv2types.QueryStringKeyValuePair{
 			Key:   value.Key,
 			Value: awssdk.String(value.Value),
 		})
 	}
-	return &elbv2sdk.QueryStringConditionConfig{
+	return &elbv2types.QueryStringConditionConfig{
 		Values: kvPairs,
 	}
 }
 
-func buildSDKSourceIpConditionConfig(modelCfg elbv2model.SourceIPConditionConfig) *elbv2sdk.SourceIpConditionConfig {
-	return &elbv2sdk.SourceIpConditionConfig{
-		Values: awssdk.StringSlice(modelCfg.Values),
+func buildSDKSourceIpConditionConfig(modelCfg elbv2model.SourceIPConditionConfig) *elbv2types.SourceIpConditionConfig {
+	return &elbv2types.SourceIpConditionConfig{
+		Values: modelCfg.Values,
 	}
 }
 
 func isListenerNotFoundError(err error) bool {
-	var awsErr awserr.Error
-	if errors.As(err, &awsErr) {
-		return awsErr.Code() == "ListenerNotFound"
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "ListenerNotFound"
 	}
 	return false
 }
+
+// int32PtrFromInt64Ptr converts the int64 fields of our model into the int32 fields of aws-sdk-go-v2.
+func int32PtrFromInt64Ptr(v *int64) *int32 {
+	if v == nil {
+		return nil
+	}
+	return awssdk.Int32(int32(*v))
+}
@@ -2,12 +2,16 @@ package elbv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -18,10 +22,11 @@ type LoadBalancerAttributeReconciler interface {
 }
 
 // NewDefaultLoadBalancerAttributeReconciler constructs new defaultLoadBalancerAttributeReconciler.
-func NewDefaultLoadBalancerAttributeReconciler(elbv2Client services.ELBV2, logger logr.Logger) *defaultLoadBalancerAttributeReconciler {
+func NewDefaultLoadBalancerAttributeReconciler(elbv2Client services.ELBV2, crossZoneValidator CrossZoneValidator, logger logr.Logger) *defaultLoadBalancerAttributeReconciler {
 	return &defaultLoadBalancerAttributeReconciler{
-		elbv2Client: elbv2Client,
-		logger:      logger,
+		elbv2Client:        elbv2Client,
+		crossZoneValidator: crossZoneValidator,
+		logger:             logger,
 	}
 }
 
@@ -29,8 +34,9 @@ var _ LoadBalancerAttributeReconciler = &defaultLoadBalancerAttributeReconciler{
 
 // default implementation for LoadBalancerAttributeReconciler
 type defaultLoadBalancerAttributeReconciler struct {
-	elbv2Client services.ELBV2
-	logger      logr.Logger
+	elbv2Client        services.ELBV2
+	crossZoneValidator CrossZoneValidator
+	logger             logr.Logger
 }
 
 func (r *defaultLoadBalancerAttributeReconciler) Reconcile(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
@@ -41,13 +47,18 @@ func (r *defaultLoadBalancerAttributeReconciler) Reconcile(ctx context.Context,
 	}
 
 	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
+	if attributesToUpdate[attrCrossZoneEnabled] == "false" && currentAttrs[attrCrossZoneEnabled] == "true" {
+		if err := r.crossZoneValidator.ValidateLoadBalancer(ctx, sdkLB.LoadBalancer); err != nil {
+			return err
+		}
+	}
 	if len(attributesToUpdate) > 0 {
 		req := &elbv2sdk.ModifyLoadBalancerAttributesInput{
 			LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
 			Attributes:      nil,
 		}
 		for _, attrKey := range sets.StringKeySet(attributesToUpdate).List() {
-			req.Attributes = append(req.Attributes, &elbv2sdk.LoadBalancerAttribute{
+			req.Attributes = append(req.Attributes, elbv2types.LoadBalancerAttribute{
 				Key:   awssdk.String(attrKey),
 				Value: awssdk.String(attributesToUpdate[attrKey]),
 			})
@@ -56,7 +67,7 @@ func (r *defaultLoadBalancerAttributeReconciler) Reconcile(ctx context.Context,
 		r.logger.Info("modifying loadBalancer attributes",
 			"stackID", resLB.Stack().StackID(),
 			"resourceID", resLB.ID(),
-			"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+			"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
 			"change", attributesToUpdate)
 		if _, err := r.elbv2Client.ModifyLoadBalancerAttributesWithContext(ctx, req); err != nil {
 			return err
@@ -64,7 +75,9 @@ func (r *defaultLoadBalancerAttributeReconciler) Reconcile(ctx context.Context,
 		r.logger.Info("modified loadBalancer attributes",
 			"stackID", resLB.Stack().StackID(),
 			"resourceID", resLB.ID(),
-			"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+			"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+		audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+			fmt.Sprintf("attributes %v", attributesToUpdate))
 	}
 	return nil
 }
@@ -88,7 +101,7 @@ func (r *defaultLoadBalancerAttributeReconciler) getCurrentLoadBalancerAttribute
 
 	lbAttributes := make(map[string]string, len(resp.Attributes))
 	for _, attr := range resp.Attributes {
-		lbAttributes[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+		lbAttributes[awssdk.ToString(attr.Key)] = awssdk.ToString(attr.Value)
 	}
 	return lbAttributes, nil
 }
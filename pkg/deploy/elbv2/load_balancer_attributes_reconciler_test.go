@@ -4,8 +4,9 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -29,9 +30,15 @@ func Test_defaultLoadBalancerAttributeReconciler_updateSDKLoadBalancerWithAttrib
 		err  error
 	}
 
+	type validateLoadBalancerCall struct {
+		sdkLB *elbv2types.LoadBalancer
+		err   error
+	}
+
 	type fields struct {
 		describeLoadBalancerAttributesWithContextCalls []describeLoadBalancerAttributesWithContextCall
 		modifyLoadBalancerAttributesWithContextCalls   []modifyLoadBalancerAttributesWithContextCall
+		validateLoadBalancerCalls                      []validateLoadBalancerCall
 	}
 	type args struct {
 		sdkLB LoadBalancerWithTags
@@ -54,7 +61,7 @@ func Test_defaultLoadBalancerAttributeReconciler_updateSDKLoadBalancerWithAttrib
 							LoadBalancerArn: awssdk.String("my-arn"),
 						},
 						resp: &elbv2sdk.DescribeLoadBalancerAttributesOutput{
-							Attributes: []*elbv2sdk.LoadBalancerAttribute{
+							Attributes: []elbv2types.LoadBalancerAttribute{
 								{
 									Key:   awssdk.String("idle_timeout.timeout_seconds"),
 									Value: awssdk.String("50"),
@@ -71,7 +78,7 @@ func Test_defaultLoadBalancerAttributeReconciler_updateSDKLoadBalancerWithAttrib
 					{
 						req: &elbv2sdk.ModifyLoadBalancerAttributesInput{
 							LoadBalancerArn: awssdk.String("my-arn"),
-							Attributes: []*elbv2sdk.LoadBalancerAttribute{
+							Attributes: []elbv2types.LoadBalancerAttribute{
 								{
 									Key:   awssdk.String("idle_timeout.timeout_seconds"),
 									Value: awssdk.String("100"),
@@ -87,7 +94,7 @@ func Test_defaultLoadBalancerAttributeReconciler_updateSDKLoadBalancerWithAttrib
 			},
 			args: args{
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						LoadBalancerArn: awssdk.String("my-arn"),
 					},
 				},
@@ -117,7 +124,7 @@ func Test_defaultLoadBalancerAttributeReconciler_updateSDKLoadBalancerWithAttrib
 							LoadBalancerArn: awssdk.String("my-arn"),
 						},
 						resp: &elbv2sdk.DescribeLoadBalancerAttributesOutput{
-							Attributes: []*elbv2sdk.LoadBalancerAttribute{
+							Attributes: []elbv2types.LoadBalancerAttribute{
 								{
 									Key:   awssdk.String("idle_timeout.timeout_seconds"),
 									Value: awssdk.String("50"),
@@ -134,7 +141,7 @@ func Test_defaultLoadBalancerAttributeReconciler_updateSDKLoadBalancerWithAttrib
 			},
 			args: args{
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						LoadBalancerArn: awssdk.String("my-arn"),
 					},
 				},
@@ -151,12 +158,63 @@ func Test_defaultLoadBalancerAttributeReconciler_updateSDKLoadBalancerWithAttrib
 				},
 			},
 		},
+		{
+			name: "disabling cross-zone load balancing is refused",
+			fields: fields{
+				describeLoadBalancerAttributesWithContextCalls: []describeLoadBalancerAttributesWithContextCall{
+					{
+						req: &elbv2sdk.DescribeLoadBalancerAttributesInput{
+							LoadBalancerArn: awssdk.String("my-arn"),
+						},
+						resp: &elbv2sdk.DescribeLoadBalancerAttributesOutput{
+							Attributes: []elbv2types.LoadBalancerAttribute{
+								{
+									Key:   awssdk.String("load_balancing.cross_zone.enabled"),
+									Value: awssdk.String("true"),
+								},
+							},
+						},
+					},
+				},
+				validateLoadBalancerCalls: []validateLoadBalancerCall{
+					{
+						sdkLB: &elbv2types.LoadBalancer{
+							LoadBalancerArn: awssdk.String("my-arn"),
+						},
+						err: errors.New("refusing to disable cross-zone load balancing on load balancer my-arn"),
+					},
+				},
+			},
+			args: args{
+				sdkLB: LoadBalancerWithTags{
+					LoadBalancer: &elbv2types.LoadBalancer{
+						LoadBalancerArn: awssdk.String("my-arn"),
+					},
+				},
+				resLB: &elbv2model.LoadBalancer{
+					ResourceMeta: coremodel.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::LoadBalancer", "id-1"),
+					Spec: elbv2model.LoadBalancerSpec{
+						LoadBalancerAttributes: []elbv2model.LoadBalancerAttribute{
+							{
+								Key:   "load_balancing.cross_zone.enabled",
+								Value: "false",
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("refusing to disable cross-zone load balancing on load balancer my-arn"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 			elbv2Client := services.NewMockELBV2(ctrl)
+			crossZoneValidator := NewMockCrossZoneValidator(ctrl)
+			for _, call := range tt.fields.validateLoadBalancerCalls {
+				crossZoneValidator.EXPECT().ValidateLoadBalancer(gomock.Any(), call.sdkLB).Return(call.err)
+			}
 			for _, call := range tt.fields.describeLoadBalancerAttributesWithContextCalls {
 				elbv2Client.EXPECT().DescribeLoadBalancerAttributesWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
 			}
@@ -164,8 +222,9 @@ func Test_defaultLoadBalancerAttributeReconciler_updateSDKLoadBalancerWithAttrib
 				elbv2Client.EXPECT().ModifyLoadBalancerAttributesWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
 			}
 			r := &defaultLoadBalancerAttributeReconciler{
-				elbv2Client: elbv2Client,
-				logger:      logr.New(&log.NullLogSink{}),
+				elbv2Client:        elbv2Client,
+				crossZoneValidator: crossZoneValidator,
+				logger:             logr.New(&log.NullLogSink{}),
 			}
 			err := r.Reconcile(context.Background(), tt.args.resLB, tt.args.sdkLB)
 			if tt.wantErr != nil {
@@ -258,7 +317,7 @@ func Test_defaultLoadBalancerAttributeReconciler_getCurrentLoadBalancerAttribute
 							LoadBalancerArn: awssdk.String("my-arn"),
 						},
 						resp: &elbv2sdk.DescribeLoadBalancerAttributesOutput{
-							Attributes: []*elbv2sdk.LoadBalancerAttribute{
+							Attributes: []elbv2types.LoadBalancerAttribute{
 								{
 									Key:   awssdk.String("keyA"),
 									Value: awssdk.String("valueA"),
@@ -274,7 +333,7 @@ func Test_defaultLoadBalancerAttributeReconciler_getCurrentLoadBalancerAttribute
 			},
 			args: args{
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						LoadBalancerArn: awssdk.String("my-arn"),
 					},
 					Tags: nil,
@@ -299,7 +358,7 @@ func Test_defaultLoadBalancerAttributeReconciler_getCurrentLoadBalancerAttribute
 			},
 			args: args{
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						LoadBalancerArn: awssdk.String("my-arn"),
 					},
 					Tags: nil,
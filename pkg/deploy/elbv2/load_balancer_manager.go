@@ -3,14 +3,20 @@ package elbv2
 import (
 	"context"
 	"fmt"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 )
 
 // LoadBalancerManager is responsible for create/update/delete LoadBalancer resources.
@@ -23,13 +29,14 @@ type LoadBalancerManager interface {
 }
 
 // NewDefaultLoadBalancerManager constructs new defaultLoadBalancerManager.
-func NewDefaultLoadBalancerManager(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
-	taggingManager TaggingManager, externalManagedTags []string, logger logr.Logger) *defaultLoadBalancerManager {
+func NewDefaultLoadBalancerManager(elbv2Client services.ELBV2, ec2Client services.EC2, trackingProvider tracking.Provider,
+	taggingManager TaggingManager, crossZoneValidator CrossZoneValidator, externalManagedTags []string, logger logr.Logger) *defaultLoadBalancerManager {
 	return &defaultLoadBalancerManager{
 		elbv2Client:          elbv2Client,
+		ec2Client:            ec2Client,
 		trackingProvider:     trackingProvider,
 		taggingManager:       taggingManager,
-		attributesReconciler: NewDefaultLoadBalancerAttributeReconciler(elbv2Client, logger),
+		attributesReconciler: NewDefaultLoadBalancerAttributeReconciler(elbv2Client, crossZoneValidator, logger),
 		externalManagedTags:  externalManagedTags,
 		logger:               logger,
 	}
@@ -40,6 +47,7 @@ var _ LoadBalancerManager = &defaultLoadBalancerManager{}
 // defaultLoadBalancerManager implement LoadBalancerManager
 type defaultLoadBalancerManager struct {
 	elbv2Client          services.ELBV2
+	ec2Client            services.EC2
 	trackingProvider     tracking.Provider
 	taggingManager       TaggingManager
 	attributesReconciler LoadBalancerAttributeReconciler
@@ -59,23 +67,38 @@ func (m *defaultLoadBalancerManager) Create(ctx context.Context, resLB *elbv2mod
 	m.logger.Info("creating loadBalancer",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID())
-	resp, err := m.elbv2Client.CreateLoadBalancerWithContext(ctx, req)
+	var reqOpts []func(*elbv2sdk.Options)
+	if resLB.Spec.IPAMPools != nil {
+		reqOpts = append(reqOpts, services.WithIpamPools(&services.IpamPools{
+			Ipv4IpamPoolId: awssdk.String(resLB.Spec.IPAMPools.IPv4IPAMPoolID),
+		}))
+	}
+	resp, err := m.elbv2Client.CreateLoadBalancerWithContext(ctx, req, reqOpts...)
 	if err != nil {
 		return elbv2model.LoadBalancerStatus{}, err
 	}
 	sdkLB := LoadBalancerWithTags{
-		LoadBalancer: resp.LoadBalancers[0],
+		LoadBalancer: &resp.LoadBalancers[0],
 		Tags:         lbTags,
 	}
 	m.logger.Info("created loadBalancer",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
-		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+	audit.RecordMutation(ctx, audit.ActionCreate, "loadBalancer", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn), "")
 	if err := m.attributesReconciler.Reconcile(ctx, resLB, sdkLB); err != nil {
 		return elbv2model.LoadBalancerStatus{}, err
 	}
-
-	return buildResLoadBalancerStatus(sdkLB), nil
+	lbStatus := buildResLoadBalancerStatus(sdkLB)
+	// new load balancers don't have any capacity reserved yet.
+	if resLB.Spec.MinimumLoadBalancerCapacity != nil {
+		capacityReservationState, err := m.updateSDKLoadBalancerWithCapacityReservation(ctx, resLB, sdkLB)
+		if err != nil {
+			return elbv2model.LoadBalancerStatus{}, err
+		}
+		lbStatus.CapacityReservationState = capacityReservationState
+	}
+	return lbStatus, nil
 }
 
 func (m *defaultLoadBalancerManager) Update(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (elbv2model.LoadBalancerStatus, error) {
@@ -91,13 +114,22 @@ func (m *defaultLoadBalancerManager) Update(ctx context.Context, resLB *elbv2mod
 	if err := m.updateSDKLoadBalancerWithIPAddressType(ctx, resLB, sdkLB); err != nil {
 		return elbv2model.LoadBalancerStatus{}, err
 	}
+	if err := m.updateSDKLoadBalancerWithIPAMPools(ctx, resLB, sdkLB); err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
 	if err := m.attributesReconciler.Reconcile(ctx, resLB, sdkLB); err != nil {
 		return elbv2model.LoadBalancerStatus{}, err
 	}
 	if err := m.checkSDKLoadBalancerWithCOIPv4Pool(ctx, resLB, sdkLB); err != nil {
 		return elbv2model.LoadBalancerStatus{}, err
 	}
-	return buildResLoadBalancerStatus(sdkLB), nil
+	capacityReservationState, err := m.updateSDKLoadBalancerWithCapacityReservation(ctx, resLB, sdkLB)
+	if err != nil {
+		return elbv2model.LoadBalancerStatus{}, err
+	}
+	lbStatus := buildResLoadBalancerStatus(sdkLB)
+	lbStatus.CapacityReservationState = capacityReservationState
+	return lbStatus, nil
 }
 
 func (m *defaultLoadBalancerManager) Delete(ctx context.Context, sdkLB LoadBalancerWithTags) error {
@@ -105,12 +137,13 @@ func (m *defaultLoadBalancerManager) Delete(ctx context.Context, sdkLB LoadBalan
 		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
 	}
 	m.logger.Info("deleting loadBalancer",
-		"arn", awssdk.StringValue(req.LoadBalancerArn))
+		"arn", awssdk.ToString(req.LoadBalancerArn))
 	if _, err := m.elbv2Client.DeleteLoadBalancerWithContext(ctx, req); err != nil {
 		return err
 	}
 	m.logger.Info("deleted loadBalancer",
-		"arn", awssdk.StringValue(req.LoadBalancerArn))
+		"arn", awssdk.ToString(req.LoadBalancerArn))
+	audit.RecordMutation(ctx, audit.ActionDelete, "loadBalancer", awssdk.ToString(req.LoadBalancerArn), "")
 	return nil
 }
 
@@ -119,20 +152,26 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithIPAddressType(ctx
 		return nil
 	}
 	desiredIPAddressType := string(*resLB.Spec.IPAddressType)
-	currentIPAddressType := awssdk.StringValue(sdkLB.LoadBalancer.IpAddressType)
+	currentIPAddressType := string(sdkLB.LoadBalancer.IpAddressType)
 	if desiredIPAddressType == currentIPAddressType {
 		return nil
 	}
+	// the ipAddressType is changed in-place, the securityGroup rules for IPv6 sources are adjusted along with the model.
+	if resLB.Spec.IPAddressType.IsDualStack() && !elbv2model.IPAddressType(currentIPAddressType).IsDualStack() {
+		if err := m.validateSubnetsIPv6Readiness(ctx, resLB); err != nil {
+			return err
+		}
+	}
 
 	req := &elbv2sdk.SetIpAddressTypeInput{
 		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-		IpAddressType:   awssdk.String(desiredIPAddressType),
+		IpAddressType:   elbv2types.IpAddressType(desiredIPAddressType),
 	}
 	changeDesc := fmt.Sprintf("%v => %v", currentIPAddressType, desiredIPAddressType)
 	m.logger.Info("modifying loadBalancer ipAddressType",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
-		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
 		"change", changeDesc)
 	if _, err := m.elbv2Client.SetIpAddressTypeWithContext(ctx, req); err != nil {
 		return err
@@ -140,11 +179,158 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithIPAddressType(ctx
 	m.logger.Info("modified loadBalancer ipAddressType",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
-		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+		fmt.Sprintf("ipAddressType %v", changeDesc))
 
 	return nil
 }
 
+// validateSubnetsIPv6Readiness checks whether all subnets of the LoadBalancer have an associated IPv6 CIDR,
+// which is required to migrate the LoadBalancer to dualstack.
+func (m *defaultLoadBalancerManager) validateSubnetsIPv6Readiness(ctx context.Context, resLB *elbv2model.LoadBalancer) error {
+	subnetIDs := make([]string, 0, len(resLB.Spec.SubnetMappings))
+	for _, subnetMapping := range resLB.Spec.SubnetMappings {
+		subnetIDs = append(subnetIDs, subnetMapping.SubnetID)
+	}
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	req := &ec2sdk.DescribeSubnetsInput{
+		SubnetIds: subnetIDs,
+	}
+	subnets, err := m.ec2Client.DescribeSubnetsAsList(ctx, req)
+	if err != nil {
+		return err
+	}
+	var subnetIDsWithoutIPv6 []string
+	for i, subnet := range subnets {
+		ipv6CIDRs, err := networking.GetSubnetAssociatedIPv6CIDRs(&subnets[i])
+		if err != nil {
+			return err
+		}
+		if len(ipv6CIDRs) == 0 {
+			subnetIDsWithoutIPv6 = append(subnetIDsWithoutIPv6, awssdk.ToString(subnet.SubnetId))
+		}
+	}
+	if len(subnetIDsWithoutIPv6) != 0 {
+		return errors.Errorf("cannot change ipAddressType to %v, subnets don't have associated IPv6 CIDR: %v",
+			*resLB.Spec.IPAddressType, subnetIDsWithoutIPv6)
+	}
+	return nil
+}
+
+// updateSDKLoadBalancerWithIPAMPools modifies the IPAM pools of internet-facing application load balancers.
+// The IPAM pools aren't part of the LoadBalancer returned by aws-sdk-go-v2, so they're described separately.
+func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithIPAMPools(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
+	if resLB.Spec.Type != elbv2model.LoadBalancerTypeApplication ||
+		string(sdkLB.LoadBalancer.Scheme) != string(elbv2model.LoadBalancerSchemeInternetFacing) {
+		return nil
+	}
+	desiredIPv4IPAMPoolID := ""
+	if resLB.Spec.IPAMPools != nil {
+		desiredIPv4IPAMPoolID = resLB.Spec.IPAMPools.IPv4IPAMPoolID
+	}
+	resp, err := m.elbv2Client.DescribeLoadBalancerIpamPoolsWithContext(ctx, &services.DescribeLoadBalancerIpamPoolsInput{
+		LoadBalancerArns: []string{awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn)},
+	})
+	if err != nil {
+		return err
+	}
+	currentIPv4IPAMPoolID := ""
+	for _, lb := range resp.LoadBalancers {
+		if lb.IpamPools != nil {
+			currentIPv4IPAMPoolID = awssdk.ToString(lb.IpamPools.Ipv4IpamPoolId)
+		}
+	}
+	if desiredIPv4IPAMPoolID == currentIPv4IPAMPoolID {
+		return nil
+	}
+
+	req := &services.ModifyIpPoolsInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+	}
+	if desiredIPv4IPAMPoolID != "" {
+		req.IpamPools = &services.IpamPools{
+			Ipv4IpamPoolId: awssdk.String(desiredIPv4IPAMPoolID),
+		}
+	} else {
+		req.RemoveIpamPools = []services.RemoveIpamPoolEnum{services.RemoveIpamPoolEnumIpv4}
+	}
+	changeDesc := fmt.Sprintf("%v => %v", currentIPv4IPAMPoolID, desiredIPv4IPAMPoolID)
+	m.logger.Info("modifying loadBalancer ipamPools",
+		"stackID", resLB.Stack().StackID(),
+		"resourceID", resLB.ID(),
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+		"change", changeDesc)
+	if _, err := m.elbv2Client.ModifyIpPoolsWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified loadBalancer ipamPools",
+		"stackID", resLB.Stack().StackID(),
+		"resourceID", resLB.ID(),
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+		fmt.Sprintf("ipamPools %v", changeDesc))
+
+	return nil
+}
+
+// updateSDKLoadBalancerWithCapacityReservation modifies the capacity reservation of application load balancers,
+// the reservation is reset once the minimum capacity is no longer specified.
+// It returns the provisioning state of the capacity reservation, which is empty if no capacity is reserved.
+func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithCapacityReservation(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) (string, error) {
+	if resLB.Spec.Type != elbv2model.LoadBalancerTypeApplication {
+		return "", nil
+	}
+	var desiredCapacityUnits int32
+	if resLB.Spec.MinimumLoadBalancerCapacity != nil {
+		desiredCapacityUnits = int32(resLB.Spec.MinimumLoadBalancerCapacity.CapacityUnits)
+	}
+	resp, err := m.elbv2Client.DescribeCapacityReservationWithContext(ctx, &elbv2sdk.DescribeCapacityReservationInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+	})
+	if err != nil {
+		return "", err
+	}
+	var currentCapacityUnits int32
+	if resp.MinimumLoadBalancerCapacity != nil {
+		currentCapacityUnits = awssdk.ToInt32(resp.MinimumLoadBalancerCapacity.CapacityUnits)
+	}
+	if desiredCapacityUnits == currentCapacityUnits {
+		return buildCapacityReservationState(currentCapacityUnits, resp.CapacityReservationState), nil
+	}
+
+	req := &elbv2sdk.ModifyCapacityReservationInput{
+		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
+	}
+	if desiredCapacityUnits != 0 {
+		req.MinimumLoadBalancerCapacity = &elbv2types.MinimumLoadBalancerCapacity{
+			CapacityUnits: awssdk.Int32(desiredCapacityUnits),
+		}
+	} else {
+		req.ResetCapacityReservation = awssdk.Bool(true)
+	}
+	changeDesc := fmt.Sprintf("%v => %v", currentCapacityUnits, desiredCapacityUnits)
+	m.logger.Info("modifying loadBalancer capacityReservation",
+		"stackID", resLB.Stack().StackID(),
+		"resourceID", resLB.ID(),
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+		"change", changeDesc)
+	modifyResp, err := m.elbv2Client.ModifyCapacityReservationWithContext(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	m.logger.Info("modified loadBalancer capacityReservation",
+		"stackID", resLB.Stack().StackID(),
+		"resourceID", resLB.ID(),
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+		fmt.Sprintf("capacityReservation %v", changeDesc))
+
+	return buildCapacityReservationState(desiredCapacityUnits, modifyResp.CapacityReservationState), nil
+}
+
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSubnetMappings(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
 	desiredSubnets := sets.NewString()
 	for _, mapping := range resLB.Spec.SubnetMappings {
@@ -152,21 +338,25 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSubnetMappings(ctx
 	}
 	currentSubnets := sets.NewString()
 	for _, az := range sdkLB.LoadBalancer.AvailabilityZones {
-		currentSubnets.Insert(awssdk.StringValue(az.SubnetId))
+		currentSubnets.Insert(awssdk.ToString(az.SubnetId))
 	}
 	if desiredSubnets.Equal(currentSubnets) {
 		return nil
 	}
 
+	sdkSubnetMappings, err := buildSDKSubnetMappings(resLB.Spec.SubnetMappings)
+	if err != nil {
+		return err
+	}
 	req := &elbv2sdk.SetSubnetsInput{
 		LoadBalancerArn: sdkLB.LoadBalancer.LoadBalancerArn,
-		SubnetMappings:  buildSDKSubnetMappings(resLB.Spec.SubnetMappings),
+		SubnetMappings:  sdkSubnetMappings,
 	}
 	changeDesc := fmt.Sprintf("%v => %v", currentSubnets.List(), desiredSubnets.List())
 	m.logger.Info("modifying loadBalancer subnetMappings",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
-		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
 		"change", changeDesc)
 	if _, err := m.elbv2Client.SetSubnetsWithContext(ctx, req); err != nil {
 		return err
@@ -174,7 +364,9 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSubnetMappings(ctx
 	m.logger.Info("modified loadBalancer subnetMappings",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
-		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+		fmt.Sprintf("subnetMappings %v", changeDesc))
 
 	return nil
 }
@@ -184,8 +376,8 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSecurityGroups(ctx
 	if err != nil {
 		return err
 	}
-	desiredSecurityGroups := sets.NewString(awssdk.StringValueSlice(securityGroups)...)
-	currentSecurityGroups := sets.NewString(awssdk.StringValueSlice(sdkLB.LoadBalancer.SecurityGroups)...)
+	desiredSecurityGroups := sets.NewString(securityGroups...)
+	currentSecurityGroups := sets.NewString(sdkLB.LoadBalancer.SecurityGroups...)
 	if desiredSecurityGroups.Equal(currentSecurityGroups) {
 		return nil
 	}
@@ -198,7 +390,7 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSecurityGroups(ctx
 	m.logger.Info("modifying loadBalancer securityGroups",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
-		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
 		"change", changeDesc)
 	if _, err := m.elbv2Client.SetSecurityGroupsWithContext(ctx, req); err != nil {
 		return err
@@ -206,23 +398,25 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithSecurityGroups(ctx
 	m.logger.Info("modified loadBalancer securityGroups",
 		"stackID", resLB.Stack().StackID(),
 		"resourceID", resLB.ID(),
-		"arn", awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+		"arn", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+	audit.RecordMutation(ctx, audit.ActionModify, "loadBalancer", awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+		fmt.Sprintf("securityGroups %v", changeDesc))
 
 	return nil
 }
 
 func (m *defaultLoadBalancerManager) checkSDKLoadBalancerWithCOIPv4Pool(_ context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
-	if awssdk.StringValue(resLB.Spec.CustomerOwnedIPv4Pool) != awssdk.StringValue(sdkLB.LoadBalancer.CustomerOwnedIpv4Pool) {
+	if awssdk.ToString(resLB.Spec.CustomerOwnedIPv4Pool) != awssdk.ToString(sdkLB.LoadBalancer.CustomerOwnedIpv4Pool) {
 		m.logger.Info("loadBalancer has drifted CustomerOwnedIPv4Pool setting",
-			"desired", awssdk.StringValue(resLB.Spec.CustomerOwnedIPv4Pool),
-			"current", awssdk.StringValue(sdkLB.LoadBalancer.CustomerOwnedIpv4Pool))
+			"desired", awssdk.ToString(resLB.Spec.CustomerOwnedIPv4Pool),
+			"current", awssdk.ToString(sdkLB.LoadBalancer.CustomerOwnedIpv4Pool))
 	}
 	return nil
 }
 
 func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithTags(ctx context.Context, resLB *elbv2model.LoadBalancer, sdkLB LoadBalancerWithTags) error {
 	desiredLBTags := m.trackingProvider.ResourceTags(resLB.Stack(), resLB, resLB.Spec.Tags)
-	return m.taggingManager.ReconcileTags(ctx, awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn), desiredLBTags,
+	return m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn), desiredLBTags,
 		WithCurrentTags(sdkLB.Tags),
 		WithIgnoredTagKeys(m.trackingProvider.LegacyTagKeys()),
 		WithIgnoredTagKeys(m.externalManagedTags))
@@ -231,21 +425,21 @@ func (m *defaultLoadBalancerManager) updateSDKLoadBalancerWithTags(ctx context.C
 func buildSDKCreateLoadBalancerInput(lbSpec elbv2model.LoadBalancerSpec) (*elbv2sdk.CreateLoadBalancerInput, error) {
 	sdkObj := &elbv2sdk.CreateLoadBalancerInput{}
 	sdkObj.Name = awssdk.String(lbSpec.Name)
-	sdkObj.Type = awssdk.String(string(lbSpec.Type))
+	sdkObj.Type = elbv2types.LoadBalancerTypeEnum(lbSpec.Type)
 
 	if lbSpec.Scheme != nil {
-		sdkObj.Scheme = (*string)(lbSpec.Scheme)
-	} else {
-		sdkObj.Scheme = nil
+		sdkObj.Scheme = elbv2types.LoadBalancerSchemeEnum(*lbSpec.Scheme)
 	}
 
 	if lbSpec.IPAddressType != nil {
-		sdkObj.IpAddressType = (*string)(lbSpec.IPAddressType)
-	} else {
-		sdkObj.IpAddressType = nil
+		sdkObj.IpAddressType = elbv2types.IpAddressType(*lbSpec.IPAddressType)
 	}
 
-	sdkObj.SubnetMappings = buildSDKSubnetMappings(lbSpec.SubnetMappings)
+	if sdkSubnetMappings, err := buildSDKSubnetMappings(lbSpec.SubnetMappings); err != nil {
+		return nil, err
+	} else {
+		sdkObj.SubnetMappings = sdkSubnetMappings
+	}
 	if sdkSecurityGroups, err := buildSDKSecurityGroups(lbSpec.SecurityGroups); err != nil {
 		return nil, err
 	} else {
@@ -256,45 +450,87 @@ func buildSDKCreateLoadBalancerInput(lbSpec elbv2model.LoadBalancerSpec) (*elbv2
 	return sdkObj, nil
 }
 
-func buildSDKSubnetMappings(modelSubnetMappings []elbv2model.SubnetMapping) []*elbv2sdk.SubnetMapping {
-	var sdkSubnetMappings []*elbv2sdk.SubnetMapping
+func buildSDKSubnetMappings(modelSubnetMappings []elbv2model.SubnetMapping) ([]elbv2types.SubnetMapping, error) {
+	var sdkSubnetMappings []elbv2types.SubnetMapping
 	if len(modelSubnetMappings) != 0 {
-		sdkSubnetMappings = make([]*elbv2sdk.SubnetMapping, 0, len(modelSubnetMappings))
+		sdkSubnetMappings = make([]elbv2types.SubnetMapping, 0, len(modelSubnetMappings))
 		for _, modelSubnetMapping := range modelSubnetMappings {
-			sdkSubnetMappings = append(sdkSubnetMappings, buildSDKSubnetMapping(modelSubnetMapping))
+			sdkSubnetMapping, err := buildSDKSubnetMapping(modelSubnetMapping)
+			if err != nil {
+				return nil, err
+			}
+			sdkSubnetMappings = append(sdkSubnetMappings, sdkSubnetMapping)
 		}
 	}
-	return sdkSubnetMappings
+	return sdkSubnetMappings, nil
 }
 
-func buildSDKSecurityGroups(modelSecurityGroups []coremodel.StringToken) ([]*string, error) {
+func buildSDKSecurityGroups(modelSecurityGroups []coremodel.StringToken) ([]string, error) {
 	ctx := context.Background()
-	var sdkSecurityGroups []*string
+	var sdkSecurityGroups []string
 	if len(modelSecurityGroups) != 0 {
-		sdkSecurityGroups = make([]*string, 0, len(modelSecurityGroups))
+		sdkSecurityGroups = make([]string, 0, len(modelSecurityGroups))
 		for _, modelSecurityGroup := range modelSecurityGroups {
 			token, err := modelSecurityGroup.Resolve(ctx)
 			if err != nil {
 				return nil, err
 			}
-			sdkSecurityGroups = append(sdkSecurityGroups, awssdk.String(token))
+			sdkSecurityGroups = append(sdkSecurityGroups, token)
 		}
 	}
 	return sdkSecurityGroups, nil
 }
 
-func buildSDKSubnetMapping(modelSubnetMapping elbv2model.SubnetMapping) *elbv2sdk.SubnetMapping {
-	return &elbv2sdk.SubnetMapping{
-		AllocationId:       modelSubnetMapping.AllocationID,
+func buildSDKSubnetMapping(modelSubnetMapping elbv2model.SubnetMapping) (elbv2types.SubnetMapping, error) {
+	var allocationID *string
+	if modelSubnetMapping.AllocationID != nil {
+		token, err := modelSubnetMapping.AllocationID.Resolve(context.Background())
+		if err != nil {
+			return elbv2types.SubnetMapping{}, err
+		}
+		allocationID = awssdk.String(token)
+	}
+	return elbv2types.SubnetMapping{
+		AllocationId:       allocationID,
 		PrivateIPv4Address: modelSubnetMapping.PrivateIPv4Address,
 		IPv6Address:        modelSubnetMapping.IPv6Address,
 		SubnetId:           awssdk.String(modelSubnetMapping.SubnetID),
+	}, nil
+}
+
+// capacityReservationStatePrecedence orders the zonal capacity reservation states, the state of the least provisioned zone
+// takes precedence when summarizing the capacity reservation.
+var capacityReservationStatePrecedence = []elbv2types.CapacityReservationStateEnum{
+	elbv2types.CapacityReservationStateEnumFailed,
+	elbv2types.CapacityReservationStateEnumPending,
+	elbv2types.CapacityReservationStateEnumRebalancing,
+	elbv2types.CapacityReservationStateEnumProvisioned,
+}
+
+// buildCapacityReservationState summarizes the zonal states of a capacity reservation of capacityUnits,
+// it's empty if no capacity is reserved, and pending until the zonal states are reported.
+func buildCapacityReservationState(capacityUnits int32, zonalStates []elbv2types.ZonalCapacityReservationState) string {
+	if capacityUnits == 0 {
+		return ""
+	}
+	stateCodes := sets.NewString()
+	for _, zonalState := range zonalStates {
+		if zonalState.State != nil {
+			stateCodes.Insert(string(zonalState.State.Code))
+		}
+	}
+	for _, stateCode := range capacityReservationStatePrecedence {
+		if stateCodes.Has(string(stateCode)) {
+			return string(stateCode)
+		}
 	}
+	return string(elbv2types.CapacityReservationStateEnumPending)
 }
 
 func buildResLoadBalancerStatus(sdkLB LoadBalancerWithTags) elbv2model.LoadBalancerStatus {
 	return elbv2model.LoadBalancerStatus{
-		LoadBalancerARN: awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn),
-		DNSName:         awssdk.StringValue(sdkLB.LoadBalancer.DNSName),
+		LoadBalancerARN:       awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn),
+		DNSName:               awssdk.ToString(sdkLB.LoadBalancer.DNSName),
+		CanonicalHostedZoneID: awssdk.ToString(sdkLB.LoadBalancer.CanonicalHostedZoneId),
 	}
 }
@@ -4,10 +4,17 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -49,10 +56,10 @@ func Test_buildSDKCreateLoadBalancerInput(t *testing.T) {
 			},
 			want: &elbv2sdk.CreateLoadBalancerInput{
 				Name:          awssdk.String("my-alb"),
-				Type:          awssdk.String("application"),
-				IpAddressType: awssdk.String("dualstack"),
-				Scheme:        awssdk.String("internet-facing"),
-				SubnetMappings: []*elbv2sdk.SubnetMapping{
+				Type:          elbv2types.LoadBalancerTypeEnumApplication,
+				IpAddressType: elbv2types.IpAddressTypeDualstack,
+				Scheme:        elbv2types.LoadBalancerSchemeEnumInternetFacing,
+				SubnetMappings: []elbv2types.SubnetMapping{
 					{
 						SubnetId: awssdk.String("subnet-A"),
 					},
@@ -60,7 +67,7 @@ func Test_buildSDKCreateLoadBalancerInput(t *testing.T) {
 						SubnetId: awssdk.String("subnet-B"),
 					},
 				},
-				SecurityGroups: awssdk.StringSlice([]string{"sg-A", "sg-B"}),
+				SecurityGroups: []string{"sg-A", "sg-B"},
 			},
 		},
 		{
@@ -83,10 +90,10 @@ func Test_buildSDKCreateLoadBalancerInput(t *testing.T) {
 			},
 			want: &elbv2sdk.CreateLoadBalancerInput{
 				Name:          awssdk.String("my-nlb"),
-				Type:          awssdk.String("network"),
-				IpAddressType: awssdk.String("dualstack"),
-				Scheme:        awssdk.String("internet-facing"),
-				SubnetMappings: []*elbv2sdk.SubnetMapping{
+				Type:          elbv2types.LoadBalancerTypeEnumNetwork,
+				IpAddressType: elbv2types.IpAddressTypeDualstack,
+				Scheme:        elbv2types.LoadBalancerSchemeEnumInternetFacing,
+				SubnetMappings: []elbv2types.SubnetMapping{
 					{
 						SubnetId: awssdk.String("subnet-A"),
 					},
@@ -121,10 +128,10 @@ func Test_buildSDKCreateLoadBalancerInput(t *testing.T) {
 			},
 			want: &elbv2sdk.CreateLoadBalancerInput{
 				Name:          awssdk.String("my-alb"),
-				Type:          awssdk.String("application"),
-				IpAddressType: awssdk.String("dualstack"),
-				Scheme:        awssdk.String("internet-facing"),
-				SubnetMappings: []*elbv2sdk.SubnetMapping{
+				Type:          elbv2types.LoadBalancerTypeEnumApplication,
+				IpAddressType: elbv2types.IpAddressTypeDualstack,
+				Scheme:        elbv2types.LoadBalancerSchemeEnumInternetFacing,
+				SubnetMappings: []elbv2types.SubnetMapping{
 					{
 						SubnetId: awssdk.String("subnet-A"),
 					},
@@ -132,7 +139,7 @@ func Test_buildSDKCreateLoadBalancerInput(t *testing.T) {
 						SubnetId: awssdk.String("subnet-B"),
 					},
 				},
-				SecurityGroups:        awssdk.StringSlice([]string{"sg-A", "sg-B"}),
+				SecurityGroups:        []string{"sg-A", "sg-B"},
 				CustomerOwnedIpv4Pool: awssdk.String("coIP-pool-x"),
 			},
 		},
@@ -157,7 +164,7 @@ func Test_buildSDKSubnetMappings(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want []*elbv2sdk.SubnetMapping
+		want []elbv2types.SubnetMapping
 	}{
 		{
 			name: "standard case",
@@ -171,7 +178,7 @@ func Test_buildSDKSubnetMappings(t *testing.T) {
 					},
 				},
 			},
-			want: []*elbv2sdk.SubnetMapping{
+			want: []elbv2types.SubnetMapping{
 				{
 					SubnetId: awssdk.String("subnet-a"),
 				},
@@ -183,7 +190,8 @@ func Test_buildSDKSubnetMappings(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildSDKSubnetMappings(tt.args.modelSubnetMappings)
+			got, err := buildSDKSubnetMappings(tt.args.modelSubnetMappings)
+			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -196,7 +204,7 @@ func Test_buildSDKSecurityGroups(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		want    []*string
+		want    []string
 		wantErr error
 	}{
 		{
@@ -206,7 +214,7 @@ func Test_buildSDKSecurityGroups(t *testing.T) {
 					coremodel.LiteralStringToken("sg-a"),
 				},
 			},
-			want: awssdk.StringSlice([]string{"sg-a"}),
+			want: []string{"sg-a"},
 		},
 		{
 			name: "multiple securityGroups",
@@ -216,7 +224,7 @@ func Test_buildSDKSecurityGroups(t *testing.T) {
 					coremodel.LiteralStringToken("sg-b"),
 				},
 			},
-			want: awssdk.StringSlice([]string{"sg-a", "sg-b"}),
+			want: []string{"sg-a", "sg-b"},
 		},
 	}
 	for _, tt := range tests {
@@ -239,18 +247,18 @@ func Test_buildSDKSubnetMapping(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want *elbv2sdk.SubnetMapping
+		want elbv2types.SubnetMapping
 	}{
 		{
 			name: "stand case",
 			args: args{
 				modelSubnetMapping: elbv2model.SubnetMapping{
-					AllocationID:       awssdk.String("some-id"),
+					AllocationID:       coremodel.LiteralStringToken("some-id"),
 					PrivateIPv4Address: awssdk.String("192.168.100.0"),
 					SubnetID:           "subnet-abc",
 				},
 			},
-			want: &elbv2sdk.SubnetMapping{
+			want: elbv2types.SubnetMapping{
 				AllocationId:       awssdk.String("some-id"),
 				PrivateIPv4Address: awssdk.String("192.168.100.0"),
 				SubnetId:           awssdk.String("subnet-abc"),
@@ -263,14 +271,15 @@ func Test_buildSDKSubnetMapping(t *testing.T) {
 					SubnetID: "subnet-abc",
 				},
 			},
-			want: &elbv2sdk.SubnetMapping{
+			want: elbv2types.SubnetMapping{
 				SubnetId: awssdk.String("subnet-abc"),
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := buildSDKSubnetMapping(tt.args.modelSubnetMapping)
+			got, err := buildSDKSubnetMapping(tt.args.modelSubnetMapping)
+			assert.NoError(t, err)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -289,7 +298,7 @@ func Test_buildResLoadBalancerStatus(t *testing.T) {
 			name: "standard case",
 			args: args{
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						LoadBalancerArn: awssdk.String("my-arn"),
 						DNSName:         awssdk.String("www.example.com"),
 					},
@@ -328,7 +337,7 @@ func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testi
 					},
 				},
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						CustomerOwnedIpv4Pool: nil,
 					},
 				},
@@ -344,7 +353,7 @@ func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testi
 					},
 				},
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						CustomerOwnedIpv4Pool: awssdk.String("ipv4pool-coip-abc"),
 					},
 				},
@@ -360,7 +369,7 @@ func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testi
 					},
 				},
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						CustomerOwnedIpv4Pool: awssdk.String("ipv4pool-coip-def"),
 					},
 				},
@@ -376,7 +385,7 @@ func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testi
 					},
 				},
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						CustomerOwnedIpv4Pool: nil,
 					},
 				},
@@ -392,7 +401,7 @@ func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testi
 					},
 				},
 				sdkLB: LoadBalancerWithTags{
-					LoadBalancer: &elbv2sdk.LoadBalancer{
+					LoadBalancer: &elbv2types.LoadBalancer{
 						CustomerOwnedIpv4Pool: awssdk.String("ipv4pool-coip-abc"),
 					},
 				},
@@ -414,3 +423,525 @@ func Test_defaultLoadBalancerManager_checkSDKLoadBalancerWithCOIPv4Pool(t *testi
 		})
 	}
 }
+
+func Test_defaultLoadBalancerManager_updateSDKLoadBalancerWithIPAMPools(t *testing.T) {
+	type describeLoadBalancerIpamPoolsWithContextCall struct {
+		req  *services.DescribeLoadBalancerIpamPoolsInput
+		resp *services.DescribeLoadBalancerIpamPoolsOutput
+		err  error
+	}
+	type modifyIpPoolsWithContextCall struct {
+		req  *services.ModifyIpPoolsInput
+		resp *services.ModifyIpPoolsOutput
+		err  error
+	}
+	type fields struct {
+		describeLoadBalancerIpamPoolsWithContextCalls []describeLoadBalancerIpamPoolsWithContextCall
+		modifyIpPoolsWithContextCalls                 []modifyIpPoolsWithContextCall
+	}
+	type args struct {
+		lbSpec elbv2model.LoadBalancerSpec
+		sdkLB  LoadBalancerWithTags
+	}
+
+	internetFacingSDKLB := LoadBalancerWithTags{
+		LoadBalancer: &elbv2types.LoadBalancer{
+			LoadBalancerArn: awssdk.String("my-arn"),
+			Scheme:          elbv2types.LoadBalancerSchemeEnumInternetFacing,
+		},
+	}
+	describeReq := &services.DescribeLoadBalancerIpamPoolsInput{
+		LoadBalancerArns: []string{"my-arn"},
+	}
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "IPAM pool is set",
+			fields: fields{
+				describeLoadBalancerIpamPoolsWithContextCalls: []describeLoadBalancerIpamPoolsWithContextCall{
+					{
+						req: describeReq,
+						resp: &services.DescribeLoadBalancerIpamPoolsOutput{
+							LoadBalancers: []services.LoadBalancerIpamPools{
+								{LoadBalancerArn: awssdk.String("my-arn")},
+							},
+						},
+					},
+				},
+				modifyIpPoolsWithContextCalls: []modifyIpPoolsWithContextCall{
+					{
+						req: &services.ModifyIpPoolsInput{
+							LoadBalancerArn: awssdk.String("my-arn"),
+							IpamPools: &services.IpamPools{
+								Ipv4IpamPoolId: awssdk.String("ipam-pool-1"),
+							},
+						},
+						resp: &services.ModifyIpPoolsOutput{},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type:      elbv2model.LoadBalancerTypeApplication,
+					IPAMPools: &elbv2model.IPAMPools{IPv4IPAMPoolID: "ipam-pool-1"},
+				},
+				sdkLB: internetFacingSDKLB,
+			},
+		},
+		{
+			name: "IPAM pool is unchanged",
+			fields: fields{
+				describeLoadBalancerIpamPoolsWithContextCalls: []describeLoadBalancerIpamPoolsWithContextCall{
+					{
+						req: describeReq,
+						resp: &services.DescribeLoadBalancerIpamPoolsOutput{
+							LoadBalancers: []services.LoadBalancerIpamPools{
+								{
+									LoadBalancerArn: awssdk.String("my-arn"),
+									IpamPools:       &services.IpamPools{Ipv4IpamPoolId: awssdk.String("ipam-pool-1")},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type:      elbv2model.LoadBalancerTypeApplication,
+					IPAMPools: &elbv2model.IPAMPools{IPv4IPAMPoolID: "ipam-pool-1"},
+				},
+				sdkLB: internetFacingSDKLB,
+			},
+		},
+		{
+			name: "IPAM pool is removed",
+			fields: fields{
+				describeLoadBalancerIpamPoolsWithContextCalls: []describeLoadBalancerIpamPoolsWithContextCall{
+					{
+						req: describeReq,
+						resp: &services.DescribeLoadBalancerIpamPoolsOutput{
+							LoadBalancers: []services.LoadBalancerIpamPools{
+								{
+									LoadBalancerArn: awssdk.String("my-arn"),
+									IpamPools:       &services.IpamPools{Ipv4IpamPoolId: awssdk.String("ipam-pool-1")},
+								},
+							},
+						},
+					},
+				},
+				modifyIpPoolsWithContextCalls: []modifyIpPoolsWithContextCall{
+					{
+						req: &services.ModifyIpPoolsInput{
+							LoadBalancerArn: awssdk.String("my-arn"),
+							RemoveIpamPools: []services.RemoveIpamPoolEnum{services.RemoveIpamPoolEnumIpv4},
+						},
+						resp: &services.ModifyIpPoolsOutput{},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type: elbv2model.LoadBalancerTypeApplication,
+				},
+				sdkLB: internetFacingSDKLB,
+			},
+		},
+		{
+			name: "network load balancers are skipped",
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type: elbv2model.LoadBalancerTypeNetwork,
+				},
+				sdkLB: internetFacingSDKLB,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.describeLoadBalancerIpamPoolsWithContextCalls {
+				elbv2Client.EXPECT().DescribeLoadBalancerIpamPoolsWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.modifyIpPoolsWithContextCalls {
+				elbv2Client.EXPECT().ModifyIpPoolsWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			m := &defaultLoadBalancerManager{
+				elbv2Client: elbv2Client,
+				logger:      logr.New(&log.NullLogSink{}),
+			}
+			resLB := elbv2model.NewLoadBalancer(stack, "LoadBalancer", tt.args.lbSpec)
+			err := m.updateSDKLoadBalancerWithIPAMPools(context.Background(), resLB, tt.args.sdkLB)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_defaultLoadBalancerManager_updateSDKLoadBalancerWithIPAddressType(t *testing.T) {
+	type describeSubnetsAsListCall struct {
+		req  *ec2sdk.DescribeSubnetsInput
+		resp []ec2types.Subnet
+		err  error
+	}
+	type setIpAddressTypeWithContextCall struct {
+		req  *elbv2sdk.SetIpAddressTypeInput
+		resp *elbv2sdk.SetIpAddressTypeOutput
+		err  error
+	}
+	type fields struct {
+		describeSubnetsAsListCalls       []describeSubnetsAsListCall
+		setIpAddressTypeWithContextCalls []setIpAddressTypeWithContextCall
+	}
+	type args struct {
+		lbSpec elbv2model.LoadBalancerSpec
+		sdkLB  LoadBalancerWithTags
+	}
+
+	ipv4SDKLB := LoadBalancerWithTags{
+		LoadBalancer: &elbv2types.LoadBalancer{
+			LoadBalancerArn: awssdk.String("my-arn"),
+			IpAddressType:   elbv2types.IpAddressTypeIpv4,
+		},
+	}
+	dualStackSDKLB := LoadBalancerWithTags{
+		LoadBalancer: &elbv2types.LoadBalancer{
+			LoadBalancerArn: awssdk.String("my-arn"),
+			IpAddressType:   elbv2types.IpAddressTypeDualstack,
+		},
+	}
+	ipv4 := elbv2model.IPAddressTypeIPV4
+	dualStack := elbv2model.IPAddressTypeDualStack
+	subnetMappings := []elbv2model.SubnetMapping{{SubnetID: "subnet-a"}, {SubnetID: "subnet-b"}}
+	describeSubnetsReq := &ec2sdk.DescribeSubnetsInput{
+		SubnetIds: []string{"subnet-a", "subnet-b"},
+	}
+	ipv6Subnet := func(subnetID string, ipv6CIDR string) ec2types.Subnet {
+		return ec2types.Subnet{
+			SubnetId: awssdk.String(subnetID),
+			Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
+				{
+					Ipv6CidrBlock:      awssdk.String(ipv6CIDR),
+					Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{State: ec2types.SubnetCidrBlockStateCodeAssociated},
+				},
+			},
+		}
+	}
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "ipAddressType is unchanged",
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{IPAddressType: &ipv4, SubnetMappings: subnetMappings},
+				sdkLB:  ipv4SDKLB,
+			},
+		},
+		{
+			name: "ipAddressType is changed to dualstack",
+			fields: fields{
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						req: describeSubnetsReq,
+						resp: []ec2types.Subnet{
+							ipv6Subnet("subnet-a", "2600:1f14:f8c:2700::/64"),
+							ipv6Subnet("subnet-b", "2600:1f14:f8c:2701::/64"),
+						},
+					},
+				},
+				setIpAddressTypeWithContextCalls: []setIpAddressTypeWithContextCall{
+					{
+						req: &elbv2sdk.SetIpAddressTypeInput{
+							LoadBalancerArn: awssdk.String("my-arn"),
+							IpAddressType:   elbv2types.IpAddressTypeDualstack,
+						},
+						resp: &elbv2sdk.SetIpAddressTypeOutput{},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{IPAddressType: &dualStack, SubnetMappings: subnetMappings},
+				sdkLB:  ipv4SDKLB,
+			},
+		},
+		{
+			name: "ipAddressType isn't changed to dualstack when subnets don't have IPv6 CIDR",
+			fields: fields{
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						req: describeSubnetsReq,
+						resp: []ec2types.Subnet{
+							ipv6Subnet("subnet-a", "2600:1f14:f8c:2700::/64"),
+							{SubnetId: awssdk.String("subnet-b")},
+						},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{IPAddressType: &dualStack, SubnetMappings: subnetMappings},
+				sdkLB:  ipv4SDKLB,
+			},
+			wantErr: errors.New("cannot change ipAddressType to dualstack, subnets don't have associated IPv6 CIDR: [subnet-b]"),
+		},
+		{
+			name: "ipAddressType is changed to ipv4",
+			fields: fields{
+				setIpAddressTypeWithContextCalls: []setIpAddressTypeWithContextCall{
+					{
+						req: &elbv2sdk.SetIpAddressTypeInput{
+							LoadBalancerArn: awssdk.String("my-arn"),
+							IpAddressType:   elbv2types.IpAddressTypeIpv4,
+						},
+						resp: &elbv2sdk.SetIpAddressTypeOutput{},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{IPAddressType: &ipv4, SubnetMappings: subnetMappings},
+				sdkLB:  dualStackSDKLB,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.fields.describeSubnetsAsListCalls {
+				ec2Client.EXPECT().DescribeSubnetsAsList(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.setIpAddressTypeWithContextCalls {
+				elbv2Client.EXPECT().SetIpAddressTypeWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			m := &defaultLoadBalancerManager{
+				elbv2Client: elbv2Client,
+				ec2Client:   ec2Client,
+				logger:      logr.New(&log.NullLogSink{}),
+			}
+			resLB := elbv2model.NewLoadBalancer(stack, "LoadBalancer", tt.args.lbSpec)
+			err := m.updateSDKLoadBalancerWithIPAddressType(context.Background(), resLB, tt.args.sdkLB)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_defaultLoadBalancerManager_updateSDKLoadBalancerWithCapacityReservation(t *testing.T) {
+	type describeCapacityReservationWithContextCall struct {
+		req  *elbv2sdk.DescribeCapacityReservationInput
+		resp *elbv2sdk.DescribeCapacityReservationOutput
+		err  error
+	}
+	type modifyCapacityReservationWithContextCall struct {
+		req  *elbv2sdk.ModifyCapacityReservationInput
+		resp *elbv2sdk.ModifyCapacityReservationOutput
+		err  error
+	}
+	type fields struct {
+		describeCapacityReservationWithContextCalls []describeCapacityReservationWithContextCall
+		modifyCapacityReservationWithContextCalls   []modifyCapacityReservationWithContextCall
+	}
+	type args struct {
+		lbSpec elbv2model.LoadBalancerSpec
+		sdkLB  LoadBalancerWithTags
+	}
+
+	sdkLB := LoadBalancerWithTags{
+		LoadBalancer: &elbv2types.LoadBalancer{
+			LoadBalancerArn: awssdk.String("my-arn"),
+		},
+	}
+	describeReq := &elbv2sdk.DescribeCapacityReservationInput{
+		LoadBalancerArn: awssdk.String("my-arn"),
+	}
+	zonalState := func(az string, code elbv2types.CapacityReservationStateEnum) elbv2types.ZonalCapacityReservationState {
+		return elbv2types.ZonalCapacityReservationState{
+			AvailabilityZone: awssdk.String(az),
+			State:            &elbv2types.CapacityReservationStatus{Code: code},
+		}
+	}
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "capacity is reserved",
+			fields: fields{
+				describeCapacityReservationWithContextCalls: []describeCapacityReservationWithContextCall{
+					{
+						req:  describeReq,
+						resp: &elbv2sdk.DescribeCapacityReservationOutput{},
+					},
+				},
+				modifyCapacityReservationWithContextCalls: []modifyCapacityReservationWithContextCall{
+					{
+						req: &elbv2sdk.ModifyCapacityReservationInput{
+							LoadBalancerArn: awssdk.String("my-arn"),
+							MinimumLoadBalancerCapacity: &elbv2types.MinimumLoadBalancerCapacity{
+								CapacityUnits: awssdk.Int32(1000),
+							},
+						},
+						resp: &elbv2sdk.ModifyCapacityReservationOutput{
+							CapacityReservationState: []elbv2types.ZonalCapacityReservationState{
+								zonalState("us-west-2a", elbv2types.CapacityReservationStateEnumPending),
+								zonalState("us-west-2b", elbv2types.CapacityReservationStateEnumPending),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type:                        elbv2model.LoadBalancerTypeApplication,
+					MinimumLoadBalancerCapacity: &elbv2model.MinimumLoadBalancerCapacity{CapacityUnits: 1000},
+				},
+				sdkLB: sdkLB,
+			},
+			want: "pending",
+		},
+		{
+			name: "capacity reservation is unchanged",
+			fields: fields{
+				describeCapacityReservationWithContextCalls: []describeCapacityReservationWithContextCall{
+					{
+						req: describeReq,
+						resp: &elbv2sdk.DescribeCapacityReservationOutput{
+							MinimumLoadBalancerCapacity: &elbv2types.MinimumLoadBalancerCapacity{
+								CapacityUnits: awssdk.Int32(1000),
+							},
+							CapacityReservationState: []elbv2types.ZonalCapacityReservationState{
+								zonalState("us-west-2a", elbv2types.CapacityReservationStateEnumProvisioned),
+								zonalState("us-west-2b", elbv2types.CapacityReservationStateEnumRebalancing),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type:                        elbv2model.LoadBalancerTypeApplication,
+					MinimumLoadBalancerCapacity: &elbv2model.MinimumLoadBalancerCapacity{CapacityUnits: 1000},
+				},
+				sdkLB: sdkLB,
+			},
+			want: "rebalancing",
+		},
+		{
+			name: "capacity reservation is reset",
+			fields: fields{
+				describeCapacityReservationWithContextCalls: []describeCapacityReservationWithContextCall{
+					{
+						req: describeReq,
+						resp: &elbv2sdk.DescribeCapacityReservationOutput{
+							MinimumLoadBalancerCapacity: &elbv2types.MinimumLoadBalancerCapacity{
+								CapacityUnits: awssdk.Int32(1000),
+							},
+						},
+					},
+				},
+				modifyCapacityReservationWithContextCalls: []modifyCapacityReservationWithContextCall{
+					{
+						req: &elbv2sdk.ModifyCapacityReservationInput{
+							LoadBalancerArn:          awssdk.String("my-arn"),
+							ResetCapacityReservation: awssdk.Bool(true),
+						},
+						resp: &elbv2sdk.ModifyCapacityReservationOutput{},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type: elbv2model.LoadBalancerTypeApplication,
+				},
+				sdkLB: sdkLB,
+			},
+			want: "",
+		},
+		{
+			name: "failed zone takes precedence",
+			fields: fields{
+				describeCapacityReservationWithContextCalls: []describeCapacityReservationWithContextCall{
+					{
+						req: describeReq,
+						resp: &elbv2sdk.DescribeCapacityReservationOutput{
+							MinimumLoadBalancerCapacity: &elbv2types.MinimumLoadBalancerCapacity{
+								CapacityUnits: awssdk.Int32(1000),
+							},
+							CapacityReservationState: []elbv2types.ZonalCapacityReservationState{
+								zonalState("us-west-2a", elbv2types.CapacityReservationStateEnumPending),
+								zonalState("us-west-2b", elbv2types.CapacityReservationStateEnumFailed),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type:                        elbv2model.LoadBalancerTypeApplication,
+					MinimumLoadBalancerCapacity: &elbv2model.MinimumLoadBalancerCapacity{CapacityUnits: 1000},
+				},
+				sdkLB: sdkLB,
+			},
+			want: "failed",
+		},
+		{
+			name: "network load balancers are skipped",
+			args: args{
+				lbSpec: elbv2model.LoadBalancerSpec{
+					Type: elbv2model.LoadBalancerTypeNetwork,
+				},
+				sdkLB: sdkLB,
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.fields.describeCapacityReservationWithContextCalls {
+				elbv2Client.EXPECT().DescribeCapacityReservationWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.modifyCapacityReservationWithContextCalls {
+				elbv2Client.EXPECT().ModifyCapacityReservationWithContext(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			m := &defaultLoadBalancerManager{
+				elbv2Client: elbv2Client,
+				logger:      logr.New(&log.NullLogSink{}),
+			}
+			resLB := elbv2model.NewLoadBalancer(stack, "LoadBalancer", tt.args.lbSpec)
+			got, err := m.updateSDKLoadBalancerWithCapacityReservation(context.Background(), resLB, tt.args.sdkLB)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package elbv2
+
+import (
+	"strings"
+)
+
+const (
+	// DNS names of internal ALBs are prefixed with "internal-".
+	internalLoadBalancerDNSNamePrefix = "internal-"
+)
+
+// IsLoadBalancerDNSNameOf checks whether dnsName is the DNS name of the LoadBalancer named lbName.
+// DNS names of LoadBalancers are composed of the LoadBalancer name, a dash and an alphanumeric ID as first label,
+// e.g. "my-alb-1234567890.us-west-2.elb.amazonaws.com" or "my-nlb-0123456789abcdef.elb.us-west-2.amazonaws.com".
+func IsLoadBalancerDNSNameOf(dnsName string, lbName string) bool {
+	if lbName == "" {
+		return false
+	}
+	firstLabel := strings.ToLower(strings.SplitN(dnsName, ".", 2)[0])
+	if isLoadBalancerDNSLabelOf(firstLabel, lbName) {
+		return true
+	}
+	return strings.HasPrefix(firstLabel, internalLoadBalancerDNSNamePrefix) &&
+		isLoadBalancerDNSLabelOf(strings.TrimPrefix(firstLabel, internalLoadBalancerDNSNamePrefix), lbName)
+}
+
+// isLoadBalancerDNSLabelOf checks whether dnsLabel is composed of lbName, a dash and an alphanumeric ID.
+func isLoadBalancerDNSLabelOf(dnsLabel string, lbName string) bool {
+	lbID := strings.TrimPrefix(dnsLabel, strings.ToLower(lbName)+"-")
+	if lbID == dnsLabel || lbID == "" {
+		return false
+	}
+	for _, c := range lbID {
+		if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
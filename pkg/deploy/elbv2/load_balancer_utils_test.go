@@ -0,0 +1,71 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsLoadBalancerDNSNameOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		dnsName string
+		lbName  string
+		want    bool
+	}{
+		{
+			name:    "internet-facing ALB",
+			dnsName: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+			lbName:  "my-alb",
+			want:    true,
+		},
+		{
+			name:    "internal ALB",
+			dnsName: "internal-my-alb-1234567890.us-west-2.elb.amazonaws.com",
+			lbName:  "my-alb",
+			want:    true,
+		},
+		{
+			name:    "NLB",
+			dnsName: "my-nlb-0123456789abcdef.elb.us-west-2.amazonaws.com",
+			lbName:  "my-nlb",
+			want:    true,
+		},
+		{
+			name:    "internet-facing ALB whose name starts with internal",
+			dnsName: "internal-alb-1234567890.us-west-2.elb.amazonaws.com",
+			lbName:  "internal-alb",
+			want:    true,
+		},
+		{
+			name:    "name differs in case",
+			dnsName: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+			lbName:  "My-ALB",
+			want:    true,
+		},
+		{
+			name:    "name is a prefix of another name",
+			dnsName: "my-alb-v2-1234567890.us-west-2.elb.amazonaws.com",
+			lbName:  "my-alb",
+			want:    false,
+		},
+		{
+			name:    "different name",
+			dnsName: "k8s-default-echoserv-1234567890.us-west-2.elb.amazonaws.com",
+			lbName:  "my-alb",
+			want:    false,
+		},
+		{
+			name:    "empty name",
+			dnsName: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+			lbName:  "",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsLoadBalancerDNSNameOf(tt.dnsName, tt.lbName)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
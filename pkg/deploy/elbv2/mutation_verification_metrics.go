@@ -0,0 +1,49 @@
+package elbv2
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemMutationVerification = "mutation_verification"
+
+	metricMutationVerificationsTotal = "verifications_total"
+)
+
+const (
+	labelVerifiedResource   = "resource"
+	labelVerificationResult = "result"
+
+	verificationResultVerified = "verified"
+	verificationResultMismatch = "mismatch"
+	verificationResultError    = "error"
+)
+
+// MutationVerificationMetrics contains the metrics for verifying listener and listener rule mutations.
+type MutationVerificationMetrics struct {
+	verificationsTotal *prometheus.CounterVec
+}
+
+// NewMutationVerificationMetrics allocates and register new MutationVerificationMetrics to registerer.
+func NewMutationVerificationMetrics(registerer prometheus.Registerer) (*MutationVerificationMetrics, error) {
+	verificationsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemMutationVerification,
+		Name:      metricMutationVerificationsTotal,
+		Help:      "Total number of read-after-write verifications of mutations by resource and whether the mutation is visible",
+	}, []string{labelVerifiedResource, labelVerificationResult})
+
+	if err := registerer.Register(verificationsTotal); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricMutationVerificationsTotal)
+	}
+	return &MutationVerificationMetrics{
+		verificationsTotal: verificationsTotal,
+	}, nil
+}
+
+func (m *MutationVerificationMetrics) observeVerification(resource string, result string) {
+	if m == nil {
+		return
+	}
+	m.verificationsTotal.WithLabelValues(resource, result).Inc()
+}
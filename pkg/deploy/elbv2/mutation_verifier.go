@@ -0,0 +1,105 @@
+package elbv2
+
+import (
+	"context"
+	"time"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	defaultMutationVerificationMaxAttempts   = 3
+	defaultMutationVerificationRetryInterval = 2 * time.Second
+
+	verifiedResourceListener     = "listener"
+	verifiedResourceListenerRule = "listenerRule"
+)
+
+// MutationVerifier verifies a mutation is visible via a follow-up describe, and retries the mutation if the API
+// accepted it but the resulting state differs, e.g. when external automation modifies the same resource concurrently.
+type MutationVerifier struct {
+	elbv2Client services.ELBV2
+	metrics     *MutationVerificationMetrics
+	logger      logr.Logger
+
+	maxAttempts   int
+	retryInterval time.Duration
+}
+
+// NewMutationVerifier constructs new MutationVerifier.
+func NewMutationVerifier(elbv2Client services.ELBV2, metrics *MutationVerificationMetrics, logger logr.Logger) *MutationVerifier {
+	return &MutationVerifier{
+		elbv2Client:   elbv2Client,
+		metrics:       metrics,
+		logger:        logger,
+		maxAttempts:   defaultMutationVerificationMaxAttempts,
+		retryInterval: defaultMutationVerificationRetryInterval,
+	}
+}
+
+// MutateAndVerify invokes mutate, and repeats it until verify reports the resulting state matches the desired one.
+// A nil MutationVerifier invokes mutate once without verification.
+func (v *MutationVerifier) MutateAndVerify(ctx context.Context, resource string, arn string,
+	mutate func() error, verify func() (bool, error)) error {
+	if v == nil {
+		return mutate()
+	}
+	for attempt := 1; ; attempt++ {
+		if err := mutate(); err != nil {
+			return err
+		}
+		matched, err := verify()
+		if err != nil {
+			v.metrics.observeVerification(resource, verificationResultError)
+			return errors.Wrapf(err, "failed to verify %v %v", resource, arn)
+		}
+		if matched {
+			v.metrics.observeVerification(resource, verificationResultVerified)
+			return nil
+		}
+		v.metrics.observeVerification(resource, verificationResultMismatch)
+		if attempt >= v.maxAttempts {
+			return errors.Errorf("%v %v doesn't match the desired state after %v attempts", resource, arn, attempt)
+		}
+		v.logger.Info("mutation isn't visible, retrying",
+			"resource", resource,
+			"arn", arn,
+			"attempt", attempt)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(v.retryInterval):
+		}
+	}
+}
+
+// describeListener describes the current state of listener with lsARN.
+func (v *MutationVerifier) describeListener(ctx context.Context, lsARN string) (ListenerWithTags, error) {
+	listeners, err := v.elbv2Client.DescribeListenersAsList(ctx, &elbv2sdk.DescribeListenersInput{
+		ListenerArns: []string{lsARN},
+	})
+	if err != nil {
+		return ListenerWithTags{}, err
+	}
+	if len(listeners) == 0 {
+		return ListenerWithTags{}, errors.Errorf("listener %v not found", lsARN)
+	}
+	return ListenerWithTags{Listener: &listeners[0]}, nil
+}
+
+// describeListenerRule describes the current state of listener rule with ruleARN.
+func (v *MutationVerifier) describeListenerRule(ctx context.Context, ruleARN string) (ListenerRuleWithTags, error) {
+	rules, err := v.elbv2Client.DescribeRulesAsList(ctx, &elbv2sdk.DescribeRulesInput{
+		RuleArns: []string{ruleARN},
+	})
+	if err != nil {
+		return ListenerRuleWithTags{}, err
+	}
+	if len(rules) == 0 {
+		return ListenerRuleWithTags{}, errors.Errorf("listener rule %v not found", ruleARN)
+	}
+	return ListenerRuleWithTags{ListenerRule: &rules[0]}, nil
+}
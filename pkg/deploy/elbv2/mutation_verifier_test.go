@@ -0,0 +1,129 @@
+package elbv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+func Test_MutationVerifier_MutateAndVerify(t *testing.T) {
+	tests := []struct {
+		name            string
+		verifyResults   []bool
+		verifyErr       error
+		mutateErr       error
+		wantMutations   int
+		wantErr         string
+		wantVerified    float64
+		wantMismatches  float64
+		wantVerifyError float64
+	}{
+		{
+			name:          "mutation is visible immediately",
+			verifyResults: []bool{true},
+			wantMutations: 1,
+			wantVerified:  1,
+		},
+		{
+			name:           "mutation is retried until visible",
+			verifyResults:  []bool{false, true},
+			wantMutations:  2,
+			wantVerified:   1,
+			wantMismatches: 1,
+		},
+		{
+			name:           "mutation isn't visible after max attempts",
+			verifyResults:  []bool{false, false, false},
+			wantMutations:  3,
+			wantErr:        "listenerRule rule-arn doesn't match the desired state after 3 attempts",
+			wantMismatches: 3,
+		},
+		{
+			name:            "verification fails",
+			verifyErr:       errors.New("some error"),
+			wantMutations:   1,
+			wantErr:         "failed to verify listenerRule rule-arn: some error",
+			wantVerifyError: 1,
+		},
+		{
+			name:          "mutation fails",
+			mutateErr:     errors.New("some error"),
+			wantMutations: 1,
+			wantErr:       "some error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics, err := NewMutationVerificationMetrics(prometheus.NewRegistry())
+			assert.NoError(t, err)
+			v := NewMutationVerifier(nil, metrics, logr.Discard())
+			v.retryInterval = 0
+
+			mutations, verifications := 0, 0
+			err = v.MutateAndVerify(context.Background(), verifiedResourceListenerRule, "rule-arn", func() error {
+				mutations++
+				return tt.mutateErr
+			}, func() (bool, error) {
+				if tt.verifyErr != nil {
+					return false, tt.verifyErr
+				}
+				verifications++
+				return tt.verifyResults[verifications-1], nil
+			})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantMutations, mutations)
+			assert.Equal(t, tt.wantVerified, testutil.ToFloat64(metrics.verificationsTotal.WithLabelValues(verifiedResourceListenerRule, verificationResultVerified)))
+			assert.Equal(t, tt.wantMismatches, testutil.ToFloat64(metrics.verificationsTotal.WithLabelValues(verifiedResourceListenerRule, verificationResultMismatch)))
+			assert.Equal(t, tt.wantVerifyError, testutil.ToFloat64(metrics.verificationsTotal.WithLabelValues(verifiedResourceListenerRule, verificationResultError)))
+		})
+	}
+}
+
+func Test_MutationVerifier_MutateAndVerify_disabled(t *testing.T) {
+	var v *MutationVerifier
+	mutations := 0
+	err := v.MutateAndVerify(context.Background(), verifiedResourceListener, "ls-arn", func() error {
+		mutations++
+		return nil
+	}, func() (bool, error) {
+		t.Fatal("disabled verifier mustn't verify")
+		return false, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mutations)
+}
+
+func Test_MutationVerifier_describeListenerRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	elbv2Client := services.NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().DescribeRulesAsList(gomock.Any(), &elbv2sdk.DescribeRulesInput{
+		RuleArns: []string{"rule-arn"},
+	}).Return([]elbv2types.Rule{{RuleArn: awssdk.String("rule-arn")}}, nil)
+	elbv2Client.EXPECT().DescribeRulesAsList(gomock.Any(), &elbv2sdk.DescribeRulesInput{
+		RuleArns: []string{"gone-rule-arn"},
+	}).Return(nil, nil)
+
+	v := NewMutationVerifier(elbv2Client, nil, logr.Discard())
+	got, err := v.describeListenerRule(context.Background(), "rule-arn")
+	assert.NoError(t, err)
+	assert.Equal(t, "rule-arn", awssdk.ToString(got.ListenerRule.RuleArn))
+
+	_, err = v.describeListenerRule(context.Background(), "gone-rule-arn")
+	assert.EqualError(t, err, "listener rule gone-rule-arn not found")
+}
@@ -0,0 +1,11 @@
+package elbv2
+
+import (
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// isRecreationRequested checks whether the recreation of an AWS resource with sdkTags is requested with a new token via resTags.
+func isRecreationRequested(resTags map[string]string, sdkTags map[string]string) bool {
+	token, ok := resTags[elbv2model.RecreationTokenTagKey]
+	return ok && sdkTags[elbv2model.RecreationTokenTagKey] != token
+}
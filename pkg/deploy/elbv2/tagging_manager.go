@@ -3,9 +3,12 @@ package elbv2
 import (
 	"context"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
-	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+
+	resourcegroupstaggingapi "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	rgttypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -22,28 +25,34 @@ const (
 
 // LoadBalancer with it's tags.
 type LoadBalancerWithTags struct {
-	LoadBalancer *elbv2sdk.LoadBalancer
+	LoadBalancer *elbv2types.LoadBalancer
 	Tags         map[string]string
 }
 
 // TargetGroup with it's tags.
 type TargetGroupWithTags struct {
-	TargetGroup *elbv2sdk.TargetGroup
+	TargetGroup *elbv2types.TargetGroup
 	Tags        map[string]string
 }
 
 // Listener with it's tags.
 type ListenerWithTags struct {
-	Listener *elbv2sdk.Listener
+	Listener *elbv2types.Listener
 	Tags     map[string]string
 }
 
 // ListenerRule with tags
 type ListenerRuleWithTags struct {
-	ListenerRule *elbv2sdk.Rule
+	ListenerRule *elbv2types.Rule
 	Tags         map[string]string
 }
 
+// TrustStore with it's tags.
+type TrustStoreWithTags struct {
+	TrustStore *elbv2types.TrustStore
+	Tags       map[string]string
+}
+
 // options for ReconcileTags API.
 type ReconcileTagsOptions struct {
 	// CurrentTags on resources.
@@ -93,14 +102,20 @@ type TaggingManager interface {
 
 	// ListListenerRules returns the Listener Rules along with tags
 	ListListenerRules(ctx context.Context, lsARN string) ([]ListenerRuleWithTags, error)
+
+	// ListTrustStores returns TrustStores that matches any of the tagging requirements.
+	ListTrustStores(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TrustStoreWithTags, error)
 }
 
 // NewDefaultTaggingManager constructs default TaggingManager.
-func NewDefaultTaggingManager(elbv2Client services.ELBV2, vpcID string, featureGates config.FeatureGates, rgt services.RGT, logger logr.Logger) *defaultTaggingManager {
+// strictTagEnforcement specifies whether ReconcileTags removes the tags not desired, otherwise they're left untouched.
+func NewDefaultTaggingManager(elbv2Client services.ELBV2, vpcID string, featureGates config.FeatureGates, rgt services.RGT,
+	strictTagEnforcement bool, logger logr.Logger) *defaultTaggingManager {
 	return &defaultTaggingManager{
 		elbv2Client:           elbv2Client,
 		vpcID:                 vpcID,
 		featureGates:          featureGates,
+		strictTagEnforcement:  strictTagEnforcement,
 		logger:                logger,
 		describeTagsChunkSize: defaultDescribeTagsChunkSize,
 		rgt:                   rgt,
@@ -115,6 +130,7 @@ type defaultTaggingManager struct {
 	elbv2Client           services.ELBV2
 	vpcID                 string
 	featureGates          config.FeatureGates
+	strictTagEnforcement  bool
 	logger                logr.Logger
 	describeTagsChunkSize int
 	rgt                   services.RGT
@@ -140,10 +156,13 @@ func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, arn string, d
 		delete(tagsToUpdate, ignoredTagKey)
 		delete(tagsToRemove, ignoredTagKey)
 	}
+	if !m.strictTagEnforcement {
+		tagsToRemove = nil
+	}
 
 	if len(tagsToUpdate) > 0 {
 		req := &elbv2sdk.AddTagsInput{
-			ResourceArns: []*string{awssdk.String(arn)},
+			ResourceArns: []string{arn},
 			Tags:         convertTagsToSDKTags(tagsToUpdate),
 		}
 
@@ -160,8 +179,8 @@ func (m *defaultTaggingManager) ReconcileTags(ctx context.Context, arn string, d
 	if len(tagsToRemove) > 0 {
 		tagKeys := sets.StringKeySet(tagsToRemove).List()
 		req := &elbv2sdk.RemoveTagsInput{
-			ResourceArns: []*string{awssdk.String(arn)},
-			TagKeys:      awssdk.StringSlice(tagKeys),
+			ResourceArns: []string{arn},
+			TagKeys:      tagKeys,
 		}
 
 		m.logger.Info("removing resource tags",
@@ -185,9 +204,10 @@ func (m *defaultTaggingManager) ListListeners(ctx context.Context, lbARN string)
 		return nil, err
 	}
 	lsARNs := make([]string, 0, len(listeners))
-	lsByARN := make(map[string]*elbv2sdk.Listener, len(listeners))
-	for _, listener := range listeners {
-		lsARN := awssdk.StringValue(listener.ListenerArn)
+	lsByARN := make(map[string]*elbv2types.Listener, len(listeners))
+	for i := range listeners {
+		listener := &listeners[i]
+		lsARN := awssdk.ToString(listener.ListenerArn)
 		lsARNs = append(lsARNs, lsARN)
 		lsByARN[lsARN] = listener
 	}
@@ -218,9 +238,10 @@ func (m *defaultTaggingManager) ListListenerRules(ctx context.Context, lsARN str
 		return nil, err
 	}
 	lrARNs := make([]string, 0, len(rules))
-	lrByARN := make(map[string]*elbv2sdk.Rule, len(rules))
-	for _, rule := range rules {
-		lrARN := awssdk.StringValue(rule.RuleArn)
+	lrByARN := make(map[string]*elbv2types.Rule, len(rules))
+	for i := range rules {
+		rule := &rules[i]
+		lrARN := awssdk.ToString(rule.RuleArn)
 		lrARNs = append(lrARNs, lrARN)
 		lrByARN[lrARN] = rule
 	}
@@ -259,26 +280,26 @@ func (m *defaultTaggingManager) ListTargetGroups(ctx context.Context, tagFilters
 
 func (m *defaultTaggingManager) listLoadBalancersRGT(ctx context.Context, tagFilters []tracking.TagFilter) ([]LoadBalancerWithTags, error) {
 	// use a map to avoid potential duplication in returned resources
-	resourceTagsByARN := make(map[string][]*resourcegroupstaggingapi.Tag)
+	resourceTagsByARN := make(map[string][]rgttypes.Tag)
 	for _, tagFilter := range tagFilters {
 		req := &resourcegroupstaggingapi.GetResourcesInput{
 			TagFilters:          convertTagFiltersToRGTTagFilters(tagFilter),
-			ResourceTypeFilters: awssdk.StringSlice([]string{services.ResourceTypeELBLoadBalancer}),
+			ResourceTypeFilters: []string{services.ResourceTypeELBLoadBalancer},
 		}
 		resources, err := m.rgt.GetResourcesAsList(ctx, req)
 		if err != nil {
 			return nil, err
 		}
 		for _, resource := range resources {
-			if _, exists := resourceTagsByARN[awssdk.StringValue(resource.ResourceARN)]; !exists {
-				resourceTagsByARN[awssdk.StringValue(resource.ResourceARN)] = resource.Tags
+			if _, exists := resourceTagsByARN[awssdk.ToString(resource.ResourceARN)]; !exists {
+				resourceTagsByARN[awssdk.ToString(resource.ResourceARN)] = resource.Tags
 			}
 		}
 	}
 	var matchedLBs []LoadBalancerWithTags
 	for resourceARN, resourceTags := range resourceTagsByARN {
 		elbv2Req := &elbv2sdk.DescribeLoadBalancersInput{
-			LoadBalancerArns: []*string{&resourceARN},
+			LoadBalancerArns: []string{resourceARN},
 		}
 		elbv2Resp, err := m.elbv2Client.DescribeLoadBalancersAsList(ctx, elbv2Req)
 		if err != nil {
@@ -288,7 +309,7 @@ func (m *defaultTaggingManager) listLoadBalancersRGT(ctx context.Context, tagFil
 			return nil, errors.Errorf("no load balancer found for the arn: %v", resourceARN)
 		}
 		matchedLBs = append(matchedLBs, LoadBalancerWithTags{
-			LoadBalancer: elbv2Resp[0],
+			LoadBalancer: &elbv2Resp[0],
 			Tags:         services.ParseRGTTags(resourceTags),
 		})
 	}
@@ -302,12 +323,13 @@ func (m *defaultTaggingManager) listLoadBalancersNative(ctx context.Context, tag
 		return nil, err
 	}
 	lbARNsWithinVPC := make([]string, 0, len(lbs))
-	lbByARNWithinVPC := make(map[string]*elbv2sdk.LoadBalancer, len(lbs))
-	for _, lb := range lbs {
-		if awssdk.StringValue(lb.VpcId) != m.vpcID {
+	lbByARNWithinVPC := make(map[string]*elbv2types.LoadBalancer, len(lbs))
+	for i := range lbs {
+		lb := &lbs[i]
+		if awssdk.ToString(lb.VpcId) != m.vpcID {
 			continue
 		}
-		lbARN := awssdk.StringValue(lb.LoadBalancerArn)
+		lbARN := awssdk.ToString(lb.LoadBalancerArn)
 		lbARNsWithinVPC = append(lbARNsWithinVPC, lbARN)
 		lbByARNWithinVPC[lbARN] = lb
 	}
@@ -338,26 +360,26 @@ func (m *defaultTaggingManager) listLoadBalancersNative(ctx context.Context, tag
 
 func (m *defaultTaggingManager) listTargetGroupsRGT(ctx context.Context, tagFilters []tracking.TagFilter) ([]TargetGroupWithTags, error) {
 	// use a map to avoid potential duplication in returned resources
-	resourceTagsByARN := make(map[string][]*resourcegroupstaggingapi.Tag)
+	resourceTagsByARN := make(map[string][]rgttypes.Tag)
 	for _, tagFilter := range tagFilters {
 		req := &resourcegroupstaggingapi.GetResourcesInput{
 			TagFilters:          convertTagFiltersToRGTTagFilters(tagFilter),
-			ResourceTypeFilters: awssdk.StringSlice([]string{services.ResourceTypeELBTargetGroup}),
+			ResourceTypeFilters: []string{services.ResourceTypeELBTargetGroup},
 		}
 		resources, err := m.rgt.GetResourcesAsList(ctx, req)
 		if err != nil {
 			return nil, err
 		}
 		for _, resource := range resources {
-			if _, exists := resourceTagsByARN[awssdk.StringValue(resource.ResourceARN)]; !exists {
-				resourceTagsByARN[awssdk.StringValue(resource.ResourceARN)] = resource.Tags
+			if _, exists := resourceTagsByARN[awssdk.ToString(resource.ResourceARN)]; !exists {
+				resourceTagsByARN[awssdk.ToString(resource.ResourceARN)] = resource.Tags
 			}
 		}
 	}
 	var matchedTGs []TargetGroupWithTags
 	for resourceARN, resourceTags := range resourceTagsByARN {
 		elbv2Req := &elbv2sdk.DescribeTargetGroupsInput{
-			TargetGroupArns: []*string{&resourceARN},
+			TargetGroupArns: []string{resourceARN},
 		}
 		elbv2Resp, err := m.elbv2Client.DescribeTargetGroupsAsList(ctx, elbv2Req)
 		if err != nil {
@@ -367,7 +389,7 @@ func (m *defaultTaggingManager) listTargetGroupsRGT(ctx context.Context, tagFilt
 			return nil, errors.Errorf("no target group found for the arn: %v", resourceARN)
 		}
 		matchedTGs = append(matchedTGs, TargetGroupWithTags{
-			TargetGroup: elbv2Resp[0],
+			TargetGroup: &elbv2Resp[0],
 			Tags:        services.ParseRGTTags(resourceTags),
 		})
 	}
@@ -382,12 +404,13 @@ func (m *defaultTaggingManager) listTargetGroupsNative(ctx context.Context, tagF
 	}
 
 	tgARNsWithinVPC := make([]string, 0, len(tgs))
-	tgByARNWithinVPC := make(map[string]*elbv2sdk.TargetGroup, len(tgs))
-	for _, tg := range tgs {
-		if awssdk.StringValue(tg.VpcId) != m.vpcID {
+	tgByARNWithinVPC := make(map[string]*elbv2types.TargetGroup, len(tgs))
+	for i := range tgs {
+		tg := &tgs[i]
+		if awssdk.ToString(tg.VpcId) != m.vpcID {
 			continue
 		}
-		tgARN := awssdk.StringValue(tg.TargetGroupArn)
+		tgARN := awssdk.ToString(tg.TargetGroupArn)
 		tgARNsWithinVPC = append(tgARNsWithinVPC, tgARN)
 		tgByARNWithinVPC[tgARN] = tg
 	}
@@ -416,6 +439,47 @@ func (m *defaultTaggingManager) listTargetGroupsNative(ctx context.Context, tagF
 	return matchedTGs, nil
 }
 
+// ListTrustStores lists trustStores with native ELBV2 API only, as trustStores are not scoped to VPC.
+func (m *defaultTaggingManager) ListTrustStores(ctx context.Context, tagFilters ...tracking.TagFilter) ([]TrustStoreWithTags, error) {
+	req := &elbv2sdk.DescribeTrustStoresInput{}
+	trustStores, err := m.elbv2Client.DescribeTrustStoresAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	trustStoreARNs := make([]string, 0, len(trustStores))
+	trustStoreByARN := make(map[string]*elbv2types.TrustStore, len(trustStores))
+	for i := range trustStores {
+		trustStore := &trustStores[i]
+		trustStoreARN := awssdk.ToString(trustStore.TrustStoreArn)
+		trustStoreARNs = append(trustStoreARNs, trustStoreARN)
+		trustStoreByARN[trustStoreARN] = trustStore
+	}
+	tagsByARN, err := m.describeResourceTagsNative(ctx, trustStoreARNs)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchedTrustStores []TrustStoreWithTags
+	for _, arn := range trustStoreARNs {
+		tags := tagsByARN[arn]
+		matchedAnyTagFilter := false
+		for _, tagFilter := range tagFilters {
+			if tagFilter.Matches(tags) {
+				matchedAnyTagFilter = true
+				break
+			}
+		}
+		if matchedAnyTagFilter {
+			matchedTrustStores = append(matchedTrustStores, TrustStoreWithTags{
+				TrustStore: trustStoreByARN[arn],
+				Tags:       tags,
+			})
+		}
+	}
+	return matchedTrustStores, nil
+}
+
 // describeResourceTagsNative describes tags for elbv2 resources.
 // returns tags indexed by resource ARN.
 func (m *defaultTaggingManager) describeResourceTagsNative(ctx context.Context, arns []string) (map[string]map[string]string, error) {
@@ -423,28 +487,28 @@ func (m *defaultTaggingManager) describeResourceTagsNative(ctx context.Context,
 	arnsChunks := algorithm.ChunkStrings(arns, m.describeTagsChunkSize)
 	for _, arnsChunk := range arnsChunks {
 		req := &elbv2sdk.DescribeTagsInput{
-			ResourceArns: awssdk.StringSlice(arnsChunk),
+			ResourceArns: arnsChunk,
 		}
 		resp, err := m.elbv2Client.DescribeTagsWithContext(ctx, req)
 		if err != nil {
 			return nil, err
 		}
 		for _, tagDescription := range resp.TagDescriptions {
-			tagsByARN[awssdk.StringValue(tagDescription.ResourceArn)] = convertSDKTagsToTags(tagDescription.Tags)
+			tagsByARN[awssdk.ToString(tagDescription.ResourceArn)] = convertSDKTagsToTags(tagDescription.Tags)
 		}
 	}
 	return tagsByARN, nil
 }
 
 // convert tags into AWS SDK tag presentation.
-func convertTagsToSDKTags(tags map[string]string) []*elbv2sdk.Tag {
+func convertTagsToSDKTags(tags map[string]string) []elbv2types.Tag {
 	if len(tags) == 0 {
 		return nil
 	}
-	sdkTags := make([]*elbv2sdk.Tag, 0, len(tags))
+	sdkTags := make([]elbv2types.Tag, 0, len(tags))
 
 	for _, key := range sets.StringKeySet(tags).List() {
-		sdkTags = append(sdkTags, &elbv2sdk.Tag{
+		sdkTags = append(sdkTags, elbv2types.Tag{
 			Key:   awssdk.String(key),
 			Value: awssdk.String(tags[key]),
 		})
@@ -453,21 +517,21 @@ func convertTagsToSDKTags(tags map[string]string) []*elbv2sdk.Tag {
 }
 
 // convert AWS SDK tag presentation into tags.
-func convertSDKTagsToTags(sdkTags []*elbv2sdk.Tag) map[string]string {
+func convertSDKTagsToTags(sdkTags []elbv2types.Tag) map[string]string {
 	tags := make(map[string]string, len(sdkTags))
 	for _, sdkTag := range sdkTags {
-		tags[awssdk.StringValue(sdkTag.Key)] = awssdk.StringValue(sdkTag.Value)
+		tags[awssdk.ToString(sdkTag.Key)] = awssdk.ToString(sdkTag.Value)
 	}
 	return tags
 }
 
 // convert tagFilters to RGTTagFilters
-func convertTagFiltersToRGTTagFilters(tagFilter tracking.TagFilter) []*resourcegroupstaggingapi.TagFilter {
-	var RGTTagFilters []*resourcegroupstaggingapi.TagFilter
+func convertTagFiltersToRGTTagFilters(tagFilter tracking.TagFilter) []rgttypes.TagFilter {
+	var RGTTagFilters []rgttypes.TagFilter
 	for k, v := range tagFilter {
-		RGTTagFilters = append(RGTTagFilters, &resourcegroupstaggingapi.TagFilter{
+		RGTTagFilters = append(RGTTagFilters, rgttypes.TagFilter{
 			Key:    awssdk.String(k),
-			Values: awssdk.StringSlice(v),
+			Values: v,
 		})
 	}
 	return RGTTagFilters
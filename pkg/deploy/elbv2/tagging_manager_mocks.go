@@ -105,6 +105,26 @@ func (mr *MockTaggingManagerMockRecorder) ListTargetGroups(arg0 interface{}, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTargetGroups", reflect.TypeOf((*MockTaggingManager)(nil).ListTargetGroups), varargs...)
 }
 
+// ListTrustStores mocks base method.
+func (m *MockTaggingManager) ListTrustStores(arg0 context.Context, arg1 ...tracking.TagFilter) ([]TrustStoreWithTags, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrustStores", varargs...)
+	ret0, _ := ret[0].([]TrustStoreWithTags)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrustStores indicates an expected call of ListTrustStores.
+func (mr *MockTaggingManagerMockRecorder) ListTrustStores(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrustStores", reflect.TypeOf((*MockTaggingManager)(nil).ListTrustStores), varargs...)
+}
+
 // ReconcileTags mocks base method.
 func (m *MockTaggingManager) ReconcileTags(arg0 context.Context, arg1 string, arg2 map[string]string, arg3 ...ReconcileTagsOption) error {
 	m.ctrl.T.Helper()
@@ -4,8 +4,9 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -43,10 +44,11 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 		opts        []ReconcileTagsOption
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr error
+		name     string
+		fields   fields
+		args     args
+		additive bool
+		wantErr  error
 	}{
 		{
 			name: "standard case - add/update/remove tags",
@@ -54,13 +56,13 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn")},
+							ResourceArns: []string{"my-arn"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("my-arn"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA"),
@@ -82,8 +84,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				addTagsWithContextCalls: []addTagsWithContextCall{
 					{
 						req: &elbv2sdk.AddTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn")},
-							Tags: []*elbv2sdk.Tag{
+							ResourceArns: []string{"my-arn"},
+							Tags: []elbv2types.Tag{
 								{
 									Key:   awssdk.String("keyB"),
 									Value: awssdk.String("valueB2"),
@@ -99,8 +101,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				removeTagsWithContextCalls: []removeTagsWithContextCall{
 					{
 						req: &elbv2sdk.RemoveTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn")},
-							TagKeys:      []*string{awssdk.String("keyC")},
+							ResourceArns: []string{"my-arn"},
+							TagKeys:      []string{"keyC"},
 						},
 					},
 				},
@@ -122,8 +124,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				addTagsWithContextCalls: []addTagsWithContextCall{
 					{
 						req: &elbv2sdk.AddTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn")},
-							Tags: []*elbv2sdk.Tag{
+							ResourceArns: []string{"my-arn"},
+							Tags: []elbv2types.Tag{
 								{
 									Key:   awssdk.String("keyB"),
 									Value: awssdk.String("valueB2"),
@@ -139,8 +141,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				removeTagsWithContextCalls: []removeTagsWithContextCall{
 					{
 						req: &elbv2sdk.RemoveTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn")},
-							TagKeys:      []*string{awssdk.String("keyC")},
+							ResourceArns: []string{"my-arn"},
+							TagKeys:      []string{"keyC"},
 						},
 					},
 				},
@@ -168,8 +170,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				addTagsWithContextCalls: []addTagsWithContextCall{
 					{
 						req: &elbv2sdk.AddTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn")},
-							Tags: []*elbv2sdk.Tag{
+							ResourceArns: []string{"my-arn"},
+							Tags: []elbv2types.Tag{
 								{
 									Key:   awssdk.String("keyC"),
 									Value: awssdk.String("valueC2"),
@@ -185,8 +187,8 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				removeTagsWithContextCalls: []removeTagsWithContextCall{
 					{
 						req: &elbv2sdk.RemoveTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn")},
-							TagKeys:      []*string{awssdk.String("keyF")},
+							ResourceArns: []string{"my-arn"},
+							TagKeys:      []string{"keyF"},
 						},
 					},
 				},
@@ -211,6 +213,46 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "additive tag enforcement - add/update tags only",
+			fields: fields{
+				describeTagsWithContextCalls: nil,
+				addTagsWithContextCalls: []addTagsWithContextCall{
+					{
+						req: &elbv2sdk.AddTagsInput{
+							ResourceArns: []string{"my-arn"},
+							Tags: []elbv2types.Tag{
+								{
+									Key:   awssdk.String("keyB"),
+									Value: awssdk.String("valueB2"),
+								},
+								{
+									Key:   awssdk.String("keyD"),
+									Value: awssdk.String("valueD"),
+								},
+							},
+						},
+					},
+				},
+				removeTagsWithContextCalls: nil,
+			},
+			args: args{
+				arn: "my-arn",
+				desiredTags: map[string]string{
+					"keyA": "valueA",
+					"keyB": "valueB2",
+					"keyD": "valueD",
+				},
+				opts: []ReconcileTagsOption{
+					WithCurrentTags(map[string]string{
+						"keyA": "valueA",
+						"keyB": "valueB",
+						"keyC": "valueC",
+					}),
+				},
+			},
+			additive: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -234,6 +276,7 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 				logger:                logr.New(&log.NullLogSink{}),
 				describeTagsChunkSize: defaultDescribeTagsChunkSize,
 				featureGates:          featureGates,
+				strictTagEnforcement:  !tt.additive,
 			}
 			err := m.ReconcileTags(context.Background(), tt.args.arn, tt.args.desiredTags, tt.args.opts...)
 			if tt.wantErr != nil {
@@ -248,7 +291,7 @@ func Test_defaultTaggingManager_ReconcileTags(t *testing.T) {
 func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 	type describeLoadBalancersAsListCall struct {
 		req  *elbv2sdk.DescribeLoadBalancersInput
-		resp []*elbv2sdk.LoadBalancer
+		resp []elbv2types.LoadBalancer
 		err  error
 	}
 	type describeTagsWithContextCall struct {
@@ -276,7 +319,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 				describeLoadBalancersAsListCalls: []describeLoadBalancersAsListCall{
 					{
 						req: &elbv2sdk.DescribeLoadBalancersInput{},
-						resp: []*elbv2sdk.LoadBalancer{
+						resp: []elbv2types.LoadBalancer{
 							{
 								LoadBalancerArn: awssdk.String("lb-1"),
 								VpcId:           awssdk.String("vpc-xxxxxxx"),
@@ -295,13 +338,13 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"lb-1", "lb-2", "lb-3"}),
+							ResourceArns: []string{"lb-1", "lb-2", "lb-3"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("lb-1"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA1"),
@@ -314,7 +357,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("lb-2"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA2"),
@@ -327,7 +370,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("lb-3"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA3"),
@@ -352,14 +395,14 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 			},
 			want: []LoadBalancerWithTags{
 				{
-					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA1",
 						"keyB": "valueB1",
 					},
 				},
 				{
-					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-3"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-3"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA3",
 						"keyB": "valueB3",
@@ -373,7 +416,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 				describeLoadBalancersAsListCalls: []describeLoadBalancersAsListCall{
 					{
 						req: &elbv2sdk.DescribeLoadBalancersInput{},
-						resp: []*elbv2sdk.LoadBalancer{
+						resp: []elbv2types.LoadBalancer{
 							{
 								LoadBalancerArn: awssdk.String("lb-1"),
 								VpcId:           awssdk.String("vpc-xxxxxxx"),
@@ -392,13 +435,13 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"lb-1", "lb-2"}),
+							ResourceArns: []string{"lb-1", "lb-2"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("lb-1"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA1"),
@@ -411,7 +454,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("lb-2"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA2"),
@@ -436,7 +479,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 			},
 			want: []LoadBalancerWithTags{
 				{
-					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA1",
 						"keyB": "valueB1",
@@ -450,7 +493,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 				describeLoadBalancersAsListCalls: []describeLoadBalancersAsListCall{
 					{
 						req: &elbv2sdk.DescribeLoadBalancersInput{},
-						resp: []*elbv2sdk.LoadBalancer{
+						resp: []elbv2types.LoadBalancer{
 							{
 								LoadBalancerArn: awssdk.String("lb-1"),
 								VpcId:           awssdk.String("vpc-xxxxxxx"),
@@ -469,13 +512,13 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"lb-1"}),
+							ResourceArns: []string{"lb-1"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("lb-1"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA1"),
@@ -500,7 +543,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 			},
 			want: []LoadBalancerWithTags{
 				{
-					LoadBalancer: &elbv2sdk.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA1",
 						"keyB": "valueB1",
@@ -514,7 +557,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 				describeLoadBalancersAsListCalls: []describeLoadBalancersAsListCall{
 					{
 						req: &elbv2sdk.DescribeLoadBalancersInput{},
-						resp: []*elbv2sdk.LoadBalancer{
+						resp: []elbv2types.LoadBalancer{
 							{
 								LoadBalancerArn: awssdk.String("lb-1"),
 								VpcId:           awssdk.String("vpc-xxxxxxx"),
@@ -533,13 +576,13 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"lb-1", "lb-2", "lb-3"}),
+							ResourceArns: []string{"lb-1", "lb-2", "lb-3"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("lb-1"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA1"),
@@ -552,7 +595,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("lb-2"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA2"),
@@ -565,7 +608,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("lb-3"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA3"),
@@ -624,7 +667,7 @@ func Test_defaultTaggingManager_ListLoadBalancers(t *testing.T) {
 func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 	type describeTargetGroupsAsListCall struct {
 		req  *elbv2sdk.DescribeTargetGroupsInput
-		resp []*elbv2sdk.TargetGroup
+		resp []elbv2types.TargetGroup
 		err  error
 	}
 	type describeTagsWithContextCall struct {
@@ -652,7 +695,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-1"),
 								VpcId:          awssdk.String("vpc-xxxxxxx"),
@@ -671,13 +714,13 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"tg-1", "tg-2", "tg-3"}),
+							ResourceArns: []string{"tg-1", "tg-2", "tg-3"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("tg-1"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA1"),
@@ -690,7 +733,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("tg-2"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA2"),
@@ -703,7 +746,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("tg-3"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA3"),
@@ -728,14 +771,14 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 			},
 			want: []TargetGroupWithTags{
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA1",
 						"keyB": "valueB1",
 					},
 				},
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-3"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-3"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA3",
 						"keyB": "valueB3",
@@ -749,7 +792,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-1"),
 								VpcId:          awssdk.String("vpc-yyyyyyy"),
@@ -768,13 +811,13 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"tg-2", "tg-3"}),
+							ResourceArns: []string{"tg-2", "tg-3"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("tg-2"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA2"),
@@ -787,7 +830,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("tg-3"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA3"),
@@ -812,7 +855,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 			},
 			want: []TargetGroupWithTags{
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-3"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-3"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA3",
 						"keyB": "valueB3",
@@ -826,7 +869,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-1"),
 								VpcId:          awssdk.String("vpc-yyyyyyy"),
@@ -845,13 +888,13 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"tg-3"}),
+							ResourceArns: []string{"tg-3"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("tg-3"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA3"),
@@ -876,7 +919,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 			},
 			want: []TargetGroupWithTags{
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-3"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-3"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA3",
 						"keyB": "valueB3",
@@ -890,7 +933,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-1"),
 								VpcId:          awssdk.String("vpc-xxxxxxx"),
@@ -909,13 +952,13 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"tg-1", "tg-2", "tg-3"}),
+							ResourceArns: []string{"tg-1", "tg-2", "tg-3"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("tg-1"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA1"),
@@ -928,7 +971,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("tg-2"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA2"),
@@ -941,7 +984,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("tg-3"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA3"),
@@ -972,7 +1015,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-1"),
 								VpcId:          awssdk.String("vpc-xxxxxxx"),
@@ -995,13 +1038,13 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: awssdk.StringSlice([]string{"tg-1", "tg-2", "tg-3", "tg-4"}),
+							ResourceArns: []string{"tg-1", "tg-2", "tg-3", "tg-4"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("tg-1"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA1"),
@@ -1014,7 +1057,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("tg-2"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA2"),
@@ -1027,7 +1070,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("tg-3"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA3"),
@@ -1040,7 +1083,7 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("tg-4"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA4"),
@@ -1068,21 +1111,21 @@ func Test_defaultTaggingManager_ListTargetGroups(t *testing.T) {
 			},
 			want: []TargetGroupWithTags{
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-1"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA1",
 						"keyB": "valueB1",
 					},
 				},
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-2"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-2"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA2",
 						"keyB": "valueB2",
 					},
 				},
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{TargetGroupArn: awssdk.String("tg-4"), VpcId: awssdk.String("vpc-xxxxxxx")},
+					TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-4"), VpcId: awssdk.String("vpc-xxxxxxx")},
 					Tags: map[string]string{
 						"keyA": "valueA4",
 						"keyB": "valueB4",
@@ -1146,13 +1189,13 @@ func Test_defaultTaggingManager_describeResourceTagsNative(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn")},
+							ResourceArns: []string{"my-arn"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("my-arn"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA"),
@@ -1184,13 +1227,13 @@ func Test_defaultTaggingManager_describeResourceTagsNative(t *testing.T) {
 				describeTagsWithContextCalls: []describeTagsWithContextCall{
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn1"), awssdk.String("my-arn2")},
+							ResourceArns: []string{"my-arn1", "my-arn2"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("my-arn1"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA1"),
@@ -1203,7 +1246,7 @@ func Test_defaultTaggingManager_describeResourceTagsNative(t *testing.T) {
 								},
 								{
 									ResourceArn: awssdk.String("my-arn2"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA2"),
@@ -1219,13 +1262,13 @@ func Test_defaultTaggingManager_describeResourceTagsNative(t *testing.T) {
 					},
 					{
 						req: &elbv2sdk.DescribeTagsInput{
-							ResourceArns: []*string{awssdk.String("my-arn3")},
+							ResourceArns: []string{"my-arn3"},
 						},
 						resp: &elbv2sdk.DescribeTagsOutput{
-							TagDescriptions: []*elbv2sdk.TagDescription{
+							TagDescriptions: []elbv2types.TagDescription{
 								{
 									ResourceArn: awssdk.String("my-arn3"),
-									Tags: []*elbv2sdk.Tag{
+									Tags: []elbv2types.Tag{
 										{
 											Key:   awssdk.String("keyA"),
 											Value: awssdk.String("valueA3"),
@@ -1292,7 +1335,7 @@ func Test_convertTagsToSDKTags(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want []*elbv2sdk.Tag
+		want []elbv2types.Tag
 	}{
 		{
 			name: "non-empty case",
@@ -1302,7 +1345,7 @@ func Test_convertTagsToSDKTags(t *testing.T) {
 					"keyB": "valueB",
 				},
 			},
-			want: []*elbv2sdk.Tag{
+			want: []elbv2types.Tag{
 				{
 					Key:   awssdk.String("keyA"),
 					Value: awssdk.String("valueA"),
@@ -1334,7 +1377,7 @@ func Test_convertTagsToSDKTags(t *testing.T) {
 
 func Test_convertSDKTagsToTags(t *testing.T) {
 	type args struct {
-		sdkTags []*elbv2sdk.Tag
+		sdkTags []elbv2types.Tag
 	}
 	tests := []struct {
 		name string
@@ -1344,7 +1387,7 @@ func Test_convertSDKTagsToTags(t *testing.T) {
 		{
 			name: "non-empty case",
 			args: args{
-				sdkTags: []*elbv2sdk.Tag{
+				sdkTags: []elbv2types.Tag{
 					{
 						Key:   awssdk.String("keyA"),
 						Value: awssdk.String("valueA"),
@@ -1370,7 +1413,7 @@ func Test_convertSDKTagsToTags(t *testing.T) {
 		{
 			name: "empty case",
 			args: args{
-				sdkTags: []*elbv2sdk.Tag{},
+				sdkTags: []elbv2types.Tag{},
 			},
 			want: map[string]string{},
 		},
@@ -2,12 +2,16 @@ package elbv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
@@ -18,10 +22,11 @@ type TargetGroupAttributesReconciler interface {
 }
 
 // NewDefaultTargetGroupAttributesReconciler constructs new TargetGroupAttributesReconciler.
-func NewDefaultTargetGroupAttributesReconciler(elbv2Client services.ELBV2, logger logr.Logger) *defaultTargetGroupAttributeReconciler {
+func NewDefaultTargetGroupAttributesReconciler(elbv2Client services.ELBV2, crossZoneValidator CrossZoneValidator, logger logr.Logger) *defaultTargetGroupAttributeReconciler {
 	return &defaultTargetGroupAttributeReconciler{
-		elbv2Client: elbv2Client,
-		logger:      logger,
+		elbv2Client:        elbv2Client,
+		crossZoneValidator: crossZoneValidator,
+		logger:             logger,
 	}
 }
 
@@ -29,8 +34,9 @@ var _ TargetGroupAttributesReconciler = &defaultTargetGroupAttributeReconciler{}
 
 // default implementation for TargetGroupAttributesReconciler
 type defaultTargetGroupAttributeReconciler struct {
-	elbv2Client services.ELBV2
-	logger      logr.Logger
+	elbv2Client        services.ELBV2
+	crossZoneValidator CrossZoneValidator
+	logger             logr.Logger
 }
 
 func (r *defaultTargetGroupAttributeReconciler) Reconcile(ctx context.Context, resTG *elbv2model.TargetGroup, sdkTG TargetGroupWithTags) error {
@@ -41,13 +47,19 @@ func (r *defaultTargetGroupAttributeReconciler) Reconcile(ctx context.Context, r
 	}
 
 	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
+	if desiredCrossZone, ok := attributesToUpdate[attrCrossZoneEnabled]; ok {
+		if err := r.crossZoneValidator.ValidateTargetGroup(ctx, awssdk.ToString(sdkTG.TargetGroup.TargetGroupArn),
+			currentAttrs[attrCrossZoneEnabled], desiredCrossZone); err != nil {
+			return err
+		}
+	}
 	if len(attributesToUpdate) > 0 {
 		req := &elbv2sdk.ModifyTargetGroupAttributesInput{
 			TargetGroupArn: sdkTG.TargetGroup.TargetGroupArn,
 			Attributes:     nil,
 		}
 		for _, attrKey := range sets.StringKeySet(attributesToUpdate).List() {
-			req.Attributes = append(req.Attributes, &elbv2sdk.TargetGroupAttribute{
+			req.Attributes = append(req.Attributes, elbv2types.TargetGroupAttribute{
 				Key:   awssdk.String(attrKey),
 				Value: awssdk.String(attributesToUpdate[attrKey]),
 			})
@@ -56,7 +68,7 @@ func (r *defaultTargetGroupAttributeReconciler) Reconcile(ctx context.Context, r
 		r.logger.Info("modifying targetGroup attributes",
 			"stackID", resTG.Stack().StackID(),
 			"resourceID", resTG.ID(),
-			"arn", awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn),
+			"arn", awssdk.ToString(sdkTG.TargetGroup.TargetGroupArn),
 			"change", attributesToUpdate)
 		if _, err := r.elbv2Client.ModifyTargetGroupAttributesWithContext(ctx, req); err != nil {
 			return err
@@ -64,7 +76,9 @@ func (r *defaultTargetGroupAttributeReconciler) Reconcile(ctx context.Context, r
 		r.logger.Info("modified targetGroup attributes",
 			"stackID", resTG.Stack().StackID(),
 			"resourceID", resTG.ID(),
-			"arn", awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn))
+			"arn", awssdk.ToString(sdkTG.TargetGroup.TargetGroupArn))
+		audit.RecordMutation(ctx, audit.ActionModify, "targetGroup", awssdk.ToString(sdkTG.TargetGroup.TargetGroupArn),
+			fmt.Sprintf("attributes %v", attributesToUpdate))
 	}
 	return nil
 }
@@ -89,7 +103,7 @@ func (r *defaultTargetGroupAttributeReconciler) getCurrentTargetGroupAttributes(
 
 	tgAttributes := make(map[string]string, len(resp.Attributes))
 	for _, attr := range resp.Attributes {
-		tgAttributes[awssdk.StringValue(attr.Key)] = awssdk.StringValue(attr.Value)
+		tgAttributes[awssdk.ToString(attr.Key)] = awssdk.ToString(attr.Value)
 	}
 	return tgAttributes, nil
 }
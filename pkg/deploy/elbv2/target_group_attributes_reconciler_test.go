@@ -4,8 +4,9 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -54,7 +55,7 @@ func Test_defaultTargetGroupAttributeReconciler_Reconcile(t *testing.T) {
 							TargetGroupArn: awssdk.String("my-arn"),
 						},
 						resp: &elbv2sdk.DescribeTargetGroupAttributesOutput{
-							Attributes: []*elbv2sdk.TargetGroupAttribute{
+							Attributes: []elbv2types.TargetGroupAttribute{
 								{
 									Key:   awssdk.String("slow_start.duration_second"),
 									Value: awssdk.String("50"),
@@ -71,7 +72,7 @@ func Test_defaultTargetGroupAttributeReconciler_Reconcile(t *testing.T) {
 					{
 						req: &elbv2sdk.ModifyTargetGroupAttributesInput{
 							TargetGroupArn: awssdk.String("my-arn"),
-							Attributes: []*elbv2sdk.TargetGroupAttribute{
+							Attributes: []elbv2types.TargetGroupAttribute{
 								{
 									Key:   awssdk.String("slow_start.duration_second"),
 									Value: awssdk.String("100"),
@@ -87,7 +88,7 @@ func Test_defaultTargetGroupAttributeReconciler_Reconcile(t *testing.T) {
 			},
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						TargetGroupArn: awssdk.String("my-arn"),
 					},
 				},
@@ -117,7 +118,7 @@ func Test_defaultTargetGroupAttributeReconciler_Reconcile(t *testing.T) {
 							TargetGroupArn: awssdk.String("my-arn"),
 						},
 						resp: &elbv2sdk.DescribeTargetGroupAttributesOutput{
-							Attributes: []*elbv2sdk.TargetGroupAttribute{
+							Attributes: []elbv2types.TargetGroupAttribute{
 								{
 									Key:   awssdk.String("slow_start.duration_second"),
 									Value: awssdk.String("50"),
@@ -134,7 +135,7 @@ func Test_defaultTargetGroupAttributeReconciler_Reconcile(t *testing.T) {
 			},
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						TargetGroupArn: awssdk.String("my-arn"),
 					},
 				},
@@ -258,7 +259,7 @@ func Test_defaultTargetGroupAttributeReconciler_getCurrentTargetGroupAttributes(
 							TargetGroupArn: awssdk.String("my-arn"),
 						},
 						resp: &elbv2sdk.DescribeTargetGroupAttributesOutput{
-							Attributes: []*elbv2sdk.TargetGroupAttribute{
+							Attributes: []elbv2types.TargetGroupAttribute{
 								{
 									Key:   awssdk.String("keyA"),
 									Value: awssdk.String("valueA"),
@@ -274,7 +275,7 @@ func Test_defaultTargetGroupAttributeReconciler_getCurrentTargetGroupAttributes(
 			},
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						TargetGroupArn: awssdk.String("my-arn"),
 					},
 					Tags: nil,
@@ -299,7 +300,7 @@ func Test_defaultTargetGroupAttributeReconciler_getCurrentTargetGroupAttributes(
 			},
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						TargetGroupArn: awssdk.String("my-arn"),
 					},
 					Tags: nil,
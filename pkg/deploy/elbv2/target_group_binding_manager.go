@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -187,6 +188,10 @@ func buildK8sTargetGroupBindingSpec(ctx context.Context, resTGB *elbv2model.Targ
 	}
 	k8sTGBSpec.NodeSelector = resTGB.Spec.Template.Spec.NodeSelector
 	k8sTGBSpec.IPAddressType = resTGB.Spec.Template.Spec.IPAddressType
+	k8sTGBSpec.TargetIPFamilyPolicy = resTGB.Spec.Template.Spec.TargetIPFamilyPolicy
+	k8sTGBSpec.MultiClusterTargetGroup = resTGB.Spec.Template.Spec.MultiClusterTargetGroup
+	k8sTGBSpec.ConnectionTermination = resTGB.Spec.Template.Spec.ConnectionTermination
+	k8sTGBSpec.AWSRoleARN = resTGB.Spec.Template.Spec.AWSRoleARN
 	return k8sTGBSpec, nil
 }
 
@@ -228,7 +233,12 @@ func buildK8sNetworkingPeer(ctx context.Context, resNetworkingPeer elbv2model.Ne
 			},
 		}, nil
 	}
-	return elbv2api.NetworkingPeer{}, errors.New("either ipBlock or securityGroup should be specified")
+	if resNetworkingPeer.PrefixList != nil {
+		return elbv2api.NetworkingPeer{
+			PrefixList: resNetworkingPeer.PrefixList,
+		}, nil
+	}
+	return elbv2api.NetworkingPeer{}, errors.New("either ipBlock, securityGroup or prefixList should be specified")
 }
 
 func buildResTargetGroupBindingStatus(k8sTGB *elbv2api.TargetGroupBinding) elbv2model.TargetGroupBindingResourceStatus {
@@ -240,3 +250,47 @@ func buildResTargetGroupBindingStatus(k8sTGB *elbv2api.TargetGroupBinding) elbv2
 		},
 	}
 }
+
+// NewDryRunTargetGroupBindingManager constructs new dryRunTargetGroupBindingManager
+func NewDryRunTargetGroupBindingManager(logger logr.Logger) *dryRunTargetGroupBindingManager {
+	return &dryRunTargetGroupBindingManager{
+		logger: logger,
+	}
+}
+
+var _ TargetGroupBindingManager = &dryRunTargetGroupBindingManager{}
+
+// dryRunTargetGroupBindingManager plans the changes of TargetGroupBinding resources via audit.RecordMutation instead of applying them.
+type dryRunTargetGroupBindingManager struct {
+	logger logr.Logger
+}
+
+func (m *dryRunTargetGroupBindingManager) Create(ctx context.Context, resTGB *elbv2model.TargetGroupBindingResource) (elbv2model.TargetGroupBindingResourceStatus, error) {
+	if _, err := buildK8sTargetGroupBindingSpec(ctx, resTGB); err != nil {
+		return elbv2model.TargetGroupBindingResourceStatus{}, err
+	}
+	k8sTGB := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resTGB.Spec.Template.Namespace,
+			Name:      resTGB.Spec.Template.Name,
+		},
+	}
+	audit.RecordMutation(ctx, audit.ActionCreate, "targetGroupBinding", k8s.NamespacedName(k8sTGB).String(), "")
+	return buildResTargetGroupBindingStatus(k8sTGB), nil
+}
+
+func (m *dryRunTargetGroupBindingManager) Update(ctx context.Context, resTGB *elbv2model.TargetGroupBindingResource, k8sTGB *elbv2api.TargetGroupBinding) (elbv2model.TargetGroupBindingResourceStatus, error) {
+	k8sTGBSpec, err := buildK8sTargetGroupBindingSpec(ctx, resTGB)
+	if err != nil {
+		return elbv2model.TargetGroupBindingResourceStatus{}, err
+	}
+	if !equality.Semantic.DeepEqual(k8sTGB.Spec, k8sTGBSpec) {
+		audit.RecordMutation(ctx, audit.ActionModify, "targetGroupBinding", k8s.NamespacedName(k8sTGB).String(), "spec")
+	}
+	return buildResTargetGroupBindingStatus(k8sTGB), nil
+}
+
+func (m *dryRunTargetGroupBindingManager) Delete(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	audit.RecordMutation(ctx, audit.ActionDelete, "targetGroupBinding", k8s.NamespacedName(tgb).String(), "")
+	return nil
+}
@@ -1,9 +1,10 @@
 package elbv2
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -43,16 +44,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -78,16 +79,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9091"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -113,16 +114,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("TCP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumTcp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -148,16 +149,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/some-other-path"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -183,16 +184,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("503")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("503")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -218,16 +219,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{GrpcCode: awssdk.String("503")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{GrpcCode: awssdk.String("503")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -253,16 +254,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(11),
+						HealthCheckIntervalSeconds: awssdk.Int32(11),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -288,16 +289,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(6),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(6),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -323,16 +324,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(4),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(4),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 			},
@@ -358,16 +359,16 @@ func Test_isSDKTargetGroupHealthCheckDrifted(t *testing.T) {
 					},
 				},
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						HealthCheckEnabled:         awssdk.Bool(true),
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
 						HealthCheckPath:            awssdk.String("/healthcheck"),
 						HealthCheckPort:            awssdk.String("9090"),
-						HealthCheckProtocol:        awssdk.String("HTTP"),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-						UnhealthyThresholdCount:    awssdk.Int64(3),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+						UnhealthyThresholdCount:    awssdk.Int32(3),
 					},
 				},
 			},
@@ -419,18 +420,18 @@ func Test_buildSDKCreateTargetGroupInput(t *testing.T) {
 			},
 			want: &elbv2sdk.CreateTargetGroupInput{
 				HealthCheckEnabled:         awssdk.Bool(true),
-				HealthCheckIntervalSeconds: awssdk.Int64(10),
+				HealthCheckIntervalSeconds: awssdk.Int32(10),
 				HealthCheckPath:            awssdk.String("/healthcheck"),
 				HealthCheckPort:            awssdk.String("9090"),
-				HealthCheckProtocol:        awssdk.String("HTTP"),
-				HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-				HealthyThresholdCount:      awssdk.Int64(3),
-				Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-				UnhealthyThresholdCount:    awssdk.Int64(2),
+				HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+				HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+				HealthyThresholdCount:      awssdk.Int32(3),
+				Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+				UnhealthyThresholdCount:    awssdk.Int32(2),
 				Name:                       awssdk.String("my-tg"),
-				Port:                       awssdk.Int64(8080),
-				Protocol:                   awssdk.String("HTTP"),
-				TargetType:                 awssdk.String("ip"),
+				Port:                       awssdk.Int32(8080),
+				Protocol:                   elbv2types.ProtocolEnumHttp,
+				TargetType:                 elbv2types.TargetTypeEnumIp,
 			},
 		},
 		{
@@ -456,19 +457,19 @@ func Test_buildSDKCreateTargetGroupInput(t *testing.T) {
 			},
 			want: &elbv2sdk.CreateTargetGroupInput{
 				HealthCheckEnabled:         awssdk.Bool(true),
-				HealthCheckIntervalSeconds: awssdk.Int64(10),
+				HealthCheckIntervalSeconds: awssdk.Int32(10),
 				HealthCheckPath:            awssdk.String("/healthcheck"),
 				HealthCheckPort:            awssdk.String("9090"),
-				HealthCheckProtocol:        awssdk.String("HTTP"),
-				HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-				HealthyThresholdCount:      awssdk.Int64(3),
-				Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-				UnhealthyThresholdCount:    awssdk.Int64(2),
+				HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+				HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+				HealthyThresholdCount:      awssdk.Int32(3),
+				Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+				UnhealthyThresholdCount:    awssdk.Int32(2),
 				Name:                       awssdk.String("my-tg"),
-				Port:                       awssdk.Int64(8080),
-				Protocol:                   awssdk.String("HTTP"),
+				Port:                       awssdk.Int32(8080),
+				Protocol:                   elbv2types.ProtocolEnumHttp,
 				ProtocolVersion:            awssdk.String("HTTP2"),
-				TargetType:                 awssdk.String("ip"),
+				TargetType:                 elbv2types.TargetTypeEnumIp,
 			},
 		},
 		{
@@ -494,19 +495,19 @@ func Test_buildSDKCreateTargetGroupInput(t *testing.T) {
 			},
 			want: &elbv2sdk.CreateTargetGroupInput{
 				HealthCheckEnabled:         awssdk.Bool(true),
-				HealthCheckIntervalSeconds: awssdk.Int64(10),
+				HealthCheckIntervalSeconds: awssdk.Int32(10),
 				HealthCheckPath:            awssdk.String("/healthcheck"),
 				HealthCheckPort:            awssdk.String("9090"),
-				HealthCheckProtocol:        awssdk.String("HTTP"),
-				HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-				HealthyThresholdCount:      awssdk.Int64(3),
-				Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-				UnhealthyThresholdCount:    awssdk.Int64(2),
+				HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+				HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+				HealthyThresholdCount:      awssdk.Int32(3),
+				Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+				UnhealthyThresholdCount:    awssdk.Int32(2),
 				Name:                       awssdk.String("my-tg"),
-				Port:                       awssdk.Int64(8080),
-				Protocol:                   awssdk.String("HTTP"),
-				TargetType:                 awssdk.String("ip"),
-				IpAddressType:              awssdk.String("ipv6"),
+				Port:                       awssdk.Int32(8080),
+				Protocol:                   elbv2types.ProtocolEnumHttp,
+				TargetType:                 elbv2types.TargetTypeEnumIp,
+				IpAddressType:              elbv2types.TargetGroupIpAddressTypeEnumIpv6,
 			},
 		},
 	}
@@ -551,14 +552,14 @@ func Test_buildSDKModifyTargetGroupInput(t *testing.T) {
 			},
 			want: &elbv2sdk.ModifyTargetGroupInput{
 				HealthCheckEnabled:         awssdk.Bool(true),
-				HealthCheckIntervalSeconds: awssdk.Int64(10),
+				HealthCheckIntervalSeconds: awssdk.Int32(10),
 				HealthCheckPath:            awssdk.String("/healthcheck"),
 				HealthCheckPort:            awssdk.String("9090"),
-				HealthCheckProtocol:        awssdk.String("HTTP"),
-				HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-				HealthyThresholdCount:      awssdk.Int64(3),
-				Matcher:                    &elbv2sdk.Matcher{HttpCode: awssdk.String("200")},
-				UnhealthyThresholdCount:    awssdk.Int64(2),
+				HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+				HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+				HealthyThresholdCount:      awssdk.Int32(3),
+				Matcher:                    &elbv2types.Matcher{HttpCode: awssdk.String("200")},
+				UnhealthyThresholdCount:    awssdk.Int32(2),
 			},
 		},
 	}
@@ -578,7 +579,7 @@ func Test_buildSDKMatcher(t *testing.T) {
 	tests := []struct {
 		name string
 		args args
-		want *elbv2sdk.Matcher
+		want *elbv2types.Matcher
 	}{
 		{
 			name: "standard case",
@@ -588,7 +589,7 @@ func Test_buildSDKMatcher(t *testing.T) {
 				},
 				protocolVersion: elbv2model.ProtocolVersionHTTP1,
 			},
-			want: &elbv2sdk.Matcher{
+			want: &elbv2types.Matcher{
 				HttpCode: awssdk.String("200"),
 			},
 		},
@@ -600,7 +601,7 @@ func Test_buildSDKMatcher(t *testing.T) {
 				},
 				protocolVersion: elbv2model.ProtocolVersionGRPC,
 			},
-			want: &elbv2sdk.Matcher{
+			want: &elbv2types.Matcher{
 				GrpcCode: awssdk.String("2"),
 			},
 		},
@@ -626,7 +627,7 @@ func Test_buildResTargetGroupStatus(t *testing.T) {
 			name: "standard case",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						TargetGroupArn: awssdk.String("my-arn"),
 					},
 				},
@@ -654,14 +655,14 @@ func Test_isTargetGroupResourceInUseError(t *testing.T) {
 		{
 			name: "is ResourceInUse error",
 			args: args{
-				err: awserr.New("ResourceInUse", "some message", nil),
+				err: &smithy.GenericAPIError{Code: "ResourceInUse", Message: "some message"},
 			},
 			want: true,
 		},
 		{
 			name: "wraps ResourceInUse error",
 			args: args{
-				err: errors.Wrap(awserr.New("ResourceInUse", "some message", nil), "wrapped message"),
+				err: errors.Wrap(&smithy.GenericAPIError{Code: "ResourceInUse", Message: "some message"}, "wrapped message"),
 			},
 			want: true,
 		},
@@ -2,7 +2,7 @@ package elbv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -15,12 +15,13 @@ import (
 
 // NewTargetGroupSynthesizer constructs targetGroupSynthesizer
 func NewTargetGroupSynthesizer(elbv2Client services.ELBV2, trackingProvider tracking.Provider, taggingManager TaggingManager,
-	tgManager TargetGroupManager, logger logr.Logger, featureGates config.FeatureGates, stack core.Stack) *targetGroupSynthesizer {
+	tgManager TargetGroupManager, tgTransitioner *TargetGroupTransitioner, logger logr.Logger, featureGates config.FeatureGates, stack core.Stack) *targetGroupSynthesizer {
 	return &targetGroupSynthesizer{
 		elbv2Client:      elbv2Client,
 		trackingProvider: trackingProvider,
 		taggingManager:   taggingManager,
 		tgManager:        tgManager,
+		tgTransitioner:   tgTransitioner,
 		featureGates:     featureGates,
 		logger:           logger,
 		stack:            stack,
@@ -34,6 +35,7 @@ type targetGroupSynthesizer struct {
 	trackingProvider tracking.Provider
 	taggingManager   TaggingManager
 	tgManager        TargetGroupManager
+	tgTransitioner   *TargetGroupTransitioner
 	featureGates     config.FeatureGates
 	logger           logr.Logger
 
@@ -72,6 +74,13 @@ func (s *targetGroupSynthesizer) Synthesize(ctx context.Context) error {
 		}
 		resAndSDKTG.resTG.SetStatus(tgStatus)
 	}
+	// the listeners are switched to the replacements of TargetGroups after synthesize,
+	// so the transitions must complete beforehand, including the ones started by previous synthesizes.
+	for _, transition := range findProtocolVersionTransitions(resTGs, unmatchedSDKTGs, s.trackingProvider.ResourceIDTagKey()) {
+		if err := s.tgTransitioner.Transition(ctx, transition.sdkTG, transition.resTG.Status.TargetGroupARN); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -141,6 +150,23 @@ func matchResAndSDKTargetGroups(resTGs []*elbv2model.TargetGroup, sdkTGs []Targe
 	return matchedResAndSDKTGs, unmatchedResTGs, unmatchedSDKTGs, nil
 }
 
+// findProtocolVersionTransitions finds the unmatched sdk TargetGroups that are replaced by TargetGroup resources only due to protocolVersion change.
+func findProtocolVersionTransitions(resTGs []*elbv2model.TargetGroup, unmatchedSDKTGs []TargetGroupWithTags, resourceIDTagKey string) []resAndSDKTargetGroupPair {
+	resTGsByID := mapResTargetGroupByResourceID(resTGs)
+	var transitions []resAndSDKTargetGroupPair
+	for _, sdkTG := range unmatchedSDKTGs {
+		resTG, ok := resTGsByID[sdkTG.Tags[resourceIDTagKey]]
+		if !ok || !isSDKTargetGroupProtocolVersionTransition(sdkTG, resTG) {
+			continue
+		}
+		transitions = append(transitions, resAndSDKTargetGroupPair{
+			resTG: resTG,
+			sdkTG: sdkTG,
+		})
+	}
+	return transitions
+}
+
 func mapResTargetGroupByResourceID(resTGs []*elbv2model.TargetGroup) map[string]*elbv2model.TargetGroup {
 	resTGsByID := make(map[string]*elbv2model.TargetGroup, len(resTGs))
 	for _, resTG := range resTGs {
@@ -154,7 +180,7 @@ func mapSDKTargetGroupByResourceID(sdkTGs []TargetGroupWithTags, resourceIDTagKe
 	for _, sdkTG := range sdkTGs {
 		resourceID, ok := sdkTG.Tags[resourceIDTagKey]
 		if !ok {
-			return nil, errors.Errorf("unexpected targetGroup with no resourceID: %v", awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn))
+			return nil, errors.Errorf("unexpected targetGroup with no resourceID: %v", awssdk.ToString(sdkTG.TargetGroup.TargetGroupArn))
 		}
 		sdkTGsByID[resourceID] = append(sdkTGsByID[resourceID], sdkTG)
 	}
@@ -162,15 +188,27 @@ func mapSDKTargetGroupByResourceID(sdkTGs []TargetGroupWithTags, resourceIDTagKe
 }
 
 // isSDKTargetGroupRequiresReplacement checks whether a sdk TargetGroup requires replacement to fulfill a TargetGroup resource.
+// Only settings that cannot be changed by ModifyTargetGroup requires replacement, i.e. targetType, protocol, protocolVersion and ipAddressType.
+// Other settings like name, port, attributes and most healthCheck settings are either ignored or modified in place by the TargetGroupManager.
+// TargetGroups are also replaced once their recreation is requested with a new token.
 func isSDKTargetGroupRequiresReplacement(sdkTG TargetGroupWithTags, resTG *elbv2model.TargetGroup, featureGates config.FeatureGates) bool {
-	if string(resTG.Spec.TargetType) != awssdk.StringValue(sdkTG.TargetGroup.TargetType) {
+	if isRecreationRequested(resTG.Spec.Tags, sdkTG.Tags) {
 		return true
 	}
-	if string(resTG.Spec.Protocol) != awssdk.StringValue(sdkTG.TargetGroup.Protocol) {
+	if string(resTG.Spec.TargetType) != string(sdkTG.TargetGroup.TargetType) {
+		return true
+	}
+	if string(resTG.Spec.Protocol) != string(sdkTG.TargetGroup.Protocol) {
 		return true
 	}
 	if resTG.Spec.ProtocolVersion != nil {
-		if string(*resTG.Spec.ProtocolVersion) != awssdk.StringValue(sdkTG.TargetGroup.ProtocolVersion) {
+		if string(*resTG.Spec.ProtocolVersion) != awssdk.ToString(sdkTG.TargetGroup.ProtocolVersion) {
+			return true
+		}
+	}
+	// TargetGroups created before ipAddressType is supported don't have it populated, which are ipv4 TargetGroups.
+	if resTG.Spec.IPAddressType != nil && sdkTG.TargetGroup.IpAddressType != "" {
+		if string(*resTG.Spec.IPAddressType) != string(sdkTG.TargetGroup.IpAddressType) {
 			return true
 		}
 	}
@@ -178,7 +216,8 @@ func isSDKTargetGroupRequiresReplacement(sdkTG TargetGroupWithTags, resTG *elbv2
 	return isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(sdkTG, resTG, featureGates)
 }
 
-// most of the healthCheck settings for NLB targetGroups cannot be changed for now.
+// without advanced healthCheck configuration, the healthCheck protocol, timeout and success codes for NLB targetGroups cannot be changed.
+// healthCheck port, path, interval and thresholds can always be modified in place.
 func isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(sdkTG TargetGroupWithTags, resTG *elbv2model.TargetGroup, featureGates config.FeatureGates) bool {
 	if resTG.Spec.HealthCheckConfig == nil || featureGates.Enabled(config.NLBHealthCheckAdvancedConfig) {
 		return false
@@ -189,16 +228,13 @@ func isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(sdkTG TargetGroupWit
 	}
 	sdkObj := sdkTG.TargetGroup
 	hcConfig := *resTG.Spec.HealthCheckConfig
-	if hcConfig.Protocol != nil && string(*hcConfig.Protocol) != awssdk.StringValue(sdkObj.HealthCheckProtocol) {
-		return true
-	}
-	if hcConfig.Matcher != nil && (sdkObj.Matcher == nil || awssdk.StringValue(hcConfig.Matcher.GRPCCode) != awssdk.StringValue(sdkObj.Matcher.GrpcCode) || awssdk.StringValue(hcConfig.Matcher.HTTPCode) != awssdk.StringValue(sdkObj.Matcher.HttpCode)) {
+	if hcConfig.Protocol != nil && string(*hcConfig.Protocol) != string(sdkObj.HealthCheckProtocol) {
 		return true
 	}
-	if hcConfig.IntervalSeconds != nil && awssdk.Int64Value(hcConfig.IntervalSeconds) != awssdk.Int64Value(sdkObj.HealthCheckIntervalSeconds) {
+	if hcConfig.Matcher != nil && (sdkObj.Matcher == nil || awssdk.ToString(hcConfig.Matcher.GRPCCode) != awssdk.ToString(sdkObj.Matcher.GrpcCode) || awssdk.ToString(hcConfig.Matcher.HTTPCode) != awssdk.ToString(sdkObj.Matcher.HttpCode)) {
 		return true
 	}
-	if hcConfig.TimeoutSeconds != nil && awssdk.Int64Value(hcConfig.TimeoutSeconds) != awssdk.Int64Value(sdkObj.HealthCheckTimeoutSeconds) {
+	if hcConfig.TimeoutSeconds != nil && awssdk.ToInt64(hcConfig.TimeoutSeconds) != int64(awssdk.ToInt32(sdkObj.HealthCheckTimeoutSeconds)) {
 		return true
 	}
 	return false
@@ -1,8 +1,8 @@
 package elbv2
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -46,7 +46,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 				},
 				sdkTGs: []TargetGroupWithTags{
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -54,7 +54,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 						},
 					},
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2"),
 						},
 						Tags: map[string]string{
@@ -73,7 +73,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 						},
 					},
 					sdkTG: TargetGroupWithTags{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -89,7 +89,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 						},
 					},
 					sdkTG: TargetGroupWithTags{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2"),
 						},
 						Tags: map[string]string{
@@ -118,7 +118,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 				},
 				sdkTGs: []TargetGroupWithTags{
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -137,7 +137,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 						},
 					},
 					sdkTG: TargetGroupWithTags{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -168,7 +168,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 				},
 				sdkTGs: []TargetGroupWithTags{
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -176,7 +176,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 						},
 					},
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2"),
 						},
 						Tags: map[string]string{
@@ -195,7 +195,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 						},
 					},
 					sdkTG: TargetGroupWithTags{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -206,7 +206,7 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 			},
 			want2: []TargetGroupWithTags{
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						TargetGroupArn: awssdk.String("arn-2"),
 					},
 					Tags: map[string]string{
@@ -229,18 +229,18 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 				},
 				sdkTGs: []TargetGroupWithTags{
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
-							TargetType:     awssdk.String("instance"),
+							TargetType:     elbv2types.TargetTypeEnumInstance,
 						},
 						Tags: map[string]string{
 							"ingress.k8s.aws/resource": "id-1",
 						},
 					},
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2"),
-							TargetType:     awssdk.String("ip"),
+							TargetType:     elbv2types.TargetTypeEnumIp,
 						},
 						Tags: map[string]string{
 							"ingress.k8s.aws/resource": "id-1",
@@ -259,9 +259,9 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 						},
 					},
 					sdkTG: TargetGroupWithTags{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2"),
-							TargetType:     awssdk.String("ip"),
+							TargetType:     elbv2types.TargetTypeEnumIp,
 						},
 						Tags: map[string]string{
 							"ingress.k8s.aws/resource": "id-1",
@@ -271,9 +271,9 @@ func Test_matchResAndSDKTargetGroups(t *testing.T) {
 			},
 			want2: []TargetGroupWithTags{
 				{
-					TargetGroup: &elbv2sdk.TargetGroup{
+					TargetGroup: &elbv2types.TargetGroup{
 						TargetGroupArn: awssdk.String("arn-1"),
-						TargetType:     awssdk.String("instance"),
+						TargetType:     elbv2types.TargetTypeEnumInstance,
 					},
 					Tags: map[string]string{
 						"ingress.k8s.aws/resource": "id-1",
@@ -366,7 +366,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 			args: args{
 				sdkTGs: []TargetGroupWithTags{
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -374,7 +374,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 						},
 					},
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2"),
 						},
 						Tags: map[string]string{
@@ -387,7 +387,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 			want: map[string][]TargetGroupWithTags{
 				"id-1": {
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -397,7 +397,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 				},
 				"id-2": {
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2"),
 						},
 						Tags: map[string]string{
@@ -412,7 +412,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 			args: args{
 				sdkTGs: []TargetGroupWithTags{
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -420,7 +420,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 						},
 					},
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2A"),
 						},
 						Tags: map[string]string{
@@ -428,7 +428,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 						},
 					},
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2B"),
 						},
 						Tags: map[string]string{
@@ -441,7 +441,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 			want: map[string][]TargetGroupWithTags{
 				"id-1": {
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{
@@ -451,7 +451,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 				},
 				"id-2": {
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2A"),
 						},
 						Tags: map[string]string{
@@ -459,7 +459,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 						},
 					},
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-2B"),
 						},
 						Tags: map[string]string{
@@ -474,7 +474,7 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 			args: args{
 				sdkTGs: []TargetGroupWithTags{
 					{
-						TargetGroup: &elbv2sdk.TargetGroup{
+						TargetGroup: &elbv2types.TargetGroup{
 							TargetGroupArn: awssdk.String("arn-1"),
 						},
 						Tags: map[string]string{},
@@ -500,7 +500,12 @@ func Test_mapSDKTargetGroupByResourceID(t *testing.T) {
 
 func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 	port8080 := intstr.FromInt(8080)
+	portTrafficPort := intstr.FromString("traffic-port")
 	protocolHTTP := elbv2model.ProtocolHTTP
+	protocolTCP := elbv2model.ProtocolTCP
+	protocolVersionGRPC := elbv2model.ProtocolVersionGRPC
+	ipAddressTypeIPv4 := elbv2model.TargetGroupIPAddressTypeIPv4
+	ipAddressTypeIPv6 := elbv2model.TargetGroupIPAddressTypeIPv6
 	type args struct {
 		sdkTG TargetGroupWithTags
 		resTG *elbv2model.TargetGroup
@@ -514,10 +519,10 @@ func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 			name: "targetGroup don't need replacement",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						TargetType:      awssdk.String("ip"),
-						Port:            awssdk.Int64(8080),
-						Protocol:        awssdk.String("HTTP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:      elbv2types.TargetTypeEnumIp,
+						Port:            awssdk.Int32(8080),
+						Protocol:        elbv2types.ProtocolEnumHttp,
 						TargetGroupName: awssdk.String("my-tg"),
 					},
 				},
@@ -536,10 +541,10 @@ func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 			name: "name-only change shouldn't need replacement",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						TargetType:      awssdk.String("ip"),
-						Port:            awssdk.Int64(8080),
-						Protocol:        awssdk.String("HTTP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:      elbv2types.TargetTypeEnumIp,
+						Port:            awssdk.Int32(8080),
+						Protocol:        elbv2types.ProtocolEnumHttp,
 						TargetGroupName: awssdk.String("my-tg1"),
 					},
 				},
@@ -558,10 +563,10 @@ func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 			name: "port-only change shouldn't need replacement",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						TargetType:      awssdk.String("ip"),
-						Port:            awssdk.Int64(9090),
-						Protocol:        awssdk.String("HTTP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:      elbv2types.TargetTypeEnumIp,
+						Port:            awssdk.Int32(9090),
+						Protocol:        elbv2types.ProtocolEnumHttp,
 						TargetGroupName: awssdk.String("my-tg"),
 					},
 				},
@@ -580,10 +585,10 @@ func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 			name: "targetType change need replacement",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						TargetType:      awssdk.String("instance"),
-						Port:            awssdk.Int64(8080),
-						Protocol:        awssdk.String("HTTP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:      elbv2types.TargetTypeEnumInstance,
+						Port:            awssdk.Int32(8080),
+						Protocol:        elbv2types.ProtocolEnumHttp,
 						TargetGroupName: awssdk.String("my-tg"),
 					},
 				},
@@ -602,10 +607,10 @@ func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 			name: "protocol change need replacement",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						TargetType:      awssdk.String("ip"),
-						Port:            awssdk.Int64(8080),
-						Protocol:        awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:      elbv2types.TargetTypeEnumIp,
+						Port:            awssdk.Int32(8080),
+						Protocol:        elbv2types.ProtocolEnumTcp,
 						TargetGroupName: awssdk.String("my-tg"),
 					},
 				},
@@ -620,23 +625,127 @@ func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "protocolVersion change need replacement",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:      elbv2types.TargetTypeEnumIp,
+						Port:            awssdk.Int32(8080),
+						Protocol:        elbv2types.ProtocolEnumHttp,
+						ProtocolVersion: awssdk.String("HTTP1"),
+						TargetGroupName: awssdk.String("my-tg"),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						TargetType:      elbv2model.TargetTypeIP,
+						Port:            8080,
+						Protocol:        elbv2model.ProtocolHTTP,
+						ProtocolVersion: &protocolVersionGRPC,
+						Name:            "my-tg",
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "ipAddressType change need replacement",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:      elbv2types.TargetTypeEnumIp,
+						Port:            awssdk.Int32(8080),
+						Protocol:        elbv2types.ProtocolEnumHttp,
+						IpAddressType:   elbv2types.TargetGroupIpAddressTypeEnumIpv4,
+						TargetGroupName: awssdk.String("my-tg"),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						TargetType:    elbv2model.TargetTypeIP,
+						Port:          8080,
+						Protocol:      elbv2model.ProtocolHTTP,
+						IPAddressType: &ipAddressTypeIPv6,
+						Name:          "my-tg",
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "ipAddressType unknown shouldn't need replacement",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:      elbv2types.TargetTypeEnumIp,
+						Port:            awssdk.Int32(8080),
+						Protocol:        elbv2types.ProtocolEnumHttp,
+						TargetGroupName: awssdk.String("my-tg"),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						TargetType:    elbv2model.TargetTypeIP,
+						Port:          8080,
+						Protocol:      elbv2model.ProtocolHTTP,
+						IPAddressType: &ipAddressTypeIPv4,
+						Name:          "my-tg",
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "NLB healthCheck interval and threshold changes needs no replacement",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType:                 elbv2types.TargetTypeEnumIp,
+						Protocol:                   elbv2types.ProtocolEnumTcp,
+						HealthCheckEnabled:         awssdk.Bool(true),
+						HealthCheckPort:            awssdk.String("traffic-port"),
+						HealthCheckProtocol:        elbv2types.ProtocolEnumTcp,
+						HealthCheckIntervalSeconds: awssdk.Int32(30),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(10),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(3),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						TargetType: elbv2model.TargetTypeIP,
+						Protocol:   elbv2model.ProtocolTCP,
+						HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+							Port:                    &portTrafficPort,
+							Protocol:                &protocolTCP,
+							IntervalSeconds:         awssdk.Int64(10),
+							TimeoutSeconds:          awssdk.Int64(10),
+							HealthyThresholdCount:   awssdk.Int64(2),
+							UnhealthyThresholdCount: awssdk.Int64(2),
+						},
+					},
+				},
+			},
+			want: false,
+		},
 		{
 			name: "healthCheck change needs no replacement for protocol change",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("8080"),
-						HealthCheckProtocol: awssdk.String("HTTP"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
 						HealthCheckPath:     awssdk.String("/"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("200"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(11),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(11),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -657,6 +766,50 @@ func Test_isSDKTargetGroupRequiresReplacement(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "new recreation token need replacement",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType: elbv2types.TargetTypeEnumIp,
+						Port:       awssdk.Int32(8080),
+						Protocol:   elbv2types.ProtocolEnumHttp,
+					},
+					Tags: map[string]string{elbv2model.RecreationTokenTagKey: "token-1"},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						TargetType: elbv2model.TargetTypeIP,
+						Port:       8080,
+						Protocol:   elbv2model.ProtocolHTTP,
+						Tags:       map[string]string{elbv2model.RecreationTokenTagKey: "token-2"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "same recreation token shouldn't need replacement",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						TargetType: elbv2types.TargetTypeEnumIp,
+						Port:       awssdk.Int32(8080),
+						Protocol:   elbv2types.ProtocolEnumHttp,
+					},
+					Tags: map[string]string{elbv2model.RecreationTokenTagKey: "token-1"},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						TargetType: elbv2model.TargetTypeIP,
+						Port:       8080,
+						Protocol:   elbv2model.ProtocolHTTP,
+						Tags:       map[string]string{elbv2model.RecreationTokenTagKey: "token-1"},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -684,19 +837,19 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 			name: "NLB TargetGroup healthCheck haven't changed",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("8080"),
-						HealthCheckProtocol: awssdk.String("HTTP"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
 						HealthCheckPath:     awssdk.String("/"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("200"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -721,19 +874,19 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 			name: "NLB TargetGroup healthCheck cannot change protocol without advanced config",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("8080"),
-						HealthCheckProtocol: awssdk.String("HTTPS"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttps,
 						HealthCheckPath:     awssdk.String("/"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("200"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -759,19 +912,19 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 			name: "NLB TargetGroup healthCheck cannot changed matcher",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("8080"),
-						HealthCheckProtocol: awssdk.String("HTTP"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
 						HealthCheckPath:     awssdk.String("/"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("300"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -794,22 +947,22 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 			want: true,
 		},
 		{
-			name: "NLB TargetGroup healthCheck cannot change intervalSeconds",
+			name: "NLB TargetGroup healthCheck can change intervalSeconds without advanced config",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("8080"),
-						HealthCheckProtocol: awssdk.String("HTTP"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
 						HealthCheckPath:     awssdk.String("/"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("200"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(11),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(11),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -829,25 +982,25 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 				},
 				disableAdvancedNLBHealthCheckConfig: true,
 			},
-			want: true,
+			want: false,
 		},
 		{
 			name: "NLB TargetGroup healthCheck cannot change timeoutSecond",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("8080"),
-						HealthCheckProtocol: awssdk.String("HTTP"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
 						HealthCheckPath:     awssdk.String("/"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("200"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(6),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(6),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -873,19 +1026,19 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 			name: "NLB TargetGroup healthCheck can change port",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("9090"),
-						HealthCheckProtocol: awssdk.String("HTTP"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
 						HealthCheckPath:     awssdk.String("/"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("200"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -910,19 +1063,19 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 			name: "NLB TargetGroup healthCheck can change path",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("8080"),
-						HealthCheckProtocol: awssdk.String("HTTP"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
 						HealthCheckPath:     awssdk.String("/some-other"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("200"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(3),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -947,19 +1100,132 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 			name: "NLB TargetGroup healthCheck can change healthyThresholdCount",
 			args: args{
 				sdkTG: TargetGroupWithTags{
-					TargetGroup: &elbv2sdk.TargetGroup{
-						Protocol:            awssdk.String("TCP"),
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
+						HealthCheckEnabled:  awssdk.Bool(true),
+						HealthCheckPort:     awssdk.String("8080"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
+						HealthCheckPath:     awssdk.String("/"),
+						Matcher: &elbv2types.Matcher{
+							HttpCode: awssdk.String("200"),
+						},
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(4),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						Protocol: elbv2model.ProtocolTCP,
+						HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+							Port:                    &port8080,
+							Protocol:                &protocolHTTP,
+							Path:                    awssdk.String("/"),
+							Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200")},
+							IntervalSeconds:         awssdk.Int64(10),
+							TimeoutSeconds:          awssdk.Int64(5),
+							HealthyThresholdCount:   awssdk.Int64(3),
+							UnhealthyThresholdCount: awssdk.Int64(2),
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "NLB TargetGroup healthCheck can change port without advanced config",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
+						HealthCheckEnabled:  awssdk.Bool(true),
+						HealthCheckPort:     awssdk.String("9090"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
+						HealthCheckPath:     awssdk.String("/"),
+						Matcher: &elbv2types.Matcher{
+							HttpCode: awssdk.String("200"),
+						},
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						Protocol: elbv2model.ProtocolTCP,
+						HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+							Port:                    &port8080,
+							Protocol:                &protocolHTTP,
+							Path:                    awssdk.String("/"),
+							Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200")},
+							IntervalSeconds:         awssdk.Int64(10),
+							TimeoutSeconds:          awssdk.Int64(5),
+							HealthyThresholdCount:   awssdk.Int64(3),
+							UnhealthyThresholdCount: awssdk.Int64(2),
+						},
+					},
+				},
+				disableAdvancedNLBHealthCheckConfig: true,
+			},
+			want: false,
+		},
+		{
+			name: "NLB TargetGroup healthCheck can change path without advanced config",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
+						HealthCheckEnabled:  awssdk.Bool(true),
+						HealthCheckPort:     awssdk.String("8080"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
+						HealthCheckPath:     awssdk.String("/some-other"),
+						Matcher: &elbv2types.Matcher{
+							HttpCode: awssdk.String("200"),
+						},
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						Protocol: elbv2model.ProtocolTCP,
+						HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+							Port:                    &port8080,
+							Protocol:                &protocolHTTP,
+							Path:                    awssdk.String("/"),
+							Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200")},
+							IntervalSeconds:         awssdk.Int64(10),
+							TimeoutSeconds:          awssdk.Int64(5),
+							HealthyThresholdCount:   awssdk.Int64(3),
+							UnhealthyThresholdCount: awssdk.Int64(2),
+						},
+					},
+				},
+				disableAdvancedNLBHealthCheckConfig: true,
+			},
+			want: false,
+		},
+		{
+			name: "NLB TargetGroup healthCheck can change healthyThresholdCount without advanced config",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
 						HealthCheckEnabled:  awssdk.Bool(true),
 						HealthCheckPort:     awssdk.String("8080"),
-						HealthCheckProtocol: awssdk.String("HTTP"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
 						HealthCheckPath:     awssdk.String("/"),
-						Matcher: &elbv2sdk.Matcher{
+						Matcher: &elbv2types.Matcher{
 							HttpCode: awssdk.String("200"),
 						},
-						HealthCheckIntervalSeconds: awssdk.Int64(10),
-						HealthCheckTimeoutSeconds:  awssdk.Int64(5),
-						HealthyThresholdCount:      awssdk.Int64(4),
-						UnhealthyThresholdCount:    awssdk.Int64(2),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(4),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
 					},
 				},
 				resTG: &elbv2model.TargetGroup{
@@ -977,9 +1243,86 @@ func Test_isSDKTargetGroupRequiresReplacementDueToNLBHealthCheck(t *testing.T) {
 						},
 					},
 				},
+				disableAdvancedNLBHealthCheckConfig: true,
+			},
+			want: false,
+		},
+		{
+			name: "NLB TargetGroup healthCheck can change unhealthyThresholdCount without advanced config",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumTcp,
+						HealthCheckEnabled:  awssdk.Bool(true),
+						HealthCheckPort:     awssdk.String("8080"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
+						HealthCheckPath:     awssdk.String("/"),
+						Matcher: &elbv2types.Matcher{
+							HttpCode: awssdk.String("200"),
+						},
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(4),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						Protocol: elbv2model.ProtocolTCP,
+						HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+							Port:                    &port8080,
+							Protocol:                &protocolHTTP,
+							Path:                    awssdk.String("/"),
+							Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200")},
+							IntervalSeconds:         awssdk.Int64(10),
+							TimeoutSeconds:          awssdk.Int64(5),
+							HealthyThresholdCount:   awssdk.Int64(3),
+							UnhealthyThresholdCount: awssdk.Int64(2),
+						},
+					},
+				},
+				disableAdvancedNLBHealthCheckConfig: true,
 			},
 			want: false,
 		},
+		{
+			name: "UDP TargetGroup healthCheck cannot change timeoutSeconds without advanced config",
+			args: args{
+				sdkTG: TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{
+						Protocol:            elbv2types.ProtocolEnumUdp,
+						HealthCheckEnabled:  awssdk.Bool(true),
+						HealthCheckPort:     awssdk.String("8080"),
+						HealthCheckProtocol: elbv2types.ProtocolEnumHttp,
+						HealthCheckPath:     awssdk.String("/"),
+						Matcher: &elbv2types.Matcher{
+							HttpCode: awssdk.String("200"),
+						},
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+						HealthCheckTimeoutSeconds:  awssdk.Int32(6),
+						HealthyThresholdCount:      awssdk.Int32(3),
+						UnhealthyThresholdCount:    awssdk.Int32(2),
+					},
+				},
+				resTG: &elbv2model.TargetGroup{
+					Spec: elbv2model.TargetGroupSpec{
+						Protocol: elbv2model.ProtocolUDP,
+						HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+							Port:                    &port8080,
+							Protocol:                &protocolHTTP,
+							Path:                    awssdk.String("/"),
+							Matcher:                 &elbv2model.HealthCheckMatcher{HTTPCode: awssdk.String("200")},
+							IntervalSeconds:         awssdk.Int64(10),
+							TimeoutSeconds:          awssdk.Int64(5),
+							HealthyThresholdCount:   awssdk.Int64(3),
+							UnhealthyThresholdCount: awssdk.Int64(2),
+						},
+					},
+				},
+				disableAdvancedNLBHealthCheckConfig: true,
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,138 @@
+package elbv2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+const (
+	defaultTargetGroupTransitionRequeueDuration = 15 * time.Second
+)
+
+// TargetGroupTransitioner transitions the targets of TargetGroups replaced due to protocolVersion change onto their replacements.
+// TargetGroupBindings only register targets to the replacements after listeners are switched to them, which would drop the traffic
+// until the targets pass health checks. Instead, the current targets are registered to the replacements ahead of the switch,
+// and the switch is held back until the replacements have as many healthy targets as the replaced TargetGroups.
+type TargetGroupTransitioner struct {
+	elbv2Client services.ELBV2
+	logger      logr.Logger
+
+	requeueDuration time.Duration
+}
+
+// NewTargetGroupTransitioner constructs new TargetGroupTransitioner.
+func NewTargetGroupTransitioner(elbv2Client services.ELBV2, logger logr.Logger) *TargetGroupTransitioner {
+	return &TargetGroupTransitioner{
+		elbv2Client:     elbv2Client,
+		logger:          logger,
+		requeueDuration: defaultTargetGroupTransitionRequeueDuration,
+	}
+}
+
+// Transition registers the targets of sdkTG to the TargetGroup with tgARN, and returns a RequeueNeededAfter error
+// until the TargetGroup with tgARN is healthy enough to take over the traffic of sdkTG.
+// A nil TargetGroupTransitioner doesn't transition targets.
+func (t *TargetGroupTransitioner) Transition(ctx context.Context, sdkTG TargetGroupWithTags, tgARN string) error {
+	if t == nil {
+		return nil
+	}
+	sdkTGARN := awssdk.ToString(sdkTG.TargetGroup.TargetGroupArn)
+	sdkTargets, err := t.describeTargetHealth(ctx, sdkTGARN)
+	if err != nil {
+		return err
+	}
+	var targets []elbv2types.TargetDescription
+	sdkHealthyCount := 0
+	for _, target := range sdkTargets {
+		switch target.TargetHealth.State {
+		case elbv2types.TargetHealthStateEnumDraining, elbv2types.TargetHealthStateEnumUnused:
+			continue
+		case elbv2types.TargetHealthStateEnumHealthy:
+			sdkHealthyCount++
+		}
+		targets = append(targets, *target.Target)
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	t.logger.Info("registering targets to replacement targetGroup",
+		"arn", tgARN,
+		"replacedARN", sdkTGARN,
+		"targets", len(targets))
+	if _, err := t.elbv2Client.RegisterTargetsWithContext(ctx, &elbv2sdk.RegisterTargetsInput{
+		TargetGroupArn: awssdk.String(tgARN),
+		Targets:        targets,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to register targets to replacement targetGroup %v", tgARN)
+	}
+	audit.RecordMutation(ctx, audit.ActionModify, "targetGroup", tgARN,
+		fmt.Sprintf("registered %d targets of replaced targetGroup %v", len(targets), sdkTGARN))
+
+	if sdkHealthyCount == 0 {
+		return nil
+	}
+	replacementTargets, err := t.describeTargetHealth(ctx, tgARN)
+	if err != nil {
+		return err
+	}
+	healthyCount := 0
+	for _, target := range replacementTargets {
+		if target.TargetHealth.State == elbv2types.TargetHealthStateEnumHealthy {
+			healthyCount++
+		}
+	}
+	if healthyCount < sdkHealthyCount {
+		t.logger.Info("waiting for targets of replacement targetGroup to become healthy",
+			"arn", tgARN,
+			"replacedARN", sdkTGARN,
+			"healthyTargets", healthyCount,
+			"replacedHealthyTargets", sdkHealthyCount)
+		return runtime.NewRequeueNeededAfter(fmt.Sprintf("waiting for targets of replacement targetGroup %v to become healthy", tgARN), t.requeueDuration)
+	}
+	return nil
+}
+
+func (t *TargetGroupTransitioner) describeTargetHealth(ctx context.Context, tgARN string) ([]elbv2types.TargetHealthDescription, error) {
+	resp, err := t.elbv2Client.DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe target health of targetGroup %v", tgARN)
+	}
+	return resp.TargetHealthDescriptions, nil
+}
+
+// isSDKTargetGroupProtocolVersionTransition checks whether a sdk TargetGroup is replaced by a TargetGroup resource only due to protocolVersion change,
+// in which case the targets of the sdk TargetGroup are the same as the ones of the replacement.
+func isSDKTargetGroupProtocolVersionTransition(sdkTG TargetGroupWithTags, resTG *elbv2model.TargetGroup) bool {
+	if resTG.Spec.ProtocolVersion == nil || string(*resTG.Spec.ProtocolVersion) == awssdk.ToString(sdkTG.TargetGroup.ProtocolVersion) {
+		return false
+	}
+	if resTG.Spec.TargetType != elbv2model.TargetTypeInstance && resTG.Spec.TargetType != elbv2model.TargetTypeIP {
+		return false
+	}
+	if string(resTG.Spec.TargetType) != string(sdkTG.TargetGroup.TargetType) {
+		return false
+	}
+	if string(resTG.Spec.Protocol) != string(sdkTG.TargetGroup.Protocol) {
+		return false
+	}
+	if resTG.Spec.IPAddressType != nil && sdkTG.TargetGroup.IpAddressType != "" {
+		if string(*resTG.Spec.IPAddressType) != string(sdkTG.TargetGroup.IpAddressType) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,168 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	coremodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_TargetGroupTransitioner_Transition(t *testing.T) {
+	newTargetHealth := func(id string, state elbv2types.TargetHealthStateEnum) elbv2types.TargetHealthDescription {
+		return elbv2types.TargetHealthDescription{
+			Target:       &elbv2types.TargetDescription{Id: awssdk.String(id), Port: awssdk.Int32(8080)},
+			TargetHealth: &elbv2types.TargetHealth{State: state},
+		}
+	}
+	tests := []struct {
+		name                string
+		replacedTargets     []elbv2types.TargetHealthDescription
+		replacementTargets  []elbv2types.TargetHealthDescription
+		wantRegisterTargets []elbv2types.TargetDescription
+		wantRequeue         bool
+	}{
+		{
+			name: "replaced targetGroup without targets",
+		},
+		{
+			name: "replaced targetGroup without healthy targets",
+			replacedTargets: []elbv2types.TargetHealthDescription{
+				newTargetHealth("10.0.0.1", elbv2types.TargetHealthStateEnumUnhealthy),
+				newTargetHealth("10.0.0.2", elbv2types.TargetHealthStateEnumDraining),
+			},
+			wantRegisterTargets: []elbv2types.TargetDescription{
+				{Id: awssdk.String("10.0.0.1"), Port: awssdk.Int32(8080)},
+			},
+		},
+		{
+			name: "replacement targetGroup is healthy",
+			replacedTargets: []elbv2types.TargetHealthDescription{
+				newTargetHealth("10.0.0.1", elbv2types.TargetHealthStateEnumHealthy),
+				newTargetHealth("10.0.0.2", elbv2types.TargetHealthStateEnumHealthy),
+			},
+			replacementTargets: []elbv2types.TargetHealthDescription{
+				newTargetHealth("10.0.0.1", elbv2types.TargetHealthStateEnumHealthy),
+				newTargetHealth("10.0.0.2", elbv2types.TargetHealthStateEnumHealthy),
+			},
+			wantRegisterTargets: []elbv2types.TargetDescription{
+				{Id: awssdk.String("10.0.0.1"), Port: awssdk.Int32(8080)},
+				{Id: awssdk.String("10.0.0.2"), Port: awssdk.Int32(8080)},
+			},
+		},
+		{
+			name: "replacement targetGroup is still initializing",
+			replacedTargets: []elbv2types.TargetHealthDescription{
+				newTargetHealth("10.0.0.1", elbv2types.TargetHealthStateEnumHealthy),
+				newTargetHealth("10.0.0.2", elbv2types.TargetHealthStateEnumHealthy),
+			},
+			replacementTargets: []elbv2types.TargetHealthDescription{
+				newTargetHealth("10.0.0.1", elbv2types.TargetHealthStateEnumHealthy),
+				newTargetHealth("10.0.0.2", elbv2types.TargetHealthStateEnumInitial),
+			},
+			wantRegisterTargets: []elbv2types.TargetDescription{
+				{Id: awssdk.String("10.0.0.1"), Port: awssdk.Int32(8080)},
+				{Id: awssdk.String("10.0.0.2"), Port: awssdk.Int32(8080)},
+			},
+			wantRequeue: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), &elbv2sdk.DescribeTargetHealthInput{TargetGroupArn: awssdk.String("replaced-arn")}).
+				Return(&elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: tt.replacedTargets}, nil)
+			if tt.wantRegisterTargets != nil {
+				elbv2Client.EXPECT().RegisterTargetsWithContext(gomock.Any(), &elbv2sdk.RegisterTargetsInput{
+					TargetGroupArn: awssdk.String("replacement-arn"),
+					Targets:        tt.wantRegisterTargets,
+				}).Return(&elbv2sdk.RegisterTargetsOutput{}, nil)
+			}
+			if tt.replacementTargets != nil {
+				elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), &elbv2sdk.DescribeTargetHealthInput{TargetGroupArn: awssdk.String("replacement-arn")}).
+					Return(&elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: tt.replacementTargets}, nil)
+			}
+
+			transitioner := NewTargetGroupTransitioner(elbv2Client, log.Log)
+			sdkTG := TargetGroupWithTags{TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("replaced-arn")}}
+			err := transitioner.Transition(context.Background(), sdkTG, "replacement-arn")
+			if tt.wantRequeue {
+				var requeueNeededAfter *runtime.RequeueNeededAfter
+				assert.True(t, errors.As(err, &requeueNeededAfter))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_isSDKTargetGroupProtocolVersionTransition(t *testing.T) {
+	stack := coremodel.NewDefaultStack(coremodel.StackID{Namespace: "namespace", Name: "name"})
+	protocolVersionGRPC := elbv2model.ProtocolVersionGRPC
+	tests := []struct {
+		name  string
+		sdkTG TargetGroupWithTags
+		resTG *elbv2model.TargetGroup
+		want  bool
+	}{
+		{
+			name: "protocolVersion changed",
+			sdkTG: TargetGroupWithTags{TargetGroup: &elbv2types.TargetGroup{
+				TargetType:      elbv2types.TargetTypeEnumIp,
+				Protocol:        elbv2types.ProtocolEnumHttp,
+				ProtocolVersion: awssdk.String("HTTP1"),
+			}},
+			resTG: elbv2model.NewTargetGroup(stack, "id-1", elbv2model.TargetGroupSpec{
+				TargetType:      elbv2model.TargetTypeIP,
+				Protocol:        elbv2model.ProtocolHTTP,
+				ProtocolVersion: &protocolVersionGRPC,
+			}),
+			want: true,
+		},
+		{
+			name: "protocolVersion unchanged",
+			sdkTG: TargetGroupWithTags{TargetGroup: &elbv2types.TargetGroup{
+				TargetType:      elbv2types.TargetTypeEnumIp,
+				Protocol:        elbv2types.ProtocolEnumHttp,
+				ProtocolVersion: awssdk.String("GRPC"),
+			}},
+			resTG: elbv2model.NewTargetGroup(stack, "id-1", elbv2model.TargetGroupSpec{
+				TargetType:      elbv2model.TargetTypeIP,
+				Protocol:        elbv2model.ProtocolHTTP,
+				ProtocolVersion: &protocolVersionGRPC,
+			}),
+			want: false,
+		},
+		{
+			name: "protocolVersion changed along with targetType",
+			sdkTG: TargetGroupWithTags{TargetGroup: &elbv2types.TargetGroup{
+				TargetType:      elbv2types.TargetTypeEnumInstance,
+				Protocol:        elbv2types.ProtocolEnumHttp,
+				ProtocolVersion: awssdk.String("HTTP1"),
+			}},
+			resTG: elbv2model.NewTargetGroup(stack, "id-1", elbv2model.TargetGroupSpec{
+				TargetType:      elbv2model.TargetTypeIP,
+				Protocol:        elbv2model.ProtocolHTTP,
+				ProtocolVersion: &protocolVersionGRPC,
+			}),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isSDKTargetGroupProtocolVersionTransition(tt.sdkTG, tt.resTG)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
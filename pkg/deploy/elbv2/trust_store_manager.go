@@ -0,0 +1,138 @@
+package elbv2
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+const (
+	defaultWaitTrustStoreDeletionPollInterval = 2 * time.Second
+	defaultWaitTrustStoreDeletionTimeout      = 20 * time.Second
+)
+
+// TrustStoreManager is responsible for create/update/delete TrustStore resources.
+type TrustStoreManager interface {
+	Create(ctx context.Context, resTrustStore *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error)
+
+	Update(ctx context.Context, resTrustStore *elbv2model.TrustStore, sdkTrustStore TrustStoreWithTags) (elbv2model.TrustStoreStatus, error)
+
+	Delete(ctx context.Context, sdkTrustStore TrustStoreWithTags) error
+}
+
+// NewDefaultTrustStoreManager constructs new defaultTrustStoreManager.
+func NewDefaultTrustStoreManager(elbv2Client services.ELBV2, trackingProvider tracking.Provider,
+	taggingManager TaggingManager, externalManagedTags []string, logger logr.Logger) *defaultTrustStoreManager {
+	return &defaultTrustStoreManager{
+		elbv2Client:         elbv2Client,
+		trackingProvider:    trackingProvider,
+		taggingManager:      taggingManager,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitTrustStoreDeletionPollInterval: defaultWaitTrustStoreDeletionPollInterval,
+		waitTrustStoreDeletionTimeout:      defaultWaitTrustStoreDeletionTimeout,
+	}
+}
+
+var _ TrustStoreManager = &defaultTrustStoreManager{}
+
+// default implementation for TrustStoreManager
+type defaultTrustStoreManager struct {
+	elbv2Client         services.ELBV2
+	trackingProvider    tracking.Provider
+	taggingManager      TaggingManager
+	externalManagedTags []string
+
+	logger logr.Logger
+
+	waitTrustStoreDeletionPollInterval time.Duration
+	waitTrustStoreDeletionTimeout      time.Duration
+}
+
+func (m *defaultTrustStoreManager) Create(ctx context.Context, resTrustStore *elbv2model.TrustStore) (elbv2model.TrustStoreStatus, error) {
+	req := buildSDKCreateTrustStoreInput(resTrustStore.Spec)
+	trustStoreTags := m.trackingProvider.ResourceTags(resTrustStore.Stack(), resTrustStore, resTrustStore.Spec.Tags)
+	req.Tags = convertTagsToSDKTags(trustStoreTags)
+
+	m.logger.Info("creating trustStore",
+		"stackID", resTrustStore.Stack().StackID(),
+		"resourceID", resTrustStore.ID())
+	resp, err := m.elbv2Client.CreateTrustStoreWithContext(ctx, req)
+	if err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	sdkTrustStore := TrustStoreWithTags{
+		TrustStore: &resp.TrustStores[0],
+		Tags:       trustStoreTags,
+	}
+	m.logger.Info("created trustStore",
+		"stackID", resTrustStore.Stack().StackID(),
+		"resourceID", resTrustStore.ID(),
+		"arn", awssdk.ToString(sdkTrustStore.TrustStore.TrustStoreArn))
+	audit.RecordMutation(ctx, audit.ActionCreate, "trustStore", awssdk.ToString(sdkTrustStore.TrustStore.TrustStoreArn), "")
+	return buildResTrustStoreStatus(sdkTrustStore), nil
+}
+
+func (m *defaultTrustStoreManager) Update(ctx context.Context, resTrustStore *elbv2model.TrustStore, sdkTrustStore TrustStoreWithTags) (elbv2model.TrustStoreStatus, error) {
+	desiredTrustStoreTags := m.trackingProvider.ResourceTags(resTrustStore.Stack(), resTrustStore, resTrustStore.Spec.Tags)
+	if err := m.taggingManager.ReconcileTags(ctx, awssdk.ToString(sdkTrustStore.TrustStore.TrustStoreArn), desiredTrustStoreTags,
+		WithCurrentTags(sdkTrustStore.Tags),
+		WithIgnoredTagKeys(m.externalManagedTags)); err != nil {
+		return elbv2model.TrustStoreStatus{}, err
+	}
+	return buildResTrustStoreStatus(sdkTrustStore), nil
+}
+
+func (m *defaultTrustStoreManager) Delete(ctx context.Context, sdkTrustStore TrustStoreWithTags) error {
+	req := &elbv2sdk.DeleteTrustStoreInput{
+		TrustStoreArn: sdkTrustStore.TrustStore.TrustStoreArn,
+	}
+
+	m.logger.Info("deleting trustStore",
+		"arn", awssdk.ToString(req.TrustStoreArn))
+	if err := runtime.RetryImmediateOnError(m.waitTrustStoreDeletionPollInterval, m.waitTrustStoreDeletionTimeout, isTrustStoreInUseError, func() error {
+		_, err := m.elbv2Client.DeleteTrustStoreWithContext(ctx, req)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete trustStore")
+	}
+	m.logger.Info("deleted trustStore",
+		"arn", awssdk.ToString(req.TrustStoreArn))
+	audit.RecordMutation(ctx, audit.ActionDelete, "trustStore", awssdk.ToString(req.TrustStoreArn), "")
+	return nil
+}
+
+func isTrustStoreInUseError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "TrustStoreInUse"
+	}
+	return false
+}
+
+func buildSDKCreateTrustStoreInput(trustStoreSpec elbv2model.TrustStoreSpec) *elbv2sdk.CreateTrustStoreInput {
+	return &elbv2sdk.CreateTrustStoreInput{
+		Name:                                awssdk.String(trustStoreSpec.Name),
+		CaCertificatesBundleS3Bucket:        awssdk.String(trustStoreSpec.CACertificatesBundleS3Bucket),
+		CaCertificatesBundleS3Key:           awssdk.String(trustStoreSpec.CACertificatesBundleS3Key),
+		CaCertificatesBundleS3ObjectVersion: trustStoreSpec.CACertificatesBundleS3ObjectVersion,
+	}
+}
+
+func buildResTrustStoreStatus(sdkTrustStore TrustStoreWithTags) elbv2model.TrustStoreStatus {
+	return elbv2model.TrustStoreStatus{
+		TrustStoreARN: awssdk.ToString(sdkTrustStore.TrustStore.TrustStoreArn),
+	}
+}
@@ -0,0 +1,146 @@
+package elbv2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// NewTrustStoreSynthesizer constructs trustStoreSynthesizer
+func NewTrustStoreSynthesizer(trackingProvider tracking.Provider, taggingManager TaggingManager,
+	trustStoreManager TrustStoreManager, logger logr.Logger, stack core.Stack) *trustStoreSynthesizer {
+	return &trustStoreSynthesizer{
+		trackingProvider:        trackingProvider,
+		taggingManager:          taggingManager,
+		trustStoreManager:       trustStoreManager,
+		logger:                  logger,
+		stack:                   stack,
+		unmatchedSDKTrustStores: nil,
+	}
+}
+
+// trustStoreSynthesizer is responsible for synthesize TrustStore resources types for certain stack.
+type trustStoreSynthesizer struct {
+	trackingProvider  tracking.Provider
+	taggingManager    TaggingManager
+	trustStoreManager TrustStoreManager
+	logger            logr.Logger
+
+	stack                   core.Stack
+	unmatchedSDKTrustStores []TrustStoreWithTags
+}
+
+func (s *trustStoreSynthesizer) Synthesize(ctx context.Context) error {
+	var resTrustStores []*elbv2model.TrustStore
+	s.stack.ListResources(&resTrustStores)
+	sdkTrustStores, err := s.findSDKTrustStores(ctx)
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKTrustStores, unmatchedResTrustStores, unmatchedSDKTrustStores, err := matchResAndSDKTrustStores(resTrustStores, sdkTrustStores,
+		s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For TrustStores, we delete unmatched ones during post synthesize given below facts:
+	// * unmatched trustStores might still be associated with a listener.
+	s.unmatchedSDKTrustStores = unmatchedSDKTrustStores
+
+	for _, resTrustStore := range unmatchedResTrustStores {
+		trustStoreStatus, err := s.trustStoreManager.Create(ctx, resTrustStore)
+		if err != nil {
+			return err
+		}
+		resTrustStore.SetStatus(trustStoreStatus)
+	}
+	for _, resAndSDKTrustStore := range matchedResAndSDKTrustStores {
+		trustStoreStatus, err := s.trustStoreManager.Update(ctx, resAndSDKTrustStore.resTrustStore, resAndSDKTrustStore.sdkTrustStore)
+		if err != nil {
+			return err
+		}
+		resAndSDKTrustStore.resTrustStore.SetStatus(trustStoreStatus)
+	}
+	return nil
+}
+
+func (s *trustStoreSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, sdkTrustStore := range s.unmatchedSDKTrustStores {
+		if err := s.trustStoreManager.Delete(ctx, sdkTrustStore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findSDKTrustStores will find all AWS TrustStores created for stack.
+func (s *trustStoreSynthesizer) findSDKTrustStores(ctx context.Context) ([]TrustStoreWithTags, error) {
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	return s.taggingManager.ListTrustStores(ctx, tracking.TagsAsTagFilter(stackTags))
+}
+
+type resAndSDKTrustStorePair struct {
+	resTrustStore *elbv2model.TrustStore
+	sdkTrustStore TrustStoreWithTags
+}
+
+func matchResAndSDKTrustStores(resTrustStores []*elbv2model.TrustStore, sdkTrustStores []TrustStoreWithTags,
+	resourceIDTagKey string) ([]resAndSDKTrustStorePair, []*elbv2model.TrustStore, []TrustStoreWithTags, error) {
+	var matchedResAndSDKTrustStores []resAndSDKTrustStorePair
+	var unmatchedResTrustStores []*elbv2model.TrustStore
+	var unmatchedSDKTrustStores []TrustStoreWithTags
+
+	resTrustStoresByID := make(map[string]*elbv2model.TrustStore, len(resTrustStores))
+	for _, resTrustStore := range resTrustStores {
+		resTrustStoresByID[resTrustStore.ID()] = resTrustStore
+	}
+	sdkTrustStoresByID := make(map[string][]TrustStoreWithTags, len(sdkTrustStores))
+	for _, sdkTrustStore := range sdkTrustStores {
+		resourceID, ok := sdkTrustStore.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, nil, nil, errors.Errorf("unexpected trustStore with no resourceID: %v", awssdk.StringValue(sdkTrustStore.TrustStore.TrustStoreArn))
+		}
+		sdkTrustStoresByID[resourceID] = append(sdkTrustStoresByID[resourceID], sdkTrustStore)
+	}
+
+	resTrustStoreIDs := sets.StringKeySet(resTrustStoresByID)
+	sdkTrustStoreIDs := sets.StringKeySet(sdkTrustStoresByID)
+	for _, resID := range resTrustStoreIDs.Intersection(sdkTrustStoreIDs).List() {
+		resTrustStore := resTrustStoresByID[resID]
+		foundMatch := false
+		for _, sdkTrustStore := range sdkTrustStoresByID[resID] {
+			if isSDKTrustStoreRequiresReplacement(sdkTrustStore, resTrustStore) {
+				unmatchedSDKTrustStores = append(unmatchedSDKTrustStores, sdkTrustStore)
+				continue
+			}
+			matchedResAndSDKTrustStores = append(matchedResAndSDKTrustStores, resAndSDKTrustStorePair{
+				resTrustStore: resTrustStore,
+				sdkTrustStore: sdkTrustStore,
+			})
+			foundMatch = true
+		}
+		if !foundMatch {
+			unmatchedResTrustStores = append(unmatchedResTrustStores, resTrustStore)
+		}
+	}
+	for _, resID := range resTrustStoreIDs.Difference(sdkTrustStoreIDs).List() {
+		unmatchedResTrustStores = append(unmatchedResTrustStores, resTrustStoresByID[resID])
+	}
+	for _, resID := range sdkTrustStoreIDs.Difference(resTrustStoreIDs).List() {
+		unmatchedSDKTrustStores = append(unmatchedSDKTrustStores, sdkTrustStoresByID[resID]...)
+	}
+
+	return matchedResAndSDKTrustStores, unmatchedResTrustStores, unmatchedSDKTrustStores, nil
+}
+
+// isSDKTrustStoreRequiresReplacement checks whether a sdk TrustStore requires replacement to fulfill a TrustStore resource.
+// The name of trustStore is derived from the ca certificates bundle, since the bundle location cannot be observed from AWS.
+func isSDKTrustStoreRequiresReplacement(sdkTrustStore TrustStoreWithTags, resTrustStore *elbv2model.TrustStore) bool {
+	return resTrustStore.Spec.Name != awssdk.StringValue(sdkTrustStore.TrustStore.Name)
+}
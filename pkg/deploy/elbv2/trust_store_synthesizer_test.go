@@ -0,0 +1,90 @@
+package elbv2
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_matchResAndSDKTrustStores(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Name: "awesome-group"}))
+	resTrustStore := elbv2model.NewTrustStore(stack, "TrustStore", elbv2model.TrustStoreSpec{
+		Name: "k8s-awesomegroup-f77ecb1ebb",
+	})
+	sdkTrustStoreCurrent := TrustStoreWithTags{
+		TrustStore: &elbv2types.TrustStore{
+			Name:          awssdk.String("k8s-awesomegroup-f77ecb1ebb"),
+			TrustStoreArn: awssdk.String("arn-1"),
+		},
+		Tags: map[string]string{"elbv2.k8s.aws/resource": "TrustStore"},
+	}
+	sdkTrustStoreOutdated := TrustStoreWithTags{
+		TrustStore: &elbv2types.TrustStore{
+			Name:          awssdk.String("k8s-awesomegroup-0123456789"),
+			TrustStoreArn: awssdk.String("arn-2"),
+		},
+		Tags: map[string]string{"elbv2.k8s.aws/resource": "TrustStore"},
+	}
+	sdkTrustStoreOrphan := TrustStoreWithTags{
+		TrustStore: &elbv2types.TrustStore{
+			Name:          awssdk.String("k8s-awesomegroup-9876543210"),
+			TrustStoreArn: awssdk.String("arn-3"),
+		},
+		Tags: map[string]string{"elbv2.k8s.aws/resource": "OtherTrustStore"},
+	}
+	tests := []struct {
+		name             string
+		resTrustStores   []*elbv2model.TrustStore
+		sdkTrustStores   []TrustStoreWithTags
+		wantMatched      []resAndSDKTrustStorePair
+		wantUnmatchedRes []*elbv2model.TrustStore
+		wantUnmatchedSDK []TrustStoreWithTags
+		wantErr          bool
+	}{
+		{
+			name:           "trustStore matches by resourceID and name",
+			resTrustStores: []*elbv2model.TrustStore{resTrustStore},
+			sdkTrustStores: []TrustStoreWithTags{sdkTrustStoreCurrent, sdkTrustStoreOrphan},
+			wantMatched: []resAndSDKTrustStorePair{
+				{resTrustStore: resTrustStore, sdkTrustStore: sdkTrustStoreCurrent},
+			},
+			wantUnmatchedSDK: []TrustStoreWithTags{sdkTrustStoreOrphan},
+		},
+		{
+			name:             "trustStore requires replacement when name changed",
+			resTrustStores:   []*elbv2model.TrustStore{resTrustStore},
+			sdkTrustStores:   []TrustStoreWithTags{sdkTrustStoreOutdated},
+			wantUnmatchedRes: []*elbv2model.TrustStore{resTrustStore},
+			wantUnmatchedSDK: []TrustStoreWithTags{sdkTrustStoreOutdated},
+		},
+		{
+			name:           "sdk trustStore without resourceID",
+			resTrustStores: []*elbv2model.TrustStore{resTrustStore},
+			sdkTrustStores: []TrustStoreWithTags{
+				{
+					TrustStore: &elbv2types.TrustStore{TrustStoreArn: awssdk.String("arn-4")},
+					Tags:       map[string]string{},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMatched, gotUnmatchedRes, gotUnmatchedSDK, err := matchResAndSDKTrustStores(tt.resTrustStores, tt.sdkTrustStores, "elbv2.k8s.aws/resource")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantMatched, gotMatched)
+			assert.Equal(t, tt.wantUnmatchedRes, gotUnmatchedRes)
+			assert.Equal(t, tt.wantUnmatchedSDK, gotUnmatchedSDK)
+		})
+	}
+}
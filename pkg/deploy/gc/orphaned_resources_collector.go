@@ -0,0 +1,317 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	ec2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	clusterNameTagKey = "elbv2.k8s.aws/cluster"
+
+	// stack tag keys of the resources created for Ingresses, Services and Gateways, whose value is the stackID.
+	ingressStackTagKey = "ingress.k8s.aws/stack"
+	serviceStackTagKey = "service.k8s.aws/stack"
+	gatewayStackTagKey = "gateway.k8s.aws/stack"
+
+	resourceTypeLoadBalancer  = "loadBalancer"
+	resourceTypeTargetGroup   = "targetGroup"
+	resourceTypeSecurityGroup = "securityGroup"
+	resourceTypeElasticIP     = "elasticIP"
+)
+
+// OrphanedResourcesCollector deletes AWS resources tagged with the cluster name whose owning Kubernetes resource no longer exists.
+type OrphanedResourcesCollector interface {
+	manager.Runnable
+	manager.LeaderElectionRunnable
+}
+
+// NewDefaultOrphanedResourcesCollector constructs new defaultOrphanedResourcesCollector.
+func NewDefaultOrphanedResourcesCollector(cloud aws.Cloud, k8sClient client.Client, eventRecorder record.EventRecorder,
+	controllerConfig config.ControllerConfig, logger logr.Logger) *defaultOrphanedResourcesCollector {
+	annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+	classLoader := ingress.NewDefaultClassLoader(k8sClient, true)
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(controllerConfig.IngressConfig.IngressClass)
+	manageIngressesWithoutIngressClass := controllerConfig.IngressConfig.IngressClass == ""
+	groupLoader := ingress.NewDefaultGroupLoader(k8sClient, eventRecorder, annotationParser, classLoader, classAnnotationMatcher, manageIngressesWithoutIngressClass,
+		controllerConfig.FeatureGates.Enabled(config.IngressGroupResource))
+	networkingSGManager := networkingpkg.NewDefaultSecurityGroupManager(cloud.EC2(), logger)
+
+	return &defaultOrphanedResourcesCollector{
+		elbv2Client:         cloud.ELBV2(),
+		ec2Client:           cloud.EC2(),
+		elbv2TaggingManager: elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), controllerConfig.StrictTagEnforcement(), logger),
+		ec2TaggingManager:   ec2deploy.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), controllerConfig.StrictTagEnforcement(), logger),
+		k8sClient:           k8sClient,
+		groupLoader:         groupLoader,
+		clusterName:         controllerConfig.ClusterName,
+		enableGatewayAPI:    controllerConfig.FeatureGates.Enabled(config.GatewayAPI),
+		interval:            controllerConfig.OrphanedResourcesGCConfig.Interval,
+		minAge:              controllerConfig.OrphanedResourcesGCConfig.MinAge,
+		dryRun:              controllerConfig.OrphanedResourcesGCConfig.DryRun || controllerConfig.DryRun || controllerConfig.ShadowMode,
+		logger:              logger,
+		orphanedSince:       make(map[string]time.Time),
+	}
+}
+
+var _ OrphanedResourcesCollector = &defaultOrphanedResourcesCollector{}
+
+// default implementation for OrphanedResourcesCollector, which sweeps the load balancers, target groups, security groups and elastic IPs periodically.
+// listeners and listener rules are not swept individually, they're deleted along with their load balancer.
+type defaultOrphanedResourcesCollector struct {
+	elbv2Client         services.ELBV2
+	ec2Client           services.EC2
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	ec2TaggingManager   ec2deploy.TaggingManager
+	k8sClient           client.Client
+	groupLoader         ingress.GroupLoader
+	clusterName         string
+	enableGatewayAPI    bool
+	interval            time.Duration
+	minAge              time.Duration
+	dryRun              bool
+	logger              logr.Logger
+
+	// orphanedSince is the time since when resources have been continuously observed as orphaned, keyed by resource ID.
+	// it's only accessed by the sweeping goroutine.
+	orphanedSince map[string]time.Time
+}
+
+// orphanedResource is an AWS resource whose owning Kubernetes resource no longer exists.
+type orphanedResource struct {
+	resourceType string
+	resourceID   string
+	stackTagKey  string
+	stackID      string
+}
+
+// Start sweeps the orphaned resources periodically until ctx is done.
+func (c *defaultOrphanedResourcesCollector) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, c.collect, c.interval)
+	return nil
+}
+
+// NeedLeaderElection returns true, as orphaned resources must only be deleted by the leader.
+func (c *defaultOrphanedResourcesCollector) NeedLeaderElection() bool {
+	return true
+}
+
+func (c *defaultOrphanedResourcesCollector) collect(ctx context.Context) {
+	if err := c.sweep(ctx, time.Now()); err != nil {
+		c.logger.Error(err, "failed to collect orphaned resources")
+	}
+}
+
+// sweep deletes the resources that have been orphaned for at least minAge.
+// load balancers are deleted before target groups, security groups and elastic IPs, since they're depended upon by load balancers.
+// failed deletions(e.g. security groups or elastic IPs still in use by ENIs of a deleting load balancer) are retried by the next sweep.
+func (c *defaultOrphanedResourcesCollector) sweep(ctx context.Context, now time.Time) error {
+	liveStackIDsByTagKey, err := c.loadLiveStackIDs(ctx)
+	if err != nil {
+		return err
+	}
+	orphanedResources, err := c.findOrphanedResources(ctx, liveStackIDsByTagKey)
+	if err != nil {
+		return err
+	}
+
+	orphanedSince := make(map[string]time.Time, len(orphanedResources))
+	for _, res := range orphanedResources {
+		since, ok := c.orphanedSince[res.resourceID]
+		if !ok {
+			since = now
+		}
+		orphanedSince[res.resourceID] = since
+		if now.Sub(since) < c.minAge {
+			c.logger.V(1).Info("waiting for orphaned resource to reach min age",
+				"resourceType", res.resourceType, "resourceID", res.resourceID, "stackID", res.stackID, "orphanedSince", since)
+			continue
+		}
+		if c.dryRun {
+			c.logger.Info("dry run planned to delete orphaned resource",
+				"resourceType", res.resourceType, "resourceID", res.resourceID, "stackTagKey", res.stackTagKey, "stackID", res.stackID)
+			continue
+		}
+		c.logger.Info("deleting orphaned resource",
+			"resourceType", res.resourceType, "resourceID", res.resourceID, "stackTagKey", res.stackTagKey, "stackID", res.stackID)
+		if err := c.deleteResource(ctx, res); err != nil {
+			c.logger.Error(err, "failed to delete orphaned resource",
+				"resourceType", res.resourceType, "resourceID", res.resourceID)
+			continue
+		}
+		c.logger.Info("deleted orphaned resource",
+			"resourceType", res.resourceType, "resourceID", res.resourceID)
+		delete(orphanedSince, res.resourceID)
+	}
+	c.orphanedSince = orphanedSince
+	return nil
+}
+
+// findOrphanedResources returns the load balancers, target groups, security groups and elastic IPs of this cluster, whose stack is no longer live.
+// resources without a stack tag(e.g. the shared backend security group), or whose stack liveness is unknown are never considered orphaned.
+func (c *defaultOrphanedResourcesCollector) findOrphanedResources(ctx context.Context, liveStackIDsByTagKey map[string]sets.String) ([]orphanedResource, error) {
+	clusterTagFilter := tracking.TagFilter{clusterNameTagKey: {c.clusterName}}
+	sdkLBs, err := c.elbv2TaggingManager.ListLoadBalancers(ctx, clusterTagFilter)
+	if err != nil {
+		return nil, err
+	}
+	sdkTGs, err := c.elbv2TaggingManager.ListTargetGroups(ctx, clusterTagFilter)
+	if err != nil {
+		return nil, err
+	}
+	sdkSGs, err := c.ec2TaggingManager.ListSecurityGroups(ctx, clusterTagFilter)
+	if err != nil {
+		return nil, err
+	}
+	sdkEIPs, err := c.ec2TaggingManager.ListElasticIPs(ctx, clusterTagFilter)
+	if err != nil {
+		return nil, err
+	}
+	boundTGARNs, err := c.loadBoundTargetGroupARNs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var orphanedResources []orphanedResource
+	appendIfOrphaned := func(resourceType string, resourceID string, tags map[string]string) {
+		for stackTagKey, liveStackIDs := range liveStackIDsByTagKey {
+			stackID, ok := tags[stackTagKey]
+			if !ok || liveStackIDs.Has(stackID) {
+				continue
+			}
+			orphanedResources = append(orphanedResources, orphanedResource{
+				resourceType: resourceType,
+				resourceID:   resourceID,
+				stackTagKey:  stackTagKey,
+				stackID:      stackID,
+			})
+			return
+		}
+	}
+	for _, sdkLB := range sdkLBs {
+		appendIfOrphaned(resourceTypeLoadBalancer, awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn), sdkLB.Tags)
+	}
+	for _, sdkTG := range sdkTGs {
+		tgARN := awssdk.ToString(sdkTG.TargetGroup.TargetGroupArn)
+		if boundTGARNs.Has(tgARN) {
+			continue
+		}
+		appendIfOrphaned(resourceTypeTargetGroup, tgARN, sdkTG.Tags)
+	}
+	for _, sdkSG := range sdkSGs {
+		appendIfOrphaned(resourceTypeSecurityGroup, sdkSG.SecurityGroupID, sdkSG.Tags)
+	}
+	for _, sdkEIP := range sdkEIPs {
+		appendIfOrphaned(resourceTypeElasticIP, awssdk.ToString(sdkEIP.Address.AllocationId), sdkEIP.Tags)
+	}
+	return orphanedResources, nil
+}
+
+// loadLiveStackIDs returns the stackIDs of existing Ingresses, Services and Gateways, keyed by their stack tag key.
+// an IngressGroup is live as long as any Ingress holds its finalizer, which is added before any resource is created for it.
+func (c *defaultOrphanedResourcesCollector) loadLiveStackIDs(ctx context.Context) (map[string]sets.String, error) {
+	ingList := &networking.IngressList{}
+	if err := c.k8sClient.List(ctx, ingList); err != nil {
+		return nil, errors.Wrap(err, "failed to list Ingresses")
+	}
+	ingStackIDs := sets.NewString()
+	for i := range ingList.Items {
+		ing := &ingList.Items[i]
+		ingStackIDs.Insert(ingress.NewGroupIDForImplicitGroup(k8s.NamespacedName(ing)).String())
+		for _, groupID := range c.groupLoader.LoadGroupIDsPendingFinalization(ctx, ing) {
+			ingStackIDs.Insert(groupID.String())
+		}
+	}
+
+	svcList := &corev1.ServiceList{}
+	if err := c.k8sClient.List(ctx, svcList); err != nil {
+		return nil, errors.Wrap(err, "failed to list Services")
+	}
+	svcStackIDs := sets.NewString()
+	for i := range svcList.Items {
+		svcStackIDs.Insert(k8s.NamespacedName(&svcList.Items[i]).String())
+	}
+
+	liveStackIDsByTagKey := map[string]sets.String{
+		ingressStackTagKey: ingStackIDs,
+		serviceStackTagKey: svcStackIDs,
+	}
+	// the liveness of Gateways is unknown unless the Gateway API is enabled, their resources are left untouched.
+	if c.enableGatewayAPI {
+		gwList := &gwv1beta1.GatewayList{}
+		if err := c.k8sClient.List(ctx, gwList); err != nil {
+			return nil, errors.Wrap(err, "failed to list Gateways")
+		}
+		gwStackIDs := sets.NewString()
+		for i := range gwList.Items {
+			gwStackIDs.Insert(k8s.NamespacedName(&gwList.Items[i]).String())
+		}
+		liveStackIDsByTagKey[gatewayStackTagKey] = gwStackIDs
+	}
+	return liveStackIDsByTagKey, nil
+}
+
+// loadBoundTargetGroupARNs returns the ARNs of target groups referenced by any TargetGroupBinding.
+func (c *defaultOrphanedResourcesCollector) loadBoundTargetGroupARNs(ctx context.Context) (sets.String, error) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := c.k8sClient.List(ctx, tgbList); err != nil {
+		return nil, errors.Wrap(err, "failed to list TargetGroupBindings")
+	}
+	tgARNs := sets.NewString()
+	for _, tgb := range tgbList.Items {
+		tgARNs.Insert(tgb.Spec.TargetGroupARN)
+	}
+	return tgARNs, nil
+}
+
+func (c *defaultOrphanedResourcesCollector) deleteResource(ctx context.Context, res orphanedResource) error {
+	switch res.resourceType {
+	case resourceTypeLoadBalancer:
+		_, err := c.elbv2Client.DeleteLoadBalancerWithContext(ctx, &elbv2sdk.DeleteLoadBalancerInput{
+			LoadBalancerArn: awssdk.String(res.resourceID),
+		})
+		return err
+	case resourceTypeTargetGroup:
+		_, err := c.elbv2Client.DeleteTargetGroupWithContext(ctx, &elbv2sdk.DeleteTargetGroupInput{
+			TargetGroupArn: awssdk.String(res.resourceID),
+		})
+		return err
+	case resourceTypeSecurityGroup:
+		_, err := c.ec2Client.DeleteSecurityGroupWithContext(ctx, &ec2sdk.DeleteSecurityGroupInput{
+			GroupId: awssdk.String(res.resourceID),
+		})
+		return err
+	case resourceTypeElasticIP:
+		_, err := c.ec2Client.ReleaseAddressWithContext(ctx, &ec2sdk.ReleaseAddressInput{
+			AllocationId: awssdk.String(res.resourceID),
+		})
+		return err
+	default:
+		return errors.Errorf("unsupported resource type: %v", res.resourceType)
+	}
+}
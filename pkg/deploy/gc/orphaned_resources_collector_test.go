@@ -0,0 +1,235 @@
+package gc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	ec2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultOrphanedResourcesCollector_sweep(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	clusterTagFilter := tracking.TagFilter{clusterNameTagKey: {"my-cluster"}}
+	k8sObjects := []client.Object{
+		&networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "awesome-ns",
+				Name:       "ing-1",
+				Finalizers: []string{"group.ingress.k8s.aws/awesome-group"},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "svc-1",
+			},
+		},
+		&elbv2api.TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "tgb-1",
+			},
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN: "tg-bound",
+			},
+		},
+	}
+	sdkLBs := []elbv2deploy.LoadBalancerWithTags{
+		{
+			LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-live-ingress-group")},
+			Tags:         map[string]string{clusterNameTagKey: "my-cluster", ingressStackTagKey: "awesome-group"},
+		},
+		{
+			LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-live-ingress")},
+			Tags:         map[string]string{clusterNameTagKey: "my-cluster", ingressStackTagKey: "awesome-ns/ing-1"},
+		},
+		{
+			LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-orphaned-ingress")},
+			Tags:         map[string]string{clusterNameTagKey: "my-cluster", ingressStackTagKey: "deleted-group"},
+		},
+		{
+			LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-live-service")},
+			Tags:         map[string]string{clusterNameTagKey: "my-cluster", serviceStackTagKey: "awesome-ns/svc-1"},
+		},
+		{
+			LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-orphaned-service")},
+			Tags:         map[string]string{clusterNameTagKey: "my-cluster", serviceStackTagKey: "awesome-ns/svc-2"},
+		},
+		{
+			LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-gateway")},
+			Tags:         map[string]string{clusterNameTagKey: "my-cluster", gatewayStackTagKey: "awesome-ns/gw-1"},
+		},
+	}
+	sdkTGs := []elbv2deploy.TargetGroupWithTags{
+		{
+			TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-orphaned-service")},
+			Tags:        map[string]string{clusterNameTagKey: "my-cluster", serviceStackTagKey: "awesome-ns/svc-2"},
+		},
+		{
+			TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String("tg-bound")},
+			Tags:        map[string]string{clusterNameTagKey: "my-cluster", serviceStackTagKey: "awesome-ns/svc-2"},
+		},
+	}
+	sdkSGs := []networkingpkg.SecurityGroupInfo{
+		{
+			SecurityGroupID: "sg-orphaned-ingress",
+			Tags:            map[string]string{clusterNameTagKey: "my-cluster", ingressStackTagKey: "deleted-group"},
+		},
+		{
+			SecurityGroupID: "sg-backend",
+			Tags:            map[string]string{clusterNameTagKey: "my-cluster", "elbv2.k8s.aws/resource": "backend-sg"},
+		},
+	}
+	sdkEIPs := []ec2deploy.ElasticIPWithTags{
+		{
+			Address: &ec2types.Address{AllocationId: awssdk.String("eipalloc-orphaned-service")},
+			Tags:    map[string]string{clusterNameTagKey: "my-cluster", serviceStackTagKey: "awesome-ns/svc-2"},
+		},
+		{
+			Address: &ec2types.Address{AllocationId: awssdk.String("eipalloc-live-service")},
+			Tags:    map[string]string{clusterNameTagKey: "my-cluster", serviceStackTagKey: "awesome-ns/svc-1"},
+		},
+	}
+
+	tests := []struct {
+		name              string
+		minAge            time.Duration
+		dryRun            bool
+		orphanedSince     map[string]time.Time
+		wantDeletedLBARNs []string
+		wantDeletedTGARNs []string
+		wantDeletedSGIDs  []string
+		wantReleasedEIPs  []string
+		wantOrphanedSince map[string]time.Time
+	}{
+		{
+			name:              "orphaned resources are deleted",
+			minAge:            0,
+			wantDeletedLBARNs: []string{"lb-orphaned-ingress", "lb-orphaned-service"},
+			wantDeletedTGARNs: []string{"tg-orphaned-service"},
+			wantDeletedSGIDs:  []string{"sg-orphaned-ingress"},
+			wantReleasedEIPs:  []string{"eipalloc-orphaned-service"},
+			wantOrphanedSince: map[string]time.Time{},
+		},
+		{
+			name:   "orphaned resources are only tracked before min age",
+			minAge: time.Hour,
+			orphanedSince: map[string]time.Time{
+				"lb-orphaned-service": now.Add(-time.Hour),
+				"lb-live-service":     now.Add(-2 * time.Hour),
+			},
+			wantDeletedLBARNs: []string{"lb-orphaned-service"},
+			wantOrphanedSince: map[string]time.Time{
+				"lb-orphaned-ingress":       now,
+				"tg-orphaned-service":       now,
+				"sg-orphaned-ingress":       now,
+				"eipalloc-orphaned-service": now,
+			},
+		},
+		{
+			name:   "orphaned resources are not deleted in dry run",
+			minAge: time.Hour,
+			dryRun: true,
+			orphanedSince: map[string]time.Time{
+				"lb-orphaned-service": now.Add(-time.Hour),
+			},
+			wantOrphanedSince: map[string]time.Time{
+				"lb-orphaned-ingress":       now,
+				"lb-orphaned-service":       now.Add(-time.Hour),
+				"tg-orphaned-service":       now,
+				"sg-orphaned-ingress":       now,
+				"eipalloc-orphaned-service": now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2TaggingManager := elbv2deploy.NewMockTaggingManager(ctrl)
+			elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), clusterTagFilter).Return(sdkLBs, nil)
+			elbv2TaggingManager.EXPECT().ListTargetGroups(gomock.Any(), clusterTagFilter).Return(sdkTGs, nil)
+			ec2TaggingManager := ec2deploy.NewMockTaggingManager(ctrl)
+			ec2TaggingManager.EXPECT().ListSecurityGroups(gomock.Any(), clusterTagFilter).Return(sdkSGs, nil)
+			ec2TaggingManager.EXPECT().ListElasticIPs(gomock.Any(), clusterTagFilter).Return(sdkEIPs, nil)
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, lbARN := range tt.wantDeletedLBARNs {
+				elbv2Client.EXPECT().DeleteLoadBalancerWithContext(gomock.Any(), &elbv2sdk.DeleteLoadBalancerInput{
+					LoadBalancerArn: awssdk.String(lbARN),
+				}).Return(&elbv2sdk.DeleteLoadBalancerOutput{}, nil)
+			}
+			for _, tgARN := range tt.wantDeletedTGARNs {
+				elbv2Client.EXPECT().DeleteTargetGroupWithContext(gomock.Any(), &elbv2sdk.DeleteTargetGroupInput{
+					TargetGroupArn: awssdk.String(tgARN),
+				}).Return(&elbv2sdk.DeleteTargetGroupOutput{}, nil)
+			}
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, sgID := range tt.wantDeletedSGIDs {
+				ec2Client.EXPECT().DeleteSecurityGroupWithContext(gomock.Any(), &ec2sdk.DeleteSecurityGroupInput{
+					GroupId: awssdk.String(sgID),
+				}).Return(&ec2sdk.DeleteSecurityGroupOutput{}, nil)
+			}
+			for _, allocationID := range tt.wantReleasedEIPs {
+				ec2Client.EXPECT().ReleaseAddressWithContext(gomock.Any(), &ec2sdk.ReleaseAddressInput{
+					AllocationId: awssdk.String(allocationID),
+				}).Return(&ec2sdk.ReleaseAddressOutput{}, nil)
+			}
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(k8sObjects...).Build()
+			groupLoader := ingress.NewDefaultGroupLoader(k8sClient, record.NewFakeRecorder(10),
+				annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress), ingress.NewDefaultClassLoader(k8sClient, true),
+				ingress.NewDefaultClassAnnotationMatcher("alb"), false, false)
+
+			orphanedSince := tt.orphanedSince
+			if orphanedSince == nil {
+				orphanedSince = make(map[string]time.Time)
+			}
+			c := &defaultOrphanedResourcesCollector{
+				elbv2Client:         elbv2Client,
+				ec2Client:           ec2Client,
+				elbv2TaggingManager: elbv2TaggingManager,
+				ec2TaggingManager:   ec2TaggingManager,
+				k8sClient:           k8sClient,
+				groupLoader:         groupLoader,
+				clusterName:         "my-cluster",
+				minAge:              tt.minAge,
+				dryRun:              tt.dryRun,
+				logger:              log.Log,
+				orphanedSince:       orphanedSince,
+			}
+			err := c.sweep(context.Background(), now)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOrphanedSince, c.orphanedSince)
+		})
+	}
+}
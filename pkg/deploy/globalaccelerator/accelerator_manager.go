@@ -0,0 +1,520 @@
+package globalaccelerator
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	gasdk "github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	gamodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/globalaccelerator"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+const (
+	// accelerators are disabled before deletion, which takes minutes to be deployed.
+	defaultAcceleratorDeletionRequeueDuration = 30 * time.Second
+)
+
+// AcceleratorWithTags represents a Global Accelerator accelerator with its associated tags.
+type AcceleratorWithTags struct {
+	Accelerator *gasdk.Accelerator
+	Tags        map[string]string
+}
+
+// AcceleratorManager is responsible for create/update/delete Global Accelerator accelerators managed by controller.
+// the listeners and endpoint groups of accelerators are managed as part of their accelerator.
+type AcceleratorManager interface {
+	Create(ctx context.Context, resAccelerator *gamodel.Accelerator) (gamodel.AcceleratorStatus, error)
+
+	Update(ctx context.Context, resAccelerator *gamodel.Accelerator, sdkAccelerator AcceleratorWithTags) (gamodel.AcceleratorStatus, error)
+
+	// Delete deletes the accelerator, it returns runtime.RequeueNeededAfter while the accelerator is being disabled.
+	Delete(ctx context.Context, sdkAccelerator AcceleratorWithTags) error
+
+	// ListAccelerators returns the accelerators managed by controller that matches tagFilter.
+	ListAccelerators(ctx context.Context, tagFilter tracking.TagFilter) ([]AcceleratorWithTags, error)
+}
+
+// NewDefaultAcceleratorManager constructs new defaultAcceleratorManager.
+// endpointRegion is the region of LoadBalancers, where the endpoint groups are created.
+func NewDefaultAcceleratorManager(gaClient services.GlobalAccelerator, trackingProvider tracking.Provider,
+	endpointRegion string, externalManagedTags []string, logger logr.Logger) *defaultAcceleratorManager {
+	return &defaultAcceleratorManager{
+		gaClient:            gaClient,
+		trackingProvider:    trackingProvider,
+		endpointRegion:      endpointRegion,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		deletionRequeueDuration: defaultAcceleratorDeletionRequeueDuration,
+	}
+}
+
+var _ AcceleratorManager = &defaultAcceleratorManager{}
+
+// default implementation for AcceleratorManager
+type defaultAcceleratorManager struct {
+	gaClient            services.GlobalAccelerator
+	trackingProvider    tracking.Provider
+	endpointRegion      string
+	externalManagedTags []string
+
+	logger logr.Logger
+
+	deletionRequeueDuration time.Duration
+}
+
+func (m *defaultAcceleratorManager) Create(ctx context.Context, resAccelerator *gamodel.Accelerator) (gamodel.AcceleratorStatus, error) {
+	lbARN, err := resAccelerator.Spec.LoadBalancerARN.Resolve(ctx)
+	if err != nil {
+		return gamodel.AcceleratorStatus{}, err
+	}
+	acceleratorTags := m.trackingProvider.ResourceTags(resAccelerator.Stack(), resAccelerator, resAccelerator.Spec.Tags)
+	req := &gasdk.CreateAcceleratorInput{
+		Name:             awssdk.String(resAccelerator.Spec.Name),
+		IpAddressType:    awssdk.String(gasdk.IpAddressTypeIpv4),
+		Enabled:          awssdk.Bool(true),
+		IdempotencyToken: awssdk.String(string(uuid.NewUUID())),
+		Tags:             convertTagsToSDKTags(acceleratorTags),
+	}
+	m.logger.Info("creating accelerator",
+		"stackID", resAccelerator.Stack().StackID(),
+		"resourceID", resAccelerator.ID())
+	resp, err := m.gaClient.CreateAcceleratorWithContext(ctx, req)
+	if err != nil {
+		return gamodel.AcceleratorStatus{}, err
+	}
+	acceleratorARN := awssdk.StringValue(resp.Accelerator.AcceleratorArn)
+	m.logger.Info("created accelerator",
+		"stackID", resAccelerator.Stack().StackID(),
+		"resourceID", resAccelerator.ID(),
+		"arn", acceleratorARN)
+	audit.RecordMutation(ctx, audit.ActionCreate, "accelerator", acceleratorARN, "")
+
+	if err := m.reconcileListeners(ctx, acceleratorARN, resAccelerator.Spec.Listeners, nil, lbARN); err != nil {
+		return gamodel.AcceleratorStatus{}, err
+	}
+	return buildResAcceleratorStatus(resp.Accelerator), nil
+}
+
+func (m *defaultAcceleratorManager) Update(ctx context.Context, resAccelerator *gamodel.Accelerator, sdkAccelerator AcceleratorWithTags) (gamodel.AcceleratorStatus, error) {
+	lbARN, err := resAccelerator.Spec.LoadBalancerARN.Resolve(ctx)
+	if err != nil {
+		return gamodel.AcceleratorStatus{}, err
+	}
+	acceleratorARN := awssdk.StringValue(sdkAccelerator.Accelerator.AcceleratorArn)
+	if err := m.updateSDKAcceleratorWithSettings(ctx, resAccelerator, sdkAccelerator); err != nil {
+		return gamodel.AcceleratorStatus{}, err
+	}
+	desiredTags := m.trackingProvider.ResourceTags(resAccelerator.Stack(), resAccelerator, resAccelerator.Spec.Tags)
+	if err := m.reconcileTags(ctx, acceleratorARN, desiredTags, sdkAccelerator.Tags); err != nil {
+		return gamodel.AcceleratorStatus{}, err
+	}
+	sdkListeners, err := m.listSDKListeners(ctx, acceleratorARN)
+	if err != nil {
+		return gamodel.AcceleratorStatus{}, err
+	}
+	if err := m.reconcileListeners(ctx, acceleratorARN, resAccelerator.Spec.Listeners, sdkListeners, lbARN); err != nil {
+		return gamodel.AcceleratorStatus{}, err
+	}
+	return buildResAcceleratorStatus(sdkAccelerator.Accelerator), nil
+}
+
+func (m *defaultAcceleratorManager) Delete(ctx context.Context, sdkAccelerator AcceleratorWithTags) error {
+	acceleratorARN := awssdk.StringValue(sdkAccelerator.Accelerator.AcceleratorArn)
+	// the listeners are deleted first, so that the LoadBalancer is released while the accelerator is being disabled.
+	sdkListeners, err := m.listSDKListeners(ctx, acceleratorARN)
+	if err != nil {
+		return err
+	}
+	if err := m.reconcileListeners(ctx, acceleratorARN, nil, sdkListeners, ""); err != nil {
+		return err
+	}
+	if awssdk.BoolValue(sdkAccelerator.Accelerator.Enabled) {
+		m.logger.Info("disabling accelerator",
+			"arn", acceleratorARN)
+		if _, err := m.gaClient.UpdateAcceleratorWithContext(ctx, &gasdk.UpdateAcceleratorInput{
+			AcceleratorArn: awssdk.String(acceleratorARN),
+			Enabled:        awssdk.Bool(false),
+		}); err != nil {
+			return errors.Wrap(err, "failed to disable accelerator")
+		}
+		m.logger.Info("disabled accelerator",
+			"arn", acceleratorARN)
+		audit.RecordMutation(ctx, audit.ActionModify, "accelerator", acceleratorARN, "disabled")
+		return runtime.NewRequeueNeededAfter("waiting for accelerator to be disabled", m.deletionRequeueDuration)
+	}
+	// an accelerator can only be deleted after the disabling is deployed.
+	if awssdk.StringValue(sdkAccelerator.Accelerator.Status) != gasdk.AcceleratorStatusDeployed {
+		return runtime.NewRequeueNeededAfter("waiting for accelerator to be disabled", m.deletionRequeueDuration)
+	}
+	m.logger.Info("deleting accelerator",
+		"arn", acceleratorARN)
+	if _, err := m.gaClient.DeleteAcceleratorWithContext(ctx, &gasdk.DeleteAcceleratorInput{
+		AcceleratorArn: awssdk.String(acceleratorARN),
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete accelerator")
+	}
+	m.logger.Info("deleted accelerator",
+		"arn", acceleratorARN)
+	audit.RecordMutation(ctx, audit.ActionDelete, "accelerator", acceleratorARN, "")
+	return nil
+}
+
+func (m *defaultAcceleratorManager) ListAccelerators(ctx context.Context, tagFilter tracking.TagFilter) ([]AcceleratorWithTags, error) {
+	sdkAccelerators, err := m.gaClient.ListAcceleratorsAsList(ctx, &gasdk.ListAcceleratorsInput{})
+	if err != nil {
+		return nil, err
+	}
+	var accelerators []AcceleratorWithTags
+	for _, sdkAccelerator := range sdkAccelerators {
+		tags, err := m.describeAcceleratorTags(ctx, awssdk.StringValue(sdkAccelerator.AcceleratorArn))
+		if err != nil {
+			return nil, err
+		}
+		if !tagFilter.Matches(tags) {
+			continue
+		}
+		accelerators = append(accelerators, AcceleratorWithTags{
+			Accelerator: sdkAccelerator,
+			Tags:        tags,
+		})
+	}
+	return accelerators, nil
+}
+
+func (m *defaultAcceleratorManager) updateSDKAcceleratorWithSettings(ctx context.Context, resAccelerator *gamodel.Accelerator, sdkAccelerator AcceleratorWithTags) error {
+	if resAccelerator.Spec.Name == awssdk.StringValue(sdkAccelerator.Accelerator.Name) && awssdk.BoolValue(sdkAccelerator.Accelerator.Enabled) {
+		return nil
+	}
+	acceleratorARN := awssdk.StringValue(sdkAccelerator.Accelerator.AcceleratorArn)
+	req := &gasdk.UpdateAcceleratorInput{
+		AcceleratorArn: awssdk.String(acceleratorARN),
+		Name:           awssdk.String(resAccelerator.Spec.Name),
+		Enabled:        awssdk.Bool(true),
+	}
+	m.logger.Info("modifying accelerator",
+		"stackID", resAccelerator.Stack().StackID(),
+		"resourceID", resAccelerator.ID(),
+		"arn", acceleratorARN)
+	if _, err := m.gaClient.UpdateAcceleratorWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified accelerator",
+		"stackID", resAccelerator.Stack().StackID(),
+		"resourceID", resAccelerator.ID(),
+		"arn", acceleratorARN)
+	audit.RecordMutation(ctx, audit.ActionModify, "accelerator", acceleratorARN, "settings")
+	return nil
+}
+
+func (m *defaultAcceleratorManager) listSDKListeners(ctx context.Context, acceleratorARN string) ([]*gasdk.Listener, error) {
+	return m.gaClient.ListListenersAsList(ctx, &gasdk.ListListenersInput{
+		AcceleratorArn: awssdk.String(acceleratorARN),
+	})
+}
+
+// reconcileListeners reconciles the sdkListeners of accelerator to match resListeners, each listener forwards to lbARN.
+// the listeners are matched by protocol, since the port ranges of an accelerator's listeners cannot overlap.
+func (m *defaultAcceleratorManager) reconcileListeners(ctx context.Context, acceleratorARN string, resListeners []gamodel.AcceleratorListener,
+	sdkListeners []*gasdk.Listener, lbARN string) error {
+	resListenerByProtocol := make(map[string]gamodel.AcceleratorListener, len(resListeners))
+	for _, resListener := range resListeners {
+		resListenerByProtocol[string(resListener.Protocol)] = resListener
+	}
+	var matchedListeners []*gasdk.Listener
+	for _, sdkListener := range sdkListeners {
+		resListener, exists := resListenerByProtocol[awssdk.StringValue(sdkListener.Protocol)]
+		if !exists {
+			if err := m.deleteListener(ctx, sdkListener); err != nil {
+				return err
+			}
+			continue
+		}
+		delete(resListenerByProtocol, awssdk.StringValue(sdkListener.Protocol))
+		if err := m.updateListener(ctx, sdkListener, resListener); err != nil {
+			return err
+		}
+		matchedListeners = append(matchedListeners, sdkListener)
+	}
+	for _, resListener := range resListeners {
+		if _, pending := resListenerByProtocol[string(resListener.Protocol)]; !pending {
+			continue
+		}
+		listenerARN, err := m.createListener(ctx, acceleratorARN, resListener)
+		if err != nil {
+			return err
+		}
+		if err := m.reconcileEndpointGroups(ctx, listenerARN, nil, lbARN); err != nil {
+			return err
+		}
+	}
+	for _, sdkListener := range matchedListeners {
+		sdkEndpointGroups, err := m.gaClient.ListEndpointGroupsAsList(ctx, &gasdk.ListEndpointGroupsInput{
+			ListenerArn: sdkListener.ListenerArn,
+		})
+		if err != nil {
+			return err
+		}
+		if err := m.reconcileEndpointGroups(ctx, awssdk.StringValue(sdkListener.ListenerArn), sdkEndpointGroups, lbARN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *defaultAcceleratorManager) createListener(ctx context.Context, acceleratorARN string, resListener gamodel.AcceleratorListener) (string, error) {
+	req := &gasdk.CreateListenerInput{
+		AcceleratorArn:   awssdk.String(acceleratorARN),
+		Protocol:         awssdk.String(string(resListener.Protocol)),
+		PortRanges:       buildSDKPortRanges(resListener.Ports),
+		IdempotencyToken: awssdk.String(string(uuid.NewUUID())),
+	}
+	m.logger.Info("creating accelerator listener",
+		"acceleratorARN", acceleratorARN,
+		"protocol", resListener.Protocol)
+	resp, err := m.gaClient.CreateListenerWithContext(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	listenerARN := awssdk.StringValue(resp.Listener.ListenerArn)
+	m.logger.Info("created accelerator listener",
+		"acceleratorARN", acceleratorARN,
+		"arn", listenerARN)
+	audit.RecordMutation(ctx, audit.ActionCreate, "acceleratorListener", listenerARN, string(resListener.Protocol))
+	return listenerARN, nil
+}
+
+func (m *defaultAcceleratorManager) updateListener(ctx context.Context, sdkListener *gasdk.Listener, resListener gamodel.AcceleratorListener) error {
+	if cmp.Equal(resListener.Ports, buildResPorts(sdkListener.PortRanges), cmpopts.EquateEmpty()) {
+		return nil
+	}
+	listenerARN := awssdk.StringValue(sdkListener.ListenerArn)
+	m.logger.Info("modifying accelerator listener",
+		"arn", listenerARN)
+	if _, err := m.gaClient.UpdateListenerWithContext(ctx, &gasdk.UpdateListenerInput{
+		ListenerArn: awssdk.String(listenerARN),
+		PortRanges:  buildSDKPortRanges(resListener.Ports),
+	}); err != nil {
+		return err
+	}
+	m.logger.Info("modified accelerator listener",
+		"arn", listenerARN)
+	audit.RecordMutation(ctx, audit.ActionModify, "acceleratorListener", listenerARN, "ports")
+	return nil
+}
+
+// deleteListener deletes the listener along with its endpoint groups, which must be deleted first.
+func (m *defaultAcceleratorManager) deleteListener(ctx context.Context, sdkListener *gasdk.Listener) error {
+	listenerARN := awssdk.StringValue(sdkListener.ListenerArn)
+	sdkEndpointGroups, err := m.gaClient.ListEndpointGroupsAsList(ctx, &gasdk.ListEndpointGroupsInput{
+		ListenerArn: awssdk.String(listenerARN),
+	})
+	if err != nil {
+		return err
+	}
+	if err := m.reconcileEndpointGroups(ctx, listenerARN, sdkEndpointGroups, ""); err != nil {
+		return err
+	}
+	m.logger.Info("deleting accelerator listener",
+		"arn", listenerARN)
+	if _, err := m.gaClient.DeleteListenerWithContext(ctx, &gasdk.DeleteListenerInput{
+		ListenerArn: awssdk.String(listenerARN),
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete accelerator listener")
+	}
+	m.logger.Info("deleted accelerator listener",
+		"arn", listenerARN)
+	audit.RecordMutation(ctx, audit.ActionDelete, "acceleratorListener", listenerARN, "")
+	return nil
+}
+
+// reconcileEndpointGroups reconciles the endpoint groups of listener to a single endpoint group in endpointRegion with lbARN as endpoint.
+// all endpoint groups are deleted if lbARN is empty.
+func (m *defaultAcceleratorManager) reconcileEndpointGroups(ctx context.Context, listenerARN string, sdkEndpointGroups []*gasdk.EndpointGroup, lbARN string) error {
+	var matchedEndpointGroup *gasdk.EndpointGroup
+	for _, sdkEndpointGroup := range sdkEndpointGroups {
+		if lbARN != "" && awssdk.StringValue(sdkEndpointGroup.EndpointGroupRegion) == m.endpointRegion {
+			matchedEndpointGroup = sdkEndpointGroup
+			continue
+		}
+		endpointGroupARN := awssdk.StringValue(sdkEndpointGroup.EndpointGroupArn)
+		m.logger.Info("deleting accelerator endpointGroup",
+			"arn", endpointGroupARN)
+		if _, err := m.gaClient.DeleteEndpointGroupWithContext(ctx, &gasdk.DeleteEndpointGroupInput{
+			EndpointGroupArn: awssdk.String(endpointGroupARN),
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete accelerator endpointGroup")
+		}
+		m.logger.Info("deleted accelerator endpointGroup",
+			"arn", endpointGroupARN)
+		audit.RecordMutation(ctx, audit.ActionDelete, "acceleratorEndpointGroup", endpointGroupARN, "")
+	}
+	if lbARN == "" {
+		return nil
+	}
+	if matchedEndpointGroup == nil {
+		return m.createEndpointGroup(ctx, listenerARN, lbARN)
+	}
+	return m.updateEndpointGroup(ctx, matchedEndpointGroup, lbARN)
+}
+
+func (m *defaultAcceleratorManager) createEndpointGroup(ctx context.Context, listenerARN string, lbARN string) error {
+	req := &gasdk.CreateEndpointGroupInput{
+		ListenerArn:            awssdk.String(listenerARN),
+		EndpointGroupRegion:    awssdk.String(m.endpointRegion),
+		EndpointConfigurations: buildSDKEndpointConfigurations(lbARN),
+		IdempotencyToken:       awssdk.String(string(uuid.NewUUID())),
+	}
+	m.logger.Info("creating accelerator endpointGroup",
+		"listenerARN", listenerARN,
+		"region", m.endpointRegion)
+	resp, err := m.gaClient.CreateEndpointGroupWithContext(ctx, req)
+	if err != nil {
+		return err
+	}
+	endpointGroupARN := awssdk.StringValue(resp.EndpointGroup.EndpointGroupArn)
+	m.logger.Info("created accelerator endpointGroup",
+		"listenerARN", listenerARN,
+		"arn", endpointGroupARN)
+	audit.RecordMutation(ctx, audit.ActionCreate, "acceleratorEndpointGroup", endpointGroupARN, "endpoint "+lbARN)
+	return nil
+}
+
+func (m *defaultAcceleratorManager) updateEndpointGroup(ctx context.Context, sdkEndpointGroup *gasdk.EndpointGroup, lbARN string) error {
+	if len(sdkEndpointGroup.EndpointDescriptions) == 1 && awssdk.StringValue(sdkEndpointGroup.EndpointDescriptions[0].EndpointId) == lbARN {
+		return nil
+	}
+	endpointGroupARN := awssdk.StringValue(sdkEndpointGroup.EndpointGroupArn)
+	m.logger.Info("modifying accelerator endpointGroup",
+		"arn", endpointGroupARN)
+	if _, err := m.gaClient.UpdateEndpointGroupWithContext(ctx, &gasdk.UpdateEndpointGroupInput{
+		EndpointGroupArn:       awssdk.String(endpointGroupARN),
+		EndpointConfigurations: buildSDKEndpointConfigurations(lbARN),
+	}); err != nil {
+		return err
+	}
+	m.logger.Info("modified accelerator endpointGroup",
+		"arn", endpointGroupARN)
+	audit.RecordMutation(ctx, audit.ActionModify, "acceleratorEndpointGroup", endpointGroupARN, "endpoint "+lbARN)
+	return nil
+}
+
+func (m *defaultAcceleratorManager) reconcileTags(ctx context.Context, acceleratorARN string, desiredTags map[string]string, currentTags map[string]string) error {
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, currentTags)
+	for _, ignoredTagKey := range m.externalManagedTags {
+		delete(tagsToUpdate, ignoredTagKey)
+		delete(tagsToRemove, ignoredTagKey)
+	}
+	if len(tagsToUpdate) > 0 {
+		m.logger.Info("adding resource tags",
+			"arn", acceleratorARN,
+			"change", tagsToUpdate)
+		if _, err := m.gaClient.TagResourceWithContext(ctx, &gasdk.TagResourceInput{
+			ResourceArn: awssdk.String(acceleratorARN),
+			Tags:        convertTagsToSDKTags(tagsToUpdate),
+		}); err != nil {
+			return err
+		}
+		m.logger.Info("added resource tags",
+			"arn", acceleratorARN)
+	}
+	if len(tagsToRemove) > 0 {
+		tagKeys := sets.StringKeySet(tagsToRemove).List()
+		m.logger.Info("removing resource tags",
+			"arn", acceleratorARN,
+			"change", tagKeys)
+		if _, err := m.gaClient.UntagResourceWithContext(ctx, &gasdk.UntagResourceInput{
+			ResourceArn: awssdk.String(acceleratorARN),
+			TagKeys:     awssdk.StringSlice(tagKeys),
+		}); err != nil {
+			return err
+		}
+		m.logger.Info("removed resource tags",
+			"arn", acceleratorARN)
+	}
+	return nil
+}
+
+func (m *defaultAcceleratorManager) describeAcceleratorTags(ctx context.Context, acceleratorARN string) (map[string]string, error) {
+	resp, err := m.gaClient.ListTagsForResourceWithContext(ctx, &gasdk.ListTagsForResourceInput{
+		ResourceArn: awssdk.String(acceleratorARN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(resp.Tags))
+	for _, tag := range resp.Tags {
+		tags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+	}
+	return tags, nil
+}
+
+func buildResAcceleratorStatus(sdkAccelerator *gasdk.Accelerator) gamodel.AcceleratorStatus {
+	var ipAddresses []string
+	for _, ipSet := range sdkAccelerator.IpSets {
+		ipAddresses = append(ipAddresses, awssdk.StringValueSlice(ipSet.IpAddresses)...)
+	}
+	return gamodel.AcceleratorStatus{
+		AcceleratorARN: awssdk.StringValue(sdkAccelerator.AcceleratorArn),
+		DNSName:        awssdk.StringValue(sdkAccelerator.DnsName),
+		IPAddresses:    ipAddresses,
+	}
+}
+
+func buildSDKPortRanges(ports []int64) []*gasdk.PortRange {
+	sdkPortRanges := make([]*gasdk.PortRange, 0, len(ports))
+	for _, port := range ports {
+		sdkPortRanges = append(sdkPortRanges, &gasdk.PortRange{
+			FromPort: awssdk.Int64(port),
+			ToPort:   awssdk.Int64(port),
+		})
+	}
+	return sdkPortRanges
+}
+
+// buildResPorts returns the ports covered by port ranges, in ascending order.
+func buildResPorts(sdkPortRanges []*gasdk.PortRange) []int64 {
+	portSet := sets.NewInt64()
+	for _, sdkPortRange := range sdkPortRanges {
+		for port := awssdk.Int64Value(sdkPortRange.FromPort); port <= awssdk.Int64Value(sdkPortRange.ToPort); port++ {
+			portSet.Insert(port)
+		}
+	}
+	ports := portSet.UnsortedList()
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports
+}
+
+func buildSDKEndpointConfigurations(lbARN string) []*gasdk.EndpointConfiguration {
+	return []*gasdk.EndpointConfiguration{
+		{
+			EndpointId: awssdk.String(lbARN),
+		},
+	}
+}
+
+func convertTagsToSDKTags(tags map[string]string) []*gasdk.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	sdkTags := make([]*gasdk.Tag, 0, len(tags))
+	for _, key := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, &gasdk.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
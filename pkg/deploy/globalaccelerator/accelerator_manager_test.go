@@ -0,0 +1,325 @@
+package globalaccelerator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	gasdk "github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	gamodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/globalaccelerator"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultAcceleratorManager_reconcileListeners(t *testing.T) {
+	acceleratorARN := "arn:aws:globalaccelerator::123456789012:accelerator/acc-1"
+	lbARN := "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/lb-1/abc"
+	tcpListener := &gasdk.Listener{
+		ListenerArn: awssdk.String(acceleratorARN + "/listener/tcp"),
+		Protocol:    awssdk.String(gasdk.ProtocolTcp),
+		PortRanges: []*gasdk.PortRange{
+			{FromPort: awssdk.Int64(80), ToPort: awssdk.Int64(80)},
+			{FromPort: awssdk.Int64(443), ToPort: awssdk.Int64(443)},
+		},
+	}
+	udpListener := &gasdk.Listener{
+		ListenerArn: awssdk.String(acceleratorARN + "/listener/udp"),
+		Protocol:    awssdk.String(gasdk.ProtocolUdp),
+		PortRanges: []*gasdk.PortRange{
+			{FromPort: awssdk.Int64(53), ToPort: awssdk.Int64(53)},
+		},
+	}
+	tcpEndpointGroup := &gasdk.EndpointGroup{
+		EndpointGroupArn:    awssdk.String(acceleratorARN + "/listener/tcp/endpoint-group/eg-1"),
+		EndpointGroupRegion: awssdk.String("us-east-1"),
+		EndpointDescriptions: []*gasdk.EndpointDescription{
+			{EndpointId: awssdk.String(lbARN)},
+		},
+	}
+	type listEndpointGroupsCall struct {
+		listenerARN string
+		resp        []*gasdk.EndpointGroup
+	}
+	tests := []struct {
+		name                        string
+		resListeners                []gamodel.AcceleratorListener
+		sdkListeners                []*gasdk.Listener
+		listEndpointGroupsCalls     []listEndpointGroupsCall
+		wantCreateListenerReqs      []*gasdk.CreateListenerInput
+		wantUpdateListenerReqs      []*gasdk.UpdateListenerInput
+		wantDeleteListenerReqs      []*gasdk.DeleteListenerInput
+		wantCreateEndpointGroupReqs []*gasdk.CreateEndpointGroupInput
+		wantUpdateEndpointGroupReqs []*gasdk.UpdateEndpointGroupInput
+		wantDeleteEndpointGroupReqs []*gasdk.DeleteEndpointGroupInput
+	}{
+		{
+			name: "listeners are in sync",
+			resListeners: []gamodel.AcceleratorListener{
+				{Protocol: gamodel.ProtocolTCP, Ports: []int64{80, 443}},
+			},
+			sdkListeners: []*gasdk.Listener{tcpListener},
+			listEndpointGroupsCalls: []listEndpointGroupsCall{
+				{listenerARN: awssdk.StringValue(tcpListener.ListenerArn), resp: []*gasdk.EndpointGroup{tcpEndpointGroup}},
+			},
+		},
+		{
+			name: "missing listener is created with its endpoint group",
+			resListeners: []gamodel.AcceleratorListener{
+				{Protocol: gamodel.ProtocolUDP, Ports: []int64{53}},
+			},
+			wantCreateListenerReqs: []*gasdk.CreateListenerInput{
+				{
+					AcceleratorArn: awssdk.String(acceleratorARN),
+					Protocol:       awssdk.String(gasdk.ProtocolUdp),
+					PortRanges: []*gasdk.PortRange{
+						{FromPort: awssdk.Int64(53), ToPort: awssdk.Int64(53)},
+					},
+				},
+			},
+			wantCreateEndpointGroupReqs: []*gasdk.CreateEndpointGroupInput{
+				{
+					ListenerArn:         awssdk.String(acceleratorARN + "/listener/new"),
+					EndpointGroupRegion: awssdk.String("us-east-1"),
+					EndpointConfigurations: []*gasdk.EndpointConfiguration{
+						{EndpointId: awssdk.String(lbARN)},
+					},
+				},
+			},
+		},
+		{
+			name: "drifted listener and endpoint group are updated, extra listener is deleted",
+			resListeners: []gamodel.AcceleratorListener{
+				{Protocol: gamodel.ProtocolTCP, Ports: []int64{443}},
+			},
+			sdkListeners: []*gasdk.Listener{tcpListener, udpListener},
+			listEndpointGroupsCalls: []listEndpointGroupsCall{
+				{
+					listenerARN: awssdk.StringValue(udpListener.ListenerArn),
+					resp: []*gasdk.EndpointGroup{
+						{
+							EndpointGroupArn:    awssdk.String(acceleratorARN + "/listener/udp/endpoint-group/eg-2"),
+							EndpointGroupRegion: awssdk.String("us-east-1"),
+						},
+					},
+				},
+				{
+					listenerARN: awssdk.StringValue(tcpListener.ListenerArn),
+					resp: []*gasdk.EndpointGroup{
+						{
+							EndpointGroupArn:    tcpEndpointGroup.EndpointGroupArn,
+							EndpointGroupRegion: awssdk.String("us-east-1"),
+							EndpointDescriptions: []*gasdk.EndpointDescription{
+								{EndpointId: awssdk.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/net/lb-0/def")},
+							},
+						},
+						{
+							EndpointGroupArn:    awssdk.String(acceleratorARN + "/listener/tcp/endpoint-group/eg-3"),
+							EndpointGroupRegion: awssdk.String("us-west-2"),
+						},
+					},
+				},
+			},
+			wantUpdateListenerReqs: []*gasdk.UpdateListenerInput{
+				{
+					ListenerArn: tcpListener.ListenerArn,
+					PortRanges: []*gasdk.PortRange{
+						{FromPort: awssdk.Int64(443), ToPort: awssdk.Int64(443)},
+					},
+				},
+			},
+			wantDeleteListenerReqs: []*gasdk.DeleteListenerInput{
+				{ListenerArn: udpListener.ListenerArn},
+			},
+			wantUpdateEndpointGroupReqs: []*gasdk.UpdateEndpointGroupInput{
+				{
+					EndpointGroupArn: tcpEndpointGroup.EndpointGroupArn,
+					EndpointConfigurations: []*gasdk.EndpointConfiguration{
+						{EndpointId: awssdk.String(lbARN)},
+					},
+				},
+			},
+			wantDeleteEndpointGroupReqs: []*gasdk.DeleteEndpointGroupInput{
+				{EndpointGroupArn: awssdk.String(acceleratorARN + "/listener/udp/endpoint-group/eg-2")},
+				{EndpointGroupArn: awssdk.String(acceleratorARN + "/listener/tcp/endpoint-group/eg-3")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gaClient := services.NewMockGlobalAccelerator(ctrl)
+			for _, call := range tt.listEndpointGroupsCalls {
+				gaClient.EXPECT().ListEndpointGroupsAsList(gomock.Any(), &gasdk.ListEndpointGroupsInput{
+					ListenerArn: awssdk.String(call.listenerARN),
+				}).Return(call.resp, nil)
+			}
+			for _, req := range tt.wantCreateListenerReqs {
+				gaClient.EXPECT().CreateListenerWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, input *gasdk.CreateListenerInput, opts ...interface{}) (*gasdk.CreateListenerOutput, error) {
+						assert.NotEmpty(t, awssdk.StringValue(input.IdempotencyToken))
+						input.IdempotencyToken = nil
+						assert.Equal(t, req, input)
+						return &gasdk.CreateListenerOutput{
+							Listener: &gasdk.Listener{ListenerArn: awssdk.String(acceleratorARN + "/listener/new")},
+						}, nil
+					})
+			}
+			for _, req := range tt.wantUpdateListenerReqs {
+				gaClient.EXPECT().UpdateListenerWithContext(gomock.Any(), req).Return(&gasdk.UpdateListenerOutput{}, nil)
+			}
+			for _, req := range tt.wantDeleteListenerReqs {
+				gaClient.EXPECT().DeleteListenerWithContext(gomock.Any(), req).Return(&gasdk.DeleteListenerOutput{}, nil)
+			}
+			for _, req := range tt.wantCreateEndpointGroupReqs {
+				gaClient.EXPECT().CreateEndpointGroupWithContext(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, input *gasdk.CreateEndpointGroupInput, opts ...interface{}) (*gasdk.CreateEndpointGroupOutput, error) {
+						assert.NotEmpty(t, awssdk.StringValue(input.IdempotencyToken))
+						input.IdempotencyToken = nil
+						assert.Equal(t, req, input)
+						return &gasdk.CreateEndpointGroupOutput{
+							EndpointGroup: &gasdk.EndpointGroup{EndpointGroupArn: awssdk.String(acceleratorARN + "/listener/new/endpoint-group/new")},
+						}, nil
+					})
+			}
+			for _, req := range tt.wantUpdateEndpointGroupReqs {
+				gaClient.EXPECT().UpdateEndpointGroupWithContext(gomock.Any(), req).Return(&gasdk.UpdateEndpointGroupOutput{}, nil)
+			}
+			for _, req := range tt.wantDeleteEndpointGroupReqs {
+				gaClient.EXPECT().DeleteEndpointGroupWithContext(gomock.Any(), req).Return(&gasdk.DeleteEndpointGroupOutput{}, nil)
+			}
+
+			m := &defaultAcceleratorManager{
+				gaClient:       gaClient,
+				endpointRegion: "us-east-1",
+				logger:         log.Log,
+			}
+			err := m.reconcileListeners(context.Background(), acceleratorARN, tt.resListeners, tt.sdkListeners, lbARN)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_defaultAcceleratorManager_Delete(t *testing.T) {
+	acceleratorARN := "arn:aws:globalaccelerator::123456789012:accelerator/acc-1"
+	tests := []struct {
+		name           string
+		sdkAccelerator *gasdk.Accelerator
+		deleteErr      error
+		wantDisable    bool
+		wantDelete     bool
+		wantRequeue    bool
+		wantErr        error
+	}{
+		{
+			name: "enabled accelerator is disabled",
+			sdkAccelerator: &gasdk.Accelerator{
+				AcceleratorArn: awssdk.String(acceleratorARN),
+				Enabled:        awssdk.Bool(true),
+				Status:         awssdk.String(gasdk.AcceleratorStatusDeployed),
+			},
+			wantDisable: true,
+			wantRequeue: true,
+		},
+		{
+			name: "disabled accelerator is still in progress",
+			sdkAccelerator: &gasdk.Accelerator{
+				AcceleratorArn: awssdk.String(acceleratorARN),
+				Enabled:        awssdk.Bool(false),
+				Status:         awssdk.String(gasdk.AcceleratorStatusInProgress),
+			},
+			wantRequeue: true,
+		},
+		{
+			name: "disabled accelerator is deleted",
+			sdkAccelerator: &gasdk.Accelerator{
+				AcceleratorArn: awssdk.String(acceleratorARN),
+				Enabled:        awssdk.Bool(false),
+				Status:         awssdk.String(gasdk.AcceleratorStatusDeployed),
+			},
+			wantDelete: true,
+		},
+		{
+			name: "delete accelerator failed",
+			sdkAccelerator: &gasdk.Accelerator{
+				AcceleratorArn: awssdk.String(acceleratorARN),
+				Enabled:        awssdk.Bool(false),
+				Status:         awssdk.String(gasdk.AcceleratorStatusDeployed),
+			},
+			deleteErr:  errors.New("some error"),
+			wantDelete: true,
+			wantErr:    errors.New("failed to delete accelerator: some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			gaClient := services.NewMockGlobalAccelerator(ctrl)
+			gaClient.EXPECT().ListListenersAsList(gomock.Any(), &gasdk.ListListenersInput{
+				AcceleratorArn: awssdk.String(acceleratorARN),
+			}).Return(nil, nil)
+			if tt.wantDisable {
+				gaClient.EXPECT().UpdateAcceleratorWithContext(gomock.Any(), &gasdk.UpdateAcceleratorInput{
+					AcceleratorArn: awssdk.String(acceleratorARN),
+					Enabled:        awssdk.Bool(false),
+				}).Return(&gasdk.UpdateAcceleratorOutput{}, nil)
+			}
+			if tt.wantDelete {
+				gaClient.EXPECT().DeleteAcceleratorWithContext(gomock.Any(), &gasdk.DeleteAcceleratorInput{
+					AcceleratorArn: awssdk.String(acceleratorARN),
+				}).Return(&gasdk.DeleteAcceleratorOutput{}, tt.deleteErr)
+			}
+
+			m := &defaultAcceleratorManager{
+				gaClient:                gaClient,
+				endpointRegion:          "us-east-1",
+				logger:                  log.Log,
+				deletionRequeueDuration: time.Second,
+			}
+			err := m.Delete(context.Background(), AcceleratorWithTags{Accelerator: tt.sdkAccelerator})
+			if tt.wantRequeue {
+				var requeueErr *runtime.RequeueNeededAfter
+				assert.ErrorAs(t, err, &requeueErr)
+			} else if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_buildResPorts(t *testing.T) {
+	tests := []struct {
+		name          string
+		sdkPortRanges []*gasdk.PortRange
+		want          []int64
+	}{
+		{
+			name: "port ranges are expanded in ascending order",
+			sdkPortRanges: []*gasdk.PortRange{
+				{FromPort: awssdk.Int64(443), ToPort: awssdk.Int64(443)},
+				{FromPort: awssdk.Int64(80), ToPort: awssdk.Int64(82)},
+			},
+			want: []int64{80, 81, 82, 443},
+		},
+		{
+			name: "no port ranges",
+			want: []int64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildResPorts(tt.sdkPortRanges)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
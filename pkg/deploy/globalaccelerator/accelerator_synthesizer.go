@@ -0,0 +1,125 @@
+package globalaccelerator
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	gamodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/globalaccelerator"
+)
+
+// NewAcceleratorSynthesizer constructs new acceleratorSynthesizer.
+func NewAcceleratorSynthesizer(trackingProvider tracking.Provider, acceleratorManager AcceleratorManager, logger logr.Logger, stack core.Stack) *acceleratorSynthesizer {
+	return &acceleratorSynthesizer{
+		trackingProvider:   trackingProvider,
+		acceleratorManager: acceleratorManager,
+		logger:             logger,
+		stack:              stack,
+	}
+}
+
+// acceleratorSynthesizer is responsible for synthesize Accelerator resources types for certain stack.
+type acceleratorSynthesizer struct {
+	trackingProvider   tracking.Provider
+	acceleratorManager AcceleratorManager
+	logger             logr.Logger
+
+	stack                        core.Stack
+	matchedResAndSDKAccelerators []resAndSDKAcceleratorPair
+	unmatchedResAccelerators     []*gamodel.Accelerator
+}
+
+func (s *acceleratorSynthesizer) Synthesize(ctx context.Context) error {
+	var resAccelerators []*gamodel.Accelerator
+	s.stack.ListResources(&resAccelerators)
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	sdkAccelerators, err := s.acceleratorManager.ListAccelerators(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKAccelerators, unmatchedResAccelerators, unmatchedSDKAccelerators, err := matchResAndSDKAccelerators(resAccelerators, sdkAccelerators,
+		s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For Accelerators, we delete unmatched ones during synthesize,
+	// since a LoadBalancer cannot be deleted while it's an endpoint of an Accelerator.
+	for _, sdkAccelerator := range unmatchedSDKAccelerators {
+		if err := s.acceleratorManager.Delete(ctx, sdkAccelerator); err != nil {
+			return err
+		}
+	}
+	// For Accelerators, we create and update matched ones during post synthesize,
+	// since the LoadBalancers are fulfilled by then.
+	s.matchedResAndSDKAccelerators = matchedResAndSDKAccelerators
+	s.unmatchedResAccelerators = unmatchedResAccelerators
+	return nil
+}
+
+func (s *acceleratorSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, resAccelerator := range s.unmatchedResAccelerators {
+		acceleratorStatus, err := s.acceleratorManager.Create(ctx, resAccelerator)
+		if err != nil {
+			return err
+		}
+		resAccelerator.SetStatus(acceleratorStatus)
+	}
+	for _, resAndSDKAccelerator := range s.matchedResAndSDKAccelerators {
+		acceleratorStatus, err := s.acceleratorManager.Update(ctx, resAndSDKAccelerator.resAccelerator, resAndSDKAccelerator.sdkAccelerator)
+		if err != nil {
+			return err
+		}
+		resAndSDKAccelerator.resAccelerator.SetStatus(acceleratorStatus)
+	}
+	return nil
+}
+
+type resAndSDKAcceleratorPair struct {
+	resAccelerator *gamodel.Accelerator
+	sdkAccelerator AcceleratorWithTags
+}
+
+func matchResAndSDKAccelerators(resAccelerators []*gamodel.Accelerator, sdkAccelerators []AcceleratorWithTags,
+	resourceIDTagKey string) ([]resAndSDKAcceleratorPair, []*gamodel.Accelerator, []AcceleratorWithTags, error) {
+	var matchedResAndSDKAccelerators []resAndSDKAcceleratorPair
+	var unmatchedResAccelerators []*gamodel.Accelerator
+	var unmatchedSDKAccelerators []AcceleratorWithTags
+
+	resAcceleratorsByID := make(map[string]*gamodel.Accelerator, len(resAccelerators))
+	for _, resAccelerator := range resAccelerators {
+		resAcceleratorsByID[resAccelerator.ID()] = resAccelerator
+	}
+	sdkAcceleratorsByID := make(map[string][]AcceleratorWithTags, len(sdkAccelerators))
+	for _, sdkAccelerator := range sdkAccelerators {
+		resourceID, ok := sdkAccelerator.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, nil, nil, errors.Errorf("unexpected accelerator with no resourceID: %v",
+				awssdk.StringValue(sdkAccelerator.Accelerator.AcceleratorArn))
+		}
+		sdkAcceleratorsByID[resourceID] = append(sdkAcceleratorsByID[resourceID], sdkAccelerator)
+	}
+
+	resAcceleratorIDs := sets.StringKeySet(resAcceleratorsByID)
+	sdkAcceleratorIDs := sets.StringKeySet(sdkAcceleratorsByID)
+	for _, resID := range resAcceleratorIDs.Intersection(sdkAcceleratorIDs).List() {
+		resAccelerator := resAcceleratorsByID[resID]
+		sdkAccelerators := sdkAcceleratorsByID[resID]
+		matchedResAndSDKAccelerators = append(matchedResAndSDKAccelerators, resAndSDKAcceleratorPair{
+			resAccelerator: resAccelerator,
+			sdkAccelerator: sdkAccelerators[0],
+		})
+		unmatchedSDKAccelerators = append(unmatchedSDKAccelerators, sdkAccelerators[1:]...)
+	}
+	for _, resID := range resAcceleratorIDs.Difference(sdkAcceleratorIDs).List() {
+		unmatchedResAccelerators = append(unmatchedResAccelerators, resAcceleratorsByID[resID])
+	}
+	for _, resID := range sdkAcceleratorIDs.Difference(resAcceleratorIDs).List() {
+		unmatchedSDKAccelerators = append(unmatchedSDKAccelerators, sdkAcceleratorsByID[resID]...)
+	}
+	return matchedResAndSDKAccelerators, unmatchedResAccelerators, unmatchedSDKAccelerators, nil
+}
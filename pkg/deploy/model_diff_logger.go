@@ -0,0 +1,135 @@
+package deploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// ModelDiffLogger logs the differences of the models of stacks between reconciles, so that the changes to AWS resources
+// can be traced back to the changes of the model from logs alone.
+type ModelDiffLogger struct {
+	logger logr.Logger
+
+	mutex            sync.Mutex
+	modelJSONByStack map[core.StackID]string
+}
+
+// NewModelDiffLogger constructs new ModelDiffLogger.
+func NewModelDiffLogger(logger logr.Logger) *ModelDiffLogger {
+	return &ModelDiffLogger{
+		logger:           logger,
+		modelJSONByStack: make(map[core.StackID]string),
+	}
+}
+
+// Log logs the diff between the model of stack from the previous reconcile and modelJSON, which is remembered for the next reconcile.
+// Nothing is logged for the first model of stack, nor when the model is unchanged.
+// A nil ModelDiffLogger doesn't log diffs.
+func (l *ModelDiffLogger) Log(stackID core.StackID, modelJSON string) {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	previousModelJSON, exists := l.modelJSONByStack[stackID]
+	l.modelJSONByStack[stackID] = modelJSON
+	l.mutex.Unlock()
+	if !exists || previousModelJSON == modelJSON {
+		return
+	}
+	diff, err := DiffModelJSON(previousModelJSON, modelJSON)
+	if err != nil {
+		l.logger.Error(err, "failed to diff model", "stackID", stackID.String())
+		return
+	}
+	if len(diff) == 0 {
+		return
+	}
+	l.logger.Info("model changed", "stackID", stackID.String(), "diff", diff)
+}
+
+// Forget forgets the model of stack, e.g. once the stack is deleted.
+// A nil ModelDiffLogger doesn't log diffs.
+func (l *ModelDiffLogger) Forget(stackID core.StackID) {
+	if l == nil {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	delete(l.modelJSONByStack, stackID)
+}
+
+// DiffModelJSON returns the differences between two models in JSON, one line per changed leaf value sorted by path:
+//   - "+ path: value" for added values
+//   - "- path: value" for removed values
+//   - "~ path: old -> new" for changed values
+//
+// paths are dot separated object keys and bracketed array indexes, e.g. `resources.AWS::EC2::SecurityGroup.ManagedLBSecurityGroup.spec.ingress[0].fromPort`.
+func DiffModelJSON(oldJSON string, newJSON string) ([]string, error) {
+	var oldModel, newModel interface{}
+	if err := json.Unmarshal([]byte(oldJSON), &oldModel); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(newJSON), &newModel); err != nil {
+		return nil, err
+	}
+	oldLeaves := make(map[string]string)
+	newLeaves := make(map[string]string)
+	flattenModelJSON("", oldModel, oldLeaves)
+	flattenModelJSON("", newModel, newLeaves)
+
+	var diff []string
+	for path, oldValue := range oldLeaves {
+		newValue, exists := newLeaves[path]
+		switch {
+		case !exists:
+			diff = append(diff, fmt.Sprintf("- %s: %s", path, oldValue))
+		case newValue != oldValue:
+			diff = append(diff, fmt.Sprintf("~ %s: %s -> %s", path, oldValue, newValue))
+		}
+	}
+	for path, newValue := range newLeaves {
+		if _, exists := oldLeaves[path]; !exists {
+			diff = append(diff, fmt.Sprintf("+ %s: %s", path, newValue))
+		}
+	}
+	// sorted by path regardless of the kind of change, so that the changes of a resource are logged together.
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i][2:] < diff[j][2:]
+	})
+	return diff, nil
+}
+
+// flattenModelJSON flattens the leaf values of value into leaves by path, the empty objects and arrays are kept as leaves.
+func flattenModelJSON(path string, value interface{}, leaves map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			leaves[path] = "{}"
+			return
+		}
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = strings.Join([]string{path, key}, ".")
+			}
+			flattenModelJSON(childPath, child, leaves)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			leaves[path] = "[]"
+			return
+		}
+		for i, child := range v {
+			flattenModelJSON(fmt.Sprintf("%s[%d]", path, i), child, leaves)
+		}
+	default:
+		payload, _ := json.Marshal(v)
+		leaves[path] = string(payload)
+	}
+}
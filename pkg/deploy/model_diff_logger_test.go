@@ -0,0 +1,41 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffModelJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldJSON string
+		newJSON string
+		want    []string
+	}{
+		{
+			name:    "unchanged",
+			oldJSON: `{"id":"namespace/name","resources":{"typeX":{"resA":{"spec":{"port":80}}}}}`,
+			newJSON: `{"id":"namespace/name","resources":{"typeX":{"resA":{"spec":{"port":80}}}}}`,
+			want:    nil,
+		},
+		{
+			name:    "changed, added and removed values",
+			oldJSON: `{"id":"namespace/name","resources":{"typeX":{"resA":{"spec":{"port":80,"tags":{"team":"a"}}},"resB":{"spec":{"cidrs":["10.0.0.0/8"]}}}}}`,
+			newJSON: `{"id":"namespace/name","resources":{"typeX":{"resA":{"spec":{"port":443,"tags":{}}},"resB":{"spec":{"cidrs":["10.0.0.0/8","192.168.0.0/16"]}}}}}`,
+			want: []string{
+				`~ resources.typeX.resA.spec.port: 80 -> 443`,
+				`- resources.typeX.resA.spec.tags.team: "a"`,
+				`+ resources.typeX.resA.spec.tags: {}`,
+				`+ resources.typeX.resB.spec.cidrs[1]: "192.168.0.0/16"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffModelJSON(tt.oldJSON, tt.newJSON)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
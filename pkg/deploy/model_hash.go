@@ -0,0 +1,61 @@
+package deploy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// modelHashLength is the number of hex characters of the model hash.
+	modelHashLength = 16
+
+	maskedARN           = `"<arn>"`
+	maskedResourceID    = `"<id>"`
+	maskedGeneratedName = `"<generated-name>"`
+)
+
+var (
+	arnPattern = regexp.MustCompile(`^"arn:[^"]*"$`)
+	// resourceIDPattern matches the IDs of the EC2 resources referenced by models, e.g. subnets, securityGroups and prefix lists.
+	resourceIDPattern = regexp.MustCompile(`^"(subnet|sg|vpc|eipalloc|pl|ipam-pool|vpce-svc|igw)-[0-9a-zA-Z]+"$`)
+	// generatedNamePattern matches the names of load balancers and target groups generated by the controller,
+	// whose hash suffix covers the cluster name.
+	generatedNamePattern = regexp.MustCompile(`^"k8s-[0-9a-z-]*-[0-9a-f]{10}"$`)
+)
+
+// HashModelJSON returns the hash of a model in JSON, which is identical for the same model across clusters.
+// the values specific to the cluster, AWS account or region are masked beforehand, i.e. ARNs, EC2 resource IDs and generated names,
+// so that the hash only differs if the configuration differs, e.g. one cluster lacks a WAF WebACL.
+func HashModelJSON(modelJSON string) (string, error) {
+	var model interface{}
+	if err := json.Unmarshal([]byte(modelJSON), &model); err != nil {
+		return "", err
+	}
+	leaves := make(map[string]string)
+	flattenModelJSON("", model, leaves)
+	lines := make([]string, 0, len(leaves))
+	for path, value := range leaves {
+		lines = append(lines, path+"="+maskModelJSONValue(value))
+	}
+	sort.Strings(lines)
+	checksum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(checksum[:])[:modelHashLength], nil
+}
+
+// maskModelJSONValue masks a leaf value of model in JSON if it's specific to the cluster, AWS account or region.
+func maskModelJSONValue(value string) string {
+	switch {
+	case arnPattern.MatchString(value):
+		return maskedARN
+	case resourceIDPattern.MatchString(value):
+		return maskedResourceID
+	case generatedNamePattern.MatchString(value):
+		return maskedGeneratedName
+	default:
+		return value
+	}
+}
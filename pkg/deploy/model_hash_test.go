@@ -0,0 +1,52 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashModelJSON(t *testing.T) {
+	clusterAModelJSON := `{"id":"ns/ing","resources":{"AWS::ElasticLoadBalancingV2::LoadBalancer":{"LoadBalancer":{"spec":{"name":"k8s-ns-ing-b7e914000d","subnetMapping":[{"subnetID":"subnet-0a1b2c3d"}],"securityGroups":["sg-0123abcd"]}}},"AWS::WAFv2::WebACLAssociation":{"LoadBalancer":{"spec":{"webACLARN":"arn:aws:wafv2:us-east-1:111111111111:regional/webacl/acl/1"}}}}}`
+	tests := []struct {
+		name      string
+		modelJSON string
+		wantEqual bool
+	}{
+		{
+			name:      "same model",
+			modelJSON: clusterAModelJSON,
+			wantEqual: true,
+		},
+		{
+			name:      "same model in another cluster, account and region",
+			modelJSON: `{"resources":{"AWS::WAFv2::WebACLAssociation":{"LoadBalancer":{"spec":{"webACLARN":"arn:aws:wafv2:eu-west-1:222222222222:regional/webacl/acl/2"}}},"AWS::ElasticLoadBalancingV2::LoadBalancer":{"LoadBalancer":{"spec":{"name":"k8s-ns-ing-0f0e0d0c0b","subnetMapping":[{"subnetID":"subnet-9f8e7d6c"}],"securityGroups":["sg-9876fedc"]}}}},"id":"ns/ing"}`,
+			wantEqual: true,
+		},
+		{
+			name:      "model without WAF WebACL",
+			modelJSON: `{"id":"ns/ing","resources":{"AWS::ElasticLoadBalancingV2::LoadBalancer":{"LoadBalancer":{"spec":{"name":"k8s-ns-ing-b7e914000d","subnetMapping":[{"subnetID":"subnet-0a1b2c3d"}],"securityGroups":["sg-0123abcd"]}}}}}`,
+			wantEqual: false,
+		},
+		{
+			name:      "model with explicit load balancer name",
+			modelJSON: `{"id":"ns/ing","resources":{"AWS::ElasticLoadBalancingV2::LoadBalancer":{"LoadBalancer":{"spec":{"name":"my-alb","subnetMapping":[{"subnetID":"subnet-0a1b2c3d"}],"securityGroups":["sg-0123abcd"]}}},"AWS::WAFv2::WebACLAssociation":{"LoadBalancer":{"spec":{"webACLARN":"arn:aws:wafv2:us-east-1:111111111111:regional/webacl/acl/1"}}}}}`,
+			wantEqual: false,
+		},
+	}
+	clusterAHash, err := HashModelJSON(clusterAModelJSON)
+	assert.NoError(t, err)
+	assert.Len(t, clusterAHash, 16)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := HashModelJSON(tt.modelJSON)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEqual, got == clusterAHash)
+		})
+	}
+}
+
+func TestHashModelJSON_invalidJSON(t *testing.T) {
+	_, err := HashModelJSON(`{"id":`)
+	assert.Error(t, err)
+}
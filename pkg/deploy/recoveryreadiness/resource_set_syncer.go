@@ -0,0 +1,242 @@
+package recoveryreadiness
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	arcsdk "github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	clusterNameTagKey = "elbv2.k8s.aws/cluster"
+
+	// resourceSetTypeLoadBalancer is the ARC resource set type of application and network load balancers.
+	resourceSetTypeLoadBalancer = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+	// resourceSetTypeTargetGroup is the ARC resource set type of target groups.
+	resourceSetTypeTargetGroup = "AWS::ElasticLoadBalancingV2::TargetGroup"
+	// targetGroupResourceSetNameSuffix suffixes the name of the resource set of target groups,
+	// as a resource set only contains resources of a single type.
+	targetGroupResourceSetNameSuffix = "-target-groups"
+)
+
+// ResourceSetSyncer keeps the members of Route 53 ARC resource sets in sync with the load balancers and target groups managed by this controller.
+type ResourceSetSyncer interface {
+	manager.Runnable
+	manager.LeaderElectionRunnable
+}
+
+// NewDefaultResourceSetSyncer constructs new defaultResourceSetSyncer.
+func NewDefaultResourceSetSyncer(cloud aws.Cloud, controllerConfig config.ControllerConfig, logger logr.Logger) *defaultResourceSetSyncer {
+	return &defaultResourceSetSyncer{
+		arcClient:           cloud.Route53RecoveryReadiness(),
+		elbv2TaggingManager: elbv2deploy.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), controllerConfig.FeatureGates, cloud.RGT(), controllerConfig.StrictTagEnforcement(), logger),
+		clusterName:         controllerConfig.ClusterName,
+		resourceSetName:     controllerConfig.RecoveryReadinessConfig.ResourceSet,
+		interval:            controllerConfig.RecoveryReadinessConfig.SyncInterval,
+		dryRun:              controllerConfig.DryRun || controllerConfig.ShadowMode,
+		logger:              logger,
+	}
+}
+
+var _ ResourceSetSyncer = &defaultResourceSetSyncer{}
+
+// default implementation for ResourceSetSyncer, which syncs the resource sets periodically.
+// the load balancers are synced into the resource set of resourceSetName, and the target groups are synced into
+// the resource set of resourceSetName suffixed by targetGroupResourceSetNameSuffix.
+// the resource sets and a readiness check of the same name for each are created when they don't exist.
+type defaultResourceSetSyncer struct {
+	arcClient           services.Route53RecoveryReadiness
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	clusterName         string
+	resourceSetName     string
+	interval            time.Duration
+	dryRun              bool
+	logger              logr.Logger
+}
+
+// Start syncs the resource sets periodically until ctx is done.
+func (s *defaultResourceSetSyncer) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, s.sync, s.interval)
+	return nil
+}
+
+// NeedLeaderElection returns true, as the resource sets must only be updated by the leader.
+func (s *defaultResourceSetSyncer) NeedLeaderElection() bool {
+	return true
+}
+
+func (s *defaultResourceSetSyncer) sync(ctx context.Context) {
+	if err := s.reconcile(ctx); err != nil {
+		s.logger.Error(err, "failed to sync recovery readiness resource set", "resourceSet", s.resourceSetName)
+	}
+}
+
+// reconcile registers the load balancers and target groups tagged with the cluster name into their resource sets,
+// and removes the others from them.
+func (s *defaultResourceSetSyncer) reconcile(ctx context.Context) error {
+	sdkLBs, err := s.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagFilter{clusterNameTagKey: {s.clusterName}})
+	if err != nil {
+		return err
+	}
+	desiredLBARNs := sets.NewString()
+	for _, sdkLB := range sdkLBs {
+		desiredLBARNs.Insert(awssdk.StringValue(sdkLB.LoadBalancer.LoadBalancerArn))
+	}
+	if err := s.reconcileResourceSet(ctx, s.resourceSetName, resourceSetTypeLoadBalancer, desiredLBARNs); err != nil {
+		return err
+	}
+
+	sdkTGs, err := s.elbv2TaggingManager.ListTargetGroups(ctx, tracking.TagFilter{clusterNameTagKey: {s.clusterName}})
+	if err != nil {
+		return err
+	}
+	desiredTGARNs := sets.NewString()
+	for _, sdkTG := range sdkTGs {
+		desiredTGARNs.Insert(awssdk.StringValue(sdkTG.TargetGroup.TargetGroupArn))
+	}
+	return s.reconcileResourceSet(ctx, s.resourceSetName+targetGroupResourceSetNameSuffix, resourceSetTypeTargetGroup, desiredTGARNs)
+}
+
+// reconcileResourceSet registers desiredARNs into the resource set of resourceSetName, and removes the others from it.
+func (s *defaultResourceSetSyncer) reconcileResourceSet(ctx context.Context, resourceSetName string, resourceSetType string, desiredARNs sets.String) error {
+	resourceSet, err := s.arcClient.GetResourceSetWithContext(ctx, &arcsdk.GetResourceSetInput{
+		ResourceSetName: awssdk.String(resourceSetName),
+	})
+	if err != nil && !isResourceNotFoundError(err) {
+		return err
+	}
+	if err != nil {
+		// the resource set cannot be created without members, it's created once there are resources.
+		if desiredARNs.Len() == 0 {
+			return nil
+		}
+		if err := s.createResourceSet(ctx, resourceSetName, resourceSetType, desiredARNs); err != nil {
+			return err
+		}
+	} else {
+		if err := s.updateResourceSet(ctx, resourceSetName, resourceSetType, resourceSet, desiredARNs); err != nil {
+			return err
+		}
+	}
+	return s.ensureReadinessCheck(ctx, resourceSetName)
+}
+
+func (s *defaultResourceSetSyncer) createResourceSet(ctx context.Context, resourceSetName string, resourceSetType string, desiredARNs sets.String) error {
+	if s.dryRun {
+		s.logger.Info("dry run planned to create recovery readiness resource set",
+			"resourceSet", resourceSetName, "members", desiredARNs.List())
+		return nil
+	}
+	s.logger.Info("creating recovery readiness resource set",
+		"resourceSet", resourceSetName, "members", desiredARNs.List())
+	if _, err := s.arcClient.CreateResourceSetWithContext(ctx, &arcsdk.CreateResourceSetInput{
+		ResourceSetName: awssdk.String(resourceSetName),
+		ResourceSetType: awssdk.String(resourceSetType),
+		Resources:       buildSDKResources(nil, desiredARNs),
+		Tags:            awssdk.StringMap(map[string]string{clusterNameTagKey: s.clusterName}),
+	}); err != nil {
+		return err
+	}
+	s.logger.Info("created recovery readiness resource set", "resourceSet", resourceSetName)
+	return nil
+}
+
+func (s *defaultResourceSetSyncer) updateResourceSet(ctx context.Context, resourceSetName string, resourceSetType string,
+	resourceSet *arcsdk.GetResourceSetOutput, desiredARNs sets.String) error {
+	if owner := awssdk.StringValue(resourceSet.Tags[clusterNameTagKey]); owner != s.clusterName {
+		return errors.Errorf("resource set %v isn't owned by cluster %v, expects tag %v: %v",
+			resourceSetName, s.clusterName, clusterNameTagKey, s.clusterName)
+	}
+	currentARNs := sets.NewString()
+	for _, resource := range resourceSet.Resources {
+		currentARNs.Insert(awssdk.StringValue(resource.ResourceArn))
+	}
+	if currentARNs.Equal(desiredARNs) {
+		return nil
+	}
+	membersToAdd := desiredARNs.Difference(currentARNs).List()
+	membersToRemove := currentARNs.Difference(desiredARNs).List()
+	if s.dryRun {
+		s.logger.Info("dry run planned to update recovery readiness resource set",
+			"resourceSet", resourceSetName, "membersToAdd", membersToAdd, "membersToRemove", membersToRemove)
+		return nil
+	}
+	s.logger.Info("updating recovery readiness resource set",
+		"resourceSet", resourceSetName, "membersToAdd", membersToAdd, "membersToRemove", membersToRemove)
+	if _, err := s.arcClient.UpdateResourceSetWithContext(ctx, &arcsdk.UpdateResourceSetInput{
+		ResourceSetName: awssdk.String(resourceSetName),
+		ResourceSetType: awssdk.String(resourceSetType),
+		Resources:       buildSDKResources(resourceSet.Resources, desiredARNs),
+	}); err != nil {
+		return err
+	}
+	s.logger.Info("updated recovery readiness resource set", "resourceSet", resourceSetName)
+	return nil
+}
+
+// ensureReadinessCheck creates the readiness check of the resource set if it doesn't exist.
+func (s *defaultResourceSetSyncer) ensureReadinessCheck(ctx context.Context, resourceSetName string) error {
+	_, err := s.arcClient.GetReadinessCheckWithContext(ctx, &arcsdk.GetReadinessCheckInput{
+		ReadinessCheckName: awssdk.String(resourceSetName),
+	})
+	if err == nil {
+		return nil
+	}
+	if !isResourceNotFoundError(err) {
+		return err
+	}
+	if s.dryRun {
+		s.logger.Info("dry run planned to create recovery readiness check", "readinessCheck", resourceSetName)
+		return nil
+	}
+	s.logger.Info("creating recovery readiness check", "readinessCheck", resourceSetName)
+	if _, err := s.arcClient.CreateReadinessCheckWithContext(ctx, &arcsdk.CreateReadinessCheckInput{
+		ReadinessCheckName: awssdk.String(resourceSetName),
+		ResourceSetName:    awssdk.String(resourceSetName),
+		Tags:               awssdk.StringMap(map[string]string{clusterNameTagKey: s.clusterName}),
+	}); err != nil {
+		return err
+	}
+	s.logger.Info("created recovery readiness check", "readinessCheck", resourceSetName)
+	return nil
+}
+
+// buildSDKResources builds the members of resource set for desiredARNs.
+// the current members are retained as is, so that their readiness scopes configured outside the controller are preserved.
+func buildSDKResources(currentResources []*arcsdk.Resource, desiredARNs sets.String) []*arcsdk.Resource {
+	resources := make([]*arcsdk.Resource, 0, desiredARNs.Len())
+	retainedARNs := sets.NewString()
+	for _, resource := range currentResources {
+		arn := awssdk.StringValue(resource.ResourceArn)
+		if desiredARNs.Has(arn) {
+			resources = append(resources, resource)
+			retainedARNs.Insert(arn)
+		}
+	}
+	for _, arn := range desiredARNs.Difference(retainedARNs).List() {
+		resources = append(resources, &arcsdk.Resource{
+			ResourceArn: awssdk.String(arn),
+		})
+	}
+	return resources
+}
+
+func isResourceNotFoundError(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code() == arcsdk.ErrCodeResourceNotFoundException
+	}
+	return false
+}
@@ -0,0 +1,313 @@
+package recoveryreadiness
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	arcsdk "github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultResourceSetSyncer_reconcileResourceSet(t *testing.T) {
+	notFoundErr := awserr.New(arcsdk.ErrCodeResourceNotFoundException, "not found", nil)
+	ownedTags := awssdk.StringMap(map[string]string{clusterNameTagKey: "my-cluster"})
+	type getResourceSetCall struct {
+		resp *arcsdk.GetResourceSetOutput
+		err  error
+	}
+	tests := []struct {
+		name                     string
+		resourceSetType          string
+		desiredARNs              []string
+		getResourceSetCall       getResourceSetCall
+		wantCreateResourceSet    *arcsdk.CreateResourceSetInput
+		wantUpdateResourceSet    *arcsdk.UpdateResourceSetInput
+		getReadinessCheckErr     error
+		wantGetReadinessCheck    bool
+		wantCreateReadinessCheck bool
+		dryRun                   bool
+		wantErr                  error
+	}{
+		{
+			name:            "resource set doesn't exist",
+			resourceSetType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			desiredARNs:     []string{"lb-2", "lb-1"},
+			getResourceSetCall: getResourceSetCall{
+				err: notFoundErr,
+			},
+			wantCreateResourceSet: &arcsdk.CreateResourceSetInput{
+				ResourceSetName: awssdk.String("my-set"),
+				ResourceSetType: awssdk.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+				Resources: []*arcsdk.Resource{
+					{ResourceArn: awssdk.String("lb-1")},
+					{ResourceArn: awssdk.String("lb-2")},
+				},
+				Tags: ownedTags,
+			},
+			getReadinessCheckErr:     notFoundErr,
+			wantGetReadinessCheck:    true,
+			wantCreateReadinessCheck: true,
+		},
+		{
+			name:            "resource set of target groups doesn't exist",
+			resourceSetType: "AWS::ElasticLoadBalancingV2::TargetGroup",
+			desiredARNs:     []string{"tg-1"},
+			getResourceSetCall: getResourceSetCall{
+				err: notFoundErr,
+			},
+			wantCreateResourceSet: &arcsdk.CreateResourceSetInput{
+				ResourceSetName: awssdk.String("my-set"),
+				ResourceSetType: awssdk.String("AWS::ElasticLoadBalancingV2::TargetGroup"),
+				Resources: []*arcsdk.Resource{
+					{ResourceArn: awssdk.String("tg-1")},
+				},
+				Tags: ownedTags,
+			},
+			getReadinessCheckErr:     notFoundErr,
+			wantGetReadinessCheck:    true,
+			wantCreateReadinessCheck: true,
+		},
+		{
+			name:            "resource set doesn't exist and there are no resources",
+			resourceSetType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			desiredARNs:     nil,
+			getResourceSetCall: getResourceSetCall{
+				err: notFoundErr,
+			},
+		},
+		{
+			name:            "resource set is outdated",
+			resourceSetType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			desiredARNs:     []string{"lb-1", "lb-3"},
+			getResourceSetCall: getResourceSetCall{
+				resp: &arcsdk.GetResourceSetOutput{
+					Resources: []*arcsdk.Resource{
+						{ResourceArn: awssdk.String("lb-1"), ReadinessScopes: awssdk.StringSlice([]string{"cell-1"})},
+						{ResourceArn: awssdk.String("lb-2")},
+					},
+					Tags: ownedTags,
+				},
+			},
+			wantUpdateResourceSet: &arcsdk.UpdateResourceSetInput{
+				ResourceSetName: awssdk.String("my-set"),
+				ResourceSetType: awssdk.String("AWS::ElasticLoadBalancingV2::LoadBalancer"),
+				Resources: []*arcsdk.Resource{
+					{ResourceArn: awssdk.String("lb-1"), ReadinessScopes: awssdk.StringSlice([]string{"cell-1"})},
+					{ResourceArn: awssdk.String("lb-3")},
+				},
+			},
+			wantGetReadinessCheck: true,
+		},
+		{
+			name:            "resource set is up to date",
+			resourceSetType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			desiredARNs:     []string{"lb-1"},
+			getResourceSetCall: getResourceSetCall{
+				resp: &arcsdk.GetResourceSetOutput{
+					Resources: []*arcsdk.Resource{
+						{ResourceArn: awssdk.String("lb-1")},
+					},
+					Tags: ownedTags,
+				},
+			},
+			wantGetReadinessCheck: true,
+		},
+		{
+			name:            "resource set is outdated in dry run",
+			resourceSetType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			desiredARNs:     []string{"lb-1", "lb-3"},
+			getResourceSetCall: getResourceSetCall{
+				resp: &arcsdk.GetResourceSetOutput{
+					Resources: []*arcsdk.Resource{
+						{ResourceArn: awssdk.String("lb-1")},
+					},
+					Tags: ownedTags,
+				},
+			},
+			getReadinessCheckErr:  notFoundErr,
+			wantGetReadinessCheck: true,
+			dryRun:                true,
+		},
+		{
+			name:            "resource set isn't owned by cluster",
+			resourceSetType: "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			desiredARNs:     []string{"lb-1"},
+			getResourceSetCall: getResourceSetCall{
+				resp: &arcsdk.GetResourceSetOutput{
+					Resources: []*arcsdk.Resource{
+						{ResourceArn: awssdk.String("lb-other")},
+					},
+					Tags: awssdk.StringMap(map[string]string{clusterNameTagKey: "other-cluster"}),
+				},
+			},
+			wantErr: errors.New("resource set my-set isn't owned by cluster my-cluster, expects tag elbv2.k8s.aws/cluster: my-cluster"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			arcClient := services.NewMockRoute53RecoveryReadiness(ctrl)
+			arcClient.EXPECT().GetResourceSetWithContext(gomock.Any(), &arcsdk.GetResourceSetInput{
+				ResourceSetName: awssdk.String("my-set"),
+			}).Return(tt.getResourceSetCall.resp, tt.getResourceSetCall.err)
+			if tt.wantCreateResourceSet != nil {
+				arcClient.EXPECT().CreateResourceSetWithContext(gomock.Any(), tt.wantCreateResourceSet).Return(&arcsdk.CreateResourceSetOutput{}, nil)
+			}
+			if tt.wantUpdateResourceSet != nil {
+				arcClient.EXPECT().UpdateResourceSetWithContext(gomock.Any(), tt.wantUpdateResourceSet).Return(&arcsdk.UpdateResourceSetOutput{}, nil)
+			}
+			if tt.wantGetReadinessCheck {
+				arcClient.EXPECT().GetReadinessCheckWithContext(gomock.Any(), &arcsdk.GetReadinessCheckInput{
+					ReadinessCheckName: awssdk.String("my-set"),
+				}).Return(&arcsdk.GetReadinessCheckOutput{}, tt.getReadinessCheckErr)
+			}
+			if tt.wantCreateReadinessCheck {
+				arcClient.EXPECT().CreateReadinessCheckWithContext(gomock.Any(), &arcsdk.CreateReadinessCheckInput{
+					ReadinessCheckName: awssdk.String("my-set"),
+					ResourceSetName:    awssdk.String("my-set"),
+					Tags:               ownedTags,
+				}).Return(&arcsdk.CreateReadinessCheckOutput{}, nil)
+			}
+
+			s := &defaultResourceSetSyncer{
+				arcClient:       arcClient,
+				clusterName:     "my-cluster",
+				resourceSetName: "my-set",
+				dryRun:          tt.dryRun,
+				logger:          log.Log,
+			}
+			err := s.reconcileResourceSet(context.Background(), "my-set", tt.resourceSetType, sets.NewString(tt.desiredARNs...))
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_defaultResourceSetSyncer_reconcile(t *testing.T) {
+	notFoundErr := awserr.New(arcsdk.ErrCodeResourceNotFoundException, "not found", nil)
+	ownedTags := awssdk.StringMap(map[string]string{clusterNameTagKey: "my-cluster"})
+	tests := []struct {
+		name                 string
+		lbARNs               []string
+		tgARNs               []string
+		listTargetGroupsErr  error
+		wantResourceSetTypes map[string]string
+		wantErr              error
+	}{
+		{
+			name:   "load balancers and target groups are synced into their resource sets",
+			lbARNs: []string{"lb-1"},
+			tgARNs: []string{"tg-1", "tg-2"},
+			wantResourceSetTypes: map[string]string{
+				"my-set":               "AWS::ElasticLoadBalancingV2::LoadBalancer",
+				"my-set-target-groups": "AWS::ElasticLoadBalancingV2::TargetGroup",
+			},
+		},
+		{
+			name:   "only target groups are synced into their resource set",
+			tgARNs: []string{"tg-1"},
+			wantResourceSetTypes: map[string]string{
+				"my-set-target-groups": "AWS::ElasticLoadBalancingV2::TargetGroup",
+			},
+		},
+		{
+			name:                "target groups cannot be listed",
+			lbARNs:              []string{"lb-1"},
+			listTargetGroupsErr: errors.New("some error"),
+			wantResourceSetTypes: map[string]string{
+				"my-set": "AWS::ElasticLoadBalancingV2::LoadBalancer",
+			},
+			wantErr: errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var sdkLBs []elbv2deploy.LoadBalancerWithTags
+			for _, lbARN := range tt.lbARNs {
+				sdkLBs = append(sdkLBs, elbv2deploy.LoadBalancerWithTags{
+					LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String(lbARN)},
+					Tags:         map[string]string{clusterNameTagKey: "my-cluster"},
+				})
+			}
+			var sdkTGs []elbv2deploy.TargetGroupWithTags
+			for _, tgARN := range tt.tgARNs {
+				sdkTGs = append(sdkTGs, elbv2deploy.TargetGroupWithTags{
+					TargetGroup: &elbv2types.TargetGroup{TargetGroupArn: awssdk.String(tgARN)},
+					Tags:        map[string]string{clusterNameTagKey: "my-cluster"},
+				})
+			}
+			elbv2TaggingManager := elbv2deploy.NewMockTaggingManager(ctrl)
+			elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), tracking.TagFilter{clusterNameTagKey: {"my-cluster"}}).Return(sdkLBs, nil)
+			elbv2TaggingManager.EXPECT().ListTargetGroups(gomock.Any(), tracking.TagFilter{clusterNameTagKey: {"my-cluster"}}).Return(sdkTGs, tt.listTargetGroupsErr)
+
+			arcClient := services.NewMockRoute53RecoveryReadiness(ctrl)
+			for _, resourceSetName := range []string{"my-set", "my-set-target-groups"} {
+				resourceSetType, wantResourceSet := tt.wantResourceSetTypes[resourceSetName]
+				if resourceSetName == "my-set-target-groups" && tt.listTargetGroupsErr != nil {
+					continue
+				}
+				arcClient.EXPECT().GetResourceSetWithContext(gomock.Any(), &arcsdk.GetResourceSetInput{
+					ResourceSetName: awssdk.String(resourceSetName),
+				}).Return(nil, notFoundErr)
+				if !wantResourceSet {
+					continue
+				}
+				memberARNs := tt.lbARNs
+				if resourceSetName == "my-set-target-groups" {
+					memberARNs = tt.tgARNs
+				}
+				var resources []*arcsdk.Resource
+				for _, memberARN := range memberARNs {
+					resources = append(resources, &arcsdk.Resource{ResourceArn: awssdk.String(memberARN)})
+				}
+				arcClient.EXPECT().CreateResourceSetWithContext(gomock.Any(), &arcsdk.CreateResourceSetInput{
+					ResourceSetName: awssdk.String(resourceSetName),
+					ResourceSetType: awssdk.String(resourceSetType),
+					Resources:       resources,
+					Tags:            ownedTags,
+				}).Return(&arcsdk.CreateResourceSetOutput{}, nil)
+				arcClient.EXPECT().GetReadinessCheckWithContext(gomock.Any(), &arcsdk.GetReadinessCheckInput{
+					ReadinessCheckName: awssdk.String(resourceSetName),
+				}).Return(nil, notFoundErr)
+				arcClient.EXPECT().CreateReadinessCheckWithContext(gomock.Any(), &arcsdk.CreateReadinessCheckInput{
+					ReadinessCheckName: awssdk.String(resourceSetName),
+					ResourceSetName:    awssdk.String(resourceSetName),
+					Tags:               ownedTags,
+				}).Return(&arcsdk.CreateReadinessCheckOutput{}, nil)
+			}
+
+			s := &defaultResourceSetSyncer{
+				arcClient:           arcClient,
+				elbv2TaggingManager: elbv2TaggingManager,
+				clusterName:         "my-cluster",
+				resourceSetName:     "my-set",
+				logger:              log.Log,
+			}
+			err := s.reconcile(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package deploy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// deployResumption resumes a deploy of stack from the stages completed by its last failed deploy.
+type deployResumption struct {
+	stack core.Stack
+	// previousStages is the stages completed by the last failed deploy of stack.
+	previousStages map[DeployStage]stageProgress
+	// progress is the stages completed by this deploy, which is stored once this deploy fails.
+	progress stackProgress
+	// maxAge is how long a stage can be resumed since it was last synthesized.
+	maxAge time.Duration
+}
+
+// newDeployResumption constructs new deployResumption from the progress of the last failed deploy of stack.
+// stages synthesized longer than maxAge ago are synthesized again, so that out-of-band changes are corrected even if later stages keep failing.
+func newDeployResumption(stack core.Stack, previousProgress stackProgress, maxAge time.Duration) *deployResumption {
+	previousStages := make(map[DeployStage]stageProgress, len(previousProgress.Stages))
+	for _, stage := range previousProgress.Stages {
+		previousStages[stage.Stage] = stage
+	}
+	return &deployResumption{
+		stack:          stack,
+		previousStages: previousStages,
+		maxAge:         maxAge,
+	}
+}
+
+// newResumableStageSynthesizer constructs new resumableStageSynthesizer, the synthesizer is returned as is if resumption is nil.
+func newResumableStageSynthesizer(synthesizer ResourceSynthesizer, stage DeployStage, resumption *deployResumption, logger logr.Logger) ResourceSynthesizer {
+	if resumption == nil {
+		return synthesizer
+	}
+	return &resumableStageSynthesizer{
+		synthesizer: synthesizer,
+		stage:       stage,
+		resumption:  resumption,
+		logger:      logger,
+	}
+}
+
+var _ ResourceSynthesizer = &resumableStageSynthesizer{}
+
+// resumableStageSynthesizer skips the Synthesize of a stage that's completed by the last failed deploy of stack,
+// if nothing it depends on changed since, i.e. the model and the status of resources synthesized before the stage,
+// and the stage was synthesized within the max age of resumption.
+// the status of the stage resources is restored from the progress of the last failed deploy instead.
+// only stages without PostSynthesize work can be resumed, as the unneeded resources they found would never be deleted otherwise.
+type resumableStageSynthesizer struct {
+	synthesizer ResourceSynthesizer
+	stage       DeployStage
+	resumption  *deployResumption
+	logger      logr.Logger
+}
+
+func (s *resumableStageSynthesizer) Synthesize(ctx context.Context) error {
+	stack := s.resumption.stack
+	checksum, err := computeStackChecksum(stack)
+	if err != nil {
+		return err
+	}
+	if previousStage, ok := s.resumption.previousStages[s.stage]; ok && previousStage.Checksum == checksum {
+		if time.Since(previousStage.SynthesizedAt) > s.resumption.maxAge {
+			s.logger.V(1).Info("deploy stage progress expired", "stackID", stack.StackID().String(), "stage", s.stage,
+				"synthesizedAt", previousStage.SynthesizedAt)
+		} else if err := restoreStageStatuses(stack, s.stage, previousStage.Statuses); err != nil {
+			s.logger.Error(err, "unable to resume deploy stage", "stackID", stack.StackID().String(), "stage", s.stage)
+		} else {
+			s.logger.Info("resumed deploy stage", "stackID", stack.StackID().String(), "stage", s.stage)
+			s.resumption.progress.Stages = append(s.resumption.progress.Stages, previousStage)
+			return nil
+		}
+	}
+	if err := s.synthesizer.Synthesize(ctx); err != nil {
+		return err
+	}
+	statuses, err := captureStageStatuses(stack, s.stage)
+	if err != nil {
+		return err
+	}
+	s.resumption.progress.Stages = append(s.resumption.progress.Stages, stageProgress{
+		Stage:         s.stage,
+		Checksum:      checksum,
+		Statuses:      statuses,
+		SynthesizedAt: time.Now(),
+	})
+	return nil
+}
+
+func (s *resumableStageSynthesizer) PostSynthesize(ctx context.Context) error {
+	return s.synthesizer.PostSynthesize(ctx)
+}
+
+// computeStackChecksum computes the checksum of stack, including the status of resources synthesized so far.
+func computeStackChecksum(stack core.Stack) (string, error) {
+	stackJSON, err := NewDefaultStackMarshaller().Marshal(stack)
+	if err != nil {
+		return "", err
+	}
+	checksum := sha256.Sum256([]byte(stackJSON))
+	return hex.EncodeToString(checksum[:]), nil
+}
+
+// captureStageStatuses returns the status of the resources synthesized by stage, by resource ID.
+func captureStageStatuses(stack core.Stack, stage DeployStage) (map[string]json.RawMessage, error) {
+	statuses := make(map[string]json.RawMessage)
+	captureStatus := func(resID string, status interface{}) error {
+		payload, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+		statuses[resID] = payload
+		return nil
+	}
+	switch stage {
+	case DeployStageLoadBalancer:
+		var resLBs []*elbv2model.LoadBalancer
+		if err := stack.ListResources(&resLBs); err != nil {
+			return nil, err
+		}
+		for _, resLB := range resLBs {
+			if err := captureStatus(resLB.ID(), resLB.Status); err != nil {
+				return nil, err
+			}
+		}
+	case DeployStageListener:
+		var resLSs []*elbv2model.Listener
+		if err := stack.ListResources(&resLSs); err != nil {
+			return nil, err
+		}
+		for _, resLS := range resLSs {
+			if err := captureStatus(resLS.ID(), resLS.Status); err != nil {
+				return nil, err
+			}
+		}
+	case DeployStageListenerRule:
+		var resLRs []*elbv2model.ListenerRule
+		if err := stack.ListResources(&resLRs); err != nil {
+			return nil, err
+		}
+		for _, resLR := range resLRs {
+			if err := captureStatus(resLR.ID(), resLR.Status); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, errors.Errorf("deploy stage %v cannot be resumed", stage)
+	}
+	return statuses, nil
+}
+
+// restoreStageStatuses restores the status of the resources synthesized by stage, by resource ID.
+// it fails without restoring any status if the status of any resource is missing.
+func restoreStageStatuses(stack core.Stack, stage DeployStage, statuses map[string]json.RawMessage) error {
+	var restoreFuncs []func()
+	decodeStatus := func(resID string, status interface{}) error {
+		payload, ok := statuses[resID]
+		if !ok || string(payload) == "null" {
+			return errors.Errorf("missing status of resource: %v", resID)
+		}
+		return json.Unmarshal(payload, status)
+	}
+	switch stage {
+	case DeployStageLoadBalancer:
+		var resLBs []*elbv2model.LoadBalancer
+		if err := stack.ListResources(&resLBs); err != nil {
+			return err
+		}
+		for _, resLB := range resLBs {
+			resLB := resLB
+			var status elbv2model.LoadBalancerStatus
+			if err := decodeStatus(resLB.ID(), &status); err != nil {
+				return err
+			}
+			restoreFuncs = append(restoreFuncs, func() { resLB.SetStatus(status) })
+		}
+	case DeployStageListener:
+		var resLSs []*elbv2model.Listener
+		if err := stack.ListResources(&resLSs); err != nil {
+			return err
+		}
+		for _, resLS := range resLSs {
+			resLS := resLS
+			var status elbv2model.ListenerStatus
+			if err := decodeStatus(resLS.ID(), &status); err != nil {
+				return err
+			}
+			restoreFuncs = append(restoreFuncs, func() { resLS.SetStatus(status) })
+		}
+	case DeployStageListenerRule:
+		var resLRs []*elbv2model.ListenerRule
+		if err := stack.ListResources(&resLRs); err != nil {
+			return err
+		}
+		for _, resLR := range resLRs {
+			resLR := resLR
+			var status elbv2model.ListenerRuleStatus
+			if err := decodeStatus(resLR.ID(), &status); err != nil {
+				return err
+			}
+			restoreFuncs = append(restoreFuncs, func() { resLR.SetStatus(status) })
+		}
+	default:
+		return errors.Errorf("deploy stage %v cannot be resumed", stage)
+	}
+	for _, restore := range restoreFuncs {
+		restore()
+	}
+	return nil
+}
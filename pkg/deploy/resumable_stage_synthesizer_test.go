@@ -0,0 +1,125 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// funcSynthesizer synthesizes via synthesize, and counts the invocations.
+type funcSynthesizer struct {
+	synthesize      func(ctx context.Context) error
+	synthesizeCalls int
+}
+
+func (s *funcSynthesizer) Synthesize(ctx context.Context) error {
+	s.synthesizeCalls++
+	return s.synthesize(ctx)
+}
+
+func (s *funcSynthesizer) PostSynthesize(_ context.Context) error {
+	return nil
+}
+
+// resumableTestStack is a stack with a LoadBalancer and a Listener on it.
+type resumableTestStack struct {
+	stack core.Stack
+	lb    *elbv2model.LoadBalancer
+	ls    *elbv2model.Listener
+}
+
+func buildResumableTestStack(lbName string) resumableTestStack {
+	stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "ing-1"})
+	lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{Name: lbName})
+	ls := elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{LoadBalancerARN: lb.LoadBalancerARN(), Port: 80})
+	return resumableTestStack{stack: stack, lb: lb, ls: ls}
+}
+
+// deploy deploys the stack with the LoadBalancer and Listener stages, the Listener stage fails if lsErr is specified.
+func (s resumableTestStack) deploy(resumption *deployResumption, lsErr error) (*funcSynthesizer, *funcSynthesizer, error) {
+	lbSynthesizer := &funcSynthesizer{synthesize: func(_ context.Context) error {
+		s.lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "lb-arn", DNSName: "lb-dns"})
+		return nil
+	}}
+	lsSynthesizer := &funcSynthesizer{synthesize: func(ctx context.Context) error {
+		if lsErr != nil {
+			return lsErr
+		}
+		lbARN, err := s.ls.Spec.LoadBalancerARN.Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		s.ls.SetStatus(elbv2model.ListenerStatus{ListenerARN: lbARN + "/ls-80"})
+		return nil
+	}}
+	logger := logr.New(&log.NullLogSink{})
+	err := runSynthesizers(context.Background(), []ResourceSynthesizer{
+		newResumableStageSynthesizer(lbSynthesizer, DeployStageLoadBalancer, resumption, logger),
+		newResumableStageSynthesizer(lsSynthesizer, DeployStageListener, resumption, logger),
+	})
+	return lbSynthesizer, lsSynthesizer, err
+}
+
+func Test_resumableStageSynthesizer(t *testing.T) {
+	failedStack := buildResumableTestStack("my-lb")
+	failedResumption := newDeployResumption(failedStack.stack, stackProgress{}, time.Hour)
+	_, _, err := failedStack.deploy(failedResumption, errors.New("listener throttled"))
+	assert.EqualError(t, err, "listener throttled")
+	assert.Len(t, failedResumption.progress.Stages, 1)
+	assert.Equal(t, DeployStageLoadBalancer, failedResumption.progress.Stages[0].Stage)
+	failedProgress := failedResumption.progress
+
+	t.Run("unchanged stack resumes completed stages", func(t *testing.T) {
+		stack := buildResumableTestStack("my-lb")
+		resumption := newDeployResumption(stack.stack, failedProgress, time.Hour)
+		lbSynthesizer, lsSynthesizer, err := stack.deploy(resumption, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, lbSynthesizer.synthesizeCalls)
+		assert.Equal(t, 1, lsSynthesizer.synthesizeCalls)
+		assert.Equal(t, &elbv2model.LoadBalancerStatus{LoadBalancerARN: "lb-arn", DNSName: "lb-dns"}, stack.lb.Status)
+		assert.Equal(t, &elbv2model.ListenerStatus{ListenerARN: "lb-arn/ls-80"}, stack.ls.Status)
+		assert.Len(t, resumption.progress.Stages, 2)
+	})
+	t.Run("changed stack synthesizes all stages", func(t *testing.T) {
+		stack := buildResumableTestStack("my-renamed-lb")
+		resumption := newDeployResumption(stack.stack, failedProgress, time.Hour)
+		lbSynthesizer, lsSynthesizer, err := stack.deploy(resumption, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, lbSynthesizer.synthesizeCalls)
+		assert.Equal(t, 1, lsSynthesizer.synthesizeCalls)
+	})
+	t.Run("stage synthesized beyond max age is synthesized again", func(t *testing.T) {
+		stack := buildResumableTestStack("my-lb")
+		expiredProgress := stackProgress{Stages: append([]stageProgress(nil), failedProgress.Stages...)}
+		expiredProgress.Stages[0].SynthesizedAt = time.Now().Add(-2 * time.Hour)
+		resumption := newDeployResumption(stack.stack, expiredProgress, time.Hour)
+		lbSynthesizer, _, err := stack.deploy(resumption, errors.New("listener throttled"))
+		assert.EqualError(t, err, "listener throttled")
+		assert.Equal(t, 1, lbSynthesizer.synthesizeCalls)
+		assert.Len(t, resumption.progress.Stages, 1)
+		assert.True(t, resumption.progress.Stages[0].SynthesizedAt.After(expiredProgress.Stages[0].SynthesizedAt))
+	})
+	t.Run("stage with missing status is synthesized", func(t *testing.T) {
+		stack := buildResumableTestStack("my-lb")
+		incompleteProgress := stackProgress{Stages: []stageProgress{
+			{Stage: DeployStageLoadBalancer, Checksum: failedProgress.Stages[0].Checksum, SynthesizedAt: time.Now()},
+		}}
+		resumption := newDeployResumption(stack.stack, incompleteProgress, time.Hour)
+		lbSynthesizer, _, err := stack.deploy(resumption, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, lbSynthesizer.synthesizeCalls)
+	})
+}
+
+func Test_newResumableStageSynthesizer_withoutResumption(t *testing.T) {
+	synthesizer := &funcSynthesizer{}
+	got := newResumableStageSynthesizer(synthesizer, DeployStageListener, nil, logr.New(&log.NullLogSink{}))
+	assert.Equal(t, synthesizer, got)
+}
@@ -0,0 +1,377 @@
+package route53
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	route53sdk "github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	route53model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/route53"
+)
+
+const (
+	// ownershipRecordNamePrefix is the prefix of the TXT records that record the ownership of DNS names, since
+	// TXT records of the DNS names themselves may be in use already.
+	ownershipRecordNamePrefix = "_aws-lbc-owner."
+	// ownershipHeritage identifies the ownership records of this controller.
+	ownershipHeritage  = "heritage=aws-load-balancer-controller"
+	ownershipRecordTTL = 300
+)
+
+// RecordSetWithOwnership represents the Route 53 records of a DNS name owned by controller.
+type RecordSetWithOwnership struct {
+	// The ID of the hosted zone the records are in.
+	HostedZoneID string
+	// The DNS name of the records, in lower case without trailing dot.
+	Name string
+	// The tags of the stack owning the DNS name, recorded in OwnershipRecord.
+	Owner map[string]string
+	// The TXT record that records the ownership of the DNS name.
+	OwnershipRecord *route53types.ResourceRecordSet
+	// The alias records of the DNS name.
+	AliasRecords []*route53types.ResourceRecordSet
+}
+
+// RecordSetManager is responsible for create/update/delete Route 53 alias records managed by controller.
+// records can't be tagged, thus the ownership of a DNS name is recorded with a TXT record created and deleted together with the alias records.
+type RecordSetManager interface {
+	// ResolveHostedZoneID returns the ID of the configured hosted zone the DNS name belongs to, or empty if there is none.
+	ResolveHostedZoneID(ctx context.Context, name string) (string, error)
+
+	Create(ctx context.Context, resRecordSet *route53model.RecordSet, hostedZoneID string) (route53model.RecordSetStatus, error)
+
+	Update(ctx context.Context, resRecordSet *route53model.RecordSet, sdkRecordSet RecordSetWithOwnership) (route53model.RecordSetStatus, error)
+
+	Delete(ctx context.Context, sdkRecordSet RecordSetWithOwnership) error
+
+	// ListRecordSets returns the record sets in the configured hosted zones whose owner matches tagFilter.
+	ListRecordSets(ctx context.Context, tagFilter tracking.TagFilter) ([]RecordSetWithOwnership, error)
+}
+
+// NewDefaultRecordSetManager constructs new defaultRecordSetManager.
+func NewDefaultRecordSetManager(route53Client services.Route53, trackingProvider tracking.Provider,
+	hostedZoneIDs []string, logger logr.Logger) *defaultRecordSetManager {
+	return &defaultRecordSetManager{
+		route53Client:      route53Client,
+		trackingProvider:   trackingProvider,
+		hostedZoneIDs:      hostedZoneIDs,
+		logger:             logger,
+		hostedZoneNameByID: make(map[string]string),
+	}
+}
+
+var _ RecordSetManager = &defaultRecordSetManager{}
+
+// default implementation for RecordSetManager
+type defaultRecordSetManager struct {
+	route53Client    services.Route53
+	trackingProvider tracking.Provider
+	hostedZoneIDs    []string
+
+	logger logr.Logger
+
+	// the names of hosted zones never change, thus they're cached.
+	hostedZoneNameByID      map[string]string
+	hostedZoneNameByIDMutex sync.Mutex
+}
+
+func (m *defaultRecordSetManager) ResolveHostedZoneID(ctx context.Context, name string) (string, error) {
+	var chosenHostedZoneID, chosenHostedZoneName string
+	for _, hostedZoneID := range m.hostedZoneIDs {
+		hostedZoneName, err := m.fetchHostedZoneName(ctx, hostedZoneID)
+		if err != nil {
+			return "", err
+		}
+		if name != hostedZoneName && !strings.HasSuffix(name, "."+hostedZoneName) {
+			continue
+		}
+		if len(hostedZoneName) > len(chosenHostedZoneName) {
+			chosenHostedZoneID = hostedZoneID
+			chosenHostedZoneName = hostedZoneName
+		}
+	}
+	return chosenHostedZoneID, nil
+}
+
+func (m *defaultRecordSetManager) Create(ctx context.Context, resRecordSet *route53model.RecordSet, hostedZoneID string) (route53model.RecordSetStatus, error) {
+	aliasRecords, err := buildSDKAliasRecords(ctx, resRecordSet)
+	if err != nil {
+		return route53model.RecordSetStatus{}, err
+	}
+	owner := m.trackingProvider.StackTags(resRecordSet.Stack())
+	// records are created rather than upserted, so that the change fails if the DNS name is in use already.
+	changes := []route53types.Change{
+		{
+			Action:            route53types.ChangeActionCreate,
+			ResourceRecordSet: buildSDKOwnershipRecord(resRecordSet.Spec.Name, owner),
+		},
+	}
+	for _, aliasRecord := range aliasRecords {
+		changes = append(changes, route53types.Change{
+			Action:            route53types.ChangeActionCreate,
+			ResourceRecordSet: aliasRecord,
+		})
+	}
+	m.logger.Info("creating record set",
+		"stackID", resRecordSet.Stack().StackID(),
+		"resourceID", resRecordSet.ID(),
+		"hostedZoneID", hostedZoneID)
+	if err := m.changeRecords(ctx, hostedZoneID, changes); err != nil {
+		return route53model.RecordSetStatus{}, errors.Wrapf(err, "failed to create records for %v, records not managed by controller may exist", resRecordSet.Spec.Name)
+	}
+	m.logger.Info("created record set",
+		"stackID", resRecordSet.Stack().StackID(),
+		"resourceID", resRecordSet.ID(),
+		"hostedZoneID", hostedZoneID)
+	audit.RecordMutation(ctx, audit.ActionCreate, "recordSet", resRecordSet.Spec.Name, fmt.Sprintf("in hosted zone %v", hostedZoneID))
+	return route53model.RecordSetStatus{HostedZoneID: hostedZoneID}, nil
+}
+
+func (m *defaultRecordSetManager) Update(ctx context.Context, resRecordSet *route53model.RecordSet, sdkRecordSet RecordSetWithOwnership) (route53model.RecordSetStatus, error) {
+	desiredAliasRecords, err := buildSDKAliasRecords(ctx, resRecordSet)
+	if err != nil {
+		return route53model.RecordSetStatus{}, err
+	}
+	currentAliasRecordByType := make(map[string]*route53types.ResourceRecordSet, len(sdkRecordSet.AliasRecords))
+	for _, aliasRecord := range sdkRecordSet.AliasRecords {
+		currentAliasRecordByType[string(aliasRecord.Type)] = aliasRecord
+	}
+	var changes []route53types.Change
+	for _, desiredAliasRecord := range desiredAliasRecords {
+		recordType := string(desiredAliasRecord.Type)
+		currentAliasRecord, exists := currentAliasRecordByType[recordType]
+		delete(currentAliasRecordByType, recordType)
+		switch {
+		case !exists:
+			changes = append(changes, route53types.Change{
+				Action:            route53types.ChangeActionCreate,
+				ResourceRecordSet: desiredAliasRecord,
+			})
+		case !isSDKAliasRecordUpToDate(desiredAliasRecord, currentAliasRecord):
+			changes = append(changes, route53types.Change{
+				Action:            route53types.ChangeActionUpsert,
+				ResourceRecordSet: desiredAliasRecord,
+			})
+		}
+	}
+	for _, recordType := range sortedRecordTypes(currentAliasRecordByType) {
+		changes = append(changes, route53types.Change{
+			Action:            route53types.ChangeActionDelete,
+			ResourceRecordSet: currentAliasRecordByType[recordType],
+		})
+	}
+	status := route53model.RecordSetStatus{HostedZoneID: sdkRecordSet.HostedZoneID}
+	if len(changes) == 0 {
+		return status, nil
+	}
+	m.logger.Info("modifying record set",
+		"stackID", resRecordSet.Stack().StackID(),
+		"resourceID", resRecordSet.ID(),
+		"hostedZoneID", sdkRecordSet.HostedZoneID)
+	if err := m.changeRecords(ctx, sdkRecordSet.HostedZoneID, changes); err != nil {
+		return route53model.RecordSetStatus{}, errors.Wrapf(err, "failed to modify records for %v", resRecordSet.Spec.Name)
+	}
+	m.logger.Info("modified record set",
+		"stackID", resRecordSet.Stack().StackID(),
+		"resourceID", resRecordSet.ID(),
+		"hostedZoneID", sdkRecordSet.HostedZoneID)
+	audit.RecordMutation(ctx, audit.ActionModify, "recordSet", resRecordSet.Spec.Name, "updated alias records")
+	return status, nil
+}
+
+func (m *defaultRecordSetManager) Delete(ctx context.Context, sdkRecordSet RecordSetWithOwnership) error {
+	// the ownership record is deleted together with the alias records, so that no alias records are left behind unowned.
+	changes := make([]route53types.Change, 0, len(sdkRecordSet.AliasRecords)+1)
+	for _, aliasRecord := range sdkRecordSet.AliasRecords {
+		changes = append(changes, route53types.Change{
+			Action:            route53types.ChangeActionDelete,
+			ResourceRecordSet: aliasRecord,
+		})
+	}
+	changes = append(changes, route53types.Change{
+		Action:            route53types.ChangeActionDelete,
+		ResourceRecordSet: sdkRecordSet.OwnershipRecord,
+	})
+	m.logger.Info("deleting record set",
+		"name", sdkRecordSet.Name,
+		"hostedZoneID", sdkRecordSet.HostedZoneID)
+	if err := m.changeRecords(ctx, sdkRecordSet.HostedZoneID, changes); err != nil {
+		return errors.Wrapf(err, "failed to delete records for %v", sdkRecordSet.Name)
+	}
+	m.logger.Info("deleted record set",
+		"name", sdkRecordSet.Name,
+		"hostedZoneID", sdkRecordSet.HostedZoneID)
+	audit.RecordMutation(ctx, audit.ActionDelete, "recordSet", sdkRecordSet.Name, fmt.Sprintf("in hosted zone %v", sdkRecordSet.HostedZoneID))
+	return nil
+}
+
+func (m *defaultRecordSetManager) ListRecordSets(ctx context.Context, tagFilter tracking.TagFilter) ([]RecordSetWithOwnership, error) {
+	var recordSets []RecordSetWithOwnership
+	for _, hostedZoneID := range m.hostedZoneIDs {
+		sdkRecords, err := m.route53Client.ListResourceRecordSetsAsList(ctx, &route53sdk.ListResourceRecordSetsInput{
+			HostedZoneId: awssdk.String(hostedZoneID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		aliasRecordsByName := make(map[string][]*route53types.ResourceRecordSet)
+		var ownedRecordSets []RecordSetWithOwnership
+		for i := range sdkRecords {
+			sdkRecord := &sdkRecords[i]
+			name := normalizeDNSName(awssdk.ToString(sdkRecord.Name))
+			switch sdkRecord.Type {
+			case route53types.RRTypeA, route53types.RRTypeAaaa:
+				if sdkRecord.AliasTarget != nil {
+					aliasRecordsByName[name] = append(aliasRecordsByName[name], sdkRecord)
+				}
+			case route53types.RRTypeTxt:
+				if !strings.HasPrefix(name, ownershipRecordNamePrefix) {
+					continue
+				}
+				owner, ok := parseSDKOwnershipRecord(sdkRecord)
+				if !ok || !tagFilter.Matches(owner) {
+					continue
+				}
+				ownedRecordSets = append(ownedRecordSets, RecordSetWithOwnership{
+					HostedZoneID:    hostedZoneID,
+					Name:            strings.TrimPrefix(name, ownershipRecordNamePrefix),
+					Owner:           owner,
+					OwnershipRecord: sdkRecord,
+				})
+			}
+		}
+		for _, recordSet := range ownedRecordSets {
+			recordSet.AliasRecords = aliasRecordsByName[recordSet.Name]
+			recordSets = append(recordSets, recordSet)
+		}
+	}
+	return recordSets, nil
+}
+
+func (m *defaultRecordSetManager) changeRecords(ctx context.Context, hostedZoneID string, changes []route53types.Change) error {
+	_, err := m.route53Client.ChangeResourceRecordSetsWithContext(ctx, &route53sdk.ChangeResourceRecordSetsInput{
+		HostedZoneId: awssdk.String(hostedZoneID),
+		ChangeBatch: &route53types.ChangeBatch{
+			Changes: changes,
+		},
+	})
+	return err
+}
+
+func (m *defaultRecordSetManager) fetchHostedZoneName(ctx context.Context, hostedZoneID string) (string, error) {
+	m.hostedZoneNameByIDMutex.Lock()
+	defer m.hostedZoneNameByIDMutex.Unlock()
+	if hostedZoneName, ok := m.hostedZoneNameByID[hostedZoneID]; ok {
+		return hostedZoneName, nil
+	}
+	resp, err := m.route53Client.GetHostedZoneWithContext(ctx, &route53sdk.GetHostedZoneInput{
+		Id: awssdk.String(hostedZoneID),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get hosted zone %v", hostedZoneID)
+	}
+	hostedZoneName := normalizeDNSName(awssdk.ToString(resp.HostedZone.Name))
+	m.hostedZoneNameByID[hostedZoneID] = hostedZoneName
+	return hostedZoneName, nil
+}
+
+// buildSDKAliasRecords builds the alias records of resRecordSet, one per record type.
+func buildSDKAliasRecords(ctx context.Context, resRecordSet *route53model.RecordSet) ([]*route53types.ResourceRecordSet, error) {
+	targetDNSName, err := resRecordSet.Spec.AliasTarget.DNSName.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targetHostedZoneID, err := resRecordSet.Spec.AliasTarget.HostedZoneID.Resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	aliasRecords := make([]*route53types.ResourceRecordSet, 0, len(resRecordSet.Spec.Types))
+	for _, recordType := range resRecordSet.Spec.Types {
+		aliasRecords = append(aliasRecords, &route53types.ResourceRecordSet{
+			Name: awssdk.String(resRecordSet.Spec.Name),
+			Type: route53types.RRType(recordType),
+			AliasTarget: &route53types.AliasTarget{
+				DNSName:              awssdk.String(targetDNSName),
+				HostedZoneId:         awssdk.String(targetHostedZoneID),
+				EvaluateTargetHealth: true,
+			},
+		})
+	}
+	return aliasRecords, nil
+}
+
+// buildSDKOwnershipRecord builds the TXT record that records the ownership of a DNS name by owner.
+func buildSDKOwnershipRecord(name string, owner map[string]string) *route53types.ResourceRecordSet {
+	ownerKeys := make([]string, 0, len(owner))
+	for key := range owner {
+		ownerKeys = append(ownerKeys, key)
+	}
+	sort.Strings(ownerKeys)
+	fields := []string{ownershipHeritage}
+	for _, key := range ownerKeys {
+		fields = append(fields, fmt.Sprintf("%v=%v", key, owner[key]))
+	}
+	return &route53types.ResourceRecordSet{
+		Name: awssdk.String(ownershipRecordNamePrefix + name),
+		Type: route53types.RRTypeTxt,
+		TTL:  awssdk.Int64(ownershipRecordTTL),
+		ResourceRecords: []route53types.ResourceRecord{
+			{
+				Value: awssdk.String(fmt.Sprintf("%q", strings.Join(fields, ","))),
+			},
+		},
+	}
+}
+
+// parseSDKOwnershipRecord parses the owner recorded by the TXT record, it returns false if it's not an ownership record of this controller.
+func parseSDKOwnershipRecord(sdkRecord *route53types.ResourceRecordSet) (map[string]string, bool) {
+	if len(sdkRecord.ResourceRecords) != 1 {
+		return nil, false
+	}
+	value := strings.Trim(awssdk.ToString(sdkRecord.ResourceRecords[0].Value), `"`)
+	fields := strings.Split(value, ",")
+	if fields[0] != ownershipHeritage {
+		return nil, false
+	}
+	owner := make(map[string]string, len(fields)-1)
+	for _, field := range fields[1:] {
+		key, val, ok := strings.Cut(field, "=")
+		if !ok {
+			return nil, false
+		}
+		owner[key] = val
+	}
+	return owner, true
+}
+
+// isSDKAliasRecordUpToDate checks whether the current alias record routes to the same target as the desired one.
+func isSDKAliasRecordUpToDate(desired *route53types.ResourceRecordSet, current *route53types.ResourceRecordSet) bool {
+	return normalizeDNSName(awssdk.ToString(desired.AliasTarget.DNSName)) == normalizeDNSName(awssdk.ToString(current.AliasTarget.DNSName)) &&
+		awssdk.ToString(desired.AliasTarget.HostedZoneId) == awssdk.ToString(current.AliasTarget.HostedZoneId) &&
+		desired.AliasTarget.EvaluateTargetHealth == current.AliasTarget.EvaluateTargetHealth
+}
+
+// normalizeDNSName converts a DNS name returned by Route 53 to lower case without trailing dot,
+// Route 53 returns the asterisk of wildcard names in octal escape.
+func normalizeDNSName(name string) string {
+	name = strings.ReplaceAll(name, `\052`, "*")
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+func sortedRecordTypes(aliasRecordByType map[string]*route53types.ResourceRecordSet) []string {
+	recordTypes := make([]string, 0, len(aliasRecordByType))
+	for recordType := range aliasRecordByType {
+		recordTypes = append(recordTypes, recordType)
+	}
+	sort.Strings(recordTypes)
+	return recordTypes
+}
@@ -0,0 +1,279 @@
+package route53
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	route53sdk "github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	route53model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/route53"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultRecordSetManager_ResolveHostedZoneID(t *testing.T) {
+	hostedZoneNameByID := map[string]string{
+		"Z1": "example.com.",
+		"Z2": "svc.example.com.",
+	}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{
+			name: "example.com",
+			want: "Z1",
+		},
+		{
+			name: "app.example.com",
+			want: "Z1",
+		},
+		{
+			name: "app.svc.example.com",
+			want: "Z2",
+		},
+		{
+			name: "*.svc.example.com",
+			want: "Z2",
+		},
+		{
+			name: "app.another-example.com",
+			want: "",
+		},
+		{
+			name: "notexample.com",
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			route53Client := services.NewMockRoute53(ctrl)
+			for hostedZoneID, hostedZoneName := range hostedZoneNameByID {
+				route53Client.EXPECT().GetHostedZoneWithContext(gomock.Any(), &route53sdk.GetHostedZoneInput{
+					Id: awssdk.String(hostedZoneID),
+				}).Return(&route53sdk.GetHostedZoneOutput{
+					HostedZone: &route53types.HostedZone{Name: awssdk.String(hostedZoneName)},
+				}, nil).Times(1)
+			}
+			m := NewDefaultRecordSetManager(route53Client, nil, []string{"Z1", "Z2"}, log.Log)
+			for i := 0; i < 2; i++ {
+				got, err := m.ResolveHostedZoneID(context.Background(), tt.name)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultRecordSetManager_ListRecordSets(t *testing.T) {
+	owner := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "awesome-group",
+	}
+	anotherOwner := map[string]string{
+		"elbv2.k8s.aws/cluster": "cluster-name",
+		"ingress.k8s.aws/stack": "another-group",
+	}
+	aliasRecordA := &route53types.ResourceRecordSet{
+		Name: awssdk.String("app.example.com."),
+		Type: route53types.RRTypeA,
+		AliasTarget: &route53types.AliasTarget{
+			DNSName:      awssdk.String("lb-dns-name."),
+			HostedZoneId: awssdk.String("lb-hosted-zone-id"),
+		},
+	}
+	wildcardAliasRecordA := &route53types.ResourceRecordSet{
+		Name: awssdk.String(`\052.example.com.`),
+		Type: route53types.RRTypeA,
+		AliasTarget: &route53types.AliasTarget{
+			DNSName:      awssdk.String("lb-dns-name."),
+			HostedZoneId: awssdk.String("lb-hosted-zone-id"),
+		},
+	}
+	plainRecordA := &route53types.ResourceRecordSet{
+		Name:            awssdk.String("app.example.com."),
+		Type:            route53types.RRTypeA,
+		ResourceRecords: []route53types.ResourceRecord{{Value: awssdk.String("192.0.2.1")}},
+	}
+	ownershipRecord := buildSDKOwnershipRecord("app.example.com", owner)
+	wildcardOwnershipRecord := buildSDKOwnershipRecord("*.example.com", owner)
+	anotherOwnershipRecord := buildSDKOwnershipRecord("another.example.com", anotherOwner)
+	foreignTXTRecord := &route53types.ResourceRecordSet{
+		Name:            awssdk.String("_aws-lbc-owner.foreign.example.com."),
+		Type:            route53types.RRTypeTxt,
+		ResourceRecords: []route53types.ResourceRecord{{Value: awssdk.String(`"heritage=external-dns"`)}},
+	}
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	route53Client := services.NewMockRoute53(ctrl)
+	route53Client.EXPECT().ListResourceRecordSetsAsList(gomock.Any(), &route53sdk.ListResourceRecordSetsInput{
+		HostedZoneId: awssdk.String("Z1"),
+	}).Return([]route53types.ResourceRecordSet{
+		*aliasRecordA, *wildcardAliasRecordA, *plainRecordA, *ownershipRecord, *wildcardOwnershipRecord, *anotherOwnershipRecord, *foreignTXTRecord,
+	}, nil)
+	m := NewDefaultRecordSetManager(route53Client, nil, []string{"Z1"}, log.Log)
+	got, err := m.ListRecordSets(context.Background(), tracking.TagsAsTagFilter(owner))
+	assert.NoError(t, err)
+	assert.Equal(t, []RecordSetWithOwnership{
+		{
+			HostedZoneID:    "Z1",
+			Name:            "app.example.com",
+			Owner:           owner,
+			OwnershipRecord: ownershipRecord,
+			AliasRecords:    []*route53types.ResourceRecordSet{aliasRecordA},
+		},
+		{
+			HostedZoneID:    "Z1",
+			Name:            "*.example.com",
+			Owner:           owner,
+			OwnershipRecord: wildcardOwnershipRecord,
+			AliasRecords:    []*route53types.ResourceRecordSet{wildcardAliasRecordA},
+		},
+	}, got)
+}
+
+func Test_defaultRecordSetManager_Update(t *testing.T) {
+	newAliasRecord := func(recordType route53types.RRType, dnsName string) *route53types.ResourceRecordSet {
+		return &route53types.ResourceRecordSet{
+			Name: awssdk.String("app.example.com"),
+			Type: recordType,
+			AliasTarget: &route53types.AliasTarget{
+				DNSName:              awssdk.String(dnsName),
+				HostedZoneId:         awssdk.String("lb-hosted-zone-id"),
+				EvaluateTargetHealth: true,
+			},
+		}
+	}
+	tests := []struct {
+		name            string
+		resTypes        []route53model.RecordType
+		sdkAliasRecords []*route53types.ResourceRecordSet
+		wantChanges     []route53types.Change
+	}{
+		{
+			name:     "records are in sync",
+			resTypes: []route53model.RecordType{route53model.RecordTypeA},
+			sdkAliasRecords: []*route53types.ResourceRecordSet{
+				newAliasRecord(route53types.RRTypeA, "LB-DNS-Name."),
+			},
+		},
+		{
+			name:     "records are created, updated and deleted",
+			resTypes: []route53model.RecordType{route53model.RecordTypeA},
+			sdkAliasRecords: []*route53types.ResourceRecordSet{
+				newAliasRecord(route53types.RRTypeAaaa, "lb-dns-name."),
+			},
+			wantChanges: []route53types.Change{
+				{
+					Action:            route53types.ChangeActionCreate,
+					ResourceRecordSet: newAliasRecord(route53types.RRTypeA, "lb-dns-name"),
+				},
+				{
+					Action:            route53types.ChangeActionDelete,
+					ResourceRecordSet: newAliasRecord(route53types.RRTypeAaaa, "lb-dns-name."),
+				},
+			},
+		},
+		{
+			name:     "records routing to another load balancer are updated",
+			resTypes: []route53model.RecordType{route53model.RecordTypeA, route53model.RecordTypeAAAA},
+			sdkAliasRecords: []*route53types.ResourceRecordSet{
+				newAliasRecord(route53types.RRTypeA, "another-lb-dns-name."),
+				newAliasRecord(route53types.RRTypeAaaa, "lb-dns-name."),
+			},
+			wantChanges: []route53types.Change{
+				{
+					Action:            route53types.ChangeActionUpsert,
+					ResourceRecordSet: newAliasRecord(route53types.RRTypeA, "lb-dns-name"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			route53Client := services.NewMockRoute53(ctrl)
+			if len(tt.wantChanges) != 0 {
+				route53Client.EXPECT().ChangeResourceRecordSetsWithContext(gomock.Any(), &route53sdk.ChangeResourceRecordSetsInput{
+					HostedZoneId: awssdk.String("Z1"),
+					ChangeBatch:  &route53types.ChangeBatch{Changes: tt.wantChanges},
+				}).Return(&route53sdk.ChangeResourceRecordSetsOutput{}, nil)
+			}
+			stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Name: "awesome-group"}))
+			resRecordSet := route53model.NewRecordSet(stack, "app.example.com", route53model.RecordSetSpec{
+				Name:  "app.example.com",
+				Types: tt.resTypes,
+				AliasTarget: route53model.AliasTarget{
+					DNSName:      core.LiteralStringToken("lb-dns-name"),
+					HostedZoneID: core.LiteralStringToken("lb-hosted-zone-id"),
+				},
+			})
+			m := NewDefaultRecordSetManager(route53Client, nil, []string{"Z1"}, log.Log)
+			got, err := m.Update(context.Background(), resRecordSet, RecordSetWithOwnership{
+				HostedZoneID: "Z1",
+				Name:         "app.example.com",
+				AliasRecords: tt.sdkAliasRecords,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, route53model.RecordSetStatus{HostedZoneID: "Z1"}, got)
+		})
+	}
+}
+
+func Test_parseSDKOwnershipRecord(t *testing.T) {
+	tests := []struct {
+		name      string
+		sdkRecord *route53types.ResourceRecordSet
+		want      map[string]string
+		wantOK    bool
+	}{
+		{
+			name: "ownership record of this controller",
+			sdkRecord: buildSDKOwnershipRecord("app.example.com", map[string]string{
+				"elbv2.k8s.aws/cluster": "cluster-name",
+				"service.k8s.aws/stack": "awesome-ns/awesome-svc",
+			}),
+			want: map[string]string{
+				"elbv2.k8s.aws/cluster": "cluster-name",
+				"service.k8s.aws/stack": "awesome-ns/awesome-svc",
+			},
+			wantOK: true,
+		},
+		{
+			name: "TXT record of another owner",
+			sdkRecord: &route53types.ResourceRecordSet{
+				ResourceRecords: []route53types.ResourceRecord{{Value: awssdk.String(`"heritage=external-dns,external-dns/owner=default"`)}},
+			},
+			wantOK: false,
+		},
+		{
+			name: "malformed ownership record",
+			sdkRecord: &route53types.ResourceRecordSet{
+				ResourceRecords: []route53types.ResourceRecord{{Value: awssdk.String(`"heritage=aws-load-balancer-controller,malformed"`)}},
+			},
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOK := parseSDKOwnershipRecord(tt.sdkRecord)
+			assert.Equal(t, tt.wantOK, gotOK)
+			if tt.wantOK {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
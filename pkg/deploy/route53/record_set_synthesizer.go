@@ -0,0 +1,136 @@
+package route53
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	route53model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/route53"
+)
+
+// NewRecordSetSynthesizer constructs new recordSetSynthesizer.
+func NewRecordSetSynthesizer(trackingProvider tracking.Provider, recordSetManager RecordSetManager, logger logr.Logger, stack core.Stack) *recordSetSynthesizer {
+	return &recordSetSynthesizer{
+		trackingProvider: trackingProvider,
+		recordSetManager: recordSetManager,
+		logger:           logger,
+		stack:            stack,
+	}
+}
+
+// recordSetSynthesizer is responsible for synthesize RecordSet resources types for certain stack.
+type recordSetSynthesizer struct {
+	trackingProvider tracking.Provider
+	recordSetManager RecordSetManager
+	logger           logr.Logger
+
+	stack                       core.Stack
+	matchedResAndSDKRecordSets  []resAndSDKRecordSetPair
+	unmatchedResRecordSets      []*route53model.RecordSet
+	hostedZoneIDByResRecordSets map[*route53model.RecordSet]string
+}
+
+func (s *recordSetSynthesizer) Synthesize(ctx context.Context) error {
+	var resRecordSets []*route53model.RecordSet
+	s.stack.ListResources(&resRecordSets)
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	sdkRecordSets, err := s.recordSetManager.ListRecordSets(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return err
+	}
+	var resRecordSetsInHostedZones []*route53model.RecordSet
+	hostedZoneIDByResRecordSets := make(map[*route53model.RecordSet]string, len(resRecordSets))
+	for _, resRecordSet := range resRecordSets {
+		hostedZoneID, err := s.recordSetManager.ResolveHostedZoneID(ctx, resRecordSet.Spec.Name)
+		if err != nil {
+			return err
+		}
+		if hostedZoneID == "" {
+			s.logger.V(1).Info("skipping record set outside of configured hosted zones",
+				"stackID", s.stack.StackID(),
+				"name", resRecordSet.Spec.Name)
+			continue
+		}
+		resRecordSetsInHostedZones = append(resRecordSetsInHostedZones, resRecordSet)
+		hostedZoneIDByResRecordSets[resRecordSet] = hostedZoneID
+	}
+	matchedResAndSDKRecordSets, unmatchedResRecordSets, unmatchedSDKRecordSets := matchResAndSDKRecordSets(resRecordSetsInHostedZones,
+		hostedZoneIDByResRecordSets, sdkRecordSets)
+
+	// For RecordSets, we delete unmatched ones during synthesize,
+	// so that no records route to LoadBalancers while they're being deleted.
+	for _, sdkRecordSet := range unmatchedSDKRecordSets {
+		if err := s.recordSetManager.Delete(ctx, sdkRecordSet); err != nil {
+			return err
+		}
+	}
+	// For RecordSets, we create and update matched ones during post synthesize,
+	// since the LoadBalancers are fulfilled by then.
+	s.matchedResAndSDKRecordSets = matchedResAndSDKRecordSets
+	s.unmatchedResRecordSets = unmatchedResRecordSets
+	s.hostedZoneIDByResRecordSets = hostedZoneIDByResRecordSets
+	return nil
+}
+
+func (s *recordSetSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, resRecordSet := range s.unmatchedResRecordSets {
+		recordSetStatus, err := s.recordSetManager.Create(ctx, resRecordSet, s.hostedZoneIDByResRecordSets[resRecordSet])
+		if err != nil {
+			return err
+		}
+		resRecordSet.SetStatus(recordSetStatus)
+	}
+	for _, resAndSDKRecordSet := range s.matchedResAndSDKRecordSets {
+		recordSetStatus, err := s.recordSetManager.Update(ctx, resAndSDKRecordSet.resRecordSet, resAndSDKRecordSet.sdkRecordSet)
+		if err != nil {
+			return err
+		}
+		resAndSDKRecordSet.resRecordSet.SetStatus(recordSetStatus)
+	}
+	return nil
+}
+
+type resAndSDKRecordSetPair struct {
+	resRecordSet *route53model.RecordSet
+	sdkRecordSet RecordSetWithOwnership
+}
+
+// recordSetKey identifies the records of a DNS name within a hosted zone.
+type recordSetKey struct {
+	hostedZoneID string
+	name         string
+}
+
+func matchResAndSDKRecordSets(resRecordSets []*route53model.RecordSet, hostedZoneIDByResRecordSets map[*route53model.RecordSet]string,
+	sdkRecordSets []RecordSetWithOwnership) (
+	[]resAndSDKRecordSetPair, []*route53model.RecordSet, []RecordSetWithOwnership) {
+	var matchedResAndSDKRecordSets []resAndSDKRecordSetPair
+	var unmatchedResRecordSets []*route53model.RecordSet
+	var unmatchedSDKRecordSets []RecordSetWithOwnership
+
+	sdkRecordSetByKey := make(map[recordSetKey]RecordSetWithOwnership, len(sdkRecordSets))
+	for _, sdkRecordSet := range sdkRecordSets {
+		sdkRecordSetByKey[recordSetKey{hostedZoneID: sdkRecordSet.HostedZoneID, name: sdkRecordSet.Name}] = sdkRecordSet
+	}
+	matchedKeys := make(map[recordSetKey]struct{}, len(resRecordSets))
+	for _, resRecordSet := range resRecordSets {
+		key := recordSetKey{hostedZoneID: hostedZoneIDByResRecordSets[resRecordSet], name: resRecordSet.Spec.Name}
+		sdkRecordSet, exists := sdkRecordSetByKey[key]
+		if !exists {
+			unmatchedResRecordSets = append(unmatchedResRecordSets, resRecordSet)
+			continue
+		}
+		matchedKeys[key] = struct{}{}
+		matchedResAndSDKRecordSets = append(matchedResAndSDKRecordSets, resAndSDKRecordSetPair{
+			resRecordSet: resRecordSet,
+			sdkRecordSet: sdkRecordSet,
+		})
+	}
+	for _, sdkRecordSet := range sdkRecordSets {
+		if _, matched := matchedKeys[recordSetKey{hostedZoneID: sdkRecordSet.HostedZoneID, name: sdkRecordSet.Name}]; !matched {
+			unmatchedSDKRecordSets = append(unmatchedSDKRecordSets, sdkRecordSet)
+		}
+	}
+	return matchedResAndSDKRecordSets, unmatchedResRecordSets, unmatchedSDKRecordSets
+}
@@ -0,0 +1,180 @@
+package deploy
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// StackAbandoner will abandon the resources of a resource stack, so that they're no longer managed by the controller.
+type StackAbandoner interface {
+	// Abandon the resources of stack, leaving them intact in AWS.
+	Abandon(ctx context.Context, stack core.Stack) error
+}
+
+// ParseDeletionPolicyAnnotation parses the deletion policy specified via the annotation of suffix, which defaults to Delete.
+func ParseDeletionPolicyAnnotation(parser annotations.Parser, suffix string, rawAnnotations map[string]string) (elbv2api.DeletionPolicy, error) {
+	rawDeletionPolicy := ""
+	if !parser.ParseStringAnnotation(suffix, &rawDeletionPolicy, rawAnnotations) {
+		return elbv2api.DeletionPolicyDelete, nil
+	}
+	switch deletionPolicy := elbv2api.DeletionPolicy(rawDeletionPolicy); deletionPolicy {
+	case elbv2api.DeletionPolicyDelete, elbv2api.DeletionPolicyRetain:
+		return deletionPolicy, nil
+	default:
+		return "", errors.Errorf("invalid deletion policy %v, expects %v or %v", rawDeletionPolicy, elbv2api.DeletionPolicyDelete, elbv2api.DeletionPolicyRetain)
+	}
+}
+
+// NewDefaultStackAbandoner constructs new defaultStackAbandoner.
+func NewDefaultStackAbandoner(cloud aws.Cloud, k8sClient client.Client, networkingSGManager networking.SecurityGroupManager,
+	config config.ControllerConfig, tagPrefix string, logger logr.Logger) *defaultStackAbandoner {
+	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName, config.DeniedTagKeyPrefixes)
+	return &defaultStackAbandoner{
+		k8sClient:           k8sClient,
+		elbv2Client:         cloud.ELBV2(),
+		ec2Client:           cloud.EC2(),
+		trackingProvider:    trackingProvider,
+		elbv2TaggingManager: elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, cloud.RGT(), config.StrictTagEnforcement(), logger),
+		ec2TaggingManager:   ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), config.StrictTagEnforcement(), logger),
+		tgbManager:          elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger),
+		logger:              logger,
+	}
+}
+
+var _ StackAbandoner = &defaultStackAbandoner{}
+
+// defaultStackAbandoner is the default implementation for StackAbandoner.
+// the TargetGroupBindings of stack are deleted with Retain deletion policy, so that their targets stay registered,
+// and the tracking tags are removed from the load balancers, target groups, security groups and elastic IPs of stack,
+// so that they're neither adopted by stacks with the same ID, nor deleted as orphaned resources.
+type defaultStackAbandoner struct {
+	k8sClient           client.Client
+	elbv2Client         services.ELBV2
+	ec2Client           services.EC2
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2.TaggingManager
+	ec2TaggingManager   ec2.TaggingManager
+	tgbManager          elbv2.TargetGroupBindingManager
+	logger              logr.Logger
+}
+
+func (a *defaultStackAbandoner) Abandon(ctx context.Context, stack core.Stack) error {
+	if err := a.abandonTargetGroupBindings(ctx, stack); err != nil {
+		return err
+	}
+	stackTags := a.trackingProvider.StackTags(stack)
+	stackTagsLegacy := a.trackingProvider.StackTagsLegacy(stack)
+	tagFilters := []tracking.TagFilter{tracking.TagsAsTagFilter(stackTags), tracking.TagsAsTagFilter(stackTagsLegacy)}
+	trackingTagKeys := sets.StringKeySet(stackTags).Union(sets.StringKeySet(stackTagsLegacy)).Insert(a.trackingProvider.ResourceIDTagKey())
+
+	sdkLBs, err := a.elbv2TaggingManager.ListLoadBalancers(ctx, tagFilters...)
+	if err != nil {
+		return err
+	}
+	for _, sdkLB := range sdkLBs {
+		if err := a.removeELBV2TrackingTags(ctx, awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn), sdkLB.Tags, trackingTagKeys); err != nil {
+			return err
+		}
+	}
+	sdkTGs, err := a.elbv2TaggingManager.ListTargetGroups(ctx, tagFilters...)
+	if err != nil {
+		return err
+	}
+	for _, sdkTG := range sdkTGs {
+		if err := a.removeELBV2TrackingTags(ctx, awssdk.ToString(sdkTG.TargetGroup.TargetGroupArn), sdkTG.Tags, trackingTagKeys); err != nil {
+			return err
+		}
+	}
+	sdkSGs, err := a.ec2TaggingManager.ListSecurityGroups(ctx, tagFilters...)
+	if err != nil {
+		return err
+	}
+	for _, sdkSG := range sdkSGs {
+		if err := a.removeEC2TrackingTags(ctx, sdkSG.SecurityGroupID, sdkSG.Tags, trackingTagKeys); err != nil {
+			return err
+		}
+	}
+	sdkEIPs, err := a.ec2TaggingManager.ListElasticIPs(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return err
+	}
+	for _, sdkEIP := range sdkEIPs {
+		if err := a.removeEC2TrackingTags(ctx, awssdk.ToString(sdkEIP.Address.AllocationId), sdkEIP.Tags, trackingTagKeys); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// abandonTargetGroupBindings deletes the TargetGroupBindings of stack with Retain deletion policy.
+func (a *defaultStackAbandoner) abandonTargetGroupBindings(ctx context.Context, stack core.Stack) error {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := a.k8sClient.List(ctx, tgbList, client.MatchingLabels(a.trackingProvider.StackLabels(stack))); err != nil {
+		return err
+	}
+	for i := range tgbList.Items {
+		tgb := &tgbList.Items[i]
+		if tgb.Spec.DeletionPolicy == nil || *tgb.Spec.DeletionPolicy != elbv2api.DeletionPolicyRetain {
+			oldTGB := tgb.DeepCopy()
+			deletionPolicy := elbv2api.DeletionPolicyRetain
+			tgb.Spec.DeletionPolicy = &deletionPolicy
+			if err := a.k8sClient.Patch(ctx, tgb, client.MergeFrom(oldTGB)); err != nil {
+				return err
+			}
+			a.logger.Info("retaining targetGroupBinding", "targetGroupBinding", k8s.NamespacedName(tgb))
+		}
+		if err := a.tgbManager.Delete(ctx, tgb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *defaultStackAbandoner) removeELBV2TrackingTags(ctx context.Context, arn string, tags map[string]string, trackingTagKeys sets.String) error {
+	tagKeys := sets.StringKeySet(tags).Intersection(trackingTagKeys).List()
+	if len(tagKeys) == 0 {
+		return nil
+	}
+	a.logger.Info("abandoning resource", "arn", arn, "removedTags", tagKeys)
+	_, err := a.elbv2Client.RemoveTagsWithContext(ctx, &elbv2sdk.RemoveTagsInput{
+		ResourceArns: []string{arn},
+		TagKeys:      tagKeys,
+	})
+	return err
+}
+
+func (a *defaultStackAbandoner) removeEC2TrackingTags(ctx context.Context, resID string, tags map[string]string, trackingTagKeys sets.String) error {
+	tagKeys := sets.StringKeySet(tags).Intersection(trackingTagKeys).List()
+	if len(tagKeys) == 0 {
+		return nil
+	}
+	a.logger.Info("abandoning resource", "resourceID", resID, "removedTags", tagKeys)
+	req := &ec2sdk.DeleteTagsInput{
+		Resources: []string{resID},
+	}
+	for _, tagKey := range tagKeys {
+		req.Tags = append(req.Tags, ec2types.Tag{Key: awssdk.String(tagKey)})
+	}
+	_, err := a.ec2Client.DeleteTagsWithContext(ctx, req)
+	return err
+}
@@ -0,0 +1,154 @@
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeTargetGroupBindingManager records the deleted TargetGroupBindings.
+type fakeTargetGroupBindingManager struct {
+	elbv2.TargetGroupBindingManager
+
+	deletedTGBs []*elbv2api.TargetGroupBinding
+}
+
+func (m *fakeTargetGroupBindingManager) Delete(_ context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	m.deletedTGBs = append(m.deletedTGBs, tgb)
+	return nil
+}
+
+func TestParseDeletionPolicyAnnotation(t *testing.T) {
+	tests := []struct {
+		name           string
+		rawAnnotations map[string]string
+		want           elbv2api.DeletionPolicy
+		wantErr        bool
+	}{
+		{
+			name: "defaults to Delete",
+			want: elbv2api.DeletionPolicyDelete,
+		},
+		{
+			name:           "Retain",
+			rawAnnotations: map[string]string{"alb.ingress.kubernetes.io/deletion-policy": "Retain"},
+			want:           elbv2api.DeletionPolicyRetain,
+		},
+		{
+			name:           "invalid value",
+			rawAnnotations: map[string]string{"alb.ingress.kubernetes.io/deletion-policy": "retain"},
+			wantErr:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			got, err := ParseDeletionPolicyAnnotation(parser, annotations.IngressSuffixDeletionPolicy, tt.rawAnnotations)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultStackAbandoner_Abandon(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "awesome-svc"})
+	trackingProvider := tracking.NewDefaultProvider("service.k8s.aws", "awesome-cluster", nil)
+	trackingTags := map[string]string{
+		"elbv2.k8s.aws/cluster":    "awesome-cluster",
+		"service.k8s.aws/stack":    "awesome-ns/awesome-svc",
+		"service.k8s.aws/resource": "LoadBalancer",
+	}
+
+	elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
+	elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any()).Return([]elbv2.LoadBalancerWithTags{
+		{
+			LoadBalancer: &elbv2types.LoadBalancer{LoadBalancerArn: awssdk.String("lb-arn")},
+			Tags:         map[string]string{"elbv2.k8s.aws/cluster": "awesome-cluster", "service.k8s.aws/stack": "awesome-ns/awesome-svc", "service.k8s.aws/resource": "LoadBalancer", "team": "awesome"},
+		},
+	}, nil)
+	elbv2TaggingManager.EXPECT().ListTargetGroups(gomock.Any(), gomock.Any()).Return(nil, nil)
+	ec2TaggingManager := ec2.NewMockTaggingManager(ctrl)
+	ec2TaggingManager.EXPECT().ListSecurityGroups(gomock.Any(), gomock.Any()).Return([]networking.SecurityGroupInfo{
+		{SecurityGroupID: "sg-a", Tags: trackingTags},
+	}, nil)
+	ec2TaggingManager.EXPECT().ListElasticIPs(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	elbv2Client := services.NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().RemoveTagsWithContext(gomock.Any(), &elbv2sdk.RemoveTagsInput{
+		ResourceArns: []string{"lb-arn"},
+		TagKeys:      []string{"elbv2.k8s.aws/cluster", "service.k8s.aws/resource", "service.k8s.aws/stack"},
+	}).Return(&elbv2sdk.RemoveTagsOutput{}, nil)
+	ec2Client := services.NewMockEC2(ctrl)
+	ec2Client.EXPECT().DeleteTagsWithContext(gomock.Any(), &ec2sdk.DeleteTagsInput{
+		Resources: []string{"sg-a"},
+		Tags: []ec2types.Tag{
+			{Key: awssdk.String("elbv2.k8s.aws/cluster")},
+			{Key: awssdk.String("service.k8s.aws/resource")},
+			{Key: awssdk.String("service.k8s.aws/stack")},
+		},
+	}).Return(&ec2sdk.DeleteTagsOutput{}, nil)
+
+	k8sSchema := runtime.NewScheme()
+	clientgoscheme.AddToScheme(k8sSchema)
+	elbv2api.AddToScheme(k8sSchema)
+	k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(
+		&elbv2api.TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "k8s-awesomes-awesomes-0123456789",
+				Labels:    trackingProvider.StackLabels(stack),
+			},
+		},
+		&elbv2api.TargetGroupBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "unrelated-tgb",
+			},
+		},
+	).Build()
+	tgbManager := &fakeTargetGroupBindingManager{}
+
+	a := &defaultStackAbandoner{
+		k8sClient:           k8sClient,
+		elbv2Client:         elbv2Client,
+		ec2Client:           ec2Client,
+		trackingProvider:    trackingProvider,
+		elbv2TaggingManager: elbv2TaggingManager,
+		ec2TaggingManager:   ec2TaggingManager,
+		tgbManager:          tgbManager,
+		logger:              logr.New(&log.NullLogSink{}),
+	}
+	assert.NoError(t, a.Abandon(context.Background(), stack))
+	assert.Len(t, tgbManager.deletedTGBs, 1)
+	assert.Equal(t, "k8s-awesomes-awesomes-0123456789", tgbManager.deletedTGBs[0].Name)
+	assert.Equal(t, elbv2api.DeletionPolicyRetain, *tgbManager.deletedTGBs[0].Spec.DeletionPolicy)
+}
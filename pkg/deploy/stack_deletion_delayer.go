@@ -0,0 +1,61 @@
+package deploy
+
+import (
+	"context"
+	"time"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// StackDeletionDelayer delays the deletion of the internet-facing load balancers of resource stacks,
+// so that the DNS caches of their names expire before the names stop resolving.
+type StackDeletionDelayer interface {
+	// DelayedUntil returns the time until which the deletion of stack deleted at deletionTime is delayed, zero if it can be deleted now.
+	DelayedUntil(ctx context.Context, stack core.Stack, deletionTime time.Time) (time.Time, error)
+}
+
+// NewDefaultStackDeletionDelayer constructs new defaultStackDeletionDelayer.
+func NewDefaultStackDeletionDelayer(cloud aws.Cloud, config config.ControllerConfig, tagPrefix string, logger logr.Logger) *defaultStackDeletionDelayer {
+	return &defaultStackDeletionDelayer{
+		trackingProvider:    tracking.NewDefaultProvider(tagPrefix, config.ClusterName, config.DeniedTagKeyPrefixes),
+		elbv2TaggingManager: elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, cloud.RGT(), config.StrictTagEnforcement(), logger),
+		gracePeriod:         config.LBDeleteDNSGracePeriod,
+		now:                 time.Now,
+	}
+}
+
+var _ StackDeletionDelayer = &defaultStackDeletionDelayer{}
+
+// defaultStackDeletionDelayer is the default implementation for StackDeletionDelayer.
+// internal load balancers aren't delayed, as their names are only resolved within the VPC.
+type defaultStackDeletionDelayer struct {
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2.TaggingManager
+	gracePeriod         time.Duration
+	now                 func() time.Time
+}
+
+func (d *defaultStackDeletionDelayer) DelayedUntil(ctx context.Context, stack core.Stack, deletionTime time.Time) (time.Time, error) {
+	delayedUntil := deletionTime.Add(d.gracePeriod)
+	if !delayedUntil.After(d.now()) {
+		return time.Time{}, nil
+	}
+	sdkLBs, err := d.elbv2TaggingManager.ListLoadBalancers(ctx,
+		tracking.TagsAsTagFilter(d.trackingProvider.StackTags(stack)),
+		tracking.TagsAsTagFilter(d.trackingProvider.StackTagsLegacy(stack)))
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, sdkLB := range sdkLBs {
+		if sdkLB.LoadBalancer.Scheme == elbv2types.LoadBalancerSchemeEnumInternetFacing {
+			return delayedUntil, nil
+		}
+	}
+	return time.Time{}, nil
+}
@@ -0,0 +1,77 @@
+package deploy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+func Test_defaultStackDeletionDelayer_DelayedUntil(t *testing.T) {
+	deletionTime := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		now        time.Time
+		lbSchemes  []elbv2types.LoadBalancerSchemeEnum
+		listCalled bool
+		want       time.Time
+	}{
+		{
+			name: "grace period elapsed",
+			now:  deletionTime.Add(5 * time.Minute),
+			want: time.Time{},
+		},
+		{
+			name:       "internet-facing load balancer within grace period",
+			now:        deletionTime.Add(time.Minute),
+			lbSchemes:  []elbv2types.LoadBalancerSchemeEnum{elbv2types.LoadBalancerSchemeEnumInternetFacing},
+			listCalled: true,
+			want:       deletionTime.Add(5 * time.Minute),
+		},
+		{
+			name:       "internal load balancer within grace period",
+			now:        deletionTime.Add(time.Minute),
+			lbSchemes:  []elbv2types.LoadBalancerSchemeEnum{elbv2types.LoadBalancerSchemeEnumInternal},
+			listCalled: true,
+			want:       time.Time{},
+		},
+		{
+			name:       "load balancer already deleted",
+			now:        deletionTime.Add(time.Minute),
+			listCalled: true,
+			want:       time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
+			if tt.listCalled {
+				var sdkLBs []elbv2.LoadBalancerWithTags
+				for _, scheme := range tt.lbSchemes {
+					sdkLBs = append(sdkLBs, elbv2.LoadBalancerWithTags{
+						LoadBalancer: &elbv2types.LoadBalancer{Scheme: scheme},
+					})
+				}
+				elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any(), gomock.Any()).Return(sdkLBs, nil)
+			}
+			d := &defaultStackDeletionDelayer{
+				trackingProvider:    tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
+				elbv2TaggingManager: elbv2TaggingManager,
+				gracePeriod:         5 * time.Minute,
+				now:                 func() time.Time { return tt.now },
+			}
+			stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "ing-1"})
+			got, err := d.DelayedUntil(context.Background(), stack, deletionTime)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,11 +2,17 @@ package deploy
 
 import (
 	"context"
+	"time"
+
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/dryrun"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/ec2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/globalaccelerator"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/route53"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/shield"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/wafregional"
@@ -25,34 +31,112 @@ type StackDeployer interface {
 // NewDefaultStackDeployer constructs new defaultStackDeployer.
 func NewDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
 	networkingSGManager networking.SecurityGroupManager, networkingSGReconciler networking.SecurityGroupReconciler,
-	config config.ControllerConfig, tagPrefix string, logger logr.Logger) *defaultStackDeployer {
+	config config.ControllerConfig, tagPrefix string, listenerRulesFetchMetrics *elbv2.ListenerRulesFetchMetrics,
+	mutationVerificationMetrics *elbv2.MutationVerificationMetrics, describeCacheMetrics *elbv2.DescribeCacheMetrics, logger logr.Logger) *defaultStackDeployer {
+	elbv2DescribeCache := newELBV2DescribeCache(config.FeatureGates, describeCacheMetrics)
+	return newDefaultStackDeployer(cloud, k8sClient, networkingSGManager, networkingSGReconciler, config, tagPrefix,
+		listenerRulesFetchMetrics, mutationVerificationMetrics, elbv2DescribeCache, logger)
+}
+
+func newDefaultStackDeployer(cloud aws.Cloud, k8sClient client.Client,
+	networkingSGManager networking.SecurityGroupManager, networkingSGReconciler networking.SecurityGroupReconciler,
+	config config.ControllerConfig, tagPrefix string, listenerRulesFetchMetrics *elbv2.ListenerRulesFetchMetrics,
+	mutationVerificationMetrics *elbv2.MutationVerificationMetrics, elbv2DescribeCache *elbv2.DescribeCache, logger logr.Logger) *defaultStackDeployer {
+	elbv2Client := cloud.ELBV2()
+	if elbv2DescribeCache != nil {
+		elbv2Client = elbv2DescribeCache.WrapELBV2(elbv2Client)
+	}
 
-	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName)
-	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), logger)
-	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, cloud.RGT(), logger)
+	trackingProvider := tracking.NewDefaultProvider(tagPrefix, config.ClusterName, config.DeniedTagKeyPrefixes)
+	ec2TaggingManager := ec2.NewDefaultTaggingManager(cloud.EC2(), networkingSGManager, cloud.VpcID(), config.StrictTagEnforcement(), logger)
+	elbv2TaggingManager := elbv2.NewDefaultTaggingManager(elbv2Client, cloud.VpcID(), config.FeatureGates, cloud.RGT(), config.StrictTagEnforcement(), logger)
+	elbv2CrossZoneValidator := elbv2.NewDefaultCrossZoneValidator(cloud.ELBV2(), cloud.EC2(), config.CrossZoneDisableValidationMode, logger)
+	elbv2MutationVerifier := newELBV2MutationVerifier(cloud, config.FeatureGates, mutationVerificationMetrics, logger)
 
 	return &defaultStackDeployer{
 		cloud:                               cloud,
 		k8sClient:                           k8sClient,
 		addonsConfig:                        config.AddonsConfig,
+		deployConfig:                        config.DeployConfig,
 		trackingProvider:                    trackingProvider,
 		ec2TaggingManager:                   ec2TaggingManager,
 		ec2SGManager:                        ec2.NewDefaultSecurityGroupManager(cloud.EC2(), trackingProvider, ec2TaggingManager, networkingSGReconciler, cloud.VpcID(), config.ExternalManagedTags, logger),
+		ec2ESManager:                        ec2.NewDefaultVPCEndpointServiceManager(cloud.EC2(), trackingProvider, ec2TaggingManager, config.ExternalManagedTags, logger),
+		ec2EIPManager:                       ec2.NewDefaultElasticIPManager(cloud.EC2(), trackingProvider, ec2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2TaggingManager:                 elbv2TaggingManager,
-		elbv2LBManager:                      elbv2.NewDefaultLoadBalancerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
-		elbv2LSManager:                      elbv2.NewDefaultListenerManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
-		elbv2LRManager:                      elbv2.NewDefaultListenerRuleManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, logger),
-		elbv2TGManager:                      elbv2.NewDefaultTargetGroupManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, cloud.VpcID(), config.ExternalManagedTags, logger),
+		elbv2Client:                         elbv2Client,
+		elbv2DescribeCache:                  elbv2DescribeCache,
+		elbv2LBManager:                      elbv2.NewDefaultLoadBalancerManager(elbv2Client, cloud.EC2(), trackingProvider, elbv2TaggingManager, elbv2CrossZoneValidator, config.ExternalManagedTags, logger),
+		elbv2LSManager:                      elbv2.NewDefaultListenerManager(elbv2Client, trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, elbv2MutationVerifier, logger),
+		elbv2LRManager:                      elbv2.NewDefaultListenerRuleManager(elbv2Client, trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, config.FeatureGates, elbv2MutationVerifier, logger),
+		elbv2TGManager:                      elbv2.NewDefaultTargetGroupManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, elbv2CrossZoneValidator, cloud.VpcID(), config.ExternalManagedTags, logger),
+		elbv2TGTransitioner:                 elbv2.NewTargetGroupTransitioner(cloud.ELBV2(), logger),
+		elbv2TrustStoreManager:              elbv2.NewDefaultTrustStoreManager(cloud.ELBV2(), trackingProvider, elbv2TaggingManager, config.ExternalManagedTags, logger),
 		elbv2TGBManager:                     elbv2.NewDefaultTargetGroupBindingManager(k8sClient, trackingProvider, logger),
+		elbv2LRFetchTracker:                 elbv2.NewListenerRulesFetchTracker(listenerRulesFetchMetrics),
+		wafv2WebACLManager:                  wafv2.NewDefaultWebACLManager(cloud.WAFv2(), trackingProvider, config.ExternalManagedTags, logger),
 		wafv2WebACLAssociationManager:       wafv2.NewDefaultWebACLAssociationManager(cloud.WAFv2(), logger),
+		wafv2WebACLLoggingManager:           wafv2.NewDefaultWebACLLoggingManager(cloud.WAFv2(), logger),
 		wafRegionalWebACLAssociationManager: wafregional.NewDefaultWebACLAssociationManager(cloud.WAFRegional(), logger),
 		shieldProtectionManager:             shield.NewDefaultProtectionManager(cloud.Shield(), logger),
+		gaAcceleratorManager:                globalaccelerator.NewDefaultAcceleratorManager(cloud.GlobalAccelerator(), trackingProvider, cloud.Region(), config.ExternalManagedTags, logger),
+		route53RecordSetManager:             route53.NewDefaultRecordSetManager(cloud.Route53(), trackingProvider, config.Route53HostedZoneIDs, logger),
+		progressStore:                       newStackDeployProgressStore(k8sClient, config, tagPrefix, logger),
 		featureGates:                        config.FeatureGates,
 		vpcID:                               cloud.VpcID(),
 		logger:                              logger,
 	}
 }
 
+// NewDryRunStackDeployer constructs new defaultStackDeployer that plans the changes to deploy a resource stack without applying them.
+// the planned changes are recorded with the audit.MutationRecorder carried by context.
+func NewDryRunStackDeployer(cloud aws.Cloud, k8sClient client.Client, config config.ControllerConfig, tagPrefix string, logger logr.Logger) *defaultStackDeployer {
+	dryRunCloud := dryrun.NewCloud(cloud)
+	networkingSGManager := networking.NewDefaultSecurityGroupManager(dryRunCloud.EC2(), logger)
+	networkingSGReconciler := networking.NewDefaultSecurityGroupReconciler(networkingSGManager, nil, logger)
+	// the describes aren't cached, as the dry run cache wouldn't be invalidated by the mutations of the default deployer.
+	deployer := newDefaultStackDeployer(dryRunCloud, k8sClient, networkingSGManager, networkingSGReconciler, config, tagPrefix, nil, nil, nil, logger)
+	deployer.elbv2TGBManager = elbv2.NewDryRunTargetGroupBindingManager(logger)
+	// the planned mutations are never visible, so they're not verified.
+	deployer.elbv2LSManager = elbv2.NewDefaultListenerManager(dryRunCloud.ELBV2(), deployer.trackingProvider, deployer.elbv2TaggingManager,
+		config.ExternalManagedTags, config.FeatureGates, nil, logger)
+	deployer.elbv2LRManager = elbv2.NewDefaultListenerRuleManager(dryRunCloud.ELBV2(), deployer.trackingProvider, deployer.elbv2TaggingManager,
+		config.ExternalManagedTags, config.FeatureGates, nil, logger)
+	// the listener rules are always fetched, as the checksum of planned listener rules isn't tagged.
+	deployer.elbv2LRFetchTracker = nil
+	// the planned TargetGroups never become healthy, so the switch to replacements isn't held back.
+	deployer.elbv2TGTransitioner = nil
+	// the planned changes are never applied, so the planned deploys are always from scratch.
+	deployer.progressStore = nil
+	return deployer
+}
+
+// newELBV2MutationVerifier constructs the verifier for listener and listener rule mutations, or nil if the verification is disabled.
+func newELBV2MutationVerifier(cloud aws.Cloud, featureGates config.FeatureGates, metrics *elbv2.MutationVerificationMetrics, logger logr.Logger) *elbv2.MutationVerifier {
+	if !featureGates.Enabled(config.MutationVerification) {
+		return nil
+	}
+	return elbv2.NewMutationVerifier(cloud.ELBV2(), metrics, logger)
+}
+
+// newELBV2DescribeCache constructs the cache for describing listeners, listener rules and listener certificates, or nil if the cache is disabled.
+func newELBV2DescribeCache(featureGates config.FeatureGates, metrics *elbv2.DescribeCacheMetrics) *elbv2.DescribeCache {
+	if !featureGates.Enabled(config.ELBV2DescribeCache) {
+		return nil
+	}
+	return elbv2.NewDescribeCache(metrics)
+}
+
+// newStackDeployProgressStore constructs the store for the progress of failed deploys, or nil if deploys aren't resumed.
+func newStackDeployProgressStore(k8sClient client.Client, controllerConfig config.ControllerConfig, tagPrefix string, logger logr.Logger) *deployProgressStore {
+	if !controllerConfig.FeatureGates.Enabled(config.ResumableDeploy) {
+		return nil
+	}
+	// the configMap key is validated along with the controller configuration.
+	configMapKey, _ := controllerConfig.DeployConfig.ProgressConfigMapKey()
+	return newDeployProgressStore(k8sClient, configMapKey, tagPrefix, logger)
+}
+
 var _ StackDeployer = &defaultStackDeployer{}
 
 // defaultStackDeployer is the default implementation for StackDeployer
@@ -60,20 +144,34 @@ type defaultStackDeployer struct {
 	cloud                               aws.Cloud
 	k8sClient                           client.Client
 	addonsConfig                        config.AddonsConfig
+	deployConfig                        config.DeployConfig
 	trackingProvider                    tracking.Provider
 	ec2TaggingManager                   ec2.TaggingManager
 	ec2SGManager                        ec2.SecurityGroupManager
+	ec2ESManager                        ec2.VPCEndpointServiceManager
+	ec2EIPManager                       ec2.ElasticIPManager
 	elbv2TaggingManager                 elbv2.TaggingManager
+	elbv2Client                         services.ELBV2
+	elbv2DescribeCache                  *elbv2.DescribeCache
 	elbv2LBManager                      elbv2.LoadBalancerManager
 	elbv2LSManager                      elbv2.ListenerManager
 	elbv2LRManager                      elbv2.ListenerRuleManager
 	elbv2TGManager                      elbv2.TargetGroupManager
+	elbv2TGTransitioner                 *elbv2.TargetGroupTransitioner
+	elbv2TrustStoreManager              elbv2.TrustStoreManager
 	elbv2TGBManager                     elbv2.TargetGroupBindingManager
+	elbv2LRFetchTracker                 *elbv2.ListenerRulesFetchTracker
+	wafv2WebACLManager                  wafv2.WebACLManager
 	wafv2WebACLAssociationManager       wafv2.WebACLAssociationManager
+	wafv2WebACLLoggingManager           wafv2.WebACLLoggingManager
 	wafRegionalWebACLAssociationManager wafregional.WebACLAssociationManager
 	shieldProtectionManager             shield.ProtectionManager
-	featureGates                        config.FeatureGates
-	vpcID                               string
+	gaAcceleratorManager                globalaccelerator.AcceleratorManager
+	route53RecordSetManager             route53.RecordSetManager
+	// progressStore is optional, and is nil when deploys aren't resumed.
+	progressStore *deployProgressStore
+	featureGates  config.FeatureGates
+	vpcID         string
 
 	logger logr.Logger
 }
@@ -85,17 +183,79 @@ type ResourceSynthesizer interface {
 
 // Deploy a resource stack.
 func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) error {
+	ctx, describeCacheUsage := elbv2.WithDescribeCacheUsage(ctx)
+	startTime := time.Now()
+	if err := d.deploy(ctx, stack); err != nil {
+		return err
+	}
+	d.elbv2DescribeCache.ObserveDeploy(describeCacheUsage, time.Since(startTime))
+	return nil
+}
+
+func (d *defaultStackDeployer) deploy(ctx context.Context, stack core.Stack) error {
+	progress := &deployProgress{}
+	var resumption *deployResumption
+	if d.progressStore != nil {
+		previousProgress, err := d.progressStore.Load(ctx, stack.StackID())
+		if err != nil {
+			// the stack is deployed from scratch if the progress of its last failed deploy is unavailable.
+			d.logger.Error(err, "unable to load deploy progress", "stackID", stack.StackID().String())
+		}
+		resumption = newDeployResumption(stack, previousProgress, d.deployConfig.ProgressMaxAge)
+	}
 	synthesizers := []ResourceSynthesizer{
 		ec2.NewSecurityGroupSynthesizer(d.cloud.EC2(), d.trackingProvider, d.ec2TaggingManager, d.ec2SGManager, d.vpcID, d.logger, stack),
-		elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.logger, d.featureGates, stack),
-		elbv2.NewLoadBalancerSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
-		elbv2.NewListenerSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
-		elbv2.NewListenerRuleSynthesizer(d.cloud.ELBV2(), d.elbv2TaggingManager, d.elbv2LRManager, d.logger, stack),
-		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack),
+		newStageTimeoutSynthesizer(
+			elbv2.NewTargetGroupSynthesizer(d.cloud.ELBV2(), d.trackingProvider, d.elbv2TaggingManager, d.elbv2TGManager, d.elbv2TGTransitioner, d.logger, d.featureGates, stack),
+			DeployStageTargetGroup, d.deployConfig.TargetGroupTimeout, progress),
+		elbv2.NewTrustStoreSynthesizer(d.trackingProvider, d.elbv2TaggingManager, d.elbv2TrustStoreManager, d.logger, stack),
+		// ElasticIPs are synthesized before LoadBalancers, so that they're released after their LoadBalancers are deleted.
+		ec2.NewElasticIPSynthesizer(d.trackingProvider, d.ec2TaggingManager, d.ec2EIPManager, d.logger, stack),
+	}
+	// VPCEndpointServices are synthesized before LoadBalancers, so that unneeded ones are deleted before their LoadBalancers.
+	if d.featureGates.Enabled(config.EndpointServices) {
+		synthesizers = append(synthesizers, ec2.NewVPCEndpointServiceSynthesizer(d.trackingProvider, d.ec2TaggingManager, d.ec2ESManager, d.logger, stack))
+	}
+	// Accelerators are synthesized before LoadBalancers, so that unneeded ones are deleted before their LoadBalancers.
+	if d.featureGates.Enabled(config.GlobalAccelerator) {
+		synthesizers = append(synthesizers, globalaccelerator.NewAcceleratorSynthesizer(d.trackingProvider, d.gaAcceleratorManager, d.logger, stack))
+	}
+	// RecordSets are synthesized before LoadBalancers, so that unneeded ones are deleted before their LoadBalancers.
+	if d.featureGates.Enabled(config.Route53AliasRecords) {
+		synthesizers = append(synthesizers, route53.NewRecordSetSynthesizer(d.trackingProvider, d.route53RecordSetManager, d.logger, stack))
 	}
+	// the listener rules are fetched only if changed since last synthesize, which relies on the checksum tagged on listeners.
+	var lrFetchTracker *elbv2.ListenerRulesFetchTracker
+	if d.featureGates.Enabled(config.ListenerRulesConditionalFetch) && d.featureGates.Enabled(config.ListenerRulesTagging) {
+		lrFetchTracker = d.elbv2LRFetchTracker
+	}
+	synthesizers = append(synthesizers,
+		// the TargetGroups are never resumed, as the unneeded ones are deleted by PostSynthesize.
+		newStageTimeoutSynthesizer(
+			newResumableStageSynthesizer(
+				elbv2.NewLoadBalancerSynthesizer(d.elbv2Client, d.trackingProvider, d.elbv2TaggingManager, d.elbv2LBManager, d.logger, stack),
+				DeployStageLoadBalancer, resumption, d.logger),
+			DeployStageLoadBalancer, d.deployConfig.LoadBalancerTimeout, progress),
+		newStageTimeoutSynthesizer(
+			newResumableStageSynthesizer(
+				elbv2.NewListenerSynthesizer(d.elbv2Client, d.elbv2TaggingManager, d.elbv2LSManager, d.logger, stack),
+				DeployStageListener, resumption, d.logger),
+			DeployStageListener, d.deployConfig.ListenerTimeout, progress),
+		newStageTimeoutSynthesizer(
+			newResumableStageSynthesizer(
+				elbv2.NewListenerRuleSynthesizer(d.elbv2Client, d.elbv2TaggingManager, d.elbv2LRManager, d.featureGates, lrFetchTracker, d.logger, stack),
+				DeployStageListenerRule, resumption, d.logger),
+			DeployStageListenerRule, d.deployConfig.ListenerRuleTimeout, progress),
+		elbv2.NewALBTargetSynthesizer(d.cloud.ELBV2(), d.logger, stack),
+		elbv2.NewTargetGroupBindingSynthesizer(d.k8sClient, d.trackingProvider, d.elbv2TGBManager, d.logger, stack),
+	)
 
 	if d.addonsConfig.WAFV2Enabled {
-		synthesizers = append(synthesizers, wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.logger, stack))
+		// WebACLs are synthesized before their associations, so that unneeded ones are deleted after they're disassociated.
+		synthesizers = append(synthesizers,
+			wafv2.NewWebACLSynthesizer(d.trackingProvider, d.wafv2WebACLManager, d.logger, stack),
+			wafv2.NewWebACLAssociationSynthesizer(d.wafv2WebACLAssociationManager, d.wafv2WebACLLoggingManager, d.logger, stack),
+		)
 	}
 	if d.addonsConfig.WAFEnabled && d.cloud.WAFRegional().Available() {
 		synthesizers = append(synthesizers, wafregional.NewWebACLAssociationSynthesizer(d.wafRegionalWebACLAssociationManager, d.logger, stack))
@@ -109,6 +269,21 @@ func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) err
 		}
 	}
 
+	deployErr := runSynthesizers(ctx, synthesizers)
+	if resumption != nil {
+		// the progress of a successful deploy is forgotten, so that the next deploy starts from scratch.
+		if deployErr == nil {
+			resumption.progress = stackProgress{}
+		}
+		if err := d.progressStore.Save(ctx, stack.StackID(), resumption.progress); err != nil {
+			d.logger.Error(err, "unable to save deploy progress", "stackID", stack.StackID().String())
+		}
+	}
+	return deployErr
+}
+
+// runSynthesizers runs Synthesize of all synthesizers in order, and then PostSynthesize of them in reverse order.
+func runSynthesizers(ctx context.Context, synthesizers []ResourceSynthesizer) error {
 	for _, synthesizer := range synthesizers {
 		if err := synthesizer.Synthesize(ctx); err != nil {
 			return err
@@ -119,6 +294,5 @@ func (d *defaultStackDeployer) Deploy(ctx context.Context, stack core.Stack) err
 			return err
 		}
 	}
-
 	return nil
 }
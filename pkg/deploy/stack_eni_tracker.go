@@ -0,0 +1,214 @@
+package deploy
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+const (
+	metricSubsystemLoadBalancerENIs = "load_balancer"
+	metricLoadBalancerENIs          = "enis"
+
+	labelENIKind      = "kind"
+	labelENINamespace = "namespace"
+	labelENIName      = "name"
+)
+
+// StackENIMetrics contains the metrics for the network interfaces of the load balancers of resource stacks.
+type StackENIMetrics struct {
+	enis *prometheus.GaugeVec
+}
+
+// NewStackENIMetrics allocates and register new StackENIMetrics to registerer.
+func NewStackENIMetrics(registerer prometheus.Registerer) (*StackENIMetrics, error) {
+	enis := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemLoadBalancerENIs,
+		Name:      metricLoadBalancerENIs,
+		Help:      "Number of network interfaces of the load balancers per object, including the ones of deleted load balancers that aren't released yet",
+	}, []string{labelENIKind, labelENINamespace, labelENIName})
+	if err := registerer.Register(enis); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricLoadBalancerENIs)
+	}
+	return &StackENIMetrics{
+		enis: enis,
+	}, nil
+}
+
+func (m *StackENIMetrics) setENIs(kind string, stackID core.StackID, count int) {
+	if m == nil {
+		return
+	}
+	m.enis.WithLabelValues(kind, stackID.Namespace, stackID.Name).Set(float64(count))
+}
+
+func (m *StackENIMetrics) deleteENIs(kind string, stackID core.StackID) {
+	if m == nil {
+		return
+	}
+	m.enis.DeleteLabelValues(kind, stackID.Namespace, stackID.Name)
+}
+
+// StackENITracker tracks the network interfaces of the load balancers of resource stacks.
+// AWS releases the network interfaces of load balancers asynchronously after they're deleted, which blocks the deletion
+// of their subnets and securityGroups meanwhile.
+type StackENITracker interface {
+	// Track discovers the network interfaces of the load balancers of stack, and returns their IDs.
+	// the network interfaces of previously tracked load balancers of stack are included until they're released.
+	Track(ctx context.Context, stack core.Stack) ([]string, error)
+
+	// PendingRelease returns the IDs of the network interfaces of the tracked load balancers of stack that aren't released yet.
+	// the stack is forgotten once all of its network interfaces are released.
+	PendingRelease(ctx context.Context, stack core.Stack) ([]string, error)
+}
+
+// NewDefaultStackENITracker constructs new defaultStackENITracker.
+// kind is the kind of object that owns the stacks, e.g. IngressGroup or Service.
+func NewDefaultStackENITracker(cloud aws.Cloud, config config.ControllerConfig, tagPrefix string, kind string,
+	metrics *StackENIMetrics, logger logr.Logger) *defaultStackENITracker {
+	return &defaultStackENITracker{
+		ec2Client:           cloud.EC2(),
+		vpcID:               cloud.VpcID(),
+		trackingProvider:    tracking.NewDefaultProvider(tagPrefix, config.ClusterName, config.DeniedTagKeyPrefixes),
+		elbv2TaggingManager: elbv2.NewDefaultTaggingManager(cloud.ELBV2(), cloud.VpcID(), config.FeatureGates, cloud.RGT(), config.StrictTagEnforcement(), logger),
+		kind:                kind,
+		metrics:             metrics,
+		logger:              logger,
+		descriptionsByStack: make(map[core.StackID]sets.String),
+	}
+}
+
+var _ StackENITracker = &defaultStackENITracker{}
+
+// defaultStackENITracker is the default implementation for StackENITracker.
+// the network interfaces of load balancers are identified by their description "ELB <type>/<name>/<id>", as they aren't tagged.
+// the descriptions are kept in memory, thus the network interfaces of load balancers deleted before the controller restarts aren't tracked.
+type defaultStackENITracker struct {
+	ec2Client           services.EC2
+	vpcID               string
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2.TaggingManager
+	kind                string
+	metrics             *StackENIMetrics
+	logger              logr.Logger
+
+	// descriptionsMutex protects descriptionsByStack.
+	descriptionsMutex   sync.Mutex
+	descriptionsByStack map[core.StackID]sets.String
+}
+
+func (t *defaultStackENITracker) Track(ctx context.Context, stack core.Stack) ([]string, error) {
+	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx,
+		tracking.TagsAsTagFilter(t.trackingProvider.StackTags(stack)),
+		tracking.TagsAsTagFilter(t.trackingProvider.StackTagsLegacy(stack)))
+	if err != nil {
+		return nil, err
+	}
+	lbDescriptions := sets.NewString()
+	for _, sdkLB := range sdkLBs {
+		description, err := buildLoadBalancerENIDescription(awssdk.ToString(sdkLB.LoadBalancer.LoadBalancerArn))
+		if err != nil {
+			return nil, err
+		}
+		lbDescriptions.Insert(description)
+	}
+	descriptions := lbDescriptions.Union(t.trackedDescriptions(stack.StackID()))
+	sdkENIs, err := t.describeENIs(ctx, descriptions)
+	if err != nil {
+		return nil, err
+	}
+	// the descriptions of deleted load balancers are only tracked until their network interfaces are released.
+	for _, sdkENI := range sdkENIs {
+		lbDescriptions.Insert(awssdk.ToString(sdkENI.Description))
+	}
+	t.setTrackedDescriptions(stack.StackID(), lbDescriptions)
+	eniIDs := extractENIIDs(sdkENIs)
+	t.metrics.setENIs(t.kind, stack.StackID(), len(eniIDs))
+	return eniIDs, nil
+}
+
+func (t *defaultStackENITracker) PendingRelease(ctx context.Context, stack core.Stack) ([]string, error) {
+	descriptions := t.trackedDescriptions(stack.StackID())
+	sdkENIs, err := t.describeENIs(ctx, descriptions)
+	if err != nil {
+		return nil, err
+	}
+	eniIDs := extractENIIDs(sdkENIs)
+	if len(eniIDs) != 0 {
+		t.logger.V(1).Info("network interfaces of deleted load balancers aren't released yet", "stackID", stack.StackID().String(), "networkInterfaceIDs", eniIDs)
+		t.metrics.setENIs(t.kind, stack.StackID(), len(eniIDs))
+		return eniIDs, nil
+	}
+	t.setTrackedDescriptions(stack.StackID(), nil)
+	t.metrics.deleteENIs(t.kind, stack.StackID())
+	return nil, nil
+}
+
+func (t *defaultStackENITracker) describeENIs(ctx context.Context, descriptions sets.String) ([]ec2types.NetworkInterface, error) {
+	if len(descriptions) == 0 {
+		return nil, nil
+	}
+	req := &ec2sdk.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("vpc-id"),
+				Values: []string{t.vpcID},
+			},
+			{
+				Name:   awssdk.String("description"),
+				Values: descriptions.List(),
+			},
+		},
+	}
+	return t.ec2Client.DescribeNetworkInterfacesAsList(ctx, req)
+}
+
+func (t *defaultStackENITracker) trackedDescriptions(stackID core.StackID) sets.String {
+	t.descriptionsMutex.Lock()
+	defer t.descriptionsMutex.Unlock()
+	return sets.NewString(t.descriptionsByStack[stackID].UnsortedList()...)
+}
+
+func (t *defaultStackENITracker) setTrackedDescriptions(stackID core.StackID, descriptions sets.String) {
+	t.descriptionsMutex.Lock()
+	defer t.descriptionsMutex.Unlock()
+	if len(descriptions) == 0 {
+		delete(t.descriptionsByStack, stackID)
+		return
+	}
+	t.descriptionsByStack[stackID] = descriptions
+}
+
+// buildLoadBalancerENIDescription returns the description of the network interfaces of load balancer with lbARN,
+// e.g. "ELB app/my-lb/50dc6c495c0c9188" for "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188".
+func buildLoadBalancerENIDescription(lbARN string) (string, error) {
+	parts := strings.SplitN(lbARN, ":loadbalancer/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", errors.Errorf("invalid load balancer ARN: %v", lbARN)
+	}
+	return "ELB " + parts[1], nil
+}
+
+func extractENIIDs(sdkENIs []ec2types.NetworkInterface) []string {
+	eniIDs := make([]string, 0, len(sdkENIs))
+	for _, sdkENI := range sdkENIs {
+		eniIDs = append(eniIDs, awssdk.ToString(sdkENI.NetworkInterfaceId))
+	}
+	sort.Strings(eniIDs)
+	return eniIDs
+}
@@ -0,0 +1,126 @@
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultStackENITracker(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ec2Client := services.NewMockEC2(ctrl)
+	elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
+	tracker := &defaultStackENITracker{
+		ec2Client:           ec2Client,
+		vpcID:               "vpc-xxx",
+		trackingProvider:    tracking.NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
+		elbv2TaggingManager: elbv2TaggingManager,
+		kind:                "IngressGroup",
+		logger:              log.Log,
+		descriptionsByStack: make(map[core.StackID]sets.String),
+	}
+	stack := core.NewDefaultStack(core.StackID{Namespace: "awesome-ns", Name: "ing-1"})
+	lbDescription := "ELB app/k8s-awesomen-ing1-1234567890/50dc6c495c0c9188"
+	describeENIsReq := &ec2sdk.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("vpc-id"),
+				Values: []string{"vpc-xxx"},
+			},
+			{
+				Name:   awssdk.String("description"),
+				Values: []string{lbDescription},
+			},
+		},
+	}
+	sdkENIs := []ec2types.NetworkInterface{
+		{NetworkInterfaceId: awssdk.String("eni-b"), Description: awssdk.String(lbDescription)},
+		{NetworkInterfaceId: awssdk.String("eni-a"), Description: awssdk.String(lbDescription)},
+	}
+
+	// the network interfaces of the load balancer are tracked.
+	elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any(), gomock.Any()).Return([]elbv2.LoadBalancerWithTags{
+		{
+			LoadBalancer: &elbv2types.LoadBalancer{
+				LoadBalancerArn: awssdk.String("arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/k8s-awesomen-ing1-1234567890/50dc6c495c0c9188"),
+			},
+		},
+	}, nil)
+	ec2Client.EXPECT().DescribeNetworkInterfacesAsList(gomock.Any(), describeENIsReq).Return(sdkENIs, nil)
+	eniIDs, err := tracker.Track(context.Background(), stack)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eni-a", "eni-b"}, eniIDs)
+
+	// the network interfaces of the deleted load balancer are still tracked until they're released.
+	elbv2TaggingManager.EXPECT().ListLoadBalancers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+	ec2Client.EXPECT().DescribeNetworkInterfacesAsList(gomock.Any(), describeENIsReq).Return(sdkENIs[:1], nil)
+	eniIDs, err = tracker.Track(context.Background(), stack)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eni-b"}, eniIDs)
+
+	ec2Client.EXPECT().DescribeNetworkInterfacesAsList(gomock.Any(), describeENIsReq).Return(sdkENIs[:1], nil)
+	eniIDs, err = tracker.PendingRelease(context.Background(), stack)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eni-b"}, eniIDs)
+
+	// the stack is forgotten once the network interfaces are released.
+	ec2Client.EXPECT().DescribeNetworkInterfacesAsList(gomock.Any(), describeENIsReq).Return(nil, nil)
+	eniIDs, err = tracker.PendingRelease(context.Background(), stack)
+	assert.NoError(t, err)
+	assert.Empty(t, eniIDs)
+	assert.Empty(t, tracker.descriptionsByStack)
+
+	eniIDs, err = tracker.PendingRelease(context.Background(), stack)
+	assert.NoError(t, err)
+	assert.Empty(t, eniIDs)
+}
+
+func Test_buildLoadBalancerENIDescription(t *testing.T) {
+	tests := []struct {
+		name    string
+		lbARN   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "application load balancer",
+			lbARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			want:  "ELB app/my-lb/50dc6c495c0c9188",
+		},
+		{
+			name:  "network load balancer",
+			lbARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/net/my-lb/50dc6c495c0c9188",
+			want:  "ELB net/my-lb/50dc6c495c0c9188",
+		},
+		{
+			name:    "invalid ARN",
+			lbARN:   "my-lb",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildLoadBalancerENIDescription(tt.lbARN)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
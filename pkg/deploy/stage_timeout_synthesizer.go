@@ -0,0 +1,105 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DeployStage is a stage of deploying a resource stack, which is bounded by its own timeout.
+type DeployStage string
+
+const (
+	DeployStageTargetGroup  DeployStage = "targetGroup"
+	DeployStageLoadBalancer DeployStage = "loadBalancer"
+	DeployStageListener     DeployStage = "listener"
+	DeployStageListenerRule DeployStage = "listenerRule"
+)
+
+// DeployStageTimeoutError is returned by Deploy when a stage times out.
+// the changes of the stages completed beforehand are applied regardless, and are picked up by the next reconcile.
+type DeployStageTimeoutError struct {
+	Stage           DeployStage
+	Timeout         time.Duration
+	CompletedStages []DeployStage
+	Err             error
+}
+
+func (e *DeployStageTimeoutError) Error() string {
+	completedStages := make([]string, 0, len(e.CompletedStages))
+	for _, stage := range e.CompletedStages {
+		completedStages = append(completedStages, string(stage))
+	}
+	return fmt.Sprintf("deploy stage %v timed out after %v, completed stages: [%v]: %v",
+		e.Stage, e.Timeout, strings.Join(completedStages, ","), e.Err)
+}
+
+func (e *DeployStageTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// deployProgress tracks the stages completed by a single deploy.
+type deployProgress struct {
+	completedStages []DeployStage
+}
+
+// newStageTimeoutSynthesizer constructs new stageTimeoutSynthesizer.
+func newStageTimeoutSynthesizer(synthesizer ResourceSynthesizer, stage DeployStage, timeout time.Duration, progress *deployProgress) *stageTimeoutSynthesizer {
+	return &stageTimeoutSynthesizer{
+		synthesizer: synthesizer,
+		stage:       stage,
+		timeout:     timeout,
+		progress:    progress,
+	}
+}
+
+var _ ResourceSynthesizer = &stageTimeoutSynthesizer{}
+
+// stageTimeoutSynthesizer bounds both Synthesize and PostSynthesize of a ResourceSynthesizer by the timeout of its stage,
+// so that a hung AWS API call doesn't pin the reconcile worker for the full timeout of the AWS client.
+// the stage isn't bounded if the timeout is zero.
+type stageTimeoutSynthesizer struct {
+	synthesizer ResourceSynthesizer
+	stage       DeployStage
+	timeout     time.Duration
+	progress    *deployProgress
+}
+
+func (s *stageTimeoutSynthesizer) Synthesize(ctx context.Context) error {
+	if err := s.runWithTimeout(ctx, s.synthesizer.Synthesize); err != nil {
+		return err
+	}
+	s.progress.completedStages = append(s.progress.completedStages, s.stage)
+	return nil
+}
+
+func (s *stageTimeoutSynthesizer) PostSynthesize(ctx context.Context) error {
+	return s.runWithTimeout(ctx, s.synthesizer.PostSynthesize)
+}
+
+func (s *stageTimeoutSynthesizer) runWithTimeout(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.timeout == 0 {
+		return fn(ctx)
+	}
+	stageCtx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	err := fn(stageCtx)
+	if err == nil {
+		return nil
+	}
+	// the cancellation of the reconcile itself isn't attributed to the stage.
+	if ctx.Err() == nil && errors.Is(stageCtx.Err(), context.DeadlineExceeded) {
+		completedStages := make([]DeployStage, len(s.progress.completedStages))
+		copy(completedStages, s.progress.completedStages)
+		return &DeployStageTimeoutError{
+			Stage:           s.stage,
+			Timeout:         s.timeout,
+			CompletedStages: completedStages,
+			Err:             err,
+		}
+	}
+	return err
+}
@@ -0,0 +1,107 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeSynthesizer blocks until its context is done when hung, or returns err otherwise.
+type fakeSynthesizer struct {
+	hung bool
+	err  error
+}
+
+func (s *fakeSynthesizer) Synthesize(ctx context.Context) error {
+	return s.run(ctx)
+}
+
+func (s *fakeSynthesizer) PostSynthesize(ctx context.Context) error {
+	return s.run(ctx)
+}
+
+func (s *fakeSynthesizer) run(ctx context.Context) error {
+	if s.hung {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	return s.err
+}
+
+func Test_stageTimeoutSynthesizer_Synthesize(t *testing.T) {
+	tests := []struct {
+		name               string
+		synthesizer        *fakeSynthesizer
+		timeout            time.Duration
+		cancelParent       bool
+		wantErr            error
+		wantCompletedStage bool
+	}{
+		{
+			name:               "stage completes",
+			synthesizer:        &fakeSynthesizer{},
+			timeout:            time.Second,
+			wantCompletedStage: true,
+		},
+		{
+			name:               "unbounded stage completes",
+			synthesizer:        &fakeSynthesizer{},
+			wantCompletedStage: true,
+		},
+		{
+			name:        "stage fails",
+			synthesizer: &fakeSynthesizer{err: errors.New("some error")},
+			timeout:     time.Second,
+			wantErr:     errors.New("some error"),
+		},
+		{
+			name:        "stage times out",
+			synthesizer: &fakeSynthesizer{hung: true},
+			timeout:     10 * time.Millisecond,
+			wantErr:     errors.New("deploy stage listener timed out after 10ms, completed stages: [targetGroup,loadBalancer]: context deadline exceeded"),
+		},
+		{
+			name:         "reconcile canceled",
+			synthesizer:  &fakeSynthesizer{hung: true},
+			timeout:      time.Minute,
+			cancelParent: true,
+			wantErr:      context.Canceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelParent {
+				cancel()
+			}
+			progress := &deployProgress{completedStages: []DeployStage{DeployStageTargetGroup, DeployStageLoadBalancer}}
+			synthesizer := newStageTimeoutSynthesizer(tt.synthesizer, DeployStageListener, tt.timeout, progress)
+			err := synthesizer.Synthesize(ctx)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			wantCompletedStages := []DeployStage{DeployStageTargetGroup, DeployStageLoadBalancer}
+			if tt.wantCompletedStage {
+				wantCompletedStages = append(wantCompletedStages, DeployStageListener)
+			}
+			assert.Equal(t, wantCompletedStages, progress.completedStages)
+		})
+	}
+}
+
+func Test_stageTimeoutSynthesizer_PostSynthesize(t *testing.T) {
+	progress := &deployProgress{}
+	synthesizer := newStageTimeoutSynthesizer(&fakeSynthesizer{hung: true}, DeployStageTargetGroup, 10*time.Millisecond, progress)
+	err := synthesizer.PostSynthesize(context.Background())
+	var timeoutErr *DeployStageTimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+	assert.Equal(t, DeployStageTargetGroup, timeoutErr.Stage)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Empty(t, progress.completedStages)
+}
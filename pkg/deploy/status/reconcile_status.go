@@ -0,0 +1,180 @@
+package status
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gamodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/globalaccelerator"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationPrefixIngress is the prefix of reconcile status annotations on Ingresses.
+	AnnotationPrefixIngress = "ingress.k8s.aws"
+	// AnnotationPrefixService is the prefix of reconcile status annotations on Services.
+	AnnotationPrefixService = "service.k8s.aws"
+
+	annotationSuffixLoadBalancerARN    = "load-balancer-arn"
+	annotationSuffixTargetGroupARNs    = "target-group-arns"
+	annotationSuffixLastReconcileTime  = "last-reconcile-time"
+	annotationSuffixLastReconcileError = "last-reconcile-error"
+	annotationSuffixGADNSName          = "global-accelerator-dns-name"
+	annotationSuffixGAIPs              = "global-accelerator-ips"
+	annotationSuffixCapacityState      = "capacity-reservation-state"
+	annotationSuffixENIIDs             = "load-balancer-eni-ids"
+)
+
+var annotationSuffixes = []string{
+	annotationSuffixLoadBalancerARN,
+	annotationSuffixTargetGroupARNs,
+	annotationSuffixLastReconcileTime,
+	annotationSuffixLastReconcileError,
+	annotationSuffixGADNSName,
+	annotationSuffixGAIPs,
+	annotationSuffixCapacityState,
+	annotationSuffixENIIDs,
+}
+
+// ReconcileStatus describes the outcome of the last reconcile of an object.
+type ReconcileStatus struct {
+	// the ARN of load balancer provisioned for the object, empty if there is no load balancer.
+	LoadBalancerARN string
+	// the ARNs of target groups provisioned for the object, in lexical order.
+	TargetGroupARNs []string
+	// the state of the capacity reservation of load balancer, empty if there is no capacity reserved.
+	CapacityReservationState string
+	// the DNS name of global accelerator provisioned for the object, empty if there is no global accelerator.
+	GlobalAcceleratorDNSName string
+	// the static IP addresses of global accelerator provisioned for the object.
+	GlobalAcceleratorIPs []string
+	// the IDs of the network interfaces of load balancer, in lexical order. It's only tracked if LoadBalancerENITracking is enabled.
+	NetworkInterfaceIDs []string
+	// the time the reconcile finished.
+	ReconcileTime time.Time
+	// the error the reconcile failed with, nil if the reconcile succeeded.
+	Err error
+}
+
+// NewSucceededReconcileStatus builds ReconcileStatus for a succeeded reconcile from the deployed stack.
+// lb is the load balancer provisioned for the object, which can be nil if there is none.
+func NewSucceededReconcileStatus(ctx context.Context, stack core.Stack, lb *elbv2model.LoadBalancer, reconcileTime time.Time) (ReconcileStatus, error) {
+	reconcileStatus := ReconcileStatus{ReconcileTime: reconcileTime}
+	if lb != nil {
+		lbARN, err := lb.LoadBalancerARN().Resolve(ctx)
+		if err != nil {
+			return ReconcileStatus{}, err
+		}
+		reconcileStatus.LoadBalancerARN = lbARN
+		if lb.Status != nil {
+			reconcileStatus.CapacityReservationState = lb.Status.CapacityReservationState
+		}
+	}
+	var resTGs []*elbv2model.TargetGroup
+	if err := stack.ListResources(&resTGs); err != nil {
+		return ReconcileStatus{}, err
+	}
+	for _, resTG := range resTGs {
+		tgARN, err := resTG.TargetGroupARN().Resolve(ctx)
+		if err != nil {
+			return ReconcileStatus{}, err
+		}
+		reconcileStatus.TargetGroupARNs = append(reconcileStatus.TargetGroupARNs, tgARN)
+	}
+	sort.Strings(reconcileStatus.TargetGroupARNs)
+	var resAccelerators []*gamodel.Accelerator
+	if err := stack.ListResources(&resAccelerators); err != nil {
+		return ReconcileStatus{}, err
+	}
+	for _, resAccelerator := range resAccelerators {
+		if resAccelerator.Status == nil {
+			continue
+		}
+		reconcileStatus.GlobalAcceleratorDNSName = resAccelerator.Status.DNSName
+		reconcileStatus.GlobalAcceleratorIPs = resAccelerator.Status.IPAddresses
+	}
+	return reconcileStatus, nil
+}
+
+// NewFailedReconcileStatus builds ReconcileStatus for a failed reconcile.
+func NewFailedReconcileStatus(err error, reconcileTime time.Time) ReconcileStatus {
+	return ReconcileStatus{ReconcileTime: reconcileTime, Err: err}
+}
+
+// ApplyAnnotations returns a copy of annotations with the reconcile status applied.
+// The resource ARNs are kept as is for failed reconciles, since the resources might be partially deployed.
+func ApplyAnnotations(prefix string, annotations map[string]string, reconcileStatus ReconcileStatus) map[string]string {
+	result := make(map[string]string, len(annotations)+len(annotationSuffixes))
+	for key, value := range annotations {
+		result[key] = value
+	}
+	result[buildAnnotationKey(prefix, annotationSuffixLastReconcileTime)] = reconcileStatus.ReconcileTime.UTC().Format(time.RFC3339)
+	if reconcileStatus.Err != nil {
+		result[buildAnnotationKey(prefix, annotationSuffixLastReconcileError)] = reconcileStatus.Err.Error()
+		return result
+	}
+	delete(result, buildAnnotationKey(prefix, annotationSuffixLastReconcileError))
+	setOrDeleteAnnotation(result, buildAnnotationKey(prefix, annotationSuffixLoadBalancerARN), reconcileStatus.LoadBalancerARN)
+	setOrDeleteAnnotation(result, buildAnnotationKey(prefix, annotationSuffixTargetGroupARNs), strings.Join(reconcileStatus.TargetGroupARNs, ","))
+	setOrDeleteAnnotation(result, buildAnnotationKey(prefix, annotationSuffixCapacityState), reconcileStatus.CapacityReservationState)
+	setOrDeleteAnnotation(result, buildAnnotationKey(prefix, annotationSuffixGADNSName), reconcileStatus.GlobalAcceleratorDNSName)
+	setOrDeleteAnnotation(result, buildAnnotationKey(prefix, annotationSuffixGAIPs), strings.Join(reconcileStatus.GlobalAcceleratorIPs, ","))
+	setOrDeleteAnnotation(result, buildAnnotationKey(prefix, annotationSuffixENIIDs), strings.Join(reconcileStatus.NetworkInterfaceIDs, ","))
+	return result
+}
+
+// StripAnnotations returns a copy of annotations without the reconcile status annotations.
+// It's used by event handlers to ignore the changes made by the reconcile status itself.
+func StripAnnotations(prefix string, annotations map[string]string) map[string]string {
+	if annotations == nil {
+		return nil
+	}
+	result := make(map[string]string, len(annotations))
+	for key, value := range annotations {
+		result[key] = value
+	}
+	for _, suffix := range annotationSuffixes {
+		delete(result, buildAnnotationKey(prefix, suffix))
+	}
+	return result
+}
+
+// UpdateAnnotations patches the reconcile status annotations of obj.
+func UpdateAnnotations(ctx context.Context, k8sClient client.Client, obj client.Object, prefix string, reconcileStatus ReconcileStatus) error {
+	return patchAnnotations(ctx, k8sClient, obj, ApplyAnnotations(prefix, obj.GetAnnotations(), reconcileStatus))
+}
+
+// RemoveAnnotations patches obj to remove the reconcile status annotations.
+func RemoveAnnotations(ctx context.Context, k8sClient client.Client, obj client.Object, prefix string) error {
+	return patchAnnotations(ctx, k8sClient, obj, StripAnnotations(prefix, obj.GetAnnotations()))
+}
+
+func patchAnnotations(ctx context.Context, k8sClient client.Client, obj client.Object, annotations map[string]string) error {
+	if equality.Semantic.DeepEqual(annotations, obj.GetAnnotations()) {
+		return nil
+	}
+	objOld := obj.DeepCopyObject().(client.Object)
+	obj.SetAnnotations(annotations)
+	if err := k8sClient.Patch(ctx, obj, client.MergeFrom(objOld)); err != nil {
+		return errors.Wrapf(err, "failed to update reconcile status annotations: %v", k8s.NamespacedName(obj))
+	}
+	return nil
+}
+
+func setOrDeleteAnnotation(annotations map[string]string, key string, value string) {
+	if value == "" {
+		delete(annotations, key)
+		return
+	}
+	annotations[key] = value
+}
+
+func buildAnnotationKey(prefix string, suffix string) string {
+	return prefix + "/" + suffix
+}
@@ -0,0 +1,164 @@
+package status
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ApplyAnnotations(t *testing.T) {
+	reconcileTime := time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC)
+	type args struct {
+		annotations     map[string]string
+		reconcileStatus ReconcileStatus
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]string
+	}{
+		{
+			name: "succeeded reconcile on object without annotations",
+			args: args{
+				annotations: nil,
+				reconcileStatus: ReconcileStatus{
+					LoadBalancerARN: "lb-arn",
+					TargetGroupARNs: []string{"tg-arn-1", "tg-arn-2"},
+					ReconcileTime:   reconcileTime,
+				},
+			},
+			want: map[string]string{
+				"ingress.k8s.aws/load-balancer-arn":   "lb-arn",
+				"ingress.k8s.aws/target-group-arns":   "tg-arn-1,tg-arn-2",
+				"ingress.k8s.aws/last-reconcile-time": "2023-05-01T10:30:00Z",
+			},
+		},
+		{
+			name: "succeeded reconcile clears previous error and stale ARNs",
+			args: args{
+				annotations: map[string]string{
+					"kubernetes.io/ingress.class":          "alb",
+					"ingress.k8s.aws/load-balancer-arn":    "lb-arn",
+					"ingress.k8s.aws/target-group-arns":    "tg-arn-1",
+					"ingress.k8s.aws/last-reconcile-time":  "2023-05-01T10:00:00Z",
+					"ingress.k8s.aws/last-reconcile-error": "some error",
+				},
+				reconcileStatus: ReconcileStatus{
+					ReconcileTime: reconcileTime,
+				},
+			},
+			want: map[string]string{
+				"kubernetes.io/ingress.class":         "alb",
+				"ingress.k8s.aws/last-reconcile-time": "2023-05-01T10:30:00Z",
+			},
+		},
+		{
+			name: "succeeded reconcile with global accelerator",
+			args: args{
+				annotations: nil,
+				reconcileStatus: ReconcileStatus{
+					LoadBalancerARN:          "lb-arn",
+					GlobalAcceleratorDNSName: "a1234567890abcdef.awsglobalaccelerator.com",
+					GlobalAcceleratorIPs:     []string{"75.2.0.1", "99.83.0.1"},
+					ReconcileTime:            reconcileTime,
+				},
+			},
+			want: map[string]string{
+				"ingress.k8s.aws/load-balancer-arn":           "lb-arn",
+				"ingress.k8s.aws/global-accelerator-dns-name": "a1234567890abcdef.awsglobalaccelerator.com",
+				"ingress.k8s.aws/global-accelerator-ips":      "75.2.0.1,99.83.0.1",
+				"ingress.k8s.aws/last-reconcile-time":         "2023-05-01T10:30:00Z",
+			},
+		},
+		{
+			name: "succeeded reconcile with capacity reservation",
+			args: args{
+				annotations: nil,
+				reconcileStatus: ReconcileStatus{
+					LoadBalancerARN:          "lb-arn",
+					CapacityReservationState: "provisioned",
+					ReconcileTime:            reconcileTime,
+				},
+			},
+			want: map[string]string{
+				"ingress.k8s.aws/load-balancer-arn":          "lb-arn",
+				"ingress.k8s.aws/capacity-reservation-state": "provisioned",
+				"ingress.k8s.aws/last-reconcile-time":        "2023-05-01T10:30:00Z",
+			},
+		},
+		{
+			name: "succeeded reconcile with network interfaces",
+			args: args{
+				annotations: nil,
+				reconcileStatus: ReconcileStatus{
+					LoadBalancerARN:     "lb-arn",
+					NetworkInterfaceIDs: []string{"eni-a", "eni-b"},
+					ReconcileTime:       reconcileTime,
+				},
+			},
+			want: map[string]string{
+				"ingress.k8s.aws/load-balancer-arn":     "lb-arn",
+				"ingress.k8s.aws/load-balancer-eni-ids": "eni-a,eni-b",
+				"ingress.k8s.aws/last-reconcile-time":   "2023-05-01T10:30:00Z",
+			},
+		},
+		{
+			name: "failed reconcile keeps previous ARNs",
+			args: args{
+				annotations: map[string]string{
+					"ingress.k8s.aws/load-balancer-arn":   "lb-arn",
+					"ingress.k8s.aws/target-group-arns":   "tg-arn-1",
+					"ingress.k8s.aws/last-reconcile-time": "2023-05-01T10:00:00Z",
+				},
+				reconcileStatus: NewFailedReconcileStatus(errors.New("some error"), reconcileTime),
+			},
+			want: map[string]string{
+				"ingress.k8s.aws/load-balancer-arn":    "lb-arn",
+				"ingress.k8s.aws/target-group-arns":    "tg-arn-1",
+				"ingress.k8s.aws/last-reconcile-time":  "2023-05-01T10:30:00Z",
+				"ingress.k8s.aws/last-reconcile-error": "some error",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ApplyAnnotations(AnnotationPrefixIngress, tt.args.annotations, tt.args.reconcileStatus)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_StripAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        map[string]string
+	}{
+		{
+			name:        "nil annotations",
+			annotations: nil,
+			want:        nil,
+		},
+		{
+			name: "reconcile status annotations are removed",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type": "external",
+				"service.k8s.aws/load-balancer-arn":                 "lb-arn",
+				"service.k8s.aws/target-group-arns":                 "tg-arn-1",
+				"service.k8s.aws/last-reconcile-time":               "2023-05-01T10:00:00Z",
+				"service.k8s.aws/last-reconcile-error":              "some error",
+			},
+			want: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type": "external",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StripAnnotations(AnnotationPrefixService, tt.annotations)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -63,10 +63,12 @@ type Provider interface {
 }
 
 // NewDefaultProvider constructs defaultProvider
-func NewDefaultProvider(tagPrefix string, clusterName string) *defaultProvider {
+// additional tags with any of deniedTagKeyPrefixes are never included in the resource tags.
+func NewDefaultProvider(tagPrefix string, clusterName string, deniedTagKeyPrefixes []string) *defaultProvider {
 	return &defaultProvider{
-		tagPrefix:   tagPrefix,
-		clusterName: clusterName,
+		tagPrefix:            tagPrefix,
+		clusterName:          clusterName,
+		deniedTagKeyPrefixes: deniedTagKeyPrefixes,
 	}
 }
 
@@ -74,8 +76,9 @@ var _ Provider = &defaultProvider{}
 
 // defaultImplementation for Provider
 type defaultProvider struct {
-	tagPrefix   string
-	clusterName string
+	tagPrefix            string
+	clusterName          string
+	deniedTagKeyPrefixes []string
 }
 
 func (p *defaultProvider) ResourceIDTagKey() string {
@@ -95,7 +98,8 @@ func (p *defaultProvider) ResourceTags(stack core.Stack, res core.Resource, addi
 	resourceIDTags := map[string]string{
 		p.ResourceIDTagKey(): res.ID(),
 	}
-	return algorithm.MergeStringMap(stackTags, resourceIDTags, additionalTags)
+	allowedAdditionalTags := FilterDeniedTags(additionalTags, p.deniedTagKeyPrefixes)
+	return algorithm.MergeStringMap(stackTags, resourceIDTags, allowedAdditionalTags)
 }
 
 func (p *defaultProvider) StackLabels(stack core.Stack) map[string]string {
@@ -14,12 +14,12 @@ func Test_defaultProvider_ResourceIDTagKey(t *testing.T) {
 	}{
 		{
 			name:     "resourceTagKey for Ingress",
-			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
 			want:     "ingress.k8s.aws/resource",
 		},
 		{
 			name:     "resourceTagKey for Service",
-			provider: NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("service.k8s.aws", "cluster-name", nil),
 			want:     "service.k8s.aws/resource",
 		},
 	}
@@ -43,7 +43,7 @@ func Test_defaultProvider_StackTags(t *testing.T) {
 	}{
 		{
 			name:     "stackTags for explicit IngressGroup",
-			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "", Name: "awesome-group"})},
 			want: map[string]string{
 				"elbv2.k8s.aws/cluster": "cluster-name",
@@ -52,7 +52,7 @@ func Test_defaultProvider_StackTags(t *testing.T) {
 		},
 		{
 			name:     "stackTags for implicit IngressGroup",
-			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "ingressName"})},
 			want: map[string]string{
 				"elbv2.k8s.aws/cluster": "cluster-name",
@@ -61,7 +61,7 @@ func Test_defaultProvider_StackTags(t *testing.T) {
 		},
 		{
 			name:     "stackTags for Service",
-			provider: NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("service.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
 			want: map[string]string{
 				"elbv2.k8s.aws/cluster": "cluster-name",
@@ -94,7 +94,7 @@ func Test_defaultProvider_ResourceTags(t *testing.T) {
 	}{
 		{
 			name:     "resourceTags for Ingress",
-			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
 			args: args{
 				stack: stack,
 				res:   fakeRes,
@@ -105,6 +105,42 @@ func Test_defaultProvider_ResourceTags(t *testing.T) {
 				"ingress.k8s.aws/resource": "fake-id",
 			},
 		},
+		{
+			name:     "resourceTags with additional tags",
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
+			args: args{
+				stack: stack,
+				res:   fakeRes,
+				additionalTags: map[string]string{
+					"team": "my-team",
+				},
+			},
+			want: map[string]string{
+				"elbv2.k8s.aws/cluster":    "cluster-name",
+				"ingress.k8s.aws/stack":    "namespace/ingressName",
+				"ingress.k8s.aws/resource": "fake-id",
+				"team":                     "my-team",
+			},
+		},
+		{
+			name:     "resourceTags with additional tags that have denied prefixes",
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", []string{"security/", "cost-center"}),
+			args: args{
+				stack: stack,
+				res:   fakeRes,
+				additionalTags: map[string]string{
+					"team":                "my-team",
+					"security/compliance": "pci",
+					"cost-center":         "1234",
+				},
+			},
+			want: map[string]string{
+				"elbv2.k8s.aws/cluster":    "cluster-name",
+				"ingress.k8s.aws/stack":    "namespace/ingressName",
+				"ingress.k8s.aws/resource": "fake-id",
+				"team":                     "my-team",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -126,7 +162,7 @@ func Test_defaultProvider_StackLabels(t *testing.T) {
 	}{
 		{
 			name:     "stackLabels for explicit IngressGroup",
-			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "", Name: "awesome-group"})},
 			want: map[string]string{
 				"ingress.k8s.aws/stack": "awesome-group",
@@ -134,7 +170,7 @@ func Test_defaultProvider_StackLabels(t *testing.T) {
 		},
 		{
 			name:     "stackLabels for implicit IngressGroup",
-			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "ingressName"})},
 			want: map[string]string{
 				"ingress.k8s.aws/stack-namespace": "namespace",
@@ -143,7 +179,7 @@ func Test_defaultProvider_StackLabels(t *testing.T) {
 		},
 		{
 			name:     "stackLabels for Service",
-			provider: NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("service.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
 			want: map[string]string{
 				"service.k8s.aws/stack-namespace": "namespace",
@@ -171,7 +207,7 @@ func Test_defaultProvider_StackTagsLegacy(t *testing.T) {
 	}{
 		{
 			name:     "stackTags for explicit IngressGroup",
-			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "", Name: "awesome-group"})},
 			want: map[string]string{
 				"ingress.k8s.aws/cluster": "cluster-name",
@@ -180,7 +216,7 @@ func Test_defaultProvider_StackTagsLegacy(t *testing.T) {
 		},
 		{
 			name:     "stackTags for implicit IngressGroup",
-			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("ingress.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "ingressName"})},
 			want: map[string]string{
 				"ingress.k8s.aws/cluster": "cluster-name",
@@ -189,7 +225,7 @@ func Test_defaultProvider_StackTagsLegacy(t *testing.T) {
 		},
 		{
 			name:     "stackTags for Service",
-			provider: NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			provider: NewDefaultProvider("service.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
 			want: map[string]string{
 				"ingress.k8s.aws/cluster": "cluster-name",
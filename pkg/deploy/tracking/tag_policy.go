@@ -0,0 +1,42 @@
+package tracking
+
+import (
+	"sort"
+	"strings"
+)
+
+// IsDeniedTagKey returns whether tagKey has any of deniedTagKeyPrefixes.
+func IsDeniedTagKey(tagKey string, deniedTagKeyPrefixes []string) bool {
+	for _, prefix := range deniedTagKeyPrefixes {
+		if strings.HasPrefix(tagKey, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterDeniedTags returns tags without the ones whose key has any of deniedTagKeyPrefixes.
+func FilterDeniedTags(tags map[string]string, deniedTagKeyPrefixes []string) map[string]string {
+	if len(deniedTagKeyPrefixes) == 0 {
+		return tags
+	}
+	allowedTags := make(map[string]string, len(tags))
+	for key, value := range tags {
+		if !IsDeniedTagKey(key, deniedTagKeyPrefixes) {
+			allowedTags[key] = value
+		}
+	}
+	return allowedTags
+}
+
+// DeniedTagKeys returns the keys of tags that have any of deniedTagKeyPrefixes, in lexical order.
+func DeniedTagKeys(tags map[string]string, deniedTagKeyPrefixes []string) []string {
+	var deniedKeys []string
+	for key := range tags {
+		if IsDeniedTagKey(key, deniedTagKeyPrefixes) {
+			deniedKeys = append(deniedKeys, key)
+		}
+	}
+	sort.Strings(deniedKeys)
+	return deniedKeys
+}
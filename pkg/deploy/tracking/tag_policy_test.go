@@ -0,0 +1,99 @@
+package tracking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FilterDeniedTags(t *testing.T) {
+	type args struct {
+		tags                 map[string]string
+		deniedTagKeyPrefixes []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]string
+	}{
+		{
+			name: "no denied prefixes",
+			args: args{
+				tags: map[string]string{
+					"security/compliance": "pci",
+				},
+			},
+			want: map[string]string{
+				"security/compliance": "pci",
+			},
+		},
+		{
+			name: "tags with denied prefixes are filtered",
+			args: args{
+				tags: map[string]string{
+					"team":                "my-team",
+					"security/compliance": "pci",
+					"cost-center":         "1234",
+				},
+				deniedTagKeyPrefixes: []string{"security/", "cost-"},
+			},
+			want: map[string]string{
+				"team": "my-team",
+			},
+		},
+		{
+			name: "nil tags",
+			args: args{
+				tags:                 nil,
+				deniedTagKeyPrefixes: []string{"security/"},
+			},
+			want: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FilterDeniedTags(tt.args.tags, tt.args.deniedTagKeyPrefixes)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_DeniedTagKeys(t *testing.T) {
+	type args struct {
+		tags                 map[string]string
+		deniedTagKeyPrefixes []string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "no denied prefixes",
+			args: args{
+				tags: map[string]string{
+					"security/compliance": "pci",
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "denied keys are sorted",
+			args: args{
+				tags: map[string]string{
+					"team":                "my-team",
+					"security/owner":      "sec-team",
+					"security/compliance": "pci",
+				},
+				deniedTagKeyPrefixes: []string{"security/"},
+			},
+			want: []string{"security/compliance", "security/owner"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DeniedTagKeys(tt.args.tags, tt.args.deniedTagKeyPrefixes)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
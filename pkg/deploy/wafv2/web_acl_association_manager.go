@@ -2,15 +2,21 @@ package wafv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	wafv2sdk "github.com/aws/aws-sdk-go/service/wafv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	wafv2sdk "github.com/aws/aws-sdk-go-v2/service/wafv2"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"time"
 )
 
-const defaultWebACLARNByResourceARNCacheTTL = 10 * time.Minute
+const (
+	defaultWebACLARNByResourceARNCacheTTL = 10 * time.Minute
+	// newly created webACLs are unavailable to associate for a while.
+	defaultWaitWebACLAvailablePollInterval = 2 * time.Second
+	defaultWaitWebACLAvailableTimeout      = 30 * time.Second
+)
 
 // WebACLAssociationManager is responsible for manage WAFv2 webACL associations.
 type WebACLAssociationManager interface {
@@ -27,10 +33,12 @@ type WebACLAssociationManager interface {
 // NewDefaultWebACLAssociationManager constructs new defaultWebACLAssociationManager.
 func NewDefaultWebACLAssociationManager(wafv2Client services.WAFv2, logger logr.Logger) *defaultWebACLAssociationManager {
 	return &defaultWebACLAssociationManager{
-		wafv2Client:                    wafv2Client,
-		logger:                         logger,
-		webACLARNByResourceARNCache:    cache.NewExpiring(),
-		webACLARNByResourceARNCacheTTL: defaultWebACLARNByResourceARNCacheTTL,
+		wafv2Client:                     wafv2Client,
+		logger:                          logger,
+		webACLARNByResourceARNCache:     cache.NewExpiring(),
+		webACLARNByResourceARNCacheTTL:  defaultWebACLARNByResourceARNCacheTTL,
+		waitWebACLAvailablePollInterval: defaultWaitWebACLAvailablePollInterval,
+		waitWebACLAvailableTimeout:      defaultWaitWebACLAvailableTimeout,
 	}
 }
 
@@ -46,6 +54,9 @@ type defaultWebACLAssociationManager struct {
 	webACLARNByResourceARNCache *cache.Expiring
 	// ttl for webACLARNByResourceARNCache
 	webACLARNByResourceARNCacheTTL time.Duration
+
+	waitWebACLAvailablePollInterval time.Duration
+	waitWebACLAvailableTimeout      time.Duration
 }
 
 func (m *defaultWebACLAssociationManager) AssociateWebACL(ctx context.Context, resourceARN string, webACLARN string) error {
@@ -56,7 +67,10 @@ func (m *defaultWebACLAssociationManager) AssociateWebACL(ctx context.Context, r
 	m.logger.Info("associating WAFv2 webACL",
 		"resourceARN", resourceARN,
 		"webACLARN", webACLARN)
-	if _, err := m.wafv2Client.AssociateWebACLWithContext(ctx, req); err != nil {
+	if err := runtime.RetryImmediateOnError(m.waitWebACLAvailablePollInterval, m.waitWebACLAvailableTimeout, isWAFUnavailableEntityError, func() error {
+		_, err := m.wafv2Client.AssociateWebACLWithContext(ctx, req)
+		return err
+	}); err != nil {
 		return err
 	}
 	m.logger.Info("associated WAFv2 webACL",
@@ -97,7 +111,7 @@ func (m *defaultWebACLAssociationManager) GetAssociatedWebACL(ctx context.Contex
 	}
 	var webACLARN string
 	if resp.WebACL != nil {
-		webACLARN = awssdk.StringValue(resp.WebACL.ARN)
+		webACLARN = awssdk.ToString(resp.WebACL.ARN)
 	}
 
 	m.webACLARNByResourceARNCache.Set(resourceARN, webACLARN, m.webACLARNByResourceARNCacheTTL)
@@ -2,6 +2,8 @@ package wafv2
 
 import (
 	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
@@ -10,9 +12,10 @@ import (
 )
 
 // NewWebACLAssociationSynthesizer constructs new webACLAssociationSynthesizer.
-func NewWebACLAssociationSynthesizer(associationManager WebACLAssociationManager, logger logr.Logger, stack core.Stack) *webACLAssociationSynthesizer {
+func NewWebACLAssociationSynthesizer(associationManager WebACLAssociationManager, loggingManager WebACLLoggingManager, logger logr.Logger, stack core.Stack) *webACLAssociationSynthesizer {
 	return &webACLAssociationSynthesizer{
 		associationManager: associationManager,
+		loggingManager:     loggingManager,
 		logger:             logger,
 		stack:              stack,
 	}
@@ -20,6 +23,7 @@ func NewWebACLAssociationSynthesizer(associationManager WebACLAssociationManager
 
 type webACLAssociationSynthesizer struct {
 	associationManager WebACLAssociationManager
+	loggingManager     WebACLLoggingManager
 	logger             logr.Logger
 	stack              core.Stack
 }
@@ -62,8 +66,14 @@ func (s *webACLAssociationSynthesizer) synthesizeWebACLAssociationsOnLB(ctx cont
 	}
 
 	var desiredWebACLARN string
+	var desiredLogDestinationARN *string
 	if len(resAssociations) == 1 {
-		desiredWebACLARN = resAssociations[0].Spec.WebACLARN
+		webACLARN, err := resAssociations[0].Spec.WebACLARN.Resolve(ctx)
+		if err != nil {
+			return err
+		}
+		desiredWebACLARN = webACLARN
+		desiredLogDestinationARN = resAssociations[0].Spec.LogDestinationARN
 	}
 	currentWebACLARN, err := s.associationManager.GetAssociatedWebACL(ctx, lbARN)
 	if err != nil {
@@ -83,9 +93,27 @@ func (s *webACLAssociationSynthesizer) synthesizeWebACLAssociationsOnLB(ctx cont
 			return errors.Wrap(err, "failed to update WAFv2 webACL association on LoadBalancer")
 		}
 	}
+	if desiredWebACLARN != "" && desiredLogDestinationARN != nil {
+		if err := s.synthesizeWebACLLogging(ctx, desiredWebACLARN, awssdk.StringValue(desiredLogDestinationARN)); err != nil {
+			return errors.Wrap(err, "failed to configure WAFv2 webACL logging")
+		}
+	}
 	return nil
 }
 
+// synthesizeWebACLLogging ensures webACL delivers logs to desired log destination.
+// the logging configuration is never removed since the webACL is not owned by the controller.
+func (s *webACLAssociationSynthesizer) synthesizeWebACLLogging(ctx context.Context, webACLARN string, desiredLogDestinationARN string) error {
+	currentLogDestinationARN, err := s.loggingManager.GetLogDestination(ctx, webACLARN)
+	if err != nil {
+		return err
+	}
+	if currentLogDestinationARN == desiredLogDestinationARN {
+		return nil
+	}
+	return s.loggingManager.PutLogDestination(ctx, webACLARN, desiredLogDestinationARN)
+}
+
 func mapResWebACLAssociationByResourceARN(resAssociations []*wafv2model.WebACLAssociation) (map[string][]*wafv2model.WebACLAssociation, error) {
 	resAssociationsByResARN := make(map[string][]*wafv2model.WebACLAssociation, len(resAssociations))
 	ctx := context.Background()
@@ -0,0 +1,122 @@
+package wafv2
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	wafv2sdk "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const defaultLoggingConfigurationByWebACLARNCacheTTL = 10 * time.Minute
+
+// WebACLLoggingManager is responsible for manage WAFv2 webACL logging configurations.
+type WebACLLoggingManager interface {
+	// GetLogDestination returns the log destination configured for webACL, returns empty if logging is not configured.
+	GetLogDestination(ctx context.Context, webACLARN string) (string, error)
+
+	// PutLogDestination configures webACL to deliver logs to logDestination.
+	// the other settings of an existing logging configuration are retained, e.g. the redacted fields and the logging filter.
+	PutLogDestination(ctx context.Context, webACLARN string, logDestinationARN string) error
+}
+
+// NewDefaultWebACLLoggingManager constructs new defaultWebACLLoggingManager.
+func NewDefaultWebACLLoggingManager(wafv2Client services.WAFv2, logger logr.Logger) *defaultWebACLLoggingManager {
+	return &defaultWebACLLoggingManager{
+		wafv2Client:                          wafv2Client,
+		logger:                               logger,
+		loggingConfigurationByWebACLARNCache: cache.NewExpiring(),
+		loggingConfigurationByWebACLARNTTL:   defaultLoggingConfigurationByWebACLARNCacheTTL,
+	}
+}
+
+var _ WebACLLoggingManager = &defaultWebACLLoggingManager{}
+
+// default implementation for WebACLLoggingManager.
+type defaultWebACLLoggingManager struct {
+	wafv2Client services.WAFv2
+	logger      logr.Logger
+
+	// cache that stores logging configuration indexed by webACLARN
+	// The cache value is *wafv2types.LoggingConfiguration, while nil represents logging is not configured.
+	loggingConfigurationByWebACLARNCache *cache.Expiring
+	// ttl for loggingConfigurationByWebACLARNCache
+	loggingConfigurationByWebACLARNTTL time.Duration
+}
+
+func (m *defaultWebACLLoggingManager) GetLogDestination(ctx context.Context, webACLARN string) (string, error) {
+	loggingConfig, err := m.getLoggingConfiguration(ctx, webACLARN)
+	if err != nil {
+		return "", err
+	}
+	if loggingConfig == nil || len(loggingConfig.LogDestinationConfigs) == 0 {
+		return "", nil
+	}
+	return loggingConfig.LogDestinationConfigs[0], nil
+}
+
+func (m *defaultWebACLLoggingManager) PutLogDestination(ctx context.Context, webACLARN string, logDestinationARN string) error {
+	existingLoggingConfig, err := m.getLoggingConfiguration(ctx, webACLARN)
+	if err != nil {
+		return err
+	}
+	loggingConfig := &wafv2types.LoggingConfiguration{
+		ResourceArn: awssdk.String(webACLARN),
+	}
+	if existingLoggingConfig != nil {
+		copiedLoggingConfig := *existingLoggingConfig
+		loggingConfig = &copiedLoggingConfig
+	}
+	loggingConfig.LogDestinationConfigs = []string{logDestinationARN}
+	req := &wafv2sdk.PutLoggingConfigurationInput{
+		LoggingConfiguration: loggingConfig,
+	}
+	m.logger.Info("configuring WAFv2 webACL logging",
+		"webACLARN", webACLARN,
+		"logDestinationARN", logDestinationARN)
+	resp, err := m.wafv2Client.PutLoggingConfigurationWithContext(ctx, req)
+	if err != nil {
+		return err
+	}
+	m.logger.Info("configured WAFv2 webACL logging",
+		"webACLARN", webACLARN,
+		"logDestinationARN", logDestinationARN)
+	if resp.LoggingConfiguration != nil {
+		loggingConfig = resp.LoggingConfiguration
+	}
+	m.loggingConfigurationByWebACLARNCache.Set(webACLARN, loggingConfig, m.loggingConfigurationByWebACLARNTTL)
+	return nil
+}
+
+// getLoggingConfiguration returns the logging configuration of webACL, returns nil if logging is not configured.
+func (m *defaultWebACLLoggingManager) getLoggingConfiguration(ctx context.Context, webACLARN string) (*wafv2types.LoggingConfiguration, error) {
+	rawCacheItem, exists := m.loggingConfigurationByWebACLARNCache.Get(webACLARN)
+	if exists {
+		return rawCacheItem.(*wafv2types.LoggingConfiguration), nil
+	}
+
+	req := &wafv2sdk.GetLoggingConfigurationInput{
+		ResourceArn: awssdk.String(webACLARN),
+	}
+	resp, err := m.wafv2Client.GetLoggingConfigurationWithContext(ctx, req)
+	if err != nil && !isWAFNonexistentItemError(err) {
+		return nil, err
+	}
+	var loggingConfig *wafv2types.LoggingConfiguration
+	if resp != nil {
+		loggingConfig = resp.LoggingConfiguration
+	}
+
+	m.loggingConfigurationByWebACLARNCache.Set(webACLARN, loggingConfig, m.loggingConfigurationByWebACLARNTTL)
+	return loggingConfig, nil
+}
+
+func isWAFNonexistentItemError(err error) bool {
+	var nonexistentItemErr *wafv2types.WAFNonexistentItemException
+	return errors.As(err, &nonexistentItemErr)
+}
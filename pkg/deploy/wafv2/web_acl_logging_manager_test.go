@@ -0,0 +1,89 @@
+package wafv2
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	wafv2sdk "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeLoggingWAFv2 is a WAFv2 client that only supports the logging configuration of a single webACL.
+type fakeLoggingWAFv2 struct {
+	services.WAFv2
+	loggingConfig  *wafv2types.LoggingConfiguration
+	putLoggingReqs []*wafv2sdk.PutLoggingConfigurationInput
+}
+
+func (c *fakeLoggingWAFv2) GetLoggingConfigurationWithContext(_ context.Context, _ *wafv2sdk.GetLoggingConfigurationInput) (*wafv2sdk.GetLoggingConfigurationOutput, error) {
+	if c.loggingConfig == nil {
+		return nil, &wafv2types.WAFNonexistentItemException{Message: awssdk.String("logging configuration not found")}
+	}
+	return &wafv2sdk.GetLoggingConfigurationOutput{LoggingConfiguration: c.loggingConfig}, nil
+}
+
+func (c *fakeLoggingWAFv2) PutLoggingConfigurationWithContext(_ context.Context, input *wafv2sdk.PutLoggingConfigurationInput) (*wafv2sdk.PutLoggingConfigurationOutput, error) {
+	c.putLoggingReqs = append(c.putLoggingReqs, input)
+	c.loggingConfig = input.LoggingConfiguration
+	return &wafv2sdk.PutLoggingConfigurationOutput{LoggingConfiguration: input.LoggingConfiguration}, nil
+}
+
+func Test_defaultWebACLLoggingManager_PutLogDestination(t *testing.T) {
+	webACLARN := "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/my-acl/3b0ee5a4-7d35-4e2c-8f0e-a4f9a1b8e2d1"
+	logDestinationARN := "arn:aws:logs:us-west-2:123456789012:log-group:aws-waf-logs-new"
+	tests := []struct {
+		name              string
+		loggingConfig     *wafv2types.LoggingConfiguration
+		wantLoggingConfig *wafv2types.LoggingConfiguration
+	}{
+		{
+			name:          "logging not configured",
+			loggingConfig: nil,
+			wantLoggingConfig: &wafv2types.LoggingConfiguration{
+				ResourceArn:           awssdk.String(webACLARN),
+				LogDestinationConfigs: []string{logDestinationARN},
+			},
+		},
+		{
+			name: "logging configured with other settings",
+			loggingConfig: &wafv2types.LoggingConfiguration{
+				ResourceArn:           awssdk.String(webACLARN),
+				LogDestinationConfigs: []string{"arn:aws:logs:us-west-2:123456789012:log-group:aws-waf-logs-old"},
+				RedactedFields: []wafv2types.FieldToMatch{
+					{SingleHeader: &wafv2types.SingleHeader{Name: awssdk.String("authorization")}},
+				},
+				LoggingFilter: &wafv2types.LoggingFilter{
+					DefaultBehavior: wafv2types.FilterBehaviorKeep,
+				},
+			},
+			wantLoggingConfig: &wafv2types.LoggingConfiguration{
+				ResourceArn:           awssdk.String(webACLARN),
+				LogDestinationConfigs: []string{logDestinationARN},
+				RedactedFields: []wafv2types.FieldToMatch{
+					{SingleHeader: &wafv2types.SingleHeader{Name: awssdk.String("authorization")}},
+				},
+				LoggingFilter: &wafv2types.LoggingFilter{
+					DefaultBehavior: wafv2types.FilterBehaviorKeep,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wafv2Client := &fakeLoggingWAFv2{loggingConfig: tt.loggingConfig}
+			m := NewDefaultWebACLLoggingManager(wafv2Client, logr.New(&log.NullLogSink{}))
+			err := m.PutLogDestination(context.Background(), webACLARN, logDestinationARN)
+			assert.NoError(t, err)
+			assert.Equal(t, []*wafv2sdk.PutLoggingConfigurationInput{{LoggingConfiguration: tt.wantLoggingConfig}}, wafv2Client.putLoggingReqs)
+
+			gotLogDestinationARN, err := m.GetLogDestination(context.Background(), webACLARN)
+			assert.NoError(t, err)
+			assert.Equal(t, logDestinationARN, gotLogDestinationARN)
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package wafv2
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	wafv2sdk "github.com/aws/aws-sdk-go-v2/service/wafv2"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/go-logr/logr"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+const (
+	// the webACLs managed by controller are named with this prefix, other webACLs are skipped while listing.
+	managedWebACLNamePrefix = "k8s-"
+
+	defaultWaitWebACLDeletionPollInterval = 2 * time.Second
+	defaultWaitWebACLDeletionTimeout      = 20 * time.Second
+)
+
+// WebACLWithTags represents a WAFv2 webACL with its associated tags.
+type WebACLWithTags struct {
+	WebACL *wafv2types.WebACLSummary
+	Tags   map[string]string
+}
+
+// WebACLManager is responsible for create/update/delete WAFv2 webACLs managed by controller.
+type WebACLManager interface {
+	Create(ctx context.Context, resWebACL *wafv2model.WebACL) (wafv2model.WebACLStatus, error)
+
+	Update(ctx context.Context, resWebACL *wafv2model.WebACL, sdkWebACL WebACLWithTags) (wafv2model.WebACLStatus, error)
+
+	Delete(ctx context.Context, sdkWebACL WebACLWithTags) error
+
+	// ListWebACLs returns the webACLs managed by controller that matches tagFilter.
+	ListWebACLs(ctx context.Context, tagFilter tracking.TagFilter) ([]WebACLWithTags, error)
+}
+
+// NewDefaultWebACLManager constructs new defaultWebACLManager.
+func NewDefaultWebACLManager(wafv2Client services.WAFv2, trackingProvider tracking.Provider,
+	externalManagedTags []string, logger logr.Logger) *defaultWebACLManager {
+	return &defaultWebACLManager{
+		wafv2Client:         wafv2Client,
+		trackingProvider:    trackingProvider,
+		externalManagedTags: externalManagedTags,
+		logger:              logger,
+
+		waitWebACLDeletionPollInterval: defaultWaitWebACLDeletionPollInterval,
+		waitWebACLDeletionTimeout:      defaultWaitWebACLDeletionTimeout,
+	}
+}
+
+var _ WebACLManager = &defaultWebACLManager{}
+
+// default implementation for WebACLManager
+type defaultWebACLManager struct {
+	wafv2Client         services.WAFv2
+	trackingProvider    tracking.Provider
+	externalManagedTags []string
+
+	logger logr.Logger
+
+	waitWebACLDeletionPollInterval time.Duration
+	waitWebACLDeletionTimeout      time.Duration
+}
+
+func (m *defaultWebACLManager) Create(ctx context.Context, resWebACL *wafv2model.WebACL) (wafv2model.WebACLStatus, error) {
+	webACLTags := m.trackingProvider.ResourceTags(resWebACL.Stack(), resWebACL, resWebACL.Spec.Tags)
+	req := &wafv2sdk.CreateWebACLInput{
+		Name:             awssdk.String(resWebACL.Spec.Name),
+		Scope:            wafv2types.ScopeRegional,
+		DefaultAction:    buildSDKDefaultAction(resWebACL.Spec.DefaultAction),
+		Rules:            buildSDKRules(resWebACL.Spec.Rules),
+		VisibilityConfig: buildSDKVisibilityConfig(resWebACL.Spec.Name),
+		Tags:             convertTagsToSDKTags(webACLTags),
+	}
+
+	m.logger.Info("creating WAFv2 webACL",
+		"stackID", resWebACL.Stack().StackID(),
+		"resourceID", resWebACL.ID())
+	resp, err := m.wafv2Client.CreateWebACLWithContext(ctx, req)
+	if err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	webACLARN := awssdk.ToString(resp.Summary.ARN)
+	m.logger.Info("created WAFv2 webACL",
+		"stackID", resWebACL.Stack().StackID(),
+		"resourceID", resWebACL.ID(),
+		"arn", webACLARN)
+	audit.RecordMutation(ctx, audit.ActionCreate, "webACL", webACLARN, "")
+	return wafv2model.WebACLStatus{WebACLARN: webACLARN}, nil
+}
+
+func (m *defaultWebACLManager) Update(ctx context.Context, resWebACL *wafv2model.WebACL, sdkWebACL WebACLWithTags) (wafv2model.WebACLStatus, error) {
+	if err := m.updateSDKWebACLWithSettings(ctx, resWebACL, sdkWebACL); err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	desiredTags := m.trackingProvider.ResourceTags(resWebACL.Stack(), resWebACL, resWebACL.Spec.Tags)
+	if err := m.reconcileTags(ctx, awssdk.ToString(sdkWebACL.WebACL.ARN), desiredTags, sdkWebACL.Tags); err != nil {
+		return wafv2model.WebACLStatus{}, err
+	}
+	return wafv2model.WebACLStatus{WebACLARN: awssdk.ToString(sdkWebACL.WebACL.ARN)}, nil
+}
+
+func (m *defaultWebACLManager) Delete(ctx context.Context, sdkWebACL WebACLWithTags) error {
+	webACLARN := awssdk.ToString(sdkWebACL.WebACL.ARN)
+	m.logger.Info("deleting WAFv2 webACL",
+		"arn", webACLARN)
+	// the webACL stays associated with LoadBalancers for a while after they're deleted.
+	if err := runtime.RetryImmediateOnError(m.waitWebACLDeletionPollInterval, m.waitWebACLDeletionTimeout, isWAFAssociatedItemError, func() error {
+		getResp, err := m.wafv2Client.GetWebACLWithContext(ctx, &wafv2sdk.GetWebACLInput{
+			Id:    sdkWebACL.WebACL.Id,
+			Name:  sdkWebACL.WebACL.Name,
+			Scope: wafv2types.ScopeRegional,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = m.wafv2Client.DeleteWebACLWithContext(ctx, &wafv2sdk.DeleteWebACLInput{
+			Id:        sdkWebACL.WebACL.Id,
+			Name:      sdkWebACL.WebACL.Name,
+			Scope:     wafv2types.ScopeRegional,
+			LockToken: getResp.LockToken,
+		})
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed to delete WAFv2 webACL")
+	}
+	m.logger.Info("deleted WAFv2 webACL",
+		"arn", webACLARN)
+	audit.RecordMutation(ctx, audit.ActionDelete, "webACL", webACLARN, "")
+	return nil
+}
+
+func (m *defaultWebACLManager) ListWebACLs(ctx context.Context, tagFilter tracking.TagFilter) ([]WebACLWithTags, error) {
+	var webACLs []WebACLWithTags
+	req := &wafv2sdk.ListWebACLsInput{
+		Scope: wafv2types.ScopeRegional,
+		Limit: awssdk.Int32(100),
+	}
+	for {
+		resp, err := m.wafv2Client.ListWebACLsWithContext(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.WebACLs {
+			webACL := &resp.WebACLs[i]
+			if !strings.HasPrefix(awssdk.ToString(webACL.Name), managedWebACLNamePrefix) {
+				continue
+			}
+			tags, err := m.describeWebACLTags(ctx, awssdk.ToString(webACL.ARN))
+			if err != nil {
+				return nil, err
+			}
+			if !tagFilter.Matches(tags) {
+				continue
+			}
+			webACLs = append(webACLs, WebACLWithTags{
+				WebACL: webACL,
+				Tags:   tags,
+			})
+		}
+		if awssdk.ToString(resp.NextMarker) == "" || len(resp.WebACLs) == 0 {
+			break
+		}
+		req.NextMarker = resp.NextMarker
+	}
+	return webACLs, nil
+}
+
+func (m *defaultWebACLManager) updateSDKWebACLWithSettings(ctx context.Context, resWebACL *wafv2model.WebACL, sdkWebACL WebACLWithTags) error {
+	getResp, err := m.wafv2Client.GetWebACLWithContext(ctx, &wafv2sdk.GetWebACLInput{
+		Id:    sdkWebACL.WebACL.Id,
+		Name:  sdkWebACL.WebACL.Name,
+		Scope: wafv2types.ScopeRegional,
+	})
+	if err != nil {
+		return err
+	}
+	if !isSDKWebACLSettingsDrifted(resWebACL.Spec, getResp.WebACL) {
+		return nil
+	}
+	req := &wafv2sdk.UpdateWebACLInput{
+		Id:               sdkWebACL.WebACL.Id,
+		Name:             sdkWebACL.WebACL.Name,
+		Scope:            wafv2types.ScopeRegional,
+		LockToken:        getResp.LockToken,
+		DefaultAction:    buildSDKDefaultAction(resWebACL.Spec.DefaultAction),
+		Rules:            buildSDKRules(resWebACL.Spec.Rules),
+		VisibilityConfig: buildSDKVisibilityConfig(resWebACL.Spec.Name),
+	}
+	m.logger.Info("modifying WAFv2 webACL",
+		"stackID", resWebACL.Stack().StackID(),
+		"resourceID", resWebACL.ID(),
+		"arn", awssdk.ToString(sdkWebACL.WebACL.ARN))
+	if _, err := m.wafv2Client.UpdateWebACLWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified WAFv2 webACL",
+		"stackID", resWebACL.Stack().StackID(),
+		"resourceID", resWebACL.ID(),
+		"arn", awssdk.ToString(sdkWebACL.WebACL.ARN))
+	audit.RecordMutation(ctx, audit.ActionModify, "webACL", awssdk.ToString(sdkWebACL.WebACL.ARN), "rules")
+	return nil
+}
+
+func (m *defaultWebACLManager) reconcileTags(ctx context.Context, webACLARN string, desiredTags map[string]string, currentTags map[string]string) error {
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, currentTags)
+	for _, ignoredTagKey := range m.externalManagedTags {
+		delete(tagsToUpdate, ignoredTagKey)
+		delete(tagsToRemove, ignoredTagKey)
+	}
+	if len(tagsToUpdate) > 0 {
+		m.logger.Info("adding resource tags",
+			"arn", webACLARN,
+			"change", tagsToUpdate)
+		if _, err := m.wafv2Client.TagResourceWithContext(ctx, &wafv2sdk.TagResourceInput{
+			ResourceARN: awssdk.String(webACLARN),
+			Tags:        convertTagsToSDKTags(tagsToUpdate),
+		}); err != nil {
+			return err
+		}
+		m.logger.Info("added resource tags",
+			"arn", webACLARN)
+	}
+	if len(tagsToRemove) > 0 {
+		tagKeys := sets.StringKeySet(tagsToRemove).List()
+		m.logger.Info("removing resource tags",
+			"arn", webACLARN,
+			"change", tagKeys)
+		if _, err := m.wafv2Client.UntagResourceWithContext(ctx, &wafv2sdk.UntagResourceInput{
+			ResourceARN: awssdk.String(webACLARN),
+			TagKeys:     tagKeys,
+		}); err != nil {
+			return err
+		}
+		m.logger.Info("removed resource tags",
+			"arn", webACLARN)
+	}
+	return nil
+}
+
+func (m *defaultWebACLManager) describeWebACLTags(ctx context.Context, webACLARN string) (map[string]string, error) {
+	tags := make(map[string]string)
+	req := &wafv2sdk.ListTagsForResourceInput{
+		ResourceARN: awssdk.String(webACLARN),
+	}
+	for {
+		resp, err := m.wafv2Client.ListTagsForResourceWithContext(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.TagInfoForResource != nil {
+			for _, tag := range resp.TagInfoForResource.TagList {
+				tags[awssdk.ToString(tag.Key)] = awssdk.ToString(tag.Value)
+			}
+		}
+		if awssdk.ToString(resp.NextMarker) == "" {
+			break
+		}
+		req.NextMarker = resp.NextMarker
+	}
+	return tags, nil
+}
+
+// isSDKWebACLSettingsDrifted checks whether the default action or rules of sdk webACL differs from the desired ones.
+func isSDKWebACLSettingsDrifted(webACLSpec wafv2model.WebACLSpec, sdkWebACL *wafv2types.WebACL) bool {
+	if sdkWebACL == nil {
+		return true
+	}
+	currentDefaultAction := wafv2model.WebACLDefaultActionAllow
+	if sdkWebACL.DefaultAction != nil && sdkWebACL.DefaultAction.Block != nil {
+		currentDefaultAction = wafv2model.WebACLDefaultActionBlock
+	}
+	if currentDefaultAction != webACLSpec.DefaultAction {
+		return true
+	}
+	return !cmp.Equal(webACLSpec.Rules, buildResRules(sdkWebACL.Rules), cmpopts.EquateEmpty())
+}
+
+func buildSDKDefaultAction(defaultAction wafv2model.WebACLDefaultAction) *wafv2types.DefaultAction {
+	if defaultAction == wafv2model.WebACLDefaultActionBlock {
+		return &wafv2types.DefaultAction{Block: &wafv2types.BlockAction{}}
+	}
+	return &wafv2types.DefaultAction{Allow: &wafv2types.AllowAction{}}
+}
+
+func buildSDKRules(rules []wafv2model.WebACLRule) []wafv2types.Rule {
+	sdkRules := make([]wafv2types.Rule, 0, len(rules))
+	for _, rule := range rules {
+		sdkRule := wafv2types.Rule{
+			Name:             awssdk.String(rule.Name),
+			Priority:         int32(rule.Priority),
+			Statement:        &wafv2types.Statement{},
+			VisibilityConfig: buildSDKVisibilityConfig(rule.Name),
+		}
+		switch {
+		case rule.ManagedRuleGroup != nil:
+			sdkRule.Statement.ManagedRuleGroupStatement = &wafv2types.ManagedRuleGroupStatement{
+				VendorName: awssdk.String(rule.ManagedRuleGroup.VendorName),
+				Name:       awssdk.String(rule.ManagedRuleGroup.Name),
+				Version:    rule.ManagedRuleGroup.Version,
+			}
+			sdkRule.OverrideAction = &wafv2types.OverrideAction{None: &wafv2types.NoneAction{}}
+		case rule.RateLimit != nil:
+			sdkRule.Statement.RateBasedStatement = &wafv2types.RateBasedStatement{
+				Limit:            awssdk.Int64(rule.RateLimit.Limit),
+				AggregateKeyType: wafv2types.RateBasedStatementAggregateKeyTypeIp,
+			}
+			sdkRule.Action = &wafv2types.RuleAction{Block: &wafv2types.BlockAction{}}
+		}
+		sdkRules = append(sdkRules, sdkRule)
+	}
+	return sdkRules
+}
+
+// buildResRules builds the rules of sdk webACL in the form of resource, the statements not supported by controller are ignored.
+func buildResRules(sdkRules []wafv2types.Rule) []wafv2model.WebACLRule {
+	rules := make([]wafv2model.WebACLRule, 0, len(sdkRules))
+	for _, sdkRule := range sdkRules {
+		rule := wafv2model.WebACLRule{
+			Name:     awssdk.ToString(sdkRule.Name),
+			Priority: int64(sdkRule.Priority),
+		}
+		if sdkRule.Statement != nil && sdkRule.Statement.ManagedRuleGroupStatement != nil {
+			rule.ManagedRuleGroup = &wafv2model.ManagedRuleGroup{
+				VendorName: awssdk.ToString(sdkRule.Statement.ManagedRuleGroupStatement.VendorName),
+				Name:       awssdk.ToString(sdkRule.Statement.ManagedRuleGroupStatement.Name),
+				Version:    sdkRule.Statement.ManagedRuleGroupStatement.Version,
+			}
+		}
+		if sdkRule.Statement != nil && sdkRule.Statement.RateBasedStatement != nil {
+			rule.RateLimit = &wafv2model.RateLimit{
+				Limit: awssdk.ToInt64(sdkRule.Statement.RateBasedStatement.Limit),
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func buildSDKVisibilityConfig(metricName string) *wafv2types.VisibilityConfig {
+	return &wafv2types.VisibilityConfig{
+		CloudWatchMetricsEnabled: true,
+		MetricName:               awssdk.String(metricName),
+		SampledRequestsEnabled:   true,
+	}
+}
+
+func convertTagsToSDKTags(tags map[string]string) []wafv2types.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	sdkTags := make([]wafv2types.Tag, 0, len(tags))
+	for _, key := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, wafv2types.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
+
+func isWAFAssociatedItemError(err error) bool {
+	var associatedItemErr *wafv2types.WAFAssociatedItemException
+	return errors.As(err, &associatedItemErr)
+}
+
+func isWAFUnavailableEntityError(err error) bool {
+	var unavailableEntityErr *wafv2types.WAFUnavailableEntityException
+	return errors.As(err, &unavailableEntityErr)
+}
@@ -0,0 +1,88 @@
+package wafv2
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/stretchr/testify/assert"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+func Test_isSDKWebACLSettingsDrifted(t *testing.T) {
+	webACLSpec := wafv2model.WebACLSpec{
+		Name:          "k8s-awesomegroup-f77ecb1ebb",
+		DefaultAction: wafv2model.WebACLDefaultActionAllow,
+		Rules: []wafv2model.WebACLRule{
+			{
+				Name:      "per-ip",
+				Priority:  0,
+				RateLimit: &wafv2model.RateLimit{Limit: 1000},
+			},
+			{
+				Name:     "AWS-AWSManagedRulesCommonRuleSet",
+				Priority: 1,
+				ManagedRuleGroup: &wafv2model.ManagedRuleGroup{
+					VendorName: "AWS",
+					Name:       "AWSManagedRulesCommonRuleSet",
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		sdkWebACL *wafv2types.WebACL
+		want      bool
+	}{
+		{
+			name: "webACL in sync",
+			sdkWebACL: &wafv2types.WebACL{
+				DefaultAction: buildSDKDefaultAction(wafv2model.WebACLDefaultActionAllow),
+				Rules:         buildSDKRules(webACLSpec.Rules),
+			},
+			want: false,
+		},
+		{
+			name: "default action changed",
+			sdkWebACL: &wafv2types.WebACL{
+				DefaultAction: buildSDKDefaultAction(wafv2model.WebACLDefaultActionBlock),
+				Rules:         buildSDKRules(webACLSpec.Rules),
+			},
+			want: true,
+		},
+		{
+			name: "rate limit changed",
+			sdkWebACL: &wafv2types.WebACL{
+				DefaultAction: buildSDKDefaultAction(wafv2model.WebACLDefaultActionAllow),
+				Rules: []wafv2types.Rule{
+					{
+						Name:     awssdk.String("per-ip"),
+						Priority: 0,
+						Statement: &wafv2types.Statement{
+							RateBasedStatement: &wafv2types.RateBasedStatement{
+								Limit:            awssdk.Int64(2000),
+								AggregateKeyType: wafv2types.RateBasedStatementAggregateKeyTypeIp,
+							},
+						},
+					},
+					buildSDKRules(webACLSpec.Rules)[1],
+				},
+			},
+			want: true,
+		},
+		{
+			name: "managed rule group removed",
+			sdkWebACL: &wafv2types.WebACL{
+				DefaultAction: buildSDKDefaultAction(wafv2model.WebACLDefaultActionAllow),
+				Rules:         buildSDKRules(webACLSpec.Rules[:1]),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isSDKWebACLSettingsDrifted(webACLSpec, tt.sdkWebACL)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
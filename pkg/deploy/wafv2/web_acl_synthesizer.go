@@ -0,0 +1,138 @@
+package wafv2
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+// NewWebACLSynthesizer constructs new webACLSynthesizer.
+func NewWebACLSynthesizer(trackingProvider tracking.Provider, webACLManager WebACLManager, logger logr.Logger, stack core.Stack) *webACLSynthesizer {
+	return &webACLSynthesizer{
+		trackingProvider:    trackingProvider,
+		webACLManager:       webACLManager,
+		logger:              logger,
+		stack:               stack,
+		unmatchedSDKWebACLs: nil,
+	}
+}
+
+// webACLSynthesizer is responsible for synthesize WebACL resources types for certain stack.
+type webACLSynthesizer struct {
+	trackingProvider tracking.Provider
+	webACLManager    WebACLManager
+	logger           logr.Logger
+
+	stack               core.Stack
+	unmatchedSDKWebACLs []WebACLWithTags
+}
+
+func (s *webACLSynthesizer) Synthesize(ctx context.Context) error {
+	var resWebACLs []*wafv2model.WebACL
+	s.stack.ListResources(&resWebACLs)
+	stackTags := s.trackingProvider.StackTags(s.stack)
+	sdkWebACLs, err := s.webACLManager.ListWebACLs(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return err
+	}
+	matchedResAndSDKWebACLs, unmatchedResWebACLs, unmatchedSDKWebACLs, err := matchResAndSDKWebACLs(resWebACLs, sdkWebACLs,
+		s.trackingProvider.ResourceIDTagKey())
+	if err != nil {
+		return err
+	}
+
+	// For WebACLs, we delete unmatched ones during post synthesize given below facts:
+	// * unmatched webACLs might still be associated with a LoadBalancer.
+	s.unmatchedSDKWebACLs = unmatchedSDKWebACLs
+
+	for _, resWebACL := range unmatchedResWebACLs {
+		webACLStatus, err := s.webACLManager.Create(ctx, resWebACL)
+		if err != nil {
+			return err
+		}
+		resWebACL.SetStatus(webACLStatus)
+	}
+	for _, resAndSDKWebACL := range matchedResAndSDKWebACLs {
+		webACLStatus, err := s.webACLManager.Update(ctx, resAndSDKWebACL.resWebACL, resAndSDKWebACL.sdkWebACL)
+		if err != nil {
+			return err
+		}
+		resAndSDKWebACL.resWebACL.SetStatus(webACLStatus)
+	}
+	return nil
+}
+
+func (s *webACLSynthesizer) PostSynthesize(ctx context.Context) error {
+	for _, sdkWebACL := range s.unmatchedSDKWebACLs {
+		if err := s.webACLManager.Delete(ctx, sdkWebACL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type resAndSDKWebACLPair struct {
+	resWebACL *wafv2model.WebACL
+	sdkWebACL WebACLWithTags
+}
+
+func matchResAndSDKWebACLs(resWebACLs []*wafv2model.WebACL, sdkWebACLs []WebACLWithTags,
+	resourceIDTagKey string) ([]resAndSDKWebACLPair, []*wafv2model.WebACL, []WebACLWithTags, error) {
+	var matchedResAndSDKWebACLs []resAndSDKWebACLPair
+	var unmatchedResWebACLs []*wafv2model.WebACL
+	var unmatchedSDKWebACLs []WebACLWithTags
+
+	resWebACLsByID := make(map[string]*wafv2model.WebACL, len(resWebACLs))
+	for _, resWebACL := range resWebACLs {
+		resWebACLsByID[resWebACL.ID()] = resWebACL
+	}
+	sdkWebACLsByID := make(map[string][]WebACLWithTags, len(sdkWebACLs))
+	for _, sdkWebACL := range sdkWebACLs {
+		resourceID, ok := sdkWebACL.Tags[resourceIDTagKey]
+		if !ok {
+			return nil, nil, nil, errors.Errorf("unexpected webACL with no resourceID: %v", awssdk.StringValue(sdkWebACL.WebACL.ARN))
+		}
+		sdkWebACLsByID[resourceID] = append(sdkWebACLsByID[resourceID], sdkWebACL)
+	}
+
+	resWebACLIDs := sets.StringKeySet(resWebACLsByID)
+	sdkWebACLIDs := sets.StringKeySet(sdkWebACLsByID)
+	for _, resID := range resWebACLIDs.Intersection(sdkWebACLIDs).List() {
+		resWebACL := resWebACLsByID[resID]
+		foundMatch := false
+		for _, sdkWebACL := range sdkWebACLsByID[resID] {
+			if isSDKWebACLRequiresReplacement(sdkWebACL, resWebACL) {
+				unmatchedSDKWebACLs = append(unmatchedSDKWebACLs, sdkWebACL)
+				continue
+			}
+			matchedResAndSDKWebACLs = append(matchedResAndSDKWebACLs, resAndSDKWebACLPair{
+				resWebACL: resWebACL,
+				sdkWebACL: sdkWebACL,
+			})
+			foundMatch = true
+		}
+		if !foundMatch {
+			unmatchedResWebACLs = append(unmatchedResWebACLs, resWebACL)
+		}
+	}
+	for _, resID := range resWebACLIDs.Difference(sdkWebACLIDs).List() {
+		unmatchedResWebACLs = append(unmatchedResWebACLs, resWebACLsByID[resID])
+	}
+	for _, resID := range sdkWebACLIDs.Difference(resWebACLIDs).List() {
+		unmatchedSDKWebACLs = append(unmatchedSDKWebACLs, sdkWebACLsByID[resID]...)
+	}
+
+	return matchedResAndSDKWebACLs, unmatchedResWebACLs, unmatchedSDKWebACLs, nil
+}
+
+// isSDKWebACLRequiresReplacement checks whether a sdk WebACL requires replacement to fulfill a WebACL resource.
+// The name of webACL cannot be modified.
+func isSDKWebACLRequiresReplacement(sdkWebACL WebACLWithTags, resWebACL *wafv2model.WebACL) bool {
+	return resWebACL.Spec.Name != awssdk.StringValue(sdkWebACL.WebACL.Name)
+}
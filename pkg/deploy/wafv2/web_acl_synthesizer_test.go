@@ -0,0 +1,95 @@
+package wafv2
+
+import (
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	wafv2types "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+func Test_matchResAndSDKWebACLs(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Name: "awesome-group"}))
+	resWebACL := wafv2model.NewWebACL(stack, "WebACL", wafv2model.WebACLSpec{
+		Name: "k8s-awesomegroup-f77ecb1ebb",
+	})
+	sdkWebACLCurrent := WebACLWithTags{
+		WebACL: &wafv2types.WebACLSummary{
+			Name: awssdk.String("k8s-awesomegroup-f77ecb1ebb"),
+			ARN:  awssdk.String("arn-1"),
+		},
+		Tags: map[string]string{"elbv2.k8s.aws/resource": "WebACL"},
+	}
+	sdkWebACLOutdated := WebACLWithTags{
+		WebACL: &wafv2types.WebACLSummary{
+			Name: awssdk.String("k8s-awesomegroup-0123456789"),
+			ARN:  awssdk.String("arn-2"),
+		},
+		Tags: map[string]string{"elbv2.k8s.aws/resource": "WebACL"},
+	}
+	sdkWebACLOrphan := WebACLWithTags{
+		WebACL: &wafv2types.WebACLSummary{
+			Name: awssdk.String("k8s-awesomegroup-9876543210"),
+			ARN:  awssdk.String("arn-3"),
+		},
+		Tags: map[string]string{"elbv2.k8s.aws/resource": "OtherWebACL"},
+	}
+	tests := []struct {
+		name             string
+		resWebACLs       []*wafv2model.WebACL
+		sdkWebACLs       []WebACLWithTags
+		wantMatched      []resAndSDKWebACLPair
+		wantUnmatchedRes []*wafv2model.WebACL
+		wantUnmatchedSDK []WebACLWithTags
+		wantErr          bool
+	}{
+		{
+			name:       "webACL matches by resourceID and name",
+			resWebACLs: []*wafv2model.WebACL{resWebACL},
+			sdkWebACLs: []WebACLWithTags{sdkWebACLCurrent, sdkWebACLOrphan},
+			wantMatched: []resAndSDKWebACLPair{
+				{resWebACL: resWebACL, sdkWebACL: sdkWebACLCurrent},
+			},
+			wantUnmatchedSDK: []WebACLWithTags{sdkWebACLOrphan},
+		},
+		{
+			name:             "webACL requires replacement when name changed",
+			resWebACLs:       []*wafv2model.WebACL{resWebACL},
+			sdkWebACLs:       []WebACLWithTags{sdkWebACLOutdated},
+			wantUnmatchedRes: []*wafv2model.WebACL{resWebACL},
+			wantUnmatchedSDK: []WebACLWithTags{sdkWebACLOutdated},
+		},
+		{
+			name:             "webACL deleted with IngressGroup",
+			sdkWebACLs:       []WebACLWithTags{sdkWebACLCurrent},
+			wantUnmatchedSDK: []WebACLWithTags{sdkWebACLCurrent},
+		},
+		{
+			name:       "sdk webACL without resourceID",
+			resWebACLs: []*wafv2model.WebACL{resWebACL},
+			sdkWebACLs: []WebACLWithTags{
+				{
+					WebACL: &wafv2types.WebACLSummary{ARN: awssdk.String("arn-4")},
+					Tags:   map[string]string{},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMatched, gotUnmatchedRes, gotUnmatchedSDK, err := matchResAndSDKWebACLs(tt.resWebACLs, tt.sdkWebACLs, "elbv2.k8s.aws/resource")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantMatched, gotMatched)
+			assert.Equal(t, tt.wantUnmatchedRes, gotUnmatchedRes)
+			assert.Equal(t, tt.wantUnmatchedSDK, gotUnmatchedSDK)
+		})
+	}
+}
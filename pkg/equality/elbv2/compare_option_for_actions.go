@@ -1,20 +1,20 @@
 package elbv2
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 )
 
 func CompareOptionForTargetGroupTuples() cmp.Option {
-	return cmpopts.AcyclicTransformer("normalizeWeights", func(tgt []*elbv2sdk.TargetGroupTuple) []*elbv2sdk.TargetGroupTuple {
+	return cmpopts.AcyclicTransformer("normalizeWeights", func(tgt []elbv2types.TargetGroupTuple) []elbv2types.TargetGroupTuple {
 		if len(tgt) != 1 {
 			return tgt
 		}
 		singleTG := tgt[0]
-		return []*elbv2sdk.TargetGroupTuple{
+		return []elbv2types.TargetGroupTuple{
 			{
 				TargetGroupArn: singleTG.TargetGroupArn,
 				Weight:         nil,
@@ -25,41 +25,47 @@ func CompareOptionForTargetGroupTuples() cmp.Option {
 
 func CompareOptionForForwardActionConfig() cmp.Option {
 	return cmp.Options{
-		equality.IgnoreLeftHandUnset(elbv2sdk.ForwardActionConfig{}, "TargetGroupStickinessConfig"),
+		cmpopts.IgnoreUnexported(elbv2types.ForwardActionConfig{}, elbv2types.TargetGroupTuple{}, elbv2types.TargetGroupStickinessConfig{}),
+		equality.IgnoreLeftHandUnset(elbv2types.ForwardActionConfig{}, "TargetGroupStickinessConfig"),
 		CompareOptionForTargetGroupTuples(),
 	}
 }
 
 func CompareOptionForRedirectActionConfig() cmp.Option {
-	return cmpopts.AcyclicTransformer("normalizeRedirectActionConfig", func(config *elbv2sdk.RedirectActionConfig) *elbv2sdk.RedirectActionConfig {
-		if config == nil {
-			return nil
-		}
-		normalizedCFG := *config
-		if normalizedCFG.Host == nil {
-			normalizedCFG.Host = awssdk.String("#{host}")
-		}
-		if normalizedCFG.Path == nil {
-			normalizedCFG.Path = awssdk.String("/#{path}")
-		}
-		if normalizedCFG.Port == nil {
-			normalizedCFG.Port = awssdk.String("#{port}")
-		}
-		if normalizedCFG.Protocol == nil {
-			normalizedCFG.Protocol = awssdk.String("#{protocol}")
-		}
-		if normalizedCFG.Query == nil {
-			normalizedCFG.Query = awssdk.String("#{query}")
-		}
-		return &normalizedCFG
-	})
+	return cmp.Options{
+		cmpopts.IgnoreUnexported(elbv2types.RedirectActionConfig{}),
+		cmpopts.AcyclicTransformer("normalizeRedirectActionConfig", func(config *elbv2types.RedirectActionConfig) *elbv2types.RedirectActionConfig {
+			if config == nil {
+				return nil
+			}
+			normalizedCFG := *config
+			if normalizedCFG.Host == nil {
+				normalizedCFG.Host = awssdk.String("#{host}")
+			}
+			if normalizedCFG.Path == nil {
+				normalizedCFG.Path = awssdk.String("/#{path}")
+			}
+			if normalizedCFG.Port == nil {
+				normalizedCFG.Port = awssdk.String("#{port}")
+			}
+			if normalizedCFG.Protocol == nil {
+				normalizedCFG.Protocol = awssdk.String("#{protocol}")
+			}
+			if normalizedCFG.Query == nil {
+				normalizedCFG.Query = awssdk.String("#{query}")
+			}
+			return &normalizedCFG
+		}),
+	}
 }
 
 // CompareOptionForAction returns the compare option for action.
 func CompareOptionForAction() cmp.Option {
 	return cmp.Options{
-		cmpopts.IgnoreFields(elbv2sdk.Action{}, "Order"),
-		cmpopts.IgnoreFields(elbv2sdk.Action{}, "TargetGroupArn"),
+		cmpopts.IgnoreUnexported(elbv2types.Action{}, elbv2types.FixedResponseActionConfig{},
+			elbv2types.AuthenticateCognitoActionConfig{}, elbv2types.AuthenticateOidcActionConfig{}),
+		cmpopts.IgnoreFields(elbv2types.Action{}, "Order"),
+		cmpopts.IgnoreFields(elbv2types.Action{}, "TargetGroupArn"),
 		CompareOptionForForwardActionConfig(),
 		CompareOptionForRedirectActionConfig(),
 	}
@@ -69,11 +75,11 @@ func CompareOptionForAction() cmp.Option {
 func CompareOptionForActions() cmp.Option {
 	return cmp.Options{
 		cmpopts.EquateEmpty(),
-		cmpopts.SortSlices(func(lhs *elbv2sdk.Action, rhs *elbv2sdk.Action) bool {
+		cmpopts.SortSlices(func(lhs elbv2types.Action, rhs elbv2types.Action) bool {
 			if lhs.Order == nil || rhs.Order == nil {
 				return false
 			}
-			return awssdk.Int64Value(lhs.Order) < awssdk.Int64Value(rhs.Order)
+			return awssdk.ToInt32(lhs.Order) < awssdk.ToInt32(rhs.Order)
 		}),
 		CompareOptionForAction(),
 	}
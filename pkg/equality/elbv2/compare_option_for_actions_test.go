@@ -1,8 +1,8 @@
 package elbv2
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -10,8 +10,8 @@ import (
 
 func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 	type args struct {
-		lhs *elbv2sdk.RedirectActionConfig
-		rhs *elbv2sdk.RedirectActionConfig
+		lhs *elbv2types.RedirectActionConfig
+		rhs *elbv2types.RedirectActionConfig
 	}
 	tests := []struct {
 		name string
@@ -21,21 +21,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "equals for all fields",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: true,
@@ -43,21 +43,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "host not equals",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("app.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -65,21 +65,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "host not equals with #{host}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("#{host}"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -87,21 +87,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "nil host equals with #{host}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("#{host}"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       nil,
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: true,
@@ -109,21 +109,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "path not equals",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/app"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -131,21 +131,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "path not equals with /#{path}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/#{path}"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -153,21 +153,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "nil path equals with /#{path}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/#{path}"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       nil,
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: true,
@@ -175,21 +175,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "port not equals",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("443"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -197,21 +197,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "port not equals with #{port}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("#{port}"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -219,21 +219,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "nil port equals with #{port}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("#{port}"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       nil,
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: true,
@@ -241,21 +241,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "protocol not equals",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTP"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -263,21 +263,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "protocol not equals with #{protocol}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("#{protocol}"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -285,21 +285,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "nil protocol equals with #{protocol}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("#{protocol}"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   nil,
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: true,
@@ -307,21 +307,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "query not equals",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=c"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -329,21 +329,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "query not equals with #{query}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("#{query}"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: false,
@@ -351,21 +351,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "nil query equals with #{query}",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("#{query}"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      nil,
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
 			},
 			want: true,
@@ -373,21 +373,21 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 		{
 			name: "statusCode not equals",
 			args: args{
-				lhs: &elbv2sdk.RedirectActionConfig{
+				lhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_301"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp301,
 				},
-				rhs: &elbv2sdk.RedirectActionConfig{
+				rhs: &elbv2types.RedirectActionConfig{
 					Host:       awssdk.String("www.example.com"),
 					Path:       awssdk.String("/home"),
 					Port:       awssdk.String("80"),
 					Protocol:   awssdk.String("HTTPS"),
 					Query:      awssdk.String("a=b"),
-					StatusCode: awssdk.String("HTTP_302"),
+					StatusCode: elbv2types.RedirectActionStatusCodeEnumHttp302,
 				},
 			},
 			want: false,
@@ -412,8 +412,8 @@ func TestCompareOptionForRedirectActionConfig(t *testing.T) {
 
 func TestCompareOptionForAction(t *testing.T) {
 	type args struct {
-		lhs elbv2sdk.Action
-		rhs elbv2sdk.Action
+		lhs elbv2types.Action
+		rhs elbv2types.Action
 	}
 	tests := []struct {
 		name string
@@ -423,20 +423,20 @@ func TestCompareOptionForAction(t *testing.T) {
 		{
 			name: "two actions equals exactly",
 			args: args{
-				lhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				lhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
 						},
 					},
 				},
-				rhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				rhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
@@ -449,20 +449,20 @@ func TestCompareOptionForAction(t *testing.T) {
 		{
 			name: "two actions are not equal if some fields un-equal",
 			args: args{
-				lhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				lhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
 						},
 					},
 				},
-				rhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				rhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-b"),
 							},
@@ -475,26 +475,26 @@ func TestCompareOptionForAction(t *testing.T) {
 		{
 			name: "two actions are equal irrelevant of their order existence",
 			args: args{
-				lhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				lhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
 						},
 					},
 				},
-				rhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				rhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
 						},
 					},
-					Order: awssdk.Int64(1),
+					Order: awssdk.Int32(1),
 				},
 			},
 			want: true,
@@ -502,27 +502,27 @@ func TestCompareOptionForAction(t *testing.T) {
 		{
 			name: "two actions are equal irrelevant of their order value",
 			args: args{
-				lhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				lhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
 						},
 					},
-					Order: awssdk.Int64(1),
+					Order: awssdk.Int32(1),
 				},
-				rhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				rhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
 						},
 					},
-					Order: awssdk.Int64(2),
+					Order: awssdk.Int32(2),
 				},
 			},
 			want: true,
@@ -530,20 +530,20 @@ func TestCompareOptionForAction(t *testing.T) {
 		{
 			name: "two action are equal irrelevant of their targetGroupARN existence",
 			args: args{
-				lhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				lhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
 						},
 					},
 				},
-				rhs: elbv2sdk.Action{
-					Type: awssdk.String("forward"),
-					ForwardConfig: &elbv2sdk.ForwardActionConfig{
-						TargetGroups: []*elbv2sdk.TargetGroupTuple{
+				rhs: elbv2types.Action{
+					Type: elbv2types.ActionTypeEnumForward,
+					ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{
 							{
 								TargetGroupArn: awssdk.String("tg-a"),
 							},
@@ -565,8 +565,8 @@ func TestCompareOptionForAction(t *testing.T) {
 
 func TestCompareOptionForActions(t *testing.T) {
 	type args struct {
-		lhs []*elbv2sdk.Action
-		rhs []*elbv2sdk.Action
+		lhs []elbv2types.Action
+		rhs []elbv2types.Action
 	}
 	tests := []struct {
 		name string
@@ -576,48 +576,48 @@ func TestCompareOptionForActions(t *testing.T) {
 		{
 			name: "two actions slice equals exactly",
 			args: args{
-				lhs: []*elbv2sdk.Action{
+				lhs: []elbv2types.Action{
 					{
-						Type: awssdk.String("authenticate-cognito"),
-						AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						Type: elbv2types.ActionTypeEnumAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2types.AuthenticateCognitoActionConfig{
 							UserPoolArn:      awssdk.String("pool-arn-a"),
 							UserPoolClientId: awssdk.String("pool-client-id-a"),
 							UserPoolDomain:   awssdk.String("pool-domain-a"),
 						},
-						Order: awssdk.Int64(1),
+						Order: awssdk.Int32(1),
 					},
 					{
-						Type: awssdk.String("forward"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnumForward,
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("tg-a"),
 								},
 							},
 						},
-						Order: awssdk.Int64(2),
+						Order: awssdk.Int32(2),
 					},
 				},
-				rhs: []*elbv2sdk.Action{
+				rhs: []elbv2types.Action{
 					{
-						Type: awssdk.String("authenticate-cognito"),
-						AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						Type: elbv2types.ActionTypeEnumAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2types.AuthenticateCognitoActionConfig{
 							UserPoolArn:      awssdk.String("pool-arn-a"),
 							UserPoolClientId: awssdk.String("pool-client-id-a"),
 							UserPoolDomain:   awssdk.String("pool-domain-a"),
 						},
-						Order: awssdk.Int64(1),
+						Order: awssdk.Int32(1),
 					},
 					{
-						Type: awssdk.String("forward"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnumForward,
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("tg-a"),
 								},
 							},
 						},
-						Order: awssdk.Int64(2),
+						Order: awssdk.Int32(2),
 					},
 				},
 			},
@@ -626,48 +626,48 @@ func TestCompareOptionForActions(t *testing.T) {
 		{
 			name: "two actions slice are not equal if there actions are not equal",
 			args: args{
-				lhs: []*elbv2sdk.Action{
+				lhs: []elbv2types.Action{
 					{
-						Type: awssdk.String("authenticate-cognito"),
-						AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						Type: elbv2types.ActionTypeEnumAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2types.AuthenticateCognitoActionConfig{
 							UserPoolArn:      awssdk.String("pool-arn-a"),
 							UserPoolClientId: awssdk.String("pool-client-id-a"),
 							UserPoolDomain:   awssdk.String("pool-domain-a"),
 						},
-						Order: awssdk.Int64(1),
+						Order: awssdk.Int32(1),
 					},
 					{
-						Type: awssdk.String("forward"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnumForward,
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("tg-a"),
 								},
 							},
 						},
-						Order: awssdk.Int64(2),
+						Order: awssdk.Int32(2),
 					},
 				},
-				rhs: []*elbv2sdk.Action{
+				rhs: []elbv2types.Action{
 					{
-						Type: awssdk.String("authenticate-cognito"),
-						AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						Type: elbv2types.ActionTypeEnumAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2types.AuthenticateCognitoActionConfig{
 							UserPoolArn:      awssdk.String("pool-arn-b"),
 							UserPoolClientId: awssdk.String("pool-client-id-b"),
 							UserPoolDomain:   awssdk.String("pool-domain-b"),
 						},
-						Order: awssdk.Int64(1),
+						Order: awssdk.Int32(1),
 					},
 					{
-						Type: awssdk.String("forward"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnumForward,
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("tg-a"),
 								},
 							},
 						},
-						Order: awssdk.Int64(2),
+						Order: awssdk.Int32(2),
 					},
 				},
 			},
@@ -676,48 +676,48 @@ func TestCompareOptionForActions(t *testing.T) {
 		{
 			name: "two actions slice equals when they are equal after sorted by order",
 			args: args{
-				lhs: []*elbv2sdk.Action{
+				lhs: []elbv2types.Action{
 					{
-						Type: awssdk.String("authenticate-cognito"),
-						AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						Type: elbv2types.ActionTypeEnumAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2types.AuthenticateCognitoActionConfig{
 							UserPoolArn:      awssdk.String("pool-arn-a"),
 							UserPoolClientId: awssdk.String("pool-client-id-a"),
 							UserPoolDomain:   awssdk.String("pool-domain-a"),
 						},
-						Order: awssdk.Int64(1),
+						Order: awssdk.Int32(1),
 					},
 					{
-						Type: awssdk.String("forward"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnumForward,
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("tg-a"),
 								},
 							},
 						},
-						Order: awssdk.Int64(2),
+						Order: awssdk.Int32(2),
 					},
 				},
-				rhs: []*elbv2sdk.Action{
+				rhs: []elbv2types.Action{
 					{
-						Type: awssdk.String("forward"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnumForward,
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("tg-a"),
 								},
 							},
 						},
-						Order: awssdk.Int64(4),
+						Order: awssdk.Int32(4),
 					},
 					{
-						Type: awssdk.String("authenticate-cognito"),
-						AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						Type: elbv2types.ActionTypeEnumAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2types.AuthenticateCognitoActionConfig{
 							UserPoolArn:      awssdk.String("pool-arn-a"),
 							UserPoolClientId: awssdk.String("pool-client-id-a"),
 							UserPoolDomain:   awssdk.String("pool-domain-a"),
 						},
-						Order: awssdk.Int64(3),
+						Order: awssdk.Int32(3),
 					},
 				},
 			},
@@ -726,48 +726,48 @@ func TestCompareOptionForActions(t *testing.T) {
 		{
 			name: "two actions slice are not equals when they are not equal after sorted by order",
 			args: args{
-				lhs: []*elbv2sdk.Action{
+				lhs: []elbv2types.Action{
 					{
-						Type: awssdk.String("authenticate-cognito"),
-						AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						Type: elbv2types.ActionTypeEnumAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2types.AuthenticateCognitoActionConfig{
 							UserPoolArn:      awssdk.String("pool-arn-a"),
 							UserPoolClientId: awssdk.String("pool-client-id-a"),
 							UserPoolDomain:   awssdk.String("pool-domain-a"),
 						},
-						Order: awssdk.Int64(1),
+						Order: awssdk.Int32(1),
 					},
 					{
-						Type: awssdk.String("forward"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnumForward,
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("tg-a"),
 								},
 							},
 						},
-						Order: awssdk.Int64(2),
+						Order: awssdk.Int32(2),
 					},
 				},
-				rhs: []*elbv2sdk.Action{
+				rhs: []elbv2types.Action{
 					{
-						Type: awssdk.String("forward"),
-						ForwardConfig: &elbv2sdk.ForwardActionConfig{
-							TargetGroups: []*elbv2sdk.TargetGroupTuple{
+						Type: elbv2types.ActionTypeEnumForward,
+						ForwardConfig: &elbv2types.ForwardActionConfig{
+							TargetGroups: []elbv2types.TargetGroupTuple{
 								{
 									TargetGroupArn: awssdk.String("tg-a"),
 								},
 							},
 						},
-						Order: awssdk.Int64(3),
+						Order: awssdk.Int32(3),
 					},
 					{
-						Type: awssdk.String("authenticate-cognito"),
-						AuthenticateCognitoConfig: &elbv2sdk.AuthenticateCognitoActionConfig{
+						Type: elbv2types.ActionTypeEnumAuthenticateCognito,
+						AuthenticateCognitoConfig: &elbv2types.AuthenticateCognitoActionConfig{
 							UserPoolArn:      awssdk.String("pool-arn-a"),
 							UserPoolClientId: awssdk.String("pool-client-id-a"),
 							UserPoolDomain:   awssdk.String("pool-domain-a"),
 						},
-						Order: awssdk.Int64(4),
+						Order: awssdk.Int32(4),
 					},
 				},
 			},
@@ -1,21 +1,24 @@
 package elbv2
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func CompareOptionForCertificate() cmp.Option {
-	return cmpopts.IgnoreFields(elbv2sdk.Certificate{}, "IsDefault")
+	return cmp.Options{
+		cmpopts.IgnoreUnexported(elbv2types.Certificate{}),
+		cmpopts.IgnoreFields(elbv2types.Certificate{}, "IsDefault"),
+	}
 }
 
 func CompareOptionForCertificates() cmp.Options {
 	return cmp.Options{
 		cmpopts.EquateEmpty(),
-		cmpopts.SortSlices(func(lhs *elbv2sdk.Certificate, rhs *elbv2sdk.Certificate) bool {
-			return aws.StringValue(lhs.CertificateArn) < aws.StringValue(rhs.CertificateArn)
+		cmpopts.SortSlices(func(lhs elbv2types.Certificate, rhs elbv2types.Certificate) bool {
+			return aws.ToString(lhs.CertificateArn) < aws.ToString(rhs.CertificateArn)
 		}),
 		CompareOptionForCertificate(),
 	}
@@ -1,15 +1,19 @@
 package elbv2
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func CompareOptionForRuleCondition() cmp.Option {
 	return cmp.Options{
-		cmpopts.IgnoreFields(elbv2sdk.RuleCondition{}, "Values"),
+		cmpopts.IgnoreUnexported(elbv2types.RuleCondition{}, elbv2types.HostHeaderConditionConfig{},
+			elbv2types.HttpHeaderConditionConfig{}, elbv2types.HttpRequestMethodConditionConfig{},
+			elbv2types.PathPatternConditionConfig{}, elbv2types.QueryStringConditionConfig{},
+			elbv2types.QueryStringKeyValuePair{}, elbv2types.SourceIpConditionConfig{}),
+		cmpopts.IgnoreFields(elbv2types.RuleCondition{}, "Values"),
 	}
 }
 
@@ -17,8 +21,8 @@ func CompareOptionForRuleCondition() cmp.Option {
 func CompareOptionForRuleConditions() cmp.Option {
 	return cmp.Options{
 		cmpopts.EquateEmpty(),
-		cmpopts.SortSlices(func(lhs *elbv2sdk.RuleCondition, rhs *elbv2sdk.RuleCondition) bool {
-			return awssdk.StringValue(lhs.Field) < awssdk.StringValue(rhs.Field)
+		cmpopts.SortSlices(func(lhs elbv2types.RuleCondition, rhs elbv2types.RuleCondition) bool {
+			return awssdk.ToString(lhs.Field) < awssdk.ToString(rhs.Field)
 		}),
 		CompareOptionForRuleCondition(),
 	}
@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// runForNLB builds a NLB for the Gateway, with one listener per Gateway listener that have a route attached.
+// NLB listener can only forward to a single TargetGroup, thus each L4 listener supports a single route with a single backendRef.
+func (t *defaultModelBuildTask) runForNLB(ctx context.Context) error {
+	listenerByPort := make(map[int64]gwv1beta1.Listener, len(t.gw.Spec.Listeners))
+	listenPortConfigByPort := make(map[int64]listenPortConfig, len(t.gw.Spec.Listeners))
+	for _, listener := range t.gw.Spec.Listeners {
+		port := int64(listener.Port)
+		if existingListener, exists := listenerByPort[port]; exists {
+			return errors.Errorf("conflicting listeners on port %v: %v, %v", port, existingListener.Name, listener.Name)
+		}
+		listenerByPort[port] = listener
+		cfg, err := t.computeListenPortConfig(ctx, port, []gwv1beta1.Listener{listener})
+		if err != nil {
+			return errors.Wrapf(err, "failed to compute listenPort config for port: %v", port)
+		}
+		listenPortConfigByPort[port] = cfg
+	}
+
+	lb, err := t.buildLoadBalancer(ctx, listenPortConfigByPort)
+	if err != nil {
+		return err
+	}
+	for port, cfg := range listenPortConfigByPort {
+		listener := listenerByPort[port]
+		route, exists := t.routes.L4Routes[listener.Name]
+		if !exists {
+			continue
+		}
+		tg, err := t.buildTargetGroupForL4Route(ctx, route, cfg.protocol)
+		if err != nil {
+			return errors.Wrapf(err, "%v", route.String())
+		}
+		defaultActions := []elbv2model.Action{
+			{
+				Type: elbv2model.ActionTypeForward,
+				ForwardConfig: &elbv2model.ForwardActionConfig{
+					TargetGroups: []elbv2model.TargetGroupTuple{
+						{
+							TargetGroupARN: tg.TargetGroupARN(),
+						},
+					},
+				},
+			},
+		}
+		if _, err := t.buildListener(ctx, lb.LoadBalancerARN(), port, cfg, defaultActions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupForL4Route(ctx context.Context, route *L4Route, listenerProtocol elbv2model.Protocol) (*elbv2model.TargetGroup, error) {
+	var backendRefs []gwv1beta1.BackendRef
+	for _, backendRef := range route.BackendRefs {
+		if backendRef.Weight != nil && *backendRef.Weight == 0 {
+			continue
+		}
+		backendRefs = append(backendRefs, backendRef)
+	}
+	if len(backendRefs) != 1 {
+		return nil, errors.Errorf("exactly one backendRef is supported, got %v", len(backendRefs))
+	}
+	svc, port, err := t.loadServiceForBackendRef(ctx, route.Kind, route.Object, backendRefs[0])
+	if err != nil {
+		return nil, err
+	}
+	return t.buildL4TargetGroup(ctx, route.Kind, k8s.NamespacedName(route.Object), svc, port, t.buildL4TargetGroupProtocol(listenerProtocol))
+}
+
+// buildL4TargetGroupProtocol computes the TargetGroup protocol for NLB listener protocol,
+// TLS listeners will forward traffic to targets via TCP once TLS is terminated.
+func (t *defaultModelBuildTask) buildL4TargetGroupProtocol(listenerProtocol elbv2model.Protocol) elbv2model.Protocol {
+	if listenerProtocol == elbv2model.ProtocolTLS {
+		return elbv2model.ProtocolTCP
+	}
+	return listenerProtocol
+}
+
+func (t *defaultModelBuildTask) buildL4TargetGroup(ctx context.Context, routeKind string, routeKey types.NamespacedName, svc *corev1.Service,
+	port intstr.IntOrString, tgProtocol elbv2model.Protocol) (*elbv2model.TargetGroup, error) {
+	// L4 routes of different kinds can have the same name, thus routeKind is part of the resource ID.
+	tgResID := fmt.Sprintf("%s:%s", routeKind, t.buildTargetGroupResourceID(routeKey, k8s.NamespacedName(svc), port))
+	if tg, exists := t.tgByResID[tgResID]; exists {
+		return tg, nil
+	}
+	svcPort, err := k8s.LookupServicePort(svc, port)
+	if err != nil {
+		return nil, err
+	}
+	tgSpec, err := t.buildL4TargetGroupSpec(ctx, routeKey, svc, port, svcPort, tgProtocol)
+	if err != nil {
+		return nil, err
+	}
+	tg := elbv2model.NewTargetGroup(t.stack, tgResID, tgSpec)
+	t.tgByResID[tgResID] = tg
+	_ = t.buildTargetGroupBinding(ctx, tg, svc, port, svcPort)
+	return tg, nil
+}
+
+func (t *defaultModelBuildTask) buildL4TargetGroupSpec(ctx context.Context, routeKey types.NamespacedName, svc *corev1.Service,
+	port intstr.IntOrString, svcPort corev1.ServicePort, tgProtocol elbv2model.Protocol) (elbv2model.TargetGroupSpec, error) {
+	targetType, err := t.buildTargetGroupTargetType(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	healthCheckConfig := t.buildL4TargetGroupHealthCheckConfig(ctx, svc)
+	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tags, err := t.buildGatewayBackendResourceTags(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgPort := t.buildTargetGroupPort(ctx, targetType, svcPort)
+	name := t.buildTargetGroupName(ctx, routeKey, svc, port, tgPort, targetType, tgProtocol, "")
+	return elbv2model.TargetGroupSpec{
+		Name:                  name,
+		TargetType:            targetType,
+		Port:                  tgPort,
+		Protocol:              tgProtocol,
+		IPAddressType:         &ipAddressType,
+		HealthCheckConfig:     &healthCheckConfig,
+		TargetGroupAttributes: tgAttributes,
+		Tags:                  tags,
+	}, nil
+}
+
+// buildL4TargetGroupHealthCheckConfig builds the health check config for NLB TargetGroups.
+// TCP health checks are sent to the traffic port by default, HTTP health checks are used if healthcheck path is specified.
+func (t *defaultModelBuildTask) buildL4TargetGroupHealthCheckConfig(_ context.Context, svc *corev1.Service) elbv2model.TargetGroupHealthCheckConfig {
+	healthCheckPort := intstr.FromString(healthCheckPortTrafficPort)
+	healthCheckConfig := elbv2model.TargetGroupHealthCheckConfig{
+		Port:                    &healthCheckPort,
+		IntervalSeconds:         &t.defaultL4HealthCheckIntervalSeconds,
+		TimeoutSeconds:          &t.defaultL4HealthCheckTimeoutSeconds,
+		HealthyThresholdCount:   &t.defaultL4HealthCheckHealthyThresholdCount,
+		UnhealthyThresholdCount: &t.defaultL4HealthCheckUnhealthyThresholdCount,
+	}
+	var healthCheckPath string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixHealthCheckPath, &healthCheckPath, svc.Annotations); !exists {
+		healthCheckProtocol := elbv2model.ProtocolTCP
+		healthCheckConfig.Protocol = &healthCheckProtocol
+		return healthCheckConfig
+	}
+	healthCheckProtocol := elbv2model.ProtocolHTTP
+	healthCheckMatcherCode := t.defaultHealthCheckMatcherHTTPCode
+	_ = t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixHealthCheckSuccessCodes, &healthCheckMatcherCode, svc.Annotations)
+	healthCheckConfig.Protocol = &healthCheckProtocol
+	healthCheckConfig.Path = &healthCheckPath
+	healthCheckConfig.Matcher = &elbv2model.HealthCheckMatcher{HTTPCode: &healthCheckMatcherCode}
+	return healthCheckConfig
+}
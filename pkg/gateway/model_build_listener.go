@@ -0,0 +1,211 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// the listen port config for specific listener port.
+type listenPortConfig struct {
+	protocol       elbv2model.Protocol
+	inboundCIDRv4s []string
+	inboundCIDRv6s []string
+	sslPolicy      *string
+	tlsCerts       []string
+}
+
+func (t *defaultModelBuildTask) buildListener(ctx context.Context, lbARN core.StringToken, port int64, config listenPortConfig, defaultActions []elbv2model.Action) (*elbv2model.Listener, error) {
+	lsSpec, err := t.buildListenerSpec(ctx, lbARN, port, config, defaultActions)
+	if err != nil {
+		return nil, err
+	}
+	lsResID := fmt.Sprintf("%v", port)
+	ls := elbv2model.NewListener(t.stack, lsResID, lsSpec)
+	return ls, nil
+}
+
+func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN core.StringToken, port int64, config listenPortConfig, defaultActions []elbv2model.Action) (elbv2model.ListenerSpec, error) {
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts))
+	for _, certARN := range config.tlsCerts {
+		certs = append(certs, elbv2model.Certificate{
+			CertificateARN: awssdk.String(certARN),
+		})
+	}
+	return elbv2model.ListenerSpec{
+		LoadBalancerARN: lbARN,
+		Port:            port,
+		Protocol:        config.protocol,
+		DefaultActions:  defaultActions,
+		Certificates:    certs,
+		SSLPolicy:       config.sslPolicy,
+		Tags:            tags,
+	}, nil
+}
+
+// computeListenPortConfig computes the listen port config for Gateway listeners sharing the same port.
+func (t *defaultModelBuildTask) computeListenPortConfig(ctx context.Context, port int64, listeners []gwv1beta1.Listener) (listenPortConfig, error) {
+	if port < 1 || port > 65535 {
+		return listenPortConfig{}, errors.Errorf("listen port must be within [1, 65535]: %v", port)
+	}
+	protocol, err := t.computeListenerProtocol(ctx, listeners)
+	if err != nil {
+		return listenPortConfig{}, err
+	}
+	inboundCIDRv4s, inboundCIDRv6s, err := t.computeGatewayInboundCIDRs(ctx)
+	if err != nil {
+		return listenPortConfig{}, err
+	}
+	cfg := listenPortConfig{
+		protocol:       protocol,
+		inboundCIDRv4s: inboundCIDRv4s,
+		inboundCIDRv6s: inboundCIDRv6s,
+	}
+	if protocol != elbv2model.ProtocolHTTPS && protocol != elbv2model.ProtocolTLS {
+		return cfg, nil
+	}
+
+	var tlsCerts []string
+	tlsCertsSet := sets.NewString()
+	sslPolicies := sets.NewString()
+	for _, listener := range listeners {
+		listenerCerts, err := t.computeListenerTLSCertARNs(ctx, listener)
+		if err != nil {
+			return listenPortConfig{}, errors.Wrapf(err, "listener: %v", listener.Name)
+		}
+		for _, cert := range listenerCerts {
+			if !tlsCertsSet.Has(cert) {
+				tlsCertsSet.Insert(cert)
+				tlsCerts = append(tlsCerts, cert)
+			}
+		}
+		sslPolicies.Insert(t.computeListenerSSLPolicy(ctx, listener))
+	}
+	if len(sslPolicies) > 1 {
+		return listenPortConfig{}, errors.Errorf("conflicting sslPolicy: %v", sslPolicies.List())
+	}
+	sslPolicy, _ := sslPolicies.PopAny()
+	cfg.tlsCerts = tlsCerts
+	cfg.sslPolicy = awssdk.String(sslPolicy)
+	return cfg, nil
+}
+
+func (t *defaultModelBuildTask) computeListenerProtocol(_ context.Context, listeners []gwv1beta1.Listener) (elbv2model.Protocol, error) {
+	protocols := sets.NewString()
+	for _, listener := range listeners {
+		protocols.Insert(string(listener.Protocol))
+	}
+	if len(protocols) > 1 {
+		return "", errors.Errorf("conflicting protocol: %v", protocols.List())
+	}
+	rawProtocol, _ := protocols.PopAny()
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeNetwork {
+		return t.computeNLBListenerProtocol(listeners[0])
+	}
+	switch gwv1beta1.ProtocolType(rawProtocol) {
+	case gwv1beta1.HTTPProtocolType:
+		return elbv2model.ProtocolHTTP, nil
+	case gwv1beta1.HTTPSProtocolType:
+		return elbv2model.ProtocolHTTPS, nil
+	default:
+		return "", errors.Errorf("listener protocol must be within [%v, %v]: %v", gwv1beta1.HTTPProtocolType, gwv1beta1.HTTPSProtocolType, rawProtocol)
+	}
+}
+
+// computeNLBListenerProtocol computes the NLB listener protocol for a Gateway listener.
+// TLS listeners in Passthrough mode are translated into TCP listeners, so that TLS is terminated by the targets.
+func (t *defaultModelBuildTask) computeNLBListenerProtocol(listener gwv1beta1.Listener) (elbv2model.Protocol, error) {
+	switch listener.Protocol {
+	case gwv1beta1.TCPProtocolType:
+		return elbv2model.ProtocolTCP, nil
+	case gwv1beta1.UDPProtocolType:
+		return elbv2model.ProtocolUDP, nil
+	case gwv1beta1.TLSProtocolType:
+		if listener.TLS != nil && listener.TLS.Mode != nil && *listener.TLS.Mode == gwv1beta1.TLSModePassthrough {
+			return elbv2model.ProtocolTCP, nil
+		}
+		return elbv2model.ProtocolTLS, nil
+	default:
+		return "", errors.Errorf("listener protocol must be within [%v, %v, %v]: %v", gwv1beta1.TCPProtocolType, gwv1beta1.UDPProtocolType, gwv1beta1.TLSProtocolType, listener.Protocol)
+	}
+}
+
+// computeListenerTLSCertARNs computes the certificates for a HTTPS or TLS listener.
+// the certificates can be explicitly specified via TLS options, otherwise they will be discovered from ACM by listener's hostname.
+func (t *defaultModelBuildTask) computeListenerTLSCertARNs(ctx context.Context, listener gwv1beta1.Listener) ([]string, error) {
+	if listener.TLS == nil {
+		return nil, errors.Errorf("tls configuration is required for %v listener", listener.Protocol)
+	}
+	if listener.TLS.Mode != nil && *listener.TLS.Mode != gwv1beta1.TLSModeTerminate {
+		return nil, errors.Errorf("unsupported tls mode: %v", *listener.TLS.Mode)
+	}
+	var rawTLSCertARNs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.GatewaySuffixCertificateARN, &rawTLSCertARNs, tlsOptionsAsMap(listener.TLS)); exists {
+		return rawTLSCertARNs, nil
+	}
+	if listener.Hostname == nil || len(*listener.Hostname) == 0 {
+		return nil, errors.Errorf("either hostname or tls option %v/%v must be specified for %v listener",
+			annotations.AnnotationPrefixGateway, annotations.GatewaySuffixCertificateARN, listener.Protocol)
+	}
+	return t.certDiscovery.Discover(ctx, []string{string(*listener.Hostname)})
+}
+
+// computeListenerSSLPolicy computes the SSL policy for a HTTPS or TLS listener.
+// the SSL policy specified via TLS options takes higher priority than the annotation on Gateway.
+func (t *defaultModelBuildTask) computeListenerSSLPolicy(_ context.Context, listener gwv1beta1.Listener) string {
+	var rawSSLPolicy string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixSSLPolicy, &rawSSLPolicy, tlsOptionsAsMap(listener.TLS)); exists {
+		return rawSSLPolicy
+	}
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixSSLPolicy, &rawSSLPolicy, t.gw.Annotations); exists {
+		return rawSSLPolicy
+	}
+	return t.defaultSSLPolicy
+}
+
+func (t *defaultModelBuildTask) computeGatewayInboundCIDRs(_ context.Context) ([]string, []string, error) {
+	var rawInboundCIDRs []string
+	_ = t.annotationParser.ParseStringSliceAnnotation(annotations.GatewaySuffixInboundCIDRs, &rawInboundCIDRs, t.gw.Annotations)
+	if len(rawInboundCIDRs) == 0 {
+		return []string{"0.0.0.0/0"}, []string{"::/0"}, nil
+	}
+
+	var inboundCIDRv4s, inboundCIDRv6s []string
+	for _, cidr := range rawInboundCIDRs {
+		_, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %v settings on Gateway: %v: %w", annotations.GatewaySuffixInboundCIDRs, k8s.NamespacedName(t.gw), err)
+		}
+		if strings.Contains(cidr, ":") {
+			inboundCIDRv6s = append(inboundCIDRv6s, cidr)
+		} else {
+			inboundCIDRv4s = append(inboundCIDRv4s, cidr)
+		}
+	}
+	return inboundCIDRv4s, inboundCIDRv6s, nil
+}
+
+func tlsOptionsAsMap(tlsConfig *gwv1beta1.GatewayTLSConfig) map[string]string {
+	if tlsConfig == nil {
+		return nil
+	}
+	options := make(map[string]string, len(tlsConfig.Options))
+	for key, value := range tlsConfig.Options {
+		options[string(key)] = string(value)
+	}
+	return options
+}
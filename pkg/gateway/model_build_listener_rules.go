@@ -0,0 +1,362 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// routeMatch is a single match of HTTPRoute rule attached to a listener, which will be translated into a listener rule.
+type routeMatch struct {
+	route      *gwv1beta1.HTTPRoute
+	routeOrder int
+	ruleIndex  int
+	matchIndex int
+	rule       gwv1beta1.HTTPRouteRule
+	match      gwv1beta1.HTTPRouteMatch
+	hostnames  []string
+}
+
+func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN core.StringToken, port int64, listeners []gwv1beta1.Listener) error {
+	var matches []routeMatch
+	for _, listener := range listeners {
+		for routeOrder, route := range t.routes.HTTPRoutes[listener.Name] {
+			hostnames := ComputeRouteHostnames(listener.Hostname, route.Spec.Hostnames)
+			for ruleIndex, rule := range route.Spec.Rules {
+				ruleMatches := rule.Matches
+				if len(ruleMatches) == 0 {
+					ruleMatches = []gwv1beta1.HTTPRouteMatch{{}}
+				}
+				for matchIndex, match := range ruleMatches {
+					matches = append(matches, routeMatch{
+						route:      route,
+						routeOrder: routeOrder,
+						ruleIndex:  ruleIndex,
+						matchIndex: matchIndex,
+						rule:       rule,
+						match:      match,
+						hostnames:  hostnames,
+					})
+				}
+			}
+		}
+	}
+	sortRouteMatches(matches)
+
+	priority := int64(1)
+	for _, match := range matches {
+		routeKey := k8s.NamespacedName(match.route)
+		conditions, err := t.buildRuleConditions(ctx, match.hostnames, match.match)
+		if err != nil {
+			return errors.Wrapf(err, "HTTPRoute: %v", routeKey.String())
+		}
+		actions, err := t.buildRuleActions(ctx, match.route, match.rule)
+		if err != nil {
+			return errors.Wrapf(err, "HTTPRoute: %v", routeKey.String())
+		}
+		tags, err := t.buildGatewayResourceTags(ctx)
+		if err != nil {
+			return err
+		}
+		ruleResID := fmt.Sprintf("%v:%v", port, priority)
+		_ = elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
+			ListenerARN: lsARN,
+			Priority:    priority,
+			Conditions:  conditions,
+			Actions:     actions,
+			Tags:        tags,
+		})
+		priority += 1
+	}
+	return nil
+}
+
+// sortRouteMatches sorts the matches by the precedence defined by Gateway API:
+//  1. matches with non-wildcard hostnames, followed by matches with wildcard hostnames, followed by matches without hostnames.
+//  2. exact path matches, followed by prefix path matches with longer paths get precedence.
+//  3. matches with method specified.
+//  4. matches with larger number of header matches.
+//  5. matches with larger number of query param matches.
+//
+// ties are broken by the order of routes, and then the order of rules and matches within a route.
+func sortRouteMatches(matches []routeMatch) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		mi, mj := matches[i], matches[j]
+		if hi, hj := hostnamesPrecedence(mi.hostnames), hostnamesPrecedence(mj.hostnames); hi != hj {
+			return hi > hj
+		}
+		ei, li := pathPrecedence(mi.match.Path)
+		ej, lj := pathPrecedence(mj.match.Path)
+		if ei != ej {
+			return ei
+		}
+		if li != lj {
+			return li > lj
+		}
+		if (mi.match.Method != nil) != (mj.match.Method != nil) {
+			return mi.match.Method != nil
+		}
+		if len(mi.match.Headers) != len(mj.match.Headers) {
+			return len(mi.match.Headers) > len(mj.match.Headers)
+		}
+		if len(mi.match.QueryParams) != len(mj.match.QueryParams) {
+			return len(mi.match.QueryParams) > len(mj.match.QueryParams)
+		}
+		if mi.routeOrder != mj.routeOrder {
+			return mi.routeOrder < mj.routeOrder
+		}
+		if mi.ruleIndex != mj.ruleIndex {
+			return mi.ruleIndex < mj.ruleIndex
+		}
+		return mi.matchIndex < mj.matchIndex
+	})
+}
+
+func hostnamesPrecedence(hostnames []string) int {
+	if len(hostnames) == 0 {
+		return 0
+	}
+	for _, hostname := range hostnames {
+		if !strings.HasPrefix(hostname, "*") {
+			return 2
+		}
+	}
+	return 1
+}
+
+// pathPrecedence returns whether the path match is an exact match, and the length of path.
+func pathPrecedence(pathMatch *gwv1beta1.HTTPPathMatch) (bool, int) {
+	pathType, pathValue := normalizePathMatch(pathMatch)
+	return pathType == gwv1beta1.PathMatchExact, len(pathValue)
+}
+
+func normalizePathMatch(pathMatch *gwv1beta1.HTTPPathMatch) (gwv1beta1.PathMatchType, string) {
+	pathType := gwv1beta1.PathMatchPathPrefix
+	pathValue := "/"
+	if pathMatch != nil {
+		if pathMatch.Type != nil {
+			pathType = *pathMatch.Type
+		}
+		if pathMatch.Value != nil {
+			pathValue = *pathMatch.Value
+		}
+	}
+	return pathType, pathValue
+}
+
+func (t *defaultModelBuildTask) buildRuleConditions(ctx context.Context, hostnames []string, match gwv1beta1.HTTPRouteMatch) ([]elbv2model.RuleCondition, error) {
+	var conditions []elbv2model.RuleCondition
+	if len(hostnames) != 0 {
+		conditions = append(conditions, t.buildHostHeaderCondition(ctx, hostnames))
+	}
+	pathPatterns, err := t.buildPathPatterns(match.Path)
+	if err != nil {
+		return nil, err
+	}
+	conditions = append(conditions, t.buildPathPatternCondition(ctx, pathPatterns))
+	for _, header := range match.Headers {
+		if header.Type != nil && *header.Type != gwv1beta1.HeaderMatchExact {
+			return nil, errors.Errorf("unsupported header match type: %v", *header.Type)
+		}
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+				HTTPHeaderName: string(header.Name),
+				Values:         []string{header.Value},
+			},
+		})
+	}
+	if len(match.QueryParams) != 0 {
+		values := make([]elbv2model.QueryStringKeyValuePair, 0, len(match.QueryParams))
+		for _, queryParam := range match.QueryParams {
+			if queryParam.Type != nil && *queryParam.Type != gwv1beta1.QueryParamMatchExact {
+				return nil, errors.Errorf("unsupported query param match type: %v", *queryParam.Type)
+			}
+			values = append(values, elbv2model.QueryStringKeyValuePair{
+				Key:   awssdk.String(queryParam.Name),
+				Value: queryParam.Value,
+			})
+		}
+		// each query param match needs to be satisfied, so they are translated into separate conditions.
+		for _, value := range values {
+			conditions = append(conditions, elbv2model.RuleCondition{
+				Field: elbv2model.RuleConditionFieldQueryString,
+				QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+					Values: []elbv2model.QueryStringKeyValuePair{value},
+				},
+			})
+		}
+	}
+	if match.Method != nil {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+				Values: []string{string(*match.Method)},
+			},
+		})
+	}
+	return conditions, nil
+}
+
+// buildPathPatterns will build ALB path patterns for the path match.
+// with PathPrefix type, the "/foo" or "/foo/" matches path like "/foo" or "/foo/" or "/foo/bar".
+// for above case, we'll generate two path pattern: "/foo" and "/foo/*".
+// an special case is "/", which matches all paths, thus we generate the path pattern as "/*"
+func (t *defaultModelBuildTask) buildPathPatterns(pathMatch *gwv1beta1.HTTPPathMatch) ([]string, error) {
+	pathType, pathValue := normalizePathMatch(pathMatch)
+	switch pathType {
+	case gwv1beta1.PathMatchExact:
+		if strings.ContainsAny(pathValue, "*?") {
+			return nil, errors.Errorf("exact path shouldn't contain wildcards: %v", pathValue)
+		}
+		return []string{pathValue}, nil
+	case gwv1beta1.PathMatchPathPrefix:
+		if pathValue == "/" {
+			return []string{"/*"}, nil
+		}
+		if strings.ContainsAny(pathValue, "*?") {
+			return nil, errors.Errorf("prefix path shouldn't contain wildcards: %v", pathValue)
+		}
+		normalizedPath := strings.TrimSuffix(pathValue, "/")
+		return []string{normalizedPath, normalizedPath + "/*"}, nil
+	default:
+		return nil, errors.Errorf("unsupported path match type: %v", pathType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildHostHeaderCondition(_ context.Context, hosts []string) elbv2model.RuleCondition {
+	return elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldHostHeader,
+		HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+			Values: hosts,
+		},
+	}
+}
+
+func (t *defaultModelBuildTask) buildPathPatternCondition(_ context.Context, paths []string) elbv2model.RuleCondition {
+	return elbv2model.RuleCondition{
+		Field: elbv2model.RuleConditionFieldPathPattern,
+		PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+			Values: paths,
+		},
+	}
+}
+
+func (t *defaultModelBuildTask) buildRuleActions(ctx context.Context, route *gwv1beta1.HTTPRoute, rule gwv1beta1.HTTPRouteRule) ([]elbv2model.Action, error) {
+	for _, filter := range rule.Filters {
+		switch filter.Type {
+		case gwv1beta1.HTTPRouteFilterRequestRedirect:
+			redirectAction, err := t.buildRedirectAction(ctx, filter.RequestRedirect)
+			if err != nil {
+				return nil, err
+			}
+			return []elbv2model.Action{redirectAction}, nil
+		default:
+			return nil, errors.Errorf("unsupported filter type: %v", filter.Type)
+		}
+	}
+	forwardAction, err := t.buildForwardAction(ctx, route, rule.BackendRefs)
+	if err != nil {
+		return nil, err
+	}
+	return []elbv2model.Action{forwardAction}, nil
+}
+
+func (t *defaultModelBuildTask) buildRedirectAction(_ context.Context, redirect *gwv1beta1.HTTPRequestRedirectFilter) (elbv2model.Action, error) {
+	if redirect == nil {
+		return elbv2model.Action{}, errors.New("missing RequestRedirect")
+	}
+	statusCode := "HTTP_302"
+	if redirect.StatusCode != nil {
+		switch *redirect.StatusCode {
+		case 301:
+			statusCode = "HTTP_301"
+		case 302:
+			statusCode = "HTTP_302"
+		default:
+			return elbv2model.Action{}, errors.Errorf("unsupported redirect statusCode: %v", *redirect.StatusCode)
+		}
+	}
+	redirectConfig := &elbv2model.RedirectActionConfig{
+		StatusCode: statusCode,
+	}
+	if redirect.Scheme != nil {
+		redirectConfig.Protocol = awssdk.String(strings.ToUpper(*redirect.Scheme))
+	}
+	if redirect.Hostname != nil {
+		redirectConfig.Host = awssdk.String(string(*redirect.Hostname))
+	}
+	if redirect.Port != nil {
+		redirectConfig.Port = awssdk.String(strconv.Itoa(int(*redirect.Port)))
+	}
+	if redirect.Path != nil {
+		if redirect.Path.Type != gwv1beta1.FullPathHTTPPathModifier || redirect.Path.ReplaceFullPath == nil {
+			return elbv2model.Action{}, errors.Errorf("unsupported redirect path modifier: %v", redirect.Path.Type)
+		}
+		redirectConfig.Path = redirect.Path.ReplaceFullPath
+	}
+	return elbv2model.Action{
+		Type:           elbv2model.ActionTypeRedirect,
+		RedirectConfig: redirectConfig,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildForwardAction(ctx context.Context, route *gwv1beta1.HTTPRoute, backendRefs []gwv1beta1.HTTPBackendRef) (elbv2model.Action, error) {
+	var targetGroupTuples []elbv2model.TargetGroupTuple
+	for _, backendRef := range backendRefs {
+		weight := int64(1)
+		if backendRef.Weight != nil {
+			weight = int64(*backendRef.Weight)
+		}
+		if weight == 0 {
+			continue
+		}
+		tg, err := t.buildTargetGroupForBackendRef(ctx, route, backendRef.BackendRef)
+		if err != nil {
+			return elbv2model.Action{}, err
+		}
+		targetGroupTuples = append(targetGroupTuples, elbv2model.TargetGroupTuple{
+			TargetGroupARN: tg.TargetGroupARN(),
+			Weight:         awssdk.Int64(weight),
+		})
+	}
+	// per Gateway API, requests to a rule without any valid backends should receive a 500 response.
+	if len(targetGroupTuples) == 0 {
+		return t.build500Action(ctx), nil
+	}
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeForward,
+		ForwardConfig: &elbv2model.ForwardActionConfig{
+			TargetGroups: targetGroupTuples,
+		},
+	}, nil
+}
+
+func (t *defaultModelBuildTask) build404Action(_ context.Context) elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "404",
+		},
+	}
+}
+
+func (t *defaultModelBuildTask) build500Action(_ context.Context) elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "500",
+		},
+	}
+}
@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_defaultModelBuildTask_buildPathPatterns(t *testing.T) {
+	pathTypeExact := gwv1beta1.PathMatchExact
+	pathTypePrefix := gwv1beta1.PathMatchPathPrefix
+	pathTypeRegex := gwv1beta1.PathMatchRegularExpression
+	tests := []struct {
+		name      string
+		pathMatch *gwv1beta1.HTTPPathMatch
+		want      []string
+		wantErr   error
+	}{
+		{
+			name:      "nil path match defaults to all paths",
+			pathMatch: nil,
+			want:      []string{"/*"},
+		},
+		{
+			name:      "exact path",
+			pathMatch: &gwv1beta1.HTTPPathMatch{Type: &pathTypeExact, Value: awssdk.String("/foo")},
+			want:      []string{"/foo"},
+		},
+		{
+			name:      "prefix path",
+			pathMatch: &gwv1beta1.HTTPPathMatch{Type: &pathTypePrefix, Value: awssdk.String("/foo/")},
+			want:      []string{"/foo", "/foo/*"},
+		},
+		{
+			name:      "prefix path with wildcards",
+			pathMatch: &gwv1beta1.HTTPPathMatch{Type: &pathTypePrefix, Value: awssdk.String("/foo*")},
+			wantErr:   errors.New("prefix path shouldn't contain wildcards: /foo*"),
+		},
+		{
+			name:      "regular expression path",
+			pathMatch: &gwv1beta1.HTTPPathMatch{Type: &pathTypeRegex, Value: awssdk.String("/foo.*")},
+			wantErr:   errors.New("unsupported path match type: RegularExpression"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{}
+			got, err := task.buildPathPatterns(tt.pathMatch)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildRuleConditions(t *testing.T) {
+	method := gwv1beta1.HTTPMethodGet
+	headerTypeRegex := gwv1beta1.HeaderMatchRegularExpression
+	tests := []struct {
+		name      string
+		hostnames []string
+		match     gwv1beta1.HTTPRouteMatch
+		want      []elbv2model.RuleCondition
+		wantErr   error
+	}{
+		{
+			name:      "hostnames, headers, query params and method",
+			hostnames: []string{"www.example.com"},
+			match: gwv1beta1.HTTPRouteMatch{
+				Headers: []gwv1beta1.HTTPHeaderMatch{
+					{Name: "version", Value: "v1"},
+				},
+				QueryParams: []gwv1beta1.HTTPQueryParamMatch{
+					{Name: "a", Value: "1"},
+					{Name: "b", Value: "2"},
+				},
+				Method: &method,
+			},
+			want: []elbv2model.RuleCondition{
+				{
+					Field: elbv2model.RuleConditionFieldHostHeader,
+					HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{
+						Values: []string{"www.example.com"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldPathPattern,
+					PathPatternConfig: &elbv2model.PathPatternConditionConfig{
+						Values: []string{"/*"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHTTPHeader,
+					HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+						HTTPHeaderName: "version",
+						Values:         []string{"v1"},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldQueryString,
+					QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+						Values: []elbv2model.QueryStringKeyValuePair{{Key: awssdk.String("a"), Value: "1"}},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldQueryString,
+					QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+						Values: []elbv2model.QueryStringKeyValuePair{{Key: awssdk.String("b"), Value: "2"}},
+					},
+				},
+				{
+					Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+					HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+						Values: []string{"GET"},
+					},
+				},
+			},
+		},
+		{
+			name: "regular expression header match",
+			match: gwv1beta1.HTTPRouteMatch{
+				Headers: []gwv1beta1.HTTPHeaderMatch{
+					{Type: &headerTypeRegex, Name: "version", Value: "v.*"},
+				},
+			},
+			wantErr: errors.New("unsupported header match type: RegularExpression"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{}
+			got, err := task.buildRuleConditions(context.Background(), tt.hostnames, tt.match)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_sortRouteMatches(t *testing.T) {
+	pathTypeExact := gwv1beta1.PathMatchExact
+	pathTypePrefix := gwv1beta1.PathMatchPathPrefix
+	method := gwv1beta1.HTTPMethodPost
+	matches := []routeMatch{
+		{
+			matchIndex: 0,
+			match:      gwv1beta1.HTTPRouteMatch{},
+		},
+		{
+			matchIndex: 1,
+			match: gwv1beta1.HTTPRouteMatch{
+				Path: &gwv1beta1.HTTPPathMatch{Type: &pathTypePrefix, Value: awssdk.String("/foo")},
+			},
+		},
+		{
+			matchIndex: 2,
+			match: gwv1beta1.HTTPRouteMatch{
+				Path: &gwv1beta1.HTTPPathMatch{Type: &pathTypeExact, Value: awssdk.String("/foo")},
+			},
+		},
+		{
+			matchIndex: 3,
+			match: gwv1beta1.HTTPRouteMatch{
+				Path:   &gwv1beta1.HTTPPathMatch{Type: &pathTypePrefix, Value: awssdk.String("/foo")},
+				Method: &method,
+			},
+		},
+		{
+			matchIndex: 4,
+			hostnames:  []string{"*.example.com"},
+		},
+		{
+			matchIndex: 5,
+			hostnames:  []string{"www.example.com"},
+		},
+		{
+			matchIndex: 6,
+			match: gwv1beta1.HTTPRouteMatch{
+				Path: &gwv1beta1.HTTPPathMatch{Type: &pathTypePrefix, Value: awssdk.String("/foo/bar")},
+			},
+		},
+	}
+	sortRouteMatches(matches)
+	var got []int
+	for _, match := range matches {
+		got = append(got, match.matchIndex)
+	}
+	assert.Equal(t, []int{5, 4, 2, 6, 3, 1, 0}, got)
+}
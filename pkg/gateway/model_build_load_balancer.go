@@ -0,0 +1,222 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceIDLoadBalancer         = "LoadBalancer"
+	minimalAvailableIPAddressCount = int64(8)
+)
+
+func (t *defaultModelBuildTask) buildLoadBalancer(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (*elbv2model.LoadBalancer, error) {
+	lbSpec, err := t.buildLoadBalancerSpec(ctx, listenPortConfigByPort)
+	if err != nil {
+		return nil, err
+	}
+	lb := elbv2model.NewLoadBalancer(t.stack, resourceIDLoadBalancer, lbSpec)
+	t.loadBalancer = lb
+	return lb, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (elbv2model.LoadBalancerSpec, error) {
+	scheme, err := t.buildLoadBalancerScheme(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	ipAddressType, err := t.buildLoadBalancerIPAddressType(ctx, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	subnetMappings, err := t.buildLoadBalancerSubnetMappings(ctx, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	securityGroups, err := t.buildLoadBalancerSecurityGroups(ctx, listenPortConfigByPort, ipAddressType, tags)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	loadBalancerAttributes, err := t.buildLoadBalancerAttributes(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	name, err := t.buildLoadBalancerName(ctx, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	return elbv2model.LoadBalancerSpec{
+		Name:                   name,
+		Type:                   t.loadBalancerType,
+		Scheme:                 &scheme,
+		IPAddressType:          &ipAddressType,
+		SubnetMappings:         subnetMappings,
+		SecurityGroups:         securityGroups,
+		LoadBalancerAttributes: loadBalancerAttributes,
+		Tags:                   tags,
+	}, nil
+}
+
+var invalidLoadBalancerNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme elbv2model.LoadBalancerScheme) (string, error) {
+	rawName := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixLoadBalancerName, &rawName, t.gw.Annotations); exists {
+		// The name of the loadbalancer can only have up to 32 characters
+		if len(rawName) > 32 {
+			return "", errors.New("load balancer name cannot be longer than 32 characters")
+		}
+		return rawName, nil
+	}
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	_, _ = uuidHash.Write([]byte(scheme))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.gw.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid), nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerScheme(_ context.Context) (elbv2model.LoadBalancerScheme, error) {
+	rawScheme := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixScheme, &rawScheme, t.gw.Annotations); !exists {
+		return t.defaultScheme, nil
+	}
+	switch rawScheme {
+	case string(elbv2model.LoadBalancerSchemeInternetFacing):
+		return elbv2model.LoadBalancerSchemeInternetFacing, nil
+	case string(elbv2model.LoadBalancerSchemeInternal):
+		return elbv2model.LoadBalancerSchemeInternal, nil
+	default:
+		return "", errors.Errorf("unknown scheme: %v", rawScheme)
+	}
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context, scheme elbv2model.LoadBalancerScheme) (elbv2model.IPAddressType, error) {
+	rawIPAddressType := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixIPAddressType, &rawIPAddressType, t.gw.Annotations); !exists {
+		return t.defaultIPAddressType, nil
+	}
+	switch rawIPAddressType {
+	case string(elbv2model.IPAddressTypeIPV4):
+		return elbv2model.IPAddressTypeIPV4, nil
+	case string(elbv2model.IPAddressTypeDualStack):
+		return elbv2model.IPAddressTypeDualStack, nil
+	case string(elbv2model.IPAddressTypeDualStackWithoutPublicIPV4):
+		if t.loadBalancerType != elbv2model.LoadBalancerTypeApplication || scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+			return "", errors.Errorf("IPAddressType %v is only supported for internet-facing application load balancers", rawIPAddressType)
+		}
+		return elbv2model.IPAddressTypeDualStackWithoutPublicIPV4, nil
+	default:
+		return "", errors.Errorf("unknown IPAddressType: %v", rawIPAddressType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]elbv2model.SubnetMapping, error) {
+	var rawSubnetNameOrIDs []string
+	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.GatewaySuffixSubnets, &rawSubnetNameOrIDs, t.gw.Annotations); exists {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
+			networking.WithSubnetsResolveLBType(t.loadBalancerType),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithALBSingleSubnet(t.featureGates.Enabled(config.ALBSingleSubnet)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
+	}
+
+	stackTags := t.trackingProvider.StackTags(t.stack)
+	sdkLBs, err := t.elbv2TaggingManager.ListLoadBalancers(ctx, tracking.TagsAsTagFilter(stackTags))
+	if err != nil {
+		return nil, err
+	}
+	if len(sdkLBs) == 0 || (string(scheme) != string(sdkLBs[0].LoadBalancer.Scheme)) {
+		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx,
+			networking.WithSubnetsResolveLBType(t.loadBalancerType),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
+			networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't auto-discover subnets")
+		}
+		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
+	}
+
+	availabilityZones := sdkLBs[0].LoadBalancer.AvailabilityZones
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(availabilityZones))
+	for _, availabilityZone := range availabilityZones {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.ToString(availabilityZone.SubnetId),
+		})
+	}
+	return subnetMappings, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType, additionalTags map[string]string) ([]core.StringToken, error) {
+	managedSG, err := t.buildManagedSecurityGroup(ctx, listenPortConfigByPort, ipAddressType)
+	if err != nil {
+		return nil, err
+	}
+	lbSGTokens := []core.StringToken{managedSG.GroupID()}
+	if !t.enableBackendSG {
+		t.backendSGIDToken = managedSG.GroupID()
+	} else {
+		backendSGID, err := t.backendSGProvider.Get(ctx, networking.ResourceTypeGateway, []types.NamespacedName{k8s.NamespacedName(t.gw)}, additionalTags)
+		if err != nil {
+			return nil, err
+		}
+		t.backendSGIDToken = core.LiteralStringToken(backendSGID)
+		t.backendSGAllocated = true
+		lbSGTokens = append(lbSGTokens, t.backendSGIDToken)
+	}
+	t.logger.Info("Auto Create SG", "LB SGs", lbSGTokens, "backend SG", t.backendSGIDToken)
+	return lbSGTokens, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.GatewaySuffixLoadBalancerAttributes, &rawAttributes, t.gw.Annotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.LoadBalancerAttribute, 0, len(rawAttributes))
+	for attrKey, attrValue := range rawAttributes {
+		attributes = append(attributes, elbv2model.LoadBalancerAttribute{
+			Key:   attrKey,
+			Value: attrValue,
+		})
+	}
+	return attributes, nil
+}
+
+func buildLoadBalancerSubnetMappingsWithSubnets(subnets []ec2types.Subnet) []elbv2model.SubnetMapping {
+	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+			SubnetID: awssdk.ToString(subnet.SubnetId),
+		})
+	}
+	return subnetMappings
+}
@@ -0,0 +1,100 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	resourceIDManagedSecurityGroup = "ManagedLBSecurityGroup"
+)
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroup(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) (*ec2model.SecurityGroup, error) {
+	sgSpec, err := t.buildManagedSecurityGroupSpec(ctx, listenPortConfigByPort, ipAddressType)
+	if err != nil {
+		return nil, err
+	}
+
+	sg := ec2model.NewSecurityGroup(t.stack, resourceIDManagedSecurityGroup, sgSpec)
+	return sg, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupSpec(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) (ec2model.SecurityGroupSpec, error) {
+	name := t.buildManagedSecurityGroupName(ctx)
+	tags, err := t.buildGatewayResourceTags(ctx)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	ingressPermissions := t.buildManagedSecurityGroupIngressPermissions(ctx, listenPortConfigByPort, ipAddressType)
+	return ec2model.SecurityGroupSpec{
+		GroupName:   name,
+		Description: "[k8s] Managed SecurityGroup for LoadBalancer",
+		Tags:        tags,
+		Ingress:     ingressPermissions,
+	}, nil
+}
+
+var invalidSecurityGroupNamePtn = regexp.MustCompile("[[:^alnum:]]")
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidSecurityGroupNamePtn.ReplaceAllString(t.gw.Namespace, "")
+	sanitizedName := invalidSecurityGroupNamePtn.ReplaceAllString(t.gw.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(_ context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) []ec2model.IPPermission {
+	// ports are sorted, so that the permissions are built in the same order across reconciles.
+	ports := make([]int64, 0, len(listenPortConfigByPort))
+	for port := range listenPortConfigByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	var permissions []ec2model.IPPermission
+	for _, port := range ports {
+		cfg := listenPortConfigByPort[port]
+		ipProtocol := "tcp"
+		if cfg.protocol == elbv2model.ProtocolUDP {
+			ipProtocol = "udp"
+		}
+		for _, cidr := range cfg.inboundCIDRv4s {
+			permissions = append(permissions, ec2model.IPPermission{
+				IPProtocol: ipProtocol,
+				FromPort:   awssdk.Int64(port),
+				ToPort:     awssdk.Int64(port),
+				IPRanges: []ec2model.IPRange{
+					{
+						CIDRIP: cidr,
+					},
+				},
+			})
+		}
+		if ipAddressType.IsDualStack() {
+			for _, cidr := range cfg.inboundCIDRv6s {
+				permissions = append(permissions, ec2model.IPPermission{
+					IPProtocol: ipProtocol,
+					FromPort:   awssdk.Int64(port),
+					ToPort:     awssdk.Int64(port),
+					IPv6Range: []ec2model.IPv6Range{
+						{
+							CIDRIPv6: cidr,
+						},
+					},
+				})
+			}
+		}
+	}
+	return permissions
+}
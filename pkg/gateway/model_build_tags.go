@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+// buildGatewayResourceTags builds the AWS Tags used for a Gateway. e.g. LoadBalancer, SecurityGroup, Listener, ListenerRule
+func (t *defaultModelBuildTask) buildGatewayResourceTags(_ context.Context) (map[string]string, error) {
+	var annotationTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.GatewaySuffixTags, &annotationTags, t.gw.Annotations); err != nil {
+		return nil, err
+	}
+	if err := t.validateTagCollisionWithExternalManagedTags(annotationTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for Gateway %v", k8s.NamespacedName(t.gw).String())
+	}
+	return algorithm.MergeStringMap(t.defaultTags, annotationTags), nil
+}
+
+// buildGatewayBackendResourceTags builds the AWS Tags used for a Gateway and Backend. e.g. TargetGroup.
+// the Tags annotation of Service takes higher priority if there is conflict between the tags of Gateway and Service
+func (t *defaultModelBuildTask) buildGatewayBackendResourceTags(_ context.Context, svc *corev1.Service) (map[string]string, error) {
+	var backendAnnotationTags map[string]string
+	var gatewayAnnotationTags map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.GatewaySuffixTags, &backendAnnotationTags, svc.Annotations); err != nil {
+		return nil, err
+	}
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.GatewaySuffixTags, &gatewayAnnotationTags, t.gw.Annotations); err != nil {
+		return nil, err
+	}
+	mergedAnnotationTags := algorithm.MergeStringMap(backendAnnotationTags, gatewayAnnotationTags)
+	if err := t.validateTagCollisionWithExternalManagedTags(mergedAnnotationTags); err != nil {
+		return nil, errors.Wrapf(err, "failed build tags for Gateway %v and Service %v",
+			k8s.NamespacedName(t.gw).String(), k8s.NamespacedName(svc).String())
+	}
+	return algorithm.MergeStringMap(t.defaultTags, mergedAnnotationTags), nil
+}
+
+func (t *defaultModelBuildTask) validateTagCollisionWithExternalManagedTags(tags map[string]string) error {
+	for tagKey := range tags {
+		if t.externalManagedTags.Has(tagKey) {
+			return errors.Errorf("external managed tag key %v cannot be specified", tagKey)
+		}
+	}
+	return nil
+}
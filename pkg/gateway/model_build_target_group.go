@@ -0,0 +1,376 @@
+package gateway
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	healthCheckPortTrafficPort = "traffic-port"
+)
+
+func (t *defaultModelBuildTask) buildTargetGroupForBackendRef(ctx context.Context, route *gwv1beta1.HTTPRoute, backendRef gwv1beta1.BackendRef) (*elbv2model.TargetGroup, error) {
+	svc, port, err := t.loadServiceForBackendRef(ctx, kindHTTPRoute, route, backendRef)
+	if err != nil {
+		return nil, err
+	}
+	return t.buildTargetGroup(ctx, k8s.NamespacedName(route), svc, port)
+}
+
+// loadServiceForBackendRef loads the Service referenced by backendRef of route, along with the Service port.
+func (t *defaultModelBuildTask) loadServiceForBackendRef(ctx context.Context, routeKind string, route metav1.Object, backendRef gwv1beta1.BackendRef) (*corev1.Service, intstr.IntOrString, error) {
+	if backendRef.Group != nil && len(*backendRef.Group) != 0 {
+		return nil, intstr.IntOrString{}, errors.Errorf("unsupported backendRef group: %v", *backendRef.Group)
+	}
+	if backendRef.Kind != nil && string(*backendRef.Kind) != kindService {
+		return nil, intstr.IntOrString{}, errors.Errorf("unsupported backendRef kind: %v", *backendRef.Kind)
+	}
+	if backendRef.Port == nil {
+		return nil, intstr.IntOrString{}, errors.Errorf("port is required for backendRef: %v", backendRef.Name)
+	}
+	svcKey := types.NamespacedName{Namespace: route.GetNamespace(), Name: string(backendRef.Name)}
+	if backendRef.Namespace != nil {
+		svcKey.Namespace = string(*backendRef.Namespace)
+	}
+	if svcKey.Namespace != route.GetNamespace() {
+		granted, err := t.isBackendReferenceGranted(ctx, routeKind, route.GetNamespace(), svcKey)
+		if err != nil {
+			return nil, intstr.IntOrString{}, err
+		}
+		if !granted {
+			return nil, intstr.IntOrString{}, errors.Errorf("backendRef to %v is not permitted by any ReferenceGrant", svcKey.String())
+		}
+	}
+	svc, err := t.loadBackendService(ctx, svcKey)
+	if err != nil {
+		return nil, intstr.IntOrString{}, err
+	}
+	return svc, intstr.FromInt(int(*backendRef.Port)), nil
+}
+
+// isBackendReferenceGranted checks whether there is ReferenceGrant in the namespace of the Service that allows route to reference it.
+func (t *defaultModelBuildTask) isBackendReferenceGranted(ctx context.Context, routeKind string, routeNamespace string, svcKey types.NamespacedName) (bool, error) {
+	refGrantList := &gwv1beta1.ReferenceGrantList{}
+	if err := t.k8sClient.List(ctx, refGrantList, client.InNamespace(svcKey.Namespace)); err != nil {
+		return false, errors.Wrapf(err, "failed to list ReferenceGrants in namespace %v", svcKey.Namespace)
+	}
+	for _, refGrant := range refGrantList.Items {
+		fromAllowed := false
+		for _, from := range refGrant.Spec.From {
+			if string(from.Group) == gwv1beta1.GroupName && string(from.Kind) == routeKind && string(from.Namespace) == routeNamespace {
+				fromAllowed = true
+				break
+			}
+		}
+		if !fromAllowed {
+			continue
+		}
+		for _, to := range refGrant.Spec.To {
+			if len(to.Group) == 0 && string(to.Kind) == kindService && (to.Name == nil || string(*to.Name) == svcKey.Name) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (t *defaultModelBuildTask) loadBackendService(ctx context.Context, svcKey types.NamespacedName) (*corev1.Service, error) {
+	if svc, exists := t.backendServices[svcKey]; exists {
+		return svc, nil
+	}
+	svc := &corev1.Service{}
+	if err := t.k8sClient.Get(ctx, svcKey, svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, errors.Errorf("service not found: %v", svcKey.String())
+		}
+		return nil, err
+	}
+	t.backendServices[svcKey] = svc
+	return svc, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context, routeKey types.NamespacedName, svc *corev1.Service, port intstr.IntOrString) (*elbv2model.TargetGroup, error) {
+	tgResID := t.buildTargetGroupResourceID(routeKey, k8s.NamespacedName(svc), port)
+	if tg, exists := t.tgByResID[tgResID]; exists {
+		return tg, nil
+	}
+	svcPort, err := k8s.LookupServicePort(svc, port)
+	if err != nil {
+		return nil, err
+	}
+	tgSpec, err := t.buildTargetGroupSpec(ctx, routeKey, svc, port, svcPort)
+	if err != nil {
+		return nil, err
+	}
+	tg := elbv2model.NewTargetGroup(t.stack, tgResID, tgSpec)
+	t.tgByResID[tgResID] = tg
+	_ = t.buildTargetGroupBinding(ctx, tg, svc, port, svcPort)
+	return tg, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) *elbv2model.TargetGroupBindingResource {
+	tgbSpec := t.buildTargetGroupBindingSpec(ctx, tg, svc, port, svcPort)
+	tgb := elbv2model.NewTargetGroupBindingResource(t.stack, tg.ID(), tgbSpec)
+	return tgb
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) elbv2model.TargetGroupBindingResourceSpec {
+	targetType := elbv2api.TargetType(tg.Spec.TargetType)
+	targetPort := svcPort.TargetPort
+	if targetType == elbv2api.TargetTypeInstance {
+		targetPort = intstr.FromInt(int(svcPort.NodePort))
+	}
+	tgbNetworking := t.buildTargetGroupBindingNetworking(ctx, targetPort, tg.Spec.Protocol)
+	return elbv2model.TargetGroupBindingResourceSpec{
+		Template: elbv2model.TargetGroupBindingTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: svc.Namespace,
+				Name:      tg.Spec.Name,
+			},
+			Spec: elbv2model.TargetGroupBindingSpec{
+				TargetGroupARN: tg.TargetGroupARN(),
+				TargetType:     &targetType,
+				ServiceRef: elbv2api.ServiceReference{
+					Name: svc.Name,
+					Port: port,
+				},
+				Networking:    tgbNetworking,
+				IPAddressType: (*elbv2api.TargetGroupIPAddressType)(tg.Spec.IPAddressType),
+			},
+		},
+	}
+}
+
+// buildTargetGroupBindingNetworking builds the networking rules that allows traffic from the LoadBalancer to targets.
+// Note: health checks are always sent to the traffic port over TCP, so UDP targets need TCP access as well.
+func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Context, targetPort intstr.IntOrString, tgProtocol elbv2model.Protocol) *elbv2model.TargetGroupBindingNetworking {
+	if t.backendSGIDToken == nil {
+		return nil
+	}
+	networkingProtocols := []elbv2api.NetworkingProtocol{elbv2api.NetworkingProtocolTCP}
+	if tgProtocol == elbv2model.ProtocolUDP {
+		networkingProtocols = append(networkingProtocols, elbv2api.NetworkingProtocolUDP)
+	}
+	networkingPorts := make([]elbv2api.NetworkingPort, 0, len(networkingProtocols))
+	for _, protocol := range networkingProtocols {
+		networkingProtocol := protocol
+		networkingPort := elbv2api.NetworkingPort{
+			Protocol: &networkingProtocol,
+			Port:     &targetPort,
+		}
+		if t.disableRestrictedSGRules {
+			networkingPort.Port = nil
+		}
+		networkingPorts = append(networkingPorts, networkingPort)
+	}
+	return &elbv2model.TargetGroupBindingNetworking{
+		Ingress: []elbv2model.NetworkingIngressRule{
+			{
+				From: []elbv2model.NetworkingPeer{
+					{
+						SecurityGroup: &elbv2model.SecurityGroup{
+							GroupID: t.backendSGIDToken,
+						},
+					},
+				},
+				Ports: networkingPorts,
+			},
+		},
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context, routeKey types.NamespacedName, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) (elbv2model.TargetGroupSpec, error) {
+	targetType, err := t.buildTargetGroupTargetType(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgProtocol, err := t.buildTargetGroupProtocol(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgProtocolVersion, err := t.buildTargetGroupProtocolVersion(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	healthCheckConfig := t.buildTargetGroupHealthCheckConfig(ctx, svc, tgProtocol, tgProtocolVersion)
+	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tags, err := t.buildGatewayBackendResourceTags(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, svc)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	tgPort := t.buildTargetGroupPort(ctx, targetType, svcPort)
+	name := t.buildTargetGroupName(ctx, routeKey, svc, port, tgPort, targetType, tgProtocol, tgProtocolVersion)
+	return elbv2model.TargetGroupSpec{
+		Name:                  name,
+		TargetType:            targetType,
+		Port:                  tgPort,
+		Protocol:              tgProtocol,
+		ProtocolVersion:       &tgProtocolVersion,
+		IPAddressType:         &ipAddressType,
+		HealthCheckConfig:     &healthCheckConfig,
+		TargetGroupAttributes: tgAttributes,
+		Tags:                  tags,
+	}, nil
+}
+
+var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
+
+// buildTargetGroupName will calculate the targetGroup's name.
+func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context,
+	routeKey types.NamespacedName, svc *corev1.Service, port intstr.IntOrString, tgPort int64,
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.stack.StackID().String()))
+	_, _ = uuidHash.Write([]byte(routeKey.Namespace))
+	_, _ = uuidHash.Write([]byte(routeKey.Name))
+	_, _ = uuidHash.Write([]byte(svc.UID))
+	_, _ = uuidHash.Write([]byte(port.String()))
+	_, _ = uuidHash.Write([]byte(strconv.Itoa(int(tgPort))))
+	_, _ = uuidHash.Write([]byte(targetType))
+	_, _ = uuidHash.Write([]byte(tgProtocol))
+	_, _ = uuidHash.Write([]byte(tgProtocolVersion))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(svc.Namespace, "")
+	sanitizedName := invalidTargetGroupNamePattern.ReplaceAllString(svc.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, svc *corev1.Service) (elbv2model.TargetType, error) {
+	rawTargetType := string(t.defaultTargetType)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixTargetType, &rawTargetType, svc.Annotations)
+	switch rawTargetType {
+	case string(elbv2model.TargetTypeInstance):
+		return elbv2model.TargetTypeInstance, nil
+	case string(elbv2model.TargetTypeIP):
+		if !t.enableIPTargetType {
+			return "", errors.Errorf("unsupported targetType: %v when EnableIPTargetType is %v", rawTargetType, t.enableIPTargetType)
+		}
+		return elbv2model.TargetTypeIP, nil
+	default:
+		return "", errors.Errorf("unknown targetType: %v", rawTargetType)
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context, svc *corev1.Service) (elbv2model.TargetGroupIPAddressType, error) {
+	for _, ipFamily := range svc.Spec.IPFamilies {
+		if ipFamily == corev1.IPv6Protocol {
+			if !t.loadBalancer.Spec.IPAddressType.IsDualStack() {
+				return "", errors.New("unsupported IPv6 configuration, lb not dual-stack")
+			}
+			return elbv2model.TargetGroupIPAddressTypeIPv6, nil
+		}
+	}
+	return elbv2model.TargetGroupIPAddressTypeIPv4, nil
+}
+
+// buildTargetGroupPort constructs the TargetGroup's port.
+// Note: TargetGroup's port is not in the data path as we always register targets with port specified.
+func (t *defaultModelBuildTask) buildTargetGroupPort(_ context.Context, targetType elbv2model.TargetType, svcPort corev1.ServicePort) int64 {
+	if targetType == elbv2model.TargetTypeInstance {
+		return int64(svcPort.NodePort)
+	}
+	if svcPort.TargetPort.Type == intstr.Int {
+		return int64(svcPort.TargetPort.IntValue())
+	}
+	return 1
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupProtocol(_ context.Context, svc *corev1.Service) (elbv2model.Protocol, error) {
+	rawBackendProtocol := string(t.defaultBackendProtocol)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixBackendProtocol, &rawBackendProtocol, svc.Annotations)
+	switch rawBackendProtocol {
+	case string(elbv2model.ProtocolHTTP):
+		return elbv2model.ProtocolHTTP, nil
+	case string(elbv2model.ProtocolHTTPS):
+		return elbv2model.ProtocolHTTPS, nil
+	default:
+		return "", errors.Errorf("backend protocol must be within [%v, %v]: %v", elbv2model.ProtocolHTTP, elbv2model.ProtocolHTTPS, rawBackendProtocol)
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupProtocolVersion(_ context.Context, svc *corev1.Service) (elbv2model.ProtocolVersion, error) {
+	rawBackendProtocolVersion := string(t.defaultBackendProtocolVersion)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixBackendProtocolVersion, &rawBackendProtocolVersion, svc.Annotations)
+	switch rawBackendProtocolVersion {
+	case string(elbv2model.ProtocolVersionHTTP1):
+		return elbv2model.ProtocolVersionHTTP1, nil
+	case string(elbv2model.ProtocolVersionHTTP2):
+		return elbv2model.ProtocolVersionHTTP2, nil
+	case string(elbv2model.ProtocolVersionGRPC):
+		return elbv2model.ProtocolVersionGRPC, nil
+	default:
+		return "", errors.Errorf("backend protocol version must be within [%v, %v, %v]: %v", elbv2model.ProtocolVersionHTTP1, elbv2model.ProtocolVersionHTTP2, elbv2model.ProtocolVersionGRPC, rawBackendProtocolVersion)
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupHealthCheckConfig(_ context.Context, svc *corev1.Service, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) elbv2model.TargetGroupHealthCheckConfig {
+	healthCheckPort := intstr.FromString(healthCheckPortTrafficPort)
+	healthCheckProtocol := tgProtocol
+	healthCheckPath := t.defaultHealthCheckPathHTTP
+	healthCheckMatcherCode := t.defaultHealthCheckMatcherHTTPCode
+	if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
+		healthCheckPath = t.defaultHealthCheckPathGRPC
+		healthCheckMatcherCode = t.defaultHealthCheckMatcherGRPCCode
+	}
+	_ = t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixHealthCheckPath, &healthCheckPath, svc.Annotations)
+	_ = t.annotationParser.ParseStringAnnotation(annotations.GatewaySuffixHealthCheckSuccessCodes, &healthCheckMatcherCode, svc.Annotations)
+	healthCheckMatcher := elbv2model.HealthCheckMatcher{HTTPCode: &healthCheckMatcherCode}
+	if tgProtocolVersion == elbv2model.ProtocolVersionGRPC {
+		healthCheckMatcher = elbv2model.HealthCheckMatcher{GRPCCode: &healthCheckMatcherCode}
+	}
+	return elbv2model.TargetGroupHealthCheckConfig{
+		Port:                    &healthCheckPort,
+		Protocol:                &healthCheckProtocol,
+		Path:                    &healthCheckPath,
+		Matcher:                 &healthCheckMatcher,
+		IntervalSeconds:         &t.defaultHealthCheckIntervalSeconds,
+		TimeoutSeconds:          &t.defaultHealthCheckTimeoutSeconds,
+		HealthyThresholdCount:   &t.defaultHealthCheckHealthyThresholdCount,
+		UnhealthyThresholdCount: &t.defaultHealthCheckUnhealthyThresholdCount,
+	}
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svc *corev1.Service) ([]elbv2model.TargetGroupAttribute, error) {
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.GatewaySuffixTargetGroupAttributes, &rawAttributes, svc.Annotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
+	for attrKey, attrValue := range rawAttributes {
+		attributes = append(attributes, elbv2model.TargetGroupAttribute{
+			Key:   attrKey,
+			Value: attrValue,
+		})
+	}
+	return attributes, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupResourceID(routeKey types.NamespacedName, svcKey types.NamespacedName, port intstr.IntOrString) string {
+	return fmt.Sprintf("%s/%s-%s/%s:%s", routeKey.Namespace, routeKey.Name, svcKey.Namespace, svcKey.Name, port.String())
+}
@@ -0,0 +1,230 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// ModelBuilder is responsible for build mode stack for a Gateway.
+type ModelBuilder interface {
+	// build mode stack for a Gateway and its attached routes, with specified type of LoadBalancer.
+	Build(ctx context.Context, gw *gwv1beta1.Gateway, lbType elbv2model.LoadBalancerType, routes ListenerRoutes) (core.Stack, *elbv2model.LoadBalancer, bool, error)
+}
+
+// NewDefaultModelBuilder constructs new defaultModelBuilder.
+func NewDefaultModelBuilder(k8sClient client.Client, annotationParser annotations.Parser, subnetsResolver networkingpkg.SubnetsResolver,
+	certDiscovery ingress.CertDiscovery, trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager, featureGates config.FeatureGates,
+	vpcID string, clusterName string, defaultTagsProvider networkingpkg.DefaultTagsProvider, externalManagedTags []string, dynamicConfigProvider config.DynamicConfigProvider,
+	backendSGProvider networkingpkg.BackendSGProvider, enableBackendSG bool, disableRestrictedSGRules bool, enableIPTargetType bool, logger logr.Logger) *defaultModelBuilder {
+	return &defaultModelBuilder{
+		k8sClient:                k8sClient,
+		vpcID:                    vpcID,
+		clusterName:              clusterName,
+		annotationParser:         annotationParser,
+		subnetsResolver:          subnetsResolver,
+		backendSGProvider:        backendSGProvider,
+		certDiscovery:            certDiscovery,
+		trackingProvider:         trackingProvider,
+		elbv2TaggingManager:      elbv2TaggingManager,
+		featureGates:             featureGates,
+		defaultTagsProvider:      defaultTagsProvider,
+		externalManagedTags:      sets.NewString(externalManagedTags...),
+		dynamicConfigProvider:    dynamicConfigProvider,
+		enableBackendSG:          enableBackendSG,
+		disableRestrictedSGRules: disableRestrictedSGRules,
+		enableIPTargetType:       enableIPTargetType,
+		logger:                   logger,
+	}
+}
+
+var _ ModelBuilder = &defaultModelBuilder{}
+
+// default implementation for ModelBuilder
+type defaultModelBuilder struct {
+	k8sClient client.Client
+
+	vpcID       string
+	clusterName string
+
+	annotationParser         annotations.Parser
+	subnetsResolver          networkingpkg.SubnetsResolver
+	backendSGProvider        networkingpkg.BackendSGProvider
+	certDiscovery            ingress.CertDiscovery
+	trackingProvider         tracking.Provider
+	elbv2TaggingManager      elbv2deploy.TaggingManager
+	featureGates             config.FeatureGates
+	defaultTagsProvider      networkingpkg.DefaultTagsProvider
+	externalManagedTags      sets.String
+	dynamicConfigProvider    config.DynamicConfigProvider
+	enableBackendSG          bool
+	disableRestrictedSGRules bool
+	enableIPTargetType       bool
+
+	logger logr.Logger
+}
+
+// build mode stack for a Gateway and its attached routes.
+func (b *defaultModelBuilder) Build(ctx context.Context, gw *gwv1beta1.Gateway, lbType elbv2model.LoadBalancerType, routes ListenerRoutes) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
+	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(gw)))
+	// in IPv6-only clusters, the LoadBalancers must be dualstack to route traffic to the IPv6 targets.
+	defaultIPAddressType := elbv2model.IPAddressTypeIPV4
+	if b.featureGates.Enabled(config.IPv6Only) {
+		defaultIPAddressType = elbv2model.IPAddressTypeDualStack
+	}
+	task := &defaultModelBuildTask{
+		k8sClient:                b.k8sClient,
+		vpcID:                    b.vpcID,
+		clusterName:              b.clusterName,
+		annotationParser:         b.annotationParser,
+		subnetsResolver:          b.subnetsResolver,
+		backendSGProvider:        b.backendSGProvider,
+		certDiscovery:            b.certDiscovery,
+		trackingProvider:         b.trackingProvider,
+		elbv2TaggingManager:      b.elbv2TaggingManager,
+		featureGates:             b.featureGates,
+		logger:                   b.logger,
+		enableBackendSG:          b.enableBackendSG,
+		disableRestrictedSGRules: b.disableRestrictedSGRules,
+		enableIPTargetType:       b.enableIPTargetType,
+
+		gw:               gw,
+		loadBalancerType: lbType,
+		routes:           routes,
+		stack:            stack,
+
+		defaultTags:                               b.defaultTagsProvider.DefaultTags(),
+		externalManagedTags:                       b.externalManagedTags,
+		defaultIPAddressType:                      defaultIPAddressType,
+		defaultScheme:                             elbv2model.LoadBalancerSchemeInternal,
+		defaultSSLPolicy:                          b.dynamicConfigProvider.DefaultSSLPolicy(),
+		defaultTargetType:                         elbv2model.TargetType(b.dynamicConfigProvider.DefaultTargetType()),
+		defaultBackendProtocol:                    elbv2model.ProtocolHTTP,
+		defaultBackendProtocolVersion:             elbv2model.ProtocolVersionHTTP1,
+		defaultHealthCheckPathHTTP:                "/",
+		defaultHealthCheckPathGRPC:                "/AWS.ALB/healthcheck",
+		defaultHealthCheckIntervalSeconds:         15,
+		defaultHealthCheckTimeoutSeconds:          5,
+		defaultHealthCheckHealthyThresholdCount:   2,
+		defaultHealthCheckUnhealthyThresholdCount: 2,
+		defaultHealthCheckMatcherHTTPCode:         "200",
+		defaultHealthCheckMatcherGRPCCode:         "12",
+
+		defaultL4HealthCheckIntervalSeconds:         10,
+		defaultL4HealthCheckTimeoutSeconds:          10,
+		defaultL4HealthCheckHealthyThresholdCount:   3,
+		defaultL4HealthCheckUnhealthyThresholdCount: 3,
+
+		loadBalancer:    nil,
+		tgByResID:       make(map[string]*elbv2model.TargetGroup),
+		backendServices: make(map[types.NamespacedName]*corev1.Service),
+	}
+	if err := task.run(ctx); err != nil {
+		return nil, nil, false, err
+	}
+	return task.stack, task.loadBalancer, task.backendSGAllocated, nil
+}
+
+// the default model build task
+type defaultModelBuildTask struct {
+	k8sClient           client.Client
+	vpcID               string
+	clusterName         string
+	annotationParser    annotations.Parser
+	subnetsResolver     networkingpkg.SubnetsResolver
+	backendSGProvider   networkingpkg.BackendSGProvider
+	certDiscovery       ingress.CertDiscovery
+	trackingProvider    tracking.Provider
+	elbv2TaggingManager elbv2deploy.TaggingManager
+	featureGates        config.FeatureGates
+	logger              logr.Logger
+
+	gw                       *gwv1beta1.Gateway
+	loadBalancerType         elbv2model.LoadBalancerType
+	routes                   ListenerRoutes
+	stack                    core.Stack
+	backendSGIDToken         core.StringToken
+	backendSGAllocated       bool
+	enableBackendSG          bool
+	disableRestrictedSGRules bool
+	enableIPTargetType       bool
+
+	defaultTags                               map[string]string
+	externalManagedTags                       sets.String
+	defaultIPAddressType                      elbv2model.IPAddressType
+	defaultScheme                             elbv2model.LoadBalancerScheme
+	defaultSSLPolicy                          string
+	defaultTargetType                         elbv2model.TargetType
+	defaultBackendProtocol                    elbv2model.Protocol
+	defaultBackendProtocolVersion             elbv2model.ProtocolVersion
+	defaultHealthCheckPathHTTP                string
+	defaultHealthCheckPathGRPC                string
+	defaultHealthCheckTimeoutSeconds          int64
+	defaultHealthCheckIntervalSeconds         int64
+	defaultHealthCheckHealthyThresholdCount   int64
+	defaultHealthCheckUnhealthyThresholdCount int64
+	defaultHealthCheckMatcherHTTPCode         string
+	defaultHealthCheckMatcherGRPCCode         string
+
+	defaultL4HealthCheckIntervalSeconds         int64
+	defaultL4HealthCheckTimeoutSeconds          int64
+	defaultL4HealthCheckHealthyThresholdCount   int64
+	defaultL4HealthCheckUnhealthyThresholdCount int64
+
+	loadBalancer    *elbv2model.LoadBalancer
+	tgByResID       map[string]*elbv2model.TargetGroup
+	backendServices map[types.NamespacedName]*corev1.Service
+}
+
+func (t *defaultModelBuildTask) run(ctx context.Context) error {
+	if !t.gw.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if t.loadBalancerType == elbv2model.LoadBalancerTypeNetwork {
+		return t.runForNLB(ctx)
+	}
+
+	listenersByPort := make(map[int64][]gwv1beta1.Listener)
+	for _, listener := range t.gw.Spec.Listeners {
+		port := int64(listener.Port)
+		listenersByPort[port] = append(listenersByPort[port], listener)
+	}
+	listenPortConfigByPort := make(map[int64]listenPortConfig, len(listenersByPort))
+	for port, listeners := range listenersByPort {
+		cfg, err := t.computeListenPortConfig(ctx, port, listeners)
+		if err != nil {
+			return errors.Wrapf(err, "failed to compute listenPort config for port: %v", port)
+		}
+		listenPortConfigByPort[port] = cfg
+	}
+
+	lb, err := t.buildLoadBalancer(ctx, listenPortConfigByPort)
+	if err != nil {
+		return err
+	}
+	for port, cfg := range listenPortConfigByPort {
+		ls, err := t.buildListener(ctx, lb.LoadBalancerARN(), port, cfg, []elbv2model.Action{t.build404Action(ctx)})
+		if err != nil {
+			return err
+		}
+		if err := t.buildListenerRules(ctx, ls.ListenerARN(), port, listenersByPort[port]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,486 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// GatewayControllerNameALB is the controllerName of GatewayClasses whose Gateways are provisioned as ALBs by this controller.
+	GatewayControllerNameALB gwv1beta1.GatewayController = "gateway.k8s.aws/alb"
+	// GatewayControllerNameNLB is the controllerName of GatewayClasses whose Gateways are provisioned as NLBs by this controller.
+	GatewayControllerNameNLB gwv1beta1.GatewayController = "gateway.k8s.aws/nlb"
+
+	kindGateway   = "Gateway"
+	kindHTTPRoute = "HTTPRoute"
+	kindTCPRoute  = "TCPRoute"
+	kindUDPRoute  = "UDPRoute"
+	kindTLSRoute  = "TLSRoute"
+	kindService   = "Service"
+)
+
+// LoadBalancerTypeForGatewayController returns the type of LoadBalancer provisioned for GatewayClasses with specified controllerName.
+// it returns false if the controllerName isn't managed by this controller.
+func LoadBalancerTypeForGatewayController(controllerName gwv1beta1.GatewayController) (elbv2model.LoadBalancerType, bool) {
+	switch controllerName {
+	case GatewayControllerNameALB:
+		return elbv2model.LoadBalancerTypeApplication, true
+	case GatewayControllerNameNLB:
+		return elbv2model.LoadBalancerTypeNetwork, true
+	default:
+		return "", false
+	}
+}
+
+// ListenerRoutes contains the routes attached to listeners of a Gateway.
+type ListenerRoutes struct {
+	// HTTPRoutes attached, indexed by listener name.
+	HTTPRoutes map[gwv1beta1.SectionName][]*gwv1beta1.HTTPRoute
+	// UnattachedHTTPRoutes references the Gateway, but isn't allowed by any of its listeners.
+	UnattachedHTTPRoutes []*gwv1beta1.HTTPRoute
+
+	// L4Routes attached, indexed by listener name. Each L4 listener supports a single route.
+	L4Routes map[gwv1beta1.SectionName]*L4Route
+	// UnattachedL4Routes references the Gateway, but isn't allowed by any of its listeners.
+	UnattachedL4Routes []*L4Route
+}
+
+// AttachedHTTPRoutes returns all HTTPRoutes attached to at least one listener, without duplicates.
+func (r ListenerRoutes) AttachedHTTPRoutes() []*gwv1beta1.HTTPRoute {
+	seen := make(map[types.NamespacedName]bool)
+	var routes []*gwv1beta1.HTTPRoute
+	for _, listenerRoutes := range r.HTTPRoutes {
+		for _, route := range listenerRoutes {
+			routeKey := k8s.NamespacedName(route)
+			if seen[routeKey] {
+				continue
+			}
+			seen[routeKey] = true
+			routes = append(routes, route)
+		}
+	}
+	sortHTTPRoutes(routes)
+	return routes
+}
+
+// AttachedL4Routes returns all L4 routes attached to at least one listener, without duplicates.
+func (r ListenerRoutes) AttachedL4Routes() []*L4Route {
+	seen := make(map[string]bool)
+	var routes []*L4Route
+	for _, route := range r.L4Routes {
+		if seen[route.String()] {
+			continue
+		}
+		seen[route.String()] = true
+		routes = append(routes, route)
+	}
+	sortL4Routes(routes)
+	return routes
+}
+
+// L4Route is a TCPRoute, UDPRoute or TLSRoute.
+type L4Route struct {
+	// Kind of the route.
+	Kind string
+	// Object is the route object.
+	Object client.Object
+	// ParentRefs of the route.
+	ParentRefs []gwv1beta1.ParentReference
+	// Hostnames of the route, only applicable to TLSRoute.
+	Hostnames []gwv1beta1.Hostname
+	// BackendRefs of all rules of the route.
+	BackendRefs []gwv1beta1.BackendRef
+	// Status points to the status of route object.
+	Status *gwv1beta1.RouteStatus
+}
+
+// String returns the kind and namespaced name of route.
+func (r *L4Route) String() string {
+	return fmt.Sprintf("%v/%v", r.Kind, k8s.NamespacedName(r.Object).String())
+}
+
+// NewL4Route constructs L4Route from a TCPRoute, UDPRoute or TLSRoute, it returns false for other objects.
+func NewL4Route(obj client.Object) (*L4Route, bool) {
+	switch route := obj.(type) {
+	case *gwv1alpha2.TCPRoute:
+		var backendRefs []gwv1beta1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+		return &L4Route{Kind: kindTCPRoute, Object: route, ParentRefs: route.Spec.ParentRefs, BackendRefs: backendRefs, Status: &route.Status.RouteStatus}, true
+	case *gwv1alpha2.UDPRoute:
+		var backendRefs []gwv1beta1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+		return &L4Route{Kind: kindUDPRoute, Object: route, ParentRefs: route.Spec.ParentRefs, BackendRefs: backendRefs, Status: &route.Status.RouteStatus}, true
+	case *gwv1alpha2.TLSRoute:
+		var backendRefs []gwv1beta1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+		return &L4Route{Kind: kindTLSRoute, Object: route, ParentRefs: route.Spec.ParentRefs, Hostnames: route.Spec.Hostnames, BackendRefs: backendRefs, Status: &route.Status.RouteStatus}, true
+	default:
+		return nil, false
+	}
+}
+
+// RouteLoader is responsible for load routes attached to a Gateway.
+type RouteLoader interface {
+	// Load returns the routes attached to each listener of Gateway.
+	Load(ctx context.Context, gw *gwv1beta1.Gateway) (ListenerRoutes, error)
+}
+
+// NewDefaultRouteLoader constructs new defaultRouteLoader.
+func NewDefaultRouteLoader(k8sClient client.Client) *defaultRouteLoader {
+	return &defaultRouteLoader{
+		k8sClient: k8sClient,
+	}
+}
+
+var _ RouteLoader = &defaultRouteLoader{}
+
+// default implementation for RouteLoader
+type defaultRouteLoader struct {
+	k8sClient client.Client
+}
+
+// Load only lists the route kinds supported by listeners of Gateway,
+// so that CRDs for L4 routes are only required when the Gateway have L4 listeners.
+func (l *defaultRouteLoader) Load(ctx context.Context, gw *gwv1beta1.Gateway) (ListenerRoutes, error) {
+	result := ListenerRoutes{
+		HTTPRoutes: make(map[gwv1beta1.SectionName][]*gwv1beta1.HTTPRoute),
+		L4Routes:   make(map[gwv1beta1.SectionName]*L4Route),
+	}
+	routeKinds := sets.NewString()
+	for _, listener := range gw.Spec.Listeners {
+		routeKinds.Insert(RouteKindForListener(listener))
+	}
+	namespaceCache := make(map[string]*corev1.Namespace)
+	if routeKinds.Has(kindHTTPRoute) {
+		if err := l.loadHTTPRoutes(ctx, gw, namespaceCache, &result); err != nil {
+			return ListenerRoutes{}, err
+		}
+	}
+	var l4Routes []*L4Route
+	for _, kind := range []string{kindTCPRoute, kindUDPRoute, kindTLSRoute} {
+		if !routeKinds.Has(kind) {
+			continue
+		}
+		routes, err := l.listL4Routes(ctx, kind)
+		if err != nil {
+			return ListenerRoutes{}, err
+		}
+		l4Routes = append(l4Routes, routes...)
+	}
+	sortL4Routes(l4Routes)
+	for _, route := range l4Routes {
+		parentRefs := ParentRefsForGateway(gw, route.Object.GetNamespace(), route.ParentRefs)
+		if len(parentRefs) == 0 {
+			continue
+		}
+		attached := false
+		for _, listener := range gw.Spec.Listeners {
+			if _, exists := result.L4Routes[listener.Name]; exists {
+				continue
+			}
+			allowed, err := l.isRouteAllowedByListener(ctx, gw, listener, route.Kind, route.Object.GetNamespace(), parentRefs, route.Hostnames, namespaceCache)
+			if err != nil {
+				return ListenerRoutes{}, err
+			}
+			if !allowed {
+				continue
+			}
+			result.L4Routes[listener.Name] = route
+			attached = true
+		}
+		if !attached {
+			result.UnattachedL4Routes = append(result.UnattachedL4Routes, route)
+		}
+	}
+	return result, nil
+}
+
+func (l *defaultRouteLoader) loadHTTPRoutes(ctx context.Context, gw *gwv1beta1.Gateway, namespaceCache map[string]*corev1.Namespace, result *ListenerRoutes) error {
+	routeList := &gwv1beta1.HTTPRouteList{}
+	if err := l.k8sClient.List(ctx, routeList); err != nil {
+		return errors.Wrap(err, "failed to list HTTPRoutes")
+	}
+	for i := range routeList.Items {
+		route := &routeList.Items[i]
+		if !route.DeletionTimestamp.IsZero() {
+			continue
+		}
+		parentRefs := ParentRefsForGateway(gw, route.Namespace, route.Spec.ParentRefs)
+		if len(parentRefs) == 0 {
+			continue
+		}
+		attached := false
+		for _, listener := range gw.Spec.Listeners {
+			allowed, err := l.isRouteAllowedByListener(ctx, gw, listener, kindHTTPRoute, route.Namespace, parentRefs, route.Spec.Hostnames, namespaceCache)
+			if err != nil {
+				return err
+			}
+			if !allowed {
+				continue
+			}
+			result.HTTPRoutes[listener.Name] = append(result.HTTPRoutes[listener.Name], route)
+			attached = true
+		}
+		if !attached {
+			result.UnattachedHTTPRoutes = append(result.UnattachedHTTPRoutes, route)
+		}
+	}
+	for _, routes := range result.HTTPRoutes {
+		sortHTTPRoutes(routes)
+	}
+	sortHTTPRoutes(result.UnattachedHTTPRoutes)
+	return nil
+}
+
+func (l *defaultRouteLoader) listL4Routes(ctx context.Context, kind string) ([]*L4Route, error) {
+	var objs []client.Object
+	switch kind {
+	case kindTCPRoute:
+		routeList := &gwv1alpha2.TCPRouteList{}
+		if err := l.k8sClient.List(ctx, routeList); err != nil {
+			return nil, errors.Wrap(err, "failed to list TCPRoutes")
+		}
+		for i := range routeList.Items {
+			objs = append(objs, &routeList.Items[i])
+		}
+	case kindUDPRoute:
+		routeList := &gwv1alpha2.UDPRouteList{}
+		if err := l.k8sClient.List(ctx, routeList); err != nil {
+			return nil, errors.Wrap(err, "failed to list UDPRoutes")
+		}
+		for i := range routeList.Items {
+			objs = append(objs, &routeList.Items[i])
+		}
+	case kindTLSRoute:
+		routeList := &gwv1alpha2.TLSRouteList{}
+		if err := l.k8sClient.List(ctx, routeList); err != nil {
+			return nil, errors.Wrap(err, "failed to list TLSRoutes")
+		}
+		for i := range routeList.Items {
+			objs = append(objs, &routeList.Items[i])
+		}
+	}
+	routes := make([]*L4Route, 0, len(objs))
+	for _, obj := range objs {
+		if !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		route, _ := NewL4Route(obj)
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+// isRouteAllowedByListener checks whether route can attach to the listener.
+func (l *defaultRouteLoader) isRouteAllowedByListener(ctx context.Context, gw *gwv1beta1.Gateway, listener gwv1beta1.Listener,
+	routeKind string, routeNamespace string, parentRefs []gwv1beta1.ParentReference, routeHostnames []gwv1beta1.Hostname,
+	namespaceCache map[string]*corev1.Namespace) (bool, error) {
+	if !isRouteAllowedByParentRefs(listener, parentRefs) {
+		return false, nil
+	}
+	if RouteKindForListener(listener) != routeKind {
+		return false, nil
+	}
+	if !isRouteKindAllowed(listener, routeKind) {
+		return false, nil
+	}
+	allowed, err := l.isNamespaceAllowed(ctx, gw, listener, routeNamespace, namespaceCache)
+	if err != nil || !allowed {
+		return false, err
+	}
+	return isHostnameIntersected(listener.Hostname, routeHostnames), nil
+}
+
+func (l *defaultRouteLoader) isNamespaceAllowed(ctx context.Context, gw *gwv1beta1.Gateway, listener gwv1beta1.Listener,
+	routeNamespace string, namespaceCache map[string]*corev1.Namespace) (bool, error) {
+	from := gwv1beta1.NamespacesFromSame
+	var selector *metav1.LabelSelector
+	if listener.AllowedRoutes != nil && listener.AllowedRoutes.Namespaces != nil {
+		if listener.AllowedRoutes.Namespaces.From != nil {
+			from = *listener.AllowedRoutes.Namespaces.From
+		}
+		selector = listener.AllowedRoutes.Namespaces.Selector
+	}
+
+	switch from {
+	case gwv1beta1.NamespacesFromAll:
+		return true, nil
+	case gwv1beta1.NamespacesFromSame:
+		return routeNamespace == gw.Namespace, nil
+	case gwv1beta1.NamespacesFromSelector:
+		if selector == nil {
+			return false, nil
+		}
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return false, errors.Wrapf(err, "invalid namespace selector for listener %v", listener.Name)
+		}
+		ns, exists := namespaceCache[routeNamespace]
+		if !exists {
+			ns = &corev1.Namespace{}
+			if err := l.k8sClient.Get(ctx, types.NamespacedName{Name: routeNamespace}, ns); err != nil {
+				return false, errors.Wrapf(err, "failed to get namespace %v", routeNamespace)
+			}
+			namespaceCache[routeNamespace] = ns
+		}
+		return labelSelector.Matches(labels.Set(ns.Labels)), nil
+	default:
+		return false, nil
+	}
+}
+
+// ParentRefsForGateway returns the parentRefs of route that references specified Gateway.
+func ParentRefsForGateway(gw *gwv1beta1.Gateway, routeNamespace string, parentRefs []gwv1beta1.ParentReference) []gwv1beta1.ParentReference {
+	var matchedParentRefs []gwv1beta1.ParentReference
+	for _, parentRef := range parentRefs {
+		if parentRef.Group != nil && string(*parentRef.Group) != gwv1beta1.GroupName {
+			continue
+		}
+		if parentRef.Kind != nil && string(*parentRef.Kind) != kindGateway {
+			continue
+		}
+		parentNamespace := routeNamespace
+		if parentRef.Namespace != nil {
+			parentNamespace = string(*parentRef.Namespace)
+		}
+		if parentNamespace != gw.Namespace || string(parentRef.Name) != gw.Name {
+			continue
+		}
+		matchedParentRefs = append(matchedParentRefs, parentRef)
+	}
+	return matchedParentRefs
+}
+
+// isRouteAllowedByParentRefs checks whether any of the parentRefs selects the listener.
+func isRouteAllowedByParentRefs(listener gwv1beta1.Listener, parentRefs []gwv1beta1.ParentReference) bool {
+	for _, parentRef := range parentRefs {
+		if parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+			continue
+		}
+		if parentRef.Port != nil && *parentRef.Port != listener.Port {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// RouteKindForListener returns the kind of routes served by listener according to its protocol.
+func RouteKindForListener(listener gwv1beta1.Listener) string {
+	switch listener.Protocol {
+	case gwv1beta1.HTTPProtocolType, gwv1beta1.HTTPSProtocolType:
+		return kindHTTPRoute
+	case gwv1beta1.TCPProtocolType:
+		return kindTCPRoute
+	case gwv1beta1.UDPProtocolType:
+		return kindUDPRoute
+	case gwv1beta1.TLSProtocolType:
+		return kindTLSRoute
+	default:
+		return ""
+	}
+}
+
+// isRouteKindAllowed checks whether routeKind is allowed by the listener's allowedRoutes.
+func isRouteKindAllowed(listener gwv1beta1.Listener, routeKind string) bool {
+	if listener.AllowedRoutes == nil || len(listener.AllowedRoutes.Kinds) == 0 {
+		return true
+	}
+	for _, kind := range listener.AllowedRoutes.Kinds {
+		if kind.Group != nil && string(*kind.Group) != gwv1beta1.GroupName {
+			continue
+		}
+		if string(kind.Kind) == routeKind {
+			return true
+		}
+	}
+	return false
+}
+
+// ComputeRouteHostnames computes the effective hostnames of a route attached to listener.
+// It returns the intersection between listener's hostname and route's hostnames, with the more specific hostname chosen.
+// An empty result means the route matches any hostname if neither listener nor route have hostnames specified,
+// or the route cannot attach to the listener otherwise.
+func ComputeRouteHostnames(listenerHostname *gwv1beta1.Hostname, routeHostnames []gwv1beta1.Hostname) []string {
+	if listenerHostname == nil || len(*listenerHostname) == 0 {
+		hostnames := make([]string, 0, len(routeHostnames))
+		for _, hostname := range routeHostnames {
+			hostnames = append(hostnames, string(hostname))
+		}
+		return hostnames
+	}
+	if len(routeHostnames) == 0 {
+		return []string{string(*listenerHostname)}
+	}
+	var hostnames []string
+	for _, routeHostname := range routeHostnames {
+		switch {
+		case hostnameMatches(string(*listenerHostname), string(routeHostname)):
+			hostnames = append(hostnames, string(routeHostname))
+		case hostnameMatches(string(routeHostname), string(*listenerHostname)):
+			hostnames = append(hostnames, string(*listenerHostname))
+		}
+	}
+	return hostnames
+}
+
+// isHostnameIntersected checks whether route's hostnames intersects with listener's hostname.
+func isHostnameIntersected(listenerHostname *gwv1beta1.Hostname, routeHostnames []gwv1beta1.Hostname) bool {
+	if listenerHostname == nil || len(*listenerHostname) == 0 || len(routeHostnames) == 0 {
+		return true
+	}
+	return len(ComputeRouteHostnames(listenerHostname, routeHostnames)) != 0
+}
+
+// hostnameMatches checks whether hostname is covered by pattern, where pattern can be a wildcard hostname like "*.example.com".
+func hostnameMatches(pattern string, hostname string) bool {
+	if pattern == hostname {
+		return true
+	}
+	if !strings.HasPrefix(pattern, "*.") {
+		return false
+	}
+	suffix := strings.TrimPrefix(pattern, "*")
+	return strings.HasSuffix(hostname, suffix) && len(hostname) > len(suffix)
+}
+
+// sortHTTPRoutes sorts routes by creationTimestamp, and then by namespace/name, as the order of precedence defined by Gateway API.
+func sortHTTPRoutes(routes []*gwv1beta1.HTTPRoute) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		if !routes[i].CreationTimestamp.Equal(&routes[j].CreationTimestamp) {
+			return routes[i].CreationTimestamp.Before(&routes[j].CreationTimestamp)
+		}
+		return k8s.NamespacedName(routes[i]).String() < k8s.NamespacedName(routes[j]).String()
+	})
+}
+
+// sortL4Routes sorts routes by creationTimestamp, and then by kind/namespace/name.
+func sortL4Routes(routes []*L4Route) {
+	sort.SliceStable(routes, func(i, j int) bool {
+		ti, tj := routes[i].Object.GetCreationTimestamp(), routes[j].Object.GetCreationTimestamp()
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return routes[i].String() < routes[j].String()
+	})
+}
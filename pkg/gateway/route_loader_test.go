@@ -0,0 +1,351 @@
+package gateway
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func Test_defaultRouteLoader_Load(t *testing.T) {
+	fromAll := gwv1beta1.NamespacesFromAll
+	fromSelector := gwv1beta1.NamespacesFromSelector
+	sectionHTTPS := gwv1beta1.SectionName("https")
+	listenerHostname := gwv1beta1.Hostname("*.example.com")
+	gw := &gwv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "gw-ns",
+			Name:      "gw",
+		},
+		Spec: gwv1beta1.GatewaySpec{
+			GatewayClassName: "alb",
+			Listeners: []gwv1beta1.Listener{
+				{
+					Name:     "http",
+					Protocol: gwv1beta1.HTTPProtocolType,
+					Port:     80,
+				},
+				{
+					Name:     "https",
+					Protocol: gwv1beta1.HTTPSProtocolType,
+					Port:     443,
+					Hostname: &listenerHostname,
+					AllowedRoutes: &gwv1beta1.AllowedRoutes{
+						Namespaces: &gwv1beta1.RouteNamespaces{From: &fromAll},
+					},
+				},
+				{
+					Name:     "selector",
+					Protocol: gwv1beta1.HTTPProtocolType,
+					Port:     8080,
+					AllowedRoutes: &gwv1beta1.AllowedRoutes{
+						Namespaces: &gwv1beta1.RouteNamespaces{
+							From: &fromSelector,
+							Selector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"team": "a"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	gwNamespace := gwv1beta1.Namespace("gw-ns")
+	tests := []struct {
+		name           string
+		nsList         []*corev1.Namespace
+		routes         []*gwv1beta1.HTTPRoute
+		wantRoutes     map[gwv1beta1.SectionName][]string
+		wantUnattached []string
+	}{
+		{
+			name: "route in same namespace attaches to all listeners allowing it",
+			nsList: []*corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: "gw-ns"}},
+			},
+			routes: []*gwv1beta1.HTTPRoute{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "route"},
+					Spec: gwv1beta1.HTTPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "gw"}},
+						},
+					},
+				},
+			},
+			wantRoutes: map[gwv1beta1.SectionName][]string{
+				"http":  {"gw-ns/route"},
+				"https": {"gw-ns/route"},
+			},
+		},
+		{
+			name: "route in other namespace only attaches to listeners allowing it",
+			nsList: []*corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"team": "a"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"team": "b"}}},
+			},
+			routes: []*gwv1beta1.HTTPRoute{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "route"},
+					Spec: gwv1beta1.HTTPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "gw", Namespace: &gwNamespace}},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "team-b", Name: "route"},
+					Spec: gwv1beta1.HTTPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "gw", Namespace: &gwNamespace}},
+						},
+					},
+				},
+			},
+			wantRoutes: map[gwv1beta1.SectionName][]string{
+				"https":    {"team-a/route", "team-b/route"},
+				"selector": {"team-a/route"},
+			},
+		},
+		{
+			name: "route with sectionName and non-intersecting hostname is unattached",
+			nsList: []*corev1.Namespace{
+				{ObjectMeta: metav1.ObjectMeta{Name: "gw-ns"}},
+			},
+			routes: []*gwv1beta1.HTTPRoute{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "route"},
+					Spec: gwv1beta1.HTTPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "gw", SectionName: &sectionHTTPS}},
+						},
+						Hostnames: []gwv1beta1.Hostname{"www.example.org"},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "other-gw-route"},
+					Spec: gwv1beta1.HTTPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "other-gw"}},
+						},
+					},
+				},
+			},
+			wantRoutes:     map[gwv1beta1.SectionName][]string{},
+			wantUnattached: []string{"gw-ns/route"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1beta1.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ns := range tt.nsList {
+				assert.NoError(t, k8sClient.Create(ctx, ns.DeepCopy()))
+			}
+			for _, route := range tt.routes {
+				assert.NoError(t, k8sClient.Create(ctx, route.DeepCopy()))
+			}
+
+			l := NewDefaultRouteLoader(k8sClient)
+			got, err := l.Load(ctx, gw)
+			assert.NoError(t, err)
+			gotRoutes := make(map[gwv1beta1.SectionName][]string)
+			for sectionName, routes := range got.HTTPRoutes {
+				for _, route := range routes {
+					gotRoutes[sectionName] = append(gotRoutes[sectionName], k8s.NamespacedName(route).String())
+				}
+			}
+			var gotUnattached []string
+			for _, route := range got.UnattachedHTTPRoutes {
+				gotUnattached = append(gotUnattached, k8s.NamespacedName(route).String())
+			}
+			assert.Equal(t, tt.wantRoutes, gotRoutes)
+			assert.Equal(t, tt.wantUnattached, gotUnattached)
+		})
+	}
+}
+
+func Test_defaultRouteLoader_Load_L4Routes(t *testing.T) {
+	sectionTCP := gwv1beta1.SectionName("tcp")
+	gw := &gwv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "gw-ns",
+			Name:      "gw",
+		},
+		Spec: gwv1beta1.GatewaySpec{
+			GatewayClassName: "nlb",
+			Listeners: []gwv1beta1.Listener{
+				{
+					Name:     "tcp",
+					Protocol: gwv1beta1.TCPProtocolType,
+					Port:     8080,
+				},
+				{
+					Name:     "udp",
+					Protocol: gwv1beta1.UDPProtocolType,
+					Port:     53,
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name           string
+		routes         []client.Object
+		wantRoutes     map[gwv1beta1.SectionName]string
+		wantUnattached []string
+	}{
+		{
+			name: "routes attach to listeners with matching kind",
+			routes: []client.Object{
+				&gwv1alpha2.TCPRoute{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "route"},
+					Spec: gwv1alpha2.TCPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "gw"}},
+						},
+					},
+				},
+				&gwv1alpha2.UDPRoute{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "gw-ns", Name: "route"},
+					Spec: gwv1alpha2.UDPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "gw"}},
+						},
+					},
+				},
+			},
+			wantRoutes: map[gwv1beta1.SectionName]string{
+				"tcp": "TCPRoute/gw-ns/route",
+				"udp": "UDPRoute/gw-ns/route",
+			},
+		},
+		{
+			name: "only the oldest route attaches to a listener",
+			routes: []client.Object{
+				&gwv1alpha2.TCPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "gw-ns",
+						Name:              "route-new",
+						CreationTimestamp: metav1.NewTime(time.Unix(2000, 0)),
+					},
+					Spec: gwv1alpha2.TCPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "gw", SectionName: &sectionTCP}},
+						},
+					},
+				},
+				&gwv1alpha2.TCPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "gw-ns",
+						Name:              "route-old",
+						CreationTimestamp: metav1.NewTime(time.Unix(1000, 0)),
+					},
+					Spec: gwv1alpha2.TCPRouteSpec{
+						CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+							ParentRefs: []gwv1beta1.ParentReference{{Name: "gw", SectionName: &sectionTCP}},
+						},
+					},
+				},
+			},
+			wantRoutes: map[gwv1beta1.SectionName]string{
+				"tcp": "TCPRoute/gw-ns/route-old",
+			},
+			wantUnattached: []string{"TCPRoute/gw-ns/route-new"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1alpha2.AddToScheme(k8sSchema)
+			gwv1beta1.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			assert.NoError(t, k8sClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "gw-ns"}}))
+			for _, route := range tt.routes {
+				assert.NoError(t, k8sClient.Create(ctx, route.DeepCopyObject().(client.Object)))
+			}
+
+			l := NewDefaultRouteLoader(k8sClient)
+			got, err := l.Load(ctx, gw)
+			assert.NoError(t, err)
+			gotRoutes := make(map[gwv1beta1.SectionName]string)
+			for sectionName, route := range got.L4Routes {
+				gotRoutes[sectionName] = route.String()
+			}
+			var gotUnattached []string
+			for _, route := range got.UnattachedL4Routes {
+				gotUnattached = append(gotUnattached, route.String())
+			}
+			assert.Equal(t, tt.wantRoutes, gotRoutes)
+			assert.Equal(t, tt.wantUnattached, gotUnattached)
+		})
+	}
+}
+
+func Test_ComputeRouteHostnames(t *testing.T) {
+	wildcardHostname := gwv1beta1.Hostname("*.example.com")
+	exactHostname := gwv1beta1.Hostname("www.example.com")
+	tests := []struct {
+		name             string
+		listenerHostname *gwv1beta1.Hostname
+		routeHostnames   []gwv1beta1.Hostname
+		want             []string
+	}{
+		{
+			name:             "neither listener nor route have hostnames",
+			listenerHostname: nil,
+			routeHostnames:   nil,
+			want:             []string{},
+		},
+		{
+			name:             "only route have hostnames",
+			listenerHostname: nil,
+			routeHostnames:   []gwv1beta1.Hostname{"www.example.com", "*.example.org"},
+			want:             []string{"www.example.com", "*.example.org"},
+		},
+		{
+			name:             "only listener have hostname",
+			listenerHostname: &wildcardHostname,
+			routeHostnames:   nil,
+			want:             []string{"*.example.com"},
+		},
+		{
+			name:             "wildcard listener hostname with route hostnames",
+			listenerHostname: &wildcardHostname,
+			routeHostnames:   []gwv1beta1.Hostname{"www.example.com", "example.com", "www.example.org"},
+			want:             []string{"www.example.com"},
+		},
+		{
+			name:             "exact listener hostname with wildcard route hostname",
+			listenerHostname: &exactHostname,
+			routeHostnames:   []gwv1beta1.Hostname{"*.example.com"},
+			want:             []string{"www.example.com"},
+		},
+		{
+			name:             "no intersection",
+			listenerHostname: &exactHostname,
+			routeHostnames:   []gwv1beta1.Hostname{"api.example.com"},
+			want:             nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComputeRouteHostnames(tt.listenerHostname, tt.routeHostnames)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -114,6 +114,9 @@ func (b *defaultAuthConfigBuilder) buildAuthIDPConfigOIDC(_ context.Context, svc
 	if !exists {
 		return nil, nil
 	}
+	if (authIDP.SecretName == "") == (authIDP.SecretARN == "") {
+		return nil, errors.New("exactly one of secretName and secretARN must be specified for OIDC IdP")
+	}
 	return &authIDP, nil
 }
 
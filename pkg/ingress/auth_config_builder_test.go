@@ -2,6 +2,7 @@ package ingress
 
 import (
 	"context"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"testing"
@@ -179,6 +180,39 @@ func Test_defaultAuthConfigBuilder_Build(t *testing.T) {
 				SessionTimeout:           86400,
 			},
 		},
+		{
+			name: "oidc configuration with AWS Secrets Manager secret",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/auth-type":     "oidc",
+					"alb.ingress.kubernetes.io/auth-idp-oidc": `{"issuer":"https://example.com","authorizationEndpoint":"https://authorization.example.com","tokenEndpoint":"https://token.example.com","userInfoEndpoint":"https://userinfo.example.com","secretARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-secret"}`,
+				},
+			},
+			want: AuthConfig{
+				Type: AuthTypeOIDC,
+				IDPConfigOIDC: &AuthIDPConfigOIDC{
+					Issuer:                "https://example.com",
+					AuthorizationEndpoint: "https://authorization.example.com",
+					TokenEndpoint:         "https://token.example.com",
+					UserInfoEndpoint:      "https://userinfo.example.com",
+					SecretARN:             "arn:aws:secretsmanager:us-west-2:123456789012:secret:my-secret",
+				},
+				OnUnauthenticatedRequest: defaultAuthOnUnauthenticatedRequest,
+				Scope:                    defaultAuthScope,
+				SessionCookieName:        defaultAuthSessionCookieName,
+				SessionTimeout:           defaultAuthSessionTimeout,
+			},
+		},
+		{
+			name: "oidc configuration with both k8s secret and AWS Secrets Manager secret",
+			args: args{
+				svcAndIngAnnotations: map[string]string{
+					"alb.ingress.kubernetes.io/auth-type":     "oidc",
+					"alb.ingress.kubernetes.io/auth-idp-oidc": `{"issuer":"https://example.com","authorizationEndpoint":"https://authorization.example.com","tokenEndpoint":"https://token.example.com","userInfoEndpoint":"https://userinfo.example.com","secretName":"my-k8s-secret","secretARN":"arn:aws:secretsmanager:us-west-2:123456789012:secret:my-secret"}`,
+				},
+			},
+			wantErr: errors.New("exactly one of secretName and secretARN must be specified for OIDC IdP"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,52 @@
+package ingress
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+)
+
+// ResolveAWSRoleARN resolves the IAM role to assume when provisioning AWS resources for IngressGroup.
+// The IngressClassParams setting takes precedence over the annotation on Ingresses.
+// As anyone who can create Ingresses can set the annotation, it can only specify one of allowedAnnotationRoleARNs,
+// i.e. the annotation is rejected if allowedAnnotationRoleARNs is empty.
+// Inactive members are considered as well, so that resources provisioned with the role can be cleaned up with it.
+// An empty string is returned if no IAM role is specified.
+func ResolveAWSRoleARN(annotationParser annotations.Parser, ingGroup Group, allowedAnnotationRoleARNs sets.String) (string, error) {
+	explicitRoleARNs := sets.NewString()
+	annotationRoleARNs := sets.NewString()
+	for _, member := range ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.AWSRoleARN != "" {
+			explicitRoleARNs.Insert(member.IngClassConfig.IngClassParams.Spec.AWSRoleARN)
+			continue
+		}
+		rawRoleARN := ""
+		if exists := annotationParser.ParseStringAnnotation(annotations.IngressSuffixAWSRoleARN, &rawRoleARN, member.Ing.Annotations); exists {
+			annotationRoleARNs.Insert(rawRoleARN)
+		}
+	}
+	for _, inactiveMember := range ingGroup.InactiveMembers {
+		rawRoleARN := ""
+		if exists := annotationParser.ParseStringAnnotation(annotations.IngressSuffixAWSRoleARN, &rawRoleARN, inactiveMember.Annotations); exists {
+			annotationRoleARNs.Insert(rawRoleARN)
+		}
+	}
+	for _, roleARN := range annotationRoleARNs.List() {
+		if !allowedAnnotationRoleARNs.Has(roleARN) {
+			return "", errors.Errorf("aws role ARN %v isn't allowed to be specified via annotation", roleARN)
+		}
+	}
+	explicitRoleARNs = explicitRoleARNs.Union(annotationRoleARNs)
+	if len(explicitRoleARNs) > 1 {
+		return "", errors.Errorf("conflicting aws role ARNs: %v", explicitRoleARNs.List())
+	}
+	roleARN, _ := explicitRoleARNs.PopAny()
+	if roleARN == "" {
+		return "", nil
+	}
+	if err := aws.ValidateRoleARN(roleARN); err != nil {
+		return "", err
+	}
+	return roleARN, nil
+}
@@ -0,0 +1,142 @@
+package ingress
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func TestResolveAWSRoleARN(t *testing.T) {
+	buildIngress := func(name string, roleARN string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+		if roleARN != "" {
+			ing.Annotations = map[string]string{"alb.ingress.kubernetes.io/aws-role-arn": roleARN}
+		}
+		return ing
+	}
+	ingClassParamsWithRole := &v1beta1.IngressClassParams{
+		Spec: v1beta1.IngressClassParamsSpec{
+			AWSRoleARN: "arn:aws:iam::123456789012:role/class-role",
+		},
+	}
+	allowedRoleARNs := sets.NewString("arn:aws:iam::123456789012:role/ing-role", "arn:aws:iam::123456789012:role/another-role")
+	tests := []struct {
+		name            string
+		ingGroup        Group
+		allowedRoleARNs sets.String
+		want            string
+		wantErr         error
+	}{
+		{
+			name: "no role specified",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{{Ing: buildIngress("ing-1", "")}},
+			},
+			want: "",
+		},
+		{
+			name:            "role specified via annotation",
+			allowedRoleARNs: allowedRoleARNs,
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{Ing: buildIngress("ing-1", "arn:aws:iam::123456789012:role/ing-role")},
+					{Ing: buildIngress("ing-2", "")},
+				},
+			},
+			want: "arn:aws:iam::123456789012:role/ing-role",
+		},
+		{
+			name: "role specified via IngressClassParams takes precedence",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{
+						Ing:            buildIngress("ing-1", "arn:aws:iam::123456789012:role/ing-role"),
+						IngClassConfig: ClassConfiguration{IngClassParams: ingClassParamsWithRole},
+					},
+				},
+			},
+			want: "arn:aws:iam::123456789012:role/class-role",
+		},
+		{
+			name:            "role specified on inactive members",
+			allowedRoleARNs: allowedRoleARNs,
+			ingGroup: Group{
+				InactiveMembers: []*networking.Ingress{buildIngress("ing-1", "arn:aws:iam::123456789012:role/ing-role")},
+			},
+			want: "arn:aws:iam::123456789012:role/ing-role",
+		},
+		{
+			name:            "conflicting roles",
+			allowedRoleARNs: allowedRoleARNs,
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{Ing: buildIngress("ing-1", "arn:aws:iam::123456789012:role/ing-role")},
+					{Ing: buildIngress("ing-2", "arn:aws:iam::123456789012:role/another-role")},
+				},
+			},
+			wantErr: errors.New("conflicting aws role ARNs: [arn:aws:iam::123456789012:role/another-role arn:aws:iam::123456789012:role/ing-role]"),
+		},
+		{
+			name: "role specified via annotation without allowed roles",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{{Ing: buildIngress("ing-1", "arn:aws:iam::123456789012:role/ing-role")}},
+			},
+			wantErr: errors.New("aws role ARN arn:aws:iam::123456789012:role/ing-role isn't allowed to be specified via annotation"),
+		},
+		{
+			name:            "role specified via annotation not allowed",
+			allowedRoleARNs: allowedRoleARNs,
+			ingGroup: Group{
+				Members: []ClassifiedIngress{{Ing: buildIngress("ing-1", "arn:aws:iam::123456789012:role/admin")}},
+			},
+			wantErr: errors.New("aws role ARN arn:aws:iam::123456789012:role/admin isn't allowed to be specified via annotation"),
+		},
+		{
+			name:            "role specified on inactive members not allowed",
+			allowedRoleARNs: allowedRoleARNs,
+			ingGroup: Group{
+				InactiveMembers: []*networking.Ingress{buildIngress("ing-1", "arn:aws:iam::123456789012:role/admin")},
+			},
+			wantErr: errors.New("aws role ARN arn:aws:iam::123456789012:role/admin isn't allowed to be specified via annotation"),
+		},
+		{
+			name: "invalid role specified via IngressClassParams",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{
+						Ing: buildIngress("ing-1", ""),
+						IngClassConfig: ClassConfiguration{IngClassParams: &v1beta1.IngressClassParams{
+							Spec: v1beta1.IngressClassParamsSpec{
+								AWSRoleARN: "class-role",
+							},
+						}},
+					},
+				},
+			},
+			wantErr: errors.New("invalid IAM role ARN class-role"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			got, err := ResolveAWSRoleARN(annotationParser, tt.ingGroup, tt.allowedRoleARNs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
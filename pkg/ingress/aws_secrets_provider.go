@@ -0,0 +1,195 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	secretsmanagersdk "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+const (
+	// the tracked secrets are polled every 5 minutes to pick up rotations.
+	defaultAWSSecretsPollInterval = 5 * time.Minute
+	// the secrets are cached for 10 minutes, tracked secrets are refreshed by polling before expiration.
+	defaultAWSSecretsCacheTTL = 10 * time.Minute
+)
+
+// AWSSecretsProvider is responsible for providing the credentials of OIDC IdPs stored in AWS Secrets Manager.
+// The secrets must contain a JSON object with the clientID and clientSecret keys.
+type AWSSecretsProvider interface {
+	// LoadOIDCCredentials loads the clientID and clientSecret of OIDC IdP from the secret.
+	LoadOIDCCredentials(ctx context.Context, secretARN string) (string, string, error)
+
+	// Track replaces the tracked secrets of IngressGroup, its members are notified via RotationEvents once any tracked secret got rotated.
+	Track(ingGroup Group, secretARNs []string)
+
+	// RotationEvents returns the channel of Ingresses that need to be reconciled after secrets got rotated.
+	RotationEvents() <-chan event.GenericEvent
+}
+
+// NewDefaultAWSSecretsProvider constructs new defaultAWSSecretsProvider.
+func NewDefaultAWSSecretsProvider(secretsManagerClient services.SecretsManager, logger logr.Logger) *defaultAWSSecretsProvider {
+	return &defaultAWSSecretsProvider{
+		secretsManagerClient: secretsManagerClient,
+		logger:               logger,
+		pollInterval:         defaultAWSSecretsPollInterval,
+		secretsCache:         cache.NewExpiring(),
+		secretsCacheTTL:      defaultAWSSecretsCacheTTL,
+		groupByID:            make(map[GroupID]awsSecretsGroup),
+		rotationEventChan:    make(chan event.GenericEvent),
+	}
+}
+
+var _ AWSSecretsProvider = &defaultAWSSecretsProvider{}
+
+// default implementation for AWSSecretsProvider, which polls the tracked secrets periodically to detect new versions.
+type defaultAWSSecretsProvider struct {
+	secretsManagerClient services.SecretsManager
+	logger               logr.Logger
+	pollInterval         time.Duration
+
+	secretsCache    *cache.Expiring
+	secretsCacheTTL time.Duration
+
+	// groupByID is the tracked secrets per IngressGroup.
+	groupByID map[GroupID]awsSecretsGroup
+	// groupMutex protects groupByID
+	groupMutex sync.Mutex
+
+	rotationEventChan chan event.GenericEvent
+}
+
+// awsSecretsGroup is the tracked secrets of an IngressGroup.
+type awsSecretsGroup struct {
+	members    []*networking.Ingress
+	secretARNs sets.String
+}
+
+// oidcCredentials is the content of secrets holding the credentials of OIDC IdP.
+type oidcCredentials struct {
+	VersionID    string `json:"-"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+}
+
+func (p *defaultAWSSecretsProvider) LoadOIDCCredentials(ctx context.Context, secretARN string) (string, string, error) {
+	if rawCacheItem, ok := p.secretsCache.Get(secretARN); ok {
+		credentials := rawCacheItem.(oidcCredentials)
+		return credentials.ClientID, credentials.ClientSecret, nil
+	}
+	credentials, err := p.fetchOIDCCredentials(ctx, secretARN)
+	if err != nil {
+		return "", "", err
+	}
+	return credentials.ClientID, credentials.ClientSecret, nil
+}
+
+func (p *defaultAWSSecretsProvider) Track(ingGroup Group, secretARNs []string) {
+	p.groupMutex.Lock()
+	defer p.groupMutex.Unlock()
+	if len(secretARNs) == 0 {
+		delete(p.groupByID, ingGroup.ID)
+		return
+	}
+	members := make([]*networking.Ingress, 0, len(ingGroup.Members))
+	for _, member := range ingGroup.Members {
+		members = append(members, member.Ing)
+	}
+	p.groupByID[ingGroup.ID] = awsSecretsGroup{
+		members:    members,
+		secretARNs: sets.NewString(secretARNs...),
+	}
+}
+
+func (p *defaultAWSSecretsProvider) RotationEvents() <-chan event.GenericEvent {
+	return p.rotationEventChan
+}
+
+// Start polls the tracked secrets until ctx is done.
+func (p *defaultAWSSecretsProvider) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, p.poll, p.pollInterval)
+	return nil
+}
+
+// NeedLeaderElection returns true, as secrets are only tracked by the leader.
+func (p *defaultAWSSecretsProvider) NeedLeaderElection() bool {
+	return true
+}
+
+// poll fetches the tracked secrets, and notifies the members of IngressGroups referencing secrets with a new version.
+func (p *defaultAWSSecretsProvider) poll(ctx context.Context) {
+	p.groupMutex.Lock()
+	groupByID := make(map[GroupID]awsSecretsGroup, len(p.groupByID))
+	secretARNs := sets.NewString()
+	for groupID, group := range p.groupByID {
+		groupByID[groupID] = group
+		secretARNs = secretARNs.Union(group.secretARNs)
+	}
+	p.groupMutex.Unlock()
+
+	rotatedSecretARNs := sets.NewString()
+	for _, secretARN := range secretARNs.List() {
+		var previousVersionID string
+		if rawCacheItem, ok := p.secretsCache.Get(secretARN); ok {
+			previousVersionID = rawCacheItem.(oidcCredentials).VersionID
+		}
+		credentials, err := p.fetchOIDCCredentials(ctx, secretARN)
+		if err != nil {
+			p.logger.Error(err, "failed to poll secret", "secretARN", secretARN)
+			continue
+		}
+		if previousVersionID != "" && previousVersionID != credentials.VersionID {
+			p.logger.Info("secret rotated", "secretARN", secretARN, "versionID", credentials.VersionID)
+			rotatedSecretARNs.Insert(secretARN)
+		}
+	}
+	if rotatedSecretARNs.Len() == 0 {
+		return
+	}
+	for _, group := range groupByID {
+		if !group.secretARNs.HasAny(rotatedSecretARNs.UnsortedList()...) {
+			continue
+		}
+		for _, ing := range group.members {
+			select {
+			case p.rotationEventChan <- event.GenericEvent{Object: ing}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (p *defaultAWSSecretsProvider) fetchOIDCCredentials(ctx context.Context, secretARN string) (oidcCredentials, error) {
+	req := &secretsmanagersdk.GetSecretValueInput{
+		SecretId: awssdk.String(secretARN),
+	}
+	resp, err := p.secretsManagerClient.GetSecretValueWithContext(ctx, req)
+	if err != nil {
+		return oidcCredentials{}, err
+	}
+	var credentials oidcCredentials
+	if err := json.Unmarshal([]byte(awssdk.ToString(resp.SecretString)), &credentials); err != nil {
+		return oidcCredentials{}, errors.Wrapf(err, "failed to parse secret: %v", secretARN)
+	}
+	if credentials.ClientID == "" {
+		return oidcCredentials{}, errors.Errorf("missing clientID, secret: %v", secretARN)
+	}
+	if credentials.ClientSecret == "" {
+		return oidcCredentials{}, errors.Errorf("missing clientSecret, secret: %v", secretARN)
+	}
+	credentials.VersionID = awssdk.ToString(resp.VersionId)
+	p.secretsCache.Set(secretARN, credentials, p.secretsCacheTTL)
+	return credentials, nil
+}
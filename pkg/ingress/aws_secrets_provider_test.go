@@ -0,0 +1,172 @@
+package ingress
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	secretsmanagersdk "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeSecretsManager serves secrets from memory and counts the GetSecretValue calls.
+type fakeSecretsManager struct {
+	services.SecretsManager
+
+	mutex               sync.Mutex
+	secretStringByARN   map[string]string
+	versionIDByARN      map[string]string
+	getSecretValueCalls int
+}
+
+func (c *fakeSecretsManager) GetSecretValueWithContext(_ context.Context, input *secretsmanagersdk.GetSecretValueInput) (*secretsmanagersdk.GetSecretValueOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.getSecretValueCalls++
+	secretARN := awssdk.ToString(input.SecretId)
+	secretString, ok := c.secretStringByARN[secretARN]
+	if !ok {
+		return nil, errors.Errorf("secret not found: %v", secretARN)
+	}
+	return &secretsmanagersdk.GetSecretValueOutput{
+		ARN:          input.SecretId,
+		SecretString: awssdk.String(secretString),
+		VersionId:    awssdk.String(c.versionIDByARN[secretARN]),
+	}, nil
+}
+
+func Test_defaultAWSSecretsProvider_LoadOIDCCredentials(t *testing.T) {
+	tests := []struct {
+		name             string
+		secretString     string
+		wantClientID     string
+		wantClientSecret string
+		wantErr          error
+	}{
+		{
+			name:             "clientID & clientSecret configured",
+			secretString:     `{"clientID":"my-client-id","clientSecret":"my-client-secret"}`,
+			wantClientID:     "my-client-id",
+			wantClientSecret: "my-client-secret",
+		},
+		{
+			name:         "missing clientID",
+			secretString: `{"clientSecret":"my-client-secret"}`,
+			wantErr:      errors.New("missing clientID, secret: my-secret-arn"),
+		},
+		{
+			name:         "missing clientSecret",
+			secretString: `{"clientID":"my-client-id"}`,
+			wantErr:      errors.New("missing clientSecret, secret: my-secret-arn"),
+		},
+		{
+			name:         "secret isn't JSON",
+			secretString: "my-client-secret",
+			wantErr:      errors.New("failed to parse secret: my-secret-arn: invalid character 'm' looking for beginning of value"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secretsManagerClient := &fakeSecretsManager{
+				secretStringByARN: map[string]string{"my-secret-arn": tt.secretString},
+				versionIDByARN:    map[string]string{"my-secret-arn": "version-1"},
+			}
+			p := NewDefaultAWSSecretsProvider(secretsManagerClient, logr.New(&log.NullLogSink{}))
+			for i := 0; i < 2; i++ {
+				gotClientID, gotClientSecret, err := p.LoadOIDCCredentials(context.Background(), "my-secret-arn")
+				if tt.wantErr != nil {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tt.wantClientID, gotClientID)
+					assert.Equal(t, tt.wantClientSecret, gotClientSecret)
+				}
+			}
+			if tt.wantErr == nil {
+				assert.Equal(t, 1, secretsManagerClient.getSecretValueCalls)
+			}
+		})
+	}
+}
+
+func Test_defaultAWSSecretsProvider_poll(t *testing.T) {
+	ing1 := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-1"}}
+	ing2 := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-2"}}
+	ing3 := &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-3"}}
+	tests := []struct {
+		name          string
+		rotatedARNs   []string
+		wantIngresses []types.NamespacedName
+	}{
+		{
+			name:        "no secret rotated",
+			rotatedARNs: nil,
+		},
+		{
+			name:        "secret rotated",
+			rotatedARNs: []string{"secret-arn-1"},
+			wantIngresses: []types.NamespacedName{
+				{Namespace: "awesome-ns", Name: "ing-1"},
+				{Namespace: "awesome-ns", Name: "ing-2"},
+			},
+		},
+		{
+			name:        "untracked secret rotated",
+			rotatedARNs: []string{"secret-arn-3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secretsManagerClient := &fakeSecretsManager{
+				secretStringByARN: map[string]string{
+					"secret-arn-1": `{"clientID":"client-id-1","clientSecret":"client-secret-1"}`,
+					"secret-arn-2": `{"clientID":"client-id-2","clientSecret":"client-secret-2"}`,
+					"secret-arn-3": `{"clientID":"client-id-3","clientSecret":"client-secret-3"}`,
+				},
+				versionIDByARN: map[string]string{
+					"secret-arn-1": "version-1",
+					"secret-arn-2": "version-1",
+					"secret-arn-3": "version-1",
+				},
+			}
+			p := NewDefaultAWSSecretsProvider(secretsManagerClient, logr.New(&log.NullLogSink{}))
+			for _, secretARN := range []string{"secret-arn-1", "secret-arn-2", "secret-arn-3"} {
+				_, _, err := p.LoadOIDCCredentials(context.Background(), secretARN)
+				assert.NoError(t, err)
+			}
+			p.Track(Group{
+				ID:      GroupID{Name: "group-1"},
+				Members: []ClassifiedIngress{{Ing: ing1}, {Ing: ing2}},
+			}, []string{"secret-arn-1"})
+			p.Track(Group{
+				ID:      GroupID{Name: "group-2"},
+				Members: []ClassifiedIngress{{Ing: ing3}},
+			}, []string{"secret-arn-2"})
+			for _, secretARN := range tt.rotatedARNs {
+				secretsManagerClient.versionIDByARN[secretARN] = "version-2"
+			}
+
+			var gotIngresses []types.NamespacedName
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for e := range p.RotationEvents() {
+					gotIngresses = append(gotIngresses, k8s.NamespacedName(e.Object))
+				}
+			}()
+			p.poll(context.Background())
+			close(p.rotationEventChan)
+			<-done
+			assert.Equal(t, tt.wantIngresses, gotIngresses)
+		})
+	}
+}
@@ -2,17 +2,20 @@ package ingress
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/acm"
+	"fmt"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
-	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -24,6 +27,12 @@ const (
 	defaultImportedCertDomainsCacheTTL = 5 * time.Minute
 	// the domain names for private certificates won't change, cache for a longer time.
 	defaultPrivateCertDomainsCacheTTL = 10 * time.Hour
+	// the tags for certificates will be cached for 5 minute.
+	defaultCertTagsCacheTTL = 5 * time.Minute
+	// certTagValueAny is the value of certificate tag selectors that matches any value.
+	certTagValueAny = "*"
+	// the number of concurrent workers to describe certificates and match tls hosts.
+	defaultCertDiscoveryWorkers = 8
 )
 
 // CertDiscovery is responsible for auto-discover TLS certificates for tls hosts.
@@ -32,11 +41,28 @@ type CertDiscovery interface {
 	Discover(ctx context.Context, tlsHosts []string) ([]string, error)
 }
 
-// NewACMCertDiscovery constructs new acmCertDiscovery
-func NewACMCertDiscovery(acmClient services.ACM, logger logr.Logger) *acmCertDiscovery {
+type certDiscoveryUsageContextKey struct{}
+
+// ContextWithCertDiscoveryUsage returns a context that records whether certificates got auto-discovered within it.
+func ContextWithCertDiscoveryUsage(ctx context.Context) context.Context {
+	return context.WithValue(ctx, certDiscoveryUsageContextKey{}, &atomic.Bool{})
+}
+
+// CertDiscoveryUsed returns whether certificates got auto-discovered within context from ContextWithCertDiscoveryUsage.
+func CertDiscoveryUsed(ctx context.Context) bool {
+	used, ok := ctx.Value(certDiscoveryUsageContextKey{}).(*atomic.Bool)
+	return ok && used.Load()
+}
+
+// NewACMCertDiscovery constructs new acmCertDiscovery.
+// Only the certificates with all certTags will be discovered if certTags is non-empty.
+func NewACMCertDiscovery(acmClient services.ACM, certTags map[string]string, metrics *CertDiscoveryMetrics, logger logr.Logger) *acmCertDiscovery {
 	return &acmCertDiscovery{
 		acmClient: acmClient,
+		certTags:  certTags,
+		metrics:   metrics,
 		logger:    logger,
+		workers:   defaultCertDiscoveryWorkers,
 
 		loadDomainsByCertARNMutex:   sync.Mutex{},
 		certARNsCache:               cache.NewExpiring(),
@@ -44,6 +70,8 @@ func NewACMCertDiscovery(acmClient services.ACM, logger logr.Logger) *acmCertDis
 		certDomainsCache:            cache.NewExpiring(),
 		importedCertDomainsCacheTTL: defaultImportedCertDomainsCacheTTL,
 		privateCertDomainsCacheTTL:  defaultPrivateCertDomainsCacheTTL,
+		certTagsCache:               cache.NewExpiring(),
+		certTagsCacheTTL:            defaultCertTagsCacheTTL,
 	}
 }
 
@@ -52,7 +80,12 @@ var _ CertDiscovery = &acmCertDiscovery{}
 // CertDiscovery implementation for ACM certificates.
 type acmCertDiscovery struct {
 	acmClient services.ACM
-	logger    logr.Logger
+	// certTags are the tags certificates must have to be discovered, all certificates are discovered if empty.
+	certTags map[string]string
+	// metrics is optional, no metrics will be recorded if nil.
+	metrics *CertDiscoveryMetrics
+	logger  logr.Logger
+	workers int
 
 	// mutex to serialize the call to loadDomainsForAllCertificates
 	loadDomainsByCertARNMutex   sync.Mutex
@@ -61,31 +94,70 @@ type acmCertDiscovery struct {
 	certDomainsCache            *cache.Expiring
 	importedCertDomainsCacheTTL time.Duration
 	privateCertDomainsCacheTTL  time.Duration
+	certTagsCache               *cache.Expiring
+	certTagsCacheTTL            time.Duration
 }
 
 func (d *acmCertDiscovery) Discover(ctx context.Context, tlsHosts []string) ([]string, error) {
+	startTime := time.Now()
+	defer func() {
+		d.metrics.observeDuration(time.Since(startTime).Seconds())
+	}()
+	if used, ok := ctx.Value(certDiscoveryUsageContextKey{}).(*atomic.Bool); ok {
+		used.Store(true)
+	}
+
 	domainsByCertARN, err := d.loadDomainsForAllCertificates(ctx)
 	if err != nil {
 		return nil, err
 	}
+	certARNsByHost := make([][]string, len(tlsHosts))
+	workqueue.ParallelizeUntil(ctx, d.workers, len(tlsHosts), func(i int) {
+		certARNsByHost[i] = d.matchCertificatesForHost(domainsByCertARN, tlsHosts[i])
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	certARNs := sets.NewString()
-	for _, host := range tlsHosts {
-		var certARNsForHost []string
-		for certARN, domains := range domainsByCertARN {
-			for domain := range domains {
-				if d.domainMatchesHost(domain, host) {
-					certARNsForHost = append(certARNsForHost, certARN)
-					break
-				}
-			}
+	var hostsWithoutCert []string
+	for i, host := range tlsHosts {
+		if len(certARNsByHost[i]) == 0 {
+			hostsWithoutCert = append(hostsWithoutCert, host)
+			continue
 		}
+		certARNs.Insert(certARNsByHost[i]...)
+	}
+	if len(hostsWithoutCert) != 0 {
+		return nil, &CertNotFoundError{Hosts: hostsWithoutCert}
+	}
+	return certARNs.List(), nil
+}
+
+// CertNotFoundError is returned by Discover when no certificate is found for some tls hosts.
+type CertNotFoundError struct {
+	Hosts []string
+}
+
+func (e *CertNotFoundError) Error() string {
+	if len(e.Hosts) == 1 {
+		return fmt.Sprintf("no certificate found for host: %s", e.Hosts[0])
+	}
+	return fmt.Sprintf("no certificate found for hosts: %s", strings.Join(e.Hosts, ", "))
+}
 
-		if len(certARNsForHost) == 0 {
-			return nil, errors.Errorf("no certificate found for host: %s", host)
+// matchCertificatesForHost returns the certificateARNs with any domain matches the tlsHost.
+func (d *acmCertDiscovery) matchCertificatesForHost(domainsByCertARN map[string]sets.String, host string) []string {
+	var certARNsForHost []string
+	for certARN, domains := range domainsByCertARN {
+		for domain := range domains {
+			if d.domainMatchesHost(domain, host) {
+				certARNsForHost = append(certARNsForHost, certARN)
+				break
+			}
 		}
-		certARNs.Insert(certARNsForHost...)
 	}
-	return certARNs.List(), nil
+	return certARNsForHost
 }
 
 func (d *acmCertDiscovery) loadDomainsForAllCertificates(ctx context.Context) (map[string]sets.String, error) {
@@ -96,27 +168,64 @@ func (d *acmCertDiscovery) loadDomainsForAllCertificates(ctx context.Context) (m
 	if err != nil {
 		return nil, err
 	}
-	domainsByCertARN := make(map[string]sets.String, len(certARNs))
-	for _, certARN := range certARNs {
-		certDomains, err := d.loadDomainsForCertificate(ctx, certARN)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	certDomainsList := make([]sets.String, len(certARNs))
+	var firstErr error
+	var firstErrOnce sync.Once
+	workqueue.ParallelizeUntil(ctx, d.workers, len(certARNs), func(i int) {
+		certMatchesTags, err := d.certificateMatchesTags(ctx, certARNs[i])
 		if err != nil {
-			return nil, err
+			firstErrOnce.Do(func() {
+				firstErr = err
+				cancel()
+			})
+			return
+		}
+		if !certMatchesTags {
+			return
 		}
-		domainsByCertARN[certARN] = certDomains
+		certDomains, err := d.loadDomainsForCertificate(ctx, certARNs[i])
+		if err != nil {
+			firstErrOnce.Do(func() {
+				firstErr = err
+				cancel()
+			})
+			return
+		}
+		certDomainsList[i] = certDomains
+	})
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	domainsByCertARN := make(map[string]sets.String, len(certARNs))
+	for i, certARN := range certARNs {
+		if certDomainsList[i] == nil {
+			continue
+		}
+		domainsByCertARN[certARN] = certDomainsList[i]
 	}
 	return domainsByCertARN, nil
 }
 
 func (d *acmCertDiscovery) loadAllCertificateARNs(ctx context.Context) ([]string, error) {
-	if rawCacheItem, ok := d.certARNsCache.Get(certARNsCacheKey); ok {
+	rawCacheItem, ok := d.certARNsCache.Get(certARNsCacheKey)
+	d.metrics.observeCacheLookup(cacheCertARNs, ok)
+	if ok {
 		return rawCacheItem.([]string), nil
 	}
 	req := &acm.ListCertificatesInput{
-		CertificateStatuses: aws.StringSlice([]string{acm.CertificateStatusIssued}),
-		Includes: &acm.Filters{
-			KeyTypes: aws.StringSlice(acm.KeyAlgorithm_Values()),
+		CertificateStatuses: []acmtypes.CertificateStatus{acmtypes.CertificateStatusIssued},
+		Includes: &acmtypes.Filters{
+			KeyTypes: acmtypes.KeyAlgorithm("").Values(),
 		},
 	}
+	d.metrics.observeACMCall("ListCertificates")
 	certSummaries, err := d.acmClient.ListCertificatesAsList(ctx, req)
 	if err != nil {
 		return nil, err
@@ -124,7 +233,7 @@ func (d *acmCertDiscovery) loadAllCertificateARNs(ctx context.Context) ([]string
 
 	var certARNs []string
 	for _, certSummary := range certSummaries {
-		certARN := aws.StringValue(certSummary.CertificateArn)
+		certARN := aws.ToString(certSummary.CertificateArn)
 		certARNs = append(certARNs, certARN)
 	}
 	d.certARNsCache.Set(certARNsCacheKey, certARNs, d.certARNsCacheTTL)
@@ -132,27 +241,70 @@ func (d *acmCertDiscovery) loadAllCertificateARNs(ctx context.Context) ([]string
 }
 
 func (d *acmCertDiscovery) loadDomainsForCertificate(ctx context.Context, certARN string) (sets.String, error) {
-	if rawCacheItem, ok := d.certDomainsCache.Get(certARN); ok {
+	rawCacheItem, ok := d.certDomainsCache.Get(certARN)
+	d.metrics.observeCacheLookup(cacheCertDomains, ok)
+	if ok {
 		return rawCacheItem.(sets.String), nil
 	}
 	req := &acm.DescribeCertificateInput{
 		CertificateArn: aws.String(certARN),
 	}
+	d.metrics.observeACMCall("DescribeCertificate")
 	resp, err := d.acmClient.DescribeCertificateWithContext(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 	certDetail := resp.Certificate
-	domains := sets.NewString(aws.StringValueSlice(certDetail.SubjectAlternativeNames)...)
-	switch aws.StringValue(certDetail.Type) {
-	case acm.CertificateTypeImported:
+	domains := sets.NewString(certDetail.SubjectAlternativeNames...)
+	switch certDetail.Type {
+	case acmtypes.CertificateTypeImported:
 		d.certDomainsCache.Set(certARN, domains, d.importedCertDomainsCacheTTL)
-	case acm.CertificateTypeAmazonIssued, acm.CertificateTypePrivate:
+	case acmtypes.CertificateTypeAmazonIssued, acmtypes.CertificateTypePrivate:
 		d.certDomainsCache.Set(certARN, domains, d.privateCertDomainsCacheTTL)
 	}
 	return domains, nil
 }
 
+// certificateMatchesTags checks whether the certificate has all certTags, the tag value * matches any value.
+func (d *acmCertDiscovery) certificateMatchesTags(ctx context.Context, certARN string) (bool, error) {
+	if len(d.certTags) == 0 {
+		return true, nil
+	}
+	tags, err := d.loadTagsForCertificate(ctx, certARN)
+	if err != nil {
+		return false, err
+	}
+	for key, value := range d.certTags {
+		tagValue, exists := tags[key]
+		if !exists || (value != certTagValueAny && value != tagValue) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (d *acmCertDiscovery) loadTagsForCertificate(ctx context.Context, certARN string) (map[string]string, error) {
+	rawCacheItem, ok := d.certTagsCache.Get(certARN)
+	d.metrics.observeCacheLookup(cacheCertTags, ok)
+	if ok {
+		return rawCacheItem.(map[string]string), nil
+	}
+	req := &acm.ListTagsForCertificateInput{
+		CertificateArn: aws.String(certARN),
+	}
+	d.metrics.observeACMCall("ListTagsForCertificate")
+	resp, err := d.acmClient.ListTagsForCertificateWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(resp.Tags))
+	for _, tag := range resp.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	d.certTagsCache.Set(certARN, tags, d.certTagsCacheTTL)
+	return tags, nil
+}
+
 func (d *acmCertDiscovery) domainMatchesHost(domainName string, tlsHost string) bool {
 	if strings.HasPrefix(domainName, "*.") {
 		ds := strings.Split(domainName, ".")
@@ -0,0 +1,93 @@
+package ingress
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystemCertDiscovery = "cert_discovery"
+
+	metricCertDiscoveryDurationSeconds = "duration_seconds"
+	metricCertDiscoveryCacheLookups    = "cache_lookups_total"
+	metricCertDiscoveryACMCalls        = "acm_calls_total"
+)
+
+const (
+	labelCache     = "cache"
+	labelResult    = "result"
+	labelOperation = "operation"
+
+	cacheCertARNs    = "cert_arns"
+	cacheCertDomains = "cert_domains"
+	cacheCertTags    = "cert_tags"
+
+	cacheResultHit  = "hit"
+	cacheResultMiss = "miss"
+)
+
+// CertDiscoveryMetrics contains the metrics shared by all CertDiscovery instances.
+type CertDiscoveryMetrics struct {
+	durationSeconds prometheus.Histogram
+	cacheLookups    *prometheus.CounterVec
+	acmCalls        *prometheus.CounterVec
+}
+
+// NewCertDiscoveryMetrics allocates and register new CertDiscoveryMetrics to registerer.
+func NewCertDiscoveryMetrics(registerer prometheus.Registerer) (*CertDiscoveryMetrics, error) {
+	durationSeconds := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemCertDiscovery,
+		Name:      metricCertDiscoveryDurationSeconds,
+		Help:      "Latency of discovering certificates for TLS hosts",
+	})
+	cacheLookups := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemCertDiscovery,
+		Name:      metricCertDiscoveryCacheLookups,
+		Help:      "Total number of lookups into the ACM certificate caches",
+	}, []string{labelCache, labelResult})
+	acmCalls := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemCertDiscovery,
+		Name:      metricCertDiscoveryACMCalls,
+		Help:      "Total number of ACM API calls made for certificate discovery",
+	}, []string{labelOperation})
+
+	if err := registerer.Register(durationSeconds); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricCertDiscoveryDurationSeconds)
+	}
+	if err := registerer.Register(cacheLookups); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricCertDiscoveryCacheLookups)
+	}
+	if err := registerer.Register(acmCalls); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricCertDiscoveryACMCalls)
+	}
+	return &CertDiscoveryMetrics{
+		durationSeconds: durationSeconds,
+		cacheLookups:    cacheLookups,
+		acmCalls:        acmCalls,
+	}, nil
+}
+
+func (m *CertDiscoveryMetrics) observeDuration(seconds float64) {
+	if m == nil {
+		return
+	}
+	m.durationSeconds.Observe(seconds)
+}
+
+func (m *CertDiscoveryMetrics) observeCacheLookup(cache string, hit bool) {
+	if m == nil {
+		return
+	}
+	result := cacheResultMiss
+	if hit {
+		result = cacheResultHit
+	}
+	m.cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+func (m *CertDiscoveryMetrics) observeACMCall(operation string) {
+	if m == nil {
+		return
+	}
+	m.acmCalls.WithLabelValues(operation).Inc()
+}
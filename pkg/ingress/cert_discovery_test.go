@@ -1,10 +1,192 @@
 package ingress
 
 import (
+	"context"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sync"
 	"testing"
 )
 
+// fakeACM serves certificates from memory and counts the ACM calls.
+type fakeACM struct {
+	services.ACM
+
+	domainsByCertARN map[string][]string
+	tagsByCertARN    map[string]map[string]string
+
+	mutex                    sync.Mutex
+	listCertificatesCalls    int
+	describeCertificateErr   error
+	describeCertificateCalls int
+}
+
+func (c *fakeACM) ListCertificatesAsList(_ context.Context, _ *acm.ListCertificatesInput) ([]acmtypes.CertificateSummary, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.listCertificatesCalls++
+	var certSummaries []acmtypes.CertificateSummary
+	for certARN := range c.domainsByCertARN {
+		certSummaries = append(certSummaries, acmtypes.CertificateSummary{CertificateArn: aws.String(certARN)})
+	}
+	return certSummaries, nil
+}
+
+func (c *fakeACM) DescribeCertificateWithContext(_ context.Context, input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.describeCertificateCalls++
+	if c.describeCertificateErr != nil {
+		return nil, c.describeCertificateErr
+	}
+	return &acm.DescribeCertificateOutput{
+		Certificate: &acmtypes.CertificateDetail{
+			CertificateArn:          input.CertificateArn,
+			SubjectAlternativeNames: c.domainsByCertARN[aws.ToString(input.CertificateArn)],
+			Type:                    acmtypes.CertificateTypeAmazonIssued,
+		},
+	}, nil
+}
+
+func (c *fakeACM) ListTagsForCertificateWithContext(_ context.Context, input *acm.ListTagsForCertificateInput) (*acm.ListTagsForCertificateOutput, error) {
+	var tags []acmtypes.Tag
+	for key, value := range c.tagsByCertARN[aws.ToString(input.CertificateArn)] {
+		tags = append(tags, acmtypes.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return &acm.ListTagsForCertificateOutput{Tags: tags}, nil
+}
+
+func Test_acmCertDiscovery_Discover(t *testing.T) {
+	domainsByCertARN := map[string][]string{
+		"cert-arn-1": {"example.com", "www.example.com"},
+		"cert-arn-2": {"*.example.com"},
+		"cert-arn-3": {"app.example.org"},
+	}
+	tagsByCertARN := map[string]map[string]string{
+		"cert-arn-1": {"team": "awesome", "env": "prod"},
+		"cert-arn-2": {"team": "other"},
+		"cert-arn-3": {"team": "awesome"},
+	}
+	tests := []struct {
+		name                   string
+		tlsHosts               []string
+		certTags               map[string]string
+		describeCertificateErr error
+		want                   []string
+		wantErr                error
+	}{
+		{
+			name:     "discover certificates for multiple hosts",
+			tlsHosts: []string{"example.com", "api.example.com", "app.example.org"},
+			want:     []string{"cert-arn-1", "cert-arn-2", "cert-arn-3"},
+		},
+		{
+			name:     "discover certificates matches multiple certificates",
+			tlsHosts: []string{"www.example.com"},
+			want:     []string{"cert-arn-1", "cert-arn-2"},
+		},
+		{
+			name:     "discover certificates with tags",
+			tlsHosts: []string{"www.example.com", "app.example.org"},
+			certTags: map[string]string{"team": "awesome"},
+			want:     []string{"cert-arn-1", "cert-arn-3"},
+		},
+		{
+			name:     "discover certificates with tag of any value",
+			tlsHosts: []string{"www.example.com"},
+			certTags: map[string]string{"env": "*"},
+			want:     []string{"cert-arn-1"},
+		},
+		{
+			name:     "no certificate with tags found for host",
+			tlsHosts: []string{"api.example.com"},
+			certTags: map[string]string{"team": "awesome"},
+			wantErr:  errors.New("no certificate found for host: api.example.com"),
+		},
+		{
+			name:     "no certificate found for host",
+			tlsHosts: []string{"example.com", "example.net"},
+			wantErr:  errors.New("no certificate found for host: example.net"),
+		},
+		{
+			name:     "no certificate found for multiple hosts",
+			tlsHosts: []string{"example.net", "example.com", "app.example.net"},
+			wantErr:  errors.New("no certificate found for hosts: example.net, app.example.net"),
+		},
+		{
+			name:                   "failed to describe certificate",
+			tlsHosts:               []string{"example.com"},
+			describeCertificateErr: errors.New("some error"),
+			wantErr:                errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acmClient := &fakeACM{
+				domainsByCertARN:       domainsByCertARN,
+				tagsByCertARN:          tagsByCertARN,
+				describeCertificateErr: tt.describeCertificateErr,
+			}
+			metrics, err := NewCertDiscoveryMetrics(prometheus.NewRegistry())
+			assert.NoError(t, err)
+			d := NewACMCertDiscovery(acmClient, tt.certTags, metrics, logr.New(&log.NullLogSink{}))
+			got, err := d.Discover(context.Background(), tt.tlsHosts)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_acmCertDiscovery_Discover_cachesCertificates(t *testing.T) {
+	acmClient := &fakeACM{
+		domainsByCertARN: map[string][]string{
+			"cert-arn-1": {"example.com"},
+			"cert-arn-2": {"*.example.com"},
+		},
+	}
+	metrics, err := NewCertDiscoveryMetrics(prometheus.NewRegistry())
+	assert.NoError(t, err)
+	d := NewACMCertDiscovery(acmClient, nil, metrics, logr.New(&log.NullLogSink{}))
+	for i := 0; i < 3; i++ {
+		got, err := d.Discover(context.Background(), []string{"example.com", "www.example.com"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"cert-arn-1", "cert-arn-2"}, got)
+	}
+	assert.Equal(t, 1, acmClient.listCertificatesCalls)
+	assert.Equal(t, 2, acmClient.describeCertificateCalls)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.acmCalls.WithLabelValues("ListCertificates")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.acmCalls.WithLabelValues("DescribeCertificate")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.cacheLookups.WithLabelValues(cacheCertARNs, cacheResultHit)))
+	assert.Equal(t, float64(4), testutil.ToFloat64(metrics.cacheLookups.WithLabelValues(cacheCertDomains, cacheResultHit)))
+}
+
+func Test_acmCertDiscovery_Discover_recordsUsage(t *testing.T) {
+	acmClient := &fakeACM{
+		domainsByCertARN: map[string][]string{
+			"cert-arn-1": {"example.com"},
+		},
+	}
+	d := NewACMCertDiscovery(acmClient, nil, nil, logr.New(&log.NullLogSink{}))
+	ctx := ContextWithCertDiscoveryUsage(context.Background())
+	assert.False(t, CertDiscoveryUsed(ctx))
+	_, err := d.Discover(ctx, []string{"example.com"})
+	assert.NoError(t, err)
+	assert.True(t, CertDiscoveryUsed(ctx))
+	assert.False(t, CertDiscoveryUsed(context.Background()))
+}
+
 func Test_acmCertDiscovery_domainMatchesHost(t *testing.T) {
 	type args struct {
 		domainName string
@@ -0,0 +1,270 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	// CertValidationPollInterval is the interval to check whether requested certificates got issued.
+	CertValidationPollInterval = 1 * time.Minute
+	// the tag key on requested certificates for the cluster requesting them.
+	certRequestClusterTagKey = "elbv2.k8s.aws/cluster"
+	// the TTL of DNS validation records.
+	certValidationRecordTTL = 300
+)
+
+// CertRequest is an ACM certificate requested for tls hosts.
+type CertRequest struct {
+	CertARN string
+	Hosts   []string
+	// Issued is whether the certificate is issued, it's pending DNS validation otherwise.
+	Issued bool
+}
+
+// CertRequester is responsible for requesting DNS validated ACM certificates for tls hosts without certificate.
+type CertRequester interface {
+	// Request ensures an ACM certificate is requested for tls hosts along with its DNS validation records.
+	Request(ctx context.Context, tlsHosts []string) (CertRequest, error)
+}
+
+type certRequestPendingContextKey struct{}
+
+// ContextWithCertRequestTracking returns a context that records whether requested certificates are pending validation within it.
+func ContextWithCertRequestTracking(ctx context.Context) context.Context {
+	return context.WithValue(ctx, certRequestPendingContextKey{}, &atomic.Bool{})
+}
+
+// CertRequestPending returns whether requested certificates are pending validation within context from ContextWithCertRequestTracking.
+func CertRequestPending(ctx context.Context) bool {
+	pending, ok := ctx.Value(certRequestPendingContextKey{}).(*atomic.Bool)
+	return ok && pending.Load()
+}
+
+// NewACMCertRequester constructs new acmCertRequester.
+// The DNS validation records are created in hosted zone of hostedZoneID, which must contain all requested tls hosts.
+func NewACMCertRequester(acmClient services.ACM, route53Client services.Route53, hostedZoneID string, clusterName string, logger logr.Logger) *acmCertRequester {
+	return &acmCertRequester{
+		acmClient:             acmClient,
+		route53Client:         route53Client,
+		hostedZoneID:          hostedZoneID,
+		clusterName:           clusterName,
+		logger:                logger,
+		certARNByHosts:        make(map[string]string),
+		validatedCertARNs:     sets.NewString(),
+		requestCertificateMux: sync.Mutex{},
+	}
+}
+
+var _ CertRequester = &acmCertRequester{}
+
+// CertRequester implementation for ACM certificates validated via Route53 records.
+type acmCertRequester struct {
+	acmClient     services.ACM
+	route53Client services.Route53
+	hostedZoneID  string
+	clusterName   string
+	logger        logr.Logger
+
+	// requestCertificateMux protects below fields, and serializes the certificate requests.
+	requestCertificateMux sync.Mutex
+	// hostedZoneName is the domain name of hosted zone without trailing dot, loaded on first request.
+	hostedZoneName string
+	// certARNByHosts caches the requested certificates by the joined tls hosts.
+	certARNByHosts map[string]string
+	// validatedCertARNs are the requested certificates with DNS validation records created.
+	validatedCertARNs sets.String
+}
+
+func (r *acmCertRequester) Request(ctx context.Context, tlsHosts []string) (CertRequest, error) {
+	hosts := sets.NewString(tlsHosts...).List()
+	if len(hosts) == 0 {
+		return CertRequest{}, errors.New("no tls hosts to request certificate for")
+	}
+	r.requestCertificateMux.Lock()
+	defer r.requestCertificateMux.Unlock()
+
+	if err := r.validateHostsInHostedZone(ctx, hosts); err != nil {
+		return CertRequest{}, err
+	}
+	hostsKey := strings.Join(hosts, ",")
+	certARN, ok := r.certARNByHosts[hostsKey]
+	if !ok {
+		var err error
+		if certARN, err = r.findRequestedCertificate(ctx, hosts); err != nil {
+			return CertRequest{}, err
+		}
+		if certARN == "" {
+			if certARN, err = r.requestCertificate(ctx, hosts); err != nil {
+				return CertRequest{}, err
+			}
+		}
+		r.certARNByHosts[hostsKey] = certARN
+	}
+
+	resp, err := r.acmClient.DescribeCertificateWithContext(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(certARN),
+	})
+	if err != nil {
+		return CertRequest{}, err
+	}
+	certDetail := resp.Certificate
+	switch status := certDetail.Status; status {
+	case acmtypes.CertificateStatusIssued:
+		return CertRequest{CertARN: certARN, Hosts: hosts, Issued: true}, nil
+	case acmtypes.CertificateStatusPendingValidation:
+		if err := r.ensureValidationRecords(ctx, certDetail); err != nil {
+			return CertRequest{}, err
+		}
+		return CertRequest{CertARN: certARN, Hosts: hosts, Issued: false}, nil
+	default:
+		// the certificate cannot be issued anymore, a new one is requested on next request.
+		delete(r.certARNByHosts, hostsKey)
+		return CertRequest{}, errors.Errorf("certificate %v requested for hosts %v is %v", certARN, hosts, status)
+	}
+}
+
+// validateHostsInHostedZone checks the DNS validation records for all hosts can be created in the hosted zone.
+func (r *acmCertRequester) validateHostsInHostedZone(ctx context.Context, hosts []string) error {
+	if r.hostedZoneName == "" {
+		resp, err := r.route53Client.GetHostedZoneWithContext(ctx, &route53.GetHostedZoneInput{
+			Id: aws.String(r.hostedZoneID),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get hosted zone %v", r.hostedZoneID)
+		}
+		r.hostedZoneName = strings.TrimSuffix(aws.ToString(resp.HostedZone.Name), ".")
+	}
+	for _, host := range hosts {
+		if host != r.hostedZoneName && !strings.HasSuffix(host, "."+r.hostedZoneName) {
+			return errors.Errorf("host %v is outside of hosted zone %v for certificate requests", host, r.hostedZoneName)
+		}
+	}
+	return nil
+}
+
+// findRequestedCertificate returns the certificate requested for exactly the hosts by this cluster, it's empty if not found.
+// this avoids requesting duplicate certificates once the requested ones are no longer cached.
+func (r *acmCertRequester) findRequestedCertificate(ctx context.Context, hosts []string) (string, error) {
+	certSummaries, err := r.acmClient.ListCertificatesAsList(ctx, &acm.ListCertificatesInput{
+		CertificateStatuses: []acmtypes.CertificateStatus{acmtypes.CertificateStatusPendingValidation, acmtypes.CertificateStatusIssued},
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, certSummary := range certSummaries {
+		if aws.ToString(certSummary.DomainName) != hosts[0] {
+			continue
+		}
+		if !sets.NewString(certSummary.SubjectAlternativeNameSummaries...).Equal(sets.NewString(hosts...)) {
+			continue
+		}
+		certARN := aws.ToString(certSummary.CertificateArn)
+		resp, err := r.acmClient.ListTagsForCertificateWithContext(ctx, &acm.ListTagsForCertificateInput{
+			CertificateArn: aws.String(certARN),
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, tag := range resp.Tags {
+			if aws.ToString(tag.Key) == certRequestClusterTagKey && aws.ToString(tag.Value) == r.clusterName {
+				return certARN, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func (r *acmCertRequester) requestCertificate(ctx context.Context, hosts []string) (string, error) {
+	req := &acm.RequestCertificateInput{
+		DomainName:       aws.String(hosts[0]),
+		ValidationMethod: acmtypes.ValidationMethodDns,
+		IdempotencyToken: aws.String(r.buildIdempotencyToken(hosts)),
+		Tags: []acmtypes.Tag{
+			{
+				Key:   aws.String(certRequestClusterTagKey),
+				Value: aws.String(r.clusterName),
+			},
+		},
+	}
+	if len(hosts) > 1 {
+		req.SubjectAlternativeNames = hosts
+	}
+	r.logger.Info("requesting certificate", "hosts", hosts)
+	resp, err := r.acmClient.RequestCertificateWithContext(ctx, req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to request certificate for hosts %v", hosts)
+	}
+	certARN := aws.ToString(resp.CertificateArn)
+	r.logger.Info("requested certificate", "hosts", hosts, "arn", certARN)
+	return certARN, nil
+}
+
+// ensureValidationRecords creates the DNS validation records of certificate, once the records are known by ACM.
+func (r *acmCertRequester) ensureValidationRecords(ctx context.Context, certDetail *acmtypes.CertificateDetail) error {
+	certARN := aws.ToString(certDetail.CertificateArn)
+	if r.validatedCertARNs.Has(certARN) {
+		return nil
+	}
+	var changes []route53types.Change
+	recordNames := sets.NewString()
+	for _, validationOption := range certDetail.DomainValidationOptions {
+		record := validationOption.ResourceRecord
+		// the validation records are assigned asynchronously after the certificate is requested.
+		if record == nil {
+			return nil
+		}
+		// the validation record of wildcard domain is shared with its base domain.
+		if recordNames.Has(aws.ToString(record.Name)) {
+			continue
+		}
+		recordNames.Insert(aws.ToString(record.Name))
+		changes = append(changes, route53types.Change{
+			Action: route53types.ChangeActionUpsert,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name:            record.Name,
+				Type:            route53types.RRType(record.Type),
+				TTL:             aws.Int64(certValidationRecordTTL),
+				ResourceRecords: []route53types.ResourceRecord{{Value: record.Value}},
+			},
+		})
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	if _, err := r.route53Client.ChangeResourceRecordSetsWithContext(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(r.hostedZoneID),
+		ChangeBatch: &route53types.ChangeBatch{
+			Comment: aws.String("DNS validation of certificate " + certARN),
+			Changes: changes,
+		},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create DNS validation records for certificate %v", certARN)
+	}
+	r.logger.Info("created DNS validation records", "arn", certARN, "records", recordNames.List())
+	r.validatedCertARNs.Insert(certARN)
+	return nil
+}
+
+// buildIdempotencyToken builds the token identifying the certificate request for hosts by this cluster,
+// so that retried requests don't result in duplicate certificates.
+func (r *acmCertRequester) buildIdempotencyToken(hosts []string) string {
+	hash := sha256.Sum256([]byte(r.clusterName + "/" + strings.Join(hosts, ",")))
+	// ACM accepts tokens of up to 32 word characters.
+	return hex.EncodeToString(hash[:])[:32]
+}
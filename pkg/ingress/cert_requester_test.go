@@ -0,0 +1,239 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeRequestACM serves the requested certificates from memory.
+type fakeRequestACM struct {
+	services.ACM
+
+	certSummaries  []acmtypes.CertificateSummary
+	tagsByCertARN  map[string]map[string]string
+	certDetails    map[string]*acmtypes.CertificateDetail
+	requestedCerts []*acm.RequestCertificateInput
+}
+
+func (c *fakeRequestACM) ListCertificatesAsList(_ context.Context, _ *acm.ListCertificatesInput) ([]acmtypes.CertificateSummary, error) {
+	return c.certSummaries, nil
+}
+
+func (c *fakeRequestACM) ListTagsForCertificateWithContext(_ context.Context, input *acm.ListTagsForCertificateInput) (*acm.ListTagsForCertificateOutput, error) {
+	var tags []acmtypes.Tag
+	for key, value := range c.tagsByCertARN[aws.ToString(input.CertificateArn)] {
+		tags = append(tags, acmtypes.Tag{Key: aws.String(key), Value: aws.String(value)})
+	}
+	return &acm.ListTagsForCertificateOutput{Tags: tags}, nil
+}
+
+func (c *fakeRequestACM) RequestCertificateWithContext(_ context.Context, input *acm.RequestCertificateInput) (*acm.RequestCertificateOutput, error) {
+	c.requestedCerts = append(c.requestedCerts, input)
+	return &acm.RequestCertificateOutput{CertificateArn: aws.String("arn:aws:acm:us-west-2:123456789012:certificate/requested")}, nil
+}
+
+func (c *fakeRequestACM) DescribeCertificateWithContext(_ context.Context, input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+	certDetail, ok := c.certDetails[aws.ToString(input.CertificateArn)]
+	if !ok {
+		return nil, errors.New("certificate not found")
+	}
+	return &acm.DescribeCertificateOutput{Certificate: certDetail}, nil
+}
+
+func Test_acmCertRequester_Request(t *testing.T) {
+	requestedCertARN := "arn:aws:acm:us-west-2:123456789012:certificate/requested"
+	existingCertARN := "arn:aws:acm:us-west-2:123456789012:certificate/existing"
+	pendingCertDetail := func(certARN string) *acmtypes.CertificateDetail {
+		return &acmtypes.CertificateDetail{
+			CertificateArn: aws.String(certARN),
+			Status:         acmtypes.CertificateStatusPendingValidation,
+			DomainValidationOptions: []acmtypes.DomainValidation{
+				{
+					DomainName: aws.String("*.app.example.com"),
+					ResourceRecord: &acmtypes.ResourceRecord{
+						Name:  aws.String("_x1.app.example.com."),
+						Type:  acmtypes.RecordTypeCname,
+						Value: aws.String("_y1.acm-validations.aws."),
+					},
+				},
+				{
+					DomainName: aws.String("app.example.com"),
+					ResourceRecord: &acmtypes.ResourceRecord{
+						Name:  aws.String("_x1.app.example.com."),
+						Type:  acmtypes.RecordTypeCname,
+						Value: aws.String("_y1.acm-validations.aws."),
+					},
+				},
+			},
+		}
+	}
+	validationRecordsChange := &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String("Z0123456789"),
+		ChangeBatch: &route53types.ChangeBatch{
+			Comment: aws.String("DNS validation of certificate " + requestedCertARN),
+			Changes: []route53types.Change{
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("_x1.app.example.com."),
+						Type:            route53types.RRTypeCname,
+						TTL:             aws.Int64(300),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("_y1.acm-validations.aws.")}},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                   string
+		tlsHosts               []string
+		acmClient              *fakeRequestACM
+		wantValidationsChange  *route53.ChangeResourceRecordSetsInput
+		wantRequestedCertHosts [][]string
+		want                   CertRequest
+		wantErr                error
+	}{
+		{
+			name:     "certificate is requested and validated",
+			tlsHosts: []string{"app.example.com", "*.app.example.com"},
+			acmClient: &fakeRequestACM{
+				certDetails: map[string]*acmtypes.CertificateDetail{
+					requestedCertARN: pendingCertDetail(requestedCertARN),
+				},
+			},
+			wantValidationsChange:  validationRecordsChange,
+			wantRequestedCertHosts: [][]string{{"*.app.example.com", "app.example.com"}},
+			want: CertRequest{
+				CertARN: requestedCertARN,
+				Hosts:   []string{"*.app.example.com", "app.example.com"},
+				Issued:  false,
+			},
+		},
+		{
+			name:     "certificate requested by cluster is found",
+			tlsHosts: []string{"app.example.com"},
+			acmClient: &fakeRequestACM{
+				certSummaries: []acmtypes.CertificateSummary{
+					{
+						CertificateArn:                  aws.String("arn:aws:acm:us-west-2:123456789012:certificate/other-cluster"),
+						DomainName:                      aws.String("app.example.com"),
+						SubjectAlternativeNameSummaries: []string{"app.example.com"},
+					},
+					{
+						CertificateArn:                  aws.String(existingCertARN),
+						DomainName:                      aws.String("app.example.com"),
+						SubjectAlternativeNameSummaries: []string{"app.example.com"},
+					},
+				},
+				tagsByCertARN: map[string]map[string]string{
+					"arn:aws:acm:us-west-2:123456789012:certificate/other-cluster": {"elbv2.k8s.aws/cluster": "other-cluster"},
+					existingCertARN: {"elbv2.k8s.aws/cluster": "cluster-name"},
+				},
+				certDetails: map[string]*acmtypes.CertificateDetail{
+					existingCertARN: {
+						CertificateArn: aws.String(existingCertARN),
+						Status:         acmtypes.CertificateStatusIssued,
+					},
+				},
+			},
+			want: CertRequest{
+				CertARN: existingCertARN,
+				Hosts:   []string{"app.example.com"},
+				Issued:  true,
+			},
+		},
+		{
+			name:     "validation records aren't assigned yet",
+			tlsHosts: []string{"app.example.com"},
+			acmClient: &fakeRequestACM{
+				certDetails: map[string]*acmtypes.CertificateDetail{
+					requestedCertARN: {
+						CertificateArn: aws.String(requestedCertARN),
+						Status:         acmtypes.CertificateStatusPendingValidation,
+						DomainValidationOptions: []acmtypes.DomainValidation{
+							{DomainName: aws.String("app.example.com")},
+						},
+					},
+				},
+			},
+			wantRequestedCertHosts: [][]string{{"app.example.com"}},
+			want: CertRequest{
+				CertARN: requestedCertARN,
+				Hosts:   []string{"app.example.com"},
+				Issued:  false,
+			},
+		},
+		{
+			name:     "requested certificate failed",
+			tlsHosts: []string{"app.example.com"},
+			acmClient: &fakeRequestACM{
+				certDetails: map[string]*acmtypes.CertificateDetail{
+					requestedCertARN: {
+						CertificateArn: aws.String(requestedCertARN),
+						Status:         acmtypes.CertificateStatusFailed,
+					},
+				},
+			},
+			// failed certificates are requested again.
+			wantRequestedCertHosts: [][]string{{"app.example.com"}, {"app.example.com"}},
+			wantErr:                errors.New("certificate arn:aws:acm:us-west-2:123456789012:certificate/requested requested for hosts [app.example.com] is FAILED"),
+		},
+		{
+			name:      "host outside of hosted zone",
+			tlsHosts:  []string{"app.example.com", "app.example.net"},
+			acmClient: &fakeRequestACM{},
+			wantErr:   errors.New("host app.example.net is outside of hosted zone example.com for certificate requests"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			route53Client := services.NewMockRoute53(ctrl)
+			route53Client.EXPECT().GetHostedZoneWithContext(gomock.Any(), &route53.GetHostedZoneInput{
+				Id: aws.String("Z0123456789"),
+			}).Return(&route53.GetHostedZoneOutput{
+				HostedZone: &route53types.HostedZone{Name: aws.String("example.com.")},
+			}, nil)
+			if tt.wantValidationsChange != nil {
+				route53Client.EXPECT().ChangeResourceRecordSetsWithContext(gomock.Any(), tt.wantValidationsChange).
+					Return(&route53.ChangeResourceRecordSetsOutput{}, nil)
+			}
+			r := NewACMCertRequester(tt.acmClient, route53Client, "Z0123456789", "cluster-name", logr.New(&log.NullLogSink{}))
+			// the validation records are only created once for the same certificate.
+			for i := 0; i < 2; i++ {
+				got, err := r.Request(context.Background(), tt.tlsHosts)
+				if tt.wantErr != nil {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tt.want, got)
+				}
+			}
+			var gotRequestedCertHosts [][]string
+			for _, req := range tt.acmClient.requestedCerts {
+				hosts := []string{aws.ToString(req.DomainName)}
+				if len(req.SubjectAlternativeNames) != 0 {
+					hosts = req.SubjectAlternativeNames
+				}
+				assert.Equal(t, acmtypes.ValidationMethodDns, req.ValidationMethod)
+				gotRequestedCertHosts = append(gotRequestedCertHosts, hosts)
+			}
+			assert.Equal(t, tt.wantRequestedCertHosts, gotRequestedCertHosts)
+		})
+	}
+}
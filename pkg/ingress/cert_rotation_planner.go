@@ -0,0 +1,236 @@
+package ingress
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	certSummariesCacheKey = "certSummaries"
+	// the certificate summaries in AWS account will be cached for 1 minute.
+	defaultCertSummariesCacheTTL = 1 * time.Minute
+)
+
+// CertRotationStage is the stage of rotating a listener certificate that expires within the leadtime.
+type CertRotationStage string
+
+const (
+	// CertRotationStageStandby means the replacement certificate is attached as non-default certificate besides the expiring one.
+	CertRotationStageStandby CertRotationStage = "Standby"
+	// CertRotationStagePromoted means the replacement certificate takes the place of the expiring one.
+	CertRotationStagePromoted CertRotationStage = "Promoted"
+)
+
+// CertRotation is the rotation of an expiring listener certificate.
+type CertRotation struct {
+	// CertARN is the ARN of the expiring certificate.
+	CertARN string
+	// ReplacementCertARN is the ARN of the certificate covering all domains of the expiring certificate.
+	ReplacementCertARN string
+	// NotAfter is the expiry of the expiring certificate.
+	NotAfter time.Time
+	Stage    CertRotationStage
+}
+
+// CertRotationPlanner is responsible for planning the rotation of listener certificates ahead of their expiry.
+type CertRotationPlanner interface {
+	// Plan returns the rotations for the certificateARNs expiring within the leadtime that have a replacement certificate.
+	Plan(ctx context.Context, certARNs []string) ([]CertRotation, error)
+}
+
+// NewACMCertRotationPlanner constructs new acmCertRotationPlanner.
+// Certificates expiring within leadtime get their replacement attached as standby, and promoted once they expire within half the leadtime.
+func NewACMCertRotationPlanner(acmClient services.ACM, leadtime time.Duration, logger logr.Logger) *acmCertRotationPlanner {
+	return &acmCertRotationPlanner{
+		acmClient:             acmClient,
+		leadtime:              leadtime,
+		logger:                logger,
+		now:                   time.Now,
+		certSummariesCache:    cache.NewExpiring(),
+		certSummariesCacheTTL: defaultCertSummariesCacheTTL,
+		certDomainsCache:      cache.NewExpiring(),
+		certDomainsCacheTTL:   defaultImportedCertDomainsCacheTTL,
+	}
+}
+
+var _ CertRotationPlanner = &acmCertRotationPlanner{}
+
+// CertRotationPlanner implementation for ACM certificates.
+type acmCertRotationPlanner struct {
+	acmClient services.ACM
+	leadtime  time.Duration
+	logger    logr.Logger
+	now       func() time.Time
+
+	certSummariesCache    *cache.Expiring
+	certSummariesCacheTTL time.Duration
+	certDomainsCache      *cache.Expiring
+	certDomainsCacheTTL   time.Duration
+}
+
+func (p *acmCertRotationPlanner) Plan(ctx context.Context, certARNs []string) ([]CertRotation, error) {
+	certSummaries, err := p.loadCertificateSummaries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	certSummaryByARN := make(map[string]*acmtypes.CertificateSummary, len(certSummaries))
+	for i := range certSummaries {
+		certSummaryByARN[aws.ToString(certSummaries[i].CertificateArn)] = &certSummaries[i]
+	}
+
+	now := p.now()
+	var rotations []CertRotation
+	for _, certARN := range certARNs {
+		certSummary, ok := certSummaryByARN[certARN]
+		// certificates not managed by ACM of this account, or without expiry, cannot be rotated.
+		if !ok || certSummary.NotAfter == nil {
+			continue
+		}
+		notAfter := aws.ToTime(certSummary.NotAfter)
+		if now.Before(notAfter.Add(-p.leadtime)) {
+			continue
+		}
+		replacementCertARN, err := p.findReplacementCertificate(ctx, certSummary, certSummaries, now)
+		if err != nil {
+			return nil, err
+		}
+		if replacementCertARN == "" {
+			p.logger.Info("no replacement found for expiring certificate", "certARN", certARN, "notAfter", notAfter)
+			continue
+		}
+		stage := CertRotationStageStandby
+		if !now.Before(notAfter.Add(-p.leadtime / 2)) {
+			stage = CertRotationStagePromoted
+		}
+		rotations = append(rotations, CertRotation{
+			CertARN:            certARN,
+			ReplacementCertARN: replacementCertARN,
+			NotAfter:           notAfter,
+			Stage:              stage,
+		})
+	}
+	return rotations, nil
+}
+
+// findReplacementCertificate returns the issued certificate covering all domains of the expiring certificate,
+// which doesn't expire within the leadtime itself. The one expiring last is preferred, empty if there is none.
+func (p *acmCertRotationPlanner) findReplacementCertificate(ctx context.Context, expiringCertSummary *acmtypes.CertificateSummary,
+	certSummaries []acmtypes.CertificateSummary, now time.Time) (string, error) {
+	expiringCertDomains, err := p.loadDomainsForCertificate(ctx, expiringCertSummary)
+	if err != nil {
+		return "", err
+	}
+	var candidates []*acmtypes.CertificateSummary
+	for i := range certSummaries {
+		certSummary := &certSummaries[i]
+		if aws.ToString(certSummary.CertificateArn) == aws.ToString(expiringCertSummary.CertificateArn) ||
+			certSummary.Status != acmtypes.CertificateStatusIssued ||
+			certSummary.NotAfter == nil ||
+			!now.Before(aws.ToTime(certSummary.NotAfter).Add(-p.leadtime)) {
+			continue
+		}
+		candidates = append(candidates, certSummary)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		notAfterI, notAfterJ := aws.ToTime(candidates[i].NotAfter), aws.ToTime(candidates[j].NotAfter)
+		if !notAfterI.Equal(notAfterJ) {
+			return notAfterI.After(notAfterJ)
+		}
+		return aws.ToString(candidates[i].CertificateArn) < aws.ToString(candidates[j].CertificateArn)
+	})
+	for _, candidate := range candidates {
+		candidateDomains, err := p.loadDomainsForCertificate(ctx, candidate)
+		if err != nil {
+			return "", err
+		}
+		if candidateDomains.IsSuperset(expiringCertDomains) {
+			return aws.ToString(candidate.CertificateArn), nil
+		}
+	}
+	return "", nil
+}
+
+func (p *acmCertRotationPlanner) loadCertificateSummaries(ctx context.Context) ([]acmtypes.CertificateSummary, error) {
+	if rawCacheItem, ok := p.certSummariesCache.Get(certSummariesCacheKey); ok {
+		return rawCacheItem.([]acmtypes.CertificateSummary), nil
+	}
+	req := &acm.ListCertificatesInput{
+		CertificateStatuses: []acmtypes.CertificateStatus{acmtypes.CertificateStatusIssued, acmtypes.CertificateStatusExpired},
+		Includes: &acmtypes.Filters{
+			KeyTypes: acmtypes.KeyAlgorithm("").Values(),
+		},
+	}
+	certSummaries, err := p.acmClient.ListCertificatesAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	p.certSummariesCache.Set(certSummariesCacheKey, certSummaries, p.certSummariesCacheTTL)
+	return certSummaries, nil
+}
+
+// loadDomainsForCertificate returns the subject alternative names of certificate,
+// they're only described if the summary doesn't contain all of them.
+func (p *acmCertRotationPlanner) loadDomainsForCertificate(ctx context.Context, certSummary *acmtypes.CertificateSummary) (sets.String, error) {
+	if !aws.ToBool(certSummary.HasAdditionalSubjectAlternativeNames) {
+		return sets.NewString(certSummary.SubjectAlternativeNameSummaries...), nil
+	}
+	certARN := aws.ToString(certSummary.CertificateArn)
+	if rawCacheItem, ok := p.certDomainsCache.Get(certARN); ok {
+		return rawCacheItem.(sets.String), nil
+	}
+	resp, err := p.acmClient.DescribeCertificateWithContext(ctx, &acm.DescribeCertificateInput{
+		CertificateArn: aws.String(certARN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	domains := sets.NewString(resp.Certificate.SubjectAlternativeNames...)
+	p.certDomainsCache.Set(certARN, domains, p.certDomainsCacheTTL)
+	return domains, nil
+}
+
+// applyCertRotations returns the certificateARNs of a listener with the rotations applied.
+// Replacement certificates in standby are appended as non-default certificates,
+// and promoted ones take the place of the expiring certificates, so that they become default in place of a default one.
+func applyCertRotations(certARNs []string, rotations []CertRotation) []string {
+	rotationByCertARN := make(map[string]CertRotation, len(rotations))
+	for _, rotation := range rotations {
+		rotationByCertARN[rotation.CertARN] = rotation
+	}
+	var standbyCertARNs []string
+	rotatedCertARNs := make([]string, 0, len(certARNs))
+	for _, certARN := range certARNs {
+		rotation, ok := rotationByCertARN[certARN]
+		switch {
+		case !ok:
+			rotatedCertARNs = append(rotatedCertARNs, certARN)
+		case rotation.Stage == CertRotationStagePromoted:
+			rotatedCertARNs = append(rotatedCertARNs, rotation.ReplacementCertARN)
+		default:
+			rotatedCertARNs = append(rotatedCertARNs, certARN)
+			standbyCertARNs = append(standbyCertARNs, rotation.ReplacementCertARN)
+		}
+	}
+	rotatedCertARNs = append(rotatedCertARNs, standbyCertARNs...)
+
+	// the replacement certificates might be attached already, e.g. if they got auto-discovered.
+	seenCertARNs := sets.NewString()
+	dedupedCertARNs := make([]string, 0, len(rotatedCertARNs))
+	for _, certARN := range rotatedCertARNs {
+		if seenCertARNs.Has(certARN) {
+			continue
+		}
+		seenCertARNs.Insert(certARN)
+		dedupedCertARNs = append(dedupedCertARNs, certARN)
+	}
+	return dedupedCertARNs
+}
@@ -0,0 +1,199 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acm"
+	acmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeRotationACM serves certificate summaries from memory.
+type fakeRotationACM struct {
+	services.ACM
+
+	certSummaries    []acmtypes.CertificateSummary
+	domainsByCertARN map[string][]string
+}
+
+func (c *fakeRotationACM) ListCertificatesAsList(_ context.Context, _ *acm.ListCertificatesInput) ([]acmtypes.CertificateSummary, error) {
+	return c.certSummaries, nil
+}
+
+func (c *fakeRotationACM) DescribeCertificateWithContext(_ context.Context, input *acm.DescribeCertificateInput) (*acm.DescribeCertificateOutput, error) {
+	return &acm.DescribeCertificateOutput{
+		Certificate: &acmtypes.CertificateDetail{
+			CertificateArn:          input.CertificateArn,
+			SubjectAlternativeNames: c.domainsByCertARN[aws.ToString(input.CertificateArn)],
+		},
+	}, nil
+}
+
+func Test_acmCertRotationPlanner_Plan(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	certSummary := func(certARN string, status acmtypes.CertificateStatus, notAfter time.Time, domains ...string) acmtypes.CertificateSummary {
+		return acmtypes.CertificateSummary{
+			CertificateArn:                  aws.String(certARN),
+			Status:                          status,
+			NotAfter:                        aws.Time(notAfter),
+			SubjectAlternativeNameSummaries: domains,
+		}
+	}
+	tests := []struct {
+		name             string
+		certSummaries    []acmtypes.CertificateSummary
+		domainsByCertARN map[string][]string
+		certARNs         []string
+		want             []CertRotation
+	}{
+		{
+			name: "certificate not expiring within leadtime",
+			certSummaries: []acmtypes.CertificateSummary{
+				certSummary("arn-old", acmtypes.CertificateStatusIssued, now.Add(20*day), "example.com"),
+				certSummary("arn-new", acmtypes.CertificateStatusIssued, now.Add(400*day), "example.com"),
+			},
+			certARNs: []string{"arn-old"},
+		},
+		{
+			name: "replacement attached as standby within leadtime",
+			certSummaries: []acmtypes.CertificateSummary{
+				certSummary("arn-old", acmtypes.CertificateStatusIssued, now.Add(10*day), "example.com", "www.example.com"),
+				certSummary("arn-new", acmtypes.CertificateStatusIssued, now.Add(400*day), "example.com", "www.example.com", "api.example.com"),
+			},
+			certARNs: []string{"arn-old"},
+			want: []CertRotation{
+				{CertARN: "arn-old", ReplacementCertARN: "arn-new", NotAfter: now.Add(10 * day), Stage: CertRotationStageStandby},
+			},
+		},
+		{
+			name: "replacement promoted within half the leadtime",
+			certSummaries: []acmtypes.CertificateSummary{
+				certSummary("arn-old", acmtypes.CertificateStatusIssued, now.Add(5*day), "example.com"),
+				certSummary("arn-new", acmtypes.CertificateStatusIssued, now.Add(400*day), "example.com"),
+			},
+			certARNs: []string{"arn-old"},
+			want: []CertRotation{
+				{CertARN: "arn-old", ReplacementCertARN: "arn-new", NotAfter: now.Add(5 * day), Stage: CertRotationStagePromoted},
+			},
+		},
+		{
+			name: "expired certificate is replaced",
+			certSummaries: []acmtypes.CertificateSummary{
+				certSummary("arn-old", acmtypes.CertificateStatusExpired, now.Add(-day), "example.com"),
+				certSummary("arn-new", acmtypes.CertificateStatusIssued, now.Add(400*day), "example.com"),
+			},
+			certARNs: []string{"arn-old"},
+			want: []CertRotation{
+				{CertARN: "arn-old", ReplacementCertARN: "arn-new", NotAfter: now.Add(-day), Stage: CertRotationStagePromoted},
+			},
+		},
+		{
+			name: "replacement must cover all domains, not expire within leadtime and be issued",
+			certSummaries: []acmtypes.CertificateSummary{
+				certSummary("arn-old", acmtypes.CertificateStatusIssued, now.Add(10*day), "example.com", "www.example.com"),
+				certSummary("arn-partial", acmtypes.CertificateStatusIssued, now.Add(400*day), "example.com"),
+				certSummary("arn-expiring", acmtypes.CertificateStatusIssued, now.Add(12*day), "example.com", "www.example.com"),
+				certSummary("arn-expired", acmtypes.CertificateStatusExpired, now.Add(-day), "example.com", "www.example.com"),
+			},
+			certARNs: []string{"arn-old"},
+		},
+		{
+			name: "replacement expiring last is preferred",
+			certSummaries: []acmtypes.CertificateSummary{
+				certSummary("arn-old", acmtypes.CertificateStatusIssued, now.Add(10*day), "example.com"),
+				certSummary("arn-new-a", acmtypes.CertificateStatusIssued, now.Add(300*day), "example.com"),
+				certSummary("arn-new-b", acmtypes.CertificateStatusIssued, now.Add(400*day), "example.com"),
+			},
+			certARNs: []string{"arn-old"},
+			want: []CertRotation{
+				{CertARN: "arn-old", ReplacementCertARN: "arn-new-b", NotAfter: now.Add(10 * day), Stage: CertRotationStageStandby},
+			},
+		},
+		{
+			name: "domains beyond summary are described",
+			certSummaries: []acmtypes.CertificateSummary{
+				certSummary("arn-old", acmtypes.CertificateStatusIssued, now.Add(10*day), "example.com"),
+				func() acmtypes.CertificateSummary {
+					summary := certSummary("arn-new", acmtypes.CertificateStatusIssued, now.Add(400*day), "other.com")
+					summary.HasAdditionalSubjectAlternativeNames = aws.Bool(true)
+					return summary
+				}(),
+			},
+			domainsByCertARN: map[string][]string{
+				"arn-new": {"other.com", "example.com"},
+			},
+			certARNs: []string{"arn-old"},
+			want: []CertRotation{
+				{CertARN: "arn-old", ReplacementCertARN: "arn-new", NotAfter: now.Add(10 * day), Stage: CertRotationStageStandby},
+			},
+		},
+		{
+			name: "certificates unknown to ACM are ignored",
+			certSummaries: []acmtypes.CertificateSummary{
+				certSummary("arn-new", acmtypes.CertificateStatusIssued, now.Add(400*day), "example.com"),
+			},
+			certARNs: []string{"arn:aws:iam::123456789012:server-certificate/old"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acmClient := &fakeRotationACM{certSummaries: tt.certSummaries, domainsByCertARN: tt.domainsByCertARN}
+			p := NewACMCertRotationPlanner(acmClient, 14*day, logr.New(&log.NullLogSink{}))
+			p.now = func() time.Time { return now }
+			got, err := p.Plan(context.Background(), tt.certARNs)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_applyCertRotations(t *testing.T) {
+	tests := []struct {
+		name      string
+		certARNs  []string
+		rotations []CertRotation
+		want      []string
+	}{
+		{
+			name:     "no rotations",
+			certARNs: []string{"arn-a", "arn-b"},
+			want:     []string{"arn-a", "arn-b"},
+		},
+		{
+			name:     "standby replacement of default certificate is appended",
+			certARNs: []string{"arn-a", "arn-b"},
+			rotations: []CertRotation{
+				{CertARN: "arn-a", ReplacementCertARN: "arn-c", Stage: CertRotationStageStandby},
+			},
+			want: []string{"arn-a", "arn-b", "arn-c"},
+		},
+		{
+			name:     "promoted replacement of default certificate becomes default",
+			certARNs: []string{"arn-a", "arn-b"},
+			rotations: []CertRotation{
+				{CertARN: "arn-a", ReplacementCertARN: "arn-c", Stage: CertRotationStagePromoted},
+			},
+			want: []string{"arn-c", "arn-b"},
+		},
+		{
+			name:     "replacement already attached isn't duplicated",
+			certARNs: []string{"arn-a", "arn-c"},
+			rotations: []CertRotation{
+				{CertARN: "arn-a", ReplacementCertARN: "arn-c", Stage: CertRotationStagePromoted},
+			},
+			want: []string{"arn-c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, applyCertRotations(tt.certARNs, tt.rotations))
+		})
+	}
+}
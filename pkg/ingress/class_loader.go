@@ -59,7 +59,7 @@ func (l *defaultClassLoader) GetDefaultIngressClass(ctx context.Context) (string
 		return "", fmt.Errorf("%w: fetching ingressClasses: %v", ErrInvalidIngressClass, err.Error())
 	}
 	for _, ingressClass := range ingClassList.Items {
-		if ingressClass.Annotations[defaultClassAnnotation] == "true" {
+		if IsDefaultIngressClass(&ingressClass) {
 			if defaultClassFound {
 				return "", errors.Errorf("multiple default IngressClasses found")
 			}
@@ -71,6 +71,11 @@ func (l *defaultClassLoader) GetDefaultIngressClass(ctx context.Context) (string
 	return defaultClass, nil
 }
 
+// IsDefaultIngressClass returns whether IngressClass is marked as the default, which applies to Ingresses without IngressClassName.
+func IsDefaultIngressClass(ingClass *networking.IngressClass) bool {
+	return ingClass.Annotations[defaultClassAnnotation] == "true"
+}
+
 func (l *defaultClassLoader) Load(ctx context.Context, ing *networking.Ingress) (ClassConfiguration, error) {
 
 	if ing.Spec.IngressClassName == nil {
@@ -1,8 +1,9 @@
 package ingress
 
 import (
-	"github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -72,12 +73,21 @@ type TargetGroupTuple struct {
 	// the K8s service Name
 	ServiceName *string `json:"serviceName"`
 
+	// the K8s service Namespace, defaults to the Ingress's namespace.
+	// referencing services in other namespaces must be allowed by IngressClassParams.
+	// +optional
+	ServiceNamespace *string `json:"serviceNamespace,omitempty"`
+
 	// the K8s service port
 	ServicePort *intstr.IntOrString `json:"servicePort"`
 
 	// The weight.
 	// +optional
 	Weight *int64 `json:"weight,omitempty"`
+
+	// the target group attributes of this path, which take precedence over the target-group-attributes annotations.
+	// +optional
+	TargetGroupAttributes map[string]string `json:"targetGroupAttributes,omitempty"`
 }
 
 func (t *TargetGroupTuple) validate() error {
@@ -88,9 +98,24 @@ func (t *TargetGroupTuple) validate() error {
 	if t.ServiceName != nil && t.ServicePort == nil {
 		return errors.New("missing servicePort")
 	}
+	if t.ServiceNamespace != nil && t.ServiceName == nil {
+		return errors.New("serviceNamespace can only be specified with serviceName")
+	}
+	if len(t.TargetGroupAttributes) != 0 && t.ServiceName == nil {
+		return errors.New("targetGroupAttributes can only be specified with serviceName")
+	}
 	return nil
 }
 
+// serviceKey returns the key of referenced K8s service, defaults to Ingress's namespace.
+func (t *TargetGroupTuple) serviceKey(ingNamespace string) types.NamespacedName {
+	namespace := ingNamespace
+	if t.ServiceNamespace != nil {
+		namespace = *t.ServiceNamespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: awssdk.ToString(t.ServiceName)}
+}
+
 // Information about the target group stickiness for a rule.
 type TargetGroupStickinessConfig struct {
 	// Indicates whether target group stickiness is enabled.
@@ -160,23 +185,23 @@ type Action struct {
 	ForwardConfig *ForwardActionConfig `json:"forwardConfig,omitempty"`
 }
 
-func (a *Action) validate() error {
+func (a *Action) Validate() error {
 	switch a.Type {
-	case elbv2.ActionTypeEnumFixedResponse:
+	case ActionTypeFixedResponse:
 		if a.FixedResponseConfig == nil {
 			return errors.New("missing FixedResponseConfig")
 		}
 		if err := a.FixedResponseConfig.validate(); err != nil {
 			return errors.Wrap(err, "invalid FixedResponseConfig")
 		}
-	case elbv2.ActionTypeEnumRedirect:
+	case ActionTypeRedirect:
 		if a.RedirectConfig == nil {
 			return errors.New("missing RedirectConfig")
 		}
 		if err := a.RedirectConfig.validate(); err != nil {
 			return errors.Wrap(err, "invalid RedirectConfig")
 		}
-	case elbv2.ActionTypeEnumForward:
+	case ActionTypeForward:
 		if (a.TargetGroupARN != nil) == (a.ForwardConfig != nil) {
 			return errors.New("precisely one of TargetGroupArn and ForwardConfig can be specified")
 		}
@@ -408,7 +433,12 @@ type AuthIDPConfigOIDC struct {
 	UserInfoEndpoint string `json:"userInfoEndpoint"`
 
 	// The k8s secretName.
-	SecretName string `json:"secretName"`
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// The ARN of AWS Secrets Manager secret, which is used instead of k8s secret if specified.
+	// +optional
+	SecretARN string `json:"secretARN,omitempty"`
 
 	// The query parameters (up to 10) to include in the redirect request to the authorization endpoint.
 	// +optional
@@ -0,0 +1,46 @@
+package ingress
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// ResolveCustomerOwnedIPv4Addresses resolves the customer-owned IPv4 addresses assigned to the network interfaces of LoadBalancer on Outposts.
+// The addresses are sorted, and an empty list is returned until the addresses are assigned.
+func ResolveCustomerOwnedIPv4Addresses(ctx context.Context, ec2Client services.EC2, lbARN string) ([]string, error) {
+	parsedLBARN, err := arn.Parse(lbARN)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse LoadBalancer ARN: %v", lbARN)
+	}
+	// the network interfaces of ELB are described as "ELB app/<name>/<id>".
+	eniDescription := "ELB " + strings.TrimPrefix(parsedLBARN.Resource, "loadbalancer/")
+	enis, err := ec2Client.DescribeNetworkInterfacesAsList(ctx, &ec2sdk.DescribeNetworkInterfacesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("description"),
+				Values: []string{eniDescription},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var coIPs []string
+	for _, eni := range enis {
+		if eni.Association == nil || awssdk.ToString(eni.Association.CustomerOwnedIp) == "" {
+			continue
+		}
+		coIPs = append(coIPs, awssdk.ToString(eni.Association.CustomerOwnedIp))
+	}
+	sort.Strings(coIPs)
+	return coIPs, nil
+}
@@ -0,0 +1,110 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+func TestResolveCustomerOwnedIPv4Addresses(t *testing.T) {
+	type describeNetworkInterfacesAsListCall struct {
+		resp []ec2types.NetworkInterface
+		err  error
+	}
+	tests := []struct {
+		name                                 string
+		lbARN                                string
+		describeNetworkInterfacesAsListCalls []describeNetworkInterfacesAsListCall
+		want                                 []string
+		wantErr                              error
+	}{
+		{
+			name:  "customer-owned IPs assigned",
+			lbARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
+				{
+					resp: []ec2types.NetworkInterface{
+						{
+							NetworkInterfaceId: awssdk.String("eni-2"),
+							Association: &ec2types.NetworkInterfaceAssociation{
+								CustomerOwnedIp: awssdk.String("192.168.0.11"),
+							},
+						},
+						{
+							NetworkInterfaceId: awssdk.String("eni-1"),
+							Association: &ec2types.NetworkInterfaceAssociation{
+								CustomerOwnedIp: awssdk.String("192.168.0.10"),
+							},
+						},
+					},
+				},
+			},
+			want: []string{"192.168.0.10", "192.168.0.11"},
+		},
+		{
+			name:  "customer-owned IPs not assigned yet",
+			lbARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
+				{
+					resp: []ec2types.NetworkInterface{
+						{
+							NetworkInterfaceId: awssdk.String("eni-1"),
+						},
+						{
+							NetworkInterfaceId: awssdk.String("eni-2"),
+							Association:        &ec2types.NetworkInterfaceAssociation{},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name:  "network interfaces failed to describe",
+			lbARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
+				{
+					err: errors.New("some error"),
+				},
+			},
+			wantErr: errors.New("some error"),
+		},
+		{
+			name:    "invalid LoadBalancer ARN",
+			lbARN:   "my-lb",
+			wantErr: errors.New("failed to parse LoadBalancer ARN: my-lb: arn: invalid prefix"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.describeNetworkInterfacesAsListCalls {
+				ec2Client.EXPECT().DescribeNetworkInterfacesAsList(gomock.Any(), &ec2sdk.DescribeNetworkInterfacesInput{
+					Filters: []ec2types.Filter{
+						{
+							Name:   awssdk.String("description"),
+							Values: []string{"ELB app/my-lb/50dc6c495c0c9188"},
+						},
+					},
+				}).Return(call.resp, call.err)
+			}
+			got, err := ResolveCustomerOwnedIPv4Addresses(context.Background(), ec2Client, tt.lbARN)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
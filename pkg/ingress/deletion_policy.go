@@ -0,0 +1,41 @@
+package ingress
+
+import (
+	"time"
+
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+)
+
+// IsRetainRequested checks whether the AWS resources of IngressGroup should be retained once it has no members left.
+// Since the members of IngressGroup share the same load balancer, retaining is requested if any inactive member requests it.
+func IsRetainRequested(annotationParser annotations.Parser, ingGroup Group) (bool, error) {
+	for _, inactiveMember := range ingGroup.InactiveMembers {
+		deletionPolicy, err := deploy.ParseDeletionPolicyAnnotation(annotationParser, annotations.IngressSuffixDeletionPolicy, inactiveMember.Annotations)
+		if err != nil {
+			return false, err
+		}
+		if deletionPolicy == elbv2api.DeletionPolicyRetain {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// LatestDeletionTime returns the latest deletion time of the inactive members of IngressGroup,
+// false is returned if none of them is deleted, e.g. they're moved to other IngressGroups instead.
+func LatestDeletionTime(ingGroup Group) (time.Time, bool) {
+	var latestDeletionTime time.Time
+	deleted := false
+	for _, inactiveMember := range ingGroup.InactiveMembers {
+		if inactiveMember.DeletionTimestamp == nil {
+			continue
+		}
+		if !deleted || inactiveMember.DeletionTimestamp.Time.After(latestDeletionTime) {
+			latestDeletionTime = inactiveMember.DeletionTimestamp.Time
+		}
+		deleted = true
+	}
+	return latestDeletionTime, deleted
+}
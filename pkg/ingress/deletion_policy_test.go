@@ -0,0 +1,119 @@
+package ingress
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func TestIsRetainRequested(t *testing.T) {
+	buildIngress := func(name string, deletionPolicy string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+		if deletionPolicy != "" {
+			ing.Annotations = map[string]string{"alb.ingress.kubernetes.io/deletion-policy": deletionPolicy}
+		}
+		return ing
+	}
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name: "retain not requested",
+			ingGroup: Group{
+				InactiveMembers: []*networking.Ingress{buildIngress("ing-1", "")},
+			},
+			want: false,
+		},
+		{
+			name: "delete requested explicitly",
+			ingGroup: Group{
+				InactiveMembers: []*networking.Ingress{buildIngress("ing-1", "Delete")},
+			},
+			want: false,
+		},
+		{
+			name: "retain requested by one of inactive members",
+			ingGroup: Group{
+				InactiveMembers: []*networking.Ingress{buildIngress("ing-1", ""), buildIngress("ing-2", "Retain")},
+			},
+			want: true,
+		},
+		{
+			name: "invalid annotation value",
+			ingGroup: Group{
+				InactiveMembers: []*networking.Ingress{buildIngress("ing-1", "Orphan")},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			got, err := IsRetainRequested(annotationParser, tt.ingGroup)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLatestDeletionTime(t *testing.T) {
+	deletionTime := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	buildIngress := func(name string, deletionTimestamp *metav1.Time) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "awesome-ns",
+				Name:              name,
+				DeletionTimestamp: deletionTimestamp,
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		ingGroup    Group
+		want        time.Time
+		wantDeleted bool
+	}{
+		{
+			name: "inactive members aren't deleted",
+			ingGroup: Group{
+				InactiveMembers: []*networking.Ingress{buildIngress("ing-1", nil)},
+			},
+			wantDeleted: false,
+		},
+		{
+			name: "latest deletion time of inactive members",
+			ingGroup: Group{
+				InactiveMembers: []*networking.Ingress{
+					buildIngress("ing-1", &metav1.Time{Time: deletionTime.Add(time.Minute)}),
+					buildIngress("ing-2", nil),
+					buildIngress("ing-3", &metav1.Time{Time: deletionTime}),
+				},
+			},
+			want:        deletionTime.Add(time.Minute),
+			wantDeleted: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotDeleted := LatestDeletionTime(tt.ingGroup)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDeleted, gotDeleted)
+		})
+	}
+}
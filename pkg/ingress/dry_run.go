@@ -0,0 +1,30 @@
+package ingress
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+// IsDryRunRequested checks whether dry run is requested for IngressGroup.
+// Since the members of IngressGroup share the same load balancer, dry run is requested if any member requests it.
+// Inactive members are considered as well, so that their removal is planned instead of applied.
+func IsDryRunRequested(annotationParser annotations.Parser, ingGroup Group) (bool, error) {
+	for _, member := range ingGroup.Members {
+		dryRun := false
+		if _, err := annotationParser.ParseBoolAnnotation(annotations.IngressSuffixDryRun, &dryRun, member.Ing.Annotations); err != nil {
+			return false, err
+		}
+		if dryRun {
+			return true, nil
+		}
+	}
+	for _, inactiveMember := range ingGroup.InactiveMembers {
+		dryRun := false
+		if _, err := annotationParser.ParseBoolAnnotation(annotations.IngressSuffixDryRun, &dryRun, inactiveMember.Annotations); err != nil {
+			return false, err
+		}
+		if dryRun {
+			return true, nil
+		}
+	}
+	return false, nil
+}
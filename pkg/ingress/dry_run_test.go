@@ -0,0 +1,83 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func TestIsDryRunRequested(t *testing.T) {
+	buildIngress := func(name string, dryRun string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+		if dryRun != "" {
+			ing.Annotations = map[string]string{"alb.ingress.kubernetes.io/dry-run": dryRun}
+		}
+		return ing
+	}
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name: "dry run not requested",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{{Ing: buildIngress("ing-1", "")}},
+			},
+			want: false,
+		},
+		{
+			name: "dry run disabled explicitly",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{{Ing: buildIngress("ing-1", "false")}},
+			},
+			want: false,
+		},
+		{
+			name: "dry run requested by one of members",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{Ing: buildIngress("ing-1", "")},
+					{Ing: buildIngress("ing-2", "true")},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "dry run requested by inactive members",
+			ingGroup: Group{
+				Members:         []ClassifiedIngress{{Ing: buildIngress("ing-1", "")}},
+				InactiveMembers: []*networking.Ingress{buildIngress("ing-2", "true")},
+			},
+			want: true,
+		},
+		{
+			name: "invalid annotation value",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{{Ing: buildIngress("ing-1", "yes please")}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			got, err := IsDryRunRequested(annotationParser, tt.ingGroup)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -12,8 +12,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -45,6 +47,9 @@ type EnhancedBackendBuildOptions struct {
 
 	// whether to load auth configuration. when load authConfiguration, LoadBackendServices must be enabled as well.
 	LoadAuthConfig bool
+
+	// AllowedServiceNamespaces contains namespaces other than Ingress's namespace, whose services can be referenced in Action.
+	AllowedServiceNamespaces sets.String
 }
 
 type EnhancedBackendBuildOption func(opts *EnhancedBackendBuildOptions)
@@ -70,19 +75,27 @@ func WithLoadAuthConfig(loadAuthConfig bool) EnhancedBackendBuildOption {
 	}
 }
 
+// WithAllowedServiceNamespaces is a option that sets the AllowedServiceNamespaces.
+func WithAllowedServiceNamespaces(namespaces []string) EnhancedBackendBuildOption {
+	return func(opts *EnhancedBackendBuildOptions) {
+		opts.AllowedServiceNamespaces = sets.NewString(namespaces...)
+	}
+}
+
 // EnhancedBackendBuilder is capable of build EnhancedBackend for Ingress backend.
 type EnhancedBackendBuilder interface {
 	Build(ctx context.Context, ing *networking.Ingress, backend networking.IngressBackend, opts ...EnhancedBackendBuildOption) (EnhancedBackend, error)
 }
 
 // NewDefaultEnhancedBackendBuilder constructs new defaultEnhancedBackendBuilder.
-func NewDefaultEnhancedBackendBuilder(k8sClient client.Client, annotationParser annotations.Parser, authConfigBuilder AuthConfigBuilder, tolerateNonExistentBackendService bool, tolerateNonExistentBackendAction bool) *defaultEnhancedBackendBuilder {
+func NewDefaultEnhancedBackendBuilder(k8sClient client.Client, annotationParser annotations.Parser, authConfigBuilder AuthConfigBuilder, tolerateNonExistentBackendService bool, tolerateNonExistentBackendAction bool, enableTargetGroupWeightPolicy bool) *defaultEnhancedBackendBuilder {
 	return &defaultEnhancedBackendBuilder{
 		k8sClient:                         k8sClient,
 		annotationParser:                  annotationParser,
 		authConfigBuilder:                 authConfigBuilder,
 		tolerateNonExistentBackendService: tolerateNonExistentBackendService,
 		tolerateNonExistentBackendAction:  tolerateNonExistentBackendAction,
+		enableTargetGroupWeightPolicy:     enableTargetGroupWeightPolicy,
 	}
 }
 
@@ -100,6 +113,9 @@ type defaultEnhancedBackendBuilder struct {
 	// whether to tolerate misconfiguration that used a non-existent backend action.
 	// when tolerate, If the backend action annotation is non-existent, a fixed 503 response will be used instead.
 	tolerateNonExistentBackendAction bool
+	// whether to build backend actions from TargetGroupWeightPolicy resources.
+	// when enabled, a TargetGroupWeightPolicy referencing the backend action takes precedence over the actions annotation.
+	enableTargetGroupWeightPolicy bool
 }
 
 func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networking.Ingress, backend networking.IngressBackend, opts ...EnhancedBackendBuildOption) (EnhancedBackend, error) {
@@ -121,9 +137,18 @@ func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networki
 
 	var action Action
 	if backend.Service.Port.Name == magicServicePortUseAnnotation {
-		action, err = b.buildActionViaAnnotation(ctx, ing.Annotations, backend.Service.Name)
-		if err != nil {
-			return EnhancedBackend{}, err
+		var policyExists bool
+		if b.enableTargetGroupWeightPolicy {
+			action, policyExists, err = b.buildActionViaTargetGroupWeightPolicy(ctx, ing, backend.Service.Name)
+			if err != nil {
+				return EnhancedBackend{}, err
+			}
+		}
+		if !policyExists {
+			action, err = b.buildActionViaAnnotation(ctx, ing.Annotations, backend.Service.Name)
+			if err != nil {
+				return EnhancedBackend{}, err
+			}
 		}
 	} else if backend.Service.Port.Name != "" {
 		action = b.buildActionViaServiceAndServicePort(ctx, backend.Service.Name, intstr.FromString(backend.Service.Port.Name))
@@ -133,6 +158,9 @@ func (b *defaultEnhancedBackendBuilder) Build(ctx context.Context, ing *networki
 
 	var authCfg AuthConfig
 	if buildOpts.LoadBackendServices {
+		if err := b.validateServiceNamespaces(ctx, action, ing.Namespace, buildOpts.AllowedServiceNamespaces); err != nil {
+			return EnhancedBackend{}, err
+		}
 		if err := b.loadBackendServices(ctx, &action, ing.Namespace, buildOpts.BackendServices); err != nil {
 			return EnhancedBackend{}, err
 		}
@@ -181,7 +209,7 @@ func (b *defaultEnhancedBackendBuilder) buildActionViaAnnotation(ctx context.Con
 		}
 		return Action{}, errors.Errorf("missing %v configuration", annotationKey)
 	}
-	if err := action.validate(); err != nil {
+	if err := action.Validate(); err != nil {
 		return Action{}, err
 	}
 	b.normalizeSimplifiedSchemaForwardAction(ctx, &action)
@@ -189,6 +217,57 @@ func (b *defaultEnhancedBackendBuilder) buildActionViaAnnotation(ctx context.Con
 	return action, nil
 }
 
+// buildActionViaTargetGroupWeightPolicy will build the backend action specified via TargetGroupWeightPolicy.
+// The weights applied by the TargetGroupWeightPolicy controller are used if available, otherwise the desired weights are used.
+func (b *defaultEnhancedBackendBuilder) buildActionViaTargetGroupWeightPolicy(ctx context.Context, ing *networking.Ingress, actionName string) (Action, bool, error) {
+	policyList := &elbv2api.TargetGroupWeightPolicyList{}
+	if err := b.k8sClient.List(ctx, policyList, client.InNamespace(ing.Namespace)); err != nil {
+		return Action{}, false, err
+	}
+	var matchedPolicies []*elbv2api.TargetGroupWeightPolicy
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		if policy.Spec.IngressName == ing.Name && policy.Spec.ActionName == actionName {
+			matchedPolicies = append(matchedPolicies, policy)
+		}
+	}
+	if len(matchedPolicies) == 0 {
+		return Action{}, false, nil
+	}
+	if len(matchedPolicies) > 1 {
+		return Action{}, false, errors.Errorf("multiple TargetGroupWeightPolicy found for action %v", actionName)
+	}
+	policy := matchedPolicies[0]
+	weights := policy.Status.Weights
+	if len(weights) != len(policy.Spec.Backends) {
+		weights = make([]int64, 0, len(policy.Spec.Backends))
+		for _, backend := range policy.Spec.Backends {
+			weights = append(weights, backend.Weight)
+		}
+	}
+	targetGroups := make([]TargetGroupTuple, 0, len(policy.Spec.Backends))
+	for i, backend := range policy.Spec.Backends {
+		svcName := backend.ServiceRef.Name
+		svcPort := backend.ServiceRef.Port
+		weight := weights[i]
+		targetGroups = append(targetGroups, TargetGroupTuple{
+			ServiceName: &svcName,
+			ServicePort: &svcPort,
+			Weight:      &weight,
+		})
+	}
+	action := Action{
+		Type: ActionTypeForward,
+		ForwardConfig: &ForwardActionConfig{
+			TargetGroups: targetGroups,
+		},
+	}
+	if err := action.Validate(); err != nil {
+		return Action{}, false, errors.Wrapf(err, "invalid TargetGroupWeightPolicy %v", k8s.NamespacedName(policy))
+	}
+	return action, true, nil
+}
+
 // buildActionViaServiceAndServicePort will build the backend Action that forward to specified Kubernetes Service.
 func (b *defaultEnhancedBackendBuilder) buildActionViaServiceAndServicePort(_ context.Context, svcName string, svcPort intstr.IntOrString) Action {
 	action := Action{
@@ -235,21 +314,37 @@ func (b *defaultEnhancedBackendBuilder) normalizeServicePortForBackwardsCompatib
 	}
 }
 
+// validateServiceNamespaces will ensure services referenced in other namespaces than Ingress's namespace are allowed.
+func (b *defaultEnhancedBackendBuilder) validateServiceNamespaces(_ context.Context, action Action, namespace string, allowedServiceNamespaces sets.String) error {
+	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
+		return nil
+	}
+	for _, tgt := range action.ForwardConfig.TargetGroups {
+		if tgt.ServiceName == nil {
+			continue
+		}
+		svcKey := tgt.serviceKey(namespace)
+		if svcKey.Namespace != namespace && !allowedServiceNamespaces.Has(svcKey.Namespace) {
+			return errors.Errorf("referencing service %v across namespaces isn't allowed by IngressClassParams", svcKey.String())
+		}
+	}
+	return nil
+}
+
 // loadBackendServices will load referenced backend services into backendServices.
 // when tolerateNonExistentBackendService==true, and forward to a single non-existent Kubernetes Service, a fixed 503 response instead.
 func (b *defaultEnhancedBackendBuilder) loadBackendServices(ctx context.Context, action *Action, namespace string,
 	backendServices map[types.NamespacedName]*corev1.Service) error {
 	if action.Type == ActionTypeForward && action.ForwardConfig != nil {
-		svcNames := sets.NewString()
+		svcKeys := make(map[types.NamespacedName]struct{})
 		for _, tgt := range action.ForwardConfig.TargetGroups {
 			if tgt.ServiceName != nil {
-				svcNames.Insert(awssdk.StringValue(tgt.ServiceName))
+				svcKeys[tgt.serviceKey(namespace)] = struct{}{}
 			}
 		}
-		forwardToSingleSvc := (len(action.ForwardConfig.TargetGroups) == 1) && (svcNames.Len() == 1)
+		forwardToSingleSvc := (len(action.ForwardConfig.TargetGroups) == 1) && (len(svcKeys) == 1)
 		tolerateNonExistentBackendService := b.tolerateNonExistentBackendService && forwardToSingleSvc
-		for svcName := range svcNames {
-			svcKey := types.NamespacedName{Namespace: namespace, Name: svcName}
+		for svcKey := range svcKeys {
 			if _, ok := backendServices[svcKey]; ok {
 				continue
 			}
@@ -275,8 +370,7 @@ func (b *defaultEnhancedBackendBuilder) buildAuthConfig(ctx context.Context, act
 		action.ForwardConfig != nil &&
 		len(action.ForwardConfig.TargetGroups) == 1 &&
 		action.ForwardConfig.TargetGroups[0].ServiceName != nil {
-		svcName := awssdk.StringValue(action.ForwardConfig.TargetGroups[0].ServiceName)
-		svcKey := types.NamespacedName{Namespace: namespace, Name: svcName}
+		svcKey := action.ForwardConfig.TargetGroups[0].serviceKey(namespace)
 		svc := backendServices[svcKey]
 		svcAndIngAnnotations = algorithm.MergeStringMap(svc.Annotations, svcAndIngAnnotations)
 	}
@@ -14,7 +14,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -1259,6 +1261,133 @@ func Test_defaultEnhancedBackendBuilder_buildActionViaAnnotation(t *testing.T) {
 	}
 }
 
+func Test_defaultEnhancedBackendBuilder_buildActionViaTargetGroupWeightPolicy(t *testing.T) {
+	port80 := intstr.FromInt(80)
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-ing",
+		},
+	}
+	policy := func(name string, actionName string, weights []int64) *elbv2api.TargetGroupWeightPolicy {
+		return &elbv2api.TargetGroupWeightPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+			Spec: elbv2api.TargetGroupWeightPolicySpec{
+				IngressName: "awesome-ing",
+				ActionName:  actionName,
+				Backends: []elbv2api.WeightedServiceBackend{
+					{
+						ServiceRef: elbv2api.ServiceReference{Name: "blue", Port: port80},
+						Weight:     0,
+					},
+					{
+						ServiceRef: elbv2api.ServiceReference{Name: "green", Port: port80},
+						Weight:     100,
+					},
+				},
+			},
+			Status: elbv2api.TargetGroupWeightPolicyStatus{
+				Weights: weights,
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		policies   []*elbv2api.TargetGroupWeightPolicy
+		actionName string
+		want       Action
+		wantExists bool
+		wantErr    error
+	}{
+		{
+			name:       "no matching policy",
+			policies:   []*elbv2api.TargetGroupWeightPolicy{policy("policy-1", "other-action", nil)},
+			actionName: "blue-green",
+			wantExists: false,
+		},
+		{
+			name:       "policy without applied weights",
+			policies:   []*elbv2api.TargetGroupWeightPolicy{policy("policy-1", "blue-green", nil)},
+			actionName: "blue-green",
+			want: Action{
+				Type: ActionTypeForward,
+				ForwardConfig: &ForwardActionConfig{
+					TargetGroups: []TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("blue"),
+							ServicePort: &port80,
+							Weight:      awssdk.Int64(0),
+						},
+						{
+							ServiceName: awssdk.String("green"),
+							ServicePort: &port80,
+							Weight:      awssdk.Int64(100),
+						},
+					},
+				},
+			},
+			wantExists: true,
+		},
+		{
+			name:       "policy with applied weights",
+			policies:   []*elbv2api.TargetGroupWeightPolicy{policy("policy-1", "blue-green", []int64{80, 20})},
+			actionName: "blue-green",
+			want: Action{
+				Type: ActionTypeForward,
+				ForwardConfig: &ForwardActionConfig{
+					TargetGroups: []TargetGroupTuple{
+						{
+							ServiceName: awssdk.String("blue"),
+							ServicePort: &port80,
+							Weight:      awssdk.Int64(80),
+						},
+						{
+							ServiceName: awssdk.String("green"),
+							ServicePort: &port80,
+							Weight:      awssdk.Int64(20),
+						},
+					},
+				},
+			},
+			wantExists: true,
+		},
+		{
+			name: "multiple matching policies",
+			policies: []*elbv2api.TargetGroupWeightPolicy{
+				policy("policy-1", "blue-green", nil),
+				policy("policy-2", "blue-green", nil),
+			},
+			actionName: "blue-green",
+			wantErr:    errors.New("multiple TargetGroupWeightPolicy found for action blue-green"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, policy := range tt.policies {
+				assert.NoError(t, k8sClient.Create(context.Background(), policy.DeepCopy()))
+			}
+			b := &defaultEnhancedBackendBuilder{
+				k8sClient: k8sClient,
+			}
+			got, exists, err := b.buildActionViaTargetGroupWeightPolicy(context.Background(), ing, tt.actionName)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantExists, exists)
+				assert.Equal(t, tt.want, got, "diff", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
 func Test_defaultEnhancedBackendBuilder_buildActionViaServiceAndServicePort(t *testing.T) {
 	portHTTP := intstr.FromString("http")
 	type args struct {
@@ -1299,6 +1428,93 @@ func Test_defaultEnhancedBackendBuilder_buildActionViaServiceAndServicePort(t *t
 	}
 }
 
+func Test_defaultEnhancedBackendBuilder_validateServiceNamespaces(t *testing.T) {
+	port80 := intstr.FromInt(80)
+	type args struct {
+		action                   Action
+		namespace                string
+		allowedServiceNamespaces sets.String
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "forward to service in Ingress's namespace",
+			args: args{
+				action: Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName: awssdk.String("svc-1"),
+								ServicePort: &port80,
+							},
+							{
+								ServiceName:      awssdk.String("svc-2"),
+								ServiceNamespace: awssdk.String("awesome-ns"),
+								ServicePort:      &port80,
+							},
+						},
+					},
+				},
+				namespace: "awesome-ns",
+			},
+		},
+		{
+			name: "forward to service in allowed namespace",
+			args: args{
+				action: Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName:      awssdk.String("svc-1"),
+								ServiceNamespace: awssdk.String("platform"),
+								ServicePort:      &port80,
+							},
+						},
+					},
+				},
+				namespace:                "awesome-ns",
+				allowedServiceNamespaces: sets.NewString("platform"),
+			},
+		},
+		{
+			name: "forward to service in disallowed namespace",
+			args: args{
+				action: Action{
+					Type: ActionTypeForward,
+					ForwardConfig: &ForwardActionConfig{
+						TargetGroups: []TargetGroupTuple{
+							{
+								ServiceName:      awssdk.String("svc-1"),
+								ServiceNamespace: awssdk.String("other-ns"),
+								ServicePort:      &port80,
+							},
+						},
+					},
+				},
+				namespace:                "awesome-ns",
+				allowedServiceNamespaces: sets.NewString("platform"),
+			},
+			wantErr: errors.New("referencing service other-ns/svc-1 across namespaces isn't allowed by IngressClassParams"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &defaultEnhancedBackendBuilder{}
+			err := b.validateServiceNamespaces(context.Background(), tt.args.action, tt.args.namespace, tt.args.allowedServiceNamespaces)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_defaultEnhancedBackendBuilder_loadBackendServices(t *testing.T) {
 	port80 := intstr.FromInt(80)
 	svc1 := &corev1.Service{
@@ -64,4 +64,34 @@ type Group struct {
 
 	// InactiveMembers are Ingresses that no longer belong to this group, but still hold the finalizers.
 	InactiveMembers []*networking.Ingress
+
+	// DefaultAnnotations are the annotations applied to all members unless the member specifies the same annotation.
+	// They're declared by the IngressGroup resource of explicit groups.
+	DefaultAnnotations map[string]string
+}
+
+// WithDefaultAnnotations returns a copy of this group, where DefaultAnnotations are applied to the members.
+// The members of returned group are copies of the Ingresses and must not be used to update the Ingresses.
+func (group Group) WithDefaultAnnotations() Group {
+	if len(group.DefaultAnnotations) == 0 {
+		return group
+	}
+	members := make([]ClassifiedIngress, 0, len(group.Members))
+	for _, member := range group.Members {
+		ing := member.Ing.DeepCopy()
+		if ing.Annotations == nil {
+			ing.Annotations = make(map[string]string, len(group.DefaultAnnotations))
+		}
+		for key, value := range group.DefaultAnnotations {
+			if _, exists := ing.Annotations[key]; !exists {
+				ing.Annotations[key] = value
+			}
+		}
+		members = append(members, ClassifiedIngress{
+			Ing:            ing,
+			IngClassConfig: member.IngClassConfig,
+		})
+	}
+	group.Members = members
+	return group
 }
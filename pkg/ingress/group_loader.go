@@ -9,7 +9,12 @@ import (
 
 	"github.com/pkg/errors"
 	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	elbv2v1alpha1 "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1alpha1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,7 +50,7 @@ type GroupLoader interface {
 }
 
 // NewDefaultGroupLoader constructs new GroupLoader instance.
-func NewDefaultGroupLoader(client client.Client, eventRecorder record.EventRecorder, annotationParser annotations.Parser, classLoader ClassLoader, classAnnotationMatcher ClassAnnotationMatcher, manageIngressesWithoutIngressClass bool) *defaultGroupLoader {
+func NewDefaultGroupLoader(client client.Client, eventRecorder record.EventRecorder, annotationParser annotations.Parser, classLoader ClassLoader, classAnnotationMatcher ClassAnnotationMatcher, manageIngressesWithoutIngressClass bool, enableIngressGroupResource bool) *defaultGroupLoader {
 	return &defaultGroupLoader{
 		client:           client,
 		eventRecorder:    eventRecorder,
@@ -54,6 +59,7 @@ func NewDefaultGroupLoader(client client.Client, eventRecorder record.EventRecor
 		classLoader:                        classLoader,
 		classAnnotationMatcher:             classAnnotationMatcher,
 		manageIngressesWithoutIngressClass: manageIngressesWithoutIngressClass,
+		enableIngressGroupResource:         enableIngressGroupResource,
 	}
 }
 
@@ -74,6 +80,9 @@ type defaultGroupLoader struct {
 	// manageIngressesWithoutIngressClass specifies whether ingresses without "kubernetes.io/ingress.class" annotation
 	// and "spec.ingressClassName" should be managed or not.
 	manageIngressesWithoutIngressClass bool
+
+	// enableIngressGroupResource specifies whether the membership of explicit groups is governed by IngressGroup resources.
+	enableIngressGroupResource bool
 }
 
 func (m *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group, error) {
@@ -81,6 +90,10 @@ func (m *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group,
 	if err := m.client.List(ctx, ingList); err != nil {
 		return Group{}, err
 	}
+	ingGroupRes, err := m.loadIngressGroupResource(ctx, groupID)
+	if err != nil {
+		return Group{}, err
+	}
 	var members []ClassifiedIngress
 	var inactiveMembers []*networking.Ingress
 	for index := range ingList.Items {
@@ -97,15 +110,20 @@ func (m *defaultGroupLoader) Load(ctx context.Context, groupID GroupID) (Group,
 		}
 	}
 
-	sortedMembers, err := m.sortGroupMembers(members)
+	sortedMembers, err := m.sortGroupMembers(members, ingGroupRes)
 	if err != nil {
 		return Group{}, err
 	}
 
+	var defaultAnnotations map[string]string
+	if ingGroupRes != nil {
+		defaultAnnotations = ingGroupRes.Spec.DefaultAnnotations
+	}
 	return Group{
-		ID:              groupID,
-		Members:         sortedMembers,
-		InactiveMembers: inactiveMembers,
+		ID:                 groupID,
+		Members:            sortedMembers,
+		InactiveMembers:    inactiveMembers,
+		DefaultAnnotations: defaultAnnotations,
 	}, nil
 }
 
@@ -184,7 +202,7 @@ func (m *defaultGroupLoader) loadGroupIDIfAnyHelper(ctx context.Context, ing *ne
 		return ClassifiedIngress{}, nil, nil
 	}
 
-	groupID, err := m.loadGroupID(classifiedIngress)
+	groupID, err := m.loadGroupID(ctx, classifiedIngress)
 	if err != nil {
 		return ClassifiedIngress{}, nil, err
 	}
@@ -229,7 +247,7 @@ func (m *defaultGroupLoader) classifyIngress(ctx context.Context, ing *networkin
 }
 
 // loadGroupID loads the groupID for classified Ingress.
-func (m *defaultGroupLoader) loadGroupID(classifiedIng ClassifiedIngress) (GroupID, error) {
+func (m *defaultGroupLoader) loadGroupID(ctx context.Context, classifiedIng ClassifiedIngress) (GroupID, error) {
 	// the "group" settings in associated IngClassParams takes higher priority than "group.name" annotation on Ingresses.
 	if classifiedIng.IngClassConfig.IngClassParams != nil && classifiedIng.IngClassConfig.IngClassParams.Spec.Group != nil {
 		groupName := classifiedIng.IngClassConfig.IngClassParams.Spec.Group.Name
@@ -240,12 +258,30 @@ func (m *defaultGroupLoader) loadGroupID(classifiedIng ClassifiedIngress) (Group
 		return groupID, nil
 	}
 
+	// the members selected by IngressGroup resources takes higher priority than "group.name" annotation on Ingresses.
+	if m.enableIngressGroupResource {
+		ingGroupRes, err := m.findIngressGroupResourceSelecting(ctx, classifiedIng.Ing)
+		if err != nil {
+			return GroupID{}, err
+		}
+		if ingGroupRes != nil {
+			return NewGroupIDForExplicitGroup(ingGroupRes.Name), nil
+		}
+	}
+
 	groupName := ""
 	if exists := m.annotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &groupName, classifiedIng.Ing.Annotations); exists {
 		if err := validateGroupName(groupName); err != nil {
 			return GroupID{}, fmt.Errorf("%w: %v", errInvalidIngressGroup, err.Error())
 		}
 		groupID := NewGroupIDForExplicitGroup(groupName)
+		ingGroupRes, err := m.loadIngressGroupResource(ctx, groupID)
+		if err != nil {
+			return GroupID{}, err
+		}
+		if ingGroupRes != nil && !isNamespacePermittedByIngressGroupResource(ingGroupRes, classifiedIng.Ing.Namespace) {
+			return GroupID{}, fmt.Errorf("%w: namespace %v is not permitted to join IngressGroup %v", errInvalidIngressGroup, classifiedIng.Ing.Namespace, groupName)
+		}
 		return groupID, nil
 	}
 
@@ -272,13 +308,19 @@ type groupMemberWithOrder struct {
 // * explicit denote the order via "group.order" annotation.
 // * implicit denote the order of ${defaultGroupOrder}.
 // If two Ingress are of same order, they are sorted by lexical order of their full-qualified name.
-func (m *defaultGroupLoader) sortGroupMembers(members []ClassifiedIngress) ([]ClassifiedIngress, error) {
+func (m *defaultGroupLoader) sortGroupMembers(members []ClassifiedIngress, ingGroupRes *elbv2v1alpha1.IngressGroup) ([]ClassifiedIngress, error) {
 	if len(members) == 0 {
 		return nil, nil
 	}
 
 	groupMemberWithOrderList := make([]groupMemberWithOrder, 0, len(members))
 	for _, member := range members {
+		if ingGroupRes != nil {
+			if resMember := findIngressGroupResourceMember(ingGroupRes, member.Ing); resMember != nil && resMember.Order != nil {
+				groupMemberWithOrderList = append(groupMemberWithOrderList, groupMemberWithOrder{member: member, order: *resMember.Order})
+				continue
+			}
+		}
 		var order = defaultGroupOrder
 		exists, err := m.annotationParser.ParseInt64Annotation(annotations.IngressSuffixGroupOrder, &order, member.Ing.Annotations)
 		if err != nil {
@@ -313,6 +355,79 @@ func (m *defaultGroupLoader) sortGroupMembers(members []ClassifiedIngress) ([]Cl
 	return sortedMembers, nil
 }
 
+// loadIngressGroupResource loads the IngressGroup resource of explicit group if any.
+func (m *defaultGroupLoader) loadIngressGroupResource(ctx context.Context, groupID GroupID) (*elbv2v1alpha1.IngressGroup, error) {
+	if !m.enableIngressGroupResource || !groupID.IsExplicit() {
+		return nil, nil
+	}
+	ingGroupRes := &elbv2v1alpha1.IngressGroup{}
+	if err := m.client.Get(ctx, types.NamespacedName{Name: groupID.Name}, ingGroupRes); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to load IngressGroup resource: %v", groupID.Name)
+	}
+	return ingGroupRes, nil
+}
+
+// findIngressGroupResourceSelecting finds the IngressGroup resource whose members select the Ingress.
+// If multiple IngressGroup resources select the Ingress, the first one by name is chosen.
+func (m *defaultGroupLoader) findIngressGroupResourceSelecting(ctx context.Context, ing *networking.Ingress) (*elbv2v1alpha1.IngressGroup, error) {
+	ingGroupResList := &elbv2v1alpha1.IngressGroupList{}
+	if err := m.client.List(ctx, ingGroupResList); err != nil {
+		return nil, errors.Wrap(err, "failed to list IngressGroup resources")
+	}
+	ingGroupResources := ingGroupResList.Items
+	sort.Slice(ingGroupResources, func(i, j int) bool {
+		return ingGroupResources[i].Name < ingGroupResources[j].Name
+	})
+	for i := range ingGroupResources {
+		ingGroupRes := &ingGroupResources[i]
+		if validateGroupName(ingGroupRes.Name) != nil {
+			continue
+		}
+		if findIngressGroupResourceMember(ingGroupRes, ing) != nil {
+			return ingGroupRes, nil
+		}
+	}
+	return nil, nil
+}
+
+// findIngressGroupResourceMember finds the member of IngressGroup resource that selects the Ingress.
+// Members with invalid selector don't select any Ingress.
+func findIngressGroupResourceMember(ingGroupRes *elbv2v1alpha1.IngressGroup, ing *networking.Ingress) *elbv2v1alpha1.IngressGroupMember {
+	if !isNamespacePermittedByIngressGroupResource(ingGroupRes, ing.Namespace) {
+		return nil
+	}
+	for i := range ingGroupRes.Spec.Members {
+		member := &ingGroupRes.Spec.Members[i]
+		if member.Namespace != "" && member.Namespace != ing.Namespace {
+			continue
+		}
+		selector := labels.Everything()
+		if member.Selector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(member.Selector); err != nil {
+				continue
+			}
+		}
+		if selector.Matches(labels.Set(ing.Labels)) {
+			return member
+		}
+	}
+	return nil
+}
+
+// isNamespacePermittedByIngressGroupResource checks whether Ingresses from namespace are permitted to join the IngressGroup.
+func isNamespacePermittedByIngressGroupResource(ingGroupRes *elbv2v1alpha1.IngressGroup, namespace string) bool {
+	for _, permittedNamespace := range ingGroupRes.Spec.PermittedNamespaces {
+		if permittedNamespace == namespace {
+			return true
+		}
+	}
+	return false
+}
+
 // validateGroupName validates whether Ingress group name is valid
 func validateGroupName(groupName string) error {
 	if !groupNameRegex.MatchString(groupName) {
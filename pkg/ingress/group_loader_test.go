@@ -14,6 +14,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2v1alpha1 "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1alpha1"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	mock_client "sigs.k8s.io/aws-load-balancer-controller/mocks/controller-runtime/client"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
@@ -2376,7 +2377,136 @@ func Test_defaultGroupLoader_loadGroupID(t *testing.T) {
 			m := &defaultGroupLoader{
 				annotationParser: annotationParser,
 			}
-			got, err := m.loadGroupID(tt.args.classifiedIng)
+			got, err := m.loadGroupID(context.Background(), tt.args.classifiedIng)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultGroupLoader_loadGroupID_withIngressGroupResource(t *testing.T) {
+	ingGroupRes := &elbv2v1alpha1.IngressGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-group",
+		},
+		Spec: elbv2v1alpha1.IngressGroupSpec{
+			PermittedNamespaces: []string{"ns-1", "ns-2"},
+			Members: []elbv2v1alpha1.IngressGroupMember{
+				{
+					Namespace: "ns-1",
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app": "web"},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name                       string
+		enableIngressGroupResource bool
+		ing                        *networking.Ingress
+		want                       GroupID
+		wantErr                    error
+	}{
+		{
+			name:                       "Ingress selected by IngressGroup resource",
+			enableIngressGroupResource: true,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "ing-name",
+					Labels:    map[string]string{"app": "web"},
+				},
+			},
+			want: GroupID{Name: "awesome-group"},
+		},
+		{
+			name:                       "Ingress selected by IngressGroup resource - takes priority over annotation",
+			enableIngressGroupResource: true,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "ing-name",
+					Labels:    map[string]string{"app": "web"},
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/group.name": "another-group",
+					},
+				},
+			},
+			want: GroupID{Name: "awesome-group"},
+		},
+		{
+			name:                       "Ingress not selected by IngressGroup resource",
+			enableIngressGroupResource: true,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-1",
+					Name:      "ing-name",
+					Labels:    map[string]string{"app": "api"},
+				},
+			},
+			want: GroupID{Namespace: "ns-1", Name: "ing-name"},
+		},
+		{
+			name:                       "Ingress joins IngressGroup resource via annotation from permitted namespace",
+			enableIngressGroupResource: true,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-2",
+					Name:      "ing-name",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/group.name": "awesome-group",
+					},
+				},
+			},
+			want: GroupID{Name: "awesome-group"},
+		},
+		{
+			name:                       "Ingress joins IngressGroup resource via annotation from non-permitted namespace",
+			enableIngressGroupResource: true,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-3",
+					Name:      "ing-name",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/group.name": "awesome-group",
+					},
+				},
+			},
+			wantErr: errors.New("invalid ingress group: namespace ns-3 is not permitted to join IngressGroup awesome-group"),
+		},
+		{
+			name:                       "IngressGroup resource ignored when disabled",
+			enableIngressGroupResource: false,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ns-3",
+					Name:      "ing-name",
+					Labels:    map[string]string{"app": "web"},
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/group.name": "awesome-group",
+					},
+				},
+			},
+			want: GroupID{Name: "awesome-group"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2v1alpha1.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(ingGroupRes.DeepCopy()).Build()
+			m := &defaultGroupLoader{
+				client:                     k8sClient,
+				annotationParser:           annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				enableIngressGroupResource: tt.enableIngressGroupResource,
+			}
+			got, err := m.loadGroupID(context.Background(), ClassifiedIngress{Ing: tt.ing})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -2991,7 +3121,7 @@ func Test_defaultGroupLoader_sortGroupMembers(t *testing.T) {
 				classAnnotationMatcher:             NewDefaultClassAnnotationMatcher(ingressClassALB),
 				manageIngressesWithoutIngressClass: false,
 			}
-			got, err := m.sortGroupMembers(tt.members)
+			got, err := m.sortGroupMembers(tt.members, nil)
 			assert.Equal(t, tt.want, got)
 			if tt.wantErr == nil {
 				assert.NoError(t, err)
@@ -3002,6 +3132,47 @@ func Test_defaultGroupLoader_sortGroupMembers(t *testing.T) {
 	}
 }
 
+func Test_defaultGroupLoader_sortGroupMembers_withIngressGroupResource(t *testing.T) {
+	ingA := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-1",
+			Name:      "ingress-a",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/group.order": "1",
+			},
+		},
+	}
+	ingB := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns-2",
+			Name:      "ingress-b",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/group.order": "2",
+			},
+		},
+	}
+	ingGroupRes := &elbv2v1alpha1.IngressGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-group",
+		},
+		Spec: elbv2v1alpha1.IngressGroupSpec{
+			PermittedNamespaces: []string{"ns-1", "ns-2"},
+			Members: []elbv2v1alpha1.IngressGroupMember{
+				{
+					Namespace: "ns-2",
+					Order:     awssdk.Int64(-1),
+				},
+			},
+		},
+	}
+	m := &defaultGroupLoader{
+		annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+	}
+	got, err := m.sortGroupMembers([]ClassifiedIngress{{Ing: ingA}, {Ing: ingB}}, ingGroupRes)
+	assert.NoError(t, err)
+	assert.Equal(t, []ClassifiedIngress{{Ing: ingB}, {Ing: ingA}}, got)
+}
+
 func Test_validateGroupName(t *testing.T) {
 	tests := []struct {
 		name      string
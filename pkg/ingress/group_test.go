@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -192,3 +194,31 @@ func TestDecodeGroupIDFromReconcileRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestGroup_WithDefaultAnnotations(t *testing.T) {
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "namespace",
+			Name:      "ingress",
+			Annotations: map[string]string{
+				"alb.ingress.kubernetes.io/scheme": "internal",
+			},
+		},
+	}
+	group := Group{
+		ID:      GroupID{Name: "awesome-group"},
+		Members: []ClassifiedIngress{{Ing: ing}},
+		DefaultAnnotations: map[string]string{
+			"alb.ingress.kubernetes.io/scheme":       "internet-facing",
+			"alb.ingress.kubernetes.io/ssl-redirect": "443",
+		},
+	}
+	got := group.WithDefaultAnnotations()
+	assert.Equal(t, map[string]string{
+		"alb.ingress.kubernetes.io/scheme":       "internal",
+		"alb.ingress.kubernetes.io/ssl-redirect": "443",
+	}, got.Members[0].Ing.Annotations)
+	assert.Equal(t, map[string]string{
+		"alb.ingress.kubernetes.io/scheme": "internal",
+	}, ing.Annotations)
+}
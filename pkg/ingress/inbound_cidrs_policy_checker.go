@@ -0,0 +1,125 @@
+package ingress
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// the inbound CIDRs of Ingresses without explicit inbound CIDRs or prefix lists.
+var defaultInboundCIDRs = []string{"0.0.0.0/0", "::/0"}
+
+// InboundCIDRsPolicyChecker checks the inbound CIDRs of Ingresses against the InboundCIDRsPolicy of their IngressClassParams.
+type InboundCIDRsPolicyChecker interface {
+	// Check checks the inbound CIDRs of Ingress against the InboundCIDRsPolicy of ingClassParams, which can be nil.
+	Check(ctx context.Context, ing *networking.Ingress, ingClassParams *elbv2api.IngressClassParams) error
+}
+
+// NewDefaultInboundCIDRsPolicyChecker constructs new defaultInboundCIDRsPolicyChecker.
+func NewDefaultInboundCIDRsPolicyChecker(k8sClient client.Client) *defaultInboundCIDRsPolicyChecker {
+	return &defaultInboundCIDRsPolicyChecker{
+		k8sClient:        k8sClient,
+		annotationParser: annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
+	}
+}
+
+var _ InboundCIDRsPolicyChecker = &defaultInboundCIDRsPolicyChecker{}
+
+// default implementation for InboundCIDRsPolicyChecker.
+type defaultInboundCIDRsPolicyChecker struct {
+	k8sClient        client.Client
+	annotationParser annotations.Parser
+}
+
+func (c *defaultInboundCIDRsPolicyChecker) Check(ctx context.Context, ing *networking.Ingress, ingClassParams *elbv2api.IngressClassParams) error {
+	// the inbound CIDRs specified by IngressClassParams are trusted, as they override the annotation.
+	if ingClassParams == nil || ingClassParams.Spec.InboundCIDRsPolicy == nil || len(ingClassParams.Spec.InboundCIDRs) != 0 {
+		return nil
+	}
+	policy := ingClassParams.Spec.InboundCIDRsPolicy
+	applies, err := c.policyAppliesToIngress(ctx, ing, policy)
+	if err != nil {
+		return err
+	}
+	if !applies {
+		return nil
+	}
+
+	var inboundCIDRs []string
+	_ = c.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixInboundCIDRs, &inboundCIDRs, ing.Annotations)
+	if len(inboundCIDRs) == 0 {
+		var prefixLists []string
+		_ = c.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroupPrefixLists, &prefixLists, ing.Annotations)
+		if len(prefixLists) != 0 || len(ingClassParams.Spec.PrefixListsIDs) != 0 {
+			return nil
+		}
+		inboundCIDRs = defaultInboundCIDRs
+	}
+	allowedCIDRs := make([]*net.IPNet, 0, len(policy.AllowedCIDRs))
+	for _, cidr := range policy.AllowedCIDRs {
+		_, allowedCIDR, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid allowed CIDR in InboundCIDRsPolicy of IngressClassParams %v", ingClassParams.Name)
+		}
+		allowedCIDRs = append(allowedCIDRs, allowedCIDR)
+	}
+	var deniedCIDRs []string
+	for _, cidr := range inboundCIDRs {
+		_, inboundCIDR, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %v settings on Ingress: %v", annotations.IngressSuffixInboundCIDRs, k8s.NamespacedName(ing))
+		}
+		if !isCIDRWithinAny(inboundCIDR, allowedCIDRs) {
+			deniedCIDRs = append(deniedCIDRs, cidr)
+		}
+	}
+	if len(deniedCIDRs) != 0 {
+		return errors.Errorf("inbound CIDRs %v of Ingress %v aren't allowed by InboundCIDRsPolicy of IngressClassParams %v, allowed CIDRs: %v",
+			deniedCIDRs, k8s.NamespacedName(ing), ingClassParams.Name, policy.AllowedCIDRs)
+	}
+	return nil
+}
+
+// policyAppliesToIngress checks whether the namespace of Ingress is selected by the policy.
+func (c *defaultInboundCIDRsPolicyChecker) policyAppliesToIngress(ctx context.Context, ing *networking.Ingress, policy *elbv2api.InboundCIDRsPolicy) (bool, error) {
+	if policy.NamespaceSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(policy.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	ingNamespace := ing.Namespace
+	// see https://github.com/kubernetes/kubernetes/issues/88282 and https://github.com/kubernetes/kubernetes/issues/76680
+	if admissionReq := webhook.ContextGetAdmissionRequest(ctx); admissionReq != nil {
+		ingNamespace = admissionReq.Namespace
+	}
+	ingNS := &corev1.Namespace{}
+	if err := c.k8sClient.Get(ctx, types.NamespacedName{Name: ingNamespace}, ingNS); err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(ingNS.Labels)), nil
+}
+
+// isCIDRWithinAny checks whether cidr is within any of the CIDRs of the same address family.
+func isCIDRWithinAny(cidr *net.IPNet, cidrs []*net.IPNet) bool {
+	ones, bits := cidr.Mask.Size()
+	for _, candidate := range cidrs {
+		candidateOnes, candidateBits := candidate.Mask.Size()
+		if bits == candidateBits && ones >= candidateOnes && candidate.Contains(cidr.IP) {
+			return true
+		}
+	}
+	return false
+}
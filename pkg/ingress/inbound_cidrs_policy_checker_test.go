@@ -0,0 +1,147 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultInboundCIDRsPolicyChecker_Check(t *testing.T) {
+	tenantNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "tenant",
+			Labels: map[string]string{"tenancy": "restricted"},
+		},
+	}
+	trustedNS := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "trusted",
+		},
+	}
+	restrictedPolicy := &elbv2api.InboundCIDRsPolicy{
+		NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"tenancy": "restricted"},
+		},
+		AllowedCIDRs: []string{"10.0.0.0/8", "2600:1f14::/32"},
+	}
+	ingClassParamsWithPolicy := func(policy *elbv2api.InboundCIDRsPolicy) *elbv2api.IngressClassParams {
+		return &elbv2api.IngressClassParams{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "tenants",
+			},
+			Spec: elbv2api.IngressClassParamsSpec{
+				InboundCIDRsPolicy: policy,
+			},
+		}
+	}
+	ingWithAnnotations := func(namespace string, annotations map[string]string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   namespace,
+				Name:        "ing",
+				Annotations: annotations,
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		ing            *networking.Ingress
+		ingClassParams *elbv2api.IngressClassParams
+		wantErr        error
+	}{
+		{
+			name:           "no IngressClassParams",
+			ing:            ingWithAnnotations("tenant", nil),
+			ingClassParams: nil,
+		},
+		{
+			name:           "no InboundCIDRsPolicy",
+			ing:            ingWithAnnotations("tenant", nil),
+			ingClassParams: ingClassParamsWithPolicy(nil),
+		},
+		{
+			name: "inbound CIDRs within allowed CIDRs",
+			ing: ingWithAnnotations("tenant", map[string]string{
+				"alb.ingress.kubernetes.io/inbound-cidrs": "10.1.0.0/16, 2600:1f14:1234::/48",
+			}),
+			ingClassParams: ingClassParamsWithPolicy(restrictedPolicy),
+		},
+		{
+			name: "inbound CIDRs outside allowed CIDRs",
+			ing: ingWithAnnotations("tenant", map[string]string{
+				"alb.ingress.kubernetes.io/inbound-cidrs": "10.1.0.0/16, 192.168.0.0/16, 0.0.0.0/0",
+			}),
+			ingClassParams: ingClassParamsWithPolicy(restrictedPolicy),
+			wantErr:        errors.New("inbound CIDRs [192.168.0.0/16 0.0.0.0/0] of Ingress tenant/ing aren't allowed by InboundCIDRsPolicy of IngressClassParams tenants, allowed CIDRs: [10.0.0.0/8 2600:1f14::/32]"),
+		},
+		{
+			name:           "default inbound CIDRs aren't allowed",
+			ing:            ingWithAnnotations("tenant", nil),
+			ingClassParams: ingClassParamsWithPolicy(restrictedPolicy),
+			wantErr:        errors.New("inbound CIDRs [0.0.0.0/0 ::/0] of Ingress tenant/ing aren't allowed by InboundCIDRsPolicy of IngressClassParams tenants, allowed CIDRs: [10.0.0.0/8 2600:1f14::/32]"),
+		},
+		{
+			name: "prefix lists are used instead of default inbound CIDRs",
+			ing: ingWithAnnotations("tenant", map[string]string{
+				"alb.ingress.kubernetes.io/security-group-prefix-lists": "pl-00000000",
+			}),
+			ingClassParams: ingClassParamsWithPolicy(restrictedPolicy),
+		},
+		{
+			name:           "namespace isn't selected by policy",
+			ing:            ingWithAnnotations("trusted", nil),
+			ingClassParams: ingClassParamsWithPolicy(restrictedPolicy),
+		},
+		{
+			name: "policy without namespaceSelector applies to all namespaces",
+			ing:  ingWithAnnotations("trusted", nil),
+			ingClassParams: ingClassParamsWithPolicy(&elbv2api.InboundCIDRsPolicy{
+				AllowedCIDRs: []string{"10.0.0.0/8"},
+			}),
+			wantErr: errors.New("inbound CIDRs [0.0.0.0/0 ::/0] of Ingress trusted/ing aren't allowed by InboundCIDRsPolicy of IngressClassParams tenants, allowed CIDRs: [10.0.0.0/8]"),
+		},
+		{
+			name: "inbound CIDRs of IngressClassParams override the annotation",
+			ing: ingWithAnnotations("tenant", map[string]string{
+				"alb.ingress.kubernetes.io/inbound-cidrs": "0.0.0.0/0",
+			}),
+			ingClassParams: &elbv2api.IngressClassParams{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenants",
+				},
+				Spec: elbv2api.IngressClassParamsSpec{
+					InboundCIDRs:       []string{"10.0.0.0/8"},
+					InboundCIDRsPolicy: restrictedPolicy,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			assert.NoError(t, k8sClient.Create(ctx, tenantNS.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, trustedNS.DeepCopy()))
+
+			checker := NewDefaultInboundCIDRsPolicyChecker(k8sClient)
+			err := checker.Check(ctx, tt.ing, tt.ingClassParams)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
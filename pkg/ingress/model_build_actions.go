@@ -6,7 +6,9 @@ import (
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"strings"
@@ -24,7 +26,7 @@ func (t *defaultModelBuildTask) buildActions(ctx context.Context, protocol elbv2
 			actions = append(actions, *authAction)
 		}
 	}
-	backendAction, err := t.buildBackendAction(ctx, ing, backend.Action)
+	backendAction, err := t.buildBackendAction(ctx, protocol, ing, backend.Action)
 	if err != nil {
 		return nil, err
 	}
@@ -32,14 +34,14 @@ func (t *defaultModelBuildTask) buildActions(ctx context.Context, protocol elbv2
 	return actions, nil
 }
 
-func (t *defaultModelBuildTask) buildBackendAction(ctx context.Context, ing ClassifiedIngress, actionCfg Action) (elbv2model.Action, error) {
+func (t *defaultModelBuildTask) buildBackendAction(ctx context.Context, protocol elbv2model.Protocol, ing ClassifiedIngress, actionCfg Action) (elbv2model.Action, error) {
 	switch actionCfg.Type {
 	case ActionTypeFixedResponse:
 		return t.buildFixedResponseAction(ctx, actionCfg)
 	case ActionTypeRedirect:
 		return t.buildRedirectAction(ctx, actionCfg)
 	case ActionTypeForward:
-		return t.buildForwardAction(ctx, ing, actionCfg)
+		return t.buildForwardAction(ctx, protocol, ing, actionCfg)
 	}
 	return elbv2model.Action{}, errors.Errorf("unknown action type: %v", actionCfg.Type)
 }
@@ -95,7 +97,7 @@ func (t *defaultModelBuildTask) buildRedirectAction(_ context.Context, actionCfg
 	}, nil
 }
 
-func (t *defaultModelBuildTask) buildForwardAction(ctx context.Context, ing ClassifiedIngress, actionCfg Action) (elbv2model.Action, error) {
+func (t *defaultModelBuildTask) buildForwardAction(ctx context.Context, protocol elbv2model.Protocol, ing ClassifiedIngress, actionCfg Action) (elbv2model.Action, error) {
 	if actionCfg.ForwardConfig == nil {
 		return elbv2model.Action{}, errors.New("missing ForwardConfig")
 	}
@@ -106,15 +108,17 @@ func (t *defaultModelBuildTask) buildForwardAction(ctx context.Context, ing Clas
 		if tgt.TargetGroupARN != nil {
 			tgARN = core.LiteralStringToken(*tgt.TargetGroupARN)
 		} else {
-			svcKey := types.NamespacedName{
-				Namespace: ing.Ing.Namespace,
-				Name:      awssdk.StringValue(tgt.ServiceName),
-			}
+			svcKey := tgt.serviceKey(ing.Ing.Namespace)
 			svc := t.backendServices[svcKey]
-			tg, err := t.buildTargetGroup(ctx, ing, svc, *tgt.ServicePort)
+			tg, err := t.buildTargetGroup(ctx, ing, svc, *tgt.ServicePort, tgt.TargetGroupAttributes)
 			if err != nil {
 				return elbv2model.Action{}, err
 			}
+			// HTTP2 and GRPC protocol versions are only supported by HTTPS listeners.
+			if protocol != elbv2model.ProtocolHTTPS && tg.Spec.ProtocolVersion != nil && *tg.Spec.ProtocolVersion != elbv2model.ProtocolVersionHTTP1 {
+				return elbv2model.Action{}, errors.Errorf("backend protocol version %v of service %v port %v requires HTTPS listener, got %v",
+					*tg.Spec.ProtocolVersion, svcKey, tgt.ServicePort.String(), protocol)
+			}
 			tgARN = tg.TargetGroupARN()
 		}
 		targetGroupTuples = append(targetGroupTuples, elbv2model.TargetGroupTuple{
@@ -164,13 +168,49 @@ func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context,
 		return elbv2model.Action{}, errors.New("missing IDPConfigOIDC")
 	}
 	onUnauthenticatedRequest := elbv2model.AuthenticateOIDCActionConditionalBehavior(authCfg.OnUnauthenticatedRequest)
+	clientID, clientSecret, err := t.loadOIDCCredentials(ctx, namespace, *authCfg.IDPConfigOIDC)
+	if err != nil {
+		return elbv2model.Action{}, err
+	}
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeAuthenticateOIDC,
+		AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
+			Issuer:                           authCfg.IDPConfigOIDC.Issuer,
+			AuthorizationEndpoint:            authCfg.IDPConfigOIDC.AuthorizationEndpoint,
+			TokenEndpoint:                    authCfg.IDPConfigOIDC.TokenEndpoint,
+			UserInfoEndpoint:                 authCfg.IDPConfigOIDC.UserInfoEndpoint,
+			ClientID:                         clientID,
+			ClientSecret:                     clientSecret,
+			AuthenticationRequestExtraParams: authCfg.IDPConfigOIDC.AuthenticationRequestExtraParams,
+			OnUnauthenticatedRequest:         &onUnauthenticatedRequest,
+			Scope:                            &authCfg.Scope,
+			SessionCookieName:                &authCfg.SessionCookieName,
+			SessionTimeout:                   &authCfg.SessionTimeout,
+		},
+	}, nil
+}
+
+// loadOIDCCredentials loads the clientID and clientSecret of OIDC IdP from the AWS Secrets Manager secret if specified, or the k8s secret otherwise.
+func (t *defaultModelBuildTask) loadOIDCCredentials(ctx context.Context, namespace string, idpConfig AuthIDPConfigOIDC) (string, string, error) {
+	if idpConfig.SecretARN != "" {
+		if t.awsSecretsProvider == nil {
+			return "", "", errors.New("AWS Secrets Manager secrets are not supported")
+		}
+		clientID, clientSecret, err := t.awsSecretsProvider.LoadOIDCCredentials(ctx, idpConfig.SecretARN)
+		if err != nil {
+			return "", "", err
+		}
+		t.awsSecretARNs.Insert(idpConfig.SecretARN)
+		return clientID, clientSecret, nil
+	}
+
 	secretKey := types.NamespacedName{
 		Namespace: namespace,
-		Name:      authCfg.IDPConfigOIDC.SecretName,
+		Name:      idpConfig.SecretName,
 	}
 	secret := &corev1.Secret{}
 	if err := t.k8sClient.Get(ctx, secretKey, secret); err != nil {
-		return elbv2model.Action{}, err
+		return "", "", err
 	}
 	rawClientID, ok := secret.Data["clientID"]
 	// AWSALBIngressController looks for clientId, we should be backwards-compatible here.
@@ -178,32 +218,17 @@ func (t *defaultModelBuildTask) buildAuthenticateOIDCAction(ctx context.Context,
 		rawClientID, ok = secret.Data["clientId"]
 	}
 	if !ok {
-		return elbv2model.Action{}, errors.Errorf("missing clientID, secret: %v", secretKey)
+		return "", "", errors.Errorf("missing clientID, secret: %v", secretKey)
 	}
 	rawClientSecret, ok := secret.Data["clientSecret"]
 	if !ok {
-		return elbv2model.Action{}, errors.Errorf("missing clientSecret, secret: %v", secretKey)
+		return "", "", errors.Errorf("missing clientSecret, secret: %v", secretKey)
 	}
 
 	t.secretKeys = append(t.secretKeys, secretKey)
 	clientID := strings.TrimRightFunc(string(rawClientID), unicode.IsSpace)
 	clientSecret := string(rawClientSecret)
-	return elbv2model.Action{
-		Type: elbv2model.ActionTypeAuthenticateOIDC,
-		AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
-			Issuer:                           authCfg.IDPConfigOIDC.Issuer,
-			AuthorizationEndpoint:            authCfg.IDPConfigOIDC.AuthorizationEndpoint,
-			TokenEndpoint:                    authCfg.IDPConfigOIDC.TokenEndpoint,
-			UserInfoEndpoint:                 authCfg.IDPConfigOIDC.UserInfoEndpoint,
-			ClientID:                         clientID,
-			ClientSecret:                     clientSecret,
-			AuthenticationRequestExtraParams: authCfg.IDPConfigOIDC.AuthenticationRequestExtraParams,
-			OnUnauthenticatedRequest:         &onUnauthenticatedRequest,
-			Scope:                            &authCfg.Scope,
-			SessionCookieName:                &authCfg.SessionCookieName,
-			SessionTimeout:                   &authCfg.SessionTimeout,
-		},
-	}, nil
+	return clientID, clientSecret, nil
 }
 
 func (t *defaultModelBuildTask) build404Action(_ context.Context) elbv2model.Action {
@@ -216,6 +241,95 @@ func (t *defaultModelBuildTask) build404Action(_ context.Context) elbv2model.Act
 	}
 }
 
+// buildIngressGroupDefaultActions builds the listener default actions of IngressGroup from the defaultAction of IngressClassParams.
+// it defaults to fixed 404 response if no IngressClassParams specifies defaultAction.
+func (t *defaultModelBuildTask) buildIngressGroupDefaultActions(ctx context.Context, protocol elbv2model.Protocol) ([]elbv2model.Action, error) {
+	var defaultAction *elbv2api.DefaultAction
+	var defaultActionMember ClassifiedIngress
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams == nil || member.IngClassConfig.IngClassParams.Spec.DefaultAction == nil {
+			continue
+		}
+		memberDefaultAction := member.IngClassConfig.IngClassParams.Spec.DefaultAction
+		if defaultAction == nil {
+			defaultAction = memberDefaultAction
+			defaultActionMember = member
+			continue
+		}
+		if !equality.Semantic.DeepEqual(defaultAction, memberDefaultAction) {
+			return nil, errors.Errorf("conflicting default actions from IngressClassParams: %v, %v",
+				defaultActionMember.IngClassConfig.IngClassParams.Name, member.IngClassConfig.IngClassParams.Name)
+		}
+	}
+	if defaultAction == nil {
+		return []elbv2model.Action{t.build404Action(ctx)}, nil
+	}
+
+	switch defaultAction.Type {
+	case elbv2api.DefaultActionTypeNotFound:
+		return []elbv2model.Action{t.build404Action(ctx)}, nil
+	case elbv2api.DefaultActionTypeMisdirectedRequest:
+		return []elbv2model.Action{t.build421Action(ctx)}, nil
+	case elbv2api.DefaultActionTypeRedirect:
+		if defaultAction.Redirect == nil {
+			return nil, errors.New("missing redirect config of default action")
+		}
+		statusCode := defaultAction.Redirect.StatusCode
+		if statusCode == "" {
+			statusCode = "HTTP_301"
+		}
+		return []elbv2model.Action{
+			{
+				Type: elbv2model.ActionTypeRedirect,
+				RedirectConfig: &elbv2model.RedirectActionConfig{
+					Host:       awssdk.String(defaultAction.Redirect.Host),
+					StatusCode: statusCode,
+				},
+			},
+		}, nil
+	case elbv2api.DefaultActionTypeForward:
+		if defaultAction.Forward == nil {
+			return nil, errors.New("missing forward config of default action")
+		}
+		forwardCfg := *defaultAction.Forward
+		svcKey := types.NamespacedName{Namespace: forwardCfg.ServiceNamespace, Name: forwardCfg.ServiceName}
+		if _, exists := t.backendServices[svcKey]; !exists {
+			svc := &corev1.Service{}
+			if err := t.k8sClient.Get(ctx, svcKey, svc); err != nil {
+				return nil, errors.Wrapf(err, "failed to load default action service: %v", svcKey)
+			}
+			t.backendServices[svcKey] = svc
+		}
+		forwardAction, err := t.buildForwardAction(ctx, protocol, defaultActionMember, Action{
+			Type: ActionTypeForward,
+			ForwardConfig: &ForwardActionConfig{
+				TargetGroups: []TargetGroupTuple{
+					{
+						ServiceName:      awssdk.String(forwardCfg.ServiceName),
+						ServiceNamespace: awssdk.String(forwardCfg.ServiceNamespace),
+						ServicePort:      &forwardCfg.ServicePort,
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return []elbv2model.Action{forwardAction}, nil
+	}
+	return nil, errors.Errorf("unknown default action type: %v", defaultAction.Type)
+}
+
+func (t *defaultModelBuildTask) build421Action(_ context.Context) elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "421",
+		},
+	}
+}
+
 func (t *defaultModelBuildTask) buildSSLRedirectAction(_ context.Context, sslRedirectConfig SSLRedirectConfig) elbv2model.Action {
 	return elbv2model.Action{
 		Type: elbv2model.ActionTypeRedirect,
@@ -226,3 +340,11 @@ func (t *defaultModelBuildTask) buildSSLRedirectAction(_ context.Context, sslRed
 		},
 	}
 }
+
+// buildAllowedServiceNamespaces computes the namespaces whose services can be referenced by actions of this Ingress.
+func (t *defaultModelBuildTask) buildAllowedServiceNamespaces(_ context.Context, ing ClassifiedIngress) []string {
+	if ing.IngClassConfig.IngClassParams == nil {
+		return nil
+	}
+	return ing.IngClassConfig.IngClassParams.Spec.AllowedServiceNamespaces
+}
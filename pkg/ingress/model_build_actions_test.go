@@ -3,20 +3,26 @@ package ingress
 import (
 	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 	"testing"
 )
 
 func Test_defaultModelBuildTask_buildAuthenticateOIDCAction(t *testing.T) {
 	type env struct {
-		secrets []*corev1.Secret
+		secrets    []*corev1.Secret
+		awsSecrets map[string]string
 	}
 	type args struct {
 		authCfg   AuthConfig
@@ -140,6 +146,46 @@ func Test_defaultModelBuildTask_buildAuthenticateOIDCAction(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "clientID & clientSecret configured via AWS Secrets Manager secret",
+			env: env{
+				awsSecrets: map[string]string{
+					"my-secret-arn": `{"clientID":"my-client-id","clientSecret":"my-client-secret"}`,
+				},
+			},
+			args: args{
+				authCfg: AuthConfig{
+					Type: AuthTypeOIDC,
+					IDPConfigOIDC: &AuthIDPConfigOIDC{
+						Issuer:                "https://example.com",
+						AuthorizationEndpoint: "https://authorization.example.com",
+						TokenEndpoint:         "https://token.example.com",
+						UserInfoEndpoint:      "https://userinfo.example.co",
+						SecretARN:             "my-secret-arn",
+					},
+					OnUnauthenticatedRequest: "authenticate",
+					Scope:                    "email",
+					SessionCookieName:        "my-session-cookie",
+					SessionTimeout:           65536,
+				},
+				namespace: "my-ns",
+			},
+			want: elbv2model.Action{
+				Type: elbv2model.ActionTypeAuthenticateOIDC,
+				AuthenticateOIDCConfig: &elbv2model.AuthenticateOIDCActionConfig{
+					Issuer:                   "https://example.com",
+					AuthorizationEndpoint:    "https://authorization.example.com",
+					TokenEndpoint:            "https://token.example.com",
+					UserInfoEndpoint:         "https://userinfo.example.co",
+					ClientID:                 "my-client-id",
+					ClientSecret:             "my-client-secret",
+					OnUnauthenticatedRequest: &authBehaviorAuthenticate,
+					Scope:                    awssdk.String("email"),
+					SessionCookieName:        awssdk.String("my-session-cookie"),
+					SessionTimeout:           awssdk.Int64(65536),
+				},
+			},
+		},
 		{
 			name: "missing IDPConfigOIDC",
 			args: args{
@@ -235,8 +281,14 @@ func Test_defaultModelBuildTask_buildAuthenticateOIDCAction(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
+			secretsManagerClient := &fakeSecretsManager{
+				secretStringByARN: tt.env.awsSecrets,
+			}
+
 			task := &defaultModelBuildTask{
-				k8sClient: k8sClient,
+				k8sClient:          k8sClient,
+				awsSecretsProvider: NewDefaultAWSSecretsProvider(secretsManagerClient, logr.New(&log.NullLogSink{})),
+				awsSecretARNs:      sets.NewString(),
 			}
 			got, err := task.buildAuthenticateOIDCAction(context.Background(), tt.args.namespace, tt.args.authCfg)
 			if tt.wantErr != nil {
@@ -284,3 +336,171 @@ func Test_defaultModelBuildTask_buildSSLRedirectAction(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildIngressGroupDefaultActions(t *testing.T) {
+	newMember := func(name string, ingClassParams *elbv2api.IngressClassParams) ClassifiedIngress {
+		return ClassifiedIngress{
+			Ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      name,
+				},
+			},
+			IngClassConfig: ClassConfiguration{
+				IngClassParams: ingClassParams,
+			},
+		}
+	}
+	newIngClassParams := func(name string, defaultAction *elbv2api.DefaultAction) *elbv2api.IngressClassParams {
+		return &elbv2api.IngressClassParams{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: elbv2api.IngressClassParamsSpec{
+				DefaultAction: defaultAction,
+			},
+		}
+	}
+	action404 := elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: awssdk.String("text/plain"),
+			StatusCode:  "404",
+		},
+	}
+	tests := []struct {
+		name    string
+		members []ClassifiedIngress
+		want    []elbv2model.Action
+		wantErr error
+	}{
+		{
+			name: "no IngressClassParams",
+			members: []ClassifiedIngress{
+				newMember("ing-1", nil),
+			},
+			want: []elbv2model.Action{action404},
+		},
+		{
+			name: "IngressClassParams without defaultAction",
+			members: []ClassifiedIngress{
+				newMember("ing-1", newIngClassParams("params", nil)),
+			},
+			want: []elbv2model.Action{action404},
+		},
+		{
+			name: "not-found defaultAction",
+			members: []ClassifiedIngress{
+				newMember("ing-1", newIngClassParams("params", &elbv2api.DefaultAction{
+					Type: elbv2api.DefaultActionTypeNotFound,
+				})),
+			},
+			want: []elbv2model.Action{action404},
+		},
+		{
+			name: "misdirected-request defaultAction",
+			members: []ClassifiedIngress{
+				newMember("ing-1", nil),
+				newMember("ing-2", newIngClassParams("params", &elbv2api.DefaultAction{
+					Type: elbv2api.DefaultActionTypeMisdirectedRequest,
+				})),
+			},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+						ContentType: awssdk.String("text/plain"),
+						StatusCode:  "421",
+					},
+				},
+			},
+		},
+		{
+			name: "redirect defaultAction with default status code",
+			members: []ClassifiedIngress{
+				newMember("ing-1", newIngClassParams("params", &elbv2api.DefaultAction{
+					Type: elbv2api.DefaultActionTypeRedirect,
+					Redirect: &elbv2api.DefaultActionRedirectConfig{
+						Host: "www.example.com",
+					},
+				})),
+			},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeRedirect,
+					RedirectConfig: &elbv2model.RedirectActionConfig{
+						Host:       awssdk.String("www.example.com"),
+						StatusCode: "HTTP_301",
+					},
+				},
+			},
+		},
+		{
+			name: "redirect defaultAction with explicit status code",
+			members: []ClassifiedIngress{
+				newMember("ing-1", newIngClassParams("params", &elbv2api.DefaultAction{
+					Type: elbv2api.DefaultActionTypeRedirect,
+					Redirect: &elbv2api.DefaultActionRedirectConfig{
+						Host:       "www.example.com",
+						StatusCode: "HTTP_302",
+					},
+				})),
+			},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeRedirect,
+					RedirectConfig: &elbv2model.RedirectActionConfig{
+						Host:       awssdk.String("www.example.com"),
+						StatusCode: "HTTP_302",
+					},
+				},
+			},
+		},
+		{
+			name: "same defaultAction from multiple IngressClassParams",
+			members: []ClassifiedIngress{
+				newMember("ing-1", newIngClassParams("params-1", &elbv2api.DefaultAction{
+					Type: elbv2api.DefaultActionTypeMisdirectedRequest,
+				})),
+				newMember("ing-2", newIngClassParams("params-2", &elbv2api.DefaultAction{
+					Type: elbv2api.DefaultActionTypeMisdirectedRequest,
+				})),
+			},
+			want: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeFixedResponse,
+					FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+						ContentType: awssdk.String("text/plain"),
+						StatusCode:  "421",
+					},
+				},
+			},
+		},
+		{
+			name: "conflicting defaultAction from multiple IngressClassParams",
+			members: []ClassifiedIngress{
+				newMember("ing-1", newIngClassParams("params-1", &elbv2api.DefaultAction{
+					Type: elbv2api.DefaultActionTypeMisdirectedRequest,
+				})),
+				newMember("ing-2", newIngClassParams("params-2", &elbv2api.DefaultAction{
+					Type: elbv2api.DefaultActionTypeNotFound,
+				})),
+			},
+			wantErr: errors.New("conflicting default actions from IngressClassParams: params-1, params-2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup: Group{Members: tt.members},
+			}
+			got, err := task.buildIngressGroupDefaultActions(context.Background(), elbv2model.ProtocolHTTP)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
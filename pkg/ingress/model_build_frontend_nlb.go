@@ -0,0 +1,443 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+const (
+	resourceIDFrontendNlb              = "FrontendNlb"
+	resourceIDFrontendNlbSecurityGroup = "ManagedFrontendNlbSecurityGroup"
+
+	frontendNlbHealthCheckIntervalSeconds         = 15
+	frontendNlbHealthCheckTimeoutSeconds          = 5
+	frontendNlbHealthCheckHealthyThresholdCount   = 3
+	frontendNlbHealthCheckUnhealthyThresholdCount = 3
+	frontendNlbHealthCheckDefaultPath             = "/"
+	frontendNlbHealthCheckDefaultSuccessCodes     = "200"
+)
+
+// frontendNlbConfig is the configuration for the NLB provisioned in front of the ALB.
+type frontendNlbConfig struct {
+	// scheme of the frontend NLB, defaults to the scheme of ALB.
+	scheme *elbv2model.LoadBalancerScheme
+	// subnets for the frontend NLB, defaults to the subnets of ALB.
+	subnetNameOrIDs []string
+	// EIP allocations for the frontend NLB, one per subnet.
+	eipAllocations []string
+
+	healthCheckProtocol     elbv2model.Protocol
+	healthCheckPath         string
+	healthCheckSuccessCodes string
+}
+
+// buildFrontendNlbConfig builds the frontend NLB configuration for the IngressGroup.
+// returns nil if the frontend NLB is not enabled.
+func (t *defaultModelBuildTask) buildFrontendNlbConfig(_ context.Context) (*frontendNlbConfig, error) {
+	enabled, err := t.buildFrontendNlbEnabled()
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, nil
+	}
+
+	cfg := &frontendNlbConfig{
+		healthCheckProtocol:     elbv2model.ProtocolHTTP,
+		healthCheckPath:         frontendNlbHealthCheckDefaultPath,
+		healthCheckSuccessCodes: frontendNlbHealthCheckDefaultSuccessCodes,
+	}
+	rawScheme, err := t.buildFrontendNlbStringSetting(annotations.IngressSuffixFrontendNLBScheme, func(params *elbv2api.FrontendNlbConfiguration) string {
+		if params.Scheme == nil {
+			return ""
+		}
+		return string(*params.Scheme)
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch rawScheme {
+	case "":
+	case string(elbv2model.LoadBalancerSchemeInternetFacing), string(elbv2model.LoadBalancerSchemeInternal):
+		scheme := elbv2model.LoadBalancerScheme(rawScheme)
+		cfg.scheme = &scheme
+	default:
+		return nil, errors.Errorf("unknown frontend NLB scheme: %v", rawScheme)
+	}
+
+	rawHealthCheckProtocol, err := t.buildFrontendNlbStringSetting(annotations.IngressSuffixFrontendNLBHealthCheckProtocol, func(params *elbv2api.FrontendNlbConfiguration) string {
+		if params.HealthCheck == nil {
+			return ""
+		}
+		return string(params.HealthCheck.Protocol)
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch rawHealthCheckProtocol {
+	case "":
+	case string(elbv2model.ProtocolHTTP), string(elbv2model.ProtocolHTTPS):
+		cfg.healthCheckProtocol = elbv2model.Protocol(rawHealthCheckProtocol)
+	default:
+		return nil, errors.Errorf("unsupported frontend NLB healthcheck protocol: %v", rawHealthCheckProtocol)
+	}
+	if rawHealthCheckPath, err := t.buildFrontendNlbStringSetting(annotations.IngressSuffixFrontendNLBHealthCheckPath, func(params *elbv2api.FrontendNlbConfiguration) string {
+		if params.HealthCheck == nil {
+			return ""
+		}
+		return params.HealthCheck.Path
+	}); err != nil {
+		return nil, err
+	} else if rawHealthCheckPath != "" {
+		cfg.healthCheckPath = rawHealthCheckPath
+	}
+	if rawSuccessCodes, err := t.buildFrontendNlbStringSetting(annotations.IngressSuffixFrontendNLBSuccessCodes, func(params *elbv2api.FrontendNlbConfiguration) string {
+		if params.HealthCheck == nil {
+			return ""
+		}
+		return params.HealthCheck.SuccessCodes
+	}); err != nil {
+		return nil, err
+	} else if rawSuccessCodes != "" {
+		cfg.healthCheckSuccessCodes = rawSuccessCodes
+	}
+
+	if cfg.subnetNameOrIDs, err = t.buildFrontendNlbStringSliceSetting(annotations.IngressSuffixFrontendNLBSubnets, true, func(params *elbv2api.FrontendNlbConfiguration) []string {
+		return params.Subnets
+	}); err != nil {
+		return nil, err
+	}
+	if cfg.eipAllocations, err = t.buildFrontendNlbStringSliceSetting(annotations.IngressSuffixFrontendNLBEIPAllocations, false, func(params *elbv2api.FrontendNlbConfiguration) []string {
+		return params.EIPAllocations
+	}); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// buildFrontendNlbEnabled determines whether the frontend NLB is enabled from IngressClassParams and annotations of members.
+func (t *defaultModelBuildTask) buildFrontendNlbEnabled() (bool, error) {
+	var enabledProvider *types.NamespacedName
+	enabled := false
+	for _, member := range t.ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		rawEnabled := false
+		if params := frontendNlbParams(member); params != nil {
+			rawEnabled = params.Enabled
+		} else {
+			exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixEnableFrontendNLB, &rawEnabled, member.Ing.Annotations)
+			if err != nil {
+				return false, errors.Wrapf(err, "ingress: %v", ingKey.String())
+			}
+			if !exists {
+				continue
+			}
+		}
+		if enabledProvider == nil {
+			enabledProvider = &ingKey
+			enabled = rawEnabled
+		} else if enabled != rawEnabled {
+			return false, errors.Errorf("conflicting frontend NLB enablement, %v: %v | %v: %v",
+				*enabledProvider, enabled, ingKey, rawEnabled)
+		}
+	}
+	return enabled, nil
+}
+
+// buildFrontendNlbStringSetting determines a frontend NLB setting from IngressClassParams and annotations of members,
+// the settings of IngressClassParams take precedence over the annotations of the same member.
+func (t *defaultModelBuildTask) buildFrontendNlbStringSetting(annotation string, paramsValue func(params *elbv2api.FrontendNlbConfiguration) string) (string, error) {
+	explicitValues := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		rawValue := ""
+		if params := frontendNlbParams(member); params != nil {
+			if rawValue = paramsValue(params); rawValue == "" {
+				continue
+			}
+		} else if exists := t.annotationParser.ParseStringAnnotation(annotation, &rawValue, member.Ing.Annotations); !exists {
+			continue
+		}
+		explicitValues.Insert(rawValue)
+	}
+	if len(explicitValues) > 1 {
+		return "", errors.Errorf("conflicting %v: %v", annotation, explicitValues.List())
+	}
+	rawValue, _ := explicitValues.PopAny()
+	return rawValue, nil
+}
+
+// buildFrontendNlbStringSliceSetting determines a frontend NLB setting from IngressClassParams and annotations of members,
+// the settings of IngressClassParams take precedence over the annotations of the same member.
+func (t *defaultModelBuildTask) buildFrontendNlbStringSliceSetting(annotation string, ignoreOrder bool, paramsValues func(params *elbv2api.FrontendNlbConfiguration) []string) ([]string, error) {
+	var chosenValues []string
+	for _, member := range t.ingGroup.Members {
+		var rawValues []string
+		if params := frontendNlbParams(member); params != nil {
+			if rawValues = paramsValues(params); len(rawValues) == 0 {
+				continue
+			}
+		} else if exists := t.annotationParser.ParseStringSliceAnnotation(annotation, &rawValues, member.Ing.Annotations); !exists {
+			continue
+		}
+		if chosenValues == nil {
+			chosenValues = rawValues
+			continue
+		}
+		var opts []cmp.Option
+		if ignoreOrder {
+			opts = append(opts, equality.IgnoreStringSliceOrder())
+		}
+		if !cmp.Equal(chosenValues, rawValues, opts...) {
+			return nil, errors.Errorf("conflicting %v: %v | %v", annotation, chosenValues, rawValues)
+		}
+	}
+	return chosenValues, nil
+}
+
+// frontendNlbParams returns the frontend NLB settings of the IngressClassParams of member, nil if absent.
+func frontendNlbParams(member ClassifiedIngress) *elbv2api.FrontendNlbConfiguration {
+	if member.IngClassConfig.IngClassParams == nil {
+		return nil
+	}
+	return member.IngClassConfig.IngClassParams.Spec.FrontendNlb
+}
+
+// buildFrontendNlbSecurityGroup builds the managed SecurityGroup for the frontend NLB.
+// it must be built before the ALB, so that the ALB's managed SecurityGroup can allow traffic from it.
+func (t *defaultModelBuildTask) buildFrontendNlbSecurityGroup(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) (*ec2model.SecurityGroup, error) {
+	tags, err := t.buildManagedSecurityGroupTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ingressPermissions, err := t.buildManagedSecurityGroupIngressPermissions(ctx, listenPortConfigByPort, elbv2model.IPAddressTypeIPV4)
+	if err != nil {
+		return nil, err
+	}
+	sg := ec2model.NewSecurityGroup(t.stack, resourceIDFrontendNlbSecurityGroup, ec2model.SecurityGroupSpec{
+		GroupName:   t.buildFrontendNlbSecurityGroupName(ctx),
+		Description: "[k8s] Managed SecurityGroup for frontend NLB",
+		Tags:        tags,
+		Ingress:     ingressPermissions,
+	})
+	t.frontendNlbSGIDToken = sg.GroupID()
+	return sg, nil
+}
+
+func (t *defaultModelBuildTask) buildFrontendNlbSecurityGroupName(_ context.Context) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(resourceIDFrontendNlb))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	if t.ingGroup.ID.IsExplicit() {
+		payload := invalidSecurityGroupNamePtn.ReplaceAllString(t.ingGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+
+	sanitizedNamespace := invalidSecurityGroupNamePtn.ReplaceAllString(t.ingGroup.ID.Namespace, "")
+	sanitizedName := invalidSecurityGroupNamePtn.ReplaceAllString(t.ingGroup.ID.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+// buildFrontendNlb builds the NLB in front of the ALB, with a listener per ALB listener port
+// that forwards to a TargetGroup with the ALB registered as target.
+func (t *defaultModelBuildTask) buildFrontendNlb(ctx context.Context, cfg frontendNlbConfig, alb *elbv2model.LoadBalancer, listenPortConfigByPort map[int64]listenPortConfig) error {
+	nlbSpec, err := t.buildFrontendNlbSpec(ctx, cfg, alb)
+	if err != nil {
+		return err
+	}
+	nlb := elbv2model.NewLoadBalancer(t.stack, resourceIDFrontendNlb, nlbSpec)
+
+	ports := make([]int64, 0, len(listenPortConfigByPort))
+	for port := range listenPortConfigByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i] < ports[j]
+	})
+	for _, port := range ports {
+		resID := fmt.Sprintf("%v-%v", resourceIDFrontendNlb, port)
+		tgSpec, err := t.buildFrontendNlbTargetGroupSpec(ctx, cfg, port)
+		if err != nil {
+			return err
+		}
+		tg := elbv2model.NewTargetGroup(t.stack, resID, tgSpec)
+		elbv2model.NewListener(t.stack, resID, elbv2model.ListenerSpec{
+			LoadBalancerARN: nlb.LoadBalancerARN(),
+			Port:            port,
+			Protocol:        elbv2model.ProtocolTCP,
+			DefaultActions: []elbv2model.Action{
+				{
+					Type: elbv2model.ActionTypeForward,
+					ForwardConfig: &elbv2model.ForwardActionConfig{
+						TargetGroups: []elbv2model.TargetGroupTuple{
+							{
+								TargetGroupARN: tg.TargetGroupARN(),
+							},
+						},
+					},
+				},
+			},
+			Tags: nlbSpec.Tags,
+		})
+		elbv2model.NewALBTarget(t.stack, resID, elbv2model.ALBTargetSpec{
+			TargetGroupARN:  tg.TargetGroupARN(),
+			LoadBalancerARN: alb.LoadBalancerARN(),
+			Port:            port,
+		})
+	}
+	return nil
+}
+
+func (t *defaultModelBuildTask) buildFrontendNlbSpec(ctx context.Context, cfg frontendNlbConfig, alb *elbv2model.LoadBalancer) (elbv2model.LoadBalancerSpec, error) {
+	scheme := *alb.Spec.Scheme
+	if cfg.scheme != nil {
+		scheme = *cfg.scheme
+	}
+	subnetMappings, err := t.buildFrontendNlbSubnetMappings(ctx, cfg, alb, scheme)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	ipAddressType := elbv2model.IPAddressTypeIPV4
+	return elbv2model.LoadBalancerSpec{
+		Name:           t.buildFrontendNlbName(ctx, scheme),
+		Type:           elbv2model.LoadBalancerTypeNetwork,
+		Scheme:         &scheme,
+		IPAddressType:  &ipAddressType,
+		SubnetMappings: subnetMappings,
+		SecurityGroups: []core.StringToken{t.frontendNlbSGIDToken},
+		Tags:           tags,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildFrontendNlbName(_ context.Context, scheme elbv2model.LoadBalancerScheme) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(scheme))
+	_, _ = uuidHash.Write([]byte(resourceIDFrontendNlb))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	if t.ingGroup.ID.IsExplicit() {
+		payload := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildFrontendNlbSubnetMappings(ctx context.Context, cfg frontendNlbConfig, alb *elbv2model.LoadBalancer, scheme elbv2model.LoadBalancerScheme) ([]elbv2model.SubnetMapping, error) {
+	var subnetMappings []elbv2model.SubnetMapping
+	switch {
+	case len(cfg.subnetNameOrIDs) != 0:
+		chosenSubnets, err := t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, cfg.subnetNameOrIDs,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBScheme(scheme),
+		)
+		if err != nil {
+			return nil, err
+		}
+		subnetMappings = buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets)
+	case scheme == *alb.Spec.Scheme:
+		for _, albSubnetMapping := range alb.Spec.SubnetMappings {
+			subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
+				SubnetID: albSubnetMapping.SubnetID,
+			})
+		}
+	default:
+		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx,
+			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+			networking.WithSubnetsResolveLBScheme(scheme),
+			networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't auto-discover subnets for frontend NLB")
+		}
+		subnetMappings = buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets)
+	}
+
+	if len(cfg.eipAllocations) != 0 {
+		if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+			return nil, errors.New("frontend NLB EIP allocations can only be set for internet-facing scheme")
+		}
+		if len(cfg.eipAllocations) != len(subnetMappings) {
+			return nil, errors.Errorf("count of frontend NLB EIP allocations(%d) and subnets(%d) must match", len(cfg.eipAllocations), len(subnetMappings))
+		}
+		for i := range subnetMappings {
+			subnetMappings[i].AllocationID = core.LiteralStringToken(cfg.eipAllocations[i])
+		}
+	}
+	return subnetMappings, nil
+}
+
+func (t *defaultModelBuildTask) buildFrontendNlbTargetGroupSpec(ctx context.Context, cfg frontendNlbConfig, port int64) (elbv2model.TargetGroupSpec, error) {
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return elbv2model.TargetGroupSpec{}, err
+	}
+	healthCheckPort := intstr.FromString(healthCheckPortTrafficPort)
+	return elbv2model.TargetGroupSpec{
+		Name:       t.buildFrontendNlbTargetGroupName(ctx, port),
+		TargetType: elbv2model.TargetTypeALB,
+		Port:       port,
+		Protocol:   elbv2model.ProtocolTCP,
+		HealthCheckConfig: &elbv2model.TargetGroupHealthCheckConfig{
+			Port:     &healthCheckPort,
+			Protocol: &cfg.healthCheckProtocol,
+			Path:     awssdk.String(cfg.healthCheckPath),
+			Matcher: &elbv2model.HealthCheckMatcher{
+				HTTPCode: awssdk.String(cfg.healthCheckSuccessCodes),
+			},
+			IntervalSeconds:         awssdk.Int64(frontendNlbHealthCheckIntervalSeconds),
+			TimeoutSeconds:          awssdk.Int64(frontendNlbHealthCheckTimeoutSeconds),
+			HealthyThresholdCount:   awssdk.Int64(frontendNlbHealthCheckHealthyThresholdCount),
+			UnhealthyThresholdCount: awssdk.Int64(frontendNlbHealthCheckUnhealthyThresholdCount),
+		},
+		Tags: tags,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildFrontendNlbTargetGroupName(_ context.Context, port int64) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(resourceIDFrontendNlb))
+	_, _ = uuidHash.Write([]byte(strconv.Itoa(int(port))))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	if t.ingGroup.ID.IsExplicit() {
+		payload := invalidTargetGroupNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+
+	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(t.ingGroup.ID.Namespace, "")
+	sanitizedName := invalidTargetGroupNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
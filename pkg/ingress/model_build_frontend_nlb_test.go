@@ -0,0 +1,345 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+func Test_defaultModelBuildTask_buildFrontendNlbConfig(t *testing.T) {
+	schemeInternetFacing := elbv2model.LoadBalancerSchemeInternetFacing
+	schemeInternal := elbv2api.LoadBalancerSchemeInternal
+	tests := []struct {
+		name        string
+		annotations []map[string]string
+		// frontendNlbParams is the frontend NLB settings of the IngressClassParams of the member of the same index.
+		frontendNlbParams []*elbv2api.FrontendNlbConfiguration
+		want              *frontendNlbConfig
+		wantErr           error
+	}{
+		{
+			name: "frontend NLB not enabled",
+			annotations: []map[string]string{
+				{},
+			},
+			want: nil,
+		},
+		{
+			name: "frontend NLB enabled with defaults",
+			annotations: []map[string]string{
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb": "true",
+				},
+				{},
+			},
+			want: &frontendNlbConfig{
+				healthCheckProtocol:     elbv2model.ProtocolHTTP,
+				healthCheckPath:         "/",
+				healthCheckSuccessCodes: "200",
+			},
+		},
+		{
+			name: "frontend NLB enabled with explicit settings",
+			annotations: []map[string]string{
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb":               "true",
+					"alb.ingress.kubernetes.io/frontend-nlb-scheme":               "internet-facing",
+					"alb.ingress.kubernetes.io/frontend-nlb-subnets":              "subnet-a, subnet-b",
+					"alb.ingress.kubernetes.io/frontend-nlb-eip-allocations":      "eipalloc-a, eipalloc-b",
+					"alb.ingress.kubernetes.io/frontend-nlb-healthcheck-protocol": "HTTPS",
+					"alb.ingress.kubernetes.io/frontend-nlb-healthcheck-path":     "/healthz",
+					"alb.ingress.kubernetes.io/frontend-nlb-success-codes":        "200-299",
+				},
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb":  "true",
+					"alb.ingress.kubernetes.io/frontend-nlb-subnets": "subnet-b, subnet-a",
+				},
+			},
+			want: &frontendNlbConfig{
+				scheme:                  &schemeInternetFacing,
+				subnetNameOrIDs:         []string{"subnet-a", "subnet-b"},
+				eipAllocations:          []string{"eipalloc-a", "eipalloc-b"},
+				healthCheckProtocol:     elbv2model.ProtocolHTTPS,
+				healthCheckPath:         "/healthz",
+				healthCheckSuccessCodes: "200-299",
+			},
+		},
+		{
+			name: "conflicting frontend NLB enablement",
+			annotations: []map[string]string{
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb": "true",
+				},
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb": "false",
+				},
+			},
+			wantErr: errors.New("conflicting frontend NLB enablement, awesome-ns/ing-0: true | awesome-ns/ing-1: false"),
+		},
+		{
+			name: "conflicting frontend NLB scheme",
+			annotations: []map[string]string{
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb": "true",
+					"alb.ingress.kubernetes.io/frontend-nlb-scheme": "internal",
+				},
+				{
+					"alb.ingress.kubernetes.io/frontend-nlb-scheme": "internet-facing",
+				},
+			},
+			wantErr: errors.New("conflicting frontend-nlb-scheme: [internal internet-facing]"),
+		},
+		{
+			name: "frontend NLB enabled via IngressClassParams",
+			annotations: []map[string]string{
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb": "false",
+					"alb.ingress.kubernetes.io/frontend-nlb-scheme": "internet-facing",
+				},
+				{},
+			},
+			frontendNlbParams: []*elbv2api.FrontendNlbConfiguration{
+				{
+					Enabled:        true,
+					Scheme:         &schemeInternal,
+					Subnets:        []string{"subnet-b", "subnet-a"},
+					EIPAllocations: []string{"eipalloc-a", "eipalloc-b"},
+					HealthCheck: &elbv2api.FrontendNlbHealthCheckConfiguration{
+						Protocol:     elbv2api.FrontendNlbHealthCheckProtocolHTTPS,
+						Path:         "/healthz",
+						SuccessCodes: "200-299",
+					},
+				},
+				nil,
+			},
+			want: &frontendNlbConfig{
+				scheme:                  (*elbv2model.LoadBalancerScheme)(&schemeInternal),
+				subnetNameOrIDs:         []string{"subnet-b", "subnet-a"},
+				eipAllocations:          []string{"eipalloc-a", "eipalloc-b"},
+				healthCheckProtocol:     elbv2model.ProtocolHTTPS,
+				healthCheckPath:         "/healthz",
+				healthCheckSuccessCodes: "200-299",
+			},
+		},
+		{
+			name: "frontend NLB enabled via IngressClassParams with defaults",
+			annotations: []map[string]string{
+				{},
+			},
+			frontendNlbParams: []*elbv2api.FrontendNlbConfiguration{
+				{
+					Enabled: true,
+				},
+			},
+			want: &frontendNlbConfig{
+				healthCheckProtocol:     elbv2model.ProtocolHTTP,
+				healthCheckPath:         "/",
+				healthCheckSuccessCodes: "200",
+			},
+		},
+		{
+			name: "frontend NLB disabled via IngressClassParams",
+			annotations: []map[string]string{
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb": "true",
+				},
+			},
+			frontendNlbParams: []*elbv2api.FrontendNlbConfiguration{
+				{
+					Enabled: false,
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "conflicting frontend NLB scheme between IngressClassParams and annotation",
+			annotations: []map[string]string{
+				{},
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb": "true",
+					"alb.ingress.kubernetes.io/frontend-nlb-scheme": "internet-facing",
+				},
+			},
+			frontendNlbParams: []*elbv2api.FrontendNlbConfiguration{
+				{
+					Enabled: true,
+					Scheme:  &schemeInternal,
+				},
+				nil,
+			},
+			wantErr: errors.New("conflicting frontend-nlb-scheme: [internal internet-facing]"),
+		},
+		{
+			name: "unsupported frontend NLB healthcheck protocol",
+			annotations: []map[string]string{
+				{
+					"alb.ingress.kubernetes.io/enable-frontend-nlb":               "true",
+					"alb.ingress.kubernetes.io/frontend-nlb-healthcheck-protocol": "TCP",
+				},
+			},
+			wantErr: errors.New("unsupported frontend NLB healthcheck protocol: TCP"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var members []ClassifiedIngress
+			for i, ingAnnotations := range tt.annotations {
+				member := ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:   "awesome-ns",
+							Name:        fmt.Sprintf("ing-%d", i),
+							Annotations: ingAnnotations,
+						},
+					},
+				}
+				if i < len(tt.frontendNlbParams) && tt.frontendNlbParams[i] != nil {
+					member.IngClassConfig.IngClassParams = &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							FrontendNlb: tt.frontendNlbParams[i],
+						},
+					}
+				}
+				members = append(members, member)
+			}
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				ingGroup: Group{
+					ID:      GroupID{Name: "awesome-group"},
+					Members: members,
+				},
+			}
+			got, err := task.buildFrontendNlbConfig(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildFrontendNlbSubnetMappings(t *testing.T) {
+	schemeInternal := elbv2model.LoadBalancerSchemeInternal
+	schemeInternetFacing := elbv2model.LoadBalancerSchemeInternetFacing
+	alb := &elbv2model.LoadBalancer{
+		Spec: elbv2model.LoadBalancerSpec{
+			Scheme: &schemeInternetFacing,
+			SubnetMappings: []elbv2model.SubnetMapping{
+				{SubnetID: "subnet-a"},
+				{SubnetID: "subnet-b"},
+			},
+		},
+	}
+	type resolveViaNameOrIDSliceCall struct {
+		subnetNameOrIDs []string
+		subnets         []ec2types.Subnet
+	}
+	tests := []struct {
+		name                         string
+		cfg                          frontendNlbConfig
+		scheme                       elbv2model.LoadBalancerScheme
+		resolveViaNameOrIDSliceCalls []resolveViaNameOrIDSliceCall
+		want                         []elbv2model.SubnetMapping
+		wantErr                      error
+	}{
+		{
+			name:   "reuse ALB subnets",
+			scheme: schemeInternetFacing,
+			want: []elbv2model.SubnetMapping{
+				{SubnetID: "subnet-a"},
+				{SubnetID: "subnet-b"},
+			},
+		},
+		{
+			name: "reuse ALB subnets with EIP allocations",
+			cfg: frontendNlbConfig{
+				eipAllocations: []string{"eipalloc-a", "eipalloc-b"},
+			},
+			scheme: schemeInternetFacing,
+			want: []elbv2model.SubnetMapping{
+				{SubnetID: "subnet-a", AllocationID: core.LiteralStringToken("eipalloc-a")},
+				{SubnetID: "subnet-b", AllocationID: core.LiteralStringToken("eipalloc-b")},
+			},
+		},
+		{
+			name: "explicit subnets",
+			cfg: frontendNlbConfig{
+				scheme:          &schemeInternal,
+				subnetNameOrIDs: []string{"subnet-c"},
+			},
+			scheme: schemeInternal,
+			resolveViaNameOrIDSliceCalls: []resolveViaNameOrIDSliceCall{
+				{
+					subnetNameOrIDs: []string{"subnet-c"},
+					subnets:         []ec2types.Subnet{{SubnetId: awssdk.String("subnet-c")}},
+				},
+			},
+			want: []elbv2model.SubnetMapping{
+				{SubnetID: "subnet-c"},
+			},
+		},
+		{
+			name: "EIP allocations count mismatch",
+			cfg: frontendNlbConfig{
+				eipAllocations: []string{"eipalloc-a"},
+			},
+			scheme:  schemeInternetFacing,
+			wantErr: errors.New("count of frontend NLB EIP allocations(1) and subnets(2) must match"),
+		},
+		{
+			name: "EIP allocations with internal scheme",
+			cfg: frontendNlbConfig{
+				scheme:          &schemeInternal,
+				subnetNameOrIDs: []string{"subnet-c"},
+				eipAllocations:  []string{"eipalloc-c"},
+			},
+			scheme: schemeInternal,
+			resolveViaNameOrIDSliceCalls: []resolveViaNameOrIDSliceCall{
+				{
+					subnetNameOrIDs: []string{"subnet-c"},
+					subnets:         []ec2types.Subnet{{SubnetId: awssdk.String("subnet-c")}},
+				},
+			},
+			wantErr: errors.New("frontend NLB EIP allocations can only be set for internet-facing scheme"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			subnetsResolver := networkingpkg.NewMockSubnetsResolver(ctrl)
+			for _, call := range tt.resolveViaNameOrIDSliceCalls {
+				subnetsResolver.EXPECT().ResolveViaNameOrIDSlice(gomock.Any(), call.subnetNameOrIDs, gomock.Any()).Return(call.subnets, nil)
+			}
+			task := &defaultModelBuildTask{
+				subnetsResolver: subnetsResolver,
+				ingGroup:        Group{ID: GroupID{Name: "awesome-group"}},
+				stack:           core.NewDefaultStack(core.StackID(types.NamespacedName{Name: "awesome-group"})),
+			}
+			got, err := task.buildFrontendNlbSubnetMappings(context.Background(), tt.cfg, alb, tt.scheme)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
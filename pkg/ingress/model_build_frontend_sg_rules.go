@@ -0,0 +1,176 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+)
+
+const (
+	// frontendSGRuleDescriptionPrefix marks the SecurityGroup rules owned by FrontendSecurityGroupRules.
+	frontendSGRuleDescriptionPrefix = "FrontendSecurityGroupRule"
+)
+
+// buildFrontendSecurityGroupRulePermissions builds the inbound permissions declared by FrontendSecurityGroupRules referencing this IngressGroup.
+// Invalid FrontendSecurityGroupRules are reported via events and skipped, so that they don't block the reconciliation of IngressGroup.
+func (t *defaultModelBuildTask) buildFrontendSecurityGroupRulePermissions(ctx context.Context) ([]ec2model.IPPermission, error) {
+	if !t.featureGates.Enabled(config.FrontendSecurityGroupRule) {
+		return nil, nil
+	}
+	fsgrList := &elbv2api.FrontendSecurityGroupRuleList{}
+	if err := t.k8sClient.List(ctx, fsgrList); err != nil {
+		return nil, errors.Wrap(err, "failed to list FrontendSecurityGroupRules")
+	}
+	fsgrs := fsgrList.Items
+	sort.Slice(fsgrs, func(i, j int) bool {
+		return k8s.NamespacedName(&fsgrs[i]).String() < k8s.NamespacedName(&fsgrs[j]).String()
+	})
+
+	memberNamespaces := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		memberNamespaces.Insert(member.Ing.Namespace)
+	}
+	var permissions []ec2model.IPPermission
+	for i := range fsgrs {
+		fsgr := &fsgrs[i]
+		if !isFrontendSecurityGroupRuleForGroup(fsgr, t.ingGroup.ID) {
+			continue
+		}
+		// rules are only accepted from the namespaces of IngressGroup members, so that other namespaces cannot open the LoadBalancer.
+		if !memberNamespaces.Has(fsgr.Namespace) {
+			t.recordFrontendSecurityGroupRuleWarning(fsgr, errors.Errorf("no Ingress of IngressGroup %v in namespace %v", t.ingGroup.ID, fsgr.Namespace))
+			continue
+		}
+		fsgrPermissions, err := buildFrontendSecurityGroupRuleIPPermissions(fsgr)
+		if err != nil {
+			t.recordFrontendSecurityGroupRuleWarning(fsgr, err)
+			continue
+		}
+		permissions = append(permissions, fsgrPermissions...)
+	}
+	return permissions, nil
+}
+
+func (t *defaultModelBuildTask) recordFrontendSecurityGroupRuleWarning(fsgr *elbv2api.FrontendSecurityGroupRule, err error) {
+	t.logger.Info("ignoring FrontendSecurityGroupRule", "frontendSecurityGroupRule", k8s.NamespacedName(fsgr), "reason", err.Error())
+	if t.eventRecorder != nil {
+		t.eventRecorder.Event(fsgr, corev1.EventTypeWarning, k8s.FrontendSecurityGroupRuleEventReasonInvalidRule,
+			fmt.Sprintf("Ignored rules due to %v", err))
+	}
+}
+
+// isFrontendSecurityGroupRuleForGroup checks whether FrontendSecurityGroupRule references the IngressGroup.
+func isFrontendSecurityGroupRuleForGroup(fsgr *elbv2api.FrontendSecurityGroupRule, groupID GroupID) bool {
+	if fsgr.Spec.Group != nil && fsgr.Spec.IngressName == "" {
+		return groupID == NewGroupIDForExplicitGroup(fsgr.Spec.Group.Name)
+	}
+	if fsgr.Spec.Group == nil && fsgr.Spec.IngressName != "" {
+		return groupID == NewGroupIDForImplicitGroup(types.NamespacedName{Namespace: fsgr.Namespace, Name: fsgr.Spec.IngressName})
+	}
+	return false
+}
+
+// buildFrontendSecurityGroupRuleIPPermissions builds the inbound permissions of FrontendSecurityGroupRule.
+// The description of each permission carries the FrontendSecurityGroupRule's key as ownership marker.
+func buildFrontendSecurityGroupRuleIPPermissions(fsgr *elbv2api.FrontendSecurityGroupRule) ([]ec2model.IPPermission, error) {
+	var permissions []ec2model.IPPermission
+	for _, rule := range fsgr.Spec.Rules {
+		protocol := elbv2api.NetworkingProtocolTCP
+		if rule.Protocol != nil {
+			protocol = *rule.Protocol
+		}
+		fromPort := rule.Port
+		toPort := rule.Port
+		if rule.EndPort != nil {
+			toPort = *rule.EndPort
+		}
+		if toPort < fromPort {
+			return nil, errors.Errorf("endPort %v must not be less than port %v", toPort, fromPort)
+		}
+		if len(rule.CIDRs) == 0 && len(rule.PrefixListIDs) == 0 {
+			return nil, errors.Errorf("at least one of cidrs and prefixListIDs must be specified for port %v", rule.Port)
+		}
+		description := fmt.Sprintf("%s %s", frontendSGRuleDescriptionPrefix, k8s.NamespacedName(fsgr))
+		if rule.Description != "" {
+			description = fmt.Sprintf("%s: %s", description, rule.Description)
+		}
+
+		for _, cidr := range rule.CIDRs {
+			ip, _, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return nil, errors.Errorf("invalid CIDR: %v", cidr)
+			}
+			permission := ec2model.IPPermission{
+				IPProtocol: strings.ToLower(string(protocol)),
+				FromPort:   awssdk.Int64(fromPort),
+				ToPort:     awssdk.Int64(toPort),
+			}
+			if ip.To4() != nil {
+				permission.IPRanges = []ec2model.IPRange{{CIDRIP: cidr, Description: description}}
+			} else {
+				permission.IPv6Range = []ec2model.IPv6Range{{CIDRIPv6: cidr, Description: description}}
+			}
+			permissions = append(permissions, permission)
+		}
+		for _, prefixListID := range rule.PrefixListIDs {
+			permissions = append(permissions, ec2model.IPPermission{
+				IPProtocol: strings.ToLower(string(protocol)),
+				FromPort:   awssdk.Int64(fromPort),
+				ToPort:     awssdk.Int64(toPort),
+				PrefixLists: []ec2model.PrefixList{
+					{
+						ListID:      prefixListID,
+						Description: description,
+					},
+				},
+			})
+		}
+	}
+	return permissions, nil
+}
+
+// mergeFrontendSecurityGroupRulePermissions appends the permissions of FrontendSecurityGroupRules, except the ones already
+// granted by permissions, since SecurityGroup rules with same protocol, ports and source cannot co-exist.
+func mergeFrontendSecurityGroupRulePermissions(permissions []ec2model.IPPermission, fsgrPermissions []ec2model.IPPermission) []ec2model.IPPermission {
+	grantedKeys := sets.NewString()
+	for _, permission := range permissions {
+		grantedKeys.Insert(buildIPPermissionSourceKey(permission))
+	}
+	for _, permission := range fsgrPermissions {
+		key := buildIPPermissionSourceKey(permission)
+		if grantedKeys.Has(key) {
+			continue
+		}
+		grantedKeys.Insert(key)
+		permissions = append(permissions, permission)
+	}
+	return permissions
+}
+
+// buildIPPermissionSourceKey builds a key identifies the protocol, ports and source of permission, ignoring description.
+func buildIPPermissionSourceKey(permission ec2model.IPPermission) string {
+	var sources []string
+	for _, ipRange := range permission.IPRanges {
+		sources = append(sources, ipRange.CIDRIP)
+	}
+	for _, ipv6Range := range permission.IPv6Range {
+		sources = append(sources, ipv6Range.CIDRIPv6)
+	}
+	for _, prefixList := range permission.PrefixLists {
+		sources = append(sources, prefixList.ListID)
+	}
+	return fmt.Sprintf("%s/%d-%d/%s", permission.IPProtocol, awssdk.Int64Value(permission.FromPort),
+		awssdk.Int64Value(permission.ToPort), strings.Join(sources, ","))
+}
@@ -0,0 +1,281 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultModelBuildTask_buildFrontendSecurityGroupRulePermissions(t *testing.T) {
+	udp := elbv2api.NetworkingProtocolUDP
+	explicitGroup := Group{
+		ID: NewGroupIDForExplicitGroup("awesome-group"),
+		Members: []ClassifiedIngress{
+			{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1"}}},
+		},
+	}
+	implicitGroup := Group{
+		ID: NewGroupIDForImplicitGroup(types.NamespacedName{Namespace: "ns-1", Name: "ing-1"}),
+		Members: []ClassifiedIngress{
+			{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1"}}},
+		},
+	}
+	tests := []struct {
+		name           string
+		ingGroup       Group
+		fsgrs          []*elbv2api.FrontendSecurityGroupRule
+		disableFeature bool
+		want           []ec2model.IPPermission
+		wantEvents     int
+	}{
+		{
+			name:     "rules referencing explicit group",
+			ingGroup: explicitGroup,
+			fsgrs: []*elbv2api.FrontendSecurityGroupRule{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "partner"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						Group: &elbv2api.IngressGroup{Name: "awesome-group"},
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{
+								Port:          8443,
+								CIDRs:         []string{"192.0.2.0/24", "2001:db8::/32"},
+								PrefixListIDs: []string{"pl-123"},
+								Description:   "partner NAT",
+							},
+							{
+								Protocol: &udp,
+								Port:     5000,
+								EndPort:  awssdk.Int64(5010),
+								CIDRs:    []string{"198.51.100.0/24"},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "other-group"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						Group: &elbv2api.IngressGroup{Name: "other-group"},
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{Port: 8443, CIDRs: []string{"203.0.113.0/24"}},
+						},
+					},
+				},
+			},
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(8443),
+					ToPort:     awssdk.Int64(8443),
+					IPRanges: []ec2model.IPRange{
+						{CIDRIP: "192.0.2.0/24", Description: "FrontendSecurityGroupRule ns-1/partner: partner NAT"},
+					},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(8443),
+					ToPort:     awssdk.Int64(8443),
+					IPv6Range: []ec2model.IPv6Range{
+						{CIDRIPv6: "2001:db8::/32", Description: "FrontendSecurityGroupRule ns-1/partner: partner NAT"},
+					},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(8443),
+					ToPort:     awssdk.Int64(8443),
+					PrefixLists: []ec2model.PrefixList{
+						{ListID: "pl-123", Description: "FrontendSecurityGroupRule ns-1/partner: partner NAT"},
+					},
+				},
+				{
+					IPProtocol: "udp",
+					FromPort:   awssdk.Int64(5000),
+					ToPort:     awssdk.Int64(5010),
+					IPRanges: []ec2model.IPRange{
+						{CIDRIP: "198.51.100.0/24", Description: "FrontendSecurityGroupRule ns-1/partner"},
+					},
+				},
+			},
+		},
+		{
+			name:     "rules referencing implicit group",
+			ingGroup: implicitGroup,
+			fsgrs: []*elbv2api.FrontendSecurityGroupRule{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "partner"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						IngressName: "ing-1",
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{Port: 8443, CIDRs: []string{"192.0.2.0/24"}},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "partner"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						IngressName: "ing-1",
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{Port: 8443, CIDRs: []string{"203.0.113.0/24"}},
+						},
+					},
+				},
+			},
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(8443),
+					ToPort:     awssdk.Int64(8443),
+					IPRanges: []ec2model.IPRange{
+						{CIDRIP: "192.0.2.0/24", Description: "FrontendSecurityGroupRule ns-1/partner"},
+					},
+				},
+			},
+		},
+		{
+			name:     "rules from namespace without group members are ignored",
+			ingGroup: explicitGroup,
+			fsgrs: []*elbv2api.FrontendSecurityGroupRule{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "partner"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						Group: &elbv2api.IngressGroup{Name: "awesome-group"},
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{Port: 8443, CIDRs: []string{"192.0.2.0/24"}},
+						},
+					},
+				},
+			},
+			want:       nil,
+			wantEvents: 1,
+		},
+		{
+			name:     "invalid rules are ignored",
+			ingGroup: explicitGroup,
+			fsgrs: []*elbv2api.FrontendSecurityGroupRule{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "invalid-cidr"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						Group: &elbv2api.IngressGroup{Name: "awesome-group"},
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{Port: 8443, CIDRs: []string{"192.0.2.0"}},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "invalid-port-range"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						Group: &elbv2api.IngressGroup{Name: "awesome-group"},
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{Port: 8443, EndPort: awssdk.Int64(8000), CIDRs: []string{"192.0.2.0/24"}},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "no-source"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						Group: &elbv2api.IngressGroup{Name: "awesome-group"},
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{Port: 8443},
+						},
+					},
+				},
+			},
+			want:       nil,
+			wantEvents: 3,
+		},
+		{
+			name:           "feature disabled",
+			ingGroup:       explicitGroup,
+			disableFeature: true,
+			fsgrs: []*elbv2api.FrontendSecurityGroupRule{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "partner"},
+					Spec: elbv2api.FrontendSecurityGroupRuleSpec{
+						Group: &elbv2api.IngressGroup{Name: "awesome-group"},
+						Rules: []elbv2api.FrontendSecurityGroupIngressRule{
+							{Port: 8443, CIDRs: []string{"192.0.2.0/24"}},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, fsgr := range tt.fsgrs {
+				assert.NoError(t, k8sClient.Create(ctx, fsgr.DeepCopy()))
+			}
+			featureGates := config.NewFeatureGates()
+			if !tt.disableFeature {
+				featureGates.Enable(config.FrontendSecurityGroupRule)
+			}
+			eventRecorder := record.NewFakeRecorder(10)
+			task := &defaultModelBuildTask{
+				k8sClient:     k8sClient,
+				eventRecorder: eventRecorder,
+				featureGates:  featureGates,
+				ingGroup:      tt.ingGroup,
+				logger:        logr.Discard(),
+			}
+			got, err := task.buildFrontendSecurityGroupRulePermissions(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, eventRecorder.Events, tt.wantEvents)
+		})
+	}
+}
+
+func Test_mergeFrontendSecurityGroupRulePermissions(t *testing.T) {
+	permissions := []ec2model.IPPermission{
+		{
+			IPProtocol: "tcp",
+			FromPort:   awssdk.Int64(443),
+			ToPort:     awssdk.Int64(443),
+			IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+		},
+	}
+	fsgrPermissions := []ec2model.IPPermission{
+		{
+			IPProtocol: "tcp",
+			FromPort:   awssdk.Int64(443),
+			ToPort:     awssdk.Int64(443),
+			IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0", Description: "FrontendSecurityGroupRule ns-1/a"}},
+		},
+		{
+			IPProtocol: "tcp",
+			FromPort:   awssdk.Int64(8443),
+			ToPort:     awssdk.Int64(8443),
+			IPRanges:   []ec2model.IPRange{{CIDRIP: "192.0.2.0/24", Description: "FrontendSecurityGroupRule ns-1/a"}},
+		},
+		{
+			IPProtocol: "tcp",
+			FromPort:   awssdk.Int64(8443),
+			ToPort:     awssdk.Int64(8443),
+			IPRanges:   []ec2model.IPRange{{CIDRIP: "192.0.2.0/24", Description: "FrontendSecurityGroupRule ns-1/b"}},
+		},
+	}
+	want := []ec2model.IPPermission{
+		permissions[0],
+		fsgrPermissions[1],
+	}
+	assert.Equal(t, want, mergeFrontendSecurityGroupRulePermissions(permissions, fsgrPermissions))
+}
@@ -0,0 +1,73 @@
+package ingress
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gamodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/globalaccelerator"
+)
+
+const resourceIDGlobalAccelerator = "GlobalAccelerator"
+
+// buildGlobalAccelerator builds the Global Accelerator managed for this IngressGroup, which forwards the listen ports to the ALB.
+func (t *defaultModelBuildTask) buildGlobalAccelerator(ctx context.Context, lb *elbv2model.LoadBalancer, listenPortConfigByPort map[int64]listenPortConfig) error {
+	enabled, err := t.buildGlobalAcceleratorEnabled(ctx)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return nil
+	}
+	if !t.featureGates.Enabled(config.GlobalAccelerator) {
+		return errors.Errorf("global accelerator cannot be enabled on IngressGroup, the %v feature gate is disabled", config.GlobalAccelerator)
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return err
+	}
+	ports := make([]int64, 0, len(listenPortConfigByPort))
+	for port := range listenPortConfigByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	gamodel.NewAccelerator(t.stack, resourceIDGlobalAccelerator, gamodel.AcceleratorSpec{
+		Name: lb.Spec.Name,
+		Listeners: []gamodel.AcceleratorListener{
+			{
+				Protocol: gamodel.ProtocolTCP,
+				Ports:    ports,
+			},
+		},
+		LoadBalancerARN: lb.LoadBalancerARN(),
+		Tags:            tags,
+	})
+	return nil
+}
+
+// buildGlobalAcceleratorEnabled determines whether Global Accelerator is enabled from IngressClassParams and annotations of members.
+func (t *defaultModelBuildTask) buildGlobalAcceleratorEnabled(_ context.Context) (bool, error) {
+	explicitEnables := make(map[bool]struct{})
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.GlobalAcceleratorEnabled != nil {
+			explicitEnables[*member.IngClassConfig.IngClassParams.Spec.GlobalAcceleratorEnabled] = struct{}{}
+			continue
+		}
+		rawEnabled := false
+		exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixGlobalAcceleratorEnabled, &rawEnabled, member.Ing.Annotations)
+		if err != nil {
+			return false, err
+		}
+		if exists {
+			explicitEnables[rawEnabled] = struct{}{}
+		}
+	}
+	if len(explicitEnables) > 1 {
+		return false, errors.New("conflicting global accelerator enabled")
+	}
+	_, enabled := explicitEnables[true]
+	return enabled, nil
+}
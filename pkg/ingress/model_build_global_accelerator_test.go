@@ -0,0 +1,98 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_defaultModelBuildTask_buildGlobalAcceleratorEnabled(t *testing.T) {
+	newIngress := func(name string, enabled string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+		if enabled != "" {
+			ing.Annotations = map[string]string{"alb.ingress.kubernetes.io/global-accelerator-enabled": enabled}
+		}
+		return ing
+	}
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     bool
+		wantErr  error
+	}{
+		{
+			name: "not enabled",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{Ing: newIngress("ing-1", "")},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "enabled via annotation",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{Ing: newIngress("ing-1", "true")},
+					{Ing: newIngress("ing-2", "")},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "IngressClassParams overrides annotation",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{
+						Ing: newIngress("ing-1", "false"),
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &elbv2api.IngressClassParams{
+								Spec: elbv2api.IngressClassParamsSpec{
+									GlobalAcceleratorEnabled: awssdk.Bool(true),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "conflicting annotations",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{Ing: newIngress("ing-1", "true")},
+					{Ing: newIngress("ing-2", "false")},
+				},
+			},
+			wantErr: errors.New("conflicting global accelerator enabled"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup:         tt.ingGroup,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildGlobalAcceleratorEnabled(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
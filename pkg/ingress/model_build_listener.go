@@ -6,17 +6,22 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 )
 
 func (t *defaultModelBuildTask) buildListener(ctx context.Context, lbARN core.StringToken, port int64, config listenPortConfig, ingList []ClassifiedIngress) (*elbv2model.Listener, error) {
@@ -38,20 +43,89 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, lbARN cor
 	if err != nil {
 		return elbv2model.ListenerSpec{}, err
 	}
-	certs := make([]elbv2model.Certificate, 0, len(config.tlsCerts))
-	for _, certARN := range config.tlsCerts {
+	mutualAuthentication, err := t.buildListenerMutualAuthentication(ctx, config.mutualAuthentication)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	listenerAttributes, err := t.buildListenerAttributes(ctx, config.protocol, port, ingList)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	certARNs, err := t.buildListenerCertificateARNs(ctx, port, config.tlsCerts, ingList)
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+	certs := make([]elbv2model.Certificate, 0, len(certARNs))
+	for _, certARN := range certARNs {
 		certs = append(certs, elbv2model.Certificate{
 			CertificateARN: awssdk.String(certARN),
 		})
 	}
 	return elbv2model.ListenerSpec{
-		LoadBalancerARN: lbARN,
-		Port:            port,
-		Protocol:        config.protocol,
-		DefaultActions:  defaultActions,
-		Certificates:    certs,
-		SSLPolicy:       config.sslPolicy,
-		Tags:            tags,
+		LoadBalancerARN:      lbARN,
+		Port:                 port,
+		Protocol:             config.protocol,
+		DefaultActions:       defaultActions,
+		Certificates:         certs,
+		SSLPolicy:            config.sslPolicy,
+		MutualAuthentication: mutualAuthentication,
+		ListenerAttributes:   listenerAttributes,
+		Tags:                 tags,
+	}, nil
+}
+
+// buildListenerCertificateARNs returns the certificateARNs of listener, with the expiring ones rotated if the rotation is enabled.
+// The rotation of each certificate is reported via events on the Ingresses of the listener.
+func (t *defaultModelBuildTask) buildListenerCertificateARNs(ctx context.Context, port int64, certARNs []string, ingList []ClassifiedIngress) ([]string, error) {
+	if t.certRotationPlanner == nil || len(certARNs) == 0 {
+		return certARNs, nil
+	}
+	rotations, err := t.certRotationPlanner.Plan(ctx, certARNs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to plan certificate rotations")
+	}
+	if t.eventRecorder != nil {
+		for _, rotation := range rotations {
+			for _, ing := range ingList {
+				switch rotation.Stage {
+				case CertRotationStageStandby:
+					t.eventRecorder.Eventf(ing.Ing, corev1.EventTypeNormal, k8s.IngressEventReasonCertificateStandby,
+						"Certificate %v expires at %v, attaching replacement %v as non-default certificate on port %v",
+						rotation.CertARN, rotation.NotAfter.Format(time.RFC3339), rotation.ReplacementCertARN, port)
+				case CertRotationStagePromoted:
+					t.eventRecorder.Eventf(ing.Ing, corev1.EventTypeNormal, k8s.IngressEventReasonCertificatePromoted,
+						"Certificate %v expires at %v, promoting replacement %v in its place on port %v",
+						rotation.CertARN, rotation.NotAfter.Format(time.RFC3339), rotation.ReplacementCertARN, port)
+				}
+			}
+		}
+	}
+	return applyCertRotations(certARNs, rotations), nil
+}
+
+func (t *defaultModelBuildTask) buildListenerMutualAuthentication(ctx context.Context, config *elbv2api.MutualAuthenticationAttributes) (*elbv2model.MutualAuthenticationAttributes, error) {
+	if config == nil {
+		return nil, nil
+	}
+	if config.Mode != elbv2api.MutualAuthenticationModeVerify {
+		return &elbv2model.MutualAuthenticationAttributes{
+			Mode: elbv2model.MutualAuthenticationMode(config.Mode),
+		}, nil
+	}
+	var trustStoreARN core.StringToken
+	if config.TrustStore != "" {
+		trustStoreARN = core.LiteralStringToken(config.TrustStore)
+	} else {
+		trustStore, err := t.buildManagedTrustStore(ctx)
+		if err != nil {
+			return nil, err
+		}
+		trustStoreARN = trustStore.TrustStoreARN()
+	}
+	return &elbv2model.MutualAuthenticationAttributes{
+		Mode:                          elbv2model.MutualAuthenticationModeVerify,
+		TrustStoreARN:                 trustStoreARN,
+		IgnoreClientCertificateExpiry: config.IgnoreClientCertificateExpiry,
 	}, nil
 }
 
@@ -67,8 +141,7 @@ func (t *defaultModelBuildTask) buildListenerDefaultActions(ctx context.Context,
 		}
 	}
 	if len(ingsWithDefaultBackend) == 0 {
-		action404 := t.build404Action(ctx)
-		return []elbv2model.Action{action404}, nil
+		return t.buildIngressGroupDefaultActions(ctx, protocol)
 	}
 	if len(ingsWithDefaultBackend) > 1 {
 		ingKeys := make([]types.NamespacedName, 0, len(ingsWithDefaultBackend))
@@ -80,7 +153,8 @@ func (t *defaultModelBuildTask) buildListenerDefaultActions(ctx context.Context,
 	ing := ingsWithDefaultBackend[0]
 	enhancedBackend, err := t.enhancedBackendBuilder.Build(ctx, ing.Ing, *ing.Ing.Spec.DefaultBackend,
 		WithLoadBackendServices(true, t.backendServices),
-		WithLoadAuthConfig(true))
+		WithLoadAuthConfig(true),
+		WithAllowedServiceNamespaces(t.buildAllowedServiceNamespaces(ctx, ing)))
 	if err != nil {
 		return nil, err
 	}
@@ -100,22 +174,47 @@ type listenPortConfig struct {
 	protocol       elbv2model.Protocol
 	inboundCIDRv4s []string
 	inboundCIDRv6s []string
+	prefixLists    []string
 	sslPolicy      *string
 	tlsCerts       []string
+
+	mutualAuthentication *elbv2api.MutualAuthenticationAttributes
 }
 
 func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context.Context, ing *ClassifiedIngress) (map[int64]listenPortConfig, error) {
 	explicitTLSCertARNs := t.computeIngressExplicitTLSCertARNs(ctx, ing.Ing)
 	explicitSSLPolicy := t.computeIngressExplicitSSLPolicy(ctx, ing)
+	// the inbound CIDRs are re-checked against the policy of IngressClassParams, as it can be changed after the Ingress is admitted.
+	if t.inboundCIDRsChecker != nil {
+		if err := t.inboundCIDRsChecker.Check(ctx, ing.Ing, ing.IngClassConfig.IngClassParams); err != nil {
+			if t.eventRecorder != nil {
+				t.eventRecorder.Event(ing.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonDeniedInboundCIDRs, err.Error())
+			}
+			return nil, err
+		}
+	}
 	inboundCIDRv4s, inboundCIDRV6s, err := t.computeIngressExplicitInboundCIDRs(ctx, ing)
 	if err != nil {
 		return nil, err
 	}
+	prefixLists, err := t.computeIngressExplicitPrefixLists(ctx, ing)
+	if err != nil {
+		return nil, err
+	}
 	preferTLS := len(explicitTLSCertARNs) != 0
 	listenPorts, err := t.computeIngressListenPorts(ctx, ing.Ing, preferTLS)
 	if err != nil {
 		return nil, err
 	}
+	mutualAuthenticationByPort, err := t.computeIngressMutualAuthentication(ctx, ing)
+	if err != nil {
+		return nil, err
+	}
+	for port := range mutualAuthenticationByPort {
+		if listenPorts[port] != elbv2model.ProtocolHTTPS {
+			return nil, errors.Errorf("mutual authentication can only be configured on HTTPS listen ports: %v", port)
+		}
+	}
 
 	containsHTTPSPort := false
 	for _, protocol := range listenPorts {
@@ -138,6 +237,7 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 			protocol:       protocol,
 			inboundCIDRv4s: inboundCIDRv4s,
 			inboundCIDRv6s: inboundCIDRV6s,
+			prefixLists:    prefixLists,
 		}
 		if protocol == elbv2model.ProtocolHTTPS {
 			if len(explicitTLSCertARNs) == 0 {
@@ -146,6 +246,9 @@ func (t *defaultModelBuildTask) computeIngressListenPortConfigByPort(ctx context
 				cfg.tlsCerts = explicitTLSCertARNs
 			}
 			cfg.sslPolicy = explicitSSLPolicy
+			if mutualAuthentication, ok := mutualAuthenticationByPort[port]; ok {
+				cfg.mutualAuthentication = &mutualAuthentication
+			}
 		}
 		listenPortConfigByPort[port] = cfg
 	}
@@ -169,7 +272,41 @@ func (t *defaultModelBuildTask) computeIngressInferredTLSCertARNs(ctx context.Co
 	for _, t := range ing.Spec.TLS {
 		hosts.Insert(t.Hosts...)
 	}
-	return t.certDiscovery.Discover(ctx, hosts.List())
+	certARNs, err := t.certDiscovery.Discover(ctx, hosts.List())
+	var certNotFoundErr *CertNotFoundError
+	if t.certRequester == nil || !errors.As(err, &certNotFoundErr) {
+		return certARNs, err
+	}
+	return t.requestIngressTLSCert(ctx, ing, hosts, certNotFoundErr.Hosts)
+}
+
+// requestIngressTLSCert requests a certificate for the hosts without certificate, and returns it along with the certificates discovered
+// for the other hosts. The certificate is attached once issued, meanwhile the discovered certificates are used if any.
+func (t *defaultModelBuildTask) requestIngressTLSCert(ctx context.Context, ing *networking.Ingress, hosts sets.String, hostsWithoutCert []string) ([]string, error) {
+	certRequest, err := t.certRequester.Request(ctx, hostsWithoutCert)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to request certificate for hosts %v", hostsWithoutCert)
+	}
+	var certARNs []string
+	if hostsWithCert := hosts.Difference(sets.NewString(hostsWithoutCert...)); hostsWithCert.Len() != 0 {
+		if certARNs, err = t.certDiscovery.Discover(ctx, hostsWithCert.List()); err != nil {
+			return nil, err
+		}
+	}
+	if certRequest.Issued {
+		return append(certARNs, certRequest.CertARN), nil
+	}
+	if pending, ok := ctx.Value(certRequestPendingContextKey{}).(*atomic.Bool); ok {
+		pending.Store(true)
+	}
+	if t.eventRecorder != nil {
+		t.eventRecorder.Eventf(ing, corev1.EventTypeNormal, k8s.IngressEventReasonCertificateRequested,
+			"Certificate %v requested for hosts %v is pending DNS validation", certRequest.CertARN, certRequest.Hosts)
+	}
+	if len(certARNs) == 0 {
+		return nil, runtime.NewRequeueNeededAfter(fmt.Sprintf("certificate %v pending DNS validation", certRequest.CertARN), CertValidationPollInterval)
+	}
+	return certARNs, nil
 }
 
 func (t *defaultModelBuildTask) computeIngressListenPorts(_ context.Context, ing *networking.Ingress, preferTLS bool) (map[int64]elbv2model.Protocol, error) {
@@ -237,6 +374,27 @@ func (t *defaultModelBuildTask) computeIngressExplicitInboundCIDRs(_ context.Con
 	return inboundCIDRv4s, inboundCIDRv6s, nil
 }
 
+func (t *defaultModelBuildTask) computeIngressExplicitPrefixLists(_ context.Context, ing *ClassifiedIngress) ([]string, error) {
+	var rawPrefixLists []string
+	fromIngressClassParams := false
+	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.PrefixListsIDs) != 0 {
+		rawPrefixLists = ing.IngClassConfig.IngClassParams.Spec.PrefixListsIDs
+		fromIngressClassParams = true
+	} else {
+		_ = t.annotationParser.ParseStringSliceAnnotation(annotations.IngressSuffixSecurityGroupPrefixLists, &rawPrefixLists, ing.Ing.Annotations)
+	}
+
+	for _, prefixList := range rawPrefixLists {
+		if !strings.HasPrefix(prefixList, "pl-") {
+			if fromIngressClassParams {
+				return nil, fmt.Errorf("invalid prefix list in IngressClassParams PrefixListsIDs %s", prefixList)
+			}
+			return nil, fmt.Errorf("invalid %v settings on Ingress: %v: invalid prefix list %s", annotations.IngressSuffixSecurityGroupPrefixLists, k8s.NamespacedName(ing.Ing), prefixList)
+		}
+	}
+	return rawPrefixLists, nil
+}
+
 func (t *defaultModelBuildTask) computeIngressExplicitSSLPolicy(_ context.Context, ing *ClassifiedIngress) *string {
 	var rawSSLPolicy string
 	if ing.IngClassConfig.IngClassParams != nil && ing.IngClassConfig.IngClassParams.Spec.SSLPolicy != "" {
@@ -247,3 +405,35 @@ func (t *defaultModelBuildTask) computeIngressExplicitSSLPolicy(_ context.Contex
 	}
 	return &rawSSLPolicy
 }
+
+func (t *defaultModelBuildTask) computeIngressMutualAuthentication(_ context.Context, ing *ClassifiedIngress) (map[int64]elbv2api.MutualAuthenticationAttributes, error) {
+	var rawMutualAuthentication []elbv2api.MutualAuthenticationAttributes
+	if ing.IngClassConfig.IngClassParams != nil && len(ing.IngClassConfig.IngClassParams.Spec.MutualAuthentication) != 0 {
+		rawMutualAuthentication = ing.IngClassConfig.IngClassParams.Spec.MutualAuthentication
+	} else if _, err := t.annotationParser.ParseJSONAnnotation(annotations.IngressSuffixMutualAuthentication, &rawMutualAuthentication, ing.Ing.Annotations); err != nil {
+		return nil, err
+	}
+
+	mutualAuthenticationByPort := make(map[int64]elbv2api.MutualAuthenticationAttributes, len(rawMutualAuthentication))
+	for _, entry := range rawMutualAuthentication {
+		if _, exists := mutualAuthenticationByPort[entry.Port]; exists {
+			return nil, errors.Errorf("duplicate mutual authentication configuration for port: %v", entry.Port)
+		}
+		switch entry.Mode {
+		case elbv2api.MutualAuthenticationModeOff, elbv2api.MutualAuthenticationModePassthrough:
+			if entry.TrustStore != "" || entry.IgnoreClientCertificateExpiry != nil {
+				return nil, errors.Errorf("trustStore and ignoreClientCertificateExpiry can only be specified in %v mode: %v",
+					elbv2api.MutualAuthenticationModeVerify, entry.Port)
+			}
+		case elbv2api.MutualAuthenticationModeVerify:
+			if entry.TrustStore != "" && !strings.HasPrefix(entry.TrustStore, "arn:") {
+				return nil, errors.Errorf("trustStore must be an ARN: %v", entry.TrustStore)
+			}
+		default:
+			return nil, errors.Errorf("mutual authentication mode must be within [%v, %v, %v]: %v",
+				elbv2api.MutualAuthenticationModeOff, elbv2api.MutualAuthenticationModePassthrough, elbv2api.MutualAuthenticationModeVerify, entry.Mode)
+		}
+		mutualAuthenticationByPort[entry.Port] = entry
+	}
+	return mutualAuthenticationByPort, nil
+}
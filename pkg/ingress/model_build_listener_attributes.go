@@ -0,0 +1,40 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// buildListenerAttributes builds the listener attributes for the listener on port from Ingresses listening on it.
+func (t *defaultModelBuildTask) buildListenerAttributes(_ context.Context, protocol elbv2model.Protocol, port int64,
+	ingList []ClassifiedIngress) ([]elbv2model.ListenerAttribute, error) {
+	annotationSuffix := fmt.Sprintf("%v.%v-%v", annotations.IngressSuffixListenerAttributes, protocol, port)
+	ingGroupAttributes := make(map[string]string)
+	for _, ing := range ingList {
+		var ingAttributes map[string]string
+		if _, err := t.annotationParser.ParseStringMapAnnotation(annotationSuffix, &ingAttributes, ing.Ing.Annotations); err != nil {
+			return nil, err
+		}
+		// check for conflict attribute values
+		for attrKey, attrValue := range ingAttributes {
+			existingAttrValue, exists := ingGroupAttributes[attrKey]
+			if exists && existingAttrValue != attrValue {
+				return nil, errors.Errorf("conflicting listener attributes %v: %v | %v", attrKey, existingAttrValue, attrValue)
+			}
+			ingGroupAttributes[attrKey] = attrValue
+		}
+	}
+	attributes := make([]elbv2model.ListenerAttribute, 0, len(ingGroupAttributes))
+	for _, attrKey := range sets.StringKeySet(ingGroupAttributes).List() {
+		attributes = append(attributes, elbv2model.ListenerAttribute{
+			Key:   attrKey,
+			Value: ingGroupAttributes[attrKey],
+		})
+	}
+	return attributes, nil
+}
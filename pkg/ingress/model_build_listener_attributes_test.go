@@ -0,0 +1,138 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildListenerAttributes(t *testing.T) {
+	type args struct {
+		protocol elbv2model.Protocol
+		port     int64
+		ingList  []ClassifiedIngress
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []elbv2model.ListenerAttribute
+		wantErr error
+	}{
+		{
+			name: "attributes from multiple Ingress that do not conflict",
+			args: args{
+				protocol: elbv2model.ProtocolHTTPS,
+				port:     443,
+				ingList: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/listener-attributes.HTTPS-443": "routing.http.response.server.enabled=false",
+								},
+							},
+						},
+					},
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-2",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/listener-attributes.HTTPS-443": "routing.http.response.server.enabled=false,routing.http.response.x_frame_options.header_value=DENY",
+									"alb.ingress.kubernetes.io/listener-attributes.HTTP-80":   "routing.http.response.server.enabled=true",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []elbv2model.ListenerAttribute{
+				{
+					Key:   "routing.http.response.server.enabled",
+					Value: "false",
+				},
+				{
+					Key:   "routing.http.response.x_frame_options.header_value",
+					Value: "DENY",
+				},
+			},
+		},
+		{
+			name: "attributes from multiple Ingress that conflict",
+			args: args{
+				protocol: elbv2model.ProtocolHTTPS,
+				port:     443,
+				ingList: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/listener-attributes.HTTPS-443": "routing.http.response.server.enabled=false",
+								},
+							},
+						},
+					},
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-2",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/listener-attributes.HTTPS-443": "routing.http.response.server.enabled=true",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting listener attributes routing.http.response.server.enabled: false | true"),
+		},
+		{
+			name: "no attributes for listener",
+			args: args{
+				protocol: elbv2model.ProtocolHTTP,
+				port:     8080,
+				ingList: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/listener-attributes.HTTP-80": "routing.http.response.server.enabled=false",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []elbv2model.ListenerAttribute{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			task := &defaultModelBuildTask{
+				annotationParser: annotationParser,
+			}
+			got, err := task.buildListenerAttributes(context.Background(), tt.args.protocol, tt.args.port, tt.args.ingList)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -6,21 +6,46 @@ import (
 	"sort"
 	"strings"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
+const (
+	// MinListenerRulePriority and MaxListenerRulePriority bound the priorities of listener rules.
+	MinListenerRulePriority = 1
+	MaxListenerRulePriority = 50000
+
+	// MaxConditionValuesPerRule is the max number of condition values allowed in a single listener rule.
+	MaxConditionValuesPerRule = 5
+	// DefaultListenerRulesQuota is the default quota of listener rules per ALB, excluding the default rules.
+	DefaultListenerRulesQuota = 100
+)
+
 func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN core.StringToken, port int64, protocol elbv2model.Protocol, ingList []ClassifiedIngress) error {
 	if t.sslRedirectConfig != nil && protocol == elbv2model.ProtocolHTTP {
 		return nil
 	}
 
 	var rules []Rule
+	// reservedPriorityErr is set once an Ingress uses an explicit priority from the band reserved for LoadBalancerRoutes.
+	var reservedPriorityErr error
+	explicitPriorityOwners := make(map[int64]types.NamespacedName)
 	for _, ing := range ingList {
+		priorityBase, pathPriorities, err := t.buildRulePrioritySettings(ctx, ing)
+		if err != nil {
+			return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+		}
+		usedPathPriorityKeys := sets.NewString()
+		basePriorityOffset := int64(0)
 		for _, rule := range ing.Ing.Spec.Rules {
 			if rule.HTTP == nil {
 				continue
@@ -32,7 +57,8 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 			for _, path := range paths {
 				enhancedBackend, err := t.enhancedBackendBuilder.Build(ctx, ing.Ing, path.Backend,
 					WithLoadBackendServices(true, t.backendServices),
-					WithLoadAuthConfig(true))
+					WithLoadAuthConfig(true),
+					WithAllowedServiceNamespaces(t.buildAllowedServiceNamespaces(ctx, ing)))
 				if err != nil {
 					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
 				}
@@ -40,6 +66,13 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 				if err != nil {
 					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
 				}
+				splitConditions, err := splitRuleConditions(conditions)
+				if err != nil {
+					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
+				}
+				if len(splitConditions) > 1 {
+					t.ingressesWithSplitRules[k8s.NamespacedName(ing.Ing)] = ing.Ing
+				}
 				actions, err := t.buildActions(ctx, protocol, ing, enhancedBackend)
 				if err != nil {
 					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
@@ -48,35 +81,315 @@ func (t *defaultModelBuildTask) buildListenerRules(ctx context.Context, lsARN co
 				if err != nil {
 					return errors.Wrapf(err, "ingress: %v", k8s.NamespacedName(ing.Ing))
 				}
-				rules = append(rules, Rule{
-					Conditions: conditions,
-					Actions:    actions,
-					Tags:       tags,
-				})
+
+				// the rules split from same path get consecutive priorities.
+				var firstPriority *int64
+				pathPriorityKey := rule.Host + path.Path
+				if pathPriority, ok := pathPriorities[pathPriorityKey]; ok {
+					firstPriority = awssdk.Int64(pathPriority)
+					usedPathPriorityKeys.Insert(pathPriorityKey)
+				} else if priorityBase != nil {
+					firstPriority = awssdk.Int64(*priorityBase + basePriorityOffset)
+					basePriorityOffset += int64(len(splitConditions))
+				}
+				for i, ruleConditions := range splitConditions {
+					var priority *int64
+					if firstPriority != nil {
+						priority = awssdk.Int64(*firstPriority + int64(i))
+						if *priority > MaxListenerRulePriority {
+							return errors.Errorf("listener rule priority must be within [%v:%v], ingress: %v, priority: %v",
+								MinListenerRulePriority, MaxListenerRulePriority, k8s.NamespacedName(ing.Ing), *priority)
+						}
+						if *priority >= loadBalancerRoutePriorityBandStart && reservedPriorityErr == nil {
+							reservedPriorityErr = errors.Errorf("listener rule priority %v reserved for LoadBalancerRoutes is used by ingress: %v",
+								*priority, k8s.NamespacedName(ing.Ing))
+						}
+						if owner, exists := explicitPriorityOwners[*priority]; exists {
+							return errors.Errorf("conflicting listener rule priority %v between Ingresses: %v, %v",
+								*priority, owner, k8s.NamespacedName(ing.Ing))
+						}
+						explicitPriorityOwners[*priority] = k8s.NamespacedName(ing.Ing)
+					}
+					rules = append(rules, Rule{
+						Conditions: ruleConditions,
+						Actions:    actions,
+						Tags:       tags,
+						Priority:   priority,
+					})
+				}
 			}
 		}
+		if unknownKeys := sets.StringKeySet(pathPriorities).Difference(usedPathPriorityKeys); unknownKeys.Len() != 0 {
+			return errors.Errorf("unknown paths in %v annotation: %v, ingress: %v",
+				annotations.IngressSuffixRulePriorities, unknownKeys.List(), k8s.NamespacedName(ing.Ing))
+		}
 	}
 	optimizedRules, err := t.ruleOptimizer.Optimize(ctx, port, protocol, rules)
 	if err != nil {
 		return err
 	}
 
-	priority := int64(1)
-	for _, rule := range optimizedRules {
-		ruleResID := fmt.Sprintf("%v:%v", port, priority)
+	// the priorities from loadBalancerRoutePriorityBandStart are reserved once LoadBalancerRoutes are attached to the listener,
+	// unless Ingresses already use explicit priorities from the band, in which case the LoadBalancerRoutes are rejected instead,
+	// so that a LoadBalancerRoute never blocks the reconciliation of IngressGroup.
+	routes := t.buildLoadBalancerRoutesForPort(port)
+	maxIngressRulePriority := int64(MaxListenerRulePriority)
+	if len(routes) != 0 && reservedPriorityErr == nil {
+		maxIngressRulePriority = loadBalancerRoutePriorityBandStart - 1
+	}
+	priorities, err := allocateRulePriorities(optimizedRules, maxIngressRulePriority)
+	if err != nil {
+		return err
+	}
+	if reservedPriorityErr != nil {
+		for _, route := range routes {
+			t.recordLoadBalancerRouteWarning(route, reservedPriorityErr)
+		}
+		routes = nil
+	}
+	// the rules of LoadBalancerRoutes bypass the optimizer, since they come with priorities from the reserved band.
+	for _, rule := range t.buildLoadBalancerRouteRules(ctx, port, routes) {
+		optimizedRules = append(optimizedRules, rule)
+		priorities = append(priorities, *rule.Priority)
+	}
+	for i, rule := range optimizedRules {
+		ruleResID := fmt.Sprintf("%v:%v", port, priorities[i])
 		_ = elbv2model.NewListenerRule(t.stack, ruleResID, elbv2model.ListenerRuleSpec{
 			ListenerARN: lsARN,
-			Priority:    priority,
+			Priority:    priorities[i],
 			Conditions:  rule.Conditions,
 			Actions:     rule.Actions,
 			Tags:        rule.Tags,
 		})
-		priority += 1
 	}
 
 	return nil
 }
 
+// buildRulePrioritySettings builds the explicit priority settings of Ingress rules.
+// It returns the priority base of Ingress, and the priority overrides keyed by host and path.
+func (t *defaultModelBuildTask) buildRulePrioritySettings(_ context.Context, ing ClassifiedIngress) (*int64, map[string]int64, error) {
+	var priorityBase *int64
+	var rawPriorityBase int64
+	exists, err := t.annotationParser.ParseInt64Annotation(annotations.IngressSuffixRulePriorityBase, &rawPriorityBase, ing.Ing.Annotations)
+	if err != nil {
+		return nil, nil, err
+	}
+	if exists {
+		if rawPriorityBase < MinListenerRulePriority || rawPriorityBase > MaxListenerRulePriority {
+			return nil, nil, errors.Errorf("listener rule priority base must be within [%v:%v], priority base: %v",
+				MinListenerRulePriority, MaxListenerRulePriority, rawPriorityBase)
+		}
+		priorityBase = awssdk.Int64(rawPriorityBase)
+	}
+	var pathPriorities map[string]int64
+	if _, err := t.annotationParser.ParseJSONAnnotation(annotations.IngressSuffixRulePriorities, &pathPriorities, ing.Ing.Annotations); err != nil {
+		return nil, nil, err
+	}
+	for pathKey, priority := range pathPriorities {
+		if priority < MinListenerRulePriority || priority > MaxListenerRulePriority {
+			return nil, nil, errors.Errorf("listener rule priority must be within [%v:%v], path: %v, priority: %v",
+				MinListenerRulePriority, MaxListenerRulePriority, pathKey, priority)
+		}
+	}
+	return priorityBase, pathPriorities, nil
+}
+
+// allocateRulePriorities allocates the priorities of rules, up to maxPriority.
+// Rules with explicit priority keep their priority, and the remaining rules are allocated the lowest unused priorities in order,
+// so that rules with explicit priority never get renumbered when other rules are added or removed.
+func allocateRulePriorities(rules []Rule, maxPriority int64) ([]int64, error) {
+	reservedPriorities := sets.NewInt64()
+	for _, rule := range rules {
+		if rule.Priority != nil {
+			reservedPriorities.Insert(*rule.Priority)
+		}
+	}
+	priorities := make([]int64, 0, len(rules))
+	nextPriority := int64(MinListenerRulePriority)
+	for _, rule := range rules {
+		if rule.Priority != nil {
+			priorities = append(priorities, *rule.Priority)
+			continue
+		}
+		for reservedPriorities.Has(nextPriority) {
+			nextPriority++
+		}
+		if nextPriority > maxPriority {
+			return nil, errors.Errorf("listener rule priorities exhausted, at most %v rules are supported", maxPriority)
+		}
+		priorities = append(priorities, nextPriority)
+		nextPriority++
+	}
+	return priorities, nil
+}
+
+// splitRuleConditions splits the conditions of a rule into multiple sets of conditions, so that each set fits into the
+// limit of condition values per rule. The host-header, path-pattern and source-ip conditions are split into chunks,
+// and each set of conditions is a combination of these chunks, which matches the same requests as the original conditions.
+func splitRuleConditions(conditions []elbv2model.RuleCondition) ([][]elbv2model.RuleCondition, error) {
+	totalValues := 0
+	var splittableIndexes []int
+	for i, condition := range conditions {
+		valuesCount := countRuleConditionValues(condition)
+		totalValues += valuesCount
+		if isRuleConditionSplittable(condition) {
+			splittableIndexes = append(splittableIndexes, i)
+		}
+	}
+	if totalValues <= MaxConditionValuesPerRule {
+		return [][]elbv2model.RuleCondition{conditions}, nil
+	}
+
+	fixedValues := totalValues
+	for _, i := range splittableIndexes {
+		fixedValues -= countRuleConditionValues(conditions[i])
+	}
+	budget := MaxConditionValuesPerRule - fixedValues
+	if len(splittableIndexes) == 0 || budget < len(splittableIndexes) {
+		return nil, errors.Errorf("conditions must contain at most %v values, got %v", MaxConditionValuesPerRule, totalValues)
+	}
+
+	// each splittable condition starts with chunks of single value, the remaining budget is granted one at a time to
+	// the condition producing the most chunks, which minimizes the number of rules.
+	chunkSizes := make([]int, len(splittableIndexes))
+	for i := range chunkSizes {
+		chunkSizes[i] = 1
+	}
+	for budget -= len(splittableIndexes); budget > 0; budget-- {
+		candidate := -1
+		for i, conditionIndex := range splittableIndexes {
+			valuesCount := countRuleConditionValues(conditions[conditionIndex])
+			if chunkSizes[i] >= valuesCount {
+				continue
+			}
+			if candidate == -1 || ceilDiv(valuesCount, chunkSizes[i]) > ceilDiv(countRuleConditionValues(conditions[splittableIndexes[candidate]]), chunkSizes[candidate]) {
+				candidate = i
+			}
+		}
+		if candidate == -1 {
+			break
+		}
+		chunkSizes[candidate]++
+	}
+
+	splitConditions := [][]elbv2model.RuleCondition{nil}
+	for i, condition := range conditions {
+		chunks := [][]elbv2model.RuleCondition{{condition}}
+		for j, conditionIndex := range splittableIndexes {
+			if conditionIndex == i {
+				chunks = chunkRuleCondition(condition, chunkSizes[j])
+				break
+			}
+		}
+		var combinedConditions [][]elbv2model.RuleCondition
+		for _, prefix := range splitConditions {
+			for _, chunk := range chunks {
+				combined := make([]elbv2model.RuleCondition, 0, len(prefix)+len(chunk))
+				combined = append(combined, prefix...)
+				combined = append(combined, chunk...)
+				combinedConditions = append(combinedConditions, combined)
+			}
+		}
+		splitConditions = combinedConditions
+	}
+	return splitConditions, nil
+}
+
+// countRuleConditionValues returns the number of values in condition.
+func countRuleConditionValues(condition elbv2model.RuleCondition) int {
+	switch condition.Field {
+	case elbv2model.RuleConditionFieldHostHeader:
+		return len(condition.HostHeaderConfig.Values)
+	case elbv2model.RuleConditionFieldPathPattern:
+		return len(condition.PathPatternConfig.Values)
+	case elbv2model.RuleConditionFieldSourceIP:
+		return len(condition.SourceIPConfig.Values)
+	case elbv2model.RuleConditionFieldHTTPHeader:
+		return len(condition.HTTPHeaderConfig.Values)
+	case elbv2model.RuleConditionFieldHTTPRequestMethod:
+		return len(condition.HTTPRequestMethodConfig.Values)
+	case elbv2model.RuleConditionFieldQueryString:
+		return len(condition.QueryStringConfig.Values)
+	}
+	return 0
+}
+
+// isRuleConditionSplittable checks whether condition can be split into multiple conditions with subset of values.
+func isRuleConditionSplittable(condition elbv2model.RuleCondition) bool {
+	switch condition.Field {
+	case elbv2model.RuleConditionFieldHostHeader, elbv2model.RuleConditionFieldPathPattern, elbv2model.RuleConditionFieldSourceIP:
+		return true
+	}
+	return false
+}
+
+// chunkRuleCondition splits the values of condition into chunks of at most chunkSize values.
+// Each chunk is returned as a single element slice of conditions.
+func chunkRuleCondition(condition elbv2model.RuleCondition, chunkSize int) [][]elbv2model.RuleCondition {
+	var values []string
+	switch condition.Field {
+	case elbv2model.RuleConditionFieldHostHeader:
+		values = condition.HostHeaderConfig.Values
+	case elbv2model.RuleConditionFieldPathPattern:
+		values = condition.PathPatternConfig.Values
+	case elbv2model.RuleConditionFieldSourceIP:
+		values = condition.SourceIPConfig.Values
+	}
+	var chunks [][]elbv2model.RuleCondition
+	for start := 0; start < len(values); start += chunkSize {
+		end := start + chunkSize
+		if end > len(values) {
+			end = len(values)
+		}
+		chunkValues := append([]string(nil), values[start:end]...)
+		chunk := elbv2model.RuleCondition{Field: condition.Field}
+		switch condition.Field {
+		case elbv2model.RuleConditionFieldHostHeader:
+			chunk.HostHeaderConfig = &elbv2model.HostHeaderConditionConfig{Values: chunkValues}
+		case elbv2model.RuleConditionFieldPathPattern:
+			chunk.PathPatternConfig = &elbv2model.PathPatternConditionConfig{Values: chunkValues}
+		case elbv2model.RuleConditionFieldSourceIP:
+			chunk.SourceIPConfig = &elbv2model.SourceIPConditionConfig{Values: chunkValues}
+		}
+		chunks = append(chunks, []elbv2model.RuleCondition{chunk})
+	}
+	return chunks
+}
+
+func ceilDiv(a int, b int) int {
+	return (a + b - 1) / b
+}
+
+// checkListenerRulesQuota emits warning events on Ingresses having split rules when the default quota of
+// listener rules per ALB would be exceeded, since the rules split from Ingress paths count against the quota.
+func (t *defaultModelBuildTask) checkListenerRulesQuota(_ context.Context) {
+	if len(t.ingressesWithSplitRules) == 0 || t.eventRecorder == nil {
+		return
+	}
+	var resLRs []*elbv2model.ListenerRule
+	t.stack.ListResources(&resLRs)
+	if len(resLRs) <= DefaultListenerRulesQuota {
+		return
+	}
+	for _, ingKey := range sortedIngressKeys(t.ingressesWithSplitRules) {
+		t.eventRecorder.Eventf(t.ingressesWithSplitRules[ingKey], corev1.EventTypeWarning, k8s.IngressEventReasonListenerRulesQuota,
+			"listener rules split to fit condition limits bring the total to %v, exceeding the default quota of %v rules per load balancer",
+			len(resLRs), DefaultListenerRulesQuota)
+	}
+}
+
+func sortedIngressKeys(ingByKey map[types.NamespacedName]*networking.Ingress) []types.NamespacedName {
+	keys := make([]types.NamespacedName, 0, len(ingByKey))
+	for key := range ingByKey {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	return keys
+}
+
 // sortIngressPaths will sort the paths following the strategy:
 // all exact match paths come first, no need to sort since exact match has to be unique
 // followed by prefix paths, sort by lengths - longer paths get precedence
@@ -1,10 +1,14 @@
 package ingress
 
 import (
+	"context"
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
 
@@ -675,3 +679,258 @@ func Test_defaultModelBuildTask_buildPathPatternsForPrefixPathType(t *testing.T)
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildRulePrioritySettings(t *testing.T) {
+	tests := []struct {
+		name               string
+		annotations        map[string]string
+		wantPriorityBase   *int64
+		wantPathPriorities map[string]int64
+		wantErr            error
+	}{
+		{
+			name: "no priority settings",
+		},
+		{
+			name: "priority base and path priorities",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-priority-base": "100",
+				"alb.ingress.kubernetes.io/rule-priorities":    `{"/api": 10, "app.example.com/login": 5}`,
+			},
+			wantPriorityBase: awssdk.Int64(100),
+			wantPathPriorities: map[string]int64{
+				"/api":                  10,
+				"app.example.com/login": 5,
+			},
+		},
+		{
+			name: "priority base out of range",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-priority-base": "0",
+			},
+			wantErr: errors.New("listener rule priority base must be within [1:50000], priority base: 0"),
+		},
+		{
+			name: "path priority out of range",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-priorities": `{"/api": 50001}`,
+			},
+			wantErr: errors.New("listener rule priority must be within [1:50000], path: /api, priority: 50001"),
+		},
+		{
+			name: "invalid path priorities",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/rule-priorities": `/api=10`,
+			},
+			wantErr: errors.New("failed to parse json annotation, alb.ingress.kubernetes.io/rule-priorities: /api=10: invalid character '/' looking for beginning of value"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			ing := ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "ing-1",
+						Annotations: tt.annotations,
+					},
+				},
+			}
+			gotPriorityBase, gotPathPriorities, err := task.buildRulePrioritySettings(context.Background(), ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantPriorityBase, gotPriorityBase)
+				assert.Equal(t, tt.wantPathPriorities, gotPathPriorities)
+			}
+		})
+	}
+}
+
+func Test_allocateRulePriorities(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       []Rule
+		maxPriority int64
+		want        []int64
+		wantErr     error
+	}{
+		{
+			name:        "implicit priorities only",
+			rules:       []Rule{{}, {}, {}},
+			maxPriority: 50000,
+			want:        []int64{1, 2, 3},
+		},
+		{
+			name: "implicit priorities skip explicit priorities",
+			rules: []Rule{
+				{},
+				{Priority: awssdk.Int64(2)},
+				{},
+				{Priority: awssdk.Int64(100)},
+				{},
+			},
+			maxPriority: 50000,
+			want:        []int64{1, 2, 3, 100, 4},
+		},
+		{
+			name: "explicit priorities before implicit ones",
+			rules: []Rule{
+				{Priority: awssdk.Int64(1)},
+				{Priority: awssdk.Int64(3)},
+				{},
+				{},
+			},
+			maxPriority: 50000,
+			want:        []int64{1, 3, 2, 4},
+		},
+		{
+			name: "explicit priority at upper bound",
+			rules: []Rule{
+				{Priority: awssdk.Int64(50000)},
+				{},
+			},
+			maxPriority: 50000,
+			want:        []int64{50000, 1},
+		},
+		{
+			name: "implicit priorities exhausted",
+			rules: []Rule{
+				{Priority: awssdk.Int64(1)},
+				{},
+				{},
+			},
+			maxPriority: 2,
+			wantErr:     errors.New("listener rule priorities exhausted, at most 2 rules are supported"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := allocateRulePriorities(tt.rules, tt.maxPriority)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_splitRuleConditions(t *testing.T) {
+	hostHeaderCondition := func(hosts ...string) elbv2model.RuleCondition {
+		return elbv2model.RuleCondition{
+			Field:            elbv2model.RuleConditionFieldHostHeader,
+			HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{Values: hosts},
+		}
+	}
+	pathPatternCondition := func(paths ...string) elbv2model.RuleCondition {
+		return elbv2model.RuleCondition{
+			Field:             elbv2model.RuleConditionFieldPathPattern,
+			PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: paths},
+		}
+	}
+	httpHeaderCondition := func(values ...string) elbv2model.RuleCondition {
+		return elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+				HTTPHeaderName: "x-env",
+				Values:         values,
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		conditions []elbv2model.RuleCondition
+		want       [][]elbv2model.RuleCondition
+		wantErr    error
+	}{
+		{
+			name: "conditions within limit are kept",
+			conditions: []elbv2model.RuleCondition{
+				hostHeaderCondition("a.com", "b.com"),
+				pathPatternCondition("/p1", "/p2", "/p3"),
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					hostHeaderCondition("a.com", "b.com"),
+					pathPatternCondition("/p1", "/p2", "/p3"),
+				},
+			},
+		},
+		{
+			name: "host header exceeding limit",
+			conditions: []elbv2model.RuleCondition{
+				hostHeaderCondition("a.com", "b.com", "c.com", "d.com", "e.com", "f.com", "g.com"),
+				pathPatternCondition("/*"),
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					hostHeaderCondition("a.com", "b.com", "c.com", "d.com"),
+					pathPatternCondition("/*"),
+				},
+				{
+					hostHeaderCondition("e.com", "f.com", "g.com"),
+					pathPatternCondition("/*"),
+				},
+			},
+		},
+		{
+			name: "both host header and path pattern exceeding limit",
+			conditions: []elbv2model.RuleCondition{
+				hostHeaderCondition("a.com", "b.com", "c.com"),
+				pathPatternCondition("/p1", "/p2", "/p3"),
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					hostHeaderCondition("a.com", "b.com", "c.com"),
+					pathPatternCondition("/p1", "/p2"),
+				},
+				{
+					hostHeaderCondition("a.com", "b.com", "c.com"),
+					pathPatternCondition("/p3"),
+				},
+			},
+		},
+		{
+			name: "unsplittable conditions are kept in each rule",
+			conditions: []elbv2model.RuleCondition{
+				httpHeaderCondition("dev", "prod"),
+				pathPatternCondition("/p1", "/p2", "/p3", "/p4"),
+			},
+			want: [][]elbv2model.RuleCondition{
+				{
+					httpHeaderCondition("dev", "prod"),
+					pathPatternCondition("/p1", "/p2", "/p3"),
+				},
+				{
+					httpHeaderCondition("dev", "prod"),
+					pathPatternCondition("/p4"),
+				},
+			},
+		},
+		{
+			name: "unsplittable conditions exceeding limit",
+			conditions: []elbv2model.RuleCondition{
+				httpHeaderCondition("v1", "v2", "v3", "v4", "v5"),
+				pathPatternCondition("/*"),
+			},
+			wantErr: errors.New("conditions must contain at most 5 values, got 6"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := splitRuleConditions(tt.conditions)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+func Test_defaultModelBuildTask_computeIngressMutualAuthentication(t *testing.T) {
+	tests := []struct {
+		name    string
+		ing     ClassifiedIngress
+		want    map[int64]elbv2api.MutualAuthenticationAttributes
+		wantErr error
+	}{
+		{
+			name: "mutual authentication not configured",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+					},
+				},
+			},
+			want: map[int64]elbv2api.MutualAuthenticationAttributes{},
+		},
+		{
+			name: "mutual authentication configured via annotation",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify", "trustStore": "arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/ts-1/abc", "ignoreClientCertificateExpiry": true}, {"port": 8443, "mode": "passthrough"}]`,
+						},
+					},
+				},
+			},
+			want: map[int64]elbv2api.MutualAuthenticationAttributes{
+				443: {
+					Port:                          443,
+					Mode:                          elbv2api.MutualAuthenticationModeVerify,
+					TrustStore:                    "arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/ts-1/abc",
+					IgnoreClientCertificateExpiry: awssdk.Bool(true),
+				},
+				8443: {
+					Port: 8443,
+					Mode: elbv2api.MutualAuthenticationModePassthrough,
+				},
+			},
+		},
+		{
+			name: "mutual authentication configured via IngressClassParams takes precedence",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "passthrough"}]`,
+						},
+					},
+				},
+				IngClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						Spec: elbv2api.IngressClassParamsSpec{
+							MutualAuthentication: []elbv2api.MutualAuthenticationAttributes{
+								{
+									Port: 443,
+									Mode: elbv2api.MutualAuthenticationModeVerify,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[int64]elbv2api.MutualAuthenticationAttributes{
+				443: {
+					Port: 443,
+					Mode: elbv2api.MutualAuthenticationModeVerify,
+				},
+			},
+		},
+		{
+			name: "trustStore specified in passthrough mode",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "passthrough", "trustStore": "arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/ts-1/abc"}]`,
+						},
+					},
+				},
+			},
+			wantErr: errors.New("trustStore and ignoreClientCertificateExpiry can only be specified in verify mode: 443"),
+		},
+		{
+			name: "trustStore isn't an ARN",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "verify", "trustStore": "ts-1"}]`,
+						},
+					},
+				},
+			},
+			wantErr: errors.New("trustStore must be an ARN: ts-1"),
+		},
+		{
+			name: "unknown mode",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "strict"}]`,
+						},
+					},
+				},
+			},
+			wantErr: errors.New("mutual authentication mode must be within [off, passthrough, verify]: strict"),
+		},
+		{
+			name: "duplicate port",
+			ing: ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/mutual-authentication": `[{"port": 443, "mode": "off"}, {"port": 443, "mode": "passthrough"}]`,
+						},
+					},
+				},
+			},
+			wantErr: errors.New("duplicate mutual authentication configuration for port: 443"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.computeIngressMutualAuthentication(context.Background(), &tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
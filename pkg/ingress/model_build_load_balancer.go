@@ -6,9 +6,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"strconv"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -16,6 +18,7 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -44,7 +47,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
-	ipAddressType, err := t.buildLoadBalancerIPAddressType(ctx)
+	ipAddressType, err := t.buildLoadBalancerIPAddressType(ctx, scheme)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
@@ -60,7 +63,11 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
-	coIPv4Pool, err := t.buildLoadBalancerCOIPv4Pool(ctx)
+	coIPv4Pool, err := t.buildLoadBalancerCOIPv4Pool(ctx, subnetMappings)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
+	ipamPools, err := t.buildLoadBalancerIPAMPools(ctx, scheme)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
@@ -68,20 +75,26 @@ func (t *defaultModelBuildTask) buildLoadBalancerSpec(ctx context.Context, liste
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
+	minimumCapacity, err := t.buildLoadBalancerMinimumCapacity(ctx)
+	if err != nil {
+		return elbv2model.LoadBalancerSpec{}, err
+	}
 	name, err := t.buildLoadBalancerName(ctx, scheme)
 	if err != nil {
 		return elbv2model.LoadBalancerSpec{}, err
 	}
 	return elbv2model.LoadBalancerSpec{
-		Name:                   name,
-		Type:                   elbv2model.LoadBalancerTypeApplication,
-		Scheme:                 &scheme,
-		IPAddressType:          &ipAddressType,
-		SubnetMappings:         subnetMappings,
-		SecurityGroups:         securityGroups,
-		CustomerOwnedIPv4Pool:  coIPv4Pool,
-		LoadBalancerAttributes: loadBalancerAttributes,
-		Tags:                   tags,
+		Name:                        name,
+		Type:                        elbv2model.LoadBalancerTypeApplication,
+		Scheme:                      &scheme,
+		IPAddressType:               &ipAddressType,
+		SubnetMappings:              subnetMappings,
+		SecurityGroups:              securityGroups,
+		CustomerOwnedIPv4Pool:       coIPv4Pool,
+		IPAMPools:                   ipamPools,
+		LoadBalancerAttributes:      loadBalancerAttributes,
+		MinimumLoadBalancerCapacity: minimumCapacity,
+		Tags:                        tags,
 	}, nil
 }
 
@@ -98,9 +111,8 @@ func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme
 	}
 	if len(explicitNames) == 1 {
 		name, _ := explicitNames.PopAny()
-		// The name of the loadbalancer can only have up to 32 characters
-		if len(name) > 32 {
-			return "", errors.New("load balancer name cannot be longer than 32 characters")
+		if len(name) > elbv2model.LoadBalancerNameMaxLength {
+			return "", errors.Errorf("load balancer name cannot be longer than %d characters", elbv2model.LoadBalancerNameMaxLength)
 		}
 		return name, nil
 	}
@@ -155,7 +167,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerScheme(_ context.Context) (elbv
 }
 
 // buildLoadBalancerIPAddressType builds the LoadBalancer IPAddressType.
-func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context) (elbv2model.IPAddressType, error) {
+func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context, scheme elbv2model.LoadBalancerScheme) (elbv2model.IPAddressType, error) {
 	explicitIPAddressTypes := sets.NewString()
 	for _, member := range t.ingGroup.Members {
 		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.IPAddressType != nil {
@@ -181,6 +193,11 @@ func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context
 		return elbv2model.IPAddressTypeIPV4, nil
 	case string(elbv2model.IPAddressTypeDualStack):
 		return elbv2model.IPAddressTypeDualStack, nil
+	case string(elbv2model.IPAddressTypeDualStackWithoutPublicIPV4):
+		if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+			return "", errors.Errorf("IPAddressType %v is only supported for internet-facing load balancers", rawIPAddressType)
+		}
+		return elbv2model.IPAddressTypeDualStackWithoutPublicIPV4, nil
 	default:
 		return "", errors.Errorf("unknown IPAddressType: %v", rawIPAddressType)
 	}
@@ -211,12 +228,23 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 				return nil, errors.Errorf("conflicting IngressClassParams subnet specifications")
 			}
 		}
-		chosenSubnets, err := t.subnetsResolver.ResolveViaSelector(ctx, chosenSubnetSelector,
+		resolveOpts := []networking.SubnetsResolveOption{
 			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
 			networking.WithSubnetsResolveLBScheme(scheme),
 			networking.WithSubnetsClusterTagCheck(t.featureGates.Enabled(config.SubnetsClusterTagCheck)),
 			networking.WithALBSingleSubnet(t.featureGates.Enabled(config.ALBSingleSubnet)),
-		)
+		}
+		var chosenSubnets []ec2types.Subnet
+		var err error
+		if chosenSubnetSelector.Selector != nil {
+			resolveOpts = append(resolveOpts,
+				networking.WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
+				networking.WithSubnetsDiscoverySelector(chosenSubnetSelector.Selector),
+			)
+			chosenSubnets, err = t.subnetsResolver.ResolveViaDiscovery(ctx, resolveOpts...)
+		} else {
+			chosenSubnets, err = t.subnetsResolver.ResolveViaSelector(ctx, chosenSubnetSelector, resolveOpts...)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -248,7 +276,16 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 		return nil, err
 	}
 
-	if len(sdkLBs) == 0 || (string(scheme) != awssdk.StringValue(sdkLBs[0].LoadBalancer.Scheme)) {
+	// the stack might contain other LoadBalancers(e.g. the frontend NLB), only reuse subnets from the ALB.
+	var sdkLB *elbv2deploy.LoadBalancerWithTags
+	for i := range sdkLBs {
+		if resID, ok := sdkLBs[i].Tags[t.trackingProvider.ResourceIDTagKey()]; ok && resID != resourceIDLoadBalancer {
+			continue
+		}
+		sdkLB = &sdkLBs[i]
+		break
+	}
+	if sdkLB == nil || (string(scheme) != string(sdkLB.LoadBalancer.Scheme)) {
 		chosenSubnets, err := t.subnetsResolver.ResolveViaDiscovery(ctx,
 			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
 			networking.WithSubnetsResolveLBScheme(scheme),
@@ -261,10 +298,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Cont
 		return buildLoadBalancerSubnetMappingsWithSubnets(chosenSubnets), nil
 	}
 
-	availabilityZones := sdkLBs[0].LoadBalancer.AvailabilityZones
+	availabilityZones := sdkLB.LoadBalancer.AvailabilityZones
 	subnetIDs := make([]string, 0, len(availabilityZones))
 	for _, availabilityZone := range availabilityZones {
-		subnetID := awssdk.StringValue(availabilityZone.SubnetId)
+		subnetID := awssdk.ToString(availabilityZone.SubnetId)
 		subnetIDs = append(subnetIDs, subnetID)
 	}
 	return buildLoadBalancerSubnetMappingsWithSubnetIDs(subnetIDs), nil
@@ -275,6 +312,13 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
+	externallyManagedNetworking, err := t.buildExternallyManagedNetworking(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if externallyManagedNetworking {
+		return t.buildExternallyManagedLoadBalancerSecurityGroups(ctx, sgNameOrIDsViaAnnotation)
+	}
 	var lbSGTokens []core.StringToken
 	if len(sgNameOrIDsViaAnnotation) == 0 {
 		managedSG, err := t.buildManagedSecurityGroup(ctx, listenPortConfigByPort, ipAddressType)
@@ -324,6 +368,32 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 	return lbSGTokens, nil
 }
 
+// buildExternallyManagedLoadBalancerSecurityGroups builds the securityGroups of LoadBalancer whose networking is managed externally.
+// neither the managed nor the backend securityGroup is created, thus no securityGroup rule is added to the targets either,
+// the specified securityGroups are only resolved to validate that they exist.
+func (t *defaultModelBuildTask) buildExternallyManagedLoadBalancerSecurityGroups(ctx context.Context, sgNameOrIDs []string) ([]core.StringToken, error) {
+	if len(sgNameOrIDs) == 0 {
+		return nil, errors.Errorf("securityGroups must be specified via %v annotation when networking is externally managed", annotations.IngressSuffixSecurityGroups)
+	}
+	manageBackendSGRules, err := t.buildManageSecurityGroupRulesFlag(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if manageBackendSGRules {
+		return nil, errors.New("backend securityGroup rules cannot be managed when networking is externally managed")
+	}
+	frontendSGIDs, err := t.sgResolver.ResolveViaNameOrID(ctx, sgNameOrIDs)
+	if err != nil {
+		return nil, err
+	}
+	lbSGTokens := make([]core.StringToken, 0, len(frontendSGIDs))
+	for _, sgID := range frontendSGIDs {
+		lbSGTokens = append(lbSGTokens, core.LiteralStringToken(sgID))
+	}
+	t.logger.Info("SG managed externally", "LB SGs", lbSGTokens)
+	return lbSGTokens, nil
+}
+
 func (t *defaultModelBuildTask) buildFrontendSGNameOrIDsFromAnnotation(ctx context.Context) ([]string, error) {
 	var explicitSGNameOrIDsList [][]string
 	for _, member := range t.ingGroup.Members {
@@ -345,7 +415,7 @@ func (t *defaultModelBuildTask) buildFrontendSGNameOrIDsFromAnnotation(ctx conte
 	return chosenSGNameOrIDs, nil
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerCOIPv4Pool(_ context.Context) (*string, error) {
+func (t *defaultModelBuildTask) buildLoadBalancerCOIPv4Pool(ctx context.Context, subnetMappings []elbv2model.SubnetMapping) (*string, error) {
 	explicitCOIPv4Pools := sets.NewString()
 	for _, member := range t.ingGroup.Members {
 		rawCOIPv4Pool := ""
@@ -367,9 +437,150 @@ func (t *defaultModelBuildTask) buildLoadBalancerCOIPv4Pool(_ context.Context) (
 	}
 
 	rawCOIPv4Pool, _ := explicitCOIPv4Pools.PopAny()
+	if err := t.validateLoadBalancerCOIPv4Pool(ctx, rawCOIPv4Pool, subnetMappings); err != nil {
+		return nil, err
+	}
 	return &rawCOIPv4Pool, nil
 }
 
+// validateLoadBalancerCOIPv4Pool validates the CoIP pool exists and belongs to the Outpost of the LoadBalancer subnets.
+func (t *defaultModelBuildTask) validateLoadBalancerCOIPv4Pool(ctx context.Context, coIPv4Pool string, subnetMappings []elbv2model.SubnetMapping) error {
+	coipPoolsResp, err := t.ec2Client.DescribeCoipPoolsWithContext(ctx, &ec2sdk.DescribeCoipPoolsInput{
+		PoolIds: []string{coIPv4Pool},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe CustomerOwnedIPv4Pool %v", coIPv4Pool)
+	}
+	if len(coipPoolsResp.CoipPools) == 0 {
+		return errors.Errorf("CustomerOwnedIPv4Pool %v not found", coIPv4Pool)
+	}
+	lgwRouteTableID := awssdk.ToString(coipPoolsResp.CoipPools[0].LocalGatewayRouteTableId)
+	lgwRouteTablesResp, err := t.ec2Client.DescribeLocalGatewayRouteTablesWithContext(ctx, &ec2sdk.DescribeLocalGatewayRouteTablesInput{
+		LocalGatewayRouteTableIds: []string{lgwRouteTableID},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to describe local gateway route table %v of CustomerOwnedIPv4Pool %v", lgwRouteTableID, coIPv4Pool)
+	}
+	if len(lgwRouteTablesResp.LocalGatewayRouteTables) == 0 {
+		return errors.Errorf("local gateway route table %v of CustomerOwnedIPv4Pool %v not found", lgwRouteTableID, coIPv4Pool)
+	}
+	poolOutpostARN := awssdk.ToString(lgwRouteTablesResp.LocalGatewayRouteTables[0].OutpostArn)
+
+	subnetIDs := make([]string, 0, len(subnetMappings))
+	for _, subnetMapping := range subnetMappings {
+		subnetIDs = append(subnetIDs, subnetMapping.SubnetID)
+	}
+	subnets, err := t.ec2Client.DescribeSubnetsAsList(ctx, &ec2sdk.DescribeSubnetsInput{
+		SubnetIds: subnetIDs,
+	})
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		subnetOutpostARN := awssdk.ToString(subnet.OutpostArn)
+		if subnetOutpostARN != poolOutpostARN {
+			return errors.Errorf("CustomerOwnedIPv4Pool %v belongs to Outpost %v, but subnet %v isn't within it",
+				coIPv4Pool, poolOutpostARN, awssdk.ToString(subnet.SubnetId))
+		}
+	}
+	return nil
+}
+
+// buildLoadBalancerIPAMPools builds the IPAM pools to source the public IP addresses of the LoadBalancer from.
+func (t *defaultModelBuildTask) buildLoadBalancerIPAMPools(_ context.Context, scheme elbv2model.LoadBalancerScheme) (*elbv2model.IPAMPools, error) {
+	explicitIPv4IPAMPoolIDs := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.IPAMConfiguration != nil &&
+			member.IngClassConfig.IngClassParams.Spec.IPAMConfiguration.IPv4IPAMPoolId != nil {
+			explicitIPv4IPAMPoolIDs.Insert(*member.IngClassConfig.IngClassParams.Spec.IPAMConfiguration.IPv4IPAMPoolId)
+			continue
+		}
+		rawIPv4IPAMPoolID := ""
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixIPAMIPv4PoolID, &rawIPv4IPAMPoolID, member.Ing.Annotations); !exists {
+			continue
+		}
+		if len(rawIPv4IPAMPoolID) == 0 {
+			return nil, errors.Errorf("cannot use empty value for %s annotation, ingress: %v",
+				annotations.IngressSuffixIPAMIPv4PoolID, k8s.NamespacedName(member.Ing))
+		}
+		explicitIPv4IPAMPoolIDs.Insert(rawIPv4IPAMPoolID)
+	}
+
+	if len(explicitIPv4IPAMPoolIDs) == 0 {
+		return nil, nil
+	}
+	if len(explicitIPv4IPAMPoolIDs) > 1 {
+		return nil, errors.Errorf("conflicting IPv4 IPAM pool: %v", explicitIPv4IPAMPoolIDs.List())
+	}
+	if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+		return nil, errors.Errorf("IPv4 IPAM pool is only supported for internet-facing load balancers")
+	}
+
+	rawIPv4IPAMPoolID, _ := explicitIPv4IPAMPoolIDs.PopAny()
+	return &elbv2model.IPAMPools{
+		IPv4IPAMPoolID: rawIPv4IPAMPoolID,
+	}, nil
+}
+
+// buildLoadBalancerMinimumCapacity builds the capacity units reserved for the LoadBalancer.
+func (t *defaultModelBuildTask) buildLoadBalancerMinimumCapacity(_ context.Context) (*elbv2model.MinimumLoadBalancerCapacity, error) {
+	explicitCapacityUnits := sets.NewInt64()
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.MinimumLoadBalancerCapacity != nil {
+			explicitCapacityUnits.Insert(member.IngClassConfig.IngClassParams.Spec.MinimumLoadBalancerCapacity.CapacityUnits)
+			continue
+		}
+		var rawCapacity map[string]string
+		exists, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixMinimumLoadBalancerCapacity, &rawCapacity, member.Ing.Annotations)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %v annotation, ingress: %v",
+				annotations.IngressSuffixMinimumLoadBalancerCapacity, k8s.NamespacedName(member.Ing))
+		}
+		if !exists {
+			continue
+		}
+		capacityUnits, err := parseMinimumLoadBalancerCapacityUnits(rawCapacity)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %v annotation, ingress: %v",
+				annotations.IngressSuffixMinimumLoadBalancerCapacity, k8s.NamespacedName(member.Ing))
+		}
+		explicitCapacityUnits.Insert(capacityUnits)
+	}
+
+	if len(explicitCapacityUnits) == 0 {
+		return nil, nil
+	}
+	if len(explicitCapacityUnits) > 1 {
+		return nil, errors.Errorf("conflicting minimum load balancer capacity: %v", explicitCapacityUnits.List())
+	}
+	capacityUnits, _ := explicitCapacityUnits.PopAny()
+	return &elbv2model.MinimumLoadBalancerCapacity{
+		CapacityUnits: capacityUnits,
+	}, nil
+}
+
+// parseMinimumLoadBalancerCapacityUnits parses the capacity units from rawCapacity like CapacityUnits=1000.
+func parseMinimumLoadBalancerCapacityUnits(rawCapacity map[string]string) (int64, error) {
+	const capacityUnitsKey = "CapacityUnits"
+	for key := range rawCapacity {
+		if key != capacityUnitsKey {
+			return 0, errors.Errorf("unknown key %v, expects %v", key, capacityUnitsKey)
+		}
+	}
+	rawCapacityUnits, ok := rawCapacity[capacityUnitsKey]
+	if !ok {
+		return 0, errors.Errorf("missing key %v", capacityUnitsKey)
+	}
+	capacityUnits, err := strconv.ParseInt(rawCapacityUnits, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse %v=%v", capacityUnitsKey, rawCapacityUnits)
+	}
+	if capacityUnits < 1 {
+		return 0, errors.Errorf("%v=%v must be positive", capacityUnitsKey, rawCapacityUnits)
+	}
+	return capacityUnits, nil
+}
+
 func (t *defaultModelBuildTask) buildLoadBalancerAttributes(_ context.Context) ([]elbv2model.LoadBalancerAttribute, error) {
 	ingGroupAttributes, err := t.buildIngressGroupLoadBalancerAttributes(t.ingGroup.Members)
 	if err != nil {
@@ -393,11 +604,11 @@ func (t *defaultModelBuildTask) buildLoadBalancerTags(_ context.Context) (map[st
 	return algorithm.MergeStringMap(t.defaultTags, ingGroupTags), nil
 }
 
-func buildLoadBalancerSubnetMappingsWithSubnets(subnets []*ec2sdk.Subnet) []elbv2model.SubnetMapping {
+func buildLoadBalancerSubnetMappingsWithSubnets(subnets []ec2types.Subnet) []elbv2model.SubnetMapping {
 	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(subnets))
 	for _, subnet := range subnets {
 		subnetMappings = append(subnetMappings, elbv2model.SubnetMapping{
-			SubnetID: awssdk.StringValue(subnet.SubnetId),
+			SubnetID: awssdk.ToString(subnet.SubnetId),
 		})
 	}
 	return subnetMappings
@@ -2,9 +2,15 @@ package ingress
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	shieldmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/shield"
 	wafregionalmodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafregional"
@@ -24,29 +30,98 @@ func (t *defaultModelBuildTask) buildLoadBalancerAddOns(ctx context.Context, lbA
 	return nil
 }
 
-func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(_ context.Context, lbARN core.StringToken) (*wafv2model.WebACLAssociation, error) {
-	explicitWebACLARNs := sets.NewString()
+func (t *defaultModelBuildTask) buildWAFv2WebACLAssociation(ctx context.Context, lbARN core.StringToken) (*wafv2model.WebACLAssociation, error) {
+	managedWebACL, err := t.buildManagedWAFv2WebACL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the WebACL is associated with the whole load balancer, thus individual Ingresses cannot opt out of it,
+	// the Ingresses specifying conflicting WebACLs are reported instead.
+	ingKeysByExplicitWebACLARN := make(map[string][]string)
 	for _, member := range t.ingGroup.Members {
 		rawWebACLARN := ""
-		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &rawWebACLARN, member.Ing.Annotations); exists {
-			explicitWebACLARNs.Insert(rawWebACLARN)
+		if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &rawWebACLARN, member.Ing.Annotations); !exists {
+			continue
 		}
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.WAFv2WebACL != nil {
+			if t.eventRecorder != nil {
+				t.eventRecorder.Eventf(member.Ing, corev1.EventTypeWarning, k8s.IngressEventReasonIgnoredWAFv2WebACL,
+					"%v/%v annotation is ignored, as the WAFv2 WebACL of the load balancer is enforced by IngressClassParams %v",
+					annotations.AnnotationPrefixIngress, annotations.IngressSuffixWAFv2ACLARN, member.IngClassConfig.IngClassParams.Name)
+			}
+			continue
+		}
+		ingKey := k8s.NamespacedName(member.Ing).String()
+		ingKeysByExplicitWebACLARN[rawWebACLARN] = append(ingKeysByExplicitWebACLARN[rawWebACLARN], ingKey)
 	}
-	if len(explicitWebACLARNs) == 0 {
-		return nil, nil
+	var webACLARN core.StringToken
+	if managedWebACL != nil {
+		if len(ingKeysByExplicitWebACLARN) != 0 {
+			return nil, errors.Errorf("conflicting WAFv2 WebACLs: controller managed WebACL and %v", formatIngKeysByWebACLARN(ingKeysByExplicitWebACLARN))
+		}
+		webACLARN = managedWebACL.WebACLARN()
+	} else {
+		if len(ingKeysByExplicitWebACLARN) == 0 {
+			return nil, nil
+		}
+		if len(ingKeysByExplicitWebACLARN) > 1 {
+			return nil, errors.Errorf("conflicting WAFv2 WebACL ARNs: %v", formatIngKeysByWebACLARN(ingKeysByExplicitWebACLARN))
+		}
+		rawWebACLARN := ""
+		for explicitWebACLARN := range ingKeysByExplicitWebACLARN {
+			rawWebACLARN = explicitWebACLARN
+		}
+		if rawWebACLARN == "" {
+			return nil, nil
+		}
+		webACLARN = core.LiteralStringToken(rawWebACLARN)
 	}
-	if len(explicitWebACLARNs) > 1 {
-		return nil, errors.Errorf("conflicting WAFv2 WebACL ARNs: %v", explicitWebACLARNs.List())
+	logDestinationARN, err := t.buildWAFv2LogDestinationARN(ctx)
+	if err != nil {
+		return nil, err
 	}
-	webACLARN, _ := explicitWebACLARNs.PopAny()
-	if webACLARN != "" {
-		association := wafv2model.NewWebACLAssociation(t.stack, resourceIDLoadBalancer, wafv2model.WebACLAssociationSpec{
-			WebACLARN:   webACLARN,
-			ResourceARN: lbARN,
-		})
-		return association, nil
+	association := wafv2model.NewWebACLAssociation(t.stack, resourceIDLoadBalancer, wafv2model.WebACLAssociationSpec{
+		WebACLARN:         webACLARN,
+		ResourceARN:       lbARN,
+		LogDestinationARN: logDestinationARN,
+	})
+	return association, nil
+}
+
+// formatIngKeysByWebACLARN formats the Ingresses specifying each WebACL ARN, e.g. "[arn-1 (ns/ing-1, ns/ing-2), none (ns/ing-3)]".
+func formatIngKeysByWebACLARN(ingKeysByWebACLARN map[string][]string) string {
+	webACLARNs := make([]string, 0, len(ingKeysByWebACLARN))
+	for webACLARN := range ingKeysByWebACLARN {
+		webACLARNs = append(webACLARNs, webACLARN)
 	}
-	return nil, nil
+	sort.Strings(webACLARNs)
+	formatted := make([]string, 0, len(webACLARNs))
+	for _, webACLARN := range webACLARNs {
+		displayedWebACLARN := webACLARN
+		if displayedWebACLARN == "" {
+			displayedWebACLARN = "none"
+		}
+		formatted = append(formatted, fmt.Sprintf("%v (%v)", displayedWebACLARN, strings.Join(ingKeysByWebACLARN[webACLARN], ", ")))
+	}
+	return fmt.Sprintf("[%v]", strings.Join(formatted, ", "))
+}
+
+// buildWAFv2LogDestinationARN builds the log destination for WAFv2 webACL from IngressClassParams.
+func (t *defaultModelBuildTask) buildWAFv2LogDestinationARN(_ context.Context) (*string, error) {
+	explicitLogDestinationARNs := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.WAFv2LoggingConfiguration != nil {
+			explicitLogDestinationARNs.Insert(member.IngClassConfig.IngClassParams.Spec.WAFv2LoggingConfiguration.LogDestinationARN)
+		}
+	}
+	if len(explicitLogDestinationARNs) == 0 {
+		return nil, nil
+	}
+	if len(explicitLogDestinationARNs) > 1 {
+		return nil, errors.Errorf("conflicting WAFv2 log destination ARNs: %v", explicitLogDestinationARNs.List())
+	}
+	logDestinationARN, _ := explicitLogDestinationARNs.PopAny()
+	return &logDestinationARN, nil
 }
 
 func (t *defaultModelBuildTask) buildWAFRegionalWebACLAssociation(_ context.Context, lbARN core.StringToken) (*wafregionalmodel.WebACLAssociation, error) {
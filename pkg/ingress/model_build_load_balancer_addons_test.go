@@ -0,0 +1,125 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+func Test_defaultModelBuildTask_buildWAFv2WebACLAssociation(t *testing.T) {
+	newIngress := func(name string, webACLARN *string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+		if webACLARN != nil {
+			ing.Annotations = map[string]string{
+				"alb.ingress.kubernetes.io/wafv2-acl-arn": *webACLARN,
+			}
+		}
+		return ing
+	}
+	webACLARN1 := "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/acl-1/id-1"
+	webACLARN2 := "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/acl-2/id-2"
+	optOut := ""
+	classParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class-params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			WAFv2WebACL: &elbv2api.WAFv2WebACL{},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		members       []ClassifiedIngress
+		wantWebACLARN *string
+		wantManaged   bool
+		wantEvents    []string
+		wantErr       error
+	}{
+		{
+			name: "WebACL shared by Ingresses",
+			members: []ClassifiedIngress{
+				{Ing: newIngress("ing-1", &webACLARN1)},
+				{Ing: newIngress("ing-2", nil)},
+				{Ing: newIngress("ing-3", &webACLARN1)},
+			},
+			wantWebACLARN: &webACLARN1,
+		},
+		{
+			name: "WebACL disassociated by Ingresses",
+			members: []ClassifiedIngress{
+				{Ing: newIngress("ing-1", &optOut)},
+			},
+		},
+		{
+			name: "Ingress opting out of WebACL of other Ingresses",
+			members: []ClassifiedIngress{
+				{Ing: newIngress("ing-1", &webACLARN1)},
+				{Ing: newIngress("ing-2", &optOut)},
+				{Ing: newIngress("ing-3", &webACLARN2)},
+				{Ing: newIngress("ing-4", &webACLARN1)},
+			},
+			wantErr: errors.New("conflicting WAFv2 WebACL ARNs: [none (awesome-ns/ing-2), " +
+				"arn:aws:wafv2:us-west-2:123456789012:regional/webacl/acl-1/id-1 (awesome-ns/ing-1, awesome-ns/ing-4), " +
+				"arn:aws:wafv2:us-west-2:123456789012:regional/webacl/acl-2/id-2 (awesome-ns/ing-3)]"),
+		},
+		{
+			name: "Ingress opting out of WebACL enforced by IngressClassParams",
+			members: []ClassifiedIngress{
+				{Ing: newIngress("ing-1", nil), IngClassConfig: ClassConfiguration{IngClassParams: classParams}},
+				{Ing: newIngress("ing-2", &optOut), IngClassConfig: ClassConfiguration{IngClassParams: classParams}},
+			},
+			wantManaged: true,
+			wantEvents: []string{
+				"Warning IgnoredWAFv2WebACL alb.ingress.kubernetes.io/wafv2-acl-arn annotation is ignored, as the WAFv2 WebACL of the load balancer is enforced by IngressClassParams awesome-class-params",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRecorder := record.NewFakeRecorder(10)
+			task := &defaultModelBuildTask{
+				clusterName:      "cluster-name",
+				ingGroup:         Group{ID: GroupID{Name: "awesome-group"}, Members: tt.members},
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				eventRecorder:    eventRecorder,
+				stack:            core.NewDefaultStack(core.StackID{Name: "awesome-group"}),
+			}
+			got, err := task.buildWAFv2WebACLAssociation(context.Background(), core.LiteralStringToken("lb-arn"))
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				switch {
+				case tt.wantManaged:
+					assert.NotNil(t, got)
+					_, isLiteral := got.Spec.WebACLARN.(core.LiteralStringToken)
+					assert.False(t, isLiteral)
+				case tt.wantWebACLARN != nil:
+					assert.Equal(t, core.LiteralStringToken(*tt.wantWebACLARN), got.Spec.WebACLARN)
+				default:
+					assert.Nil(t, got)
+				}
+			}
+			close(eventRecorder.Events)
+			var gotEvents []string
+			for event := range eventRecorder.Events {
+				gotEvents = append(gotEvents, event)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package ingress
 
 import (
+	"strconv"
+
 	"github.com/pkg/errors"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
 // buildIngressGroupLoadBalancerAttributes builds the LB attributes for a group of Ingresses.
@@ -40,17 +43,43 @@ func (t *defaultModelBuildTask) buildIngressLoadBalancerAttributes(ing Classifie
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &annotationAttributes, ing.Ing.Annotations); err != nil {
 		return nil, err
 	}
+	var rawWAFFailOpen bool
+	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixWAFFailOpen, &rawWAFFailOpen, ing.Ing.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		wafFailOpen := strconv.FormatBool(rawWAFFailOpen)
+		if existingValue, ok := annotationAttributes[elbv2model.LBAttrsWAFFailOpenEnabled]; ok && existingValue != wafFailOpen {
+			return nil, errors.Errorf("conflicting attributes %v: %v | %v", elbv2model.LBAttrsWAFFailOpenEnabled, existingValue, wafFailOpen)
+		}
+		if annotationAttributes == nil {
+			annotationAttributes = make(map[string]string)
+		}
+		annotationAttributes[elbv2model.LBAttrsWAFFailOpenEnabled] = wafFailOpen
+	}
 	return annotationAttributes, nil
 }
 
 // buildIngressClassLoadBalancerAttributes builds the LB attributes for an IngressClass.
 func (t *defaultModelBuildTask) buildIngressClassLoadBalancerAttributes(ingClassConfig ClassConfiguration) (map[string]string, error) {
-	if ingClassConfig.IngClassParams == nil || len(ingClassConfig.IngClassParams.Spec.LoadBalancerAttributes) == 0 {
+	if ingClassConfig.IngClassParams == nil {
+		return nil, nil
+	}
+	ingClassParamsSpec := ingClassConfig.IngClassParams.Spec
+	if len(ingClassParamsSpec.LoadBalancerAttributes) == 0 && ingClassParamsSpec.WAFFailOpen == nil {
 		return nil, nil
 	}
-	ingClassAttributes := make(map[string]string, len(ingClassConfig.IngClassParams.Spec.LoadBalancerAttributes))
-	for _, attr := range ingClassConfig.IngClassParams.Spec.LoadBalancerAttributes {
+	ingClassAttributes := make(map[string]string, len(ingClassParamsSpec.LoadBalancerAttributes))
+	for _, attr := range ingClassParamsSpec.LoadBalancerAttributes {
 		ingClassAttributes[attr.Key] = attr.Value
 	}
+	if ingClassParamsSpec.WAFFailOpen != nil {
+		wafFailOpen := strconv.FormatBool(*ingClassParamsSpec.WAFFailOpen)
+		if existingValue, ok := ingClassAttributes[elbv2model.LBAttrsWAFFailOpenEnabled]; ok && existingValue != wafFailOpen {
+			return nil, errors.Errorf("conflicting attributes %v: %v | %v", elbv2model.LBAttrsWAFFailOpenEnabled, existingValue, wafFailOpen)
+		}
+		ingClassAttributes[elbv2model.LBAttrsWAFFailOpenEnabled] = wafFailOpen
+	}
 	return ingClassAttributes, nil
 }
@@ -2,6 +2,7 @@ package ingress
 
 import (
 	"fmt"
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
@@ -303,6 +304,37 @@ func Test_defaultModelBuildTask_buildIngressGroupLoadBalancerAttributes(t *testi
 				"deletion_protection.enabled":  "true",
 			},
 		},
+		{
+			name: "waf-fail-open annotation from Ingress, wafFailOpen from IngressClass takes priority",
+			args: args{
+				ingList: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "awesome-ing",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/waf-fail-open": "true",
+								},
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &elbv2api.IngressClassParams{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "awesome-class",
+								},
+								Spec: elbv2api.IngressClassParamsSpec{
+									WAFFailOpen: awssdk.Bool(false),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[string]string{
+				"waf.fail_open.enabled": "false",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -351,6 +383,64 @@ func Test_defaultModelBuildTask_buildIngressLoadBalancerAttributes(t *testing.T)
 				"access_logs.s3.enabled":       "true",
 			},
 		},
+		{
+			name: "waf-fail-open annotation from Ingress",
+			args: args{
+				ing: ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/waf-fail-open": "true",
+							},
+						},
+					},
+				},
+			},
+			want: map[string]string{
+				"waf.fail_open.enabled": "true",
+			},
+		},
+		{
+			name: "waf-fail-open annotation and load-balancer-attributes from Ingress - no conflict",
+			args: args{
+				ing: ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/load-balancer-attributes": "idle_timeout.timeout_seconds=30, waf.fail_open.enabled=false",
+								"alb.ingress.kubernetes.io/waf-fail-open":            "false",
+							},
+						},
+					},
+				},
+			},
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "30",
+				"waf.fail_open.enabled":        "false",
+			},
+		},
+		{
+			name: "waf-fail-open annotation and load-balancer-attributes from Ingress - conflict",
+			args: args{
+				ing: ClassifiedIngress{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "awesome-ing",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/load-balancer-attributes": "waf.fail_open.enabled=false",
+								"alb.ingress.kubernetes.io/waf-fail-open":            "true",
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting attributes waf.fail_open.enabled: false | true"),
+		},
 		{
 			name: "empty attributes from Ingress",
 			args: args{
@@ -422,6 +512,31 @@ func Test_defaultModelBuildTask_buildIngressClassLoadBalancerAttributes(t *testi
 				"idle_timeout.timeout_seconds": "60",
 			},
 		},
+		{
+			name: "non-empty ingressClassParams, wafFailOpen specified",
+			args: args{
+				ingClassConfig: ClassConfiguration{
+					IngClassParams: &elbv2api.IngressClassParams{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "awesome-class",
+						},
+						Spec: elbv2api.IngressClassParamsSpec{
+							LoadBalancerAttributes: []elbv2api.Attribute{
+								{
+									Key:   "idle_timeout.timeout_seconds",
+									Value: "60",
+								},
+							},
+							WAFFailOpen: awssdk.Bool(false),
+						},
+					},
+				},
+			},
+			want: map[string]string{
+				"idle_timeout.timeout_seconds": "60",
+				"waf.fail_open.enabled":        "false",
+			},
+		},
 		{
 			name: "non-empty ingressClassParams, empty LoadBalancerAttributes",
 			args: args{
@@ -0,0 +1,385 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// loadBalancerRoutePriorityBandStart is the first listener rule priority of the band reserved for LoadBalancerRoutes.
+	// The rules of Ingresses in IngressGroups with LoadBalancerRoutes get the priorities below this band.
+	loadBalancerRoutePriorityBandStart = 40001
+	// loadBalancerRouteIngressNamePrefix prefixes the name of the Ingress representing a LoadBalancerRoute, it's not
+	// valid in Kubernetes object names, so that the target groups of LoadBalancerRoutes never collide with Ingresses'.
+	loadBalancerRouteIngressNamePrefix = "LoadBalancerRoute/"
+)
+
+// loadLoadBalancerRoutes loads the LoadBalancerRoutes referencing this IngressGroup, sorted by namespace and name.
+// Invalid LoadBalancerRoutes are reported via events and skipped, so that they don't block the reconciliation of IngressGroup.
+func (t *defaultModelBuildTask) loadLoadBalancerRoutes(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig) ([]*elbv2api.LoadBalancerRoute, error) {
+	if !t.featureGates.Enabled(config.LoadBalancerRoute) || !t.ingGroup.ID.IsExplicit() {
+		return nil, nil
+	}
+	routeList := &elbv2api.LoadBalancerRouteList{}
+	if err := t.k8sClient.List(ctx, routeList); err != nil {
+		return nil, errors.Wrap(err, "failed to list LoadBalancerRoutes")
+	}
+	routes := routeList.Items
+	sort.Slice(routes, func(i, j int) bool {
+		return k8s.NamespacedName(&routes[i]).String() < k8s.NamespacedName(&routes[j]).String()
+	})
+
+	memberNamespaces := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		memberNamespaces.Insert(member.Ing.Namespace)
+	}
+	var groupRoutes []*elbv2api.LoadBalancerRoute
+	for i := range routes {
+		route := &routes[i]
+		if NewGroupIDForExplicitGroup(route.Spec.Group.Name) != t.ingGroup.ID {
+			continue
+		}
+		// routes are only accepted from the namespaces of IngressGroup members, so that other namespaces cannot hijack the LoadBalancer.
+		if !memberNamespaces.Has(route.Namespace) {
+			t.recordLoadBalancerRouteWarning(route, errors.Errorf("no Ingress of IngressGroup %v in namespace %v", t.ingGroup.ID, route.Namespace))
+			continue
+		}
+		if route.Spec.Port != nil {
+			if _, exists := listenPortConfigByPort[*route.Spec.Port]; !exists {
+				t.recordLoadBalancerRouteWarning(route, errors.Errorf("no listener on port %v", *route.Spec.Port))
+				continue
+			}
+		}
+		groupRoutes = append(groupRoutes, route)
+	}
+	return groupRoutes, nil
+}
+
+func (t *defaultModelBuildTask) recordLoadBalancerRouteWarning(route *elbv2api.LoadBalancerRoute, err error) {
+	t.logger.Info("ignoring LoadBalancerRoute", "loadBalancerRoute", k8s.NamespacedName(route), "reason", err.Error())
+	if t.eventRecorder != nil {
+		t.eventRecorder.Event(route, corev1.EventTypeWarning, k8s.LoadBalancerRouteEventReasonInvalidRoute,
+			fmt.Sprintf("Ignored route due to %v", err))
+	}
+}
+
+// buildLoadBalancerRoutesForPort returns the LoadBalancerRoutes attached to the listener on port.
+func (t *defaultModelBuildTask) buildLoadBalancerRoutesForPort(port int64) []*elbv2api.LoadBalancerRoute {
+	var routes []*elbv2api.LoadBalancerRoute
+	for _, route := range t.loadBalancerRoutes {
+		if route.Spec.Port != nil && *route.Spec.Port != port {
+			continue
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+// buildLoadBalancerRouteRules builds the listener rules of the LoadBalancerRoutes attached to the listener on port.
+// The rules get consecutive priorities from the reserved band in the order of LoadBalancerRoutes and their rules.
+func (t *defaultModelBuildTask) buildLoadBalancerRouteRules(ctx context.Context, port int64, routes []*elbv2api.LoadBalancerRoute) []Rule {
+	var rules []Rule
+	for _, route := range routes {
+		routeRules, err := t.buildLoadBalancerRouteRulesForRoute(ctx, route)
+		if err != nil {
+			t.recordLoadBalancerRouteWarning(route, err)
+			continue
+		}
+		if int64(len(rules)+len(routeRules)) > MaxListenerRulePriority-loadBalancerRoutePriorityBandStart+1 {
+			t.recordLoadBalancerRouteWarning(route, errors.Errorf("listener rule priorities reserved for LoadBalancerRoutes exhausted on port %v", port))
+			continue
+		}
+		for i := range routeRules {
+			routeRules[i].Priority = awssdk.Int64(loadBalancerRoutePriorityBandStart + int64(len(rules)+i))
+		}
+		rules = append(rules, routeRules...)
+	}
+	return rules
+}
+
+// buildLoadBalancerRouteRulesForRoute builds the listener rules of LoadBalancerRoute, each match of a rule becomes
+// a listener rule. The route is validated before building its target groups, so that invalid routes don't leave
+// target groups behind in the stack.
+func (t *defaultModelBuildTask) buildLoadBalancerRouteRulesForRoute(ctx context.Context, route *elbv2api.LoadBalancerRoute) ([]Rule, error) {
+	conditionsPerRule := make([][][]elbv2model.RuleCondition, 0, len(route.Spec.Rules))
+	for i, routeRule := range route.Spec.Rules {
+		if err := validateLoadBalancerRouteRuleActions(routeRule); err != nil {
+			return nil, errors.Wrapf(err, "rules[%v]", i)
+		}
+		matches := routeRule.Matches
+		if len(matches) == 0 {
+			matches = []elbv2api.LoadBalancerRouteMatch{{}}
+		}
+		var ruleConditions [][]elbv2model.RuleCondition
+		for _, match := range matches {
+			conditions, err := t.buildLoadBalancerRouteConditions(ctx, route.Spec.Hostnames, match)
+			if err != nil {
+				return nil, errors.Wrapf(err, "rules[%v]", i)
+			}
+			splitConditions, err := splitRuleConditions(conditions)
+			if err != nil {
+				return nil, errors.Wrapf(err, "rules[%v]", i)
+			}
+			ruleConditions = append(ruleConditions, splitConditions...)
+		}
+		conditionsPerRule = append(conditionsPerRule, ruleConditions)
+	}
+	backendServices, err := t.loadLoadBalancerRouteBackendServices(ctx, route)
+	if err != nil {
+		return nil, err
+	}
+
+	ing := t.buildLoadBalancerRouteIngress(route)
+	tags, err := t.buildListenerRuleTags(ctx, ing)
+	if err != nil {
+		return nil, err
+	}
+	var rules []Rule
+	for i, routeRule := range route.Spec.Rules {
+		actions, err := t.buildLoadBalancerRouteActions(ctx, ing, routeRule, backendServices)
+		if err != nil {
+			return nil, errors.Wrapf(err, "rules[%v]", i)
+		}
+		for _, conditions := range conditionsPerRule[i] {
+			rules = append(rules, Rule{
+				Conditions: conditions,
+				Actions:    actions,
+				Tags:       tags,
+			})
+		}
+	}
+	return rules, nil
+}
+
+// buildLoadBalancerRouteIngress builds the Ingress representing LoadBalancerRoute when building target groups and tags.
+// It carries the default annotations and the IngressClass configuration of the IngressGroup.
+func (t *defaultModelBuildTask) buildLoadBalancerRouteIngress(route *elbv2api.LoadBalancerRoute) ClassifiedIngress {
+	var ingClassConfig ClassConfiguration
+	if len(t.ingGroup.Members) != 0 {
+		ingClassConfig = t.ingGroup.Members[0].IngClassConfig
+	}
+	return ClassifiedIngress{
+		Ing: &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   route.Namespace,
+				Name:        loadBalancerRouteIngressNamePrefix + route.Name,
+				Annotations: t.ingGroup.DefaultAnnotations,
+			},
+		},
+		IngClassConfig: ingClassConfig,
+	}
+}
+
+// validateLoadBalancerRouteRuleActions validates that rule either forwards to backends or responds with a single filter.
+func validateLoadBalancerRouteRuleActions(routeRule elbv2api.LoadBalancerRouteRule) error {
+	if (len(routeRule.Filters) == 0) == (len(routeRule.Backends) == 0) {
+		return errors.New("exactly one of filters and backends must be specified")
+	}
+	if len(routeRule.Filters) > 1 {
+		return errors.New("at most one filter can be specified")
+	}
+	for _, filter := range routeRule.Filters {
+		switch filter.Type {
+		case elbv2api.LoadBalancerRouteFilterTypeRequestRedirect:
+			if filter.RequestRedirect == nil {
+				return errors.Errorf("missing requestRedirect for filter type %v", filter.Type)
+			}
+		case elbv2api.LoadBalancerRouteFilterTypeFixedResponse:
+			if filter.FixedResponse == nil {
+				return errors.Errorf("missing fixedResponse for filter type %v", filter.Type)
+			}
+		default:
+			return errors.Errorf("unknown filter type: %v", filter.Type)
+		}
+	}
+	return nil
+}
+
+// loadLoadBalancerRouteBackendServices loads the backend Services of LoadBalancerRoute, and validates the referenced ports exist.
+func (t *defaultModelBuildTask) loadLoadBalancerRouteBackendServices(ctx context.Context, route *elbv2api.LoadBalancerRoute) (map[types.NamespacedName]*corev1.Service, error) {
+	backendServices := make(map[types.NamespacedName]*corev1.Service)
+	for _, routeRule := range route.Spec.Rules {
+		for _, backend := range routeRule.Backends {
+			svcKey := types.NamespacedName{Namespace: route.Namespace, Name: backend.Name}
+			svc, exists := backendServices[svcKey]
+			if !exists {
+				svc = &corev1.Service{}
+				if err := t.k8sClient.Get(ctx, svcKey, svc); err != nil {
+					return nil, errors.Wrapf(err, "failed to load backend Service %v", svcKey)
+				}
+				backendServices[svcKey] = svc
+			}
+			if _, err := k8s.LookupServicePort(svc, backend.Port); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return backendServices, nil
+}
+
+func (t *defaultModelBuildTask) buildLoadBalancerRouteActions(ctx context.Context, ing ClassifiedIngress,
+	routeRule elbv2api.LoadBalancerRouteRule, backendServices map[types.NamespacedName]*corev1.Service) ([]elbv2model.Action, error) {
+	for _, filter := range routeRule.Filters {
+		switch filter.Type {
+		case elbv2api.LoadBalancerRouteFilterTypeRequestRedirect:
+			return []elbv2model.Action{buildLoadBalancerRouteRedirectAction(*filter.RequestRedirect)}, nil
+		case elbv2api.LoadBalancerRouteFilterTypeFixedResponse:
+			return []elbv2model.Action{buildLoadBalancerRouteFixedResponseAction(*filter.FixedResponse)}, nil
+		}
+	}
+
+	targetGroupTuples := make([]elbv2model.TargetGroupTuple, 0, len(routeRule.Backends))
+	for _, backend := range routeRule.Backends {
+		svc := backendServices[types.NamespacedName{Namespace: ing.Ing.Namespace, Name: backend.Name}]
+		tg, err := t.buildTargetGroup(ctx, ing, svc, backend.Port, nil)
+		if err != nil {
+			return nil, err
+		}
+		weight := int64(1)
+		if backend.Weight != nil {
+			weight = *backend.Weight
+		}
+		targetGroupTuples = append(targetGroupTuples, elbv2model.TargetGroupTuple{
+			TargetGroupARN: tg.TargetGroupARN(),
+			Weight:         awssdk.Int64(weight),
+		})
+	}
+	return []elbv2model.Action{
+		{
+			Type: elbv2model.ActionTypeForward,
+			ForwardConfig: &elbv2model.ForwardActionConfig{
+				TargetGroups: targetGroupTuples,
+			},
+		},
+	}, nil
+}
+
+func buildLoadBalancerRouteRedirectAction(redirect elbv2api.LoadBalancerRouteRequestRedirect) elbv2model.Action {
+	statusCode := int64(301)
+	if redirect.StatusCode != nil {
+		statusCode = *redirect.StatusCode
+	}
+	var port *string
+	if redirect.Port != nil {
+		port = awssdk.String(strconv.FormatInt(*redirect.Port, 10))
+	}
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeRedirect,
+		RedirectConfig: &elbv2model.RedirectActionConfig{
+			Host:       redirect.Hostname,
+			Path:       redirect.Path,
+			Port:       port,
+			Protocol:   redirect.Scheme,
+			Query:      redirect.Query,
+			StatusCode: fmt.Sprintf("HTTP_%d", statusCode),
+		},
+	}
+}
+
+func buildLoadBalancerRouteFixedResponseAction(fixedResponse elbv2api.LoadBalancerRouteFixedResponse) elbv2model.Action {
+	return elbv2model.Action{
+		Type: elbv2model.ActionTypeFixedResponse,
+		FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+			ContentType: fixedResponse.ContentType,
+			MessageBody: fixedResponse.MessageBody,
+			StatusCode:  strconv.FormatInt(fixedResponse.StatusCode, 10),
+		},
+	}
+}
+
+// buildLoadBalancerRouteConditions builds the listener rule conditions of a match for the hostnames of LoadBalancerRoute.
+func (t *defaultModelBuildTask) buildLoadBalancerRouteConditions(ctx context.Context, hostnames []string, match elbv2api.LoadBalancerRouteMatch) ([]elbv2model.RuleCondition, error) {
+	var conditions []elbv2model.RuleCondition
+	for _, header := range match.Headers {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPHeader,
+			HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+				HTTPHeaderName: header.Name,
+				Values:         header.Values,
+			},
+		})
+	}
+	if len(match.Methods) != 0 {
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldHTTPRequestMethod,
+			HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{
+				Values: match.Methods,
+			},
+		})
+	}
+	if len(match.QueryParams) != 0 {
+		values := make([]elbv2model.QueryStringKeyValuePair, 0, len(match.QueryParams))
+		for _, queryParam := range match.QueryParams {
+			values = append(values, elbv2model.QueryStringKeyValuePair{
+				Key:   queryParam.Key,
+				Value: queryParam.Value,
+			})
+		}
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldQueryString,
+			QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+				Values: values,
+			},
+		})
+	}
+	if len(match.SourceIPs) != 0 {
+		for _, sourceIP := range match.SourceIPs {
+			if _, _, err := net.ParseCIDR(sourceIP); err != nil {
+				return nil, errors.Errorf("invalid source IP CIDR: %v", sourceIP)
+			}
+		}
+		conditions = append(conditions, elbv2model.RuleCondition{
+			Field: elbv2model.RuleConditionFieldSourceIP,
+			SourceIPConfig: &elbv2model.SourceIPConditionConfig{
+				Values: match.SourceIPs,
+			},
+		})
+	}
+	if len(hostnames) != 0 {
+		conditions = append(conditions, t.buildHostHeaderCondition(ctx, hostnames))
+	}
+	if match.Path != nil {
+		pathPatterns, err := t.buildLoadBalancerRoutePathPatterns(*match.Path)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, t.buildPathPatternCondition(ctx, pathPatterns))
+	}
+	if len(conditions) == 0 {
+		conditions = append(conditions, t.buildPathPatternCondition(ctx, []string{"/*"}))
+	}
+	return conditions, nil
+}
+
+// buildLoadBalancerRoutePathPatterns builds the path patterns of path match, with the same semantics as the Ingress path types.
+func (t *defaultModelBuildTask) buildLoadBalancerRoutePathPatterns(pathMatch elbv2api.LoadBalancerRoutePathMatch) ([]string, error) {
+	pathMatchType := elbv2api.LoadBalancerRoutePathMatchTypePrefix
+	if pathMatch.Type != nil {
+		pathMatchType = *pathMatch.Type
+	}
+	switch pathMatchType {
+	case elbv2api.LoadBalancerRoutePathMatchTypeExact:
+		return t.buildPathPatternsForExactPathType(pathMatch.Value)
+	case elbv2api.LoadBalancerRoutePathMatchTypePrefix:
+		return t.buildPathPatternsForPrefixPathType(pathMatch.Value)
+	case elbv2api.LoadBalancerRoutePathMatchTypePathPattern:
+		return t.buildPathPatternsForImplementationSpecificPathType(pathMatch.Value)
+	default:
+		return nil, errors.Errorf("unsupported path match type: %v", pathMatchType)
+	}
+}
@@ -0,0 +1,552 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultModelBuildTask_loadLoadBalancerRoutes(t *testing.T) {
+	explicitGroup := Group{
+		ID: NewGroupIDForExplicitGroup("awesome-group"),
+		Members: []ClassifiedIngress{
+			{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1"}}},
+		},
+	}
+	implicitGroup := Group{
+		ID: NewGroupIDForImplicitGroup(types.NamespacedName{Namespace: "ns-1", Name: "ing-1"}),
+		Members: []ClassifiedIngress{
+			{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1"}}},
+		},
+	}
+	listenPortConfigByPort := map[int64]listenPortConfig{
+		80:  {protocol: elbv2model.ProtocolHTTP},
+		443: {protocol: elbv2model.ProtocolHTTPS},
+	}
+	buildRoute := func(namespace string, name string, groupName string, port *int64) *elbv2api.LoadBalancerRoute {
+		return &elbv2api.LoadBalancerRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: elbv2api.LoadBalancerRouteSpec{
+				Group: elbv2api.IngressGroup{Name: groupName},
+				Port:  port,
+				Rules: []elbv2api.LoadBalancerRouteRule{
+					{Backends: []elbv2api.LoadBalancerRouteBackend{{Name: "svc-1", Port: intstr.FromInt(80)}}},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name           string
+		ingGroup       Group
+		routes         []*elbv2api.LoadBalancerRoute
+		disableFeature bool
+		want           []types.NamespacedName
+		wantEvents     int
+	}{
+		{
+			name:     "routes referencing explicit group",
+			ingGroup: explicitGroup,
+			routes: []*elbv2api.LoadBalancerRoute{
+				buildRoute("ns-1", "route-b", "awesome-group", awssdk.Int64(443)),
+				buildRoute("ns-1", "route-a", "awesome-group", nil),
+				buildRoute("ns-1", "route-c", "other-group", nil),
+			},
+			want: []types.NamespacedName{
+				{Namespace: "ns-1", Name: "route-a"},
+				{Namespace: "ns-1", Name: "route-b"},
+			},
+		},
+		{
+			name:     "routes from namespace without group members are ignored",
+			ingGroup: explicitGroup,
+			routes: []*elbv2api.LoadBalancerRoute{
+				buildRoute("ns-2", "route-a", "awesome-group", nil),
+			},
+			want:       nil,
+			wantEvents: 1,
+		},
+		{
+			name:     "routes referencing unknown listener port are ignored",
+			ingGroup: explicitGroup,
+			routes: []*elbv2api.LoadBalancerRoute{
+				buildRoute("ns-1", "route-a", "awesome-group", awssdk.Int64(8443)),
+			},
+			want:       nil,
+			wantEvents: 1,
+		},
+		{
+			name:     "implicit group",
+			ingGroup: implicitGroup,
+			routes: []*elbv2api.LoadBalancerRoute{
+				buildRoute("ns-1", "route-a", "ing-1", nil),
+			},
+			want: nil,
+		},
+		{
+			name:           "feature disabled",
+			ingGroup:       explicitGroup,
+			disableFeature: true,
+			routes: []*elbv2api.LoadBalancerRoute{
+				buildRoute("ns-1", "route-a", "awesome-group", nil),
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, route := range tt.routes {
+				assert.NoError(t, k8sClient.Create(ctx, route.DeepCopy()))
+			}
+			featureGates := config.NewFeatureGates()
+			if !tt.disableFeature {
+				featureGates.Enable(config.LoadBalancerRoute)
+			}
+			eventRecorder := record.NewFakeRecorder(10)
+			task := &defaultModelBuildTask{
+				k8sClient:     k8sClient,
+				eventRecorder: eventRecorder,
+				featureGates:  featureGates,
+				ingGroup:      tt.ingGroup,
+				logger:        logr.Discard(),
+			}
+			got, err := task.loadLoadBalancerRoutes(ctx, listenPortConfigByPort)
+			assert.NoError(t, err)
+			var gotKeys []types.NamespacedName
+			for _, route := range got {
+				gotKeys = append(gotKeys, types.NamespacedName{Namespace: route.Namespace, Name: route.Name})
+			}
+			assert.Equal(t, tt.want, gotKeys)
+			assert.Len(t, eventRecorder.Events, tt.wantEvents)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerRouteRules(t *testing.T) {
+	prefix := elbv2api.LoadBalancerRoutePathMatchTypePrefix
+	exact := elbv2api.LoadBalancerRoutePathMatchTypeExact
+	fixedResponseRule := func(statusCode int64) elbv2api.LoadBalancerRouteRule {
+		return elbv2api.LoadBalancerRouteRule{
+			Filters: []elbv2api.LoadBalancerRouteFilter{
+				{
+					Type:          elbv2api.LoadBalancerRouteFilterTypeFixedResponse,
+					FixedResponse: &elbv2api.LoadBalancerRouteFixedResponse{StatusCode: statusCode},
+				},
+			},
+		}
+	}
+	fixedResponseAction := func(statusCode string) []elbv2model.Action {
+		return []elbv2model.Action{
+			{
+				Type:                elbv2model.ActionTypeFixedResponse,
+				FixedResponseConfig: &elbv2model.FixedResponseActionConfig{StatusCode: statusCode},
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		routes     []*elbv2api.LoadBalancerRoute
+		port       int64
+		want       []Rule
+		wantEvents int
+	}{
+		{
+			name: "matches of rules get consecutive priorities in route order",
+			routes: []*elbv2api.LoadBalancerRoute{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "route-a"},
+					Spec: elbv2api.LoadBalancerRouteSpec{
+						Hostnames: []string{"example.com"},
+						Rules: []elbv2api.LoadBalancerRouteRule{
+							{
+								Matches: []elbv2api.LoadBalancerRouteMatch{
+									{
+										Path:    &elbv2api.LoadBalancerRoutePathMatch{Type: &exact, Value: "/healthz"},
+										Methods: []string{"GET"},
+									},
+									{
+										Path: &elbv2api.LoadBalancerRoutePathMatch{Type: &prefix, Value: "/status/"},
+										Headers: []elbv2api.LoadBalancerRouteHeaderMatch{
+											{Name: "x-debug", Values: []string{"true"}},
+										},
+									},
+								},
+								Filters: []elbv2api.LoadBalancerRouteFilter{
+									{
+										Type: elbv2api.LoadBalancerRouteFilterTypeFixedResponse,
+										FixedResponse: &elbv2api.LoadBalancerRouteFixedResponse{
+											StatusCode:  200,
+											ContentType: awssdk.String("text/plain"),
+											MessageBody: awssdk.String("ok"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "route-b"},
+					Spec: elbv2api.LoadBalancerRouteSpec{
+						Rules: []elbv2api.LoadBalancerRouteRule{
+							{
+								Matches: []elbv2api.LoadBalancerRouteMatch{
+									{
+										QueryParams: []elbv2api.LoadBalancerRouteQueryParamMatch{
+											{Key: awssdk.String("version"), Value: "v1"},
+										},
+										SourceIPs: []string{"192.0.2.0/24"},
+									},
+								},
+								Filters: []elbv2api.LoadBalancerRouteFilter{
+									{
+										Type: elbv2api.LoadBalancerRouteFilterTypeRequestRedirect,
+										RequestRedirect: &elbv2api.LoadBalancerRouteRequestRedirect{
+											Scheme: awssdk.String("HTTPS"),
+											Port:   awssdk.Int64(443),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			port: 80,
+			want: []Rule{
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field:                   elbv2model.RuleConditionFieldHTTPRequestMethod,
+							HTTPRequestMethodConfig: &elbv2model.HTTPRequestMethodConditionConfig{Values: []string{"GET"}},
+						},
+						{
+							Field:            elbv2model.RuleConditionFieldHostHeader,
+							HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{Values: []string{"example.com"}},
+						},
+						{
+							Field:             elbv2model.RuleConditionFieldPathPattern,
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{"/healthz"}},
+						},
+					},
+					Actions: []elbv2model.Action{
+						{
+							Type: elbv2model.ActionTypeFixedResponse,
+							FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+								ContentType: awssdk.String("text/plain"),
+								MessageBody: awssdk.String("ok"),
+								StatusCode:  "200",
+							},
+						},
+					},
+					Tags:     map[string]string{},
+					Priority: awssdk.Int64(40001),
+				},
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: elbv2model.RuleConditionFieldHTTPHeader,
+							HTTPHeaderConfig: &elbv2model.HTTPHeaderConditionConfig{
+								HTTPHeaderName: "x-debug",
+								Values:         []string{"true"},
+							},
+						},
+						{
+							Field:            elbv2model.RuleConditionFieldHostHeader,
+							HostHeaderConfig: &elbv2model.HostHeaderConditionConfig{Values: []string{"example.com"}},
+						},
+						{
+							Field:             elbv2model.RuleConditionFieldPathPattern,
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{"/status", "/status/*"}},
+						},
+					},
+					Actions: []elbv2model.Action{
+						{
+							Type: elbv2model.ActionTypeFixedResponse,
+							FixedResponseConfig: &elbv2model.FixedResponseActionConfig{
+								ContentType: awssdk.String("text/plain"),
+								MessageBody: awssdk.String("ok"),
+								StatusCode:  "200",
+							},
+						},
+					},
+					Tags:     map[string]string{},
+					Priority: awssdk.Int64(40002),
+				},
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field: elbv2model.RuleConditionFieldQueryString,
+							QueryStringConfig: &elbv2model.QueryStringConditionConfig{
+								Values: []elbv2model.QueryStringKeyValuePair{{Key: awssdk.String("version"), Value: "v1"}},
+							},
+						},
+						{
+							Field:          elbv2model.RuleConditionFieldSourceIP,
+							SourceIPConfig: &elbv2model.SourceIPConditionConfig{Values: []string{"192.0.2.0/24"}},
+						},
+					},
+					Actions: []elbv2model.Action{
+						{
+							Type: elbv2model.ActionTypeRedirect,
+							RedirectConfig: &elbv2model.RedirectActionConfig{
+								Port:       awssdk.String("443"),
+								Protocol:   awssdk.String("HTTPS"),
+								StatusCode: "HTTP_301",
+							},
+						},
+					},
+					Tags:     map[string]string{},
+					Priority: awssdk.Int64(40003),
+				},
+			},
+		},
+		{
+			name: "routes of other listeners are skipped",
+			routes: []*elbv2api.LoadBalancerRoute{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "route-a"},
+					Spec: elbv2api.LoadBalancerRouteSpec{
+						Port:  awssdk.Int64(443),
+						Rules: []elbv2api.LoadBalancerRouteRule{fixedResponseRule(404)},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "route-b"},
+					Spec: elbv2api.LoadBalancerRouteSpec{
+						Port:  awssdk.Int64(80),
+						Rules: []elbv2api.LoadBalancerRouteRule{fixedResponseRule(503)},
+					},
+				},
+			},
+			port: 80,
+			want: []Rule{
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field:             elbv2model.RuleConditionFieldPathPattern,
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{"/*"}},
+						},
+					},
+					Actions:  fixedResponseAction("503"),
+					Tags:     map[string]string{},
+					Priority: awssdk.Int64(40001),
+				},
+			},
+		},
+		{
+			name: "invalid routes are ignored",
+			routes: []*elbv2api.LoadBalancerRoute{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "filters-and-backends"},
+					Spec: elbv2api.LoadBalancerRouteSpec{
+						Rules: []elbv2api.LoadBalancerRouteRule{
+							{
+								Filters:  fixedResponseRule(404).Filters,
+								Backends: []elbv2api.LoadBalancerRouteBackend{{Name: "svc-1", Port: intstr.FromInt(80)}},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "invalid-source-ip"},
+					Spec: elbv2api.LoadBalancerRouteSpec{
+						Rules: []elbv2api.LoadBalancerRouteRule{
+							{
+								Matches: []elbv2api.LoadBalancerRouteMatch{{SourceIPs: []string{"192.0.2.1"}}},
+								Filters: fixedResponseRule(404).Filters,
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "missing-service"},
+					Spec: elbv2api.LoadBalancerRouteSpec{
+						Rules: []elbv2api.LoadBalancerRouteRule{
+							{
+								Backends: []elbv2api.LoadBalancerRouteBackend{{Name: "svc-1", Port: intstr.FromInt(80)}},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "valid"},
+					Spec: elbv2api.LoadBalancerRouteSpec{
+						Rules: []elbv2api.LoadBalancerRouteRule{fixedResponseRule(404)},
+					},
+				},
+			},
+			port: 80,
+			want: []Rule{
+				{
+					Conditions: []elbv2model.RuleCondition{
+						{
+							Field:             elbv2model.RuleConditionFieldPathPattern,
+							PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: []string{"/*"}},
+						},
+					},
+					Actions:  fixedResponseAction("404"),
+					Tags:     map[string]string{},
+					Priority: awssdk.Int64(40001),
+				},
+			},
+			wantEvents: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			eventRecorder := record.NewFakeRecorder(10)
+			task := &defaultModelBuildTask{
+				k8sClient:        k8sClient,
+				eventRecorder:    eventRecorder,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				ingGroup: Group{
+					ID: NewGroupIDForExplicitGroup("awesome-group"),
+					Members: []ClassifiedIngress{
+						{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "ing-1"}}},
+					},
+				},
+				loadBalancerRoutes: tt.routes,
+				logger:             logr.Discard(),
+			}
+			got := task.buildLoadBalancerRouteRules(ctx, tt.port, task.buildLoadBalancerRoutesForPort(tt.port))
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, eventRecorder.Events, tt.wantEvents)
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildListenerRules_withLoadBalancerRoutes(t *testing.T) {
+	route := &elbv2api.LoadBalancerRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "route-a"},
+		Spec: elbv2api.LoadBalancerRouteSpec{
+			Rules: []elbv2api.LoadBalancerRouteRule{
+				{
+					Filters: []elbv2api.LoadBalancerRouteFilter{
+						{
+							Type:          elbv2api.LoadBalancerRouteFilterTypeFixedResponse,
+							FixedResponse: &elbv2api.LoadBalancerRouteFixedResponse{StatusCode: 404},
+						},
+					},
+				},
+			},
+		},
+	}
+	buildIngress := func(rulePriorityBase string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns-1",
+				Name:      "ing-1",
+				Annotations: map[string]string{
+					"alb.ingress.kubernetes.io/rule-priority-base": rulePriorityBase,
+					"alb.ingress.kubernetes.io/actions.fixed":      `{"type":"fixed-response","fixedResponseConfig":{"statusCode":"503"}}`,
+				},
+			},
+			Spec: networking.IngressSpec{
+				Rules: []networking.IngressRule{
+					{
+						IngressRuleValue: networking.IngressRuleValue{
+							HTTP: &networking.HTTPIngressRuleValue{
+								Paths: []networking.HTTPIngressPath{
+									{
+										Path: "/maintenance",
+										Backend: networking.IngressBackend{
+											Service: &networking.IngressServiceBackend{
+												Name: "fixed",
+												Port: networking.ServiceBackendPort{Name: "use-annotation"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name           string
+		ing            *networking.Ingress
+		wantPriorities []int64
+		wantEvents     int
+	}{
+		{
+			name:           "ingress rules are below the band reserved for LoadBalancerRoutes",
+			ing:            buildIngress("100"),
+			wantPriorities: []int64{100, 40001},
+			wantEvents:     0,
+		},
+		{
+			name:           "LoadBalancerRoutes are rejected if ingress uses priority from the reserved band",
+			ing:            buildIngress("45000"),
+			wantPriorities: []int64{45000},
+			wantEvents:     1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			eventRecorder := record.NewFakeRecorder(10)
+			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
+			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
+			stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+			task := &defaultModelBuildTask{
+				k8sClient:              k8sClient,
+				eventRecorder:          eventRecorder,
+				annotationParser:       annotationParser,
+				enhancedBackendBuilder: NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, true, true, false),
+				ruleOptimizer:          NewDefaultRuleOptimizer(logr.Discard()),
+				featureGates:           config.NewFeatureGates(),
+				stack:                  stack,
+				ingGroup: Group{
+					ID:      NewGroupIDForExplicitGroup("awesome-group"),
+					Members: []ClassifiedIngress{{Ing: tt.ing}},
+				},
+				loadBalancerRoutes: []*elbv2api.LoadBalancerRoute{route},
+				backendServices:    make(map[types.NamespacedName]*corev1.Service),
+				logger:             logr.Discard(),
+			}
+			err := task.buildListenerRules(ctx, core.LiteralStringToken("ls-arn"), 80, elbv2model.ProtocolHTTP, task.ingGroup.Members)
+			assert.NoError(t, err)
+			var resLRs []*elbv2model.ListenerRule
+			assert.NoError(t, stack.ListResources(&resLRs))
+			var gotPriorities []int64
+			for _, resLR := range resLRs {
+				gotPriorities = append(gotPriorities, resLR.Spec.Priority)
+			}
+			assert.ElementsMatch(t, tt.wantPriorities, gotPriorities)
+			assert.Len(t, eventRecorder.Events, tt.wantEvents)
+		})
+	}
+}
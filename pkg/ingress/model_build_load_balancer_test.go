@@ -7,8 +7,9 @@ import (
 	"strings"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -28,8 +29,67 @@ import (
 )
 
 func Test_defaultModelBuildTask_buildLoadBalancerCOIPv4Pool(t *testing.T) {
+	type describeCoipPoolsCall struct {
+		resp *ec2.DescribeCoipPoolsOutput
+		err  error
+	}
+	type describeLocalGatewayRouteTablesCall struct {
+		resp *ec2.DescribeLocalGatewayRouteTablesOutput
+		err  error
+	}
+	type describeSubnetsAsListCall struct {
+		resp []ec2types.Subnet
+		err  error
+	}
 	type fields struct {
-		ingGroup Group
+		ingGroup                             Group
+		describeCoipPoolsCalls               []describeCoipPoolsCall
+		describeLocalGatewayRouteTablesCalls []describeLocalGatewayRouteTablesCall
+		describeSubnetsAsListCalls           []describeSubnetsAsListCall
+	}
+	outpostARN := "arn:aws:outposts:us-west-2:123456789012:outpost/op-1"
+	coipPoolOnOutpost := describeCoipPoolsCall{
+		resp: &ec2.DescribeCoipPoolsOutput{
+			CoipPools: []ec2types.CoipPool{
+				{
+					PoolId:                   awssdk.String("my-ip-pool"),
+					LocalGatewayRouteTableId: awssdk.String("lgw-rtb-1"),
+				},
+			},
+		},
+	}
+	lgwRouteTableOnOutpost := describeLocalGatewayRouteTablesCall{
+		resp: &ec2.DescribeLocalGatewayRouteTablesOutput{
+			LocalGatewayRouteTables: []ec2types.LocalGatewayRouteTable{
+				{
+					LocalGatewayRouteTableId: awssdk.String("lgw-rtb-1"),
+					OutpostArn:               awssdk.String(outpostARN),
+				},
+			},
+		},
+	}
+	subnetsOnOutpost := describeSubnetsAsListCall{
+		resp: []ec2types.Subnet{
+			{
+				SubnetId:   awssdk.String("subnet-1"),
+				OutpostArn: awssdk.String(outpostARN),
+			},
+		},
+	}
+	coipIngGroup := Group{
+		Members: []ClassifiedIngress{
+			{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "awesome-ns",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/customer-owned-ipv4-pool": "my-ip-pool",
+						},
+					},
+				},
+			},
+		},
 	}
 	tests := []struct {
 		name    string
@@ -74,6 +134,9 @@ func Test_defaultModelBuildTask_buildLoadBalancerCOIPv4Pool(t *testing.T) {
 						},
 					},
 				},
+				describeCoipPoolsCalls:               []describeCoipPoolsCall{coipPoolOnOutpost},
+				describeLocalGatewayRouteTablesCalls: []describeLocalGatewayRouteTablesCall{lgwRouteTableOnOutpost},
+				describeSubnetsAsListCalls:           []describeSubnetsAsListCall{subnetsOnOutpost},
 			},
 			want: awssdk.String("my-ip-pool"),
 		},
@@ -153,6 +216,9 @@ func Test_defaultModelBuildTask_buildLoadBalancerCOIPv4Pool(t *testing.T) {
 						},
 					},
 				},
+				describeCoipPoolsCalls:               []describeCoipPoolsCall{coipPoolOnOutpost},
+				describeLocalGatewayRouteTablesCalls: []describeLocalGatewayRouteTablesCall{lgwRouteTableOnOutpost},
+				describeSubnetsAsListCalls:           []describeSubnetsAsListCall{subnetsOnOutpost},
 			},
 			want: awssdk.String("my-ip-pool"),
 		},
@@ -185,6 +251,9 @@ func Test_defaultModelBuildTask_buildLoadBalancerCOIPv4Pool(t *testing.T) {
 						},
 					},
 				},
+				describeCoipPoolsCalls:               []describeCoipPoolsCall{coipPoolOnOutpost},
+				describeLocalGatewayRouteTablesCalls: []describeLocalGatewayRouteTablesCall{lgwRouteTableOnOutpost},
+				describeSubnetsAsListCalls:           []describeSubnetsAsListCall{subnetsOnOutpost},
 			},
 			want: awssdk.String("my-ip-pool"),
 		},
@@ -220,15 +289,97 @@ func Test_defaultModelBuildTask_buildLoadBalancerCOIPv4Pool(t *testing.T) {
 			},
 			wantErr: errors.New("conflicting CustomerOwnedIPv4Pool: [my-another-pool my-ip-pool]"),
 		},
+		{
+			name: "COIPv4 pool not found",
+			fields: fields{
+				ingGroup: coipIngGroup,
+				describeCoipPoolsCalls: []describeCoipPoolsCall{
+					{
+						resp: &ec2.DescribeCoipPoolsOutput{},
+					},
+				},
+			},
+			wantErr: errors.New("CustomerOwnedIPv4Pool my-ip-pool not found"),
+		},
+		{
+			name: "COIPv4 pool failed to describe",
+			fields: fields{
+				ingGroup: coipIngGroup,
+				describeCoipPoolsCalls: []describeCoipPoolsCall{
+					{
+						err: errors.New("InvalidCoipPoolId.Malformed"),
+					},
+				},
+			},
+			wantErr: errors.New("failed to describe CustomerOwnedIPv4Pool my-ip-pool: InvalidCoipPoolId.Malformed"),
+		},
+		{
+			name: "COIPv4 pool belongs to another Outpost",
+			fields: fields{
+				ingGroup:                             coipIngGroup,
+				describeCoipPoolsCalls:               []describeCoipPoolsCall{coipPoolOnOutpost},
+				describeLocalGatewayRouteTablesCalls: []describeLocalGatewayRouteTablesCall{lgwRouteTableOnOutpost},
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						resp: []ec2types.Subnet{
+							{
+								SubnetId:   awssdk.String("subnet-1"),
+								OutpostArn: awssdk.String("arn:aws:outposts:us-west-2:123456789012:outpost/op-2"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("CustomerOwnedIPv4Pool my-ip-pool belongs to Outpost arn:aws:outposts:us-west-2:123456789012:outpost/op-1, but subnet subnet-1 isn't within it"),
+		},
+		{
+			name: "COIPv4 pool used with subnets outside Outpost",
+			fields: fields{
+				ingGroup:                             coipIngGroup,
+				describeCoipPoolsCalls:               []describeCoipPoolsCall{coipPoolOnOutpost},
+				describeLocalGatewayRouteTablesCalls: []describeLocalGatewayRouteTablesCall{lgwRouteTableOnOutpost},
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						resp: []ec2types.Subnet{
+							{
+								SubnetId: awssdk.String("subnet-1"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("CustomerOwnedIPv4Pool my-ip-pool belongs to Outpost arn:aws:outposts:us-west-2:123456789012:outpost/op-1, but subnet subnet-1 isn't within it"),
+		},
 	}
 	for _, tt := range tests {
-		t.Run(tt.name, func(t1 *testing.T) {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.fields.describeCoipPoolsCalls {
+				ec2Client.EXPECT().DescribeCoipPoolsWithContext(gomock.Any(), &ec2.DescribeCoipPoolsInput{
+					PoolIds: []string{"my-ip-pool"},
+				}).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.describeLocalGatewayRouteTablesCalls {
+				ec2Client.EXPECT().DescribeLocalGatewayRouteTablesWithContext(gomock.Any(), &ec2.DescribeLocalGatewayRouteTablesInput{
+					LocalGatewayRouteTableIds: []string{"lgw-rtb-1"},
+				}).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.describeSubnetsAsListCalls {
+				ec2Client.EXPECT().DescribeSubnetsAsList(gomock.Any(), &ec2.DescribeSubnetsInput{
+					SubnetIds: []string{"subnet-1"},
+				}).Return(call.resp, call.err)
+			}
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			task := &defaultModelBuildTask{
 				annotationParser: annotationParser,
 				ingGroup:         tt.fields.ingGroup,
+				ec2Client:        ec2Client,
 			}
-			got, err := task.buildLoadBalancerCOIPv4Pool(context.Background())
+			subnetMappings := []elbv2.SubnetMapping{{SubnetID: "subnet-1"}}
+			got, err := task.buildLoadBalancerCOIPv4Pool(context.Background(), subnetMappings)
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -239,6 +390,266 @@ func Test_defaultModelBuildTask_buildLoadBalancerCOIPv4Pool(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuildTask_buildLoadBalancerIPAddressType(t *testing.T) {
+	tests := []struct {
+		name                 string
+		annotations          map[string]string
+		scheme               elbv2.LoadBalancerScheme
+		defaultIPAddressType elbv2.IPAddressType
+		want                 elbv2.IPAddressType
+		wantErr              error
+	}{
+		{
+			name:                 "no ip address type annotation",
+			scheme:               elbv2.LoadBalancerSchemeInternal,
+			defaultIPAddressType: elbv2.IPAddressTypeIPV4,
+			want:                 elbv2.IPAddressTypeIPV4,
+		},
+		{
+			name:                 "no ip address type annotation in IPv6-only cluster",
+			scheme:               elbv2.LoadBalancerSchemeInternal,
+			defaultIPAddressType: elbv2.IPAddressTypeDualStack,
+			want:                 elbv2.IPAddressTypeDualStack,
+		},
+		{
+			name: "dualstack ip address type annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ip-address-type": "dualstack",
+			},
+			scheme:               elbv2.LoadBalancerSchemeInternal,
+			defaultIPAddressType: elbv2.IPAddressTypeIPV4,
+			want:                 elbv2.IPAddressTypeDualStack,
+		},
+		{
+			name: "dualstack-without-public-ipv4 ip address type annotation for internet-facing load balancer",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ip-address-type": "dualstack-without-public-ipv4",
+			},
+			scheme:               elbv2.LoadBalancerSchemeInternetFacing,
+			defaultIPAddressType: elbv2.IPAddressTypeDualStack,
+			want:                 elbv2.IPAddressTypeDualStackWithoutPublicIPV4,
+		},
+		{
+			name: "dualstack-without-public-ipv4 ip address type annotation for internal load balancer",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ip-address-type": "dualstack-without-public-ipv4",
+			},
+			scheme:               elbv2.LoadBalancerSchemeInternal,
+			defaultIPAddressType: elbv2.IPAddressTypeDualStack,
+			wantErr:              errors.New("IPAddressType dualstack-without-public-ipv4 is only supported for internet-facing load balancers"),
+		},
+		{
+			name: "unknown ip address type annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ip-address-type": "ipv6",
+			},
+			scheme:               elbv2.LoadBalancerSchemeInternal,
+			defaultIPAddressType: elbv2.IPAddressTypeIPV4,
+			wantErr:              errors.New("unknown IPAddressType: ipv6"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: tt.annotations,
+								},
+							},
+						},
+					},
+				},
+				annotationParser:     annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				defaultIPAddressType: tt.defaultIPAddressType,
+			}
+			got, err := task.buildLoadBalancerIPAddressType(context.Background(), tt.scheme)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerIPAMPools(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		ingClassParams *v1beta1.IngressClassParams
+		scheme         elbv2.LoadBalancerScheme
+		want           *elbv2.IPAMPools
+		wantErr        error
+	}{
+		{
+			name:   "no IPAM pool configured",
+			scheme: elbv2.LoadBalancerSchemeInternetFacing,
+			want:   nil,
+		},
+		{
+			name: "IPAM pool configured via annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ipam-ipv4-pool-id": "ipam-pool-1",
+			},
+			scheme: elbv2.LoadBalancerSchemeInternetFacing,
+			want: &elbv2.IPAMPools{
+				IPv4IPAMPoolID: "ipam-pool-1",
+			},
+		},
+		{
+			name: "IPAM pool configured via IngressClassParams takes precedence over annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ipam-ipv4-pool-id": "ipam-pool-1",
+			},
+			ingClassParams: &v1beta1.IngressClassParams{
+				Spec: v1beta1.IngressClassParamsSpec{
+					IPAMConfiguration: &v1beta1.IPAMConfiguration{
+						IPv4IPAMPoolId: awssdk.String("ipam-pool-2"),
+					},
+				},
+			},
+			scheme: elbv2.LoadBalancerSchemeInternetFacing,
+			want: &elbv2.IPAMPools{
+				IPv4IPAMPoolID: "ipam-pool-2",
+			},
+		},
+		{
+			name: "empty IPAM pool annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ipam-ipv4-pool-id": "",
+			},
+			scheme:  elbv2.LoadBalancerSchemeInternetFacing,
+			wantErr: errors.New("cannot use empty value for ipam-ipv4-pool-id annotation, ingress: awesome-ns/ing-1"),
+		},
+		{
+			name: "IPAM pool configured for internal load balancer",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/ipam-ipv4-pool-id": "ipam-pool-1",
+			},
+			scheme:  elbv2.LoadBalancerSchemeInternal,
+			wantErr: errors.New("IPv4 IPAM pool is only supported for internet-facing load balancers"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace:   "awesome-ns",
+									Name:        "ing-1",
+									Annotations: tt.annotations,
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: tt.ingClassParams,
+							},
+						},
+					},
+				},
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildLoadBalancerIPAMPools(context.Background(), tt.scheme)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildLoadBalancerMinimumCapacity(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		ingClassParams *v1beta1.IngressClassParams
+		want           *elbv2.MinimumLoadBalancerCapacity
+		wantErr        error
+	}{
+		{
+			name: "no minimum capacity configured",
+			want: nil,
+		},
+		{
+			name: "minimum capacity configured via annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/minimum-load-balancer-capacity": "CapacityUnits=1000",
+			},
+			want: &elbv2.MinimumLoadBalancerCapacity{
+				CapacityUnits: 1000,
+			},
+		},
+		{
+			name: "minimum capacity configured via IngressClassParams takes precedence over annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/minimum-load-balancer-capacity": "CapacityUnits=1000",
+			},
+			ingClassParams: &v1beta1.IngressClassParams{
+				Spec: v1beta1.IngressClassParamsSpec{
+					MinimumLoadBalancerCapacity: &v1beta1.MinimumLoadBalancerCapacity{
+						CapacityUnits: 2000,
+					},
+				},
+			},
+			want: &elbv2.MinimumLoadBalancerCapacity{
+				CapacityUnits: 2000,
+			},
+		},
+		{
+			name: "unknown key in annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/minimum-load-balancer-capacity": "Units=1000",
+			},
+			wantErr: errors.New("invalid minimum-load-balancer-capacity annotation, ingress: awesome-ns/ing-1: unknown key Units, expects CapacityUnits"),
+		},
+		{
+			name: "non-positive capacity units in annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/minimum-load-balancer-capacity": "CapacityUnits=0",
+			},
+			wantErr: errors.New("invalid minimum-load-balancer-capacity annotation, ingress: awesome-ns/ing-1: CapacityUnits=0 must be positive"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace:   "awesome-ns",
+									Name:        "ing-1",
+									Annotations: tt.annotations,
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: tt.ingClassParams,
+							},
+						},
+					},
+				},
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildLoadBalancerMinimumCapacity(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildLoadBalancerTags(t *testing.T) {
 	type fields struct {
 		ingGroup            Group
@@ -683,15 +1094,15 @@ func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 }
 
 var (
-	subnet1 = &ec2.Subnet{
+	subnet1 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-1"),
 		AvailabilityZone: awssdk.String("az1"),
 		VpcId:            awssdk.String("vpc-1"),
 	}
-	subnet2 = &ec2.Subnet{
+	subnet2 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-2"),
 		AvailabilityZone: awssdk.String("az2"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("Name"),
 				Value: awssdk.String("namedsubnet"),
@@ -699,15 +1110,15 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet3 = &ec2.Subnet{
+	subnet3 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-3"),
 		AvailabilityZone: awssdk.String("az3"),
 		VpcId:            awssdk.String("vpc-1"),
 	}
-	subnet4 = &ec2.Subnet{
+	subnet4 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-4"),
 		AvailabilityZone: awssdk.String("az4"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("Name"),
 				Value: awssdk.String("othername"),
@@ -715,10 +1126,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet5 = &ec2.Subnet{
+	subnet5 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-5"),
 		AvailabilityZone: awssdk.String("az5"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("Name"),
 				Value: awssdk.String("namedsubnet"),
@@ -726,10 +1137,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-2"),
 	}
-	subnet6 = &ec2.Subnet{
+	subnet6 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-6"),
 		AvailabilityZone: awssdk.String("az6"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("Name"),
 				Value: awssdk.String("subnet-1"),
@@ -737,10 +1148,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet7 = &ec2.Subnet{
+	subnet7 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-7"),
 		AvailabilityZone: awssdk.String("az7"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("Name"),
 				Value: awssdk.String("subnet-1"),
@@ -751,10 +1162,10 @@ var (
 			}},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet8 = &ec2.Subnet{
+	subnet8 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-8"),
 		AvailabilityZone: awssdk.String("az8"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("alb"),
 				Value: awssdk.String("test"),
@@ -762,10 +1173,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet9 = &ec2.Subnet{
+	subnet9 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-9"),
 		AvailabilityZone: awssdk.String("az9"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("Name"),
 				Value: awssdk.String("subnet-12"),
@@ -780,10 +1191,10 @@ var (
 			}},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet10 = &ec2.Subnet{
+	subnet10 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-10"),
 		AvailabilityZone: awssdk.String("az10"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("alb"),
 				Value: awssdk.String("testing"),
@@ -795,10 +1206,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet11 = &ec2.Subnet{
+	subnet11 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-11"),
 		AvailabilityZone: awssdk.String("az11"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("tagtest"),
 				Value: awssdk.String("1"),
@@ -810,10 +1221,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet12 = &ec2.Subnet{
+	subnet12 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-12"),
 		AvailabilityZone: awssdk.String("az12"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("tagtest"),
 				Value: awssdk.String("1"),
@@ -821,10 +1232,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet13 = &ec2.Subnet{
+	subnet13 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-13"),
 		AvailabilityZone: awssdk.String("az13"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("tagtest"),
 				Value: awssdk.String("1"),
@@ -836,10 +1247,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet14 = &ec2.Subnet{
+	subnet14 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-14"),
 		AvailabilityZone: awssdk.String("az14"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("tagtest2"),
 				Value: awssdk.String("1"),
@@ -847,10 +1258,10 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
-	subnet15 = &ec2.Subnet{
+	subnet15 = ec2types.Subnet{
 		SubnetId:         awssdk.String("subnet-15"),
 		AvailabilityZone: awssdk.String("az14"),
-		Tags: []*ec2.Tag{
+		Tags: []ec2types.Tag{
 			{
 				Key:   awssdk.String("tagtest2"),
 				Value: awssdk.String("1"),
@@ -862,10 +1273,58 @@ var (
 		},
 		VpcId: awssdk.String("vpc-1"),
 	}
+	subnet16 = ec2types.Subnet{
+		SubnetId:         awssdk.String("subnet-16"),
+		AvailabilityZone: awssdk.String("az16"),
+		Tags: []ec2types.Tag{
+			{
+				Key:   awssdk.String("kubernetes.io/role/elb"),
+				Value: awssdk.String("1"),
+			},
+		},
+		VpcId:                   awssdk.String("vpc-1"),
+		AvailableIpAddressCount: awssdk.Int32(64),
+	}
+	subnet17 = ec2types.Subnet{
+		SubnetId:         awssdk.String("subnet-17"),
+		AvailabilityZone: awssdk.String("az17"),
+		Tags: []ec2types.Tag{
+			{
+				Key:   awssdk.String("kubernetes.io/role/elb"),
+				Value: awssdk.String("1"),
+			},
+		},
+		VpcId:                   awssdk.String("vpc-1"),
+		AvailableIpAddressCount: awssdk.Int32(64),
+	}
+	subnet18 = ec2types.Subnet{
+		SubnetId:         awssdk.String("subnet-18"),
+		AvailabilityZone: awssdk.String("az18"),
+		Tags: []ec2types.Tag{
+			{
+				Key:   awssdk.String("kubernetes.io/role/elb"),
+				Value: awssdk.String("1"),
+			},
+		},
+		VpcId:                   awssdk.String("vpc-1"),
+		AvailableIpAddressCount: awssdk.Int32(4),
+	}
+	subnet19 = ec2types.Subnet{
+		SubnetId:         awssdk.String("subnet-19"),
+		AvailabilityZone: awssdk.String("az19"),
+		Tags: []ec2types.Tag{
+			{
+				Key:   awssdk.String("kubernetes.io/role/elb"),
+				Value: awssdk.String("1"),
+			},
+		},
+		VpcId:                   awssdk.String("vpc-1"),
+		AvailableIpAddressCount: awssdk.Int32(64),
+	}
 )
 
-func stubDescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]*ec2.Subnet, error) {
-	subnets := []*ec2.Subnet{
+func stubDescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsInput) ([]ec2types.Subnet, error) {
+	subnets := []ec2types.Subnet{
 		subnet1,
 		subnet2,
 		subnet3,
@@ -881,12 +1340,16 @@ func stubDescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsIn
 		subnet13,
 		subnet14,
 		subnet15,
+		subnet16,
+		subnet17,
+		subnet18,
+		subnet19,
 	}
 	if input.SubnetIds != nil {
-		var filtered []*ec2.Subnet
+		var filtered []ec2types.Subnet
 		for _, subnet := range subnets {
 			for _, id := range input.SubnetIds {
-				if awssdk.StringValue(subnet.SubnetId) == awssdk.StringValue(id) {
+				if awssdk.ToString(subnet.SubnetId) == id {
 					filtered = append(filtered, subnet)
 					continue
 				}
@@ -895,30 +1358,30 @@ func stubDescribeSubnetsAsList(ctx context.Context, input *ec2.DescribeSubnetsIn
 		subnets = filtered
 	}
 	if input.Filters != nil {
-		var filtered []*ec2.Subnet
+		var filtered []ec2types.Subnet
 	subnetLoop:
 		for _, subnet := range subnets {
 			for _, filter := range input.Filters {
 				eligible := false
-				if awssdk.StringValue(filter.Name) == "vpc-id" {
+				if awssdk.ToString(filter.Name) == "vpc-id" {
 					for _, name := range filter.Values {
-						if awssdk.StringValue(subnet.VpcId) == awssdk.StringValue(name) {
+						if awssdk.ToString(subnet.VpcId) == name {
 							eligible = true
 							continue
 						}
 					}
-				} else if strings.HasPrefix(awssdk.StringValue(filter.Name), "tag:") {
-					key := strings.TrimPrefix(awssdk.StringValue(filter.Name), "tag:")
+				} else if strings.HasPrefix(awssdk.ToString(filter.Name), "tag:") {
+					key := strings.TrimPrefix(awssdk.ToString(filter.Name), "tag:")
 					for _, value := range filter.Values {
 						for _, tag := range subnet.Tags {
-							if awssdk.StringValue(tag.Key) == key && awssdk.StringValue(tag.Value) == awssdk.StringValue(value) {
+							if awssdk.ToString(tag.Key) == key && awssdk.ToString(tag.Value) == value {
 								eligible = true
 								continue
 							}
 						}
 					}
 				} else {
-					return nil, fmt.Errorf("unexpected filter %q", awssdk.StringValue(filter.Name))
+					return nil, fmt.Errorf("unexpected filter %q", awssdk.ToString(filter.Name))
 				}
 				if !eligible {
 					continue subnetLoop
@@ -1238,6 +1701,37 @@ func Test_defaultModelBuildTask_buildLoadBalancerSubnets(t *testing.T) {
 			},
 			want: []string{"subnet-11", "subnet-15"},
 		},
+		{
+			name: "classparams discovery selector",
+			fields: fields{
+				ingGroup: Group{
+					ID: GroupID{Namespace: "awesome-ns", Name: "ing-1"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "awesome-ns",
+									Name:      "ing-1",
+								},
+							},
+							IngClassConfig: ClassConfiguration{
+								IngClassParams: &v1beta1.IngressClassParams{
+									Spec: v1beta1.IngressClassParamsSpec{
+										Subnets: &v1beta1.SubnetSelector{
+											Selector: &v1beta1.SubnetDiscoverySelector{
+												ExcludeZones:          []string{"az19"},
+												MinFreeIPAddressCount: awssdk.Int64(16),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"subnet-16", "subnet-17"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1257,10 +1751,10 @@ func Test_defaultModelBuildTask_buildLoadBalancerSubnets(t *testing.T) {
 
 			azInfoProvider := networking2.NewMockAZInfoProvider(ctrl)
 			azInfoProvider.EXPECT().FetchAZInfos(gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, availabilityZoneIDs []string) (map[string]ec2.AvailabilityZone, error) {
-					ret := make(map[string]ec2.AvailabilityZone, len(availabilityZoneIDs))
+				DoAndReturn(func(ctx context.Context, availabilityZoneIDs []string) (map[string]ec2types.AvailabilityZone, error) {
+					ret := make(map[string]ec2types.AvailabilityZone, len(availabilityZoneIDs))
 					for _, id := range availabilityZoneIDs {
-						ret[id] = ec2.AvailabilityZone{ZoneType: awssdk.String("availability-zone")}
+						ret[id] = ec2types.AvailabilityZone{ZoneType: awssdk.String("availability-zone")}
 					}
 					return ret, nil
 				}).AnyTimes()
@@ -1270,6 +1764,9 @@ func Test_defaultModelBuildTask_buildLoadBalancerSubnets(t *testing.T) {
 				mockEC2,
 				"vpc-1",
 				"test-cluster",
+				networking2.NewTagSubnetsDiscoveryStrategy(),
+				v1beta1.SubnetDiscoverySelector{},
+				nil,
 				logr.New(&log.NullLogSink{}),
 			)
 
@@ -1280,7 +1777,7 @@ func Test_defaultModelBuildTask_buildLoadBalancerSubnets(t *testing.T) {
 				annotationParser:    annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
 				elbv2TaggingManager: taggingManager,
 				subnetsResolver:     subnetsResolver,
-				trackingProvider:    tracking.NewDefaultProvider("ingress.k8s.aws", "test-cluster"),
+				trackingProvider:    tracking.NewDefaultProvider("ingress.k8s.aws", "test-cluster", nil),
 			}
 			got, err := task.buildLoadBalancerSubnetMappings(context.Background(), elbv2.LoadBalancerSchemeInternetFacing)
 			if err != nil {
@@ -1295,3 +1792,116 @@ func Test_defaultModelBuildTask_buildLoadBalancerSubnets(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildLoadBalancerSecurityGroups_externallyManagedNetworking(t *testing.T) {
+	externallyManaged := true
+	type resolveViaNameOrIDCall struct {
+		sgNameOrIDs []string
+		sgIDs       []string
+		err         error
+	}
+	tests := []struct {
+		name                   string
+		annotations            map[string]string
+		ingClassParams         *v1beta1.IngressClassParams
+		resolveViaNameOrIDCall *resolveViaNameOrIDCall
+		want                   []core.StringToken
+		wantErr                error
+	}{
+		{
+			name: "externally managed networking via annotation",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/externally-managed-networking": "true",
+				"alb.ingress.kubernetes.io/security-groups":               "sg-a, frontend-sg",
+			},
+			resolveViaNameOrIDCall: &resolveViaNameOrIDCall{
+				sgNameOrIDs: []string{"sg-a", "frontend-sg"},
+				sgIDs:       []string{"sg-a", "sg-b"},
+			},
+			want: []core.StringToken{core.LiteralStringToken("sg-a"), core.LiteralStringToken("sg-b")},
+		},
+		{
+			name: "externally managed networking via IngressClassParams",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/externally-managed-networking": "false",
+				"alb.ingress.kubernetes.io/security-groups":               "sg-a",
+			},
+			ingClassParams: &v1beta1.IngressClassParams{
+				Spec: v1beta1.IngressClassParamsSpec{
+					ExternallyManagedNetworking: &externallyManaged,
+				},
+			},
+			resolveViaNameOrIDCall: &resolveViaNameOrIDCall{
+				sgNameOrIDs: []string{"sg-a"},
+				sgIDs:       []string{"sg-a"},
+			},
+			want: []core.StringToken{core.LiteralStringToken("sg-a")},
+		},
+		{
+			name: "externally managed networking without securityGroups",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/externally-managed-networking": "true",
+			},
+			wantErr: errors.New("securityGroups must be specified via security-groups annotation when networking is externally managed"),
+		},
+		{
+			name: "externally managed networking with managed backend securityGroup rules",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/externally-managed-networking":       "true",
+				"alb.ingress.kubernetes.io/security-groups":                     "sg-a",
+				"alb.ingress.kubernetes.io/manage-backend-security-group-rules": "true",
+			},
+			wantErr: errors.New("backend securityGroup rules cannot be managed when networking is externally managed"),
+		},
+		{
+			name: "externally managed networking with missing securityGroup",
+			annotations: map[string]string{
+				"alb.ingress.kubernetes.io/externally-managed-networking": "true",
+				"alb.ingress.kubernetes.io/security-groups":               "sg-a, sg-missing",
+			},
+			resolveViaNameOrIDCall: &resolveViaNameOrIDCall{
+				sgNameOrIDs: []string{"sg-a", "sg-missing"},
+				err:         errors.New("couldn't find all securityGroups, nameOrIDs: [sg-a sg-missing], found: [sg-a]"),
+			},
+			wantErr: errors.New("couldn't find all securityGroups, nameOrIDs: [sg-a sg-missing], found: [sg-a]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			sgResolver := networking2.NewMockSecurityGroupResolver(ctrl)
+			if tt.resolveViaNameOrIDCall != nil {
+				sgResolver.EXPECT().ResolveViaNameOrID(gomock.Any(), tt.resolveViaNameOrIDCall.sgNameOrIDs).
+					Return(tt.resolveViaNameOrIDCall.sgIDs, tt.resolveViaNameOrIDCall.err)
+			}
+			task := &defaultModelBuildTask{
+				ingGroup: Group{
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{
+								ObjectMeta: metav1.ObjectMeta{
+									Annotations: tt.annotations,
+								},
+							},
+							IngClassConfig: ClassConfiguration{IngClassParams: tt.ingClassParams},
+						},
+					},
+				},
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				sgResolver:       sgResolver,
+				enableBackendSG:  true,
+				logger:           logr.New(&log.NullLogSink{}),
+			}
+			got, err := task.buildLoadBalancerSecurityGroups(context.Background(), nil, elbv2.IPAddressTypeIPV4, nil)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+				assert.Nil(t, task.backendSGIDToken)
+				assert.False(t, task.backendSGAllocated)
+			}
+		})
+	}
+}
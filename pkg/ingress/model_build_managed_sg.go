@@ -6,11 +6,13 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"sort"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 )
 
 const (
@@ -33,7 +35,15 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupSpec(ctx context.Contex
 	if err != nil {
 		return ec2model.SecurityGroupSpec{}, err
 	}
-	ingressPermissions := t.buildManagedSecurityGroupIngressPermissions(ctx, listenPortConfigByPort, ipAddressType)
+	ingressPermissions, err := t.buildManagedSecurityGroupIngressPermissions(ctx, listenPortConfigByPort, ipAddressType)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	fsgrPermissions, err := t.buildFrontendSecurityGroupRulePermissions(ctx)
+	if err != nil {
+		return ec2model.SecurityGroupSpec{}, err
+	}
+	ingressPermissions = mergeFrontendSecurityGroupRulePermissions(ingressPermissions, fsgrPermissions)
 	return ec2model.SecurityGroupSpec{
 		GroupName:   name,
 		Description: "[k8s] Managed SecurityGroup for LoadBalancer",
@@ -68,10 +78,25 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupTags(_ context.Context)
 	return algorithm.MergeStringMap(t.defaultTags, ingGroupTags), nil
 }
 
-func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(_ context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) []ec2model.IPPermission {
+func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(ctx context.Context, listenPortConfigByPort map[int64]listenPortConfig, ipAddressType elbv2model.IPAddressType) ([]ec2model.IPPermission, error) {
+	// ports are sorted, so that the permissions are built in the same order across reconciles.
+	ports := make([]int64, 0, len(listenPortConfigByPort))
+	for port := range listenPortConfigByPort {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
 	var permissions []ec2model.IPPermission
-	for port, cfg := range listenPortConfigByPort {
+	for _, port := range ports {
+		cfg := listenPortConfigByPort[port]
 		for _, cidr := range cfg.inboundCIDRv4s {
+			if cidr == "0.0.0.0/0" && t.blocklistPLProvider != nil {
+				permission, err := t.buildBlocklistIngressPermission(ctx, port, networkingpkg.PrefixListAddressFamilyIPv4)
+				if err != nil {
+					return nil, err
+				}
+				permissions = append(permissions, permission)
+				continue
+			}
 			permissions = append(permissions, ec2model.IPPermission{
 				IPProtocol: "tcp",
 				FromPort:   awssdk.Int64(port),
@@ -83,8 +108,16 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(_ co
 				},
 			})
 		}
-		if ipAddressType == elbv2model.IPAddressTypeDualStack {
+		if ipAddressType.IsDualStack() {
 			for _, cidr := range cfg.inboundCIDRv6s {
+				if cidr == "::/0" && t.blocklistPLProvider != nil {
+					permission, err := t.buildBlocklistIngressPermission(ctx, port, networkingpkg.PrefixListAddressFamilyIPv6)
+					if err != nil {
+						return nil, err
+					}
+					permissions = append(permissions, permission)
+					continue
+				}
 				permissions = append(permissions, ec2model.IPPermission{
 					IPProtocol: "tcp",
 					FromPort:   awssdk.Int64(port),
@@ -97,6 +130,48 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(_ co
 				})
 			}
 		}
+		if t.frontendNlbSGIDToken != nil {
+			permissions = append(permissions, ec2model.IPPermission{
+				IPProtocol: "tcp",
+				FromPort:   awssdk.Int64(port),
+				ToPort:     awssdk.Int64(port),
+				UserIDGroupPairs: []ec2model.UserIDGroupPair{
+					{
+						GroupID: t.frontendNlbSGIDToken,
+					},
+				},
+			})
+		}
+		for _, prefixList := range cfg.prefixLists {
+			permissions = append(permissions, ec2model.IPPermission{
+				IPProtocol: "tcp",
+				FromPort:   awssdk.Int64(port),
+				ToPort:     awssdk.Int64(port),
+				PrefixLists: []ec2model.PrefixList{
+					{
+						ListID: prefixList,
+					},
+				},
+			})
+		}
 	}
-	return permissions
+	return permissions, nil
+}
+
+// buildBlocklistIngressPermission builds the permission that allows the internet except the CIDRs blocked by Blocklists.
+func (t *defaultModelBuildTask) buildBlocklistIngressPermission(ctx context.Context, port int64, addressFamily networkingpkg.PrefixListAddressFamily) (ec2model.IPPermission, error) {
+	prefixListID, err := t.blocklistPLProvider.Get(ctx, addressFamily)
+	if err != nil {
+		return ec2model.IPPermission{}, err
+	}
+	return ec2model.IPPermission{
+		IPProtocol: "tcp",
+		FromPort:   awssdk.Int64(port),
+		ToPort:     awssdk.Int64(port),
+		PrefixLists: []ec2model.PrefixList{
+			{
+				ListID: prefixListID,
+			},
+		},
+	}, nil
 }
@@ -2,12 +2,17 @@ package ingress
 
 import (
 	"context"
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 	"testing"
 )
 
@@ -265,3 +270,189 @@ func Test_defaultModelBuildTask_buildManagedSecurityGroupTags(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildManagedSecurityGroupIngressPermissions(t *testing.T) {
+	type args struct {
+		listenPortConfigByPort map[int64]listenPortConfig
+		ipAddressType          elbv2model.IPAddressType
+		enableBlocklist        bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want []ec2model.IPPermission
+	}{
+		{
+			name: "internet cidrs with blocklist",
+			args: args{
+				listenPortConfigByPort: map[int64]listenPortConfig{
+					443: {
+						protocol:       elbv2model.ProtocolHTTPS,
+						inboundCIDRv4s: []string{"0.0.0.0/0", "10.0.0.0/8"},
+						inboundCIDRv6s: []string{"::/0"},
+					},
+				},
+				ipAddressType:   elbv2model.IPAddressTypeDualStack,
+				enableBlocklist: true,
+			},
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					PrefixLists: []ec2model.PrefixList{
+						{
+							ListID: "pl-ipv4",
+						},
+					},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					IPRanges: []ec2model.IPRange{
+						{
+							CIDRIP: "10.0.0.0/8",
+						},
+					},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					PrefixLists: []ec2model.PrefixList{
+						{
+							ListID: "pl-ipv6",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ipv4 cidrs only",
+			args: args{
+				listenPortConfigByPort: map[int64]listenPortConfig{
+					80: {
+						protocol:       elbv2model.ProtocolHTTP,
+						inboundCIDRv4s: []string{"10.0.0.0/8"},
+						inboundCIDRv6s: []string{"2001:db8::/32"},
+					},
+				},
+				ipAddressType: elbv2model.IPAddressTypeIPV4,
+			},
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges: []ec2model.IPRange{
+						{
+							CIDRIP: "10.0.0.0/8",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "dualstack cidrs and prefix lists",
+			args: args{
+				listenPortConfigByPort: map[int64]listenPortConfig{
+					443: {
+						protocol:       elbv2model.ProtocolHTTPS,
+						inboundCIDRv4s: []string{"10.0.0.0/8"},
+						inboundCIDRv6s: []string{"2001:db8::/32"},
+						prefixLists:    []string{"pl-00000000000000000"},
+					},
+				},
+				ipAddressType: elbv2model.IPAddressTypeDualStack,
+			},
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					IPRanges: []ec2model.IPRange{
+						{
+							CIDRIP: "10.0.0.0/8",
+						},
+					},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					IPv6Range: []ec2model.IPv6Range{
+						{
+							CIDRIPv6: "2001:db8::/32",
+						},
+					},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					PrefixLists: []ec2model.PrefixList{
+						{
+							ListID: "pl-00000000000000000",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "prefix lists only on multiple ports",
+			args: args{
+				listenPortConfigByPort: map[int64]listenPortConfig{
+					80: {
+						protocol:    elbv2model.ProtocolHTTP,
+						prefixLists: []string{"pl-00000000000000000"},
+					},
+					443: {
+						protocol:    elbv2model.ProtocolHTTPS,
+						prefixLists: []string{"pl-00000000000000000"},
+					},
+				},
+				ipAddressType: elbv2model.IPAddressTypeIPV4,
+			},
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					PrefixLists: []ec2model.PrefixList{
+						{
+							ListID: "pl-00000000000000000",
+						},
+					},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(443),
+					ToPort:     awssdk.Int64(443),
+					PrefixLists: []ec2model.PrefixList{
+						{
+							ListID: "pl-00000000000000000",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			task := &defaultModelBuildTask{}
+			if tt.args.enableBlocklist {
+				blocklistPLProvider := networkingpkg.NewMockBlocklistPrefixListProvider(ctrl)
+				blocklistPLProvider.EXPECT().Get(gomock.Any(), networkingpkg.PrefixListAddressFamilyIPv4).Return("pl-ipv4", nil).AnyTimes()
+				blocklistPLProvider.EXPECT().Get(gomock.Any(), networkingpkg.PrefixListAddressFamilyIPv6).Return("pl-ipv6", nil).AnyTimes()
+				task.blocklistPLProvider = blocklistPLProvider
+			}
+			got, err := task.buildManagedSecurityGroupIngressPermissions(context.Background(), tt.args.listenPortConfigByPort, tt.args.ipAddressType)
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
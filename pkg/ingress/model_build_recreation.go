@@ -0,0 +1,33 @@
+package ingress
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+// buildRecreations requests the recreation of the resources specified by the members of IngressGroup.
+func (t *defaultModelBuildTask) buildRecreations(_ context.Context) error {
+	recreationTokens := make(map[string]string)
+	tokenProviderByResID := make(map[string]types.NamespacedName)
+	for _, member := range t.ingGroup.Members {
+		var memberRecreationTokens map[string]string
+		if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixRecreateResources, &memberRecreationTokens, member.Ing.Annotations); err != nil {
+			return err
+		}
+		ingKey := k8s.NamespacedName(member.Ing)
+		for resID, token := range memberRecreationTokens {
+			if existingToken, exists := recreationTokens[resID]; exists && existingToken != token {
+				return errors.Errorf("conflicting recreation token for resource %v, %v: %v | %v: %v",
+					resID, tokenProviderByResID[resID], existingToken, ingKey, token)
+			}
+			recreationTokens[resID] = token
+			tokenProviderByResID[resID] = ingKey
+		}
+	}
+	return elbv2model.ApplyRecreationTokens(t.stack, recreationTokens)
+}
@@ -0,0 +1,47 @@
+package ingress
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	route53model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/route53"
+)
+
+// buildRoute53RecordSets builds the Route 53 alias records routing the hosts of IngressGroup members to the ALB.
+func (t *defaultModelBuildTask) buildRoute53RecordSets(ctx context.Context, lb *elbv2model.LoadBalancer) error {
+	if !t.featureGates.Enabled(config.Route53AliasRecords) {
+		return nil
+	}
+	recordTypes := []route53model.RecordType{route53model.RecordTypeA}
+	if lb.Spec.IPAddressType != nil && lb.Spec.IPAddressType.IsDualStack() {
+		recordTypes = append(recordTypes, route53model.RecordTypeAAAA)
+	}
+	for _, host := range t.buildRoute53Hosts(ctx) {
+		route53model.NewRecordSet(t.stack, host, route53model.RecordSetSpec{
+			Name:  host,
+			Types: recordTypes,
+			AliasTarget: route53model.AliasTarget{
+				DNSName:      lb.DNSName(),
+				HostedZoneID: lb.CanonicalHostedZoneID(),
+			},
+		})
+	}
+	return nil
+}
+
+// buildRoute53Hosts returns the distinct hosts of rules across members, in lower case and sorted order.
+func (t *defaultModelBuildTask) buildRoute53Hosts(_ context.Context) []string {
+	hosts := sets.NewString()
+	for _, member := range t.ingGroup.Members {
+		for _, rule := range member.Ing.Spec.Rules {
+			if rule.Host == "" {
+				continue
+			}
+			hosts.Insert(strings.ToLower(rule.Host))
+		}
+	}
+	return hosts.List()
+}
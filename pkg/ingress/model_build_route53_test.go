@@ -0,0 +1,59 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_defaultModelBuildTask_buildRoute53Hosts(t *testing.T) {
+	newIngress := func(name string, hosts ...string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+		for _, host := range hosts {
+			ing.Spec.Rules = append(ing.Spec.Rules, networking.IngressRule{Host: host})
+		}
+		return ing
+	}
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     []string
+	}{
+		{
+			name: "no hosts",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{Ing: newIngress("ing-1", "")},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "hosts across members",
+			ingGroup: Group{
+				Members: []ClassifiedIngress{
+					{Ing: newIngress("ing-1", "b.example.com", "*.Example.com")},
+					{Ing: newIngress("ing-2", "", "B.example.com", "a.example.com")},
+				},
+			},
+			want: []string{"*.example.com", "a.example.com", "b.example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				ingGroup: tt.ingGroup,
+			}
+			got := task.buildRoute53Hosts(context.Background())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -22,19 +24,43 @@ import (
 
 const (
 	healthCheckPortTrafficPort = "traffic-port"
+
+	tgAttrsLoadBalancingAlgorithmType              = "load_balancing.algorithm.type"
+	tgAttrsLoadBalancingAlgorithmAnomalyMitigation = "load_balancing.algorithm.anomaly_mitigation"
+	tgAttrsSlowStartDurationSeconds                = "slow_start.duration_seconds"
+
+	tgAttrsLoadBalancingAlgorithmTypeRoundRobin               = "round_robin"
+	tgAttrsLoadBalancingAlgorithmTypeLeastOutstandingRequests = "least_outstanding_requests"
+	tgAttrsLoadBalancingAlgorithmTypeWeightedRandom           = "weighted_random"
+	tgAttrsAnomalyMitigationOn                                = "on"
+	tgAttrsAnomalyMitigationOff                               = "off"
 )
 
+// buildTargetGroup builds the targetGroup for port of backend Service of Ingress.
+// tgAttributeOverrides are the target group attributes specified per path, which take precedence over the annotations.
 func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context,
-	ing ClassifiedIngress, svc *corev1.Service, port intstr.IntOrString) (*elbv2model.TargetGroup, error) {
-	tgResID := t.buildTargetGroupResourceID(k8s.NamespacedName(ing.Ing), k8s.NamespacedName(svc), port)
+	ing ClassifiedIngress, svc *corev1.Service, port intstr.IntOrString, tgAttributeOverrides map[string]string) (*elbv2model.TargetGroup, error) {
+	tgAttributes, err := t.buildTargetGroupAttributes(ctx, ing, svc, tgAttributeOverrides)
+	if err != nil {
+		return nil, err
+	}
+	tgAttributesHash, err := t.buildTargetGroupAttributesHash(ctx, ing, svc, tgAttributes, tgAttributeOverrides)
+	if err != nil {
+		return nil, err
+	}
+	tgResID := t.buildTargetGroupResourceID(k8s.NamespacedName(ing.Ing), k8s.NamespacedName(svc), port, tgAttributesHash)
 	if tg, exists := t.tgByResID[tgResID]; exists {
+		if !equality.Semantic.DeepEqual(tg.Spec.TargetGroupAttributes, tgAttributes) {
+			return nil, errors.Errorf("conflicting target group attributes for service %v port %v, annotate Ingress with %v to split target groups by attributes",
+				k8s.NamespacedName(svc), port.String(), annotations.IngressSuffixTargetGroupSplitByAttributes)
+		}
 		return tg, nil
 	}
 	svcPort, err := k8s.LookupServicePort(svc, port)
 	if err != nil {
 		return nil, err
 	}
-	tgSpec, err := t.buildTargetGroupSpec(ctx, ing, svc, port, svcPort)
+	tgSpec, err := t.buildTargetGroupSpec(ctx, ing, svc, port, svcPort, tgAttributes, tgAttributesHash)
 	if err != nil {
 		return nil, err
 	}
@@ -42,19 +68,27 @@ func (t *defaultModelBuildTask) buildTargetGroup(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	multiClusterEnabled, err := t.buildTargetGroupBindingMultiClusterFlag(ctx, ing, svc)
+	if err != nil {
+		return nil, err
+	}
+	targetIPFamilyPolicy, err := t.buildTargetGroupBindingTargetIPFamilyPolicy(ctx, ing, svc, tgSpec)
+	if err != nil {
+		return nil, err
+	}
 	tg := elbv2model.NewTargetGroup(t.stack, tgResID, tgSpec)
 	t.tgByResID[tgResID] = tg
-	_ = t.buildTargetGroupBinding(ctx, tg, svc, port, svcPort, nodeSelector)
+	_ = t.buildTargetGroupBinding(ctx, tg, svc, port, svcPort, nodeSelector, multiClusterEnabled, targetIPFamilyPolicy)
 	return tg, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort, nodeSelector *metav1.LabelSelector) *elbv2model.TargetGroupBindingResource {
-	tgbSpec := t.buildTargetGroupBindingSpec(ctx, tg, svc, port, svcPort, nodeSelector)
+func (t *defaultModelBuildTask) buildTargetGroupBinding(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort, nodeSelector *metav1.LabelSelector, multiClusterEnabled bool, targetIPFamilyPolicy *elbv2api.TargetIPFamilyPolicy) *elbv2model.TargetGroupBindingResource {
+	tgbSpec := t.buildTargetGroupBindingSpec(ctx, tg, svc, port, svcPort, nodeSelector, multiClusterEnabled, targetIPFamilyPolicy)
 	tgb := elbv2model.NewTargetGroupBindingResource(t.stack, tg.ID(), tgbSpec)
 	return tgb
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort, nodeSelector *metav1.LabelSelector) elbv2model.TargetGroupBindingResourceSpec {
+func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context, tg *elbv2model.TargetGroup, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort, nodeSelector *metav1.LabelSelector, multiClusterEnabled bool, targetIPFamilyPolicy *elbv2api.TargetIPFamilyPolicy) elbv2model.TargetGroupBindingResourceSpec {
 	targetType := elbv2api.TargetType(tg.Spec.TargetType)
 	targetPort := svcPort.TargetPort
 	if targetType == elbv2api.TargetTypeInstance {
@@ -74,9 +108,12 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 					Name: svc.Name,
 					Port: port,
 				},
-				Networking:    tgbNetworking,
-				NodeSelector:  nodeSelector,
-				IPAddressType: (*elbv2api.TargetGroupIPAddressType)(tg.Spec.IPAddressType),
+				Networking:              tgbNetworking,
+				NodeSelector:            nodeSelector,
+				IPAddressType:           (*elbv2api.TargetGroupIPAddressType)(tg.Spec.IPAddressType),
+				TargetIPFamilyPolicy:    targetIPFamilyPolicy,
+				MultiClusterTargetGroup: multiClusterEnabled,
+				AWSRoleARN:              t.awsRoleARN,
 			},
 		},
 	}
@@ -138,7 +175,8 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(ctx context.Co
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
-	ing ClassifiedIngress, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort) (elbv2model.TargetGroupSpec, error) {
+	ing ClassifiedIngress, svc *corev1.Service, port intstr.IntOrString, svcPort corev1.ServicePort,
+	tgAttributes []elbv2model.TargetGroupAttribute, tgAttributesHash string) (elbv2model.TargetGroupSpec, error) {
 	svcAndIngAnnotations := algorithm.MergeStringMap(svc.Annotations, ing.Ing.Annotations)
 	targetType, err := t.buildTargetGroupTargetType(ctx, svcAndIngAnnotations)
 	if err != nil {
@@ -156,20 +194,16 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	tgAttributes, err := t.buildTargetGroupAttributes(ctx, svcAndIngAnnotations)
-	if err != nil {
-		return elbv2model.TargetGroupSpec{}, err
-	}
 	tags, err := t.buildTargetGroupTags(ctx, ing, svc)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
-	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, svc)
+	ipAddressType, err := t.buildTargetGroupIPAddressType(ctx, svc, svcAndIngAnnotations)
 	if err != nil {
 		return elbv2model.TargetGroupSpec{}, err
 	}
 	tgPort := t.buildTargetGroupPort(ctx, targetType, svcPort)
-	name := t.buildTargetGroupName(ctx, k8s.NamespacedName(ing.Ing), svc, port, tgPort, targetType, tgProtocol, tgProtocolVersion)
+	name := t.buildTargetGroupName(ctx, k8s.NamespacedName(ing.Ing), svc, port, tgPort, targetType, tgProtocol, tgProtocolVersion, tgAttributesHash)
 	return elbv2model.TargetGroupSpec{
 		Name:                  name,
 		TargetType:            targetType,
@@ -186,9 +220,10 @@ func (t *defaultModelBuildTask) buildTargetGroupSpec(ctx context.Context,
 var invalidTargetGroupNamePattern = regexp.MustCompile("[[:^alnum:]]")
 
 // buildTargetGroupName will calculate the targetGroup's name.
+// tgAttributesHash is only set for target groups split by attributes, so that the names of other target groups stay unchanged.
 func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context,
 	ingKey types.NamespacedName, svc *corev1.Service, port intstr.IntOrString, tgPort int64,
-	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion) string {
+	targetType elbv2model.TargetType, tgProtocol elbv2model.Protocol, tgProtocolVersion elbv2model.ProtocolVersion, tgAttributesHash string) string {
 	uuidHash := sha256.New()
 	_, _ = uuidHash.Write([]byte(t.clusterName))
 	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
@@ -200,6 +235,9 @@ func (t *defaultModelBuildTask) buildTargetGroupName(_ context.Context,
 	_, _ = uuidHash.Write([]byte(targetType))
 	_, _ = uuidHash.Write([]byte(tgProtocol))
 	_, _ = uuidHash.Write([]byte(tgProtocolVersion))
+	if tgAttributesHash != "" {
+		_, _ = uuidHash.Write([]byte(tgAttributesHash))
+	}
 	uuid := hex.EncodeToString(uuidHash.Sum(nil))
 
 	sanitizedNamespace := invalidTargetGroupNamePattern.ReplaceAllString(svc.Namespace, "")
@@ -223,16 +261,31 @@ func (t *defaultModelBuildTask) buildTargetGroupTargetType(_ context.Context, sv
 	}
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context, svc *corev1.Service) (elbv2model.TargetGroupIPAddressType, error) {
+func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(ctx context.Context, svc *corev1.Service, svcAndIngAnnotations map[string]string) (elbv2model.TargetGroupIPAddressType, error) {
+	ipFamilyPolicy, err := t.buildTargetIPFamilyPolicy(ctx, svcAndIngAnnotations)
+	if err != nil {
+		return "", err
+	}
+	ipv4Configured := len(svc.Spec.IPFamilies) == 0
 	var ipv6Configured bool
 	for _, ipFamily := range svc.Spec.IPFamilies {
-		if ipFamily == corev1.IPv6Protocol {
+		switch ipFamily {
+		case corev1.IPv4Protocol:
+			ipv4Configured = true
+		case corev1.IPv6Protocol:
 			ipv6Configured = true
-			break
 		}
 	}
-	if ipv6Configured {
-		if *t.loadBalancer.Spec.IPAddressType != elbv2model.IPAddressTypeDualStack {
+	ipv6 := ipv6Configured
+	switch ipFamilyPolicy {
+	case elbv2api.TargetIPFamilyPolicyPreferIPv6:
+		// the pod IPs of the preferred family are registered regardless of the IP families of Service.
+		ipv6 = !ipv4Configured || t.loadBalancer.Spec.IPAddressType.IsDualStack()
+	case elbv2api.TargetIPFamilyPolicyPreferIPv4:
+		ipv6 = !ipv4Configured
+	}
+	if ipv6 {
+		if !t.loadBalancer.Spec.IPAddressType.IsDualStack() {
 			return "", errors.New("unsupported IPv6 configuration, lb not dual-stack")
 		}
 		return elbv2model.TargetGroupIPAddressTypeIPv6, nil
@@ -240,6 +293,38 @@ func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context,
 	return elbv2model.TargetGroupIPAddressTypeIPv4, nil
 }
 
+// buildTargetIPFamilyPolicy builds the preferred IP family of targets for dual-stack pods, it's empty if unspecified.
+func (t *defaultModelBuildTask) buildTargetIPFamilyPolicy(_ context.Context, svcAndIngAnnotations map[string]string) (elbv2api.TargetIPFamilyPolicy, error) {
+	rawIPFamilyPolicy := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.IngressSuffixTargetIPFamilyPolicy, &rawIPFamilyPolicy, svcAndIngAnnotations); !exists {
+		return "", nil
+	}
+	switch ipFamilyPolicy := elbv2api.TargetIPFamilyPolicy(rawIPFamilyPolicy); ipFamilyPolicy {
+	case elbv2api.TargetIPFamilyPolicyPreferIPv4, elbv2api.TargetIPFamilyPolicyPreferIPv6:
+		return ipFamilyPolicy, nil
+	default:
+		return "", errors.Errorf("unknown target IP family policy: %v", rawIPFamilyPolicy)
+	}
+}
+
+// buildTargetGroupBindingTargetIPFamilyPolicy builds the targetIPFamilyPolicy of ip type TargetGroups, which registers the pod IPs of their IP address type.
+// it's only specified along with the target IP family policy annotation, so that the endpoint addresses are registered by default.
+func (t *defaultModelBuildTask) buildTargetGroupBindingTargetIPFamilyPolicy(ctx context.Context, ing ClassifiedIngress, svc *corev1.Service, tgSpec elbv2model.TargetGroupSpec) (*elbv2api.TargetIPFamilyPolicy, error) {
+	if tgSpec.TargetType != elbv2model.TargetTypeIP {
+		return nil, nil
+	}
+	svcAndIngAnnotations := algorithm.MergeStringMap(svc.Annotations, ing.Ing.Annotations)
+	ipFamilyPolicy, err := t.buildTargetIPFamilyPolicy(ctx, svcAndIngAnnotations)
+	if err != nil || ipFamilyPolicy == "" {
+		return nil, err
+	}
+	tgbIPFamilyPolicy := elbv2api.TargetIPFamilyPolicyPreferIPv4
+	if *tgSpec.IPAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+		tgbIPFamilyPolicy = elbv2api.TargetIPFamilyPolicyPreferIPv6
+	}
+	return &tgbIPFamilyPolicy, nil
+}
+
 // buildTargetGroupPort constructs the TargetGroup's port.
 // Note: TargetGroup's port is not in the data path as we always register targets with port specified.
 // so this settings don't really matter to our controller, and we do our best to use the most appropriate port as targetGroup's port to avoid UX confusing.
@@ -430,9 +515,21 @@ func (t *defaultModelBuildTask) buildTargetGroupHealthCheckUnhealthyThresholdCou
 	return rawHealthCheckUnhealthyThresholdCount, nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, svcAndIngAnnotations map[string]string) ([]elbv2model.TargetGroupAttribute, error) {
-	var rawAttributes map[string]string
-	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &rawAttributes, svcAndIngAnnotations); err != nil {
+// buildTargetGroupAttributes builds the target group attributes for backend Service of Ingress.
+// attributes specified on the Service take precedence over the ones specified on the Ingress on a per-key basis,
+// so that backend specific settings like deregistration delay or slow start can be tuned per Service.
+// tgAttributeOverrides specified per path take precedence over both of them.
+func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, ing ClassifiedIngress, svc *corev1.Service, tgAttributeOverrides map[string]string) ([]elbv2model.TargetGroupAttribute, error) {
+	var ingAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &ingAttributes, ing.Ing.Annotations); err != nil {
+		return nil, err
+	}
+	var svcAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTargetGroupAttributes, &svcAttributes, svc.Annotations); err != nil {
+		return nil, err
+	}
+	rawAttributes := algorithm.MergeStringMap(tgAttributeOverrides, svcAttributes, ingAttributes)
+	if err := validateTargetGroupAttributes(rawAttributes); err != nil {
 		return nil, err
 	}
 	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
@@ -442,9 +539,55 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context, sv
 			Value: attrValue,
 		})
 	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
 	return attributes, nil
 }
 
+// validateTargetGroupAttributes validates the load balancing algorithm and target health related target group attributes.
+// anomaly mitigation(Automatic Target Weights) is only available with the weighted_random algorithm, which in turn
+// is not compatible with slow start mode.
+func validateTargetGroupAttributes(rawAttributes map[string]string) error {
+	if err := elbv2model.ValidateTargetGroupHealthAttributes(rawAttributes); err != nil {
+		return err
+	}
+	algorithmType, algorithmTypeExists := rawAttributes[tgAttrsLoadBalancingAlgorithmType]
+	if algorithmTypeExists {
+		switch algorithmType {
+		case tgAttrsLoadBalancingAlgorithmTypeRoundRobin, tgAttrsLoadBalancingAlgorithmTypeLeastOutstandingRequests, tgAttrsLoadBalancingAlgorithmTypeWeightedRandom:
+		default:
+			return errors.Errorf("invalid target group attribute %v: %v", tgAttrsLoadBalancingAlgorithmType, algorithmType)
+		}
+	}
+	if anomalyMitigation, ok := rawAttributes[tgAttrsLoadBalancingAlgorithmAnomalyMitigation]; ok {
+		switch anomalyMitigation {
+		case tgAttrsAnomalyMitigationOn:
+			if algorithmType != tgAttrsLoadBalancingAlgorithmTypeWeightedRandom {
+				return errors.Errorf("target group attribute %v=%v requires %v=%v",
+					tgAttrsLoadBalancingAlgorithmAnomalyMitigation, anomalyMitigation,
+					tgAttrsLoadBalancingAlgorithmType, tgAttrsLoadBalancingAlgorithmTypeWeightedRandom)
+			}
+		case tgAttrsAnomalyMitigationOff:
+		default:
+			return errors.Errorf("invalid target group attribute %v: %v", tgAttrsLoadBalancingAlgorithmAnomalyMitigation, anomalyMitigation)
+		}
+	}
+	if algorithmType == tgAttrsLoadBalancingAlgorithmTypeWeightedRandom {
+		if rawSlowStart, ok := rawAttributes[tgAttrsSlowStartDurationSeconds]; ok {
+			slowStart, err := strconv.ParseInt(rawSlowStart, 10, 64)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse target group attribute %v", tgAttrsSlowStartDurationSeconds)
+			}
+			if slowStart != 0 {
+				return errors.Errorf("target group attribute %v=%v is not compatible with %v",
+					tgAttrsLoadBalancingAlgorithmType, tgAttrsLoadBalancingAlgorithmTypeWeightedRandom, tgAttrsSlowStartDurationSeconds)
+			}
+		}
+	}
+	return nil
+}
+
 func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, ing ClassifiedIngress, svc *corev1.Service) (map[string]string, error) {
 	ingSvcTags, err := t.buildIngressBackendResourceTags(ing, svc)
 	if err != nil {
@@ -453,8 +596,44 @@ func (t *defaultModelBuildTask) buildTargetGroupTags(_ context.Context, ing Clas
 	return algorithm.MergeStringMap(t.defaultTags, ingSvcTags), nil
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupResourceID(ingKey types.NamespacedName, svcKey types.NamespacedName, port intstr.IntOrString) string {
-	return fmt.Sprintf("%s/%s-%s:%s", ingKey.Namespace, ingKey.Name, svcKey.Name, port.String())
+// buildTargetGroupAttributesHash returns the hash of tgAttributes if the target group must be split from the one of the
+// same Service port, i.e. the Ingress splits target groups by attributes and tgAttributeOverrides change the attributes.
+// it's empty otherwise.
+func (t *defaultModelBuildTask) buildTargetGroupAttributesHash(ctx context.Context, ing ClassifiedIngress, svc *corev1.Service,
+	tgAttributes []elbv2model.TargetGroupAttribute, tgAttributeOverrides map[string]string) (string, error) {
+	if len(tgAttributeOverrides) == 0 {
+		return "", nil
+	}
+	splitByAttributes := false
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixTargetGroupSplitByAttributes, &splitByAttributes, ing.Ing.Annotations); err != nil {
+		return "", err
+	}
+	if !splitByAttributes {
+		return "", nil
+	}
+	defaultTGAttributes, err := t.buildTargetGroupAttributes(ctx, ing, svc, nil)
+	if err != nil {
+		return "", err
+	}
+	if equality.Semantic.DeepEqual(defaultTGAttributes, tgAttributes) {
+		return "", nil
+	}
+	attributesHash := sha256.New()
+	for _, attr := range tgAttributes {
+		_, _ = attributesHash.Write([]byte(fmt.Sprintf("%s=%s;", attr.Key, attr.Value)))
+	}
+	return hex.EncodeToString(attributesHash.Sum(nil)), nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupResourceID(ingKey types.NamespacedName, svcKey types.NamespacedName, port intstr.IntOrString, tgAttributesHash string) string {
+	resID := fmt.Sprintf("%s/%s-%s:%s", ingKey.Namespace, ingKey.Name, svcKey.Name, port.String())
+	if svcKey.Namespace != ingKey.Namespace {
+		resID = fmt.Sprintf("%s/%s-%s/%s:%s", ingKey.Namespace, ingKey.Name, svcKey.Namespace, svcKey.Name, port.String())
+	}
+	if tgAttributesHash != "" {
+		return fmt.Sprintf("%s-%.8s", resID, tgAttributesHash)
+	}
+	return resID
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupBindingNodeSelector(_ context.Context, ing ClassifiedIngress, svc *corev1.Service, targetType elbv2model.TargetType) (*metav1.LabelSelector, error) {
@@ -474,3 +653,16 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNodeSelector(_ context.Co
 		MatchLabels: targetNodeLabels,
 	}, nil
 }
+
+func (t *defaultModelBuildTask) buildTargetGroupBindingMultiClusterFlag(_ context.Context, ing ClassifiedIngress, svc *corev1.Service) (bool, error) {
+	var rawEnabled bool
+	svcAndIngAnnotations := algorithm.MergeStringMap(svc.Annotations, ing.Ing.Annotations)
+	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixMultiClusterTargetGroup, &rawEnabled, svcAndIngAnnotations)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return rawEnabled, nil
+	}
+	return false, nil
+}
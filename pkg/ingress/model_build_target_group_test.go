@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"testing"
 )
@@ -25,6 +26,7 @@ func Test_defaultModelBuildTask_buildTargetGroupName(t *testing.T) {
 		targetType        elbv2model.TargetType
 		tgProtocol        elbv2model.Protocol
 		tgProtocolVersion elbv2model.ProtocolVersion
+		tgAttributesHash  string
 	}
 	tests := []struct {
 		name string
@@ -126,11 +128,31 @@ func Test_defaultModelBuildTask_buildTargetGroupName(t *testing.T) {
 			},
 			want: "k8s-ns1-name1-22fbce26a7",
 		},
+		{
+			name: "standard case - split by attributes",
+			args: args{
+				ingKey: types.NamespacedName{Namespace: "ns-1", Name: "name-1"},
+				svc: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "name-1",
+						UID:       "my-uuid",
+					},
+				},
+				port:              intstr.FromString("http"),
+				tgPort:            8080,
+				targetType:        elbv2model.TargetTypeIP,
+				tgProtocol:        elbv2model.ProtocolHTTP,
+				tgProtocolVersion: elbv2model.ProtocolVersionHTTP1,
+				tgAttributesHash:  "2f5e3a1b",
+			},
+			want: "k8s-ns1-name1-bc3d469b96",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			task := &defaultModelBuildTask{}
-			got := task.buildTargetGroupName(context.Background(), tt.args.ingKey, tt.args.svc, tt.args.port, tt.args.tgPort, tt.args.targetType, tt.args.tgProtocol, tt.args.tgProtocolVersion)
+			got := task.buildTargetGroupName(context.Background(), tt.args.ingKey, tt.args.svc, tt.args.port, tt.args.tgPort, tt.args.targetType, tt.args.tgProtocol, tt.args.tgProtocolVersion, tt.args.tgAttributesHash)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -481,6 +503,199 @@ func Test_defaultModelBuildTask_buildTargetGroupHealthCheckPath(t *testing.T) {
 	}
 }
 
+func Test_defaultModelBuildTask_buildTargetGroupAttributes(t *testing.T) {
+	tests := []struct {
+		name                 string
+		ingAnnotations       map[string]string
+		svcAnnotations       map[string]string
+		tgAttributeOverrides map[string]string
+		want                 []elbv2model.TargetGroupAttribute
+		wantErr              error
+	}{
+		{
+			name: "without annotation configured",
+			want: []elbv2model.TargetGroupAttribute{},
+		},
+		{
+			name: "attributes of path take precedence over annotations",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "stickiness.enabled=false,deregistration_delay.timeout_seconds=30",
+			},
+			svcAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.timeout_seconds=60",
+			},
+			tgAttributeOverrides: map[string]string{
+				"stickiness.enabled": "true",
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "60",
+				},
+				{
+					Key:   "stickiness.enabled",
+					Value: "true",
+				},
+			},
+		},
+		{
+			name: "weighted random with anomaly mitigation",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "load_balancing.algorithm.type=weighted_random,load_balancing.algorithm.anomaly_mitigation=on,deregistration_delay.timeout_seconds=30",
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "30",
+				},
+				{
+					Key:   "load_balancing.algorithm.anomaly_mitigation",
+					Value: "on",
+				},
+				{
+					Key:   "load_balancing.algorithm.type",
+					Value: "weighted_random",
+				},
+			},
+		},
+		{
+			name: "weighted random with slow start disabled",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "load_balancing.algorithm.type=weighted_random,slow_start.duration_seconds=0",
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "load_balancing.algorithm.type",
+					Value: "weighted_random",
+				},
+				{
+					Key:   "slow_start.duration_seconds",
+					Value: "0",
+				},
+			},
+		},
+		{
+			name: "anomaly mitigation off with round robin",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "load_balancing.algorithm.type=round_robin,load_balancing.algorithm.anomaly_mitigation=off",
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "load_balancing.algorithm.anomaly_mitigation",
+					Value: "off",
+				},
+				{
+					Key:   "load_balancing.algorithm.type",
+					Value: "round_robin",
+				},
+			},
+		},
+		{
+			name: "service annotation only",
+			svcAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.timeout_seconds=300",
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "300",
+				},
+			},
+		},
+		{
+			name: "service annotation overrides ingress annotation per attribute",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.timeout_seconds=30,stickiness.enabled=true",
+			},
+			svcAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "deregistration_delay.timeout_seconds=300,slow_start.duration_seconds=60",
+			},
+			want: []elbv2model.TargetGroupAttribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "300",
+				},
+				{
+					Key:   "slow_start.duration_seconds",
+					Value: "60",
+				},
+				{
+					Key:   "stickiness.enabled",
+					Value: "true",
+				},
+			},
+		},
+		{
+			name: "merged attributes are validated",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "load_balancing.algorithm.type=weighted_random",
+			},
+			svcAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "slow_start.duration_seconds=60",
+			},
+			wantErr: errors.New("target group attribute load_balancing.algorithm.type=weighted_random is not compatible with slow_start.duration_seconds"),
+		},
+		{
+			name: "invalid load balancing algorithm",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "load_balancing.algorithm.type=random",
+			},
+			wantErr: errors.New("invalid target group attribute load_balancing.algorithm.type: random"),
+		},
+		{
+			name: "invalid anomaly mitigation",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "load_balancing.algorithm.type=weighted_random,load_balancing.algorithm.anomaly_mitigation=true",
+			},
+			wantErr: errors.New("invalid target group attribute load_balancing.algorithm.anomaly_mitigation: true"),
+		},
+		{
+			name: "anomaly mitigation without weighted random",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "load_balancing.algorithm.anomaly_mitigation=on",
+			},
+			wantErr: errors.New("target group attribute load_balancing.algorithm.anomaly_mitigation=on requires load_balancing.algorithm.type=weighted_random"),
+		},
+		{
+			name: "weighted random with slow start",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-attributes": "load_balancing.algorithm.type=weighted_random,slow_start.duration_seconds=30",
+			},
+			wantErr: errors.New("target group attribute load_balancing.algorithm.type=weighted_random is not compatible with slow_start.duration_seconds"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			ing := ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "ing",
+						Annotations: tt.ingAnnotations,
+					},
+				},
+			}
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "awesome-ns",
+					Name:        "svc",
+					Annotations: tt.svcAnnotations,
+				},
+			}
+			got, err := task.buildTargetGroupAttributes(context.Background(), ing, svc, tt.tgAttributeOverrides)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 func Test_defaultModelBuildTask_buildTargetGroupHealthCheckMatcher(t *testing.T) {
 	type fields struct {
 		defaultHealthCheckMatcherHTTPCode string
@@ -732,3 +947,122 @@ func Test_defaultModelBuildTask_buildTargetGroupBindingNodeSelector(t *testing.T
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildTargetGroup_splitByAttributes(t *testing.T) {
+	type tgRef struct {
+		port                 intstr.IntOrString
+		tgAttributeOverrides map[string]string
+	}
+	tests := []struct {
+		name           string
+		ingAnnotations map[string]string
+		tgRefs         []tgRef
+		wantTGResIDs   []string
+		wantErr        error
+	}{
+		{
+			name: "paths without attributes share target group",
+			tgRefs: []tgRef{
+				{port: intstr.FromInt(80)},
+				{port: intstr.FromInt(80)},
+			},
+			wantTGResIDs: []string{"awesome-ns/ing-svc:80"},
+		},
+		{
+			name: "paths with conflicting attributes",
+			tgRefs: []tgRef{
+				{port: intstr.FromInt(80), tgAttributeOverrides: map[string]string{"stickiness.enabled": "true"}},
+				{port: intstr.FromInt(80)},
+			},
+			wantErr: errors.New("conflicting target group attributes for service awesome-ns/svc port 80, annotate Ingress with target-group-split-by-attributes to split target groups by attributes"),
+		},
+		{
+			name: "paths with conflicting attributes split by attributes",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-split-by-attributes": "true",
+			},
+			tgRefs: []tgRef{
+				{port: intstr.FromInt(80), tgAttributeOverrides: map[string]string{"stickiness.enabled": "true"}},
+				{port: intstr.FromInt(80), tgAttributeOverrides: map[string]string{"stickiness.enabled": "true"}},
+				{port: intstr.FromInt(80)},
+			},
+			wantTGResIDs: []string{"awesome-ns/ing-svc:80", "awesome-ns/ing-svc:80-b3f73b00"},
+		},
+		{
+			name: "paths with attributes matching annotations aren't split",
+			ingAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/target-group-split-by-attributes": "true",
+				"alb.ingress.kubernetes.io/target-group-attributes":          "stickiness.enabled=true",
+			},
+			tgRefs: []tgRef{
+				{port: intstr.FromInt(80), tgAttributeOverrides: map[string]string{"stickiness.enabled": "true"}},
+				{port: intstr.FromInt(80)},
+			},
+			wantTGResIDs: []string{"awesome-ns/ing-svc:80"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+			task := &defaultModelBuildTask{
+				stack:                                     stack,
+				tgByResID:                                 make(map[string]*elbv2model.TargetGroup),
+				clusterName:                               "cluster-name",
+				annotationParser:                          annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				defaultTargetType:                         elbv2model.TargetTypeInstance,
+				defaultBackendProtocol:                    elbv2model.ProtocolHTTP,
+				defaultBackendProtocolVersion:             elbv2model.ProtocolVersionHTTP1,
+				defaultHealthCheckPathHTTP:                "/",
+				defaultHealthCheckMatcherHTTPCode:         "200",
+				defaultHealthCheckIntervalSeconds:         15,
+				defaultHealthCheckTimeoutSeconds:          5,
+				defaultHealthCheckHealthyThresholdCount:   2,
+				defaultHealthCheckUnhealthyThresholdCount: 2,
+			}
+			ing := ClassifiedIngress{
+				Ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "ing",
+						Annotations: tt.ingAnnotations,
+					},
+				},
+			}
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "svc",
+					UID:       "svc-uuid",
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{
+							Port:       80,
+							TargetPort: intstr.FromInt(8080),
+							NodePort:   32768,
+						},
+					},
+				},
+			}
+			var err error
+			for _, ref := range tt.tgRefs {
+				if _, err = task.buildTargetGroup(context.Background(), ing, svc, ref.port, ref.tgAttributeOverrides); err != nil {
+					break
+				}
+			}
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			var gotTGResIDs []string
+			tgNames := sets.NewString()
+			for resID, tg := range task.tgByResID {
+				gotTGResIDs = append(gotTGResIDs, resID)
+				tgNames.Insert(tg.Spec.Name)
+			}
+			assert.ElementsMatch(t, tt.wantTGResIDs, gotTGResIDs)
+			assert.Equal(t, len(tt.wantTGResIDs), tgNames.Len())
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	trustStoreBundleKeyS3Bucket        = "s3Bucket"
+	trustStoreBundleKeyS3Key           = "s3Key"
+	trustStoreBundleKeyS3ObjectVersion = "s3ObjectVersion"
+)
+
+// buildManagedTrustStore builds the trust store managed from the CA certificates bundle of this IngressGroup.
+// It's shared by all HTTPS listeners that verify client certificates without an explicit trustStore.
+func (t *defaultModelBuildTask) buildManagedTrustStore(ctx context.Context) (*elbv2model.TrustStore, error) {
+	if t.managedTrustStore != nil {
+		return t.managedTrustStore, nil
+	}
+	trustStoreSpec, err := t.buildManagedTrustStoreSpec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t.managedTrustStore = elbv2model.NewTrustStore(t.stack, "TrustStore", trustStoreSpec)
+	return t.managedTrustStore, nil
+}
+
+func (t *defaultModelBuildTask) buildManagedTrustStoreSpec(ctx context.Context) (elbv2model.TrustStoreSpec, error) {
+	bundle, err := t.buildTrustStoreBundle(ctx)
+	if err != nil {
+		return elbv2model.TrustStoreSpec{}, err
+	}
+	if bundle == nil {
+		return elbv2model.TrustStoreSpec{}, errors.Errorf("trustStore must be specified for %v mode when no %v is configured",
+			elbv2api.MutualAuthenticationModeVerify, annotations.IngressSuffixTrustStoreBundle)
+	}
+	tags, err := t.buildListenerTags(ctx, t.ingGroup.Members)
+	if err != nil {
+		return elbv2model.TrustStoreSpec{}, err
+	}
+	spec := elbv2model.TrustStoreSpec{
+		Name:                         t.buildManagedTrustStoreName(ctx, *bundle),
+		CACertificatesBundleS3Bucket: bundle.S3Bucket,
+		CACertificatesBundleS3Key:    bundle.S3Key,
+		Tags:                         tags,
+	}
+	if bundle.S3ObjectVersion != "" {
+		spec.CACertificatesBundleS3ObjectVersion = awssdk.String(bundle.S3ObjectVersion)
+	}
+	return spec, nil
+}
+
+// buildManagedTrustStoreName generates the trust store name from the CA certificates bundle location,
+// so that a changed bundle results in a new trust store that replaces the existing one.
+func (t *defaultModelBuildTask) buildManagedTrustStoreName(_ context.Context, bundle elbv2api.TrustStoreBundle) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	_, _ = uuidHash.Write([]byte(bundle.S3Bucket))
+	_, _ = uuidHash.Write([]byte(bundle.S3Key))
+	_, _ = uuidHash.Write([]byte(bundle.S3ObjectVersion))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	if t.ingGroup.ID.IsExplicit() {
+		payload := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
+
+func (t *defaultModelBuildTask) buildTrustStoreBundle(_ context.Context) (*elbv2api.TrustStoreBundle, error) {
+	var explicitBundles []elbv2api.TrustStoreBundle
+	for _, member := range t.ingGroup.Members {
+		var bundle elbv2api.TrustStoreBundle
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.TrustStoreBundle != nil {
+			bundle = *member.IngClassConfig.IngClassParams.Spec.TrustStoreBundle
+		} else {
+			var rawBundle map[string]string
+			exists, err := t.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTrustStoreBundle, &rawBundle, member.Ing.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				continue
+			}
+			for key := range rawBundle {
+				switch key {
+				case trustStoreBundleKeyS3Bucket, trustStoreBundleKeyS3Key, trustStoreBundleKeyS3ObjectVersion:
+				default:
+					return nil, errors.Errorf("invalid %v settings on Ingress: %v: unknown key %v",
+						annotations.IngressSuffixTrustStoreBundle, k8s.NamespacedName(member.Ing), key)
+				}
+			}
+			bundle = elbv2api.TrustStoreBundle{
+				S3Bucket:        rawBundle[trustStoreBundleKeyS3Bucket],
+				S3Key:           rawBundle[trustStoreBundleKeyS3Key],
+				S3ObjectVersion: rawBundle[trustStoreBundleKeyS3ObjectVersion],
+			}
+		}
+		if bundle.S3Bucket == "" || bundle.S3Key == "" {
+			return nil, errors.Errorf("invalid %v settings on Ingress: %v: %v and %v are required",
+				annotations.IngressSuffixTrustStoreBundle, k8s.NamespacedName(member.Ing), trustStoreBundleKeyS3Bucket, trustStoreBundleKeyS3Key)
+		}
+		if len(explicitBundles) != 0 && explicitBundles[0] != bundle {
+			return nil, errors.Errorf("conflicting trust store bundles: %v | %v", explicitBundles[0], bundle)
+		}
+		explicitBundles = append(explicitBundles, bundle)
+	}
+	if len(explicitBundles) == 0 {
+		return nil, nil
+	}
+	return &explicitBundles[0], nil
+}
@@ -0,0 +1,227 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuildTask_buildManagedTrustStoreSpec(t *testing.T) {
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     elbv2model.TrustStoreSpec
+		wantErr  error
+	}{
+		{
+			name: "bundle configured via annotation",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/mutual-authentication-trust-store-bundle": "s3Bucket=my-bucket,s3Key=ca.pem,s3ObjectVersion=v1",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: elbv2model.TrustStoreSpec{
+				Name:                                "k8s-awesomegroup-f77ecb1ebb",
+				CACertificatesBundleS3Bucket:        "my-bucket",
+				CACertificatesBundleS3Key:           "ca.pem",
+				CACertificatesBundleS3ObjectVersion: awssdk.String("v1"),
+				Tags:                                map[string]string{},
+			},
+		},
+		{
+			name: "bundle configured via IngressClassParams takes precedence",
+			ingGroup: Group{
+				ID: GroupID{Namespace: "awesome-ns", Name: "ing-1"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/mutual-authentication-trust-store-bundle": "s3Bucket=other-bucket,s3Key=ca.pem",
+								},
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &elbv2api.IngressClassParams{
+								Spec: elbv2api.IngressClassParamsSpec{
+									TrustStoreBundle: &elbv2api.TrustStoreBundle{
+										S3Bucket: "my-bucket",
+										S3Key:    "ca.pem",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: elbv2model.TrustStoreSpec{
+				Name:                         "k8s-awesomen-ing1-7a1784ff18",
+				CACertificatesBundleS3Bucket: "my-bucket",
+				CACertificatesBundleS3Key:    "ca.pem",
+				Tags:                         map[string]string{},
+			},
+		},
+		{
+			name: "bundle not configured",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("trustStore must be specified for verify mode when no mutual-authentication-trust-store-bundle is configured"),
+		},
+		{
+			name: "bundle missing s3Key",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/mutual-authentication-trust-store-bundle": "s3Bucket=my-bucket",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("invalid mutual-authentication-trust-store-bundle settings on Ingress: awesome-ns/ing-1: s3Bucket and s3Key are required"),
+		},
+		{
+			name: "conflicting bundles among IngressGroup",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/mutual-authentication-trust-store-bundle": "s3Bucket=my-bucket,s3Key=ca.pem",
+								},
+							},
+						},
+					},
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-2",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/mutual-authentication-trust-store-bundle": "s3Bucket=my-bucket,s3Key=other-ca.pem",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting trust store bundles: {my-bucket ca.pem } | {my-bucket other-ca.pem }"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				clusterName:      "cluster-name",
+				ingGroup:         tt.ingGroup,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			got, err := task.buildManagedTrustStoreSpec(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuildTask_buildListenerMutualAuthentication(t *testing.T) {
+	stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Name: "awesome-group"}))
+	task := &defaultModelBuildTask{
+		clusterName: "cluster-name",
+		ingGroup: Group{
+			ID: GroupID{Name: "awesome-group"},
+			Members: []ClassifiedIngress{
+				{
+					Ing: &networking.Ingress{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "awesome-ns",
+							Name:      "ing-1",
+							Annotations: map[string]string{
+								"alb.ingress.kubernetes.io/mutual-authentication-trust-store-bundle": "s3Bucket=my-bucket,s3Key=ca.pem",
+							},
+						},
+					},
+				},
+			},
+		},
+		stack:            stack,
+		annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+	}
+
+	got, err := task.buildListenerMutualAuthentication(context.Background(), &elbv2api.MutualAuthenticationAttributes{
+		Port: 443,
+		Mode: elbv2api.MutualAuthenticationModePassthrough,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &elbv2model.MutualAuthenticationAttributes{Mode: elbv2model.MutualAuthenticationModePassthrough}, got)
+
+	got, err = task.buildListenerMutualAuthentication(context.Background(), &elbv2api.MutualAuthenticationAttributes{
+		Port:       443,
+		Mode:       elbv2api.MutualAuthenticationModeVerify,
+		TrustStore: "arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/ts-1/abc",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &elbv2model.MutualAuthenticationAttributes{
+		Mode:          elbv2model.MutualAuthenticationModeVerify,
+		TrustStoreARN: core.LiteralStringToken("arn:aws:elasticloadbalancing:us-west-2:123456789012:truststore/ts-1/abc"),
+	}, got)
+
+	for _, port := range []int64{443, 8443} {
+		got, err = task.buildListenerMutualAuthentication(context.Background(), &elbv2api.MutualAuthenticationAttributes{
+			Port: port,
+			Mode: elbv2api.MutualAuthenticationModeVerify,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []core.Resource{task.managedTrustStore}, got.TrustStoreARN.Dependencies())
+	}
+	var resTrustStores []*elbv2model.TrustStore
+	stack.ListResources(&resTrustStores)
+	assert.Len(t, resTrustStores, 1)
+}
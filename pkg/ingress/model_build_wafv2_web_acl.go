@@ -0,0 +1,115 @@
+package ingress
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+const resourceIDWAFv2WebACL = "WebACL"
+
+// buildManagedWAFv2WebACL builds the WAFv2 WebACL managed for this IngressGroup from IngressClassParams.
+func (t *defaultModelBuildTask) buildManagedWAFv2WebACL(ctx context.Context) (*wafv2model.WebACL, error) {
+	webACLConfig, err := t.buildWAFv2WebACLConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if webACLConfig == nil {
+		return nil, nil
+	}
+	webACLSpec, err := t.buildManagedWAFv2WebACLSpec(ctx, *webACLConfig)
+	if err != nil {
+		return nil, err
+	}
+	return wafv2model.NewWebACL(t.stack, resourceIDWAFv2WebACL, webACLSpec), nil
+}
+
+func (t *defaultModelBuildTask) buildWAFv2WebACLConfig(_ context.Context) (*elbv2api.WAFv2WebACL, error) {
+	var explicitWebACLConfigs []elbv2api.WAFv2WebACL
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams == nil || member.IngClassConfig.IngClassParams.Spec.WAFv2WebACL == nil {
+			continue
+		}
+		webACLConfig := *member.IngClassConfig.IngClassParams.Spec.WAFv2WebACL
+		if len(explicitWebACLConfigs) != 0 && !reflect.DeepEqual(explicitWebACLConfigs[0], webACLConfig) {
+			return nil, errors.New("conflicting WAFv2 WebACL configurations")
+		}
+		explicitWebACLConfigs = append(explicitWebACLConfigs, webACLConfig)
+	}
+	if len(explicitWebACLConfigs) == 0 {
+		return nil, nil
+	}
+	return &explicitWebACLConfigs[0], nil
+}
+
+func (t *defaultModelBuildTask) buildManagedWAFv2WebACLSpec(ctx context.Context, webACLConfig elbv2api.WAFv2WebACL) (wafv2model.WebACLSpec, error) {
+	defaultAction := wafv2model.WebACLDefaultActionAllow
+	if webACLConfig.DefaultAction == elbv2api.WAFv2WebACLDefaultActionBlock {
+		defaultAction = wafv2model.WebACLDefaultActionBlock
+	}
+	rules := make([]wafv2model.WebACLRule, 0, len(webACLConfig.RateLimits)+len(webACLConfig.ManagedRuleGroups))
+	for _, rateLimit := range webACLConfig.RateLimits {
+		rules = append(rules, wafv2model.WebACLRule{
+			Name:     rateLimit.Name,
+			Priority: int64(len(rules)),
+			RateLimit: &wafv2model.RateLimit{
+				Limit: rateLimit.Limit,
+			},
+		})
+	}
+	for _, ruleGroup := range webACLConfig.ManagedRuleGroups {
+		managedRuleGroup := &wafv2model.ManagedRuleGroup{
+			VendorName: ruleGroup.VendorName,
+			Name:       ruleGroup.Name,
+		}
+		if ruleGroup.Version != "" {
+			managedRuleGroup.Version = awssdk.String(ruleGroup.Version)
+		}
+		rules = append(rules, wafv2model.WebACLRule{
+			Name:             fmt.Sprintf("%v-%v", ruleGroup.VendorName, ruleGroup.Name),
+			Priority:         int64(len(rules)),
+			ManagedRuleGroup: managedRuleGroup,
+		})
+	}
+	ruleNames := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if _, exists := ruleNames[rule.Name]; exists {
+			return wafv2model.WebACLSpec{}, errors.Errorf("duplicate WAFv2 WebACL rule: %v", rule.Name)
+		}
+		ruleNames[rule.Name] = struct{}{}
+	}
+	tags, err := t.buildLoadBalancerTags(ctx)
+	if err != nil {
+		return wafv2model.WebACLSpec{}, err
+	}
+	return wafv2model.WebACLSpec{
+		Name:          t.buildManagedWAFv2WebACLName(ctx),
+		DefaultAction: defaultAction,
+		Rules:         rules,
+		Tags:          tags,
+	}, nil
+}
+
+// buildManagedWAFv2WebACLName generates the webACL name from the IngressGroup, the webACL is updated in place when its rules change.
+func (t *defaultModelBuildTask) buildManagedWAFv2WebACLName(_ context.Context) string {
+	uuidHash := sha256.New()
+	_, _ = uuidHash.Write([]byte(t.clusterName))
+	_, _ = uuidHash.Write([]byte(t.ingGroup.ID.String()))
+	uuid := hex.EncodeToString(uuidHash.Sum(nil))
+
+	if t.ingGroup.ID.IsExplicit() {
+		payload := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+		return fmt.Sprintf("k8s-%.17s-%.10s", payload, uuid)
+	}
+
+	sanitizedNamespace := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Namespace, "")
+	sanitizedName := invalidLoadBalancerNamePattern.ReplaceAllString(t.ingGroup.ID.Name, "")
+	return fmt.Sprintf("k8s-%.8s-%.8s-%.10s", sanitizedNamespace, sanitizedName, uuid)
+}
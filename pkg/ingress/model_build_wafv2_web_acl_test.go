@@ -0,0 +1,199 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	wafv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/wafv2"
+)
+
+func Test_defaultModelBuildTask_buildManagedWAFv2WebACLSpec(t *testing.T) {
+	webACLConfig := &elbv2api.WAFv2WebACL{
+		DefaultAction: elbv2api.WAFv2WebACLDefaultActionBlock,
+		RateLimits: []elbv2api.WAFv2RateLimit{
+			{
+				Name:  "per-ip",
+				Limit: 1000,
+			},
+		},
+		ManagedRuleGroups: []elbv2api.WAFv2ManagedRuleGroup{
+			{
+				VendorName: "AWS",
+				Name:       "AWSManagedRulesCommonRuleSet",
+				Version:    "Version_1.0",
+			},
+			{
+				VendorName: "AWS",
+				Name:       "AWSManagedRulesKnownBadInputsRuleSet",
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		ingGroup Group
+		want     wafv2model.WebACLSpec
+		wantErr  error
+	}{
+		{
+			name: "webACL configured via IngressClassParams",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &elbv2api.IngressClassParams{
+								Spec: elbv2api.IngressClassParamsSpec{
+									WAFv2WebACL: webACLConfig,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: wafv2model.WebACLSpec{
+				Name:          "k8s-awesomegroup-50383175f3",
+				DefaultAction: wafv2model.WebACLDefaultActionBlock,
+				Rules: []wafv2model.WebACLRule{
+					{
+						Name:      "per-ip",
+						Priority:  0,
+						RateLimit: &wafv2model.RateLimit{Limit: 1000},
+					},
+					{
+						Name:     "AWS-AWSManagedRulesCommonRuleSet",
+						Priority: 1,
+						ManagedRuleGroup: &wafv2model.ManagedRuleGroup{
+							VendorName: "AWS",
+							Name:       "AWSManagedRulesCommonRuleSet",
+							Version:    awssdk.String("Version_1.0"),
+						},
+					},
+					{
+						Name:     "AWS-AWSManagedRulesKnownBadInputsRuleSet",
+						Priority: 2,
+						ManagedRuleGroup: &wafv2model.ManagedRuleGroup{
+							VendorName: "AWS",
+							Name:       "AWSManagedRulesKnownBadInputsRuleSet",
+						},
+					},
+				},
+				Tags: map[string]string{},
+			},
+		},
+		{
+			name: "duplicate rule names",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &elbv2api.IngressClassParams{
+								Spec: elbv2api.IngressClassParamsSpec{
+									WAFv2WebACL: &elbv2api.WAFv2WebACL{
+										DefaultAction: elbv2api.WAFv2WebACLDefaultActionAllow,
+										RateLimits: []elbv2api.WAFv2RateLimit{
+											{
+												Name:  "AWS-AWSManagedRulesCommonRuleSet",
+												Limit: 1000,
+											},
+										},
+										ManagedRuleGroups: []elbv2api.WAFv2ManagedRuleGroup{
+											{
+												VendorName: "AWS",
+												Name:       "AWSManagedRulesCommonRuleSet",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("duplicate WAFv2 WebACL rule: AWS-AWSManagedRulesCommonRuleSet"),
+		},
+		{
+			name: "conflicting webACLs among IngressGroup",
+			ingGroup: Group{
+				ID: GroupID{Name: "awesome-group"},
+				Members: []ClassifiedIngress{
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-1",
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &elbv2api.IngressClassParams{
+								Spec: elbv2api.IngressClassParamsSpec{
+									WAFv2WebACL: webACLConfig,
+								},
+							},
+						},
+					},
+					{
+						Ing: &networking.Ingress{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "awesome-ns",
+								Name:      "ing-2",
+							},
+						},
+						IngClassConfig: ClassConfiguration{
+							IngClassParams: &elbv2api.IngressClassParams{
+								Spec: elbv2api.IngressClassParamsSpec{
+									WAFv2WebACL: &elbv2api.WAFv2WebACL{
+										DefaultAction: elbv2api.WAFv2WebACLDefaultActionAllow,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("conflicting WAFv2 WebACL configurations"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := &defaultModelBuildTask{
+				clusterName:      "cluster-name",
+				ingGroup:         tt.ingGroup,
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+			}
+			ctx := context.Background()
+			webACLConfig, err := task.buildWAFv2WebACLConfig(ctx)
+			if err == nil {
+				var got wafv2model.WebACLSpec
+				got, err = task.buildManagedWAFv2WebACLSpec(ctx, *webACLConfig)
+				if err == nil {
+					assert.Equal(t, tt.want, got)
+				}
+			}
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
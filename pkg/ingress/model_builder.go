@@ -3,16 +3,19 @@ package ingress
 import (
 	"context"
 	"strconv"
+	"time"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
@@ -37,14 +40,24 @@ type ModelBuilder interface {
 
 // NewDefaultModelBuilder constructs new defaultModelBuilder.
 func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventRecorder,
-	ec2Client services.EC2, acmClient services.ACM,
+	ec2Client services.EC2, acmClient services.ACM, certDiscoveryTags map[string]string, certDiscoveryMetrics *CertDiscoveryMetrics,
+	certRotationLeadtime time.Duration, route53Client services.Route53, certRequestHostedZoneID string,
 	annotationParser annotations.Parser, subnetsResolver networkingpkg.SubnetsResolver,
 	authConfigBuilder AuthConfigBuilder, enhancedBackendBuilder EnhancedBackendBuilder,
 	trackingProvider tracking.Provider, elbv2TaggingManager elbv2deploy.TaggingManager, featureGates config.FeatureGates,
-	vpcID string, clusterName string, defaultTags map[string]string, externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string,
+	vpcID string, clusterName string, defaultTagsProvider networkingpkg.DefaultTagsProvider, externalManagedTags []string, allowedAWSRoleARNs []string, dynamicConfigProvider config.DynamicConfigProvider,
 	backendSGProvider networkingpkg.BackendSGProvider, sgResolver networkingpkg.SecurityGroupResolver,
+	blocklistPrefixListProvider networkingpkg.BlocklistPrefixListProvider, awsSecretsProvider AWSSecretsProvider,
 	enableBackendSG bool, disableRestrictedSGRules bool, enableIPTargetType bool, logger logr.Logger) *defaultModelBuilder {
-	certDiscovery := NewACMCertDiscovery(acmClient, logger)
+	certDiscovery := NewACMCertDiscovery(acmClient, certDiscoveryTags, certDiscoveryMetrics, logger)
+	var certRotationPlanner CertRotationPlanner
+	if certRotationLeadtime > 0 {
+		certRotationPlanner = NewACMCertRotationPlanner(acmClient, certRotationLeadtime, logger)
+	}
+	var certRequester CertRequester
+	if certRequestHostedZoneID != "" {
+		certRequester = NewACMCertRequester(acmClient, route53Client, certRequestHostedZoneID, clusterName, logger)
+	}
 	ruleOptimizer := NewDefaultRuleOptimizer(logger)
 	return &defaultModelBuilder{
 		k8sClient:                k8sClient,
@@ -56,17 +69,22 @@ func NewDefaultModelBuilder(k8sClient client.Client, eventRecorder record.EventR
 		subnetsResolver:          subnetsResolver,
 		backendSGProvider:        backendSGProvider,
 		sgResolver:               sgResolver,
+		blocklistPLProvider:      blocklistPrefixListProvider,
+		awsSecretsProvider:       awsSecretsProvider,
 		certDiscovery:            certDiscovery,
+		certRotationPlanner:      certRotationPlanner,
+		certRequester:            certRequester,
 		authConfigBuilder:        authConfigBuilder,
 		enhancedBackendBuilder:   enhancedBackendBuilder,
 		ruleOptimizer:            ruleOptimizer,
+		inboundCIDRsChecker:      NewDefaultInboundCIDRsPolicyChecker(k8sClient),
 		trackingProvider:         trackingProvider,
 		elbv2TaggingManager:      elbv2TaggingManager,
 		featureGates:             featureGates,
-		defaultTags:              defaultTags,
+		defaultTagsProvider:      defaultTagsProvider,
 		externalManagedTags:      sets.NewString(externalManagedTags...),
-		defaultSSLPolicy:         defaultSSLPolicy,
-		defaultTargetType:        elbv2model.TargetType(defaultTargetType),
+		allowedAWSRoleARNs:       sets.NewString(allowedAWSRoleARNs...),
+		dynamicConfigProvider:    dynamicConfigProvider,
 		enableBackendSG:          enableBackendSG,
 		disableRestrictedSGRules: disableRestrictedSGRules,
 		enableIPTargetType:       enableIPTargetType,
@@ -85,21 +103,29 @@ type defaultModelBuilder struct {
 	vpcID       string
 	clusterName string
 
-	annotationParser         annotations.Parser
-	subnetsResolver          networkingpkg.SubnetsResolver
-	backendSGProvider        networkingpkg.BackendSGProvider
-	sgResolver               networkingpkg.SecurityGroupResolver
-	certDiscovery            CertDiscovery
-	authConfigBuilder        AuthConfigBuilder
-	enhancedBackendBuilder   EnhancedBackendBuilder
-	ruleOptimizer            RuleOptimizer
-	trackingProvider         tracking.Provider
-	elbv2TaggingManager      elbv2deploy.TaggingManager
-	featureGates             config.FeatureGates
-	defaultTags              map[string]string
-	externalManagedTags      sets.String
-	defaultSSLPolicy         string
-	defaultTargetType        elbv2model.TargetType
+	annotationParser    annotations.Parser
+	subnetsResolver     networkingpkg.SubnetsResolver
+	backendSGProvider   networkingpkg.BackendSGProvider
+	sgResolver          networkingpkg.SecurityGroupResolver
+	blocklistPLProvider networkingpkg.BlocklistPrefixListProvider
+	awsSecretsProvider  AWSSecretsProvider
+	certDiscovery       CertDiscovery
+	// certRequester requests certificates for tls hosts without certificate, it's nil if the requests are disabled.
+	certRequester CertRequester
+	// certRotationPlanner plans the rotation of expiring listener certificates, it's nil if the rotation is disabled.
+	certRotationPlanner    CertRotationPlanner
+	authConfigBuilder      AuthConfigBuilder
+	enhancedBackendBuilder EnhancedBackendBuilder
+	ruleOptimizer          RuleOptimizer
+	inboundCIDRsChecker    InboundCIDRsPolicyChecker
+	trackingProvider       tracking.Provider
+	elbv2TaggingManager    elbv2deploy.TaggingManager
+	featureGates           config.FeatureGates
+	defaultTagsProvider    networkingpkg.DefaultTagsProvider
+	externalManagedTags    sets.String
+	// allowedAWSRoleARNs are the IAM roles the aws-role-arn annotation can specify.
+	allowedAWSRoleARNs       sets.String
+	dynamicConfigProvider    config.DynamicConfigProvider
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
 	enableIPTargetType       bool
@@ -110,6 +136,12 @@ type defaultModelBuilder struct {
 // build mode stack for a IngressGroup.
 func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.Stack, *elbv2model.LoadBalancer, []types.NamespacedName, bool, error) {
 	stack := core.NewDefaultStack(core.StackID(ingGroup.ID))
+	ingGroup = ingGroup.WithDefaultAnnotations()
+	// in IPv6-only clusters, the LoadBalancers must be dualstack to route traffic to the IPv6 targets.
+	defaultIPAddressType := elbv2model.IPAddressTypeIPV4
+	if b.featureGates.Enabled(config.IPv6Only) {
+		defaultIPAddressType = elbv2model.IPAddressTypeDualStack
+	}
 	task := &defaultModelBuildTask{
 		k8sClient:                b.k8sClient,
 		eventRecorder:            b.eventRecorder,
@@ -119,14 +151,19 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		annotationParser:         b.annotationParser,
 		subnetsResolver:          b.subnetsResolver,
 		certDiscovery:            b.certDiscovery,
+		certRotationPlanner:      b.certRotationPlanner,
+		certRequester:            b.certRequester,
+		awsSecretsProvider:       b.awsSecretsProvider,
 		authConfigBuilder:        b.authConfigBuilder,
 		enhancedBackendBuilder:   b.enhancedBackendBuilder,
 		ruleOptimizer:            b.ruleOptimizer,
+		inboundCIDRsChecker:      b.inboundCIDRsChecker,
 		trackingProvider:         b.trackingProvider,
 		elbv2TaggingManager:      b.elbv2TaggingManager,
 		featureGates:             b.featureGates,
 		backendSGProvider:        b.backendSGProvider,
 		sgResolver:               b.sgResolver,
+		blocklistPLProvider:      b.blocklistPLProvider,
 		logger:                   b.logger,
 		enableBackendSG:          b.enableBackendSG,
 		disableRestrictedSGRules: b.disableRestrictedSGRules,
@@ -135,12 +172,13 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		ingGroup: ingGroup,
 		stack:    stack,
 
-		defaultTags:                               b.defaultTags,
+		defaultTags:                               b.defaultTagsProvider.DefaultTags(),
 		externalManagedTags:                       b.externalManagedTags,
-		defaultIPAddressType:                      elbv2model.IPAddressTypeIPV4,
+		allowedAWSRoleARNs:                        b.allowedAWSRoleARNs,
+		defaultIPAddressType:                      defaultIPAddressType,
 		defaultScheme:                             elbv2model.LoadBalancerSchemeInternal,
-		defaultSSLPolicy:                          b.defaultSSLPolicy,
-		defaultTargetType:                         b.defaultTargetType,
+		defaultSSLPolicy:                          b.dynamicConfigProvider.DefaultSSLPolicy(),
+		defaultTargetType:                         elbv2model.TargetType(b.dynamicConfigProvider.DefaultTargetType()),
 		defaultBackendProtocol:                    elbv2model.ProtocolHTTP,
 		defaultBackendProtocolVersion:             elbv2model.ProtocolVersionHTTP1,
 		defaultHealthCheckPathHTTP:                "/",
@@ -155,10 +193,16 @@ func (b *defaultModelBuilder) Build(ctx context.Context, ingGroup Group) (core.S
 		loadBalancer:    nil,
 		tgByResID:       make(map[string]*elbv2model.TargetGroup),
 		backendServices: make(map[types.NamespacedName]*corev1.Service),
+		awsSecretARNs:   sets.NewString(),
+
+		ingressesWithSplitRules: make(map[types.NamespacedName]*networking.Ingress),
 	}
 	if err := task.run(ctx); err != nil {
 		return nil, nil, nil, false, err
 	}
+	if b.awsSecretsProvider != nil {
+		b.awsSecretsProvider.Track(ingGroup, task.awsSecretARNs.List())
+	}
 	return task.stack, task.loadBalancer, task.secretKeys, task.backendSGAllocated, nil
 }
 
@@ -173,19 +217,26 @@ type defaultModelBuildTask struct {
 	subnetsResolver        networkingpkg.SubnetsResolver
 	backendSGProvider      networkingpkg.BackendSGProvider
 	sgResolver             networkingpkg.SecurityGroupResolver
+	blocklistPLProvider    networkingpkg.BlocklistPrefixListProvider
 	certDiscovery          CertDiscovery
+	certRotationPlanner    CertRotationPlanner
+	certRequester          CertRequester
+	awsSecretsProvider     AWSSecretsProvider
 	authConfigBuilder      AuthConfigBuilder
 	enhancedBackendBuilder EnhancedBackendBuilder
 	ruleOptimizer          RuleOptimizer
+	inboundCIDRsChecker    InboundCIDRsPolicyChecker
 	trackingProvider       tracking.Provider
 	elbv2TaggingManager    elbv2deploy.TaggingManager
 	featureGates           config.FeatureGates
 	logger                 logr.Logger
 
 	ingGroup                 Group
+	awsRoleARN               string
 	sslRedirectConfig        *SSLRedirectConfig
 	stack                    core.Stack
 	backendSGIDToken         core.StringToken
+	frontendNlbSGIDToken     core.StringToken
 	backendSGAllocated       bool
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
@@ -193,6 +244,7 @@ type defaultModelBuildTask struct {
 
 	defaultTags                               map[string]string
 	externalManagedTags                       sets.String
+	allowedAWSRoleARNs                        sets.String
 	defaultIPAddressType                      elbv2model.IPAddressType
 	defaultScheme                             elbv2model.LoadBalancerScheme
 	defaultSSLPolicy                          string
@@ -208,10 +260,17 @@ type defaultModelBuildTask struct {
 	defaultHealthCheckMatcherHTTPCode         string
 	defaultHealthCheckMatcherGRPCCode         string
 
-	loadBalancer    *elbv2model.LoadBalancer
-	tgByResID       map[string]*elbv2model.TargetGroup
-	backendServices map[types.NamespacedName]*corev1.Service
-	secretKeys      []types.NamespacedName
+	loadBalancer      *elbv2model.LoadBalancer
+	managedTrustStore *elbv2model.TrustStore
+	tgByResID         map[string]*elbv2model.TargetGroup
+	backendServices   map[types.NamespacedName]*corev1.Service
+	secretKeys        []types.NamespacedName
+	awsSecretARNs     sets.String
+
+	// ingressesWithSplitRules are the Ingresses having rules split to fit into the limit of condition values per rule.
+	ingressesWithSplitRules map[types.NamespacedName]*networking.Ingress
+	// loadBalancerRoutes are the valid LoadBalancerRoutes attached to this IngressGroup.
+	loadBalancerRoutes []*elbv2api.LoadBalancerRoute
 }
 
 func (t *defaultModelBuildTask) run(ctx context.Context) error {
@@ -229,6 +288,11 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 	if len(t.ingGroup.Members) == 0 {
 		return nil
 	}
+	awsRoleARN, err := ResolveAWSRoleARN(t.annotationParser, t.ingGroup, t.allowedAWSRoleARNs)
+	if err != nil {
+		return err
+	}
+	t.awsRoleARN = awsRoleARN
 
 	ingListByPort := make(map[int64][]ClassifiedIngress)
 	listenPortConfigsByPort := make(map[int64][]listenPortConfigWithIngress)
@@ -255,6 +319,20 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 		}
 		listenPortConfigByPort[port] = mergedCfg
 	}
+	t.loadBalancerRoutes, err = t.loadLoadBalancerRoutes(ctx, listenPortConfigByPort)
+	if err != nil {
+		return err
+	}
+
+	frontendNlbCfg, err := t.buildFrontendNlbConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if frontendNlbCfg != nil {
+		if _, err := t.buildFrontendNlbSecurityGroup(ctx, listenPortConfigByPort); err != nil {
+			return err
+		}
+	}
 
 	lb, err := t.buildLoadBalancer(ctx, listenPortConfigByPort)
 	if err != nil {
@@ -278,11 +356,24 @@ func (t *defaultModelBuildTask) run(ctx context.Context) error {
 			return err
 		}
 	}
+	t.checkListenerRulesQuota(ctx)
+
+	if frontendNlbCfg != nil {
+		if err := t.buildFrontendNlb(ctx, *frontendNlbCfg, lb, listenPortConfigByPort); err != nil {
+			return err
+		}
+	}
 
 	if err := t.buildLoadBalancerAddOns(ctx, lb.LoadBalancerARN()); err != nil {
 		return err
 	}
-	return nil
+	if err := t.buildGlobalAccelerator(ctx, lb, listenPortConfigByPort); err != nil {
+		return err
+	}
+	if err := t.buildRoute53RecordSets(ctx, lb); err != nil {
+		return err
+	}
+	return t.buildRecreations(ctx)
 }
 
 func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listenPortConfigs []listenPortConfigWithIngress) (listenPortConfig, error) {
@@ -293,12 +384,18 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 	mergedInboundCIDRv6s := sets.NewString()
 	mergedInboundCIDRv4s := sets.NewString()
 
+	var mergedPrefixListsProvider *types.NamespacedName
+	mergedPrefixLists := sets.NewString()
+
 	var mergedSSLPolicyProvider *types.NamespacedName
 	var mergedSSLPolicy *string
 
 	var mergedTLSCerts []string
 	mergedTLSCertsSet := sets.NewString()
 
+	var mergedMutualAuthenticationProvider *types.NamespacedName
+	var mergedMutualAuthentication *elbv2api.MutualAuthenticationAttributes
+
 	for _, cfg := range listenPortConfigs {
 		if mergedProtocolProvider == nil {
 			mergedProtocolProvider = &cfg.ingKey
@@ -321,13 +418,34 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 			}
 		}
 
+		if len(cfg.listenPortConfig.prefixLists) != 0 {
+			cfgPrefixLists := sets.NewString(cfg.listenPortConfig.prefixLists...)
+			if mergedPrefixListsProvider == nil {
+				mergedPrefixListsProvider = &cfg.ingKey
+				mergedPrefixLists = cfgPrefixLists
+			} else if !mergedPrefixLists.Equal(cfgPrefixLists) {
+				return listenPortConfig{}, errors.Errorf("conflicting security-group-prefix-lists, %v: %v | %v: %v",
+					*mergedPrefixListsProvider, mergedPrefixLists.List(), cfg.ingKey, cfgPrefixLists.List())
+			}
+		}
+
 		if cfg.listenPortConfig.sslPolicy != nil {
 			if mergedSSLPolicyProvider == nil {
 				mergedSSLPolicyProvider = &cfg.ingKey
 				mergedSSLPolicy = cfg.listenPortConfig.sslPolicy
-			} else if awssdk.StringValue(mergedSSLPolicy) != awssdk.StringValue(cfg.listenPortConfig.sslPolicy) {
+			} else if awssdk.ToString(mergedSSLPolicy) != awssdk.ToString(cfg.listenPortConfig.sslPolicy) {
 				return listenPortConfig{}, errors.Errorf("conflicting sslPolicy, %v: %v | %v: %v",
-					*mergedSSLPolicyProvider, awssdk.StringValue(mergedSSLPolicy), cfg.ingKey, awssdk.StringValue(cfg.listenPortConfig.sslPolicy))
+					*mergedSSLPolicyProvider, awssdk.ToString(mergedSSLPolicy), cfg.ingKey, awssdk.ToString(cfg.listenPortConfig.sslPolicy))
+			}
+		}
+
+		if cfg.listenPortConfig.mutualAuthentication != nil {
+			if mergedMutualAuthenticationProvider == nil {
+				mergedMutualAuthenticationProvider = &cfg.ingKey
+				mergedMutualAuthentication = cfg.listenPortConfig.mutualAuthentication
+			} else if !equality.Semantic.DeepEqual(mergedMutualAuthentication, cfg.listenPortConfig.mutualAuthentication) {
+				return listenPortConfig{}, errors.Errorf("conflicting mutualAuthentication, %v: %v | %v: %v",
+					*mergedMutualAuthenticationProvider, mergedMutualAuthentication.Mode, cfg.ingKey, cfg.listenPortConfig.mutualAuthentication.Mode)
 			}
 		}
 
@@ -340,7 +458,7 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 		}
 	}
 
-	if len(mergedInboundCIDRv4s) == 0 && len(mergedInboundCIDRv6s) == 0 {
+	if len(mergedInboundCIDRv4s) == 0 && len(mergedInboundCIDRv6s) == 0 && len(mergedPrefixLists) == 0 {
 		mergedInboundCIDRv4s.Insert("0.0.0.0/0")
 		mergedInboundCIDRv6s.Insert("::/0")
 	}
@@ -352,8 +470,11 @@ func (t *defaultModelBuildTask) mergeListenPortConfigs(_ context.Context, listen
 		protocol:       mergedProtocol,
 		inboundCIDRv4s: mergedInboundCIDRv4s.List(),
 		inboundCIDRv6s: mergedInboundCIDRv6s.List(),
+		prefixLists:    mergedPrefixLists.List(),
 		sslPolicy:      mergedSSLPolicy,
 		tlsCerts:       mergedTLSCerts,
+
+		mutualAuthentication: mergedMutualAuthentication,
 	}, nil
 }
 
@@ -386,7 +507,7 @@ func (t *defaultModelBuildTask) buildSSLRedirectConfig(ctx context.Context, list
 
 	return &SSLRedirectConfig{
 		SSLPort:    rawSSLRedirectPort,
-		StatusCode: elbv2sdk.RedirectActionStatusCodeEnumHttp301,
+		StatusCode: string(elbv2types.RedirectActionStatusCodeEnumHttp301),
 	}, nil
 }
 
@@ -429,6 +550,30 @@ func (t *defaultModelBuildTask) buildManageSecurityGroupRulesFlag(_ context.Cont
 	return manageSGRules, nil
 }
 
+// buildExternallyManagedNetworking determines whether the security groups are managed externally from IngressClassParams and annotations of members.
+func (t *defaultModelBuildTask) buildExternallyManagedNetworking(_ context.Context) (bool, error) {
+	explicitExternallyManaged := make(map[bool]struct{})
+	for _, member := range t.ingGroup.Members {
+		if member.IngClassConfig.IngClassParams != nil && member.IngClassConfig.IngClassParams.Spec.ExternallyManagedNetworking != nil {
+			explicitExternallyManaged[*member.IngClassConfig.IngClassParams.Spec.ExternallyManagedNetworking] = struct{}{}
+			continue
+		}
+		rawExternallyManaged := false
+		exists, err := t.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixExternallyManagedNetworking, &rawExternallyManaged, member.Ing.Annotations)
+		if err != nil {
+			return false, err
+		}
+		if exists {
+			explicitExternallyManaged[rawExternallyManaged] = struct{}{}
+		}
+	}
+	if len(explicitExternallyManaged) > 1 {
+		return false, errors.New("conflicting externally managed networking settings")
+	}
+	_, externallyManaged := explicitExternallyManaged[true]
+	return externallyManaged, nil
+}
+
 // the listen port config for specific Ingress's listener port.
 type listenPortConfigWithIngress struct {
 	ingKey           types.NamespacedName
@@ -6,9 +6,10 @@ import (
 	"testing"
 	"time"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
@@ -439,7 +440,7 @@ const baseStackJSON = `
 
 func Test_defaultModelBuilder_Build(t *testing.T) {
 	type resolveViaDiscoveryCall struct {
-		subnets []*ec2sdk.Subnet
+		subnets []ec2types.Subnet
 		err     error
 	}
 	type env struct {
@@ -450,7 +451,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 		err        error
 	}
 	type describeSecurityGroupsResult struct {
-		securityGroups []*ec2sdk.SecurityGroup
+		securityGroups []ec2types.SecurityGroup
 		err            error
 	}
 	type fields struct {
@@ -573,7 +574,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 	}
 
 	resolveViaDiscoveryCallForInternalLB := resolveViaDiscoveryCall{
-		subnets: []*ec2sdk.Subnet{
+		subnets: []ec2types.Subnet{
 			{
 				SubnetId:  awssdk.String("subnet-a"),
 				CidrBlock: awssdk.String("192.168.0.0/19"),
@@ -585,7 +586,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 		},
 	}
 	resolveViaDiscoveryCallForInternetFacingLB := resolveViaDiscoveryCall{
-		subnets: []*ec2sdk.Subnet{
+		subnets: []ec2types.Subnet{
 			{
 				SubnetId:  awssdk.String("subnet-c"),
 				CidrBlock: awssdk.String("192.168.64.0/19"),
@@ -1878,9 +1879,9 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 					{
 						matchedLBs: []elbv2.LoadBalancerWithTags{
 							{
-								LoadBalancer: &elbv2sdk.LoadBalancer{
+								LoadBalancer: &elbv2types.LoadBalancer{
 									LoadBalancerArn: awssdk.String("lb-1"),
-									AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+									AvailabilityZones: []elbv2types.AvailabilityZone{
 										{
 											SubnetId: awssdk.String("subnet-e"),
 										},
@@ -1888,7 +1889,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 											SubnetId: awssdk.String("subnet-f"),
 										},
 									},
-									Scheme: awssdk.String("internal"),
+									Scheme: elbv2types.LoadBalancerSchemeEnumInternal,
 								},
 								Tags: map[string]string{
 									"elbv2.k8s.aws/cluster": "cluster-name",
@@ -1896,9 +1897,9 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								},
 							},
 							{
-								LoadBalancer: &elbv2sdk.LoadBalancer{
+								LoadBalancer: &elbv2types.LoadBalancer{
 									LoadBalancerArn: awssdk.String("lb-2"),
-									AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+									AvailabilityZones: []elbv2types.AvailabilityZone{
 										{
 											SubnetId: awssdk.String("subnet-e"),
 										},
@@ -1906,7 +1907,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 											SubnetId: awssdk.String("subnet-f"),
 										},
 									},
-									Scheme: awssdk.String("internal"),
+									Scheme: elbv2types.LoadBalancerSchemeEnumInternal,
 								},
 								Tags: map[string]string{
 									"keyA": "valueA2",
@@ -1914,9 +1915,9 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 								},
 							},
 							{
-								LoadBalancer: &elbv2sdk.LoadBalancer{
+								LoadBalancer: &elbv2types.LoadBalancer{
 									LoadBalancerArn: awssdk.String("lb-3"),
-									AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+									AvailabilityZones: []elbv2types.AvailabilityZone{
 										{
 											SubnetId: awssdk.String("subnet-e"),
 										},
@@ -1924,7 +1925,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 											SubnetId: awssdk.String("subnet-f"),
 										},
 									},
-									Scheme: awssdk.String("internal"),
+									Scheme: elbv2types.LoadBalancerSchemeEnumInternal,
 								},
 								Tags: map[string]string{
 									"keyA": "valueA3",
@@ -2065,7 +2066,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 				listLoadBalancersCalls:   []listLoadBalancersCall{listLoadBalancerCallForEmptyLB},
 				describeSecurityGroupsResult: []describeSecurityGroupsResult{
 					{
-						securityGroups: []*ec2sdk.SecurityGroup{
+						securityGroups: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-manual"),
 							},
@@ -2204,7 +2205,7 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 				listLoadBalancersCalls:   []listLoadBalancersCall{listLoadBalancerCallForEmptyLB},
 				describeSecurityGroupsResult: []describeSecurityGroupsResult{
 					{
-						securityGroups: []*ec2sdk.SecurityGroup{
+						securityGroups: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-manual"),
 							},
@@ -2563,6 +2564,59 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			},
 			wantErr: "ingress: ns-1/ing-1: unsupported targetType: ip when EnableIPTargetType is false",
 		},
+		{
+			name: "GRPC backend protocol version with HTTP listener",
+			env: env{
+				svcs: []*corev1.Service{svcWithNamedTargetPort},
+			},
+			fields: fields{
+				resolveViaDiscoveryCalls: []resolveViaDiscoveryCall{resolveViaDiscoveryCallForInternalLB},
+				listLoadBalancersCalls:   []listLoadBalancersCall{listLoadBalancerCallForEmptyLB},
+				enableBackendSG:          true,
+			},
+			args: args{
+				ingGroup: Group{
+					ID: GroupID{Namespace: "ns-1", Name: "ing-1"},
+					Members: []ClassifiedIngress{
+						{
+							Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{
+								Namespace: "ns-1",
+								Name:      "ing-1",
+								Annotations: map[string]string{
+									"alb.ingress.kubernetes.io/target-type":              "ip",
+									"alb.ingress.kubernetes.io/backend-protocol-version": "GRPC",
+								},
+							},
+								Spec: networking.IngressSpec{
+									Rules: []networking.IngressRule{
+										{
+											IngressRuleValue: networking.IngressRuleValue{
+												HTTP: &networking.HTTPIngressRuleValue{
+													Paths: []networking.HTTPIngressPath{
+														{
+															Path: "/",
+															Backend: networking.IngressBackend{
+																Service: &networking.IngressServiceBackend{
+																	Name: svcWithNamedTargetPort.Name,
+																	Port: networking.ServiceBackendPort{
+																		Name: "https",
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: "ingress: ns-1/ing-1: backend protocol version GRPC of service ns-1/svc-named-targetport port https requires HTTPS listener, got HTTP",
+		},
 		{
 			name: "target type IP with named target port",
 			env: env{
@@ -2915,9 +2969,9 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 			certDiscovery := NewMockCertDiscovery(ctrl)
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
-			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, true, true)
+			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(k8sClient, annotationParser, authConfigBuilder, true, true, false)
 			ruleOptimizer := NewDefaultRuleOptimizer(logr.New(&log.NullLogSink{}))
-			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", clusterName)
+			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", clusterName, nil)
 			stackMarshaller := deploy.NewDefaultStackMarshaller()
 			backendSGProvider := networkingpkg.NewMockBackendSGProvider(ctrl)
 			sgResolver := networkingpkg.NewDefaultSecurityGroupResolver(ec2Client, vpcID)
@@ -2950,12 +3004,15 @@ func Test_defaultModelBuilder_Build(t *testing.T) {
 				ruleOptimizer:          ruleOptimizer,
 				trackingProvider:       trackingProvider,
 				elbv2TaggingManager:    elbv2TaggingManager,
+				defaultTagsProvider:    networkingpkg.NewDefaultTagsProvider(nil, nil, log.Log),
 				enableBackendSG:        tt.fields.enableBackendSG,
 				featureGates:           config.NewFeatureGates(),
 				logger:                 logr.New(&log.NullLogSink{}),
 
-				defaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
-				defaultTargetType: elbv2model.TargetType(defaultTargetType),
+				dynamicConfigProvider: config.NewDynamicConfigProvider(config.DynamicConfig{
+					DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+					DefaultTargetType: defaultTargetType,
+				}, config.NewFeatureGates()),
 			}
 
 			if tt.enableIPTargetType == nil {
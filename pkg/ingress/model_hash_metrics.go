@@ -0,0 +1,73 @@
+package ingress
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricIngressGroupModelInfo = "model_info"
+
+	labelModelHash = "model_hash"
+)
+
+// ModelHashMetrics exports the hash of the deployed model per IngressGroup, so that fleet tooling can compare
+// the same apps across clusters and detect configuration divergence, e.g. one cluster lacks the WAF annotation.
+type ModelHashMetrics struct {
+	modelInfo *prometheus.GaugeVec
+
+	// mutex protects hashByGroup.
+	mutex sync.Mutex
+	// hashByGroup is the hash of the deployed model per IngressGroup, whose series is replaced once the hash changes.
+	hashByGroup map[GroupID]string
+}
+
+// NewModelHashMetrics allocates and register new ModelHashMetrics to registerer.
+func NewModelHashMetrics(registerer prometheus.Registerer) (*ModelHashMetrics, error) {
+	modelInfo := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemIngressGroup,
+		Name:      metricIngressGroupModelInfo,
+		Help:      "Hash of the deployed model of IngressGroup, which is identical for the same configuration across clusters, the value is always 1",
+	}, []string{labelIngressGroup, labelModelHash})
+	if err := registerer.Register(modelInfo); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricIngressGroupModelInfo)
+	}
+	return &ModelHashMetrics{
+		modelInfo:   modelInfo,
+		hashByGroup: make(map[GroupID]string),
+	}, nil
+}
+
+// Observe records modelHash as the hash of the deployed model of IngressGroup.
+// A nil ModelHashMetrics doesn't record hashes.
+func (m *ModelHashMetrics) Observe(groupID GroupID, modelHash string) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if previousHash, exists := m.hashByGroup[groupID]; exists {
+		if previousHash == modelHash {
+			return
+		}
+		m.modelInfo.DeleteLabelValues(groupID.String(), previousHash)
+	}
+	m.hashByGroup[groupID] = modelHash
+	m.modelInfo.WithLabelValues(groupID.String(), modelHash).Set(1)
+}
+
+// Forget removes the hash of IngressGroup, e.g. once its load balancer is deleted or abandoned.
+// A nil ModelHashMetrics doesn't record hashes.
+func (m *ModelHashMetrics) Forget(groupID GroupID) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if previousHash, exists := m.hashByGroup[groupID]; exists {
+		m.modelInfo.DeleteLabelValues(groupID.String(), previousHash)
+		delete(m.hashByGroup, groupID)
+	}
+}
@@ -0,0 +1,36 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelHashMetrics(t *testing.T) {
+	metrics, err := NewModelHashMetrics(prometheus.NewRegistry())
+	assert.NoError(t, err)
+	groupA := GroupID{Name: "group-a"}
+	groupB := GroupID{Namespace: "ns", Name: "ing"}
+
+	metrics.Observe(groupA, "hash-1")
+	metrics.Observe(groupB, "hash-1")
+	metrics.Observe(groupA, "hash-1")
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.modelInfo))
+
+	metrics.Observe(groupA, "hash-2")
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.modelInfo))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.modelInfo.WithLabelValues("group-a", "hash-2")))
+	assert.False(t, metrics.modelInfo.DeleteLabelValues("group-a", "hash-1"))
+
+	metrics.Forget(groupB)
+	metrics.Forget(groupB)
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.modelInfo))
+}
+
+func TestModelHashMetrics_nil(t *testing.T) {
+	var metrics *ModelHashMetrics
+	metrics.Observe(GroupID{Name: "group-a"}, "hash-1")
+	metrics.Forget(GroupID{Name: "group-a"})
+}
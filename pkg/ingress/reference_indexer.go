@@ -77,7 +77,7 @@ func (i *defaultReferenceIndexer) BuildServiceRefIndexes(ctx context.Context, in
 				"indexKey", IndexKeyServiceRefName)
 			return nil
 		}
-		serviceNamesFromBackend := extractServiceNamesFromAction(enhancedBackend.Action)
+		serviceNamesFromBackend := extractServiceNamesFromAction(enhancedBackend.Action, ing.Namespace)
 		serviceNames.Insert(serviceNamesFromBackend...)
 	}
 	return serviceNames.List()
@@ -115,27 +115,33 @@ func (i *defaultReferenceIndexer) BuildIngressClassParamsRefIndexes(_ context.Co
 	return []string{ingClassParamsName}
 }
 
-func extractServiceNamesFromAction(action Action) []string {
+// extractServiceNamesFromAction extracts the referenced service names from action.
+// services in other namespaces than the Ingress's namespace are referenced as namespace/name.
+func extractServiceNamesFromAction(action Action, ingNamespace string) []string {
 	if action.Type != ActionTypeForward || action.ForwardConfig == nil {
 		return nil
 	}
 	serviceNames := sets.NewString()
 	for _, tgt := range action.ForwardConfig.TargetGroups {
-		serviceNamesFromTGT := extractServiceNamesFromTargetGroupTuple(tgt)
+		serviceNamesFromTGT := extractServiceNamesFromTargetGroupTuple(tgt, ingNamespace)
 		serviceNames.Insert(serviceNamesFromTGT...)
 	}
 	return serviceNames.List()
 }
 
-func extractServiceNamesFromTargetGroupTuple(tgt TargetGroupTuple) []string {
+func extractServiceNamesFromTargetGroupTuple(tgt TargetGroupTuple, ingNamespace string) []string {
 	if tgt.ServiceName == nil {
 		return nil
 	}
-	return []string{*tgt.ServiceName}
+	svcKey := tgt.serviceKey(ingNamespace)
+	if svcKey.Namespace != ingNamespace {
+		return []string{svcKey.String()}
+	}
+	return []string{svcKey.Name}
 }
 
 func extractSecretNamesFromAuthConfig(authCfg AuthConfig) []string {
-	if authCfg.IDPConfigOIDC == nil {
+	if authCfg.IDPConfigOIDC == nil || authCfg.IDPConfigOIDC.SecretName == "" {
 		return nil
 	}
 	return []string{authCfg.IDPConfigOIDC.SecretName}
@@ -309,12 +309,37 @@ func Test_defaultReferenceIndexer_BuildServiceRefIndexes(t *testing.T) {
 			},
 			want: []string{"svc-a", "svc-b", "svc-c"},
 		},
+		{
+			name: "Ingress - with actions annotation referencing service in other namespace",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-ns",
+						Name:      "my-ing",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/actions.error-page": `{"type":"forward","forwardConfig":{"targetGroups":[{"serviceName":"svc-b","serviceNamespace":"platform","servicePort":"80","weight":50},{"serviceName":"svc-c","serviceNamespace":"my-ns","servicePort":"80","weight":50}]}}`,
+						},
+					},
+					Spec: networking.IngressSpec{
+						DefaultBackend: &networking.IngressBackend{
+							Service: &networking.IngressServiceBackend{
+								Name: "error-page",
+								Port: networking.ServiceBackendPort{
+									Name: "use-annotation",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []string{"platform/svc-b", "svc-c"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
-			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, true, true)
+			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, true, true, false)
 			i := &defaultReferenceIndexer{
 				enhancedBackendBuilder: enhancedBackendBuilder,
 				authConfigBuilder:      authConfigBuilder,
@@ -365,7 +390,7 @@ func Test_defaultReferenceIndexer_BuildSecretRefIndexes(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			annotationParser := annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io")
 			authConfigBuilder := NewDefaultAuthConfigBuilder(annotationParser)
-			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, true, true)
+			enhancedBackendBuilder := NewDefaultEnhancedBackendBuilder(nil, annotationParser, nil, true, true, false)
 			i := &defaultReferenceIndexer{
 				enhancedBackendBuilder: enhancedBackendBuilder,
 				authConfigBuilder:      authConfigBuilder,
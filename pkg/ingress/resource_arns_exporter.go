@@ -0,0 +1,209 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// prefix of the ConfigMap name that contains the ELBv2 resource ARNs for an Ingress.
+	resourceARNsConfigMapNamePrefix = "aws-lbc-ingress-"
+
+	resourceARNsKeyLoadBalancerARN = "loadBalancerARN"
+	resourceARNsKeyListenerARNs    = "listenerARNs"
+	resourceARNsKeyTargetGroupARNs = "targetGroupARNs"
+)
+
+// ResourceARNsExporter exports the ARNs of ELBv2 resources provisioned for Ingresses,
+// so that other in-cluster consumers don't need to discover them from AWS.
+type ResourceARNsExporter interface {
+	// Export writes the ELBv2 resource ARNs from deployed stack into a ConfigMap per member Ingress.
+	Export(ctx context.Context, ingGroup Group, stack core.Stack) error
+
+	// Cleanup removes the ConfigMaps for inactive member Ingresses.
+	Cleanup(ctx context.Context, inactiveMembers []*networking.Ingress) error
+}
+
+// NewDefaultResourceARNsExporter constructs new defaultResourceARNsExporter.
+func NewDefaultResourceARNsExporter(k8sClient client.Client, logger logr.Logger) *defaultResourceARNsExporter {
+	return &defaultResourceARNsExporter{
+		k8sClient: k8sClient,
+		logger:    logger,
+	}
+}
+
+var _ ResourceARNsExporter = &defaultResourceARNsExporter{}
+
+// default implementation for ResourceARNsExporter, which writes the ARNs into a ConfigMap owned by each Ingress.
+type defaultResourceARNsExporter struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+func (e *defaultResourceARNsExporter) Export(ctx context.Context, ingGroup Group, stack core.Stack) error {
+	data, err := e.buildResourceARNsData(ctx, stack)
+	if err != nil {
+		return err
+	}
+	for _, member := range ingGroup.Members {
+		if err := e.exportForIngress(ctx, member.Ing, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *defaultResourceARNsExporter) Cleanup(ctx context.Context, inactiveMembers []*networking.Ingress) error {
+	for _, ing := range inactiveMembers {
+		cmKey := buildResourceARNsConfigMapKey(ing)
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cmKey.Namespace,
+				Name:      cmKey.Name,
+			},
+		}
+		if err := e.k8sClient.Delete(ctx, cm); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to delete resource ARNs configMap: %v", cmKey)
+		}
+		e.logger.V(1).Info("deleted resource ARNs", "ingress", k8s.NamespacedName(ing), "configMap", cmKey)
+	}
+	return nil
+}
+
+func (e *defaultResourceARNsExporter) exportForIngress(ctx context.Context, ing *networking.Ingress, data map[string]string) error {
+	cmKey := buildResourceARNsConfigMapKey(ing)
+	cm := &corev1.ConfigMap{}
+	if err := e.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       cmKey.Namespace,
+				Name:            cmKey.Name,
+				OwnerReferences: []metav1.OwnerReference{buildIngressOwnerReference(ing)},
+			},
+			Data: data,
+		}
+		if err := e.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to create resource ARNs configMap: %v", cmKey)
+		}
+		e.logger.V(1).Info("created resource ARNs", "ingress", k8s.NamespacedName(ing), "configMap", cmKey)
+		return nil
+	}
+
+	dataToUpdate, dataToRemove := algorithm.DiffStringMap(data, cm.Data)
+	if len(dataToUpdate) == 0 && len(dataToRemove) == 0 {
+		return nil
+	}
+	oldCM := cm.DeepCopy()
+	cm.Data = data
+	if err := e.k8sClient.Patch(ctx, cm, client.MergeFrom(oldCM)); err != nil {
+		return errors.Wrapf(err, "failed to update resource ARNs configMap: %v", cmKey)
+	}
+	e.logger.V(1).Info("updated resource ARNs", "ingress", k8s.NamespacedName(ing), "configMap", cmKey)
+	return nil
+}
+
+// buildResourceARNsData builds the ConfigMap data from the ELBv2 resources within deployed stack.
+// listener ARNs are keyed by listener port, and target group ARNs are keyed by target group resource ID.
+// only the ALB and its listeners are exported.
+func (e *defaultResourceARNsExporter) buildResourceARNsData(ctx context.Context, stack core.Stack) (map[string]string, error) {
+	data := make(map[string]string)
+	var resLBs []*elbv2model.LoadBalancer
+	if err := stack.ListResources(&resLBs); err != nil {
+		return nil, err
+	}
+	lbARN := ""
+	for _, resLB := range resLBs {
+		// only the ALB is exported, additional LoadBalancers like the frontend NLB are skipped.
+		if resLB.ID() != resourceIDLoadBalancer {
+			continue
+		}
+		resLBARN, err := resLB.LoadBalancerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lbARN = resLBARN
+		data[resourceARNsKeyLoadBalancerARN] = lbARN
+	}
+
+	var resLSs []*elbv2model.Listener
+	if err := stack.ListResources(&resLSs); err != nil {
+		return nil, err
+	}
+	listenerARNs := make(map[string]string, len(resLSs))
+	for _, resLS := range resLSs {
+		lsLBARN, err := resLS.Spec.LoadBalancerARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if lsLBARN != lbARN {
+			continue
+		}
+		lsARN, err := resLS.ListenerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		listenerARNs[strconv.FormatInt(resLS.Spec.Port, 10)] = lsARN
+	}
+
+	var resTGs []*elbv2model.TargetGroup
+	if err := stack.ListResources(&resTGs); err != nil {
+		return nil, err
+	}
+	tgARNs := make(map[string]string, len(resTGs))
+	for _, resTG := range resTGs {
+		tgARN, err := resTG.TargetGroupARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tgARNs[resTG.ID()] = tgARN
+	}
+
+	for key, arns := range map[string]map[string]string{
+		resourceARNsKeyListenerARNs:    listenerARNs,
+		resourceARNsKeyTargetGroupARNs: tgARNs,
+	} {
+		payload, err := json.Marshal(arns)
+		if err != nil {
+			return nil, err
+		}
+		data[key] = string(payload)
+	}
+	return data, nil
+}
+
+func buildResourceARNsConfigMapKey(ing *networking.Ingress) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: ing.Namespace,
+		Name:      fmt.Sprintf("%s%s", resourceARNsConfigMapNamePrefix, ing.Name),
+	}
+}
+
+func buildIngressOwnerReference(ing *networking.Ingress) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: networking.SchemeGroupVersion.String(),
+		Kind:       "Ingress",
+		Name:       ing.Name,
+		UID:        ing.UID,
+	}
+}
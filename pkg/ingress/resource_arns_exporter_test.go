@@ -0,0 +1,93 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultResourceARNsExporter_Export(t *testing.T) {
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "ing-1",
+			UID:       "ing-1-uid",
+		},
+	}
+	buildStack := func() core.Stack {
+		stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+		lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+		lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "lb-arn"})
+		ls := elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{LoadBalancerARN: lb.LoadBalancerARN(), Port: 80})
+		ls.SetStatus(elbv2model.ListenerStatus{ListenerARN: "ls-arn-80"})
+		tg := elbv2model.NewTargetGroup(stack, "awesome-ns/ing-1-svc-1:http", elbv2model.TargetGroupSpec{})
+		tg.SetStatus(elbv2model.TargetGroupStatus{TargetGroupARN: "tg-arn-1"})
+		return stack
+	}
+	wantData := map[string]string{
+		"loadBalancerARN": "lb-arn",
+		"listenerARNs":    `{"80":"ls-arn-80"}`,
+		"targetGroupARNs": `{"awesome-ns/ing-1-svc-1:http":"tg-arn-1"}`,
+	}
+	tests := []struct {
+		name       string
+		existingCM *corev1.ConfigMap
+	}{
+		{
+			name: "configMap not exists",
+		},
+		{
+			name: "configMap exists with stale ARNs",
+			existingCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "aws-lbc-ingress-ing-1",
+				},
+				Data: map[string]string{
+					"loadBalancerARN": "lb-arn",
+					"listenerARNs":    `{"443":"ls-arn-443"}`,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			e := NewDefaultResourceARNsExporter(k8sClient, logr.New(&log.NullLogSink{}))
+
+			ctx := context.Background()
+			if tt.existingCM != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.existingCM.DeepCopy()))
+			}
+			ingGroup := Group{
+				ID:      GroupID{Namespace: "awesome-ns", Name: "ing-1"},
+				Members: []ClassifiedIngress{{Ing: ing}},
+			}
+			assert.NoError(t, e.Export(ctx, ingGroup, buildStack()))
+
+			cmKey := types.NamespacedName{Namespace: "awesome-ns", Name: "aws-lbc-ingress-ing-1"}
+			cm := &corev1.ConfigMap{}
+			assert.NoError(t, k8sClient.Get(ctx, cmKey, cm))
+			assert.Equal(t, wantData, cm.Data)
+
+			assert.NoError(t, e.Cleanup(ctx, []*networking.Ingress{ing}))
+			err := k8sClient.Get(ctx, cmKey, &corev1.ConfigMap{})
+			assert.True(t, apierrors.IsNotFound(err))
+		})
+	}
+}
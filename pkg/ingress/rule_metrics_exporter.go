@@ -0,0 +1,418 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	metricSubsystemIngressRule  = "ingress_rule"
+	metricSubsystemIngressGroup = "ingress_group"
+
+	metricIngressRuleRequests        = "requests"
+	metricIngressRuleTargetResponses = "target_responses"
+	metricIngressGroupRuleEvaluation = "rule_evaluations"
+
+	labelNamespace    = "namespace"
+	labelIngress      = "ingress"
+	labelPath         = "path"
+	labelTargetGroup  = "target_group"
+	labelCodeClass    = "code_class"
+	labelIngressGroup = "ingress_group"
+	labelLoadBalancer = "load_balancer"
+)
+
+const (
+	cloudWatchNamespaceApplicationELB = "AWS/ApplicationELB"
+	cloudWatchDimensionLoadBalancer   = "LoadBalancer"
+	cloudWatchDimensionTargetGroup    = "TargetGroup"
+	cloudWatchMetricRequestCount      = "RequestCount"
+	cloudWatchMetricRuleEvaluations   = "RuleEvaluations"
+	cloudWatchStatisticSum            = "Sum"
+
+	// CloudWatch metrics of load balancers are aggregated per minute.
+	cloudWatchMetricsPeriod = int32(60)
+	// CloudWatch metrics are published with delay, so the latest period within lookback is exported.
+	cloudWatchMetricsLookback = 5 * time.Minute
+	// the maximum number of queries in a GetMetricData call.
+	cloudWatchMaxMetricDataQueries = 500
+)
+
+// targetResponseMetricByCodeClass is the CloudWatch metric of target responses per HTTP code class.
+var targetResponseMetricByCodeClass = map[string]string{
+	"2xx": "HTTPCode_Target_2XX_Count",
+	"3xx": "HTTPCode_Target_3XX_Count",
+	"4xx": "HTTPCode_Target_4XX_Count",
+	"5xx": "HTTPCode_Target_5XX_Count",
+}
+
+// RuleMetricsExporter re-exports the CloudWatch metrics of Ingress listener rules as Prometheus metrics,
+// labeled with the owning Ingress and path.
+// CloudWatch doesn't report metrics per listener rule, so the metrics of the target groups forwarded to by rules are exported instead.
+type RuleMetricsExporter interface {
+	// Track replaces the tracked listener rules of IngressGroup with the ones within deployed stack.
+	// cloudWatchClient must be within the AWS account that stack is deployed into.
+	Track(ctx context.Context, ingGroup Group, stack core.Stack, cloudWatchClient services.CloudWatch) error
+}
+
+// NewDefaultRuleMetricsExporter constructs new defaultRuleMetricsExporter.
+func NewDefaultRuleMetricsExporter(pollInterval time.Duration, registerer prometheus.Registerer, logger logr.Logger) (*defaultRuleMetricsExporter, error) {
+	ruleLabels := []string{labelNamespace, labelIngress, labelPath, labelTargetGroup}
+	requests := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemIngressRule,
+		Name:      metricIngressRuleRequests,
+		Help:      "Number of requests forwarded by the Ingress rule to target group during the latest minute reported by CloudWatch",
+	}, ruleLabels)
+	targetResponses := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemIngressRule,
+		Name:      metricIngressRuleTargetResponses,
+		Help:      "Number of target responses per HTTP code class for the Ingress rule during the latest minute reported by CloudWatch",
+	}, append(ruleLabels, labelCodeClass))
+	ruleEvaluations := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemIngressGroup,
+		Name:      metricIngressGroupRuleEvaluation,
+		Help:      "Number of rules evaluated by the load balancer of IngressGroup during the latest minute reported by CloudWatch",
+	}, []string{labelIngressGroup, labelLoadBalancer})
+	for name, collector := range map[string]prometheus.Collector{
+		metricIngressRuleRequests:        requests,
+		metricIngressRuleTargetResponses: targetResponses,
+		metricIngressGroupRuleEvaluation: ruleEvaluations,
+	} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, errors.Wrapf(err, "failed to register metric: %v", name)
+		}
+	}
+	return &defaultRuleMetricsExporter{
+		pollInterval:    pollInterval,
+		requests:        requests,
+		targetResponses: targetResponses,
+		ruleEvaluations: ruleEvaluations,
+		groupByID:       make(map[GroupID]ruleMetricsGroup),
+		logger:          logger,
+	}, nil
+}
+
+var _ RuleMetricsExporter = &defaultRuleMetricsExporter{}
+
+// default implementation for RuleMetricsExporter, which polls CloudWatch periodically for all tracked IngressGroups.
+type defaultRuleMetricsExporter struct {
+	pollInterval    time.Duration
+	requests        *prometheus.GaugeVec
+	targetResponses *prometheus.GaugeVec
+	ruleEvaluations *prometheus.GaugeVec
+	logger          logr.Logger
+
+	// groupByID is the tracked listener rules per IngressGroup.
+	groupByID map[GroupID]ruleMetricsGroup
+	// groupMutex protects groupByID
+	groupMutex sync.Mutex
+}
+
+// ruleMetricsGroup is the tracked listener rules of an IngressGroup.
+type ruleMetricsGroup struct {
+	cloudWatchClient services.CloudWatch
+	// lbDimension is the CloudWatch dimension of the ALB of IngressGroup.
+	lbDimension string
+	targets     []ruleMetricsTarget
+}
+
+// ruleMetricsTarget is a target group forwarded to by a listener rule of an Ingress.
+type ruleMetricsTarget struct {
+	ingKey      types.NamespacedName
+	path        string
+	tgDimension string
+}
+
+func (e *defaultRuleMetricsExporter) Track(ctx context.Context, ingGroup Group, stack core.Stack, cloudWatchClient services.CloudWatch) error {
+	group, err := e.buildRuleMetricsGroup(ctx, ingGroup, stack, cloudWatchClient)
+	if err != nil {
+		return err
+	}
+	e.groupMutex.Lock()
+	defer e.groupMutex.Unlock()
+	if group == nil {
+		delete(e.groupByID, ingGroup.ID)
+		return nil
+	}
+	e.groupByID[ingGroup.ID] = *group
+	return nil
+}
+
+// Start polls the CloudWatch metrics of tracked listener rules until ctx is done.
+func (e *defaultRuleMetricsExporter) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, e.poll, e.pollInterval)
+	return nil
+}
+
+// NeedLeaderElection returns true, as listener rules are only tracked by the leader.
+func (e *defaultRuleMetricsExporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (e *defaultRuleMetricsExporter) poll(ctx context.Context) {
+	e.groupMutex.Lock()
+	groupByID := make(map[GroupID]ruleMetricsGroup, len(e.groupByID))
+	for groupID, group := range e.groupByID {
+		groupByID[groupID] = group
+	}
+	e.groupMutex.Unlock()
+
+	e.requests.Reset()
+	e.targetResponses.Reset()
+	e.ruleEvaluations.Reset()
+	endTime := time.Now()
+	for groupID, group := range groupByID {
+		if err := e.pollGroup(ctx, groupID, group, endTime); err != nil {
+			e.logger.Error(err, "failed to poll CloudWatch metrics of listener rules", "ingressGroup", groupID)
+		}
+	}
+}
+
+// pollGroup queries the CloudWatch metrics of IngressGroup's listener rules, and sets them into Prometheus metrics.
+func (e *defaultRuleMetricsExporter) pollGroup(ctx context.Context, groupID GroupID, group ruleMetricsGroup, endTime time.Time) error {
+	var queries []cloudwatchtypes.MetricDataQuery
+	var setters []func(value float64)
+	addQuery := func(metricName string, dimensions map[string]string, setter func(value float64)) {
+		var sdkDimensions []cloudwatchtypes.Dimension
+		for _, name := range []string{cloudWatchDimensionLoadBalancer, cloudWatchDimensionTargetGroup} {
+			if value, ok := dimensions[name]; ok {
+				sdkDimensions = append(sdkDimensions, cloudwatchtypes.Dimension{
+					Name:  awssdk.String(name),
+					Value: awssdk.String(value),
+				})
+			}
+		}
+		queries = append(queries, cloudwatchtypes.MetricDataQuery{
+			Id: awssdk.String(fmt.Sprintf("q%d", len(queries))),
+			MetricStat: &cloudwatchtypes.MetricStat{
+				Metric: &cloudwatchtypes.Metric{
+					Namespace:  awssdk.String(cloudWatchNamespaceApplicationELB),
+					MetricName: awssdk.String(metricName),
+					Dimensions: sdkDimensions,
+				},
+				Period: awssdk.Int32(cloudWatchMetricsPeriod),
+				Stat:   awssdk.String(cloudWatchStatisticSum),
+			},
+		})
+		setters = append(setters, setter)
+	}
+
+	addQuery(cloudWatchMetricRuleEvaluations, map[string]string{
+		cloudWatchDimensionLoadBalancer: group.lbDimension,
+	}, func(value float64) {
+		e.ruleEvaluations.WithLabelValues(groupID.String(), group.lbDimension).Set(value)
+	})
+	for _, target := range group.targets {
+		dimensions := map[string]string{
+			cloudWatchDimensionLoadBalancer: group.lbDimension,
+			cloudWatchDimensionTargetGroup:  target.tgDimension,
+		}
+		ruleLabelValues := []string{target.ingKey.Namespace, target.ingKey.Name, target.path, target.tgDimension}
+		addQuery(cloudWatchMetricRequestCount, dimensions, func(value float64) {
+			e.requests.WithLabelValues(ruleLabelValues...).Set(value)
+		})
+		for _, codeClass := range []string{"2xx", "3xx", "4xx", "5xx"} {
+			codeClassLabelValues := append(append([]string{}, ruleLabelValues...), codeClass)
+			addQuery(targetResponseMetricByCodeClass[codeClass], dimensions, func(value float64) {
+				e.targetResponses.WithLabelValues(codeClassLabelValues...).Set(value)
+			})
+		}
+	}
+
+	for start := 0; start < len(queries); start += cloudWatchMaxMetricDataQueries {
+		end := start + cloudWatchMaxMetricDataQueries
+		if end > len(queries) {
+			end = len(queries)
+		}
+		req := &cloudwatchsdk.GetMetricDataInput{
+			MetricDataQueries: queries[start:end],
+			StartTime:         awssdk.Time(endTime.Add(-cloudWatchMetricsLookback)),
+			EndTime:           awssdk.Time(endTime),
+			ScanBy:            cloudwatchtypes.ScanByTimestampDescending,
+		}
+		results, err := group.cloudWatchClient.GetMetricDataAsList(ctx, req)
+		if err != nil {
+			return err
+		}
+		valueByQueryID := make(map[string]float64, len(results))
+		for _, result := range results {
+			// results are scanned by timestamp descending, so the first value is from the latest period.
+			if len(result.Values) != 0 {
+				valueByQueryID[awssdk.ToString(result.Id)] = result.Values[0]
+			}
+		}
+		for i := start; i < end; i++ {
+			// a metric without datapoints means there is no traffic during the lookback.
+			setters[i](valueByQueryID[awssdk.ToString(queries[i].Id)])
+		}
+	}
+	return nil
+}
+
+// buildRuleMetricsGroup builds the tracked listener rules from the ALB within deployed stack.
+// nil is returned if there is no ALB within stack.
+func (e *defaultRuleMetricsExporter) buildRuleMetricsGroup(ctx context.Context, ingGroup Group, stack core.Stack, cloudWatchClient services.CloudWatch) (*ruleMetricsGroup, error) {
+	if len(ingGroup.Members) == 0 {
+		return nil, nil
+	}
+	var resLBs []*elbv2model.LoadBalancer
+	if err := stack.ListResources(&resLBs); err != nil {
+		return nil, err
+	}
+	lbARN := ""
+	for _, resLB := range resLBs {
+		// only the ALB is tracked, additional LoadBalancers like the frontend NLB are skipped.
+		if resLB.ID() != resourceIDLoadBalancer {
+			continue
+		}
+		resLBARN, err := resLB.LoadBalancerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		lbARN = resLBARN
+	}
+	lbDimension, ok := buildCloudWatchDimensionFromARN(lbARN, "loadbalancer/")
+	if !ok {
+		return nil, nil
+	}
+	// the LoadBalancer dimension omits the resource type, unlike the TargetGroup dimension.
+	lbDimension = strings.TrimPrefix(lbDimension, "loadbalancer/")
+
+	var resLSs []*elbv2model.Listener
+	if err := stack.ListResources(&resLSs); err != nil {
+		return nil, err
+	}
+	albListenerARNs := make(map[string]struct{}, len(resLSs))
+	for _, resLS := range resLSs {
+		lsLBARN, err := resLS.Spec.LoadBalancerARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if lsLBARN != lbARN {
+			continue
+		}
+		lsARN, err := resLS.ListenerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		albListenerARNs[lsARN] = struct{}{}
+	}
+
+	var resTGs []*elbv2model.TargetGroup
+	if err := stack.ListResources(&resTGs); err != nil {
+		return nil, err
+	}
+	tgResIDByARN := make(map[string]string, len(resTGs))
+	for _, resTG := range resTGs {
+		tgARN, err := resTG.TargetGroupARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tgResIDByARN[tgARN] = resTG.ID()
+	}
+
+	var resLRs []*elbv2model.ListenerRule
+	if err := stack.ListResources(&resLRs); err != nil {
+		return nil, err
+	}
+	var targets []ruleMetricsTarget
+	targetKeys := make(map[ruleMetricsTarget]struct{})
+	for _, resLR := range resLRs {
+		lsARN, err := resLR.Spec.ListenerARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := albListenerARNs[lsARN]; !ok {
+			continue
+		}
+		path := buildRulePathLabel(resLR.Spec.Conditions)
+		for _, action := range resLR.Spec.Actions {
+			if action.Type != elbv2model.ActionTypeForward || action.ForwardConfig == nil {
+				continue
+			}
+			for _, tgt := range action.ForwardConfig.TargetGroups {
+				tgARN, err := tgt.TargetGroupARN.Resolve(ctx)
+				if err != nil {
+					return nil, err
+				}
+				tgDimension, ok := buildCloudWatchDimensionFromARN(tgARN, "targetgroup/")
+				if !ok {
+					continue
+				}
+				// target groups that are not built from Ingress backends(e.g. specified by ARN) are skipped.
+				ingKey, ok := findTargetGroupOwnerIngress(ingGroup, tgResIDByARN[tgARN])
+				if !ok {
+					continue
+				}
+				target := ruleMetricsTarget{
+					ingKey:      ingKey,
+					path:        path,
+					tgDimension: tgDimension,
+				}
+				if _, exists := targetKeys[target]; exists {
+					continue
+				}
+				targetKeys[target] = struct{}{}
+				targets = append(targets, target)
+			}
+		}
+	}
+	return &ruleMetricsGroup{
+		cloudWatchClient: cloudWatchClient,
+		lbDimension:      lbDimension,
+		targets:          targets,
+	}, nil
+}
+
+// buildRulePathLabel builds the path label from the path-pattern conditions of listener rule.
+func buildRulePathLabel(conditions []elbv2model.RuleCondition) string {
+	var paths []string
+	for _, condition := range conditions {
+		if condition.Field == elbv2model.RuleConditionFieldPathPattern && condition.PathPatternConfig != nil {
+			paths = append(paths, condition.PathPatternConfig.Values...)
+		}
+	}
+	return strings.Join(paths, ",")
+}
+
+// findTargetGroupOwnerIngress finds the member Ingress that target group is built for, by the target group's resource ID.
+// the resource ID is prefixed with the Ingress's namespace and name, the longest matching prefix wins.
+func findTargetGroupOwnerIngress(ingGroup Group, tgResID string) (types.NamespacedName, bool) {
+	var ownerKey types.NamespacedName
+	ownerPrefixLen := 0
+	for _, member := range ingGroup.Members {
+		ingKey := k8s.NamespacedName(member.Ing)
+		prefix := fmt.Sprintf("%s/%s-", ingKey.Namespace, ingKey.Name)
+		if strings.HasPrefix(tgResID, prefix) && len(prefix) > ownerPrefixLen {
+			ownerKey = ingKey
+			ownerPrefixLen = len(prefix)
+		}
+	}
+	return ownerKey, ownerPrefixLen != 0
+}
+
+// buildCloudWatchDimensionFromARN builds the CloudWatch dimension value of ELBv2 resource from its ARN,
+// which is the ARN's resource part starting with resourceTypePrefix.
+func buildCloudWatchDimensionFromARN(arn string, resourceTypePrefix string) (string, bool) {
+	idx := strings.Index(arn, ":"+resourceTypePrefix)
+	if idx < 0 {
+		return "", false
+	}
+	return arn[idx+1:], true
+}
@@ -0,0 +1,182 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchsdk "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeCloudWatch returns the configured value per metric name for GetMetricData queries.
+type fakeCloudWatch struct {
+	services.CloudWatch
+	valueByMetricName map[string]float64
+	err               error
+	requests          []*cloudwatchsdk.GetMetricDataInput
+}
+
+func (c *fakeCloudWatch) GetMetricDataAsList(_ context.Context, input *cloudwatchsdk.GetMetricDataInput) ([]cloudwatchtypes.MetricDataResult, error) {
+	c.requests = append(c.requests, input)
+	if c.err != nil {
+		return nil, c.err
+	}
+	var results []cloudwatchtypes.MetricDataResult
+	for _, query := range input.MetricDataQueries {
+		result := cloudwatchtypes.MetricDataResult{Id: query.Id}
+		if value, ok := c.valueByMetricName[awssdk.ToString(query.MetricStat.Metric.MetricName)]; ok {
+			result.Values = []float64{value, value * 2}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func Test_defaultRuleMetricsExporter_Track(t *testing.T) {
+	newIngress := func(name string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      name,
+			},
+		}
+	}
+	ingGroup := Group{
+		ID:      GroupID{Name: "awesome-group"},
+		Members: []ClassifiedIngress{{Ing: newIngress("app")}, {Ing: newIngress("app-admin")}},
+	}
+	buildStack := func() core.Stack {
+		stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+		lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+		lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/k8s-awesome/1234"})
+		ls := elbv2model.NewListener(stack, "80", elbv2model.ListenerSpec{LoadBalancerARN: lb.LoadBalancerARN(), Port: 80})
+		ls.SetStatus(elbv2model.ListenerStatus{ListenerARN: "ls-arn-80"})
+		tgApp := elbv2model.NewTargetGroup(stack, "awesome-ns/app-svc-app:http", elbv2model.TargetGroupSpec{})
+		tgApp.SetStatus(elbv2model.TargetGroupStatus{TargetGroupARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/k8s-app/5678"})
+		tgAdmin := elbv2model.NewTargetGroup(stack, "awesome-ns/app-admin-svc-admin:http", elbv2model.TargetGroupSpec{})
+		tgAdmin.SetStatus(elbv2model.TargetGroupStatus{TargetGroupARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/k8s-admin/9012"})
+		forwardTo := func(tgARNs ...core.StringToken) []elbv2model.Action {
+			var tgs []elbv2model.TargetGroupTuple
+			for _, tgARN := range tgARNs {
+				tgs = append(tgs, elbv2model.TargetGroupTuple{TargetGroupARN: tgARN})
+			}
+			return []elbv2model.Action{{Type: elbv2model.ActionTypeForward, ForwardConfig: &elbv2model.ForwardActionConfig{TargetGroups: tgs}}}
+		}
+		pathIs := func(paths ...string) []elbv2model.RuleCondition {
+			return []elbv2model.RuleCondition{{
+				Field:             elbv2model.RuleConditionFieldPathPattern,
+				PathPatternConfig: &elbv2model.PathPatternConditionConfig{Values: paths},
+			}}
+		}
+		elbv2model.NewListenerRule(stack, "80:1", elbv2model.ListenerRuleSpec{
+			ListenerARN: ls.ListenerARN(),
+			Priority:    1,
+			Actions:     forwardTo(tgAdmin.TargetGroupARN()),
+			Conditions:  pathIs("/admin", "/admin/*"),
+		})
+		elbv2model.NewListenerRule(stack, "80:2", elbv2model.ListenerRuleSpec{
+			ListenerARN: ls.ListenerARN(),
+			Priority:    2,
+			Actions:     forwardTo(tgApp.TargetGroupARN(), core.LiteralStringToken("arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/external/3456")),
+			Conditions:  pathIs("/*"),
+		})
+		return stack
+	}
+
+	tests := []struct {
+		name                  string
+		cloudWatchClient      *fakeCloudWatch
+		wantRequests          map[string]float64
+		wantTargetResponses   map[string]float64
+		wantRuleEvaluations   float64
+		wantMetricSeriesCount int
+	}{
+		{
+			name: "latest datapoints are exported per Ingress rule",
+			cloudWatchClient: &fakeCloudWatch{
+				valueByMetricName: map[string]float64{
+					"RequestCount":              10,
+					"HTTPCode_Target_2XX_Count": 8,
+					"HTTPCode_Target_5XX_Count": 2,
+					"RuleEvaluations":           20,
+				},
+			},
+			wantRequests: map[string]float64{
+				"app":       10,
+				"app-admin": 10,
+			},
+			wantTargetResponses: map[string]float64{
+				"2xx": 8,
+				"3xx": 0,
+				"4xx": 0,
+				"5xx": 2,
+			},
+			wantRuleEvaluations:   20,
+			wantMetricSeriesCount: 2 + 2*4 + 1,
+		},
+		{
+			name: "no metrics are exported when CloudWatch fails",
+			cloudWatchClient: &fakeCloudWatch{
+				err: errors.New("some error"),
+			},
+			wantMetricSeriesCount: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := NewDefaultRuleMetricsExporter(time.Minute, prometheus.NewPedanticRegistry(), logr.New(&log.NullLogSink{}))
+			assert.NoError(t, err)
+
+			ctx := context.Background()
+			assert.NoError(t, e.Track(ctx, ingGroup, buildStack(), tt.cloudWatchClient))
+			e.poll(ctx)
+
+			assert.Len(t, tt.cloudWatchClient.requests, 1)
+			assert.Len(t, tt.cloudWatchClient.requests[0].MetricDataQueries, 1+2*5)
+			gotSeriesCount := testutil.CollectAndCount(e.requests) + testutil.CollectAndCount(e.targetResponses) + testutil.CollectAndCount(e.ruleEvaluations)
+			assert.Equal(t, tt.wantMetricSeriesCount, gotSeriesCount)
+			if tt.wantMetricSeriesCount == 0 {
+				return
+			}
+			assert.Equal(t, tt.wantRequests["app"], testutil.ToFloat64(e.requests.WithLabelValues("awesome-ns", "app", "/*", "targetgroup/k8s-app/5678")))
+			assert.Equal(t, tt.wantRequests["app-admin"], testutil.ToFloat64(e.requests.WithLabelValues("awesome-ns", "app-admin", "/admin,/admin/*", "targetgroup/k8s-admin/9012")))
+			for codeClass, want := range tt.wantTargetResponses {
+				assert.Equal(t, want, testutil.ToFloat64(e.targetResponses.WithLabelValues("awesome-ns", "app", "/*", "targetgroup/k8s-app/5678", codeClass)))
+			}
+			assert.Equal(t, tt.wantRuleEvaluations, testutil.ToFloat64(e.ruleEvaluations.WithLabelValues("awesome-group", "app/k8s-awesome/1234")))
+		})
+	}
+}
+
+func Test_defaultRuleMetricsExporter_Track_untrack(t *testing.T) {
+	e, err := NewDefaultRuleMetricsExporter(time.Minute, prometheus.NewPedanticRegistry(), logr.New(&log.NullLogSink{}))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	stack := core.NewDefaultStack(core.StackID{Name: "awesome-group"})
+	lb := elbv2model.NewLoadBalancer(stack, "LoadBalancer", elbv2model.LoadBalancerSpec{})
+	lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/k8s-awesome/1234"})
+	ingGroup := Group{
+		ID:      GroupID{Name: "awesome-group"},
+		Members: []ClassifiedIngress{{Ing: &networking.Ingress{ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "app"}}}},
+	}
+	assert.NoError(t, e.Track(ctx, ingGroup, stack, &fakeCloudWatch{}))
+	assert.Len(t, e.groupByID, 1)
+
+	ingGroup.Members = nil
+	assert.NoError(t, e.Track(ctx, ingGroup, core.NewDefaultStack(core.StackID{Name: "awesome-group"}), &fakeCloudWatch{}))
+	assert.Len(t, e.groupByID, 0)
+}
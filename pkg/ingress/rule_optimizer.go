@@ -13,6 +13,8 @@ type Rule struct {
 	Conditions []elbv2model.RuleCondition
 	Actions    []elbv2model.Action
 	Tags       map[string]string
+	// Priority is the explicit priority of rule, or nil if the priority is allocated implicitly.
+	Priority *int64
 }
 
 // RuleOptimizer will optimize the listener Rules for a single Listener.
@@ -1,16 +1,65 @@
 package inject
 
-import "github.com/spf13/pflag"
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
+)
 
 const (
-	flagEnablePodReadinessGateInject = "enable-pod-readiness-gate-inject"
+	flagEnablePodReadinessGateInject                         = "enable-pod-readiness-gate-inject"
+	flagPodReadinessGateInjectNamespaceSelector              = "pod-readiness-gate-inject-namespace-selector"
+	flagPodReadinessGateInjectExcludedNamespaceSelector      = "pod-readiness-gate-inject-excluded-namespace-selector"
+	flagPodReadinessGateInjectRequireTargetGroupBindingOptIn = "pod-readiness-gate-inject-require-tgb-opt-in"
 )
 
 type Config struct {
 	EnablePodReadinessGateInject bool
+	// PodReadinessGateInjectNamespaceSelector is the label selector for namespaces where readiness gates are injected.
+	PodReadinessGateInjectNamespaceSelector string
+	// PodReadinessGateInjectExcludedNamespaceSelector is the label selector for namespaces where readiness gates are never injected.
+	PodReadinessGateInjectExcludedNamespaceSelector string
+	// PodReadinessGateInjectRequireTargetGroupBindingOptIn denotes if only TargetGroupBindings opted in via annotation get readiness gates injected.
+	PodReadinessGateInjectRequireTargetGroupBindingOptIn bool
 }
 
 func (cfg *Config) BindFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&cfg.EnablePodReadinessGateInject, flagEnablePodReadinessGateInject, true,
 		`If enabled, targetHealth readiness gate will get injected to the pod spec for the matching endpoint pods`)
+	fs.StringVar(&cfg.PodReadinessGateInjectNamespaceSelector, flagPodReadinessGateInjectNamespaceSelector, "",
+		`Label selector for namespaces where targetHealth readiness gate will get injected, defaults to all namespaces`)
+	fs.StringVar(&cfg.PodReadinessGateInjectExcludedNamespaceSelector, flagPodReadinessGateInjectExcludedNamespaceSelector, "",
+		`Label selector for namespaces where targetHealth readiness gate will not get injected`)
+	fs.BoolVar(&cfg.PodReadinessGateInjectRequireTargetGroupBindingOptIn, flagPodReadinessGateInjectRequireTargetGroupBindingOptIn, false,
+		`If enabled, targetHealth readiness gate will only get injected for TargetGroupBindings with the opt-in annotation`)
+}
+
+// Validate the pod webhook configuration
+func (cfg *Config) Validate() error {
+	if _, _, err := cfg.buildNamespaceSelectors(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// buildNamespaceSelectors parses the included and excluded namespace selectors.
+// the included selector matches everything if unspecified, and the excluded selector matches nothing if unspecified.
+func (cfg *Config) buildNamespaceSelectors() (labels.Selector, labels.Selector, error) {
+	includedSelector := labels.Everything()
+	if len(cfg.PodReadinessGateInjectNamespaceSelector) != 0 {
+		selector, err := labels.Parse(cfg.PodReadinessGateInjectNamespaceSelector)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid value %v for %v flag", cfg.PodReadinessGateInjectNamespaceSelector, flagPodReadinessGateInjectNamespaceSelector)
+		}
+		includedSelector = selector
+	}
+	excludedSelector := labels.Nothing()
+	if len(cfg.PodReadinessGateInjectExcludedNamespaceSelector) != 0 {
+		selector, err := labels.Parse(cfg.PodReadinessGateInjectExcludedNamespaceSelector)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid value %v for %v flag", cfg.PodReadinessGateInjectExcludedNamespaceSelector, flagPodReadinessGateInjectExcludedNamespaceSelector)
+		}
+		excludedSelector = selector
+	}
+	return includedSelector, excludedSelector, nil
 }
@@ -0,0 +1,51 @@
+package inject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr string
+	}{
+		{
+			name: "namespace selectors unspecified",
+			cfg:  Config{},
+		},
+		{
+			name: "valid namespace selectors",
+			cfg: Config{
+				PodReadinessGateInjectNamespaceSelector:         "team in (team-a, team-b)",
+				PodReadinessGateInjectExcludedNamespaceSelector: "env=dev",
+			},
+		},
+		{
+			name: "invalid namespace selector",
+			cfg: Config{
+				PodReadinessGateInjectNamespaceSelector: "team in team-a",
+			},
+			wantErr: "invalid value team in team-a for pod-readiness-gate-inject-namespace-selector flag",
+		},
+		{
+			name: "invalid excluded namespace selector",
+			cfg: Config{
+				PodReadinessGateInjectExcludedNamespaceSelector: "env in (dev",
+			},
+			wantErr: "invalid value env in (dev for pod-readiness-gate-inject-excluded-namespace-selector flag",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
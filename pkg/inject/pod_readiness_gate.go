@@ -16,6 +16,13 @@ import (
 	"strings"
 )
 
+const (
+	// annotation to opt-out pods or opt-in TargetGroupBindings for targetHealth readiness gate injection.
+	annotationPodReadinessGateInject = "elbv2.k8s.aws/pod-readiness-gate-inject"
+	podReadinessGateInjectEnabled    = "enabled"
+	podReadinessGateInjectDisabled   = "disabled"
+)
+
 // NewPodReadinessGate constructs new PodReadinessGate
 func NewPodReadinessGate(config Config, k8sClient client.Client, logger logr.Logger) *PodReadinessGate {
 	return &PodReadinessGate{
@@ -38,9 +45,19 @@ func (m *PodReadinessGate) Mutate(ctx context.Context, pod *corev1.Pod) error {
 	if !m.config.EnablePodReadinessGateInject {
 		return nil
 	}
+	if pod.Annotations[annotationPodReadinessGateInject] == podReadinessGateInjectDisabled {
+		return nil
+	}
 
 	// see https://github.com/kubernetes/kubernetes/issues/88282 and https://github.com/kubernetes/kubernetes/issues/76680
 	req := webhook.ContextGetAdmissionRequest(ctx)
+	namespaceSelected, err := m.isNamespaceSelected(ctx, req.Namespace)
+	if err != nil {
+		return err
+	}
+	if !namespaceSelected {
+		return nil
+	}
 	targetHealthCondTypes, err := m.computeTargetHealthReadinessGateConditionTypes(ctx, req.Namespace, pod)
 	if err != nil {
 		return err
@@ -73,6 +90,9 @@ func (m *PodReadinessGate) computeTargetHealthReadinessGateConditionTypes(ctx co
 		if tgb.Spec.TargetType == nil || (*tgb.Spec.TargetType) != elbv2api.TargetTypeIP {
 			continue
 		}
+		if m.config.PodReadinessGateInjectRequireTargetGroupBindingOptIn && tgb.Annotations[annotationPodReadinessGateInject] != podReadinessGateInjectEnabled {
+			continue
+		}
 
 		svcKey := types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.ServiceRef.Name}
 		svc := &corev1.Service{}
@@ -98,6 +118,23 @@ func (m *PodReadinessGate) computeTargetHealthReadinessGateConditionTypes(ctx co
 	return targetHealthCondTypes, nil
 }
 
+// isNamespaceSelected checks whether targetHealth readiness gates should be injected for pods in namespace.
+func (m *PodReadinessGate) isNamespaceSelected(ctx context.Context, namespace string) (bool, error) {
+	if len(m.config.PodReadinessGateInjectNamespaceSelector) == 0 && len(m.config.PodReadinessGateInjectExcludedNamespaceSelector) == 0 {
+		return true, nil
+	}
+	includedSelector, excludedSelector, err := m.config.buildNamespaceSelectors()
+	if err != nil {
+		return false, err
+	}
+	ns := &corev1.Namespace{}
+	if err := m.k8sClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return false, errors.Wrap(err, "unable to determine targetHealth readinessGates")
+	}
+	nsLabels := labels.Set(ns.Labels)
+	return includedSelector.Matches(nsLabels) && !excludedSelector.Matches(nsLabels), nil
+}
+
 // removeLegacyTargetHealthReadinessGates removes existing legacy targetHealth readiness gates.
 func (m *PodReadinessGate) removeLegacyTargetHealthReadinessGates(_ context.Context, pod *corev1.Pod) {
 	var modifiedReadinessGates []corev1.PodReadinessGate
@@ -102,6 +102,30 @@ func Test_PodReadinessGate_Mutate(t *testing.T) {
 			},
 		},
 	}
+	tgb6 := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "tgb-6-l6qw6",
+			Namespace: testNS1,
+			Annotations: map[string]string{
+				"elbv2.k8s.aws/pod-readiness-gate-inject": "enabled",
+			},
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetType: &targetTypeIP,
+			ServiceRef: elbv2api.ServiceReference{
+				Name: svc1.Name,
+			},
+		},
+	}
+	ns1 := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testNS1,
+			Labels: map[string]string{
+				"team": "team-1",
+				"env":  "prod",
+			},
+		},
+	}
 	tgb5 := &elbv2api.TargetGroupBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "tgb-5-l6qw5",
@@ -116,14 +140,15 @@ func Test_PodReadinessGate_Mutate(t *testing.T) {
 	}
 
 	tests := []struct {
-		name      string
-		namespace string
-		services  []*corev1.Service
-		tgbList   []*elbv2api.TargetGroupBinding
-		pod       *corev1.Pod
-		want      []corev1.PodReadinessGate
-		config    Config
-		wantError bool
+		name       string
+		namespace  string
+		namespaces []*corev1.Namespace
+		services   []*corev1.Service
+		tgbList    []*elbv2api.TargetGroupBinding
+		pod        *corev1.Pod
+		want       []corev1.PodReadinessGate
+		config     Config
+		wantError  bool
 	}{
 		{
 			name:      "matching tgb with ip targetType",
@@ -255,6 +280,134 @@ func Test_PodReadinessGate_Mutate(t *testing.T) {
 				EnablePodReadinessGateInject: true,
 			},
 		},
+		{
+			name:      "pod opted out",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb1},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+					Annotations: map[string]string{
+						"elbv2.k8s.aws/pod-readiness-gate-inject": "disabled",
+					},
+				},
+			},
+			want: nil,
+			config: Config{
+				EnablePodReadinessGateInject: true,
+			},
+		},
+		{
+			name:       "namespace matches namespace selector",
+			namespace:  testNS1,
+			namespaces: []*corev1.Namespace{ns1},
+			services:   []*corev1.Service{svc1},
+			tgbList:    []*elbv2api.TargetGroupBinding{tgb1},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want: []corev1.PodReadinessGate{
+				{
+					ConditionType: "target-health.elbv2.k8s.aws/tgb-1-l6qw1",
+				},
+			},
+			config: Config{
+				EnablePodReadinessGateInject:                    true,
+				PodReadinessGateInjectNamespaceSelector:         "team in (team-1, team-2)",
+				PodReadinessGateInjectExcludedNamespaceSelector: "env=dev",
+			},
+		},
+		{
+			name:       "namespace doesn't match namespace selector",
+			namespace:  testNS1,
+			namespaces: []*corev1.Namespace{ns1},
+			services:   []*corev1.Service{svc1},
+			tgbList:    []*elbv2api.TargetGroupBinding{tgb1},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want: nil,
+			config: Config{
+				EnablePodReadinessGateInject:            true,
+				PodReadinessGateInjectNamespaceSelector: "team=team-2",
+			},
+		},
+		{
+			name:       "namespace matches excluded namespace selector",
+			namespace:  testNS1,
+			namespaces: []*corev1.Namespace{ns1},
+			services:   []*corev1.Service{svc1},
+			tgbList:    []*elbv2api.TargetGroupBinding{tgb1},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want: nil,
+			config: Config{
+				EnablePodReadinessGateInject:                    true,
+				PodReadinessGateInjectExcludedNamespaceSelector: "env=prod",
+			},
+		},
+		{
+			name:      "namespace not found with namespace selector",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb1},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			config: Config{
+				EnablePodReadinessGateInject:            true,
+				PodReadinessGateInjectNamespaceSelector: "team=team-1",
+			},
+			wantError: true,
+		},
+		{
+			name:      "only tgb opted in when opt-in required",
+			namespace: testNS1,
+			services:  []*corev1.Service{svc1},
+			tgbList:   []*elbv2api.TargetGroupBinding{tgb1, tgb6},
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"app": "app-1",
+						"svc": "svc1",
+					},
+				},
+			},
+			want: []corev1.PodReadinessGate{
+				{
+					ConditionType: "target-health.elbv2.k8s.aws/tgb-6-l6qw6",
+				},
+			},
+			config: Config{
+				EnablePodReadinessGateInject:                         true,
+				PodReadinessGateInjectRequireTargetGroupBindingOptIn: true,
+			},
+		},
 		{
 			name:      "remove related old readiness gates",
 			namespace: testNS1,
@@ -347,6 +500,9 @@ func Test_PodReadinessGate_Mutate(t *testing.T) {
 			clientgoscheme.AddToScheme(k8sSchema)
 			elbv2api.AddToScheme(k8sSchema)
 			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			for _, ns := range tt.namespaces {
+				assert.NoError(t, k8sClient.Create(ctx, ns.DeepCopy()))
+			}
 			for _, svc := range tt.services {
 				assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
 			}
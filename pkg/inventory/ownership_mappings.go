@@ -0,0 +1,60 @@
+package inventory
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// OwnerKindIngress is the kind of Ingresses owning load balancers.
+	OwnerKindIngress = "Ingress"
+	// OwnerKindService is the kind of Services owning load balancers and target groups.
+	OwnerKindService = "Service"
+)
+
+// BuildOwnershipMappings builds the ownership mappings of the AWS resources within deployed stack.
+// the load balancers are owned by owners of ownerKind, and the target groups by the Services they're bound to.
+func BuildOwnershipMappings(ctx context.Context, stack core.Stack, ownerKind string, owners []types.NamespacedName) ([]OwnershipMapping, error) {
+	var mappings []OwnershipMapping
+	var resLBs []*elbv2model.LoadBalancer
+	if err := stack.ListResources(&resLBs); err != nil {
+		return nil, err
+	}
+	for _, resLB := range resLBs {
+		lbARN, err := resLB.LoadBalancerARN().Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, owner := range owners {
+			mappings = append(mappings, OwnershipMapping{
+				ResourceARN:    lbARN,
+				ResourceType:   ResourceTypeLoadBalancer,
+				OwnerKind:      ownerKind,
+				OwnerNamespace: owner.Namespace,
+				OwnerName:      owner.Name,
+			})
+		}
+	}
+
+	var resTGBs []*elbv2model.TargetGroupBindingResource
+	if err := stack.ListResources(&resTGBs); err != nil {
+		return nil, err
+	}
+	for _, resTGB := range resTGBs {
+		tgARN, err := resTGB.Spec.Template.Spec.TargetGroupARN.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, OwnershipMapping{
+			ResourceARN:    tgARN,
+			ResourceType:   ResourceTypeTargetGroup,
+			OwnerKind:      OwnerKindService,
+			OwnerNamespace: resTGB.Spec.Template.Namespace,
+			OwnerName:      resTGB.Spec.Template.Spec.ServiceRef.Name,
+		})
+	}
+	return mappings, nil
+}
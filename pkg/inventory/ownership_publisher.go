@@ -0,0 +1,255 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+const (
+	// ownershipEventSource is the source of ownership events published to EventBridge.
+	ownershipEventSource = "aws-load-balancer-controller"
+	// ownershipEventDetailType is the detail type of ownership events published to EventBridge.
+	ownershipEventDetailType = "Resource Ownership Change"
+	// the max number of entries per PutEvents or PublishBatch call.
+	ownershipEventsBatchSize = 10
+
+	snsMessageAttributeResourceType = "resourceType"
+	snsMessageAttributeAction       = "action"
+)
+
+// ResourceType is the type of AWS resources whose ownership is published.
+type ResourceType string
+
+const (
+	ResourceTypeLoadBalancer ResourceType = "LoadBalancer"
+	ResourceTypeTargetGroup  ResourceType = "TargetGroup"
+)
+
+// OwnershipAction is the change of an OwnershipMapping.
+type OwnershipAction string
+
+const (
+	OwnershipActionAssociated    OwnershipAction = "Associated"
+	OwnershipActionDisassociated OwnershipAction = "Disassociated"
+)
+
+// OwnershipMapping maps an AWS resource to a Kubernetes object owning it.
+type OwnershipMapping struct {
+	ResourceARN    string       `json:"resourceARN"`
+	ResourceType   ResourceType `json:"resourceType"`
+	OwnerKind      string       `json:"ownerKind"`
+	OwnerNamespace string       `json:"ownerNamespace,omitempty"`
+	OwnerName      string       `json:"ownerName"`
+}
+
+// OwnershipRecord is the record published on the change of an OwnershipMapping.
+type OwnershipRecord struct {
+	OwnershipMapping
+	ClusterName string          `json:"clusterName"`
+	Action      OwnershipAction `json:"action"`
+	Time        time.Time       `json:"time"`
+}
+
+// OwnershipPublisher is responsible for publishing the ownership of managed AWS resources,
+// so that external inventories can map them to Kubernetes objects without scraping tags.
+type OwnershipPublisher interface {
+	// Publish publishes the changes of the ownership mappings of stack since the last publication.
+	// empty mappings disassociate all resources of stack, e.g. once its resources are deleted.
+	Publish(ctx context.Context, stackID core.StackID, mappings []OwnershipMapping) error
+}
+
+// NewDefaultOwnershipPublisher constructs new defaultOwnershipPublisher.
+// the records are published to the EventBridge eventBus and the SNS topicARN, each of them is optional.
+func NewDefaultOwnershipPublisher(clusterName string, eventBridgeClient services.EventBridge, eventBus string,
+	snsClient services.SNS, topicARN string, logger logr.Logger) *defaultOwnershipPublisher {
+	return &defaultOwnershipPublisher{
+		clusterName:       clusterName,
+		eventBridgeClient: eventBridgeClient,
+		eventBus:          eventBus,
+		snsClient:         snsClient,
+		topicARN:          topicARN,
+		logger:            logger,
+		now:               time.Now,
+		mappingsByStack:   make(map[core.StackID]map[OwnershipMapping]struct{}),
+	}
+}
+
+var _ OwnershipPublisher = &defaultOwnershipPublisher{}
+
+// default implementation for OwnershipPublisher.
+// the published mappings are kept in memory, so all mappings are published as associated again after restarts.
+type defaultOwnershipPublisher struct {
+	clusterName       string
+	eventBridgeClient services.EventBridge
+	eventBus          string
+	snsClient         services.SNS
+	topicARN          string
+	logger            logr.Logger
+	now               func() time.Time
+
+	// mappingsByStack is the published mappings per stack.
+	mappingsByStack map[core.StackID]map[OwnershipMapping]struct{}
+	// mutex protects mappingsByStack.
+	mutex sync.Mutex
+}
+
+func (p *defaultOwnershipPublisher) Publish(ctx context.Context, stackID core.StackID, mappings []OwnershipMapping) error {
+	desiredMappings := make(map[OwnershipMapping]struct{}, len(mappings))
+	for _, mapping := range mappings {
+		desiredMappings[mapping] = struct{}{}
+	}
+	p.mutex.Lock()
+	publishedMappings := p.mappingsByStack[stackID]
+	p.mutex.Unlock()
+
+	now := p.now()
+	var records []OwnershipRecord
+	for mapping := range desiredMappings {
+		if _, published := publishedMappings[mapping]; !published {
+			records = append(records, p.buildOwnershipRecord(mapping, OwnershipActionAssociated, now))
+		}
+	}
+	for mapping := range publishedMappings {
+		if _, desired := desiredMappings[mapping]; !desired {
+			records = append(records, p.buildOwnershipRecord(mapping, OwnershipActionDisassociated, now))
+		}
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	sortOwnershipRecords(records)
+	if err := p.publishToEventBridge(ctx, records); err != nil {
+		return errors.Wrap(err, "failed to publish ownership records to EventBridge")
+	}
+	if err := p.publishToSNS(ctx, records); err != nil {
+		return errors.Wrap(err, "failed to publish ownership records to SNS")
+	}
+	p.logger.Info("published ownership records", "stackID", stackID, "count", len(records))
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(desiredMappings) == 0 {
+		delete(p.mappingsByStack, stackID)
+	} else {
+		p.mappingsByStack[stackID] = desiredMappings
+	}
+	return nil
+}
+
+func (p *defaultOwnershipPublisher) publishToEventBridge(ctx context.Context, records []OwnershipRecord) error {
+	if p.eventBus == "" {
+		return nil
+	}
+	for start := 0; start < len(records); start += ownershipEventsBatchSize {
+		end := start + ownershipEventsBatchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		req := &eventbridge.PutEventsInput{}
+		for _, record := range records[start:end] {
+			detail, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			req.Entries = append(req.Entries, eventbridgetypes.PutEventsRequestEntry{
+				EventBusName: awssdk.String(p.eventBus),
+				Source:       awssdk.String(ownershipEventSource),
+				DetailType:   awssdk.String(ownershipEventDetailType),
+				Detail:       awssdk.String(string(detail)),
+				Resources:    []string{record.ResourceARN},
+				Time:         awssdk.Time(record.Time),
+			})
+		}
+		resp, err := p.eventBridgeClient.PutEventsWithContext(ctx, req)
+		if err != nil {
+			return err
+		}
+		if failedCount := resp.FailedEntryCount; failedCount > 0 {
+			return errors.Errorf("%v of %v events failed", failedCount, len(req.Entries))
+		}
+	}
+	return nil
+}
+
+func (p *defaultOwnershipPublisher) publishToSNS(ctx context.Context, records []OwnershipRecord) error {
+	if p.topicARN == "" {
+		return nil
+	}
+	for start := 0; start < len(records); start += ownershipEventsBatchSize {
+		end := start + ownershipEventsBatchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		req := &sns.PublishBatchInput{
+			TopicArn: awssdk.String(p.topicARN),
+		}
+		for i, record := range records[start:end] {
+			message, err := json.Marshal(record)
+			if err != nil {
+				return err
+			}
+			req.PublishBatchRequestEntries = append(req.PublishBatchRequestEntries, snstypes.PublishBatchRequestEntry{
+				Id:      awssdk.String(strconv.Itoa(i)),
+				Message: awssdk.String(string(message)),
+				MessageAttributes: map[string]snstypes.MessageAttributeValue{
+					snsMessageAttributeResourceType: {
+						DataType:    awssdk.String("String"),
+						StringValue: awssdk.String(string(record.ResourceType)),
+					},
+					snsMessageAttributeAction: {
+						DataType:    awssdk.String("String"),
+						StringValue: awssdk.String(string(record.Action)),
+					},
+				},
+			})
+		}
+		resp, err := p.snsClient.PublishBatchWithContext(ctx, req)
+		if err != nil {
+			return err
+		}
+		if len(resp.Failed) > 0 {
+			return errors.Errorf("%v of %v messages failed: %v", len(resp.Failed), len(req.PublishBatchRequestEntries),
+				awssdk.ToString(resp.Failed[0].Message))
+		}
+	}
+	return nil
+}
+
+func (p *defaultOwnershipPublisher) buildOwnershipRecord(mapping OwnershipMapping, action OwnershipAction, now time.Time) OwnershipRecord {
+	return OwnershipRecord{
+		OwnershipMapping: mapping,
+		ClusterName:      p.clusterName,
+		Action:           action,
+		Time:             now,
+	}
+}
+
+// sortOwnershipRecords sorts the disassociations before the associations, so that consumers see a resource changing owner in order.
+func sortOwnershipRecords(records []OwnershipRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Action != records[j].Action {
+			return records[i].Action == OwnershipActionDisassociated
+		}
+		if records[i].ResourceARN != records[j].ResourceARN {
+			return records[i].ResourceARN < records[j].ResourceARN
+		}
+		if records[i].OwnerNamespace != records[j].OwnerNamespace {
+			return records[i].OwnerNamespace < records[j].OwnerNamespace
+		}
+		return records[i].OwnerName < records[j].OwnerName
+	})
+}
@@ -0,0 +1,167 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeEventBridge records the published events in memory.
+type fakeEventBridge struct {
+	services.EventBridge
+
+	records []OwnershipRecord
+	calls   int
+}
+
+func (c *fakeEventBridge) PutEventsWithContext(_ context.Context, input *eventbridge.PutEventsInput) (*eventbridge.PutEventsOutput, error) {
+	c.calls++
+	for _, entry := range input.Entries {
+		var record OwnershipRecord
+		if err := json.Unmarshal([]byte(awssdk.ToString(entry.Detail)), &record); err != nil {
+			return nil, err
+		}
+		c.records = append(c.records, record)
+	}
+	return &eventbridge.PutEventsOutput{}, nil
+}
+
+// fakeSNS records the published messages in memory.
+type fakeSNS struct {
+	services.SNS
+
+	records []OwnershipRecord
+	calls   int
+}
+
+func (c *fakeSNS) PublishBatchWithContext(_ context.Context, input *sns.PublishBatchInput) (*sns.PublishBatchOutput, error) {
+	c.calls++
+	for _, entry := range input.PublishBatchRequestEntries {
+		var record OwnershipRecord
+		if err := json.Unmarshal([]byte(awssdk.ToString(entry.Message)), &record); err != nil {
+			return nil, err
+		}
+		c.records = append(c.records, record)
+	}
+	return &sns.PublishBatchOutput{}, nil
+}
+
+func Test_defaultOwnershipPublisher_Publish(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	stackID := core.StackID{Name: "awesome-group"}
+	lbMapping := func(owner string) OwnershipMapping {
+		return OwnershipMapping{
+			ResourceARN:    "lb-arn",
+			ResourceType:   ResourceTypeLoadBalancer,
+			OwnerKind:      OwnerKindIngress,
+			OwnerNamespace: "awesome-ns",
+			OwnerName:      owner,
+		}
+	}
+	tgMapping := OwnershipMapping{
+		ResourceARN:    "tg-arn",
+		ResourceType:   ResourceTypeTargetGroup,
+		OwnerKind:      OwnerKindService,
+		OwnerNamespace: "awesome-ns",
+		OwnerName:      "svc-1",
+	}
+	record := func(mapping OwnershipMapping, action OwnershipAction) OwnershipRecord {
+		return OwnershipRecord{OwnershipMapping: mapping, ClusterName: "cluster", Action: action, Time: now}
+	}
+	tests := []struct {
+		name           string
+		publishedBatch [][]OwnershipMapping
+		want           []OwnershipRecord
+	}{
+		{
+			name: "new mappings are associated",
+			publishedBatch: [][]OwnershipMapping{
+				{lbMapping("ing-1"), tgMapping},
+			},
+			want: []OwnershipRecord{
+				record(lbMapping("ing-1"), OwnershipActionAssociated),
+				record(tgMapping, OwnershipActionAssociated),
+			},
+		},
+		{
+			name: "unchanged mappings aren't published again",
+			publishedBatch: [][]OwnershipMapping{
+				{lbMapping("ing-1"), tgMapping},
+				{tgMapping, lbMapping("ing-1")},
+			},
+			want: []OwnershipRecord{
+				record(lbMapping("ing-1"), OwnershipActionAssociated),
+				record(tgMapping, OwnershipActionAssociated),
+			},
+		},
+		{
+			name: "changed owner is disassociated before associated",
+			publishedBatch: [][]OwnershipMapping{
+				{lbMapping("ing-1")},
+				{lbMapping("ing-2")},
+			},
+			want: []OwnershipRecord{
+				record(lbMapping("ing-1"), OwnershipActionAssociated),
+				record(lbMapping("ing-1"), OwnershipActionDisassociated),
+				record(lbMapping("ing-2"), OwnershipActionAssociated),
+			},
+		},
+		{
+			name: "empty mappings disassociate all",
+			publishedBatch: [][]OwnershipMapping{
+				{lbMapping("ing-1"), tgMapping},
+				nil,
+				nil,
+			},
+			want: []OwnershipRecord{
+				record(lbMapping("ing-1"), OwnershipActionAssociated),
+				record(tgMapping, OwnershipActionAssociated),
+				record(lbMapping("ing-1"), OwnershipActionDisassociated),
+				record(tgMapping, OwnershipActionDisassociated),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventBridgeClient := &fakeEventBridge{}
+			snsClient := &fakeSNS{}
+			p := NewDefaultOwnershipPublisher("cluster", eventBridgeClient, "awesome-bus", snsClient,
+				"arn:aws:sns:us-west-2:123456789012:awesome-topic", logr.New(&log.NullLogSink{}))
+			p.now = func() time.Time { return now }
+			for _, mappings := range tt.publishedBatch {
+				assert.NoError(t, p.Publish(context.Background(), stackID, mappings))
+			}
+			assert.Equal(t, tt.want, eventBridgeClient.records)
+			assert.Equal(t, tt.want, snsClient.records)
+		})
+	}
+}
+
+func Test_defaultOwnershipPublisher_Publish_batches(t *testing.T) {
+	var mappings []OwnershipMapping
+	for i := 0; i < 25; i++ {
+		mappings = append(mappings, OwnershipMapping{
+			ResourceARN:  "tg-arn-" + string(rune('a'+i)),
+			ResourceType: ResourceTypeTargetGroup,
+			OwnerKind:    OwnerKindService,
+			OwnerName:    "svc",
+		})
+	}
+	eventBridgeClient := &fakeEventBridge{}
+	snsClient := &fakeSNS{}
+	p := NewDefaultOwnershipPublisher("cluster", eventBridgeClient, "awesome-bus", snsClient, "", logr.New(&log.NullLogSink{}))
+	assert.NoError(t, p.Publish(context.Background(), core.StackID{Name: "svc"}, mappings))
+	assert.Equal(t, 3, eventBridgeClient.calls)
+	assert.Len(t, eventBridgeClient.records, 25)
+	assert.Equal(t, 0, snsClient.calls)
+}
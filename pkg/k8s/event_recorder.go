@@ -0,0 +1,23 @@
+package k8s
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// NewDiscardingEventRecorder constructs an EventRecorder that discards all events.
+func NewDiscardingEventRecorder() record.EventRecorder {
+	return &discardingEventRecorder{}
+}
+
+var _ record.EventRecorder = &discardingEventRecorder{}
+
+// discardingEventRecorder is an EventRecorder for controllers that must not write to Kubernetes objects, e.g. in shadow mode.
+type discardingEventRecorder struct{}
+
+func (r *discardingEventRecorder) Event(_ runtime.Object, _, _, _ string) {}
+
+func (r *discardingEventRecorder) Eventf(_ runtime.Object, _, _, _ string, _ ...interface{}) {}
+
+func (r *discardingEventRecorder) AnnotatedEventf(_ runtime.Object, _ map[string]string, _, _, _ string, _ ...interface{}) {
+}
@@ -2,14 +2,27 @@ package k8s
 
 const (
 	// Ingress events
-	IngressEventReasonConflictingIngressClass = "ConflictingIngressClass"
-	IngressEventReasonFailedLoadGroupID       = "FailedLoadGroupID"
-	IngressEventReasonFailedAddFinalizer      = "FailedAddFinalizer"
-	IngressEventReasonFailedRemoveFinalizer   = "FailedRemoveFinalizer"
-	IngressEventReasonFailedUpdateStatus      = "FailedUpdateStatus"
-	IngressEventReasonFailedBuildModel        = "FailedBuildModel"
-	IngressEventReasonFailedDeployModel       = "FailedDeployModel"
-	IngressEventReasonSuccessfullyReconciled  = "SuccessfullyReconciled"
+	IngressEventReasonConflictingIngressClass  = "ConflictingIngressClass"
+	IngressEventReasonFailedLoadGroupID        = "FailedLoadGroupID"
+	IngressEventReasonFailedAddFinalizer       = "FailedAddFinalizer"
+	IngressEventReasonFailedRemoveFinalizer    = "FailedRemoveFinalizer"
+	IngressEventReasonFailedUpdateStatus       = "FailedUpdateStatus"
+	IngressEventReasonFailedBuildModel         = "FailedBuildModel"
+	IngressEventReasonFailedDeployModel        = "FailedDeployModel"
+	IngressEventReasonFailedAbandonModel       = "FailedAbandonModel"
+	IngressEventReasonFailedExportResourceARNs = "FailedExportResourceARNs"
+	IngressEventReasonSuccessfullyReconciled   = "SuccessfullyReconciled"
+	IngressEventReasonListenerRulesQuota       = "ListenerRulesQuota"
+	IngressEventReasonCertificateStandby       = "CertificateStandby"
+	IngressEventReasonCertificatePromoted      = "CertificatePromoted"
+	IngressEventReasonCertificateRequested     = "CertificateRequested"
+	IngressEventReasonDeletionDelayed          = "DeletionDelayed"
+	IngressEventReasonENIReleasePending        = "ENIReleasePending"
+	IngressEventReasonDeniedInboundCIDRs       = "DeniedInboundCIDRs"
+	IngressEventReasonIgnoredWAFv2WebACL       = "IgnoredWAFv2WebACL"
+
+	// IngressClassParams events
+	IngressClassParamsEventReasonIngressesRequeued = "IngressesRequeued"
 
 	// Service events
 	ServiceEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
@@ -18,7 +31,10 @@ const (
 	ServiceEventReasonFailedCleanupStatus    = "FailedCleanupStatus"
 	ServiceEventReasonFailedBuildModel       = "FailedBuildModel"
 	ServiceEventReasonFailedDeployModel      = "FailedDeployModel"
+	ServiceEventReasonFailedAbandonModel     = "FailedAbandonModel"
 	ServiceEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	ServiceEventReasonDeletionDelayed        = "DeletionDelayed"
+	ServiceEventReasonENIReleasePending      = "ENIReleasePending"
 
 	// TargetGroupBinding events
 	TargetGroupBindingEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
@@ -28,4 +44,36 @@ const (
 	TargetGroupBindingEventReasonFailedNetworkReconcile = "FailedNetworkReconcile"
 	TargetGroupBindingEventReasonBackendNotFound        = "BackendNotFound"
 	TargetGroupBindingEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+	TargetGroupBindingEventReasonAZWithoutTargets       = "AZWithoutTargets"
+
+	// TargetGroupWeightPolicy events
+	TargetGroupWeightPolicyEventReasonFailedUpdateStatus = "FailedUpdateStatus"
+	TargetGroupWeightPolicyEventReasonWeightsShifted     = "WeightsShifted"
+
+	// Blocklist events
+	BlocklistEventReasonInvalidCIDR        = "InvalidCIDR"
+	BlocklistEventReasonFailedSync         = "FailedSync"
+	BlocklistEventReasonFailedUpdateStatus = "FailedUpdateStatus"
+
+	// FrontendSecurityGroupRule events
+	FrontendSecurityGroupRuleEventReasonInvalidRule = "InvalidRule"
+
+	// LoadBalancerRoute events
+	LoadBalancerRouteEventReasonInvalidRoute = "InvalidRoute"
+
+	// ControllerConfiguration events
+	ControllerConfigurationEventReasonInvalidDefaultTags   = "InvalidDefaultTags"
+	ControllerConfigurationEventReasonInvalidDynamicConfig = "InvalidDynamicConfig"
+	ControllerConfigurationEventReasonFailedUpdateStatus   = "FailedUpdateStatus"
+
+	// Gateway events
+	GatewayEventReasonFailedAddFinalizer     = "FailedAddFinalizer"
+	GatewayEventReasonFailedRemoveFinalizer  = "FailedRemoveFinalizer"
+	GatewayEventReasonFailedUpdateStatus     = "FailedUpdateStatus"
+	GatewayEventReasonFailedBuildModel       = "FailedBuildModel"
+	GatewayEventReasonFailedDeployModel      = "FailedDeployModel"
+	GatewayEventReasonSuccessfullyReconciled = "SuccessfullyReconciled"
+
+	// Backend SecurityGroup events
+	BackendSGEventReasonDeletedExternally = "BackendSGDeletedExternally"
 )
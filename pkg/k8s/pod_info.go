@@ -2,14 +2,27 @@ package k8s
 
 import (
 	"encoding/json"
+	"strings"
+	"time"
+
 	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
 	annotationKeyPodENIInfo = "vpc.amazonaws.com/pod-eni"
+	// resourceNamePodENI is the extended resource requested by pods using SecurityGroups for pods.
+	resourceNamePodENI corev1.ResourceName = "vpc.amazonaws.com/pod-eni"
+
+	// AnnotationKeyPodDeregistrationRequestedAt is the annotation on pods being deleted, whose targets are deregistered ahead of their termination.
+	AnnotationKeyPodDeregistrationRequestedAt = "elbv2.k8s.aws/deregistration-requested-at"
+	// podDeregistrationRequestTTL is how long a deregistration request is honored,
+	// so that pods are registered again if their deletion is rejected afterwards.
+	podDeregistrationRequestTTL = 2 * time.Minute
 )
 
 // PodInfo contains simplified pod information we cares about.
@@ -23,8 +36,25 @@ type PodInfo struct {
 	Conditions     []corev1.PodCondition
 	NodeName       string
 	PodIP          string
+	// PodIPs are the IPs of pod of each IP family, the first of which is PodIP.
+	PodIPs []string
 
 	ENIInfos []PodENIInfo
+	// PodENIRequested is whether pod uses a branch ENI via SecurityGroups for pods,
+	// such pods must be resolved to their branch ENI instead of the ENIs of node.
+	PodENIRequested bool
+
+	// DeregistrationRequestedAt is when the deregistration of pod targets was requested ahead of pod deletion, zero if not requested.
+	DeregistrationRequestedAt time.Time
+
+	// Workload is the workload controlling pod, zero if pod isn't controlled by any workload.
+	Workload PodWorkload
+}
+
+// PodWorkload identifies the workload controlling a pod, e.g. a Deployment or StatefulSet.
+type PodWorkload struct {
+	Kind string
+	Name string
 }
 
 // PodENIInfo is a json convertible structure that stores the Branch ENI details that can be
@@ -49,6 +79,11 @@ func (i *PodInfo) HasAnyOfReadinessGates(conditionTypes []corev1.PodConditionTyp
 	return false
 }
 
+// IsDeregistrationRequested returns whether the deregistration of podInfo targets was requested recently.
+func (i *PodInfo) IsDeregistrationRequested(now time.Time) bool {
+	return !i.DeregistrationRequestedAt.IsZero() && now.Sub(i.DeregistrationRequestedAt) < podDeregistrationRequestTTL
+}
+
 // IsContainersReady returns whether podInfo is ContainersReady.
 func (i *PodInfo) IsContainersReady() bool {
 	containersReadyCond, exists := i.GetPodCondition(corev1.ContainersReady)
@@ -95,6 +130,18 @@ func buildPodInfo(pod *corev1.Pod) PodInfo {
 	for _, podContainer := range pod.Spec.Containers {
 		containerPorts = append(containerPorts, podContainer.Ports...)
 	}
+	_, hasPodENIAnnotation := pod.Annotations[annotationKeyPodENIInfo]
+	var podIPs []string
+	for _, podIP := range pod.Status.PodIPs {
+		podIPs = append(podIPs, podIP.IP)
+	}
+	var deregistrationRequestedAt time.Time
+	// we kept deregistrationRequestedAt as zero if the annotation is malformed.
+	if rawRequestedAt, ok := pod.Annotations[AnnotationKeyPodDeregistrationRequestedAt]; ok {
+		if requestedAt, err := time.Parse(time.RFC3339, rawRequestedAt); err == nil {
+			deregistrationRequestedAt = requestedAt
+		}
+	}
 	return PodInfo{
 		Key: podKey,
 		UID: pod.UID,
@@ -104,9 +151,50 @@ func buildPodInfo(pod *corev1.Pod) PodInfo {
 		Conditions:     pod.Status.Conditions,
 		NodeName:       pod.Spec.NodeName,
 		PodIP:          pod.Status.PodIP,
+		PodIPs:         podIPs,
+
+		ENIInfos:        podENIInfos,
+		PodENIRequested: hasPodENIAnnotation || isPodENIRequested(pod),
+
+		DeregistrationRequestedAt: deregistrationRequestedAt,
+
+		Workload: buildPodWorkload(pod),
+	}
+}
 
-		ENIInfos: podENIInfos,
+// buildPodWorkload will construct PodWorkload for given pod.
+// pods of Deployments are resolved to the Deployment instead of their ReplicaSet, whose name is suffixed with the pod-template-hash.
+func buildPodWorkload(pod *corev1.Pod) PodWorkload {
+	controllerRef := metav1.GetControllerOf(pod)
+	if controllerRef == nil {
+		return PodWorkload{}
 	}
+	if controllerRef.Kind == "ReplicaSet" {
+		templateHash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]
+		if ok && strings.HasSuffix(controllerRef.Name, "-"+templateHash) {
+			return PodWorkload{
+				Kind: "Deployment",
+				Name: strings.TrimSuffix(controllerRef.Name, "-"+templateHash),
+			}
+		}
+	}
+	return PodWorkload{
+		Kind: controllerRef.Kind,
+		Name: controllerRef.Name,
+	}
+}
+
+// isPodENIRequested checks whether any container of pod requests the pod ENI resource.
+func isPodENIRequested(pod *corev1.Pod) bool {
+	for _, podContainer := range pod.Spec.Containers {
+		if _, ok := podContainer.Resources.Limits[resourceNamePodENI]; ok {
+			return true
+		}
+		if _, ok := podContainer.Resources.Requests[resourceNamePodENI]; ok {
+			return true
+		}
+	}
+	return false
 }
 
 // buildPodENIInfo will construct PodENIInfo for given pod if any.
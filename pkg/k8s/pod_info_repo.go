@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sync"
 	"time"
 )
 
@@ -29,17 +30,25 @@ type PodInfoRepo interface {
 }
 
 // NewDefaultPodInfoRepo constructs new defaultPodInfoRepo.
-// * watchNamespace is the namespace to monitor pod spec.
-//   - if watchNamespace is "", this repo monitors pods in all namespaces
-//   - if watchNamespace is not "", this repo monitors pods in specific namespace
-func NewDefaultPodInfoRepo(getter cache.Getter, watchNamespace string, logger logr.Logger) *defaultPodInfoRepo {
-	store := NewConversionStore(podInfoConversionFunc, podInfoKeyFunc)
-	lw := cache.NewListWatchFromClient(getter, resourceTypePods, watchNamespace, fields.Everything())
-	rt := cache.NewReflector(lw, &corev1.Pod{}, store, 0)
+// * watchNamespaces are the namespaces to monitor pod spec.
+//   - if watchNamespaces is empty, this repo monitors pods in all namespaces
+//   - if watchNamespaces is not empty, this repo monitors pods in specific namespaces
+func NewDefaultPodInfoRepo(getter cache.Getter, watchNamespaces []string, logger logr.Logger) *defaultPodInfoRepo {
+	if len(watchNamespaces) == 0 {
+		watchNamespaces = []string{corev1.NamespaceAll}
+	}
+	stores := make(map[string]*ConversionStore, len(watchNamespaces))
+	rts := make([]*cache.Reflector, 0, len(watchNamespaces))
+	for _, watchNamespace := range watchNamespaces {
+		store := NewConversionStore(podInfoConversionFunc, podInfoKeyFunc)
+		lw := cache.NewListWatchFromClient(getter, resourceTypePods, watchNamespace, fields.Everything())
+		rts = append(rts, cache.NewReflector(lw, &corev1.Pod{}, store, 0))
+		stores[watchNamespace] = store
+	}
 
 	repo := &defaultPodInfoRepo{
-		store:  store,
-		rt:     rt,
+		stores: stores,
+		rts:    rts,
 		logger: logger,
 	}
 	return repo
@@ -50,15 +59,23 @@ var _ manager.Runnable = &defaultPodInfoRepo{}
 
 // default implementation for PodInfoRepo
 type defaultPodInfoRepo struct {
-	store  *ConversionStore
-	rt     *cache.Reflector
+	// stores contains the pod information per watched namespace, keyed by corev1.NamespaceAll if all namespaces are watched.
+	stores map[string]*ConversionStore
+	rts    []*cache.Reflector
 	logger logr.Logger
 }
 
 // Get returns PodInfo specified with specific podKey, and whether it exists.
 func (r *defaultPodInfoRepo) Get(_ context.Context, key types.NamespacedName) (PodInfo, bool, error) {
+	store, ok := r.stores[corev1.NamespaceAll]
+	if !ok {
+		store, ok = r.stores[key.Namespace]
+	}
+	if !ok {
+		return PodInfo{}, false, nil
+	}
 	pInfo := PodInfo{Key: key}
-	raw, exists, err := r.store.Get(&pInfo)
+	raw, exists, err := store.Get(&pInfo)
 	if err != nil {
 		return PodInfo{}, false, err
 	}
@@ -71,15 +88,16 @@ func (r *defaultPodInfoRepo) Get(_ context.Context, key types.NamespacedName) (P
 
 // ListKeys will list the pod keys in this repo.
 func (r *defaultPodInfoRepo) ListKeys(_ context.Context) []types.NamespacedName {
-	storeKeys := r.store.ListKeys()
-	keys := make([]types.NamespacedName, 0, len(storeKeys))
-	for _, storeKey := range storeKeys {
-		namespace, name, _ := cache.SplitMetaNamespaceKey(storeKey)
-		key := types.NamespacedName{
-			Namespace: namespace,
-			Name:      name,
+	var keys []types.NamespacedName
+	for _, store := range r.stores {
+		for _, storeKey := range store.ListKeys() {
+			namespace, name, _ := cache.SplitMetaNamespaceKey(storeKey)
+			key := types.NamespacedName{
+				Namespace: namespace,
+				Name:      name,
+			}
+			keys = append(keys, key)
 		}
-		keys = append(keys, key)
 	}
 	return keys
 }
@@ -87,15 +105,27 @@ func (r *defaultPodInfoRepo) ListKeys(_ context.Context) []types.NamespacedName
 // Start will start the repo.
 // It leverages ListWatch to keep pod info stored locally to be in-sync with Kubernetes.
 func (r *defaultPodInfoRepo) Start(ctx context.Context) error {
-	r.rt.Run(ctx.Done())
+	var wg sync.WaitGroup
+	for _, rt := range r.rts {
+		wg.Add(1)
+		go func(rt *cache.Reflector) {
+			defer wg.Done()
+			rt.Run(ctx.Done())
+		}(rt)
+	}
+	wg.Wait()
 	return nil
 }
 
 // WaitForCacheSync waits for the initial sync of pod information repository.
 func (r *defaultPodInfoRepo) WaitForCacheSync(ctx context.Context) error {
 	return wait.PollImmediateUntil(waitCacheSyncPollPeriod, func() (bool, error) {
-		lastSyncResourceVersion := r.rt.LastSyncResourceVersion()
-		return lastSyncResourceVersion != "", nil
+		for _, rt := range r.rts {
+			if rt.LastSyncResourceVersion() == "" {
+				return false, nil
+			}
+		}
+		return true, nil
 	}, ctx.Done())
 }
 
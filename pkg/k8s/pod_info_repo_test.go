@@ -1,7 +1,9 @@
 package k8s
 
 import (
+	"context"
 	"errors"
+	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -103,3 +105,61 @@ func Test_podInfoConversionFunc(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultPodInfoRepo_Get(t *testing.T) {
+	podA := &PodInfo{Key: types.NamespacedName{Namespace: "ns-1", Name: "pod-a"}, UID: "pod-a-uuid"}
+	podB := &PodInfo{Key: types.NamespacedName{Namespace: "ns-2", Name: "pod-b"}, UID: "pod-b-uuid"}
+	tests := []struct {
+		name            string
+		watchNamespaces []string
+		key             types.NamespacedName
+		want            PodInfo
+		wantExists      bool
+	}{
+		{
+			name:            "all namespaces",
+			watchNamespaces: nil,
+			key:             types.NamespacedName{Namespace: "ns-2", Name: "pod-b"},
+			want:            *podB,
+			wantExists:      true,
+		},
+		{
+			name:            "pod in watched namespace",
+			watchNamespaces: []string{"ns-1", "ns-2"},
+			key:             types.NamespacedName{Namespace: "ns-1", Name: "pod-a"},
+			want:            *podA,
+			wantExists:      true,
+		},
+		{
+			name:            "pod not in watched namespace",
+			watchNamespaces: []string{"ns-1", "ns-2"},
+			key:             types.NamespacedName{Namespace: "ns-3", Name: "pod-a"},
+			want:            PodInfo{},
+			wantExists:      false,
+		},
+		{
+			name:            "pod doesn't exist",
+			watchNamespaces: []string{"ns-1", "ns-2"},
+			key:             types.NamespacedName{Namespace: "ns-2", Name: "pod-a"},
+			want:            PodInfo{},
+			wantExists:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewDefaultPodInfoRepo(nil, tt.watchNamespaces, logr.Discard())
+			for _, pInfo := range []*PodInfo{podA, podB} {
+				store, ok := repo.stores[pInfo.Key.Namespace]
+				if !ok {
+					store = repo.stores[corev1.NamespaceAll]
+				}
+				assert.NoError(t, store.store.Add(pInfo))
+			}
+			got, exists, err := repo.Get(context.Background(), tt.key)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantExists, exists)
+			assert.Equal(t, tt.want, got)
+			assert.ElementsMatch(t, []types.NamespacedName{podA.Key, podB.Key}, repo.ListKeys(context.Background()))
+		})
+	}
+}
@@ -2,12 +2,15 @@ package k8s
 
 import (
 	"errors"
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"testing"
+	"time"
 )
 
 func TestPodInfo_HasAnyOfReadinessGates(t *testing.T) {
@@ -145,6 +148,45 @@ func TestPodInfo_IsContainersReady(t *testing.T) {
 	}
 }
 
+func TestPodInfo_IsDeregistrationRequested(t *testing.T) {
+	now := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		pod  PodInfo
+		want bool
+	}{
+		{
+			name: "deregistration is requested recently",
+			pod: PodInfo{
+				Key:                       types.NamespacedName{Namespace: "ns-1", Name: "pod-1"},
+				DeregistrationRequestedAt: now.Add(-30 * time.Second),
+			},
+			want: true,
+		},
+		{
+			name: "deregistration request is expired",
+			pod: PodInfo{
+				Key:                       types.NamespacedName{Namespace: "ns-1", Name: "pod-1"},
+				DeregistrationRequestedAt: now.Add(-5 * time.Minute),
+			},
+			want: false,
+		},
+		{
+			name: "deregistration isn't requested",
+			pod: PodInfo{
+				Key: types.NamespacedName{Namespace: "ns-1", Name: "pod-1"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.pod.IsDeregistrationRequested(now)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestPodInfo_GetPodCondition(t *testing.T) {
 	type args struct {
 		conditionType corev1.PodConditionType
@@ -371,6 +413,10 @@ func Test_buildPodInfo(t *testing.T) {
 							},
 						},
 						PodIP: "192.168.1.1",
+						PodIPs: []corev1.PodIP{
+							{IP: "192.168.1.1"},
+							{IP: "2600:1f14:1234::1"},
+						},
 					},
 				},
 			},
@@ -411,6 +457,7 @@ func Test_buildPodInfo(t *testing.T) {
 				},
 				NodeName: "ip-192-168-13-198.us-west-2.compute.internal",
 				PodIP:    "192.168.1.1",
+				PodIPs:   []string{"192.168.1.1", "2600:1f14:1234::1"},
 			},
 		},
 		{
@@ -516,6 +563,111 @@ func Test_buildPodInfo(t *testing.T) {
 						PrivateIP: "192.168.219.103",
 					},
 				},
+				PodENIRequested: true,
+			},
+		},
+		{
+			name: "pod requesting pod ENI without ENIInfo",
+			args: args{
+				pod: &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-ns",
+						Name:      "pod-1",
+						UID:       "pod-uuid",
+					},
+					Spec: corev1.PodSpec{
+						NodeName: "ip-192-168-13-198.us-west-2.compute.internal",
+						Containers: []corev1.Container{
+							{
+								Ports: []corev1.ContainerPort{
+									{
+										Name:          "http",
+										ContainerPort: 8080,
+									},
+								},
+								Resources: corev1.ResourceRequirements{
+									Limits: corev1.ResourceList{
+										"vpc.amazonaws.com/pod-eni": resource.MustParse("1"),
+									},
+									Requests: corev1.ResourceList{
+										"vpc.amazonaws.com/pod-eni": resource.MustParse("1"),
+									},
+								},
+							},
+						},
+					},
+					Status: corev1.PodStatus{
+						PodIP: "192.168.1.1",
+					},
+				},
+			},
+			want: PodInfo{
+				Key: types.NamespacedName{Namespace: "my-ns", Name: "pod-1"},
+				UID: "pod-uuid",
+				ContainerPorts: []corev1.ContainerPort{
+					{
+						Name:          "http",
+						ContainerPort: 8080,
+					},
+				},
+				NodeName:        "ip-192-168-13-198.us-west-2.compute.internal",
+				PodIP:           "192.168.1.1",
+				PodENIRequested: true,
+			},
+		},
+		{
+			name: "pod with deregistration requested",
+			args: args{
+				pod: &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-ns",
+						Name:      "pod-1",
+						UID:       "pod-uuid",
+						Annotations: map[string]string{
+							"elbv2.k8s.aws/deregistration-requested-at": "2023-06-01T10:00:00Z",
+						},
+					},
+					Spec: corev1.PodSpec{
+						NodeName: "ip-192-168-13-198.us-west-2.compute.internal",
+					},
+					Status: corev1.PodStatus{
+						PodIP: "192.168.1.1",
+					},
+				},
+			},
+			want: PodInfo{
+				Key:                       types.NamespacedName{Namespace: "my-ns", Name: "pod-1"},
+				UID:                       "pod-uuid",
+				NodeName:                  "ip-192-168-13-198.us-west-2.compute.internal",
+				PodIP:                     "192.168.1.1",
+				DeregistrationRequestedAt: time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "pod with malformed deregistration request",
+			args: args{
+				pod: &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "my-ns",
+						Name:      "pod-1",
+						UID:       "pod-uuid",
+						Annotations: map[string]string{
+							"elbv2.k8s.aws/deregistration-requested-at": "yesterday",
+						},
+					},
+					Spec: corev1.PodSpec{
+						NodeName: "ip-192-168-13-198.us-west-2.compute.internal",
+					},
+					Status: corev1.PodStatus{
+						PodIP: "192.168.1.1",
+					},
+				},
+			},
+			want: PodInfo{
+				Key:      types.NamespacedName{Namespace: "my-ns", Name: "pod-1"},
+				UID:      "pod-uuid",
+				NodeName: "ip-192-168-13-198.us-west-2.compute.internal",
+				PodIP:    "192.168.1.1",
 			},
 		},
 	}
@@ -527,6 +679,67 @@ func Test_buildPodInfo(t *testing.T) {
 	}
 }
 
+func Test_buildPodWorkload(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want PodWorkload
+	}{
+		{
+			name: "pod without controller",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pod-1",
+				},
+			},
+			want: PodWorkload{},
+		},
+		{
+			name: "pod of Deployment",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "web-7d4b9c8f6-x2x9k",
+					Labels: map[string]string{"pod-template-hash": "7d4b9c8f6"},
+					OwnerReferences: []metav1.OwnerReference{
+						{Kind: "ReplicaSet", Name: "web-7d4b9c8f6", Controller: awssdk.Bool(true)},
+					},
+				},
+			},
+			want: PodWorkload{Kind: "Deployment", Name: "web"},
+		},
+		{
+			name: "pod of standalone ReplicaSet",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "web-x2x9k",
+					OwnerReferences: []metav1.OwnerReference{
+						{Kind: "ReplicaSet", Name: "web", Controller: awssdk.Bool(true)},
+					},
+				},
+			},
+			want: PodWorkload{Kind: "ReplicaSet", Name: "web"},
+		},
+		{
+			name: "pod of StatefulSet",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "db-0",
+					OwnerReferences: []metav1.OwnerReference{
+						{Kind: "StatefulSet", Name: "db", Controller: awssdk.Bool(true)},
+					},
+				},
+			},
+			want: PodWorkload{Kind: "StatefulSet", Name: "db"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildPodWorkload(tt.pod)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func Test_buildPodENIInfo(t *testing.T) {
 	type args struct {
 		pod *corev1.Pod
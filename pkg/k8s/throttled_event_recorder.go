@@ -0,0 +1,162 @@
+package k8s
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// NewThrottledEventRecorder constructs an EventRecorder that throttles the events emitted via recorder.
+// identical events for the same object are emitted once per dedupeWindow, and events are rate limited per reason,
+// the number of suppressed events is appended to the message of the next event emitted. zero dedupeWindow or rateLimit disables them.
+func NewThrottledEventRecorder(recorder record.EventRecorder, dedupeWindow time.Duration, rateLimit float64, rateLimitBurst int) *throttledEventRecorder {
+	return &throttledEventRecorder{
+		recorder:       recorder,
+		dedupeWindow:   dedupeWindow,
+		rateLimit:      rate.Limit(rateLimit),
+		rateLimitBurst: rateLimitBurst,
+		now:            time.Now,
+		dedupeEntries:  make(map[eventKey]*dedupeEntry),
+		reasonLimiters: make(map[string]*reasonLimiter),
+	}
+}
+
+var _ record.EventRecorder = &throttledEventRecorder{}
+
+// throttledEventRecorder is an EventRecorder that keeps event storms during AWS outages off the API server.
+// Warning events take priority over Normal events, which cannot consume the last half of the burst of their reason.
+type throttledEventRecorder struct {
+	recorder       record.EventRecorder
+	dedupeWindow   time.Duration
+	rateLimit      rate.Limit
+	rateLimitBurst int
+	now            func() time.Time
+
+	// dedupeEntries is the last emission of events within dedupeWindow.
+	dedupeEntries map[eventKey]*dedupeEntry
+	// lastPruneTime is the last time the expired dedupeEntries are pruned.
+	lastPruneTime time.Time
+	// reasonLimiters is the rate limiters per event reason.
+	reasonLimiters map[string]*reasonLimiter
+	// mutex protects dedupeEntries, lastPruneTime and reasonLimiters.
+	mutex sync.Mutex
+}
+
+type eventKey struct {
+	object    string
+	eventtype string
+	reason    string
+	message   string
+}
+
+type dedupeEntry struct {
+	lastEmitTime    time.Time
+	suppressedCount int
+}
+
+type reasonLimiter struct {
+	limiter         *rate.Limiter
+	suppressedCount int
+}
+
+func (r *throttledEventRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	r.emit(object, nil, eventtype, reason, message)
+}
+
+func (r *throttledEventRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.emit(object, nil, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *throttledEventRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.emit(object, annotations, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *throttledEventRecorder) emit(object runtime.Object, annotations map[string]string, eventtype, reason, message string) {
+	r.mutex.Lock()
+	message, admitted := r.admit(object, eventtype, reason, message)
+	r.mutex.Unlock()
+	if !admitted {
+		return
+	}
+	if annotations != nil {
+		r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+		return
+	}
+	r.recorder.Event(object, eventtype, reason, message)
+}
+
+// admit decides whether the event should be emitted, and returns its message with the suppressed events aggregated.
+func (r *throttledEventRecorder) admit(object runtime.Object, eventtype, reason, message string) (string, bool) {
+	now := r.now()
+	var key eventKey
+	var entry *dedupeEntry
+	if r.dedupeWindow > 0 {
+		r.pruneDedupeEntries(now)
+		key = eventKey{object: buildEventObjectKey(object), eventtype: eventtype, reason: reason, message: message}
+		entry = r.dedupeEntries[key]
+		if entry != nil && now.Sub(entry.lastEmitTime) < r.dedupeWindow {
+			entry.suppressedCount++
+			return "", false
+		}
+	}
+
+	emittedMessage := message
+	if entry != nil && entry.suppressedCount > 0 {
+		emittedMessage = fmt.Sprintf("%s (%d identical events suppressed)", emittedMessage, entry.suppressedCount)
+	}
+	if r.rateLimit > 0 {
+		limiter, ok := r.reasonLimiters[reason]
+		if !ok {
+			limiter = &reasonLimiter{limiter: rate.NewLimiter(r.rateLimit, r.rateLimitBurst)}
+			r.reasonLimiters[reason] = limiter
+		}
+		if eventtype != corev1.EventTypeWarning && limiter.limiter.TokensAt(now) < float64(r.rateLimitBurst/2+1) {
+			limiter.suppressedCount++
+			return "", false
+		}
+		if !limiter.limiter.AllowN(now, 1) {
+			limiter.suppressedCount++
+			return "", false
+		}
+		if limiter.suppressedCount > 0 {
+			emittedMessage = fmt.Sprintf("%s (%d events with reason %v rate limited)", emittedMessage, limiter.suppressedCount, reason)
+			limiter.suppressedCount = 0
+		}
+	}
+	if r.dedupeWindow > 0 {
+		r.dedupeEntries[key] = &dedupeEntry{lastEmitTime: now}
+	}
+	return emittedMessage, true
+}
+
+// pruneDedupeEntries removes the dedupeEntries idle for twice the dedupeWindow, at most once per dedupeWindow.
+// entries are kept beyond dedupeWindow, so that the suppressed events are aggregated if the event recurs shortly after.
+func (r *throttledEventRecorder) pruneDedupeEntries(now time.Time) {
+	if now.Sub(r.lastPruneTime) < r.dedupeWindow {
+		return
+	}
+	for key, entry := range r.dedupeEntries {
+		if now.Sub(entry.lastEmitTime) >= 2*r.dedupeWindow {
+			delete(r.dedupeEntries, key)
+		}
+	}
+	r.lastPruneTime = now
+}
+
+// buildEventObjectKey identifies the object of events by its UID, or its namespace and name if UID is unavailable.
+func buildEventObjectKey(object runtime.Object) string {
+	metaObj, err := meta.Accessor(object)
+	if err != nil {
+		return fmt.Sprintf("%T", object)
+	}
+	if uid := metaObj.GetUID(); uid != "" {
+		return string(uid)
+	}
+	return fmt.Sprintf("%T/%s/%s", object, metaObj.GetNamespace(), metaObj.GetName())
+}
@@ -0,0 +1,114 @@
+package k8s
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+)
+
+func Test_throttledEventRecorder(t *testing.T) {
+	startTime := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	svc1 := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc-1", UID: types.UID("uid-1")}}
+	svc2 := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "svc-2", UID: types.UID("uid-2")}}
+	type event struct {
+		offset    time.Duration
+		object    *corev1.Service
+		eventtype string
+		reason    string
+		message   string
+	}
+	tests := []struct {
+		name           string
+		dedupeWindow   time.Duration
+		rateLimit      float64
+		rateLimitBurst int
+		events         []event
+		want           []string
+	}{
+		{
+			name: "throttling disabled",
+			events: []event{
+				{object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+				{object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+			},
+			want: []string{
+				"Warning FailedDeploy throttled",
+				"Warning FailedDeploy throttled",
+			},
+		},
+		{
+			name:         "identical events are deduplicated within window",
+			dedupeWindow: 5 * time.Minute,
+			events: []event{
+				{object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+				{offset: time.Minute, object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+				{offset: 2 * time.Minute, object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+				{offset: 2 * time.Minute, object: svc2, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+				{offset: 3 * time.Minute, object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "access denied"},
+				{offset: 6 * time.Minute, object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+			},
+			want: []string{
+				"Warning FailedDeploy throttled",
+				"Warning FailedDeploy throttled",
+				"Warning FailedDeploy access denied",
+				"Warning FailedDeploy throttled (2 identical events suppressed)",
+			},
+		},
+		{
+			name:           "events are rate limited per reason",
+			rateLimit:      0.1,
+			rateLimitBurst: 2,
+			events: []event{
+				{object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+				{object: svc2, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+				{object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "access denied"},
+				{object: svc1, eventtype: corev1.EventTypeWarning, reason: "FailedBuildModel", message: "invalid annotation"},
+				{offset: 10 * time.Second, object: svc2, eventtype: corev1.EventTypeWarning, reason: "FailedDeploy", message: "throttled"},
+			},
+			want: []string{
+				"Warning FailedDeploy throttled",
+				"Warning FailedDeploy throttled",
+				"Warning FailedBuildModel invalid annotation",
+				"Warning FailedDeploy throttled (1 events with reason FailedDeploy rate limited)",
+			},
+		},
+		{
+			name:           "normal events cannot consume the burst reserved for warning events",
+			rateLimit:      0.1,
+			rateLimitBurst: 4,
+			events: []event{
+				{object: svc1, eventtype: corev1.EventTypeNormal, reason: "SuccessfullyReconciled", message: "reconciled"},
+				{object: svc2, eventtype: corev1.EventTypeNormal, reason: "SuccessfullyReconciled", message: "reconciled"},
+				{object: svc1, eventtype: corev1.EventTypeNormal, reason: "SuccessfullyReconciled", message: "reconciled"},
+				{object: svc1, eventtype: corev1.EventTypeWarning, reason: "SuccessfullyReconciled", message: "reconciled"},
+			},
+			want: []string{
+				"Normal SuccessfullyReconciled reconciled",
+				"Normal SuccessfullyReconciled reconciled",
+				"Warning SuccessfullyReconciled reconciled (1 events with reason SuccessfullyReconciled rate limited)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeRecorder := record.NewFakeRecorder(len(tt.events))
+			r := NewThrottledEventRecorder(fakeRecorder, tt.dedupeWindow, tt.rateLimit, tt.rateLimitBurst)
+			for _, e := range tt.events {
+				now := startTime.Add(e.offset)
+				r.now = func() time.Time { return now }
+				r.Event(e.object, e.eventtype, e.reason, e.message)
+			}
+			close(fakeRecorder.Events)
+			var got []string
+			for event := range fakeRecorder.Events {
+				got = append(got, event)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+)
+
+const serviceAnnotationPrefix = "service.beta.kubernetes.io"
+
+// annotationCheck checks the value of the annotation of suffix parses the way the controller parses it.
+type annotationCheck func(parser annotations.Parser, suffix string, rawAnnotations map[string]string) error
+
+func checkString(_ annotations.Parser, _ string, _ map[string]string) error {
+	return nil
+}
+
+func checkBool(parser annotations.Parser, suffix string, rawAnnotations map[string]string) error {
+	_, err := parser.ParseBoolAnnotation(suffix, new(bool), rawAnnotations)
+	return err
+}
+
+func checkDeletionPolicy(parser annotations.Parser, suffix string, rawAnnotations map[string]string) error {
+	_, err := deploy.ParseDeletionPolicyAnnotation(parser, suffix, rawAnnotations)
+	return err
+}
+
+func checkInt64(parser annotations.Parser, suffix string, rawAnnotations map[string]string) error {
+	_, err := parser.ParseInt64Annotation(suffix, new(int64), rawAnnotations)
+	return err
+}
+
+func checkStringMap(parser annotations.Parser, suffix string, rawAnnotations map[string]string) error {
+	_, err := parser.ParseStringMapAnnotation(suffix, nil, rawAnnotations)
+	return err
+}
+
+// checkJSON returns an annotationCheck that parses the annotation into the value returned by newValue.
+func checkJSON(newValue func() interface{}) annotationCheck {
+	return func(parser annotations.Parser, suffix string, rawAnnotations map[string]string) error {
+		_, err := parser.ParseJSONAnnotation(suffix, newValue(), rawAnnotations)
+		return err
+	}
+}
+
+func checkIngressAction(parser annotations.Parser, suffix string, rawAnnotations map[string]string) error {
+	action := ingress.Action{}
+	if _, err := parser.ParseJSONAnnotation(suffix, &action, rawAnnotations); err != nil {
+		return err
+	}
+	return action.Validate()
+}
+
+func checkIngressConditions(parser annotations.Parser, suffix string, rawAnnotations map[string]string) error {
+	var conditions []ingress.RuleCondition
+	if _, err := parser.ParseJSONAnnotation(suffix, &conditions, rawAnnotations); err != nil {
+		return err
+	}
+	for _, condition := range conditions {
+		if err := condition.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ingressAnnotationChecks are the checks of Ingress annotations by suffix.
+var ingressAnnotationChecks = map[string]annotationCheck{
+	annotations.IngressSuffixLoadBalancerName:             checkString,
+	annotations.IngressSuffixGroupName:                    checkString,
+	annotations.IngressSuffixGroupOrder:                   checkInt64,
+	annotations.IngressSuffixTags:                         checkStringMap,
+	annotations.IngressSuffixIPAddressType:                checkString,
+	annotations.IngressSuffixScheme:                       checkString,
+	annotations.IngressSuffixSubnets:                      checkString,
+	annotations.IngressSuffixCustomerOwnedIPv4Pool:        checkString,
+	annotations.IngressSuffixIPAMIPv4PoolID:               checkString,
+	annotations.IngressSuffixLoadBalancerAttributes:       checkStringMap,
+	annotations.IngressSuffixMinimumLoadBalancerCapacity:  checkStringMap,
+	annotations.IngressSuffixWAFv2ACLARN:                  checkString,
+	annotations.IngressSuffixWAFACLID:                     checkString,
+	annotations.IngressSuffixWebACLID:                     checkString,
+	annotations.IngressSuffixWAFFailOpen:                  checkBool,
+	annotations.IngressSuffixShieldAdvancedProtection:     checkBool,
+	annotations.IngressSuffixGlobalAcceleratorEnabled:     checkBool,
+	annotations.IngressSuffixSecurityGroups:               checkString,
+	annotations.IngressSuffixListenPorts:                  checkJSON(func() interface{} { return &[]map[string]int64{} }),
+	annotations.IngressSuffixSSLRedirect:                  checkInt64,
+	annotations.IngressSuffixInboundCIDRs:                 checkString,
+	annotations.IngressSuffixSecurityGroupPrefixLists:     checkString,
+	annotations.IngressSuffixCertificateARN:               checkString,
+	annotations.IngressSuffixSSLPolicy:                    checkString,
+	annotations.IngressSuffixTargetType:                   checkString,
+	annotations.IngressSuffixBackendProtocol:              checkString,
+	annotations.IngressSuffixBackendProtocolVersion:       checkString,
+	annotations.IngressSuffixTargetGroupAttributes:        checkStringMap,
+	annotations.IngressSuffixTargetGroupSplitByAttributes: checkBool,
+	annotations.IngressSuffixHealthCheckPort:              checkString,
+	annotations.IngressSuffixHealthCheckProtocol:          checkString,
+	annotations.IngressSuffixHealthCheckPath:              checkString,
+	annotations.IngressSuffixHealthCheckIntervalSeconds:   checkInt64,
+	annotations.IngressSuffixHealthCheckTimeoutSeconds:    checkInt64,
+	annotations.IngressSuffixHealthyThresholdCount:        checkInt64,
+	annotations.IngressSuffixUnhealthyThresholdCount:      checkInt64,
+	annotations.IngressSuffixSuccessCodes:                 checkString,
+	annotations.IngressSuffixAuthType:                     checkString,
+	annotations.IngressSuffixAuthIDPCognito:               checkJSON(func() interface{} { return &ingress.AuthIDPConfigCognito{} }),
+	annotations.IngressSuffixAuthIDPOIDC:                  checkJSON(func() interface{} { return &ingress.AuthIDPConfigOIDC{} }),
+	annotations.IngressSuffixAuthOnUnauthenticatedRequest: checkString,
+	annotations.IngressSuffixAuthScope:                    checkString,
+	annotations.IngressSuffixAuthSessionCookie:            checkString,
+	annotations.IngressSuffixAuthSessionTimeout:           checkInt64,
+	annotations.IngressSuffixTargetNodeLabels:             checkStringMap,
+	annotations.IngressSuffixManageSecurityGroupRules:     checkBool,
+	annotations.IngressSuffixExternallyManagedNetworking:  checkBool,
+	annotations.IngressSuffixMultiClusterTargetGroup:      checkBool,
+	annotations.IngressSuffixAWSRoleARN:                   checkString,
+	annotations.IngressSuffixMutualAuthentication:         checkJSON(func() interface{} { return &[]elbv2api.MutualAuthenticationAttributes{} }),
+	annotations.IngressSuffixTrustStoreBundle:             checkStringMap,
+	annotations.IngressSuffixDryRun:                       checkBool,
+	annotations.IngressSuffixDeletionPolicy:               checkDeletionPolicy,
+	annotations.IngressSuffixRecreateResources:            checkStringMap,
+	annotations.IngressSuffixRulePriorityBase:             checkInt64,
+	annotations.IngressSuffixRulePriorities:               checkJSON(func() interface{} { return &map[string]int64{} }),
+
+	annotations.IngressSuffixEnableFrontendNLB:              checkBool,
+	annotations.IngressSuffixFrontendNLBScheme:              checkString,
+	annotations.IngressSuffixFrontendNLBSubnets:             checkString,
+	annotations.IngressSuffixFrontendNLBEIPAllocations:      checkString,
+	annotations.IngressSuffixFrontendNLBHealthCheckProtocol: checkString,
+	annotations.IngressSuffixFrontendNLBHealthCheckPath:     checkString,
+	annotations.IngressSuffixFrontendNLBSuccessCodes:        checkString,
+}
+
+// ingressAnnotationPrefixChecks are the checks of Ingress annotations whose suffix has a variable part, by the fixed part.
+var ingressAnnotationPrefixChecks = map[string]annotationCheck{
+	"actions.":    checkIngressAction,
+	"conditions.": checkIngressConditions,
+	annotations.IngressSuffixListenerAttributes + ".": checkStringMap,
+}
+
+// serviceAnnotationChecks are the checks of Service annotations by suffix.
+var serviceAnnotationChecks = map[string]annotationCheck{
+	annotations.SvcLBSuffixSourceRanges:                  checkString,
+	annotations.SvcLBSuffixLoadBalancerType:              checkString,
+	annotations.SvcLBSuffixTargetType:                    checkString,
+	annotations.SvcLBSuffixLoadBalancerName:              checkString,
+	annotations.SvcLBSuffixScheme:                        checkString,
+	annotations.SvcLBSuffixInternal:                      checkBool,
+	annotations.SvcLBSuffixProxyProtocol:                 checkString,
+	annotations.SvcLBSuffixIPAddressType:                 checkString,
+	annotations.SvcLBSuffixAccessLogEnabled:              checkBool,
+	annotations.SvcLBSuffixAccessLogS3BucketName:         checkString,
+	annotations.SvcLBSuffixAccessLogS3BucketPrefix:       checkString,
+	annotations.SvcLBSuffixCrossZoneLoadBalancingEnabled: checkBool,
+	annotations.SvcLBSuffixSSLCertificate:                checkString,
+	annotations.SvcLBSuffixSSLPorts:                      checkString,
+	annotations.SvcLBSuffixSSLNegotiationPolicy:          checkString,
+	annotations.SvcLBSuffixBEProtocol:                    checkString,
+	annotations.SvcLBSuffixAdditionalTags:                checkStringMap,
+	annotations.SvcLBSuffixHCHealthyThreshold:            checkInt64,
+	annotations.SvcLBSuffixHCUnhealthyThreshold:          checkInt64,
+	annotations.SvcLBSuffixHCTimeout:                     checkInt64,
+	annotations.SvcLBSuffixHCInterval:                    checkInt64,
+	annotations.SvcLBSuffixHCProtocol:                    checkString,
+	annotations.SvcLBSuffixHCPort:                        checkString,
+	annotations.SvcLBSuffixHCPath:                        checkString,
+	annotations.SvcLBSuffixHCSuccessCodes:                checkString,
+	annotations.SvcLBSuffixTargetGroupAttributes:         checkStringMap,
+	annotations.SvcLBSuffixSubnets:                       checkString,
+	annotations.SvcLBSuffixEIPAllocations:                checkString,
+	annotations.SvcLBSuffixEIPPool:                       checkString,
+	annotations.SvcLBSuffixPrivateIpv4Addresses:          checkString,
+	annotations.SvcLBSuffixIpv6Addresses:                 checkString,
+	annotations.SvcLBSuffixALPNPolicy:                    checkString,
+	annotations.SvcLBSuffixTargetNodeLabels:              checkStringMap,
+	annotations.SvcLBSuffixLoadBalancerAttributes:        checkStringMap,
+	annotations.SvcLBSuffixLoadBalancerSecurityGroups:    checkString,
+	annotations.SvcLBSuffixManageSGRules:                 checkBool,
+	annotations.SvcLBSuffixExternallyManagedNetworking:   checkBool,
+	annotations.SvcLBSuffixSecurityGroupPrefixLists:      checkString,
+	annotations.SvcLBSuffixMultiClusterTargetGroup:       checkBool,
+	annotations.SvcLBSuffixConnectionTermination:         checkBool,
+	annotations.SvcLBSuffixEndpointServiceEnabled:        checkBool,
+	annotations.SvcLBSuffixEndpointServiceAcceptance:     checkBool,
+	annotations.SvcLBSuffixEndpointServicePrincipals:     checkString,
+	annotations.SvcLBSuffixGlobalAcceleratorEnabled:      checkBool,
+	annotations.SvcLBSuffixHostname:                      checkString,
+	annotations.SvcLBSuffixDryRun:                        checkBool,
+	annotations.SvcLBSuffixDeletionPolicy:                checkDeletionPolicy,
+	annotations.SvcLBSuffixRecreateResources:             checkStringMap,
+	annotations.SvcLBSuffixLoadBalancerConfiguration:     checkString,
+}
+
+// serviceAnnotationPrefixChecks are the checks of Service annotations whose suffix has a variable part, by the fixed part.
+var serviceAnnotationPrefixChecks = map[string]annotationCheck{
+	annotations.SvcLBSuffixListenerAttributes + ".": checkStringMap,
+}
+
+// serviceAnnotationSuffixPrefix is the common prefix of the suffixes of Service annotations, only annotations with it are reported as unknown,
+// since other annotations of the prefix are used by Kubernetes itself.
+const serviceAnnotationSuffixPrefix = "aws-load-balancer-"
+
+// SupportedIngressAnnotations returns the sorted keys of the Ingress annotations supported by the controller,
+// keys with a variable part end with "*".
+func SupportedIngressAnnotations() []string {
+	return supportedAnnotationKeys(annotations.AnnotationPrefixIngress, ingressAnnotationChecks, ingressAnnotationPrefixChecks)
+}
+
+// SupportedServiceAnnotations returns the sorted keys of the Service annotations supported by the controller,
+// keys with a variable part end with "*".
+func SupportedServiceAnnotations() []string {
+	return supportedAnnotationKeys(serviceAnnotationPrefix, serviceAnnotationChecks, serviceAnnotationPrefixChecks)
+}
+
+func supportedAnnotationKeys(prefix string, checks map[string]annotationCheck, prefixChecks map[string]annotationCheck) []string {
+	keys := make([]string, 0, len(checks)+len(prefixChecks))
+	for suffix := range checks {
+		keys = append(keys, fmt.Sprintf("%s/%s", prefix, suffix))
+	}
+	for suffixPrefix := range prefixChecks {
+		keys = append(keys, fmt.Sprintf("%s/%s*", prefix, suffixPrefix))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// annotationFinding is a problem found in the annotation of key.
+type annotationFinding struct {
+	key      string
+	severity Severity
+	err      error
+}
+
+// checkAnnotations checks the annotations of prefix against checks and prefixChecks.
+// unknown annotations whose suffix has unknownSuffixPrefix are reported as warnings, since they're ignored by the controller.
+func checkAnnotations(rawAnnotations map[string]string, prefix string, checks map[string]annotationCheck,
+	prefixChecks map[string]annotationCheck, unknownSuffixPrefix string) []annotationFinding {
+	parser := annotations.NewSuffixAnnotationParser(prefix)
+	var findings []annotationFinding
+	for key := range rawAnnotations {
+		suffix, ok := strings.CutPrefix(key, prefix+"/")
+		if !ok {
+			continue
+		}
+		check, known := checks[suffix]
+		if !known {
+			for suffixPrefix, prefixCheck := range prefixChecks {
+				if strings.HasPrefix(suffix, suffixPrefix) {
+					check, known = prefixCheck, true
+					break
+				}
+			}
+		}
+		if !known {
+			if strings.HasPrefix(suffix, unknownSuffixPrefix) {
+				findings = append(findings, annotationFinding{
+					key:      key,
+					severity: SeverityWarning,
+					err:      errors.New("unknown annotation, it's ignored by the controller"),
+				})
+			}
+			continue
+		}
+		if err := check(parser, suffix, rawAnnotations); err != nil {
+			findings = append(findings, annotationFinding{
+				key:      key,
+				severity: SeverityError,
+				err:      err,
+			})
+		}
+	}
+	return findings
+}
@@ -0,0 +1,257 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	corewebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/core"
+	elbv2webhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/elbv2"
+	networkingwebhook "sigs.k8s.io/aws-load-balancer-controller/webhooks/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// defaultNamespace is the namespace of namespaced objects without one in manifests.
+const defaultNamespace = "default"
+
+// Severity is the severity of a Finding.
+type Severity string
+
+const (
+	// SeverityError means the object would be rejected by the webhooks, or fail to be reconciled by the controller.
+	SeverityError Severity = "error"
+	// SeverityWarning means the object is accepted, but likely doesn't behave as intended.
+	SeverityWarning Severity = "warning"
+)
+
+// Finding is a problem found in a manifest.
+type Finding struct {
+	// The path of the manifest file.
+	File string
+	// The line of the annotation the problem is found in, or of the object.
+	Line int
+	// The severity of the problem.
+	Severity Severity
+	// The object, in the form of "Kind namespace/name".
+	Object string
+	// The annotation the problem is found in, empty if it's not specific to an annotation.
+	Annotation string
+	// The description of the problem.
+	Message string
+}
+
+func (f Finding) String() string {
+	if f.Annotation != "" {
+		return fmt.Sprintf("%v:%v: %v: %v: annotation %v: %v", f.File, f.Line, f.Severity, f.Object, f.Annotation, f.Message)
+	}
+	return fmt.Sprintf("%v:%v: %v: %v: %v", f.File, f.Line, f.Severity, f.Object, f.Message)
+}
+
+// Linter validates Ingress, Service, IngressClassParams and LoadBalancerConfiguration manifests against the rules of controller,
+// without access to a cluster or AWS. It runs the validating webhooks, and parses the annotations the way the controller does.
+// checks that rely on AWS, e.g. the resolution of subnets and certificates, are out of scope.
+type Linter struct {
+	ingConfig            config.IngressConfig
+	svcConfig            config.ServiceConfig
+	featureGates         config.FeatureGates
+	deniedTagKeyPrefixes []string
+	scheme               *runtime.Scheme
+	logger               logr.Logger
+}
+
+// NewLinter constructs new Linter that validates manifests against the controller running with controllerCFG.
+func NewLinter(controllerCFG config.ControllerConfig, scheme *runtime.Scheme, logger logr.Logger) *Linter {
+	return &Linter{
+		ingConfig:            controllerCFG.IngressConfig,
+		svcConfig:            controllerCFG.ServiceConfig,
+		featureGates:         controllerCFG.FeatureGates,
+		deniedTagKeyPrefixes: controllerCFG.DeniedTagKeyPrefixes,
+		scheme:               scheme,
+		logger:               logger,
+	}
+}
+
+// Lint returns the problems found in manifests, sorted by location.
+// the IngressClasses, IngressClassParams, LoadBalancerConfigurations and Namespaces in manifests are used to resolve references,
+// the IngressClass of the controller is assumed to exist if it isn't specified, other IngressClasses are assumed to belong to other controllers.
+func (l *Linter) Lint(ctx context.Context, manifests []Manifest) []Finding {
+	for _, manifest := range manifests {
+		if isNamespaced(manifest.Object) && manifest.Object.GetNamespace() == "" {
+			manifest.Object.SetNamespace(defaultNamespace)
+		}
+	}
+	k8sClient, ingClassNames := l.buildClient(manifests)
+	// manifests aren't linted on behalf of a user, thus privileged annotations aren't authorized.
+	ingValidator := networkingwebhook.NewIngressValidator(k8sClient, l.ingConfig, l.deniedTagKeyPrefixes, nil, l.logger)
+	svcValidator := corewebhook.NewServiceValidator(k8sClient, nil, l.logger)
+	ingClassParamsValidator := elbv2webhook.NewIngressClassParamsValidator()
+	lbConfigurationValidator := elbv2webhook.NewLoadBalancerConfigurationValidator()
+	classAnnotationMatcher := ingress.NewDefaultClassAnnotationMatcher(l.ingConfig.IngressClass)
+	classLoader := ingress.NewDefaultClassLoader(k8sClient, false)
+	svcUtils := service.NewServiceUtils(annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix), "", l.svcConfig.LoadBalancerClass, l.featureGates)
+
+	var findings []Finding
+	for _, manifest := range manifests {
+		validationCtx := webhook.ContextWithAdmissionWarnings(ctx)
+		var err error
+		switch obj := manifest.Object.(type) {
+		case *networking.Ingress:
+			managed, loadErr := isIngressManaged(ctx, obj.DeepCopy(), ingClassNames, classAnnotationMatcher, classLoader, l.ingConfig.IngressClass == "")
+			if loadErr != nil || !managed {
+				err = loadErr
+				break
+			}
+			err = ingValidator.ValidateCreate(validationCtx, obj)
+			findings = append(findings, buildAnnotationFindings(manifest,
+				checkAnnotations(obj.Annotations, annotations.AnnotationPrefixIngress, ingressAnnotationChecks, ingressAnnotationPrefixChecks, ""))...)
+		case *corev1.Service:
+			if !svcUtils.IsServiceSupported(obj) {
+				continue
+			}
+			err = svcValidator.ValidateCreate(validationCtx, obj)
+			findings = append(findings, buildAnnotationFindings(manifest,
+				checkAnnotations(obj.Annotations, serviceAnnotationPrefix, serviceAnnotationChecks, serviceAnnotationPrefixChecks, serviceAnnotationSuffixPrefix))...)
+		case *elbv2api.IngressClassParams:
+			err = ingClassParamsValidator.ValidateCreate(validationCtx, obj)
+		case *elbv2api.LoadBalancerConfiguration:
+			err = lbConfigurationValidator.ValidateCreate(validationCtx, obj)
+		default:
+			continue
+		}
+		if err != nil {
+			findings = append(findings, buildFinding(manifest, SeverityError, err.Error()))
+		}
+		for _, warning := range webhook.ContextGetAdmissionWarnings(validationCtx) {
+			findings = append(findings, buildFinding(manifest, SeverityWarning, warning))
+		}
+	}
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].Line < findings[j].Line
+	})
+	return findings
+}
+
+// buildClient builds the client serving the objects in manifests the validations refer to, and returns the names of the IngressClasses it serves.
+func (l *Linter) buildClient(manifests []Manifest) (client.Client, sets.String) {
+	var objs []client.Object
+	ingClassNames := sets.NewString()
+	namespaces := sets.NewString()
+	objNamespaces := sets.NewString()
+	for _, manifest := range manifests {
+		switch obj := manifest.Object.(type) {
+		case *networking.IngressClass:
+			ingClassNames.Insert(obj.Name)
+		case *corev1.Namespace:
+			namespaces.Insert(obj.Name)
+		case *elbv2api.IngressClassParams, *elbv2api.LoadBalancerConfiguration:
+		default:
+			objNamespaces.Insert(obj.GetNamespace())
+			continue
+		}
+		objs = append(objs, manifest.Object.DeepCopyObject().(client.Object))
+	}
+	if l.ingConfig.IngressClass != "" && !ingClassNames.Has(l.ingConfig.IngressClass) {
+		objs = append(objs, &networking.IngressClass{
+			ObjectMeta: metav1.ObjectMeta{Name: l.ingConfig.IngressClass},
+			Spec:       networking.IngressClassSpec{Controller: ingress.IngressClassControllerALB},
+		})
+		ingClassNames.Insert(l.ingConfig.IngressClass)
+	}
+	for _, namespace := range objNamespaces.Difference(namespaces).List() {
+		if namespace == "" {
+			continue
+		}
+		objs = append(objs, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
+	}
+	return fake.NewClientBuilder().WithScheme(l.scheme).WithObjects(objs...).Build(), ingClassNames
+}
+
+// isIngressManaged checks whether Ingress is managed by the controller, the same way as the Ingress webhook.
+// Ingresses referring to IngressClasses not in ingClassNames are assumed to be managed by other controllers.
+func isIngressManaged(ctx context.Context, ing *networking.Ingress, ingClassNames sets.String, classAnnotationMatcher ingress.ClassAnnotationMatcher,
+	classLoader ingress.ClassLoader, manageIngressesWithoutIngressClass bool) (bool, error) {
+	if ingClassAnnotation, exists := ing.Annotations[annotations.IngressClass]; exists {
+		return classAnnotationMatcher.Matches(ingClassAnnotation), nil
+	}
+	if ing.Spec.IngressClassName != nil && !ingClassNames.Has(*ing.Spec.IngressClassName) {
+		return false, nil
+	}
+	classConfiguration, err := classLoader.Load(ctx, ing)
+	if err != nil {
+		return false, err
+	}
+	if classConfiguration.IngClass != nil {
+		return classConfiguration.IngClass.Spec.Controller == ingress.IngressClassControllerALB, nil
+	}
+	return manageIngressesWithoutIngressClass, nil
+}
+
+func isNamespaced(obj client.Object) bool {
+	switch obj.(type) {
+	case *networking.Ingress, *corev1.Service:
+		return true
+	}
+	return false
+}
+
+// buildFinding builds the Finding of the problem described by message, which is located at the first annotation of the object it mentions.
+func buildFinding(manifest Manifest, severity Severity, message string) Finding {
+	finding := Finding{
+		File:     manifest.File,
+		Line:     manifest.Line,
+		Severity: severity,
+		Object:   describeObject(manifest.Object),
+		Message:  message,
+	}
+	keys := sets.StringKeySet(manifest.Object.GetAnnotations()).List()
+	// longer keys are matched first, so that "conditions.svc-1" isn't located at "conditions.svc".
+	sort.SliceStable(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+	for _, key := range keys {
+		if strings.Contains(message, key) {
+			finding.Annotation = key
+			finding.Line = manifest.AnnotationLine(key)
+			break
+		}
+	}
+	return finding
+}
+
+func buildAnnotationFindings(manifest Manifest, annotationFindings []annotationFinding) []Finding {
+	findings := make([]Finding, 0, len(annotationFindings))
+	for _, annotationFinding := range annotationFindings {
+		findings = append(findings, Finding{
+			File:       manifest.File,
+			Line:       manifest.AnnotationLine(annotationFinding.key),
+			Severity:   annotationFinding.severity,
+			Object:     describeObject(manifest.Object),
+			Annotation: annotationFinding.key,
+			Message:    annotationFinding.err.Error(),
+		})
+	}
+	return findings
+}
+
+func describeObject(obj client.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%v %v", kind, obj.GetName())
+	}
+	return fmt.Sprintf("%v %v/%v", kind, obj.GetNamespace(), obj.GetName())
+}
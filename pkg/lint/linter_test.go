@@ -0,0 +1,181 @@
+package lint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+)
+
+func TestLinter_Lint(t *testing.T) {
+	tests := []struct {
+		name      string
+		manifests string
+		want      []string
+	}{
+		{
+			name: "valid manifests",
+			manifests: `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing
+  annotations:
+    alb.ingress.kubernetes.io/scheme: internet-facing
+    alb.ingress.kubernetes.io/listen-ports: '[{"HTTP": 80}, {"HTTPS": 443}]'
+    alb.ingress.kubernetes.io/actions.response-503: '{"type":"fixed-response","fixedResponseConfig":{"contentType":"text/plain","statusCode":"503"}}'
+spec:
+  ingressClassName: alb
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+    service.beta.kubernetes.io/aws-load-balancer-healthcheck-interval: "10"
+spec:
+  type: LoadBalancer
+`,
+		},
+		{
+			name: "invalid Ingress annotations",
+			manifests: `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing
+  namespace: awesome-ns
+  annotations:
+    alb.ingress.kubernetes.io/group.order: first
+    alb.ingress.kubernetes.io/healthcheck-paht: /healthz
+    alb.ingress.kubernetes.io/actions.forward: '{"type":"forward"}'
+    alb.ingress.kubernetes.io/tags: 'team'
+spec:
+  ingressClassName: alb
+`,
+			want: []string{
+				"manifests.yaml:7: error: Ingress awesome-ns/ing: annotation alb.ingress.kubernetes.io/group.order: failed to parse int64 annotation, alb.ingress.kubernetes.io/group.order: first: strconv.ParseInt: parsing \"first\": invalid syntax",
+				"manifests.yaml:8: warning: Ingress awesome-ns/ing: annotation alb.ingress.kubernetes.io/healthcheck-paht: unknown annotation, it's ignored by the controller",
+				"manifests.yaml:9: error: Ingress awesome-ns/ing: annotation alb.ingress.kubernetes.io/actions.forward: precisely one of TargetGroupArn and ForwardConfig can be specified",
+				"manifests.yaml:10: error: Ingress awesome-ns/ing: annotation alb.ingress.kubernetes.io/tags: failed to parse stringMap annotation, alb.ingress.kubernetes.io/tags: team",
+			},
+		},
+		{
+			name: "Ingress rejected by webhook",
+			manifests: `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing
+  annotations:
+    alb.ingress.kubernetes.io/waf-fail-open: "true"
+    alb.ingress.kubernetes.io/load-balancer-attributes: waf.fail_open.enabled=false
+spec:
+  ingressClassName: alb
+`,
+			want: []string{
+				"manifests.yaml:6: error: Ingress default/ing: annotation alb.ingress.kubernetes.io/waf-fail-open: conflicting `alb.ingress.kubernetes.io/waf-fail-open` annotation and waf.fail_open.enabled load balancer attribute",
+			},
+		},
+		{
+			name: "Ingress with IngressClassParams restricting namespace",
+			manifests: `apiVersion: elbv2.k8s.aws/v1beta1
+kind: IngressClassParams
+metadata:
+  name: restricted
+spec:
+  namespaceSelector:
+    matchLabels:
+      team: payments
+---
+apiVersion: networking.k8s.io/v1
+kind: IngressClass
+metadata:
+  name: restricted
+spec:
+  controller: ingress.k8s.aws/alb
+  parameters:
+    apiGroup: elbv2.k8s.aws
+    kind: IngressClassParams
+    name: restricted
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing
+  annotations:
+    alb.ingress.kubernetes.io/group.name: awesome-group
+spec:
+  ingressClassName: restricted
+`,
+			want: []string{
+				"manifests.yaml:21: error: Ingress default/ing: invalid ingress class: namespaceSelector of IngressClassParams restricted mismatch",
+			},
+		},
+		{
+			name: "objects of other controllers are ignored",
+			manifests: `apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing
+  annotations:
+    alb.ingress.kubernetes.io/group.order: first
+spec:
+  ingressClassName: nginx
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-internal: "maybe"
+spec:
+  type: LoadBalancer
+`,
+		},
+		{
+			name: "invalid Service annotations",
+			manifests: `apiVersion: v1
+kind: Service
+metadata:
+  name: svc
+  annotations:
+    service.beta.kubernetes.io/aws-load-balancer-type: external
+    service.beta.kubernetes.io/aws-load-balancer-nlb-target-type: ip
+    service.beta.kubernetes.io/aws-load-balancer-internal: "maybe"
+    service.beta.kubernetes.io/aws-load-balancer-extra-security-groups: sg-1
+    service.beta.kubernetes.io/load-balancer-source-ranges: 10.0.0.0/8
+spec:
+  type: LoadBalancer
+`,
+			want: []string{
+				"manifests.yaml:8: error: Service default/svc: annotation service.beta.kubernetes.io/aws-load-balancer-internal: failed to parse bool annotation, service.beta.kubernetes.io/aws-load-balancer-internal: maybe: strconv.ParseBool: parsing \"maybe\": invalid syntax",
+				"manifests.yaml:9: warning: Service default/svc: annotation service.beta.kubernetes.io/aws-load-balancer-extra-security-groups: unknown annotation, it's ignored by the controller",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			_ = elbv2api.AddToScheme(scheme)
+			manifests, err := LoadManifests("manifests.yaml", []byte(tt.manifests), scheme)
+			assert.NoError(t, err)
+			controllerCFG := config.ControllerConfig{
+				IngressConfig: config.IngressConfig{IngressClass: "alb"},
+				ServiceConfig: config.ServiceConfig{LoadBalancerClass: "service.k8s.aws/nlb"},
+				FeatureGates:  config.NewFeatureGates(),
+			}
+			linter := NewLinter(controllerCFG, scheme, logr.Discard())
+			var got []string
+			for _, finding := range linter.Lint(context.Background(), manifests) {
+				got = append(got, finding.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Manifest is a Kubernetes object decoded from a manifest file, together with its location.
+type Manifest struct {
+	// The path of the manifest file.
+	File string
+	// The line the object starts at.
+	Line int
+	// The decoded object.
+	Object client.Object
+
+	// node is the YAML mapping node of the object, to locate its annotations.
+	node *yaml.Node
+}
+
+// AnnotationLine returns the line of the annotation of key, or the line of the object if it doesn't exist.
+func (m Manifest) AnnotationLine(key string) int {
+	annotationsNode := lookupMappingValue(lookupMappingValue(m.node, "metadata"), "annotations")
+	if annotationsNode == nil {
+		return m.Line
+	}
+	for i := 0; i+1 < len(annotationsNode.Content); i += 2 {
+		if annotationsNode.Content[i].Value == key {
+			return annotationsNode.Content[i].Line
+		}
+	}
+	return m.Line
+}
+
+// LoadManifests decodes the objects in the YAML documents of data, which is read from file.
+// objects of kinds not registered in scheme are ignored.
+func LoadManifests(file string, data []byte, scheme *runtime.Scheme) ([]Manifest, error) {
+	deserializer := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	var manifests []Manifest
+	for {
+		var doc yaml.Node
+		if err := decoder.Decode(&doc); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, errors.Wrapf(err, "failed to parse %v", file)
+		}
+		if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+			continue
+		}
+		node := doc.Content[0]
+		var raw map[string]interface{}
+		if err := node.Decode(&raw); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %v:%v", file, node.Line)
+		}
+		rawJSON, err := json.Marshal(raw)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %v:%v", file, node.Line)
+		}
+		obj, _, err := deserializer.Decode(rawJSON, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) || runtime.IsMissingKind(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to decode %v:%v", file, node.Line)
+		}
+		clientObj, ok := obj.(client.Object)
+		if !ok {
+			continue
+		}
+		manifests = append(manifests, Manifest{
+			File:   file,
+			Line:   node.Line,
+			Object: clientObj,
+			node:   node,
+		})
+	}
+	return manifests, nil
+}
+
+// lookupMappingValue returns the value of key in the mapping node, or nil if it doesn't exist.
+func lookupMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestLoadManifests(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	data := []byte(`# leading comment
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing-1
+  annotations:
+    alb.ingress.kubernetes.io/scheme: internal
+    alb.ingress.kubernetes.io/group.name: awesome-group
+---
+---
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: unknown
+---
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata:
+  name: ing-2
+`)
+	manifests, err := LoadManifests("ing.yaml", data, scheme)
+	assert.NoError(t, err)
+	assert.Len(t, manifests, 2)
+
+	assert.Equal(t, "ing.yaml", manifests[0].File)
+	assert.Equal(t, 2, manifests[0].Line)
+	assert.Equal(t, "ing-1", manifests[0].Object.(*networking.Ingress).Name)
+	assert.Equal(t, 8, manifests[0].AnnotationLine("alb.ingress.kubernetes.io/group.name"))
+	assert.Equal(t, 2, manifests[0].AnnotationLine("alb.ingress.kubernetes.io/subnets"))
+
+	assert.Equal(t, 16, manifests[1].Line)
+	assert.Equal(t, "ing-2", manifests[1].Object.(*networking.Ingress).Name)
+	assert.Equal(t, 16, manifests[1].AnnotationLine("alb.ingress.kubernetes.io/scheme"))
+
+	_, err = LoadManifests("invalid.yaml", []byte("kind: [\n"), scheme)
+	assert.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	awsmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/aws/metrics"
+)
+
+const (
+	metricReconcileAWSAPICalls    = "reconcile_aws_api_calls"
+	metricReconcileAWSAPIRequests = "reconcile_aws_api_requests"
+)
+
+const (
+	labelService   = "service"
+	labelOperation = "operation"
+)
+
+// ReconcileAPICallReporter reports the AWS API calls made by each reconcile, so that the cost of reconciling an object
+// can be quantified in AWS API calls.
+type ReconcileAPICallReporter struct {
+	reconcileAWSAPICalls    *prometheus.HistogramVec
+	reconcileAWSAPIRequests *prometheus.HistogramVec
+	logger                  logr.Logger
+}
+
+// NewReconcileAPICallReporter constructs new ReconcileAPICallReporter and registers the metrics to registerer.
+func NewReconcileAPICallReporter(registerer prometheus.Registerer, logger logr.Logger) (*ReconcileAPICallReporter, error) {
+	buckets := []float64{1, 2, 5, 10, 20, 50, 100, 200, 500}
+	reconcileAWSAPICalls := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemController,
+		Name:      metricReconcileAWSAPICalls,
+		Help:      "Number of AWS API calls per reconcile by controller and AWS API operation",
+		Buckets:   buckets,
+	}, []string{labelController, labelService, labelOperation})
+	reconcileAWSAPIRequests := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemController,
+		Name:      metricReconcileAWSAPIRequests,
+		Help:      "Number of AWS API requests including retries per reconcile by controller and AWS API operation",
+		Buckets:   buckets,
+	}, []string{labelController, labelService, labelOperation})
+
+	if err := registerer.Register(reconcileAWSAPICalls); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricReconcileAWSAPICalls)
+	}
+	if err := registerer.Register(reconcileAWSAPIRequests); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricReconcileAWSAPIRequests)
+	}
+	return &ReconcileAPICallReporter{
+		reconcileAWSAPICalls:    reconcileAWSAPICalls,
+		reconcileAWSAPIRequests: reconcileAWSAPIRequests,
+		logger:                  logger,
+	}, nil
+}
+
+// StartReconcile returns a copy of ctx that counts the AWS API calls made by the reconcile, along with the counter.
+func (r *ReconcileAPICallReporter) StartReconcile(ctx context.Context) (context.Context, *awsmetrics.APICallCounter) {
+	if r == nil {
+		return ctx, nil
+	}
+	counter := awsmetrics.NewAPICallCounter()
+	return awsmetrics.ContextWithAPICallCounter(ctx, counter), counter
+}
+
+// ReportReconcile reports the AWS API calls counted by counter for the reconcile of object with key by controller.
+func (r *ReconcileAPICallReporter) ReportReconcile(controller string, key types.NamespacedName, counter *awsmetrics.APICallCounter) {
+	if r == nil || counter == nil {
+		return
+	}
+	counts := counter.Counts()
+	apiCalls := make([]awsmetrics.APICall, 0, len(counts))
+	for apiCall := range counts {
+		apiCalls = append(apiCalls, apiCall)
+	}
+	sort.Slice(apiCalls, func(i, j int) bool {
+		return apiCalls[i].String() < apiCalls[j].String()
+	})
+
+	totalCalls, totalRequests := 0, 0
+	breakdown := make([]string, 0, len(apiCalls))
+	for _, apiCall := range apiCalls {
+		count := counts[apiCall]
+		totalCalls += count.Calls
+		totalRequests += count.Requests
+		breakdown = append(breakdown, formatAPICallCount(apiCall, count))
+		labels := map[string]string{
+			labelController: controller,
+			labelService:    apiCall.Service,
+			labelOperation:  apiCall.Operation,
+		}
+		r.reconcileAWSAPICalls.With(labels).Observe(float64(count.Calls))
+		r.reconcileAWSAPIRequests.With(labels).Observe(float64(count.Requests))
+	}
+	r.logger.Info("reconcile AWS API calls",
+		"controller", controller,
+		"key", key.String(),
+		"calls", totalCalls,
+		"requests", totalRequests,
+		"breakdown", breakdown)
+}
+
+// formatAPICallCount formats the count of AWS API call, e.g. "EC2/DescribeSubnets: calls=2 requests=3".
+func formatAPICallCount(apiCall awsmetrics.APICall, count awsmetrics.APICallCount) string {
+	return fmt.Sprintf("%v: calls=%d requests=%d", apiCall, count.Calls, count.Requests)
+}
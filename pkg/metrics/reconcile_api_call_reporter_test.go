@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	awsmetrics "sigs.k8s.io/aws-load-balancer-controller/pkg/aws/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func TestReconcileAPICallReporter(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	reporter, err := NewReconcileAPICallReporter(registry, log.Log)
+	assert.NoError(t, err)
+
+	ctx, counter := reporter.StartReconcile(context.Background())
+	assert.Same(t, counter, awsmetrics.ContextGetAPICallCounter(ctx))
+	reporter.ReportReconcile("service", types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}, counter)
+	assert.Equal(t, 0, testutil.CollectAndCount(registry))
+
+	var nilReporter *ReconcileAPICallReporter
+	ctx, counter = nilReporter.StartReconcile(context.Background())
+	assert.Nil(t, counter)
+	assert.Nil(t, awsmetrics.ContextGetAPICallCounter(ctx))
+	nilReporter.ReportReconcile("service", types.NamespacedName{Namespace: "awesome-ns", Name: "svc-1"}, counter)
+}
+
+func Test_formatAPICallCount(t *testing.T) {
+	got := formatAPICallCount(awsmetrics.APICall{Service: "EC2", Operation: "DescribeSubnets"}, awsmetrics.APICallCount{Calls: 2, Requests: 3})
+	assert.Equal(t, "EC2/DescribeSubnets: calls=2 requests=3", got)
+}
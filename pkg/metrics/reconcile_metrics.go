@@ -0,0 +1,118 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+const (
+	metricSubsystemController = "controller"
+
+	metricReconcileTotal           = "reconcile_total"
+	metricReconcileDurationSeconds = "reconcile_duration_seconds"
+)
+
+const (
+	labelController = "controller"
+	labelResult     = "result"
+	labelErrorCode  = "error_code"
+)
+
+const (
+	ReconcileResultSuccess      = "success"
+	ReconcileResultRequeue      = "requeue"
+	ReconcileResultRequeueAfter = "requeue_after"
+	ReconcileResultError        = "error"
+
+	// errorCodeInternal is the error code for reconcile errors not originated from AWS APIs.
+	errorCodeInternal = "internal"
+)
+
+// ReconcileMetrics instruments the reconcile outcomes per controller.
+type ReconcileMetrics struct {
+	reconcileTotal           *prometheus.CounterVec
+	reconcileDurationSeconds *prometheus.HistogramVec
+}
+
+// NewReconcileMetrics constructs new ReconcileMetrics and registers the metrics to registerer.
+func NewReconcileMetrics(registerer prometheus.Registerer) (*ReconcileMetrics, error) {
+	reconcileTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: metricSubsystemController,
+		Name:      metricReconcileTotal,
+		Help:      "Total number of reconciles per controller by result, with the AWS error code for failed reconciles",
+	}, []string{labelController, labelResult, labelErrorCode})
+	reconcileDurationSeconds := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemController,
+		Name:      metricReconcileDurationSeconds,
+		Help:      "Latency of reconciles per controller by result",
+		Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{labelController, labelResult})
+
+	if err := registerer.Register(reconcileTotal); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricReconcileTotal)
+	}
+	if err := registerer.Register(reconcileDurationSeconds); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricReconcileDurationSeconds)
+	}
+	return &ReconcileMetrics{
+		reconcileTotal:           reconcileTotal,
+		reconcileDurationSeconds: reconcileDurationSeconds,
+	}, nil
+}
+
+// ObserveReconcile records the outcome of a reconcile for controller that started at startTime and returned err.
+func (m *ReconcileMetrics) ObserveReconcile(controller string, startTime time.Time, err error) {
+	if m == nil {
+		return
+	}
+	result := reconcileResultForError(err)
+	errorCode := ""
+	if result == ReconcileResultError {
+		errorCode = errorCodeForReconcileError(err)
+	}
+	m.reconcileTotal.With(map[string]string{
+		labelController: controller,
+		labelResult:     result,
+		labelErrorCode:  errorCode,
+	}).Inc()
+	m.reconcileDurationSeconds.With(map[string]string{
+		labelController: controller,
+		labelResult:     result,
+	}).Observe(time.Since(startTime).Seconds())
+}
+
+// reconcileResultForError returns the reconcile result for error returned by reconcile handlers.
+// it classifies errors the same way as runtime.HandleReconcileError.
+func reconcileResultForError(err error) string {
+	if err == nil {
+		return ReconcileResultSuccess
+	}
+	var requeueNeededAfter *runtime.RequeueNeededAfter
+	if errors.As(err, &requeueNeededAfter) {
+		return ReconcileResultRequeueAfter
+	}
+	var requeueNeeded *runtime.RequeueNeeded
+	if errors.As(err, &requeueNeeded) {
+		return ReconcileResultRequeue
+	}
+	return ReconcileResultError
+}
+
+// errorCodeForReconcileError returns the AWS error code of the reconcile error.
+// if the error isn't originated from AWS APIs, returns "internal".
+func errorCodeForReconcileError(err error) string {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code()
+	}
+	return errorCodeInternal
+}
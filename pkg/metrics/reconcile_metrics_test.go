@@ -0,0 +1,109 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
+)
+
+func Test_reconcileResultForError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "no error",
+			err:  nil,
+			want: ReconcileResultSuccess,
+		},
+		{
+			name: "requeue needed",
+			err:  runtime.NewRequeueNeeded("monitor provisioning state"),
+			want: ReconcileResultRequeue,
+		},
+		{
+			name: "wrapped requeue needed after",
+			err:  errors.Wrap(runtime.NewRequeueNeededAfter("monitor targetHealth", time.Second), "reconcile"),
+			want: ReconcileResultRequeueAfter,
+		},
+		{
+			name: "plain error",
+			err:  errors.New("some error"),
+			want: ReconcileResultError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reconcileResultForError(tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_errorCodeForReconcileError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "aws error",
+			err:  awserr.New("Throttling", "Rate exceeded", nil),
+			want: "Throttling",
+		},
+		{
+			name: "wrapped aws error",
+			err:  errors.Wrap(awserr.New("Throttling", "Rate exceeded", nil), "failed to create loadBalancer"),
+			want: "Throttling",
+		},
+		{
+			name: "aws-sdk-go-v2 api error",
+			err:  &smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"},
+			want: "Throttling",
+		},
+		{
+			name: "wrapped aws-sdk-go-v2 api error",
+			err:  errors.Wrap(&smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"}, "failed to create loadBalancer"),
+			want: "Throttling",
+		},
+		{
+			name: "non aws error",
+			err:  errors.New("some error"),
+			want: "internal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := errorCodeForReconcileError(tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReconcileMetrics_ObserveReconcile(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m, err := NewReconcileMetrics(registry)
+	assert.NoError(t, err)
+
+	startTime := time.Now()
+	m.ObserveReconcile("ingress", startTime, nil)
+	m.ObserveReconcile("ingress", startTime, awserr.New("Throttling", "Rate exceeded", nil))
+	m.ObserveReconcile("ingress", startTime, errors.Wrap(awserr.New("Throttling", "Rate exceeded", nil), "failed"))
+	m.ObserveReconcile("service", startTime, runtime.NewRequeueNeeded("monitor provisioning state"))
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.reconcileTotal.WithLabelValues("ingress", ReconcileResultSuccess, "")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.reconcileTotal.WithLabelValues("ingress", ReconcileResultError, "Throttling")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.reconcileTotal.WithLabelValues("service", ReconcileResultRequeue, "")))
+	assert.Equal(t, 3, testutil.CollectAndCount(m.reconcileDurationSeconds))
+
+	var nilMetrics *ReconcileMetrics
+	nilMetrics.ObserveReconcile("ingress", startTime, nil)
+}
@@ -0,0 +1,69 @@
+package ec2
+
+import (
+	"context"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &ElasticIP{}
+
+// ElasticIP represents a EC2 Elastic IP address allocated for an internet-facing Network LoadBalancer.
+type ElasticIP struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of ElasticIP
+	Spec ElasticIPSpec `json:"spec"`
+
+	// observed state of ElasticIP
+	Status *ElasticIPStatus `json:"status,omitempty"`
+}
+
+// NewElasticIP constructs new ElasticIP resource.
+func NewElasticIP(stack core.Stack, id string, spec ElasticIPSpec) *ElasticIP {
+	eip := &ElasticIP{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::EC2::EIP", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(eip)
+	return eip
+}
+
+// SetStatus sets the ElasticIP's status
+func (eip *ElasticIP) SetStatus(status ElasticIPStatus) {
+	eip.Status = &status
+}
+
+// AllocationID returns a token for this ElasticIP's allocationID.
+func (eip *ElasticIP) AllocationID() core.StringToken {
+	return core.NewResourceFieldStringToken(eip, "status/allocationID",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			eip := res.(*ElasticIP)
+			if eip.Status == nil {
+				return "", errors.Errorf("ElasticIP is not fulfilled yet: %v", eip.ID())
+			}
+			return eip.Status.AllocationID, nil
+		},
+	)
+}
+
+// ElasticIPSpec defines the desired state of ElasticIP
+type ElasticIPSpec struct {
+	// The ID of the public IPv4 address pool to allocate the address from, such as a BYOIP pool.
+	// If unspecified, the address is allocated from Amazon's pool of IPv4 addresses.
+	// +optional
+	PublicIPv4Pool *string `json:"publicIPv4Pool,omitempty"`
+
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ElasticIPStatus defines the observed state of ElasticIP
+type ElasticIPStatus struct {
+	// The allocation ID of the Elastic IP address.
+	AllocationID string `json:"allocationID"`
+
+	// The Elastic IP address.
+	PublicIP string `json:"publicIP"`
+}
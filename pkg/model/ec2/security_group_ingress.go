@@ -1,5 +1,7 @@
 package ec2
 
+import "sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+
 type IPRange struct {
 	CIDRIP string `json:"cidrIP"`
 	// +optional
@@ -13,7 +15,13 @@ type IPv6Range struct {
 }
 
 type UserIDGroupPair struct {
-	GroupID string `json:"groupID"`
+	GroupID core.StringToken `json:"groupID"`
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+type PrefixList struct {
+	ListID string `json:"listID"`
 	// +optional
 	Description string `json:"description,omitempty"`
 }
@@ -30,4 +38,6 @@ type IPPermission struct {
 	IPv6Range []IPv6Range `json:"ipv6Ranges,omitempty"`
 	// +optional
 	UserIDGroupPairs []UserIDGroupPair `json:"userIDGroupPairs,omitempty"`
+	// +optional
+	PrefixLists []PrefixList `json:"prefixLists,omitempty"`
 }
@@ -0,0 +1,48 @@
+package elbv2
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &ALBTarget{}
+
+// ALBTarget represents an Application LoadBalancer registered as target of a TargetGroup with alb target type.
+type ALBTarget struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of ALBTarget
+	Spec ALBTargetSpec `json:"spec"`
+}
+
+// NewALBTarget constructs new ALBTarget resource.
+func NewALBTarget(stack core.Stack, id string, spec ALBTargetSpec) *ALBTarget {
+	target := &ALBTarget{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::ElasticLoadBalancingV2::ALBTarget", id),
+		Spec:         spec,
+	}
+	stack.AddResource(target)
+	target.registerDependencies(stack)
+	return target
+}
+
+// register dependencies for ALBTarget.
+func (t *ALBTarget) registerDependencies(stack core.Stack) {
+	for _, dep := range t.Spec.TargetGroupARN.Dependencies() {
+		stack.AddDependency(dep, t)
+	}
+	for _, dep := range t.Spec.LoadBalancerARN.Dependencies() {
+		stack.AddDependency(dep, t)
+	}
+}
+
+// ALBTargetSpec defines the desired state of ALBTarget
+type ALBTargetSpec struct {
+	// The Amazon Resource Name (ARN) of the TargetGroup with alb target type.
+	TargetGroupARN core.StringToken `json:"targetGroupARN"`
+
+	// The Amazon Resource Name (ARN) of the Application LoadBalancer to register as target.
+	LoadBalancerARN core.StringToken `json:"loadBalancerARN"`
+
+	// The port on which the Application LoadBalancer listens, must match the port of TargetGroup.
+	Port int64 `json:"port"`
+}
@@ -56,6 +56,11 @@ func (ls *Listener) registerDependencies(stack core.Stack) {
 	for _, dep := range ls.Spec.LoadBalancerARN.Dependencies() {
 		stack.AddDependency(dep, ls)
 	}
+	if ls.Spec.MutualAuthentication != nil && ls.Spec.MutualAuthentication.TrustStoreARN != nil {
+		for _, dep := range ls.Spec.MutualAuthentication.TrustStoreARN.Dependencies() {
+			stack.AddDependency(dep, ls)
+		}
+	}
 }
 
 type Protocol string
@@ -304,6 +309,37 @@ const (
 	ALPNPolicyHTTP2Preferred ALPNPolicy = "HTTP2Preferred"
 )
 
+type MutualAuthenticationMode string
+
+const (
+	MutualAuthenticationModeOff         MutualAuthenticationMode = "off"
+	MutualAuthenticationModePassthrough MutualAuthenticationMode = "passthrough"
+	MutualAuthenticationModeVerify      MutualAuthenticationMode = "verify"
+)
+
+// MutualAuthenticationAttributes defines the mutual authentication configuration for a HTTPS listener.
+type MutualAuthenticationAttributes struct {
+	// The client certificate handling method.
+	Mode MutualAuthenticationMode `json:"mode"`
+
+	// The Amazon Resource Name (ARN) of the trust store.
+	// +optional
+	TrustStoreARN core.StringToken `json:"trustStoreARN,omitempty"`
+
+	// Indicates whether expired client certificates are ignored.
+	// +optional
+	IgnoreClientCertificateExpiry *bool `json:"ignoreClientCertificateExpiry,omitempty"`
+}
+
+// Information about a listener attribute.
+type ListenerAttribute struct {
+	// The name of the attribute.
+	Key string `json:"key"`
+
+	// The value of the attribute.
+	Value string `json:"value"`
+}
+
 // ListenerSpec defines the desired state of Listener
 type ListenerSpec struct {
 	// The Amazon Resource Name (ARN) of the load balancer.
@@ -332,6 +368,14 @@ type ListenerSpec struct {
 	// +optional
 	ALPNPolicy []string `json:"alpnPolicy,omitempty"`
 
+	// [HTTPS listener] The mutual authentication configuration.
+	// +optional
+	MutualAuthentication *MutualAuthenticationAttributes `json:"mutualAuthentication,omitempty"`
+
+	// The listener attributes.
+	// +optional
+	ListenerAttributes []ListenerAttribute `json:"listenerAttributes,omitempty"`
+
 	// The tags.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
@@ -63,6 +63,19 @@ func (lb *LoadBalancer) DNSName() core.StringToken {
 	)
 }
 
+// CanonicalHostedZoneID returns The ID of the Amazon Route 53 hosted zone associated with the load balancer.
+func (lb *LoadBalancer) CanonicalHostedZoneID() core.StringToken {
+	return core.NewResourceFieldStringToken(lb, "status/canonicalHostedZoneID",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			lb := res.(*LoadBalancer)
+			if lb.Status == nil {
+				return "", errors.Errorf("LoadBalancer is not fulfilled yet: %v", lb.ID())
+			}
+			return lb.Status.CanonicalHostedZoneID, nil
+		},
+	)
+}
+
 // register dependencies for LoadBalancer.
 func (lb *LoadBalancer) registerDependencies(stack core.Stack) {
 	for _, sgToken := range lb.Spec.SecurityGroups {
@@ -72,6 +85,9 @@ func (lb *LoadBalancer) registerDependencies(stack core.Stack) {
 	}
 }
 
+// LoadBalancerNameMaxLength is the max number of characters in load balancer names.
+const LoadBalancerNameMaxLength = 32
+
 type LoadBalancerType string
 
 const (
@@ -82,10 +98,16 @@ const (
 type IPAddressType string
 
 const (
-	IPAddressTypeIPV4      IPAddressType = "ipv4"
-	IPAddressTypeDualStack IPAddressType = "dualstack"
+	IPAddressTypeIPV4                       IPAddressType = "ipv4"
+	IPAddressTypeDualStack                  IPAddressType = "dualstack"
+	IPAddressTypeDualStackWithoutPublicIPV4 IPAddressType = "dualstack-without-public-ipv4"
 )
 
+// IsDualStack checks whether the LoadBalancer is assigned IPv6 addresses, thus accepts IPv6 traffic and routes to IPv6 targets.
+func (t IPAddressType) IsDualStack() bool {
+	return t == IPAddressTypeDualStack || t == IPAddressTypeDualStackWithoutPublicIPV4
+}
+
 type LoadBalancerScheme string
 
 const (
@@ -97,7 +119,7 @@ const (
 type SubnetMapping struct {
 	// [Network Load Balancers] The allocation ID of the Elastic IP address for
 	// an internet-facing load balancer.
-	AllocationID *string `json:"allocationID,omitempty"`
+	AllocationID core.StringToken `json:"allocationID,omitempty"`
 
 	// [Network Load Balancers] The private IPv4 address for an internal load balancer.
 	PrivateIPv4Address *string `json:"privateIPv4Address,omitempty"`
@@ -109,6 +131,18 @@ type SubnetMapping struct {
 	SubnetID string `json:"subnetID"`
 }
 
+// Information about the IPAM pools of a load balancer.
+type IPAMPools struct {
+	// The ID of the IPv4 IPAM pool to source the public IPv4 addresses from.
+	IPv4IPAMPoolID string `json:"ipv4IPAMPoolID"`
+}
+
+// Information about the minimum capacity of a load balancer.
+type MinimumLoadBalancerCapacity struct {
+	// The number of capacity units reserved.
+	CapacityUnits int64 `json:"capacityUnits"`
+}
+
 // Information about a load balancer attribute.
 type LoadBalancerAttribute struct {
 	// The name of the attribute.
@@ -147,6 +181,14 @@ type LoadBalancerSpec struct {
 	// +optional
 	CustomerOwnedIPv4Pool *string `json:"customerOwnedIPv4Pool,omitempty"`
 
+	// [Application Load Balancers] The IPAM pools to source the public IP addresses from.
+	// +optional
+	IPAMPools *IPAMPools `json:"ipamPools,omitempty"`
+
+	// [Application Load Balancers] The minimum capacity reserved for the load balancer.
+	// +optional
+	MinimumLoadBalancerCapacity *MinimumLoadBalancerCapacity `json:"minimumLoadBalancerCapacity,omitempty"`
+
 	// The load balancer attributes.
 	// +optional
 	LoadBalancerAttributes []LoadBalancerAttribute `json:"loadBalancerAttributes,omitempty"`
@@ -163,4 +205,11 @@ type LoadBalancerStatus struct {
 
 	// The public DNS name of the load balancer.
 	DNSName string `json:"dnsName"`
+
+	// The ID of the Amazon Route 53 hosted zone associated with the load balancer.
+	CanonicalHostedZoneID string `json:"canonicalHostedZoneID"`
+
+	// The provisioning state of the capacity reservation, empty if no capacity is reserved.
+	// +optional
+	CapacityReservationState string `json:"capacityReservationState,omitempty"`
 }
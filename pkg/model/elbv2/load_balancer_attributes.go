@@ -0,0 +1,6 @@
+package elbv2
+
+// load balancer attributes that are also configurable via dedicated annotations or IngressClassParams settings.
+const (
+	LBAttrsWAFFailOpenEnabled = "waf.fail_open.enabled"
+)
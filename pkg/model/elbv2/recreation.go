@@ -0,0 +1,91 @@
+package elbv2
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+// RecreationTokenTagKey is the tag of the resources recreated on request, whose value is the token of the last recreation.
+// resources are recreated once per token, i.e. when the token of the resource differs from the token of its AWS resource.
+const RecreationTokenTagKey = "elbv2.k8s.aws/recreation-token"
+
+// ApplyRecreationTokens requests the recreation of the TargetGroups and Listeners of stack, recreationTokens maps their resource IDs to the tokens.
+// the recreated TargetGroups are renamed along with their TargetGroupBindings, so that they're created before the old ones are deleted.
+func ApplyRecreationTokens(stack core.Stack, recreationTokens map[string]string) error {
+	if len(recreationTokens) == 0 {
+		return nil
+	}
+	var resTGs []*TargetGroup
+	stack.ListResources(&resTGs)
+	var resLSs []*Listener
+	stack.ListResources(&resLSs)
+	var resTGBs []*TargetGroupBindingResource
+	stack.ListResources(&resTGBs)
+
+	appliedResIDs := make(map[string]struct{}, len(recreationTokens))
+	tgNameByResID := make(map[string]string)
+	for _, resTG := range resTGs {
+		token, ok := recreationTokens[resTG.ID()]
+		if !ok {
+			continue
+		}
+		resTG.Spec.Tags = withRecreationToken(resTG.Spec.Tags, token)
+		tgNameByResID[resTG.ID()] = buildRecreatedTargetGroupName(resTG.Spec.Name, token)
+		resTG.Spec.Name = tgNameByResID[resTG.ID()]
+		appliedResIDs[resTG.ID()] = struct{}{}
+	}
+	for _, resTGB := range resTGBs {
+		if tgName, ok := tgNameByResID[resTGB.ID()]; ok {
+			resTGB.Spec.Template.Name = tgName
+		}
+	}
+	for _, resLS := range resLSs {
+		token, ok := recreationTokens[resLS.ID()]
+		if !ok {
+			continue
+		}
+		resLS.Spec.Tags = withRecreationToken(resLS.Spec.Tags, token)
+		appliedResIDs[resLS.ID()] = struct{}{}
+	}
+
+	var unknownResIDs []string
+	for resID := range recreationTokens {
+		if _, ok := appliedResIDs[resID]; !ok {
+			unknownResIDs = append(unknownResIDs, resID)
+		}
+	}
+	if len(unknownResIDs) != 0 {
+		sort.Strings(unknownResIDs)
+		return errors.Errorf("cannot recreate resources %v, only target groups and listeners of the stack can be recreated", unknownResIDs)
+	}
+	return nil
+}
+
+func withRecreationToken(tags map[string]string, token string) map[string]string {
+	tagsWithToken := make(map[string]string, len(tags)+1)
+	for key, val := range tags {
+		tagsWithToken[key] = val
+	}
+	tagsWithToken[RecreationTokenTagKey] = token
+	return tagsWithToken
+}
+
+// buildRecreatedTargetGroupName replaces the hash suffix of the targetGroup's name with a hash of the name and token,
+// as the new targetGroup cannot have the name of the old one.
+func buildRecreatedTargetGroupName(name string, token string) string {
+	nameHash := sha256.New()
+	_, _ = nameHash.Write([]byte(name))
+	_, _ = nameHash.Write([]byte(token))
+	hash := hex.EncodeToString(nameHash.Sum(nil))
+	prefix := name
+	if idx := strings.LastIndex(name, "-"); idx > 0 {
+		prefix = name[:idx]
+	}
+	return fmt.Sprintf("%.21s-%.10s", prefix, hash)
+}
@@ -0,0 +1,79 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+func TestApplyRecreationTokens(t *testing.T) {
+	tests := []struct {
+		name             string
+		recreationTokens map[string]string
+		wantTGName       string
+		wantTGTags       map[string]string
+		wantLSTags       map[string]string
+		wantErr          error
+	}{
+		{
+			name:       "no recreation tokens",
+			wantTGName: "k8s-ns-svc-1234567890",
+			wantTGTags: map[string]string{"key": "value"},
+		},
+		{
+			name:             "targetGroup is renamed along with its targetGroupBinding",
+			recreationTokens: map[string]string{"ns/svc:80": "token-1"},
+			wantTGName:       "k8s-ns-svc-dea4a31ded",
+			wantTGTags:       map[string]string{"key": "value", RecreationTokenTagKey: "token-1"},
+		},
+		{
+			name:             "listener is tagged with the token",
+			recreationTokens: map[string]string{"80": "token-1"},
+			wantTGName:       "k8s-ns-svc-1234567890",
+			wantTGTags:       map[string]string{"key": "value"},
+			wantLSTags:       map[string]string{RecreationTokenTagKey: "token-1"},
+		},
+		{
+			name:             "unknown resources cannot be recreated",
+			recreationTokens: map[string]string{"LoadBalancer": "token-1", "ns/other:80": "token-1"},
+			wantTGName:       "k8s-ns-svc-1234567890",
+			wantTGTags:       map[string]string{"key": "value"},
+			wantErr:          errors.New("cannot recreate resources [LoadBalancer ns/other:80], only target groups and listeners of the stack can be recreated"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID{Namespace: "ns", Name: "ing"})
+			tg := NewTargetGroup(stack, "ns/svc:80", TargetGroupSpec{
+				Name: "k8s-ns-svc-1234567890",
+				Tags: map[string]string{"key": "value"},
+			})
+			tgb := NewTargetGroupBindingResource(stack, tg.ID(), TargetGroupBindingResourceSpec{
+				Template: TargetGroupBindingTemplate{
+					ObjectMeta: metav1.ObjectMeta{Name: tg.Spec.Name},
+					Spec: TargetGroupBindingSpec{
+						TargetGroupARN: tg.TargetGroupARN(),
+					},
+				},
+			})
+			ls := NewListener(stack, "80", ListenerSpec{
+				LoadBalancerARN: core.LiteralStringToken("lb-arn"),
+				Port:            80,
+			})
+
+			err := ApplyRecreationTokens(stack, tt.recreationTokens)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantTGName, tg.Spec.Name)
+			assert.Equal(t, tt.wantTGName, tgb.Spec.Template.Name)
+			assert.Equal(t, tt.wantTGTags, tg.Spec.Tags)
+			assert.Equal(t, tt.wantLSTags, ls.Spec.Tags)
+		})
+	}
+}
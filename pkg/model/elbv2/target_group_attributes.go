@@ -0,0 +1,81 @@
+package elbv2
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// target group attributes controlling the behavior for unhealthy targets and target groups.
+const (
+	TGAttrsUnhealthyConnectionTerminationEnabled = "target_health_state.unhealthy.connection_termination.enabled"
+	TGAttrsUnhealthyDrainingIntervalSeconds      = "target_health_state.unhealthy.draining_interval_seconds"
+
+	TGAttrsDNSFailoverMinimumHealthyTargetsCount                = "target_group_health.dns_failover.minimum_healthy_targets.count"
+	TGAttrsDNSFailoverMinimumHealthyTargetsPercentage           = "target_group_health.dns_failover.minimum_healthy_targets.percentage"
+	TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsCount      = "target_group_health.unhealthy_state_routing.minimum_healthy_targets.count"
+	TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsPercentage = "target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage"
+
+	// TGAttrsValueOff disables the minimum healthy targets thresholds.
+	TGAttrsValueOff = "off"
+
+	maxUnhealthyDrainingIntervalSeconds = 360000
+)
+
+// ValidateTargetGroupHealthAttributes validates the target health state and target group health attributes within attrs,
+// so that invalid values are rejected upfront instead of failing to modify the target group attributes.
+func ValidateTargetGroupHealthAttributes(attrs map[string]string) error {
+	connectionTermination := true
+	if rawValue, ok := attrs[TGAttrsUnhealthyConnectionTerminationEnabled]; ok {
+		value, err := strconv.ParseBool(rawValue)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse target group attribute %v=%v", TGAttrsUnhealthyConnectionTerminationEnabled, rawValue)
+		}
+		connectionTermination = value
+	}
+	if rawValue, ok := attrs[TGAttrsUnhealthyDrainingIntervalSeconds]; ok {
+		value, err := strconv.ParseInt(rawValue, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse target group attribute %v=%v", TGAttrsUnhealthyDrainingIntervalSeconds, rawValue)
+		}
+		if value < 0 || value > maxUnhealthyDrainingIntervalSeconds {
+			return errors.Errorf("target group attribute %v=%v must be between 0 and %v",
+				TGAttrsUnhealthyDrainingIntervalSeconds, rawValue, maxUnhealthyDrainingIntervalSeconds)
+		}
+		// unhealthy targets are only drained if their connections aren't terminated right away.
+		if value != 0 && connectionTermination {
+			return errors.Errorf("target group attribute %v=%v requires %v=false",
+				TGAttrsUnhealthyDrainingIntervalSeconds, rawValue, TGAttrsUnhealthyConnectionTerminationEnabled)
+		}
+	}
+	if err := validateMinimumHealthyTargetsAttribute(attrs, TGAttrsDNSFailoverMinimumHealthyTargetsCount, true, 0); err != nil {
+		return err
+	}
+	if err := validateMinimumHealthyTargetsAttribute(attrs, TGAttrsDNSFailoverMinimumHealthyTargetsPercentage, true, 100); err != nil {
+		return err
+	}
+	if err := validateMinimumHealthyTargetsAttribute(attrs, TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsCount, false, 0); err != nil {
+		return err
+	}
+	if err := validateMinimumHealthyTargetsAttribute(attrs, TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsPercentage, true, 100); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateMinimumHealthyTargetsAttribute validates the minimum healthy targets attribute attrKey is a positive number up to maxValue,
+// maxValue 0 means unbounded. The attribute can be "off" if allowOff is true.
+func validateMinimumHealthyTargetsAttribute(attrs map[string]string, attrKey string, allowOff bool, maxValue int64) error {
+	rawValue, ok := attrs[attrKey]
+	if !ok || (allowOff && rawValue == TGAttrsValueOff) {
+		return nil
+	}
+	value, err := strconv.ParseInt(rawValue, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse target group attribute %v=%v", attrKey, rawValue)
+	}
+	if value < 1 || (maxValue != 0 && value > maxValue) {
+		return errors.Errorf("target group attribute %v=%v is out of range", attrKey, rawValue)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package elbv2
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTargetGroupHealthAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		attrs   map[string]string
+		wantErr error
+	}{
+		{
+			name: "no target health attributes",
+			attrs: map[string]string{
+				"deregistration_delay.timeout_seconds": "30",
+			},
+		},
+		{
+			name: "draining interval with connection termination disabled",
+			attrs: map[string]string{
+				TGAttrsUnhealthyConnectionTerminationEnabled: "false",
+				TGAttrsUnhealthyDrainingIntervalSeconds:      "300",
+			},
+		},
+		{
+			name: "draining interval with connection termination enabled by default",
+			attrs: map[string]string{
+				TGAttrsUnhealthyDrainingIntervalSeconds: "300",
+			},
+			wantErr: errors.New("target group attribute target_health_state.unhealthy.draining_interval_seconds=300 requires target_health_state.unhealthy.connection_termination.enabled=false"),
+		},
+		{
+			name: "draining interval out of range",
+			attrs: map[string]string{
+				TGAttrsUnhealthyConnectionTerminationEnabled: "false",
+				TGAttrsUnhealthyDrainingIntervalSeconds:      "360001",
+			},
+			wantErr: errors.New("target group attribute target_health_state.unhealthy.draining_interval_seconds=360001 must be between 0 and 360000"),
+		},
+		{
+			name: "invalid connection termination",
+			attrs: map[string]string{
+				TGAttrsUnhealthyConnectionTerminationEnabled: "maybe",
+			},
+			wantErr: errors.New("failed to parse target group attribute target_health_state.unhealthy.connection_termination.enabled=maybe: strconv.ParseBool: parsing \"maybe\": invalid syntax"),
+		},
+		{
+			name: "minimum healthy targets",
+			attrs: map[string]string{
+				TGAttrsDNSFailoverMinimumHealthyTargetsCount:                "off",
+				TGAttrsDNSFailoverMinimumHealthyTargetsPercentage:           "50",
+				TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsCount:      "2",
+				TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsPercentage: "off",
+			},
+		},
+		{
+			name: "unhealthy state routing count cannot be off",
+			attrs: map[string]string{
+				TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsCount: "off",
+			},
+			wantErr: errors.New("failed to parse target group attribute target_group_health.unhealthy_state_routing.minimum_healthy_targets.count=off: strconv.ParseInt: parsing \"off\": invalid syntax"),
+		},
+		{
+			name: "percentage out of range",
+			attrs: map[string]string{
+				TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsPercentage: "101",
+			},
+			wantErr: errors.New("target group attribute target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage=101 is out of range"),
+		},
+		{
+			name: "count out of range",
+			attrs: map[string]string{
+				TGAttrsDNSFailoverMinimumHealthyTargetsCount: "0",
+			},
+			wantErr: errors.New("target group attribute target_group_health.dns_failover.minimum_healthy_targets.count=0 is out of range"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTargetGroupHealthAttributes(tt.attrs)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
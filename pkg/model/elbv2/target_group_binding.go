@@ -62,6 +62,11 @@ type NetworkingPeer struct {
 	// If specified, none of the other fields can be set.
 	// +optional
 	SecurityGroup *SecurityGroup `json:"securityGroup,omitempty"`
+
+	// PrefixList defines a managed prefix list peer.
+	// If specified, none of the other fields can be set.
+	// +optional
+	PrefixList *elbv2api.PrefixList `json:"prefixList,omitempty"`
 }
 
 type NetworkingIngressRule struct {
@@ -103,6 +108,22 @@ type TargetGroupBindingSpec struct {
 	// ipAddressType specifies whether the target group is of type IPv4 or IPv6. If unspecified, it will be automatically inferred.
 	// +optional
 	IPAddressType *elbv2api.TargetGroupIPAddressType `json:"ipAddressType,omitempty"`
+
+	// targetIPFamilyPolicy specifies the IP address family of the pod IPs registered as targets.
+	// +optional
+	TargetIPFamilyPolicy *elbv2api.TargetIPFamilyPolicy `json:"targetIPFamilyPolicy,omitempty"`
+
+	// multiClusterTargetGroup denotes if the TargetGroup is shared across multiple clusters.
+	// +optional
+	MultiClusterTargetGroup bool `json:"multiClusterTargetGroup,omitempty"`
+
+	// connectionTermination denotes if the TargetGroup terminates connections at the end of the deregistration delay.
+	// +optional
+	ConnectionTermination bool `json:"connectionTermination,omitempty"`
+
+	// awsRoleARN is the IAM role to assume when managing targets of the TargetGroup.
+	// +optional
+	AWSRoleARN string `json:"awsRoleARN,omitempty"`
 }
 
 // Template for TargetGroupBinding Custom Resource.
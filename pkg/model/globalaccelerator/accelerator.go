@@ -0,0 +1,102 @@
+package globalaccelerator
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &Accelerator{}
+
+// Accelerator represents an AWS Global Accelerator accelerator, which routes traffic from its static IP addresses to a LoadBalancer.
+type Accelerator struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of Accelerator
+	Spec AcceleratorSpec `json:"spec"`
+
+	// observed state of Accelerator
+	Status *AcceleratorStatus `json:"status,omitempty"`
+}
+
+// NewAccelerator constructs new Accelerator resource.
+func NewAccelerator(stack core.Stack, id string, spec AcceleratorSpec) *Accelerator {
+	accelerator := &Accelerator{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::GlobalAccelerator::Accelerator", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(accelerator)
+	accelerator.registerDependencies(stack)
+	return accelerator
+}
+
+// SetStatus sets the Accelerator's status
+func (a *Accelerator) SetStatus(status AcceleratorStatus) {
+	a.Status = &status
+}
+
+// AcceleratorARN returns a token for this Accelerator's acceleratorARN.
+func (a *Accelerator) AcceleratorARN() core.StringToken {
+	return core.NewResourceFieldStringToken(a, "status/acceleratorARN",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			accelerator := res.(*Accelerator)
+			if accelerator.Status == nil {
+				return "", errors.Errorf("Accelerator is not fulfilled yet: %v", accelerator.ID())
+			}
+			return accelerator.Status.AcceleratorARN, nil
+		},
+	)
+}
+
+// register dependencies for Accelerator.
+func (a *Accelerator) registerDependencies(stack core.Stack) {
+	for _, dep := range a.Spec.LoadBalancerARN.Dependencies() {
+		stack.AddDependency(dep, a)
+	}
+}
+
+// Protocol is the protocol of accelerator listeners.
+type Protocol string
+
+const (
+	ProtocolTCP Protocol = "TCP"
+	ProtocolUDP Protocol = "UDP"
+)
+
+// AcceleratorListener defines a listener of Accelerator, which forwards the traffic on its ports to the same ports of LoadBalancer.
+type AcceleratorListener struct {
+	// The protocol of listener.
+	Protocol Protocol `json:"protocol"`
+
+	// The ports of listener, in ascending order.
+	Ports []int64 `json:"ports"`
+}
+
+// AcceleratorSpec defines the desired state of Accelerator
+type AcceleratorSpec struct {
+	// The name of the accelerator.
+	Name string `json:"name"`
+
+	// The listeners of the accelerator, at most one per protocol.
+	Listeners []AcceleratorListener `json:"listeners"`
+
+	// The Amazon Resource Name (ARN) of the LoadBalancer, which is the endpoint of all listeners.
+	LoadBalancerARN core.StringToken `json:"loadBalancerARN"`
+
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// AcceleratorStatus defines the observed state of Accelerator
+type AcceleratorStatus struct {
+	// The Amazon Resource Name (ARN) of the accelerator.
+	AcceleratorARN string `json:"acceleratorARN"`
+
+	// The DNS name of the accelerator.
+	DNSName string `json:"dnsName"`
+
+	// The static IP addresses of the accelerator.
+	IPAddresses []string `json:"ipAddresses"`
+}
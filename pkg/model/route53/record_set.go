@@ -0,0 +1,81 @@
+package route53
+
+import (
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &RecordSet{}
+
+// RecordSet represents the Amazon Route 53 alias records of a DNS name, which route to a LoadBalancer.
+type RecordSet struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of RecordSet
+	Spec RecordSetSpec `json:"spec"`
+
+	// observed state of RecordSet
+	// +optional
+	Status *RecordSetStatus `json:"status,omitempty"`
+}
+
+// NewRecordSet constructs new RecordSet resource.
+func NewRecordSet(stack core.Stack, id string, spec RecordSetSpec) *RecordSet {
+	recordSet := &RecordSet{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::Route53::RecordSet", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(recordSet)
+	recordSet.registerDependencies(stack)
+	return recordSet
+}
+
+// SetStatus sets the RecordSet's status
+func (r *RecordSet) SetStatus(status RecordSetStatus) {
+	r.Status = &status
+}
+
+// register dependencies for RecordSet.
+func (r *RecordSet) registerDependencies(stack core.Stack) {
+	for _, dep := range r.Spec.AliasTarget.DNSName.Dependencies() {
+		stack.AddDependency(dep, r)
+	}
+	for _, dep := range r.Spec.AliasTarget.HostedZoneID.Dependencies() {
+		stack.AddDependency(dep, r)
+	}
+}
+
+// RecordType is the type of alias records.
+type RecordType string
+
+const (
+	RecordTypeA    RecordType = "A"
+	RecordTypeAAAA RecordType = "AAAA"
+)
+
+// AliasTarget defines the LoadBalancer the alias records route to.
+type AliasTarget struct {
+	// The DNS name of the LoadBalancer.
+	DNSName core.StringToken `json:"dnsName"`
+
+	// The ID of the hosted zone associated with the LoadBalancer.
+	HostedZoneID core.StringToken `json:"hostedZoneID"`
+}
+
+// RecordSetSpec defines the desired state of RecordSet
+type RecordSetSpec struct {
+	// The DNS name of the records, in lower case without trailing dot.
+	Name string `json:"name"`
+
+	// The types of the alias records.
+	Types []RecordType `json:"types"`
+
+	// The target of the alias records.
+	AliasTarget AliasTarget `json:"aliasTarget"`
+}
+
+// RecordSetStatus defines the observed state of RecordSet
+type RecordSetStatus struct {
+	// The ID of the hosted zone the records are in.
+	HostedZoneID string `json:"hostedZoneID"`
+}
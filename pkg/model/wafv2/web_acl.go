@@ -0,0 +1,118 @@
+package wafv2
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+)
+
+var _ core.Resource = &WebACL{}
+
+// WebACL represents a WAFv2 WebACL managed by the controller.
+type WebACL struct {
+	core.ResourceMeta `json:"-"`
+
+	// desired state of WebACL
+	Spec WebACLSpec `json:"spec"`
+
+	// observed state of WebACL
+	// +optional
+	Status *WebACLStatus `json:"status,omitempty"`
+}
+
+// NewWebACL constructs new WebACL resource.
+func NewWebACL(stack core.Stack, id string, spec WebACLSpec) *WebACL {
+	webACL := &WebACL{
+		ResourceMeta: core.NewResourceMeta(stack, "AWS::WAFv2::WebACL", id),
+		Spec:         spec,
+		Status:       nil,
+	}
+	stack.AddResource(webACL)
+	return webACL
+}
+
+// SetStatus sets the WebACL's status
+func (a *WebACL) SetStatus(status WebACLStatus) {
+	a.Status = &status
+}
+
+// WebACLARN returns The Amazon Resource Name (ARN) of the webACL.
+func (a *WebACL) WebACLARN() core.StringToken {
+	return core.NewResourceFieldStringToken(a, "status/webACLARN",
+		func(ctx context.Context, res core.Resource, fieldPath string) (s string, err error) {
+			webACL := res.(*WebACL)
+			if webACL.Status == nil {
+				return "", errors.Errorf("WebACL is not fulfilled yet: %v", webACL.ID())
+			}
+			return webACL.Status.WebACLARN, nil
+		},
+	)
+}
+
+// WebACLDefaultAction is the action for requests that don't match any rule.
+type WebACLDefaultAction string
+
+const (
+	WebACLDefaultActionAllow WebACLDefaultAction = "allow"
+	WebACLDefaultActionBlock WebACLDefaultAction = "block"
+)
+
+// ManagedRuleGroup is a managed rule group referenced by a rule.
+type ManagedRuleGroup struct {
+	// The name of the managed rule group vendor.
+	VendorName string `json:"vendorName"`
+
+	// The name of the managed rule group.
+	Name string `json:"name"`
+
+	// The version of the managed rule group.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// RateLimit is the rate-based statement of a rule, which blocks requests from IP addresses exceeding the limit.
+type RateLimit struct {
+	// The maximum number of requests from a single IP address in any 5-minute period.
+	Limit int64 `json:"limit"`
+}
+
+// WebACLRule is a rule of WebACL, exactly one of ManagedRuleGroup and RateLimit is specified.
+type WebACLRule struct {
+	// The name of the rule.
+	Name string `json:"name"`
+
+	// The priority of the rule, rules are evaluated in ascending order of priority.
+	Priority int64 `json:"priority"`
+
+	// The managed rule group referenced by the rule.
+	// +optional
+	ManagedRuleGroup *ManagedRuleGroup `json:"managedRuleGroup,omitempty"`
+
+	// The rate-based statement of the rule.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+}
+
+// WebACLSpec defines the desired state of WebACL
+type WebACLSpec struct {
+	// The name of the webACL.
+	Name string `json:"name"`
+
+	// The action for requests that don't match any rule.
+	DefaultAction WebACLDefaultAction `json:"defaultAction"`
+
+	// The rules of the webACL.
+	// +optional
+	Rules []WebACLRule `json:"rules,omitempty"`
+
+	// The tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// WebACLStatus defines the observed state of WebACL
+type WebACLStatus struct {
+	// The Amazon Resource Name (ARN) of the webACL.
+	WebACLARN string `json:"webACLARN"`
+}
@@ -0,0 +1,186 @@
+package networking
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	eventbridgesdk "github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	sqssdk "github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	sgDeletionEventsRuleDescription = "[k8s] Delivers the deletions of security groups to the AWS Load Balancer Controller"
+	sgDeletionEventsTargetID        = "backend-sg-deletion-events-queue"
+	sgDeletionEventsRetryInterval   = 30 * time.Second
+	sgDeletionEventsWaitTimeSeconds = 20
+	sgDeletionEventsMaxMessages     = 10
+)
+
+// sgDeletionEventPattern matches the DeleteSecurityGroup calls recorded by CloudTrail on the default event bus.
+const sgDeletionEventPattern = `{"source":["aws.ec2"],"detail-type":["AWS API Call via CloudTrail"],` +
+	`"detail":{"eventSource":["ec2.amazonaws.com"],"eventName":["DeleteSecurityGroup"]}}`
+
+// SGDeletionHandler is invoked with the ID of each security group deleted in the account.
+type SGDeletionHandler func(ctx context.Context, sgID string)
+
+// sgDeletionEvent is the part of the CloudTrail event of DeleteSecurityGroup calls used by the controller.
+type sgDeletionEvent struct {
+	Detail struct {
+		ErrorCode         string `json:"errorCode"`
+		RequestParameters struct {
+			GroupID string `json:"groupId"`
+		} `json:"requestParameters"`
+	} `json:"detail"`
+}
+
+// NewBackendSGDeletionEventWatcher constructs new backendSGDeletionEventWatcher.
+// It routes the security group deletions from the default EventBridge event bus to the SQS queue of queueURL,
+// and invokes handler for each deletion received from the queue.
+func NewBackendSGDeletionEventWatcher(clusterName string, queueURL string, eventBridgeClient services.EventBridge,
+	sqsClient services.SQS, handler SGDeletionHandler, logger logr.Logger) *backendSGDeletionEventWatcher {
+	return &backendSGDeletionEventWatcher{
+		clusterName:       clusterName,
+		queueURL:          queueURL,
+		eventBridgeClient: eventBridgeClient,
+		sqsClient:         sqsClient,
+		handler:           handler,
+		logger:            logger,
+		retryInterval:     sgDeletionEventsRetryInterval,
+	}
+}
+
+var _ manager.LeaderElectionRunnable = &backendSGDeletionEventWatcher{}
+
+// backendSGDeletionEventWatcher delivers the deletions of security groups as soon as EventBridge receives them,
+// so that the auto-generated backend SG is recovered without waiting for its periodic check.
+type backendSGDeletionEventWatcher struct {
+	clusterName       string
+	queueURL          string
+	eventBridgeClient services.EventBridge
+	sqsClient         services.SQS
+	handler           SGDeletionHandler
+	logger            logr.Logger
+
+	// retryInterval is the interval to retry after failing to set up the rule or to receive events.
+	retryInterval time.Duration
+}
+
+// Start sets up the EventBridge rule, then receives the deletion events from the SQS queue until ctx is done.
+func (w *backendSGDeletionEventWatcher) Start(ctx context.Context) error {
+	ruleReady := false
+	for ctx.Err() == nil {
+		var err error
+		if !ruleReady {
+			err = w.ensureRule(ctx)
+			ruleReady = err == nil
+		} else {
+			err = w.receiveEvents(ctx)
+		}
+		if err == nil || ctx.Err() != nil {
+			continue
+		}
+		w.logger.Error(err, "failed to watch backend SG deletion events")
+		select {
+		case <-ctx.Done():
+		case <-time.After(w.retryInterval):
+		}
+	}
+	return nil
+}
+
+// NeedLeaderElection returns true, as the auto-generated backend SG is only allocated by the leader.
+func (w *backendSGDeletionEventWatcher) NeedLeaderElection() bool {
+	return true
+}
+
+// ensureRule creates or updates the EventBridge rule routing the security group deletions to the SQS queue.
+func (w *backendSGDeletionEventWatcher) ensureRule(ctx context.Context) error {
+	attrsResp, err := w.sqsClient.GetQueueAttributesWithContext(ctx, &sqssdk.GetQueueAttributesInput{
+		QueueUrl:       awssdk.String(w.queueURL),
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNameQueueArn},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get ARN of queue %v", w.queueURL)
+	}
+	queueARN := attrsResp.Attributes[string(sqstypes.QueueAttributeNameQueueArn)]
+	ruleName := w.ruleName()
+	if _, err := w.eventBridgeClient.PutRuleWithContext(ctx, &eventbridgesdk.PutRuleInput{
+		Name:         awssdk.String(ruleName),
+		Description:  awssdk.String(sgDeletionEventsRuleDescription),
+		EventPattern: awssdk.String(sgDeletionEventPattern),
+		State:        eventbridgetypes.RuleStateEnabled,
+		Tags: []eventbridgetypes.Tag{
+			{
+				Key:   awssdk.String(tagKeyK8sCluster),
+				Value: awssdk.String(w.clusterName),
+			},
+		},
+	}); err != nil {
+		return errors.Wrapf(err, "failed to put rule %v", ruleName)
+	}
+	resp, err := w.eventBridgeClient.PutTargetsWithContext(ctx, &eventbridgesdk.PutTargetsInput{
+		Rule: awssdk.String(ruleName),
+		Targets: []eventbridgetypes.Target{
+			{
+				Id:  awssdk.String(sgDeletionEventsTargetID),
+				Arn: awssdk.String(queueARN),
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to put target of rule %v", ruleName)
+	}
+	if resp.FailedEntryCount > 0 {
+		return errors.Errorf("failed to put target of rule %v: %v", ruleName, awssdk.ToString(resp.FailedEntries[0].ErrorMessage))
+	}
+	w.logger.Info("watching backend SG deletion events", "rule", ruleName, "queue", w.queueURL)
+	return nil
+}
+
+// receiveEvents long-polls the SQS queue once, and invokes the handler for the security groups deleted successfully.
+// The messages are deleted once handled, including the ones that aren't deletion events.
+func (w *backendSGDeletionEventWatcher) receiveEvents(ctx context.Context) error {
+	resp, err := w.sqsClient.ReceiveMessageWithContext(ctx, &sqssdk.ReceiveMessageInput{
+		QueueUrl:            awssdk.String(w.queueURL),
+		MaxNumberOfMessages: sgDeletionEventsMaxMessages,
+		WaitTimeSeconds:     sgDeletionEventsWaitTimeSeconds,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to receive messages from queue %v", w.queueURL)
+	}
+	for _, msg := range resp.Messages {
+		var deletionEvent sgDeletionEvent
+		if err := json.Unmarshal([]byte(awssdk.ToString(msg.Body)), &deletionEvent); err != nil {
+			w.logger.Error(err, "ignoring malformed backend SG deletion event", "messageID", awssdk.ToString(msg.MessageId))
+		} else if sgID := deletionEvent.Detail.RequestParameters.GroupID; len(sgID) > 0 && len(deletionEvent.Detail.ErrorCode) == 0 {
+			w.logger.V(1).Info("received security group deletion event", "id", sgID)
+			w.handler(ctx, sgID)
+		}
+		if _, err := w.sqsClient.DeleteMessageWithContext(ctx, &sqssdk.DeleteMessageInput{
+			QueueUrl:      awssdk.String(w.queueURL),
+			ReceiptHandle: msg.ReceiptHandle,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to delete message from queue %v", w.queueURL)
+		}
+	}
+	return nil
+}
+
+// ruleName returns the name of the EventBridge rule of the cluster, unique per cluster in the account.
+func (w *backendSGDeletionEventWatcher) ruleName() string {
+	ruleNameHash := sha256.New()
+	_, _ = ruleNameHash.Write([]byte(w.clusterName))
+	ruleHash := hex.EncodeToString(ruleNameHash.Sum(nil))
+	return fmt.Sprintf("k8s-backend-sg-deletion-%.10s", ruleHash)
+}
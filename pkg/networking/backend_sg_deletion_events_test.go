@@ -0,0 +1,186 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	eventbridgesdk "github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	sqssdk "github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// fakeEventBridge records the rules and targets put in memory.
+type fakeEventBridge struct {
+	services.EventBridge
+
+	putRuleInputs     []*eventbridgesdk.PutRuleInput
+	putTargetsInputs  []*eventbridgesdk.PutTargetsInput
+	putTargetsFailure string
+}
+
+func (c *fakeEventBridge) PutRuleWithContext(_ context.Context, input *eventbridgesdk.PutRuleInput) (*eventbridgesdk.PutRuleOutput, error) {
+	c.putRuleInputs = append(c.putRuleInputs, input)
+	return &eventbridgesdk.PutRuleOutput{}, nil
+}
+
+func (c *fakeEventBridge) PutTargetsWithContext(_ context.Context, input *eventbridgesdk.PutTargetsInput) (*eventbridgesdk.PutTargetsOutput, error) {
+	c.putTargetsInputs = append(c.putTargetsInputs, input)
+	if len(c.putTargetsFailure) > 0 {
+		return &eventbridgesdk.PutTargetsOutput{
+			FailedEntryCount: 1,
+			FailedEntries:    []eventbridgetypes.PutTargetsResultEntry{{ErrorMessage: awssdk.String(c.putTargetsFailure)}},
+		}, nil
+	}
+	return &eventbridgesdk.PutTargetsOutput{}, nil
+}
+
+// fakeSQS serves the configured messages, and records the deleted ones in memory.
+type fakeSQS struct {
+	services.SQS
+
+	queueARN        string
+	messages        []sqstypes.Message
+	receiveErr      error
+	deletedReceipts []string
+}
+
+func (c *fakeSQS) GetQueueAttributesWithContext(_ context.Context, _ *sqssdk.GetQueueAttributesInput) (*sqssdk.GetQueueAttributesOutput, error) {
+	return &sqssdk.GetQueueAttributesOutput{
+		Attributes: map[string]string{string(sqstypes.QueueAttributeNameQueueArn): c.queueARN},
+	}, nil
+}
+
+func (c *fakeSQS) ReceiveMessageWithContext(_ context.Context, _ *sqssdk.ReceiveMessageInput) (*sqssdk.ReceiveMessageOutput, error) {
+	if c.receiveErr != nil {
+		return nil, c.receiveErr
+	}
+	return &sqssdk.ReceiveMessageOutput{Messages: c.messages}, nil
+}
+
+func (c *fakeSQS) DeleteMessageWithContext(_ context.Context, input *sqssdk.DeleteMessageInput) (*sqssdk.DeleteMessageOutput, error) {
+	c.deletedReceipts = append(c.deletedReceipts, awssdk.ToString(input.ReceiptHandle))
+	return &sqssdk.DeleteMessageOutput{}, nil
+}
+
+func Test_backendSGDeletionEventWatcher_ensureRule(t *testing.T) {
+	tests := []struct {
+		name              string
+		putTargetsFailure string
+		wantErr           error
+	}{
+		{
+			name: "rule and target put",
+		},
+		{
+			name:              "target failed",
+			putTargetsFailure: "access denied",
+			wantErr:           errors.New("failed to put target of rule k8s-backend-sg-deletion-411a1bcdb1: access denied"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventBridgeClient := &fakeEventBridge{putTargetsFailure: tt.putTargetsFailure}
+			sqsClient := &fakeSQS{queueARN: "arn:aws:sqs:us-west-2:123456789012:my-queue"}
+			w := NewBackendSGDeletionEventWatcher(defaultClusterName, "https://sqs.us-west-2.amazonaws.com/123456789012/my-queue",
+				eventBridgeClient, sqsClient, nil, logr.New(&log.NullLogSink{}))
+			err := w.ensureRule(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, []*eventbridgesdk.PutRuleInput{
+				{
+					Name:         awssdk.String(w.ruleName()),
+					Description:  awssdk.String(sgDeletionEventsRuleDescription),
+					EventPattern: awssdk.String(sgDeletionEventPattern),
+					State:        eventbridgetypes.RuleStateEnabled,
+					Tags: []eventbridgetypes.Tag{
+						{
+							Key:   awssdk.String("elbv2.k8s.aws/cluster"),
+							Value: awssdk.String(defaultClusterName),
+						},
+					},
+				},
+			}, eventBridgeClient.putRuleInputs)
+			assert.Equal(t, []*eventbridgesdk.PutTargetsInput{
+				{
+					Rule: awssdk.String(w.ruleName()),
+					Targets: []eventbridgetypes.Target{
+						{
+							Id:  awssdk.String("backend-sg-deletion-events-queue"),
+							Arn: awssdk.String("arn:aws:sqs:us-west-2:123456789012:my-queue"),
+						},
+					},
+				},
+			}, eventBridgeClient.putTargetsInputs)
+		})
+	}
+}
+
+func Test_backendSGDeletionEventWatcher_receiveEvents(t *testing.T) {
+	message := func(receipt string, body string) sqstypes.Message {
+		return sqstypes.Message{
+			MessageId:     awssdk.String(receipt),
+			ReceiptHandle: awssdk.String(receipt),
+			Body:          awssdk.String(body),
+		}
+	}
+	tests := []struct {
+		name           string
+		messages       []sqstypes.Message
+		receiveErr     error
+		wantDeletedSGs []string
+		wantDeleted    []string
+		wantErr        error
+	}{
+		{
+			name: "deletion events",
+			messages: []sqstypes.Message{
+				message("receipt-1", `{"detail":{"eventName":"DeleteSecurityGroup","requestParameters":{"groupId":"sg-1"}}}`),
+				message("receipt-2", `{"detail":{"eventName":"DeleteSecurityGroup","requestParameters":{"groupId":"sg-2"}}}`),
+			},
+			wantDeletedSGs: []string{"sg-1", "sg-2"},
+			wantDeleted:    []string{"receipt-1", "receipt-2"},
+		},
+		{
+			name: "failed deletions and malformed messages are only deleted",
+			messages: []sqstypes.Message{
+				message("receipt-1", `{"detail":{"eventName":"DeleteSecurityGroup","errorCode":"DependencyViolation","requestParameters":{"groupId":"sg-1"}}}`),
+				message("receipt-2", `not json`),
+				message("receipt-3", `{"detail":{"eventName":"DeleteSecurityGroup","requestParameters":{"groupName":"default"}}}`),
+			},
+			wantDeleted: []string{"receipt-1", "receipt-2", "receipt-3"},
+		},
+		{
+			name:       "receive failed",
+			receiveErr: errors.New("queue does not exist"),
+			wantErr:    errors.New("failed to receive messages from queue https://sqs.us-west-2.amazonaws.com/123456789012/my-queue: queue does not exist"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqsClient := &fakeSQS{messages: tt.messages, receiveErr: tt.receiveErr}
+			var gotDeletedSGs []string
+			w := NewBackendSGDeletionEventWatcher(defaultClusterName, "https://sqs.us-west-2.amazonaws.com/123456789012/my-queue",
+				&fakeEventBridge{}, sqsClient, func(_ context.Context, sgID string) {
+					gotDeletedSGs = append(gotDeletedSGs, sgID)
+				}, logr.New(&log.NullLogSink{}))
+			err := w.receiveEvents(context.Background())
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantDeletedSGs, gotDeletedSGs)
+			assert.Equal(t, tt.wantDeleted, sqsClient.deletedReceipts)
+		})
+	}
+}
@@ -11,34 +11,53 @@ import (
 	"sync"
 	"time"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 const (
 	defaultSGDeletionPollInterval = 2 * time.Second
 	defaultSGDeletionTimeout      = 2 * time.Minute
+	defaultSGCheckInterval        = 5 * time.Minute
 
-	resourceTypeSecurityGroup = "security-group"
-	tagKeyK8sCluster          = "elbv2.k8s.aws/cluster"
-	tagKeyResource            = "elbv2.k8s.aws/resource"
-	tagValueBackend           = "backend-sg"
+	tagKeyK8sCluster = "elbv2.k8s.aws/cluster"
+	tagKeyResource   = "elbv2.k8s.aws/resource"
+	tagValueBackend  = "backend-sg"
+	// tagKeyBackendSGShareKey is the tag of the backend SG shared by the clusters with the same share key.
+	tagKeyBackendSGShareKey = "elbv2.k8s.aws/backend-sg-share-key"
+	// tagKeyPrefixBackendSGCluster is the tag prefix of the clusters using the shared backend SG, which counts its references.
+	tagKeyPrefixBackendSGCluster = "elbv2.k8s.aws/backend-sg-cluster/"
+	tagValueBackendSGCluster     = "true"
 
 	explicitGroupFinalizerPrefix = "group.ingress.k8s.aws/"
 	implicitGroupFinalizer       = "ingress.k8s.aws/resources"
 	serviceFinalizer             = "service.k8s.aws/resources"
+	gatewayFinalizer             = "gateway.k8s.aws/resources"
 
 	sgDescription = "[k8s] Shared Backend SecurityGroup for LoadBalancer"
+
+	errCodeSecurityGroupDuplicate = "InvalidGroup.Duplicate"
 )
 
 type ResourceType string
@@ -46,6 +65,7 @@ type ResourceType string
 const (
 	ResourceTypeIngress = "ingress"
 	ResourceTypeService = "service"
+	ResourceTypeGateway = "gateway"
 )
 
 // BackendSGProvider is responsible for providing backend security groups
@@ -54,20 +74,32 @@ type BackendSGProvider interface {
 	Get(ctx context.Context, resourceType ResourceType, activeResources []types.NamespacedName, additionalTags map[string]string) (string, error)
 	// Release cleans up the auto-generated backend SG if necessary
 	Release(ctx context.Context, resourceType ResourceType, inactiveResources []types.NamespacedName) error
+	// RecoveryEvents returns the channel of resources that need to be reconciled after the auto-generated backend SG
+	// has been deleted externally.
+	RecoveryEvents(resourceType ResourceType) <-chan event.GenericEvent
 }
 
 // NewBackendSGProvider constructs a new  defaultBackendSGProvider
-func NewBackendSGProvider(clusterName string, backendSG string, vpcID string,
-	ec2Client services.EC2, k8sClient client.Client, defaultTags map[string]string, logger logr.Logger) *defaultBackendSGProvider {
+// when shareKey is specified, the auto-generated backend SG is shared by the clusters in the VPC with the same shareKey.
+func NewBackendSGProvider(clusterName string, backendSG string, shareKey string, vpcID string,
+	ec2Client services.EC2, k8sClient client.Client, defaultTagsProvider DefaultTagsProvider,
+	externalManagedTags []string, strictTagEnforcement bool, releaseGracePeriod time.Duration,
+	eventRecorder record.EventRecorder, logger logr.Logger) *defaultBackendSGProvider {
 	return &defaultBackendSGProvider{
-		vpcID:       vpcID,
-		clusterName: clusterName,
-		backendSG:   backendSG,
-		defaultTags: defaultTags,
-		ec2Client:   ec2Client,
-		k8sClient:   k8sClient,
-		logger:      logger,
-		mutex:       sync.Mutex{},
+		vpcID:                vpcID,
+		clusterName:          clusterName,
+		backendSG:            backendSG,
+		shareKey:             shareKey,
+		defaultTagsProvider:  defaultTagsProvider,
+		externalManagedTags:  externalManagedTags,
+		strictTagEnforcement: strictTagEnforcement,
+		releaseGracePeriod:   releaseGracePeriod,
+		additionalTags:       make(map[string]string),
+		ec2Client:            ec2Client,
+		k8sClient:            k8sClient,
+		eventRecorder:        eventRecorder,
+		logger:               logger,
+		mutex:                sync.Mutex{},
 
 		checkIngressFinalizersFunc: func(finalizers []string) bool {
 			for _, fin := range finalizers {
@@ -87,12 +119,24 @@ func NewBackendSGProvider(clusterName string, backendSG string, vpcID string,
 			return false
 		},
 
+		checkGatewayFinalizersFunc: func(finalizers []string) bool {
+			for _, fin := range finalizers {
+				if fin == gatewayFinalizer {
+					return true
+				}
+			}
+			return false
+		},
+
 		defaultDeletionPollInterval: defaultSGDeletionPollInterval,
 		defaultDeletionTimeout:      defaultSGDeletionTimeout,
+		checkInterval:               defaultSGCheckInterval,
+		recoveryEventChans:          make(map[ResourceType]chan event.GenericEvent),
 	}
 }
 
 var _ BackendSGProvider = &defaultBackendSGProvider{}
+var _ manager.LeaderElectionRunnable = &defaultBackendSGProvider{}
 
 type defaultBackendSGProvider struct {
 	vpcID       string
@@ -101,10 +145,24 @@ type defaultBackendSGProvider struct {
 
 	backendSG       string
 	autoGeneratedSG string
-	defaultTags     map[string]string
-	ec2Client       services.EC2
-	k8sClient       client.Client
-	logger          logr.Logger
+	// shareKey is the key of the auto-generated backend SG shared by multiple clusters in the VPC, it's empty if not shared.
+	// each cluster tags the shared backend SG with its own cluster tag, and it's only deleted once none of them is left.
+	shareKey             string
+	defaultTagsProvider  DefaultTagsProvider
+	externalManagedTags  []string
+	strictTagEnforcement bool
+	// releaseGracePeriod is the period to wait after the last resource released the auto-generated backend SG before deleting it,
+	// so that the backend SG isn't deleted and recreated when resources are deleted and recreated shortly after.
+	releaseGracePeriod time.Duration
+	// pendingRelease is the timer of the deferred deletion of the auto-generated backend SG, it's nil if none is pending.
+	pendingRelease *time.Timer
+	// additionalTags are the additional tags requested by resources for the auto-generated backend SG.
+	// they're only applied when missing, as the shared backend SG is created with the additional tags of a single resource.
+	additionalTags map[string]string
+	ec2Client      services.EC2
+	k8sClient      client.Client
+	eventRecorder  record.EventRecorder
+	logger         logr.Logger
 	// objectsMap keeps track of whether the backend SG is required for any tracked resources in the cluster.
 	// If any entry in the map is true, or there are resources with this controller specific finalizers which
 	// haven't been tracked in the map yet, controller doesn't delete the backend SG. If the controller has
@@ -114,9 +172,15 @@ type defaultBackendSGProvider struct {
 
 	checkServiceFinalizersFunc func([]string) bool
 	checkIngressFinalizersFunc func([]string) bool
+	checkGatewayFinalizersFunc func([]string) bool
 
 	defaultDeletionPollInterval time.Duration
 	defaultDeletionTimeout      time.Duration
+	// checkInterval is the interval to check the existence of the auto-generated backend SG.
+	checkInterval time.Duration
+
+	recoveryEventChans      map[ResourceType]chan event.GenericEvent
+	recoveryEventChansMutex sync.Mutex
 }
 
 func (p *defaultBackendSGProvider) Get(ctx context.Context, resourceType ResourceType, activeResources []types.NamespacedName, additionalTags map[string]string) (string, error) {
@@ -146,9 +210,155 @@ func (p *defaultBackendSGProvider) Release(ctx context.Context, resourceType Res
 	if required, err := p.isBackendSGRequired(ctx); required || err != nil {
 		return err
 	}
+	if p.releaseGracePeriod > 0 {
+		p.deferReleaseSG()
+		return nil
+	}
 	return p.releaseSG(ctx)
 }
 
+// deferReleaseSG schedules the deletion of the auto-generated backend SG after the release grace period.
+// Each release restarts the grace period, and allocating the backend SG in the meantime cancels the deletion.
+func (p *defaultBackendSGProvider) deferReleaseSG() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(p.autoGeneratedSG) == 0 {
+		return
+	}
+	if p.pendingRelease != nil {
+		p.pendingRelease.Stop()
+	}
+	p.logger.V(1).Info("deferring backend SG release", "id", p.autoGeneratedSG, "gracePeriod", p.releaseGracePeriod)
+	p.pendingRelease = time.AfterFunc(p.releaseGracePeriod, func() {
+		if err := p.releaseSG(context.Background()); err != nil {
+			p.logger.Error(err, "failed to release backend SG")
+		}
+	})
+}
+
+// cancelPendingRelease cancels the deferred deletion of the auto-generated backend SG if any, the caller must hold the mutex.
+func (p *defaultBackendSGProvider) cancelPendingRelease() {
+	if p.pendingRelease == nil {
+		return
+	}
+	if p.pendingRelease.Stop() {
+		p.logger.V(1).Info("cancelled backend SG release", "id", p.autoGeneratedSG)
+	}
+	p.pendingRelease = nil
+}
+
+func (p *defaultBackendSGProvider) RecoveryEvents(resourceType ResourceType) <-chan event.GenericEvent {
+	p.recoveryEventChansMutex.Lock()
+	defer p.recoveryEventChansMutex.Unlock()
+	eventChan, exists := p.recoveryEventChans[resourceType]
+	if !exists {
+		eventChan = make(chan event.GenericEvent)
+		p.recoveryEventChans[resourceType] = eventChan
+	}
+	return eventChan
+}
+
+// Start periodically checks the existence and the tags of the auto-generated backend SG until ctx is done.
+// When the auto-generated backend SG is deleted externally, resources that require it are notified via RecoveryEvents,
+// so that their reconciliation recreates the backend SG and re-attaches the rules.
+func (p *defaultBackendSGProvider) Start(ctx context.Context) error {
+	if len(p.backendSG) > 0 {
+		return nil
+	}
+	wait.UntilWithContext(ctx, p.checkAutoGeneratedSG, p.checkInterval)
+	return nil
+}
+
+// NeedLeaderElection returns true, as the auto-generated backend SG is only allocated by the leader.
+func (p *defaultBackendSGProvider) NeedLeaderElection() bool {
+	return true
+}
+
+func (p *defaultBackendSGProvider) checkAutoGeneratedSG(ctx context.Context) {
+	deletedSG, err := p.syncAutoGeneratedSG(ctx)
+	if err != nil {
+		p.logger.Error(err, "failed to check backend SG")
+		return
+	}
+	if len(deletedSG) == 0 {
+		return
+	}
+	p.notifyBackendSGDeleted(ctx, deletedSG)
+}
+
+// HandleSGDeleted checks the auto-generated backend SG right away when it's reported deleted by a deletion event,
+// instead of waiting for the next periodic check.
+func (p *defaultBackendSGProvider) HandleSGDeleted(ctx context.Context, sgID string) {
+	p.mutex.Lock()
+	autoGeneratedSG := p.autoGeneratedSG
+	p.mutex.Unlock()
+	if len(autoGeneratedSG) == 0 || sgID != autoGeneratedSG {
+		return
+	}
+	p.checkAutoGeneratedSG(ctx)
+}
+
+// syncAutoGeneratedSG resets the auto-generated backend SG if it no longer exists, and returns the ID of the deleted backend SG.
+// Otherwise, the tags of the auto-generated backend SG are reconciled.
+func (p *defaultBackendSGProvider) syncAutoGeneratedSG(ctx context.Context) (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(p.autoGeneratedSG) == 0 {
+		return "", nil
+	}
+	req := &ec2sdk.DescribeSecurityGroupsInput{
+		GroupIds: []string{p.autoGeneratedSG},
+	}
+	sgs, err := p.ec2Client.DescribeSecurityGroupsAsList(ctx, req)
+	if err != nil && !isEC2SecurityGroupNotFoundError(err) {
+		return "", err
+	}
+	if len(sgs) > 0 {
+		return "", p.reconcileBackendSGTags(ctx, &sgs[0])
+	}
+	deletedSG := p.autoGeneratedSG
+	p.logger.Info("backend SG deleted externally", "id", deletedSG)
+	p.autoGeneratedSG = ""
+	return deletedSG, nil
+}
+
+func (p *defaultBackendSGProvider) notifyBackendSGDeleted(ctx context.Context, deletedSG string) {
+	p.objectsMap.Range(func(k, v interface{}) bool {
+		if !v.(bool) {
+			return true
+		}
+		resourceType, resource := parseObjectKey(k.(string))
+		var obj client.Object
+		switch resourceType {
+		case ResourceTypeIngress:
+			obj = &networking.Ingress{}
+		case ResourceTypeService:
+			obj = &corev1.Service{}
+		default:
+			return true
+		}
+		if err := p.k8sClient.Get(ctx, resource, obj); err != nil {
+			p.logger.Error(err, "failed to fetch resource requiring backend SG", "resource", k)
+			return true
+		}
+		p.eventRecorder.Event(obj, corev1.EventTypeWarning, k8s.BackendSGEventReasonDeletedExternally,
+			fmt.Sprintf("Backend security group %s was deleted externally, recreating it", deletedSG))
+
+		p.recoveryEventChansMutex.Lock()
+		eventChan, exists := p.recoveryEventChans[resourceType]
+		p.recoveryEventChansMutex.Unlock()
+		if !exists {
+			return true
+		}
+		select {
+		case eventChan <- event.GenericEvent{Object: obj}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+}
+
 func (p *defaultBackendSGProvider) updateObjectsMap(_ context.Context, resourceType ResourceType,
 	resources []types.NamespacedName, backendSGRequired bool) {
 	for _, res := range resources {
@@ -174,6 +384,9 @@ func (p *defaultBackendSGProvider) isBackendSGRequired(ctx context.Context) (boo
 	if required, err := p.checkServiceListForUnmapped(ctx); required || err != nil {
 		return required, err
 	}
+	if required, err := p.checkGatewayListForUnmapped(ctx); required || err != nil {
+		return required, err
+	}
 	return false, nil
 }
 
@@ -209,6 +422,26 @@ func (p *defaultBackendSGProvider) checkServiceListForUnmapped(ctx context.Conte
 	return false, nil
 }
 
+func (p *defaultBackendSGProvider) checkGatewayListForUnmapped(ctx context.Context) (bool, error) {
+	gwList := &gwv1beta1.GatewayList{}
+	if err := p.k8sClient.List(ctx, gwList); err != nil {
+		// the Gateway API CRDs are only installed along with the GatewayAPI feature gate.
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return true, errors.Wrapf(err, "unable to list gateways")
+	}
+	for _, gw := range gwList.Items {
+		if !p.checkGatewayFinalizersFunc(gw.GetFinalizers()) {
+			continue
+		}
+		if !p.existsInObjectMap(ResourceTypeGateway, k8s.NamespacedName(&gw)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (p *defaultBackendSGProvider) existsInObjectMap(resourceType ResourceType, resource types.NamespacedName) bool {
 	if _, exists := p.objectsMap.Load(getObjectKey(resourceType, resource)); exists {
 		return true
@@ -221,6 +454,10 @@ func (p *defaultBackendSGProvider) allocateBackendSG(ctx context.Context, resour
 	defer p.mutex.Unlock()
 
 	p.updateObjectsMap(ctx, resourceType, activeResources, true)
+	p.cancelPendingRelease()
+	for key, val := range additionalTags {
+		p.additionalTags[key] = val
+	}
 	if len(p.autoGeneratedSG) > 0 {
 		return nil
 	}
@@ -232,6 +469,9 @@ func (p *defaultBackendSGProvider) allocateBackendSG(ctx context.Context, resour
 	}
 	if len(sgID) > 1 {
 		p.logger.V(1).Info("Existing SG found", "id", sgID)
+		if err := p.joinSharedBackendSG(ctx, sgID); err != nil {
+			return err
+		}
 		p.autoGeneratedSG = sgID
 		return nil
 	}
@@ -245,63 +485,139 @@ func (p *defaultBackendSGProvider) allocateBackendSG(ctx context.Context, resour
 	p.logger.V(1).Info("creating securityGroup", "name", sgName)
 	resp, err := p.ec2Client.CreateSecurityGroupWithContext(ctx, createReq)
 	if err != nil {
+		// the shared backend SG might have been created by another cluster in the meantime.
+		if len(p.shareKey) > 0 && isEC2SecurityGroupDuplicateError(err) {
+			return p.joinConcurrentlyCreatedSharedBackendSG(ctx, sgName)
+		}
 		return err
 	}
 	p.logger.Info("created SecurityGroup", "name", sgName, "id", resp.GroupId)
-	p.autoGeneratedSG = awssdk.StringValue(resp.GroupId)
+	audit.RecordMutation(ctx, audit.ActionCreate, "securityGroup", awssdk.ToString(resp.GroupId), "backend securityGroup")
+	p.autoGeneratedSG = awssdk.ToString(resp.GroupId)
 	return nil
 }
 
-func (p *defaultBackendSGProvider) buildBackendSGTags(_ context.Context, additionalTags map[string]string) []*ec2sdk.TagSpecification {
-	var tags []*ec2sdk.Tag
-	for key, val := range p.defaultTags {
-		tags = append(tags, &ec2sdk.Tag{
+func (p *defaultBackendSGProvider) buildBackendSGTags(_ context.Context, additionalTags map[string]string) []ec2types.TagSpecification {
+	var tags []ec2types.Tag
+	for key, val := range p.defaultTagsProvider.DefaultTags() {
+		tags = append(tags, ec2types.Tag{
 			Key:   awssdk.String(key),
 			Value: awssdk.String(val),
 		})
 	}
 
 	for key, val := range additionalTags {
-		tags = append(tags, &ec2sdk.Tag{
+		tags = append(tags, ec2types.Tag{
 			Key:   awssdk.String(key),
 			Value: awssdk.String(val),
 		})
 	}
 
 	sort.Slice(tags, func(i, j int) bool {
-		return awssdk.StringValue(tags[i].Key) < awssdk.StringValue(tags[j].Key)
+		return awssdk.ToString(tags[i].Key) < awssdk.ToString(tags[j].Key)
 	})
-	return []*ec2sdk.TagSpecification{
+	return []ec2types.TagSpecification{
 		{
-			ResourceType: awssdk.String(resourceTypeSecurityGroup),
-			Tags: append(tags, []*ec2sdk.Tag{
-				{
-					Key:   awssdk.String(tagKeyK8sCluster),
-					Value: awssdk.String(p.clusterName),
-				},
-				{
-					Key:   awssdk.String(tagKeyResource),
-					Value: awssdk.String(tagValueBackend),
-				},
-			}...),
+			ResourceType: ec2types.ResourceTypeSecurityGroup,
+			Tags:         append(tags, convertTagsToSDKTags(p.buildBackendSGTrackingTags())...),
 		},
 	}
 }
 
+// buildBackendSGTrackingTags builds the tags to identify the auto-generated backend SG.
+func (p *defaultBackendSGProvider) buildBackendSGTrackingTags() map[string]string {
+	trackingTags := map[string]string{
+		tagKeyK8sCluster: p.clusterName,
+		tagKeyResource:   tagValueBackend,
+	}
+	if len(p.shareKey) > 0 {
+		trackingTags[tagKeyBackendSGShareKey] = p.shareKey
+		trackingTags[p.getBackendSGClusterTagKey()] = tagValueBackendSGCluster
+	}
+	return trackingTags
+}
+
+// reconcileBackendSGTags reconciles the default tags and the tracking tags of the auto-generated backend SG.
+// The additional tags requested by resources are added if missing, and never removed.
+func (p *defaultBackendSGProvider) reconcileBackendSGTags(ctx context.Context, sg *ec2types.SecurityGroup) error {
+	currentTags := make(map[string]string, len(sg.Tags))
+	for _, tag := range sg.Tags {
+		currentTags[awssdk.ToString(tag.Key)] = awssdk.ToString(tag.Value)
+	}
+	desiredTags := make(map[string]string)
+	for _, tagSpec := range p.buildBackendSGTags(ctx, nil) {
+		for _, tag := range tagSpec.Tags {
+			desiredTags[awssdk.ToString(tag.Key)] = awssdk.ToString(tag.Value)
+		}
+	}
+	for key, val := range p.additionalTags {
+		if _, exists := desiredTags[key]; exists {
+			continue
+		}
+		if currentVal, exists := currentTags[key]; exists {
+			val = currentVal
+		}
+		desiredTags[key] = val
+	}
+
+	// the shared backend SG is tagged by all clusters using it, and the cluster tag cannot be changed,
+	// so the existing tags are kept as is and only the missing ones are added.
+	if len(p.shareKey) > 0 {
+		for key, val := range currentTags {
+			desiredTags[key] = val
+		}
+	}
+
+	tagsToUpdate, tagsToRemove := algorithm.DiffStringMap(desiredTags, currentTags)
+	for _, externalManagedTag := range p.externalManagedTags {
+		delete(tagsToUpdate, externalManagedTag)
+		delete(tagsToRemove, externalManagedTag)
+	}
+	if !p.strictTagEnforcement {
+		tagsToRemove = nil
+	}
+	sgID := awssdk.ToString(sg.GroupId)
+	if len(tagsToUpdate) > 0 {
+		p.logger.Info("adding backend SG tags", "id", sgID, "change", tagsToUpdate)
+		req := &ec2sdk.CreateTagsInput{
+			Resources: []string{sgID},
+			Tags:      convertTagsToSDKTags(tagsToUpdate),
+		}
+		if _, err := p.ec2Client.CreateTagsWithContext(ctx, req); err != nil {
+			return errors.Wrap(err, "failed to add backend SG tags")
+		}
+	}
+	if len(tagsToRemove) > 0 {
+		p.logger.Info("removing backend SG tags", "id", sgID, "change", tagsToRemove)
+		req := &ec2sdk.DeleteTagsInput{
+			Resources: []string{sgID},
+			Tags:      convertTagsToSDKTags(tagsToRemove),
+		}
+		if _, err := p.ec2Client.DeleteTagsWithContext(ctx, req); err != nil {
+			return errors.Wrap(err, "failed to remove backend SG tags")
+		}
+	}
+	return nil
+}
+
 func (p *defaultBackendSGProvider) getBackendSGFromEC2(ctx context.Context, sgName string, vpcID string) (string, error) {
+	ownerTagKey, ownerTagValue := tagKeyK8sCluster, p.clusterName
+	if len(p.shareKey) > 0 {
+		ownerTagKey, ownerTagValue = tagKeyBackendSGShareKey, p.shareKey
+	}
 	req := &ec2sdk.DescribeSecurityGroupsInput{
-		Filters: []*ec2sdk.Filter{
+		Filters: []ec2types.Filter{
 			{
 				Name:   awssdk.String("vpc-id"),
-				Values: awssdk.StringSlice([]string{vpcID}),
+				Values: []string{vpcID},
 			},
 			{
-				Name:   awssdk.String(fmt.Sprintf("tag:%v", tagKeyK8sCluster)),
-				Values: awssdk.StringSlice([]string{p.clusterName}),
+				Name:   awssdk.String(fmt.Sprintf("tag:%v", ownerTagKey)),
+				Values: []string{ownerTagValue},
 			},
 			{
 				Name:   awssdk.String(fmt.Sprintf("tag:%v", tagKeyResource)),
-				Values: awssdk.StringSlice([]string{tagValueBackend}),
+				Values: []string{tagValueBackend},
 			},
 		},
 	}
@@ -311,7 +627,7 @@ func (p *defaultBackendSGProvider) getBackendSGFromEC2(ctx context.Context, sgNa
 		return "", err
 	}
 	if len(sgs) > 0 {
-		return awssdk.StringValue(sgs[0].GroupId), nil
+		return awssdk.ToString(sgs[0].GroupId), nil
 	}
 	return "", nil
 }
@@ -319,6 +635,7 @@ func (p *defaultBackendSGProvider) getBackendSGFromEC2(ctx context.Context, sgNa
 func (p *defaultBackendSGProvider) releaseSG(ctx context.Context) error {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
+	p.pendingRelease = nil
 	if len(p.autoGeneratedSG) == 0 {
 		return nil
 	}
@@ -327,6 +644,17 @@ func (p *defaultBackendSGProvider) releaseSG(ctx context.Context) error {
 		p.logger.V(1).Info("releaseSG ignore delete", "required", required, "err", err)
 		return err
 	}
+	if len(p.shareKey) > 0 {
+		usedByOtherClusters, err := p.leaveSharedBackendSG(ctx)
+		if err != nil {
+			return err
+		}
+		if usedByOtherClusters {
+			p.logger.Info("left shared backend SG", "ID", p.autoGeneratedSG)
+			p.autoGeneratedSG = ""
+			return nil
+		}
+	}
 	req := &ec2sdk.DeleteSecurityGroupInput{
 		GroupId: awssdk.String(p.autoGeneratedSG),
 	}
@@ -337,14 +665,90 @@ func (p *defaultBackendSGProvider) releaseSG(ctx context.Context) error {
 		return errors.Wrap(err, "failed to delete securityGroup")
 	}
 	p.logger.Info("deleted securityGroup", "ID", p.autoGeneratedSG)
+	audit.RecordMutation(ctx, audit.ActionDelete, "securityGroup", p.autoGeneratedSG, "backend securityGroup")
 
 	p.autoGeneratedSG = ""
 	return nil
 }
 
+// joinSharedBackendSG tags the shared backend SG with the cluster tag of this cluster, so that it isn't deleted by other clusters.
+func (p *defaultBackendSGProvider) joinSharedBackendSG(ctx context.Context, sgID string) error {
+	if len(p.shareKey) == 0 {
+		return nil
+	}
+	req := &ec2sdk.CreateTagsInput{
+		Resources: []string{sgID},
+		Tags:      convertTagsToSDKTags(map[string]string{p.getBackendSGClusterTagKey(): tagValueBackendSGCluster}),
+	}
+	if _, err := p.ec2Client.CreateTagsWithContext(ctx, req); err != nil {
+		return errors.Wrap(err, "failed to join shared backend SG")
+	}
+	return nil
+}
+
+// joinConcurrentlyCreatedSharedBackendSG joins the shared backend SG created by another cluster while this cluster created it.
+func (p *defaultBackendSGProvider) joinConcurrentlyCreatedSharedBackendSG(ctx context.Context, sgName string) error {
+	sgID, err := p.getBackendSGFromEC2(ctx, sgName, p.vpcID)
+	if err != nil {
+		return err
+	}
+	if len(sgID) == 0 {
+		return errors.Errorf("shared backend SG %v exists but cannot be found by its tags", sgName)
+	}
+	if err := p.joinSharedBackendSG(ctx, sgID); err != nil {
+		return err
+	}
+	p.logger.V(1).Info("Existing SG found", "id", sgID)
+	p.autoGeneratedSG = sgID
+	return nil
+}
+
+// leaveSharedBackendSG removes the cluster tag of this cluster from the shared backend SG,
+// and returns whether it's still used by other clusters.
+func (p *defaultBackendSGProvider) leaveSharedBackendSG(ctx context.Context) (bool, error) {
+	clusterTagKey := p.getBackendSGClusterTagKey()
+	deleteTagsReq := &ec2sdk.DeleteTagsInput{
+		Resources: []string{p.autoGeneratedSG},
+		Tags:      []ec2types.Tag{{Key: awssdk.String(clusterTagKey)}},
+	}
+	if _, err := p.ec2Client.DeleteTagsWithContext(ctx, deleteTagsReq); err != nil {
+		if isEC2SecurityGroupNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to leave shared backend SG")
+	}
+	describeReq := &ec2sdk.DescribeSecurityGroupsInput{
+		GroupIds: []string{p.autoGeneratedSG},
+	}
+	sgs, err := p.ec2Client.DescribeSecurityGroupsAsList(ctx, describeReq)
+	if err != nil && !isEC2SecurityGroupNotFoundError(err) {
+		return false, err
+	}
+	for _, sg := range sgs {
+		for _, tag := range sg.Tags {
+			tagKey := awssdk.ToString(tag.Key)
+			if strings.HasPrefix(tagKey, tagKeyPrefixBackendSGCluster) && tagKey != clusterTagKey {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (p *defaultBackendSGProvider) getBackendSGClusterTagKey() string {
+	return tagKeyPrefixBackendSGCluster + p.clusterName
+}
+
 var invalidSGNamePattern = regexp.MustCompile("[[:^alnum:]]")
 
 func (p *defaultBackendSGProvider) getBackendSGName() string {
+	if len(p.shareKey) > 0 {
+		sgNameHash := sha256.New()
+		_, _ = sgNameHash.Write([]byte(p.shareKey))
+		sgHash := hex.EncodeToString(sgNameHash.Sum(nil))
+		sanitizedShareKey := invalidSGNamePattern.ReplaceAllString(p.shareKey, "")
+		return fmt.Sprintf("k8s-traffic-shared-%.225s-%.10s", sanitizedShareKey, sgHash)
+	}
 	sgNameHash := sha256.New()
 	_, _ = sgNameHash.Write([]byte(p.clusterName))
 	sgHash := hex.EncodeToString(sgNameHash.Sum(nil))
@@ -353,17 +757,25 @@ func (p *defaultBackendSGProvider) getBackendSGName() string {
 }
 
 func isSecurityGroupDependencyViolationError(err error) bool {
-	var awsErr awserr.Error
-	if errors.As(err, &awsErr) {
-		return awsErr.Code() == "DependencyViolation"
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "DependencyViolation"
+	}
+	return false
+}
+
+func isEC2SecurityGroupDuplicateError(err error) bool {
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == errCodeSecurityGroupDuplicate
 	}
 	return false
 }
 
 func isEC2SecurityGroupNotFoundError(err error) bool {
-	var awsErr awserr.Error
-	if errors.As(err, &awsErr) {
-		return awsErr.Code() == "InvalidGroup.NotFound"
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "InvalidGroup.NotFound"
 	}
 	return false
 }
@@ -371,3 +783,22 @@ func isEC2SecurityGroupNotFoundError(err error) bool {
 func getObjectKey(resourceType ResourceType, resource types.NamespacedName) string {
 	return string(resourceType) + "/" + resource.String()
 }
+
+func parseObjectKey(objectKey string) (ResourceType, types.NamespacedName) {
+	parts := strings.SplitN(objectKey, "/", 3)
+	if len(parts) != 3 {
+		return "", types.NamespacedName{}
+	}
+	return ResourceType(parts[0]), types.NamespacedName{Namespace: parts[1], Name: parts[2]}
+}
+
+func convertTagsToSDKTags(tags map[string]string) []ec2types.Tag {
+	sdkTags := make([]ec2types.Tag, 0, len(tags))
+	for _, key := range sets.StringKeySet(tags).List() {
+		sdkTags = append(sdkTags, ec2types.Tag{
+			Key:   awssdk.String(key),
+			Value: awssdk.String(tags[key]),
+		})
+	}
+	return sdkTags
+}
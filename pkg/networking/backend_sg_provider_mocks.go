@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	types "k8s.io/apimachinery/pkg/types"
+	event "sigs.k8s.io/controller-runtime/pkg/event"
 )
 
 // MockBackendSGProvider is a mock of BackendSGProvider interface.
@@ -50,6 +51,20 @@ func (mr *MockBackendSGProviderMockRecorder) Get(arg0, arg1, arg2, arg3 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBackendSGProvider)(nil).Get), arg0, arg1, arg2, arg3)
 }
 
+// RecoveryEvents mocks base method.
+func (m *MockBackendSGProvider) RecoveryEvents(arg0 ResourceType) <-chan event.GenericEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoveryEvents", arg0)
+	ret0, _ := ret[0].(<-chan event.GenericEvent)
+	return ret0
+}
+
+// RecoveryEvents indicates an expected call of RecoveryEvents.
+func (mr *MockBackendSGProviderMockRecorder) RecoveryEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoveryEvents", reflect.TypeOf((*MockBackendSGProvider)(nil).RecoveryEvents), arg0)
+}
+
 // Release mocks base method.
 func (m *MockBackendSGProvider) Release(arg0 context.Context, arg1 ResourceType, arg2 []types.NamespacedName) error {
 	m.ctrl.T.Helper()
@@ -4,20 +4,29 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	mock_client "sigs.k8s.io/aws-load-balancer-controller/mocks/controller-runtime/client"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -33,7 +42,7 @@ const (
 func Test_defaultBackendSGProvider_Get(t *testing.T) {
 	type describeSecurityGroupsAsListCall struct {
 		req  *ec2sdk.DescribeSecurityGroupsInput
-		resp []*ec2sdk.SecurityGroup
+		resp []ec2types.SecurityGroup
 		err  error
 	}
 	type createSecurityGroupWithContexCall struct {
@@ -49,18 +58,18 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 		describeSGCalls []describeSecurityGroupsAsListCall
 		createSGCalls   []createSecurityGroupWithContexCall
 	}
-	defaultEC2Filters := []*ec2sdk.Filter{
+	defaultEC2Filters := []ec2types.Filter{
 		{
 			Name:   awssdk.String("vpc-id"),
-			Values: awssdk.StringSlice([]string{defaultVPCID}),
+			Values: []string{defaultVPCID},
 		},
 		{
 			Name:   awssdk.String("tag:elbv2.k8s.aws/cluster"),
-			Values: awssdk.StringSlice([]string{"testCluster"}),
+			Values: []string{"testCluster"},
 		},
 		{
 			Name:   awssdk.String("tag:elbv2.k8s.aws/resource"),
-			Values: awssdk.StringSlice([]string{"backend-sg"}),
+			Values: []string{"backend-sg"},
 		},
 	}
 	ing := &networking.Ingress{
@@ -103,7 +112,7 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 						req: &ec2sdk.DescribeSecurityGroupsInput{
 							Filters: defaultEC2Filters,
 						},
-						resp: []*ec2sdk.SecurityGroup{
+						resp: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-autogen"),
 							},
@@ -122,7 +131,7 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 						req: &ec2sdk.DescribeSecurityGroupsInput{
 							Filters: defaultEC2Filters,
 						},
-						err: awserr.New("InvalidGroup.NotFound", "", nil),
+						err: &smithy.GenericAPIError{Code: "InvalidGroup.NotFound"},
 					},
 				},
 				createSGCalls: []createSecurityGroupWithContexCall{
@@ -130,10 +139,10 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 						req: &ec2sdk.CreateSecurityGroupInput{
 							Description: awssdk.String(sgDescription),
 							GroupName:   awssdk.String("k8s-traffic-testCluster-411a1bcdb1"),
-							TagSpecifications: []*ec2sdk.TagSpecification{
+							TagSpecifications: []ec2types.TagSpecification{
 								{
-									ResourceType: awssdk.String("security-group"),
-									Tags: []*ec2sdk.Tag{
+									ResourceType: ec2types.ResourceTypeSecurityGroup,
+									Tags: []ec2types.Tag{
 										{
 											Key:   awssdk.String("elbv2.k8s.aws/cluster"),
 											Value: awssdk.String(defaultClusterName),
@@ -164,7 +173,7 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 						req: &ec2sdk.DescribeSecurityGroupsInput{
 							Filters: defaultEC2Filters,
 						},
-						err: awserr.New("InvalidGroup.NotFound", "", nil),
+						err: &smithy.GenericAPIError{Code: "InvalidGroup.NotFound"},
 					},
 				},
 				createSGCalls: []createSecurityGroupWithContexCall{
@@ -172,10 +181,10 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 						req: &ec2sdk.CreateSecurityGroupInput{
 							Description: awssdk.String(sgDescription),
 							GroupName:   awssdk.String("k8s-traffic-testCluster-411a1bcdb1"),
-							TagSpecifications: []*ec2sdk.TagSpecification{
+							TagSpecifications: []ec2types.TagSpecification{
 								{
-									ResourceType: awssdk.String("security-group"),
-									Tags: []*ec2sdk.Tag{
+									ResourceType: ec2types.ResourceTypeSecurityGroup,
+									Tags: []ec2types.Tag{
 										{
 											Key:   awssdk.String("KubernetesCluster"),
 											Value: awssdk.String(defaultClusterName),
@@ -223,12 +232,12 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 						req: &ec2sdk.DescribeSecurityGroupsInput{
 							Filters: defaultEC2Filters,
 						},
-						err: awserr.New("Some.Other.Error", "describe security group as list error", nil),
+						err: &smithy.GenericAPIError{Code: "Some.Other.Error", Message: "describe security group as list error"},
 					},
 				},
 				ingResources: []*networking.Ingress{ing},
 			},
-			wantErr: errors.New("Some.Other.Error: describe security group as list error"),
+			wantErr: errors.New("api error Some.Other.Error: describe security group as list error"),
 		},
 		{
 			name: "create SG call returns error",
@@ -238,7 +247,7 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 						req: &ec2sdk.DescribeSecurityGroupsInput{
 							Filters: defaultEC2Filters,
 						},
-						err: awserr.New("InvalidGroup.NotFound", "", nil),
+						err: &smithy.GenericAPIError{Code: "InvalidGroup.NotFound"},
 					},
 				},
 				createSGCalls: []createSecurityGroupWithContexCall{
@@ -246,10 +255,10 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 						req: &ec2sdk.CreateSecurityGroupInput{
 							Description: awssdk.String(sgDescription),
 							GroupName:   awssdk.String("k8s-traffic-testCluster-411a1bcdb1"),
-							TagSpecifications: []*ec2sdk.TagSpecification{
+							TagSpecifications: []ec2types.TagSpecification{
 								{
-									ResourceType: awssdk.String("security-group"),
-									Tags: []*ec2sdk.Tag{
+									ResourceType: ec2types.ResourceTypeSecurityGroup,
+									Tags: []ec2types.Tag{
 										{
 											Key:   awssdk.String("elbv2.k8s.aws/cluster"),
 											Value: awssdk.String(defaultClusterName),
@@ -263,12 +272,12 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 							},
 							VpcId: awssdk.String(defaultVPCID),
 						},
-						err: awserr.New("Create.Error", "unable to create security group", nil),
+						err: &smithy.GenericAPIError{Code: "Create.Error", Message: "unable to create security group"},
 					},
 				},
 				ingResources: []*networking.Ingress{ing1},
 			},
-			wantErr: errors.New("Create.Error: unable to create security group"),
+			wantErr: errors.New("api error Create.Error: unable to create security group"),
 		},
 	}
 	for _, tt := range tests {
@@ -284,8 +293,8 @@ func Test_defaultBackendSGProvider_Get(t *testing.T) {
 				ec2Client.EXPECT().CreateSecurityGroupWithContext(context.Background(), call.req).Return(call.resp, call.err)
 			}
 			k8sClient := mock_client.NewMockClient(ctrl)
-			sgProvider := NewBackendSGProvider(defaultClusterName, tt.fields.backendSG,
-				defaultVPCID, ec2Client, k8sClient, tt.fields.defaultTags, logr.New(&log.NullLogSink{}))
+			sgProvider := NewBackendSGProvider(defaultClusterName, tt.fields.backendSG, "",
+				defaultVPCID, ec2Client, k8sClient, NewDefaultTagsProvider(k8sClient, tt.fields.defaultTags, logr.New(&log.NullLogSink{})), nil, true, 0, record.NewFakeRecorder(10), logr.New(&log.NullLogSink{}))
 
 			resourceType := ResourceTypeIngress
 			var activeResources []types.NamespacedName
@@ -317,6 +326,10 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 		services []*corev1.Service
 		err      error
 	}
+	type listGatewaysCall struct {
+		gateways []*gwv1beta1.Gateway
+		err      error
+	}
 	type deleteSecurityGroupWithContextCall struct {
 		req  *ec2sdk.DeleteSecurityGroupInput
 		resp *ec2sdk.DeleteSecurityGroupOutput
@@ -333,6 +346,7 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 		listIngressCalls           []listIngressCall
 		deleteSGCalls              []deleteSecurityGroupWithContextCall
 		listServicesCalls          []listServicesCall
+		listGatewaysCalls          []listGatewaysCall
 		activeIngresses            []*networking.Ingress
 		inactiveIngresses          []*networking.Ingress
 		svcResource                *corev1.Service
@@ -369,6 +383,13 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 			Name:      "svc-2",
 		},
 	}
+	gw := &gwv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "awesome-ns",
+			Name:       "awesome-gw",
+			Finalizers: []string{"gateway.k8s.aws/resources"},
+		},
+	}
 	tests := []struct {
 		name    string
 		env     env
@@ -396,6 +417,9 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 						services: []*corev1.Service{},
 					},
 				},
+				listGatewaysCalls: []listGatewaysCall{
+					{},
+				},
 				deleteSGCalls: []deleteSecurityGroupWithContextCall{
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
@@ -466,6 +490,9 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 						services: []*corev1.Service{},
 					},
 				},
+				listGatewaysCalls: []listGatewaysCall{
+					{},
+				},
 				deleteSGCalls: []deleteSecurityGroupWithContextCall{
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
@@ -616,6 +643,9 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 						},
 					},
 				},
+				listGatewaysCalls: []listGatewaysCall{
+					{},
+				},
 				deleteSGCalls: []deleteSecurityGroupWithContextCall{
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
@@ -655,12 +685,15 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 						services: []*corev1.Service{},
 					},
 				},
+				listGatewaysCalls: []listGatewaysCall{
+					{},
+				},
 				deleteSGCalls: []deleteSecurityGroupWithContextCall{
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
 							GroupId: awssdk.String("sg-autogen"),
 						},
-						err: awserr.New("DependencyViolation", "", nil),
+						err: &smithy.GenericAPIError{Code: "DependencyViolation"},
 					},
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
@@ -682,17 +715,20 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 				listServicesCalls: []listServicesCall{
 					{},
 				},
+				listGatewaysCalls: []listGatewaysCall{
+					{},
+				},
 				deleteSGCalls: []deleteSecurityGroupWithContextCall{
 					{
 						req: &ec2sdk.DeleteSecurityGroupInput{
 							GroupId: awssdk.String("sg-autogen"),
 						},
-						err: awserr.New("Something.Else", "unable to delete SG", nil),
+						err: &smithy.GenericAPIError{Code: "Something.Else", Message: "unable to delete SG"},
 					},
 				},
 				inactiveIngresses: []*networking.Ingress{ing},
 			},
-			wantErr: errors.New("failed to delete securityGroup: Something.Else: unable to delete SG"),
+			wantErr: errors.New("failed to delete securityGroup: api error Something.Else: unable to delete SG"),
 		},
 		{
 			name: "k8s ingress list returns error",
@@ -723,6 +759,115 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 			},
 			wantErr: errors.New("unable to list services: failed"),
 		},
+		{
+			name: "backend sg required for gateway",
+			fields: fields{
+				autogenSG: "sg-autogen",
+				listIngressCalls: []listIngressCall{
+					{},
+				},
+				listServicesCalls: []listServicesCall{
+					{},
+				},
+				listGatewaysCalls: []listGatewaysCall{
+					{
+						gateways: []*gwv1beta1.Gateway{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace: "regular-ns",
+									Name:      "gw-nofinalizer",
+								},
+							},
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Namespace:  "awesome-ns",
+									Name:       "gw-1",
+									Finalizers: []string{"gateway.k8s.aws/resources"},
+								},
+							},
+						},
+					},
+				},
+				inactiveIngresses: []*networking.Ingress{ing},
+			},
+		},
+		{
+			name: "backend sg requirement for gateway already known, requires delete",
+			fields: fields{
+				autogenSG: "sg-autogen",
+				listIngressCalls: []listIngressCall{
+					{},
+				},
+				listServicesCalls: []listServicesCall{
+					{},
+				},
+				listGatewaysCalls: []listGatewaysCall{
+					{
+						gateways: []*gwv1beta1.Gateway{gw},
+					},
+				},
+				deleteSGCalls: []deleteSecurityGroupWithContextCall{
+					{
+						req: &ec2sdk.DeleteSecurityGroupInput{
+							GroupId: awssdk.String("sg-autogen"),
+						},
+						resp: &ec2sdk.DeleteSecurityGroupOutput{},
+					},
+				},
+				resourceMapItems: []mapItem{
+					{
+						key:   gw,
+						value: false,
+					},
+				},
+				inactiveIngresses: []*networking.Ingress{ing},
+			},
+		},
+		{
+			name: "gateway CRDs not installed, requires delete",
+			fields: fields{
+				autogenSG: "sg-autogen",
+				listIngressCalls: []listIngressCall{
+					{},
+				},
+				listServicesCalls: []listServicesCall{
+					{},
+				},
+				listGatewaysCalls: []listGatewaysCall{
+					{
+						err: &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: gwv1beta1.GroupName, Kind: "Gateway"}},
+					},
+				},
+				deleteSGCalls: []deleteSecurityGroupWithContextCall{
+					{
+						req: &ec2sdk.DeleteSecurityGroupInput{
+							GroupId: awssdk.String("sg-autogen"),
+						},
+						resp: &ec2sdk.DeleteSecurityGroupOutput{},
+					},
+				},
+				inactiveIngresses: []*networking.Ingress{ing},
+			},
+		},
+		{
+			name: "k8s gateway list returns error",
+			fields: fields{
+				autogenSG: "sg-autogen",
+				listIngressCalls: []listIngressCall{
+					{},
+				},
+				listServicesCalls: []listServicesCall{
+					{},
+				},
+				listGatewaysCalls: []listGatewaysCall{
+					{
+						err: errors.New("failed"),
+					},
+				},
+				inactiveIngresses: []*networking.Ingress{ing},
+			},
+			wantErr: errors.New("unable to list gateways: failed"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -731,16 +876,19 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 
 			ec2Client := services.NewMockEC2(ctrl)
 			k8sClient := mock_client.NewMockClient(ctrl)
-			sgProvider := NewBackendSGProvider(defaultClusterName, tt.fields.backendSG,
-				defaultVPCID, ec2Client, k8sClient, tt.fields.defaultTags, logr.New(&log.NullLogSink{}))
+			sgProvider := NewBackendSGProvider(defaultClusterName, tt.fields.backendSG, "",
+				defaultVPCID, ec2Client, k8sClient, NewDefaultTagsProvider(k8sClient, tt.fields.defaultTags, logr.New(&log.NullLogSink{})), nil, true, 0, record.NewFakeRecorder(10), logr.New(&log.NullLogSink{}))
 			if len(tt.fields.autogenSG) > 0 {
 				sgProvider.backendSG = ""
 				sgProvider.autoGeneratedSG = tt.fields.autogenSG
 			}
 			for _, item := range tt.fields.resourceMapItems {
 				var resourceType ResourceType = ResourceTypeIngress
-				if reflect.TypeOf(item.key).String() == "*v1.Service" {
+				switch reflect.TypeOf(item.key).String() {
+				case "*v1.Service":
 					resourceType = ResourceTypeService
+				case "*v1beta1.Gateway":
+					resourceType = ResourceTypeGateway
 				}
 				sgProvider.objectsMap.Store(getObjectKey(resourceType, k8s.NamespacedName(item.key)), item.value)
 			}
@@ -771,6 +919,16 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 					},
 				).AnyTimes()
 			}
+			for _, call := range tt.fields.listGatewaysCalls {
+				k8sClient.EXPECT().List(gomock.Any(), &gwv1beta1.GatewayList{}, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, gwList *gwv1beta1.GatewayList, opts ...client.ListOption) error {
+						for _, gw := range call.gateways {
+							gwList.Items = append(gwList.Items, *(gw.DeepCopy()))
+						}
+						return call.err
+					},
+				).AnyTimes()
+			}
 			for _, ing := range tt.env.ingresses {
 				assert.NoError(t, k8sClient.Create(context.Background(), ing.DeepCopy()))
 			}
@@ -794,3 +952,557 @@ func Test_defaultBackendSGProvider_Release(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultBackendSGProvider_Release_withGracePeriod(t *testing.T) {
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-ing",
+		},
+	}
+	tests := []struct {
+		name            string
+		allocateInGrace bool
+		wantDeleted     bool
+	}{
+		{
+			name:        "backend sg deleted after grace period",
+			wantDeleted: true,
+		},
+		{
+			name:            "backend sg allocated within grace period",
+			allocateInGrace: true,
+			wantDeleted:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			k8sClient := mock_client.NewMockClient(ctrl)
+			k8sClient.EXPECT().List(gomock.Any(), &networking.IngressList{}, gomock.Any()).Return(nil).AnyTimes()
+			k8sClient.EXPECT().List(gomock.Any(), &corev1.ServiceList{}, gomock.Any()).Return(nil).AnyTimes()
+			k8sClient.EXPECT().List(gomock.Any(), &gwv1beta1.GatewayList{}, gomock.Any()).Return(nil).AnyTimes()
+			deleted := make(chan struct{})
+			if tt.wantDeleted {
+				ec2Client.EXPECT().DeleteSecurityGroupWithContext(context.Background(), &ec2sdk.DeleteSecurityGroupInput{
+					GroupId: awssdk.String("sg-autogen"),
+				}).DoAndReturn(func(_ context.Context, _ *ec2sdk.DeleteSecurityGroupInput) (*ec2sdk.DeleteSecurityGroupOutput, error) {
+					close(deleted)
+					return &ec2sdk.DeleteSecurityGroupOutput{}, nil
+				})
+			}
+			sgProvider := NewBackendSGProvider(defaultClusterName, "", "", defaultVPCID, ec2Client, k8sClient,
+				NewDefaultTagsProvider(k8sClient, nil, logr.New(&log.NullLogSink{})), nil, true, 50*time.Millisecond,
+				record.NewFakeRecorder(10), logr.New(&log.NullLogSink{}))
+			sgProvider.autoGeneratedSG = "sg-autogen"
+
+			err := sgProvider.Release(context.Background(), ResourceTypeIngress, []types.NamespacedName{k8s.NamespacedName(ing)})
+			assert.NoError(t, err)
+			assert.Equal(t, "sg-autogen", sgProvider.autoGeneratedSG)
+			if tt.allocateInGrace {
+				sgID, err := sgProvider.Get(context.Background(), ResourceTypeIngress, []types.NamespacedName{k8s.NamespacedName(ing)}, nil)
+				assert.NoError(t, err)
+				assert.Equal(t, "sg-autogen", sgID)
+			}
+
+			select {
+			case <-deleted:
+			case <-time.After(500 * time.Millisecond):
+			}
+			sgProvider.mutex.Lock()
+			defer sgProvider.mutex.Unlock()
+			if tt.wantDeleted {
+				assert.Equal(t, "", sgProvider.autoGeneratedSG)
+			} else {
+				assert.Equal(t, "sg-autogen", sgProvider.autoGeneratedSG)
+				assert.Nil(t, sgProvider.pendingRelease)
+			}
+		})
+	}
+}
+
+func Test_defaultBackendSGProvider_checkAutoGeneratedSG(t *testing.T) {
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "ing-1",
+		},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "svc-1",
+		},
+	}
+	type describeSecurityGroupsAsListCall struct {
+		req  *ec2sdk.DescribeSecurityGroupsInput
+		resp []ec2types.SecurityGroup
+		err  error
+	}
+	type createTagsWithContextCall struct {
+		req *ec2sdk.CreateTagsInput
+	}
+	type deleteTagsWithContextCall struct {
+		req *ec2sdk.DeleteTagsInput
+	}
+	type fields struct {
+		autoGeneratedSG      string
+		defaultTags          map[string]string
+		externalManagedTags  []string
+		strictTagEnforcement bool
+		additionalTags       map[string]string
+		describeSGCalls      []describeSecurityGroupsAsListCall
+		createTagsCalls      []createTagsWithContextCall
+		deleteTagsCalls      []deleteTagsWithContextCall
+	}
+	backendSGTags := []ec2types.Tag{
+		{
+			Key:   awssdk.String("elbv2.k8s.aws/cluster"),
+			Value: awssdk.String(defaultClusterName),
+		},
+		{
+			Key:   awssdk.String("elbv2.k8s.aws/resource"),
+			Value: awssdk.String("backend-sg"),
+		},
+	}
+	driftedBackendSGTags := append([]ec2types.Tag{
+		{
+			Key:   awssdk.String("team"),
+			Value: awssdk.String("old-team"),
+		},
+		{
+			Key:   awssdk.String("unknown"),
+			Value: awssdk.String("value"),
+		},
+		{
+			Key:   awssdk.String("external"),
+			Value: awssdk.String("value"),
+		},
+	}, backendSGTags...)
+	tests := []struct {
+		name                string
+		fields              fields
+		wantAutoGeneratedSG string
+		wantEvents          []string
+		wantRecoveredIngs   []types.NamespacedName
+	}{
+		{
+			name: "backend SG exists",
+			fields: fields{
+				autoGeneratedSG: "sg-autogenerated",
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							GroupIds: []string{"sg-autogenerated"},
+						},
+						resp: []ec2types.SecurityGroup{
+							{
+								GroupId: awssdk.String("sg-autogenerated"),
+								Tags:    backendSGTags,
+							},
+						},
+					},
+				},
+			},
+			wantAutoGeneratedSG: "sg-autogenerated",
+		},
+		{
+			name: "backend SG tags drifted with strict tag enforcement",
+			fields: fields{
+				autoGeneratedSG:      "sg-autogenerated",
+				defaultTags:          map[string]string{"team": "lb-team"},
+				externalManagedTags:  []string{"external"},
+				strictTagEnforcement: true,
+				additionalTags:       map[string]string{"ingress-tag": "value"},
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							GroupIds: []string{"sg-autogenerated"},
+						},
+						resp: []ec2types.SecurityGroup{
+							{
+								GroupId: awssdk.String("sg-autogenerated"),
+								Tags:    driftedBackendSGTags,
+							},
+						},
+					},
+				},
+				createTagsCalls: []createTagsWithContextCall{
+					{
+						req: &ec2sdk.CreateTagsInput{
+							Resources: []string{"sg-autogenerated"},
+							Tags: []ec2types.Tag{
+								{
+									Key:   awssdk.String("ingress-tag"),
+									Value: awssdk.String("value"),
+								},
+								{
+									Key:   awssdk.String("team"),
+									Value: awssdk.String("lb-team"),
+								},
+							},
+						},
+					},
+				},
+				deleteTagsCalls: []deleteTagsWithContextCall{
+					{
+						req: &ec2sdk.DeleteTagsInput{
+							Resources: []string{"sg-autogenerated"},
+							Tags: []ec2types.Tag{
+								{
+									Key:   awssdk.String("unknown"),
+									Value: awssdk.String("value"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantAutoGeneratedSG: "sg-autogenerated",
+		},
+		{
+			name: "backend SG tags drifted with additive tag enforcement",
+			fields: fields{
+				autoGeneratedSG:     "sg-autogenerated",
+				defaultTags:         map[string]string{"team": "lb-team"},
+				externalManagedTags: []string{"external"},
+				additionalTags:      map[string]string{"ingress-tag": "value"},
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							GroupIds: []string{"sg-autogenerated"},
+						},
+						resp: []ec2types.SecurityGroup{
+							{
+								GroupId: awssdk.String("sg-autogenerated"),
+								Tags:    driftedBackendSGTags,
+							},
+						},
+					},
+				},
+				createTagsCalls: []createTagsWithContextCall{
+					{
+						req: &ec2sdk.CreateTagsInput{
+							Resources: []string{"sg-autogenerated"},
+							Tags: []ec2types.Tag{
+								{
+									Key:   awssdk.String("ingress-tag"),
+									Value: awssdk.String("value"),
+								},
+								{
+									Key:   awssdk.String("team"),
+									Value: awssdk.String("lb-team"),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantAutoGeneratedSG: "sg-autogenerated",
+		},
+		{
+			name: "backend SG deleted externally",
+			fields: fields{
+				autoGeneratedSG: "sg-autogenerated",
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							GroupIds: []string{"sg-autogenerated"},
+						},
+						err: &smithy.GenericAPIError{Code: "InvalidGroup.NotFound"},
+					},
+				},
+			},
+			wantAutoGeneratedSG: "",
+			wantEvents: []string{
+				"Warning BackendSGDeletedExternally Backend security group sg-autogenerated was deleted externally, recreating it",
+				"Warning BackendSGDeletedExternally Backend security group sg-autogenerated was deleted externally, recreating it",
+			},
+			wantRecoveredIngs: []types.NamespacedName{k8s.NamespacedName(ing)},
+		},
+		{
+			name: "describe backend SG failed",
+			fields: fields{
+				autoGeneratedSG: "sg-autogenerated",
+				describeSGCalls: []describeSecurityGroupsAsListCall{
+					{
+						req: &ec2sdk.DescribeSecurityGroupsInput{
+							GroupIds: []string{"sg-autogenerated"},
+						},
+						err: &smithy.GenericAPIError{Code: "Describe.Error", Message: "unable to describe security group"},
+					},
+				},
+			},
+			wantAutoGeneratedSG: "sg-autogenerated",
+		},
+		{
+			name:                "backend SG not allocated",
+			wantAutoGeneratedSG: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.fields.describeSGCalls {
+				ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), call.req).Return(call.resp, call.err)
+			}
+			for _, call := range tt.fields.createTagsCalls {
+				ec2Client.EXPECT().CreateTagsWithContext(gomock.Any(), call.req).Return(&ec2sdk.CreateTagsOutput{}, nil)
+			}
+			for _, call := range tt.fields.deleteTagsCalls {
+				ec2Client.EXPECT().DeleteTagsWithContext(gomock.Any(), call.req).Return(&ec2sdk.DeleteTagsOutput{}, nil)
+			}
+			k8sClient := testclient.NewClientBuilder().WithObjects(ing.DeepCopy(), svc.DeepCopy()).Build()
+			eventRecorder := record.NewFakeRecorder(10)
+			sgProvider := NewBackendSGProvider(defaultClusterName, "", "",
+				defaultVPCID, ec2Client, k8sClient, NewDefaultTagsProvider(k8sClient, tt.fields.defaultTags, logr.New(&log.NullLogSink{})), tt.fields.externalManagedTags, tt.fields.strictTagEnforcement, 0,
+				eventRecorder, logr.New(&log.NullLogSink{}))
+			sgProvider.autoGeneratedSG = tt.fields.autoGeneratedSG
+			for key, val := range tt.fields.additionalTags {
+				sgProvider.additionalTags[key] = val
+			}
+			sgProvider.updateObjectsMap(context.Background(), ResourceTypeIngress, []types.NamespacedName{k8s.NamespacedName(ing)}, true)
+			sgProvider.updateObjectsMap(context.Background(), ResourceTypeService, []types.NamespacedName{k8s.NamespacedName(svc)}, true)
+			sgProvider.updateObjectsMap(context.Background(), ResourceTypeIngress, []types.NamespacedName{{Namespace: "awesome-ns", Name: "ing-2"}}, false)
+
+			ingEventChan := sgProvider.RecoveryEvents(ResourceTypeIngress)
+			var gotRecoveredIngs []types.NamespacedName
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				for e := range ingEventChan {
+					gotRecoveredIngs = append(gotRecoveredIngs, k8s.NamespacedName(e.Object))
+				}
+			}()
+			sgProvider.checkAutoGeneratedSG(context.Background())
+			close(sgProvider.recoveryEventChans[ResourceTypeIngress])
+			<-done
+			close(eventRecorder.Events)
+			var gotEvents []string
+			for e := range eventRecorder.Events {
+				gotEvents = append(gotEvents, e)
+			}
+
+			assert.Equal(t, tt.wantAutoGeneratedSG, sgProvider.autoGeneratedSG)
+			assert.Equal(t, tt.wantEvents, gotEvents)
+			assert.Equal(t, tt.wantRecoveredIngs, gotRecoveredIngs)
+		})
+	}
+}
+
+func Test_defaultBackendSGProvider_HandleSGDeleted(t *testing.T) {
+	tests := []struct {
+		name                string
+		autoGeneratedSG     string
+		deletedSG           string
+		wantDescribeSG      bool
+		wantAutoGeneratedSG string
+	}{
+		{
+			name:                "auto-generated backend SG deleted",
+			autoGeneratedSG:     "sg-autogenerated",
+			deletedSG:           "sg-autogenerated",
+			wantDescribeSG:      true,
+			wantAutoGeneratedSG: "",
+		},
+		{
+			name:                "other SG deleted",
+			autoGeneratedSG:     "sg-autogenerated",
+			deletedSG:           "sg-other",
+			wantAutoGeneratedSG: "sg-autogenerated",
+		},
+		{
+			name:                "backend SG not allocated",
+			deletedSG:           "sg-other",
+			wantAutoGeneratedSG: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			if tt.wantDescribeSG {
+				ec2Client.EXPECT().DescribeSecurityGroupsAsList(gomock.Any(), &ec2sdk.DescribeSecurityGroupsInput{
+					GroupIds: []string{tt.deletedSG},
+				}).Return(nil, &smithy.GenericAPIError{Code: "InvalidGroup.NotFound"})
+			}
+			k8sClient := testclient.NewClientBuilder().Build()
+			sgProvider := NewBackendSGProvider(defaultClusterName, "", "",
+				defaultVPCID, ec2Client, k8sClient, NewDefaultTagsProvider(k8sClient, nil, logr.New(&log.NullLogSink{})), nil, false, 0,
+				record.NewFakeRecorder(10), logr.New(&log.NullLogSink{}))
+			sgProvider.autoGeneratedSG = tt.autoGeneratedSG
+
+			sgProvider.HandleSGDeleted(context.Background(), tt.deletedSG)
+			assert.Equal(t, tt.wantAutoGeneratedSG, sgProvider.autoGeneratedSG)
+		})
+	}
+}
+
+func Test_defaultBackendSGProvider_sharedBackendSG(t *testing.T) {
+	shareKey := "vpc-xxxyyy"
+	sharedEC2Filters := []ec2types.Filter{
+		{
+			Name:   awssdk.String("vpc-id"),
+			Values: []string{defaultVPCID},
+		},
+		{
+			Name:   awssdk.String("tag:elbv2.k8s.aws/backend-sg-share-key"),
+			Values: []string{shareKey},
+		},
+		{
+			Name:   awssdk.String("tag:elbv2.k8s.aws/resource"),
+			Values: []string{"backend-sg"},
+		},
+	}
+	joinReq := &ec2sdk.CreateTagsInput{
+		Resources: []string{"sg-shared"},
+		Tags: []ec2types.Tag{
+			{
+				Key:   awssdk.String("elbv2.k8s.aws/backend-sg-cluster/testCluster"),
+				Value: awssdk.String("true"),
+			},
+		},
+	}
+	createReq := &ec2sdk.CreateSecurityGroupInput{
+		Description: awssdk.String(sgDescription),
+		GroupName:   awssdk.String("k8s-traffic-shared-vpcxxxyyy-3c17654b31"),
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypeSecurityGroup,
+				Tags: []ec2types.Tag{
+					{
+						Key:   awssdk.String("elbv2.k8s.aws/backend-sg-cluster/testCluster"),
+						Value: awssdk.String("true"),
+					},
+					{
+						Key:   awssdk.String("elbv2.k8s.aws/backend-sg-share-key"),
+						Value: awssdk.String(shareKey),
+					},
+					{
+						Key:   awssdk.String("elbv2.k8s.aws/cluster"),
+						Value: awssdk.String(defaultClusterName),
+					},
+					{
+						Key:   awssdk.String("elbv2.k8s.aws/resource"),
+						Value: awssdk.String("backend-sg"),
+					},
+				},
+			},
+		},
+		VpcId: awssdk.String(defaultVPCID),
+	}
+	leaveReq := &ec2sdk.DeleteTagsInput{
+		Resources: []string{"sg-shared"},
+		Tags: []ec2types.Tag{
+			{
+				Key: awssdk.String("elbv2.k8s.aws/backend-sg-cluster/testCluster"),
+			},
+		},
+	}
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-ing",
+		},
+	}
+
+	t.Run("join existing shared backend SG", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ec2Client := services.NewMockEC2(ctrl)
+		ec2Client.EXPECT().DescribeSecurityGroupsAsList(context.Background(), &ec2sdk.DescribeSecurityGroupsInput{Filters: sharedEC2Filters}).
+			Return([]ec2types.SecurityGroup{{GroupId: awssdk.String("sg-shared")}}, nil)
+		ec2Client.EXPECT().CreateTagsWithContext(context.Background(), joinReq).Return(&ec2sdk.CreateTagsOutput{}, nil)
+		k8sClient := mock_client.NewMockClient(ctrl)
+		sgProvider := NewBackendSGProvider(defaultClusterName, "", shareKey, defaultVPCID, ec2Client, k8sClient,
+			NewDefaultTagsProvider(k8sClient, nil, logr.New(&log.NullLogSink{})), nil, true, 0, record.NewFakeRecorder(10), logr.New(&log.NullLogSink{}))
+		got, err := sgProvider.Get(context.Background(), ResourceTypeIngress, k8s.ToSliceOfNamespacedNames([]*networking.Ingress{ing}), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "sg-shared", got)
+	})
+
+	t.Run("join shared backend SG created concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ec2Client := services.NewMockEC2(ctrl)
+		gomock.InOrder(
+			ec2Client.EXPECT().DescribeSecurityGroupsAsList(context.Background(), &ec2sdk.DescribeSecurityGroupsInput{Filters: sharedEC2Filters}).
+				Return(nil, nil),
+			ec2Client.EXPECT().CreateSecurityGroupWithContext(context.Background(), createReq).
+				Return(nil, &smithy.GenericAPIError{Code: "InvalidGroup.Duplicate"}),
+			ec2Client.EXPECT().DescribeSecurityGroupsAsList(context.Background(), &ec2sdk.DescribeSecurityGroupsInput{Filters: sharedEC2Filters}).
+				Return([]ec2types.SecurityGroup{{GroupId: awssdk.String("sg-shared")}}, nil),
+			ec2Client.EXPECT().CreateTagsWithContext(context.Background(), joinReq).Return(&ec2sdk.CreateTagsOutput{}, nil),
+		)
+		k8sClient := mock_client.NewMockClient(ctrl)
+		sgProvider := NewBackendSGProvider(defaultClusterName, "", shareKey, defaultVPCID, ec2Client, k8sClient,
+			NewDefaultTagsProvider(k8sClient, nil, logr.New(&log.NullLogSink{})), nil, true, 0, record.NewFakeRecorder(10), logr.New(&log.NullLogSink{}))
+		got, err := sgProvider.Get(context.Background(), ResourceTypeIngress, k8s.ToSliceOfNamespacedNames([]*networking.Ingress{ing}), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "sg-shared", got)
+	})
+
+	tests := []struct {
+		name       string
+		sharedSG   *ec2types.SecurityGroup
+		wantDelete bool
+	}{
+		{
+			name: "release shared backend SG used by other clusters",
+			sharedSG: &ec2types.SecurityGroup{
+				GroupId: awssdk.String("sg-shared"),
+				Tags: []ec2types.Tag{
+					{
+						Key:   awssdk.String("elbv2.k8s.aws/backend-sg-cluster/otherCluster"),
+						Value: awssdk.String("true"),
+					},
+				},
+			},
+		},
+		{
+			name: "release shared backend SG used by no other cluster",
+			sharedSG: &ec2types.SecurityGroup{
+				GroupId: awssdk.String("sg-shared"),
+				Tags: []ec2types.Tag{
+					{
+						Key:   awssdk.String("elbv2.k8s.aws/cluster"),
+						Value: awssdk.String("otherCluster"),
+					},
+				},
+			},
+			wantDelete: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ec2Client := services.NewMockEC2(ctrl)
+			ec2Client.EXPECT().DeleteTagsWithContext(context.Background(), leaveReq).Return(&ec2sdk.DeleteTagsOutput{}, nil)
+			ec2Client.EXPECT().DescribeSecurityGroupsAsList(context.Background(), &ec2sdk.DescribeSecurityGroupsInput{GroupIds: []string{"sg-shared"}}).
+				Return([]ec2types.SecurityGroup{*tt.sharedSG}, nil)
+			if tt.wantDelete {
+				ec2Client.EXPECT().DeleteSecurityGroupWithContext(context.Background(), &ec2sdk.DeleteSecurityGroupInput{GroupId: awssdk.String("sg-shared")}).
+					Return(&ec2sdk.DeleteSecurityGroupOutput{}, nil)
+			}
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			gwv1beta1.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			sgProvider := NewBackendSGProvider(defaultClusterName, "", shareKey, defaultVPCID, ec2Client, k8sClient,
+				NewDefaultTagsProvider(k8sClient, nil, logr.New(&log.NullLogSink{})), nil, true, 0, record.NewFakeRecorder(10), logr.New(&log.NullLogSink{}))
+			sgProvider.autoGeneratedSG = "sg-shared"
+			err := sgProvider.Release(context.Background(), ResourceTypeIngress, k8s.ToSliceOfNamespacedNames([]*networking.Ingress{ing}))
+			assert.NoError(t, err)
+			assert.Equal(t, "", sgProvider.autoGeneratedSG)
+		})
+	}
+}
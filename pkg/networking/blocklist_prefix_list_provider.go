@@ -0,0 +1,174 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	defaultBlocklistSyncInterval      = 5 * time.Minute
+	tagValueBlocklistPrefixListFormat = "blocklist-%v"
+)
+
+var blocklistPrefixListAddressFamilies = []PrefixListAddressFamily{PrefixListAddressFamilyIPv4, PrefixListAddressFamilyIPv6}
+
+// BlocklistPrefixListProvider is responsible for providing the managed prefix lists that contain all addresses
+// except the CIDRs blocked by Blocklists.
+// Security groups can only allow traffic, so the internet-facing rules reference these prefix lists instead of 0.0.0.0/0 or ::/0.
+type BlocklistPrefixListProvider interface {
+	// Get returns the ID of the blocklist prefix list of addressFamily.
+	Get(ctx context.Context, addressFamily PrefixListAddressFamily) (string, error)
+
+	// Sync ensures the blocklist prefix lists exclude the CIDRs of all Blocklists, and returns their IDs.
+	Sync(ctx context.Context) ([]string, error)
+}
+
+// NewDefaultBlocklistPrefixListProvider constructs new defaultBlocklistPrefixListProvider.
+func NewDefaultBlocklistPrefixListProvider(clusterName string, ec2Client services.EC2, k8sClient client.Client,
+	defaultTagsProvider DefaultTagsProvider, logger logr.Logger) *defaultBlocklistPrefixListProvider {
+	return &defaultBlocklistPrefixListProvider{
+		prefixListSyncer: newManagedPrefixListSyncer(clusterName, ec2Client, defaultTagsProvider, logger),
+		k8sClient:        k8sClient,
+		logger:           logger,
+		prefixListIDs:    make(map[PrefixListAddressFamily]string),
+		syncInterval:     defaultBlocklistSyncInterval,
+	}
+}
+
+var _ BlocklistPrefixListProvider = &defaultBlocklistPrefixListProvider{}
+var _ manager.Runnable = &defaultBlocklistPrefixListProvider{}
+var _ manager.LeaderElectionRunnable = &defaultBlocklistPrefixListProvider{}
+
+// default implementation for BlocklistPrefixListProvider.
+// there is one prefix list per address family, whose entries are the whole address space minus the blocked CIDRs.
+type defaultBlocklistPrefixListProvider struct {
+	prefixListSyncer *managedPrefixListSyncer
+	k8sClient        client.Client
+	logger           logr.Logger
+
+	// prefixListIDs caches the IDs of the prefix lists by address family, it's protected by prefixListIDsMutex.
+	prefixListIDs      map[PrefixListAddressFamily]string
+	prefixListIDsMutex sync.RWMutex
+	// syncMutex serializes the modifications of the prefix lists.
+	syncMutex sync.Mutex
+
+	syncInterval time.Duration
+}
+
+func (p *defaultBlocklistPrefixListProvider) Get(ctx context.Context, addressFamily PrefixListAddressFamily) (string, error) {
+	p.prefixListIDsMutex.RLock()
+	prefixListID, ok := p.prefixListIDs[addressFamily]
+	p.prefixListIDsMutex.RUnlock()
+	if ok {
+		return prefixListID, nil
+	}
+
+	prefixList, err := p.prefixListSyncer.find(ctx, buildBlocklistPrefixListResourceTagValue(addressFamily))
+	if err != nil {
+		return "", err
+	}
+	if prefixList == nil {
+		return "", errors.Errorf("blocklist prefix list for %v not found", addressFamily)
+	}
+	prefixListID = awssdk.StringValue(prefixList.PrefixListId)
+	p.cachePrefixListID(addressFamily, prefixListID)
+	return prefixListID, nil
+}
+
+func (p *defaultBlocklistPrefixListProvider) Sync(ctx context.Context) ([]string, error) {
+	p.syncMutex.Lock()
+	defer p.syncMutex.Unlock()
+
+	blockedCIDRs, err := p.loadBlockedCIDRs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefixListIDs := make([]string, 0, len(blocklistPrefixListAddressFamilies))
+	for _, addressFamily := range blocklistPrefixListAddressFamilies {
+		prefixListID, err := p.syncPrefixList(ctx, addressFamily, blockedCIDRs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to sync blocklist prefix list for %v", addressFamily)
+		}
+		prefixListIDs = append(prefixListIDs, prefixListID)
+	}
+	return prefixListIDs, nil
+}
+
+// Start syncs the blocklist prefix lists periodically until ctx is done, which recovers from external modifications.
+func (p *defaultBlocklistPrefixListProvider) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, p.periodicSync, p.syncInterval)
+	return nil
+}
+
+// NeedLeaderElection returns true, as the blocklist prefix lists are only modified by the leader.
+func (p *defaultBlocklistPrefixListProvider) NeedLeaderElection() bool {
+	return true
+}
+
+func (p *defaultBlocklistPrefixListProvider) periodicSync(ctx context.Context) {
+	if _, err := p.Sync(ctx); err != nil {
+		p.logger.Error(err, "failed to sync blocklist prefix lists")
+	}
+}
+
+// loadBlockedCIDRs returns the CIDRs of all Blocklists, invalid CIDRs are ignored.
+func (p *defaultBlocklistPrefixListProvider) loadBlockedCIDRs(ctx context.Context) ([]netip.Prefix, error) {
+	blocklistList := &elbv2api.BlocklistList{}
+	if err := p.k8sClient.List(ctx, blocklistList); err != nil {
+		return nil, err
+	}
+	var blockedCIDRs []netip.Prefix
+	for _, blocklist := range blocklistList.Items {
+		for _, cidr := range blocklist.Spec.CIDRs {
+			blockedCIDR, err := netip.ParsePrefix(cidr)
+			if err != nil {
+				p.logger.Info("ignoring invalid blocklist CIDR", "blocklist", blocklist.Name, "cidr", cidr)
+				continue
+			}
+			blockedCIDRs = append(blockedCIDRs, blockedCIDR.Masked())
+		}
+	}
+	return blockedCIDRs, nil
+}
+
+// syncPrefixList creates or modifies the prefix list of addressFamily to contain all addresses except blockedCIDRs.
+func (p *defaultBlocklistPrefixListProvider) syncPrefixList(ctx context.Context, addressFamily PrefixListAddressFamily, blockedCIDRs []netip.Prefix) (string, error) {
+	allAddresses := netip.MustParsePrefix("0.0.0.0/0")
+	if addressFamily == PrefixListAddressFamilyIPv6 {
+		allAddresses = netip.MustParsePrefix("::/0")
+	}
+	desiredCIDRs := sets.NewString()
+	for _, cidr := range ExcludeCIDRs(allAddresses, blockedCIDRs) {
+		desiredCIDRs.Insert(cidr.String())
+	}
+	prefixListID, err := p.prefixListSyncer.sync(ctx, buildBlocklistPrefixListResourceTagValue(addressFamily), addressFamily, desiredCIDRs)
+	if err != nil {
+		return "", err
+	}
+	p.cachePrefixListID(addressFamily, prefixListID)
+	return prefixListID, nil
+}
+
+func (p *defaultBlocklistPrefixListProvider) cachePrefixListID(addressFamily PrefixListAddressFamily, prefixListID string) {
+	p.prefixListIDsMutex.Lock()
+	defer p.prefixListIDsMutex.Unlock()
+	p.prefixListIDs[addressFamily] = prefixListID
+}
+
+func buildBlocklistPrefixListResourceTagValue(addressFamily PrefixListAddressFamily) string {
+	return fmt.Sprintf(tagValueBlocklistPrefixListFormat, strings.ToLower(string(addressFamily)))
+}
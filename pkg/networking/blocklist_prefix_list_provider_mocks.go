@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/networking (interfaces: BlocklistPrefixListProvider)
+
+// Package networking is a generated GoMock package.
+package networking
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBlocklistPrefixListProvider is a mock of BlocklistPrefixListProvider interface.
+type MockBlocklistPrefixListProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlocklistPrefixListProviderMockRecorder
+}
+
+// MockBlocklistPrefixListProviderMockRecorder is the mock recorder for MockBlocklistPrefixListProvider.
+type MockBlocklistPrefixListProviderMockRecorder struct {
+	mock *MockBlocklistPrefixListProvider
+}
+
+// NewMockBlocklistPrefixListProvider creates a new mock instance.
+func NewMockBlocklistPrefixListProvider(ctrl *gomock.Controller) *MockBlocklistPrefixListProvider {
+	mock := &MockBlocklistPrefixListProvider{ctrl: ctrl}
+	mock.recorder = &MockBlocklistPrefixListProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlocklistPrefixListProvider) EXPECT() *MockBlocklistPrefixListProviderMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockBlocklistPrefixListProvider) Get(arg0 context.Context, arg1 PrefixListAddressFamily) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlocklistPrefixListProviderMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlocklistPrefixListProvider)(nil).Get), arg0, arg1)
+}
+
+// Sync mocks base method.
+func (m *MockBlocklistPrefixListProvider) Sync(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockBlocklistPrefixListProviderMockRecorder) Sync(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockBlocklistPrefixListProvider)(nil).Sync), arg0)
+}
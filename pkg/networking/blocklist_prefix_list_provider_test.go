@@ -0,0 +1,222 @@
+package networking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultBlocklistPrefixListProvider_Sync(t *testing.T) {
+	type describeManagedPrefixListsCall struct {
+		req  *ec2sdk.DescribeManagedPrefixListsInput
+		resp *ec2sdk.DescribeManagedPrefixListsOutput
+	}
+	type createManagedPrefixListCall struct {
+		req  *ec2sdk.CreateManagedPrefixListInput
+		resp *ec2sdk.CreateManagedPrefixListOutput
+	}
+	type getManagedPrefixListEntriesCall struct {
+		prefixListID string
+		cidrs        []string
+	}
+	describeByTagsReq := func(addressFamily string) *ec2sdk.DescribeManagedPrefixListsInput {
+		return &ec2sdk.DescribeManagedPrefixListsInput{
+			Filters: []ec2types.Filter{
+				{
+					Name:   awssdk.String("tag:elbv2.k8s.aws/cluster"),
+					Values: []string{defaultClusterName},
+				},
+				{
+					Name:   awssdk.String("tag:elbv2.k8s.aws/resource"),
+					Values: []string{"blocklist-" + addressFamily},
+				},
+			},
+		}
+	}
+	describeByIDReq := func(prefixListID string) *ec2sdk.DescribeManagedPrefixListsInput {
+		return &ec2sdk.DescribeManagedPrefixListsInput{
+			PrefixListIds: []string{prefixListID},
+		}
+	}
+	prefixListResp := func(prefixListID string, state string, version int64, maxEntries int32) *ec2sdk.DescribeManagedPrefixListsOutput {
+		return &ec2sdk.DescribeManagedPrefixListsOutput{
+			PrefixLists: []ec2types.ManagedPrefixList{
+				{
+					PrefixListId: awssdk.String(prefixListID),
+					State:        ec2types.PrefixListState(state),
+					Version:      awssdk.Int64(version),
+					MaxEntries:   awssdk.Int32(maxEntries),
+				},
+			},
+		}
+	}
+	createReq := func(addressFamily string, cidrs []string) *ec2sdk.CreateManagedPrefixListInput {
+		req := &ec2sdk.CreateManagedPrefixListInput{
+			PrefixListName: awssdk.String("k8s-testCluster-blocklist-" + addressFamily),
+			AddressFamily:  awssdk.String(map[string]string{"ipv4": "IPv4", "ipv6": "IPv6"}[addressFamily]),
+			MaxEntries:     awssdk.Int32(int32(len(cidrs))),
+			TagSpecifications: []ec2types.TagSpecification{
+				{
+					ResourceType: ec2types.ResourceTypePrefixList,
+					Tags: []ec2types.Tag{
+						{Key: awssdk.String("elbv2.k8s.aws/cluster"), Value: awssdk.String(defaultClusterName)},
+						{Key: awssdk.String("elbv2.k8s.aws/resource"), Value: awssdk.String("blocklist-" + addressFamily)},
+						{Key: awssdk.String("team"), Value: awssdk.String("awesome")},
+					},
+				},
+			},
+		}
+		for _, cidr := range cidrs {
+			req.Entries = append(req.Entries, ec2types.AddPrefixListEntry{Cidr: awssdk.String(cidr)})
+		}
+		return req
+	}
+	blocklist := &elbv2api.Blocklist{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-blocklist",
+		},
+		Spec: elbv2api.BlocklistSpec{
+			CIDRs: []string{"64.0.0.0/2", "8000::/1", "invalid"},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		describeCalls       []describeManagedPrefixListsCall
+		createCalls         []createManagedPrefixListCall
+		getEntriesCalls     []getManagedPrefixListEntriesCall
+		modifyCalls         []*ec2sdk.ModifyManagedPrefixListInput
+		want                []string
+		wantCachedPrefixIDs map[PrefixListAddressFamily]string
+	}{
+		{
+			name: "prefix lists are created",
+			describeCalls: []describeManagedPrefixListsCall{
+				{req: describeByTagsReq("ipv4"), resp: &ec2sdk.DescribeManagedPrefixListsOutput{}},
+				{req: describeByIDReq("pl-ipv4"), resp: prefixListResp("pl-ipv4", "create-in-progress", 1, 2)},
+				{req: describeByIDReq("pl-ipv4"), resp: prefixListResp("pl-ipv4", "create-complete", 1, 2)},
+				{req: describeByTagsReq("ipv6"), resp: &ec2sdk.DescribeManagedPrefixListsOutput{}},
+				{req: describeByIDReq("pl-ipv6"), resp: prefixListResp("pl-ipv6", "create-complete", 1, 1)},
+			},
+			createCalls: []createManagedPrefixListCall{
+				{
+					req:  createReq("ipv4", []string{"0.0.0.0/2", "128.0.0.0/1"}),
+					resp: &ec2sdk.CreateManagedPrefixListOutput{PrefixList: &ec2types.ManagedPrefixList{PrefixListId: awssdk.String("pl-ipv4")}},
+				},
+				{
+					req:  createReq("ipv6", []string{"::/1"}),
+					resp: &ec2sdk.CreateManagedPrefixListOutput{PrefixList: &ec2types.ManagedPrefixList{PrefixListId: awssdk.String("pl-ipv6")}},
+				},
+			},
+			getEntriesCalls: []getManagedPrefixListEntriesCall{
+				{prefixListID: "pl-ipv4", cidrs: []string{"0.0.0.0/2", "128.0.0.0/1"}},
+				{prefixListID: "pl-ipv6", cidrs: []string{"::/1"}},
+			},
+			want: []string{"pl-ipv4", "pl-ipv6"},
+			wantCachedPrefixIDs: map[PrefixListAddressFamily]string{
+				PrefixListAddressFamilyIPv4: "pl-ipv4",
+				PrefixListAddressFamilyIPv6: "pl-ipv6",
+			},
+		},
+		{
+			name: "prefix lists are modified",
+			describeCalls: []describeManagedPrefixListsCall{
+				{req: describeByTagsReq("ipv4"), resp: prefixListResp("pl-ipv4", "modify-complete", 3, 1)},
+				{req: describeByIDReq("pl-ipv4"), resp: prefixListResp("pl-ipv4", "modify-complete", 3, 1)},
+				{req: describeByIDReq("pl-ipv4"), resp: prefixListResp("pl-ipv4", "modify-complete", 3, 2)},
+				{req: describeByIDReq("pl-ipv4"), resp: prefixListResp("pl-ipv4", "modify-complete", 4, 2)},
+				{req: describeByTagsReq("ipv6"), resp: prefixListResp("pl-ipv6", "modify-complete", 2, 3)},
+				{req: describeByIDReq("pl-ipv6"), resp: prefixListResp("pl-ipv6", "modify-complete", 2, 3)},
+				{req: describeByIDReq("pl-ipv6"), resp: prefixListResp("pl-ipv6", "modify-complete", 3, 3)},
+			},
+			getEntriesCalls: []getManagedPrefixListEntriesCall{
+				{prefixListID: "pl-ipv4", cidrs: []string{"0.0.0.0/0"}},
+				{prefixListID: "pl-ipv6", cidrs: []string{"::/1", "8000::/2", "c000::/2"}},
+			},
+			modifyCalls: []*ec2sdk.ModifyManagedPrefixListInput{
+				{
+					PrefixListId: awssdk.String("pl-ipv4"),
+					MaxEntries:   awssdk.Int32(2),
+				},
+				{
+					PrefixListId:   awssdk.String("pl-ipv4"),
+					CurrentVersion: awssdk.Int64(3),
+					AddEntries: []ec2types.AddPrefixListEntry{
+						{Cidr: awssdk.String("0.0.0.0/2")},
+						{Cidr: awssdk.String("128.0.0.0/1")},
+					},
+					RemoveEntries: []ec2types.RemovePrefixListEntry{
+						{Cidr: awssdk.String("0.0.0.0/0")},
+					},
+				},
+				{
+					PrefixListId:   awssdk.String("pl-ipv6"),
+					CurrentVersion: awssdk.Int64(2),
+					RemoveEntries: []ec2types.RemovePrefixListEntry{
+						{Cidr: awssdk.String("8000::/2")},
+						{Cidr: awssdk.String("c000::/2")},
+					},
+				},
+				{
+					PrefixListId: awssdk.String("pl-ipv6"),
+					MaxEntries:   awssdk.Int32(1),
+				},
+			},
+			want: []string{"pl-ipv4", "pl-ipv6"},
+			wantCachedPrefixIDs: map[PrefixListAddressFamily]string{
+				PrefixListAddressFamilyIPv4: "pl-ipv4",
+				PrefixListAddressFamilyIPv6: "pl-ipv6",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.describeCalls {
+				ec2Client.EXPECT().DescribeManagedPrefixListsWithContext(gomock.Any(), call.req).Return(call.resp, nil)
+			}
+			for _, call := range tt.createCalls {
+				ec2Client.EXPECT().CreateManagedPrefixListWithContext(gomock.Any(), call.req).Return(call.resp, nil)
+			}
+			for _, call := range tt.getEntriesCalls {
+				entries := make([]ec2types.PrefixListEntry, 0, len(call.cidrs))
+				for _, cidr := range call.cidrs {
+					entries = append(entries, ec2types.PrefixListEntry{Cidr: awssdk.String(cidr)})
+				}
+				ec2Client.EXPECT().GetManagedPrefixListEntriesAsList(gomock.Any(), &ec2sdk.GetManagedPrefixListEntriesInput{
+					PrefixListId: awssdk.String(call.prefixListID),
+				}).Return(entries, nil)
+			}
+			for _, req := range tt.modifyCalls {
+				ec2Client.EXPECT().ModifyManagedPrefixListWithContext(gomock.Any(), req).Return(&ec2sdk.ModifyManagedPrefixListOutput{}, nil)
+			}
+
+			k8sSchema := runtime.NewScheme()
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(blocklist.DeepCopy()).Build()
+			provider := NewDefaultBlocklistPrefixListProvider(defaultClusterName, ec2Client, k8sClient,
+				NewDefaultTagsProvider(k8sClient, map[string]string{"team": "awesome"}, log.Log), log.Log)
+			provider.prefixListSyncer.pollInterval = time.Millisecond
+
+			got, err := provider.Sync(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCachedPrefixIDs, provider.prefixListIDs)
+		})
+	}
+}
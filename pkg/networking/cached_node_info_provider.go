@@ -0,0 +1,141 @@
+package networking
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewCachedNodeInfoProvider constructs new cachedNodeInfoProvider.
+// the cached instances are described again once they're older than verifyInterval.
+func NewCachedNodeInfoProvider(nodeInfoProvider NodeInfoProvider, verifyInterval time.Duration, logger logr.Logger) *cachedNodeInfoProvider {
+	return &cachedNodeInfoProvider{
+		nodeInfoProvider:     nodeInfoProvider,
+		verifyInterval:       verifyInterval,
+		logger:               logger,
+		instanceByProviderID: make(map[string]cachedNodeInstance),
+		now:                  time.Now,
+	}
+}
+
+var _ NodeInfoProvider = &cachedNodeInfoProvider{}
+var _ toolscache.ResourceEventHandler = &cachedNodeInfoProvider{}
+
+// cachedNodeInfoProvider is a NodeInfoProvider that caches the EC2 instances of nodes by their providerID,
+// so that DescribeInstances is only called for new nodes and to periodically verify the cached instances.
+// the cached instances are evicted by node informer events, once the nodes are deleted or their providerID changes.
+type cachedNodeInfoProvider struct {
+	nodeInfoProvider NodeInfoProvider
+	verifyInterval   time.Duration
+	logger           logr.Logger
+
+	// instanceMutex protects instanceByProviderID.
+	instanceMutex        sync.RWMutex
+	instanceByProviderID map[string]cachedNodeInstance
+	now                  func() time.Time
+}
+
+// cachedNodeInstance is the EC2 instance of a node, along with the time it's described.
+type cachedNodeInstance struct {
+	instance    *ec2types.Instance
+	describedAt time.Time
+}
+
+// SetupWithManager registers the cachedNodeInfoProvider to the node informer of mgr.
+func (p *cachedNodeInfoProvider) SetupWithManager(ctx context.Context, mgr manager.Manager) error {
+	nodeInformer, err := mgr.GetCache().GetInformer(ctx, &corev1.Node{})
+	if err != nil {
+		return err
+	}
+	_, err = nodeInformer.AddEventHandler(p)
+	return err
+}
+
+func (p *cachedNodeInfoProvider) FetchNodeInstances(ctx context.Context, nodes []*corev1.Node) (map[types.NamespacedName]*ec2types.Instance, error) {
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	nodeInstanceByNodeKey := make(map[types.NamespacedName]*ec2types.Instance, len(nodes))
+	var nodesToDescribe []*corev1.Node
+	p.instanceMutex.RLock()
+	for _, node := range nodes {
+		cachedInstance, exists := p.instanceByProviderID[node.Spec.ProviderID]
+		if !exists || p.now().Sub(cachedInstance.describedAt) >= p.verifyInterval {
+			nodesToDescribe = append(nodesToDescribe, node)
+			continue
+		}
+		nodeInstanceByNodeKey[k8s.NamespacedName(node)] = cachedInstance.instance
+	}
+	p.instanceMutex.RUnlock()
+	if len(nodesToDescribe) == 0 {
+		return nodeInstanceByNodeKey, nil
+	}
+
+	describedAt := p.now()
+	describedNodeInstanceByNodeKey, err := p.nodeInfoProvider.FetchNodeInstances(ctx, nodesToDescribe)
+	if err != nil {
+		return nil, err
+	}
+	p.instanceMutex.Lock()
+	defer p.instanceMutex.Unlock()
+	for _, node := range nodesToDescribe {
+		nodeKey := k8s.NamespacedName(node)
+		instance, exists := describedNodeInstanceByNodeKey[nodeKey]
+		if !exists {
+			// the instance no longer exists, e.g. it's terminated while the node isn't deleted yet.
+			delete(p.instanceByProviderID, node.Spec.ProviderID)
+			continue
+		}
+		p.instanceByProviderID[node.Spec.ProviderID] = cachedNodeInstance{
+			instance:    instance,
+			describedAt: describedAt,
+		}
+		nodeInstanceByNodeKey[nodeKey] = instance
+	}
+	return nodeInstanceByNodeKey, nil
+}
+
+// OnAdd is a no-op, the instances of new nodes are described on demand.
+func (p *cachedNodeInfoProvider) OnAdd(_ interface{}) {
+}
+
+// OnUpdate evicts the cached instance of node if its providerID changed.
+func (p *cachedNodeInfoProvider) OnUpdate(oldObj, newObj interface{}) {
+	oldNode, ok := oldObj.(*corev1.Node)
+	if !ok {
+		return
+	}
+	newNode, ok := newObj.(*corev1.Node)
+	if !ok {
+		return
+	}
+	if oldNode.Spec.ProviderID != newNode.Spec.ProviderID {
+		p.evict(oldNode.Spec.ProviderID)
+	}
+}
+
+// OnDelete evicts the cached instance of node.
+func (p *cachedNodeInfoProvider) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return
+	}
+	p.evict(node.Spec.ProviderID)
+}
+
+func (p *cachedNodeInfoProvider) evict(providerID string) {
+	p.instanceMutex.Lock()
+	defer p.instanceMutex.Unlock()
+	delete(p.instanceByProviderID, providerID)
+}
@@ -0,0 +1,115 @@
+package networking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_cachedNodeInfoProvider_FetchNodeInstances(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	nodeInfoProvider := NewMockNodeInfoProvider(ctrl)
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	p := NewCachedNodeInfoProvider(nodeInfoProvider, 10*time.Minute, log.Log)
+	p.now = func() time.Time { return now }
+
+	node1 := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-0fa2d0064e848c69e"},
+	}
+	node2 := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+		Spec:       corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-0fa2d0064e848c69f"},
+	}
+	instance1 := &ec2types.Instance{InstanceId: awssdk.String("i-0fa2d0064e848c69e")}
+	instance2 := &ec2types.Instance{InstanceId: awssdk.String("i-0fa2d0064e848c69f")}
+
+	// the instances of new nodes are described.
+	nodeInfoProvider.EXPECT().FetchNodeInstances(gomock.Any(), []*corev1.Node{node1, node2}).Return(map[types.NamespacedName]*ec2types.Instance{
+		{Name: "node-1"}: instance1,
+		{Name: "node-2"}: instance2,
+	}, nil)
+	got, err := p.FetchNodeInstances(context.Background(), []*corev1.Node{node1, node2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[types.NamespacedName]*ec2types.Instance{
+		{Name: "node-1"}: instance1,
+		{Name: "node-2"}: instance2,
+	}, got)
+
+	// the cached instances are served without describing them.
+	now = now.Add(5 * time.Minute)
+	got, err = p.FetchNodeInstances(context.Background(), []*corev1.Node{node1, node2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[types.NamespacedName]*ec2types.Instance{
+		{Name: "node-1"}: instance1,
+		{Name: "node-2"}: instance2,
+	}, got)
+
+	// the instance of deleted node is evicted and described again.
+	p.OnDelete(toolscache.DeletedFinalStateUnknown{Key: "node-2", Obj: node2})
+	nodeInfoProvider.EXPECT().FetchNodeInstances(gomock.Any(), []*corev1.Node{node2}).Return(map[types.NamespacedName]*ec2types.Instance{
+		{Name: "node-2"}: instance2,
+	}, nil)
+	got, err = p.FetchNodeInstances(context.Background(), []*corev1.Node{node1, node2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[types.NamespacedName]*ec2types.Instance{
+		{Name: "node-1"}: instance1,
+		{Name: "node-2"}: instance2,
+	}, got)
+
+	// the instances older than verifyInterval are verified, the ones no longer existing are evicted.
+	now = now.Add(6 * time.Minute)
+	nodeInfoProvider.EXPECT().FetchNodeInstances(gomock.Any(), []*corev1.Node{node1}).Return(map[types.NamespacedName]*ec2types.Instance{}, nil)
+	got, err = p.FetchNodeInstances(context.Background(), []*corev1.Node{node1, node2})
+	assert.NoError(t, err)
+	assert.Equal(t, map[types.NamespacedName]*ec2types.Instance{
+		{Name: "node-2"}: instance2,
+	}, got)
+	assert.NotContains(t, p.instanceByProviderID, node1.Spec.ProviderID)
+}
+
+func Test_cachedNodeInfoProvider_OnUpdate(t *testing.T) {
+	tests := []struct {
+		name          string
+		oldProviderID string
+		newProviderID string
+		wantEvicted   bool
+	}{
+		{
+			name:          "providerID unchanged",
+			oldProviderID: "aws:///us-west-2a/i-0fa2d0064e848c69e",
+			newProviderID: "aws:///us-west-2a/i-0fa2d0064e848c69e",
+			wantEvicted:   false,
+		},
+		{
+			name:          "providerID changed",
+			oldProviderID: "aws:///us-west-2a/i-0fa2d0064e848c69e",
+			newProviderID: "aws:///us-west-2a/i-0fa2d0064e848c69f",
+			wantEvicted:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewCachedNodeInfoProvider(nil, 10*time.Minute, log.Log)
+			p.instanceByProviderID[tt.oldProviderID] = cachedNodeInstance{
+				instance: &ec2types.Instance{InstanceId: awssdk.String("i-0fa2d0064e848c69e")},
+			}
+			oldNode := &corev1.Node{Spec: corev1.NodeSpec{ProviderID: tt.oldProviderID}}
+			newNode := &corev1.Node{Spec: corev1.NodeSpec{ProviderID: tt.newProviderID}}
+			p.OnUpdate(oldNode, newNode)
+			_, exists := p.instanceByProviderID[tt.oldProviderID]
+			assert.Equal(t, !tt.wantEvicted, exists)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package networking
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// DefaultTagsProvider is responsible for providing the default tags applied to all AWS resources managed by the controller.
+type DefaultTagsProvider interface {
+	// DefaultTags returns the default tags, the returned map must not be modified.
+	DefaultTags() map[string]string
+	// Update replaces the default tags, resources are notified via ChangeEvents if the default tags changed.
+	Update(ctx context.Context, defaultTags map[string]string) error
+	// RequestResync requests resources to be notified via ChangeEvents on the next Update even if the default tags didn't change,
+	// e.g. because other controller configuration affecting them changed.
+	RequestResync()
+	// ChangeEvents returns the channel of resources that need to be reconciled after the default tags changed.
+	ChangeEvents(resourceType ResourceType) <-chan event.GenericEvent
+}
+
+// NewDefaultTagsProvider constructs new defaultTagsProvider with the initial default tags.
+func NewDefaultTagsProvider(k8sClient client.Client, defaultTags map[string]string, logger logr.Logger) *defaultTagsProvider {
+	return &defaultTagsProvider{
+		k8sClient:        k8sClient,
+		logger:           logger,
+		defaultTags:      defaultTags,
+		changeEventChans: make(map[ResourceType]chan event.GenericEvent),
+	}
+}
+
+var _ DefaultTagsProvider = &defaultTagsProvider{}
+
+// default implementation for DefaultTagsProvider.
+type defaultTagsProvider struct {
+	k8sClient client.Client
+	logger    logr.Logger
+
+	mutex       sync.RWMutex
+	defaultTags map[string]string
+	// notificationPending is true if the default tags changed but the resources have not been notified yet.
+	notificationPending bool
+
+	changeEventChans      map[ResourceType]chan event.GenericEvent
+	changeEventChansMutex sync.Mutex
+}
+
+func (p *defaultTagsProvider) DefaultTags() map[string]string {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.defaultTags
+}
+
+func (p *defaultTagsProvider) Update(ctx context.Context, defaultTags map[string]string) error {
+	p.mutex.Lock()
+	if !equality.Semantic.DeepEqual(p.defaultTags, defaultTags) {
+		p.logger.Info("default tags changed", "defaultTags", defaultTags)
+		p.defaultTags = defaultTags
+		p.notificationPending = true
+	}
+	notificationPending := p.notificationPending
+	p.mutex.Unlock()
+	if !notificationPending {
+		return nil
+	}
+
+	if err := p.notifyDefaultTagsChanged(ctx); err != nil {
+		return err
+	}
+	p.mutex.Lock()
+	p.notificationPending = false
+	p.mutex.Unlock()
+	return nil
+}
+
+func (p *defaultTagsProvider) RequestResync() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.notificationPending = true
+}
+
+func (p *defaultTagsProvider) ChangeEvents(resourceType ResourceType) <-chan event.GenericEvent {
+	p.changeEventChansMutex.Lock()
+	defer p.changeEventChansMutex.Unlock()
+	eventChan, exists := p.changeEventChans[resourceType]
+	if !exists {
+		eventChan = make(chan event.GenericEvent)
+		p.changeEventChans[resourceType] = eventChan
+	}
+	return eventChan
+}
+
+// notifyDefaultTagsChanged notifies all resources of the resource types watched via ChangeEvents,
+// so that their reconciliation propagates the default tags to the AWS resources.
+func (p *defaultTagsProvider) notifyDefaultTagsChanged(ctx context.Context) error {
+	p.changeEventChansMutex.Lock()
+	eventChans := make(map[ResourceType]chan event.GenericEvent, len(p.changeEventChans))
+	for resourceType, eventChan := range p.changeEventChans {
+		eventChans[resourceType] = eventChan
+	}
+	p.changeEventChansMutex.Unlock()
+
+	for resourceType, eventChan := range eventChans {
+		objs, err := p.listResources(ctx, resourceType)
+		if err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			select {
+			case eventChan <- event.GenericEvent{Object: obj}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		p.logger.V(1).Info("notified resources of default tags change",
+			"resourceType", resourceType, "count", len(objs))
+	}
+	return nil
+}
+
+func (p *defaultTagsProvider) listResources(ctx context.Context, resourceType ResourceType) ([]client.Object, error) {
+	var objs []client.Object
+	switch resourceType {
+	case ResourceTypeIngress:
+		ingList := &networking.IngressList{}
+		if err := p.k8sClient.List(ctx, ingList); err != nil {
+			return nil, err
+		}
+		for i := range ingList.Items {
+			objs = append(objs, &ingList.Items[i])
+		}
+	case ResourceTypeService:
+		svcList := &corev1.ServiceList{}
+		if err := p.k8sClient.List(ctx, svcList); err != nil {
+			return nil, err
+		}
+		for i := range svcList.Items {
+			objs = append(objs, &svcList.Items[i])
+		}
+	case ResourceTypeGateway:
+		gwList := &gwv1beta1.GatewayList{}
+		if err := p.k8sClient.List(ctx, gwList); err != nil {
+			return nil, err
+		}
+		for i := range gwList.Items {
+			objs = append(objs, &gwList.Items[i])
+		}
+	}
+	return objs, nil
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/networking (interfaces: DefaultTagsProvider)
+
+// Package networking is a generated GoMock package.
+package networking
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	event "sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// MockDefaultTagsProvider is a mock of DefaultTagsProvider interface.
+type MockDefaultTagsProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockDefaultTagsProviderMockRecorder
+}
+
+// MockDefaultTagsProviderMockRecorder is the mock recorder for MockDefaultTagsProvider.
+type MockDefaultTagsProviderMockRecorder struct {
+	mock *MockDefaultTagsProvider
+}
+
+// NewMockDefaultTagsProvider creates a new mock instance.
+func NewMockDefaultTagsProvider(ctrl *gomock.Controller) *MockDefaultTagsProvider {
+	mock := &MockDefaultTagsProvider{ctrl: ctrl}
+	mock.recorder = &MockDefaultTagsProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDefaultTagsProvider) EXPECT() *MockDefaultTagsProviderMockRecorder {
+	return m.recorder
+}
+
+// ChangeEvents mocks base method.
+func (m *MockDefaultTagsProvider) ChangeEvents(arg0 ResourceType) <-chan event.GenericEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeEvents", arg0)
+	ret0, _ := ret[0].(<-chan event.GenericEvent)
+	return ret0
+}
+
+// ChangeEvents indicates an expected call of ChangeEvents.
+func (mr *MockDefaultTagsProviderMockRecorder) ChangeEvents(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeEvents", reflect.TypeOf((*MockDefaultTagsProvider)(nil).ChangeEvents), arg0)
+}
+
+// DefaultTags mocks base method.
+func (m *MockDefaultTagsProvider) DefaultTags() map[string]string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DefaultTags")
+	ret0, _ := ret[0].(map[string]string)
+	return ret0
+}
+
+// DefaultTags indicates an expected call of DefaultTags.
+func (mr *MockDefaultTagsProviderMockRecorder) DefaultTags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DefaultTags", reflect.TypeOf((*MockDefaultTagsProvider)(nil).DefaultTags))
+}
+
+// RequestResync mocks base method.
+func (m *MockDefaultTagsProvider) RequestResync() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RequestResync")
+}
+
+// RequestResync indicates an expected call of RequestResync.
+func (mr *MockDefaultTagsProviderMockRecorder) RequestResync() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestResync", reflect.TypeOf((*MockDefaultTagsProvider)(nil).RequestResync))
+}
+
+// Update mocks base method.
+func (m *MockDefaultTagsProvider) Update(arg0 context.Context, arg1 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDefaultTagsProviderMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDefaultTagsProvider)(nil).Update), arg0, arg1)
+}
@@ -0,0 +1,83 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultTagsProvider_Update(t *testing.T) {
+	ing := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "ing-1"},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+	}
+	tests := []struct {
+		name               string
+		initialDefaultTags map[string]string
+		defaultTags        map[string]string
+		wantDefaultTags    map[string]string
+		wantIngresses      []types.NamespacedName
+		wantServices       []types.NamespacedName
+	}{
+		{
+			name:               "default tags changed",
+			initialDefaultTags: map[string]string{"team": "awesome"},
+			defaultTags:        map[string]string{"team": "awesome", "cost-center": "42"},
+			wantDefaultTags:    map[string]string{"team": "awesome", "cost-center": "42"},
+			wantIngresses:      []types.NamespacedName{{Namespace: "awesome-ns", Name: "ing-1"}},
+			wantServices:       []types.NamespacedName{{Namespace: "awesome-ns", Name: "svc-1"}},
+		},
+		{
+			name:               "default tags unchanged",
+			initialDefaultTags: map[string]string{"team": "awesome"},
+			defaultTags:        map[string]string{"team": "awesome"},
+			wantDefaultTags:    map[string]string{"team": "awesome"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClient := testclient.NewClientBuilder().WithObjects(ing.DeepCopy(), svc.DeepCopy()).Build()
+			provider := NewDefaultTagsProvider(k8sClient, tt.initialDefaultTags, log.Log)
+			ingEventChan := provider.ChangeEvents(ResourceTypeIngress)
+			svcEventChan := provider.ChangeEvents(ResourceTypeService)
+
+			var gotIngresses, gotServices []types.NamespacedName
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				gotIngresses, gotServices = receiveChangeEvents(ingEventChan, svcEventChan,
+					len(tt.wantIngresses)+len(tt.wantServices))
+			}()
+			err := provider.Update(context.Background(), tt.defaultTags)
+			<-done
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDefaultTags, provider.DefaultTags())
+			assert.Equal(t, tt.wantIngresses, gotIngresses)
+			assert.Equal(t, tt.wantServices, gotServices)
+		})
+	}
+}
+
+func receiveChangeEvents(ingEventChan <-chan event.GenericEvent, svcEventChan <-chan event.GenericEvent, count int) ([]types.NamespacedName, []types.NamespacedName) {
+	var ingresses, services []types.NamespacedName
+	for i := 0; i < count; i++ {
+		select {
+		case e := <-ingEventChan:
+			ingresses = append(ingresses, k8s.NamespacedName(e.Object))
+		case e := <-svcEventChan:
+			services = append(services, k8s.NamespacedName(e.Object))
+		}
+	}
+	return ingresses, services
+}
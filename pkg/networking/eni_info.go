@@ -1,8 +1,8 @@
 package networking
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 )
 
 // ENIInfo wraps necessary information about a ENI.
@@ -14,24 +14,24 @@ type ENIInfo struct {
 	SecurityGroups []string
 }
 
-func buildENIInfoViaENI(eni *ec2.NetworkInterface) ENIInfo {
+func buildENIInfoViaENI(eni *ec2types.NetworkInterface) ENIInfo {
 	sgIDs := make([]string, 0, len(eni.Groups))
 	for _, group := range eni.Groups {
-		sgIDs = append(sgIDs, awssdk.StringValue(group.GroupId))
+		sgIDs = append(sgIDs, awssdk.ToString(group.GroupId))
 	}
 	return ENIInfo{
-		NetworkInterfaceID: awssdk.StringValue(eni.NetworkInterfaceId),
+		NetworkInterfaceID: awssdk.ToString(eni.NetworkInterfaceId),
 		SecurityGroups:     sgIDs,
 	}
 }
 
-func buildENIInfoViaInstanceENI(eni *ec2.InstanceNetworkInterface) ENIInfo {
+func buildENIInfoViaInstanceENI(eni *ec2types.InstanceNetworkInterface) ENIInfo {
 	sgIDs := make([]string, 0, len(eni.Groups))
 	for _, group := range eni.Groups {
-		sgIDs = append(sgIDs, awssdk.StringValue(group.GroupId))
+		sgIDs = append(sgIDs, awssdk.ToString(group.GroupId))
 	}
 	return ENIInfo{
-		NetworkInterfaceID: awssdk.StringValue(eni.NetworkInterfaceId),
+		NetworkInterfaceID: awssdk.ToString(eni.NetworkInterfaceId),
 		SecurityGroups:     sgIDs,
 	}
 }
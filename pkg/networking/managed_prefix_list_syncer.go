@@ -0,0 +1,254 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+const (
+	defaultPrefixListPollInterval    = 2 * time.Second
+	defaultPrefixListPollTimeout     = 2 * time.Minute
+	defaultPrefixListModifyBatchSize = 100
+)
+
+// PrefixListAddressFamily is the address family of a managed prefix list.
+type PrefixListAddressFamily string
+
+const (
+	PrefixListAddressFamilyIPv4 PrefixListAddressFamily = "IPv4"
+	PrefixListAddressFamilyIPv6 PrefixListAddressFamily = "IPv6"
+)
+
+// newManagedPrefixListSyncer constructs new managedPrefixListSyncer.
+func newManagedPrefixListSyncer(clusterName string, ec2Client services.EC2, defaultTagsProvider DefaultTagsProvider, logger logr.Logger) *managedPrefixListSyncer {
+	return &managedPrefixListSyncer{
+		clusterName:         clusterName,
+		ec2Client:           ec2Client,
+		defaultTagsProvider: defaultTagsProvider,
+		logger:              logger,
+		pollInterval:        defaultPrefixListPollInterval,
+		pollTimeout:         defaultPrefixListPollTimeout,
+		modifyBatchSize:     defaultPrefixListModifyBatchSize,
+	}
+}
+
+// managedPrefixListSyncer creates and modifies the managed prefix lists owned by the controller.
+// the prefix lists are identified by the cluster tag and their resource tag, e.g. "blocklist-ipv4".
+type managedPrefixListSyncer struct {
+	clusterName         string
+	ec2Client           services.EC2
+	defaultTagsProvider DefaultTagsProvider
+	logger              logr.Logger
+
+	pollInterval    time.Duration
+	pollTimeout     time.Duration
+	modifyBatchSize int
+}
+
+// sync creates or modifies the prefix list with resource tag resourceTagValue to contain exactly desiredCIDRs, and returns its ID.
+func (s *managedPrefixListSyncer) sync(ctx context.Context, resourceTagValue string, addressFamily PrefixListAddressFamily, desiredCIDRs sets.String) (string, error) {
+	// max entries must be positive, even if there is no CIDR.
+	desiredMaxEntries := int32(desiredCIDRs.Len())
+	if desiredMaxEntries == 0 {
+		desiredMaxEntries = 1
+	}
+
+	prefixList, err := s.find(ctx, resourceTagValue)
+	if err != nil {
+		return "", err
+	}
+	if prefixList == nil {
+		prefixList, err = s.create(ctx, resourceTagValue, addressFamily, desiredCIDRs, desiredMaxEntries)
+		if err != nil {
+			return "", err
+		}
+	}
+	prefixListID := awssdk.ToString(prefixList.PrefixListId)
+	if prefixList, err = s.waitStable(ctx, prefixListID); err != nil {
+		return "", err
+	}
+	currentCIDRs, err := s.getCIDRs(ctx, prefixListID)
+	if err != nil {
+		return "", err
+	}
+	cidrsToAdd := desiredCIDRs.Difference(currentCIDRs).List()
+	cidrsToRemove := currentCIDRs.Difference(desiredCIDRs).List()
+	if len(cidrsToAdd) == 0 && len(cidrsToRemove) == 0 {
+		return prefixListID, s.resize(ctx, prefixList, desiredMaxEntries)
+	}
+
+	// the entries and max entries cannot be modified together, the prefix list is grown before adding entries,
+	// and shrunk after removing entries, since they count against the rule quota of referencing security groups.
+	if desiredMaxEntries > awssdk.ToInt32(prefixList.MaxEntries) {
+		if err := s.resize(ctx, prefixList, desiredMaxEntries); err != nil {
+			return "", err
+		}
+		if prefixList, err = s.waitStable(ctx, prefixListID); err != nil {
+			return "", err
+		}
+	}
+	for len(cidrsToAdd) > 0 || len(cidrsToRemove) > 0 {
+		batchCIDRsToAdd := cidrsToAdd[:s.batchLen(cidrsToAdd)]
+		batchCIDRsToRemove := cidrsToRemove[:s.batchLen(cidrsToRemove)]
+		cidrsToAdd = cidrsToAdd[len(batchCIDRsToAdd):]
+		cidrsToRemove = cidrsToRemove[len(batchCIDRsToRemove):]
+		req := &ec2sdk.ModifyManagedPrefixListInput{
+			PrefixListId:   awssdk.String(prefixListID),
+			CurrentVersion: prefixList.Version,
+		}
+		for _, cidr := range batchCIDRsToAdd {
+			req.AddEntries = append(req.AddEntries, ec2types.AddPrefixListEntry{Cidr: awssdk.String(cidr)})
+		}
+		for _, cidr := range batchCIDRsToRemove {
+			req.RemoveEntries = append(req.RemoveEntries, ec2types.RemovePrefixListEntry{Cidr: awssdk.String(cidr)})
+		}
+		s.logger.Info("modifying prefix list", "prefixListID", prefixListID,
+			"addEntries", batchCIDRsToAdd, "removeEntries", batchCIDRsToRemove)
+		if _, err := s.ec2Client.ModifyManagedPrefixListWithContext(ctx, req); err != nil {
+			return "", err
+		}
+		if prefixList, err = s.waitStable(ctx, prefixListID); err != nil {
+			return "", err
+		}
+		s.logger.Info("modified prefix list", "prefixListID", prefixListID)
+	}
+	return prefixListID, s.resize(ctx, prefixList, desiredMaxEntries)
+}
+
+func (s *managedPrefixListSyncer) create(ctx context.Context, resourceTagValue string, addressFamily PrefixListAddressFamily, desiredCIDRs sets.String, maxEntries int32) (*ec2types.ManagedPrefixList, error) {
+	// the entries beyond the first batch are added by modifications after creation.
+	initialCIDRs := desiredCIDRs.List()
+	initialCIDRs = initialCIDRs[:s.batchLen(initialCIDRs)]
+	defaultTags := s.defaultTagsProvider.DefaultTags()
+	tags := make(map[string]string, len(defaultTags)+2)
+	for key, value := range defaultTags {
+		tags[key] = value
+	}
+	tags[tagKeyK8sCluster] = s.clusterName
+	tags[tagKeyResource] = resourceTagValue
+	req := &ec2sdk.CreateManagedPrefixListInput{
+		PrefixListName: awssdk.String(s.buildName(resourceTagValue)),
+		AddressFamily:  awssdk.String(string(addressFamily)),
+		MaxEntries:     awssdk.Int32(maxEntries),
+		TagSpecifications: []ec2types.TagSpecification{
+			{
+				ResourceType: ec2types.ResourceTypePrefixList,
+				Tags:         convertTagsToSDKTags(tags),
+			},
+		},
+	}
+	for _, cidr := range initialCIDRs {
+		req.Entries = append(req.Entries, ec2types.AddPrefixListEntry{Cidr: awssdk.String(cidr)})
+	}
+	s.logger.Info("creating prefix list", "addressFamily", addressFamily, "name", awssdk.ToString(req.PrefixListName))
+	resp, err := s.ec2Client.CreateManagedPrefixListWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("created prefix list", "addressFamily", addressFamily, "prefixListID", awssdk.ToString(resp.PrefixList.PrefixListId))
+	return resp.PrefixList, nil
+}
+
+// resize sets the max entries of prefixList to maxEntries if it differs.
+func (s *managedPrefixListSyncer) resize(ctx context.Context, prefixList *ec2types.ManagedPrefixList, maxEntries int32) error {
+	if awssdk.ToInt32(prefixList.MaxEntries) == maxEntries {
+		return nil
+	}
+	req := &ec2sdk.ModifyManagedPrefixListInput{
+		PrefixListId: prefixList.PrefixListId,
+		MaxEntries:   awssdk.Int32(maxEntries),
+	}
+	s.logger.Info("resizing prefix list", "prefixListID", awssdk.ToString(prefixList.PrefixListId), "maxEntries", maxEntries)
+	if _, err := s.ec2Client.ModifyManagedPrefixListWithContext(ctx, req); err != nil {
+		return err
+	}
+	return nil
+}
+
+// find finds the prefix list by its resource tag, returns nil if it doesn't exist.
+func (s *managedPrefixListSyncer) find(ctx context.Context, resourceTagValue string) (*ec2types.ManagedPrefixList, error) {
+	req := &ec2sdk.DescribeManagedPrefixListsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String(fmt.Sprintf("tag:%v", tagKeyK8sCluster)),
+				Values: []string{s.clusterName},
+			},
+			{
+				Name:   awssdk.String(fmt.Sprintf("tag:%v", tagKeyResource)),
+				Values: []string{resourceTagValue},
+			},
+		},
+	}
+	resp, err := s.ec2Client.DescribeManagedPrefixListsWithContext(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.PrefixLists) == 0 {
+		return nil, nil
+	}
+	return &resp.PrefixLists[0], nil
+}
+
+// waitStable waits until the pending creation or modification of the prefix list completes, and returns the prefix list.
+func (s *managedPrefixListSyncer) waitStable(ctx context.Context, prefixListID string) (*ec2types.ManagedPrefixList, error) {
+	req := &ec2sdk.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{prefixListID},
+	}
+	var prefixList *ec2types.ManagedPrefixList
+	if err := wait.PollImmediateWithContext(ctx, s.pollInterval, s.pollTimeout, func(ctx context.Context) (bool, error) {
+		resp, err := s.ec2Client.DescribeManagedPrefixListsWithContext(ctx, req)
+		if err != nil {
+			return false, err
+		}
+		if len(resp.PrefixLists) == 0 {
+			return false, errors.Errorf("prefix list %v not found", prefixListID)
+		}
+		prefixList = &resp.PrefixLists[0]
+		state := string(prefixList.State)
+		if strings.HasSuffix(state, "-failed") {
+			return false, errors.Errorf("prefix list %v is in state %v: %v", prefixListID, state, awssdk.ToString(prefixList.StateMessage))
+		}
+		return !strings.HasSuffix(state, "-in-progress"), nil
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to wait for prefix list %v", prefixListID)
+	}
+	return prefixList, nil
+}
+
+func (s *managedPrefixListSyncer) getCIDRs(ctx context.Context, prefixListID string) (sets.String, error) {
+	req := &ec2sdk.GetManagedPrefixListEntriesInput{
+		PrefixListId: awssdk.String(prefixListID),
+	}
+	entries, err := s.ec2Client.GetManagedPrefixListEntriesAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	cidrs := sets.NewString()
+	for _, entry := range entries {
+		cidrs.Insert(awssdk.ToString(entry.Cidr))
+	}
+	return cidrs, nil
+}
+
+func (s *managedPrefixListSyncer) batchLen(cidrs []string) int {
+	if len(cidrs) > s.modifyBatchSize {
+		return s.modifyBatchSize
+	}
+	return len(cidrs)
+}
+
+func (s *managedPrefixListSyncer) buildName(resourceTagValue string) string {
+	sanitizedClusterName := invalidSGNamePattern.ReplaceAllString(s.clusterName, "")
+	return fmt.Sprintf("k8s-%.200s-%v", sanitizedClusterName, resourceTagValue)
+}
@@ -2,29 +2,36 @@ package networking
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 )
 
+const (
+	// the max number of instanceIDs per DescribeInstances call, as instanceIDs can't be paginated with MaxResults.
+	defaultDescribeInstancesChunkSize = 200
+)
+
 // NodeInfoProvider is responsible for providing nodeInfo for nodes.
-// TODO: provide a cached implementation for nodeInfoProvider, it can accepts cachePolicy per function.
-// e.g. when resolve pod's ENI, the cachePolicy can be node contains pod's IP and node's cache is fresher than pod's creationTime.
 type NodeInfoProvider interface {
 	// FetchNodeInstances provides EC2 instance information per k8s node.
-	FetchNodeInstances(ctx context.Context, nodes []*corev1.Node) (map[types.NamespacedName]*ec2sdk.Instance, error)
+	FetchNodeInstances(ctx context.Context, nodes []*corev1.Node) (map[types.NamespacedName]*ec2types.Instance, error)
 }
 
 // NewDefaultNodeInfoProvider constructs new defaultNodeInfoProvider.
 func NewDefaultNodeInfoProvider(ec2Client services.EC2, logger logr.Logger) *defaultNodeInfoProvider {
 	return &defaultNodeInfoProvider{
-		ec2Client: ec2Client,
-		logger:    logger,
+		ec2Client:                  ec2Client,
+		describeInstancesChunkSize: defaultDescribeInstancesChunkSize,
+		logger:                     logger,
 	}
 }
 
@@ -34,12 +41,14 @@ var _ NodeInfoProvider = &defaultNodeInfoProvider{}
 type defaultNodeInfoProvider struct {
 	// ec2 client
 	ec2Client services.EC2
+	// the max number of instanceIDs per DescribeInstances call
+	describeInstancesChunkSize int
 
 	// logger
 	logger logr.Logger
 }
 
-func (p *defaultNodeInfoProvider) FetchNodeInstances(ctx context.Context, nodes []*corev1.Node) (map[types.NamespacedName]*ec2sdk.Instance, error) {
+func (p *defaultNodeInfoProvider) FetchNodeInstances(ctx context.Context, nodes []*corev1.Node) (map[types.NamespacedName]*ec2types.Instance, error) {
 	if len(nodes) == 0 {
 		return nil, nil
 	}
@@ -53,17 +62,22 @@ func (p *defaultNodeInfoProvider) FetchNodeInstances(ctx context.Context, nodes
 		nodeKeysByInstanceID[instanceID] = append(nodeKeysByInstanceID[instanceID], nodeKey)
 	}
 	instanceIDs := sets.StringKeySet(nodeKeysByInstanceID).List()
-	req := &ec2sdk.DescribeInstancesInput{
-		InstanceIds: awssdk.StringSlice(instanceIDs),
-	}
-	instances, err := p.ec2Client.DescribeInstancesAsList(ctx, req)
-	if err != nil {
-		return nil, err
+	var instances []ec2types.Instance
+	for _, instanceIDsChunk := range algorithm.ChunkStrings(instanceIDs, p.describeInstancesChunkSize) {
+		req := &ec2sdk.DescribeInstancesInput{
+			InstanceIds: instanceIDsChunk,
+		}
+		instancesChunk, err := p.ec2Client.DescribeInstancesAsList(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, instancesChunk...)
 	}
 
-	nodeInstanceByNodeKey := make(map[types.NamespacedName]*ec2sdk.Instance, len(nodes))
-	for _, instance := range instances {
-		instanceID := awssdk.StringValue(instance.InstanceId)
+	nodeInstanceByNodeKey := make(map[types.NamespacedName]*ec2types.Instance, len(nodes))
+	for i := range instances {
+		instance := &instances[i]
+		instanceID := awssdk.ToString(instance.InstanceId)
 		for _, nodeKey := range nodeKeysByInstanceID[instanceID] {
 			nodeInstanceByNodeKey[nodeKey] = instance
 		}
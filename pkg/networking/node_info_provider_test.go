@@ -4,8 +4,9 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -20,7 +21,7 @@ import (
 func Test_defaultNodeInfoProvider_FetchNodeInstances(t *testing.T) {
 	type describeInstancesAsListCall struct {
 		req  *ec2sdk.DescribeInstancesInput
-		resp []*ec2sdk.Instance
+		resp []ec2types.Instance
 		err  error
 	}
 	type fields struct {
@@ -33,24 +34,31 @@ func Test_defaultNodeInfoProvider_FetchNodeInstances(t *testing.T) {
 		name    string
 		fields  fields
 		args    args
-		want    map[types.NamespacedName]*ec2sdk.Instance
+		want    map[types.NamespacedName]*ec2types.Instance
 		wantErr error
 	}{
 		{
-			name: "successfully fetched instance for each node",
+			name: "successfully fetched instance for each node in chunks",
 			fields: fields{
 				describeInstancesAsListCalls: []describeInstancesAsListCall{
 					{
 						req: &ec2sdk.DescribeInstancesInput{
-							InstanceIds: awssdk.StringSlice([]string{"i-0fa2d0064e848c69e", "i-0fa2d0064e848c69f", "i-0fa2d0064e848c69g"}),
+							InstanceIds: []string{"i-0fa2d0064e848c69e", "i-0fa2d0064e848c69f"},
 						},
-						resp: []*ec2sdk.Instance{
+						resp: []ec2types.Instance{
 							{
 								InstanceId: awssdk.String("i-0fa2d0064e848c69e"),
 							},
 							{
 								InstanceId: awssdk.String("i-0fa2d0064e848c69f"),
 							},
+						},
+					},
+					{
+						req: &ec2sdk.DescribeInstancesInput{
+							InstanceIds: []string{"i-0fa2d0064e848c69g"},
+						},
+						resp: []ec2types.Instance{
 							{
 								InstanceId: awssdk.String("i-0fa2d0064e848c69g"),
 							},
@@ -86,7 +94,7 @@ func Test_defaultNodeInfoProvider_FetchNodeInstances(t *testing.T) {
 					},
 				},
 			},
-			want: map[types.NamespacedName]*ec2sdk.Instance{
+			want: map[types.NamespacedName]*ec2types.Instance{
 				types.NamespacedName{Name: "node-1"}: {
 					InstanceId: awssdk.String("i-0fa2d0064e848c69e"),
 				},
@@ -99,12 +107,12 @@ func Test_defaultNodeInfoProvider_FetchNodeInstances(t *testing.T) {
 			},
 		},
 		{
-			name: "successfully fetched instance for each node",
+			name: "failed to fetch instances",
 			fields: fields{
 				describeInstancesAsListCalls: []describeInstancesAsListCall{
 					{
 						req: &ec2sdk.DescribeInstancesInput{
-							InstanceIds: awssdk.StringSlice([]string{"i-0fa2d0064e848c69e", "i-0fa2d0064e848c69f", "i-0fa2d0064e848c69g"}),
+							InstanceIds: []string{"i-0fa2d0064e848c69e", "i-0fa2d0064e848c69f"},
 						},
 						err: errors.New("some AWS API error"),
 					},
@@ -182,8 +190,9 @@ func Test_defaultNodeInfoProvider_FetchNodeInstances(t *testing.T) {
 				ec2Client.EXPECT().DescribeInstancesAsList(gomock.Any(), call.req).Return(call.resp, call.err)
 			}
 			p := &defaultNodeInfoProvider{
-				ec2Client: ec2Client,
-				logger:    logr.New(&log.NullLogSink{}),
+				ec2Client:                  ec2Client,
+				describeInstancesChunkSize: 2,
+				logger:                     logr.New(&log.NullLogSink{}),
 			}
 			got, err := p.FetchNodeInstances(context.Background(), tt.args.nodes)
 			if tt.wantErr != nil {
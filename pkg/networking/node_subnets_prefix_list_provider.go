@@ -0,0 +1,142 @@
+package networking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+)
+
+const (
+	tagValueNodeSubnetsPrefixListFormat = "node-subnets-%v"
+)
+
+// NodeSubnetsPrefixListProvider is responsible for providing the managed prefix lists that contain the CIDRs of the subnets
+// of traffic proxy nodes, so that the security group rules for instance targets reference a single prefix list
+// instead of a rule per node subnet CIDR.
+type NodeSubnetsPrefixListProvider interface {
+	// Get ensures the node subnets prefix list of addressFamily contains the CIDRs of the subnets of traffic proxy nodes,
+	// and returns its ID.
+	Get(ctx context.Context, addressFamily PrefixListAddressFamily) (string, error)
+}
+
+// NewDefaultNodeSubnetsPrefixListProvider constructs new defaultNodeSubnetsPrefixListProvider.
+// in dryRun, the existing prefix lists are looked up without being created or modified.
+func NewDefaultNodeSubnetsPrefixListProvider(clusterName string, ec2Client services.EC2, nodeSubnetsResolver NodeSubnetsResolver,
+	defaultTagsProvider DefaultTagsProvider, dryRun bool, logger logr.Logger) *defaultNodeSubnetsPrefixListProvider {
+	return &defaultNodeSubnetsPrefixListProvider{
+		prefixListSyncer:    newManagedPrefixListSyncer(clusterName, ec2Client, defaultTagsProvider, logger),
+		nodeSubnetsResolver: nodeSubnetsResolver,
+		dryRun:              dryRun,
+		logger:              logger,
+		syncedPrefixLists:   make(map[PrefixListAddressFamily]syncedPrefixList),
+	}
+}
+
+var _ NodeSubnetsPrefixListProvider = &defaultNodeSubnetsPrefixListProvider{}
+
+// default implementation for NodeSubnetsPrefixListProvider.
+// there is one prefix list per address family, which is synced whenever the subnets of traffic proxy nodes change.
+type defaultNodeSubnetsPrefixListProvider struct {
+	prefixListSyncer    *managedPrefixListSyncer
+	nodeSubnetsResolver NodeSubnetsResolver
+	dryRun              bool
+	logger              logr.Logger
+
+	// syncedPrefixLists caches the prefix lists by address family along with the CIDRs they're synced to,
+	// it's protected by syncMutex, which also serializes the modifications of the prefix lists.
+	syncedPrefixLists map[PrefixListAddressFamily]syncedPrefixList
+	syncMutex         sync.Mutex
+}
+
+// syncedPrefixList is a prefix list along with the CIDRs it's synced to.
+type syncedPrefixList struct {
+	prefixListID string
+	cidrs        sets.String
+}
+
+func (p *defaultNodeSubnetsPrefixListProvider) Get(ctx context.Context, addressFamily PrefixListAddressFamily) (string, error) {
+	desiredCIDRs, err := p.resolveNodeSubnetsCIDRs(ctx, addressFamily)
+	if err != nil {
+		return "", err
+	}
+	if len(desiredCIDRs) == 0 {
+		return "", errors.New("unable to resolve subnets for target nodes")
+	}
+
+	p.syncMutex.Lock()
+	defer p.syncMutex.Unlock()
+	if synced, ok := p.syncedPrefixLists[addressFamily]; ok && synced.cidrs.Equal(desiredCIDRs) {
+		return synced.prefixListID, nil
+	}
+	resourceTagValue := buildNodeSubnetsPrefixListResourceTagValue(addressFamily)
+	if p.dryRun {
+		prefixList, err := p.prefixListSyncer.find(ctx, resourceTagValue)
+		if err != nil {
+			return "", err
+		}
+		if prefixList == nil {
+			return "", errors.Errorf("node subnets prefix list for %v not found", addressFamily)
+		}
+		return awssdk.ToString(prefixList.PrefixListId), nil
+	}
+	prefixListID, err := p.prefixListSyncer.sync(ctx, resourceTagValue, addressFamily, desiredCIDRs)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to sync node subnets prefix list for %v", addressFamily)
+	}
+	p.syncedPrefixLists[addressFamily] = syncedPrefixList{
+		prefixListID: prefixListID,
+		cidrs:        desiredCIDRs,
+	}
+	return prefixListID, nil
+}
+
+// resolveNodeSubnetsCIDRs returns the CIDRs of addressFamily of the subnets of traffic proxy nodes.
+func (p *defaultNodeSubnetsPrefixListProvider) resolveNodeSubnetsCIDRs(ctx context.Context, addressFamily PrefixListAddressFamily) (sets.String, error) {
+	nodeSelector, err := backend.GetTrafficProxyNodeSelector(&elbv2api.TargetGroupBinding{})
+	if err != nil {
+		return nil, err
+	}
+	nodeSubnets, err := p.nodeSubnetsResolver.ResolveNodeSubnets(ctx, nodeSelector)
+	if err != nil {
+		return nil, err
+	}
+	cidrs := sets.NewString()
+	for i := range nodeSubnets {
+		subnet := &nodeSubnets[i]
+		subnetCIDRs, err := getSubnetCIDRs(subnet, addressFamily)
+		if err != nil {
+			return nil, err
+		}
+		cidrs.Insert(subnetCIDRs...)
+	}
+	return cidrs, nil
+}
+
+func getSubnetCIDRs(subnet *ec2types.Subnet, addressFamily PrefixListAddressFamily) ([]string, error) {
+	if addressFamily == PrefixListAddressFamilyIPv4 {
+		return []string{awssdk.ToString(subnet.CidrBlock)}, nil
+	}
+	ipv6CIDRs, err := GetSubnetAssociatedIPv6CIDRs(subnet)
+	if err != nil {
+		return nil, err
+	}
+	cidrs := make([]string, 0, len(ipv6CIDRs))
+	for _, ipv6CIDR := range ipv6CIDRs {
+		cidrs = append(cidrs, ipv6CIDR.String())
+	}
+	return cidrs, nil
+}
+
+func buildNodeSubnetsPrefixListResourceTagValue(addressFamily PrefixListAddressFamily) string {
+	return fmt.Sprintf(tagValueNodeSubnetsPrefixListFormat, strings.ToLower(string(addressFamily)))
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/networking (interfaces: NodeSubnetsPrefixListProvider)
+
+// Package networking is a generated GoMock package.
+package networking
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNodeSubnetsPrefixListProvider is a mock of NodeSubnetsPrefixListProvider interface.
+type MockNodeSubnetsPrefixListProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockNodeSubnetsPrefixListProviderMockRecorder
+}
+
+// MockNodeSubnetsPrefixListProviderMockRecorder is the mock recorder for MockNodeSubnetsPrefixListProvider.
+type MockNodeSubnetsPrefixListProviderMockRecorder struct {
+	mock *MockNodeSubnetsPrefixListProvider
+}
+
+// NewMockNodeSubnetsPrefixListProvider creates a new mock instance.
+func NewMockNodeSubnetsPrefixListProvider(ctrl *gomock.Controller) *MockNodeSubnetsPrefixListProvider {
+	mock := &MockNodeSubnetsPrefixListProvider{ctrl: ctrl}
+	mock.recorder = &MockNodeSubnetsPrefixListProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNodeSubnetsPrefixListProvider) EXPECT() *MockNodeSubnetsPrefixListProviderMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockNodeSubnetsPrefixListProvider) Get(arg0 context.Context, arg1 PrefixListAddressFamily) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNodeSubnetsPrefixListProviderMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNodeSubnetsPrefixListProvider)(nil).Get), arg0, arg1)
+}
@@ -0,0 +1,173 @@
+package networking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultNodeSubnetsPrefixListProvider_Get(t *testing.T) {
+	describeByTagsReq := &ec2sdk.DescribeManagedPrefixListsInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("tag:elbv2.k8s.aws/cluster"),
+				Values: []string{defaultClusterName},
+			},
+			{
+				Name:   awssdk.String("tag:elbv2.k8s.aws/resource"),
+				Values: []string{"node-subnets-ipv4"},
+			},
+		},
+	}
+	describeByIDReq := &ec2sdk.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{"pl-nodes"},
+	}
+	prefixListResp := func(version int64, maxEntries int32) *ec2sdk.DescribeManagedPrefixListsOutput {
+		return &ec2sdk.DescribeManagedPrefixListsOutput{
+			PrefixLists: []ec2types.ManagedPrefixList{
+				{
+					PrefixListId: awssdk.String("pl-nodes"),
+					State:        ec2types.PrefixListStateModifyComplete,
+					Version:      awssdk.Int64(version),
+					MaxEntries:   awssdk.Int32(maxEntries),
+				},
+			},
+		}
+	}
+	nodeSubnets := []ec2types.Subnet{
+		{SubnetId: awssdk.String("subnet-a"), CidrBlock: awssdk.String("192.168.0.0/19")},
+		{SubnetId: awssdk.String("subnet-b"), CidrBlock: awssdk.String("192.168.32.0/19")},
+	}
+
+	type describeManagedPrefixListsCall struct {
+		req  *ec2sdk.DescribeManagedPrefixListsInput
+		resp *ec2sdk.DescribeManagedPrefixListsOutput
+	}
+	type resolveNodeSubnetsCall struct {
+		subnets []ec2types.Subnet
+		err     error
+	}
+	tests := []struct {
+		name                    string
+		dryRun                  bool
+		resolveNodeSubnetsCalls []resolveNodeSubnetsCall
+		describeCalls           []describeManagedPrefixListsCall
+		getEntriesCIDRs         []string
+		modifyCalls             []*ec2sdk.ModifyManagedPrefixListInput
+		getTimes                int
+		want                    string
+		wantErr                 error
+	}{
+		{
+			name: "prefix list is modified once when node subnets don't change",
+			resolveNodeSubnetsCalls: []resolveNodeSubnetsCall{
+				{subnets: nodeSubnets},
+				{subnets: nodeSubnets},
+			},
+			describeCalls: []describeManagedPrefixListsCall{
+				{req: describeByTagsReq, resp: prefixListResp(1, 1)},
+				{req: describeByIDReq, resp: prefixListResp(1, 1)},
+				{req: describeByIDReq, resp: prefixListResp(1, 2)},
+				{req: describeByIDReq, resp: prefixListResp(2, 2)},
+			},
+			getEntriesCIDRs: []string{"192.168.0.0/19"},
+			modifyCalls: []*ec2sdk.ModifyManagedPrefixListInput{
+				{
+					PrefixListId: awssdk.String("pl-nodes"),
+					MaxEntries:   awssdk.Int32(2),
+				},
+				{
+					PrefixListId:   awssdk.String("pl-nodes"),
+					CurrentVersion: awssdk.Int64(1),
+					AddEntries: []ec2types.AddPrefixListEntry{
+						{Cidr: awssdk.String("192.168.32.0/19")},
+					},
+				},
+			},
+			getTimes: 2,
+			want:     "pl-nodes",
+		},
+		{
+			name:   "prefix list is only looked up in dryRun",
+			dryRun: true,
+			resolveNodeSubnetsCalls: []resolveNodeSubnetsCall{
+				{subnets: nodeSubnets},
+			},
+			describeCalls: []describeManagedPrefixListsCall{
+				{req: describeByTagsReq, resp: prefixListResp(1, 1)},
+			},
+			getTimes: 1,
+			want:     "pl-nodes",
+		},
+		{
+			name:   "prefix list isn't found in dryRun",
+			dryRun: true,
+			resolveNodeSubnetsCalls: []resolveNodeSubnetsCall{
+				{subnets: nodeSubnets},
+			},
+			describeCalls: []describeManagedPrefixListsCall{
+				{req: describeByTagsReq, resp: &ec2sdk.DescribeManagedPrefixListsOutput{}},
+			},
+			getTimes: 1,
+			wantErr:  errors.New("node subnets prefix list for IPv4 not found"),
+		},
+		{
+			name: "no node subnets",
+			resolveNodeSubnetsCalls: []resolveNodeSubnetsCall{
+				{subnets: nil},
+			},
+			getTimes: 1,
+			wantErr:  errors.New("unable to resolve subnets for target nodes"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			nodeSubnetsResolver := NewMockNodeSubnetsResolver(ctrl)
+			for _, call := range tt.resolveNodeSubnetsCalls {
+				nodeSubnetsResolver.EXPECT().ResolveNodeSubnets(gomock.Any(), gomock.Any()).Return(call.subnets, call.err)
+			}
+			ec2Client := services.NewMockEC2(ctrl)
+			for _, call := range tt.describeCalls {
+				ec2Client.EXPECT().DescribeManagedPrefixListsWithContext(gomock.Any(), call.req).Return(call.resp, nil)
+			}
+			if tt.getEntriesCIDRs != nil {
+				entries := make([]ec2types.PrefixListEntry, 0, len(tt.getEntriesCIDRs))
+				for _, cidr := range tt.getEntriesCIDRs {
+					entries = append(entries, ec2types.PrefixListEntry{Cidr: awssdk.String(cidr)})
+				}
+				ec2Client.EXPECT().GetManagedPrefixListEntriesAsList(gomock.Any(), &ec2sdk.GetManagedPrefixListEntriesInput{
+					PrefixListId: awssdk.String("pl-nodes"),
+				}).Return(entries, nil)
+			}
+			for _, req := range tt.modifyCalls {
+				ec2Client.EXPECT().ModifyManagedPrefixListWithContext(gomock.Any(), req).Return(&ec2sdk.ModifyManagedPrefixListOutput{}, nil)
+			}
+			defaultTagsProvider := NewMockDefaultTagsProvider(ctrl)
+
+			provider := NewDefaultNodeSubnetsPrefixListProvider(defaultClusterName, ec2Client, nodeSubnetsResolver,
+				defaultTagsProvider, tt.dryRun, log.Log)
+			provider.prefixListSyncer.pollInterval = time.Millisecond
+			for i := 0; i < tt.getTimes; i++ {
+				got, err := provider.Get(context.Background(), PrefixListAddressFamilyIPv4)
+				if tt.wantErr != nil {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, tt.want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package networking
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodeSubnetsResolver is responsible for resolving the subnets of traffic proxy nodes.
+type NodeSubnetsResolver interface {
+	// ResolveNodeSubnets resolves the subnets of nodes that matches nodeSelector and is suitable as traffic proxy.
+	ResolveNodeSubnets(ctx context.Context, nodeSelector labels.Selector) ([]ec2types.Subnet, error)
+}
+
+// NewDefaultNodeSubnetsResolver constructs new defaultNodeSubnetsResolver.
+func NewDefaultNodeSubnetsResolver(k8sClient client.Client, nodeInfoProvider NodeInfoProvider, ec2Client services.EC2,
+	logger logr.Logger) *defaultNodeSubnetsResolver {
+	return &defaultNodeSubnetsResolver{
+		k8sClient:        k8sClient,
+		nodeInfoProvider: nodeInfoProvider,
+		ec2Client:        ec2Client,
+		logger:           logger,
+	}
+}
+
+var _ NodeSubnetsResolver = &defaultNodeSubnetsResolver{}
+
+// defaultNodeSubnetsResolver is default implementation for NodeSubnetsResolver
+type defaultNodeSubnetsResolver struct {
+	k8sClient        client.Client
+	nodeInfoProvider NodeInfoProvider
+	ec2Client        services.EC2
+	logger           logr.Logger
+}
+
+func (r *defaultNodeSubnetsResolver) ResolveNodeSubnets(ctx context.Context, nodeSelector labels.Selector) ([]ec2types.Subnet, error) {
+	nodeList := &corev1.NodeList{}
+	if err := r.k8sClient.List(ctx, nodeList, client.MatchingLabelsSelector{Selector: nodeSelector}); err != nil {
+		return nil, err
+	}
+	var nodes []*corev1.Node
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if !backend.IsNodeSuitableAsTrafficProxy(node) {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	nodeInstanceByNodeKey, err := r.nodeInfoProvider.FetchNodeInstances(ctx, nodes)
+	if err != nil {
+		return nil, err
+	}
+	subnetIDs := sets.NewString()
+	for _, instance := range nodeInstanceByNodeKey {
+		if subnetID := awssdk.ToString(instance.SubnetId); subnetID != "" {
+			subnetIDs.Insert(subnetID)
+		}
+	}
+	if len(subnetIDs) == 0 {
+		return nil, nil
+	}
+	req := &ec2sdk.DescribeSubnetsInput{
+		SubnetIds: subnetIDs.List(),
+	}
+	subnets, err := r.ec2Client.DescribeSubnetsAsList(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	r.logger.V(1).Info("resolved node subnets", "subnetIDs", subnetIDs.List())
+	return subnets, nil
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: sigs.k8s.io/aws-load-balancer-controller/pkg/networking (interfaces: NodeSubnetsResolver)
+
+// Package networking is a generated GoMock package.
+package networking
+
+import (
+	context "context"
+	reflect "reflect"
+
+	types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	gomock "github.com/golang/mock/gomock"
+	labels "k8s.io/apimachinery/pkg/labels"
+)
+
+// MockNodeSubnetsResolver is a mock of NodeSubnetsResolver interface.
+type MockNodeSubnetsResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockNodeSubnetsResolverMockRecorder
+}
+
+// MockNodeSubnetsResolverMockRecorder is the mock recorder for MockNodeSubnetsResolver.
+type MockNodeSubnetsResolverMockRecorder struct {
+	mock *MockNodeSubnetsResolver
+}
+
+// NewMockNodeSubnetsResolver creates a new mock instance.
+func NewMockNodeSubnetsResolver(ctrl *gomock.Controller) *MockNodeSubnetsResolver {
+	mock := &MockNodeSubnetsResolver{ctrl: ctrl}
+	mock.recorder = &MockNodeSubnetsResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNodeSubnetsResolver) EXPECT() *MockNodeSubnetsResolverMockRecorder {
+	return m.recorder
+}
+
+// ResolveNodeSubnets mocks base method.
+func (m *MockNodeSubnetsResolver) ResolveNodeSubnets(arg0 context.Context, arg1 labels.Selector) ([]types.Subnet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveNodeSubnets", arg0, arg1)
+	ret0, _ := ret[0].([]types.Subnet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveNodeSubnets indicates an expected call of ResolveNodeSubnets.
+func (mr *MockNodeSubnetsResolverMockRecorder) ResolveNodeSubnets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveNodeSubnets", reflect.TypeOf((*MockNodeSubnetsResolver)(nil).ResolveNodeSubnets), arg0, arg1)
+}
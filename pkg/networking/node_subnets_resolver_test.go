@@ -0,0 +1,116 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultNodeSubnetsResolver_ResolveNodeSubnets(t *testing.T) {
+	nodeA := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-a",
+			Labels: map[string]string{"pool": "web"},
+		},
+		Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2a/i-a"},
+	}
+	nodeB := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-b",
+			Labels: map[string]string{"pool": "web"},
+		},
+		Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2b/i-b"},
+	}
+	nodeC := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-c",
+			Labels: map[string]string{"pool": "batch"},
+		},
+		Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2c/i-c"},
+	}
+	nodeD := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-d",
+			Labels: map[string]string{"pool": "web"},
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: "aws:///us-west-2d/i-d",
+			Taints: []corev1.Taint{
+				{
+					Key:    "ToBeDeletedByClusterAutoscaler",
+					Effect: corev1.TaintEffectNoSchedule,
+				},
+			},
+		},
+	}
+	subnetA := &ec2types.Subnet{SubnetId: awssdk.String("subnet-a"), CidrBlock: awssdk.String("192.168.0.0/19")}
+	tests := []struct {
+		name                string
+		nodes               []*corev1.Node
+		nodeSelector        labels.Selector
+		nodeInstances       map[types.NamespacedName]*ec2types.Instance
+		wantSubnetIDsReq    []string
+		describeSubnetsResp []ec2types.Subnet
+		want                []ec2types.Subnet
+	}{
+		{
+			name:         "subnets of selected nodes are resolved",
+			nodes:        []*corev1.Node{nodeA, nodeB, nodeC, nodeD},
+			nodeSelector: labels.SelectorFromSet(labels.Set{"pool": "web"}),
+			nodeInstances: map[types.NamespacedName]*ec2types.Instance{
+				{Name: "node-a"}: {InstanceId: awssdk.String("i-a"), SubnetId: awssdk.String("subnet-a")},
+				{Name: "node-b"}: {InstanceId: awssdk.String("i-b"), SubnetId: awssdk.String("subnet-a")},
+			},
+			wantSubnetIDsReq:    []string{"subnet-a"},
+			describeSubnetsResp: []ec2types.Subnet{*subnetA},
+			want:                []ec2types.Subnet{*subnetA},
+		},
+		{
+			name:         "no nodes selected",
+			nodes:        []*corev1.Node{nodeC},
+			nodeSelector: labels.SelectorFromSet(labels.Set{"pool": "web"}),
+			want:         nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := fake.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, node := range tt.nodes {
+				assert.NoError(t, k8sClient.Create(context.Background(), node.DeepCopy()))
+			}
+			nodeInfoProvider := NewMockNodeInfoProvider(ctrl)
+			ec2Client := services.NewMockEC2(ctrl)
+			if tt.nodeInstances != nil {
+				nodeInfoProvider.EXPECT().FetchNodeInstances(gomock.Any(), gomock.Len(len(tt.nodeInstances))).Return(tt.nodeInstances, nil)
+				ec2Client.EXPECT().DescribeSubnetsAsList(gomock.Any(), &ec2sdk.DescribeSubnetsInput{
+					SubnetIds: tt.wantSubnetIDsReq,
+				}).Return(tt.describeSubnetsResp, nil)
+			}
+
+			r := NewDefaultNodeSubnetsResolver(k8sClient, nodeInfoProvider, ec2Client, logr.New(&log.NullLogSink{}))
+			got, err := r.ResolveNodeSubnets(context.Background(), tt.nodeSelector)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package networking
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -93,9 +95,17 @@ func (r *defaultPodENIInfoResolver) Resolve(ctx context.Context, pods []k8s.PodI
 	}
 
 	if len(podsWithoutENIInfo) > 0 {
-		podKeysWithoutENIInfo := make([]types.NamespacedName, 0, len(podsWithoutENIInfo))
+		var podKeysWithoutENIInfo []types.NamespacedName
+		var podKeysWithoutBranchENIInfo []types.NamespacedName
 		for _, pod := range podsWithoutENIInfo {
-			podKeysWithoutENIInfo = append(podKeysWithoutENIInfo, pod.Key)
+			if pod.PodENIRequested {
+				podKeysWithoutBranchENIInfo = append(podKeysWithoutBranchENIInfo, pod.Key)
+			} else {
+				podKeysWithoutENIInfo = append(podKeysWithoutENIInfo, pod.Key)
+			}
+		}
+		if len(podKeysWithoutBranchENIInfo) > 0 {
+			return nil, errors.Errorf("cannot resolve branch ENI for pods using SecurityGroups for pods: %v", podKeysWithoutBranchENIInfo)
 		}
 		return nil, errors.Errorf("cannot resolve pod ENI for pods: %v", podKeysWithoutENIInfo)
 	}
@@ -161,6 +171,9 @@ func (r *defaultPodENIInfoResolver) resolveViaCascadedLookup(ctx context.Context
 			eniInfoByPodKey[podKey] = eniInfo
 		}
 		pods = computePodsWithoutENIInfo(pods, resolvedENIInfoByPodKey)
+		// pods using SecurityGroups for pods can only be resolved via their branch ENI annotation,
+		// since the ENIs of node have the securityGroups of node rather than the pod.
+		pods = computePodsWithoutPodENIRequest(pods)
 	}
 	return eniInfoByPodKey, nil
 }
@@ -189,15 +202,16 @@ func (r *defaultPodENIInfoResolver) resolveViaPodENIAnnotation(ctx context.Conte
 
 	eniIDs := sets.StringKeySet(podKeysByENIID).List()
 	req := &ec2sdk.DescribeNetworkInterfacesInput{
-		NetworkInterfaceIds: awssdk.StringSlice(eniIDs),
+		NetworkInterfaceIds: eniIDs,
 	}
 	enis, err := r.ec2Client.DescribeNetworkInterfacesAsList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 	eniInfoByPodKey := make(map[types.NamespacedName]ENIInfo)
-	for _, eni := range enis {
-		eniID := awssdk.StringValue(eni.NetworkInterfaceId)
+	for i := range enis {
+		eni := &enis[i]
+		eniID := awssdk.ToString(eni.NetworkInterfaceId)
 		eniInfo := buildENIInfoViaENI(eni)
 		for _, podKey := range podKeysByENIID[eniID] {
 			eniInfoByPodKey[podKey] = eniInfo
@@ -238,7 +252,8 @@ func (r *defaultPodENIInfoResolver) resolveViaNodeENIs(ctx context.Context, pods
 	for _, pod := range pods {
 		nodeKey := types.NamespacedName{Name: pod.NodeName}
 		if nodeInstance, exists := nodeInstanceByNodeKey[nodeKey]; exists {
-			for _, instanceENI := range nodeInstance.NetworkInterfaces {
+			for j := range nodeInstance.NetworkInterfaces {
+				instanceENI := &nodeInstance.NetworkInterfaces[j]
 				if r.isPodSupportedByNodeENI(pod, instanceENI) {
 					eniInfoByPodKey[pod.Key] = buildENIInfoViaInstanceENI(instanceENI)
 					break
@@ -278,7 +293,7 @@ func (r *defaultPodENIInfoResolver) resolveViaVPCENIs(ctx context.Context, pods
 		for _, eni := range eniByID {
 			eniInfo := buildENIInfoViaENI(eni)
 			for _, addr := range eni.PrivateIpAddresses {
-				eniIP := awssdk.StringValue(addr.PrivateIpAddress)
+				eniIP := awssdk.ToString(addr.PrivateIpAddress)
 				for _, podKey := range podKeysByIP[eniIP] {
 					eniInfoByPodKey[podKey] = eniInfo
 				}
@@ -294,7 +309,7 @@ func (r *defaultPodENIInfoResolver) resolveViaVPCENIs(ctx context.Context, pods
 		for _, eni := range eniByID {
 			eniInfo := buildENIInfoViaENI(eni)
 			for _, addr := range eni.Ipv6Addresses {
-				eniIPv6 := awssdk.StringValue(addr.Ipv6Address)
+				eniIPv6 := awssdk.ToString(addr.Ipv6Address)
 				for _, podKey := range podKeysByIP[eniIPv6] {
 					eniInfoByPodKey[podKey] = eniInfo
 				}
@@ -304,19 +319,19 @@ func (r *defaultPodENIInfoResolver) resolveViaVPCENIs(ctx context.Context, pods
 	return eniInfoByPodKey, nil
 }
 
-func (r *defaultPodENIInfoResolver) getENIMappingViaDescribe(ctx context.Context, podIPs []string, ipAddressFilterKey string) (map[string]*ec2sdk.NetworkInterface, error) {
+func (r *defaultPodENIInfoResolver) getENIMappingViaDescribe(ctx context.Context, podIPs []string, ipAddressFilterKey string) (map[string]*ec2types.NetworkInterface, error) {
 	podIPChunks := algorithm.ChunkStrings(podIPs, r.describeNetworkInterfacesIPChunkSize)
-	eniByID := make(map[string]*ec2sdk.NetworkInterface)
+	eniByID := make(map[string]*ec2types.NetworkInterface)
 	for _, podIPChunk := range podIPChunks {
 		req := &ec2sdk.DescribeNetworkInterfacesInput{
-			Filters: []*ec2sdk.Filter{
+			Filters: []ec2types.Filter{
 				{
 					Name:   awssdk.String("vpc-id"),
-					Values: awssdk.StringSlice([]string{r.vpcID}),
+					Values: []string{r.vpcID},
 				},
 				{
 					Name:   awssdk.String(ipAddressFilterKey),
-					Values: awssdk.StringSlice(podIPChunk),
+					Values: podIPChunk,
 				},
 			},
 		}
@@ -324,8 +339,9 @@ func (r *defaultPodENIInfoResolver) getENIMappingViaDescribe(ctx context.Context
 		if err != nil {
 			return nil, err
 		}
-		for _, eni := range enis {
-			eniID := awssdk.StringValue(eni.NetworkInterfaceId)
+		for i := range enis {
+			eni := &enis[i]
+			eniID := awssdk.ToString(eni.NetworkInterfaceId)
 			eniByID[eniID] = eni
 		}
 	}
@@ -333,9 +349,9 @@ func (r *defaultPodENIInfoResolver) getENIMappingViaDescribe(ctx context.Context
 }
 
 // isPodSupportedByNodeENI checks whether pod is supported by specific nodeENI.
-func (r *defaultPodENIInfoResolver) isPodSupportedByNodeENI(pod k8s.PodInfo, nodeENI *ec2sdk.InstanceNetworkInterface) bool {
+func (r *defaultPodENIInfoResolver) isPodSupportedByNodeENI(pod k8s.PodInfo, nodeENI *ec2types.InstanceNetworkInterface) bool {
 	for _, ipv4Address := range nodeENI.PrivateIpAddresses {
-		if pod.PodIP == awssdk.StringValue(ipv4Address.PrivateIpAddress) {
+		if pod.PodIP == awssdk.ToString(ipv4Address.PrivateIpAddress) {
 			return true
 		}
 	}
@@ -343,12 +359,12 @@ func (r *defaultPodENIInfoResolver) isPodSupportedByNodeENI(pod k8s.PodInfo, nod
 	if len(nodeENI.Ipv4Prefixes) > 0 || len(nodeENI.Ipv6Prefixes) > 0 {
 		if podIP := net.ParseIP(pod.PodIP); podIP != nil {
 			for _, ipv4Prefix := range nodeENI.Ipv4Prefixes {
-				if _, ipv4CIDR, err := net.ParseCIDR(awssdk.StringValue(ipv4Prefix.Ipv4Prefix)); err == nil && ipv4CIDR.Contains(podIP) {
+				if _, ipv4CIDR, err := net.ParseCIDR(awssdk.ToString(ipv4Prefix.Ipv4Prefix)); err == nil && ipv4CIDR.Contains(podIP) {
 					return true
 				}
 			}
 			for _, ipv6Prefix := range nodeENI.Ipv6Prefixes {
-				if _, ipv6CIDR, err := net.ParseCIDR(awssdk.StringValue(ipv6Prefix.Ipv6Prefix)); err == nil && ipv6CIDR.Contains(podIP) {
+				if _, ipv6CIDR, err := net.ParseCIDR(awssdk.ToString(ipv6Prefix.Ipv6Prefix)); err == nil && ipv6CIDR.Contains(podIP) {
 					return true
 				}
 			}
@@ -366,6 +382,17 @@ func computePodENIInfoCacheKey(podInfo k8s.PodInfo) podENIInfoCacheKey {
 	}
 }
 
+// computePodsWithoutPodENIRequest computes pods that don't use a branch ENI.
+func computePodsWithoutPodENIRequest(pods []k8s.PodInfo) []k8s.PodInfo {
+	podsWithoutPodENIRequest := make([]k8s.PodInfo, 0, len(pods))
+	for _, pod := range pods {
+		if !pod.PodENIRequested {
+			podsWithoutPodENIRequest = append(podsWithoutPodENIRequest, pod)
+		}
+	}
+	return podsWithoutPodENIRequest
+}
+
 // computePodsWithoutENIInfo computes pods that don't have a ENIInfo.
 func computePodsWithoutENIInfo(pods []k8s.PodInfo, eniInfoByPodKey map[types.NamespacedName]ENIInfo) []k8s.PodInfo {
 	podsWithoutENIInfo := make([]k8s.PodInfo, 0, len(pods)-len(eniInfoByPodKey))
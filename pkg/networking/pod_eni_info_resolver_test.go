@@ -4,8 +4,9 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -30,12 +31,12 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 			ProviderID: "aws:///us-west-2a/i-0fa2d0064e848c69a",
 		},
 	}
-	instanceA := &ec2sdk.Instance{
+	instanceA := &ec2types.Instance{
 		InstanceId: awssdk.String("i-0fa2d0064e848c69a"),
-		NetworkInterfaces: []*ec2sdk.InstanceNetworkInterface{
+		NetworkInterfaces: []ec2types.InstanceNetworkInterface{
 			{
 				NetworkInterfaceId: awssdk.String("eni-a"),
-				PrivateIpAddresses: []*ec2sdk.InstancePrivateIpAddress{
+				PrivateIpAddresses: []ec2types.InstancePrivateIpAddress{
 					{
 						PrivateIpAddress: awssdk.String("192.168.200.1"),
 					},
@@ -43,10 +44,10 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 						PrivateIpAddress: awssdk.String("192.168.200.2"),
 					},
 				},
-				Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
-					DeviceIndex: awssdk.Int64(0),
+				Attachment: &ec2types.InstanceNetworkInterfaceAttachment{
+					DeviceIndex: awssdk.Int32(0),
 				},
-				Groups: []*ec2sdk.GroupIdentifier{
+				Groups: []ec2types.GroupIdentifier{
 					{
 						GroupId: awssdk.String("sg-a-1"),
 					},
@@ -56,12 +57,12 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 	}
 	type describeNetworkInterfacesAsListCall struct {
 		req  *ec2sdk.DescribeNetworkInterfacesInput
-		resp []*ec2sdk.NetworkInterface
+		resp []ec2types.NetworkInterface
 		err  error
 	}
 	type fetchNodeInstancesCall struct {
 		nodes                 []*corev1.Node
-		nodeInstanceByNodeKey map[types.NamespacedName]*ec2sdk.Instance
+		nodeInstanceByNodeKey map[types.NamespacedName]*ec2types.Instance
 		err                   error
 	}
 	type env struct {
@@ -94,12 +95,12 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-a", "eni-b"}),
+							NetworkInterfaceIds: []string{"eni-a", "eni-b"},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -107,7 +108,7 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -117,12 +118,12 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 					},
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-c", "eni-d"}),
+							NetworkInterfaceIds: []string{"eni-c", "eni-d"},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-c"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-c-1"),
 									},
@@ -130,7 +131,7 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-d"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-d-1"),
 									},
@@ -232,12 +233,12 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-a", "eni-b"}),
+							NetworkInterfaceIds: []string{"eni-a", "eni-b"},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -245,7 +246,7 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -255,12 +256,12 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 					},
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-c"}),
+							NetworkInterfaceIds: []string{"eni-c"},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-c"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-c-1"),
 									},
@@ -362,12 +363,12 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-a", "eni-b"}),
+							NetworkInterfaceIds: []string{"eni-a", "eni-b"},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -375,7 +376,7 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -461,14 +462,14 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-abc"}),
+									Values: []string{"vpc-abc"},
 								},
 								{
 									Name:   awssdk.String("addresses.private-ip-address"),
-									Values: awssdk.StringSlice([]string{"192.168.200.3"}),
+									Values: []string{"192.168.200.3"},
 								},
 							},
 						},
@@ -478,7 +479,7 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 				fetchNodeInstancesCalls: []fetchNodeInstancesCall{
 					{
 						nodes: []*corev1.Node{nodeA},
-						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2sdk.Instance{
+						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2types.Instance{
 							types.NamespacedName{Name: "node-a"}: instanceA,
 						},
 					},
@@ -512,6 +513,28 @@ func Test_defaultPodENIInfoResolver_Resolve(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "pods using SecurityGroups for pods without branch ENI",
+			env: env{
+				nodes: []*corev1.Node{nodeA},
+			},
+			wantResolveCalls: []resolveCall{
+				{
+					args: args{
+						pods: []k8s.PodInfo{
+							{
+								Key:             types.NamespacedName{Namespace: "default", Name: "pod-1"},
+								UID:             types.UID("2d8740a6-f4b1-4074-a91c-f0084ec0bc01"),
+								NodeName:        "node-a",
+								PodIP:           "192.168.200.1",
+								PodENIRequested: true,
+							},
+						},
+					},
+					wantErr: errors.New("cannot resolve branch ENI for pods using SecurityGroups for pods: [default/pod-1]"),
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -583,12 +606,12 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 			ProviderID: "aws:///us-west-2b/xxxxxxxx/fargate-ip-192-168-128-148.us-west-2.compute.internal",
 		},
 	}
-	instanceA := &ec2sdk.Instance{
+	instanceA := &ec2types.Instance{
 		InstanceId: awssdk.String("i-0fa2d0064e848c69a"),
-		NetworkInterfaces: []*ec2sdk.InstanceNetworkInterface{
+		NetworkInterfaces: []ec2types.InstanceNetworkInterface{
 			{
 				NetworkInterfaceId: awssdk.String("eni-a"),
-				PrivateIpAddresses: []*ec2sdk.InstancePrivateIpAddress{
+				PrivateIpAddresses: []ec2types.InstancePrivateIpAddress{
 					{
 						PrivateIpAddress: awssdk.String("192.168.200.1"),
 					},
@@ -596,10 +619,10 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 						PrivateIpAddress: awssdk.String("192.168.200.2"),
 					},
 				},
-				Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
-					DeviceIndex: awssdk.Int64(0),
+				Attachment: &ec2types.InstanceNetworkInterfaceAttachment{
+					DeviceIndex: awssdk.Int32(0),
 				},
-				Groups: []*ec2sdk.GroupIdentifier{
+				Groups: []ec2types.GroupIdentifier{
 					{
 						GroupId: awssdk.String("sg-a-1"),
 					},
@@ -607,7 +630,7 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 			},
 			{
 				NetworkInterfaceId: awssdk.String("eni-b"),
-				PrivateIpAddresses: []*ec2sdk.InstancePrivateIpAddress{
+				PrivateIpAddresses: []ec2types.InstancePrivateIpAddress{
 					{
 						PrivateIpAddress: awssdk.String("192.168.200.3"),
 					},
@@ -615,10 +638,10 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 						PrivateIpAddress: awssdk.String("192.168.200.4"),
 					},
 				},
-				Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
-					DeviceIndex: awssdk.Int64(1),
+				Attachment: &ec2types.InstanceNetworkInterfaceAttachment{
+					DeviceIndex: awssdk.Int32(1),
 				},
-				Groups: []*ec2sdk.GroupIdentifier{
+				Groups: []ec2types.GroupIdentifier{
 					{
 						GroupId: awssdk.String("sg-b-1"),
 					},
@@ -628,12 +651,12 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 	}
 	type describeNetworkInterfacesAsListCall struct {
 		req  *ec2sdk.DescribeNetworkInterfacesInput
-		resp []*ec2sdk.NetworkInterface
+		resp []ec2types.NetworkInterface
 		err  error
 	}
 	type fetchNodeInstancesCall struct {
 		nodes                 []*corev1.Node
-		nodeInstanceByNodeKey map[types.NamespacedName]*ec2sdk.Instance
+		nodeInstanceByNodeKey map[types.NamespacedName]*ec2types.Instance
 		err                   error
 	}
 	type env struct {
@@ -663,12 +686,12 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-a", "eni-b"}),
+							NetworkInterfaceIds: []string{"eni-a", "eni-b"},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -676,7 +699,7 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -734,7 +757,7 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 				fetchNodeInstancesCalls: []fetchNodeInstancesCall{
 					{
 						nodes: []*corev1.Node{nodeA},
-						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2sdk.Instance{
+						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2types.Instance{
 							types.NamespacedName{Name: "node-a"}: instanceA,
 						},
 					},
@@ -776,21 +799,21 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("addresses.private-ip-address"),
-									Values: awssdk.StringSlice([]string{"192.168.128.147", "192.168.128.148"}),
+									Values: []string{"192.168.128.147", "192.168.128.148"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+								PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
 									{
 										PrivateIpAddress: awssdk.String("192.168.128.146"),
 									},
@@ -798,7 +821,7 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 										PrivateIpAddress: awssdk.String("192.168.128.147"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -806,7 +829,7 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+								PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
 									{
 										PrivateIpAddress: awssdk.String("192.168.128.148"),
 									},
@@ -814,7 +837,7 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 										PrivateIpAddress: awssdk.String("192.168.128.149"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -860,12 +883,12 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-a"}),
+							NetworkInterfaceIds: []string{"eni-a"},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -875,26 +898,26 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 					},
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("addresses.private-ip-address"),
-									Values: awssdk.StringSlice([]string{"192.168.128.147", "192.168.5.3"}),
+									Values: []string{"192.168.128.147", "192.168.5.3"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-c"),
-								PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+								PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
 									{
 										PrivateIpAddress: awssdk.String("192.168.128.147"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-c-1"),
 									},
@@ -906,7 +929,7 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 				fetchNodeInstancesCalls: []fetchNodeInstancesCall{
 					{
 						nodes: []*corev1.Node{nodeA},
-						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2sdk.Instance{
+						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2types.Instance{
 							types.NamespacedName{Name: "node-a"}: instanceA,
 						},
 					},
@@ -961,6 +984,24 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "pods using SecurityGroups for pods aren't resolved via node ENIs",
+			env: env{
+				nodes: []*corev1.Node{nodeA},
+			},
+			args: args{
+				pods: []k8s.PodInfo{
+					{
+						Key:             types.NamespacedName{Namespace: "default", Name: "pod-1"},
+						UID:             types.UID("2d8740a6-f4b1-4074-a91c-f0084ec0bc01"),
+						NodeName:        "node-a",
+						PodIP:           "192.168.200.3",
+						PodENIRequested: true,
+					},
+				},
+			},
+			want: map[types.NamespacedName]ENIInfo{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1010,7 +1051,7 @@ func Test_defaultPodENIInfoResolver_resolveViaCascadedLookup(t *testing.T) {
 func Test_defaultPodENIInfoResolver_resolveViaPodENIAnnotation(t *testing.T) {
 	type describeNetworkInterfacesAsListCall struct {
 		req  *ec2sdk.DescribeNetworkInterfacesInput
-		resp []*ec2sdk.NetworkInterface
+		resp []ec2types.NetworkInterface
 		err  error
 	}
 	type fields struct {
@@ -1032,12 +1073,12 @@ func Test_defaultPodENIInfoResolver_resolveViaPodENIAnnotation(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-a", "eni-b"}),
+							NetworkInterfaceIds: []string{"eni-a", "eni-b"},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -1045,7 +1086,7 @@ func Test_defaultPodENIInfoResolver_resolveViaPodENIAnnotation(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -1163,7 +1204,7 @@ func Test_defaultPodENIInfoResolver_resolveViaPodENIAnnotation(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							NetworkInterfaceIds: awssdk.StringSlice([]string{"eni-a"}),
+							NetworkInterfaceIds: []string{"eni-a"},
 						},
 						err: errors.New("eni eni-a not found"),
 					},
@@ -1248,12 +1289,12 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 			ProviderID: "aws:///us-west-2b/xxxxxxxx/fargate-ip-192-168-128-147.us-west-2.compute.internal",
 		},
 	}
-	instanceA := &ec2sdk.Instance{
+	instanceA := &ec2types.Instance{
 		InstanceId: awssdk.String("i-0fa2d0064e848c69a"),
-		NetworkInterfaces: []*ec2sdk.InstanceNetworkInterface{
+		NetworkInterfaces: []ec2types.InstanceNetworkInterface{
 			{
 				NetworkInterfaceId: awssdk.String("eni-a-1"),
-				PrivateIpAddresses: []*ec2sdk.InstancePrivateIpAddress{
+				PrivateIpAddresses: []ec2types.InstancePrivateIpAddress{
 					{
 						PrivateIpAddress: awssdk.String("192.168.100.1"),
 					},
@@ -1261,10 +1302,10 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 						PrivateIpAddress: awssdk.String("192.168.100.2"),
 					},
 				},
-				Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
-					DeviceIndex: awssdk.Int64(0),
+				Attachment: &ec2types.InstanceNetworkInterfaceAttachment{
+					DeviceIndex: awssdk.Int32(0),
 				},
-				Groups: []*ec2sdk.GroupIdentifier{
+				Groups: []ec2types.GroupIdentifier{
 					{
 						GroupId: awssdk.String("sg-a-1"),
 					},
@@ -1272,20 +1313,20 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 			},
 		},
 	}
-	instanceB := &ec2sdk.Instance{
+	instanceB := &ec2types.Instance{
 		InstanceId: awssdk.String("i-0fa2d0064e848c69b"),
-		NetworkInterfaces: []*ec2sdk.InstanceNetworkInterface{
+		NetworkInterfaces: []ec2types.InstanceNetworkInterface{
 			{
 				NetworkInterfaceId: awssdk.String("eni-b-1"),
-				Ipv4Prefixes: []*ec2sdk.InstanceIpv4Prefix{
+				Ipv4Prefixes: []ec2types.InstanceIpv4Prefix{
 					{
 						Ipv4Prefix: awssdk.String("192.168.142.128/28"),
 					},
 				},
-				Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
-					DeviceIndex: awssdk.Int64(0),
+				Attachment: &ec2types.InstanceNetworkInterfaceAttachment{
+					DeviceIndex: awssdk.Int32(0),
 				},
-				Groups: []*ec2sdk.GroupIdentifier{
+				Groups: []ec2types.GroupIdentifier{
 					{
 						GroupId: awssdk.String("sg-b-1"),
 					},
@@ -1293,12 +1334,12 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 			},
 		},
 	}
-	instanceC := &ec2sdk.Instance{
+	instanceC := &ec2types.Instance{
 		InstanceId: awssdk.String("i-0fa2d0064e848c69c"),
-		NetworkInterfaces: []*ec2sdk.InstanceNetworkInterface{
+		NetworkInterfaces: []ec2types.InstanceNetworkInterface{
 			{
 				NetworkInterfaceId: awssdk.String("eni-c-1"),
-				PrivateIpAddresses: []*ec2sdk.InstancePrivateIpAddress{
+				PrivateIpAddresses: []ec2types.InstancePrivateIpAddress{
 					{
 						PrivateIpAddress: awssdk.String("192.168.100.3"),
 					},
@@ -1306,10 +1347,10 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 						PrivateIpAddress: awssdk.String("192.168.100.4"),
 					},
 				},
-				Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
-					DeviceIndex: awssdk.Int64(0),
+				Attachment: &ec2types.InstanceNetworkInterfaceAttachment{
+					DeviceIndex: awssdk.Int32(0),
 				},
-				Groups: []*ec2sdk.GroupIdentifier{
+				Groups: []ec2types.GroupIdentifier{
 					{
 						GroupId: awssdk.String("sg-c-1"),
 					},
@@ -1317,15 +1358,15 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 			},
 			{
 				NetworkInterfaceId: awssdk.String("eni-c-2"),
-				Ipv4Prefixes: []*ec2sdk.InstanceIpv4Prefix{
+				Ipv4Prefixes: []ec2types.InstanceIpv4Prefix{
 					{
 						Ipv4Prefix: awssdk.String("192.168.172.128/28"),
 					},
 				},
-				Attachment: &ec2sdk.InstanceNetworkInterfaceAttachment{
-					DeviceIndex: awssdk.Int64(0),
+				Attachment: &ec2types.InstanceNetworkInterfaceAttachment{
+					DeviceIndex: awssdk.Int32(0),
 				},
-				Groups: []*ec2sdk.GroupIdentifier{
+				Groups: []ec2types.GroupIdentifier{
 					{
 						GroupId: awssdk.String("sg-c-2"),
 					},
@@ -1338,7 +1379,7 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 	}
 	type fetchNodeInstancesCall struct {
 		nodes                 []*corev1.Node
-		nodeInstanceByNodeKey map[types.NamespacedName]*ec2sdk.Instance
+		nodeInstanceByNodeKey map[types.NamespacedName]*ec2types.Instance
 		err                   error
 	}
 	type fields struct {
@@ -1364,7 +1405,7 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 				fetchNodeInstancesCalls: []fetchNodeInstancesCall{
 					{
 						nodes: []*corev1.Node{nodeA, nodeB, nodeC},
-						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2sdk.Instance{
+						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2types.Instance{
 							types.NamespacedName{Name: "node-a"}: instanceA,
 							types.NamespacedName{Name: "node-b"}: instanceB,
 							types.NamespacedName{Name: "node-c"}: instanceC,
@@ -1428,7 +1469,7 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 				fetchNodeInstancesCalls: []fetchNodeInstancesCall{
 					{
 						nodes: []*corev1.Node{nodeA, nodeB},
-						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2sdk.Instance{
+						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2types.Instance{
 							types.NamespacedName{Name: "node-a"}: instanceA,
 							types.NamespacedName{Name: "node-b"}: instanceB,
 						},
@@ -1477,7 +1518,7 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 				fetchNodeInstancesCalls: []fetchNodeInstancesCall{
 					{
 						nodes: []*corev1.Node{nodeA, nodeB, nodeC},
-						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2sdk.Instance{
+						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2types.Instance{
 							types.NamespacedName{Name: "node-a"}: instanceA,
 							types.NamespacedName{Name: "node-c"}: instanceC,
 						},
@@ -1536,7 +1577,7 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 				fetchNodeInstancesCalls: []fetchNodeInstancesCall{
 					{
 						nodes: []*corev1.Node{nodeA, nodeB, nodeC},
-						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2sdk.Instance{
+						nodeInstanceByNodeKey: map[types.NamespacedName]*ec2types.Instance{
 							types.NamespacedName{Name: "node-a"}: instanceA,
 							types.NamespacedName{Name: "node-b"}: instanceB,
 							types.NamespacedName{Name: "node-c"}: instanceC,
@@ -1705,7 +1746,7 @@ func Test_defaultPodENIInfoResolver_resolveViaNodeENIs(t *testing.T) {
 func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 	type describeNetworkInterfacesAsListCall struct {
 		req  *ec2sdk.DescribeNetworkInterfacesInput
-		resp []*ec2sdk.NetworkInterface
+		resp []ec2types.NetworkInterface
 		err  error
 	}
 	type fields struct {
@@ -1727,21 +1768,21 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("addresses.private-ip-address"),
-									Values: awssdk.StringSlice([]string{"192.168.100.1", "192.168.100.3"}),
+									Values: []string{"192.168.100.1", "192.168.100.3"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+								PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
 									{
 										PrivateIpAddress: awssdk.String("192.168.100.1"),
 									},
@@ -1749,7 +1790,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 										PrivateIpAddress: awssdk.String("192.168.100.2"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -1757,7 +1798,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+								PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
 									{
 										PrivateIpAddress: awssdk.String("192.168.100.3"),
 									},
@@ -1765,7 +1806,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 										PrivateIpAddress: awssdk.String("192.168.100.4"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -1808,21 +1849,21 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("addresses.private-ip-address"),
-									Values: awssdk.StringSlice([]string{"192.168.100.1", "192.168.100.2"}),
+									Values: []string{"192.168.100.1", "192.168.100.2"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+								PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
 									{
 										PrivateIpAddress: awssdk.String("192.168.100.1"),
 									},
@@ -1830,7 +1871,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 										PrivateIpAddress: awssdk.String("192.168.100.2"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -1840,22 +1881,22 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 					},
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("addresses.private-ip-address"),
-									Values: awssdk.StringSlice([]string{"192.168.100.3", "192.168.100.4"}),
+									Values: []string{"192.168.100.3", "192.168.100.4"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+								PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
 									{
 										PrivateIpAddress: awssdk.String("192.168.100.3"),
 									},
@@ -1863,7 +1904,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 										PrivateIpAddress: awssdk.String("192.168.100.4"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -1926,21 +1967,21 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("addresses.private-ip-address"),
-									Values: awssdk.StringSlice([]string{"192.168.100.1", "192.168.100.3"}),
+									Values: []string{"192.168.100.1", "192.168.100.3"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								PrivateIpAddresses: []*ec2sdk.NetworkInterfacePrivateIpAddress{
+								PrivateIpAddresses: []ec2types.NetworkInterfacePrivateIpAddress{
 									{
 										PrivateIpAddress: awssdk.String("192.168.100.1"),
 									},
@@ -1948,7 +1989,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 										PrivateIpAddress: awssdk.String("192.168.100.2"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -1987,14 +2028,14 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("addresses.private-ip-address"),
-									Values: awssdk.StringSlice([]string{"192.168.100.1", "192.168.100.3"}),
+									Values: []string{"192.168.100.1", "192.168.100.3"},
 								},
 							},
 						},
@@ -2050,7 +2091,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIs(t *testing.T) {
 func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 	type describeNetworkInterfacesAsListCall struct {
 		req  *ec2sdk.DescribeNetworkInterfacesInput
-		resp []*ec2sdk.NetworkInterface
+		resp []ec2types.NetworkInterface
 		err  error
 	}
 	type fields struct {
@@ -2072,21 +2113,21 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("ipv6-addresses.ipv6-address"),
-									Values: awssdk.StringSlice([]string{"2001:0db8:85a3:0000:0000:8a2e:0370:ee50", "2001:0db8:85a3:0000:0000:9704:6c49:9e7d"}),
+									Values: []string{"2001:0db8:85a3:0000:0000:8a2e:0370:ee50", "2001:0db8:85a3:0000:0000:9704:6c49:9e7d"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Ipv6Addresses: []*ec2sdk.NetworkInterfaceIpv6Address{
+								Ipv6Addresses: []ec2types.NetworkInterfaceIpv6Address{
 									{
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:8a2e:0370:ee50"),
 									},
@@ -2094,7 +2135,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:8a2e:0370:ee52"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -2102,7 +2143,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 							},
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								Ipv6Addresses: []*ec2sdk.NetworkInterfaceIpv6Address{
+								Ipv6Addresses: []ec2types.NetworkInterfaceIpv6Address{
 									{
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:9704:6c49:9e70"),
 									},
@@ -2110,7 +2151,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:9704:6c49:9e7d"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -2153,21 +2194,21 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("ipv6-addresses.ipv6-address"),
-									Values: awssdk.StringSlice([]string{"2001:0db8:85a3:0000:0000:8a2e:0370:ee50", "2001:0db8:85a3:0000:0000:9704:6c49:9e7d"}),
+									Values: []string{"2001:0db8:85a3:0000:0000:8a2e:0370:ee50", "2001:0db8:85a3:0000:0000:9704:6c49:9e7d"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Ipv6Addresses: []*ec2sdk.NetworkInterfaceIpv6Address{
+								Ipv6Addresses: []ec2types.NetworkInterfaceIpv6Address{
 									{
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:8a2e:0370:ee50"),
 									},
@@ -2175,7 +2216,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:9704:6c49:9e7d"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -2185,21 +2226,21 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 					},
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("ipv6-addresses.ipv6-address"),
-									Values: awssdk.StringSlice([]string{"2001:0db8:85a3:0000:0000:8493:9af3:a786", "2001:0db8:85a3:0000:0000:3f04:39e7:58d9"}),
+									Values: []string{"2001:0db8:85a3:0000:0000:8493:9af3:a786", "2001:0db8:85a3:0000:0000:3f04:39e7:58d9"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-b"),
-								Ipv6Addresses: []*ec2sdk.NetworkInterfaceIpv6Address{
+								Ipv6Addresses: []ec2types.NetworkInterfaceIpv6Address{
 									{
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:8493:9af3:a786"),
 									},
@@ -2207,7 +2248,7 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:3f04:39e7:58d9"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-b-1"),
 									},
@@ -2270,26 +2311,26 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("ipv6-addresses.ipv6-address"),
-									Values: awssdk.StringSlice([]string{"2001:0db8:85a3:0000:0000:8493:9af3:a786", "2001:0db8:85a3:0000:0000:3f04:39e7:58d9"}),
+									Values: []string{"2001:0db8:85a3:0000:0000:8493:9af3:a786", "2001:0db8:85a3:0000:0000:3f04:39e7:58d9"},
 								},
 							},
 						},
-						resp: []*ec2sdk.NetworkInterface{
+						resp: []ec2types.NetworkInterface{
 							{
 								NetworkInterfaceId: awssdk.String("eni-a"),
-								Ipv6Addresses: []*ec2sdk.NetworkInterfaceIpv6Address{
+								Ipv6Addresses: []ec2types.NetworkInterfaceIpv6Address{
 									{
 										Ipv6Address: awssdk.String("2001:0db8:85a3:0000:0000:8493:9af3:a786"),
 									},
 								},
-								Groups: []*ec2sdk.GroupIdentifier{
+								Groups: []ec2types.GroupIdentifier{
 									{
 										GroupId: awssdk.String("sg-a-1"),
 									},
@@ -2328,14 +2369,14 @@ func Test_defaultPodENIInfoResolver_resolveViaVPCENIsForIPv6(t *testing.T) {
 				describeNetworkInterfacesAsListCalls: []describeNetworkInterfacesAsListCall{
 					{
 						req: &ec2sdk.DescribeNetworkInterfacesInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-0d6d9ee10bd062dcc"}),
+									Values: []string{"vpc-0d6d9ee10bd062dcc"},
 								},
 								{
 									Name:   awssdk.String("ipv6-addresses.ipv6-address"),
-									Values: awssdk.StringSlice([]string{"2001:0db8:85a3:0000:0000:8493:9af3:a786", "2001:0db8:85a3:0000:0000:3f04:39e7:58d9"}),
+									Values: []string{"2001:0db8:85a3:0000:0000:8493:9af3:a786", "2001:0db8:85a3:0000:0000:3f04:39e7:58d9"},
 								},
 							},
 						},
@@ -2529,7 +2570,7 @@ func Test_computePodsWithoutENIInfo(t *testing.T) {
 func Test_defaultPodENIInfoResolver_isPodSupportedByNodeENI(t *testing.T) {
 	type args struct {
 		pod     k8s.PodInfo
-		nodeENI *ec2sdk.InstanceNetworkInterface
+		nodeENI *ec2types.InstanceNetworkInterface
 	}
 	tests := []struct {
 		name string
@@ -2542,8 +2583,8 @@ func Test_defaultPodENIInfoResolver_isPodSupportedByNodeENI(t *testing.T) {
 				pod: k8s.PodInfo{
 					PodIP: "192.168.100.23",
 				},
-				nodeENI: &ec2sdk.InstanceNetworkInterface{
-					PrivateIpAddresses: []*ec2sdk.InstancePrivateIpAddress{
+				nodeENI: &ec2types.InstanceNetworkInterface{
+					PrivateIpAddresses: []ec2types.InstancePrivateIpAddress{
 						{
 							PrivateIpAddress: awssdk.String("192.168.100.22"),
 						},
@@ -2561,8 +2602,8 @@ func Test_defaultPodENIInfoResolver_isPodSupportedByNodeENI(t *testing.T) {
 				pod: k8s.PodInfo{
 					PodIP: "192.168.100.21",
 				},
-				nodeENI: &ec2sdk.InstanceNetworkInterface{
-					PrivateIpAddresses: []*ec2sdk.InstancePrivateIpAddress{
+				nodeENI: &ec2types.InstanceNetworkInterface{
+					PrivateIpAddresses: []ec2types.InstancePrivateIpAddress{
 						{
 							PrivateIpAddress: awssdk.String("192.168.100.22"),
 						},
@@ -2580,8 +2621,8 @@ func Test_defaultPodENIInfoResolver_isPodSupportedByNodeENI(t *testing.T) {
 				pod: k8s.PodInfo{
 					PodIP: "192.168.172.140",
 				},
-				nodeENI: &ec2sdk.InstanceNetworkInterface{
-					Ipv4Prefixes: []*ec2sdk.InstanceIpv4Prefix{
+				nodeENI: &ec2types.InstanceNetworkInterface{
+					Ipv4Prefixes: []ec2types.InstanceIpv4Prefix{
 						{
 							Ipv4Prefix: awssdk.String("192.168.197.64/28"),
 						},
@@ -2599,8 +2640,8 @@ func Test_defaultPodENIInfoResolver_isPodSupportedByNodeENI(t *testing.T) {
 				pod: k8s.PodInfo{
 					PodIP: "192.168.100.23",
 				},
-				nodeENI: &ec2sdk.InstanceNetworkInterface{
-					Ipv4Prefixes: []*ec2sdk.InstanceIpv4Prefix{
+				nodeENI: &ec2types.InstanceNetworkInterface{
+					Ipv4Prefixes: []ec2types.InstanceIpv4Prefix{
 						{
 							Ipv4Prefix: awssdk.String("192.168.197.64/28"),
 						},
@@ -2618,8 +2659,8 @@ func Test_defaultPodENIInfoResolver_isPodSupportedByNodeENI(t *testing.T) {
 				pod: k8s.PodInfo{
 					PodIP: "abcdefg",
 				},
-				nodeENI: &ec2sdk.InstanceNetworkInterface{
-					Ipv4Prefixes: []*ec2sdk.InstanceIpv4Prefix{
+				nodeENI: &ec2types.InstanceNetworkInterface{
+					Ipv4Prefixes: []ec2types.InstanceIpv4Prefix{
 						{
 							Ipv4Prefix: awssdk.String("192.168.197.64/28"),
 						},
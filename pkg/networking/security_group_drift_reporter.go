@@ -0,0 +1,205 @@
+package networking
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	metricSubsystemSecurityGroup        = "security_group"
+	metricSecurityGroupDriftPermissions = "drift_permissions"
+
+	labelSecurityGroupID = "security_group_id"
+	labelDrift           = "drift"
+
+	driftMissing = "missing"
+	driftExtra   = "extra"
+
+	// SecurityGroupEventReasonPermissionDrift is the event reason for SecurityGroup permission drift.
+	SecurityGroupEventReasonPermissionDrift = "SecurityGroupPermissionDrift"
+)
+
+// SecurityGroupDrift is the difference between modeled and actual ingress permissions of a SecurityGroup.
+type SecurityGroupDrift struct {
+	// SecurityGroup's ID.
+	SecurityGroupID string
+	// MissingPermissions are modeled permissions that are absent from the SecurityGroup.
+	MissingPermissions []IPPermissionInfo
+	// ExtraPermissions are managed permissions on the SecurityGroup that are not modeled.
+	ExtraPermissions []IPPermissionInfo
+}
+
+// HasDrift returns whether there is any drift.
+func (d *SecurityGroupDrift) HasDrift() bool {
+	return len(d.MissingPermissions) != 0 || len(d.ExtraPermissions) != 0
+}
+
+// SecurityGroupDriftReporter reports SecurityGroup permission drift instead of remediating it.
+type SecurityGroupDriftReporter interface {
+	// ReportDrift reports the drift of SecurityGroup, an empty drift clears previously reported drift.
+	// eventObject is optional, drift events are recorded on it when specified.
+	ReportDrift(ctx context.Context, drift SecurityGroupDrift, eventObject runtime.Object) error
+}
+
+// NewDefaultSecurityGroupDriftReporter constructs new defaultSecurityGroupDriftReporter.
+// reportConfigMapKey is optional, the drift report is written into that ConfigMap when specified.
+func NewDefaultSecurityGroupDriftReporter(k8sClient client.Client, eventRecorder record.EventRecorder,
+	reportConfigMapKey *types.NamespacedName, metricsRegisterer prometheus.Registerer, logger logr.Logger) (*defaultSecurityGroupDriftReporter, error) {
+	driftPermissions := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemSecurityGroup,
+		Name:      metricSecurityGroupDriftPermissions,
+		Help:      "Number of ingress permissions that drift from the modeled ones per SecurityGroup",
+	}, []string{labelSecurityGroupID, labelDrift})
+	if metricsRegisterer != nil {
+		if err := metricsRegisterer.Register(driftPermissions); err != nil {
+			return nil, errors.Wrapf(err, "failed to register metric: %v", metricSecurityGroupDriftPermissions)
+		}
+	}
+	return &defaultSecurityGroupDriftReporter{
+		k8sClient:          k8sClient,
+		eventRecorder:      eventRecorder,
+		reportConfigMapKey: reportConfigMapKey,
+		driftPermissions:   driftPermissions,
+		reportBySGID:       make(map[string]string),
+		logger:             logger,
+	}, nil
+}
+
+var _ SecurityGroupDriftReporter = &defaultSecurityGroupDriftReporter{}
+
+// default implementation for SecurityGroupDriftReporter.
+// drift is reported via logs, metrics and events, and optionally a ConfigMap report keyed by SecurityGroup ID.
+type defaultSecurityGroupDriftReporter struct {
+	k8sClient          client.Client
+	eventRecorder      record.EventRecorder
+	reportConfigMapKey *types.NamespacedName
+	driftPermissions   *prometheus.GaugeVec
+	logger             logr.Logger
+
+	// reportBySGID is the rendered drift report per SecurityGroup with drift.
+	reportBySGID map[string]string
+	// reportMutex protects reportBySGID and serializes ConfigMap report writes.
+	reportMutex sync.Mutex
+}
+
+// securityGroupDriftReport is the rendered drift report of a SecurityGroup.
+type securityGroupDriftReport struct {
+	MissingPermissions []string `json:"missingPermissions,omitempty"`
+	ExtraPermissions   []string `json:"extraPermissions,omitempty"`
+}
+
+func (r *defaultSecurityGroupDriftReporter) ReportDrift(ctx context.Context, drift SecurityGroupDrift, eventObject runtime.Object) error {
+	sgID := drift.SecurityGroupID
+	r.driftPermissions.WithLabelValues(sgID, driftMissing).Set(float64(len(drift.MissingPermissions)))
+	r.driftPermissions.WithLabelValues(sgID, driftExtra).Set(float64(len(drift.ExtraPermissions)))
+
+	report := securityGroupDriftReport{
+		MissingPermissions: renderPermissions(drift.MissingPermissions),
+		ExtraPermissions:   renderPermissions(drift.ExtraPermissions),
+	}
+	payload, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	r.reportMutex.Lock()
+	defer r.reportMutex.Unlock()
+	if !drift.HasDrift() {
+		if _, reported := r.reportBySGID[sgID]; !reported {
+			return nil
+		}
+		delete(r.reportBySGID, sgID)
+		r.logger.Info("securityGroup permission drift cleared", "securityGroupID", sgID)
+		return r.writeReportConfigMap(ctx)
+	}
+	if r.reportBySGID[sgID] == string(payload) {
+		return nil
+	}
+	r.reportBySGID[sgID] = string(payload)
+	r.logger.Info("securityGroup permission drift detected",
+		"securityGroupID", sgID,
+		"missingPermissions", report.MissingPermissions,
+		"extraPermissions", report.ExtraPermissions)
+	message := fmt.Sprintf("SecurityGroup %v drifts with %d missing and %d extra permissions",
+		sgID, len(drift.MissingPermissions), len(drift.ExtraPermissions))
+	if eventObject != nil {
+		r.eventRecorder.Event(eventObject, corev1.EventTypeWarning, SecurityGroupEventReasonPermissionDrift, message)
+	} else if r.reportConfigMapKey != nil {
+		r.eventRecorder.Event(r.reportConfigMapReference(), corev1.EventTypeWarning, SecurityGroupEventReasonPermissionDrift, message)
+	}
+	return r.writeReportConfigMap(ctx)
+}
+
+// writeReportConfigMap writes the drift report of all SecurityGroups into the report ConfigMap if configured.
+func (r *defaultSecurityGroupDriftReporter) writeReportConfigMap(ctx context.Context) error {
+	if r.reportConfigMapKey == nil {
+		return nil
+	}
+	cmKey := *r.reportConfigMapKey
+	data := make(map[string]string, len(r.reportBySGID))
+	for sgID, report := range r.reportBySGID {
+		data[sgID] = report
+	}
+	cm := &corev1.ConfigMap{}
+	if err := r.k8sClient.Get(ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cmKey.Namespace,
+				Name:      cmKey.Name,
+			},
+			Data: data,
+		}
+		if err := r.k8sClient.Create(ctx, cm); err != nil {
+			return errors.Wrapf(err, "failed to create securityGroup drift report configMap: %v", cmKey)
+		}
+		return nil
+	}
+
+	dataToUpdate, dataToRemove := algorithm.DiffStringMap(data, cm.Data)
+	if len(dataToUpdate) == 0 && len(dataToRemove) == 0 {
+		return nil
+	}
+	oldCM := cm.DeepCopy()
+	cm.Data = data
+	if err := r.k8sClient.Patch(ctx, cm, client.MergeFrom(oldCM)); err != nil {
+		return errors.Wrapf(err, "failed to update securityGroup drift report configMap: %v", cmKey)
+	}
+	return nil
+}
+
+func (r *defaultSecurityGroupDriftReporter) reportConfigMapReference() *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  r.reportConfigMapKey.Namespace,
+		Name:       r.reportConfigMapKey.Name,
+	}
+}
+
+func renderPermissions(permissions []IPPermissionInfo) []string {
+	if len(permissions) == 0 {
+		return nil
+	}
+	rendered := make([]string, 0, len(permissions))
+	for i := range permissions {
+		rendered = append(rendered, permissions[i].HashCode())
+	}
+	return rendered
+}
@@ -0,0 +1,151 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultSecurityGroupDriftReporter_ReportDrift(t *testing.T) {
+	missingPermission := NewCIDRIPPermission("tcp", awssdk.Int32(80), awssdk.Int32(80), "10.0.0.0/16", nil)
+	extraPermission := NewCIDRIPPermission("tcp", awssdk.Int32(443), awssdk.Int32(443), "0.0.0.0/0", nil)
+	eventObject := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-pod",
+		},
+	}
+	type reportCall struct {
+		drift       SecurityGroupDrift
+		eventObject runtime.Object
+	}
+	tests := []struct {
+		name             string
+		withConfigMap    bool
+		reportCalls      []reportCall
+		wantEvents       []string
+		wantMissingGauge float64
+		wantExtraGauge   float64
+		wantCMData       map[string]string
+	}{
+		{
+			name: "drift is recorded on event object",
+			reportCalls: []reportCall{
+				{
+					drift: SecurityGroupDrift{
+						SecurityGroupID:    "sg-a",
+						MissingPermissions: []IPPermissionInfo{missingPermission},
+						ExtraPermissions:   []IPPermissionInfo{extraPermission},
+					},
+					eventObject: eventObject,
+				},
+			},
+			wantEvents:       []string{"Warning SecurityGroupPermissionDrift SecurityGroup sg-a drifts with 1 missing and 1 extra permissions"},
+			wantMissingGauge: 1,
+			wantExtraGauge:   1,
+		},
+		{
+			name: "unchanged drift is reported once",
+			reportCalls: []reportCall{
+				{
+					drift: SecurityGroupDrift{
+						SecurityGroupID:    "sg-a",
+						MissingPermissions: []IPPermissionInfo{missingPermission},
+					},
+					eventObject: eventObject,
+				},
+				{
+					drift: SecurityGroupDrift{
+						SecurityGroupID:    "sg-a",
+						MissingPermissions: []IPPermissionInfo{missingPermission},
+					},
+					eventObject: eventObject,
+				},
+			},
+			wantEvents:       []string{"Warning SecurityGroupPermissionDrift SecurityGroup sg-a drifts with 1 missing and 0 extra permissions"},
+			wantMissingGauge: 1,
+		},
+		{
+			name:          "drift is written into report configMap",
+			withConfigMap: true,
+			reportCalls: []reportCall{
+				{
+					drift: SecurityGroupDrift{
+						SecurityGroupID:  "sg-a",
+						ExtraPermissions: []IPPermissionInfo{extraPermission},
+					},
+				},
+			},
+			wantEvents:     []string{"Warning SecurityGroupPermissionDrift SecurityGroup sg-a drifts with 0 missing and 1 extra permissions"},
+			wantExtraGauge: 1,
+			wantCMData: map[string]string{
+				"sg-a": `{"extraPermissions":["` + extraPermission.HashCode() + `"]}`,
+			},
+		},
+		{
+			name:          "cleared drift is removed from report configMap",
+			withConfigMap: true,
+			reportCalls: []reportCall{
+				{
+					drift: SecurityGroupDrift{
+						SecurityGroupID:  "sg-a",
+						ExtraPermissions: []IPPermissionInfo{extraPermission},
+					},
+				},
+				{
+					drift: SecurityGroupDrift{
+						SecurityGroupID: "sg-a",
+					},
+				},
+			},
+			wantEvents: []string{"Warning SecurityGroupPermissionDrift SecurityGroup sg-a drifts with 0 missing and 1 extra permissions"},
+			wantCMData: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			eventRecorder := record.NewFakeRecorder(10)
+			var cmKey *types.NamespacedName
+			if tt.withConfigMap {
+				cmKey = &types.NamespacedName{Namespace: "kube-system", Name: "sg-drift-report"}
+			}
+			registry := prometheus.NewRegistry()
+			r, err := NewDefaultSecurityGroupDriftReporter(k8sClient, eventRecorder, cmKey, registry, logr.New(&log.NullLogSink{}))
+			assert.NoError(t, err)
+
+			ctx := context.Background()
+			for _, call := range tt.reportCalls {
+				assert.NoError(t, r.ReportDrift(ctx, call.drift, call.eventObject))
+			}
+
+			var gotEvents []string
+			for len(eventRecorder.Events) > 0 {
+				gotEvents = append(gotEvents, <-eventRecorder.Events)
+			}
+			assert.Equal(t, tt.wantEvents, gotEvents)
+			assert.Equal(t, tt.wantMissingGauge, testutil.ToFloat64(r.driftPermissions.WithLabelValues("sg-a", driftMissing)))
+			assert.Equal(t, tt.wantExtraGauge, testutil.ToFloat64(r.driftPermissions.WithLabelValues("sg-a", driftExtra)))
+			if cmKey != nil {
+				cm := &corev1.ConfigMap{}
+				assert.NoError(t, k8sClient.Get(ctx, *cmKey, cm))
+				assert.Equal(t, tt.wantCMData, cm.Data)
+			}
+		})
+	}
+}
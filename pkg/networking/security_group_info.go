@@ -3,8 +3,9 @@ package networking
 import (
 	"encoding/json"
 	"fmt"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"regexp"
 	"strings"
@@ -29,7 +30,7 @@ type SecurityGroupInfo struct {
 
 type IPPermissionInfo struct {
 	// the aws sdk permission
-	Permission ec2sdk.IpPermission
+	Permission ec2types.IpPermission
 
 	// a set of computed labels for IPPermission.
 	// we can use labels to select the rules we want to manage.
@@ -39,24 +40,24 @@ type IPPermissionInfo struct {
 // HashCode returns the hashcode for the IPPermissionInfo.
 // The hashCode should only include the actual permission but not labels/descriptions.
 func (perm *IPPermissionInfo) HashCode() string {
-	protocol := awssdk.StringValue(perm.Permission.IpProtocol)
-	fromPort := awssdk.Int64Value(perm.Permission.FromPort)
-	toPort := awssdk.Int64Value(perm.Permission.ToPort)
+	protocol := awssdk.ToString(perm.Permission.IpProtocol)
+	fromPort := awssdk.ToInt32(perm.Permission.FromPort)
+	toPort := awssdk.ToInt32(perm.Permission.ToPort)
 	base := fmt.Sprintf("IpProtocol: %v, FromPort: %v, ToPort: %v", protocol, fromPort, toPort)
 	if len(perm.Permission.IpRanges) == 1 {
-		cidrIP := awssdk.StringValue(perm.Permission.IpRanges[0].CidrIp)
+		cidrIP := awssdk.ToString(perm.Permission.IpRanges[0].CidrIp)
 		return fmt.Sprintf("%v, IpRange: %v", base, cidrIP)
 	}
 	if len(perm.Permission.Ipv6Ranges) == 1 {
-		cidrIPv6 := awssdk.StringValue(perm.Permission.Ipv6Ranges[0].CidrIpv6)
+		cidrIPv6 := awssdk.ToString(perm.Permission.Ipv6Ranges[0].CidrIpv6)
 		return fmt.Sprintf("%v, Ipv6Range: %v", base, cidrIPv6)
 	}
 	if len(perm.Permission.PrefixListIds) == 1 {
-		prefixListID := awssdk.StringValue(perm.Permission.PrefixListIds[0].PrefixListId)
+		prefixListID := awssdk.ToString(perm.Permission.PrefixListIds[0].PrefixListId)
 		return fmt.Sprintf("%v, PrefixListId: %v", base, prefixListID)
 	}
 	if len(perm.Permission.UserIdGroupPairs) == 1 {
-		groupID := awssdk.StringValue(perm.Permission.UserIdGroupPairs[0].GroupId)
+		groupID := awssdk.ToString(perm.Permission.UserIdGroupPairs[0].GroupId)
 		return fmt.Sprintf("%v, UserIdGroupPair: %v", base, groupID)
 	}
 
@@ -66,11 +67,11 @@ func (perm *IPPermissionInfo) HashCode() string {
 }
 
 // NewRawSecurityGroupInfo constructs new SecurityGroupInfo with raw ec2SDK's SecurityGroup object.
-func NewRawSecurityGroupInfo(sdkSG *ec2sdk.SecurityGroup) SecurityGroupInfo {
-	sgID := awssdk.StringValue(sdkSG.GroupId)
+func NewRawSecurityGroupInfo(sdkSG *ec2types.SecurityGroup) SecurityGroupInfo {
+	sgID := awssdk.ToString(sdkSG.GroupId)
 	var ingress []IPPermissionInfo
 	for _, sdkPermission := range sdkSG.IpPermissions {
-		for _, expandedPermission := range expandSDKIPPermission(*sdkPermission) {
+		for _, expandedPermission := range expandSDKIPPermission(sdkPermission) {
 			ingress = append(ingress, NewRawIPPermission(expandedPermission))
 		}
 	}
@@ -83,14 +84,14 @@ func NewRawSecurityGroupInfo(sdkSG *ec2sdk.SecurityGroup) SecurityGroupInfo {
 }
 
 // NewCIDRIPPermission constructs new IPPermissionInfo with CIDR configuration.
-func NewCIDRIPPermission(ipProtocol string, fromPort *int64, toPort *int64, cidr string, labels map[string]string) IPPermissionInfo {
+func NewCIDRIPPermission(ipProtocol string, fromPort *int32, toPort *int32, cidr string, labels map[string]string) IPPermissionInfo {
 	description := buildIPPermissionDescriptionForLabels(labels)
 	return IPPermissionInfo{
-		Permission: ec2sdk.IpPermission{
+		Permission: ec2types.IpPermission{
 			IpProtocol: awssdk.String(ipProtocol),
 			FromPort:   fromPort,
 			ToPort:     toPort,
-			IpRanges: []*ec2sdk.IpRange{
+			IpRanges: []ec2types.IpRange{
 				{
 					CidrIp:      awssdk.String(cidr),
 					Description: awssdk.String(description),
@@ -102,14 +103,14 @@ func NewCIDRIPPermission(ipProtocol string, fromPort *int64, toPort *int64, cidr
 }
 
 // NewCIDRv6IPPermission constructs new IPPermissionInfo with CIDRv6 configuration.
-func NewCIDRv6IPPermission(ipProtocol string, fromPort *int64, toPort *int64, cidrV6 string, labels map[string]string) IPPermissionInfo {
+func NewCIDRv6IPPermission(ipProtocol string, fromPort *int32, toPort *int32, cidrV6 string, labels map[string]string) IPPermissionInfo {
 	description := buildIPPermissionDescriptionForLabels(labels)
 	return IPPermissionInfo{
-		Permission: ec2sdk.IpPermission{
+		Permission: ec2types.IpPermission{
 			IpProtocol: awssdk.String(ipProtocol),
 			FromPort:   fromPort,
 			ToPort:     toPort,
-			Ipv6Ranges: []*ec2sdk.Ipv6Range{
+			Ipv6Ranges: []ec2types.Ipv6Range{
 				{
 					CidrIpv6:    awssdk.String(cidrV6),
 					Description: awssdk.String(description),
@@ -121,14 +122,14 @@ func NewCIDRv6IPPermission(ipProtocol string, fromPort *int64, toPort *int64, ci
 }
 
 // NewCIDRv6IPPermission constructs new IPPermissionInfo with groupID configuration.
-func NewGroupIDIPPermission(ipProtocol string, fromPort *int64, toPort *int64, groupID string, labels map[string]string) IPPermissionInfo {
+func NewGroupIDIPPermission(ipProtocol string, fromPort *int32, toPort *int32, groupID string, labels map[string]string) IPPermissionInfo {
 	description := buildIPPermissionDescriptionForLabels(labels)
 	return IPPermissionInfo{
-		Permission: ec2sdk.IpPermission{
+		Permission: ec2types.IpPermission{
 			IpProtocol: awssdk.String(ipProtocol),
 			FromPort:   fromPort,
 			ToPort:     toPort,
-			UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+			UserIdGroupPairs: []ec2types.UserIdGroupPair{
 				{
 					GroupId:     awssdk.String(groupID),
 					Description: awssdk.String(description),
@@ -140,14 +141,14 @@ func NewGroupIDIPPermission(ipProtocol string, fromPort *int64, toPort *int64, g
 }
 
 // NewPrefixListIDPermission constructs new IPPermissionInfo with prefixListID configuration
-func NewPrefixListIDPermission(ipProtocol string, fromPort *int64, toPort *int64, prefixListID string, labels map[string]string) IPPermissionInfo {
+func NewPrefixListIDPermission(ipProtocol string, fromPort *int32, toPort *int32, prefixListID string, labels map[string]string) IPPermissionInfo {
 	description := buildIPPermissionDescriptionForLabels(labels)
 	return IPPermissionInfo{
-		Permission: ec2sdk.IpPermission{
+		Permission: ec2types.IpPermission{
 			IpProtocol: awssdk.String(ipProtocol),
 			FromPort:   fromPort,
 			ToPort:     toPort,
-			PrefixListIds: []*ec2sdk.PrefixListId{
+			PrefixListIds: []ec2types.PrefixListId{
 				{
 					PrefixListId: awssdk.String(prefixListID),
 					Description:  awssdk.String(description),
@@ -160,29 +161,29 @@ func NewPrefixListIDPermission(ipProtocol string, fromPort *int64, toPort *int64
 
 // NewRawIPPermission constructs new IPPermissionInfo with raw ec2SDK's IpPermission object.
 // Note: this IpPermission should be expanded(i.e. only contains one source configuration)
-func NewRawIPPermission(sdkPermission ec2sdk.IpPermission) IPPermissionInfo {
+func NewRawIPPermission(sdkPermission ec2types.IpPermission) IPPermissionInfo {
 	if len(sdkPermission.IpRanges) == 1 {
 		return IPPermissionInfo{
 			Permission: sdkPermission,
-			Labels:     buildIPPermissionLabelsForDescription(awssdk.StringValue(sdkPermission.IpRanges[0].Description)),
+			Labels:     buildIPPermissionLabelsForDescription(awssdk.ToString(sdkPermission.IpRanges[0].Description)),
 		}
 	}
 	if len(sdkPermission.Ipv6Ranges) == 1 {
 		return IPPermissionInfo{
 			Permission: sdkPermission,
-			Labels:     buildIPPermissionLabelsForDescription(awssdk.StringValue(sdkPermission.Ipv6Ranges[0].Description)),
+			Labels:     buildIPPermissionLabelsForDescription(awssdk.ToString(sdkPermission.Ipv6Ranges[0].Description)),
 		}
 	}
 	if len(sdkPermission.PrefixListIds) == 1 {
 		return IPPermissionInfo{
 			Permission: sdkPermission,
-			Labels:     buildIPPermissionLabelsForDescription(awssdk.StringValue(sdkPermission.PrefixListIds[0].Description)),
+			Labels:     buildIPPermissionLabelsForDescription(awssdk.ToString(sdkPermission.PrefixListIds[0].Description)),
 		}
 	}
 	if len(sdkPermission.UserIdGroupPairs) == 1 {
 		return IPPermissionInfo{
 			Permission: sdkPermission,
-			Labels:     buildIPPermissionLabelsForDescription(awssdk.StringValue(sdkPermission.UserIdGroupPairs[0].Description)),
+			Labels:     buildIPPermissionLabelsForDescription(awssdk.ToString(sdkPermission.UserIdGroupPairs[0].Description)),
 		}
 	}
 	return IPPermissionInfo{
@@ -197,19 +198,19 @@ func NewIPPermissionLabelsForRawDescription(description string) map[string]strin
 }
 
 // buildSecurityGroupTags generates the tags for securityGroup.
-func buildSecurityGroupTags(sdkSG *ec2sdk.SecurityGroup) map[string]string {
+func buildSecurityGroupTags(sdkSG *ec2types.SecurityGroup) map[string]string {
 	sgTags := make(map[string]string, len(sdkSG.Tags))
 	for _, tag := range sdkSG.Tags {
-		sgTags[awssdk.StringValue(tag.Key)] = awssdk.StringValue(tag.Value)
+		sgTags[awssdk.ToString(tag.Key)] = awssdk.ToString(tag.Value)
 	}
 	return sgTags
 }
 
 // expandSDKIPPermission will expand the IPPermission so that each permission only contain single entry.
 // EC2 api automatically group IPPermissions, so we need to expand first before further processing.
-func expandSDKIPPermission(sdkPermission ec2sdk.IpPermission) []ec2sdk.IpPermission {
-	var expandedPermissions []ec2sdk.IpPermission
-	base := ec2sdk.IpPermission{
+func expandSDKIPPermission(sdkPermission ec2types.IpPermission) []ec2types.IpPermission {
+	var expandedPermissions []ec2types.IpPermission
+	base := ec2types.IpPermission{
 		FromPort:   sdkPermission.FromPort,
 		ToPort:     sdkPermission.ToPort,
 		IpProtocol: sdkPermission.IpProtocol,
@@ -217,25 +218,25 @@ func expandSDKIPPermission(sdkPermission ec2sdk.IpPermission) []ec2sdk.IpPermiss
 
 	for _, ipRange := range sdkPermission.IpRanges {
 		perm := base
-		perm.IpRanges = []*ec2sdk.IpRange{ipRange}
+		perm.IpRanges = []ec2types.IpRange{ipRange}
 		expandedPermissions = append(expandedPermissions, perm)
 	}
 
 	for _, ipRange := range sdkPermission.Ipv6Ranges {
 		perm := base
-		perm.Ipv6Ranges = []*ec2sdk.Ipv6Range{ipRange}
+		perm.Ipv6Ranges = []ec2types.Ipv6Range{ipRange}
 		expandedPermissions = append(expandedPermissions, perm)
 	}
 
 	for _, prefixListID := range sdkPermission.PrefixListIds {
 		perm := base
-		perm.PrefixListIds = []*ec2sdk.PrefixListId{prefixListID}
+		perm.PrefixListIds = []ec2types.PrefixListId{prefixListID}
 		expandedPermissions = append(expandedPermissions, perm)
 	}
 
 	for _, ug := range sdkPermission.UserIdGroupPairs {
 		perm := base
-		perm.UserIdGroupPairs = []*ec2sdk.UserIdGroupPair{ug}
+		perm.UserIdGroupPairs = []ec2types.UserIdGroupPair{ug}
 		expandedPermissions = append(expandedPermissions, perm)
 	}
 
@@ -2,13 +2,17 @@ package networking
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	"fmt"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
 	"sync"
 	"time"
 )
@@ -97,7 +101,7 @@ func (m *defaultSecurityGroupManager) FetchSGInfosByID(ctx context.Context, sgID
 	unFetchedSGIDs := sgIDsSet.Difference(fetchedSGIDsSet).List()
 	if len(unFetchedSGIDs) > 0 {
 		req := &ec2sdk.DescribeSecurityGroupsInput{
-			GroupIds: awssdk.StringSlice(unFetchedSGIDs),
+			GroupIds: unFetchedSGIDs,
 		}
 		sgInfoByIDFromAWS, err := m.fetchSGInfosFromAWS(ctx, req)
 		if err != nil {
@@ -139,6 +143,8 @@ func (m *defaultSecurityGroupManager) AuthorizeSGIngress(ctx context.Context, sg
 	}
 	m.logger.Info("authorized securityGroup ingress",
 		"securityGroupID", sgID)
+	audit.RecordMutation(ctx, audit.ActionModify, "securityGroup", sgID,
+		fmt.Sprintf("authorized ingress %v", renderPermissions(permissions)))
 
 	// TODO: ideally we can remember the permissions we granted to save DescribeSecurityGroup API calls.
 	m.clearSGInfosFromCache(sgID)
@@ -159,6 +165,8 @@ func (m *defaultSecurityGroupManager) RevokeSGIngress(ctx context.Context, sgID
 	}
 	m.logger.Info("revoked securityGroup ingress",
 		"securityGroupID", sgID)
+	audit.RecordMutation(ctx, audit.ActionModify, "securityGroup", sgID,
+		fmt.Sprintf("revoked ingress %v", renderPermissions(permissions)))
 
 	// TODO: ideally we can remember the permissions we revoked to save DescribeSecurityGroup API calls.
 	m.clearSGInfosFromCache(sgID)
@@ -199,23 +207,23 @@ func (m *defaultSecurityGroupManager) fetchSGInfosFromAWS(ctx context.Context, r
 		return nil, err
 	}
 	sgInfoByID := make(map[string]SecurityGroupInfo, len(sgs))
-	for _, sg := range sgs {
-		sgID := awssdk.StringValue(sg.GroupId)
-		sgInfo := NewRawSecurityGroupInfo(sg)
+	for i := range sgs {
+		sgID := awssdk.ToString(sgs[i].GroupId)
+		sgInfo := NewRawSecurityGroupInfo(&sgs[i])
 		sgInfoByID[sgID] = sgInfo
 	}
 	return sgInfoByID, nil
 }
 
-// buildSDKIPPermissions converts slice of IPPermissionInfo into slice of pointers to IPPermission
+// buildSDKIPPermissions converts slice of IPPermissionInfo into slice of IPPermission
 // if targets is empty or nil, nil will be returned.
-func buildSDKIPPermissions(permissions []IPPermissionInfo) []*ec2sdk.IpPermission {
+func buildSDKIPPermissions(permissions []IPPermissionInfo) []ec2types.IpPermission {
 	if len(permissions) == 0 {
 		return nil
 	}
-	sdkPermissions := make([]*ec2sdk.IpPermission, 0, len(permissions))
-	for i := range permissions {
-		sdkPermissions = append(sdkPermissions, &permissions[i].Permission)
+	sdkPermissions := make([]ec2types.IpPermission, 0, len(permissions))
+	for _, permission := range permissions {
+		sdkPermissions = append(sdkPermissions, permission.Permission)
 	}
 	return sdkPermissions
 }
@@ -2,10 +2,11 @@ package networking
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -19,6 +20,10 @@ type SecurityGroupReconcileOptions struct {
 	// Whether only Authorize permissions.
 	// By default, it grants and revoke permission.
 	AuthorizeOnly bool
+
+	// DriftEventObject is the object to record drift events on when drift is reported instead of remediated.
+	// By default, drift events are not recorded on any reconciled object.
+	DriftEventObject runtime.Object
 }
 
 // Apply SecurityGroupReconcileOption options
@@ -44,6 +49,13 @@ func WithAuthorizeOnly(authorizeOnly bool) SecurityGroupReconcileOption {
 	}
 }
 
+// WithDriftEventObject is a option that sets the DriftEventObject.
+func WithDriftEventObject(obj runtime.Object) SecurityGroupReconcileOption {
+	return func(opts *SecurityGroupReconcileOptions) {
+		opts.DriftEventObject = obj
+	}
+}
+
 // SecurityGroupReconciler manages securityGroup rules on securityGroup.
 type SecurityGroupReconciler interface {
 	// ReconcileIngress will reconcile Ingress permission on SecurityGroup to be desiredPermission.
@@ -51,10 +63,12 @@ type SecurityGroupReconciler interface {
 }
 
 // NewDefaultSecurityGroupReconciler constructs new defaultSecurityGroupReconciler.
-func NewDefaultSecurityGroupReconciler(sgManager SecurityGroupManager, logger logr.Logger) *defaultSecurityGroupReconciler {
+// driftReporter is optional, when specified, permission drift is reported instead of remediated.
+func NewDefaultSecurityGroupReconciler(sgManager SecurityGroupManager, driftReporter SecurityGroupDriftReporter, logger logr.Logger) *defaultSecurityGroupReconciler {
 	return &defaultSecurityGroupReconciler{
-		sgManager: sgManager,
-		logger:    logger,
+		sgManager:     sgManager,
+		driftReporter: driftReporter,
+		logger:        logger,
 	}
 }
 
@@ -62,8 +76,9 @@ var _ SecurityGroupReconciler = &defaultSecurityGroupReconciler{}
 
 // default implementation for SecurityGroupReconciler.
 type defaultSecurityGroupReconciler struct {
-	sgManager SecurityGroupManager
-	logger    logr.Logger
+	sgManager     SecurityGroupManager
+	driftReporter SecurityGroupDriftReporter
+	logger        logr.Logger
 }
 
 func (r *defaultSecurityGroupReconciler) ReconcileIngress(ctx context.Context, sgID string, desiredPermissions []IPPermissionInfo, opts ...SecurityGroupReconcileOption) error {
@@ -102,6 +117,16 @@ func (r *defaultSecurityGroupReconciler) reconcileIngressWithSGInfo(ctx context.
 		}
 	}
 	permissionsToGrant := diffIPPermissionInfos(desiredPermissions, sgInfo.Ingress)
+	if r.driftReporter != nil {
+		drift := SecurityGroupDrift{
+			SecurityGroupID:    sgInfo.SecurityGroupID,
+			MissingPermissions: permissionsToGrant,
+		}
+		if !reconcileOpts.AuthorizeOnly {
+			drift.ExtraPermissions = permissionsToRevoke
+		}
+		return r.driftReporter.ReportDrift(ctx, drift, reconcileOpts.DriftEventObject)
+	}
 	if len(permissionsToRevoke) > 0 && !reconcileOpts.AuthorizeOnly {
 		if err := r.sgManager.RevokeSGIngress(ctx, sgInfo.SecurityGroupID, permissionsToRevoke); err != nil {
 			return err
@@ -117,9 +142,9 @@ func (r *defaultSecurityGroupReconciler) reconcileIngressWithSGInfo(ctx context.
 
 // shouldRetryWithoutCache tests whether we should retry SecurityGroup rules reconcile without cache.
 func (r *defaultSecurityGroupReconciler) shouldRetryWithoutCache(err error) bool {
-	var awsErr awserr.Error
-	if errors.As(err, &awsErr) {
-		return awsErr.Code() == "InvalidPermission.Duplicate" || awsErr.Code() == "InvalidPermission.NotFound"
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "InvalidPermission.Duplicate" || apiErr.ErrorCode() == "InvalidPermission.NotFound"
 	}
 	return false
 }
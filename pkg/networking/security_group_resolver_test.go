@@ -4,9 +4,10 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -16,7 +17,7 @@ import (
 func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 	type describeSecurityGroupsAsListCall struct {
 		req  *ec2sdk.DescribeSecurityGroupsInput
-		resp []*ec2sdk.SecurityGroup
+		resp []ec2types.SecurityGroup
 		err  error
 	}
 	type args struct {
@@ -43,9 +44,9 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 				describeSGCalls: []describeSecurityGroupsAsListCall{
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							GroupIds: awssdk.StringSlice([]string{"sg-xx1", "sg-xx2"}),
+							GroupIds: []string{"sg-xx1", "sg-xx2"},
 						},
-						resp: []*ec2sdk.SecurityGroup{
+						resp: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-xx1"),
 							},
@@ -71,21 +72,21 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 				describeSGCalls: []describeSecurityGroupsAsListCall{
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name: awssdk.String("tag:Name"),
-									Values: awssdk.StringSlice([]string{
+									Values: []string{
 										"sg group one",
 										"sg group two",
-									}),
+									},
 								},
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{defaultVPCID}),
+									Values: []string{defaultVPCID},
 								},
 							},
 						},
-						resp: []*ec2sdk.SecurityGroup{
+						resp: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-0912f63b"),
 							},
@@ -111,20 +112,20 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 				describeSGCalls: []describeSecurityGroupsAsListCall{
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name: awssdk.String("tag:Name"),
-									Values: awssdk.StringSlice([]string{
+									Values: []string{
 										"sg group one",
-									}),
+									},
 								},
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{defaultVPCID}),
+									Values: []string{defaultVPCID},
 								},
 							},
 						},
-						resp: []*ec2sdk.SecurityGroup{
+						resp: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-0912f63b"),
 							},
@@ -132,9 +133,9 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 					},
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							GroupIds: awssdk.StringSlice([]string{"sg-id1"}),
+							GroupIds: []string{"sg-id1"},
 						},
-						resp: []*ec2sdk.SecurityGroup{
+						resp: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-id1"),
 							},
@@ -157,13 +158,13 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 				describeSGCalls: []describeSecurityGroupsAsListCall{
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							GroupIds: awssdk.StringSlice([]string{"sg-id"}),
+							GroupIds: []string{"sg-id"},
 						},
-						err: awserr.New("Describe.Error", "unable to describe security groups", nil),
+						err: &smithy.GenericAPIError{Code: "Describe.Error", Message: "unable to describe security groups"},
 					},
 				},
 			},
-			wantErr: errors.New("Describe.Error: unable to describe security groups"),
+			wantErr: errors.New("api error Describe.Error: unable to describe security groups"),
 		},
 		{
 			name: "describe by name returns error",
@@ -175,26 +176,26 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 				describeSGCalls: []describeSecurityGroupsAsListCall{
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name: awssdk.String("tag:Name"),
-									Values: awssdk.StringSlice([]string{
+									Values: []string{
 										"sg group name",
-									}),
+									},
 								},
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{defaultVPCID}),
+									Values: []string{defaultVPCID},
 								},
 							},
 						},
-						err: awserr.New("Describe.Error", "unable to describe security groups", nil),
+						err: &smithy.GenericAPIError{Code: "Describe.Error", Message: "unable to describe security groups"},
 					},
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							GroupIds: awssdk.StringSlice([]string{"sg-id"}),
+							GroupIds: []string{"sg-id"},
 						},
-						resp: []*ec2sdk.SecurityGroup{
+						resp: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-id"),
 							},
@@ -202,7 +203,7 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 					},
 				},
 			},
-			wantErr: errors.New("Describe.Error: unable to describe security groups"),
+			wantErr: errors.New("api error Describe.Error: unable to describe security groups"),
 		},
 		{
 			name: "unable to resolve all security groups",
@@ -215,20 +216,20 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 				describeSGCalls: []describeSecurityGroupsAsListCall{
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name: awssdk.String("tag:Name"),
-									Values: awssdk.StringSlice([]string{
+									Values: []string{
 										"sg group one",
-									}),
+									},
 								},
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{defaultVPCID}),
+									Values: []string{defaultVPCID},
 								},
 							},
 						},
-						resp: []*ec2sdk.SecurityGroup{
+						resp: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-0912f63b"),
 							},
@@ -236,9 +237,9 @@ func Test_defaultSecurityGroupResolver_ResolveViaNameOrID(t *testing.T) {
 					},
 					{
 						req: &ec2sdk.DescribeSecurityGroupsInput{
-							GroupIds: awssdk.StringSlice([]string{"sg-id1", "sg-id404"}),
+							GroupIds: []string{"sg-id1", "sg-id404"},
 						},
-						resp: []*ec2sdk.SecurityGroup{
+						resp: []ec2types.SecurityGroup{
 							{
 								GroupId: awssdk.String("sg-id1"),
 							},
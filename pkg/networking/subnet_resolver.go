@@ -6,8 +6,9 @@ import (
 	"sort"
 	"strings"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -36,6 +37,11 @@ const (
 	zoneTypeWavelengthZone   string = "wavelength-zone"
 )
 
+const (
+	wavelengthDocURL     = "https://docs.aws.amazon.com/wavelength/latest/developerguide/what-is-wavelength.html"
+	carrierGatewayDocURL = "https://docs.aws.amazon.com/vpc/latest/userguide/Carrier_Gateway.html"
+)
+
 // options for resolve subnets.
 type SubnetsResolveOptions struct {
 	// The Load Balancer Type.
@@ -50,6 +56,8 @@ type SubnetsResolveOptions struct {
 	SubnetsClusterTagCheck bool
 	// whether to allow using only 1 subnet for provisioning ALB, default to false
 	ALBSingleSubnet bool
+	// refines the auto-discovered subnets, its fields override the ones of the resolver's discovery selector
+	DiscoverySelector *elbv2api.SubnetDiscoverySelector
 }
 
 // ApplyOptions applies slice of SubnetsResolveOption.
@@ -104,32 +112,45 @@ func WithALBSingleSubnet(ALBSingleSubnet bool) SubnetsResolveOption {
 	}
 }
 
+// WithSubnetsDiscoverySelector generates an option that configures DiscoverySelector.
+func WithSubnetsDiscoverySelector(discoverySelector *elbv2api.SubnetDiscoverySelector) SubnetsResolveOption {
+	return func(opts *SubnetsResolveOptions) {
+		opts.DiscoverySelector = discoverySelector
+	}
+}
+
 // SubnetsResolver is responsible for resolve EC2 Subnets for Load Balancers.
 type SubnetsResolver interface {
 	// ResolveViaDiscovery resolve subnets by auto discover matching subnets.
-	// Discovery candidate includes all subnets within the clusterVPC. Additionally,
+	// Discovery candidate includes all subnets within the clusterVPC selected by the SubnetsDiscoveryStrategy. By default,
 	//   * for internet-facing Load Balancer, "kubernetes.io/role/elb" tag must be present.
 	//   * for internal Load Balancer, "kubernetes.io/role/internal-elb" tag must be present.
 	//   * if SubnetsClusterTagCheck is enabled, subnets within the clusterVPC must contain no cluster tag at all
 	//     or contain the "kubernetes.io/cluster/<cluster_name>" tag for the current cluster
+	// The discovery selector further requires additional tags, restricts the zones and the minimum count of free IP addresses.
 	// If multiple subnets are found for specific AZ, one subnet is chosen based on the lexical order of subnetID.
-	ResolveViaDiscovery(ctx context.Context, opts ...SubnetsResolveOption) ([]*ec2sdk.Subnet, error)
+	ResolveViaDiscovery(ctx context.Context, opts ...SubnetsResolveOption) ([]ec2types.Subnet, error)
 
 	// ResolveViaSelector resolves subnets using a SubnetSelector.
-	ResolveViaSelector(ctx context.Context, selector *elbv2api.SubnetSelector, opts ...SubnetsResolveOption) ([]*ec2sdk.Subnet, error)
+	ResolveViaSelector(ctx context.Context, selector *elbv2api.SubnetSelector, opts ...SubnetsResolveOption) ([]ec2types.Subnet, error)
 
 	// ResolveViaNameOrIDSlice resolve subnets using subnet name or ID.
-	ResolveViaNameOrIDSlice(ctx context.Context, subnetNameOrIDs []string, opts ...SubnetsResolveOption) ([]*ec2sdk.Subnet, error)
+	ResolveViaNameOrIDSlice(ctx context.Context, subnetNameOrIDs []string, opts ...SubnetsResolveOption) ([]ec2types.Subnet, error)
 }
 
 // NewDefaultSubnetsResolver constructs new defaultSubnetsResolver.
-func NewDefaultSubnetsResolver(azInfoProvider AZInfoProvider, ec2Client services.EC2, vpcID string, clusterName string, logger logr.Logger) *defaultSubnetsResolver {
+func NewDefaultSubnetsResolver(azInfoProvider AZInfoProvider, ec2Client services.EC2, vpcID string, clusterName string,
+	discoveryStrategy SubnetsDiscoveryStrategy, discoverySelector elbv2api.SubnetDiscoverySelector, allowedZones []string,
+	logger logr.Logger) *defaultSubnetsResolver {
 	return &defaultSubnetsResolver{
-		azInfoProvider: azInfoProvider,
-		ec2Client:      ec2Client,
-		vpcID:          vpcID,
-		clusterName:    clusterName,
-		logger:         logger,
+		azInfoProvider:    azInfoProvider,
+		ec2Client:         ec2Client,
+		vpcID:             vpcID,
+		clusterName:       clusterName,
+		discoveryStrategy: discoveryStrategy,
+		discoverySelector: discoverySelector,
+		allowedZones:      allowedZones,
+		logger:            logger,
 	}
 }
 
@@ -137,46 +158,55 @@ var _ SubnetsResolver = &defaultSubnetsResolver{}
 
 // default implementation for SubnetsResolver.
 type defaultSubnetsResolver struct {
-	azInfoProvider AZInfoProvider
-	ec2Client      services.EC2
-	vpcID          string
-	clusterName    string
-	logger         logr.Logger
+	azInfoProvider    AZInfoProvider
+	ec2Client         services.EC2
+	vpcID             string
+	clusterName       string
+	discoveryStrategy SubnetsDiscoveryStrategy
+	// the default discovery selector, configured via controller flags
+	discoverySelector elbv2api.SubnetDiscoverySelector
+	// the names or IDs of the only zones subnets can be chosen in, all zones are allowed if empty
+	allowedZones []string
+	logger       logr.Logger
 }
 
-func (r *defaultSubnetsResolver) ResolveViaDiscovery(ctx context.Context, opts ...SubnetsResolveOption) ([]*ec2sdk.Subnet, error) {
+func (r *defaultSubnetsResolver) ResolveViaDiscovery(ctx context.Context, opts ...SubnetsResolveOption) ([]ec2types.Subnet, error) {
 	resolveOpts := defaultSubnetsResolveOptions()
 	resolveOpts.ApplyOptions(opts)
 
-	subnetRoleTagKey := ""
-	switch resolveOpts.LBScheme {
-	case elbv2model.LoadBalancerSchemeInternal:
-		subnetRoleTagKey = TagKeySubnetInternalELB
-	case elbv2model.LoadBalancerSchemeInternetFacing:
-		subnetRoleTagKey = TagKeySubnetPublicELB
+	selector, err := r.discoveryStrategy.BuildSubnetSelector(ctx, resolveOpts)
+	if err != nil {
+		return nil, err
 	}
-
-	return r.ResolveViaSelector(ctx, &elbv2api.SubnetSelector{
-		Tags: map[string][]string{
-			subnetRoleTagKey: {"", "1"},
-		},
-	}, opts...)
+	discoverySelector := r.buildDiscoverySelector(resolveOpts.DiscoverySelector)
+	if selector.IDs == nil && len(discoverySelector.Tags) != 0 {
+		selector = selector.DeepCopy()
+		if selector.Tags == nil {
+			selector.Tags = make(map[string][]string, len(discoverySelector.Tags))
+		}
+		for key, values := range discoverySelector.Tags {
+			selector.Tags[key] = values
+		}
+	}
+	selectorOpts := make([]SubnetsResolveOption, 0, len(opts)+1)
+	selectorOpts = append(selectorOpts, opts...)
+	selectorOpts = append(selectorOpts, WithSubnetsDiscoverySelector(discoverySelector))
+	return r.ResolveViaSelector(ctx, selector, selectorOpts...)
 }
 
-func (r *defaultSubnetsResolver) ResolveViaSelector(ctx context.Context, selector *elbv2api.SubnetSelector, opts ...SubnetsResolveOption) ([]*ec2sdk.Subnet, error) {
+func (r *defaultSubnetsResolver) ResolveViaSelector(ctx context.Context, selector *elbv2api.SubnetSelector, opts ...SubnetsResolveOption) ([]ec2types.Subnet, error) {
 	resolveOpts := defaultSubnetsResolveOptions()
 	resolveOpts.ApplyOptions(opts)
 
-	var chosenSubnets []*ec2sdk.Subnet
+	var chosenSubnets []ec2types.Subnet
 	var err error
 	var explanation string
 	if selector.IDs != nil {
 		req := &ec2sdk.DescribeSubnetsInput{
-			SubnetIds: make([]*string, 0, len(selector.IDs)),
+			SubnetIds: make([]string, 0, len(selector.IDs)),
 		}
 		for _, subnetID := range selector.IDs {
-			id := string(subnetID)
-			req.SubnetIds = append(req.SubnetIds, &id)
+			req.SubnetIds = append(req.SubnetIds, string(subnetID))
 		}
 		chosenSubnets, err = r.ec2Client.DescribeSubnetsAsList(ctx, req)
 		if err != nil {
@@ -188,20 +218,28 @@ func (r *defaultSubnetsResolver) ResolveViaSelector(ctx context.Context, selecto
 		if err := r.validateSubnetsAZExclusivity(chosenSubnets); err != nil {
 			return nil, err
 		}
+		if err := r.validateSubnetsAllowedZones(chosenSubnets); err != nil {
+			return nil, err
+		}
 		// todo validate here?
 	} else {
 		req := &ec2sdk.DescribeSubnetsInput{
-			Filters: []*ec2sdk.Filter{
+			Filters: []ec2types.Filter{
 				{
 					Name:   awssdk.String("vpc-id"),
-					Values: awssdk.StringSlice([]string{r.vpcID}),
+					Values: []string{r.vpcID},
 				},
 			},
 		}
-		for key, values := range selector.Tags {
-			req.Filters = append(req.Filters, &ec2sdk.Filter{
+		tagKeys := make([]string, 0, len(selector.Tags))
+		for key := range selector.Tags {
+			tagKeys = append(tagKeys, key)
+		}
+		sort.Strings(tagKeys)
+		for _, key := range tagKeys {
+			req.Filters = append(req.Filters, ec2types.Filter{
 				Name:   awssdk.String("tag:" + key),
-				Values: awssdk.StringSlice(values),
+				Values: selector.Tags[key],
 			})
 		}
 
@@ -210,10 +248,10 @@ func (r *defaultSubnetsResolver) ResolveViaSelector(ctx context.Context, selecto
 			return nil, err
 		}
 		explanation = fmt.Sprintf("%d match VPC and tags", len(allSubnets))
-		var subnets []*ec2sdk.Subnet
+		var subnets []ec2types.Subnet
 		taggedOtherCluster := 0
-		for _, subnet := range allSubnets {
-			if r.checkSubnetIsNotTaggedForOtherClusters(subnet, resolveOpts.SubnetsClusterTagCheck) {
+		for i, subnet := range allSubnets {
+			if r.checkSubnetIsNotTaggedForOtherClusters(&allSubnets[i], resolveOpts.SubnetsClusterTagCheck) {
 				subnets = append(subnets, subnet)
 			} else {
 				taggedOtherCluster += 1
@@ -222,26 +260,42 @@ func (r *defaultSubnetsResolver) ResolveViaSelector(ctx context.Context, selecto
 		if taggedOtherCluster > 0 {
 			explanation += fmt.Sprintf(", %d tagged for other cluster", taggedOtherCluster)
 		}
-		filteredSubnets, insufficientIPs := r.filterSubnetsByAvailableIPAddress(subnets, resolveOpts.AvailableIPAddressCount)
+		var disallowedByZone int
+		subnets, disallowedByZone = filterSubnetsByZone(subnets, r.allowedZones, nil)
+		if disallowedByZone > 0 {
+			explanation += fmt.Sprintf(", %d in disallowed zones", disallowedByZone)
+		}
+		availableIPAddressCount := resolveOpts.AvailableIPAddressCount
+		if discoverySelector := resolveOpts.DiscoverySelector; discoverySelector != nil {
+			var excludedByZone int
+			subnets, excludedByZone = filterSubnetsByZone(subnets, discoverySelector.IncludeZones, discoverySelector.ExcludeZones)
+			if excludedByZone > 0 {
+				explanation += fmt.Sprintf(", %d in excluded zones", excludedByZone)
+			}
+			if minFreeIPAddressCount := awssdk.ToInt64(discoverySelector.MinFreeIPAddressCount); minFreeIPAddressCount > availableIPAddressCount {
+				availableIPAddressCount = minFreeIPAddressCount
+			}
+		}
+		filteredSubnets, insufficientIPs := r.filterSubnetsByAvailableIPAddress(subnets, availableIPAddressCount)
 		if insufficientIPs > 0 {
-			explanation += fmt.Sprintf(", %d have fewer than %d free IPs", insufficientIPs, resolveOpts.AvailableIPAddressCount)
+			explanation += fmt.Sprintf(", %d have fewer than %d free IPs", insufficientIPs, availableIPAddressCount)
 		}
 		subnetsByAZ := mapSDKSubnetsByAZ(filteredSubnets)
-		chosenSubnets = make([]*ec2sdk.Subnet, 0, len(subnetsByAZ))
+		chosenSubnets = make([]ec2types.Subnet, 0, len(subnetsByAZ))
 		for az, subnets := range subnetsByAZ {
 			if len(subnets) == 1 {
 				chosenSubnets = append(chosenSubnets, subnets[0])
 			} else if len(subnets) > 1 {
 				sort.Slice(subnets, func(i, j int) bool {
-					clusterTagI := r.checkSubnetHasClusterTag(subnets[i])
-					clusterTagJ := r.checkSubnetHasClusterTag(subnets[j])
+					clusterTagI := r.checkSubnetHasClusterTag(&subnets[i])
+					clusterTagJ := r.checkSubnetHasClusterTag(&subnets[j])
 					if clusterTagI != clusterTagJ {
 						if clusterTagI {
 							return true
 						}
 						return false
 					}
-					return awssdk.StringValue(subnets[i].SubnetId) < awssdk.StringValue(subnets[j].SubnetId)
+					return awssdk.ToString(subnets[i].SubnetId) < awssdk.ToString(subnets[j].SubnetId)
 				})
 				r.logger.Info("multiple subnet in the same AvailabilityZone", "AvailabilityZone", az,
 					"chosen", subnets[0].SubnetId, "ignored", subnets[1:])
@@ -259,11 +313,14 @@ func (r *defaultSubnetsResolver) ResolveViaSelector(ctx context.Context, selecto
 	if err := r.validateSubnetsMinimalCount(chosenSubnets, subnetLocale, resolveOpts); err != nil {
 		return nil, err
 	}
+	if err := r.validateSubnetsLocaleLBSupport(ctx, chosenSubnets, subnetLocale, resolveOpts); err != nil {
+		return nil, err
+	}
 	sortSubnetsByID(chosenSubnets)
 	return chosenSubnets, nil
 }
 
-func (r *defaultSubnetsResolver) ResolveViaNameOrIDSlice(ctx context.Context, subnetNameOrIDs []string, opts ...SubnetsResolveOption) ([]*ec2sdk.Subnet, error) {
+func (r *defaultSubnetsResolver) ResolveViaNameOrIDSlice(ctx context.Context, subnetNameOrIDs []string, opts ...SubnetsResolveOption) ([]ec2types.Subnet, error) {
 	resolveOpts := defaultSubnetsResolveOptions()
 	resolveOpts.ApplyOptions(opts)
 
@@ -276,10 +333,10 @@ func (r *defaultSubnetsResolver) ResolveViaNameOrIDSlice(ctx context.Context, su
 			subnetNames = append(subnetNames, nameOrID)
 		}
 	}
-	var resolvedSubnets []*ec2sdk.Subnet
+	var resolvedSubnets []ec2types.Subnet
 	if len(subnetIDs) > 0 {
 		req := &ec2sdk.DescribeSubnetsInput{
-			SubnetIds: awssdk.StringSlice(subnetIDs),
+			SubnetIds: subnetIDs,
 		}
 		subnets, err := r.ec2Client.DescribeSubnetsAsList(ctx, req)
 		if err != nil {
@@ -290,14 +347,14 @@ func (r *defaultSubnetsResolver) ResolveViaNameOrIDSlice(ctx context.Context, su
 
 	if len(subnetNames) > 0 {
 		req := &ec2sdk.DescribeSubnetsInput{
-			Filters: []*ec2sdk.Filter{
+			Filters: []ec2types.Filter{
 				{
 					Name:   awssdk.String("tag:Name"),
-					Values: awssdk.StringSlice(subnetNames),
+					Values: subnetNames,
 				},
 				{
 					Name:   awssdk.String("vpc-id"),
-					Values: awssdk.StringSlice([]string{r.vpcID}),
+					Values: []string{r.vpcID},
 				},
 			},
 		}
@@ -316,6 +373,9 @@ func (r *defaultSubnetsResolver) ResolveViaNameOrIDSlice(ctx context.Context, su
 	if err := r.validateSubnetsAZExclusivity(resolvedSubnets); err != nil {
 		return nil, err
 	}
+	if err := r.validateSubnetsAllowedZones(resolvedSubnets); err != nil {
+		return nil, err
+	}
 	subnetLocale, err := r.validateSubnetsLocaleUniformity(ctx, resolvedSubnets)
 	if err != nil {
 		return nil, err
@@ -323,19 +383,22 @@ func (r *defaultSubnetsResolver) ResolveViaNameOrIDSlice(ctx context.Context, su
 	if err := r.validateSubnetsMinimalCount(resolvedSubnets, subnetLocale, resolveOpts); err != nil {
 		return nil, err
 	}
+	if err := r.validateSubnetsLocaleLBSupport(ctx, resolvedSubnets, subnetLocale, resolveOpts); err != nil {
+		return nil, err
+	}
 	sortSubnetsByID(resolvedSubnets)
 	return resolvedSubnets, nil
 }
 
 // validateSDKSubnetsAZExclusivity validates subnets belong to different AZs.
 // subnets passed-in must be non-empty
-func (r *defaultSubnetsResolver) validateSubnetsAZExclusivity(subnets []*ec2sdk.Subnet) error {
+func (r *defaultSubnetsResolver) validateSubnetsAZExclusivity(subnets []ec2types.Subnet) error {
 	subnetsByAZ := mapSDKSubnetsByAZ(subnets)
 	for az, subnets := range subnetsByAZ {
 		if len(subnets) > 1 {
 			subnetIDs := make([]string, 0, len(subnets))
 			for _, subnet := range subnets {
-				subnetIDs = append(subnetIDs, awssdk.StringValue(subnet.SubnetId))
+				subnetIDs = append(subnetIDs, awssdk.ToString(subnet.SubnetId))
 			}
 			return errors.Errorf("multiple subnets in same Availability Zone %v: %v", az, subnetIDs)
 		}
@@ -343,12 +406,29 @@ func (r *defaultSubnetsResolver) validateSubnetsAZExclusivity(subnets []*ec2sdk.
 	return nil
 }
 
+// validateSubnetsAllowedZones validates subnets belong to the allowed zones.
+func (r *defaultSubnetsResolver) validateSubnetsAllowedZones(subnets []ec2types.Subnet) error {
+	if len(r.allowedZones) == 0 {
+		return nil
+	}
+	allowedZoneSet := sets.NewString(r.allowedZones...)
+	for _, subnet := range subnets {
+		subnetAZ := awssdk.ToString(subnet.AvailabilityZone)
+		subnetAZID := awssdk.ToString(subnet.AvailabilityZoneId)
+		if !allowedZoneSet.HasAny(subnetAZ, subnetAZID) {
+			return errors.Errorf("subnet %v is in Availability Zone %v (%v) which isn't allowed, allowed zones: %v",
+				awssdk.ToString(subnet.SubnetId), subnetAZ, subnetAZID, r.allowedZones)
+		}
+	}
+	return nil
+}
+
 // validateSDKSubnetsLocaleExclusivity validates all subnets belong to same locale, and returns the same locale.
 // subnets passed-in must be non-empty
-func (r *defaultSubnetsResolver) validateSubnetsLocaleUniformity(ctx context.Context, subnets []*ec2sdk.Subnet) (subnetLocaleType, error) {
+func (r *defaultSubnetsResolver) validateSubnetsLocaleUniformity(ctx context.Context, subnets []ec2types.Subnet) (subnetLocaleType, error) {
 	subnetLocales := sets.NewString()
-	for _, subnet := range subnets {
-		subnetLocale, err := r.buildSDKSubnetLocaleType(ctx, subnet)
+	for i := range subnets {
+		subnetLocale, err := r.buildSDKSubnetLocaleType(ctx, &subnets[i])
 		if err != nil {
 			return "", err
 		}
@@ -362,7 +442,7 @@ func (r *defaultSubnetsResolver) validateSubnetsLocaleUniformity(ctx context.Con
 }
 
 // validateSubnetsMinimalCount validates subnets meets minimal count requirement.
-func (r *defaultSubnetsResolver) validateSubnetsMinimalCount(subnets []*ec2sdk.Subnet, subnetLocale subnetLocaleType, resolveOpts SubnetsResolveOptions) error {
+func (r *defaultSubnetsResolver) validateSubnetsMinimalCount(subnets []ec2types.Subnet, subnetLocale subnetLocaleType, resolveOpts SubnetsResolveOptions) error {
 	minimalCount := r.computeSubnetsMinimalCount(subnetLocale, resolveOpts)
 	if len(subnets) < minimalCount {
 		return errors.Errorf("subnets count less than minimal required count: %v < %v", len(subnets), minimalCount)
@@ -379,18 +459,93 @@ func (r *defaultSubnetsResolver) computeSubnetsMinimalCount(subnetLocale subnetL
 	return minimalCount
 }
 
+// validateSubnetsLocaleLBSupport validates the load balancer is supported within the locale of subnets.
+// Wavelength Zones only support network load balancers, and internet-facing ones are reachable from the carrier network
+// only if the subnets route to a carrier gateway.
+func (r *defaultSubnetsResolver) validateSubnetsLocaleLBSupport(ctx context.Context, subnets []ec2types.Subnet, subnetLocale subnetLocaleType, resolveOpts SubnetsResolveOptions) error {
+	if subnetLocale != subnetLocaleTypeWavelengthZone {
+		return nil
+	}
+	if resolveOpts.LBType == elbv2model.LoadBalancerTypeApplication {
+		return errors.Errorf("application load balancers aren't supported in Wavelength Zones, use network load balancers instead, see %v", wavelengthDocURL)
+	}
+	if resolveOpts.LBScheme == elbv2model.LoadBalancerSchemeInternetFacing {
+		return r.validateSubnetsCarrierGatewayRoute(ctx, subnets)
+	}
+	return nil
+}
+
+// validateSubnetsCarrierGatewayRoute validates subnets route to a carrier gateway.
+// subnets without explicit route table association use the main route table of VPC.
+func (r *defaultSubnetsResolver) validateSubnetsCarrierGatewayRoute(ctx context.Context, subnets []ec2types.Subnet) error {
+	req := &ec2sdk.DescribeRouteTablesInput{
+		Filters: []ec2types.Filter{
+			{
+				Name:   awssdk.String("vpc-id"),
+				Values: []string{r.vpcID},
+			},
+		},
+	}
+	routeTables, err := r.ec2Client.DescribeRouteTablesAsList(ctx, req)
+	if err != nil {
+		return err
+	}
+	var mainRouteTable *ec2types.RouteTable
+	routeTableBySubnetID := make(map[string]*ec2types.RouteTable)
+	for i := range routeTables {
+		routeTable := &routeTables[i]
+		for _, association := range routeTable.Associations {
+			if awssdk.ToBool(association.Main) {
+				mainRouteTable = routeTable
+			}
+			if association.SubnetId != nil {
+				routeTableBySubnetID[awssdk.ToString(association.SubnetId)] = routeTable
+			}
+		}
+	}
+	var subnetIDsWithoutCarrierGatewayRoute []string
+	for _, subnet := range subnets {
+		subnetID := awssdk.ToString(subnet.SubnetId)
+		routeTable, exists := routeTableBySubnetID[subnetID]
+		if !exists {
+			routeTable = mainRouteTable
+		}
+		if !routeTableHasCarrierGatewayRoute(routeTable) {
+			subnetIDsWithoutCarrierGatewayRoute = append(subnetIDsWithoutCarrierGatewayRoute, subnetID)
+		}
+	}
+	if len(subnetIDsWithoutCarrierGatewayRoute) > 0 {
+		return errors.Errorf("internet-facing load balancers in Wavelength Zones require subnets routing to a carrier gateway, subnets without such route: %v, see %v",
+			subnetIDsWithoutCarrierGatewayRoute, carrierGatewayDocURL)
+	}
+	return nil
+}
+
+// routeTableHasCarrierGatewayRoute checks whether routeTable contains an active route to a carrier gateway.
+func routeTableHasCarrierGatewayRoute(routeTable *ec2types.RouteTable) bool {
+	if routeTable == nil {
+		return false
+	}
+	for _, route := range routeTable.Routes {
+		if route.CarrierGatewayId != nil && route.State != ec2types.RouteStateBlackhole {
+			return true
+		}
+	}
+	return false
+}
+
 // buildSDKSubnetLocaleType builds the locale type for subnet.
-func (r *defaultSubnetsResolver) buildSDKSubnetLocaleType(ctx context.Context, subnet *ec2sdk.Subnet) (subnetLocaleType, error) {
+func (r *defaultSubnetsResolver) buildSDKSubnetLocaleType(ctx context.Context, subnet *ec2types.Subnet) (subnetLocaleType, error) {
 	if subnet.OutpostArn != nil && len(*subnet.OutpostArn) != 0 {
 		return subnetLocaleTypeOutpost, nil
 	}
-	subnetAZID := awssdk.StringValue(subnet.AvailabilityZoneId)
+	subnetAZID := awssdk.ToString(subnet.AvailabilityZoneId)
 	azInfoByAZID, err := r.azInfoProvider.FetchAZInfos(ctx, []string{subnetAZID})
 	if err != nil {
 		return "", err
 	}
 	subnetAZInfo := azInfoByAZID[subnetAZID]
-	subnetZoneType := awssdk.StringValue(subnetAZInfo.ZoneType)
+	subnetZoneType := awssdk.ToString(subnetAZInfo.ZoneType)
 	switch subnetZoneType {
 	case zoneTypeAvailabilityZone:
 		return subnetLocaleTypeAvailabilityZone, nil
@@ -399,15 +554,15 @@ func (r *defaultSubnetsResolver) buildSDKSubnetLocaleType(ctx context.Context, s
 	case zoneTypeWavelengthZone:
 		return subnetLocaleTypeWavelengthZone, nil
 	default:
-		return "", errors.Errorf("unknown zone type for subnet %v: %v", awssdk.StringValue(subnet.SubnetId), subnetZoneType)
+		return "", errors.Errorf("unknown zone type for subnet %v: %v", awssdk.ToString(subnet.SubnetId), subnetZoneType)
 	}
 }
 
 // checkSubnetHasClusterTag checks if the subnet is tagged for the current cluster
-func (r *defaultSubnetsResolver) checkSubnetHasClusterTag(subnet *ec2sdk.Subnet) bool {
+func (r *defaultSubnetsResolver) checkSubnetHasClusterTag(subnet *ec2types.Subnet) bool {
 	clusterResourceTagKey := fmt.Sprintf("kubernetes.io/cluster/%s", r.clusterName)
 	for _, tag := range subnet.Tags {
-		if clusterResourceTagKey == awssdk.StringValue(tag.Key) {
+		if clusterResourceTagKey == awssdk.ToString(tag.Key) {
 			return true
 		}
 	}
@@ -418,7 +573,7 @@ func (r *defaultSubnetsResolver) checkSubnetHasClusterTag(subnet *ec2sdk.Subnet)
 // or it doesn't contain the cluster tag at all. If the subnet contains a tag for other clusters, then
 // this check returns false so that the subnet does not used for the load balancer.
 // it returns true if the subnetsClusterTagCheck is disabled
-func (r *defaultSubnetsResolver) checkSubnetIsNotTaggedForOtherClusters(subnet *ec2sdk.Subnet, subnetsClusterTagCheck bool) bool {
+func (r *defaultSubnetsResolver) checkSubnetIsNotTaggedForOtherClusters(subnet *ec2types.Subnet, subnetsClusterTagCheck bool) bool {
 	if !subnetsClusterTagCheck {
 		return true
 	}
@@ -426,7 +581,7 @@ func (r *defaultSubnetsResolver) checkSubnetIsNotTaggedForOtherClusters(subnet *
 	clusterResourceTagKey := fmt.Sprintf("kubernetes.io/cluster/%s", r.clusterName)
 	hasClusterResourceTagPrefix := false
 	for _, tag := range subnet.Tags {
-		tagKey := awssdk.StringValue(tag.Key)
+		tagKey := awssdk.ToString(tag.Key)
 		if tagKey == clusterResourceTagKey {
 			return true
 		}
@@ -443,34 +598,77 @@ func (r *defaultSubnetsResolver) checkSubnetIsNotTaggedForOtherClusters(subnet *
 }
 
 // mapSDKSubnetsByAZ builds the subnets slice by AZ mapping.
-func mapSDKSubnetsByAZ(subnets []*ec2sdk.Subnet) map[string][]*ec2sdk.Subnet {
-	subnetsByAZ := make(map[string][]*ec2sdk.Subnet)
+func mapSDKSubnetsByAZ(subnets []ec2types.Subnet) map[string][]ec2types.Subnet {
+	subnetsByAZ := make(map[string][]ec2types.Subnet)
 	for _, subnet := range subnets {
-		subnetAZ := awssdk.StringValue(subnet.AvailabilityZone)
+		subnetAZ := awssdk.ToString(subnet.AvailabilityZone)
 		subnetsByAZ[subnetAZ] = append(subnetsByAZ[subnetAZ], subnet)
 	}
 	return subnetsByAZ
 }
 
 // sortSubnetsByID sorts given subnets slice by subnetID.
-func sortSubnetsByID(subnets []*ec2sdk.Subnet) {
+func sortSubnetsByID(subnets []ec2types.Subnet) {
 	sort.Slice(subnets, func(i, j int) bool {
-		return awssdk.StringValue(subnets[i].SubnetId) < awssdk.StringValue(subnets[j].SubnetId)
+		return awssdk.ToString(subnets[i].SubnetId) < awssdk.ToString(subnets[j].SubnetId)
 	})
 }
 
-func (r *defaultSubnetsResolver) filterSubnetsByAvailableIPAddress(subnets []*ec2sdk.Subnet, availableIPAddressCount int64) ([]*ec2sdk.Subnet, int) {
-	filteredSubnets := make([]*ec2sdk.Subnet, 0, len(subnets))
+func (r *defaultSubnetsResolver) filterSubnetsByAvailableIPAddress(subnets []ec2types.Subnet, availableIPAddressCount int64) ([]ec2types.Subnet, int) {
+	filteredSubnets := make([]ec2types.Subnet, 0, len(subnets))
 
 	insufficientIPs := 0
 	for _, subnet := range subnets {
-		if awssdk.Int64Value(subnet.AvailableIpAddressCount) >= availableIPAddressCount {
+		if int64(awssdk.ToInt32(subnet.AvailableIpAddressCount)) >= availableIPAddressCount {
 			filteredSubnets = append(filteredSubnets, subnet)
 		} else {
 			insufficientIPs += 1
-			r.logger.Info("ELB requires at least 8 free IP addresses in each subnet",
-				"not enough IP addresses found in ", awssdk.StringValue(subnet.SubnetId))
+			r.logger.Info("not enough free IP addresses found in subnet",
+				"subnet", awssdk.ToString(subnet.SubnetId), "required", availableIPAddressCount)
 		}
 	}
 	return filteredSubnets, insufficientIPs
 }
+
+// buildDiscoverySelector builds the discovery selector for a resolution, fields of the override take precedence over
+// the ones of the resolver's discovery selector.
+func (r *defaultSubnetsResolver) buildDiscoverySelector(override *elbv2api.SubnetDiscoverySelector) *elbv2api.SubnetDiscoverySelector {
+	discoverySelector := r.discoverySelector.DeepCopy()
+	if override == nil {
+		return discoverySelector
+	}
+	if override.Tags != nil {
+		discoverySelector.Tags = override.Tags
+	}
+	if override.IncludeZones != nil {
+		discoverySelector.IncludeZones = override.IncludeZones
+	}
+	if override.ExcludeZones != nil {
+		discoverySelector.ExcludeZones = override.ExcludeZones
+	}
+	if override.MinFreeIPAddressCount != nil {
+		discoverySelector.MinFreeIPAddressCount = override.MinFreeIPAddressCount
+	}
+	return discoverySelector
+}
+
+// filterSubnetsByZone filters subnets by the names or IDs of their zones.
+// subnets are kept if they are in one of the includeZones when it's non-empty, and in none of the excludeZones.
+func filterSubnetsByZone(subnets []ec2types.Subnet, includeZones []string, excludeZones []string) ([]ec2types.Subnet, int) {
+	if len(includeZones) == 0 && len(excludeZones) == 0 {
+		return subnets, 0
+	}
+	includeZoneSet := sets.NewString(includeZones...)
+	excludeZoneSet := sets.NewString(excludeZones...)
+	filteredSubnets := make([]ec2types.Subnet, 0, len(subnets))
+	excluded := 0
+	for _, subnet := range subnets {
+		zones := []string{awssdk.ToString(subnet.AvailabilityZone), awssdk.ToString(subnet.AvailabilityZoneId)}
+		if (includeZoneSet.Len() != 0 && !includeZoneSet.HasAny(zones...)) || excludeZoneSet.HasAny(zones...) {
+			excluded += 1
+			continue
+		}
+		filteredSubnets = append(filteredSubnets, subnet)
+	}
+	return filteredSubnets, excluded
+}
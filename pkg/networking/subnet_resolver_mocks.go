@@ -8,7 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
-	ec2 "github.com/aws/aws-sdk-go/service/ec2"
+	types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	gomock "github.com/golang/mock/gomock"
 	v1beta1 "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 )
@@ -37,14 +37,14 @@ func (m *MockSubnetsResolver) EXPECT() *MockSubnetsResolverMockRecorder {
 }
 
 // ResolveViaDiscovery mocks base method.
-func (m *MockSubnetsResolver) ResolveViaDiscovery(arg0 context.Context, arg1 ...SubnetsResolveOption) ([]*ec2.Subnet, error) {
+func (m *MockSubnetsResolver) ResolveViaDiscovery(arg0 context.Context, arg1 ...SubnetsResolveOption) ([]types.Subnet, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0}
 	for _, a := range arg1 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ResolveViaDiscovery", varargs...)
-	ret0, _ := ret[0].([]*ec2.Subnet)
+	ret0, _ := ret[0].([]types.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -57,14 +57,14 @@ func (mr *MockSubnetsResolverMockRecorder) ResolveViaDiscovery(arg0 interface{},
 }
 
 // ResolveViaNameOrIDSlice mocks base method.
-func (m *MockSubnetsResolver) ResolveViaNameOrIDSlice(arg0 context.Context, arg1 []string, arg2 ...SubnetsResolveOption) ([]*ec2.Subnet, error) {
+func (m *MockSubnetsResolver) ResolveViaNameOrIDSlice(arg0 context.Context, arg1 []string, arg2 ...SubnetsResolveOption) ([]types.Subnet, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ResolveViaNameOrIDSlice", varargs...)
-	ret0, _ := ret[0].([]*ec2.Subnet)
+	ret0, _ := ret[0].([]types.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -77,14 +77,14 @@ func (mr *MockSubnetsResolverMockRecorder) ResolveViaNameOrIDSlice(arg0, arg1 in
 }
 
 // ResolveViaSelector mocks base method.
-func (m *MockSubnetsResolver) ResolveViaSelector(arg0 context.Context, arg1 *v1beta1.SubnetSelector, arg2 ...SubnetsResolveOption) ([]*ec2.Subnet, error) {
+func (m *MockSubnetsResolver) ResolveViaSelector(arg0 context.Context, arg1 *v1beta1.SubnetSelector, arg2 ...SubnetsResolveOption) ([]types.Subnet, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "ResolveViaSelector", varargs...)
-	ret0, _ := ret[0].([]*ec2.Subnet)
+	ret0, _ := ret[0].([]types.Subnet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -5,13 +5,15 @@ import (
 	"errors"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -20,17 +22,19 @@ import (
 func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 	type describeSubnetsAsListCall struct {
 		input  *ec2sdk.DescribeSubnetsInput
-		output []*ec2sdk.Subnet
+		output []ec2types.Subnet
 		err    error
 	}
 	type fetchAZInfosCall struct {
 		availabilityZoneIDs []string
-		azInfoByAZID        map[string]ec2sdk.AvailabilityZone
+		azInfoByAZID        map[string]ec2types.AvailabilityZone
 		err                 error
 	}
 	type fields struct {
 		vpcID                      string
 		clusterName                string
+		discoverySelector          elbv2api.SubnetDiscoverySelector
+		allowedZones               []string
 		describeSubnetsAsListCalls []describeSubnetsAsListCall
 		fetchAZInfosCalls          []fetchAZInfosCall
 	}
@@ -45,7 +49,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 		name    string
 		fields  fields
 		args    args
-		want    []*ec2sdk.Subnet
+		want    []ec2types.Subnet
 		wantErr error
 	}{
 		{
@@ -56,18 +60,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -86,7 +90,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -95,7 +99,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az2"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az2": {
 								ZoneId:   awssdk.String("usw2-az2"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -110,7 +114,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -133,18 +137,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -163,7 +167,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -172,7 +176,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az2"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az2": {
 								ZoneId:   awssdk.String("usw2-az2"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -187,7 +191,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -210,14 +214,14 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
@@ -241,18 +245,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -265,7 +269,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -280,7 +284,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -297,18 +301,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -321,7 +325,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -346,18 +350,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2-lax-1a"),
@@ -370,7 +374,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-lax1-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-lax1-az1": {
 								ZoneId:   awssdk.String("usw2-lax1-az1"),
 								ZoneType: awssdk.String("local-zone"),
@@ -385,7 +389,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2-lax-1a"),
@@ -402,18 +406,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -431,7 +435,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -449,18 +453,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-3"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -491,7 +495,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -500,7 +504,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az2"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az2": {
 								ZoneId:   awssdk.String("usw2-az2"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -515,7 +519,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -538,18 +542,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -569,7 +573,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -594,14 +598,14 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
@@ -625,18 +629,18 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2b"),
@@ -648,7 +652,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 								AvailabilityZone:   awssdk.String("us-west-2b"),
 								AvailabilityZoneId: awssdk.String("usw2-az2"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/kube-cluster"),
 										Value: awssdk.String("owned"),
@@ -661,7 +665,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az2"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az2": {
 								ZoneId:   awssdk.String("usw2-az2"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -676,13 +680,13 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-2"),
 					AvailabilityZone:   awssdk.String("us-west-2b"),
 					AvailabilityZoneId: awssdk.String("usw2-az2"),
 					VpcId:              awssdk.String("vpc-1"),
-					Tags: []*ec2sdk.Tag{
+					Tags: []ec2types.Tag{
 						{
 							Key:   awssdk.String("kubernetes.io/cluster/kube-cluster"),
 							Value: awssdk.String("owned"),
@@ -699,24 +703,24 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
 								AvailabilityZoneId: awssdk.String("usw2-az1"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/some-other-cluster"),
 										Value: awssdk.String("owned"),
@@ -728,7 +732,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 								AvailabilityZone:   awssdk.String("us-west-2a"),
 								AvailabilityZoneId: awssdk.String("usw2-az1"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/kube-cluster"),
 										Value: awssdk.String("owned"),
@@ -740,7 +744,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 								AvailabilityZone:   awssdk.String("us-west-2b"),
 								AvailabilityZoneId: awssdk.String("usw2-az2"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/no-cluster"),
 										Value: awssdk.String("owned"),
@@ -752,7 +756,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 								AvailabilityZone:   awssdk.String("us-west-2a"),
 								AvailabilityZoneId: awssdk.String("usw2-az1"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/kube-cluster"),
 										Value: awssdk.String("owned"),
@@ -771,7 +775,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -780,7 +784,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az3"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az3": {
 								ZoneId:   awssdk.String("usw2-az3"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -796,13 +800,13 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsClusterTagCheck(defaultSubnetsClusterTagCheck),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-2"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
 					AvailabilityZoneId: awssdk.String("usw2-az1"),
 					VpcId:              awssdk.String("vpc-1"),
-					Tags: []*ec2sdk.Tag{
+					Tags: []ec2types.Tag{
 						{
 							Key:   awssdk.String("kubernetes.io/cluster/kube-cluster"),
 							Value: awssdk.String("owned"),
@@ -825,24 +829,24 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-3"),
 								AvailabilityZone:   awssdk.String("us-west-2c"),
 								AvailabilityZoneId: awssdk.String("usw2-az3"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/some-other-cluster"),
 										Value: awssdk.String("owned"),
@@ -854,7 +858,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 								AvailabilityZone:   awssdk.String("us-west-2a"),
 								AvailabilityZoneId: awssdk.String("usw2-az1"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/some-other-cluster"),
 										Value: awssdk.String("owned"),
@@ -866,7 +870,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 								AvailabilityZone:   awssdk.String("us-west-2c"),
 								AvailabilityZoneId: awssdk.String("usw2-az3"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/kube-cluster"),
 										Value: awssdk.String("owned"),
@@ -879,7 +883,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -888,7 +892,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az3"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az3": {
 								ZoneId:   awssdk.String("usw2-az3"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -904,13 +908,13 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsClusterTagCheck(false),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
 					AvailabilityZoneId: awssdk.String("usw2-az1"),
 					VpcId:              awssdk.String("vpc-1"),
-					Tags: []*ec2sdk.Tag{
+					Tags: []ec2types.Tag{
 						{
 							Key:   awssdk.String("kubernetes.io/cluster/some-other-cluster"),
 							Value: awssdk.String("owned"),
@@ -922,7 +926,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					AvailabilityZone:   awssdk.String("us-west-2c"),
 					AvailabilityZoneId: awssdk.String("usw2-az3"),
 					VpcId:              awssdk.String("vpc-1"),
-					Tags: []*ec2sdk.Tag{
+					Tags: []ec2types.Tag{
 						{
 							Key:   awssdk.String("kubernetes.io/cluster/kube-cluster"),
 							Value: awssdk.String("owned"),
@@ -939,24 +943,24 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
 								AvailabilityZoneId: awssdk.String("usw2-az1"),
 								VpcId:              awssdk.String("vpc-1"),
-								Tags: []*ec2sdk.Tag{
+								Tags: []ec2types.Tag{
 									{
 										Key:   awssdk.String("kubernetes.io/cluster/some-other-cluster"),
 										Value: awssdk.String("owned"),
@@ -973,7 +977,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -988,13 +992,13 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
 					AvailabilityZoneId: awssdk.String("usw2-az1"),
 					VpcId:              awssdk.String("vpc-1"),
-					Tags: []*ec2sdk.Tag{
+					Tags: []ec2types.Tag{
 						{
 							Key:   awssdk.String("kubernetes.io/cluster/some-other-cluster"),
 							Value: awssdk.String("owned"),
@@ -1015,52 +1019,52 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 								{
 									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
-									Values: awssdk.StringSlice([]string{"", "1"}),
+									Values: []string{"", "1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:                awssdk.String("subnet-1"),
 								AvailabilityZone:        awssdk.String("us-west-2a"),
 								AvailabilityZoneId:      awssdk.String("usw2-az1"),
 								VpcId:                   awssdk.String("vpc-1"),
-								AvailableIpAddressCount: awssdk.Int64(0),
+								AvailableIpAddressCount: awssdk.Int32(0),
 							},
 							{
 								SubnetId:                awssdk.String("subnet-3"),
 								AvailabilityZone:        awssdk.String("us-west-2a"),
 								AvailabilityZoneId:      awssdk.String("usw2-az1"),
 								VpcId:                   awssdk.String("vpc-1"),
-								AvailableIpAddressCount: awssdk.Int64(8),
+								AvailableIpAddressCount: awssdk.Int32(8),
 							},
 							{
 								SubnetId:                awssdk.String("subnet-4"),
 								AvailabilityZone:        awssdk.String("us-west-2b"),
 								AvailabilityZoneId:      awssdk.String("usw2-az2"),
 								VpcId:                   awssdk.String("vpc-1"),
-								AvailableIpAddressCount: awssdk.Int64(25),
+								AvailableIpAddressCount: awssdk.Int32(25),
 							},
 							{
 								SubnetId:                awssdk.String("subnet-2"),
 								AvailabilityZone:        awssdk.String("us-west-2a"),
 								AvailabilityZoneId:      awssdk.String("usw2-az1"),
 								VpcId:                   awssdk.String("vpc-1"),
-								AvailableIpAddressCount: awssdk.Int64(2),
+								AvailableIpAddressCount: awssdk.Int32(2),
 							},
 							{
 								SubnetId:                awssdk.String("subnet-5"),
 								AvailabilityZone:        awssdk.String("us-west-2b"),
 								AvailabilityZoneId:      awssdk.String("usw2-az2"),
 								VpcId:                   awssdk.String("vpc-1"),
-								AvailableIpAddressCount: awssdk.Int64(10),
+								AvailableIpAddressCount: awssdk.Int32(10),
 							},
 						},
 					},
@@ -1068,7 +1072,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1077,7 +1081,7 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az2"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az2": {
 								ZoneId:   awssdk.String("usw2-az2"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1093,23 +1097,399 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 					WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:                awssdk.String("subnet-3"),
 					AvailabilityZone:        awssdk.String("us-west-2a"),
 					AvailabilityZoneId:      awssdk.String("usw2-az1"),
 					VpcId:                   awssdk.String("vpc-1"),
-					AvailableIpAddressCount: awssdk.Int64(8),
+					AvailableIpAddressCount: awssdk.Int32(8),
 				},
 				{
 					SubnetId:                awssdk.String("subnet-4"),
 					AvailabilityZone:        awssdk.String("us-west-2b"),
 					AvailabilityZoneId:      awssdk.String("usw2-az2"),
 					VpcId:                   awssdk.String("vpc-1"),
-					AvailableIpAddressCount: awssdk.Int64(25),
+					AvailableIpAddressCount: awssdk.Int32(25),
 				},
 			},
 		},
+		{
+			name: "subnets are filtered by the discovery selector",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				discoverySelector: elbv2api.SubnetDiscoverySelector{
+					Tags:                  map[string][]string{"tier": {"public"}},
+					ExcludeZones:          []string{"us-west-2c"},
+					MinFreeIPAddressCount: awssdk.Int64(20),
+				},
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							Filters: []ec2types.Filter{
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: []string{"vpc-1"},
+								},
+								{
+									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
+									Values: []string{"", "1"},
+								},
+								{
+									Name:   awssdk.String("tag:tier"),
+									Values: []string{"public"},
+								},
+							},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:                awssdk.String("subnet-1"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(10),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-2"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(30),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-3"),
+								AvailabilityZone:        awssdk.String("us-west-2b"),
+								AvailabilityZoneId:      awssdk.String("usw2-az2"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(25),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-4"),
+								AvailabilityZone:        awssdk.String("us-west-2c"),
+								AvailabilityZoneId:      awssdk.String("usw2-az3"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(100),
+							},
+						},
+					},
+				},
+				fetchAZInfosCalls: []fetchAZInfosCall{
+					{
+						availabilityZoneIDs: []string{"usw2-az1"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-az1": {
+								ZoneId:   awssdk.String("usw2-az1"),
+								ZoneType: awssdk.String("availability-zone"),
+							},
+						},
+					},
+					{
+						availabilityZoneIDs: []string{"usw2-az2"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-az2": {
+								ZoneId:   awssdk.String("usw2-az2"),
+								ZoneType: awssdk.String("availability-zone"),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
+					WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
+				},
+			},
+			want: []ec2types.Subnet{
+				{
+					SubnetId:                awssdk.String("subnet-2"),
+					AvailabilityZone:        awssdk.String("us-west-2a"),
+					AvailabilityZoneId:      awssdk.String("usw2-az1"),
+					VpcId:                   awssdk.String("vpc-1"),
+					AvailableIpAddressCount: awssdk.Int32(30),
+				},
+				{
+					SubnetId:                awssdk.String("subnet-3"),
+					AvailabilityZone:        awssdk.String("us-west-2b"),
+					AvailabilityZoneId:      awssdk.String("usw2-az2"),
+					VpcId:                   awssdk.String("vpc-1"),
+					AvailableIpAddressCount: awssdk.Int32(25),
+				},
+			},
+		},
+		{
+			name: "discovery selector option overrides the fields of the resolver's discovery selector",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				discoverySelector: elbv2api.SubnetDiscoverySelector{
+					Tags:                  map[string][]string{"tier": {"public"}},
+					MinFreeIPAddressCount: awssdk.Int64(20),
+				},
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							Filters: []ec2types.Filter{
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: []string{"vpc-1"},
+								},
+								{
+									Name:   awssdk.String("tag:kubernetes.io/role/internal-elb"),
+									Values: []string{"", "1"},
+								},
+								{
+									Name:   awssdk.String("tag:tier"),
+									Values: []string{"private-*"},
+								},
+							},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:                awssdk.String("subnet-1"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(10),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-3"),
+								AvailabilityZone:        awssdk.String("us-west-2b"),
+								AvailabilityZoneId:      awssdk.String("usw2-az2"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(25),
+							},
+						},
+					},
+				},
+				fetchAZInfosCalls: []fetchAZInfosCall{
+					{
+						availabilityZoneIDs: []string{"usw2-az1"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-az1": {
+								ZoneId:   awssdk.String("usw2-az1"),
+								ZoneType: awssdk.String("availability-zone"),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
+					WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
+					WithSubnetsDiscoverySelector(&elbv2api.SubnetDiscoverySelector{
+						Tags:                  map[string][]string{"tier": {"private-*"}},
+						IncludeZones:          []string{"usw2-az1"},
+						MinFreeIPAddressCount: awssdk.Int64(5),
+					}),
+				},
+			},
+			want: []ec2types.Subnet{
+				{
+					SubnetId:                awssdk.String("subnet-1"),
+					AvailabilityZone:        awssdk.String("us-west-2a"),
+					AvailabilityZoneId:      awssdk.String("usw2-az1"),
+					VpcId:                   awssdk.String("vpc-1"),
+					AvailableIpAddressCount: awssdk.Int32(10),
+				},
+			},
+		},
+		{
+			name: "all subnets are filtered by the discovery selector",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				discoverySelector: elbv2api.SubnetDiscoverySelector{
+					ExcludeZones:          []string{"usw2-az2"},
+					MinFreeIPAddressCount: awssdk.Int64(20),
+				},
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							Filters: []ec2types.Filter{
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: []string{"vpc-1"},
+								},
+								{
+									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
+									Values: []string{"", "1"},
+								},
+							},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:                awssdk.String("subnet-1"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(10),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-3"),
+								AvailabilityZone:        awssdk.String("us-west-2b"),
+								AvailabilityZoneId:      awssdk.String("usw2-az2"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(25),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
+					WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
+				},
+			},
+			wantErr: errors.New("unable to resolve at least one subnet (2 match VPC and tags, 1 in excluded zones, 1 have fewer than 20 free IPs)"),
+		},
+		{
+			name: "subnets in disallowed zones get ignored",
+			fields: fields{
+				vpcID:        "vpc-1",
+				clusterName:  "kube-cluster",
+				allowedZones: []string{"us-west-2a", "usw2-az3"},
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							Filters: []ec2types.Filter{
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: []string{"vpc-1"},
+								},
+								{
+									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
+									Values: []string{"", "1"},
+								},
+							},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:                awssdk.String("subnet-1"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(10),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-2"),
+								AvailabilityZone:        awssdk.String("us-west-2b"),
+								AvailabilityZoneId:      awssdk.String("usw2-az2"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(10),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-3"),
+								AvailabilityZone:        awssdk.String("us-west-2c"),
+								AvailabilityZoneId:      awssdk.String("usw2-az3"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(10),
+							},
+						},
+					},
+				},
+				fetchAZInfosCalls: []fetchAZInfosCall{
+					{
+						availabilityZoneIDs: []string{"usw2-az1"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-az1": {
+								ZoneId:   awssdk.String("usw2-az1"),
+								ZoneType: awssdk.String("availability-zone"),
+							},
+						},
+					},
+					{
+						availabilityZoneIDs: []string{"usw2-az3"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-az3": {
+								ZoneId:   awssdk.String("usw2-az3"),
+								ZoneType: awssdk.String("availability-zone"),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
+					WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
+					WithSubnetsDiscoverySelector(&elbv2api.SubnetDiscoverySelector{
+						IncludeZones: []string{"usw2-az1", "usw2-az2", "usw2-az3"},
+					}),
+				},
+			},
+			want: []ec2types.Subnet{
+				{
+					SubnetId:                awssdk.String("subnet-1"),
+					AvailabilityZone:        awssdk.String("us-west-2a"),
+					AvailabilityZoneId:      awssdk.String("usw2-az1"),
+					VpcId:                   awssdk.String("vpc-1"),
+					AvailableIpAddressCount: awssdk.Int32(10),
+				},
+				{
+					SubnetId:                awssdk.String("subnet-3"),
+					AvailabilityZone:        awssdk.String("us-west-2c"),
+					AvailabilityZoneId:      awssdk.String("usw2-az3"),
+					VpcId:                   awssdk.String("vpc-1"),
+					AvailableIpAddressCount: awssdk.Int32(10),
+				},
+			},
+		},
+		{
+			name: "all subnets are in disallowed zones",
+			fields: fields{
+				vpcID:        "vpc-1",
+				clusterName:  "kube-cluster",
+				allowedZones: []string{"usw2-az3"},
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							Filters: []ec2types.Filter{
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: []string{"vpc-1"},
+								},
+								{
+									Name:   awssdk.String("tag:kubernetes.io/role/elb"),
+									Values: []string{"", "1"},
+								},
+							},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:                awssdk.String("subnet-1"),
+								AvailabilityZone:        awssdk.String("us-west-2a"),
+								AvailabilityZoneId:      awssdk.String("usw2-az1"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(10),
+							},
+							{
+								SubnetId:                awssdk.String("subnet-2"),
+								AvailabilityZone:        awssdk.String("us-west-2b"),
+								AvailabilityZoneId:      awssdk.String("usw2-az2"),
+								VpcId:                   awssdk.String("vpc-1"),
+								AvailableIpAddressCount: awssdk.Int32(10),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
+					WithSubnetsResolveAvailableIPAddressCount(minimalAvailableIPAddressCount),
+				},
+			},
+			wantErr: errors.New("unable to resolve at least one subnet (2 match VPC and tags, 2 in disallowed zones)"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1128,11 +1508,14 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 			}
 
 			r := &defaultSubnetsResolver{
-				azInfoProvider: azInfoProvider,
-				ec2Client:      ec2Client,
-				vpcID:          tt.fields.vpcID,
-				clusterName:    tt.fields.clusterName,
-				logger:         logr.New(&log.NullLogSink{}),
+				azInfoProvider:    azInfoProvider,
+				ec2Client:         ec2Client,
+				vpcID:             tt.fields.vpcID,
+				clusterName:       tt.fields.clusterName,
+				discoveryStrategy: NewTagSubnetsDiscoveryStrategy(),
+				discoverySelector: tt.fields.discoverySelector,
+				allowedZones:      tt.fields.allowedZones,
+				logger:            logr.New(&log.NullLogSink{}),
 			}
 
 			got, err := r.ResolveViaDiscovery(context.Background(), tt.args.opts...)
@@ -1140,9 +1523,12 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
 				assert.NoError(t, err)
-				opts := cmpopts.SortSlices(func(lhs *ec2sdk.Subnet, rhs *ec2sdk.Subnet) bool {
-					return awssdk.StringValue(lhs.SubnetId) < awssdk.StringValue(rhs.SubnetId)
-				})
+				opts := cmp.Options{
+					cmpopts.IgnoreUnexported(ec2types.Subnet{}, ec2types.Tag{}),
+					cmpopts.SortSlices(func(lhs ec2types.Subnet, rhs ec2types.Subnet) bool {
+						return awssdk.ToString(lhs.SubnetId) < awssdk.ToString(rhs.SubnetId)
+					}),
+				}
 				assert.True(t, cmp.Equal(tt.want, got, opts), "diff", cmp.Diff(tt.want, got, opts))
 			}
 		})
@@ -1152,19 +1538,26 @@ func Test_defaultSubnetsResolver_ResolveViaDiscovery(t *testing.T) {
 func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 	type describeSubnetsAsListCall struct {
 		input  *ec2sdk.DescribeSubnetsInput
-		output []*ec2sdk.Subnet
+		output []ec2types.Subnet
 		err    error
 	}
 	type fetchAZInfosCall struct {
 		availabilityZoneIDs []string
-		azInfoByAZID        map[string]ec2sdk.AvailabilityZone
+		azInfoByAZID        map[string]ec2types.AvailabilityZone
 		err                 error
 	}
+	type describeRouteTablesAsListCall struct {
+		input  *ec2sdk.DescribeRouteTablesInput
+		output []ec2types.RouteTable
+		err    error
+	}
 	type fields struct {
-		vpcID                      string
-		clusterName                string
-		describeSubnetsAsListCalls []describeSubnetsAsListCall
-		fetchAZInfosCalls          []fetchAZInfosCall
+		vpcID                          string
+		clusterName                    string
+		allowedZones                   []string
+		describeSubnetsAsListCalls     []describeSubnetsAsListCall
+		fetchAZInfosCalls              []fetchAZInfosCall
+		describeRouteTablesAsListCalls []describeRouteTablesAsListCall
 	}
 	type args struct {
 		subnetNameOrIDs []string
@@ -1174,7 +1567,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 		name    string
 		fields  fields
 		args    args
-		want    []*ec2sdk.Subnet
+		want    []ec2types.Subnet
 		wantErr error
 	}{
 		{
@@ -1185,9 +1578,9 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							SubnetIds: awssdk.StringSlice([]string{"subnet-1", "subnet-2"}),
+							SubnetIds: []string{"subnet-1", "subnet-2"},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1206,7 +1599,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1215,7 +1608,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az2"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az2": {
 								ZoneId:   awssdk.String("usw2-az2"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1231,7 +1624,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1254,18 +1647,18 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("tag:Name"),
-									Values: awssdk.StringSlice([]string{"my-name-1", "my-name-2"}),
+									Values: []string{"my-name-1", "my-name-2"},
 								},
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1284,7 +1677,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1293,7 +1686,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az2"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az2": {
 								ZoneId:   awssdk.String("usw2-az2"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1309,7 +1702,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1332,9 +1725,9 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							SubnetIds: awssdk.StringSlice([]string{"subnet-1"}),
+							SubnetIds: []string{"subnet-1"},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1345,18 +1738,18 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					},
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("tag:Name"),
-									Values: awssdk.StringSlice([]string{"my-name-2"}),
+									Values: []string{"my-name-2"},
 								},
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-2"),
 								AvailabilityZone:   awssdk.String("us-west-2b"),
@@ -1369,7 +1762,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1378,7 +1771,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					},
 					{
 						availabilityZoneIDs: []string{"usw2-az2"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az2": {
 								ZoneId:   awssdk.String("usw2-az2"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1394,7 +1787,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1417,18 +1810,18 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							Filters: []*ec2sdk.Filter{
+							Filters: []ec2types.Filter{
 								{
 									Name:   awssdk.String("tag:Name"),
-									Values: awssdk.StringSlice([]string{"my-name-1", "my-name-2", "my-name-3"}),
+									Values: []string{"my-name-1", "my-name-2", "my-name-3"},
 								},
 								{
 									Name:   awssdk.String("vpc-id"),
-									Values: awssdk.StringSlice([]string{"vpc-1"}),
+									Values: []string{"vpc-1"},
 								},
 							},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:         awssdk.String("subnet-1"),
 								AvailabilityZone: awssdk.String("us-west-2a"),
@@ -1476,9 +1869,9 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							SubnetIds: awssdk.StringSlice([]string{"subnet-1", "subnet-2", "subnet-3"}),
+							SubnetIds: []string{"subnet-1", "subnet-2", "subnet-3"},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:         awssdk.String("subnet-1"),
 								AvailabilityZone: awssdk.String("us-west-2a"),
@@ -1507,6 +1900,43 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 			},
 			wantErr: errors.New("multiple subnets in same Availability Zone us-west-2a: [subnet-1 subnet-3]"),
 		},
+		{
+			name: "subnet in disallowed zone",
+			fields: fields{
+				vpcID:        "vpc-1",
+				clusterName:  "kube-cluster",
+				allowedZones: []string{"us-west-2a", "usw2-az3"},
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							SubnetIds: []string{"subnet-1", "subnet-2"},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:           awssdk.String("subnet-1"),
+								AvailabilityZone:   awssdk.String("us-west-2a"),
+								AvailabilityZoneId: awssdk.String("usw2-az1"),
+								VpcId:              awssdk.String("vpc-1"),
+							},
+							{
+								SubnetId:           awssdk.String("subnet-2"),
+								AvailabilityZone:   awssdk.String("us-west-2b"),
+								AvailabilityZoneId: awssdk.String("usw2-az2"),
+								VpcId:              awssdk.String("vpc-1"),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				subnetNameOrIDs: []string{"subnet-1", "subnet-2"},
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
+				},
+			},
+			wantErr: errors.New("subnet subnet-2 is in Availability Zone us-west-2b (usw2-az2) which isn't allowed, allowed zones: [us-west-2a usw2-az3]"),
+		},
 		{
 			name: "multiple subnet locales",
 			fields: fields{
@@ -1515,9 +1945,9 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							SubnetIds: awssdk.StringSlice([]string{"subnet-1", "subnet-2"}),
+							SubnetIds: []string{"subnet-1", "subnet-2"},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1537,7 +1967,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1563,9 +1993,9 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							SubnetIds: awssdk.StringSlice([]string{"subnet-1"}),
+							SubnetIds: []string{"subnet-1"},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1578,7 +2008,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1604,9 +2034,9 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							SubnetIds: awssdk.StringSlice([]string{"subnet-1"}),
+							SubnetIds: []string{"subnet-1"},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2-lax-1a"),
@@ -1619,7 +2049,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-lax1-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-lax1-az1": {
 								ZoneId:   awssdk.String("usw2-lax1-az1"),
 								ZoneType: awssdk.String("local-zone"),
@@ -1635,7 +2065,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2-lax-1a"),
@@ -1652,9 +2082,9 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							SubnetIds: awssdk.StringSlice([]string{"subnet-1"}),
+							SubnetIds: []string{"subnet-1"},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1673,7 +2103,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1691,9 +2121,9 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
 					{
 						input: &ec2sdk.DescribeSubnetsInput{
-							SubnetIds: awssdk.StringSlice([]string{"subnet-1"}),
+							SubnetIds: []string{"subnet-1"},
 						},
-						output: []*ec2sdk.Subnet{
+						output: []ec2types.Subnet{
 							{
 								SubnetId:           awssdk.String("subnet-1"),
 								AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1706,7 +2136,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1722,7 +2152,7 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
 				},
 			},
-			want: []*ec2sdk.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
@@ -1731,6 +2161,251 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ALB in Wavelength Zone",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							SubnetIds: []string{"subnet-1"},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:           awssdk.String("subnet-1"),
+								AvailabilityZone:   awssdk.String("us-west-2-wl1-las-wlz-1"),
+								AvailabilityZoneId: awssdk.String("usw2-wl1-las-wlz1"),
+								VpcId:              awssdk.String("vpc-1"),
+							},
+						},
+					},
+				},
+				fetchAZInfosCalls: []fetchAZInfosCall{
+					{
+						availabilityZoneIDs: []string{"usw2-wl1-las-wlz1"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-wl1-las-wlz1": {
+								ZoneId:   awssdk.String("usw2-wl1-las-wlz1"),
+								ZoneType: awssdk.String("wavelength-zone"),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				subnetNameOrIDs: []string{"subnet-1"},
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeApplication),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
+				},
+			},
+			wantErr: errors.New("application load balancers aren't supported in Wavelength Zones, use network load balancers instead, see https://docs.aws.amazon.com/wavelength/latest/developerguide/what-is-wavelength.html"),
+		},
+		{
+			name: "internal NLB in Wavelength Zone",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							SubnetIds: []string{"subnet-1"},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:           awssdk.String("subnet-1"),
+								AvailabilityZone:   awssdk.String("us-west-2-wl1-las-wlz-1"),
+								AvailabilityZoneId: awssdk.String("usw2-wl1-las-wlz1"),
+								VpcId:              awssdk.String("vpc-1"),
+							},
+						},
+					},
+				},
+				fetchAZInfosCalls: []fetchAZInfosCall{
+					{
+						availabilityZoneIDs: []string{"usw2-wl1-las-wlz1"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-wl1-las-wlz1": {
+								ZoneId:   awssdk.String("usw2-wl1-las-wlz1"),
+								ZoneType: awssdk.String("wavelength-zone"),
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				subnetNameOrIDs: []string{"subnet-1"},
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternal),
+				},
+			},
+			want: []ec2types.Subnet{
+				{
+					SubnetId:           awssdk.String("subnet-1"),
+					AvailabilityZone:   awssdk.String("us-west-2-wl1-las-wlz-1"),
+					AvailabilityZoneId: awssdk.String("usw2-wl1-las-wlz1"),
+					VpcId:              awssdk.String("vpc-1"),
+				},
+			},
+		},
+		{
+			name: "internet-facing NLB in Wavelength Zone with carrier gateway route in main route table",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							SubnetIds: []string{"subnet-1"},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:           awssdk.String("subnet-1"),
+								AvailabilityZone:   awssdk.String("us-west-2-wl1-las-wlz-1"),
+								AvailabilityZoneId: awssdk.String("usw2-wl1-las-wlz1"),
+								VpcId:              awssdk.String("vpc-1"),
+							},
+						},
+					},
+				},
+				fetchAZInfosCalls: []fetchAZInfosCall{
+					{
+						availabilityZoneIDs: []string{"usw2-wl1-las-wlz1"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-wl1-las-wlz1": {
+								ZoneId:   awssdk.String("usw2-wl1-las-wlz1"),
+								ZoneType: awssdk.String("wavelength-zone"),
+							},
+						},
+					},
+				},
+				describeRouteTablesAsListCalls: []describeRouteTablesAsListCall{
+					{
+						input: &ec2sdk.DescribeRouteTablesInput{
+							Filters: []ec2types.Filter{
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: []string{"vpc-1"},
+								},
+							},
+						},
+						output: []ec2types.RouteTable{
+							{
+								RouteTableId: awssdk.String("rtb-main"),
+								Associations: []ec2types.RouteTableAssociation{
+									{Main: awssdk.Bool(true)},
+								},
+								Routes: []ec2types.Route{
+									{
+										DestinationCidrBlock: awssdk.String("0.0.0.0/0"),
+										CarrierGatewayId:     awssdk.String("cagw-1"),
+										State:                ec2types.RouteStateActive,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				subnetNameOrIDs: []string{"subnet-1"},
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
+				},
+			},
+			want: []ec2types.Subnet{
+				{
+					SubnetId:           awssdk.String("subnet-1"),
+					AvailabilityZone:   awssdk.String("us-west-2-wl1-las-wlz-1"),
+					AvailabilityZoneId: awssdk.String("usw2-wl1-las-wlz1"),
+					VpcId:              awssdk.String("vpc-1"),
+				},
+			},
+		},
+		{
+			name: "internet-facing NLB in Wavelength Zone without carrier gateway route",
+			fields: fields{
+				vpcID:       "vpc-1",
+				clusterName: "kube-cluster",
+				describeSubnetsAsListCalls: []describeSubnetsAsListCall{
+					{
+						input: &ec2sdk.DescribeSubnetsInput{
+							SubnetIds: []string{"subnet-1"},
+						},
+						output: []ec2types.Subnet{
+							{
+								SubnetId:           awssdk.String("subnet-1"),
+								AvailabilityZone:   awssdk.String("us-west-2-wl1-las-wlz-1"),
+								AvailabilityZoneId: awssdk.String("usw2-wl1-las-wlz1"),
+								VpcId:              awssdk.String("vpc-1"),
+							},
+						},
+					},
+				},
+				fetchAZInfosCalls: []fetchAZInfosCall{
+					{
+						availabilityZoneIDs: []string{"usw2-wl1-las-wlz1"},
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
+							"usw2-wl1-las-wlz1": {
+								ZoneId:   awssdk.String("usw2-wl1-las-wlz1"),
+								ZoneType: awssdk.String("wavelength-zone"),
+							},
+						},
+					},
+				},
+				describeRouteTablesAsListCalls: []describeRouteTablesAsListCall{
+					{
+						input: &ec2sdk.DescribeRouteTablesInput{
+							Filters: []ec2types.Filter{
+								{
+									Name:   awssdk.String("vpc-id"),
+									Values: []string{"vpc-1"},
+								},
+							},
+						},
+						output: []ec2types.RouteTable{
+							{
+								RouteTableId: awssdk.String("rtb-main"),
+								Associations: []ec2types.RouteTableAssociation{
+									{Main: awssdk.Bool(true)},
+								},
+								Routes: []ec2types.Route{
+									{
+										DestinationCidrBlock: awssdk.String("0.0.0.0/0"),
+										CarrierGatewayId:     awssdk.String("cagw-1"),
+										State:                ec2types.RouteStateActive,
+									},
+								},
+							},
+							{
+								RouteTableId: awssdk.String("rtb-1"),
+								Associations: []ec2types.RouteTableAssociation{
+									{SubnetId: awssdk.String("subnet-1")},
+								},
+								Routes: []ec2types.Route{
+									{
+										DestinationCidrBlock: awssdk.String("0.0.0.0/0"),
+										GatewayId:            awssdk.String("igw-1"),
+										State:                ec2types.RouteStateActive,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			args: args{
+				subnetNameOrIDs: []string{"subnet-1"},
+				opts: []SubnetsResolveOption{
+					WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
+					WithSubnetsResolveLBScheme(elbv2model.LoadBalancerSchemeInternetFacing),
+				},
+			},
+			wantErr: errors.New("internet-facing load balancers in Wavelength Zones require subnets routing to a carrier gateway, subnets without such route: [subnet-1], see https://docs.aws.amazon.com/vpc/latest/userguide/Carrier_Gateway.html"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -1746,12 +2421,16 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 			for _, call := range tt.fields.fetchAZInfosCalls {
 				azInfoProvider.EXPECT().FetchAZInfos(gomock.Any(), call.availabilityZoneIDs).Return(call.azInfoByAZID, call.err)
 			}
+			for _, call := range tt.fields.describeRouteTablesAsListCalls {
+				ec2Client.EXPECT().DescribeRouteTablesAsList(gomock.Any(), call.input).Return(call.output, call.err)
+			}
 
 			r := &defaultSubnetsResolver{
 				azInfoProvider: azInfoProvider,
 				ec2Client:      ec2Client,
 				vpcID:          tt.fields.vpcID,
 				clusterName:    tt.fields.clusterName,
+				allowedZones:   tt.fields.allowedZones,
 				logger:         logr.New(&log.NullLogSink{}),
 			}
 			got, err := r.ResolveViaNameOrIDSlice(context.Background(), tt.args.subnetNameOrIDs, tt.args.opts...)
@@ -1759,9 +2438,12 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
 				assert.NoError(t, err)
-				opts := cmpopts.SortSlices(func(lhs *ec2sdk.Subnet, rhs *ec2sdk.Subnet) bool {
-					return awssdk.StringValue(lhs.SubnetId) < awssdk.StringValue(rhs.SubnetId)
-				})
+				opts := cmp.Options{
+					cmpopts.IgnoreUnexported(ec2types.Subnet{}, ec2types.Tag{}),
+					cmpopts.SortSlices(func(lhs ec2types.Subnet, rhs ec2types.Subnet) bool {
+						return awssdk.ToString(lhs.SubnetId) < awssdk.ToString(rhs.SubnetId)
+					}),
+				}
 				assert.True(t, cmp.Equal(tt.want, got, opts), "diff", cmp.Diff(tt.want, got, opts))
 			}
 		})
@@ -1771,14 +2453,14 @@ func Test_defaultSubnetsResolver_ResolveViaNameOrIDSlice(t *testing.T) {
 func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 	type fetchAZInfosCall struct {
 		availabilityZoneIDs []string
-		azInfoByAZID        map[string]ec2sdk.AvailabilityZone
+		azInfoByAZID        map[string]ec2types.AvailabilityZone
 		err                 error
 	}
 	type fields struct {
 		fetchAZInfosCalls []fetchAZInfosCall
 	}
 	type args struct {
-		subnet *ec2sdk.Subnet
+		subnet *ec2types.Subnet
 	}
 	tests := []struct {
 		name    string
@@ -1793,7 +2475,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("availability-zone"),
@@ -1803,7 +2485,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				},
 			},
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
 					AvailabilityZoneId: awssdk.String("usw2-az1"),
@@ -1818,7 +2500,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-lax1-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-lax1-az1": {
 								ZoneId:   awssdk.String("usw2-lax1-az1"),
 								ZoneType: awssdk.String("local-zone"),
@@ -1828,7 +2510,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				},
 			},
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2-lax-1a"),
 					AvailabilityZoneId: awssdk.String("usw2-lax1-az1"),
@@ -1843,7 +2525,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-wl1-las-wlz1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-wl1-las-wlz1": {
 								ZoneId:   awssdk.String("usw2-lax1-az1"),
 								ZoneType: awssdk.String("wavelength-zone"),
@@ -1853,7 +2535,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				},
 			},
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2-wl1-las-wlz-1"),
 					AvailabilityZoneId: awssdk.String("usw2-wl1-las-wlz1"),
@@ -1868,7 +2550,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				fetchAZInfosCalls: nil,
 			},
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
 					AvailabilityZoneId: awssdk.String("usw2-az1"),
@@ -1889,7 +2571,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				},
 			},
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("invalid-zone"),
 					AvailabilityZoneId: awssdk.String("invalid-zone-id"),
@@ -1904,7 +2586,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				fetchAZInfosCalls: []fetchAZInfosCall{
 					{
 						availabilityZoneIDs: []string{"usw2-az1"},
-						azInfoByAZID: map[string]ec2sdk.AvailabilityZone{
+						azInfoByAZID: map[string]ec2types.AvailabilityZone{
 							"usw2-az1": {
 								ZoneId:   awssdk.String("usw2-az1"),
 								ZoneType: awssdk.String("unknown"),
@@ -1914,7 +2596,7 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 				},
 			},
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					SubnetId:           awssdk.String("subnet-1"),
 					AvailabilityZone:   awssdk.String("us-west-2a"),
 					AvailabilityZoneId: awssdk.String("usw2-az1"),
@@ -1950,17 +2632,17 @@ func Test_defaultSubnetsResolver_buildSDKSubnetLocaleType(t *testing.T) {
 
 func Test_sortSubnetsByID(t *testing.T) {
 	type args struct {
-		subnets []*ec2sdk.Subnet
+		subnets []ec2types.Subnet
 	}
 	tests := []struct {
 		name        string
 		args        args
-		wantSubnets []*ec2sdk.Subnet
+		wantSubnets []ec2types.Subnet
 	}{
 		{
 			name: "subnets already sorted",
 			args: args{
-				subnets: []*ec2sdk.Subnet{
+				subnets: []ec2types.Subnet{
 					{
 						SubnetId: awssdk.String("subnet-a"),
 					},
@@ -1971,7 +2653,7 @@ func Test_sortSubnetsByID(t *testing.T) {
 					},
 				},
 			},
-			wantSubnets: []*ec2sdk.Subnet{
+			wantSubnets: []ec2types.Subnet{
 				{
 					SubnetId: awssdk.String("subnet-a"),
 				},
@@ -1985,7 +2667,7 @@ func Test_sortSubnetsByID(t *testing.T) {
 		{
 			name: "subnets not sorted",
 			args: args{
-				subnets: []*ec2sdk.Subnet{
+				subnets: []ec2types.Subnet{
 					{
 						SubnetId: awssdk.String("subnet-c"),
 					},
@@ -1997,7 +2679,7 @@ func Test_sortSubnetsByID(t *testing.T) {
 					},
 				},
 			},
-			wantSubnets: []*ec2sdk.Subnet{
+			wantSubnets: []ec2types.Subnet{
 				{
 					SubnetId: awssdk.String("subnet-a"),
 				},
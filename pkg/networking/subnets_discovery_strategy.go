@@ -0,0 +1,91 @@
+package networking
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// SubnetsDiscoveryStrategyTag is the name of the default subnets discovery strategy, which discovers subnets by role tags.
+	SubnetsDiscoveryStrategyTag = "tag"
+)
+
+// SubnetsDiscoveryStrategy decides which subnets are discovered for Load Balancers without explicit subnets configuration.
+type SubnetsDiscoveryStrategy interface {
+	// BuildSubnetSelector builds the SubnetSelector to resolve discovered subnets with.
+	// The chosen subnets are validated the same way as subnets from an explicit SubnetSelector.
+	BuildSubnetSelector(ctx context.Context, resolveOpts SubnetsResolveOptions) (*elbv2api.SubnetSelector, error)
+}
+
+// SubnetsDiscoveryStrategyFactory constructs a SubnetsDiscoveryStrategy for the EC2 client of an AWS account.
+type SubnetsDiscoveryStrategyFactory func(ec2Client services.EC2, vpcID string, clusterName string, logger logr.Logger) SubnetsDiscoveryStrategy
+
+var (
+	subnetsDiscoveryStrategyFactoriesMutex sync.RWMutex
+	subnetsDiscoveryStrategyFactories      = map[string]SubnetsDiscoveryStrategyFactory{
+		SubnetsDiscoveryStrategyTag: func(_ services.EC2, _ string, _ string, _ logr.Logger) SubnetsDiscoveryStrategy {
+			return NewTagSubnetsDiscoveryStrategy()
+		},
+	}
+)
+
+// RegisterSubnetsDiscoveryStrategy registers a custom SubnetsDiscoveryStrategy, which can be selected by name with the
+// subnets-discovery-strategy flag. It's expected to be invoked from init functions.
+func RegisterSubnetsDiscoveryStrategy(name string, factory SubnetsDiscoveryStrategyFactory) {
+	subnetsDiscoveryStrategyFactoriesMutex.Lock()
+	defer subnetsDiscoveryStrategyFactoriesMutex.Unlock()
+	if _, exists := subnetsDiscoveryStrategyFactories[name]; exists {
+		panic(errors.Errorf("subnets discovery strategy %v already registered", name))
+	}
+	subnetsDiscoveryStrategyFactories[name] = factory
+}
+
+// GetSubnetsDiscoveryStrategyFactory returns the SubnetsDiscoveryStrategyFactory registered with name.
+func GetSubnetsDiscoveryStrategyFactory(name string) (SubnetsDiscoveryStrategyFactory, error) {
+	subnetsDiscoveryStrategyFactoriesMutex.RLock()
+	defer subnetsDiscoveryStrategyFactoriesMutex.RUnlock()
+	factory, exists := subnetsDiscoveryStrategyFactories[name]
+	if !exists {
+		registeredNames := make([]string, 0, len(subnetsDiscoveryStrategyFactories))
+		for registeredName := range subnetsDiscoveryStrategyFactories {
+			registeredNames = append(registeredNames, registeredName)
+		}
+		sort.Strings(registeredNames)
+		return nil, errors.Errorf("unknown subnets discovery strategy %v, must be one of %v", name, registeredNames)
+	}
+	return factory, nil
+}
+
+// NewTagSubnetsDiscoveryStrategy constructs new tagSubnetsDiscoveryStrategy.
+func NewTagSubnetsDiscoveryStrategy() *tagSubnetsDiscoveryStrategy {
+	return &tagSubnetsDiscoveryStrategy{}
+}
+
+var _ SubnetsDiscoveryStrategy = &tagSubnetsDiscoveryStrategy{}
+
+// tagSubnetsDiscoveryStrategy discovers subnets by role tags.
+//   - for internet-facing Load Balancer, "kubernetes.io/role/elb" tag must be present.
+//   - for internal Load Balancer, "kubernetes.io/role/internal-elb" tag must be present.
+type tagSubnetsDiscoveryStrategy struct{}
+
+func (s *tagSubnetsDiscoveryStrategy) BuildSubnetSelector(_ context.Context, resolveOpts SubnetsResolveOptions) (*elbv2api.SubnetSelector, error) {
+	subnetRoleTagKey := ""
+	switch resolveOpts.LBScheme {
+	case elbv2model.LoadBalancerSchemeInternal:
+		subnetRoleTagKey = TagKeySubnetInternalELB
+	case elbv2model.LoadBalancerSchemeInternetFacing:
+		subnetRoleTagKey = TagKeySubnetPublicELB
+	}
+	return &elbv2api.SubnetSelector{
+		Tags: map[string][]string{
+			subnetRoleTagKey: {"", "1"},
+		},
+	}, nil
+}
@@ -0,0 +1,101 @@
+package networking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_tagSubnetsDiscoveryStrategy_BuildSubnetSelector(t *testing.T) {
+	tests := []struct {
+		name        string
+		resolveOpts SubnetsResolveOptions
+		want        *elbv2api.SubnetSelector
+	}{
+		{
+			name: "internet-facing load balancer",
+			resolveOpts: SubnetsResolveOptions{
+				LBScheme: elbv2model.LoadBalancerSchemeInternetFacing,
+			},
+			want: &elbv2api.SubnetSelector{
+				Tags: map[string][]string{
+					"kubernetes.io/role/elb": {"", "1"},
+				},
+			},
+		},
+		{
+			name: "internal load balancer",
+			resolveOpts: SubnetsResolveOptions{
+				LBScheme: elbv2model.LoadBalancerSchemeInternal,
+			},
+			want: &elbv2api.SubnetSelector{
+				Tags: map[string][]string{
+					"kubernetes.io/role/internal-elb": {"", "1"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewTagSubnetsDiscoveryStrategy()
+			got, err := s.BuildSubnetSelector(context.Background(), tt.resolveOpts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type staticSubnetsDiscoveryStrategy struct {
+	subnetIDs []elbv2api.SubnetID
+}
+
+func (s *staticSubnetsDiscoveryStrategy) BuildSubnetSelector(_ context.Context, _ SubnetsResolveOptions) (*elbv2api.SubnetSelector, error) {
+	return &elbv2api.SubnetSelector{IDs: s.subnetIDs}, nil
+}
+
+func Test_GetSubnetsDiscoveryStrategyFactory(t *testing.T) {
+	RegisterSubnetsDiscoveryStrategy("static-test", func(_ services.EC2, _ string, _ string, _ logr.Logger) SubnetsDiscoveryStrategy {
+		return &staticSubnetsDiscoveryStrategy{subnetIDs: []elbv2api.SubnetID{"subnet-1"}}
+	})
+	tests := []struct {
+		name         string
+		strategyName string
+		want         SubnetsDiscoveryStrategy
+		wantErr      error
+	}{
+		{
+			name:         "default tag strategy",
+			strategyName: SubnetsDiscoveryStrategyTag,
+			want:         NewTagSubnetsDiscoveryStrategy(),
+		},
+		{
+			name:         "custom strategy",
+			strategyName: "static-test",
+			want:         &staticSubnetsDiscoveryStrategy{subnetIDs: []elbv2api.SubnetID{"subnet-1"}},
+		},
+		{
+			name:         "unknown strategy",
+			strategyName: "unknown",
+			wantErr:      errors.New("unknown subnets discovery strategy unknown, must be one of [static-test tag]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			factory, err := GetSubnetsDiscoveryStrategyFactory(tt.strategyName)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				got := factory(nil, "vpc-1", "cluster", logr.New(&log.NullLogSink{}))
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -1,8 +1,8 @@
 package networking
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"net/netip"
 )
 
@@ -43,12 +43,49 @@ func FilterIPsWithinCIDRs(ips []netip.Addr, cidrs []netip.Prefix) []netip.Addr {
 	return ipsWithinCIDRs
 }
 
+// ExcludeCIDRs returns the minimal CIDRs that cover the addresses within cidr, except the addresses within excludedCIDRs.
+// CIDRs of other address family than cidr are ignored.
+func ExcludeCIDRs(cidr netip.Prefix, excludedCIDRs []netip.Prefix) []netip.Prefix {
+	cidr = cidr.Masked()
+	overlapped := false
+	for _, excludedCIDR := range excludedCIDRs {
+		if excludedCIDR.Addr().Is4() != cidr.Addr().Is4() || !excludedCIDR.Overlaps(cidr) {
+			continue
+		}
+		if excludedCIDR.Bits() <= cidr.Bits() {
+			return nil
+		}
+		overlapped = true
+	}
+	if !overlapped {
+		return []netip.Prefix{cidr}
+	}
+	lowerHalf, upperHalf := splitCIDR(cidr)
+	return append(ExcludeCIDRs(lowerHalf, excludedCIDRs), ExcludeCIDRs(upperHalf, excludedCIDRs)...)
+}
+
+// splitCIDR splits cidr into its lower and upper halves, cidr must not be a single address.
+func splitCIDR(cidr netip.Prefix) (netip.Prefix, netip.Prefix) {
+	bits := cidr.Bits()
+	upperAddr := cidr.Addr().As16()
+	offset := 0
+	if cidr.Addr().Is4() {
+		offset = 96
+	}
+	upperAddr[(offset+bits)/8] |= 0x80 >> ((offset + bits) % 8)
+	upper := netip.AddrFrom16(upperAddr)
+	if cidr.Addr().Is4() {
+		upper = upper.Unmap()
+	}
+	return netip.PrefixFrom(cidr.Addr(), bits+1), netip.PrefixFrom(upper, bits+1)
+}
+
 // GetSubnetAssociatedIPv4CIDRs returns the IPv4 CIDRs associated with EC2 subnet
-func GetSubnetAssociatedIPv4CIDRs(subnet *ec2sdk.Subnet) ([]netip.Prefix, error) {
+func GetSubnetAssociatedIPv4CIDRs(subnet *ec2types.Subnet) ([]netip.Prefix, error) {
 	if subnet.CidrBlock == nil {
 		return nil, nil
 	}
-	cidrBlock := awssdk.StringValue(subnet.CidrBlock)
+	cidrBlock := awssdk.ToString(subnet.CidrBlock)
 	ipv4CIDR, err := netip.ParsePrefix(cidrBlock)
 	if err != nil {
 		return nil, err
@@ -57,13 +94,13 @@ func GetSubnetAssociatedIPv4CIDRs(subnet *ec2sdk.Subnet) ([]netip.Prefix, error)
 }
 
 // GetSubnetAssociatedIPv6CIDRs returns the IPv6 CIDRs associated with EC2 subnet
-func GetSubnetAssociatedIPv6CIDRs(subnet *ec2sdk.Subnet) ([]netip.Prefix, error) {
+func GetSubnetAssociatedIPv6CIDRs(subnet *ec2types.Subnet) ([]netip.Prefix, error) {
 	var ipv6CIDRs []netip.Prefix
 	for _, cidrAssociation := range subnet.Ipv6CidrBlockAssociationSet {
-		if awssdk.StringValue(cidrAssociation.Ipv6CidrBlockState.State) != ec2sdk.SubnetCidrBlockStateCodeAssociated {
+		if cidrAssociation.Ipv6CidrBlockState.State != ec2types.SubnetCidrBlockStateCodeAssociated {
 			continue
 		}
-		cidrBlock := awssdk.StringValue(cidrAssociation.Ipv6CidrBlock)
+		cidrBlock := awssdk.ToString(cidrAssociation.Ipv6CidrBlock)
 		ipv6CIDR, err := netip.ParsePrefix(cidrBlock)
 		if err != nil {
 			return nil, err
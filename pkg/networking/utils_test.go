@@ -1,8 +1,8 @@
 package networking
 
 import (
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 
@@ -228,9 +228,106 @@ func TestFilterIPsWithinCIDRs(t *testing.T) {
 	}
 }
 
+func TestExcludeCIDRs(t *testing.T) {
+	type args struct {
+		cidr          netip.Prefix
+		excludedCIDRs []netip.Prefix
+	}
+	tests := []struct {
+		name string
+		args args
+		want []netip.Prefix
+	}{
+		{
+			name: "no excluded CIDRs",
+			args: args{
+				cidr: netip.MustParsePrefix("0.0.0.0/0"),
+			},
+			want: []netip.Prefix{
+				netip.MustParsePrefix("0.0.0.0/0"),
+			},
+		},
+		{
+			name: "excluded CIDRs don't overlap",
+			args: args{
+				cidr: netip.MustParsePrefix("10.0.0.0/16"),
+				excludedCIDRs: []netip.Prefix{
+					netip.MustParsePrefix("192.168.0.0/16"),
+					netip.MustParsePrefix("2001:db8::/32"),
+				},
+			},
+			want: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.0/16"),
+			},
+		},
+		{
+			name: "exclude ipv4 CIDR",
+			args: args{
+				cidr: netip.MustParsePrefix("0.0.0.0/0"),
+				excludedCIDRs: []netip.Prefix{
+					netip.MustParsePrefix("64.0.0.0/2"),
+				},
+			},
+			want: []netip.Prefix{
+				netip.MustParsePrefix("0.0.0.0/2"),
+				netip.MustParsePrefix("128.0.0.0/1"),
+			},
+		},
+		{
+			name: "exclude multiple ipv4 CIDRs",
+			args: args{
+				cidr: netip.MustParsePrefix("10.0.0.0/24"),
+				excludedCIDRs: []netip.Prefix{
+					netip.MustParsePrefix("10.0.0.0/26"),
+					netip.MustParsePrefix("10.0.0.255/32"),
+				},
+			},
+			want: []netip.Prefix{
+				netip.MustParsePrefix("10.0.0.64/26"),
+				netip.MustParsePrefix("10.0.0.128/26"),
+				netip.MustParsePrefix("10.0.0.192/27"),
+				netip.MustParsePrefix("10.0.0.224/28"),
+				netip.MustParsePrefix("10.0.0.240/29"),
+				netip.MustParsePrefix("10.0.0.248/30"),
+				netip.MustParsePrefix("10.0.0.252/31"),
+				netip.MustParsePrefix("10.0.0.254/32"),
+			},
+		},
+		{
+			name: "exclude ipv6 CIDR",
+			args: args{
+				cidr: netip.MustParsePrefix("2001:db8::/30"),
+				excludedCIDRs: []netip.Prefix{
+					netip.MustParsePrefix("2001:db8::/32"),
+				},
+			},
+			want: []netip.Prefix{
+				netip.MustParsePrefix("2001:db9::/32"),
+				netip.MustParsePrefix("2001:dba::/31"),
+			},
+		},
+		{
+			name: "excluded CIDR covers CIDR",
+			args: args{
+				cidr: netip.MustParsePrefix("10.0.0.0/24"),
+				excludedCIDRs: []netip.Prefix{
+					netip.MustParsePrefix("10.0.0.0/8"),
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExcludeCIDRs(tt.args.cidr, tt.args.excludedCIDRs)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestGetSubnetAssociatedIPv4CIDRs(t *testing.T) {
 	type args struct {
-		subnet *ec2sdk.Subnet
+		subnet *ec2types.Subnet
 	}
 	tests := []struct {
 		name    string
@@ -241,7 +338,7 @@ func TestGetSubnetAssociatedIPv4CIDRs(t *testing.T) {
 		{
 			name: "one IPv4 CIDR",
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					CidrBlock: awssdk.String("192.168.1.0/24"),
 				},
 			},
@@ -264,7 +361,7 @@ func TestGetSubnetAssociatedIPv4CIDRs(t *testing.T) {
 
 func TestGetSubnetAssociatedIPv6CIDRs(t *testing.T) {
 	type args struct {
-		subnet *ec2sdk.Subnet
+		subnet *ec2types.Subnet
 	}
 	tests := []struct {
 		name    string
@@ -275,13 +372,13 @@ func TestGetSubnetAssociatedIPv6CIDRs(t *testing.T) {
 		{
 			name: "one IPv6 CIDR",
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					CidrBlock: awssdk.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2sdk.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: awssdk.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2sdk.SubnetCidrBlockState{
-								State: awssdk.String(ec2sdk.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -294,19 +391,19 @@ func TestGetSubnetAssociatedIPv6CIDRs(t *testing.T) {
 		{
 			name: "multiple IPv6 CIDR",
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					CidrBlock: awssdk.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2sdk.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: awssdk.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2sdk.SubnetCidrBlockState{
-								State: awssdk.String(ec2sdk.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 						{
 							Ipv6CidrBlock: awssdk.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2sdk.SubnetCidrBlockState{
-								State: awssdk.String(ec2sdk.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -320,7 +417,7 @@ func TestGetSubnetAssociatedIPv6CIDRs(t *testing.T) {
 		{
 			name: "zero IPv6 CIDR",
 			args: args{
-				subnet: &ec2sdk.Subnet{
+				subnet: &ec2types.Subnet{
 					CidrBlock: awssdk.String("192.168.1.0/24"),
 				},
 			},
@@ -0,0 +1,122 @@
+package runtime
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// AnnotationKeyDebugLoggingUntil is the annotation on Ingresses, Services and TargetGroupBindings,
+	// which raises the log verbosity of the log lines carrying their correlation IDs until the RFC3339 timestamp.
+	AnnotationKeyDebugLoggingUntil = "elbv2.k8s.aws/debug-logging-until"
+	// MaxDebugLoggingWindow is the maximum duration ahead the debug logging can be requested for.
+	MaxDebugLoggingWindow = 24 * time.Hour
+)
+
+// NewDebugLoggingTargets constructs new DebugLoggingTargets.
+func NewDebugLoggingTargets() *DebugLoggingTargets {
+	return &DebugLoggingTargets{
+		untilByID: make(map[string]time.Time),
+		now:       time.Now,
+	}
+}
+
+// DebugLoggingTargets tracks the correlation IDs whose log lines are logged at debug verbosity, each for a bounded time window.
+// it's safe to call methods on nil DebugLoggingTargets, which has no targets.
+type DebugLoggingTargets struct {
+	mutex     sync.RWMutex
+	untilByID map[string]time.Time
+	now       func() time.Time
+}
+
+// Configure enables debug logging for the correlation IDs of an object until the time of its AnnotationKeyDebugLoggingUntil annotation,
+// or disables it if the annotation is absent.
+func (t *DebugLoggingTargets) Configure(annotations map[string]string, correlationIDs ...string) error {
+	if t == nil {
+		return nil
+	}
+	rawUntil, exists := annotations[AnnotationKeyDebugLoggingUntil]
+	if !exists {
+		t.disable(correlationIDs...)
+		return nil
+	}
+	until, err := time.Parse(time.RFC3339, rawUntil)
+	if err != nil {
+		t.disable(correlationIDs...)
+		return errors.Wrapf(err, "failed to parse annotation %v", AnnotationKeyDebugLoggingUntil)
+	}
+	if until.Sub(t.now()) > MaxDebugLoggingWindow {
+		t.disable(correlationIDs...)
+		return errors.Errorf("annotation %v must be within %v from now, got %v", AnnotationKeyDebugLoggingUntil, MaxDebugLoggingWindow, rawUntil)
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	// the expired targets of deleted objects are pruned along the way.
+	now := t.now()
+	for id, idUntil := range t.untilByID {
+		if !now.Before(idUntil) {
+			delete(t.untilByID, id)
+		}
+	}
+	for _, id := range correlationIDs {
+		t.untilByID[id] = until
+	}
+	return nil
+}
+
+func (t *DebugLoggingTargets) disable(correlationIDs ...string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for _, id := range correlationIDs {
+		delete(t.untilByID, id)
+	}
+}
+
+// Active returns whether debug logging is enabled for any correlation ID.
+func (t *DebugLoggingTargets) Active() bool {
+	if t == nil {
+		return false
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	now := t.now()
+	for _, until := range t.untilByID {
+		if now.Before(until) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns whether any of the logged values is a correlation ID with debug logging enabled.
+// values are either strings or fmt.Stringers, such as the namespaced names of objects.
+func (t *DebugLoggingTargets) Matches(values []interface{}) bool {
+	if t == nil {
+		return false
+	}
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	if len(t.untilByID) == 0 {
+		return false
+	}
+	now := t.now()
+	for _, value := range values {
+		var id string
+		switch v := value.(type) {
+		case string:
+			id = v
+		case fmt.Stringer:
+			id = v.String()
+		default:
+			continue
+		}
+		if until, ok := t.untilByID[id]; ok && now.Before(until) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDebugLoggingTargets_Configure(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name            string
+		existingTargets map[string]time.Time
+		annotations     map[string]string
+		correlationIDs  []string
+		wantTargets     map[string]time.Time
+		wantErr         error
+	}{
+		{
+			name: "debug logging requested",
+			annotations: map[string]string{
+				"elbv2.k8s.aws/debug-logging-until": "2023-06-01T14:00:00Z",
+			},
+			correlationIDs: []string{"ns/ing", "group"},
+			wantTargets: map[string]time.Time{
+				"ns/ing": now.Add(2 * time.Hour),
+				"group":  now.Add(2 * time.Hour),
+			},
+		},
+		{
+			name: "annotation removed",
+			existingTargets: map[string]time.Time{
+				"ns/ing":   now.Add(2 * time.Hour),
+				"ns/other": now.Add(2 * time.Hour),
+			},
+			correlationIDs: []string{"ns/ing"},
+			wantTargets: map[string]time.Time{
+				"ns/other": now.Add(2 * time.Hour),
+			},
+		},
+		{
+			name: "expired targets are pruned",
+			existingTargets: map[string]time.Time{
+				"ns/expired": now.Add(-time.Minute),
+			},
+			annotations: map[string]string{
+				"elbv2.k8s.aws/debug-logging-until": "2023-06-01T12:30:00Z",
+			},
+			correlationIDs: []string{"ns/svc"},
+			wantTargets: map[string]time.Time{
+				"ns/svc": now.Add(30 * time.Minute),
+			},
+		},
+		{
+			name: "annotation beyond max debug logging window",
+			existingTargets: map[string]time.Time{
+				"ns/svc": now.Add(time.Hour),
+			},
+			annotations: map[string]string{
+				"elbv2.k8s.aws/debug-logging-until": "2023-06-03T12:00:00Z",
+			},
+			correlationIDs: []string{"ns/svc"},
+			wantTargets:    map[string]time.Time{},
+			wantErr:        errors.New("annotation elbv2.k8s.aws/debug-logging-until must be within 24h0m0s from now, got 2023-06-03T12:00:00Z"),
+		},
+		{
+			name: "invalid annotation",
+			annotations: map[string]string{
+				"elbv2.k8s.aws/debug-logging-until": "2h",
+			},
+			correlationIDs: []string{"ns/svc"},
+			wantTargets:    map[string]time.Time{},
+			wantErr:        errors.New("failed to parse annotation elbv2.k8s.aws/debug-logging-until: parsing time \"2h\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"2h\" as \"2006\""),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := NewDebugLoggingTargets()
+			targets.now = func() time.Time { return now }
+			for id, until := range tt.existingTargets {
+				targets.untilByID[id] = until
+			}
+			err := targets.Configure(tt.annotations, tt.correlationIDs...)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantTargets, targets.untilByID)
+		})
+	}
+}
+
+func TestDebugLoggingTargets_Matches(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	targets := NewDebugLoggingTargets()
+	targets.now = func() time.Time { return now }
+	targets.untilByID = map[string]time.Time{
+		"ns/svc":     now.Add(time.Hour),
+		"ns/expired": now.Add(-time.Hour),
+	}
+	tests := []struct {
+		name    string
+		targets *DebugLoggingTargets
+		values  []interface{}
+		want    bool
+	}{
+		{
+			name:    "string value matches",
+			targets: targets,
+			values:  []interface{}{"service", "ns/svc"},
+			want:    true,
+		},
+		{
+			name:    "stringer value matches",
+			targets: targets,
+			values:  []interface{}{"service", types.NamespacedName{Namespace: "ns", Name: "svc"}},
+			want:    true,
+		},
+		{
+			name:    "expired target doesn't match",
+			targets: targets,
+			values:  []interface{}{"service", "ns/expired"},
+			want:    false,
+		},
+		{
+			name:    "other values don't match",
+			targets: targets,
+			values:  []interface{}{"service", "ns/other", "count", 3},
+			want:    false,
+		},
+		{
+			name:    "nil targets",
+			targets: nil,
+			values:  []interface{}{"service", "ns/svc"},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.targets.Matches(tt.values))
+		})
+	}
+}
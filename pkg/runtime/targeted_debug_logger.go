@@ -0,0 +1,59 @@
+package runtime
+
+import "github.com/go-logr/logr"
+
+// MaxDebugLoggingVerbosity is the highest verbosity logged for the correlation IDs of DebugLoggingTargets.
+const MaxDebugLoggingVerbosity = 5
+
+// NewTargetedDebugLogger constructs new targetedDebugLogger.
+// logger must be enabled up to MaxDebugLoggingVerbosity, the log lines above baseVerbosity are only logged for the correlation IDs of targets.
+func NewTargetedDebugLogger(logger logr.Logger, baseVerbosity int, targets *DebugLoggingTargets) logr.Logger {
+	return logr.New(&targetedDebugLogger{LogSink: logger.GetSink(), baseVerbosity: baseVerbosity, targets: targets})
+}
+
+var _ logr.LogSink = &targetedDebugLogger{}
+
+// targetedDebugLogger raises the log verbosity for specific resources only,
+// so that debugging a single resource doesn't flood the logs with debug lines of all resources.
+type targetedDebugLogger struct {
+	logr.LogSink
+	baseVerbosity int
+	targets       *DebugLoggingTargets
+	// values are the key/value pairs accumulated via WithValues.
+	values []interface{}
+}
+
+func (r *targetedDebugLogger) Enabled(level int) bool {
+	if level > r.baseVerbosity && !r.targets.Active() {
+		return false
+	}
+	return r.LogSink.Enabled(level)
+}
+
+func (r *targetedDebugLogger) Info(level int, msg string, keysAndValues ...interface{}) {
+	if level > r.baseVerbosity && !r.targets.Matches(r.values) && !r.targets.Matches(keysAndValues) {
+		return
+	}
+	r.LogSink.Info(level, msg, keysAndValues...)
+}
+
+func (r *targetedDebugLogger) WithValues(keysAndValues ...interface{}) logr.LogSink {
+	values := make([]interface{}, 0, len(r.values)+len(keysAndValues))
+	values = append(values, r.values...)
+	values = append(values, keysAndValues...)
+	return &targetedDebugLogger{
+		LogSink:       r.LogSink.WithValues(keysAndValues...),
+		baseVerbosity: r.baseVerbosity,
+		targets:       r.targets,
+		values:        values,
+	}
+}
+
+func (r *targetedDebugLogger) WithName(name string) logr.LogSink {
+	return &targetedDebugLogger{
+		LogSink:       r.LogSink.WithName(name),
+		baseVerbosity: r.baseVerbosity,
+		targets:       r.targets,
+		values:        r.values,
+	}
+}
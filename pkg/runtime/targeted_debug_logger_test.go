@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_targetedDebugLogger(t *testing.T) {
+	targets := NewDebugLoggingTargets()
+	var loggedLines []string
+	baseLogger := funcr.New(func(_, args string) {
+		loggedLines = append(loggedLines, args)
+	}, funcr.Options{Verbosity: MaxDebugLoggingVerbosity})
+	logger := NewTargetedDebugLogger(baseLogger, 0, targets)
+
+	logger.Info("info line", "service", "ns/svc")
+	logger.V(1).Info("debug line without targets", "service", "ns/svc")
+	assert.NoError(t, targets.Configure(map[string]string{
+		AnnotationKeyDebugLoggingUntil: time.Now().Add(time.Hour).Format(time.RFC3339),
+	}, "ns/svc"))
+	logger.V(1).Info("debug line of target", "service", "ns/svc")
+	logger.WithValues("service", "ns/svc").WithName("deployer").V(5).Info("debug line of target from values")
+	logger.V(1).Info("debug line of other service", "service", "ns/other")
+	logger.V(6).Info("debug line above max verbosity", "service", "ns/svc")
+
+	assert.Equal(t, []string{
+		`"level"=0 "msg"="info line" "service"="ns/svc"`,
+		`"level"=1 "msg"="debug line of target" "service"="ns/svc"`,
+		`"level"=5 "msg"="debug line of target from values" "service"="ns/svc"`,
+	}, loggedLines)
+}
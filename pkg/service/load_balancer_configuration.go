@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrInvalidLoadBalancerConfiguration is an sentinel error that represents the LoadBalancerConfiguration referenced by Service is invalid.
+var ErrInvalidLoadBalancerConfiguration = errors.New("invalid load balancer configuration")
+
+// LoadBalancerConfigurationLoader loads the LoadBalancerConfiguration referenced by Services.
+type LoadBalancerConfigurationLoader interface {
+	// Load returns the LoadBalancerConfiguration referenced by Service, or nil if it doesn't reference any.
+	Load(ctx context.Context, svc *corev1.Service) (*elbv2api.LoadBalancerConfiguration, error)
+}
+
+// NewDefaultLoadBalancerConfigurationLoader constructs new defaultLoadBalancerConfigurationLoader instance.
+func NewDefaultLoadBalancerConfigurationLoader(k8sClient client.Client, annotationParser annotations.Parser) *defaultLoadBalancerConfigurationLoader {
+	return &defaultLoadBalancerConfigurationLoader{
+		k8sClient:        k8sClient,
+		annotationParser: annotationParser,
+	}
+}
+
+var _ LoadBalancerConfigurationLoader = &defaultLoadBalancerConfigurationLoader{}
+
+// default implementation for LoadBalancerConfigurationLoader
+type defaultLoadBalancerConfigurationLoader struct {
+	k8sClient        client.Client
+	annotationParser annotations.Parser
+}
+
+func (l *defaultLoadBalancerConfigurationLoader) Load(ctx context.Context, svc *corev1.Service) (*elbv2api.LoadBalancerConfiguration, error) {
+	lbConfigurationName := ""
+	if exists := l.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerConfiguration, &lbConfigurationName, svc.Annotations); !exists {
+		return nil, nil
+	}
+	lbConfiguration := &elbv2api.LoadBalancerConfiguration{}
+	if err := l.k8sClient.Get(ctx, types.NamespacedName{Name: lbConfigurationName}, lbConfiguration); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidLoadBalancerConfiguration, err.Error())
+		}
+		return nil, err
+	}
+	if err := l.validateNamespaceRestriction(ctx, svc, lbConfiguration); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLoadBalancerConfiguration, err.Error())
+	}
+	return lbConfiguration, nil
+}
+
+func (l *defaultLoadBalancerConfigurationLoader) validateNamespaceRestriction(ctx context.Context, svc *corev1.Service, lbConfiguration *elbv2api.LoadBalancerConfiguration) error {
+	// when namespaceSelector is empty, it matches every namespace
+	if lbConfiguration.Spec.NamespaceSelector == nil {
+		return nil
+	}
+
+	svcNamespace := svc.Namespace
+	// see https://github.com/kubernetes/kubernetes/issues/88282 and https://github.com/kubernetes/kubernetes/issues/76680
+	if admissionReq := webhook.ContextGetAdmissionRequest(ctx); admissionReq != nil {
+		svcNamespace = admissionReq.Namespace
+	}
+	svcNS := &corev1.Namespace{}
+	if err := l.k8sClient.Get(ctx, types.NamespacedName{Name: svcNamespace}, svcNS); err != nil {
+		return err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(lbConfiguration.Spec.NamespaceSelector)
+	if err != nil {
+		return err
+	}
+	if !selector.Matches(labels.Set(svcNS.Labels)) {
+		return errors.Errorf("namespaceSelector of LoadBalancerConfiguration %v mismatch", lbConfiguration.Name)
+	}
+	return nil
+}
+
+// FindLoadBalancerConfigurationConflicts returns the suffixes of Service annotations that conflict with the settings
+// the LoadBalancerConfiguration enforces, which are the scheme, subnets, securityGroups, loadBalancer attributes and tags.
+// The target group settings of LoadBalancerConfiguration are defaults, thus never conflict.
+func FindLoadBalancerConfigurationConflicts(svc *corev1.Service, lbConfiguration *elbv2api.LoadBalancerConfiguration,
+	annotationParser annotations.Parser) ([]string, error) {
+	var conflicts []string
+	lbCfgSpec := lbConfiguration.Spec
+	if lbCfgSpec.Scheme != nil {
+		rawScheme := ""
+		if exists := annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixScheme, &rawScheme, svc.Annotations); exists && rawScheme != string(*lbCfgSpec.Scheme) {
+			conflicts = append(conflicts, annotations.SvcLBSuffixScheme)
+		}
+		internal := false
+		exists, err := annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixInternal, &internal, svc.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if exists && internal != (*lbCfgSpec.Scheme == elbv2api.LoadBalancerSchemeInternal) {
+			conflicts = append(conflicts, annotations.SvcLBSuffixInternal)
+		}
+	}
+	if len(lbCfgSpec.Subnets) != 0 {
+		var rawSubnetNameOrIDs []string
+		if exists := annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, svc.Annotations); exists &&
+			!sets.NewString(rawSubnetNameOrIDs...).Equal(sets.NewString(lbCfgSpec.Subnets...)) {
+			conflicts = append(conflicts, annotations.SvcLBSuffixSubnets)
+		}
+	}
+	if len(lbCfgSpec.SecurityGroups) != 0 {
+		var sgNameOrIDs []string
+		if exists := annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, svc.Annotations); exists &&
+			!sets.NewString(sgNameOrIDs...).Equal(sets.NewString(lbCfgSpec.SecurityGroups...)) {
+			conflicts = append(conflicts, annotations.SvcLBSuffixLoadBalancerSecurityGroups)
+		}
+	}
+	var lbAttributes map[string]string
+	if _, err := annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixLoadBalancerAttributes, &lbAttributes, svc.Annotations); err != nil {
+		return nil, err
+	}
+	for _, attr := range lbCfgSpec.LoadBalancerAttributes {
+		if value, ok := lbAttributes[attr.Key]; ok && value != attr.Value {
+			conflicts = append(conflicts, annotations.SvcLBSuffixLoadBalancerAttributes)
+			break
+		}
+	}
+	var tags map[string]string
+	if _, err := annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixAdditionalTags, &tags, svc.Annotations); err != nil {
+		return nil, err
+	}
+	for _, tag := range lbCfgSpec.Tags {
+		if value, ok := tags[tag.Key]; ok && value != tag.Value {
+			conflicts = append(conflicts, annotations.SvcLBSuffixAdditionalTags)
+			break
+		}
+	}
+	return conflicts, nil
+}
+
+// attributesAsMap converts the attributes of LoadBalancerConfiguration into a map.
+func attributesAsMap(attributes []elbv2api.Attribute) map[string]string {
+	attributesMap := make(map[string]string, len(attributes))
+	for _, attr := range attributes {
+		attributesMap[attr.Key] = attr.Value
+	}
+	return attributesMap
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func Test_defaultLoadBalancerConfigurationLoader_Load(t *testing.T) {
+	type env struct {
+		nsList          []*corev1.Namespace
+		lbConfiguration []*elbv2api.LoadBalancerConfiguration
+	}
+	internalLBConfiguration := &elbv2api.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "internal",
+		},
+		Spec: elbv2api.LoadBalancerConfigurationSpec{
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"team": "a",
+				},
+			},
+			Subnets: []string{"subnet-a", "subnet-b"},
+		},
+	}
+	tests := []struct {
+		name    string
+		env     env
+		svc     *corev1.Service
+		want    *elbv2api.LoadBalancerConfiguration
+		wantErr error
+	}{
+		{
+			name: "no annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-a",
+					Name:      "svc",
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "namespace matches namespaceSelector",
+			env: env{
+				nsList: []*corev1.Namespace{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "team-a",
+							Labels: map[string]string{"team": "a"},
+						},
+					},
+				},
+				lbConfiguration: []*elbv2api.LoadBalancerConfiguration{internalLBConfiguration},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-a",
+					Name:      "svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-configuration": "internal",
+					},
+				},
+			},
+			want: internalLBConfiguration,
+		},
+		{
+			name: "namespace mismatches namespaceSelector",
+			env: env{
+				nsList: []*corev1.Namespace{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "team-b",
+							Labels: map[string]string{"team": "b"},
+						},
+					},
+				},
+				lbConfiguration: []*elbv2api.LoadBalancerConfiguration{internalLBConfiguration},
+			},
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-b",
+					Name:      "svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-configuration": "internal",
+					},
+				},
+			},
+			wantErr: errors.New("invalid load balancer configuration: namespaceSelector of LoadBalancerConfiguration internal mismatch"),
+		},
+		{
+			name: "loadBalancerConfiguration not found",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "team-a",
+					Name:      "svc",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-configuration": "internal",
+					},
+				},
+			},
+			wantErr: errors.New("invalid load balancer configuration: loadbalancerconfigurations.elbv2.k8s.aws \"internal\" not found"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			for _, ns := range tt.env.nsList {
+				assert.NoError(t, k8sClient.Create(ctx, ns.DeepCopy()))
+			}
+			for _, lbConfiguration := range tt.env.lbConfiguration {
+				assert.NoError(t, k8sClient.Create(ctx, lbConfiguration.DeepCopy()))
+			}
+
+			l := NewDefaultLoadBalancerConfigurationLoader(k8sClient, annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"))
+			got, err := l.Load(ctx, tt.svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.True(t, errors.Is(err, ErrInvalidLoadBalancerConfiguration))
+			} else {
+				assert.NoError(t, err)
+				opt := equality.IgnoreFakeClientPopulatedFields()
+				assert.True(t, cmp.Equal(tt.want, got, opt), "diff: %v", cmp.Diff(tt.want, got, opt))
+			}
+		})
+	}
+}
+
+func Test_FindLoadBalancerConfigurationConflicts(t *testing.T) {
+	internal := elbv2api.LoadBalancerSchemeInternal
+	lbConfiguration := &elbv2api.LoadBalancerConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "internal",
+		},
+		Spec: elbv2api.LoadBalancerConfigurationSpec{
+			Scheme:         &internal,
+			Subnets:        []string{"subnet-a", "subnet-b"},
+			SecurityGroups: []string{"sg-a"},
+			LoadBalancerAttributes: []elbv2api.Attribute{
+				{
+					Key:   "deletion_protection.enabled",
+					Value: "true",
+				},
+			},
+			TargetGroupAttributes: []elbv2api.Attribute{
+				{
+					Key:   "deregistration_delay.timeout_seconds",
+					Value: "30",
+				},
+			},
+			Tags: []elbv2api.Tag{
+				{
+					Key:   "cost-center",
+					Value: "1234",
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        []string
+		wantErr     error
+	}{
+		{
+			name:        "no annotations",
+			annotations: nil,
+			want:        nil,
+		},
+		{
+			name: "annotations consistent with loadBalancerConfiguration",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                   "internal",
+				"service.beta.kubernetes.io/aws-load-balancer-internal":                 "true",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":                  "subnet-b, subnet-a",
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups":          "sg-a",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes":               "deletion_protection.enabled=true,load_balancing.cross_zone.enabled=true",
+				"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes":  "deregistration_delay.timeout_seconds=60",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "cost-center=1234,team=a",
+			},
+			want: nil,
+		},
+		{
+			name: "annotations conflict with loadBalancerConfiguration",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-scheme":                   "internet-facing",
+				"service.beta.kubernetes.io/aws-load-balancer-internal":                 "false",
+				"service.beta.kubernetes.io/aws-load-balancer-subnets":                  "subnet-a",
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups":          "sg-a, sg-b",
+				"service.beta.kubernetes.io/aws-load-balancer-attributes":               "deletion_protection.enabled=false",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "cost-center=5678",
+			},
+			want: []string{
+				"aws-load-balancer-scheme",
+				"aws-load-balancer-internal",
+				"aws-load-balancer-subnets",
+				"aws-load-balancer-security-groups",
+				"aws-load-balancer-attributes",
+				"aws-load-balancer-additional-resource-tags",
+			},
+		},
+		{
+			name: "invalid annotation",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "yes",
+			},
+			wantErr: errors.New("failed to parse bool annotation, service.beta.kubernetes.io/aws-load-balancer-internal: yes: strconv.ParseBool: parsing \"yes\": invalid syntax"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "team-a",
+					Name:        "svc",
+					Annotations: tt.annotations,
+				},
+			}
+			got, err := FindLoadBalancerConfigurationConflicts(svc, lbConfiguration, annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"))
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
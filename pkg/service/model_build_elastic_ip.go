@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+)
+
+const (
+	resourceIDElasticIP = "ElasticIP"
+	// eipPoolAmazon denotes the EIPs are allocated from Amazon's pool of IPv4 addresses.
+	eipPoolAmazon = "amazon"
+)
+
+// buildElasticIPPool returns whether EIPs should be allocated for the load balancer subnets, and the public IPv4 pool to allocate them from.
+// a nil pool denotes Amazon's pool of IPv4 addresses.
+func (t *defaultModelBuildTask) buildElasticIPPool(_ context.Context) (bool, *string, error) {
+	var rawPool string
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixEIPPool, &rawPool, t.service.Annotations); !exists {
+		return false, nil, nil
+	}
+	if rawPool == eipPoolAmazon {
+		return true, nil, nil
+	}
+	if !strings.HasPrefix(rawPool, "ipv4pool-") {
+		return false, nil, errors.Errorf("invalid EIP pool %v, must be %v or a public IPv4 pool ID", rawPool, eipPoolAmazon)
+	}
+	return true, awssdk.String(rawPool), nil
+}
+
+func (t *defaultModelBuildTask) buildElasticIP(ctx context.Context, subnetID string, publicIPv4Pool *string) (*ec2model.ElasticIP, error) {
+	tags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	spec := ec2model.ElasticIPSpec{
+		PublicIPv4Pool: publicIPv4Pool,
+		Tags:           tags,
+	}
+	eipResID := fmt.Sprintf("%v-%v", resourceIDElasticIP, subnetID)
+	return ec2model.NewElasticIP(t.stack, eipResID, spec), nil
+}
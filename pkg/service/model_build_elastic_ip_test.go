@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuilderTask_buildLoadBalancerSubnetMappings_withEIPPool(t *testing.T) {
+	subnets := []ec2types.Subnet{
+		{
+			SubnetId:         awssdk.String("subnet-1"),
+			AvailabilityZone: awssdk.String("us-west-2a"),
+			CidrBlock:        awssdk.String("192.168.1.0/24"),
+		},
+		{
+			SubnetId:         awssdk.String("subnet-2"),
+			AvailabilityZone: awssdk.String("us-west-2b"),
+			CidrBlock:        awssdk.String("192.168.2.0/24"),
+		},
+	}
+	tests := []struct {
+		name        string
+		scheme      elbv2model.LoadBalancerScheme
+		annotations map[string]string
+		wantEIPs    map[string]ec2model.ElasticIPSpec
+		wantErr     string
+	}{
+		{
+			name:   "EIPs allocated from amazon pool",
+			scheme: elbv2model.LoadBalancerSchemeInternetFacing,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-eip-pool":                 "amazon",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "team=payments",
+			},
+			wantEIPs: map[string]ec2model.ElasticIPSpec{
+				"ElasticIP-subnet-1": {Tags: map[string]string{"team": "payments"}},
+				"ElasticIP-subnet-2": {Tags: map[string]string{"team": "payments"}},
+			},
+		},
+		{
+			name:   "EIPs allocated from BYOIP pool",
+			scheme: elbv2model.LoadBalancerSchemeInternetFacing,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-eip-pool": "ipv4pool-ec2-012345abcdef",
+			},
+			wantEIPs: map[string]ec2model.ElasticIPSpec{
+				"ElasticIP-subnet-1": {PublicIPv4Pool: awssdk.String("ipv4pool-ec2-012345abcdef"), Tags: map[string]string{}},
+				"ElasticIP-subnet-2": {PublicIPv4Pool: awssdk.String("ipv4pool-ec2-012345abcdef"), Tags: map[string]string{}},
+			},
+		},
+		{
+			name:   "invalid EIP pool",
+			scheme: elbv2model.LoadBalancerSchemeInternetFacing,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-eip-pool": "my-pool",
+			},
+			wantErr: "invalid EIP pool my-pool, must be amazon or a public IPv4 pool ID",
+		},
+		{
+			name:   "EIP pool on internal load balancer",
+			scheme: elbv2model.LoadBalancerSchemeInternal,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-eip-pool": "amazon",
+			},
+			wantErr: "EIP pool can only be set for internet facing load balancers",
+		},
+		{
+			name:   "EIP pool with EIP allocations",
+			scheme: elbv2model.LoadBalancerSchemeInternetFacing,
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-eip-pool":        "amazon",
+				"service.beta.kubernetes.io/aws-load-balancer-eip-allocations": "eip1, eip2",
+			},
+			wantErr: "EIP allocations and EIP pool cannot be set together",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-svc"}))
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "awesome-svc",
+						Annotations: tt.annotations,
+					},
+				},
+				stack: stack,
+			}
+			got, err := task.buildLoadBalancerSubnetMappings(context.Background(), elbv2model.IPAddressTypeIPV4, tt.scheme, subnets)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var resEIPs []*ec2model.ElasticIP
+			stack.ListResources(&resEIPs)
+			gotEIPs := make(map[string]ec2model.ElasticIPSpec, len(resEIPs))
+			for _, resEIP := range resEIPs {
+				gotEIPs[resEIP.ID()] = resEIP.Spec
+				resEIP.SetStatus(ec2model.ElasticIPStatus{AllocationID: "eipalloc-" + resEIP.ID()})
+			}
+			assert.Equal(t, tt.wantEIPs, gotEIPs)
+			for _, mapping := range got {
+				gotAllocationID, err := mapping.AllocationID.Resolve(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, "eipalloc-ElasticIP-"+mapping.SubnetID, gotAllocationID)
+			}
+		})
+	}
+}
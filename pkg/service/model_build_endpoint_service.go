@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+)
+
+const (
+	resourceIDEndpointService = "EndpointService"
+)
+
+func (t *defaultModelBuildTask) buildEndpointService(ctx context.Context) error {
+	var enabled bool
+	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixEndpointServiceEnabled, &enabled, t.service.Annotations)
+	if err != nil {
+		return err
+	}
+	if !exists || !enabled {
+		return nil
+	}
+	if !t.featureGates.Enabled(config.EndpointServices) {
+		return errors.Errorf("endpoint service cannot be enabled on Service, the %v feature gate is disabled", config.EndpointServices)
+	}
+	spec, err := t.buildEndpointServiceSpec(ctx)
+	if err != nil {
+		return err
+	}
+	ec2model.NewVPCEndpointService(t.stack, resourceIDEndpointService, spec)
+	return nil
+}
+
+func (t *defaultModelBuildTask) buildEndpointServiceSpec(ctx context.Context) (ec2model.VPCEndpointServiceSpec, error) {
+	acceptanceRequired := true
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixEndpointServiceAcceptance, &acceptanceRequired, t.service.Annotations); err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	allowedPrincipals, err := t.buildEndpointServiceAllowedPrincipals(ctx)
+	if err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	tags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return ec2model.VPCEndpointServiceSpec{}, err
+	}
+	return ec2model.VPCEndpointServiceSpec{
+		AcceptanceRequired:      acceptanceRequired,
+		NetworkLoadBalancerARNs: []core.StringToken{t.loadBalancer.LoadBalancerARN()},
+		AllowedPrincipals:       allowedPrincipals,
+		Tags:                    tags,
+	}, nil
+}
+
+func (t *defaultModelBuildTask) buildEndpointServiceAllowedPrincipals(_ context.Context) ([]string, error) {
+	var allowedPrincipals []string
+	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixEndpointServicePrincipals, &allowedPrincipals, t.service.Annotations)
+	for _, principal := range allowedPrincipals {
+		if principal != "*" && !strings.HasPrefix(principal, "arn:") {
+			return nil, errors.Errorf("invalid endpoint service allowed principal %v, must be an ARN or *", principal)
+		}
+	}
+	return allowedPrincipals, nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+func Test_defaultModelBuilderTask_buildEndpointService(t *testing.T) {
+	tests := []struct {
+		name                    string
+		annotations             map[string]string
+		disableEndpointServices bool
+		wantSpec                *ec2model.VPCEndpointServiceSpec
+		wantErr                 string
+	}{
+		{
+			name: "endpoint service not enabled",
+		},
+		{
+			name: "endpoint service enabled with default acceptance",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled":            "true",
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-allowed-principals": "arn:aws:iam::123456789012:root, arn:aws:iam::210987654321:role/consumer",
+			},
+			wantSpec: &ec2model.VPCEndpointServiceSpec{
+				AcceptanceRequired: true,
+				AllowedPrincipals:  []string{"arn:aws:iam::123456789012:root", "arn:aws:iam::210987654321:role/consumer"},
+				Tags:               map[string]string{},
+			},
+		},
+		{
+			name: "endpoint service enabled with auto accept",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled":             "true",
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-acceptance-required": "false",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":             "team=payments",
+			},
+			wantSpec: &ec2model.VPCEndpointServiceSpec{
+				AcceptanceRequired: false,
+				Tags:               map[string]string{"team": "payments"},
+			},
+		},
+		{
+			name: "endpoint service enabled with invalid principal",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled":            "true",
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-allowed-principals": "123456789012",
+			},
+			wantErr: "invalid endpoint service allowed principal 123456789012, must be an ARN or *",
+		},
+		{
+			name: "endpoint service enabled with feature gate disabled",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-endpoint-service-enabled": "true",
+			},
+			disableEndpointServices: true,
+			wantErr:                 "endpoint service cannot be enabled on Service, the EndpointServices feature gate is disabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			if !tt.disableEndpointServices {
+				featureGates.Enable(config.EndpointServices)
+			}
+			stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-svc"}))
+			lb := elbv2model.NewLoadBalancer(stack, resourceIDLoadBalancer, elbv2model.LoadBalancerSpec{})
+			lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "lb-arn"})
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				featureGates:     featureGates,
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "awesome-svc",
+						Annotations: tt.annotations,
+					},
+				},
+				stack:        stack,
+				loadBalancer: lb,
+			}
+			err := task.buildEndpointService(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var resESs []*ec2model.VPCEndpointService
+			stack.ListResources(&resESs)
+			if tt.wantSpec == nil {
+				assert.Len(t, resESs, 0)
+				return
+			}
+			assert.Len(t, resESs, 1)
+			gotSpec := resESs[0].Spec
+			assert.Len(t, gotSpec.NetworkLoadBalancerARNs, 1)
+			gotNLBARN, err := gotSpec.NetworkLoadBalancerARNs[0].Resolve(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "lb-arn", gotNLBARN)
+			gotSpec.NetworkLoadBalancerARNs = nil
+			assert.Equal(t, *tt.wantSpec, gotSpec)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gamodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/globalaccelerator"
+)
+
+const (
+	resourceIDGlobalAccelerator = "GlobalAccelerator"
+)
+
+func (t *defaultModelBuildTask) buildGlobalAccelerator(ctx context.Context) error {
+	var enabled bool
+	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixGlobalAcceleratorEnabled, &enabled, t.service.Annotations)
+	if err != nil {
+		return err
+	}
+	if !exists || !enabled {
+		return nil
+	}
+	if !t.featureGates.Enabled(config.GlobalAccelerator) {
+		return errors.Errorf("global accelerator cannot be enabled on Service, the %v feature gate is disabled", config.GlobalAccelerator)
+	}
+	tags, err := t.buildAdditionalResourceTags(ctx)
+	if err != nil {
+		return err
+	}
+	gamodel.NewAccelerator(t.stack, resourceIDGlobalAccelerator, gamodel.AcceleratorSpec{
+		Name:            t.loadBalancer.Spec.Name,
+		Listeners:       t.buildGlobalAcceleratorListeners(ctx),
+		LoadBalancerARN: t.loadBalancer.LoadBalancerARN(),
+		Tags:            tags,
+	})
+	return nil
+}
+
+// buildGlobalAcceleratorListeners builds an accelerator listener per protocol for the ports of NLB listeners.
+func (t *defaultModelBuildTask) buildGlobalAcceleratorListeners(_ context.Context) []gamodel.AcceleratorListener {
+	var resLSs []*elbv2model.Listener
+	t.stack.ListResources(&resLSs)
+	portsByProtocol := make(map[gamodel.Protocol][]int64)
+	for _, resLS := range resLSs {
+		switch resLS.Spec.Protocol {
+		case elbv2model.ProtocolTCP, elbv2model.ProtocolTLS:
+			portsByProtocol[gamodel.ProtocolTCP] = append(portsByProtocol[gamodel.ProtocolTCP], resLS.Spec.Port)
+		case elbv2model.ProtocolUDP:
+			portsByProtocol[gamodel.ProtocolUDP] = append(portsByProtocol[gamodel.ProtocolUDP], resLS.Spec.Port)
+		case elbv2model.ProtocolTCP_UDP:
+			portsByProtocol[gamodel.ProtocolTCP] = append(portsByProtocol[gamodel.ProtocolTCP], resLS.Spec.Port)
+			portsByProtocol[gamodel.ProtocolUDP] = append(portsByProtocol[gamodel.ProtocolUDP], resLS.Spec.Port)
+		}
+	}
+	var listeners []gamodel.AcceleratorListener
+	for _, protocol := range []gamodel.Protocol{gamodel.ProtocolTCP, gamodel.ProtocolUDP} {
+		ports := portsByProtocol[protocol]
+		if len(ports) == 0 {
+			continue
+		}
+		sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+		listeners = append(listeners, gamodel.AcceleratorListener{
+			Protocol: protocol,
+			Ports:    ports,
+		})
+	}
+	return listeners
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	gamodel "sigs.k8s.io/aws-load-balancer-controller/pkg/model/globalaccelerator"
+)
+
+func Test_defaultModelBuilderTask_buildGlobalAccelerator(t *testing.T) {
+	tests := []struct {
+		name                     string
+		annotations              map[string]string
+		listeners                []elbv2model.ListenerSpec
+		disableGlobalAccelerator bool
+		wantSpec                 *gamodel.AcceleratorSpec
+		wantErr                  string
+	}{
+		{
+			name: "global accelerator not enabled",
+		},
+		{
+			name: "global accelerator enabled",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-global-accelerator-enabled": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags":   "team=payments",
+			},
+			listeners: []elbv2model.ListenerSpec{
+				{Port: 443, Protocol: elbv2model.ProtocolTLS},
+				{Port: 80, Protocol: elbv2model.ProtocolTCP},
+				{Port: 53, Protocol: elbv2model.ProtocolTCP_UDP},
+				{Port: 123, Protocol: elbv2model.ProtocolUDP},
+			},
+			wantSpec: &gamodel.AcceleratorSpec{
+				Name: "k8s-awesomens-awesomes-abcdef",
+				Listeners: []gamodel.AcceleratorListener{
+					{Protocol: gamodel.ProtocolTCP, Ports: []int64{53, 80, 443}},
+					{Protocol: gamodel.ProtocolUDP, Ports: []int64{53, 123}},
+				},
+				Tags: map[string]string{"team": "payments"},
+			},
+		},
+		{
+			name: "global accelerator enabled with feature gate disabled",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-global-accelerator-enabled": "true",
+			},
+			disableGlobalAccelerator: true,
+			wantErr:                  "global accelerator cannot be enabled on Service, the GlobalAccelerator feature gate is disabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			if !tt.disableGlobalAccelerator {
+				featureGates.Enable(config.GlobalAccelerator)
+			}
+			stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-svc"}))
+			lb := elbv2model.NewLoadBalancer(stack, resourceIDLoadBalancer, elbv2model.LoadBalancerSpec{Name: "k8s-awesomens-awesomes-abcdef"})
+			lb.SetStatus(elbv2model.LoadBalancerStatus{LoadBalancerARN: "lb-arn"})
+			for _, ls := range tt.listeners {
+				ls.LoadBalancerARN = lb.LoadBalancerARN()
+				elbv2model.NewListener(stack, string(ls.Protocol), ls)
+			}
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				featureGates:     featureGates,
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "awesome-svc",
+						Annotations: tt.annotations,
+					},
+				},
+				stack:        stack,
+				loadBalancer: lb,
+			}
+			err := task.buildGlobalAccelerator(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var resAccelerators []*gamodel.Accelerator
+			stack.ListResources(&resAccelerators)
+			if tt.wantSpec == nil {
+				assert.Len(t, resAccelerators, 0)
+				return
+			}
+			assert.Len(t, resAccelerators, 1)
+			gotSpec := resAccelerators[0].Spec
+			gotLBARN, err := gotSpec.LoadBalancerARN.Resolve(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "lb-arn", gotLBARN)
+			gotSpec.LoadBalancerARN = nil
+			assert.Equal(t, *tt.wantSpec, gotSpec)
+		})
+	}
+}
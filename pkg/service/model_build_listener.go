@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -13,13 +14,29 @@ import (
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 )
 
+// serviceProtocolTCPUDP is the protocol of the paired TCP and UDP ports sharing the same port number.
+const serviceProtocolTCPUDP = corev1.Protocol(elbv2model.ProtocolTCP_UDP)
+
+func isTCPOrUDPProtocol(protocol corev1.Protocol) bool {
+	return protocol == corev1.ProtocolTCP || protocol == corev1.ProtocolUDP
+}
+
+// hasUDPTraffic checks whether the listeners of protocol accept UDP traffic.
+func hasUDPTraffic(protocol corev1.Protocol) bool {
+	return protocol == corev1.ProtocolUDP || protocol == serviceProtocolTCPUDP
+}
+
 func (t *defaultModelBuildTask) buildListeners(ctx context.Context, scheme elbv2model.LoadBalancerScheme) error {
 	cfg, err := t.buildListenerConfig(ctx)
 	if err != nil {
 		return err
 	}
 
-	for _, port := range t.service.Spec.Ports {
+	listenerPorts, err := t.buildListenerPorts(ctx, *cfg)
+	if err != nil {
+		return err
+	}
+	for _, port := range listenerPorts {
 		_, err := t.buildListener(ctx, port, *cfg, scheme)
 		if err != nil {
 			return err
@@ -28,6 +45,61 @@ func (t *defaultModelBuildTask) buildListeners(ctx context.Context, scheme elbv2
 	return nil
 }
 
+// buildListenerPorts builds the ports served by listeners, the TCP and UDP ports sharing the same port number are
+// paired into a single TCP_UDP port, so that they're served by the same listener and target group.
+func (t *defaultModelBuildTask) buildListenerPorts(ctx context.Context, cfg listenerConfig) ([]corev1.ServicePort, error) {
+	portsByNumber := make(map[int32][]corev1.ServicePort)
+	for _, port := range t.service.Spec.Ports {
+		portsByNumber[port.Port] = append(portsByNumber[port.Port], port)
+	}
+	var listenerPorts []corev1.ServicePort
+	for _, port := range t.service.Spec.Ports {
+		samePorts, exists := portsByNumber[port.Port]
+		if !exists {
+			continue
+		}
+		delete(portsByNumber, port.Port)
+		if len(samePorts) == 1 {
+			listenerPorts = append(listenerPorts, port)
+			continue
+		}
+		pairedPort, err := t.buildTCPUDPListenerPort(ctx, samePorts, cfg)
+		if err != nil {
+			return nil, err
+		}
+		listenerPorts = append(listenerPorts, pairedPort)
+	}
+	return listenerPorts, nil
+}
+
+func (t *defaultModelBuildTask) buildTCPUDPListenerPort(ctx context.Context, samePorts []corev1.ServicePort, cfg listenerConfig) (corev1.ServicePort, error) {
+	listenPort := samePorts[0].Port
+	if len(samePorts) != 2 || samePorts[0].Protocol == samePorts[1].Protocol ||
+		!isTCPOrUDPProtocol(samePorts[0].Protocol) || !isTCPOrUDPProtocol(samePorts[1].Protocol) {
+		return corev1.ServicePort{}, errors.Errorf("unsupported ports sharing port %v, only a TCP and a UDP port can share the same port", listenPort)
+	}
+	tcpPort, udpPort := samePorts[0], samePorts[1]
+	if tcpPort.Protocol == corev1.ProtocolUDP {
+		tcpPort, udpPort = udpPort, tcpPort
+	}
+	if tcpPort.TargetPort != udpPort.TargetPort {
+		return corev1.ServicePort{}, errors.Errorf("TCP and UDP ports sharing port %v must have the same targetPort", listenPort)
+	}
+	if cfg.isTLSPort(tcpPort) {
+		return corev1.ServicePort{}, errors.Errorf("TLS listener on port %v cannot be paired with UDP", listenPort)
+	}
+	targetType, err := t.buildTargetType(ctx, tcpPort)
+	if err != nil {
+		return corev1.ServicePort{}, err
+	}
+	if targetType == elbv2model.TargetTypeInstance && tcpPort.NodePort != udpPort.NodePort {
+		return corev1.ServicePort{}, errors.Errorf("TCP and UDP ports sharing port %v must have the same nodePort for instance targets", listenPort)
+	}
+	pairedPort := *samePorts[0].DeepCopy()
+	pairedPort.Protocol = serviceProtocolTCPUDP
+	return pairedPort, nil
+}
+
 func (t *defaultModelBuildTask) buildListener(ctx context.Context, port corev1.ServicePort, cfg listenerConfig,
 	scheme elbv2model.LoadBalancerScheme) (*elbv2model.Listener, error) {
 	lsSpec, err := t.buildListenerSpec(ctx, port, cfg, scheme)
@@ -43,8 +115,7 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 	scheme elbv2model.LoadBalancerScheme) (elbv2model.ListenerSpec, error) {
 	tgProtocol := elbv2model.Protocol(port.Protocol)
 	listenerProtocol := elbv2model.Protocol(port.Protocol)
-	if tgProtocol != elbv2model.ProtocolUDP && len(cfg.certificates) != 0 && (cfg.tlsPortsSet.Len() == 0 ||
-		cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))) {
+	if cfg.isTLSPort(port) {
 		if cfg.backendProtocol == "ssl" {
 			tgProtocol = elbv2model.ProtocolTLS
 		}
@@ -65,6 +136,11 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 		return elbv2model.ListenerSpec{}, err
 	}
 
+	listenerAttributes, err := t.buildListenerAttributes(ctx, listenerProtocol, int64(port.Port))
+	if err != nil {
+		return elbv2model.ListenerSpec{}, err
+	}
+
 	var sslPolicy *string
 	var certificates []elbv2model.Certificate
 	if listenerProtocol == elbv2model.ProtocolTLS {
@@ -74,14 +150,15 @@ func (t *defaultModelBuildTask) buildListenerSpec(ctx context.Context, port core
 
 	defaultActions := t.buildListenerDefaultActions(ctx, targetGroup)
 	return elbv2model.ListenerSpec{
-		LoadBalancerARN: t.loadBalancer.LoadBalancerARN(),
-		Port:            int64(port.Port),
-		Protocol:        listenerProtocol,
-		Certificates:    certificates,
-		SSLPolicy:       sslPolicy,
-		ALPNPolicy:      alpnPolicy,
-		DefaultActions:  defaultActions,
-		Tags:            tags,
+		LoadBalancerARN:    t.loadBalancer.LoadBalancerARN(),
+		Port:               int64(port.Port),
+		Protocol:           listenerProtocol,
+		Certificates:       certificates,
+		SSLPolicy:          sslPolicy,
+		ALPNPolicy:         alpnPolicy,
+		DefaultActions:     defaultActions,
+		ListenerAttributes: listenerAttributes,
+		Tags:               tags,
 	}, nil
 }
 
@@ -184,6 +261,26 @@ func (t *defaultModelBuildTask) buildListenerALPNPolicy(ctx context.Context, lis
 	}
 }
 
+func (t *defaultModelBuildTask) buildListenerAttributes(_ context.Context, listenerProtocol elbv2model.Protocol,
+	port int64) ([]elbv2model.ListenerAttribute, error) {
+	annotationSuffix := fmt.Sprintf("%v.%v-%v", annotations.SvcLBSuffixListenerAttributes, listenerProtocol, port)
+	var rawAttributes map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotationSuffix, &rawAttributes, t.service.Annotations); err != nil {
+		return nil, err
+	}
+	attributes := make([]elbv2model.ListenerAttribute, 0, len(rawAttributes))
+	for attrKey, attrValue := range rawAttributes {
+		attributes = append(attributes, elbv2model.ListenerAttribute{
+			Key:   attrKey,
+			Value: attrValue,
+		})
+	}
+	sort.Slice(attributes, func(i, j int) bool {
+		return attributes[i].Key < attributes[j].Key
+	})
+	return attributes, nil
+}
+
 type listenerConfig struct {
 	certificates    []elbv2model.Certificate
 	tlsPortsSet     sets.String
@@ -191,6 +288,14 @@ type listenerConfig struct {
 	backendProtocol string
 }
 
+// isTLSPort checks whether the listener of port terminates TLS.
+func (cfg listenerConfig) isTLSPort(port corev1.ServicePort) bool {
+	if hasUDPTraffic(port.Protocol) || len(cfg.certificates) == 0 {
+		return false
+	}
+	return cfg.tlsPortsSet.Len() == 0 || cfg.tlsPortsSet.Has(port.Name) || cfg.tlsPortsSet.Has(strconv.Itoa(int(port.Port)))
+}
+
 func (t *defaultModelBuildTask) buildListenerConfig(ctx context.Context) (*listenerConfig, error) {
 	certificates := t.buildListenerCertificates(ctx)
 	tlsPortsSet, err := t.buildTLSPortsSet(ctx)
@@ -182,3 +182,211 @@ func Test_defaultModelBuilderTask_buildListenerConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuilderTask_buildListenerAttributes(t *testing.T) {
+	tests := []struct {
+		name             string
+		svc              *corev1.Service
+		listenerProtocol elbv2model.Protocol
+		port             int64
+		wantErr          string
+		want             []elbv2model.ListenerAttribute
+	}{
+		{
+			name:             "Service without annotation",
+			svc:              &corev1.Service{},
+			listenerProtocol: elbv2model.ProtocolTCP,
+			port:             80,
+			want:             []elbv2model.ListenerAttribute{},
+		},
+		{
+			name: "Service with annotation for listener",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-80":  "tcp.idle_timeout.seconds=400",
+						"service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TLS-443": "tcp.idle_timeout.seconds=600",
+					},
+				},
+			},
+			listenerProtocol: elbv2model.ProtocolTCP,
+			port:             80,
+			want: []elbv2model.ListenerAttribute{
+				{
+					Key:   "tcp.idle_timeout.seconds",
+					Value: "400",
+				},
+			},
+		},
+		{
+			name: "Service with invalid annotation",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-80": "tcp.idle_timeout.seconds",
+					},
+				},
+			},
+			listenerProtocol: elbv2model.ProtocolTCP,
+			port:             80,
+			wantErr:          "failed to parse stringMap annotation, service.beta.kubernetes.io/aws-load-balancer-listener-attributes.TCP-80: tcp.idle_timeout.seconds",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
+			builder := &defaultModelBuildTask{
+				annotationParser: parser,
+				service:          tt.svc,
+			}
+			got, err := builder.buildListenerAttributes(context.Background(), tt.listenerProtocol, tt.port)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_defaultModelBuilderTask_buildListenerPorts(t *testing.T) {
+	tcpPort := corev1.ServicePort{
+		Name:       "dns-tcp",
+		Port:       53,
+		TargetPort: intstr.FromInt(5353),
+		Protocol:   corev1.ProtocolTCP,
+		NodePort:   31053,
+	}
+	udpPort := corev1.ServicePort{
+		Name:       "dns-udp",
+		Port:       53,
+		TargetPort: intstr.FromInt(5353),
+		Protocol:   corev1.ProtocolUDP,
+		NodePort:   31053,
+	}
+	httpPort := corev1.ServicePort{
+		Name:       "http",
+		Port:       80,
+		TargetPort: intstr.FromInt(8080),
+		Protocol:   corev1.ProtocolTCP,
+		NodePort:   31080,
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		ports       []corev1.ServicePort
+		cfg         listenerConfig
+		want        []corev1.ServicePort
+		wantErr     error
+	}{
+		{
+			name:  "ports with distinct port numbers",
+			ports: []corev1.ServicePort{tcpPort, httpPort},
+			want:  []corev1.ServicePort{tcpPort, httpPort},
+		},
+		{
+			name:  "TCP and UDP ports sharing port number are paired",
+			ports: []corev1.ServicePort{httpPort, udpPort, tcpPort},
+			want: []corev1.ServicePort{
+				httpPort,
+				{
+					Name:       "dns-udp",
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					Protocol:   serviceProtocolTCPUDP,
+					NodePort:   31053,
+				},
+			},
+		},
+		{
+			name: "TCP and UDP ports with different nodePorts are paired for IP targets",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "ip",
+			},
+			ports: []corev1.ServicePort{tcpPort, {
+				Name:       "dns-udp",
+				Port:       53,
+				TargetPort: intstr.FromInt(5353),
+				Protocol:   corev1.ProtocolUDP,
+				NodePort:   32053,
+			}},
+			want: []corev1.ServicePort{
+				{
+					Name:       "dns-tcp",
+					Port:       53,
+					TargetPort: intstr.FromInt(5353),
+					Protocol:   serviceProtocolTCPUDP,
+					NodePort:   31053,
+				},
+			},
+		},
+		{
+			name: "TCP and UDP ports with different nodePorts for instance targets",
+			ports: []corev1.ServicePort{tcpPort, {
+				Name:       "dns-udp",
+				Port:       53,
+				TargetPort: intstr.FromInt(5353),
+				Protocol:   corev1.ProtocolUDP,
+				NodePort:   32053,
+			}},
+			wantErr: errors.New("TCP and UDP ports sharing port 53 must have the same nodePort for instance targets"),
+		},
+		{
+			name: "TCP and UDP ports with different targetPorts",
+			ports: []corev1.ServicePort{tcpPort, {
+				Name:       "dns-udp",
+				Port:       53,
+				TargetPort: intstr.FromInt(53),
+				Protocol:   corev1.ProtocolUDP,
+				NodePort:   31053,
+			}},
+			wantErr: errors.New("TCP and UDP ports sharing port 53 must have the same targetPort"),
+		},
+		{
+			name:  "TLS port cannot be paired with UDP",
+			ports: []corev1.ServicePort{tcpPort, udpPort},
+			cfg: listenerConfig{
+				certificates: []elbv2model.Certificate{{}},
+				tlsPortsSet:  sets.NewString("dns-tcp"),
+			},
+			wantErr: errors.New("TLS listener on port 53 cannot be paired with UDP"),
+		},
+		{
+			name:    "ports sharing port number with the same protocol",
+			ports:   []corev1.ServicePort{tcpPort, tcpPort},
+			wantErr: errors.New("unsupported ports sharing port 53, only a TCP and a UDP port can share the same port"),
+		},
+		{
+			name:    "more than two ports sharing port number",
+			ports:   []corev1.ServicePort{tcpPort, udpPort, tcpPort},
+			wantErr: errors.New("unsupported ports sharing port 53, only a TCP and a UDP port can share the same port"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: tt.annotations,
+					},
+					Spec: corev1.ServiceSpec{
+						Type:  corev1.ServiceTypeLoadBalancer,
+						Ports: tt.ports,
+					},
+				},
+				defaultTargetType:  elbv2model.TargetTypeInstance,
+				enableIPTargetType: true,
+			}
+			got, err := builder.buildListenerPorts(context.Background(), tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -10,8 +10,9 @@ import (
 	"sort"
 	"strconv"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
@@ -99,7 +100,18 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 	}
 	var sgNameOrIDs []string
 	var lbSGTokens []core.StringToken
-	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, t.service.Annotations)
+	if t.lbConfiguration != nil && len(t.lbConfiguration.Spec.SecurityGroups) != 0 {
+		sgNameOrIDs = t.lbConfiguration.Spec.SecurityGroups
+	} else {
+		t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixLoadBalancerSecurityGroups, &sgNameOrIDs, t.service.Annotations)
+	}
+	externallyManagedNetworking, err := t.buildExternallyManagedNetworking(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if externallyManagedNetworking {
+		return t.buildExternallyManagedLoadBalancerSecurityGroups(ctx, existingLB, sgNameOrIDs)
+	}
 	if len(sgNameOrIDs) == 0 {
 		managedSG, err := t.buildManagedSecurityGroup(ctx, ipAddressType)
 		if err != nil {
@@ -145,6 +157,48 @@ func (t *defaultModelBuildTask) buildLoadBalancerSecurityGroups(ctx context.Cont
 	return lbSGTokens, nil
 }
 
+// buildExternallyManagedLoadBalancerSecurityGroups builds the securityGroups of LoadBalancer whose networking is managed externally.
+// neither the managed nor the backend securityGroup is created, the specified securityGroups are only resolved to validate that they exist.
+func (t *defaultModelBuildTask) buildExternallyManagedLoadBalancerSecurityGroups(ctx context.Context, existingLB *elbv2deploy.LoadBalancerWithTags,
+	sgNameOrIDs []string) ([]core.StringToken, error) {
+	if len(sgNameOrIDs) == 0 {
+		// the securityGroups cannot be removed from existing NLB, which would be left without securityGroups otherwise.
+		if existingLB != nil && len(existingLB.LoadBalancer.SecurityGroups) != 0 {
+			return nil, errors.Errorf("securityGroups must be specified via %v annotation for load balancer with securityGroups when networking is externally managed",
+				annotations.SvcLBSuffixLoadBalancerSecurityGroups)
+		}
+		return nil, nil
+	}
+	frontendSGIDs, err := t.sgResolver.ResolveViaNameOrID(ctx, sgNameOrIDs)
+	if err != nil {
+		return nil, err
+	}
+	lbSGTokens := make([]core.StringToken, 0, len(frontendSGIDs))
+	for _, sgID := range frontendSGIDs {
+		lbSGTokens = append(lbSGTokens, core.LiteralStringToken(sgID))
+	}
+	return lbSGTokens, nil
+}
+
+// buildExternallyManagedNetworking determines whether the securityGroups and their rules are managed externally, e.g. by Terraform.
+func (t *defaultModelBuildTask) buildExternallyManagedNetworking(_ context.Context) (bool, error) {
+	var externallyManaged bool
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixExternallyManagedNetworking, &externallyManaged, t.service.Annotations); err != nil {
+		return false, err
+	}
+	if !externallyManaged {
+		return false, nil
+	}
+	var manageBackendSGRules bool
+	if _, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixManageSGRules, &manageBackendSGRules, t.service.Annotations); err != nil {
+		return false, err
+	}
+	if manageBackendSGRules {
+		return false, errors.New("backend securityGroup rules cannot be managed when networking is externally managed")
+	}
+	return true, nil
+}
+
 func (t *defaultModelBuildTask) buildManageSecurityGroupRulesFlag(ctx context.Context) (bool, error) {
 	var rawEnabled bool
 	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixManageSGRules, &rawEnabled, t.service.Annotations)
@@ -174,6 +228,9 @@ func (t *defaultModelBuildTask) buildLoadBalancerIPAddressType(_ context.Context
 }
 
 func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (elbv2model.LoadBalancerScheme, error) {
+	if t.lbConfiguration != nil && t.lbConfiguration.Spec.Scheme != nil {
+		return elbv2model.LoadBalancerScheme(*t.lbConfiguration.Spec.Scheme), nil
+	}
 	scheme, explicitSchemeSpecified, err := t.buildLoadBalancerSchemeViaAnnotation(ctx)
 	if err != nil {
 		return elbv2model.LoadBalancerSchemeInternal, err
@@ -186,10 +243,10 @@ func (t *defaultModelBuildTask) buildLoadBalancerScheme(ctx context.Context) (el
 		return elbv2model.LoadBalancerSchemeInternal, err
 	}
 	if existingLB != nil {
-		switch awssdk.StringValue(existingLB.LoadBalancer.Scheme) {
-		case string(elbv2model.LoadBalancerSchemeInternal):
+		switch existingLB.LoadBalancer.Scheme {
+		case elbv2types.LoadBalancerSchemeEnumInternal:
 			return elbv2model.LoadBalancerSchemeInternal, nil
-		case string(elbv2model.LoadBalancerSchemeInternetFacing):
+		case elbv2types.LoadBalancerSchemeEnumInternetFacing:
 			return elbv2model.LoadBalancerSchemeInternetFacing, nil
 		default:
 			return "", errors.New("invalid load balancer scheme")
@@ -257,8 +314,17 @@ func (t *defaultModelBuildTask) buildAdditionalResourceTags(_ context.Context) (
 			return nil, errors.Errorf("external managed tag key %v cannot be specified on Service", tagKey)
 		}
 	}
+	lbConfigurationTags := make(map[string]string)
+	if t.lbConfiguration != nil {
+		for _, tag := range t.lbConfiguration.Spec.Tags {
+			if t.externalManagedTags.Has(tag.Key) {
+				return nil, errors.Errorf("external managed tag key %v cannot be specified on LoadBalancerConfiguration %v", tag.Key, t.lbConfiguration.Name)
+			}
+			lbConfigurationTags[tag.Key] = tag.Value
+		}
+	}
 
-	mergedTags := algorithm.MergeStringMap(t.defaultTags, annotationTags)
+	mergedTags := algorithm.MergeStringMap(t.defaultTags, lbConfigurationTags, annotationTags)
 	return mergedTags, nil
 }
 
@@ -266,7 +332,7 @@ func (t *defaultModelBuildTask) buildLoadBalancerTags(ctx context.Context) (map[
 	return t.buildAdditionalResourceTags(ctx)
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Context, ipAddressType elbv2model.IPAddressType, scheme elbv2model.LoadBalancerScheme, ec2Subnets []*ec2sdk.Subnet) ([]elbv2model.SubnetMapping, error) {
+func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(ctx context.Context, ipAddressType elbv2model.IPAddressType, scheme elbv2model.LoadBalancerScheme, ec2Subnets []ec2types.Subnet) ([]elbv2model.SubnetMapping, error) {
 	var eipAllocation []string
 	eipConfigured := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixEIPAllocations, &eipAllocation, t.service.Annotations)
 	if eipConfigured {
@@ -277,6 +343,18 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Contex
 			return nil, errors.Errorf("count of EIP allocations (%d) and subnets (%d) must match", len(eipAllocation), len(ec2Subnets))
 		}
 	}
+	eipPoolConfigured, eipPool, err := t.buildElasticIPPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if eipPoolConfigured {
+		if scheme != elbv2model.LoadBalancerSchemeInternetFacing {
+			return nil, errors.Errorf("EIP pool can only be set for internet facing load balancers")
+		}
+		if eipConfigured {
+			return nil, errors.Errorf("EIP allocations and EIP pool cannot be set together")
+		}
+	}
 
 	var ipv4Addresses []netip.Addr
 	var rawIPv4Addresses []string
@@ -327,30 +405,37 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Contex
 	subnetMappings := make([]elbv2model.SubnetMapping, 0, len(ec2Subnets))
 	for idx, subnet := range ec2Subnets {
 		mapping := elbv2model.SubnetMapping{
-			SubnetID: awssdk.StringValue(subnet.SubnetId),
+			SubnetID: awssdk.ToString(subnet.SubnetId),
 		}
 		if eipConfigured {
-			mapping.AllocationID = awssdk.String(eipAllocation[idx])
+			mapping.AllocationID = core.LiteralStringToken(eipAllocation[idx])
+		}
+		if eipPoolConfigured {
+			eip, err := t.buildElasticIP(ctx, mapping.SubnetID, eipPool)
+			if err != nil {
+				return nil, err
+			}
+			mapping.AllocationID = eip.AllocationID()
 		}
 		if ipv4AddrConfigured {
-			subnetIPv4CIDRs, err := networking.GetSubnetAssociatedIPv4CIDRs(subnet)
+			subnetIPv4CIDRs, err := networking.GetSubnetAssociatedIPv4CIDRs(&ec2Subnets[idx])
 			if err != nil {
 				return nil, err
 			}
 			ipv4AddressesWithinSubnet := networking.FilterIPsWithinCIDRs(ipv4Addresses, subnetIPv4CIDRs)
 			if len(ipv4AddressesWithinSubnet) != 1 {
-				return nil, errors.Errorf("expect one private IPv4 address configured for subnet: %v", awssdk.StringValue(subnet.SubnetId))
+				return nil, errors.Errorf("expect one private IPv4 address configured for subnet: %v", awssdk.ToString(subnet.SubnetId))
 			}
 			mapping.PrivateIPv4Address = awssdk.String(ipv4AddressesWithinSubnet[0].String())
 		}
 		if ipv6AddrConfigured {
-			subnetIPv6CIDRs, err := networking.GetSubnetAssociatedIPv6CIDRs(subnet)
+			subnetIPv6CIDRs, err := networking.GetSubnetAssociatedIPv6CIDRs(&ec2Subnets[idx])
 			if err != nil {
 				return nil, err
 			}
 			ipv6AddressesWithinSubnet := networking.FilterIPsWithinCIDRs(ipv6Addresses, subnetIPv6CIDRs)
 			if len(ipv6AddressesWithinSubnet) != 1 {
-				return nil, errors.Errorf("expect one IPv6 address configured for subnet: %v", awssdk.StringValue(subnet.SubnetId))
+				return nil, errors.Errorf("expect one IPv6 address configured for subnet: %v", awssdk.ToString(subnet.SubnetId))
 			}
 			mapping.IPv6Address = awssdk.String(ipv6AddressesWithinSubnet[0].String())
 		}
@@ -359,9 +444,14 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnetMappings(_ context.Contex
 	return subnetMappings, nil
 }
 
-func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]*ec2sdk.Subnet, error) {
+func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, scheme elbv2model.LoadBalancerScheme) ([]ec2types.Subnet, error) {
 	var rawSubnetNameOrIDs []string
-	if exists := t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, t.service.Annotations); exists {
+	if t.lbConfiguration != nil && len(t.lbConfiguration.Spec.Subnets) != 0 {
+		rawSubnetNameOrIDs = t.lbConfiguration.Spec.Subnets
+	} else {
+		t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSubnets, &rawSubnetNameOrIDs, t.service.Annotations)
+	}
+	if len(rawSubnetNameOrIDs) != 0 {
 		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, rawSubnetNameOrIDs,
 			networking.WithSubnetsResolveLBType(elbv2model.LoadBalancerTypeNetwork),
 			networking.WithSubnetsResolveLBScheme(scheme),
@@ -372,11 +462,11 @@ func (t *defaultModelBuildTask) buildLoadBalancerSubnets(ctx context.Context, sc
 	if err != nil {
 		return nil, err
 	}
-	if existingLB != nil && string(scheme) == awssdk.StringValue(existingLB.LoadBalancer.Scheme) {
+	if existingLB != nil && string(scheme) == string(existingLB.LoadBalancer.Scheme) {
 		availabilityZones := existingLB.LoadBalancer.AvailabilityZones
 		subnetIDs := make([]string, 0, len(availabilityZones))
 		for _, availabilityZone := range availabilityZones {
-			subnetID := awssdk.StringValue(availabilityZone.SubnetId)
+			subnetID := awssdk.ToString(availabilityZone.SubnetId)
 			subnetIDs = append(subnetIDs, subnetID)
 		}
 		return t.subnetsResolver.ResolveViaNameOrIDSlice(ctx, subnetIDs,
@@ -437,6 +527,9 @@ func (t *defaultModelBuildTask) getLoadBalancerAttributes() (map[string]string,
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixLoadBalancerAttributes, &attributes, t.service.Annotations); err != nil {
 		return nil, err
 	}
+	if t.lbConfiguration != nil && len(t.lbConfiguration.Spec.LoadBalancerAttributes) != 0 {
+		return algorithm.MergeStringMap(attributesAsMap(t.lbConfiguration.Spec.LoadBalancerAttributes), attributes), nil
+	}
 	return attributes, nil
 }
 
@@ -475,9 +568,8 @@ var invalidLoadBalancerNamePattern = regexp.MustCompile("[[:^alnum:]]")
 func (t *defaultModelBuildTask) buildLoadBalancerName(_ context.Context, scheme elbv2model.LoadBalancerScheme) (string, error) {
 	var name string
 	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerName, &name, t.service.Annotations); exists {
-		// The name of the loadbalancer can only have up to 32 characters
-		if len(name) > 32 {
-			return "", errors.New("load balancer name cannot be longer than 32 characters")
+		if len(name) > elbv2model.LoadBalancerNameMaxLength {
+			return "", errors.Errorf("load balancer name cannot be longer than %d characters", elbv2model.LoadBalancerNameMaxLength)
 		}
 		return name, nil
 	}
@@ -5,17 +5,18 @@ import (
 	"errors"
 	"testing"
 
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
@@ -199,7 +200,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 		name          string
 		ipAddressType elbv2.IPAddressType
 		scheme        elbv2.LoadBalancerScheme
-		subnets       []*ec2.Subnet
+		subnets       []ec2types.Subnet
 		want          []elbv2.SubnetMapping
 		svc           *corev1.Service
 		wantErr       error
@@ -208,7 +209,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with auto-assigned addresses",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -236,7 +237,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with EIP allocation",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -260,11 +261,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			want: []elbv2.SubnetMapping{
 				{
 					SubnetID:     "subnet-1",
-					AllocationID: aws.String("eip1"),
+					AllocationID: core.LiteralStringToken("eip1"),
 				},
 				{
 					SubnetID:     "subnet-2",
-					AllocationID: aws.String("eip2"),
+					AllocationID: core.LiteralStringToken("eip2"),
 				},
 			},
 		},
@@ -272,7 +273,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with EIP allocation: on internal load balancer",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -299,7 +300,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with EIP allocation: subnet count mismatch",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -326,7 +327,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with PrivateIPv4Address",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -362,7 +363,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with PrivateIPv4Address: on internet-facing load balancer",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -389,7 +390,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with PrivateIpv4Addresses: subnet count mismatch",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -414,7 +415,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with PrivateIPv4Address: no matching IP for subnets",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -441,7 +442,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with PrivateIPv4Address",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -477,7 +478,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with PrivateIPv4Address: invalid ip format",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -504,7 +505,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "ipv4 - with PrivateIPv4Address: invalid ipv4 format",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
@@ -531,17 +532,17 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "dualstack - with IPv6Addresses",
 			ipAddressType: elbv2.IPAddressTypeDualStack,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -551,11 +552,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 					AvailabilityZone: aws.String("us-west-2b"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.2.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -583,17 +584,17 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "dualstack - with IPv6Addresses: on a ipv4 load balancer",
 			ipAddressType: elbv2.IPAddressTypeIPV4,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -603,11 +604,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 					AvailabilityZone: aws.String("us-west-2b"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.2.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -626,17 +627,17 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "dualstack - with IPv6Addresses: subnet count mismatch",
 			ipAddressType: elbv2.IPAddressTypeDualStack,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -646,11 +647,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 					AvailabilityZone: aws.String("us-west-2b"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.2.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -669,17 +670,17 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "dualstack - with IPv6Addresses: no matching IP for subnets",
 			ipAddressType: elbv2.IPAddressTypeDualStack,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -689,11 +690,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 					AvailabilityZone: aws.String("us-west-2b"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.2.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -712,17 +713,17 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "dualstack - with IPv6Addresses: invalid IP format",
 			ipAddressType: elbv2.IPAddressTypeDualStack,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -732,11 +733,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 					AvailabilityZone: aws.String("us-west-2b"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.2.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -755,17 +756,17 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "dualstack - with IPv6Addresses: invalid IP format",
 			ipAddressType: elbv2.IPAddressTypeDualStack,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -775,11 +776,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 					AvailabilityZone: aws.String("us-west-2b"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.2.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -798,17 +799,17 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "dualstack - with EIPAllocation and IPv6Addresses",
 			ipAddressType: elbv2.IPAddressTypeDualStack,
 			scheme:        elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -818,11 +819,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 					AvailabilityZone: aws.String("us-west-2b"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.2.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -839,12 +840,12 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			want: []elbv2.SubnetMapping{
 				{
 					SubnetID:     "subnet-1",
-					AllocationID: aws.String("eip1"),
+					AllocationID: core.LiteralStringToken("eip1"),
 					IPv6Address:  aws.String("2600:1f13:837:8500::1"),
 				},
 				{
 					SubnetID:     "subnet-2",
-					AllocationID: aws.String("eip2"),
+					AllocationID: core.LiteralStringToken("eip2"),
 					IPv6Address:  aws.String("2600:1f13:837:8504::1"),
 				},
 			},
@@ -853,17 +854,17 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 			name:          "dualstack - with EIPAllocation and IPv6Addresses",
 			ipAddressType: elbv2.IPAddressTypeDualStack,
 			scheme:        elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					SubnetId:         aws.String("subnet-1"),
 					AvailabilityZone: aws.String("us-west-2a"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.1.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8500::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -873,11 +874,11 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 					AvailabilityZone: aws.String("us-west-2b"),
 					VpcId:            aws.String("vpc-1"),
 					CidrBlock:        aws.String("192.168.2.0/24"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2600:1f13:837:8504::/64"),
-							Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{
-								State: aws.String(ec2.SubnetCidrBlockStateCodeAssociated),
+							Ipv6CidrBlockState: &ec2types.SubnetCidrBlockState{
+								State: ec2types.SubnetCidrBlockStateCodeAssociated,
 							},
 						},
 					},
@@ -925,7 +926,7 @@ func Test_defaultModelBuilderTask_buildSubnetMappings(t *testing.T) {
 
 func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 	type resolveSubnetResults struct {
-		subnets []*ec2.Subnet
+		subnets []ec2types.Subnet
 		err     error
 	}
 	type args struct {
@@ -947,19 +948,19 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 		listLoadBalancersCalls       []listLoadBalancerCall
 		resolveViaDiscoveryCalls     []resolveSubnetResults
 		resolveViaNameOrIDSliceCalls []resolveSubnetResults
-		want                         []*ec2.Subnet
+		want                         []ec2types.Subnet
 		wantErr                      error
 	}{
 		{
 			name:                   "subnet auto-discovery",
 			svc:                    &corev1.Service{},
 			scheme:                 elbv2.LoadBalancerSchemeInternal,
-			provider:               tracking.NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			provider:               tracking.NewDefaultProvider("service.k8s.aws", "cluster-name", nil),
 			args:                   args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
 			listLoadBalancersCalls: []listLoadBalancerCall{listLoadBalancerCallForEmptyLB},
 			resolveViaDiscoveryCalls: []resolveSubnetResults{
 				{
-					subnets: []*ec2.Subnet{
+					subnets: []ec2types.Subnet{
 						{
 							SubnetId:  aws.String("subnet-a"),
 							CidrBlock: aws.String("192.168.0.0/19"),
@@ -971,7 +972,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 					},
 				},
 			},
-			want: []*ec2.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:  aws.String("subnet-a"),
 					CidrBlock: aws.String("192.168.0.0/19"),
@@ -992,11 +993,11 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 				},
 			},
 			scheme:   elbv2.LoadBalancerSchemeInternal,
-			provider: tracking.NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			provider: tracking.NewDefaultProvider("service.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
 			resolveViaNameOrIDSliceCalls: []resolveSubnetResults{
 				{
-					subnets: []*ec2.Subnet{
+					subnets: []ec2types.Subnet{
 						{
 							SubnetId:  aws.String("subnet-abc"),
 							CidrBlock: aws.String("192.168.0.0/19"),
@@ -1008,7 +1009,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 					},
 				},
 			},
-			want: []*ec2.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:  aws.String("subnet-abc"),
 					CidrBlock: aws.String("192.168.0.0/19"),
@@ -1023,15 +1024,15 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 			name:     "subnet resolve via Name or ID, with existing LB and scheme wouldn't change",
 			svc:      &corev1.Service{},
 			scheme:   elbv2.LoadBalancerSchemeInternal,
-			provider: tracking.NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			provider: tracking.NewDefaultProvider("service.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
 			listLoadBalancersCalls: []listLoadBalancerCall{
 				{
 					sdkLBs: []elbv2deploy.LoadBalancerWithTags{
 						{
-							LoadBalancer: &elbv2sdk.LoadBalancer{
+							LoadBalancer: &elbv2types.LoadBalancer{
 								LoadBalancerArn: aws.String("lb-1"),
-								AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+								AvailabilityZones: []elbv2types.AvailabilityZone{
 									{
 										SubnetId: aws.String("subnet-c"),
 									},
@@ -1039,7 +1040,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 										SubnetId: aws.String("subnet-d"),
 									},
 								},
-								Scheme: aws.String("internal"),
+								Scheme: elbv2types.LoadBalancerSchemeEnumInternal,
 							},
 							Tags: map[string]string{
 								"elbv2.k8s.aws/cluster": "cluster-name",
@@ -1051,7 +1052,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 			},
 			resolveViaNameOrIDSliceCalls: []resolveSubnetResults{
 				{
-					subnets: []*ec2.Subnet{
+					subnets: []ec2types.Subnet{
 						{
 							SubnetId:  aws.String("subnet-c"),
 							CidrBlock: aws.String("192.168.0.0/19"),
@@ -1063,7 +1064,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 					},
 				},
 			},
-			want: []*ec2.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:  aws.String("subnet-c"),
 					CidrBlock: aws.String("192.168.0.0/19"),
@@ -1078,15 +1079,15 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 			name:     "subnet auto discovery, with existing LB and scheme would change",
 			svc:      &corev1.Service{},
 			scheme:   elbv2.LoadBalancerSchemeInternal,
-			provider: tracking.NewDefaultProvider("service.k8s.aws", "cluster-name"),
+			provider: tracking.NewDefaultProvider("service.k8s.aws", "cluster-name", nil),
 			args:     args{stack: core.NewDefaultStack(core.StackID{Namespace: "namespace", Name: "serviceName"})},
 			listLoadBalancersCalls: []listLoadBalancerCall{
 				{
 					sdkLBs: []elbv2deploy.LoadBalancerWithTags{
 						{
-							LoadBalancer: &elbv2sdk.LoadBalancer{
+							LoadBalancer: &elbv2types.LoadBalancer{
 								LoadBalancerArn: aws.String("lb-1"),
-								AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+								AvailabilityZones: []elbv2types.AvailabilityZone{
 									{
 										SubnetId: aws.String("subnet-c"),
 									},
@@ -1094,7 +1095,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 										SubnetId: aws.String("subnet-d"),
 									},
 								},
-								Scheme: aws.String("internet-facing"),
+								Scheme: elbv2types.LoadBalancerSchemeEnumInternetFacing,
 							},
 							Tags: map[string]string{
 								"elbv2.k8s.aws/cluster": "cluster-name",
@@ -1106,7 +1107,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 			},
 			resolveViaDiscoveryCalls: []resolveSubnetResults{
 				{
-					subnets: []*ec2.Subnet{
+					subnets: []ec2types.Subnet{
 						{
 							SubnetId:  aws.String("subnet-a"),
 							CidrBlock: aws.String("192.168.0.0/19"),
@@ -1118,7 +1119,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 					},
 				},
 			},
-			want: []*ec2.Subnet{
+			want: []ec2types.Subnet{
 				{
 					SubnetId:  aws.String("subnet-a"),
 					CidrBlock: aws.String("192.168.0.0/19"),
@@ -1150,7 +1151,7 @@ func Test_defaultModelBuilderTask_buildLoadBalancerSubnets(t *testing.T) {
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 
 			clusterName := "cluster-name"
-			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", clusterName)
+			trackingProvider := tracking.NewDefaultProvider("ingress.k8s.aws", clusterName, nil)
 			featureGates := config.NewFeatureGates()
 
 			builder := &defaultModelBuildTask{
@@ -1246,6 +1247,7 @@ func Test_defaultModelBuildTask_buildLoadBalancerIPAddressType(t *testing.T) {
 func Test_defaultModelBuildTask_buildAdditionalResourceTags(t *testing.T) {
 	type fields struct {
 		service             *corev1.Service
+		lbConfiguration     *elbv2api.LoadBalancerConfiguration
 		defaultTags         map[string]string
 		externalManagedTags sets.String
 	}
@@ -1356,11 +1358,76 @@ func Test_defaultModelBuildTask_buildAdditionalResourceTags(t *testing.T) {
 			},
 			wantErr: errors.New("external managed tag key k3 cannot be specified on Service"),
 		},
+		{
+			name: "non-empty default tags, non-empty tags annotation and loadBalancerConfiguration tags",
+			fields: fields{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							"service.beta.kubernetes.io/aws-load-balancer-additional-resource-tags": "k1=v1,k2=v2a",
+						},
+					},
+				},
+				lbConfiguration: &elbv2api.LoadBalancerConfiguration{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "awesome-config",
+					},
+					Spec: elbv2api.LoadBalancerConfigurationSpec{
+						Tags: []elbv2api.Tag{
+							{
+								Key:   "k2",
+								Value: "v2",
+							},
+							{
+								Key:   "k3",
+								Value: "v3a",
+							},
+						},
+					},
+				},
+				defaultTags: map[string]string{
+					"k3": "v3",
+					"k4": "v4",
+				},
+			},
+			want: map[string]string{
+				"k1": "v1",
+				"k2": "v2",
+				"k3": "v3",
+				"k4": "v4",
+			},
+		},
+		{
+			name: "non-empty external tags, non-empty loadBalancerConfiguration tags - has collision",
+			fields: fields{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{},
+					},
+				},
+				lbConfiguration: &elbv2api.LoadBalancerConfiguration{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "awesome-config",
+					},
+					Spec: elbv2api.LoadBalancerConfigurationSpec{
+						Tags: []elbv2api.Tag{
+							{
+								Key:   "k3",
+								Value: "v3",
+							},
+						},
+					},
+				},
+				externalManagedTags: sets.NewString("k3"),
+			},
+			wantErr: errors.New("external managed tag key k3 cannot be specified on LoadBalancerConfiguration awesome-config"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			task := &defaultModelBuildTask{
 				service:             tt.fields.service,
+				lbConfiguration:     tt.fields.lbConfiguration,
 				defaultTags:         tt.fields.defaultTags,
 				externalManagedTags: tt.fields.externalManagedTags,
 				annotationParser:    annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
@@ -1441,3 +1508,102 @@ func Test_defaultModelBuildTask_buildLoadBalancerName(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuildTask_buildLoadBalancerSecurityGroups_externallyManagedNetworking(t *testing.T) {
+	type resolveSGCall struct {
+		sgNameOrIDs []string
+		sgIDs       []string
+		err         error
+	}
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		existingLB    *elbv2deploy.LoadBalancerWithTags
+		resolveSGCall *resolveSGCall
+		want          []core.StringToken
+		wantErr       error
+	}{
+		{
+			name: "security groups specified",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-externally-managed-networking": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups":               "sg-a, sg-b-name",
+			},
+			resolveSGCall: &resolveSGCall{
+				sgNameOrIDs: []string{"sg-a", "sg-b-name"},
+				sgIDs:       []string{"sg-a", "sg-b"},
+			},
+			want: []core.StringToken{core.LiteralStringToken("sg-a"), core.LiteralStringToken("sg-b")},
+		},
+		{
+			name: "security groups not specified",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-externally-managed-networking": "true",
+			},
+			want: nil,
+		},
+		{
+			name: "security groups not specified for existing load balancer with security groups",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-externally-managed-networking": "true",
+			},
+			existingLB: &elbv2deploy.LoadBalancerWithTags{
+				LoadBalancer: &elbv2types.LoadBalancer{
+					SecurityGroups: []string{"sg-managed"},
+				},
+			},
+			wantErr: errors.New("securityGroups must be specified via aws-load-balancer-security-groups annotation for load balancer with securityGroups when networking is externally managed"),
+		},
+		{
+			name: "backend security group rules managed",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-externally-managed-networking":       "true",
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups":                     "sg-a",
+				"service.beta.kubernetes.io/aws-load-balancer-manage-backend-security-group-rules": "true",
+			},
+			wantErr: errors.New("backend securityGroup rules cannot be managed when networking is externally managed"),
+		},
+		{
+			name: "security groups failed to resolve",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-externally-managed-networking": "true",
+				"service.beta.kubernetes.io/aws-load-balancer-security-groups":               "sg-a",
+			},
+			resolveSGCall: &resolveSGCall{
+				sgNameOrIDs: []string{"sg-a"},
+				err:         errors.New("couldn't find all securityGroups"),
+			},
+			wantErr: errors.New("couldn't find all securityGroups"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sgResolver := networking.NewMockSecurityGroupResolver(ctrl)
+			if tt.resolveSGCall != nil {
+				sgResolver.EXPECT().ResolveViaNameOrID(gomock.Any(), tt.resolveSGCall.sgNameOrIDs).Return(tt.resolveSGCall.sgIDs, tt.resolveSGCall.err)
+			}
+			builder := &defaultModelBuildTask{
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "my-svc",
+						Annotations: tt.annotations,
+					},
+				},
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				sgResolver:       sgResolver,
+				featureGates:     config.NewFeatureGates(),
+			}
+			got, err := builder.buildLoadBalancerSecurityGroups(context.Background(), tt.existingLB, elbv2.IPAddressTypeIPV4, nil)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -10,10 +10,12 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
 )
 
 const (
@@ -64,32 +66,58 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupName(_ context.Context)
 
 func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(ctx context.Context, ipAddressType elbv2model.IPAddressType) ([]ec2model.IPPermission, error) {
 	var permissions []ec2model.IPPermission
-	cidrs, err := t.buildCIDRsFromSourceRanges(ctx, ipAddressType)
+	prefixLists, err := t.buildSecurityGroupPrefixLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cidrs, err := t.buildCIDRsFromSourceRanges(ctx, ipAddressType, len(prefixLists) == 0)
 	if err != nil {
 		return nil, err
 	}
 	for _, port := range t.service.Spec.Ports {
 		listenPort := int64(port.Port)
-		for _, cidr := range cidrs {
-			if !strings.Contains(cidr, ":") {
-				permissions = append(permissions, ec2model.IPPermission{
-					IPProtocol: strings.ToLower(string(port.Protocol)),
-					FromPort:   awssdk.Int64(listenPort),
-					ToPort:     awssdk.Int64(listenPort),
-					IPRanges: []ec2model.IPRange{
-						{
-							CIDRIP: cidr,
+		for _, ipProtocol := range managedSecurityGroupIPProtocols(port.Protocol) {
+			for _, cidr := range cidrs {
+				if (cidr == "0.0.0.0/0" || cidr == "::/0") && t.blocklistPLProvider != nil {
+					permission, err := t.buildBlocklistIngressPermission(ctx, ipProtocol, listenPort, cidr)
+					if err != nil {
+						return nil, err
+					}
+					permissions = append(permissions, permission)
+					continue
+				}
+				if !strings.Contains(cidr, ":") {
+					permissions = append(permissions, ec2model.IPPermission{
+						IPProtocol: ipProtocol,
+						FromPort:   awssdk.Int64(listenPort),
+						ToPort:     awssdk.Int64(listenPort),
+						IPRanges: []ec2model.IPRange{
+							{
+								CIDRIP: cidr,
+							},
 						},
-					},
-				})
-			} else {
+					})
+				} else {
+					permissions = append(permissions, ec2model.IPPermission{
+						IPProtocol: ipProtocol,
+						FromPort:   awssdk.Int64(listenPort),
+						ToPort:     awssdk.Int64(listenPort),
+						IPv6Range: []ec2model.IPv6Range{
+							{
+								CIDRIPv6: cidr,
+							},
+						},
+					})
+				}
+			}
+			for _, prefixList := range prefixLists {
 				permissions = append(permissions, ec2model.IPPermission{
-					IPProtocol: strings.ToLower(string(port.Protocol)),
+					IPProtocol: ipProtocol,
 					FromPort:   awssdk.Int64(listenPort),
 					ToPort:     awssdk.Int64(listenPort),
-					IPv6Range: []ec2model.IPv6Range{
+					PrefixLists: []ec2model.PrefixList{
 						{
-							CIDRIPv6: cidr,
+							ListID: prefixList,
 						},
 					},
 				})
@@ -99,7 +127,52 @@ func (t *defaultModelBuildTask) buildManagedSecurityGroupIngressPermissions(ctx
 	return permissions, nil
 }
 
-func (t *defaultModelBuildTask) buildCIDRsFromSourceRanges(_ context.Context, ipAddressType elbv2model.IPAddressType) ([]string, error) {
+// managedSecurityGroupIPProtocols returns the IP protocols of the managed SG permissions for the protocol of a Service port.
+// The TCP_UDP listeners require both tcp and udp permissions, as it's not a valid IP protocol.
+func managedSecurityGroupIPProtocols(protocol corev1.Protocol) []string {
+	if protocol == serviceProtocolTCPUDP {
+		return []string{"tcp", "udp"}
+	}
+	return []string{strings.ToLower(string(protocol))}
+}
+
+// buildBlocklistIngressPermission builds the permission that allows the internet except the CIDRs blocked by Blocklists.
+func (t *defaultModelBuildTask) buildBlocklistIngressPermission(ctx context.Context, ipProtocol string, port int64, cidr string) (ec2model.IPPermission, error) {
+	addressFamily := networking.PrefixListAddressFamilyIPv4
+	if strings.Contains(cidr, ":") {
+		addressFamily = networking.PrefixListAddressFamilyIPv6
+	}
+	prefixListID, err := t.blocklistPLProvider.Get(ctx, addressFamily)
+	if err != nil {
+		return ec2model.IPPermission{}, err
+	}
+	return ec2model.IPPermission{
+		IPProtocol: ipProtocol,
+		FromPort:   awssdk.Int64(port),
+		ToPort:     awssdk.Int64(port),
+		PrefixLists: []ec2model.PrefixList{
+			{
+				ListID: prefixListID,
+			},
+		},
+	}, nil
+}
+
+// buildSecurityGroupPrefixLists builds the managed prefix lists allowed to access the load balancer, in addition to the source ranges.
+func (t *defaultModelBuildTask) buildSecurityGroupPrefixLists(_ context.Context) ([]string, error) {
+	var prefixLists []string
+	t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixSecurityGroupPrefixLists, &prefixLists, t.service.Annotations)
+	for _, prefixList := range prefixLists {
+		if !strings.HasPrefix(prefixList, "pl-") {
+			return nil, errors.Errorf("invalid %v annotation: invalid prefix list %v", annotations.SvcLBSuffixSecurityGroupPrefixLists, prefixList)
+		}
+	}
+	return prefixLists, nil
+}
+
+// buildCIDRsFromSourceRanges builds the CIDRs allowed to access the load balancer, it defaults to the internet if none are specified
+// and defaultToInternet is true.
+func (t *defaultModelBuildTask) buildCIDRsFromSourceRanges(_ context.Context, ipAddressType elbv2model.IPAddressType, defaultToInternet bool) ([]string, error) {
 	var cidrs []string
 	for _, cidr := range t.service.Spec.LoadBalancerSourceRanges {
 		cidrs = append(cidrs, cidr)
@@ -112,7 +185,7 @@ func (t *defaultModelBuildTask) buildCIDRsFromSourceRanges(_ context.Context, ip
 			return nil, errors.Errorf("unsupported v6 cidr %v when lb is not dualstack", cidr)
 		}
 	}
-	if len(cidrs) == 0 {
+	if len(cidrs) == 0 && defaultToInternet {
 		cidrs = append(cidrs, "0.0.0.0/0")
 		if ipAddressType == elbv2model.IPAddressTypeDualStack {
 			cidrs = append(cidrs, "::/0")
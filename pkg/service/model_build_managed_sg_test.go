@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	ec2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/ec2"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+)
+
+func Test_defaultModelBuildTask_buildManagedSecurityGroupIngressPermissions(t *testing.T) {
+	tests := []struct {
+		name            string
+		svc             *corev1.Service
+		ipAddressType   elbv2model.IPAddressType
+		enableBlocklist bool
+		want            []ec2model.IPPermission
+		wantErr         error
+	}{
+		{
+			name: "internet cidrs without blocklist",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 80, Protocol: corev1.ProtocolTCP}},
+				},
+			},
+			ipAddressType: elbv2model.IPAddressTypeDualStack,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "0.0.0.0/0"}},
+				},
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPv6Range:  []ec2model.IPv6Range{{CIDRIPv6: "::/0"}},
+				},
+			},
+		},
+		{
+			name: "internet cidrs with blocklist",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 80, Protocol: corev1.ProtocolTCP}},
+				},
+			},
+			ipAddressType:   elbv2model.IPAddressTypeDualStack,
+			enableBlocklist: true,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol:  "tcp",
+					FromPort:    awssdk.Int64(80),
+					ToPort:      awssdk.Int64(80),
+					PrefixLists: []ec2model.PrefixList{{ListID: "pl-ipv4"}},
+				},
+				{
+					IPProtocol:  "tcp",
+					FromPort:    awssdk.Int64(80),
+					ToPort:      awssdk.Int64(80),
+					PrefixLists: []ec2model.PrefixList{{ListID: "pl-ipv6"}},
+				},
+			},
+		},
+		{
+			name: "source ranges with blocklist",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+				Spec: corev1.ServiceSpec{
+					Ports:                    []corev1.ServicePort{{Port: 53, Protocol: corev1.ProtocolUDP}},
+					LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+				},
+			},
+			ipAddressType:   elbv2model.IPAddressTypeIPV4,
+			enableBlocklist: true,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "udp",
+					FromPort:   awssdk.Int64(53),
+					ToPort:     awssdk.Int64(53),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/8"}},
+				},
+			},
+		},
+		{
+			name: "tcp_udp port",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "awesome-ns", Name: "svc-1"},
+				Spec: corev1.ServiceSpec{
+					Ports:                    []corev1.ServicePort{{Port: 53, Protocol: serviceProtocolTCPUDP}},
+					LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+				},
+			},
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(53),
+					ToPort:     awssdk.Int64(53),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/8"}},
+				},
+				{
+					IPProtocol: "udp",
+					FromPort:   awssdk.Int64(53),
+					ToPort:     awssdk.Int64(53),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/8"}},
+				},
+			},
+		},
+		{
+			name: "prefix lists without source ranges",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-security-group-prefix-lists": "pl-xxxx, pl-yyyy",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 80, Protocol: corev1.ProtocolTCP}},
+				},
+			},
+			ipAddressType: elbv2model.IPAddressTypeDualStack,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol:  "tcp",
+					FromPort:    awssdk.Int64(80),
+					ToPort:      awssdk.Int64(80),
+					PrefixLists: []ec2model.PrefixList{{ListID: "pl-xxxx"}},
+				},
+				{
+					IPProtocol:  "tcp",
+					FromPort:    awssdk.Int64(80),
+					ToPort:      awssdk.Int64(80),
+					PrefixLists: []ec2model.PrefixList{{ListID: "pl-yyyy"}},
+				},
+			},
+		},
+		{
+			name: "prefix lists with source ranges",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-security-group-prefix-lists": "pl-xxxx",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports:                    []corev1.ServicePort{{Port: 80, Protocol: corev1.ProtocolTCP}},
+					LoadBalancerSourceRanges: []string{"10.0.0.0/8"},
+				},
+			},
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			want: []ec2model.IPPermission{
+				{
+					IPProtocol: "tcp",
+					FromPort:   awssdk.Int64(80),
+					ToPort:     awssdk.Int64(80),
+					IPRanges:   []ec2model.IPRange{{CIDRIP: "10.0.0.0/8"}},
+				},
+				{
+					IPProtocol:  "tcp",
+					FromPort:    awssdk.Int64(80),
+					ToPort:      awssdk.Int64(80),
+					PrefixLists: []ec2model.PrefixList{{ListID: "pl-xxxx"}},
+				},
+			},
+		},
+		{
+			name: "invalid prefix list",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "svc-1",
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-security-group-prefix-lists": "sg-xxxx",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Port: 80, Protocol: corev1.ProtocolTCP}},
+				},
+			},
+			ipAddressType: elbv2model.IPAddressTypeIPV4,
+			wantErr:       errors.New("invalid aws-load-balancer-security-group-prefix-lists annotation: invalid prefix list sg-xxxx"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			task := &defaultModelBuildTask{
+				service:          tt.svc,
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+			}
+			if tt.enableBlocklist {
+				blocklistPLProvider := networking.NewMockBlocklistPrefixListProvider(ctrl)
+				blocklistPLProvider.EXPECT().Get(gomock.Any(), networking.PrefixListAddressFamilyIPv4).Return("pl-ipv4", nil).AnyTimes()
+				blocklistPLProvider.EXPECT().Get(gomock.Any(), networking.PrefixListAddressFamilyIPv6).Return("pl-ipv6", nil).AnyTimes()
+				task.blocklistPLProvider = blocklistPLProvider
+			}
+			got, err := task.buildManagedSecurityGroupIngressPermissions(context.Background(), tt.ipAddressType)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
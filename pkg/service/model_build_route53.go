@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	route53model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/route53"
+)
+
+// buildRoute53RecordSets builds the Route 53 alias records routing the hostnames of Service to the NLB.
+func (t *defaultModelBuildTask) buildRoute53RecordSets(_ context.Context) error {
+	var rawHostnames []string
+	if !t.annotationParser.ParseStringSliceAnnotation(annotations.SvcLBSuffixHostname, &rawHostnames, t.service.Annotations) {
+		return nil
+	}
+	if !t.featureGates.Enabled(config.Route53AliasRecords) {
+		return errors.Errorf("hostname cannot be specified on Service, the %v feature gate is disabled", config.Route53AliasRecords)
+	}
+	hostnames := sets.NewString()
+	for _, rawHostname := range rawHostnames {
+		hostname := strings.ToLower(rawHostname)
+		var errs []string
+		if strings.HasPrefix(hostname, "*.") {
+			errs = validation.IsWildcardDNS1123Subdomain(hostname)
+		} else {
+			errs = validation.IsDNS1123Subdomain(hostname)
+		}
+		if len(errs) != 0 {
+			return errors.Errorf("invalid hostname %v: %v", rawHostname, strings.Join(errs, ", "))
+		}
+		hostnames.Insert(hostname)
+	}
+	recordTypes := []route53model.RecordType{route53model.RecordTypeA}
+	if t.loadBalancer.Spec.IPAddressType != nil && t.loadBalancer.Spec.IPAddressType.IsDualStack() {
+		recordTypes = append(recordTypes, route53model.RecordTypeAAAA)
+	}
+	for _, hostname := range hostnames.List() {
+		route53model.NewRecordSet(t.stack, hostname, route53model.RecordSetSpec{
+			Name:  hostname,
+			Types: recordTypes,
+			AliasTarget: route53model.AliasTarget{
+				DNSName:      t.loadBalancer.DNSName(),
+				HostedZoneID: t.loadBalancer.CanonicalHostedZoneID(),
+			},
+		})
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/model/core"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	route53model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/route53"
+)
+
+func Test_defaultModelBuilderTask_buildRoute53RecordSets(t *testing.T) {
+	dualStack := elbv2model.IPAddressTypeDualStack
+	tests := []struct {
+		name                       string
+		annotations                map[string]string
+		ipAddressType              *elbv2model.IPAddressType
+		disableRoute53AliasRecords bool
+		wantNames                  []string
+		wantTypes                  []route53model.RecordType
+		wantErr                    string
+	}{
+		{
+			name: "hostname not specified",
+		},
+		{
+			name: "hostnames specified",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-hostname": "Svc.Example.com, *.example.com, svc.example.com",
+			},
+			wantNames: []string{"*.example.com", "svc.example.com"},
+			wantTypes: []route53model.RecordType{route53model.RecordTypeA},
+		},
+		{
+			name: "hostname specified on dualstack load balancer",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-hostname": "svc.example.com",
+			},
+			ipAddressType: &dualStack,
+			wantNames:     []string{"svc.example.com"},
+			wantTypes:     []route53model.RecordType{route53model.RecordTypeA, route53model.RecordTypeAAAA},
+		},
+		{
+			name: "invalid hostname",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-hostname": "svc_1.example.com",
+			},
+			wantErr: "invalid hostname svc_1.example.com: a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')",
+		},
+		{
+			name: "hostname specified with feature gate disabled",
+			annotations: map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-hostname": "svc.example.com",
+			},
+			disableRoute53AliasRecords: true,
+			wantErr:                    "hostname cannot be specified on Service, the Route53AliasRecords feature gate is disabled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featureGates := config.NewFeatureGates()
+			if !tt.disableRoute53AliasRecords {
+				featureGates.Enable(config.Route53AliasRecords)
+			}
+			stack := core.NewDefaultStack(core.StackID(types.NamespacedName{Namespace: "awesome-ns", Name: "awesome-svc"}))
+			lb := elbv2model.NewLoadBalancer(stack, resourceIDLoadBalancer, elbv2model.LoadBalancerSpec{
+				Name:          "k8s-awesomens-awesomes-abcdef",
+				IPAddressType: tt.ipAddressType,
+			})
+			lb.SetStatus(elbv2model.LoadBalancerStatus{
+				DNSName:               "lb-dns-name",
+				CanonicalHostedZoneID: "lb-hosted-zone-id",
+			})
+			task := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				featureGates:     featureGates,
+				service: &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "awesome-ns",
+						Name:        "awesome-svc",
+						Annotations: tt.annotations,
+					},
+				},
+				stack:        stack,
+				loadBalancer: lb,
+			}
+			err := task.buildRoute53RecordSets(context.Background())
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			var resRecordSets []*route53model.RecordSet
+			stack.ListResources(&resRecordSets)
+			var gotNames []string
+			for _, resRecordSet := range resRecordSets {
+				gotNames = append(gotNames, resRecordSet.Spec.Name)
+				assert.Equal(t, tt.wantTypes, resRecordSet.Spec.Types)
+				gotDNSName, err := resRecordSet.Spec.AliasTarget.DNSName.Resolve(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, "lb-dns-name", gotDNSName)
+				gotHostedZoneID, err := resRecordSet.Spec.AliasTarget.HostedZoneID.Resolve(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, "lb-hosted-zone-id", gotHostedZoneID)
+			}
+			assert.ElementsMatch(t, tt.wantNames, gotNames)
+		})
+	}
+}
@@ -10,14 +10,17 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
@@ -27,6 +30,7 @@ import (
 const (
 	tgAttrsProxyProtocolV2Enabled  = "proxy_protocol_v2.enabled"
 	tgAttrsPreserveClientIPEnabled = "preserve_client_ip.enabled"
+	tgAttrsConnectionTermination   = "deregistration_delay.connection_termination.enabled"
 	healthCheckPortTrafficPort     = "traffic-port"
 )
 
@@ -209,6 +213,9 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixTargetGroupAttributes, &rawAttributes, t.service.Annotations); err != nil {
 		return nil, err
 	}
+	if t.lbConfiguration != nil && len(t.lbConfiguration.Spec.TargetGroupAttributes) != 0 {
+		rawAttributes = algorithm.MergeStringMap(rawAttributes, attributesAsMap(t.lbConfiguration.Spec.TargetGroupAttributes))
+	}
 	if rawAttributes == nil {
 		rawAttributes = make(map[string]string)
 	}
@@ -228,6 +235,12 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 			return nil, errors.Wrapf(err, "failed to parse attribute %v=%v", tgAttrsPreserveClientIPEnabled, rawPreserveIPEnabled)
 		}
 	}
+	if err := t.buildTargetGroupConnectionTerminationAttribute(rawAttributes); err != nil {
+		return nil, err
+	}
+	if err := elbv2model.ValidateTargetGroupHealthAttributes(rawAttributes); err != nil {
+		return nil, err
+	}
 	attributes := make([]elbv2model.TargetGroupAttribute, 0, len(rawAttributes))
 	for attrKey, attrValue := range rawAttributes {
 		attributes = append(attributes, elbv2model.TargetGroupAttribute{
@@ -241,6 +254,33 @@ func (t *defaultModelBuildTask) buildTargetGroupAttributes(_ context.Context) ([
 	return attributes, nil
 }
 
+// buildTargetGroupConnectionTerminationAttribute sets the connection termination attribute from the typed annotation,
+// which must agree with the attribute if specified via target group attributes annotation as well.
+func (t *defaultModelBuildTask) buildTargetGroupConnectionTerminationAttribute(rawAttributes map[string]string) error {
+	var rawAttrEnabled *bool
+	if rawAttrValue, ok := rawAttributes[tgAttrsConnectionTermination]; ok {
+		enabled, err := strconv.ParseBool(rawAttrValue)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse attribute %v=%v", tgAttrsConnectionTermination, rawAttrValue)
+		}
+		rawAttrEnabled = &enabled
+	}
+	var enabled bool
+	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixConnectionTermination, &enabled, t.service.Annotations)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return nil
+	}
+	if rawAttrEnabled != nil && *rawAttrEnabled != enabled {
+		return errors.Errorf("conflicting connection termination, annotation: %v, attribute %v: %v",
+			enabled, tgAttrsConnectionTermination, *rawAttrEnabled)
+	}
+	rawAttributes[tgAttrsConnectionTermination] = strconv.FormatBool(enabled)
+	return nil
+}
+
 func (t *defaultModelBuildTask) buildPreserveClientIPFlag(_ context.Context, targetType elbv2model.TargetType, tgAttrs []elbv2model.TargetGroupAttribute) (bool, error) {
 	for _, attr := range tgAttrs {
 		if attr.Key == tgAttrsPreserveClientIPEnabled {
@@ -375,6 +415,9 @@ func (t *defaultModelBuildTask) buildTargetType(_ context.Context, port corev1.S
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, t.service.Annotations)
 	var lbTargetType string
 	lbTargetType = string(t.defaultTargetType)
+	if t.lbConfiguration != nil && t.lbConfiguration.Spec.TargetType != nil {
+		lbTargetType = string(*t.lbConfiguration.Spec.TargetType)
+	}
 	_ = t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetType, &lbTargetType, t.service.Annotations)
 	if lbTargetType == LoadBalancerTargetTypeIP && !t.enableIPTargetType {
 		return "", errors.Errorf("unsupported targetType: %v when EnableIPTargetType is %v", lbTargetType, t.enableIPTargetType)
@@ -414,6 +457,18 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 	if err != nil {
 		return elbv2model.TargetGroupBindingResourceSpec{}, err
 	}
+	multiClusterEnabled, err := t.buildTargetGroupBindingMultiClusterFlag(ctx)
+	if err != nil {
+		return elbv2model.TargetGroupBindingResourceSpec{}, err
+	}
+	connectionTermination, err := t.buildTargetGroupBindingConnectionTerminationFlag(ctx, targetGroup.Spec.TargetGroupAttributes)
+	if err != nil {
+		return elbv2model.TargetGroupBindingResourceSpec{}, err
+	}
+	targetIPFamilyPolicy, err := t.buildTargetGroupBindingTargetIPFamilyPolicy(ctx, targetGroup)
+	if err != nil {
+		return elbv2model.TargetGroupBindingResourceSpec{}, err
+	}
 	targetPort := port.TargetPort
 	targetType := elbv2api.TargetType(targetGroup.Spec.TargetType)
 	if targetType == elbv2api.TargetTypeInstance {
@@ -421,7 +476,8 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 	}
 	var tgbNetworking *elbv2model.TargetGroupBindingNetworking
 	if len(t.loadBalancer.Spec.SecurityGroups) == 0 {
-		tgbNetworking, err = t.buildTargetGroupBindingNetworkingLegacy(ctx, targetPort, *hc.Port, port, scheme, *targetGroup.Spec.IPAddressType)
+		tgbNetworking, err = t.buildTargetGroupBindingNetworkingLegacy(ctx, targetPort, *hc.Port, port, scheme, *targetGroup.Spec.IPAddressType,
+			targetType, nodeSelector)
 	} else {
 		tgbNetworking, err = t.buildTargetGroupBindingNetworking(ctx, targetPort, *hc.Port, port)
 	}
@@ -441,9 +497,12 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingSpec(ctx context.Context,
 					Name: t.service.Name,
 					Port: intstr.FromInt(int(port.Port)),
 				},
-				Networking:    tgbNetworking,
-				NodeSelector:  nodeSelector,
-				IPAddressType: (*elbv2api.TargetGroupIPAddressType)(targetGroup.Spec.IPAddressType),
+				Networking:              tgbNetworking,
+				NodeSelector:            nodeSelector,
+				IPAddressType:           (*elbv2api.TargetGroupIPAddressType)(targetGroup.Spec.IPAddressType),
+				TargetIPFamilyPolicy:    targetIPFamilyPolicy,
+				MultiClusterTargetGroup: multiClusterEnabled,
+				ConnectionTermination:   connectionTermination,
 			},
 		},
 	}, nil
@@ -463,7 +522,7 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Cont
 			Protocol: &protocolTCP,
 			Port:     nil,
 		})
-		if port.Protocol == corev1.ProtocolUDP {
+		if hasUDPTraffic(port.Protocol) {
 			ports = append(ports, elbv2api.NetworkingPort{
 				Protocol: &protocolUDP,
 				Port:     nil,
@@ -487,6 +546,14 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworking(_ context.Cont
 					Port:     &tgPort,
 				})
 			}
+		case serviceProtocolTCPUDP:
+			ports = append(ports, elbv2api.NetworkingPort{
+				Protocol: &protocolTCP,
+				Port:     &tgPort,
+			}, elbv2api.NetworkingPort{
+				Protocol: &protocolUDP,
+				Port:     &tgPort,
+			})
 		}
 
 		if hcPort.String() != healthCheckPortTrafficPort && (hcPort.Type == intstr.Int && hcPort.IntValue() != tgPort.IntValue()) {
@@ -536,7 +603,15 @@ func (t *defaultModelBuildTask) buildPeersFromSourceRangeCIDRs(_ context.Context
 }
 
 func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingLegacy(ctx context.Context, tgPort intstr.IntOrString,
-	hcPort intstr.IntOrString, port corev1.ServicePort, scheme elbv2model.LoadBalancerScheme, targetGroupIPAddressType elbv2model.TargetGroupIPAddressType) (*elbv2model.TargetGroupBindingNetworking, error) {
+	hcPort intstr.IntOrString, port corev1.ServicePort, scheme elbv2model.LoadBalancerScheme, targetGroupIPAddressType elbv2model.TargetGroupIPAddressType,
+	targetType elbv2api.TargetType, nodeSelector *metav1.LabelSelector) (*elbv2model.TargetGroupBindingNetworking, error) {
+	externallyManagedNetworking, err := t.buildExternallyManagedNetworking(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if externallyManagedNetworking {
+		return nil, nil
+	}
 	manageBackendSGRules, err := t.buildManageSecurityGroupRulesFlagLegacy(ctx)
 	if err != nil {
 		return nil, err
@@ -545,15 +620,21 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingLegacy(ctx cont
 		return nil, nil
 	}
 	tgProtocol := port.Protocol
-	networkingProtocol := elbv2api.NetworkingProtocolTCP
-	healthCheckProtocol := elbv2api.NetworkingProtocolTCP
-	if tgProtocol == corev1.ProtocolUDP {
-		networkingProtocol = elbv2api.NetworkingProtocolUDP
+	var networkingProtocols []elbv2api.NetworkingProtocol
+	switch tgProtocol {
+	case corev1.ProtocolUDP:
+		networkingProtocols = []elbv2api.NetworkingProtocol{elbv2api.NetworkingProtocolUDP}
+	case serviceProtocolTCPUDP:
+		networkingProtocols = []elbv2api.NetworkingProtocol{elbv2api.NetworkingProtocolTCP, elbv2api.NetworkingProtocolUDP}
+	default:
+		networkingProtocols = []elbv2api.NetworkingProtocol{elbv2api.NetworkingProtocolTCP}
 	}
+	healthCheckProtocol := elbv2api.NetworkingProtocolTCP
 	loadBalancerSubnetCIDRs := t.getLoadBalancerSubnetsSourceRanges(targetGroupIPAddressType)
 	trafficSource := loadBalancerSubnetCIDRs
+	var trafficPrefixListPeers []elbv2model.NetworkingPeer
 	defaultRangeUsed := false
-	if networkingProtocol == elbv2api.NetworkingProtocolUDP || t.preserveClientIP {
+	if hasUDPTraffic(tgProtocol) || t.preserveClientIP {
 		trafficSource = t.getLoadBalancerSourceRanges(ctx)
 		if len(trafficSource) == 0 {
 			trafficSource, err = t.getDefaultIPSourceRanges(ctx, targetGroupIPAddressType, port.Protocol, scheme)
@@ -561,18 +642,35 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNetworkingLegacy(ctx cont
 				return nil, err
 			}
 			defaultRangeUsed = true
+			if t.restrictSGRulesToNodeSubnets && targetType == elbv2api.TargetTypeInstance && scheme == elbv2model.LoadBalancerSchemeInternal {
+				// the health check traffic originates from the load balancer subnets, which are no longer covered by the
+				// node subnets, thus requires the dedicated health check rule.
+				if t.nodeSubnetsPrefixListProvider != nil && nodeSelector == nil {
+					// the node subnets are referenced by a single rule for the managed prefix list instead of a rule per subnet CIDR.
+					trafficSource = nil
+					trafficPrefixListPeers, err = t.buildNodeSubnetsPrefixListPeers(ctx, targetGroupIPAddressType)
+				} else {
+					trafficSource, err = t.getNodeSubnetsSourceRanges(ctx, targetGroupIPAddressType, nodeSelector)
+				}
+				if err != nil {
+					return nil, err
+				}
+				defaultRangeUsed = false
+			}
 		}
 	}
+	var trafficPorts []elbv2api.NetworkingPort
+	for i := range networkingProtocols {
+		trafficPorts = append(trafficPorts, elbv2api.NetworkingPort{
+			Port:     &tgPort,
+			Protocol: &networkingProtocols[i],
+		})
+	}
 	tgbNetworking := &elbv2model.TargetGroupBindingNetworking{
 		Ingress: []elbv2model.NetworkingIngressRule{
 			{
-				From: t.buildPeersFromSourceRangeCIDRs(ctx, trafficSource),
-				Ports: []elbv2api.NetworkingPort{
-					{
-						Port:     &tgPort,
-						Protocol: &networkingProtocol,
-					},
-				},
+				From:  append(t.buildPeersFromSourceRangeCIDRs(ctx, trafficSource), trafficPrefixListPeers...),
+				Ports: trafficPorts,
 			},
 		},
 	}
@@ -601,7 +699,7 @@ func (t *defaultModelBuildTask) getDefaultIPSourceRanges(ctx context.Context, ta
 	if targetGroupIPAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
 		defaultSourceRanges = t.defaultIPv6SourceRanges
 	}
-	if (protocol == corev1.ProtocolUDP || t.preserveClientIP) && scheme == elbv2model.LoadBalancerSchemeInternal {
+	if (hasUDPTraffic(protocol) || t.preserveClientIP) && scheme == elbv2model.LoadBalancerSchemeInternal {
 		vpcInfo, err := t.vpcInfoProvider.FetchVPCInfo(ctx, t.vpcID, networking.FetchVPCInfoWithoutCache())
 		if err != nil {
 			return nil, err
@@ -615,29 +713,86 @@ func (t *defaultModelBuildTask) getDefaultIPSourceRanges(ctx context.Context, ta
 	return defaultSourceRanges, nil
 }
 
+func (t *defaultModelBuildTask) getNodeSubnetsSourceRanges(ctx context.Context, targetGroupIPAddressType elbv2model.TargetGroupIPAddressType,
+	nodeSelector *metav1.LabelSelector) ([]string, error) {
+	trafficProxyNodeSelector, err := backend.GetTrafficProxyNodeSelector(&elbv2api.TargetGroupBinding{
+		Spec: elbv2api.TargetGroupBindingSpec{
+			NodeSelector: nodeSelector,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	nodeSubnets, err := t.nodeSubnetsResolver.ResolveNodeSubnets(ctx, trafficProxyNodeSelector)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodeSubnets) == 0 {
+		return nil, errors.New("unable to resolve subnets for target nodes")
+	}
+	return subnetsCIDRs(nodeSubnets, targetGroupIPAddressType), nil
+}
+
+func (t *defaultModelBuildTask) buildNodeSubnetsPrefixListPeers(ctx context.Context, targetGroupIPAddressType elbv2model.TargetGroupIPAddressType) ([]elbv2model.NetworkingPeer, error) {
+	addressFamily := networking.PrefixListAddressFamilyIPv4
+	if targetGroupIPAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+		addressFamily = networking.PrefixListAddressFamilyIPv6
+	}
+	prefixListID, err := t.nodeSubnetsPrefixListProvider.Get(ctx, addressFamily)
+	if err != nil {
+		return nil, err
+	}
+	return []elbv2model.NetworkingPeer{
+		{
+			PrefixList: &elbv2api.PrefixList{
+				PrefixListID: prefixListID,
+			},
+		},
+	}, nil
+}
+
 func (t *defaultModelBuildTask) getLoadBalancerSubnetsSourceRanges(targetGroupIPAddressType elbv2model.TargetGroupIPAddressType) []string {
+	return subnetsCIDRs(t.ec2Subnets, targetGroupIPAddressType)
+}
+
+func subnetsCIDRs(subnets []ec2types.Subnet, targetGroupIPAddressType elbv2model.TargetGroupIPAddressType) []string {
 	var subnetCIDRs []string
-	for _, subnet := range t.ec2Subnets {
+	for _, subnet := range subnets {
 		if targetGroupIPAddressType == elbv2model.TargetGroupIPAddressTypeIPv4 {
-			subnetCIDRs = append(subnetCIDRs, aws.StringValue(subnet.CidrBlock))
+			subnetCIDRs = append(subnetCIDRs, aws.ToString(subnet.CidrBlock))
 		} else {
 			for _, ipv6CIDRBlockAssoc := range subnet.Ipv6CidrBlockAssociationSet {
-				subnetCIDRs = append(subnetCIDRs, aws.StringValue(ipv6CIDRBlockAssoc.Ipv6CidrBlock))
+				subnetCIDRs = append(subnetCIDRs, aws.ToString(ipv6CIDRBlockAssoc.Ipv6CidrBlock))
 			}
 		}
 	}
 	return subnetCIDRs
 }
 
-func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context, svc *corev1.Service) (elbv2model.TargetGroupIPAddressType, error) {
+func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(ctx context.Context, svc *corev1.Service) (elbv2model.TargetGroupIPAddressType, error) {
+	ipFamilyPolicy, err := t.buildTargetIPFamilyPolicy(ctx)
+	if err != nil {
+		return "", err
+	}
+	ipv4Configured := len(svc.Spec.IPFamilies) == 0
 	var ipv6Configured bool
 	for _, ipFamily := range svc.Spec.IPFamilies {
-		if ipFamily == corev1.IPv6Protocol {
+		switch ipFamily {
+		case corev1.IPv4Protocol:
+			ipv4Configured = true
+		case corev1.IPv6Protocol:
 			ipv6Configured = true
-			break
 		}
 	}
-	if ipv6Configured {
+	ipv6 := ipv6Configured
+	switch ipFamilyPolicy {
+	case elbv2api.TargetIPFamilyPolicyPreferIPv6:
+		// the pod IPs of the preferred family are registered regardless of the IP families of Service.
+		ipv6 = !ipv4Configured || *t.loadBalancer.Spec.IPAddressType == elbv2model.IPAddressTypeDualStack
+	case elbv2api.TargetIPFamilyPolicyPreferIPv4:
+		ipv6 = !ipv4Configured
+	}
+	if ipv6 {
 		if *t.loadBalancer.Spec.IPAddressType != elbv2model.IPAddressTypeDualStack {
 			return "", errors.New("unsupported IPv6 configuration, lb not dual-stack")
 		}
@@ -646,6 +801,37 @@ func (t *defaultModelBuildTask) buildTargetGroupIPAddressType(_ context.Context,
 	return elbv2model.TargetGroupIPAddressTypeIPv4, nil
 }
 
+// buildTargetIPFamilyPolicy builds the preferred IP family of targets for dual-stack pods, it's empty if unspecified.
+func (t *defaultModelBuildTask) buildTargetIPFamilyPolicy(_ context.Context) (elbv2api.TargetIPFamilyPolicy, error) {
+	rawIPFamilyPolicy := ""
+	if exists := t.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixTargetIPFamilyPolicy, &rawIPFamilyPolicy, t.service.Annotations); !exists {
+		return "", nil
+	}
+	switch ipFamilyPolicy := elbv2api.TargetIPFamilyPolicy(rawIPFamilyPolicy); ipFamilyPolicy {
+	case elbv2api.TargetIPFamilyPolicyPreferIPv4, elbv2api.TargetIPFamilyPolicyPreferIPv6:
+		return ipFamilyPolicy, nil
+	default:
+		return "", errors.Errorf("unknown target IP family policy: %v", rawIPFamilyPolicy)
+	}
+}
+
+// buildTargetGroupBindingTargetIPFamilyPolicy builds the targetIPFamilyPolicy of ip type TargetGroups, which registers the pod IPs of their IP address type.
+// it's only specified along with the target IP family policy annotation, so that the endpoint addresses are registered by default.
+func (t *defaultModelBuildTask) buildTargetGroupBindingTargetIPFamilyPolicy(ctx context.Context, targetGroup *elbv2model.TargetGroup) (*elbv2api.TargetIPFamilyPolicy, error) {
+	if targetGroup.Spec.TargetType != elbv2model.TargetTypeIP {
+		return nil, nil
+	}
+	ipFamilyPolicy, err := t.buildTargetIPFamilyPolicy(ctx)
+	if err != nil || ipFamilyPolicy == "" {
+		return nil, err
+	}
+	tgbIPFamilyPolicy := elbv2api.TargetIPFamilyPolicyPreferIPv4
+	if *targetGroup.Spec.IPAddressType == elbv2model.TargetGroupIPAddressTypeIPv6 {
+		tgbIPFamilyPolicy = elbv2api.TargetIPFamilyPolicyPreferIPv6
+	}
+	return &tgbIPFamilyPolicy, nil
+}
+
 func (t *defaultModelBuildTask) buildTargetGroupBindingNodeSelector(_ context.Context, targetType elbv2model.TargetType) (*metav1.LabelSelector, error) {
 	if targetType != elbv2model.TargetTypeInstance {
 		return nil, nil
@@ -662,9 +848,34 @@ func (t *defaultModelBuildTask) buildTargetGroupBindingNodeSelector(_ context.Co
 	}, nil
 }
 
+func (t *defaultModelBuildTask) buildTargetGroupBindingMultiClusterFlag(_ context.Context) (bool, error) {
+	var rawEnabled bool
+	exists, err := t.annotationParser.ParseBoolAnnotation(annotations.SvcLBSuffixMultiClusterTargetGroup, &rawEnabled, t.service.Annotations)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return rawEnabled, nil
+	}
+	return false, nil
+}
+
+func (t *defaultModelBuildTask) buildTargetGroupBindingConnectionTerminationFlag(_ context.Context, tgAttrs []elbv2model.TargetGroupAttribute) (bool, error) {
+	for _, attr := range tgAttrs {
+		if attr.Key == tgAttrsConnectionTermination {
+			connectionTermination, err := strconv.ParseBool(attr.Value)
+			if err != nil {
+				return false, errors.Wrapf(err, "failed to parse attribute %v=%v", tgAttrsConnectionTermination, attr.Value)
+			}
+			return connectionTermination, nil
+		}
+	}
+	return false, nil
+}
+
 func (t *defaultModelBuildTask) buildHealthCheckSourceCIDRs(trafficSource, subnetCIDRs []string, tgPort, hcPort intstr.IntOrString,
 	tgProtocol corev1.Protocol, defaultRangeUsed bool) []string {
-	if tgProtocol != corev1.ProtocolUDP &&
+	if !hasUDPTraffic(tgProtocol) &&
 		(hcPort.String() == healthCheckPortTrafficPort || hcPort.IntValue() == tgPort.IntValue()) {
 		if !t.preserveClientIP {
 			return nil
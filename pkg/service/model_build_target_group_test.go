@@ -10,8 +10,8 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -140,6 +140,70 @@ func Test_defaultModelBuilderTask_targetGroupAttrs(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			testName: "connection termination enabled",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-connection-termination": "true",
+					},
+				},
+			},
+			wantValue: []elbv2.TargetGroupAttribute{
+				{
+					Key:   tgAttrsProxyProtocolV2Enabled,
+					Value: "false",
+				},
+				{
+					Key:   tgAttrsConnectionTermination,
+					Value: "true",
+				},
+			},
+		},
+		{
+			testName: "connection termination agrees with target group attributes",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": tgAttrsConnectionTermination + "=false",
+						"service.beta.kubernetes.io/aws-load-balancer-connection-termination":  "false",
+					},
+				},
+			},
+			wantValue: []elbv2.TargetGroupAttribute{
+				{
+					Key:   tgAttrsProxyProtocolV2Enabled,
+					Value: "false",
+				},
+				{
+					Key:   tgAttrsConnectionTermination,
+					Value: "false",
+				},
+			},
+		},
+		{
+			testName: "connection termination conflicts with target group attributes",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": tgAttrsConnectionTermination + "=false",
+						"service.beta.kubernetes.io/aws-load-balancer-connection-termination":  "true",
+					},
+				},
+			},
+			wantError: true,
+		},
+		{
+			testName: "connection termination attribute parse error",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-target-group-attributes": tgAttrsConnectionTermination + "=maybe",
+					},
+				},
+			},
+			wantError: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -403,7 +467,6 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 	port80 := intstr.FromInt(80)
 	port808 := intstr.FromInt(808)
 	trafficPort := intstr.FromString("traffic-port")
-	cidrBlockStateAssociated := ec2.VpcCidrBlockStateCodeAssociated
 	type fetchVPCInfoCall struct {
 		wantVPCInfo networking.VPCInfo
 		err         error
@@ -414,13 +477,18 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 		svc               *corev1.Service
 		tgPort            intstr.IntOrString
 		hcPort            intstr.IntOrString
-		subnets           []*ec2.Subnet
+		subnets           []ec2types.Subnet
 		tgProtocol        corev1.Protocol
 		ipAddressType     elbv2.TargetGroupIPAddressType
 		preserveClientIP  bool
 		scheme            elbv2.LoadBalancerScheme
 		fetchVPCInfoCalls []fetchVPCInfoCall
-		want              *elbv2.TargetGroupBindingNetworking
+		targetType        elbv2api.TargetType
+		restrictToNodes   bool
+		nodeSubnets       []ec2types.Subnet
+		// nodeSubnetsPrefixListID is the ID of the node subnets prefix list if enabled.
+		nodeSubnetsPrefixListID string
+		want                    *elbv2.TargetGroupBindingNetworking
 	}{
 		{
 			name: "udp-service with source ranges",
@@ -432,7 +500,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			scheme: elbv2.LoadBalancerSchemeInternetFacing,
 			tgPort: port80,
 			hcPort: trafficPort,
-			subnets: []*ec2.Subnet{{
+			subnets: []ec2types.Subnet{{
 				CidrBlock: aws.String("172.16.0.0/19"),
 				SubnetId:  aws.String("az-1"),
 			}},
@@ -490,7 +558,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			scheme: elbv2.LoadBalancerSchemeInternal,
 			tgPort: port80,
 			hcPort: port808,
-			subnets: []*ec2.Subnet{{
+			subnets: []ec2types.Subnet{{
 				CidrBlock: aws.String("172.16.0.0/19"),
 				SubnetId:  aws.String("az-1"),
 			}},
@@ -542,7 +610,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			tgPort: port80,
 			hcPort: port808,
 			scheme: elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{{
+			subnets: []ec2types.Subnet{{
 				CidrBlock: aws.String("172.16.0.0/19"),
 				SubnetId:  aws.String("az-1"),
 			}},
@@ -589,24 +657,24 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			tgPort: port80,
 			hcPort: port808,
 			scheme: elbv2.LoadBalancerSchemeInternal,
-			subnets: []*ec2.Subnet{{
+			subnets: []ec2types.Subnet{{
 				CidrBlock: aws.String("172.16.0.0/19"),
 				SubnetId:  aws.String("az-1"),
 			}},
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("172.16.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 							{
 								CidrBlock: aws.String("1.2.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -661,7 +729,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			tgPort: port80,
 			hcPort: trafficPort,
 			scheme: elbv2.LoadBalancerSchemeInternetFacing,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
 					SubnetId:  aws.String("sn-1"),
@@ -703,7 +771,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			svc:    &corev1.Service{},
 			tgPort: port80,
 			hcPort: trafficPort,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
 					SubnetId:  aws.String("sn-1"),
@@ -742,7 +810,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			svc:    &corev1.Service{},
 			tgPort: port80,
 			hcPort: trafficPort,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
 					SubnetId:  aws.String("sn-1"),
@@ -759,17 +827,17 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("172.16.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 							{
 								CidrBlock: aws.String("1.2.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -806,7 +874,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			svc:    &corev1.Service{},
 			tgPort: port80,
 			hcPort: port808,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
 					SubnetId:  aws.String("sn-1"),
@@ -868,7 +936,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			},
 			tgPort: port80,
 			hcPort: port808,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
 					SubnetId:  aws.String("sn-1"),
@@ -935,7 +1003,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			},
 			tgPort: port80,
 			hcPort: port80,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
 					SubnetId:  aws.String("sn-1"),
@@ -1002,7 +1070,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			},
 			tgPort: port80,
 			hcPort: port80,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
 					SubnetId:  aws.String("sn-1"),
@@ -1050,10 +1118,10 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			svc:    &corev1.Service{},
 			tgPort: port80,
 			hcPort: port80,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2300:1ab3:ab0:1900::/56"),
 						},
@@ -1062,7 +1130,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 				},
 				{
 					CidrBlock: aws.String("1.2.3.4/19"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2000:1ee3:5d0:fe00::/56"),
 						},
@@ -1098,10 +1166,10 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			svc:    &corev1.Service{},
 			tgPort: port80,
 			hcPort: port80,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2300:1ab3:ab0:1900::/64"),
 						},
@@ -1110,7 +1178,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 				},
 				{
 					CidrBlock: aws.String("1.2.3.4/19"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2300:1ab3:ab0:1901::/64"),
 						},
@@ -1153,11 +1221,11 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+						Ipv6CidrBlockAssociationSet: []ec2types.VpcIpv6CidrBlockAssociation{
 							{
 								Ipv6CidrBlock: aws.String("2300:1ab3:ab0:1900::/56"),
-								Ipv6CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								Ipv6CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -1166,10 +1234,10 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			},
 			tgPort: port80,
 			hcPort: port80,
-			subnets: []*ec2.Subnet{
+			subnets: []ec2types.Subnet{
 				{
 					CidrBlock: aws.String("172.16.0.0/19"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2300:1ab3:ab0:1900::/64"),
 						},
@@ -1178,7 +1246,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 				},
 				{
 					CidrBlock: aws.String("1.2.3.4/19"),
-					Ipv6CidrBlockAssociationSet: []*ec2.SubnetIpv6CidrBlockAssociation{
+					Ipv6CidrBlockAssociationSet: []ec2types.SubnetIpv6CidrBlockAssociation{
 						{
 							Ipv6CidrBlock: aws.String("2300:1ab3:ab0:1901::/64"),
 						},
@@ -1220,7 +1288,7 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			},
 			tgPort: port80,
 			hcPort: port808,
-			subnets: []*ec2.Subnet{{
+			subnets: []ec2types.Subnet{{
 				CidrBlock: aws.String("172.16.0.0/19"),
 				SubnetId:  aws.String("az-1"),
 			}},
@@ -1228,6 +1296,154 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			ipAddressType: elbv2.TargetGroupIPAddressTypeIPv4,
 			want:          nil,
 		},
+		{
+			name:   "tcp-service with preserveClient IP, internal, restricted to node subnets",
+			svc:    &corev1.Service{},
+			tgPort: port80,
+			hcPort: trafficPort,
+			subnets: []ec2types.Subnet{
+				{
+					CidrBlock: aws.String("172.16.0.0/19"),
+					SubnetId:  aws.String("sn-1"),
+				},
+			},
+			scheme:           elbv2.LoadBalancerSchemeInternal,
+			tgProtocol:       corev1.ProtocolTCP,
+			ipAddressType:    elbv2.TargetGroupIPAddressTypeIPv4,
+			preserveClientIP: true,
+			fetchVPCInfoCalls: []fetchVPCInfoCall{
+				{
+					wantVPCInfo: networking.VPCInfo{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
+							{
+								CidrBlock: aws.String("172.16.0.0/16"),
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
+								},
+							},
+						},
+					},
+				},
+			},
+			targetType:      elbv2api.TargetTypeInstance,
+			restrictToNodes: true,
+			nodeSubnets: []ec2types.Subnet{
+				{
+					CidrBlock: aws.String("172.16.64.0/19"),
+					SubnetId:  aws.String("sn-node-1"),
+				},
+				{
+					CidrBlock: aws.String("172.16.96.0/19"),
+					SubnetId:  aws.String("sn-node-2"),
+				},
+			},
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "172.16.64.0/19",
+								},
+							},
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "172.16.96.0/19",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "172.16.0.0/19",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "tcp-service with preserveClient IP, internal, restricted to node subnets prefix list",
+			svc:    &corev1.Service{},
+			tgPort: port80,
+			hcPort: trafficPort,
+			subnets: []ec2types.Subnet{
+				{
+					CidrBlock: aws.String("172.16.0.0/19"),
+					SubnetId:  aws.String("sn-1"),
+				},
+			},
+			scheme:           elbv2.LoadBalancerSchemeInternal,
+			tgProtocol:       corev1.ProtocolTCP,
+			ipAddressType:    elbv2.TargetGroupIPAddressTypeIPv4,
+			preserveClientIP: true,
+			fetchVPCInfoCalls: []fetchVPCInfoCall{
+				{
+					wantVPCInfo: networking.VPCInfo{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
+							{
+								CidrBlock: aws.String("172.16.0.0/16"),
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
+								},
+							},
+						},
+					},
+				},
+			},
+			targetType:              elbv2api.TargetTypeInstance,
+			restrictToNodes:         true,
+			nodeSubnetsPrefixListID: "pl-nodes",
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								PrefixList: &elbv2api.PrefixList{
+									PrefixListID: "pl-nodes",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								IPBlock: &elbv2api.IPBlock{
+									CIDR: "172.16.0.0/19",
+								},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1237,14 +1453,25 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworkingLegacy(t *tes
 			for _, call := range tt.fetchVPCInfoCalls {
 				vpcInfoProvider.EXPECT().FetchVPCInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(call.wantVPCInfo, call.err).AnyTimes()
 			}
+			nodeSubnetsResolver := networking.NewMockNodeSubnetsResolver(ctrl)
+			if tt.nodeSubnets != nil {
+				nodeSubnetsResolver.EXPECT().ResolveNodeSubnets(gomock.Any(), gomock.Any()).Return(tt.nodeSubnets, nil)
+			}
 
 			parser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
 			builder := &defaultModelBuildTask{service: tt.svc, annotationParser: parser, ec2Subnets: tt.subnets, preserveClientIP: tt.preserveClientIP,
-				defaultIPv4SourceRanges: []string{"0.0.0.0/0"}, defaultIPv6SourceRanges: []string{"::/0"}, vpcInfoProvider: vpcInfoProvider}
+				defaultIPv4SourceRanges: []string{"0.0.0.0/0"}, defaultIPv6SourceRanges: []string{"::/0"}, vpcInfoProvider: vpcInfoProvider,
+				nodeSubnetsResolver: nodeSubnetsResolver, restrictSGRulesToNodeSubnets: tt.restrictToNodes}
+			if tt.nodeSubnetsPrefixListID != "" {
+				nodeSubnetsPrefixListProvider := networking.NewMockNodeSubnetsPrefixListProvider(ctrl)
+				nodeSubnetsPrefixListProvider.EXPECT().Get(gomock.Any(), networking.PrefixListAddressFamilyIPv4).Return(tt.nodeSubnetsPrefixListID, nil)
+				builder.nodeSubnetsPrefixListProvider = nodeSubnetsPrefixListProvider
+			}
 			port := corev1.ServicePort{
 				Protocol: tt.tgProtocol,
 			}
-			got, _ := builder.buildTargetGroupBindingNetworkingLegacy(context.Background(), tt.tgPort, tt.hcPort, port, tt.scheme, tt.ipAddressType)
+			got, _ := builder.buildTargetGroupBindingNetworkingLegacy(context.Background(), tt.tgPort, tt.hcPort, port, tt.scheme, tt.ipAddressType,
+				tt.targetType, nil)
 			assert.Equal(t, tt.want, got)
 		})
 	}
@@ -1426,6 +1653,34 @@ func Test_defaultModelBuilderTask_buildTargetGroupBindingNetworking(t *testing.T
 				},
 			},
 		},
+		{
+			name:             "tcp_udp with port restricted rules",
+			tgPort:           port80,
+			hcPort:           trafficPort,
+			backendSGIDToken: core.LiteralStringToken(sgBackend),
+			tgProtocol:       serviceProtocolTCPUDP,
+			want: &elbv2.TargetGroupBindingNetworking{
+				Ingress: []elbv2.NetworkingIngressRule{
+					{
+						From: []elbv2.NetworkingPeer{
+							{
+								SecurityGroup: &elbv2.SecurityGroup{GroupID: core.LiteralStringToken(sgBackend)},
+							},
+						},
+						Ports: []elbv2api.NetworkingPort{
+							{
+								Protocol: &networkingProtocolTCP,
+								Port:     &port80,
+							},
+							{
+								Protocol: &networkingProtocolUDP,
+								Port:     &port80,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:       "no backend SG configured",
 			tgPort:     port80,
@@ -1541,10 +1796,11 @@ func Test_defaultModelBuilder_buildPreserveClientIPFlag(t *testing.T) {
 }
 
 func Test_defaultModelBuilder_buildTargetType(t *testing.T) {
-
+	targetTypeIP := elbv2api.TargetTypeIP
 	tests := []struct {
 		testName           string
 		svc                *corev1.Service
+		lbConfiguration    *elbv2api.LoadBalancerConfiguration
 		defaultTargetType  string
 		want               elbv2.TargetType
 		enableIPTargetType *bool
@@ -1752,6 +2008,57 @@ func Test_defaultModelBuilder_buildTargetType(t *testing.T) {
 			},
 			wantErr: errors.New("unable to support instance target type with an unallocated NodePort"),
 		},
+		{
+			testName: "loadBalancerConfiguration target type ip",
+			svc: &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Name:       "http",
+							Port:       80,
+							TargetPort: intstr.FromInt(80),
+							Protocol:   corev1.ProtocolTCP,
+							NodePort:   31223,
+						},
+					},
+				},
+			},
+			lbConfiguration: &elbv2api.LoadBalancerConfiguration{
+				Spec: elbv2api.LoadBalancerConfigurationSpec{
+					TargetType: &targetTypeIP,
+				},
+			},
+			want: elbv2.TargetTypeIP,
+		},
+		{
+			testName: "loadBalancerConfiguration target type ip, annotation overrides",
+			svc: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"service.beta.kubernetes.io/aws-load-balancer-nlb-target-type": "instance",
+					},
+				},
+				Spec: corev1.ServiceSpec{
+					Type: corev1.ServiceTypeLoadBalancer,
+					Ports: []corev1.ServicePort{
+						{
+							Name:       "http",
+							Port:       80,
+							TargetPort: intstr.FromInt(80),
+							Protocol:   corev1.ProtocolTCP,
+							NodePort:   31223,
+						},
+					},
+				},
+			},
+			lbConfiguration: &elbv2api.LoadBalancerConfiguration{
+				Spec: elbv2api.LoadBalancerConfigurationSpec{
+					TargetType: &targetTypeIP,
+				},
+			},
+			want: elbv2.TargetTypeInstance,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
@@ -1759,6 +2066,7 @@ func Test_defaultModelBuilder_buildTargetType(t *testing.T) {
 			builder := &defaultModelBuildTask{
 				annotationParser:  parser,
 				service:           tt.svc,
+				lbConfiguration:   tt.lbConfiguration,
 				defaultTargetType: elbv2.TargetType(tt.defaultTargetType),
 			}
 			if tt.defaultTargetType == "" {
@@ -2022,3 +2330,94 @@ func Test_defaultModelBuilder_buildTargetGroupHealthCheckPort(t *testing.T) {
 		})
 	}
 }
+
+func Test_defaultModelBuilder_buildTargetGroupIPAddressType(t *testing.T) {
+	dualStackIPFamilies := []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+	tests := []struct {
+		testName        string
+		ipFamilies      []corev1.IPFamily
+		ipFamilyPolicy  string
+		lbIPAddressType elbv2.IPAddressType
+		want            elbv2.TargetGroupIPAddressType
+		wantErr         error
+	}{
+		{
+			testName:        "dual-stack Service",
+			ipFamilies:      dualStackIPFamilies,
+			lbIPAddressType: elbv2.IPAddressTypeDualStack,
+			want:            elbv2.TargetGroupIPAddressTypeIPv6,
+		},
+		{
+			testName:        "dual-stack Service preferring IPv4",
+			ipFamilies:      dualStackIPFamilies,
+			ipFamilyPolicy:  "prefer-ipv4",
+			lbIPAddressType: elbv2.IPAddressTypeDualStack,
+			want:            elbv2.TargetGroupIPAddressTypeIPv4,
+		},
+		{
+			testName:        "IPv4 Service preferring IPv6",
+			ipFamilies:      []corev1.IPFamily{corev1.IPv4Protocol},
+			ipFamilyPolicy:  "prefer-ipv6",
+			lbIPAddressType: elbv2.IPAddressTypeDualStack,
+			want:            elbv2.TargetGroupIPAddressTypeIPv6,
+		},
+		{
+			testName:        "IPv4 Service preferring IPv6 without dual-stack load balancer",
+			ipFamilies:      []corev1.IPFamily{corev1.IPv4Protocol},
+			ipFamilyPolicy:  "prefer-ipv6",
+			lbIPAddressType: elbv2.IPAddressTypeIPV4,
+			want:            elbv2.TargetGroupIPAddressTypeIPv4,
+		},
+		{
+			testName:        "IPv6 Service preferring IPv4",
+			ipFamilies:      []corev1.IPFamily{corev1.IPv6Protocol},
+			ipFamilyPolicy:  "prefer-ipv4",
+			lbIPAddressType: elbv2.IPAddressTypeDualStack,
+			want:            elbv2.TargetGroupIPAddressTypeIPv6,
+		},
+		{
+			testName:        "IPv6 Service without dual-stack load balancer",
+			ipFamilies:      []corev1.IPFamily{corev1.IPv6Protocol},
+			ipFamilyPolicy:  "prefer-ipv6",
+			lbIPAddressType: elbv2.IPAddressTypeIPV4,
+			wantErr:         errors.New("unsupported IPv6 configuration, lb not dual-stack"),
+		},
+		{
+			testName:        "unknown target IP family policy",
+			ipFamilies:      dualStackIPFamilies,
+			ipFamilyPolicy:  "both",
+			lbIPAddressType: elbv2.IPAddressTypeDualStack,
+			wantErr:         errors.New("unknown target IP family policy: both"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			svc := &corev1.Service{
+				Spec: corev1.ServiceSpec{
+					IPFamilies: tt.ipFamilies,
+				},
+			}
+			if tt.ipFamilyPolicy != "" {
+				svc.Annotations = map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-target-ip-family-policy": tt.ipFamilyPolicy,
+				}
+			}
+			builder := &defaultModelBuildTask{
+				annotationParser: annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io"),
+				service:          svc,
+				loadBalancer: &elbv2.LoadBalancer{
+					Spec: elbv2.LoadBalancerSpec{
+						IPAddressType: &tt.lbIPAddressType,
+					},
+				},
+			}
+			got, err := builder.buildTargetGroupIPAddressType(context.Background(), svc)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
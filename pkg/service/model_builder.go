@@ -5,10 +5,12 @@ import (
 	"strconv"
 	"sync"
 
-	"github.com/aws/aws-sdk-go/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
@@ -37,10 +39,12 @@ type ModelBuilder interface {
 // NewDefaultModelBuilder construct a new defaultModelBuilder
 func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver networking.SubnetsResolver,
 	vpcInfoProvider networking.VPCInfoProvider, vpcID string, trackingProvider tracking.Provider,
-	elbv2TaggingManager elbv2deploy.TaggingManager, ec2Client services.EC2, featureGates config.FeatureGates, clusterName string, defaultTags map[string]string,
-	externalManagedTags []string, defaultSSLPolicy string, defaultTargetType string, enableIPTargetType bool, serviceUtils ServiceUtils,
+	elbv2TaggingManager elbv2deploy.TaggingManager, ec2Client services.EC2, featureGates config.FeatureGates, clusterName string, defaultTagsProvider networking.DefaultTagsProvider,
+	externalManagedTags []string, dynamicConfigProvider config.DynamicConfigProvider, enableIPTargetType bool, serviceUtils ServiceUtils,
 	backendSGProvider networking.BackendSGProvider, sgResolver networking.SecurityGroupResolver, enableBackendSG bool,
-	disableRestrictedSGRules bool) *defaultModelBuilder {
+	disableRestrictedSGRules bool, nodeSubnetsResolver networking.NodeSubnetsResolver, restrictSGRulesToNodeSubnets bool,
+	nodeSubnetsPrefixListProvider networking.NodeSubnetsPrefixListProvider, blocklistPrefixListProvider networking.BlocklistPrefixListProvider,
+	lbConfigurationLoader LoadBalancerConfigurationLoader) *defaultModelBuilder {
 	return &defaultModelBuilder{
 		annotationParser:         annotationParser,
 		subnetsResolver:          subnetsResolver,
@@ -51,16 +55,21 @@ func NewDefaultModelBuilder(annotationParser annotations.Parser, subnetsResolver
 		serviceUtils:             serviceUtils,
 		clusterName:              clusterName,
 		vpcID:                    vpcID,
-		defaultTags:              defaultTags,
+		defaultTagsProvider:      defaultTagsProvider,
 		externalManagedTags:      sets.NewString(externalManagedTags...),
-		defaultSSLPolicy:         defaultSSLPolicy,
-		defaultTargetType:        elbv2model.TargetType(defaultTargetType),
+		dynamicConfigProvider:    dynamicConfigProvider,
 		enableIPTargetType:       enableIPTargetType,
 		backendSGProvider:        backendSGProvider,
 		sgResolver:               sgResolver,
 		ec2Client:                ec2Client,
 		enableBackendSG:          enableBackendSG,
 		disableRestrictedSGRules: disableRestrictedSGRules,
+
+		nodeSubnetsResolver:           nodeSubnetsResolver,
+		restrictSGRulesToNodeSubnets:  restrictSGRulesToNodeSubnets,
+		nodeSubnetsPrefixListProvider: nodeSubnetsPrefixListProvider,
+		blocklistPLProvider:           blocklistPrefixListProvider,
+		lbConfigurationLoader:         lbConfigurationLoader,
 	}
 }
 
@@ -80,17 +89,40 @@ type defaultModelBuilder struct {
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
 
-	clusterName         string
-	vpcID               string
-	defaultTags         map[string]string
-	externalManagedTags sets.String
-	defaultSSLPolicy    string
-	defaultTargetType   elbv2model.TargetType
-	enableIPTargetType  bool
+	nodeSubnetsResolver          networking.NodeSubnetsResolver
+	restrictSGRulesToNodeSubnets bool
+	// nodeSubnetsPrefixListProvider provides the prefix list of node subnets referenced by the security group rules restricted
+	// to node subnets if enabled, it's nil otherwise.
+	nodeSubnetsPrefixListProvider networking.NodeSubnetsPrefixListProvider
+	blocklistPLProvider           networking.BlocklistPrefixListProvider
+	// lbConfigurationLoader loads the LoadBalancerConfiguration referenced by Services, it's nil if LoadBalancerConfigurations aren't supported.
+	lbConfigurationLoader LoadBalancerConfigurationLoader
+
+	clusterName           string
+	vpcID                 string
+	defaultTagsProvider   networking.DefaultTagsProvider
+	externalManagedTags   sets.String
+	dynamicConfigProvider config.DynamicConfigProvider
+	enableIPTargetType    bool
 }
 
 func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service) (core.Stack, *elbv2model.LoadBalancer, bool, error) {
 	stack := core.NewDefaultStack(core.StackID(k8s.NamespacedName(service)))
+	// in IPv6-only clusters, the LoadBalancers must be dualstack to route traffic to the IPv6 targets.
+	defaultIPAddressType := elbv2model.IPAddressTypeIPV4
+	if b.featureGates.Enabled(config.IPv6Only) {
+		defaultIPAddressType = elbv2model.IPAddressTypeDualStack
+	}
+	// the LoadBalancerConfiguration is only relevant to Services provisioned a load balancer for,
+	// so that Services can be cleaned up even if the referenced LoadBalancerConfiguration is gone.
+	var lbConfiguration *elbv2api.LoadBalancerConfiguration
+	if b.lbConfigurationLoader != nil && b.serviceUtils.IsServiceSupported(service) {
+		var err error
+		lbConfiguration, err = b.lbConfigurationLoader.Load(ctx, service)
+		if err != nil {
+			return nil, nil, false, err
+		}
+	}
 	task := &defaultModelBuildTask{
 		clusterName:              b.clusterName,
 		vpcID:                    b.vpcID,
@@ -108,20 +140,26 @@ func (b *defaultModelBuilder) Build(ctx context.Context, service *corev1.Service
 		enableBackendSG:          b.enableBackendSG,
 		disableRestrictedSGRules: b.disableRestrictedSGRules,
 
-		service:   service,
-		stack:     stack,
-		tgByResID: make(map[string]*elbv2model.TargetGroup),
+		nodeSubnetsResolver:           b.nodeSubnetsResolver,
+		restrictSGRulesToNodeSubnets:  b.restrictSGRulesToNodeSubnets,
+		nodeSubnetsPrefixListProvider: b.nodeSubnetsPrefixListProvider,
+		blocklistPLProvider:           b.blocklistPLProvider,
+
+		service:         service,
+		lbConfiguration: lbConfiguration,
+		stack:           stack,
+		tgByResID:       make(map[string]*elbv2model.TargetGroup),
 
-		defaultTags:                          b.defaultTags,
+		defaultTags:                          b.defaultTagsProvider.DefaultTags(),
 		externalManagedTags:                  b.externalManagedTags,
-		defaultSSLPolicy:                     b.defaultSSLPolicy,
+		defaultSSLPolicy:                     b.dynamicConfigProvider.DefaultSSLPolicy(),
 		defaultAccessLogS3Enabled:            false,
 		defaultAccessLogsS3Bucket:            "",
 		defaultAccessLogsS3Prefix:            "",
-		defaultIPAddressType:                 elbv2model.IPAddressTypeIPV4,
+		defaultIPAddressType:                 defaultIPAddressType,
 		defaultLoadBalancingCrossZoneEnabled: false,
 		defaultProxyProtocolV2Enabled:        false,
-		defaultTargetType:                    b.defaultTargetType,
+		defaultTargetType:                    elbv2model.TargetType(b.dynamicConfigProvider.DefaultTargetType()),
 		defaultHealthCheckProtocol:           elbv2model.ProtocolTCP,
 		defaultHealthCheckPort:               healthCheckPortTrafficPort,
 		defaultHealthCheckPath:               "/",
@@ -164,17 +202,24 @@ type defaultModelBuildTask struct {
 	ec2Client           services.EC2
 
 	service *corev1.Service
+	// lbConfiguration is the LoadBalancerConfiguration referenced by service, it's nil if service doesn't reference any.
+	lbConfiguration *elbv2api.LoadBalancerConfiguration
 
 	stack                    core.Stack
 	loadBalancer             *elbv2model.LoadBalancer
 	tgByResID                map[string]*elbv2model.TargetGroup
-	ec2Subnets               []*ec2.Subnet
+	ec2Subnets               []ec2types.Subnet
 	enableBackendSG          bool
 	disableRestrictedSGRules bool
 	backendSGIDToken         core.StringToken
 	backendSGAllocated       bool
 	preserveClientIP         bool
 
+	nodeSubnetsResolver           networking.NodeSubnetsResolver
+	restrictSGRulesToNodeSubnets  bool
+	nodeSubnetsPrefixListProvider networking.NodeSubnetsPrefixListProvider
+	blocklistPLProvider           networking.BlocklistPrefixListProvider
+
 	fetchExistingLoadBalancerOnce sync.Once
 	existingLoadBalancer          *elbv2deploy.LoadBalancerWithTags
 
@@ -244,7 +289,28 @@ func (t *defaultModelBuildTask) buildModel(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return nil
+	err = t.buildEndpointService(ctx)
+	if err != nil {
+		return err
+	}
+	err = t.buildGlobalAccelerator(ctx)
+	if err != nil {
+		return err
+	}
+	err = t.buildRoute53RecordSets(ctx)
+	if err != nil {
+		return err
+	}
+	return t.buildRecreations(ctx)
+}
+
+// buildRecreations requests the recreation of the resources specified by the service.
+func (t *defaultModelBuildTask) buildRecreations(_ context.Context) error {
+	var recreationTokens map[string]string
+	if _, err := t.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixRecreateResources, &recreationTokens, t.service.Annotations); err != nil {
+		return err
+	}
+	return elbv2model.ApplyRecreationTokens(t.stack, recreationTokens)
 }
 
 func (t *defaultModelBuildTask) getDeletionProtectionViaAnnotation(svc corev1.Service) (bool, error) {
@@ -253,6 +319,9 @@ func (t *defaultModelBuildTask) getDeletionProtectionViaAnnotation(svc corev1.Se
 	if err != nil {
 		return false, err
 	}
+	if t.lbConfiguration != nil && len(t.lbConfiguration.Spec.LoadBalancerAttributes) != 0 {
+		lbAttributes = algorithm.MergeStringMap(attributesAsMap(t.lbConfiguration.Spec.LoadBalancerAttributes), lbAttributes)
+	}
 	if _, deletionProtectionSpecified := lbAttributes[lbAttrsDeletionProtection]; deletionProtectionSpecified {
 		deletionProtectionEnabled, err := strconv.ParseBool(lbAttributes[lbAttrsDeletionProtection])
 		if err != nil {
@@ -5,9 +5,10 @@ import (
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/ec2"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -21,15 +22,16 @@ import (
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 func Test_defaultModelBuilderTask_Build(t *testing.T) {
 	type resolveViaDiscoveryCall struct {
-		subnets []*ec2.Subnet
+		subnets []ec2types.Subnet
 		err     error
 	}
 	type resolveViaNameOrIDSliceCall struct {
-		subnets []*ec2.Subnet
+		subnets []ec2types.Subnet
 		err     error
 	}
 	type listLoadBalancerCall struct {
@@ -45,9 +47,8 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		want []string
 		err  error
 	}
-	cidrBlockStateAssociated := ec2.VpcCidrBlockStateCodeAssociated
 	resolveViaDiscoveryCallForOneSubnet := resolveViaDiscoveryCall{
-		subnets: []*ec2.Subnet{
+		subnets: []ec2types.Subnet{
 			{
 				SubnetId:  aws.String("subnet-1"),
 				CidrBlock: aws.String("192.168.0.0/19"),
@@ -55,7 +56,7 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		},
 	}
 	resolveViaDiscoveryCallForTwoSubnet := resolveViaDiscoveryCall{
-		subnets: []*ec2.Subnet{
+		subnets: []ec2types.Subnet{
 			{
 				SubnetId:  aws.String("subnet-1"),
 				CidrBlock: aws.String("192.168.0.0/19"),
@@ -67,7 +68,7 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		},
 	}
 	resolveViaDiscoveryCallForThreeSubnet := resolveViaDiscoveryCall{
-		subnets: []*ec2.Subnet{
+		subnets: []ec2types.Subnet{
 			{
 				SubnetId:  aws.String("subnet-1"),
 				CidrBlock: aws.String("192.168.0.0/19"),
@@ -83,7 +84,7 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 		},
 	}
 	resolveViaNameOrIDSliceCallForThreeSubnet := resolveViaNameOrIDSliceCall{
-		subnets: []*ec2.Subnet{
+		subnets: []ec2types.Subnet{
 			{
 				SubnetId:  aws.String("subnet-1"),
 				CidrBlock: aws.String("192.168.0.0/19"),
@@ -1124,11 +1125,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("192.168.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -1403,8 +1404,8 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				{
 					sdkLBs: []elbv2.LoadBalancerWithTags{
 						{
-							LoadBalancer: &elbv2sdk.LoadBalancer{
-								Scheme: aws.String("internet-facing"),
+							LoadBalancer: &elbv2types.LoadBalancer{
+								Scheme: elbv2types.LoadBalancerSchemeEnumInternetFacing,
 							},
 						},
 					},
@@ -1877,17 +1878,17 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("192.160.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 							{
 								CidrBlock: aws.String("100.64.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -2050,17 +2051,17 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("192.160.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 							{
 								CidrBlock: aws.String("100.64.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -2373,11 +2374,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+						Ipv6CidrBlockAssociationSet: []ec2types.VpcIpv6CidrBlockAssociation{
 							{
 								Ipv6CidrBlock: aws.String("2600:1fe3:3c0:1d00::/56"),
-								Ipv6CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								Ipv6CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -2726,11 +2727,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("192.168.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -2883,7 +2884,7 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			},
 			resolveViaNameOrIDSliceCalls: []resolveViaNameOrIDSliceCall{
 				{
-					subnets: []*ec2.Subnet{
+					subnets: []ec2types.Subnet{
 						{
 							SubnetId:  aws.String("subnet-1"),
 							CidrBlock: aws.String("192.168.0.0/19"),
@@ -2895,9 +2896,9 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				{
 					sdkLBs: []elbv2.LoadBalancerWithTags{
 						{
-							LoadBalancer: &elbv2sdk.LoadBalancer{
-								Scheme: aws.String("internal"),
-								AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+							LoadBalancer: &elbv2types.LoadBalancer{
+								Scheme: elbv2types.LoadBalancerSchemeEnumInternal,
+								AvailabilityZones: []elbv2types.AvailabilityZone{
 									{
 										SubnetId: aws.String("subnet-1"),
 									},
@@ -4036,11 +4037,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("192.168.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -4350,9 +4351,9 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				{
 					sdkLBs: []elbv2.LoadBalancerWithTags{
 						{
-							LoadBalancer: &elbv2sdk.LoadBalancer{
-								Scheme:         aws.String("internet-facing"),
-								SecurityGroups: []*string{aws.String("sg-lb")},
+							LoadBalancer: &elbv2types.LoadBalancer{
+								Scheme:         elbv2types.LoadBalancerSchemeEnumInternetFacing,
+								SecurityGroups: []string{"sg-lb"},
 							},
 						},
 					},
@@ -4698,9 +4699,9 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				{
 					sdkLBs: []elbv2.LoadBalancerWithTags{
 						{
-							LoadBalancer: &elbv2sdk.LoadBalancer{
-								Scheme:         aws.String("internet-facing"),
-								SecurityGroups: []*string{aws.String("sg-lb")},
+							LoadBalancer: &elbv2types.LoadBalancer{
+								Scheme:         elbv2types.LoadBalancerSchemeEnumInternetFacing,
+								SecurityGroups: []string{"sg-lb"},
 							},
 						},
 					},
@@ -5020,7 +5021,7 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			disableRestrictedSGRules: true,
 			resolveViaNameOrIDSliceCalls: []resolveViaNameOrIDSliceCall{
 				{
-					subnets: []*ec2.Subnet{
+					subnets: []ec2types.Subnet{
 						{
 							SubnetId:  aws.String("subnet-1"),
 							CidrBlock: aws.String("192.168.0.0/19"),
@@ -5032,8 +5033,8 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				{
 					sdkLBs: []elbv2.LoadBalancerWithTags{
 						{
-							LoadBalancer: &elbv2sdk.LoadBalancer{
-								Scheme: aws.String("internal"),
+							LoadBalancer: &elbv2types.LoadBalancer{
+								Scheme: elbv2types.LoadBalancerSchemeEnumInternal,
 							},
 						},
 					},
@@ -5199,17 +5200,17 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("192.160.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 							{
 								CidrBlock: aws.String("100.64.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -5394,11 +5395,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						Ipv6CidrBlockAssociationSet: []*ec2.VpcIpv6CidrBlockAssociation{
+						Ipv6CidrBlockAssociationSet: []ec2types.VpcIpv6CidrBlockAssociation{
 							{
 								Ipv6CidrBlock: aws.String("2600:1fe3:3c0:1d00::/56"),
-								Ipv6CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								Ipv6CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -5771,11 +5772,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 			fetchVPCInfoCalls: []fetchVPCInfoCall{
 				{
 					wantVPCInfo: networking.VPCInfo{
-						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+						CidrBlockAssociationSet: []ec2types.VpcCidrBlockAssociation{
 							{
 								CidrBlock: aws.String("192.168.0.0/16"),
-								CidrBlockState: &ec2.VpcCidrBlockState{
-									State: &cidrBlockStateAssociated,
+								CidrBlockState: &ec2types.VpcCidrBlockState{
+									State: ec2types.VpcCidrBlockStateCodeAssociated,
 								},
 							},
 						},
@@ -5946,14 +5947,14 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				{
 					sdkLBs: []elbv2.LoadBalancerWithTags{
 						{
-							LoadBalancer: &elbv2sdk.LoadBalancer{
-								Scheme: aws.String("internal"),
-								AvailabilityZones: []*elbv2sdk.AvailabilityZone{
+							LoadBalancer: &elbv2types.LoadBalancer{
+								Scheme: elbv2types.LoadBalancerSchemeEnumInternal,
+								AvailabilityZones: []elbv2types.AvailabilityZone{
 									{
 										SubnetId: aws.String("subnet-1"),
 									},
 								},
-								SecurityGroups: []*string{aws.String("sg-lb")},
+								SecurityGroups: []string{"sg-lb"},
 							},
 						},
 					},
@@ -6385,7 +6386,7 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				}
 			}
 			annotationParser := annotations.NewSuffixAnnotationParser("service.beta.kubernetes.io")
-			trackingProvider := tracking.NewDefaultProvider("service.k8s.aws", "my-cluster")
+			trackingProvider := tracking.NewDefaultProvider("service.k8s.aws", "my-cluster", nil)
 
 			elbv2TaggingManager := elbv2.NewMockTaggingManager(ctrl)
 			for _, call := range tt.listLoadBalancerCalls {
@@ -6416,8 +6417,11 @@ func Test_defaultModelBuilderTask_Build(t *testing.T) {
 				enableIPTargetType = *tt.enableIPTargetType
 			}
 			builder := NewDefaultModelBuilder(annotationParser, subnetsResolver, vpcInfoProvider, "vpc-xxx", trackingProvider, elbv2TaggingManager, ec2Client, featureGates,
-				"my-cluster", nil, nil, "ELBSecurityPolicy-2016-08", defaultTargetType, enableIPTargetType, serviceUtils,
-				backendSGProvider, sgResolver, tt.enableBackendSG, tt.disableRestrictedSGRules)
+				"my-cluster", networking.NewDefaultTagsProvider(nil, nil, log.Log), nil, config.NewDynamicConfigProvider(config.DynamicConfig{
+					DefaultSSLPolicy:  "ELBSecurityPolicy-2016-08",
+					DefaultTargetType: defaultTargetType,
+				}, featureGates), enableIPTargetType, serviceUtils,
+				backendSGProvider, sgResolver, tt.enableBackendSG, tt.disableRestrictedSGRules, nil, false, nil, nil, nil)
 			ctx := context.Background()
 			stack, _, _, err := builder.Build(ctx, tt.svc)
 			if tt.wantError {
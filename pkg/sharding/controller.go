@@ -0,0 +1,37 @@
+package sharding
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// NewController constructs a controller that runs on every replica if coordinator is non-nil,
+// otherwise the controller only runs on the leader.
+func NewController(name string, mgr manager.Manager, options controller.Options, coordinator Coordinator) (controller.Controller, error) {
+	if coordinator == nil {
+		return controller.New(name, mgr, options)
+	}
+	c, err := controller.NewUnmanaged(name, mgr, options)
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.Add(NewUnelectedRunnable(c)); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewUnelectedRunnable wraps runnable so that it runs on every replica instead of only the leader.
+func NewUnelectedRunnable(runnable manager.Runnable) manager.Runnable {
+	return &unelectedRunnable{Runnable: runnable}
+}
+
+var _ manager.LeaderElectionRunnable = &unelectedRunnable{}
+
+type unelectedRunnable struct {
+	manager.Runnable
+}
+
+func (r *unelectedRunnable) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,399 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	networkingpkg "sigs.k8s.io/aws-load-balancer-controller/pkg/networking"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	// labelShardCoordinator is the label on leases of the coordinator, valued with its lease name prefix.
+	labelShardCoordinator = "elbv2.k8s.aws/shard-coordinator"
+	// labelShardLeaseKind is the label on leases of the coordinator, valued with either leaseKindShard or leaseKindMember.
+	labelShardLeaseKind = "elbv2.k8s.aws/shard-lease-kind"
+
+	leaseKindShard  = "shard"
+	leaseKindMember = "member"
+
+	metricShardsOwned  = "shards_owned"
+	metricShardMembers = "shard_members"
+)
+
+// Coordinator decides which replica reconciles an IngressGroup or Service when sharding is enabled.
+type Coordinator interface {
+	// Owns returns whether the IngressGroup or Service identified by key is reconciled by this replica.
+	Owns(key types.NamespacedName) bool
+
+	// AcquisitionEvents returns the channel of resources that need to be reconciled after shards got acquired by this replica.
+	AcquisitionEvents(resourceType networkingpkg.ResourceType) <-chan event.GenericEvent
+}
+
+// NewLeaseCoordinator constructs new leaseCoordinator.
+// identity must be unique per replica and a valid DNS label, e.g. the Pod name.
+func NewLeaseCoordinator(k8sClient client.Client, apiReader client.Reader, cfg config.ShardingConfig, leasePrefix string, identity string,
+	registerer prometheus.Registerer, logger logr.Logger) (*leaseCoordinator, error) {
+	shardsOwned := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: metricShardsOwned,
+		Help: "Number of shards owned by this replica",
+	})
+	shardMembers := prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: metricShardMembers,
+		Help: "Number of live replicas sharing the shards, as observed by this replica",
+	})
+	if err := registerer.Register(shardsOwned); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricShardsOwned)
+	}
+	if err := registerer.Register(shardMembers); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricShardMembers)
+	}
+	return &leaseCoordinator{
+		k8sClient:             k8sClient,
+		apiReader:             apiReader,
+		namespace:             cfg.LeaseNamespace,
+		leasePrefix:           leasePrefix,
+		identity:              identity,
+		shardCount:            cfg.ShardCount,
+		leaseDuration:         cfg.LeaseDuration,
+		renewPeriod:           cfg.LeaseDuration / 3,
+		now:                   time.Now,
+		logger:                logger,
+		ownedShards:           make(map[int]time.Time),
+		acquisitionEventChans: make(map[networkingpkg.ResourceType]chan event.GenericEvent),
+		shardsOwned:           shardsOwned,
+		shardMembers:          shardMembers,
+	}, nil
+}
+
+var _ Coordinator = &leaseCoordinator{}
+var _ manager.LeaderElectionRunnable = &leaseCoordinator{}
+
+// leaseCoordinator hashes keys into a fixed number of shards, and claims shards with leases.
+// Every replica registers itself with a member lease, and claims up to its fair share of the shards among live members,
+// so that shards are rebalanced as replicas come and go.
+type leaseCoordinator struct {
+	k8sClient     client.Client
+	apiReader     client.Reader
+	namespace     string
+	leasePrefix   string
+	identity      string
+	shardCount    int
+	leaseDuration time.Duration
+	renewPeriod   time.Duration
+	now           func() time.Time
+	logger        logr.Logger
+
+	// ownedShards are the shards owned by this replica, mapped to the time their ownership expires without renewal.
+	ownedShards      map[int]time.Time
+	ownedShardsMutex sync.RWMutex
+
+	acquisitionEventChans      map[networkingpkg.ResourceType]chan event.GenericEvent
+	acquisitionEventChansMutex sync.Mutex
+
+	shardsOwned  prometheus.Gauge
+	shardMembers prometheus.Gauge
+}
+
+func (c *leaseCoordinator) Owns(key types.NamespacedName) bool {
+	shard := shardForKey(key.String(), c.shardCount)
+	c.ownedShardsMutex.RLock()
+	defer c.ownedShardsMutex.RUnlock()
+	expireTime, owned := c.ownedShards[shard]
+	return owned && c.now().Before(expireTime)
+}
+
+func (c *leaseCoordinator) AcquisitionEvents(resourceType networkingpkg.ResourceType) <-chan event.GenericEvent {
+	c.acquisitionEventChansMutex.Lock()
+	defer c.acquisitionEventChansMutex.Unlock()
+	eventChan, exists := c.acquisitionEventChans[resourceType]
+	if !exists {
+		eventChan = make(chan event.GenericEvent)
+		c.acquisitionEventChans[resourceType] = eventChan
+	}
+	return eventChan
+}
+
+// Start claims and renews shards until ctx is done, the owned shards are released afterwards.
+func (c *leaseCoordinator) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.renewPeriod)
+	defer ticker.Stop()
+	for {
+		if err := c.sync(ctx); err != nil {
+			c.logger.Error(err, "failed to sync shards")
+		}
+		select {
+		case <-ctx.Done():
+			// the shards are released with a fresh context, so that other replicas can take them over without waiting for expiry.
+			c.releaseAll(context.Background())
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection returns false, as every replica claims shards.
+func (c *leaseCoordinator) NeedLeaderElection() bool {
+	return false
+}
+
+// sync renews the member lease, releases the shards beyond the fair share, renews the remaining owned shards,
+// and acquires unclaimed or expired shards up to the fair share.
+func (c *leaseCoordinator) sync(ctx context.Context) error {
+	now := c.now()
+	leaseList := &coordinationv1.LeaseList{}
+	if err := c.apiReader.List(ctx, leaseList, client.InNamespace(c.namespace),
+		client.MatchingLabels{labelShardCoordinator: c.leasePrefix}); err != nil {
+		return err
+	}
+	var memberLease *coordinationv1.Lease
+	liveMembers := 1
+	shardLeases := make(map[int]*coordinationv1.Lease)
+	for i := range leaseList.Items {
+		lease := &leaseList.Items[i]
+		switch lease.Labels[labelShardLeaseKind] {
+		case leaseKindMember:
+			if lease.Name == c.memberLeaseName() {
+				memberLease = lease
+			} else if !isLeaseExpired(lease, now) {
+				liveMembers++
+			}
+		case leaseKindShard:
+			for shard := 0; shard < c.shardCount; shard++ {
+				if lease.Name == c.shardLeaseName(shard) {
+					shardLeases[shard] = lease
+				}
+			}
+		}
+	}
+	if err := c.claimLease(ctx, memberLease, c.memberLeaseName(), leaseKindMember, now); err != nil {
+		return errors.Wrap(err, "failed to renew member lease")
+	}
+	fairShare := (c.shardCount + liveMembers - 1) / liveMembers
+	c.shardMembers.Set(float64(liveMembers))
+
+	var heldShards []int
+	for shard, lease := range shardLeases {
+		if holderIdentity(lease) == c.identity && !isLeaseExpired(lease, now) {
+			heldShards = append(heldShards, shard)
+		}
+	}
+	sort.Ints(heldShards)
+	var excessShards []int
+	if len(heldShards) > fairShare {
+		excessShards = heldShards[fairShare:]
+		heldShards = heldShards[:fairShare]
+	}
+	// the excess shards are disowned before their leases are released, so that they're never reconciled by two replicas.
+	c.retainOwnedShards(heldShards)
+	for _, shard := range excessShards {
+		if err := c.releaseLease(ctx, shardLeases[shard]); err != nil {
+			c.logger.Error(err, "failed to release shard", "shard", shard)
+		}
+	}
+
+	ownedShards := make(map[int]time.Time, fairShare)
+	for _, shard := range heldShards {
+		if err := c.claimLease(ctx, shardLeases[shard], c.shardLeaseName(shard), leaseKindShard, now); err != nil {
+			c.logger.Error(err, "failed to renew shard", "shard", shard)
+			continue
+		}
+		ownedShards[shard] = now.Add(c.leaseDuration)
+	}
+	var acquiredShards []int
+	offset := shardForKey(c.identity, c.shardCount)
+	for i := 0; i < c.shardCount && len(ownedShards) < fairShare; i++ {
+		shard := (offset + i) % c.shardCount
+		if _, owned := ownedShards[shard]; owned {
+			continue
+		}
+		lease := shardLeases[shard]
+		if lease != nil && holderIdentity(lease) != c.identity && !isLeaseExpired(lease, now) {
+			continue
+		}
+		if err := c.claimLease(ctx, lease, c.shardLeaseName(shard), leaseKindShard, now); err != nil {
+			c.logger.V(1).Info("failed to acquire shard", "shard", shard, "error", err)
+			continue
+		}
+		ownedShards[shard] = now.Add(c.leaseDuration)
+		acquiredShards = append(acquiredShards, shard)
+	}
+	c.ownedShardsMutex.Lock()
+	c.ownedShards = ownedShards
+	c.ownedShardsMutex.Unlock()
+	c.shardsOwned.Set(float64(len(ownedShards)))
+
+	if len(acquiredShards) != 0 {
+		sort.Ints(acquiredShards)
+		c.logger.Info("acquired shards", "shards", acquiredShards, "fairShare", fairShare, "liveMembers", liveMembers)
+		// the notification is sent asynchronously, so that lease renewal isn't blocked until controllers consume the events.
+		go c.notifyShardsAcquired(ctx)
+	}
+	return nil
+}
+
+// retainOwnedShards disowns all shards except shards.
+func (c *leaseCoordinator) retainOwnedShards(shards []int) {
+	c.ownedShardsMutex.Lock()
+	defer c.ownedShardsMutex.Unlock()
+	retainedShards := make(map[int]time.Time, len(shards))
+	for _, shard := range shards {
+		if expireTime, owned := c.ownedShards[shard]; owned {
+			retainedShards[shard] = expireTime
+		}
+	}
+	c.ownedShards = retainedShards
+}
+
+// releaseAll disowns and releases all shards owned by this replica, as well as its member lease.
+func (c *leaseCoordinator) releaseAll(ctx context.Context) {
+	c.retainOwnedShards(nil)
+	c.shardsOwned.Set(0)
+	leaseList := &coordinationv1.LeaseList{}
+	if err := c.apiReader.List(ctx, leaseList, client.InNamespace(c.namespace),
+		client.MatchingLabels{labelShardCoordinator: c.leasePrefix}); err != nil {
+		c.logger.Error(err, "failed to release shards")
+		return
+	}
+	for i := range leaseList.Items {
+		lease := &leaseList.Items[i]
+		if holderIdentity(lease) != c.identity {
+			continue
+		}
+		if err := c.releaseLease(ctx, lease); err != nil {
+			c.logger.Error(err, "failed to release lease", "lease", lease.Name)
+		}
+	}
+}
+
+// claimLease creates or updates the lease to be held by this replica, the update fails on conflict if the lease was modified concurrently.
+func (c *leaseCoordinator) claimLease(ctx context.Context, lease *coordinationv1.Lease, name string, kind string, now time.Time) error {
+	renewTime := metav1.NewMicroTime(now)
+	leaseDurationSeconds := int32(c.leaseDuration / time.Second)
+	if lease == nil {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: c.namespace,
+				Name:      name,
+				Labels: map[string]string{
+					labelShardCoordinator: c.leasePrefix,
+					labelShardLeaseKind:   kind,
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &c.identity,
+				LeaseDurationSeconds: &leaseDurationSeconds,
+				AcquireTime:          &renewTime,
+				RenewTime:            &renewTime,
+			},
+		}
+		return c.k8sClient.Create(ctx, lease)
+	}
+	lease = lease.DeepCopy()
+	if holderIdentity(lease) != c.identity {
+		leaseTransitions := int32(1)
+		if lease.Spec.LeaseTransitions != nil {
+			leaseTransitions = *lease.Spec.LeaseTransitions + 1
+		}
+		lease.Spec.HolderIdentity = &c.identity
+		lease.Spec.AcquireTime = &renewTime
+		lease.Spec.LeaseTransitions = &leaseTransitions
+	}
+	lease.Spec.LeaseDurationSeconds = &leaseDurationSeconds
+	lease.Spec.RenewTime = &renewTime
+	return c.k8sClient.Update(ctx, lease)
+}
+
+// releaseLease clears the holder of lease, so that other replicas can claim it immediately.
+func (c *leaseCoordinator) releaseLease(ctx context.Context, lease *coordinationv1.Lease) error {
+	lease = lease.DeepCopy()
+	lease.Spec.HolderIdentity = nil
+	return c.k8sClient.Update(ctx, lease)
+}
+
+// notifyShardsAcquired notifies all resources of the resource types watched via AcquisitionEvents,
+// resources that don't belong to the acquired shards are skipped by the controllers.
+func (c *leaseCoordinator) notifyShardsAcquired(ctx context.Context) {
+	c.acquisitionEventChansMutex.Lock()
+	eventChans := make(map[networkingpkg.ResourceType]chan event.GenericEvent, len(c.acquisitionEventChans))
+	for resourceType, eventChan := range c.acquisitionEventChans {
+		eventChans[resourceType] = eventChan
+	}
+	c.acquisitionEventChansMutex.Unlock()
+
+	for resourceType, eventChan := range eventChans {
+		objs, err := c.listResources(ctx, resourceType)
+		if err != nil {
+			c.logger.Error(err, "failed to notify resources of acquired shards", "resourceType", resourceType)
+			continue
+		}
+		for _, obj := range objs {
+			select {
+			case eventChan <- event.GenericEvent{Object: obj}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func (c *leaseCoordinator) listResources(ctx context.Context, resourceType networkingpkg.ResourceType) ([]client.Object, error) {
+	var objs []client.Object
+	switch resourceType {
+	case networkingpkg.ResourceTypeIngress:
+		ingList := &networking.IngressList{}
+		if err := c.k8sClient.List(ctx, ingList); err != nil {
+			return nil, err
+		}
+		for i := range ingList.Items {
+			objs = append(objs, &ingList.Items[i])
+		}
+	case networkingpkg.ResourceTypeService:
+		svcList := &corev1.ServiceList{}
+		if err := c.k8sClient.List(ctx, svcList); err != nil {
+			return nil, err
+		}
+		for i := range svcList.Items {
+			objs = append(objs, &svcList.Items[i])
+		}
+	}
+	return objs, nil
+}
+
+func (c *leaseCoordinator) memberLeaseName() string {
+	return fmt.Sprintf("%s-member-%s", c.leasePrefix, c.identity)
+}
+
+func (c *leaseCoordinator) shardLeaseName(shard int) string {
+	return fmt.Sprintf("%s-shard-%d", c.leasePrefix, shard)
+}
+
+func holderIdentity(lease *coordinationv1.Lease) string {
+	if lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+// isLeaseExpired checks whether lease is unheld, or not renewed within its duration.
+func isLeaseExpired(lease *coordinationv1.Lease, now time.Time) bool {
+	if holderIdentity(lease) == "" || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	expireTime := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+	return !now.Before(expireTime)
+}
@@ -0,0 +1,178 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestLeaseCoordinator(t *testing.T, k8sClient client.Client, identity string, now *time.Time) *leaseCoordinator {
+	cfg := config.ShardingConfig{
+		ShardCount:     4,
+		LeaseNamespace: "kube-system",
+		LeaseDuration:  15 * time.Second,
+	}
+	c, err := NewLeaseCoordinator(k8sClient, k8sClient, cfg, "lbc", identity, prometheus.NewRegistry(), logr.Discard())
+	assert.NoError(t, err)
+	c.now = func() time.Time { return *now }
+	return c
+}
+
+func ownedShards(c *leaseCoordinator) []int {
+	var shards []int
+	for shard := 0; shard < c.shardCount; shard++ {
+		if expireTime, owned := c.ownedShards[shard]; owned && c.now().Before(expireTime) {
+			shards = append(shards, shard)
+		}
+	}
+	return shards
+}
+
+func Test_leaseCoordinator_sync(t *testing.T) {
+	t.Run("single replica owns all shards", func(t *testing.T) {
+		now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		k8sClient := testclient.NewClientBuilder().Build()
+		c := newTestLeaseCoordinator(t, k8sClient, "replica-a", &now)
+
+		assert.NoError(t, c.sync(context.Background()))
+		assert.Equal(t, []int{0, 1, 2, 3}, ownedShards(c))
+		assert.True(t, c.Owns(types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}))
+
+		// ownership expires if not renewed.
+		now = now.Add(15 * time.Second)
+		assert.False(t, c.Owns(types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}))
+		assert.NoError(t, c.sync(context.Background()))
+		assert.True(t, c.Owns(types.NamespacedName{Namespace: "awesome-ns", Name: "ing-1"}))
+	})
+
+	t.Run("shards are rebalanced when replica joins", func(t *testing.T) {
+		now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		k8sClient := testclient.NewClientBuilder().Build()
+		replicaA := newTestLeaseCoordinator(t, k8sClient, "replica-a", &now)
+		replicaB := newTestLeaseCoordinator(t, k8sClient, "replica-b", &now)
+
+		assert.NoError(t, replicaA.sync(context.Background()))
+		assert.NoError(t, replicaB.sync(context.Background()))
+		assert.Len(t, ownedShards(replicaA), 4)
+		assert.Len(t, ownedShards(replicaB), 0)
+
+		now = now.Add(5 * time.Second)
+		assert.NoError(t, replicaA.sync(context.Background()))
+		assert.NoError(t, replicaB.sync(context.Background()))
+		assert.Len(t, ownedShards(replicaA), 2)
+		assert.Len(t, ownedShards(replicaB), 2)
+
+		for i := 0; i < 100; i++ {
+			key := types.NamespacedName{Namespace: "awesome-ns", Name: fmt.Sprintf("svc-%d", i)}
+			assert.NotEqual(t, replicaA.Owns(key), replicaB.Owns(key), "key %v must be owned by exactly one replica", key)
+		}
+	})
+
+	t.Run("expired shards are taken over", func(t *testing.T) {
+		now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		renewTime := metav1.NewMicroTime(now.Add(-time.Minute))
+		goneIdentity := "replica-gone"
+		leaseDurationSeconds := int32(15)
+		var leases []client.Object
+		for _, kind := range []string{leaseKindMember, leaseKindShard} {
+			name := "lbc-member-replica-gone"
+			if kind == leaseKindShard {
+				name = "lbc-shard-0"
+			}
+			leases = append(leases, &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "kube-system",
+					Name:      name,
+					Labels: map[string]string{
+						labelShardCoordinator: "lbc",
+						labelShardLeaseKind:   kind,
+					},
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       &goneIdentity,
+					LeaseDurationSeconds: &leaseDurationSeconds,
+					RenewTime:            &renewTime,
+				},
+			})
+		}
+		k8sClient := testclient.NewClientBuilder().WithObjects(leases...).Build()
+		c := newTestLeaseCoordinator(t, k8sClient, "replica-a", &now)
+
+		assert.NoError(t, c.sync(context.Background()))
+		assert.Equal(t, []int{0, 1, 2, 3}, ownedShards(c))
+		lease := &coordinationv1.Lease{}
+		assert.NoError(t, k8sClient.Get(context.Background(), types.NamespacedName{Namespace: "kube-system", Name: "lbc-shard-0"}, lease))
+		assert.Equal(t, "replica-a", holderIdentity(lease))
+		assert.Equal(t, int32(1), *lease.Spec.LeaseTransitions)
+	})
+
+	t.Run("shards are released on shutdown", func(t *testing.T) {
+		now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		k8sClient := testclient.NewClientBuilder().Build()
+		replicaA := newTestLeaseCoordinator(t, k8sClient, "replica-a", &now)
+		replicaB := newTestLeaseCoordinator(t, k8sClient, "replica-b", &now)
+
+		assert.NoError(t, replicaA.sync(context.Background()))
+		replicaA.releaseAll(context.Background())
+		assert.Len(t, ownedShards(replicaA), 0)
+
+		assert.NoError(t, replicaB.sync(context.Background()))
+		assert.Len(t, ownedShards(replicaB), 4)
+	})
+}
+
+func Test_isLeaseExpired(t *testing.T) {
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	holder := "replica-a"
+	leaseDurationSeconds := int32(15)
+	recentRenewTime := metav1.NewMicroTime(now.Add(-10 * time.Second))
+	staleRenewTime := metav1.NewMicroTime(now.Add(-15 * time.Second))
+	tests := []struct {
+		name  string
+		lease *coordinationv1.Lease
+		want  bool
+	}{
+		{
+			name: "renewed within lease duration",
+			lease: &coordinationv1.Lease{Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &holder,
+				LeaseDurationSeconds: &leaseDurationSeconds,
+				RenewTime:            &recentRenewTime,
+			}},
+			want: false,
+		},
+		{
+			name: "not renewed within lease duration",
+			lease: &coordinationv1.Lease{Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &holder,
+				LeaseDurationSeconds: &leaseDurationSeconds,
+				RenewTime:            &staleRenewTime,
+			}},
+			want: true,
+		},
+		{
+			name: "released",
+			lease: &coordinationv1.Lease{Spec: coordinationv1.LeaseSpec{
+				LeaseDurationSeconds: &leaseDurationSeconds,
+				RenewTime:            &recentRenewTime,
+			}},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isLeaseExpired(tt.lease, now))
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package sharding
+
+import (
+	"hash/fnv"
+)
+
+// shardForKey maps key into one of shardCount shards with jump consistent hashing,
+// so that only 1/shardCount of keys move between shards when the shard count grows by one.
+func shardForKey(key string, shardCount int) int {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(key))
+	hash := hasher.Sum64()
+
+	bucket, next := int64(-1), int64(0)
+	for next < int64(shardCount) {
+		bucket = next
+		hash = hash*2862933555777941757 + 1
+		next = int64(float64(bucket+1) * (float64(int64(1)<<31) / float64((hash>>33)+1)))
+	}
+	return int(bucket)
+}
@@ -0,0 +1,58 @@
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_shardForKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        string
+		shardCount int
+	}{
+		{
+			name:       "single shard",
+			key:        "awesome-ns/ing-1",
+			shardCount: 1,
+		},
+		{
+			name:       "explicit IngressGroup",
+			key:        "/awesome-group",
+			shardCount: 16,
+		},
+		{
+			name:       "Service",
+			key:        "awesome-ns/svc-1",
+			shardCount: 16,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := shardForKey(tt.key, tt.shardCount)
+			assert.GreaterOrEqual(t, got, 0)
+			assert.Less(t, got, tt.shardCount)
+			assert.Equal(t, got, shardForKey(tt.key, tt.shardCount))
+		})
+	}
+}
+
+func Test_shardForKey_consistentWhenShardAdded(t *testing.T) {
+	movedKeys := 0
+	keysByShard := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("awesome-ns/ing-%d", i)
+		shard := shardForKey(key, 8)
+		keysByShard[shard]++
+		shardAfterAdd := shardForKey(key, 9)
+		if shardAfterAdd != shard {
+			// keys only move into the added shard.
+			assert.Equal(t, 8, shardAfterAdd)
+			movedKeys++
+		}
+	}
+	assert.Len(t, keysByShard, 8)
+	assert.InDelta(t, 1000/9, movedKeys, 50)
+}
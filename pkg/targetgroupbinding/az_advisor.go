@@ -0,0 +1,263 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultLoadBalancerAZInfoCacheTTL = 10 * time.Minute
+
+	metricSubsystemTargetGroupBinding     = "targetgroupbinding"
+	metricAvailabilityZonesWithoutTargets = "availability_zones_without_targets"
+
+	attrCrossZoneEnabled = "load_balancing.cross_zone.enabled"
+	// TargetGroup level cross-zone attribute value to inherit the LoadBalancer level setting.
+	crossZoneUseLoadBalancerConfiguration = "use_load_balancer_configuration"
+)
+
+// AZAdvisor analyzes the availability zone distribution of targets for TargetGroupBindings,
+// and emits advisories when an availability zone enabled on the LoadBalancer has no targets while cross-zone load balancing is disabled.
+// Traffic routed to such availability zone will be dropped.
+type AZAdvisor interface {
+	// AdviseForPodEndpoints analyzes the availability zone distribution of pod endpoints.
+	AdviseForPodEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint)
+
+	// AdviseForNodePortEndpoints analyzes the availability zone distribution of nodePort endpoints.
+	AdviseForNodePortEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.NodePortEndpoint)
+
+	// Reset clears the advisories for TargetGroupBinding.
+	Reset(tgb *elbv2api.TargetGroupBinding)
+}
+
+// NewDefaultAZAdvisor constructs new defaultAZAdvisor.
+func NewDefaultAZAdvisor(k8sClient client.Client, elbv2Client services.ELBV2, eventRecorder record.EventRecorder,
+	metricsRegisterer prometheus.Registerer, logger logr.Logger) (*defaultAZAdvisor, error) {
+	azsWithoutTargets := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemTargetGroupBinding,
+		Name:      metricAvailabilityZonesWithoutTargets,
+		Help:      "Number of availability zones enabled on the load balancer without targets while cross-zone load balancing is disabled",
+	}, []string{"namespace", "name"})
+	if metricsRegisterer != nil {
+		if err := metricsRegisterer.Register(azsWithoutTargets); err != nil {
+			return nil, errors.Wrapf(err, "failed to register metric: %v", metricAvailabilityZonesWithoutTargets)
+		}
+	}
+	return &defaultAZAdvisor{
+		k8sClient:          k8sClient,
+		elbv2Client:        elbv2Client,
+		eventRecorder:      eventRecorder,
+		azsWithoutTargets:  azsWithoutTargets,
+		lbAZInfoCache:      cache.NewExpiring(),
+		lbAZInfoCacheMutex: sync.RWMutex{},
+		lbAZInfoCacheTTL:   defaultLoadBalancerAZInfoCacheTTL,
+		logger:             logger,
+	}, nil
+}
+
+var _ AZAdvisor = &defaultAZAdvisor{}
+
+// default implementation for AZAdvisor.
+type defaultAZAdvisor struct {
+	k8sClient          client.Client
+	elbv2Client        services.ELBV2
+	eventRecorder      record.EventRecorder
+	azsWithoutTargets  *prometheus.GaugeVec
+	lbAZInfoCache      *cache.Expiring
+	lbAZInfoCacheMutex sync.RWMutex
+	lbAZInfoCacheTTL   time.Duration
+
+	logger logr.Logger
+}
+
+// loadBalancerAZInfo contains the availability zones enabled on the LoadBalancers of a TargetGroup,
+// which have cross-zone load balancing disabled for that TargetGroup.
+type loadBalancerAZInfo struct {
+	// the enabled availability zones keyed by LoadBalancer ARN.
+	azsByLoadBalancerARN map[string]sets.String
+}
+
+func (a *defaultAZAdvisor) AdviseForPodEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint) {
+	if len(tgb.Spec.ExternalTargets) != 0 || tgb.Spec.AWSRoleARN != "" {
+		return
+	}
+	nodeNames := sets.NewString()
+	for _, endpoint := range endpoints {
+		nodeNames.Insert(endpoint.Pod.NodeName)
+	}
+	targetAZs := sets.NewString()
+	for _, nodeName := range nodeNames.List() {
+		node := &corev1.Node{}
+		if err := a.k8sClient.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+			a.logger.V(1).Info("unable to determine availability zone of node", "node", nodeName, "error", err)
+			return
+		}
+		az := getNodeAZ(node)
+		if az == "" {
+			return
+		}
+		targetAZs.Insert(az)
+	}
+	a.advise(ctx, tgb, targetAZs)
+}
+
+func (a *defaultAZAdvisor) AdviseForNodePortEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.NodePortEndpoint) {
+	if len(tgb.Spec.ExternalTargets) != 0 || tgb.Spec.AWSRoleARN != "" {
+		return
+	}
+	targetAZs := sets.NewString()
+	for _, endpoint := range endpoints {
+		az := getNodeAZ(endpoint.Node)
+		if az == "" {
+			return
+		}
+		targetAZs.Insert(az)
+	}
+	a.advise(ctx, tgb, targetAZs)
+}
+
+func (a *defaultAZAdvisor) Reset(tgb *elbv2api.TargetGroupBinding) {
+	a.azsWithoutTargets.DeleteLabelValues(tgb.Namespace, tgb.Name)
+}
+
+// advise compares the availability zones of targets with the availability zones enabled on LoadBalancers.
+func (a *defaultAZAdvisor) advise(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetAZs sets.String) {
+	azInfo, err := a.fetchLoadBalancerAZInfo(ctx, tgb.Spec.TargetGroupARN)
+	if err != nil {
+		a.logger.V(1).Info("unable to fetch availability zones of load balancers", "targetGroupBinding", k8s.NamespacedName(tgb), "error", err)
+		return
+	}
+	azsWithoutTargets := sets.NewString()
+	for lbARN, lbAZs := range azInfo.azsByLoadBalancerARN {
+		lbAZsWithoutTargets := lbAZs.Difference(targetAZs)
+		if lbAZsWithoutTargets.Len() == 0 {
+			continue
+		}
+		azsWithoutTargets.Insert(lbAZsWithoutTargets.UnsortedList()...)
+		a.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonAZWithoutTargets,
+			fmt.Sprintf("Availability zones [%s] enabled on load balancer %s have no targets while cross-zone load balancing is disabled",
+				strings.Join(lbAZsWithoutTargets.List(), ", "), lbARN))
+	}
+	a.azsWithoutTargets.WithLabelValues(tgb.Namespace, tgb.Name).Set(float64(azsWithoutTargets.Len()))
+}
+
+// fetchLoadBalancerAZInfo fetches the availability zones enabled on the LoadBalancers of TargetGroup.
+// LoadBalancers with cross-zone load balancing enabled for TargetGroup are excluded.
+func (a *defaultAZAdvisor) fetchLoadBalancerAZInfo(ctx context.Context, tgARN string) (loadBalancerAZInfo, error) {
+	a.lbAZInfoCacheMutex.RLock()
+	if rawCacheItem, exists := a.lbAZInfoCache.Get(tgARN); exists {
+		a.lbAZInfoCacheMutex.RUnlock()
+		return rawCacheItem.(loadBalancerAZInfo), nil
+	}
+	a.lbAZInfoCacheMutex.RUnlock()
+
+	azInfo, err := a.fetchLoadBalancerAZInfoFromAWS(ctx, tgARN)
+	if err != nil {
+		return loadBalancerAZInfo{}, err
+	}
+	a.lbAZInfoCacheMutex.Lock()
+	defer a.lbAZInfoCacheMutex.Unlock()
+	a.lbAZInfoCache.Set(tgARN, azInfo, a.lbAZInfoCacheTTL)
+	return azInfo, nil
+}
+
+func (a *defaultAZAdvisor) fetchLoadBalancerAZInfoFromAWS(ctx context.Context, tgARN string) (loadBalancerAZInfo, error) {
+	azInfo := loadBalancerAZInfo{azsByLoadBalancerARN: make(map[string]sets.String)}
+	tgs, err := a.elbv2Client.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: []string{tgARN},
+	})
+	if err != nil {
+		return loadBalancerAZInfo{}, err
+	}
+	if len(tgs) != 1 || len(tgs[0].LoadBalancerArns) == 0 {
+		return azInfo, nil
+	}
+	tgAttrsResp, err := a.elbv2Client.DescribeTargetGroupAttributesWithContext(ctx, &elbv2sdk.DescribeTargetGroupAttributesInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	})
+	if err != nil {
+		return loadBalancerAZInfo{}, err
+	}
+	tgCrossZone := crossZoneUseLoadBalancerConfiguration
+	for _, attr := range tgAttrsResp.Attributes {
+		if awssdk.ToString(attr.Key) == attrCrossZoneEnabled {
+			tgCrossZone = awssdk.ToString(attr.Value)
+		}
+	}
+	if tgCrossZone == "true" {
+		return azInfo, nil
+	}
+
+	lbs, err := a.elbv2Client.DescribeLoadBalancersAsList(ctx, &elbv2sdk.DescribeLoadBalancersInput{
+		LoadBalancerArns: tgs[0].LoadBalancerArns,
+	})
+	if err != nil {
+		return loadBalancerAZInfo{}, err
+	}
+	for i, lb := range lbs {
+		lbARN := awssdk.ToString(lb.LoadBalancerArn)
+		if tgCrossZone == crossZoneUseLoadBalancerConfiguration {
+			lbCrossZone, err := a.isLoadBalancerCrossZoneEnabled(ctx, &lbs[i])
+			if err != nil {
+				return loadBalancerAZInfo{}, err
+			}
+			if lbCrossZone {
+				continue
+			}
+		}
+		lbAZs := sets.NewString()
+		for _, az := range lb.AvailabilityZones {
+			lbAZs.Insert(awssdk.ToString(az.ZoneName))
+		}
+		azInfo.azsByLoadBalancerARN[lbARN] = lbAZs
+	}
+	return azInfo, nil
+}
+
+// isLoadBalancerCrossZoneEnabled checks whether cross-zone load balancing is enabled on LoadBalancer.
+// cross-zone load balancing is always enabled on ALB, and is configurable on NLB.
+func (a *defaultAZAdvisor) isLoadBalancerCrossZoneEnabled(ctx context.Context, lb *elbv2types.LoadBalancer) (bool, error) {
+	if lb.Type == elbv2types.LoadBalancerTypeEnumApplication {
+		return true, nil
+	}
+	resp, err := a.elbv2Client.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2sdk.DescribeLoadBalancerAttributesInput{
+		LoadBalancerArn: lb.LoadBalancerArn,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, attr := range resp.Attributes {
+		if awssdk.ToString(attr.Key) == attrCrossZoneEnabled {
+			return awssdk.ToString(attr.Value) == "true", nil
+		}
+	}
+	return false, nil
+}
+
+// getNodeAZ returns the availability zone of node, or empty string if unknown.
+func getNodeAZ(node *corev1.Node) string {
+	if az, ok := node.Labels[corev1.LabelTopologyZone]; ok {
+		return az
+	}
+	return node.Labels[corev1.LabelFailureDomainBetaZone]
+}
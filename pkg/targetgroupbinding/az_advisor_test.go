@@ -0,0 +1,147 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultAZAdvisor_AdviseForNodePortEndpoints(t *testing.T) {
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-tgb",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: "tg-arn",
+		},
+	}
+	nodeInAZ := func(name string, az string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{corev1.LabelTopologyZone: az},
+			},
+		}
+	}
+	lb := &elbv2types.LoadBalancer{
+		LoadBalancerArn: awssdk.String("lb-arn"),
+		Type:            elbv2types.LoadBalancerTypeEnumNetwork,
+		AvailabilityZones: []elbv2types.AvailabilityZone{
+			{ZoneName: awssdk.String("us-west-2a")},
+			{ZoneName: awssdk.String("us-west-2b")},
+		},
+	}
+	tests := []struct {
+		name             string
+		endpoints        []backend.NodePortEndpoint
+		tgCrossZone      string
+		lbCrossZone      string
+		wantEvents       int
+		wantAZsNoTargets float64
+	}{
+		{
+			name: "targets in all availability zones",
+			endpoints: []backend.NodePortEndpoint{
+				{InstanceID: "i-1", Node: nodeInAZ("node-1", "us-west-2a"), Port: 30080},
+				{InstanceID: "i-2", Node: nodeInAZ("node-2", "us-west-2b"), Port: 30080},
+			},
+			tgCrossZone:      "use_load_balancer_configuration",
+			lbCrossZone:      "false",
+			wantEvents:       0,
+			wantAZsNoTargets: 0,
+		},
+		{
+			name: "availability zone without targets and cross-zone disabled",
+			endpoints: []backend.NodePortEndpoint{
+				{InstanceID: "i-1", Node: nodeInAZ("node-1", "us-west-2a"), Port: 30080},
+			},
+			tgCrossZone:      "use_load_balancer_configuration",
+			lbCrossZone:      "false",
+			wantEvents:       1,
+			wantAZsNoTargets: 1,
+		},
+		{
+			name: "availability zone without targets and cross-zone enabled on load balancer",
+			endpoints: []backend.NodePortEndpoint{
+				{InstanceID: "i-1", Node: nodeInAZ("node-1", "us-west-2a"), Port: 30080},
+			},
+			tgCrossZone:      "use_load_balancer_configuration",
+			lbCrossZone:      "true",
+			wantEvents:       0,
+			wantAZsNoTargets: 0,
+		},
+		{
+			name: "availability zone without targets and cross-zone enabled on target group",
+			endpoints: []backend.NodePortEndpoint{
+				{InstanceID: "i-1", Node: nodeInAZ("node-1", "us-west-2a"), Port: 30080},
+			},
+			tgCrossZone:      "true",
+			wantEvents:       0,
+			wantAZsNoTargets: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), gomock.Any()).Return([]elbv2types.TargetGroup{
+				{
+					TargetGroupArn:   awssdk.String("tg-arn"),
+					LoadBalancerArns: []string{"lb-arn"},
+				},
+			}, nil)
+			elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), gomock.Any()).Return(&elbv2sdk.DescribeTargetGroupAttributesOutput{
+				Attributes: []elbv2types.TargetGroupAttribute{
+					{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String(tt.tgCrossZone)},
+				},
+			}, nil)
+			if tt.tgCrossZone != "true" {
+				elbv2Client.EXPECT().DescribeLoadBalancersAsList(gomock.Any(), gomock.Any()).Return([]elbv2types.LoadBalancer{*lb}, nil)
+				elbv2Client.EXPECT().DescribeLoadBalancerAttributesWithContext(gomock.Any(), gomock.Any()).Return(&elbv2sdk.DescribeLoadBalancerAttributesOutput{
+					Attributes: []elbv2types.LoadBalancerAttribute{
+						{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String(tt.lbCrossZone)},
+					},
+				}, nil)
+			}
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			eventRecorder := record.NewFakeRecorder(10)
+			a, err := NewDefaultAZAdvisor(k8sClient, elbv2Client, eventRecorder, prometheus.NewRegistry(), logr.New(&log.NullLogSink{}))
+			assert.NoError(t, err)
+
+			a.AdviseForNodePortEndpoints(context.Background(), tgb, tt.endpoints)
+			// second advise is served from cache.
+			a.AdviseForNodePortEndpoints(context.Background(), tgb, tt.endpoints)
+
+			numEvents := len(eventRecorder.Events)
+			assert.Equal(t, tt.wantEvents*2, numEvents)
+			for i := 0; i < numEvents; i++ {
+				assert.Contains(t, <-eventRecorder.Events, k8s.TargetGroupBindingEventReasonAZWithoutTargets)
+			}
+			assert.Equal(t, tt.wantAZsNoTargets, testutil.ToFloat64(a.azsWithoutTargets.WithLabelValues("default", "my-tgb")))
+		})
+	}
+}
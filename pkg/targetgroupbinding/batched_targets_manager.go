@@ -0,0 +1,231 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	defaultTargetsBatchChunkSize = 200
+)
+
+// NewBatchedTargetsManager constructs new batchedTargetsManager.
+// targets registered or deregistered for a TargetGroup within batchWindow are coalesced into a single batch,
+// whose chunked API calls are issued with at most maxConcurrency calls in flight for that TargetGroup.
+func NewBatchedTargetsManager(targetsManager TargetsManager, batchWindow time.Duration, maxConcurrency int,
+	metrics *TargetsBatchMetrics, logger logr.Logger) *batchedTargetsManager {
+	return &batchedTargetsManager{
+		targetsManager: targetsManager,
+		batchWindow:    batchWindow,
+		maxConcurrency: maxConcurrency,
+		chunkSize:      defaultTargetsBatchChunkSize,
+		metrics:        metrics,
+		logger:         logger,
+		batchersByARN:  make(map[string]*targetGroupBatcher),
+	}
+}
+
+var _ TargetsManager = &batchedTargetsManager{}
+
+// batchedTargetsManager decorates a TargetsManager to coalesce target registrations and deregistrations per TargetGroup.
+// Callers block until the batch containing their targets is flushed, and receive the result of that flush.
+// At most one batch is flushed for each TargetGroup at a time.
+type batchedTargetsManager struct {
+	targetsManager TargetsManager
+	batchWindow    time.Duration
+	maxConcurrency int
+	chunkSize      int
+	// metrics is optional, and is nil when metrics are not collected.
+	metrics *TargetsBatchMetrics
+	logger  logr.Logger
+
+	// batchersByARN tracks the TargetGroups with pending or flushing batches.
+	batchersByARN map[string]*targetGroupBatcher
+	// batchersMutex protects batchersByARN and the batchers within.
+	batchersMutex sync.Mutex
+}
+
+// targetGroupBatcher tracks the batches for a single TargetGroup.
+type targetGroupBatcher struct {
+	// pending is the batch accepting new targets, it's nil when there is nothing to flush.
+	pending *targetsBatch
+	// flushing is whether a batch is being flushed.
+	flushing bool
+}
+
+// targetsBatch is a set of coalesced target operations.
+type targetsBatch struct {
+	// registrations and deregistrations are keyed by UniqueIDForTargetDescription.
+	// a target is in at most one of them, with the latest requested operation winning.
+	registrations   map[string]elbv2types.TargetDescription
+	deregistrations map[string]elbv2types.TargetDescription
+
+	// done is closed once the batch is flushed, err is the flush result afterwards.
+	done chan struct{}
+	err  error
+}
+
+func (m *batchedTargetsManager) RegisterTargets(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) error {
+	return m.enqueueAndWait(ctx, tgARN, targets, operationRegisterTargets)
+}
+
+func (m *batchedTargetsManager) DeregisterTargets(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) error {
+	return m.enqueueAndWait(ctx, tgARN, targets, operationDeregisterTargets)
+}
+
+func (m *batchedTargetsManager) ListTargets(ctx context.Context, tgARN string) ([]TargetInfo, error) {
+	return m.targetsManager.ListTargets(ctx, tgARN)
+}
+
+// enqueueAndWait adds targets into the pending batch of TargetGroup, and waits until that batch is flushed.
+func (m *batchedTargetsManager) enqueueAndWait(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription, operation string) error {
+	if len(targets) == 0 {
+		return nil
+	}
+	batch := m.enqueue(tgARN, targets, operation)
+	select {
+	case <-batch.done:
+		return batch.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// enqueue adds targets into the pending batch of TargetGroup and returns that batch.
+func (m *batchedTargetsManager) enqueue(tgARN string, targets []elbv2types.TargetDescription, operation string) *targetsBatch {
+	m.batchersMutex.Lock()
+	defer m.batchersMutex.Unlock()
+
+	batcher, exists := m.batchersByARN[tgARN]
+	if !exists {
+		batcher = &targetGroupBatcher{}
+		m.batchersByARN[tgARN] = batcher
+	}
+	if batcher.pending == nil {
+		batcher.pending = &targetsBatch{
+			registrations:   make(map[string]elbv2types.TargetDescription),
+			deregistrations: make(map[string]elbv2types.TargetDescription),
+			done:            make(chan struct{}),
+		}
+		// a flushing batcher schedules its next flush once the current one is done.
+		if !batcher.flushing {
+			m.scheduleFlush(tgARN)
+		}
+	}
+
+	batch := batcher.pending
+	addTo, removeFrom := batch.registrations, batch.deregistrations
+	oppositeOperation := operationDeregisterTargets
+	if operation == operationDeregisterTargets {
+		addTo, removeFrom = batch.deregistrations, batch.registrations
+		oppositeOperation = operationRegisterTargets
+	}
+	for _, target := range targets {
+		targetID := UniqueIDForTargetDescription(target)
+		if _, ok := removeFrom[targetID]; ok {
+			delete(removeFrom, targetID)
+			m.metrics.addQueueDepth(oppositeOperation, -1)
+		}
+		if _, ok := addTo[targetID]; !ok {
+			m.metrics.addQueueDepth(operation, 1)
+		}
+		addTo[targetID] = target
+	}
+	return batch
+}
+
+func (m *batchedTargetsManager) scheduleFlush(tgARN string) {
+	time.AfterFunc(m.batchWindow, func() {
+		m.flush(tgARN)
+	})
+}
+
+// flush flushes the pending batch of TargetGroup.
+func (m *batchedTargetsManager) flush(tgARN string) {
+	m.batchersMutex.Lock()
+	batcher := m.batchersByARN[tgARN]
+	batch := batcher.pending
+	batcher.pending = nil
+	batcher.flushing = true
+	m.metrics.addQueueDepth(operationRegisterTargets, -len(batch.registrations))
+	m.metrics.addQueueDepth(operationDeregisterTargets, -len(batch.deregistrations))
+	m.batchersMutex.Unlock()
+
+	// the batch is shared by multiple callers, thus it's not bound to any caller's context.
+	batch.err = m.flushBatch(context.Background(), tgARN, batch)
+	close(batch.done)
+
+	m.batchersMutex.Lock()
+	defer m.batchersMutex.Unlock()
+	batcher.flushing = false
+	if batcher.pending != nil {
+		m.scheduleFlush(tgARN)
+	} else {
+		delete(m.batchersByARN, tgARN)
+	}
+}
+
+// flushBatch deregisters then registers the targets in batch.
+func (m *batchedTargetsManager) flushBatch(ctx context.Context, tgARN string, batch *targetsBatch) error {
+	deregistrations := targetDescriptionsFromMap(batch.deregistrations)
+	registrations := targetDescriptionsFromMap(batch.registrations)
+	m.logger.V(1).Info("flushing targets batch",
+		"arn", tgARN,
+		"registrations", len(registrations),
+		"deregistrations", len(deregistrations))
+
+	m.metrics.observeBatchSize(operationDeregisterTargets, len(deregistrations))
+	if err := m.processInChunks(ctx, deregistrations, func(ctx context.Context, chunk []elbv2types.TargetDescription) error {
+		return m.targetsManager.DeregisterTargets(ctx, tgARN, chunk)
+	}); err != nil {
+		return err
+	}
+	m.metrics.observeBatchSize(operationRegisterTargets, len(registrations))
+	return m.processInChunks(ctx, registrations, func(ctx context.Context, chunk []elbv2types.TargetDescription) error {
+		return m.targetsManager.RegisterTargets(ctx, tgARN, chunk)
+	})
+}
+
+// processInChunks invokes fn for chunks of targets, with at most maxConcurrency invocations in flight.
+// the first error cancels the chunks not yet processed.
+func (m *batchedTargetsManager) processInChunks(ctx context.Context, targets []elbv2types.TargetDescription,
+	fn func(ctx context.Context, chunk []elbv2types.TargetDescription) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chunks := chunkTargetDescriptions(targets, m.chunkSize)
+	var firstErr error
+	var firstErrOnce sync.Once
+	workqueue.ParallelizeUntil(ctx, m.maxConcurrency, len(chunks), func(i int) {
+		if err := fn(ctx, chunks[i]); err != nil {
+			firstErrOnce.Do(func() {
+				firstErr = err
+				cancel()
+			})
+		}
+	})
+	return firstErr
+}
+
+// targetDescriptionsFromMap returns the TargetDescriptions in map, ordered by their unique ID.
+func targetDescriptionsFromMap(targetsByUniqueID map[string]elbv2types.TargetDescription) []elbv2types.TargetDescription {
+	if len(targetsByUniqueID) == 0 {
+		return nil
+	}
+	targetIDs := make([]string, 0, len(targetsByUniqueID))
+	for targetID := range targetsByUniqueID {
+		targetIDs = append(targetIDs, targetID)
+	}
+	sort.Strings(targetIDs)
+	targets := make([]elbv2types.TargetDescription, 0, len(targetIDs))
+	for _, targetID := range targetIDs {
+		targets = append(targets, targetsByUniqueID[targetID])
+	}
+	return targets
+}
@@ -0,0 +1,298 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+type fakeTargetsCall struct {
+	operation string
+	tgARN     string
+	targetIDs []string
+}
+
+// fakeTargetsManager records the register and deregister targets calls.
+type fakeTargetsManager struct {
+	TargetsManager
+
+	errByOperation map[string]error
+	callDelay      time.Duration
+
+	mutex       sync.Mutex
+	calls       []fakeTargetsCall
+	inflight    int
+	maxInflight int
+}
+
+func (m *fakeTargetsManager) RegisterTargets(_ context.Context, tgARN string, targets []elbv2types.TargetDescription) error {
+	return m.record(operationRegisterTargets, tgARN, targets)
+}
+
+func (m *fakeTargetsManager) DeregisterTargets(_ context.Context, tgARN string, targets []elbv2types.TargetDescription) error {
+	return m.record(operationDeregisterTargets, tgARN, targets)
+}
+
+func (m *fakeTargetsManager) record(operation string, tgARN string, targets []elbv2types.TargetDescription) error {
+	m.mutex.Lock()
+	var targetIDs []string
+	for _, target := range targets {
+		targetIDs = append(targetIDs, UniqueIDForTargetDescription(target))
+	}
+	m.calls = append(m.calls, fakeTargetsCall{operation: operation, tgARN: tgARN, targetIDs: targetIDs})
+	m.inflight++
+	if m.inflight > m.maxInflight {
+		m.maxInflight = m.inflight
+	}
+	m.mutex.Unlock()
+
+	time.Sleep(m.callDelay)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.inflight--
+	return m.errByOperation[operation]
+}
+
+func newTargetDescriptions(ids ...string) []elbv2types.TargetDescription {
+	var targets []elbv2types.TargetDescription
+	for _, id := range ids {
+		targets = append(targets, elbv2types.TargetDescription{Id: awssdk.String(id), Port: awssdk.Int32(8080)})
+	}
+	return targets
+}
+
+func Test_batchedTargetsManager_coalesce(t *testing.T) {
+	type enqueueCall struct {
+		operation string
+		targetIDs []string
+	}
+	tests := []struct {
+		name         string
+		enqueueCalls []enqueueCall
+		wantCalls    []fakeTargetsCall
+	}{
+		{
+			name: "registrations are coalesced into a single call",
+			enqueueCalls: []enqueueCall{
+				{operation: operationRegisterTargets, targetIDs: []string{"192.168.1.1", "192.168.1.2"}},
+				{operation: operationRegisterTargets, targetIDs: []string{"192.168.1.2", "192.168.1.3"}},
+			},
+			wantCalls: []fakeTargetsCall{
+				{
+					operation: operationRegisterTargets,
+					tgARN:     "my-tg",
+					targetIDs: []string{"192.168.1.1:8080", "192.168.1.2:8080", "192.168.1.3:8080"},
+				},
+			},
+		},
+		{
+			name: "deregistrations are flushed before registrations",
+			enqueueCalls: []enqueueCall{
+				{operation: operationRegisterTargets, targetIDs: []string{"192.168.1.1"}},
+				{operation: operationDeregisterTargets, targetIDs: []string{"192.168.1.2"}},
+			},
+			wantCalls: []fakeTargetsCall{
+				{
+					operation: operationDeregisterTargets,
+					tgARN:     "my-tg",
+					targetIDs: []string{"192.168.1.2:8080"},
+				},
+				{
+					operation: operationRegisterTargets,
+					tgARN:     "my-tg",
+					targetIDs: []string{"192.168.1.1:8080"},
+				},
+			},
+		},
+		{
+			name: "latest operation for same target wins",
+			enqueueCalls: []enqueueCall{
+				{operation: operationRegisterTargets, targetIDs: []string{"192.168.1.1", "192.168.1.2"}},
+				{operation: operationDeregisterTargets, targetIDs: []string{"192.168.1.2"}},
+			},
+			wantCalls: []fakeTargetsCall{
+				{
+					operation: operationDeregisterTargets,
+					tgARN:     "my-tg",
+					targetIDs: []string{"192.168.1.2:8080"},
+				},
+				{
+					operation: operationRegisterTargets,
+					tgARN:     "my-tg",
+					targetIDs: []string{"192.168.1.1:8080"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetsManager := &fakeTargetsManager{}
+			m := NewBatchedTargetsManager(targetsManager, 50*time.Millisecond, 2, nil, logr.New(&log.NullLogSink{}))
+
+			var batches []*targetsBatch
+			for _, call := range tt.enqueueCalls {
+				batches = append(batches, m.enqueue("my-tg", newTargetDescriptions(call.targetIDs...), call.operation))
+			}
+			for _, batch := range batches {
+				assert.Same(t, batches[0], batch)
+				<-batch.done
+				assert.NoError(t, batch.err)
+			}
+			assert.Equal(t, tt.wantCalls, targetsManager.calls)
+		})
+	}
+}
+
+func Test_batchedTargetsManager_RegisterTargets(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxConcurrency  int
+		targetIDs       []string
+		registerErr     error
+		wantCallsCount  int
+		wantMaxInflight int
+		wantErr         error
+	}{
+		{
+			name:            "targets are registered in chunks concurrently",
+			maxConcurrency:  2,
+			targetIDs:       []string{"192.168.1.1", "192.168.1.2", "192.168.1.3", "192.168.1.4", "192.168.1.5"},
+			wantCallsCount:  3,
+			wantMaxInflight: 2,
+		},
+		{
+			name:            "targets are registered in chunks sequentially",
+			maxConcurrency:  1,
+			targetIDs:       []string{"192.168.1.1", "192.168.1.2", "192.168.1.3"},
+			wantCallsCount:  2,
+			wantMaxInflight: 1,
+		},
+		{
+			name:            "register targets failed",
+			maxConcurrency:  1,
+			targetIDs:       []string{"192.168.1.1"},
+			registerErr:     errors.New("some error"),
+			wantCallsCount:  1,
+			wantMaxInflight: 1,
+			wantErr:         errors.New("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targetsManager := &fakeTargetsManager{
+				errByOperation: map[string]error{operationRegisterTargets: tt.registerErr},
+				callDelay:      20 * time.Millisecond,
+			}
+			m := NewBatchedTargetsManager(targetsManager, time.Millisecond, tt.maxConcurrency, nil, logr.New(&log.NullLogSink{}))
+			m.chunkSize = 2
+
+			err := m.RegisterTargets(context.Background(), "my-tg", newTargetDescriptions(tt.targetIDs...))
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			var gotTargetIDs []string
+			for _, call := range targetsManager.calls {
+				gotTargetIDs = append(gotTargetIDs, call.targetIDs...)
+			}
+			sort.Strings(gotTargetIDs)
+			var wantTargetIDs []string
+			for _, id := range tt.targetIDs {
+				wantTargetIDs = append(wantTargetIDs, id+":8080")
+			}
+			assert.Equal(t, wantTargetIDs, gotTargetIDs)
+			assert.Equal(t, tt.wantCallsCount, len(targetsManager.calls))
+			assert.Equal(t, tt.wantMaxInflight, targetsManager.maxInflight)
+		})
+	}
+}
+
+func Test_batchedTargetsManager_DeregisterTargetsFailed(t *testing.T) {
+	targetsManager := &fakeTargetsManager{
+		errByOperation: map[string]error{operationDeregisterTargets: errors.New("some error")},
+	}
+	m := NewBatchedTargetsManager(targetsManager, 10*time.Millisecond, 2, nil, logr.New(&log.NullLogSink{}))
+
+	registerBatch := m.enqueue("my-tg", newTargetDescriptions("192.168.1.1"), operationRegisterTargets)
+	err := m.DeregisterTargets(context.Background(), "my-tg", newTargetDescriptions("192.168.1.2"))
+	assert.EqualError(t, err, "some error")
+	<-registerBatch.done
+	assert.EqualError(t, registerBatch.err, "some error")
+	assert.Equal(t, []fakeTargetsCall{
+		{
+			operation: operationDeregisterTargets,
+			tgARN:     "my-tg",
+			targetIDs: []string{"192.168.1.2:8080"},
+		},
+	}, targetsManager.calls)
+}
+
+func Test_batchedTargetsManager_enqueueDuringFlush(t *testing.T) {
+	targetsManager := &fakeTargetsManager{
+		callDelay: 50 * time.Millisecond,
+	}
+	m := NewBatchedTargetsManager(targetsManager, time.Millisecond, 2, nil, logr.New(&log.NullLogSink{}))
+
+	firstBatch := m.enqueue("my-tg", newTargetDescriptions("192.168.1.1"), operationRegisterTargets)
+	assert.Eventually(t, func() bool {
+		targetsManager.mutex.Lock()
+		defer targetsManager.mutex.Unlock()
+		return len(targetsManager.calls) == 1
+	}, time.Second, time.Millisecond)
+	secondBatch := m.enqueue("my-tg", newTargetDescriptions("192.168.1.2"), operationRegisterTargets)
+	thirdBatch := m.enqueue("my-tg", newTargetDescriptions("192.168.1.3"), operationRegisterTargets)
+	assert.NotSame(t, firstBatch, secondBatch)
+	assert.Same(t, secondBatch, thirdBatch)
+
+	<-secondBatch.done
+	assert.NoError(t, secondBatch.err)
+	assert.Equal(t, []fakeTargetsCall{
+		{
+			operation: operationRegisterTargets,
+			tgARN:     "my-tg",
+			targetIDs: []string{"192.168.1.1:8080"},
+		},
+		{
+			operation: operationRegisterTargets,
+			tgARN:     "my-tg",
+			targetIDs: []string{"192.168.1.2:8080", "192.168.1.3:8080"},
+		},
+	}, targetsManager.calls)
+	assert.Equal(t, 1, targetsManager.maxInflight)
+	assert.Eventually(t, func() bool {
+		m.batchersMutex.Lock()
+		defer m.batchersMutex.Unlock()
+		return len(m.batchersByARN) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func Test_batchedTargetsManager_metrics(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics, err := NewTargetsBatchMetrics(registry)
+	assert.NoError(t, err)
+	targetsManager := &fakeTargetsManager{}
+	m := NewBatchedTargetsManager(targetsManager, 50*time.Millisecond, 2, metrics, logr.New(&log.NullLogSink{}))
+
+	m.enqueue("my-tg", newTargetDescriptions("192.168.1.1", "192.168.1.2"), operationRegisterTargets)
+	batch := m.enqueue("my-tg", newTargetDescriptions("192.168.1.2", "192.168.1.3"), operationDeregisterTargets)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.queueDepth.WithLabelValues(operationRegisterTargets)))
+	assert.Equal(t, float64(2), testutil.ToFloat64(metrics.queueDepth.WithLabelValues(operationDeregisterTargets)))
+
+	<-batch.done
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.queueDepth.WithLabelValues(operationRegisterTargets)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.queueDepth.WithLabelValues(operationDeregisterTargets)))
+	assert.Equal(t, 2, testutil.CollectAndCount(metrics.batchSize))
+}
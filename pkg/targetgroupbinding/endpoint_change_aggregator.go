@@ -0,0 +1,121 @@
+package targetgroupbinding
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	metricEndpointChangesSuppressedTotal = "endpoint_changes_suppressed_total"
+)
+
+// EndpointChangeMetrics contains the metrics of EndpointChangeAggregator.
+type EndpointChangeMetrics struct {
+	suppressedTotal prometheus.Counter
+}
+
+// NewEndpointChangeMetrics allocates and register new EndpointChangeMetrics to registerer.
+func NewEndpointChangeMetrics(registerer prometheus.Registerer) (*EndpointChangeMetrics, error) {
+	suppressedTotal := prometheus.NewCounter(prometheus.CounterOpts{
+		Subsystem: metricSubsystemTargetGroupBinding,
+		Name:      metricEndpointChangesSuppressedTotal,
+		Help:      "Number of targetGroupBinding reconciles suppressed by aggregating endpoint changes",
+	})
+	if err := registerer.Register(suppressedTotal); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricEndpointChangesSuppressedTotal)
+	}
+	return &EndpointChangeMetrics{
+		suppressedTotal: suppressedTotal,
+	}, nil
+}
+
+func (m *EndpointChangeMetrics) incSuppressed() {
+	if m == nil {
+		return
+	}
+	m.suppressedTotal.Inc()
+}
+
+// EndpointChangeAggregator aggregates the endpoint changes of TargetGroupBindings into fewer reconciles.
+type EndpointChangeAggregator interface {
+	// Enqueue enqueues the reconcile request for an endpoint change of TargetGroupBinding into queue.
+	Enqueue(queue workqueue.RateLimitingInterface, req reconcile.Request)
+}
+
+// NewDefaultEndpointChangeAggregator constructs new defaultEndpointChangeAggregator.
+// the reconcile for endpoint changes of a TargetGroupBinding is enqueued once no further change happened within
+// debounceWindow, but no later than maxStaleness after the first aggregated change.
+// aggregation is disabled when debounceWindow is zero.
+func NewDefaultEndpointChangeAggregator(debounceWindow time.Duration, maxStaleness time.Duration,
+	metrics *EndpointChangeMetrics) *defaultEndpointChangeAggregator {
+	return &defaultEndpointChangeAggregator{
+		debounceWindow: debounceWindow,
+		maxStaleness:   maxStaleness,
+		metrics:        metrics,
+		pendingByReq:   make(map[reconcile.Request]*pendingEndpointChange),
+	}
+}
+
+var _ EndpointChangeAggregator = &defaultEndpointChangeAggregator{}
+
+type defaultEndpointChangeAggregator struct {
+	debounceWindow time.Duration
+	maxStaleness   time.Duration
+	// metrics is optional, and is nil when metrics are not collected.
+	metrics *EndpointChangeMetrics
+
+	// pendingByReq tracks the TargetGroupBindings with aggregated changes not enqueued yet.
+	pendingByReq map[reconcile.Request]*pendingEndpointChange
+	// pendingMutex protects pendingByReq.
+	pendingMutex sync.Mutex
+}
+
+// pendingEndpointChange tracks the aggregated changes of a single TargetGroupBinding.
+type pendingEndpointChange struct {
+	// firstChangeTime is the time of the first aggregated change.
+	firstChangeTime time.Time
+	// timer enqueues the reconcile once fired.
+	timer *time.Timer
+}
+
+func (a *defaultEndpointChangeAggregator) Enqueue(queue workqueue.RateLimitingInterface, req reconcile.Request) {
+	if a.debounceWindow <= 0 {
+		queue.Add(req)
+		return
+	}
+
+	a.pendingMutex.Lock()
+	defer a.pendingMutex.Unlock()
+	now := time.Now()
+	if pending, ok := a.pendingByReq[req]; ok {
+		delay := a.debounceWindow
+		if deadline := pending.firstChangeTime.Add(a.maxStaleness); now.Add(delay).After(deadline) {
+			delay = deadline.Sub(now)
+		}
+		// when the timer already fired, the reconcile is being enqueued and includes this change.
+		if pending.timer.Stop() {
+			pending.timer.Reset(delay)
+		}
+		a.metrics.incSuppressed()
+		return
+	}
+	a.pendingByReq[req] = &pendingEndpointChange{
+		firstChangeTime: now,
+		timer: time.AfterFunc(a.debounceWindow, func() {
+			a.flush(queue, req)
+		}),
+	}
+}
+
+// flush enqueues the reconcile for aggregated changes of TargetGroupBinding.
+func (a *defaultEndpointChangeAggregator) flush(queue workqueue.RateLimitingInterface, req reconcile.Request) {
+	a.pendingMutex.Lock()
+	delete(a.pendingByReq, req)
+	a.pendingMutex.Unlock()
+	queue.Add(req)
+}
@@ -0,0 +1,75 @@
+package targetgroupbinding
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func Test_defaultEndpointChangeAggregator_Enqueue(t *testing.T) {
+	tgbReq := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "tgb-1"}}
+	otherTGBReq := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "awesome-ns", Name: "tgb-2"}}
+
+	t.Run("enqueue immediately when aggregation is disabled", func(t *testing.T) {
+		queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		defer queue.ShutDown()
+		a := NewDefaultEndpointChangeAggregator(0, 0, nil)
+		a.Enqueue(queue, tgbReq)
+		a.Enqueue(queue, tgbReq)
+		assert.Equal(t, 1, queue.Len())
+	})
+
+	t.Run("aggregate changes within debounce window", func(t *testing.T) {
+		queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		defer queue.ShutDown()
+		metrics, err := NewEndpointChangeMetrics(prometheus.NewRegistry())
+		assert.NoError(t, err)
+		a := NewDefaultEndpointChangeAggregator(50*time.Millisecond, 10*time.Second, metrics)
+		for i := 0; i < 5; i++ {
+			a.Enqueue(queue, tgbReq)
+		}
+		a.Enqueue(queue, otherTGBReq)
+		assert.Equal(t, 0, queue.Len())
+
+		assert.Eventually(t, func() bool {
+			return queue.Len() == 2
+		}, time.Second, 5*time.Millisecond)
+		assert.Equal(t, float64(4), testutil.ToFloat64(metrics.suppressedTotal))
+
+		a.pendingMutex.Lock()
+		assert.Empty(t, a.pendingByReq)
+		a.pendingMutex.Unlock()
+	})
+
+	t.Run("enqueue continuous changes after max staleness", func(t *testing.T) {
+		queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		defer queue.ShutDown()
+		a := NewDefaultEndpointChangeAggregator(100*time.Millisecond, 200*time.Millisecond, nil)
+		stopCh := make(chan struct{})
+		doneCh := make(chan struct{})
+		go func() {
+			defer close(doneCh)
+			ticker := time.NewTicker(10 * time.Millisecond)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-stopCh:
+					return
+				case <-ticker.C:
+					a.Enqueue(queue, tgbReq)
+				}
+			}
+		}()
+		assert.Eventually(t, func() bool {
+			return queue.Len() == 1
+		}, time.Second, 5*time.Millisecond)
+		close(stopCh)
+		<-doneCh
+	})
+}
@@ -0,0 +1,145 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// prefix of the ConfigMap name that tracks the targets registered by this cluster for a TargetGroupBinding.
+	trackedTargetsConfigMapNamePrefix = "aws-lbc-targets-"
+	// key of the ConfigMap data that contains the tracked targets.
+	trackedTargetsConfigMapDataKey = "targets"
+	// separator between tracked targets in ConfigMap data.
+	trackedTargetsSeparator = ","
+)
+
+// MultiClusterManager tracks the targets registered by this cluster for TargetGroupBindings with multiClusterTargetGroup enabled.
+// When a TargetGroup is shared across clusters, only the tracked targets are deregistered,
+// so that targets registered by other clusters are left untouched.
+type MultiClusterManager interface {
+	// ListTrackedTargets returns the unique IDs of targets registered by this cluster for TargetGroupBinding.
+	ListTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (sets.String, error)
+
+	// UpdateTrackedTargets persists the unique IDs of targets registered by this cluster for TargetGroupBinding.
+	UpdateTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetIDs sets.String) error
+
+	// Cleanup removes the tracked targets for TargetGroupBinding.
+	Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
+}
+
+// NewDefaultMultiClusterManager constructs new defaultMultiClusterManager.
+// apiReader is used to read the ConfigMaps directly from API server, so that ConfigMaps don't need to be cached.
+func NewDefaultMultiClusterManager(k8sClient client.Client, apiReader client.Reader, logger logr.Logger) *defaultMultiClusterManager {
+	return &defaultMultiClusterManager{
+		k8sClient: k8sClient,
+		apiReader: apiReader,
+		logger:    logger,
+	}
+}
+
+var _ MultiClusterManager = &defaultMultiClusterManager{}
+
+// default implementation for MultiClusterManager, which tracks targets in a ConfigMap per TargetGroupBinding.
+type defaultMultiClusterManager struct {
+	k8sClient client.Client
+	apiReader client.Reader
+	logger    logr.Logger
+}
+
+func (m *defaultMultiClusterManager) ListTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (sets.String, error) {
+	cm := &corev1.ConfigMap{}
+	if err := m.apiReader.Get(ctx, buildTrackedTargetsConfigMapKey(tgb), cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return sets.NewString(), nil
+		}
+		return nil, err
+	}
+	return decodeTrackedTargets(cm.Data[trackedTargetsConfigMapDataKey]), nil
+}
+
+func (m *defaultMultiClusterManager) UpdateTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetIDs sets.String) error {
+	cmKey := buildTrackedTargetsConfigMapKey(tgb)
+	cm := &corev1.ConfigMap{}
+	if err := m.apiReader.Get(ctx, cmKey, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: cmKey.Namespace,
+				Name:      cmKey.Name,
+			},
+			Data: map[string]string{
+				trackedTargetsConfigMapDataKey: encodeTrackedTargets(targetIDs),
+			},
+		}
+		if err := m.k8sClient.Create(ctx, cm); err != nil {
+			return err
+		}
+		m.logger.V(1).Info("created tracked targets", "targetGroupBinding", k8s.NamespacedName(tgb), "configMap", cmKey)
+		return nil
+	}
+
+	encodedTargetIDs := encodeTrackedTargets(targetIDs)
+	if cm.Data[trackedTargetsConfigMapDataKey] == encodedTargetIDs {
+		return nil
+	}
+	oldCM := cm.DeepCopy()
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[trackedTargetsConfigMapDataKey] = encodedTargetIDs
+	if err := m.k8sClient.Patch(ctx, cm, client.MergeFromWithOptions(oldCM, client.MergeFromWithOptimisticLock{})); err != nil {
+		return err
+	}
+	m.logger.V(1).Info("updated tracked targets", "targetGroupBinding", k8s.NamespacedName(tgb), "configMap", cmKey)
+	return nil
+}
+
+func (m *defaultMultiClusterManager) Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	cmKey := buildTrackedTargetsConfigMapKey(tgb)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: cmKey.Namespace,
+			Name:      cmKey.Name,
+		},
+	}
+	if err := m.k8sClient.Delete(ctx, cm); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	m.logger.V(1).Info("deleted tracked targets", "targetGroupBinding", k8s.NamespacedName(tgb), "configMap", cmKey)
+	return nil
+}
+
+func buildTrackedTargetsConfigMapKey(tgb *elbv2api.TargetGroupBinding) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: tgb.Namespace,
+		Name:      fmt.Sprintf("%s%s", trackedTargetsConfigMapNamePrefix, tgb.Name),
+	}
+}
+
+func encodeTrackedTargets(targetIDs sets.String) string {
+	return strings.Join(targetIDs.List(), trackedTargetsSeparator)
+}
+
+func decodeTrackedTargets(rawTargetIDs string) sets.String {
+	targetIDs := sets.NewString()
+	for _, targetID := range strings.Split(rawTargetIDs, trackedTargetsSeparator) {
+		if targetID = strings.TrimSpace(targetID); targetID != "" {
+			targetIDs.Insert(targetID)
+		}
+	}
+	return targetIDs
+}
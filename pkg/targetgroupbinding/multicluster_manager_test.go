@@ -0,0 +1,112 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultMultiClusterManager_trackedTargets(t *testing.T) {
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "my-tgb",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			MultiClusterTargetGroup: true,
+		},
+	}
+	tests := []struct {
+		name          string
+		existingCM    *corev1.ConfigMap
+		updateTargets []sets.String
+		wantTargets   sets.String
+		wantData      string
+	}{
+		{
+			name:        "no tracked targets",
+			wantTargets: sets.NewString(),
+		},
+		{
+			name: "existing tracked targets",
+			existingCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "aws-lbc-targets-my-tgb",
+				},
+				Data: map[string]string{
+					"targets": "192.168.1.1:8080, 192.168.1.2:8080,",
+				},
+			},
+			wantTargets: sets.NewString("192.168.1.1:8080", "192.168.1.2:8080"),
+		},
+		{
+			name: "create tracked targets",
+			updateTargets: []sets.String{
+				sets.NewString("192.168.1.2:8080", "192.168.1.1:8080"),
+			},
+			wantTargets: sets.NewString("192.168.1.1:8080", "192.168.1.2:8080"),
+			wantData:    "192.168.1.1:8080,192.168.1.2:8080",
+		},
+		{
+			name: "update tracked targets",
+			existingCM: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "aws-lbc-targets-my-tgb",
+				},
+				Data: map[string]string{
+					"targets": "192.168.1.1:8080",
+				},
+			},
+			updateTargets: []sets.String{
+				sets.NewString("192.168.1.1:8080", "192.168.1.3:8080"),
+				sets.NewString("192.168.1.3:8080"),
+			},
+			wantTargets: sets.NewString("192.168.1.3:8080"),
+			wantData:    "192.168.1.3:8080",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewFakeClientWithScheme(k8sSchema)
+			m := NewDefaultMultiClusterManager(k8sClient, k8sClient, logr.New(&log.NullLogSink{}))
+
+			ctx := context.Background()
+			if tt.existingCM != nil {
+				assert.NoError(t, k8sClient.Create(ctx, tt.existingCM.DeepCopy()))
+			}
+			for _, targets := range tt.updateTargets {
+				assert.NoError(t, m.UpdateTrackedTargets(ctx, tgb, targets))
+			}
+			got, err := m.ListTrackedTargets(ctx, tgb)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTargets, got)
+
+			cmKey := types.NamespacedName{Namespace: "default", Name: "aws-lbc-targets-my-tgb"}
+			if len(tt.updateTargets) != 0 {
+				cm := &corev1.ConfigMap{}
+				assert.NoError(t, k8sClient.Get(ctx, cmKey, cm))
+				assert.Equal(t, tt.wantData, cm.Data["targets"])
+			}
+
+			assert.NoError(t, m.Cleanup(ctx, tgb))
+			err = k8sClient.Get(ctx, cmKey, &corev1.ConfigMap{})
+			assert.True(t, apierrors.IsNotFound(err))
+		})
+	}
+}
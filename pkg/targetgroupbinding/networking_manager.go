@@ -8,9 +8,11 @@ import (
 	"strings"
 	"sync"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+
+	ec2sdk "github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -28,8 +30,8 @@ import (
 const (
 	tgbNetworkingIPPermissionLabelKey   = "elbv2.k8s.aws/targetGroupBinding"
 	tgbNetworkingIPPermissionLabelValue = "shared"
-	defaultTgbMinPort                   = int64(0)
-	defaultTgbMaxPort                   = int64(65535)
+	defaultTgbMinPort                   = int32(0)
+	defaultTgbMaxPort                   = int32(65535)
 )
 
 // NetworkingManager manages the networking for targetGroupBindings.
@@ -206,7 +208,8 @@ func (m *defaultNetworkingManager) reconcileWithIngressPermissionsPerSG(ctx cont
 	for sgID, permissions := range aggregatedIngressPermissionsPerSG {
 		if err := m.sgReconciler.ReconcileIngress(ctx, sgID, permissions,
 			networking.WithPermissionSelector(permissionSelector),
-			networking.WithAuthorizeOnly(!computedForAllTGBs)); err != nil {
+			networking.WithAuthorizeOnly(!computedForAllTGBs),
+			networking.WithDriftEventObject(tgb)); err != nil {
 			sgReconciliationErrors = append(sgReconciliationErrors, err)
 			continue
 		}
@@ -263,13 +266,13 @@ func (m *defaultNetworkingManager) groupIngressPermsBySourceAndProtocolPerSG(_ c
 					}
 					permsFromIPRangeRulesPerSG[sgID] = append(permsFromIPRangeRulesPerSG[sgID], permission)
 				} else {
-					protocol := awssdk.StringValue(permission.Permission.IpProtocol)
+					protocol := awssdk.ToString(permission.Permission.IpProtocol)
 					if _, ok := permsByProtocolAndSourcePerSG[sgID][protocol]; !ok {
 						permsByProtocolAndSourcePerSG[sgID][protocol] = make(map[string][]networking.IPPermissionInfo)
 					}
 					groupID := ""
 					if len(permission.Permission.UserIdGroupPairs) == 1 {
-						groupID = awssdk.StringValue(permission.Permission.UserIdGroupPairs[0].GroupId)
+						groupID = awssdk.ToString(permission.Permission.UserIdGroupPairs[0].GroupId)
 					}
 					if _, ok := permsByProtocolAndSourcePerSG[sgID][protocol][groupID]; !ok {
 						permsByProtocolAndSourcePerSG[sgID][protocol][groupID] = []networking.IPPermissionInfo{}
@@ -299,20 +302,20 @@ func (m *defaultNetworkingManager) computeRestrictedIngressPermissionsPerSG(ctx
 				}
 				permForCurrGroup := perms[0]
 				for _, perm := range perms {
-					if awssdk.Int64Value(perm.Permission.FromPort) == 0 && awssdk.Int64Value(perm.Permission.ToPort) == 0 {
+					if awssdk.ToInt32(perm.Permission.FromPort) == 0 && awssdk.ToInt32(perm.Permission.ToPort) == 0 {
 						minPort = defaultTgbMinPort
 						maxPort = defaultTgbMaxPort
 					} else {
-						if awssdk.Int64Value(perm.Permission.FromPort) < minPort {
-							minPort = awssdk.Int64Value(perm.Permission.FromPort)
+						if awssdk.ToInt32(perm.Permission.FromPort) < minPort {
+							minPort = awssdk.ToInt32(perm.Permission.FromPort)
 						}
-						if awssdk.Int64Value(perm.Permission.ToPort) > maxPort {
-							maxPort = awssdk.Int64Value(perm.Permission.ToPort)
+						if awssdk.ToInt32(perm.Permission.ToPort) > maxPort {
+							maxPort = awssdk.ToInt32(perm.Permission.ToPort)
 						}
 					}
 				}
-				permForCurrGroup.Permission.FromPort = awssdk.Int64(minPort)
-				permForCurrGroup.Permission.ToPort = awssdk.Int64(maxPort)
+				permForCurrGroup.Permission.FromPort = awssdk.Int32(minPort)
+				permForCurrGroup.Permission.ToPort = awssdk.Int32(maxPort)
 				restrictedPermByProtocolPerSG[sgID] = append(restrictedPermByProtocolPerSG[sgID], permForCurrGroup)
 			}
 		}
@@ -384,8 +387,8 @@ func (m *defaultNetworkingManager) computeIngressPermissionsForTGBNetworking(ctx
 }
 
 type sdkFromToPortPair struct {
-	fromPort int64
-	toPort   int64
+	fromPort int32
+	toPort   int32
 }
 
 // computePermissionsForPeerPort computes the needed Inbound IPPermissions for specified peer and port.
@@ -409,8 +412,8 @@ func (m *defaultNetworkingManager) computePermissionsForPeerPort(ctx context.Con
 		}
 		for _, numericalPort := range numericalPorts {
 			sdkFromToPortPairs = append(sdkFromToPortPairs, sdkFromToPortPair{
-				fromPort: numericalPort,
-				toPort:   numericalPort,
+				fromPort: int32(numericalPort),
+				toPort:   int32(numericalPort),
 			})
 		}
 	} else {
@@ -425,7 +428,7 @@ func (m *defaultNetworkingManager) computePermissionsForPeerPort(ctx context.Con
 		groupID := peer.SecurityGroup.GroupID
 		permissions := make([]networking.IPPermissionInfo, 0, len(sdkFromToPortPairs))
 		for _, portPair := range sdkFromToPortPairs {
-			permission := networking.NewGroupIDIPPermission(sdkProtocol, awssdk.Int64(portPair.fromPort), awssdk.Int64(portPair.toPort), groupID, permissionLabels)
+			permission := networking.NewGroupIDIPPermission(sdkProtocol, awssdk.Int32(portPair.fromPort), awssdk.Int32(portPair.toPort), groupID, permissionLabels)
 			permissions = append(permissions, permission)
 		}
 		return permissions, nil
@@ -441,16 +444,26 @@ func (m *defaultNetworkingManager) computePermissionsForPeerPort(ctx context.Con
 		for _, portPair := range sdkFromToPortPairs {
 			var permission networking.IPPermissionInfo
 			if strings.Contains(cidr, ":") {
-				permission = networking.NewCIDRv6IPPermission(sdkProtocol, awssdk.Int64(portPair.fromPort), awssdk.Int64(portPair.toPort), cidr, permissionLabels)
+				permission = networking.NewCIDRv6IPPermission(sdkProtocol, awssdk.Int32(portPair.fromPort), awssdk.Int32(portPair.toPort), cidr, permissionLabels)
 			} else {
-				permission = networking.NewCIDRIPPermission(sdkProtocol, awssdk.Int64(portPair.fromPort), awssdk.Int64(portPair.toPort), cidr, permissionLabels)
+				permission = networking.NewCIDRIPPermission(sdkProtocol, awssdk.Int32(portPair.fromPort), awssdk.Int32(portPair.toPort), cidr, permissionLabels)
 			}
 			permissions = append(permissions, permission)
 		}
 		return permissions, nil
 	}
 
-	return nil, errors.New("either ipBlock or securityGroup should be specified")
+	if peer.PrefixList != nil {
+		prefixListID := peer.PrefixList.PrefixListID
+		permissions := make([]networking.IPPermissionInfo, 0, len(sdkFromToPortPairs))
+		for _, portPair := range sdkFromToPortPairs {
+			permission := networking.NewPrefixListIDPermission(sdkProtocol, awssdk.Int32(portPair.fromPort), awssdk.Int32(portPair.toPort), prefixListID, permissionLabels)
+			permissions = append(permissions, permission)
+		}
+		return permissions, nil
+	}
+
+	return nil, errors.New("either ipBlock, securityGroup or prefixList should be specified")
 }
 
 // computeNumericalPorts computes the numerical ports if a named is used.
@@ -587,14 +600,14 @@ func (m *defaultNetworkingManager) unTrackEndpointSGs(_ context.Context, sgIDs .
 func (m *defaultNetworkingManager) fetchEndpointSGsFromAWS(ctx context.Context) ([]string, error) {
 	clusterResourceTagKey := fmt.Sprintf("kubernetes.io/cluster/%s", m.clusterName)
 	req := &ec2sdk.DescribeSecurityGroupsInput{
-		Filters: []*ec2sdk.Filter{
+		Filters: []ec2types.Filter{
 			{
 				Name:   awssdk.String("tag:" + clusterResourceTagKey),
-				Values: awssdk.StringSlice([]string{"owned", "shared"}),
+				Values: []string{"owned", "shared"},
 			},
 			{
 				Name:   awssdk.String("vpc-id"),
-				Values: awssdk.StringSlice([]string{m.vpcID}),
+				Values: []string{m.vpcID},
 			},
 		},
 	}
@@ -606,9 +619,9 @@ func (m *defaultNetworkingManager) fetchEndpointSGsFromAWS(ctx context.Context)
 }
 
 func isEC2SecurityGroupNotFoundError(err error) bool {
-	var awsErr awserr.Error
-	if errors.As(err, &awsErr) {
-		return awsErr.Code() == "InvalidGroup.NotFound"
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "InvalidGroup.NotFound"
 	}
 	return false
 }
@@ -5,8 +5,8 @@ import (
 	"errors"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	ec2sdk "github.com/aws/aws-sdk-go/service/ec2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -54,11 +54,11 @@ func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("tcp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -101,11 +101,11 @@ func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("tcp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -115,11 +115,11 @@ func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *
 					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
 				},
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("tcp"),
-						FromPort:   awssdk.Int64(8443),
-						ToPort:     awssdk.Int64(8443),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(8443),
+						ToPort:     awssdk.Int32(8443),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -129,11 +129,11 @@ func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *
 					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
 				},
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("tcp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						IpRanges: []*ec2sdk.IpRange{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						IpRanges: []ec2types.IpRange{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								CidrIp:      awssdk.String("192.168.1.1/16"),
@@ -143,11 +143,11 @@ func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *
 					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
 				},
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("tcp"),
-						FromPort:   awssdk.Int64(8443),
-						ToPort:     awssdk.Int64(8443),
-						IpRanges: []*ec2sdk.IpRange{
+						FromPort:   awssdk.Int32(8443),
+						ToPort:     awssdk.Int32(8443),
+						IpRanges: []ec2types.IpRange{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								CidrIp:      awssdk.String("192.168.1.1/16"),
@@ -196,11 +196,11 @@ func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("tcp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -210,11 +210,11 @@ func Test_defaultNetworkingManager_computeIngressPermissionsForTGBNetworking(t *
 					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
 				},
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("tcp"),
-						FromPort:   awssdk.Int64(8443),
-						ToPort:     awssdk.Int64(8443),
-						IpRanges: []*ec2sdk.IpRange{
+						FromPort:   awssdk.Int32(8443),
+						ToPort:     awssdk.Int32(8443),
+						IpRanges: []ec2types.IpRange{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								CidrIp:      awssdk.String("192.168.1.1/16"),
@@ -271,11 +271,11 @@ func Test_defaultNetworkingManager_computePermissionsForPeerPort(t *testing.T) {
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("udp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -302,11 +302,11 @@ func Test_defaultNetworkingManager_computePermissionsForPeerPort(t *testing.T) {
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("udp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						IpRanges: []*ec2sdk.IpRange{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						IpRanges: []ec2types.IpRange{
 							{
 								CidrIp:      awssdk.String("192.168.1.1/16"),
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
@@ -317,6 +317,37 @@ func Test_defaultNetworkingManager_computePermissionsForPeerPort(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "permission for PrefixList peer",
+			args: args{
+				peer: elbv2api.NetworkingPeer{
+					PrefixList: &elbv2api.PrefixList{
+						PrefixListID: "pl-abcdefg",
+					},
+				},
+				port: elbv2api.NetworkingPort{
+					Protocol: &protocolUDP,
+					Port:     &port8080,
+				},
+				pods: nil,
+			},
+			want: []networking.IPPermissionInfo{
+				{
+					Permission: ec2types.IpPermission{
+						IpProtocol: awssdk.String("udp"),
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						PrefixListIds: []ec2types.PrefixListId{
+							{
+								Description:  awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
+								PrefixListId: awssdk.String("pl-abcdefg"),
+							},
+						},
+					},
+					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
+				},
+			},
+		},
 		{
 			name: "permission for IPBlock peer with IPv6 CIDR",
 			args: args{
@@ -333,11 +364,11 @@ func Test_defaultNetworkingManager_computePermissionsForPeerPort(t *testing.T) {
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("udp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						Ipv6Ranges: []*ec2sdk.Ipv6Range{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						Ipv6Ranges: []ec2types.Ipv6Range{
 							{
 								CidrIpv6:    awssdk.String("2002::1234:abcd:ffff:c0a8:101/64"),
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
@@ -383,11 +414,11 @@ func Test_defaultNetworkingManager_computePermissionsForPeerPort(t *testing.T) {
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("udp"),
-						FromPort:   awssdk.Int64(80),
-						ToPort:     awssdk.Int64(80),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(80),
+						ToPort:     awssdk.Int32(80),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -397,11 +428,11 @@ func Test_defaultNetworkingManager_computePermissionsForPeerPort(t *testing.T) {
 					Labels: map[string]string{tgbNetworkingIPPermissionLabelKey: tgbNetworkingIPPermissionLabelValue},
 				},
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("udp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -428,11 +459,11 @@ func Test_defaultNetworkingManager_computePermissionsForPeerPort(t *testing.T) {
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("tcp"),
-						FromPort:   awssdk.Int64(8080),
-						ToPort:     awssdk.Int64(8080),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(8080),
+						ToPort:     awssdk.Int32(8080),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -458,11 +489,11 @@ func Test_defaultNetworkingManager_computePermissionsForPeerPort(t *testing.T) {
 			},
 			want: []networking.IPPermissionInfo{
 				{
-					Permission: ec2sdk.IpPermission{
+					Permission: ec2types.IpPermission{
 						IpProtocol: awssdk.String("udp"),
-						FromPort:   awssdk.Int64(0),
-						ToPort:     awssdk.Int64(65535),
-						UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+						FromPort:   awssdk.Int32(0),
+						ToPort:     awssdk.Int32(65535),
+						UserIdGroupPairs: []ec2types.UserIdGroupPair{
 							{
 								Description: awssdk.String("elbv2.k8s.aws/targetGroupBinding=shared"),
 								GroupId:     awssdk.String("sg-abcdefg"),
@@ -600,11 +631,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.168.0.0/16"),
 										},
@@ -612,11 +643,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.169.0.0/16"),
 										},
@@ -626,11 +657,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 						},
 						"sg-b": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.168.0.0/16"),
 										},
@@ -644,11 +675,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.168.0.0/16"),
 								},
@@ -656,11 +687,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 						},
 					},
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.169.0.0/16"),
 								},
@@ -670,11 +701,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 				},
 				"sg-b": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.168.0.0/16"),
 								},
@@ -691,11 +722,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.168.0.0/16"),
 										},
@@ -703,11 +734,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.169.0.0/16"),
 										},
@@ -717,11 +748,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 						},
 						"sg-b": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.168.0.0/16"),
 										},
@@ -733,11 +764,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-2"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.168.0.0/16"),
 										},
@@ -745,11 +776,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.170.0.0/16"),
 										},
@@ -759,11 +790,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 						},
 						"sg-c": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.168.0.0/16"),
 										},
@@ -777,11 +808,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.168.0.0/16"),
 								},
@@ -789,11 +820,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 						},
 					},
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.169.0.0/16"),
 								},
@@ -801,11 +832,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 						},
 					},
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.170.0.0/16"),
 								},
@@ -815,11 +846,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 				},
 				"sg-b": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.168.0.0/16"),
 								},
@@ -829,11 +860,11 @@ func Test_defaultNetworkingManager_computeUnrestrictedIngressPermissionsPerSG(t
 				},
 				"sg-c": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.168.0.0/16"),
 								},
@@ -878,11 +909,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
 									FromPort:   nil,
 									ToPort:     nil,
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
@@ -894,11 +925,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(0),
-							ToPort:     awssdk.Int64(65535),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(0),
+							ToPort:     awssdk.Int32(65535),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-1")},
 							},
 						},
@@ -914,11 +945,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
 									FromPort:   nil,
 									ToPort:     nil,
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
@@ -928,11 +959,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-2"}: {
 						"sg-b": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
 									FromPort:   nil,
 									ToPort:     nil,
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-2")},
 									},
 								},
@@ -944,11 +975,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(0),
-							ToPort:     awssdk.Int64(65535),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(0),
+							ToPort:     awssdk.Int32(65535),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-1")},
 							},
 						},
@@ -957,11 +988,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 				},
 				"sg-b": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(0),
-							ToPort:     awssdk.Int64(65535),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(0),
+							ToPort:     awssdk.Int32(65535),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-2")},
 							},
 						},
@@ -977,11 +1008,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(0),
-									ToPort:     awssdk.Int64(65535),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(0),
+									ToPort:     awssdk.Int32(65535),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
@@ -993,11 +1024,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(0),
-							ToPort:     awssdk.Int64(65535),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(0),
+							ToPort:     awssdk.Int32(65535),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-1")},
 							},
 						},
@@ -1013,11 +1044,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(0),
-									ToPort:     awssdk.Int64(65535),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(0),
+									ToPort:     awssdk.Int32(65535),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
@@ -1027,11 +1058,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-2"}: {
 						"sg-b": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(0),
-									ToPort:     awssdk.Int64(65535),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(0),
+									ToPort:     awssdk.Int32(65535),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-2")},
 									},
 								},
@@ -1043,11 +1074,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(0),
-							ToPort:     awssdk.Int64(65535),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(0),
+							ToPort:     awssdk.Int32(65535),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-1")},
 							},
 						},
@@ -1056,11 +1087,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 				},
 				"sg-b": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(0),
-							ToPort:     awssdk.Int64(65535),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(0),
+							ToPort:     awssdk.Int32(65535),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-2")},
 							},
 						},
@@ -1076,21 +1107,21 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(30),
-									ToPort:     awssdk.Int64(8080),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(30),
+									ToPort:     awssdk.Int32(8080),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
@@ -1102,11 +1133,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(30),
-							ToPort:     awssdk.Int64(8080),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(30),
+							ToPort:     awssdk.Int32(8080),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-1")},
 							},
 						},
@@ -1122,21 +1153,21 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(30),
-									ToPort:     awssdk.Int64(8080),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(30),
+									ToPort:     awssdk.Int32(8080),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
@@ -1144,21 +1175,21 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 						},
 						"sg-b": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("udp"),
-									FromPort:   awssdk.Int64(8443),
-									ToPort:     awssdk.Int64(8443),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(8443),
+									ToPort:     awssdk.Int32(8443),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-2")},
 									},
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("udp"),
-									FromPort:   awssdk.Int64(8080),
-									ToPort:     awssdk.Int64(8080),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(8080),
+									ToPort:     awssdk.Int32(8080),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-2")},
 									},
 								},
@@ -1170,11 +1201,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(30),
-							ToPort:     awssdk.Int64(8080),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(30),
+							ToPort:     awssdk.Int32(8080),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-1")},
 							},
 						},
@@ -1183,11 +1214,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 				},
 				"sg-b": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("udp"),
-							FromPort:   awssdk.Int64(8080),
-							ToPort:     awssdk.Int64(8443),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(8080),
+							ToPort:     awssdk.Int32(8443),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-2")},
 							},
 						},
@@ -1202,11 +1233,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(80),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(80),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.168.0.0/16"),
 										},
@@ -1214,11 +1245,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(8080),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(8080),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.169.0.0/16"),
 										},
@@ -1226,11 +1257,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(8443),
-									ToPort:     awssdk.Int64(8443),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(8443),
+									ToPort:     awssdk.Int32(8443),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.170.0.0/16"),
 										},
@@ -1244,11 +1275,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(80),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(80),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.168.0.0/16"),
 								},
@@ -1256,11 +1287,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 						},
 					},
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(8080),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(8080),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.169.0.0/16"),
 								},
@@ -1268,11 +1299,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 						},
 					},
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(8443),
-							ToPort:     awssdk.Int64(8443),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(8443),
+							ToPort:     awssdk.Int32(8443),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.170.0.0/16"),
 								},
@@ -1289,11 +1320,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 					types.NamespacedName{Namespace: "ns-1", Name: "tgb-1"}: {
 						"sg-a": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(80),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(80),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.168.0.0/16"),
 										},
@@ -1301,11 +1332,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(8080),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(8080),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.169.0.0/16"),
 										},
@@ -1313,11 +1344,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(80),
-									ToPort:     awssdk.Int64(8080),
-									IpRanges: []*ec2sdk.IpRange{
+									FromPort:   awssdk.Int32(80),
+									ToPort:     awssdk.Int32(8080),
+									IpRanges: []ec2types.IpRange{
 										{
 											CidrIp: awssdk.String("192.170.0.0/16"),
 										},
@@ -1327,21 +1358,21 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 						},
 						"sg-b": {
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(8443),
-									ToPort:     awssdk.Int64(9090),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(8443),
+									ToPort:     awssdk.Int32(9090),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
 							},
 							{
-								Permission: ec2sdk.IpPermission{
+								Permission: ec2types.IpPermission{
 									IpProtocol: awssdk.String("tcp"),
-									FromPort:   awssdk.Int64(8443),
-									ToPort:     awssdk.Int64(32768),
-									UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+									FromPort:   awssdk.Int32(8443),
+									ToPort:     awssdk.Int32(32768),
+									UserIdGroupPairs: []ec2types.UserIdGroupPair{
 										{GroupId: awssdk.String("group-1")},
 									},
 								},
@@ -1353,11 +1384,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 			want: map[string][]networking.IPPermissionInfo{
 				"sg-a": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(80),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(80),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.168.0.0/16"),
 								},
@@ -1365,11 +1396,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 						},
 					},
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(8080),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(8080),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.169.0.0/16"),
 								},
@@ -1377,11 +1408,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 						},
 					},
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(80),
-							ToPort:     awssdk.Int64(8080),
-							IpRanges: []*ec2sdk.IpRange{
+							FromPort:   awssdk.Int32(80),
+							ToPort:     awssdk.Int32(8080),
+							IpRanges: []ec2types.IpRange{
 								{
 									CidrIp: awssdk.String("192.170.0.0/16"),
 								},
@@ -1391,11 +1422,11 @@ func Test_defaultNetworkingManager_computeRestrictedIngressPermissionsPerSG(t *t
 				},
 				"sg-b": {
 					{
-						Permission: ec2sdk.IpPermission{
+						Permission: ec2types.IpPermission{
 							IpProtocol: awssdk.String("tcp"),
-							FromPort:   awssdk.Int64(8443),
-							ToPort:     awssdk.Int64(32768),
-							UserIdGroupPairs: []*ec2sdk.UserIdGroupPair{
+							FromPort:   awssdk.Int32(8443),
+							ToPort:     awssdk.Int32(32768),
+							UserIdGroupPairs: []ec2types.UserIdGroupPair{
 								{GroupId: awssdk.String("group-1")},
 							},
 						},
@@ -0,0 +1,84 @@
+package targetgroupbinding
+
+import (
+	"hash/fnv"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+)
+
+// selectNodePortEndpoints returns the endpoints of nodes to register as targets, limited by MaxTargetNodes of tgb.
+// Nodes are ranked by rendezvous hashing of TargetGroup ARN and instance ID, so that the choice is stable as nodes join and leave,
+// and differs between TargetGroups, spreading the targets over the nodes of the cluster.
+// Nodes are taken round-robin across availability zones, so that every zone keeps targets as long as the limit allows.
+func selectNodePortEndpoints(tgb *elbv2api.TargetGroupBinding, endpoints []backend.NodePortEndpoint) ([]backend.NodePortEndpoint, error) {
+	if tgb.Spec.MaxTargetNodes == nil {
+		return endpoints, nil
+	}
+	limit, err := intstr.GetScaledValueFromIntOrPercent(tgb.Spec.MaxTargetNodes, len(endpoints), true)
+	if err != nil {
+		return nil, err
+	}
+	if limit >= len(endpoints) {
+		return endpoints, nil
+	}
+
+	type rankedEndpoint struct {
+		endpoint backend.NodePortEndpoint
+		score    uint64
+		// rank of the endpoint within its zone.
+		zoneRank int
+	}
+	endpointsByZone := make(map[string][]rankedEndpoint)
+	for _, endpoint := range endpoints {
+		zone := nodeZone(endpoint.Node)
+		endpointsByZone[zone] = append(endpointsByZone[zone], rankedEndpoint{
+			endpoint: endpoint,
+			score:    rendezvousScore(tgb.Spec.TargetGroupARN, endpoint.InstanceID),
+		})
+	}
+	var rankedEndpoints []rankedEndpoint
+	for _, zoneEndpoints := range endpointsByZone {
+		sort.Slice(zoneEndpoints, func(i, j int) bool {
+			return zoneEndpoints[i].score < zoneEndpoints[j].score
+		})
+		for i := range zoneEndpoints {
+			zoneEndpoints[i].zoneRank = i
+			rankedEndpoints = append(rankedEndpoints, zoneEndpoints[i])
+		}
+	}
+	sort.Slice(rankedEndpoints, func(i, j int) bool {
+		if rankedEndpoints[i].zoneRank != rankedEndpoints[j].zoneRank {
+			return rankedEndpoints[i].zoneRank < rankedEndpoints[j].zoneRank
+		}
+		return rankedEndpoints[i].score < rankedEndpoints[j].score
+	})
+
+	selectedEndpoints := make([]backend.NodePortEndpoint, 0, limit)
+	for _, rankedEndpoint := range rankedEndpoints[:limit] {
+		selectedEndpoints = append(selectedEndpoints, rankedEndpoint.endpoint)
+	}
+	return selectedEndpoints, nil
+}
+
+// nodeZone returns the availability zone of node, or empty if unknown.
+func nodeZone(node *corev1.Node) string {
+	if node == nil {
+		return ""
+	}
+	if zone, ok := node.Labels[corev1.LabelTopologyZone]; ok {
+		return zone
+	}
+	return node.Labels[corev1.LabelFailureDomainBetaZone]
+}
+
+func rendezvousScore(targetGroupARN string, instanceID string) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(targetGroupARN))
+	_, _ = hash.Write([]byte{0})
+	_, _ = hash.Write([]byte(instanceID))
+	return hash.Sum64()
+}
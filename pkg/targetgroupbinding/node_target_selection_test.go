@@ -0,0 +1,101 @@
+package targetgroupbinding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+)
+
+func Test_selectNodePortEndpoints(t *testing.T) {
+	buildEndpoints := func(zones ...string) []backend.NodePortEndpoint {
+		var endpoints []backend.NodePortEndpoint
+		for i, zone := range zones {
+			endpoints = append(endpoints, backend.NodePortEndpoint{
+				InstanceID: fmt.Sprintf("i-%05d", i),
+				Port:       30080,
+				Node: &corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   fmt.Sprintf("node-%d", i),
+						Labels: map[string]string{corev1.LabelTopologyZone: zone},
+					},
+				},
+			})
+		}
+		return endpoints
+	}
+	countByZone := func(endpoints []backend.NodePortEndpoint) map[string]int {
+		counts := make(map[string]int)
+		for _, endpoint := range endpoints {
+			counts[nodeZone(endpoint.Node)]++
+		}
+		return counts
+	}
+	buildTGB := func(targetGroupARN string, maxTargetNodes *intstr.IntOrString) *elbv2api.TargetGroupBinding {
+		return &elbv2api.TargetGroupBinding{
+			Spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupARN: targetGroupARN,
+				MaxTargetNodes: maxTargetNodes,
+			},
+		}
+	}
+	var zones []string
+	for i := 0; i < 30; i++ {
+		zones = append(zones, []string{"us-west-2a", "us-west-2b", "us-west-2c"}[i%3])
+	}
+	endpoints := buildEndpoints(zones...)
+
+	t.Run("all endpoints without maxTargetNodes", func(t *testing.T) {
+		got, err := selectNodePortEndpoints(buildTGB("tg-1", nil), endpoints)
+		assert.NoError(t, err)
+		assert.Equal(t, endpoints, got)
+	})
+	t.Run("all endpoints if maxTargetNodes exceeds endpoints", func(t *testing.T) {
+		got, err := selectNodePortEndpoints(buildTGB("tg-1", &intstr.IntOrString{Type: intstr.Int, IntVal: 50}), endpoints)
+		assert.NoError(t, err)
+		assert.Equal(t, endpoints, got)
+	})
+	t.Run("limited number of endpoints spread across zones", func(t *testing.T) {
+		got, err := selectNodePortEndpoints(buildTGB("tg-1", &intstr.IntOrString{Type: intstr.Int, IntVal: 6}), endpoints)
+		assert.NoError(t, err)
+		assert.Len(t, got, 6)
+		assert.Equal(t, map[string]int{"us-west-2a": 2, "us-west-2b": 2, "us-west-2c": 2}, countByZone(got))
+	})
+	t.Run("percentage of endpoints rounded up", func(t *testing.T) {
+		got, err := selectNodePortEndpoints(buildTGB("tg-1", &intstr.IntOrString{Type: intstr.String, StrVal: "25%"}), endpoints)
+		assert.NoError(t, err)
+		assert.Len(t, got, 8)
+	})
+	t.Run("selection is deterministic and stable as other nodes leave", func(t *testing.T) {
+		tgb := buildTGB("tg-1", &intstr.IntOrString{Type: intstr.Int, IntVal: 6})
+		got, err := selectNodePortEndpoints(tgb, endpoints)
+		assert.NoError(t, err)
+		selected := sets.NewString()
+		for _, endpoint := range got {
+			selected.Insert(endpoint.InstanceID)
+		}
+		var remaining []backend.NodePortEndpoint
+		for _, endpoint := range endpoints {
+			if selected.Has(endpoint.InstanceID) || len(remaining) < 15 {
+				remaining = append(remaining, endpoint)
+			}
+		}
+		gotAgain, err := selectNodePortEndpoints(tgb, remaining)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, got, gotAgain)
+	})
+	t.Run("selection differs between target groups", func(t *testing.T) {
+		maxTargetNodes := &intstr.IntOrString{Type: intstr.Int, IntVal: 6}
+		got1, err := selectNodePortEndpoints(buildTGB("tg-1", maxTargetNodes), endpoints)
+		assert.NoError(t, err)
+		got2, err := selectNodePortEndpoints(buildTGB("tg-2", maxTargetNodes), endpoints)
+		assert.NoError(t, err)
+		assert.NotEqual(t, got1, got2)
+	})
+}
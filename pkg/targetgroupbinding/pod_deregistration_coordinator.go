@@ -0,0 +1,217 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultPodDeregistrationPollInterval = 1 * time.Second
+)
+
+// PodDeregistrationCoordinator coordinates the deletion of pods with the deregistration of their targets.
+type PodDeregistrationCoordinator interface {
+	// Coordinate deregisters the targets of pod that is being deleted, and waits until they're draining.
+	// the wait is bounded by the largest podDeregistrationWaitSeconds of TargetGroupBindings with the pod as backend.
+	Coordinate(ctx context.Context, pod *corev1.Pod) error
+}
+
+// NewDefaultPodDeregistrationCoordinator constructs new defaultPodDeregistrationCoordinator.
+func NewDefaultPodDeregistrationCoordinator(k8sClient client.Client, cloud aws.Cloud, logger logr.Logger) *defaultPodDeregistrationCoordinator {
+	return &defaultPodDeregistrationCoordinator{
+		k8sClient:    k8sClient,
+		cloud:        cloud,
+		elbv2Client:  cloud.ELBV2(),
+		pollInterval: defaultPodDeregistrationPollInterval,
+		logger:       logger,
+	}
+}
+
+var _ PodDeregistrationCoordinator = &defaultPodDeregistrationCoordinator{}
+
+// default implementation for PodDeregistrationCoordinator.
+type defaultPodDeregistrationCoordinator struct {
+	k8sClient    client.Client
+	cloud        aws.Cloud
+	elbv2Client  services.ELBV2
+	pollInterval time.Duration
+	logger       logr.Logger
+}
+
+func (c *defaultPodDeregistrationCoordinator) Coordinate(ctx context.Context, pod *corev1.Pod) error {
+	if pod.Status.PodIP == "" || pod.DeletionTimestamp != nil {
+		return nil
+	}
+	tgbs, err := c.findTargetGroupBindingsForPod(ctx, pod)
+	if err != nil {
+		return err
+	}
+	if len(tgbs) == 0 {
+		return nil
+	}
+	// the pod is marked before deregistering its targets, so that they won't be registered again by reconciles.
+	if err := c.markDeregistrationRequested(ctx, pod); err != nil {
+		return err
+	}
+
+	var waitSeconds int64
+	pendingTargetsByTGB := make(map[*elbv2api.TargetGroupBinding][]elbv2types.TargetDescription)
+	for _, tgb := range tgbs {
+		targets, err := c.deregisterPodTargets(ctx, tgb, buildPodIPs(pod))
+		if err != nil {
+			return err
+		}
+		if len(targets) == 0 {
+			continue
+		}
+		pendingTargetsByTGB[tgb] = targets
+		if awssdk.ToInt64(tgb.Spec.PodDeregistrationWaitSeconds) > waitSeconds {
+			waitSeconds = awssdk.ToInt64(tgb.Spec.PodDeregistrationWaitSeconds)
+		}
+	}
+	if len(pendingTargetsByTGB) == 0 {
+		return nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(waitSeconds)*time.Second)
+	defer cancel()
+	err = wait.PollImmediateUntilWithContext(waitCtx, c.pollInterval, func(ctx context.Context) (bool, error) {
+		for tgb, targets := range pendingTargetsByTGB {
+			drained, err := c.isTargetsDraining(ctx, tgb, targets)
+			if err != nil {
+				return false, err
+			}
+			if !drained {
+				return false, nil
+			}
+			delete(pendingTargetsByTGB, tgb)
+		}
+		return true, nil
+	})
+	// the describe call in flight fails instead of ErrWaitTimeout when the wait times out.
+	if err != nil && waitCtx.Err() != nil {
+		c.logger.Info("timed out waiting for targets of pod to be draining",
+			"pod", k8s.NamespacedName(pod), "waitSeconds", waitSeconds)
+		return nil
+	}
+	return err
+}
+
+// findTargetGroupBindingsForPod finds the TargetGroupBindings with pod deregistration coordination, whose Service selects the pod.
+func (c *defaultPodDeregistrationCoordinator) findTargetGroupBindingsForPod(ctx context.Context, pod *corev1.Pod) ([]*elbv2api.TargetGroupBinding, error) {
+	tgbList := &elbv2api.TargetGroupBindingList{}
+	if err := c.k8sClient.List(ctx, tgbList, client.InNamespace(pod.Namespace)); err != nil {
+		return nil, err
+	}
+	var matchedTGBs []*elbv2api.TargetGroupBinding
+	for i := range tgbList.Items {
+		tgb := &tgbList.Items[i]
+		if tgb.Spec.PodDeregistrationWaitSeconds == nil || tgb.Spec.TargetType == nil || *tgb.Spec.TargetType != elbv2api.TargetTypeIP {
+			continue
+		}
+		svc := &corev1.Service{}
+		svcKey := types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.ServiceRef.Name}
+		if err := c.k8sClient.Get(ctx, svcKey, svc); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if len(svc.Spec.Selector) == 0 || !labels.SelectorFromSet(svc.Spec.Selector).Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		matchedTGBs = append(matchedTGBs, tgb)
+	}
+	return matchedTGBs, nil
+}
+
+// markDeregistrationRequested annotates the pod with the time its deregistration is requested.
+func (c *defaultPodDeregistrationCoordinator) markDeregistrationRequested(ctx context.Context, pod *corev1.Pod) error {
+	oldPod := pod.DeepCopy()
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	pod.Annotations[k8s.AnnotationKeyPodDeregistrationRequestedAt] = time.Now().UTC().Format(time.RFC3339)
+	if err := c.k8sClient.Patch(ctx, pod, client.MergeFrom(oldPod)); err != nil {
+		return errors.Wrapf(err, "failed to mark deregistration requested for pod %v", k8s.NamespacedName(pod))
+	}
+	return nil
+}
+
+// deregisterPodTargets deregisters the targets of podIPs from the TargetGroup of tgb, and returns the deregistered targets.
+func (c *defaultPodDeregistrationCoordinator) deregisterPodTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, podIPs sets.String) ([]elbv2types.TargetDescription, error) {
+	elbv2Client := aws.ELBV2ForRole(c.cloud, c.elbv2Client, tgb.Spec.AWSRoleARN)
+	resp, err := elbv2Client.DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: awssdk.String(tgb.Spec.TargetGroupARN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var podTargets []elbv2types.TargetDescription
+	for _, thd := range resp.TargetHealthDescriptions {
+		if podIPs.Has(awssdk.ToString(thd.Target.Id)) {
+			podTargets = append(podTargets, *thd.Target)
+		}
+	}
+	if len(podTargets) == 0 {
+		return nil, nil
+	}
+	c.logger.Info("deRegistering targets of pod being deleted",
+		"arn", tgb.Spec.TargetGroupARN,
+		"podIPs", podIPs.List())
+	if _, err := elbv2Client.DeregisterTargetsWithContext(ctx, &elbv2sdk.DeregisterTargetsInput{
+		TargetGroupArn: awssdk.String(tgb.Spec.TargetGroupARN),
+		Targets:        podTargets,
+	}); err != nil {
+		return nil, err
+	}
+	c.logger.Info("deRegistered targets of pod being deleted",
+		"arn", tgb.Spec.TargetGroupARN,
+		"podIPs", podIPs.List())
+	return podTargets, nil
+}
+
+// isTargetsDraining checks whether the targets are draining or no longer registered in the TargetGroup of tgb.
+func (c *defaultPodDeregistrationCoordinator) isTargetsDraining(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []elbv2types.TargetDescription) (bool, error) {
+	resp, err := aws.ELBV2ForRole(c.cloud, c.elbv2Client, tgb.Spec.AWSRoleARN).DescribeTargetHealthWithContext(ctx, &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: awssdk.String(tgb.Spec.TargetGroupARN),
+		Targets:        targets,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, thd := range resp.TargetHealthDescriptions {
+		switch thd.TargetHealth.State {
+		case elbv2types.TargetHealthStateEnumDraining, elbv2types.TargetHealthStateEnumUnused:
+		default:
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// buildPodIPs returns the IPs of pod of each IP family, as targets can be registered with either of them.
+func buildPodIPs(pod *corev1.Pod) sets.String {
+	podIPs := sets.NewString(pod.Status.PodIP)
+	for _, podIP := range pod.Status.PodIPs {
+		podIPs.Insert(podIP.IP)
+	}
+	return podIPs
+}
@@ -0,0 +1,180 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultPodDeregistrationCoordinator_Coordinate(t *testing.T) {
+	ipTargetType := elbv2api.TargetTypeIP
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "svc-1",
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "web"},
+		},
+	}
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "tgb-1",
+		},
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: "tg-1",
+			TargetType:     &ipTargetType,
+			ServiceRef: elbv2api.ServiceReference{
+				Name: "svc-1",
+				Port: intstr.FromInt(80),
+			},
+			PodDeregistrationWaitSeconds: awssdk.Int64(1),
+		},
+	}
+	tgbWithoutWait := tgb.DeepCopy()
+	tgbWithoutWait.Spec.PodDeregistrationWaitSeconds = nil
+	webPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "pod-1",
+			Labels:    map[string]string{"app": "web"},
+		},
+		Status: corev1.PodStatus{
+			PodIP: "192.168.1.1",
+		},
+	}
+	otherPod := webPod.DeepCopy()
+	otherPod.Labels = map[string]string{"app": "other"}
+	podTarget := &elbv2types.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int32(8080)}
+	otherTarget := &elbv2types.TargetDescription{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int32(8080)}
+
+	tests := []struct {
+		name              string
+		tgb               *elbv2api.TargetGroupBinding
+		pod               *corev1.Pod
+		registeredTargets []elbv2types.TargetDescription
+		wantDeregister    bool
+		targetStates      []elbv2types.TargetHealthStateEnum
+		wantMarked        bool
+	}{
+		{
+			name:              "targets of pod are deregistered and draining",
+			tgb:               tgb,
+			pod:               webPod,
+			registeredTargets: []elbv2types.TargetDescription{*podTarget, *otherTarget},
+			wantDeregister:    true,
+			targetStates:      []elbv2types.TargetHealthStateEnum{elbv2types.TargetHealthStateEnumHealthy, elbv2types.TargetHealthStateEnumDraining},
+			wantMarked:        true,
+		},
+		{
+			name:              "targets of pod are deregistered but not draining before timeout",
+			tgb:               tgb,
+			pod:               webPod,
+			registeredTargets: []elbv2types.TargetDescription{*podTarget},
+			wantDeregister:    true,
+			targetStates:      []elbv2types.TargetHealthStateEnum{elbv2types.TargetHealthStateEnumHealthy},
+			wantMarked:        true,
+		},
+		{
+			name:              "pod has no registered targets",
+			tgb:               tgb,
+			pod:               webPod,
+			registeredTargets: []elbv2types.TargetDescription{*otherTarget},
+			wantMarked:        true,
+		},
+		{
+			name: "pod isn't selected by service",
+			tgb:  tgb,
+			pod:  otherPod,
+		},
+		{
+			name: "targetGroupBinding doesn't coordinate pod deregistration",
+			tgb:  tgbWithoutWait,
+			pod:  webPod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).Build()
+			ctx := context.Background()
+			assert.NoError(t, k8sClient.Create(ctx, svc.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, tt.tgb.DeepCopy()))
+			assert.NoError(t, k8sClient.Create(ctx, tt.pod.DeepCopy()))
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			if tt.registeredTargets != nil {
+				var thds []elbv2types.TargetHealthDescription
+				for i := range tt.registeredTargets {
+					thds = append(thds, elbv2types.TargetHealthDescription{
+						Target:       &tt.registeredTargets[i],
+						TargetHealth: &elbv2types.TargetHealth{State: elbv2types.TargetHealthStateEnumHealthy},
+					})
+				}
+				elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), &elbv2sdk.DescribeTargetHealthInput{
+					TargetGroupArn: awssdk.String("tg-1"),
+				}).Return(&elbv2sdk.DescribeTargetHealthOutput{TargetHealthDescriptions: thds}, nil)
+			}
+			if tt.wantDeregister {
+				elbv2Client.EXPECT().DeregisterTargetsWithContext(gomock.Any(), &elbv2sdk.DeregisterTargetsInput{
+					TargetGroupArn: awssdk.String("tg-1"),
+					Targets:        []elbv2types.TargetDescription{*podTarget},
+				}).Return(&elbv2sdk.DeregisterTargetsOutput{}, nil)
+				stateIdx := 0
+				elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), &elbv2sdk.DescribeTargetHealthInput{
+					TargetGroupArn: awssdk.String("tg-1"),
+					Targets:        []elbv2types.TargetDescription{*podTarget},
+				}).DoAndReturn(func(ctx context.Context, req *elbv2sdk.DescribeTargetHealthInput) (*elbv2sdk.DescribeTargetHealthOutput, error) {
+					state := tt.targetStates[stateIdx]
+					if stateIdx < len(tt.targetStates)-1 {
+						stateIdx++
+					}
+					return &elbv2sdk.DescribeTargetHealthOutput{
+						TargetHealthDescriptions: []elbv2types.TargetHealthDescription{
+							{
+								Target:       podTarget,
+								TargetHealth: &elbv2types.TargetHealth{State: state},
+							},
+						},
+					}, nil
+				}).MinTimes(len(tt.targetStates))
+			}
+
+			c := &defaultPodDeregistrationCoordinator{
+				k8sClient:    k8sClient,
+				elbv2Client:  elbv2Client,
+				pollInterval: 10 * time.Millisecond,
+				logger:       log.Log,
+			}
+			err := c.Coordinate(ctx, tt.pod.DeepCopy())
+			assert.NoError(t, err)
+
+			pod := &corev1.Pod{}
+			assert.NoError(t, k8sClient.Get(ctx, k8s.NamespacedName(tt.pod), pod))
+			_, marked := pod.Annotations[k8s.AnnotationKeyPodDeregistrationRequestedAt]
+			assert.Equal(t, tt.wantMarked, marked)
+		})
+	}
+}
@@ -4,20 +4,24 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"sync"
 	"time"
 
 	"k8s.io/client-go/tools/record"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/smithy-go"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
@@ -26,8 +30,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const defaultTargetHealthRequeueDuration = 15 * time.Second
-
 // ResourceManager manages the TargetGroupBinding resource.
 type ResourceManager interface {
 	Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error
@@ -35,56 +37,91 @@ type ResourceManager interface {
 }
 
 // NewDefaultResourceManager constructs new defaultResourceManager.
-func NewDefaultResourceManager(k8sClient client.Client, elbv2Client services.ELBV2, ec2Client services.EC2,
+func NewDefaultResourceManager(k8sClient client.Client, apiReader client.Reader, cloud aws.Cloud,
 	podInfoRepo k8s.PodInfoRepo, sgManager networking.SecurityGroupManager, sgReconciler networking.SecurityGroupReconciler,
-	vpcInfoProvider networking.VPCInfoProvider,
+	vpcInfoProvider networking.VPCInfoProvider, nodeInfoProvider networking.NodeInfoProvider,
 	vpcID string, clusterName string, failOpenEnabled bool, endpointSliceEnabled bool, disabledRestrictedSGRulesFlag bool,
-	endpointSGTags map[string]string,
+	endpointSGTags map[string]string, azAdvisor AZAdvisor,
+	targetsBatchWindow time.Duration, targetsBatchMaxConcurrency int, targetsBatchMetrics *TargetsBatchMetrics,
+	targetHealthPollInterval time.Duration, targetHealthStreamer *TargetHealthStreamer,
 	eventRecorder record.EventRecorder, logger logr.Logger) *defaultResourceManager {
-	targetsManager := NewCachedTargetsManager(elbv2Client, logger)
+	ec2Client := cloud.EC2()
 	endpointResolver := backend.NewDefaultEndpointResolver(k8sClient, podInfoRepo, failOpenEnabled, endpointSliceEnabled, logger)
 
-	nodeInfoProvider := networking.NewDefaultNodeInfoProvider(ec2Client, logger)
-	podENIResolver := networking.NewDefaultPodENIInfoResolver(k8sClient, ec2Client, nodeInfoProvider, vpcID, logger)
+	multiClusterManager := NewDefaultMultiClusterManager(k8sClient, apiReader, logger)
+
+	// pods' secondary IPs change frequently, thus podENIResolver always describes the instances of nodes.
+	podENIResolver := networking.NewDefaultPodENIInfoResolver(k8sClient, ec2Client, networking.NewDefaultNodeInfoProvider(ec2Client, logger), vpcID, logger)
 	nodeENIResolver := networking.NewDefaultNodeENIInfoResolver(nodeInfoProvider, logger)
 
 	networkingManager := NewDefaultNetworkingManager(k8sClient, podENIResolver, nodeENIResolver, sgManager, sgReconciler, vpcID, clusterName, endpointSGTags, logger, disabledRestrictedSGRulesFlag)
-	return &defaultResourceManager{
-		k8sClient:         k8sClient,
-		targetsManager:    targetsManager,
-		endpointResolver:  endpointResolver,
-		networkingManager: networkingManager,
-		eventRecorder:     eventRecorder,
-		logger:            logger,
-		vpcID:             vpcID,
-		vpcInfoProvider:   vpcInfoProvider,
-		podInfoRepo:       podInfoRepo,
-
-		targetHealthRequeueDuration: defaultTargetHealthRequeueDuration,
-	}
+	m := &defaultResourceManager{
+		k8sClient:                  k8sClient,
+		cloud:                      cloud,
+		assumedRoleTargetsManagers: make(map[string]TargetsManager),
+		endpointResolver:           endpointResolver,
+		networkingManager:          networkingManager,
+		multiClusterManager:        multiClusterManager,
+		tgConfigManager:            NewDefaultTargetGroupConfigManager(logger),
+		azAdvisor:                  azAdvisor,
+		eventRecorder:              eventRecorder,
+		logger:                     logger,
+		vpcID:                      vpcID,
+		vpcInfoProvider:            vpcInfoProvider,
+		podInfoRepo:                podInfoRepo,
+
+		targetsBatchWindow:         targetsBatchWindow,
+		targetsBatchMaxConcurrency: targetsBatchMaxConcurrency,
+		targetsBatchMetrics:        targetsBatchMetrics,
+
+		targetHealthRequeueDuration: targetHealthPollInterval,
+		targetHealthStreamer:        targetHealthStreamer,
+	}
+	m.targetsManager = m.newTargetsManager(cloud.ELBV2())
+	return m
 }
 
 var _ ResourceManager = &defaultResourceManager{}
 
 // default implementation for ResourceManager.
 type defaultResourceManager struct {
-	k8sClient         client.Client
-	targetsManager    TargetsManager
-	endpointResolver  backend.EndpointResolver
-	networkingManager NetworkingManager
-	eventRecorder     record.EventRecorder
-	logger            logr.Logger
-	vpcInfoProvider   networking.VPCInfoProvider
-	podInfoRepo       k8s.PodInfoRepo
-	vpcID             string
-
+	k8sClient      client.Client
+	cloud          aws.Cloud
+	targetsManager TargetsManager
+	// assumedRoleTargetsManagers caches the TargetsManager per assumed IAM role ARN.
+	assumedRoleTargetsManagers      map[string]TargetsManager
+	assumedRoleTargetsManagersMutex sync.Mutex
+	endpointResolver                backend.EndpointResolver
+	networkingManager               NetworkingManager
+	multiClusterManager             MultiClusterManager
+	tgConfigManager                 TargetGroupConfigManager
+	// azAdvisor is optional, and is nil when availability zone target distribution advisory is disabled.
+	azAdvisor       AZAdvisor
+	eventRecorder   record.EventRecorder
+	logger          logr.Logger
+	vpcInfoProvider networking.VPCInfoProvider
+	podInfoRepo     k8s.PodInfoRepo
+	vpcID           string
+
+	// targets registrations and deregistrations are batched per TargetGroup when targetsBatchWindow is positive.
+	targetsBatchWindow         time.Duration
+	targetsBatchMaxConcurrency int
+	targetsBatchMetrics        *TargetsBatchMetrics
+
+	// targetHealthRequeueDuration is the interval to poll targets health for pods with readiness gate.
 	targetHealthRequeueDuration time.Duration
+	// targetHealthStreamer is optional, and is nil when target health streaming is disabled.
+	// if enabled, the targetHealth condition is updated for pods without readiness gate as well.
+	targetHealthStreamer *TargetHealthStreamer
 }
 
 func (m *defaultResourceManager) Reconcile(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	if tgb.Spec.TargetType == nil {
 		return errors.Errorf("targetType is not specified: %v", k8s.NamespacedName(tgb).String())
 	}
+	if err := m.tgConfigManager.Reconcile(ctx, aws.ELBV2ForRole(m.cloud, m.cloud.ELBV2(), tgb.Spec.AWSRoleARN), tgb); err != nil {
+		return err
+	}
 	if *tgb.Spec.TargetType == elbv2api.TargetTypeIP {
 		return m.reconcileWithIPTargetType(ctx, tgb)
 	}
@@ -92,15 +129,26 @@ func (m *defaultResourceManager) Reconcile(ctx context.Context, tgb *elbv2api.Ta
 }
 
 func (m *defaultResourceManager) Cleanup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	if err := m.cleanupTargets(ctx, tgb); err != nil {
-		return err
+	// with Retain deletion policy, the targets and networking rules are left intact, so that the TargetGroup keeps serving.
+	if tgb.Spec.DeletionPolicy == nil || *tgb.Spec.DeletionPolicy != elbv2api.DeletionPolicyRetain {
+		if err := m.cleanupTargets(ctx, tgb); err != nil {
+			return err
+		}
+		if err := m.networkingManager.Cleanup(ctx, tgb); err != nil {
+			return err
+		}
 	}
-	if err := m.networkingManager.Cleanup(ctx, tgb); err != nil {
+	if err := m.updatePodAsHealthyForDeletedTGB(ctx, tgb); err != nil {
 		return err
 	}
-	if err := m.updatePodAsHealthyForDeletedTGB(ctx, tgb); err != nil {
+	if err := m.multiClusterManager.Cleanup(ctx, tgb); err != nil {
 		return err
 	}
+	m.tgConfigManager.Reset(tgb)
+	m.targetHealthStreamer.Reset(tgb)
+	if m.azAdvisor != nil {
+		m.azAdvisor.Reset(tgb)
+	}
 	return nil
 }
 
@@ -111,6 +159,20 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 	resolveOpts := []backend.EndpointResolveOption{
 		backend.WithPodReadinessGate(targetHealthCondType),
 	}
+	// with connection termination, targets of terminating pods are deregistered right away instead of being kept registered while serving,
+	// so that the deregistration delay elapses and their connections are terminated before the pods exit.
+	if !tgb.Spec.ConnectionTermination {
+		resolveOpts = append(resolveOpts, backend.WithTerminatingEndpoints())
+	}
+	// only the endpoints of the TargetGroup's IP address type can be registered, e.g. the IPv6 endpoints in IPv6-only clusters.
+	// with targetIPFamilyPolicy, the pod IPs of the family are registered instead, regardless of the address type of the endpoints.
+	if tgb.Spec.IPAddressType != nil && tgb.Spec.TargetIPFamilyPolicy == nil {
+		if *tgb.Spec.IPAddressType == elbv2api.TargetGroupIPAddressTypeIPv6 {
+			resolveOpts = append(resolveOpts, backend.WithAddressType(discovery.AddressTypeIPv6))
+		} else {
+			resolveOpts = append(resolveOpts, backend.WithAddressType(discovery.AddressTypeIPv4))
+		}
+	}
 
 	var endpoints []backend.PodEndpoint
 	var containsPotentialReadyEndpoints bool
@@ -125,35 +187,67 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 		}
 		return err
 	}
+	if tgb.Spec.TargetIPFamilyPolicy != nil {
+		endpoints = mapPodEndpointsToIPFamily(endpoints, *tgb.Spec.TargetIPFamilyPolicy)
+	}
 
-	tgARN := tgb.Spec.TargetGroupARN
-	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
+	targets, err := m.getTargetsManager(tgb).ListTargets(ctx, tgb.Spec.TargetGroupARN)
 	if err != nil {
 		return err
 	}
 	notDrainingTargets, drainingTargets := partitionTargetsByDrainingStatus(targets)
-	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchPodEndpointWithTargets(endpoints, notDrainingTargets)
+	activeEndpoints, terminatingEndpoints := partitionPodEndpointsByTerminatingStatus(endpoints)
+	matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets := matchPodEndpointWithTargets(activeEndpoints, notDrainingTargets)
+	// terminating endpoints are kept registered until they stop serving, but never registered anew.
+	retainedTargets, unmatchedTargets := matchTerminatingPodEndpointWithTargets(terminatingEndpoints, unmatchedTargets)
 
 	needNetworkingRequeue := false
 	if err := m.networkingManager.ReconcileForPodEndpoints(ctx, tgb, endpoints); err != nil {
 		m.eventRecorder.Event(tgb, corev1.EventTypeWarning, k8s.TargetGroupBindingEventReasonFailedNetworkReconcile, err.Error())
 		needNetworkingRequeue = true
 	}
+	unmatchedExternalTargets, unmatchedTargets := matchExternalTargetsWithTargets(tgb.Spec.ExternalTargets, notDrainingTargets, unmatchedTargets)
+	desiredTargetIDs := sets.NewString()
+	for _, endpoint := range activeEndpoints {
+		desiredTargetIDs.Insert(fmt.Sprintf("%v:%v", endpoint.IP, endpoint.Port))
+	}
+	for _, target := range retainedTargets {
+		desiredTargetIDs.Insert(UniqueIDForTargetDescription(target.Target))
+	}
+	for _, externalTarget := range tgb.Spec.ExternalTargets {
+		desiredTargetIDs.Insert(fmt.Sprintf("%v:%v", externalTarget.ID, externalTarget.Port))
+	}
+	unmatchedTargets, err = m.filterTargetsForDeregistration(ctx, tgb, desiredTargetIDs, unmatchedTargets)
+	if err != nil {
+		return err
+	}
 	if len(unmatchedTargets) > 0 {
-		if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
+		if err := m.deregisterTargets(ctx, tgb, unmatchedTargets); err != nil {
 			return err
 		}
 	}
 	if len(unmatchedEndpoints) > 0 {
-		if err := m.registerPodEndpoints(ctx, tgARN, unmatchedEndpoints); err != nil {
+		if err := m.registerPodEndpoints(ctx, tgb, unmatchedEndpoints); err != nil {
 			return err
 		}
 	}
+	if len(unmatchedExternalTargets) > 0 {
+		if err := m.registerExternalTargets(ctx, tgb, elbv2api.TargetTypeIP, unmatchedExternalTargets); err != nil {
+			return err
+		}
+	}
+	if err := m.updateTrackedTargets(ctx, tgb, desiredTargetIDs); err != nil {
+		return err
+	}
+	if m.azAdvisor != nil {
+		m.azAdvisor.AdviseForPodEndpoints(ctx, tgb, activeEndpoints)
+	}
 
 	anyPodNeedFurtherProbe, err := m.updateTargetHealthPodCondition(ctx, targetHealthCondType, matchedEndpointAndTargets, unmatchedEndpoints)
 	if err != nil {
 		return err
 	}
+	m.targetHealthStreamer.Observe(tgb, matchedEndpointAndTargets, unmatchedEndpoints)
 
 	if anyPodNeedFurtherProbe {
 		if containsTargetsInInitialState(matchedEndpointAndTargets) || len(unmatchedEndpoints) != 0 {
@@ -171,6 +265,11 @@ func (m *defaultResourceManager) reconcileWithIPTargetType(ctx context.Context,
 	if needNetworkingRequeue {
 		return runtime.NewRequeueNeeded("networking reconciliation")
 	}
+
+	// the target health is polled continuously while streamed, as healthy targets might become unhealthy at any time.
+	if m.targetHealthStreamer != nil && len(matchedEndpointAndTargets) != 0 {
+		return runtime.NewRequeueNeededAfter("stream targetHealth", m.targetHealthRequeueDuration)
+	}
 	return nil
 }
 
@@ -190,8 +289,11 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 		}
 		return err
 	}
-	tgARN := tgb.Spec.TargetGroupARN
-	targets, err := m.targetsManager.ListTargets(ctx, tgARN)
+	endpoints, err = selectNodePortEndpoints(tgb, endpoints)
+	if err != nil {
+		return err
+	}
+	targets, err := m.getTargetsManager(tgb).ListTargets(ctx, tgb.Spec.TargetGroupARN)
 	if err != nil {
 		return err
 	}
@@ -201,22 +303,45 @@ func (m *defaultResourceManager) reconcileWithInstanceTargetType(ctx context.Con
 	if err := m.networkingManager.ReconcileForNodePortEndpoints(ctx, tgb, endpoints); err != nil {
 		return err
 	}
+	unmatchedExternalTargets, unmatchedTargets := matchExternalTargetsWithTargets(tgb.Spec.ExternalTargets, notDrainingTargets, unmatchedTargets)
+	desiredTargetIDs := sets.NewString()
+	for _, endpoint := range endpoints {
+		desiredTargetIDs.Insert(fmt.Sprintf("%v:%v", endpoint.InstanceID, endpoint.Port))
+	}
+	for _, externalTarget := range tgb.Spec.ExternalTargets {
+		desiredTargetIDs.Insert(fmt.Sprintf("%v:%v", externalTarget.ID, externalTarget.Port))
+	}
+	unmatchedTargets, err = m.filterTargetsForDeregistration(ctx, tgb, desiredTargetIDs, unmatchedTargets)
+	if err != nil {
+		return err
+	}
 	if len(unmatchedTargets) > 0 {
-		if err := m.deregisterTargets(ctx, tgARN, unmatchedTargets); err != nil {
+		if err := m.deregisterTargets(ctx, tgb, unmatchedTargets); err != nil {
 			return err
 		}
 	}
 	if len(unmatchedEndpoints) > 0 {
-		if err := m.registerNodePortEndpoints(ctx, tgARN, unmatchedEndpoints); err != nil {
+		if err := m.registerNodePortEndpoints(ctx, tgb, unmatchedEndpoints); err != nil {
 			return err
 		}
 	}
+	if len(unmatchedExternalTargets) > 0 {
+		if err := m.registerExternalTargets(ctx, tgb, elbv2api.TargetTypeInstance, unmatchedExternalTargets); err != nil {
+			return err
+		}
+	}
+	if err := m.updateTrackedTargets(ctx, tgb, desiredTargetIDs); err != nil {
+		return err
+	}
+	if m.azAdvisor != nil {
+		m.azAdvisor.AdviseForNodePortEndpoints(ctx, tgb, endpoints)
+	}
 	_ = drainingTargets
 	return nil
 }
 
 func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	targets, err := m.targetsManager.ListTargets(ctx, tgb.Spec.TargetGroupARN)
+	targets, err := m.getTargetsManager(tgb).ListTargets(ctx, tgb.Spec.TargetGroupARN)
 	if err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
 			return nil
@@ -225,7 +350,11 @@ func (m *defaultResourceManager) cleanupTargets(ctx context.Context, tgb *elbv2a
 		}
 		return err
 	}
-	if err := m.deregisterTargets(ctx, tgb.Spec.TargetGroupARN, targets); err != nil {
+	targets, err = m.filterTargetsForDeregistration(ctx, tgb, sets.NewString(), targets)
+	if err != nil {
+		return err
+	}
+	if err := m.deregisterTargets(ctx, tgb, targets); err != nil {
 		if isELBV2TargetGroupNotFoundError(err) {
 			return nil
 		} else if isELBV2TargetGroupARNInvalidError(err) {
@@ -256,9 +385,9 @@ func (m *defaultResourceManager) updateTargetHealthPodCondition(ctx context.Cont
 
 	for _, endpoint := range unmatchedEndpoints {
 		pod := endpoint.Pod
-		targetHealth := &elbv2sdk.TargetHealth{
-			State:       awssdk.String(elbv2sdk.TargetHealthStateEnumInitial),
-			Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress),
+		targetHealth := &elbv2types.TargetHealth{
+			State:       elbv2types.TargetHealthStateEnumInitial,
+			Reason:      elbv2types.TargetHealthReasonEnumRegistrationInProgress,
 			Description: awssdk.String("Target registration is in progress"),
 		}
 		needFurtherProbe, err := m.updateTargetHealthPodConditionForPod(ctx, pod, targetHealth, targetHealthCondType)
@@ -273,26 +402,29 @@ func (m *defaultResourceManager) updateTargetHealthPodCondition(ctx context.Cont
 }
 
 // updateTargetHealthPodConditionForPod updates pod's targetHealth condition for a single pod and its matched target.
+// pods without readiness gate are only updated when target health streaming is enabled.
 // returns whether further probe is needed or not.
 func (m *defaultResourceManager) updateTargetHealthPodConditionForPod(ctx context.Context, pod k8s.PodInfo,
-	targetHealth *elbv2sdk.TargetHealth, targetHealthCondType corev1.PodConditionType) (bool, error) {
-	if !pod.HasAnyOfReadinessGates([]corev1.PodConditionType{targetHealthCondType}) {
+	targetHealth *elbv2types.TargetHealth, targetHealthCondType corev1.PodConditionType) (bool, error) {
+	hasReadinessGate := pod.HasAnyOfReadinessGates([]corev1.PodConditionType{targetHealthCondType})
+	if !hasReadinessGate && m.targetHealthStreamer == nil {
 		return false, nil
 	}
 
 	targetHealthCondStatus := corev1.ConditionUnknown
 	var reason, message string
 	if targetHealth != nil {
-		if awssdk.StringValue(targetHealth.State) == elbv2sdk.TargetHealthStateEnumHealthy {
+		if targetHealth.State == elbv2types.TargetHealthStateEnumHealthy {
 			targetHealthCondStatus = corev1.ConditionTrue
 		} else {
 			targetHealthCondStatus = corev1.ConditionFalse
 		}
 
-		reason = awssdk.StringValue(targetHealth.Reason)
-		message = awssdk.StringValue(targetHealth.Description)
+		reason = string(targetHealth.Reason)
+		message = awssdk.ToString(targetHealth.Description)
 	}
-	needFurtherProbe := targetHealthCondStatus != corev1.ConditionTrue
+	// pods without readiness gate don't need further probe, their targetHealth is polled by the streaming requeue instead.
+	needFurtherProbe := hasReadinessGate && targetHealthCondStatus != corev1.ConditionTrue
 
 	existingTargetHealthCond, hasExistingTargetHealthCond := pod.GetPodCondition(targetHealthCondType)
 	// we skip patch pod if it matches current computed status/reason/message.
@@ -343,7 +475,7 @@ func (m *defaultResourceManager) updateTargetHealthPodConditionForPod(ctx contex
 }
 
 // updatePodAsHealthyForDeletedTGB updates pod's targetHealth condition as healthy when deleting a TGB
-// if the pod has readiness Gate.
+// if the pod has readiness Gate, the streamed targetHealth condition of pods without readiness gate is updated as unknown.
 func (m *defaultResourceManager) updatePodAsHealthyForDeletedTGB(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
 	targetHealthCondType := BuildTargetHealthPodConditionType(tgb)
 
@@ -361,45 +493,77 @@ func (m *defaultResourceManager) updatePodAsHealthyForDeletedTGB(ctx context.Con
 			continue
 		}
 		if pod.HasAnyOfReadinessGates([]corev1.PodConditionType{targetHealthCondType}) {
-			targetHealth := &elbv2sdk.TargetHealth{
-				State:       awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+			targetHealth := &elbv2types.TargetHealth{
+				State:       elbv2types.TargetHealthStateEnumHealthy,
 				Description: awssdk.String("Target Group Binding is deleted"),
 			}
 			_, err := m.updateTargetHealthPodConditionForPod(ctx, pod, targetHealth, targetHealthCondType)
 			if err != nil {
 				return err
 			}
+		} else if _, hasTargetHealthCond := pod.GetPodCondition(targetHealthCondType); hasTargetHealthCond && m.targetHealthStreamer != nil {
+			if _, err := m.updateTargetHealthPodConditionForPod(ctx, pod, nil, targetHealthCondType); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
-func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgARN string, targets []TargetInfo) error {
-	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(targets))
+// filterTargetsForDeregistration returns the targets that are safe to deregister.
+// For multiCluster TargetGroupBindings, only targets registered by this cluster will be deregistered, and desiredTargetIDs are
+// tracked before registration, so that they can be deregistered later even if the registration partially succeeded.
+func (m *defaultResourceManager) filterTargetsForDeregistration(ctx context.Context, tgb *elbv2api.TargetGroupBinding,
+	desiredTargetIDs sets.String, targets []TargetInfo) ([]TargetInfo, error) {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return targets, nil
+	}
+	trackedTargetIDs, err := m.multiClusterManager.ListTrackedTargets(ctx, tgb)
+	if err != nil {
+		return nil, err
+	}
+	var trackedTargets []TargetInfo
 	for _, target := range targets {
-		sdkTargets = append(sdkTargets, target.Target)
+		if trackedTargetIDs.Has(UniqueIDForTargetDescription(target.Target)) {
+			trackedTargets = append(trackedTargets, target)
+		}
 	}
-	return m.targetsManager.DeregisterTargets(ctx, tgARN, sdkTargets)
+	if desiredTargetIDs.Len() != 0 {
+		if err := m.multiClusterManager.UpdateTrackedTargets(ctx, tgb, trackedTargetIDs.Union(desiredTargetIDs)); err != nil {
+			return nil, err
+		}
+	}
+	return trackedTargets, nil
 }
 
-func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN string, endpoints []backend.PodEndpoint) error {
-	vpcInfo, err := m.vpcInfoProvider.FetchVPCInfo(ctx, m.vpcID)
-	if err != nil {
-		return err
+// updateTrackedTargets tracks desiredTargetIDs as the only targets registered by this cluster for multiCluster TargetGroupBindings.
+// It should be invoked once targets are registered and deregistered successfully.
+func (m *defaultResourceManager) updateTrackedTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, desiredTargetIDs sets.String) error {
+	if !tgb.Spec.MultiClusterTargetGroup {
+		return nil
 	}
-	var vpcRawCIDRs []string
-	vpcRawCIDRs = append(vpcRawCIDRs, vpcInfo.AssociatedIPv4CIDRs()...)
-	vpcRawCIDRs = append(vpcRawCIDRs, vpcInfo.AssociatedIPv6CIDRs()...)
-	vpcCIDRs, err := networking.ParseCIDRs(vpcRawCIDRs)
+	return m.multiClusterManager.UpdateTrackedTargets(ctx, tgb, desiredTargetIDs)
+}
+
+func (m *defaultResourceManager) deregisterTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targets []TargetInfo) error {
+	sdkTargets := make([]elbv2types.TargetDescription, 0, len(targets))
+	for _, target := range targets {
+		sdkTargets = append(sdkTargets, target.Target)
+	}
+	return m.getTargetsManager(tgb).DeregisterTargets(ctx, tgb.Spec.TargetGroupARN, sdkTargets)
+}
+
+func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.PodEndpoint) error {
+	vpcCIDRs, err := m.fetchVPCCIDRs(ctx)
 	if err != nil {
 		return err
 	}
 
-	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(endpoints))
+	sdkTargets := make([]elbv2types.TargetDescription, 0, len(endpoints))
 	for _, endpoint := range endpoints {
-		target := elbv2sdk.TargetDescription{
+		target := elbv2types.TargetDescription{
 			Id:   awssdk.String(endpoint.IP),
-			Port: awssdk.Int64(endpoint.Port),
+			Port: awssdk.Int32(int32(endpoint.Port)),
 		}
 		podIP, err := netip.ParseAddr(endpoint.IP)
 		if err != nil {
@@ -410,18 +574,62 @@ func (m *defaultResourceManager) registerPodEndpoints(ctx context.Context, tgARN
 		}
 		sdkTargets = append(sdkTargets, target)
 	}
-	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+	return m.getTargetsManager(tgb).RegisterTargets(ctx, tgb.Spec.TargetGroupARN, sdkTargets)
 }
 
-func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context, tgARN string, endpoints []backend.NodePortEndpoint) error {
-	sdkTargets := make([]elbv2sdk.TargetDescription, 0, len(endpoints))
+func (m *defaultResourceManager) registerNodePortEndpoints(ctx context.Context, tgb *elbv2api.TargetGroupBinding, endpoints []backend.NodePortEndpoint) error {
+	sdkTargets := make([]elbv2types.TargetDescription, 0, len(endpoints))
 	for _, endpoint := range endpoints {
-		sdkTargets = append(sdkTargets, elbv2sdk.TargetDescription{
+		sdkTargets = append(sdkTargets, elbv2types.TargetDescription{
 			Id:   awssdk.String(endpoint.InstanceID),
-			Port: awssdk.Int64(endpoint.Port),
+			Port: awssdk.Int32(int32(endpoint.Port)),
 		})
 	}
-	return m.targetsManager.RegisterTargets(ctx, tgARN, sdkTargets)
+	return m.getTargetsManager(tgb).RegisterTargets(ctx, tgb.Spec.TargetGroupARN, sdkTargets)
+}
+
+// registerExternalTargets registers the external targets into TargetGroup.
+// For ip TargetType, external targets outside the VPC are registered with "all" availabilityZone unless specified explicitly.
+func (m *defaultResourceManager) registerExternalTargets(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetType elbv2api.TargetType, externalTargets []elbv2api.ExternalTarget) error {
+	var vpcCIDRs []netip.Prefix
+	if targetType == elbv2api.TargetTypeIP {
+		var err error
+		if vpcCIDRs, err = m.fetchVPCCIDRs(ctx); err != nil {
+			return err
+		}
+	}
+
+	sdkTargets := make([]elbv2types.TargetDescription, 0, len(externalTargets))
+	for _, externalTarget := range externalTargets {
+		target := elbv2types.TargetDescription{
+			Id:               awssdk.String(externalTarget.ID),
+			Port:             awssdk.Int32(int32(externalTarget.Port)),
+			AvailabilityZone: externalTarget.AvailabilityZone,
+		}
+		if targetType == elbv2api.TargetTypeIP && target.AvailabilityZone == nil {
+			targetIP, err := netip.ParseAddr(externalTarget.ID)
+			if err != nil {
+				return errors.Wrapf(err, "invalid external target: %v", externalTarget.ID)
+			}
+			if !networking.IsIPWithinCIDRs(targetIP, vpcCIDRs) {
+				target.AvailabilityZone = awssdk.String("all")
+			}
+		}
+		sdkTargets = append(sdkTargets, target)
+	}
+	return m.getTargetsManager(tgb).RegisterTargets(ctx, tgb.Spec.TargetGroupARN, sdkTargets)
+}
+
+// fetchVPCCIDRs returns the IPv4 and IPv6 CIDRs associated with the VPC.
+func (m *defaultResourceManager) fetchVPCCIDRs(ctx context.Context) ([]netip.Prefix, error) {
+	vpcInfo, err := m.vpcInfoProvider.FetchVPCInfo(ctx, m.vpcID)
+	if err != nil {
+		return nil, err
+	}
+	var vpcRawCIDRs []string
+	vpcRawCIDRs = append(vpcRawCIDRs, vpcInfo.AssociatedIPv4CIDRs()...)
+	vpcRawCIDRs = append(vpcRawCIDRs, vpcInfo.AssociatedIPv6CIDRs()...)
+	return networking.ParseCIDRs(vpcRawCIDRs)
 }
 
 type podEndpointAndTargetPair struct {
@@ -463,7 +671,7 @@ func matchPodEndpointWithTargets(endpoints []backend.PodEndpoint, targets []Targ
 	}
 	targetsByUID := make(map[string]TargetInfo, len(targets))
 	for _, target := range targets {
-		targetUID := fmt.Sprintf("%v:%v", awssdk.StringValue(target.Target.Id), awssdk.Int64Value(target.Target.Port))
+		targetUID := fmt.Sprintf("%v:%v", awssdk.ToString(target.Target.Id), awssdk.ToInt32(target.Target.Port))
 		targetsByUID[targetUID] = target
 	}
 	endpointUIDs := sets.StringKeySet(endpointsByUID)
@@ -485,6 +693,80 @@ func matchPodEndpointWithTargets(endpoints []backend.PodEndpoint, targets []Targ
 	return matchedEndpointAndTargets, unmatchedEndpoints, unmatchedTargets
 }
 
+// mapPodEndpointsToIPFamily replaces the addresses of endpoints with the pod IPs of the IP family of policy.
+// endpoints of pods without pod IP of the family are dropped, as TargetGroups only accept targets of a single IP family.
+func mapPodEndpointsToIPFamily(endpoints []backend.PodEndpoint, policy elbv2api.TargetIPFamilyPolicy) []backend.PodEndpoint {
+	ipv6 := policy == elbv2api.TargetIPFamilyPolicyPreferIPv6
+	endpointUIDs := sets.NewString()
+	var mappedEndpoints []backend.PodEndpoint
+	for _, endpoint := range endpoints {
+		podIP := findPodIPOfFamily(endpoint.Pod, ipv6)
+		if podIP == "" {
+			continue
+		}
+		// dual-stack Services have endpoints of both IP families for each pod.
+		endpointUID := fmt.Sprintf("%v:%v", podIP, endpoint.Port)
+		if endpointUIDs.Has(endpointUID) {
+			continue
+		}
+		endpointUIDs.Insert(endpointUID)
+		endpoint.IP = podIP
+		mappedEndpoints = append(mappedEndpoints, endpoint)
+	}
+	return mappedEndpoints
+}
+
+// findPodIPOfFamily returns the pod IP of IPv6 or IPv4 family, it's empty if pod doesn't have one.
+func findPodIPOfFamily(pod k8s.PodInfo, ipv6 bool) string {
+	podIPs := pod.PodIPs
+	if len(podIPs) == 0 {
+		podIPs = []string{pod.PodIP}
+	}
+	for _, podIP := range podIPs {
+		ip, err := netip.ParseAddr(podIP)
+		if err == nil && ip.Unmap().Is6() == ipv6 {
+			return podIP
+		}
+	}
+	return ""
+}
+
+// partitionPodEndpointsByTerminatingStatus splits endpoints into active endpoints and terminating endpoints.
+func partitionPodEndpointsByTerminatingStatus(endpoints []backend.PodEndpoint) ([]backend.PodEndpoint, []backend.PodEndpoint) {
+	var activeEndpoints []backend.PodEndpoint
+	var terminatingEndpoints []backend.PodEndpoint
+	for _, endpoint := range endpoints {
+		if endpoint.Terminating {
+			terminatingEndpoints = append(terminatingEndpoints, endpoint)
+		} else {
+			activeEndpoints = append(activeEndpoints, endpoint)
+		}
+	}
+	return activeEndpoints, terminatingEndpoints
+}
+
+// matchTerminatingPodEndpointWithTargets returns the unmatchedTargets that still correspond to terminating endpoints,
+// and the remaining unmatchedTargets.
+func matchTerminatingPodEndpointWithTargets(terminatingEndpoints []backend.PodEndpoint, unmatchedTargets []TargetInfo) ([]TargetInfo, []TargetInfo) {
+	if len(terminatingEndpoints) == 0 {
+		return nil, unmatchedTargets
+	}
+	terminatingEndpointUIDs := sets.NewString()
+	for _, endpoint := range terminatingEndpoints {
+		terminatingEndpointUIDs.Insert(fmt.Sprintf("%v:%v", endpoint.IP, endpoint.Port))
+	}
+	var retainedTargets []TargetInfo
+	var remainingTargets []TargetInfo
+	for _, target := range unmatchedTargets {
+		if terminatingEndpointUIDs.Has(UniqueIDForTargetDescription(target.Target)) {
+			retainedTargets = append(retainedTargets, target)
+		} else {
+			remainingTargets = append(remainingTargets, target)
+		}
+	}
+	return retainedTargets, remainingTargets
+}
+
 type nodePortEndpointAndTargetPair struct {
 	endpoint backend.NodePortEndpoint
 	target   TargetInfo
@@ -502,7 +784,7 @@ func matchNodePortEndpointWithTargets(endpoints []backend.NodePortEndpoint, targ
 	}
 	targetsByUID := make(map[string]TargetInfo, len(targets))
 	for _, target := range targets {
-		targetUID := fmt.Sprintf("%v:%v", awssdk.StringValue(target.Target.Id), awssdk.Int64Value(target.Target.Port))
+		targetUID := fmt.Sprintf("%v:%v", awssdk.ToString(target.Target.Id), awssdk.ToInt32(target.Target.Port))
 		targetsByUID[targetUID] = target
 	}
 	endpointUIDs := sets.StringKeySet(endpointsByUID)
@@ -525,17 +807,71 @@ func matchNodePortEndpointWithTargets(endpoints []backend.NodePortEndpoint, targ
 }
 
 func isELBV2TargetGroupNotFoundError(err error) bool {
-	var awsErr awserr.Error
-	if errors.As(err, &awsErr) {
-		return awsErr.Code() == "TargetGroupNotFound"
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "TargetGroupNotFound"
 	}
 	return false
 }
 
 func isELBV2TargetGroupARNInvalidError(err error) bool {
-	var awsErr awserr.Error
-	if errors.As(err, &awsErr) {
-		return awsErr.Code() == "ValidationError"
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode() == "ValidationError"
 	}
 	return false
 }
+
+// matchExternalTargetsWithTargets matches the external targets with existing targets in TargetGroup.
+// It returns the external targets that are not registered yet, and the unmatchedTargets that don't correspond to any external target.
+func matchExternalTargetsWithTargets(externalTargets []elbv2api.ExternalTarget, targets []TargetInfo, unmatchedTargets []TargetInfo) ([]elbv2api.ExternalTarget, []TargetInfo) {
+	if len(externalTargets) == 0 {
+		return nil, unmatchedTargets
+	}
+	externalTargetUIDs := sets.NewString()
+	for _, externalTarget := range externalTargets {
+		externalTargetUIDs.Insert(fmt.Sprintf("%v:%v", externalTarget.ID, externalTarget.Port))
+	}
+	targetUIDs := sets.NewString()
+	for _, target := range targets {
+		targetUIDs.Insert(fmt.Sprintf("%v:%v", awssdk.ToString(target.Target.Id), awssdk.ToInt32(target.Target.Port)))
+	}
+
+	var unmatchedExternalTargets []elbv2api.ExternalTarget
+	for _, externalTarget := range externalTargets {
+		if !targetUIDs.Has(fmt.Sprintf("%v:%v", externalTarget.ID, externalTarget.Port)) {
+			unmatchedExternalTargets = append(unmatchedExternalTargets, externalTarget)
+		}
+	}
+	var remainingUnmatchedTargets []TargetInfo
+	for _, target := range unmatchedTargets {
+		if !externalTargetUIDs.Has(fmt.Sprintf("%v:%v", awssdk.ToString(target.Target.Id), awssdk.ToInt32(target.Target.Port))) {
+			remainingUnmatchedTargets = append(remainingUnmatchedTargets, target)
+		}
+	}
+	return unmatchedExternalTargets, remainingUnmatchedTargets
+}
+
+// getTargetsManager returns the TargetsManager for TargetGroupBinding, which assumes the IAM role if specified.
+func (m *defaultResourceManager) getTargetsManager(tgb *elbv2api.TargetGroupBinding) TargetsManager {
+	if tgb.Spec.AWSRoleARN == "" {
+		return m.targetsManager
+	}
+	m.assumedRoleTargetsManagersMutex.Lock()
+	defer m.assumedRoleTargetsManagersMutex.Unlock()
+	if targetsManager, exists := m.assumedRoleTargetsManagers[tgb.Spec.AWSRoleARN]; exists {
+		return targetsManager
+	}
+	targetsManager := m.newTargetsManager(m.cloud.AssumeRole(tgb.Spec.AWSRoleARN).ELBV2())
+	m.assumedRoleTargetsManagers[tgb.Spec.AWSRoleARN] = targetsManager
+	return targetsManager
+}
+
+// newTargetsManager constructs the TargetsManager for elbv2Client, which batches targets operations if enabled.
+func (m *defaultResourceManager) newTargetsManager(elbv2Client services.ELBV2) TargetsManager {
+	targetsManager := NewCachedTargetsManager(elbv2Client, m.targetHealthRequeueDuration, m.targetHealthStreamer != nil, m.logger)
+	if m.targetsBatchWindow <= 0 {
+		return targetsManager
+	}
+	return NewBatchedTargetsManager(targetsManager, m.targetsBatchWindow, m.targetsBatchMaxConcurrency, m.targetsBatchMetrics, m.logger)
+}
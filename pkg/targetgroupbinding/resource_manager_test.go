@@ -4,8 +4,8 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -15,6 +15,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/equality"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -28,18 +30,96 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 
 	type args struct {
 		pod                  k8s.PodInfo
-		targetHealth         *elbv2sdk.TargetHealth
+		targetHealth         *elbv2types.TargetHealth
 		targetHealthCondType corev1.PodConditionType
 	}
 
 	tests := []struct {
-		name    string
-		env     env
-		args    args
-		want    bool
-		wantPod *corev1.Pod
-		wantErr error
+		name                         string
+		env                          env
+		targetHealthStreamingEnabled bool
+		args                         args
+		want                         bool
+		wantPod                      *corev1.Pod
+		wantErr                      error
 	}{
+		{
+			name: "pod without readinessGate - skip pod condition",
+			env: env{
+				pods: []*corev1.Pod{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "my-pod",
+							UID:       "my-pod-uuid",
+						},
+					},
+				},
+			},
+			args: args{
+				pod: k8s.PodInfo{
+					Key: types.NamespacedName{Namespace: "default", Name: "my-pod"},
+					UID: "my-pod-uuid",
+				},
+				targetHealth: &elbv2types.TargetHealth{
+					State: elbv2types.TargetHealthStateEnumHealthy,
+				},
+				targetHealthCondType: "target-health.elbv2.k8s.aws/my-tgb",
+			},
+			want: false,
+			wantPod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "my-pod",
+					UID:       "my-pod-uuid",
+				},
+			},
+		},
+		{
+			name: "pod without readinessGate and target health streaming enabled - add pod condition",
+			env: env{
+				pods: []*corev1.Pod{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "my-pod",
+							UID:       "my-pod-uuid",
+						},
+					},
+				},
+			},
+			targetHealthStreamingEnabled: true,
+			args: args{
+				pod: k8s.PodInfo{
+					Key: types.NamespacedName{Namespace: "default", Name: "my-pod"},
+					UID: "my-pod-uuid",
+				},
+				targetHealth: &elbv2types.TargetHealth{
+					State:       elbv2types.TargetHealthStateEnumUnhealthy,
+					Reason:      elbv2types.TargetHealthReasonEnumFailedHealthChecks,
+					Description: awssdk.String("Health checks failed"),
+				},
+				targetHealthCondType: "target-health.elbv2.k8s.aws/my-tgb",
+			},
+			want: false,
+			wantPod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "my-pod",
+					UID:       "my-pod-uuid",
+				},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{
+						{
+							Type:    "target-health.elbv2.k8s.aws/my-tgb",
+							Status:  corev1.ConditionFalse,
+							Reason:  string(elbv2types.TargetHealthReasonEnumFailedHealthChecks),
+							Message: "Health checks failed",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "pod contains readinessGate and targetHealth is healthy - add pod condition",
 			env: env{
@@ -84,8 +164,8 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 						},
 					},
 				},
-				targetHealth: &elbv2sdk.TargetHealth{
-					State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+				targetHealth: &elbv2types.TargetHealth{
+					State: elbv2types.TargetHealthStateEnumHealthy,
 				},
 				targetHealthCondType: "target-health.elbv2.k8s.aws/my-tgb",
 			},
@@ -138,7 +218,7 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 							Conditions: []corev1.PodCondition{
 								{
 									Type:    "target-health.elbv2.k8s.aws/my-tgb",
-									Message: elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress,
+									Message: string(elbv2types.TargetHealthReasonEnumRegistrationInProgress),
 									Reason:  "Elb.RegistrationInProgress",
 									Status:  corev1.ConditionFalse,
 								},
@@ -163,7 +243,7 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 					Conditions: []corev1.PodCondition{
 						{
 							Type:    "target-health.elbv2.k8s.aws/my-tgb",
-							Message: elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress,
+							Message: string(elbv2types.TargetHealthReasonEnumRegistrationInProgress),
 							Reason:  "Elb.RegistrationInProgress",
 							Status:  corev1.ConditionFalse,
 						},
@@ -173,8 +253,8 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 						},
 					},
 				},
-				targetHealth: &elbv2sdk.TargetHealth{
-					State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+				targetHealth: &elbv2types.TargetHealth{
+					State: elbv2types.TargetHealthStateEnumHealthy,
 				},
 				targetHealthCondType: "target-health.elbv2.k8s.aws/my-tgb",
 			},
@@ -228,7 +308,7 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 								{
 									Type:    "target-health.elbv2.k8s.aws/my-tgb",
 									Status:  corev1.ConditionFalse,
-									Reason:  elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress,
+									Reason:  string(elbv2types.TargetHealthReasonEnumRegistrationInProgress),
 									Message: "Target registration is in progress",
 								},
 								{
@@ -253,7 +333,7 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 						{
 							Type:    "target-health.elbv2.k8s.aws/my-tgb",
 							Status:  corev1.ConditionFalse,
-							Reason:  elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress,
+							Reason:  string(elbv2types.TargetHealthReasonEnumRegistrationInProgress),
 							Message: "Target registration is in progress",
 						},
 						{
@@ -262,9 +342,9 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 						},
 					},
 				},
-				targetHealth: &elbv2sdk.TargetHealth{
-					State:       awssdk.String(elbv2sdk.TargetHealthStateEnumUnhealthy),
-					Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumTargetFailedHealthChecks),
+				targetHealth: &elbv2types.TargetHealth{
+					State:       elbv2types.TargetHealthStateEnumUnhealthy,
+					Reason:      elbv2types.TargetHealthReasonEnumFailedHealthChecks,
 					Description: awssdk.String("Health checks failed"),
 				},
 				targetHealthCondType: "target-health.elbv2.k8s.aws/my-tgb",
@@ -288,7 +368,7 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 						{
 							Type:    "target-health.elbv2.k8s.aws/my-tgb",
 							Status:  corev1.ConditionFalse,
-							Reason:  elbv2sdk.TargetHealthReasonEnumTargetFailedHealthChecks,
+							Reason:  string(elbv2types.TargetHealthReasonEnumFailedHealthChecks),
 							Message: "Health checks failed",
 						},
 						{
@@ -385,6 +465,9 @@ func Test_defaultResourceManager_updateTargetHealthPodConditionForPod(t *testing
 				k8sClient: k8sClient,
 				logger:    logr.New(&log.NullLogSink{}),
 			}
+			if tt.targetHealthStreamingEnabled {
+				m.targetHealthStreamer = &TargetHealthStreamer{}
+			}
 
 			ctx := context.Background()
 			for _, pod := range tt.env.pods {
@@ -429,9 +512,9 @@ func Test_containsTargetsInInitialState(t *testing.T) {
 				matchedEndpointAndTargets: []podEndpointAndTargetPair{
 					{
 						target: TargetInfo{
-							TargetHealth: &elbv2sdk.TargetHealth{
-								State:       awssdk.String(elbv2sdk.TargetHealthStateEnumInitial),
-								Reason:      awssdk.String(elbv2sdk.TargetHealthReasonEnumElbRegistrationInProgress),
+							TargetHealth: &elbv2types.TargetHealth{
+								State:       elbv2types.TargetHealthStateEnumInitial,
+								Reason:      elbv2types.TargetHealthReasonEnumRegistrationInProgress,
 								Description: awssdk.String("Target registration is in progress"),
 							},
 						},
@@ -446,8 +529,8 @@ func Test_containsTargetsInInitialState(t *testing.T) {
 				matchedEndpointAndTargets: []podEndpointAndTargetPair{
 					{
 						target: TargetInfo{
-							TargetHealth: &elbv2sdk.TargetHealth{
-								State: awssdk.String(elbv2sdk.TargetHealthStateEnumHealthy),
+							TargetHealth: &elbv2types.TargetHealth{
+								State: elbv2types.TargetHealthStateEnumHealthy,
 							},
 						},
 					},
@@ -463,3 +546,171 @@ func Test_containsTargetsInInitialState(t *testing.T) {
 		})
 	}
 }
+
+func Test_matchExternalTargetsWithTargets(t *testing.T) {
+	type args struct {
+		externalTargets  []elbv2api.ExternalTarget
+		targets          []TargetInfo
+		unmatchedTargets []TargetInfo
+	}
+	target1 := TargetInfo{Target: elbv2types.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int32(8080)}}
+	target2 := TargetInfo{Target: elbv2types.TargetDescription{Id: awssdk.String("10.100.0.1"), Port: awssdk.Int32(8080)}}
+	target3 := TargetInfo{Target: elbv2types.TargetDescription{Id: awssdk.String("10.100.0.2"), Port: awssdk.Int32(8080)}}
+	tests := []struct {
+		name                         string
+		args                         args
+		wantUnmatchedExternalTargets []elbv2api.ExternalTarget
+		wantUnmatchedTargets         []TargetInfo
+	}{
+		{
+			name: "no external targets",
+			args: args{
+				targets:          []TargetInfo{target1, target2},
+				unmatchedTargets: []TargetInfo{target2},
+			},
+			wantUnmatchedExternalTargets: nil,
+			wantUnmatchedTargets:         []TargetInfo{target2},
+		},
+		{
+			name: "external targets partially registered",
+			args: args{
+				externalTargets: []elbv2api.ExternalTarget{
+					{ID: "10.100.0.1", Port: 8080},
+					{ID: "10.100.0.3", Port: 8080},
+				},
+				targets:          []TargetInfo{target1, target2, target3},
+				unmatchedTargets: []TargetInfo{target2, target3},
+			},
+			wantUnmatchedExternalTargets: []elbv2api.ExternalTarget{
+				{ID: "10.100.0.3", Port: 8080},
+			},
+			wantUnmatchedTargets: []TargetInfo{target3},
+		},
+		{
+			name: "external targets port mismatch",
+			args: args{
+				externalTargets: []elbv2api.ExternalTarget{
+					{ID: "10.100.0.1", Port: 9090},
+				},
+				targets:          []TargetInfo{target2},
+				unmatchedTargets: []TargetInfo{target2},
+			},
+			wantUnmatchedExternalTargets: []elbv2api.ExternalTarget{
+				{ID: "10.100.0.1", Port: 9090},
+			},
+			wantUnmatchedTargets: []TargetInfo{target2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotUnmatchedExternalTargets, gotUnmatchedTargets := matchExternalTargetsWithTargets(tt.args.externalTargets, tt.args.targets, tt.args.unmatchedTargets)
+			assert.Equal(t, tt.wantUnmatchedExternalTargets, gotUnmatchedExternalTargets)
+			assert.Equal(t, tt.wantUnmatchedTargets, gotUnmatchedTargets)
+		})
+	}
+}
+
+func Test_matchTerminatingPodEndpointWithTargets(t *testing.T) {
+	type args struct {
+		terminatingEndpoints []backend.PodEndpoint
+		unmatchedTargets     []TargetInfo
+	}
+	target1 := TargetInfo{Target: elbv2types.TargetDescription{Id: awssdk.String("192.168.1.1"), Port: awssdk.Int32(8080)}}
+	target2 := TargetInfo{Target: elbv2types.TargetDescription{Id: awssdk.String("192.168.1.2"), Port: awssdk.Int32(8080)}}
+	tests := []struct {
+		name                 string
+		args                 args
+		wantRetainedTargets  []TargetInfo
+		wantUnmatchedTargets []TargetInfo
+	}{
+		{
+			name: "no terminating endpoints",
+			args: args{
+				unmatchedTargets: []TargetInfo{target1, target2},
+			},
+			wantRetainedTargets:  nil,
+			wantUnmatchedTargets: []TargetInfo{target1, target2},
+		},
+		{
+			name: "terminating endpoint is kept registered",
+			args: args{
+				terminatingEndpoints: []backend.PodEndpoint{
+					{IP: "192.168.1.1", Port: 8080, Terminating: true},
+				},
+				unmatchedTargets: []TargetInfo{target1, target2},
+			},
+			wantRetainedTargets:  []TargetInfo{target1},
+			wantUnmatchedTargets: []TargetInfo{target2},
+		},
+		{
+			name: "terminating endpoint not registered",
+			args: args{
+				terminatingEndpoints: []backend.PodEndpoint{
+					{IP: "192.168.1.3", Port: 8080, Terminating: true},
+				},
+				unmatchedTargets: []TargetInfo{target2},
+			},
+			wantRetainedTargets:  nil,
+			wantUnmatchedTargets: []TargetInfo{target2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotRetainedTargets, gotUnmatchedTargets := matchTerminatingPodEndpointWithTargets(tt.args.terminatingEndpoints, tt.args.unmatchedTargets)
+			assert.Equal(t, tt.wantRetainedTargets, gotRetainedTargets)
+			assert.Equal(t, tt.wantUnmatchedTargets, gotUnmatchedTargets)
+		})
+	}
+}
+
+func Test_partitionPodEndpointsByTerminatingStatus(t *testing.T) {
+	activeEndpoint := backend.PodEndpoint{IP: "192.168.1.1", Port: 8080}
+	terminatingEndpoint := backend.PodEndpoint{IP: "192.168.1.2", Port: 8080, Terminating: true}
+	gotActiveEndpoints, gotTerminatingEndpoints := partitionPodEndpointsByTerminatingStatus([]backend.PodEndpoint{activeEndpoint, terminatingEndpoint})
+	assert.Equal(t, []backend.PodEndpoint{activeEndpoint}, gotActiveEndpoints)
+	assert.Equal(t, []backend.PodEndpoint{terminatingEndpoint}, gotTerminatingEndpoints)
+}
+
+func Test_mapPodEndpointsToIPFamily(t *testing.T) {
+	dualStackPod := k8s.PodInfo{
+		Key:    types.NamespacedName{Namespace: "default", Name: "dual-stack"},
+		PodIP:  "192.168.1.1",
+		PodIPs: []string{"192.168.1.1", "2600:1f14:1234::1"},
+	}
+	ipv4Pod := k8s.PodInfo{
+		Key:   types.NamespacedName{Namespace: "default", Name: "ipv4"},
+		PodIP: "192.168.1.2",
+	}
+	endpoints := []backend.PodEndpoint{
+		{IP: "192.168.1.1", Port: 8080, Pod: dualStackPod},
+		{IP: "2600:1f14:1234::1", Port: 8080, Pod: dualStackPod},
+		{IP: "192.168.1.2", Port: 8080, Pod: ipv4Pod},
+	}
+	tests := []struct {
+		name   string
+		policy elbv2api.TargetIPFamilyPolicy
+		want   []backend.PodEndpoint
+	}{
+		{
+			name:   "prefer IPv6",
+			policy: elbv2api.TargetIPFamilyPolicyPreferIPv6,
+			want: []backend.PodEndpoint{
+				{IP: "2600:1f14:1234::1", Port: 8080, Pod: dualStackPod},
+			},
+		},
+		{
+			name:   "prefer IPv4",
+			policy: elbv2api.TargetIPFamilyPolicyPreferIPv4,
+			want: []backend.PodEndpoint{
+				{IP: "192.168.1.1", Port: 8080, Pod: dualStackPod},
+				{IP: "192.168.1.2", Port: 8080, Pod: ipv4Pod},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapPodEndpointsToIPFamily(endpoints, tt.policy)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
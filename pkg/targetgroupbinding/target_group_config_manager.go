@@ -0,0 +1,293 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/algorithm"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/audit"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+)
+
+const (
+	// the reconciled settings are re-verified after the TTL, to correct the settings drifted by others.
+	defaultTargetGroupConfigCacheTTL = 10 * time.Minute
+
+	tgAttrsDeregistrationDelayTimeoutSeconds  = "deregistration_delay.timeout_seconds"
+	tgAttrsStickinessEnabled                  = "stickiness.enabled"
+	tgAttrsStickinessType                     = "stickiness.type"
+	tgAttrsStickinessLBCookieDurationSeconds  = "stickiness.lb_cookie.duration_seconds"
+	tgAttrsStickinessAppCookieDurationSeconds = "stickiness.app_cookie.duration_seconds"
+)
+
+// TargetGroupConfigManager reconciles the settings of TargetGroup specified by TargetGroupBindings,
+// which are the targetGroupAttributes and the manageTargetGroupConfig.
+type TargetGroupConfigManager interface {
+	// Reconcile the TargetGroup settings specified by TargetGroupBinding with elbv2Client.
+	Reconcile(ctx context.Context, elbv2Client services.ELBV2, tgb *elbv2api.TargetGroupBinding) error
+
+	// Reset forgets the reconciled TargetGroup settings of TargetGroupBinding.
+	Reset(tgb *elbv2api.TargetGroupBinding)
+}
+
+// NewDefaultTargetGroupConfigManager constructs new defaultTargetGroupConfigManager.
+func NewDefaultTargetGroupConfigManager(logger logr.Logger) *defaultTargetGroupConfigManager {
+	return &defaultTargetGroupConfigManager{
+		reconciledConfigCache:      cache.NewExpiring(),
+		reconciledConfigCacheMutex: sync.Mutex{},
+		reconciledConfigCacheTTL:   defaultTargetGroupConfigCacheTTL,
+		logger:                     logger,
+	}
+}
+
+var _ TargetGroupConfigManager = &defaultTargetGroupConfigManager{}
+
+// default implementation for TargetGroupConfigManager.
+type defaultTargetGroupConfigManager struct {
+	// reconciledConfigCache caches the last reconciled settings per TargetGroup ARN,
+	// so that the settings aren't described for every reconcile of TargetGroupBindings.
+	reconciledConfigCache      *cache.Expiring
+	reconciledConfigCacheMutex sync.Mutex
+	reconciledConfigCacheTTL   time.Duration
+	logger                     logr.Logger
+}
+
+func (m *defaultTargetGroupConfigManager) Reconcile(ctx context.Context, elbv2Client services.ELBV2, tgb *elbv2api.TargetGroupBinding) error {
+	desiredAttrs := buildDesiredTargetGroupAttributes(tgb)
+	var desiredHealthCheck *elbv2api.ManagedTargetGroupHealthCheck
+	if tgb.Spec.ManageTargetGroupConfig != nil {
+		desiredHealthCheck = tgb.Spec.ManageTargetGroupConfig.HealthCheck
+	}
+	if len(desiredAttrs) == 0 && desiredHealthCheck == nil {
+		return nil
+	}
+	tgARN := tgb.Spec.TargetGroupARN
+	desiredConfigKey := computeTargetGroupConfigKey(desiredAttrs, desiredHealthCheck)
+
+	m.reconciledConfigCacheMutex.Lock()
+	rawCacheItem, exists := m.reconciledConfigCache.Get(tgARN)
+	m.reconciledConfigCacheMutex.Unlock()
+	if exists && rawCacheItem.(string) == desiredConfigKey {
+		return nil
+	}
+
+	if len(desiredAttrs) != 0 {
+		if err := m.reconcileAttributes(ctx, elbv2Client, tgb, desiredAttrs); err != nil {
+			return err
+		}
+	}
+	if desiredHealthCheck != nil {
+		if err := m.reconcileHealthCheck(ctx, elbv2Client, tgb, *desiredHealthCheck); err != nil {
+			return err
+		}
+	}
+	m.reconciledConfigCacheMutex.Lock()
+	m.reconciledConfigCache.Set(tgARN, desiredConfigKey, m.reconciledConfigCacheTTL)
+	m.reconciledConfigCacheMutex.Unlock()
+	return nil
+}
+
+func (m *defaultTargetGroupConfigManager) Reset(tgb *elbv2api.TargetGroupBinding) {
+	m.reconciledConfigCacheMutex.Lock()
+	defer m.reconciledConfigCacheMutex.Unlock()
+	m.reconciledConfigCache.Delete(tgb.Spec.TargetGroupARN)
+}
+
+func (m *defaultTargetGroupConfigManager) reconcileAttributes(ctx context.Context, elbv2Client services.ELBV2,
+	tgb *elbv2api.TargetGroupBinding, desiredAttrs map[string]string) error {
+	tgARN := tgb.Spec.TargetGroupARN
+	resp, err := elbv2Client.DescribeTargetGroupAttributesWithContext(ctx, &elbv2sdk.DescribeTargetGroupAttributesInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	})
+	if err != nil {
+		return err
+	}
+	currentAttrs := make(map[string]string, len(resp.Attributes))
+	for _, attr := range resp.Attributes {
+		currentAttrs[awssdk.ToString(attr.Key)] = awssdk.ToString(attr.Value)
+	}
+	attributesToUpdate, _ := algorithm.DiffStringMap(desiredAttrs, currentAttrs)
+	if len(attributesToUpdate) == 0 {
+		return nil
+	}
+	req := &elbv2sdk.ModifyTargetGroupAttributesInput{
+		TargetGroupArn: awssdk.String(tgARN),
+	}
+	for _, attrKey := range sets.StringKeySet(attributesToUpdate).List() {
+		req.Attributes = append(req.Attributes, elbv2types.TargetGroupAttribute{
+			Key:   awssdk.String(attrKey),
+			Value: awssdk.String(attributesToUpdate[attrKey]),
+		})
+	}
+	m.logger.Info("modifying targetGroup attributes",
+		"targetGroupBinding", k8s.NamespacedName(tgb),
+		"arn", tgARN,
+		"change", attributesToUpdate)
+	if _, err := elbv2Client.ModifyTargetGroupAttributesWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified targetGroup attributes",
+		"targetGroupBinding", k8s.NamespacedName(tgb),
+		"arn", tgARN)
+	audit.RecordMutation(ctx, audit.ActionModify, "targetGroup", tgARN, fmt.Sprintf("attributes %v", attributesToUpdate))
+	return nil
+}
+
+func (m *defaultTargetGroupConfigManager) reconcileHealthCheck(ctx context.Context, elbv2Client services.ELBV2,
+	tgb *elbv2api.TargetGroupBinding, desiredHealthCheck elbv2api.ManagedTargetGroupHealthCheck) error {
+	tgARN := tgb.Spec.TargetGroupARN
+	tgs, err := elbv2Client.DescribeTargetGroupsAsList(ctx, &elbv2sdk.DescribeTargetGroupsInput{
+		TargetGroupArns: []string{tgARN},
+	})
+	if err != nil {
+		return err
+	}
+	if len(tgs) != 1 {
+		return errors.Errorf("expecting a single targetGroup with arn %v, but got %v", tgARN, len(tgs))
+	}
+	req, needsUpdate := buildSDKModifyTargetGroupHealthCheckInput(&tgs[0], desiredHealthCheck)
+	if !needsUpdate {
+		return nil
+	}
+	m.logger.Info("modifying targetGroup healthCheck",
+		"targetGroupBinding", k8s.NamespacedName(tgb),
+		"arn", tgARN)
+	if _, err := elbv2Client.ModifyTargetGroupWithContext(ctx, req); err != nil {
+		return err
+	}
+	m.logger.Info("modified targetGroup healthCheck",
+		"targetGroupBinding", k8s.NamespacedName(tgb),
+		"arn", tgARN)
+	audit.RecordMutation(ctx, audit.ActionModify, "targetGroup", tgARN, "healthCheck")
+	return nil
+}
+
+// buildDesiredTargetGroupAttributes builds the TargetGroup attributes specified by TargetGroupBinding,
+// either as targetGroupAttributes or derived from manageTargetGroupConfig.
+func buildDesiredTargetGroupAttributes(tgb *elbv2api.TargetGroupBinding) map[string]string {
+	desiredAttrs := BuildManagedTargetGroupConfigAttributes(tgb.Spec.ManageTargetGroupConfig)
+	for _, attr := range tgb.Spec.TargetGroupAttributes {
+		desiredAttrs[attr.Key] = attr.Value
+	}
+	return desiredAttrs
+}
+
+// BuildManagedTargetGroupConfigAttributes builds the TargetGroup attributes derived from tgConfig.
+func BuildManagedTargetGroupConfigAttributes(tgConfig *elbv2api.ManagedTargetGroupConfig) map[string]string {
+	attrs := make(map[string]string)
+	if tgConfig == nil {
+		return attrs
+	}
+	if tgConfig.DeregistrationDelaySeconds != nil {
+		attrs[tgAttrsDeregistrationDelayTimeoutSeconds] = strconv.FormatInt(*tgConfig.DeregistrationDelaySeconds, 10)
+	}
+	if stickiness := tgConfig.Stickiness; stickiness != nil {
+		attrs[tgAttrsStickinessEnabled] = strconv.FormatBool(stickiness.Enabled)
+		if stickiness.Type != nil {
+			attrs[tgAttrsStickinessType] = string(*stickiness.Type)
+		}
+		if stickiness.DurationSeconds != nil {
+			durationAttrKey := tgAttrsStickinessLBCookieDurationSeconds
+			if stickiness.Type != nil && *stickiness.Type == elbv2api.ManagedTargetGroupStickinessTypeAppCookie {
+				durationAttrKey = tgAttrsStickinessAppCookieDurationSeconds
+			}
+			attrs[durationAttrKey] = strconv.FormatInt(*stickiness.DurationSeconds, 10)
+		}
+	}
+	if unhealthyTargetState := tgConfig.UnhealthyTargetState; unhealthyTargetState != nil {
+		if unhealthyTargetState.ConnectionTermination != nil {
+			attrs[elbv2model.TGAttrsUnhealthyConnectionTerminationEnabled] = strconv.FormatBool(*unhealthyTargetState.ConnectionTermination)
+		}
+		if unhealthyTargetState.DrainingIntervalSeconds != nil {
+			attrs[elbv2model.TGAttrsUnhealthyDrainingIntervalSeconds] = strconv.FormatInt(*unhealthyTargetState.DrainingIntervalSeconds, 10)
+		}
+	}
+	if tgHealth := tgConfig.TargetGroupHealth; tgHealth != nil {
+		buildMinimumHealthyTargetsAttributes(attrs, tgHealth.DNSFailover,
+			elbv2model.TGAttrsDNSFailoverMinimumHealthyTargetsCount, elbv2model.TGAttrsDNSFailoverMinimumHealthyTargetsPercentage)
+		buildMinimumHealthyTargetsAttributes(attrs, tgHealth.UnhealthyStateRouting,
+			elbv2model.TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsCount, elbv2model.TGAttrsUnhealthyStateRoutingMinimumHealthyTargetsPercentage)
+	}
+	return attrs
+}
+
+// buildMinimumHealthyTargetsAttributes sets the count and percentage attributes of minimumHealthyTargets into attrs.
+func buildMinimumHealthyTargetsAttributes(attrs map[string]string, minimumHealthyTargets *elbv2api.ManagedTargetGroupMinimumHealthyTargets,
+	countAttrKey string, percentageAttrKey string) {
+	if minimumHealthyTargets == nil {
+		return
+	}
+	if minimumHealthyTargets.Count != nil {
+		attrs[countAttrKey] = minimumHealthyTargets.Count.String()
+	}
+	if minimumHealthyTargets.Percentage != nil {
+		attrs[percentageAttrKey] = minimumHealthyTargets.Percentage.String()
+	}
+}
+
+// buildSDKModifyTargetGroupHealthCheckInput builds the request to modify the drifted health check settings of sdkTG.
+// the returned bool indicates whether any setting drifted.
+func buildSDKModifyTargetGroupHealthCheckInput(sdkTG *elbv2types.TargetGroup, desiredHealthCheck elbv2api.ManagedTargetGroupHealthCheck) (*elbv2sdk.ModifyTargetGroupInput, bool) {
+	req := &elbv2sdk.ModifyTargetGroupInput{
+		TargetGroupArn: sdkTG.TargetGroupArn,
+	}
+	needsUpdate := false
+	if desiredHealthCheck.Path != nil && awssdk.ToString(desiredHealthCheck.Path) != awssdk.ToString(sdkTG.HealthCheckPath) {
+		req.HealthCheckPath = desiredHealthCheck.Path
+		needsUpdate = true
+	}
+	if desiredHealthCheck.Port != nil && desiredHealthCheck.Port.String() != awssdk.ToString(sdkTG.HealthCheckPort) {
+		req.HealthCheckPort = awssdk.String(desiredHealthCheck.Port.String())
+		needsUpdate = true
+	}
+	if desiredHealthCheck.IntervalSeconds != nil && int32(awssdk.ToInt64(desiredHealthCheck.IntervalSeconds)) != awssdk.ToInt32(sdkTG.HealthCheckIntervalSeconds) {
+		req.HealthCheckIntervalSeconds = awssdk.Int32(int32(awssdk.ToInt64(desiredHealthCheck.IntervalSeconds)))
+		needsUpdate = true
+	}
+	if desiredHealthCheck.TimeoutSeconds != nil && int32(awssdk.ToInt64(desiredHealthCheck.TimeoutSeconds)) != awssdk.ToInt32(sdkTG.HealthCheckTimeoutSeconds) {
+		req.HealthCheckTimeoutSeconds = awssdk.Int32(int32(awssdk.ToInt64(desiredHealthCheck.TimeoutSeconds)))
+		needsUpdate = true
+	}
+	if desiredHealthCheck.HealthyThresholdCount != nil && int32(awssdk.ToInt64(desiredHealthCheck.HealthyThresholdCount)) != awssdk.ToInt32(sdkTG.HealthyThresholdCount) {
+		req.HealthyThresholdCount = awssdk.Int32(int32(awssdk.ToInt64(desiredHealthCheck.HealthyThresholdCount)))
+		needsUpdate = true
+	}
+	if desiredHealthCheck.UnhealthyThresholdCount != nil && int32(awssdk.ToInt64(desiredHealthCheck.UnhealthyThresholdCount)) != awssdk.ToInt32(sdkTG.UnhealthyThresholdCount) {
+		req.UnhealthyThresholdCount = awssdk.Int32(int32(awssdk.ToInt64(desiredHealthCheck.UnhealthyThresholdCount)))
+		needsUpdate = true
+	}
+	return req, needsUpdate
+}
+
+// computeTargetGroupConfigKey computes a key that is identical for identical attributes and health check.
+func computeTargetGroupConfigKey(attrs map[string]string, healthCheck *elbv2api.ManagedTargetGroupHealthCheck) string {
+	var kvPairs []string
+	for _, attrKey := range sets.StringKeySet(attrs).List() {
+		kvPairs = append(kvPairs, fmt.Sprintf("%v=%v", attrKey, attrs[attrKey]))
+	}
+	if healthCheck != nil {
+		var port string
+		if healthCheck.Port != nil {
+			port = healthCheck.Port.String()
+		}
+		kvPairs = append(kvPairs, fmt.Sprintf("healthCheck=%v/%v/%v/%v/%v/%v",
+			awssdk.ToString(healthCheck.Path), port,
+			awssdk.ToInt64(healthCheck.IntervalSeconds), awssdk.ToInt64(healthCheck.TimeoutSeconds),
+			awssdk.ToInt64(healthCheck.HealthyThresholdCount), awssdk.ToInt64(healthCheck.UnhealthyThresholdCount)))
+	}
+	return strings.Join(kvPairs, ",")
+}
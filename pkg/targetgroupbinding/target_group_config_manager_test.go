@@ -0,0 +1,388 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_defaultTargetGroupConfigManager_Reconcile(t *testing.T) {
+	type describeAttributesCall struct {
+		resp *elbv2sdk.DescribeTargetGroupAttributesOutput
+		err  error
+	}
+	type modifyAttributesCall struct {
+		req *elbv2sdk.ModifyTargetGroupAttributesInput
+		err error
+	}
+	tests := []struct {
+		name                    string
+		attrs                   []elbv2api.Attribute
+		describeAttributesCalls []describeAttributesCall
+		modifyAttributesCalls   []modifyAttributesCall
+		reconcileTimes          int
+		wantErr                 error
+	}{
+		{
+			name:           "no attributes specified",
+			attrs:          nil,
+			reconcileTimes: 1,
+		},
+		{
+			name: "attributes drifted",
+			attrs: []elbv2api.Attribute{
+				{Key: "load_balancing.cross_zone.enabled", Value: "false"},
+				{Key: "deregistration_delay.timeout_seconds", Value: "30"},
+			},
+			describeAttributesCalls: []describeAttributesCall{
+				{
+					resp: &elbv2sdk.DescribeTargetGroupAttributesOutput{
+						Attributes: []elbv2types.TargetGroupAttribute{
+							{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String("true")},
+							{Key: awssdk.String("deregistration_delay.timeout_seconds"), Value: awssdk.String("30")},
+							{Key: awssdk.String("stickiness.enabled"), Value: awssdk.String("true")},
+						},
+					},
+				},
+			},
+			modifyAttributesCalls: []modifyAttributesCall{
+				{
+					req: &elbv2sdk.ModifyTargetGroupAttributesInput{
+						TargetGroupArn: awssdk.String("tg-arn"),
+						Attributes: []elbv2types.TargetGroupAttribute{
+							{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String("false")},
+						},
+					},
+				},
+			},
+			reconcileTimes: 2,
+		},
+		{
+			name: "attributes in sync",
+			attrs: []elbv2api.Attribute{
+				{Key: "load_balancing.cross_zone.enabled", Value: "false"},
+			},
+			describeAttributesCalls: []describeAttributesCall{
+				{
+					resp: &elbv2sdk.DescribeTargetGroupAttributesOutput{
+						Attributes: []elbv2types.TargetGroupAttribute{
+							{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String("false")},
+						},
+					},
+				},
+			},
+			reconcileTimes: 2,
+		},
+		{
+			name: "failed to modify attributes",
+			attrs: []elbv2api.Attribute{
+				{Key: "load_balancing.cross_zone.enabled", Value: "false"},
+			},
+			describeAttributesCalls: []describeAttributesCall{
+				{
+					resp: &elbv2sdk.DescribeTargetGroupAttributesOutput{
+						Attributes: []elbv2types.TargetGroupAttribute{
+							{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String("true")},
+						},
+					},
+				},
+			},
+			modifyAttributesCalls: []modifyAttributesCall{
+				{
+					req: &elbv2sdk.ModifyTargetGroupAttributesInput{
+						TargetGroupArn: awssdk.String("tg-arn"),
+						Attributes: []elbv2types.TargetGroupAttribute{
+							{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String("false")},
+						},
+					},
+					err: errors.New("some aws api error"),
+				},
+			},
+			reconcileTimes: 1,
+			wantErr:        errors.New("some aws api error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.describeAttributesCalls {
+				elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), &elbv2sdk.DescribeTargetGroupAttributesInput{
+					TargetGroupArn: awssdk.String("tg-arn"),
+				}).Return(call.resp, call.err)
+			}
+			for _, call := range tt.modifyAttributesCalls {
+				elbv2Client.EXPECT().ModifyTargetGroupAttributesWithContext(gomock.Any(), call.req).Return(&elbv2sdk.ModifyTargetGroupAttributesOutput{}, call.err)
+			}
+			m := NewDefaultTargetGroupConfigManager(logr.New(&log.NullLogSink{}))
+			tgb := &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN:        "tg-arn",
+					TargetGroupAttributes: tt.attrs,
+				},
+			}
+			for i := 0; i < tt.reconcileTimes; i++ {
+				err := m.Reconcile(context.Background(), elbv2Client, tgb)
+				if tt.wantErr != nil {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_defaultTargetGroupConfigManager_Reset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	elbv2Client := services.NewMockELBV2(ctrl)
+	elbv2Client.EXPECT().DescribeTargetGroupAttributesWithContext(gomock.Any(), gomock.Any()).Return(&elbv2sdk.DescribeTargetGroupAttributesOutput{
+		Attributes: []elbv2types.TargetGroupAttribute{
+			{Key: awssdk.String("load_balancing.cross_zone.enabled"), Value: awssdk.String("false")},
+		},
+	}, nil).Times(2)
+
+	m := NewDefaultTargetGroupConfigManager(logr.New(&log.NullLogSink{}))
+	tgb := &elbv2api.TargetGroupBinding{
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: "tg-arn",
+			TargetGroupAttributes: []elbv2api.Attribute{
+				{Key: "load_balancing.cross_zone.enabled", Value: "false"},
+			},
+		},
+	}
+	assert.NoError(t, m.Reconcile(context.Background(), elbv2Client, tgb))
+	m.Reset(tgb)
+	assert.NoError(t, m.Reconcile(context.Background(), elbv2Client, tgb))
+}
+
+func Test_defaultTargetGroupConfigManager_Reconcile_healthCheck(t *testing.T) {
+	type describeTargetGroupsCall struct {
+		resp []elbv2types.TargetGroup
+		err  error
+	}
+	type modifyTargetGroupCall struct {
+		req *elbv2sdk.ModifyTargetGroupInput
+		err error
+	}
+	tests := []struct {
+		name                      string
+		healthCheck               elbv2api.ManagedTargetGroupHealthCheck
+		describeTargetGroupsCalls []describeTargetGroupsCall
+		modifyTargetGroupCalls    []modifyTargetGroupCall
+		reconcileTimes            int
+		wantErr                   error
+	}{
+		{
+			name: "healthCheck drifted",
+			healthCheck: elbv2api.ManagedTargetGroupHealthCheck{
+				Path:                  awssdk.String("/healthz"),
+				Port:                  &intstr.IntOrString{Type: intstr.String, StrVal: "traffic-port"},
+				IntervalSeconds:       awssdk.Int64(10),
+				HealthyThresholdCount: awssdk.Int64(3),
+			},
+			describeTargetGroupsCalls: []describeTargetGroupsCall{
+				{
+					resp: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn:             awssdk.String("tg-arn"),
+							HealthCheckPath:            awssdk.String("/"),
+							HealthCheckPort:            awssdk.String("traffic-port"),
+							HealthCheckIntervalSeconds: awssdk.Int32(30),
+							HealthCheckTimeoutSeconds:  awssdk.Int32(5),
+							HealthyThresholdCount:      awssdk.Int32(3),
+							UnhealthyThresholdCount:    awssdk.Int32(2),
+						},
+					},
+				},
+			},
+			modifyTargetGroupCalls: []modifyTargetGroupCall{
+				{
+					req: &elbv2sdk.ModifyTargetGroupInput{
+						TargetGroupArn:             awssdk.String("tg-arn"),
+						HealthCheckPath:            awssdk.String("/healthz"),
+						HealthCheckIntervalSeconds: awssdk.Int32(10),
+					},
+				},
+			},
+			reconcileTimes: 2,
+		},
+		{
+			name: "healthCheck in sync",
+			healthCheck: elbv2api.ManagedTargetGroupHealthCheck{
+				Port: &intstr.IntOrString{Type: intstr.Int, IntVal: 8080},
+			},
+			describeTargetGroupsCalls: []describeTargetGroupsCall{
+				{
+					resp: []elbv2types.TargetGroup{
+						{
+							TargetGroupArn:  awssdk.String("tg-arn"),
+							HealthCheckPort: awssdk.String("8080"),
+						},
+					},
+				},
+			},
+			reconcileTimes: 2,
+		},
+		{
+			name: "targetGroup not found",
+			healthCheck: elbv2api.ManagedTargetGroupHealthCheck{
+				Path: awssdk.String("/healthz"),
+			},
+			describeTargetGroupsCalls: []describeTargetGroupsCall{
+				{
+					resp: nil,
+				},
+			},
+			reconcileTimes: 1,
+			wantErr:        errors.New("expecting a single targetGroup with arn tg-arn, but got 0"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			for _, call := range tt.describeTargetGroupsCalls {
+				elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), &elbv2sdk.DescribeTargetGroupsInput{
+					TargetGroupArns: []string{"tg-arn"},
+				}).Return(call.resp, call.err)
+			}
+			for _, call := range tt.modifyTargetGroupCalls {
+				elbv2Client.EXPECT().ModifyTargetGroupWithContext(gomock.Any(), call.req).Return(&elbv2sdk.ModifyTargetGroupOutput{}, call.err)
+			}
+			m := NewDefaultTargetGroupConfigManager(logr.New(&log.NullLogSink{}))
+			tgb := &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-arn",
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						HealthCheck: &tt.healthCheck,
+					},
+				},
+			}
+			for i := 0; i < tt.reconcileTimes; i++ {
+				err := m.Reconcile(context.Background(), elbv2Client, tgb)
+				if tt.wantErr != nil {
+					assert.EqualError(t, err, tt.wantErr.Error())
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_buildDesiredTargetGroupAttributes(t *testing.T) {
+	lbCookie := elbv2api.ManagedTargetGroupStickinessTypeLBCookie
+	appCookie := elbv2api.ManagedTargetGroupStickinessTypeAppCookie
+	tests := []struct {
+		name string
+		spec elbv2api.TargetGroupBindingSpec
+		want map[string]string
+	}{
+		{
+			name: "nothing specified",
+			spec: elbv2api.TargetGroupBindingSpec{},
+			want: map[string]string{},
+		},
+		{
+			name: "deregistrationDelay and lb_cookie stickiness",
+			spec: elbv2api.TargetGroupBindingSpec{
+				ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+					DeregistrationDelaySeconds: awssdk.Int64(30),
+					Stickiness: &elbv2api.ManagedTargetGroupStickiness{
+						Enabled:         true,
+						Type:            &lbCookie,
+						DurationSeconds: awssdk.Int64(3600),
+					},
+				},
+			},
+			want: map[string]string{
+				"deregistration_delay.timeout_seconds":  "30",
+				"stickiness.enabled":                    "true",
+				"stickiness.type":                       "lb_cookie",
+				"stickiness.lb_cookie.duration_seconds": "3600",
+			},
+		},
+		{
+			name: "app_cookie stickiness along with targetGroupAttributes",
+			spec: elbv2api.TargetGroupBindingSpec{
+				TargetGroupAttributes: []elbv2api.Attribute{
+					{Key: "stickiness.app_cookie.cookie_name", Value: "session"},
+				},
+				ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+					Stickiness: &elbv2api.ManagedTargetGroupStickiness{
+						Enabled:         true,
+						Type:            &appCookie,
+						DurationSeconds: awssdk.Int64(60),
+					},
+				},
+			},
+			want: map[string]string{
+				"stickiness.enabled":                     "true",
+				"stickiness.type":                        "app_cookie",
+				"stickiness.app_cookie.duration_seconds": "60",
+				"stickiness.app_cookie.cookie_name":      "session",
+			},
+		},
+		{
+			name: "stickiness disabled",
+			spec: elbv2api.TargetGroupBindingSpec{
+				ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+					Stickiness: &elbv2api.ManagedTargetGroupStickiness{
+						Enabled: false,
+					},
+				},
+			},
+			want: map[string]string{
+				"stickiness.enabled": "false",
+			},
+		},
+		{
+			name: "unhealthyTargetState and targetGroupHealth",
+			spec: elbv2api.TargetGroupBindingSpec{
+				ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+					UnhealthyTargetState: &elbv2api.ManagedTargetGroupUnhealthyTargetState{
+						ConnectionTermination:   awssdk.Bool(false),
+						DrainingIntervalSeconds: awssdk.Int64(300),
+					},
+					TargetGroupHealth: &elbv2api.ManagedTargetGroupHealth{
+						DNSFailover: &elbv2api.ManagedTargetGroupMinimumHealthyTargets{
+							Count:      &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+							Percentage: &intstr.IntOrString{Type: intstr.String, StrVal: "off"},
+						},
+						UnhealthyStateRouting: &elbv2api.ManagedTargetGroupMinimumHealthyTargets{
+							Percentage: &intstr.IntOrString{Type: intstr.Int, IntVal: 50},
+						},
+					},
+				},
+			},
+			want: map[string]string{
+				"target_health_state.unhealthy.connection_termination.enabled":                   "false",
+				"target_health_state.unhealthy.draining_interval_seconds":                        "300",
+				"target_group_health.dns_failover.minimum_healthy_targets.count":                 "2",
+				"target_group_health.dns_failover.minimum_healthy_targets.percentage":            "off",
+				"target_group_health.unhealthy_state_routing.minimum_healthy_targets.percentage": "50",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildDesiredTargetGroupAttributes(&elbv2api.TargetGroupBinding{Spec: tt.spec})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
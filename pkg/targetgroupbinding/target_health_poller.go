@@ -0,0 +1,151 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+)
+
+// TargetHealthPoller polls the health of targets in TargetGroups.
+type TargetHealthPoller interface {
+	// PollTargetHealth returns the health of specified targets in TargetGroup.
+	// if targets is empty, the health of all registered targets is returned.
+	PollTargetHealth(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) ([]TargetInfo, error)
+
+	// Invalidate drops the polled health for TargetGroup, it should be called once targets are registered or deregistered.
+	Invalidate(tgARN string)
+}
+
+// NewCachedTargetHealthPoller constructs new cachedTargetHealthPoller.
+func NewCachedTargetHealthPoller(elbv2Client services.ELBV2, pollInterval time.Duration, logger logr.Logger) *cachedTargetHealthPoller {
+	return &cachedTargetHealthPoller{
+		elbv2Client:  elbv2Client,
+		pollInterval: pollInterval,
+		healthCache:  cache.NewExpiring(),
+		logger:       logger,
+	}
+}
+
+var _ TargetHealthPoller = &cachedTargetHealthPoller{}
+
+// cachedTargetHealthPoller is a TargetHealthPoller that is shared by all TargetGroupBindings using the same ELBV2 client.
+// The health of all targets in a TargetGroup is described by a single API call, and cached for pollInterval.
+// Concurrent polls for the same TargetGroup are served by the same API call.
+type cachedTargetHealthPoller struct {
+	elbv2Client  services.ELBV2
+	pollInterval time.Duration
+
+	// cache of targetHealthCacheItem by targetGroupARN.
+	// NOTE: since this cache implementation will automatically GC expired entries, we don't need to GC entries.
+	healthCache *cache.Expiring
+	// healthCacheMutex protects healthCache
+	healthCacheMutex sync.Mutex
+
+	logger logr.Logger
+}
+
+// cache entry for healthCache
+type targetHealthCacheItem struct {
+	// mutex serializes polls for the TargetGroup, and protects below fields
+	mutex sync.Mutex
+	// polled is whether targetsByUniqueID is populated.
+	polled bool
+	// targetsByUniqueID is the registered targets for TargetGroup.
+	targetsByUniqueID map[string]TargetInfo
+	// targetIDs is the unique IDs of registered targets in the order returned by ELBV2 API.
+	targetIDs []string
+}
+
+func (p *cachedTargetHealthPoller) PollTargetHealth(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) ([]TargetInfo, error) {
+	p.healthCacheMutex.Lock()
+	var item *targetHealthCacheItem
+	if rawItem, exists := p.healthCache.Get(tgARN); exists {
+		item = rawItem.(*targetHealthCacheItem)
+	} else {
+		item = &targetHealthCacheItem{}
+		p.healthCache.Set(tgARN, item, p.pollInterval)
+	}
+	p.healthCacheMutex.Unlock()
+
+	item.mutex.Lock()
+	defer item.mutex.Unlock()
+	if item.polled {
+		if polledTargets, ok := item.lookupTargets(targets); ok {
+			return polledTargets, nil
+		}
+	}
+	if err := p.pollAllTargets(ctx, tgARN, item); err != nil {
+		return nil, err
+	}
+	polledTargets, _ := item.lookupTargets(targets)
+	return polledTargets, nil
+}
+
+func (p *cachedTargetHealthPoller) Invalidate(tgARN string) {
+	p.healthCacheMutex.Lock()
+	defer p.healthCacheMutex.Unlock()
+	p.healthCache.Delete(tgARN)
+}
+
+// pollAllTargets describes the health of all registered targets for TargetGroup into item.
+func (p *cachedTargetHealthPoller) pollAllTargets(ctx context.Context, tgARN string, item *targetHealthCacheItem) error {
+	req := &elbv2sdk.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(tgARN),
+	}
+	resp, err := p.elbv2Client.DescribeTargetHealthWithContext(ctx, req)
+	if err != nil {
+		return err
+	}
+	item.targetsByUniqueID = make(map[string]TargetInfo, len(resp.TargetHealthDescriptions))
+	item.targetIDs = make([]string, 0, len(resp.TargetHealthDescriptions))
+	for _, elem := range resp.TargetHealthDescriptions {
+		targetID := UniqueIDForTargetDescription(*elem.Target)
+		item.targetsByUniqueID[targetID] = TargetInfo{
+			Target:       *elem.Target,
+			TargetHealth: elem.TargetHealth,
+		}
+		item.targetIDs = append(item.targetIDs, targetID)
+	}
+	item.polled = true
+	p.logger.V(1).Info("polled targets health",
+		"arn", tgARN,
+		"targets", len(item.targetIDs))
+	return nil
+}
+
+// lookupTargets returns the polled health of targets, or all polled targets if targets is empty.
+// targets absent from polled targets are reported as not registered, with ok set to false.
+func (item *targetHealthCacheItem) lookupTargets(targets []elbv2types.TargetDescription) ([]TargetInfo, bool) {
+	if len(targets) == 0 {
+		result := make([]TargetInfo, 0, len(item.targetIDs))
+		for _, targetID := range item.targetIDs {
+			result = append(result, item.targetsByUniqueID[targetID])
+		}
+		return result, true
+	}
+
+	allFound := true
+	result := make([]TargetInfo, 0, len(targets))
+	for _, target := range targets {
+		if targetInfo, exists := item.targetsByUniqueID[UniqueIDForTargetDescription(target)]; exists {
+			result = append(result, targetInfo)
+			continue
+		}
+		allFound = false
+		result = append(result, TargetInfo{
+			Target: target,
+			TargetHealth: &elbv2types.TargetHealth{
+				State:  elbv2types.TargetHealthStateEnumUnused,
+				Reason: elbv2types.TargetHealthReasonEnumNotRegistered,
+			},
+		})
+	}
+	return result, allFound
+}
@@ -0,0 +1,174 @@
+package targetgroupbinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/go-logr/logr"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+func Test_cachedTargetHealthPoller_PollTargetHealth(t *testing.T) {
+	healthyTarget := func(id string) TargetInfo {
+		return TargetInfo{
+			Target: elbv2types.TargetDescription{
+				Id:   awssdk.String(id),
+				Port: awssdk.Int32(8080),
+			},
+			TargetHealth: &elbv2types.TargetHealth{
+				State: elbv2types.TargetHealthStateEnumHealthy,
+			},
+		}
+	}
+	notRegisteredTarget := func(id string) TargetInfo {
+		return TargetInfo{
+			Target: elbv2types.TargetDescription{
+				Id:   awssdk.String(id),
+				Port: awssdk.Int32(8080),
+			},
+			TargetHealth: &elbv2types.TargetHealth{
+				State:  elbv2types.TargetHealthStateEnumUnused,
+				Reason: elbv2types.TargetHealthReasonEnumNotRegistered,
+			},
+		}
+	}
+	describeOutput := func(targets ...TargetInfo) *elbv2sdk.DescribeTargetHealthOutput {
+		output := &elbv2sdk.DescribeTargetHealthOutput{}
+		for i := range targets {
+			output.TargetHealthDescriptions = append(output.TargetHealthDescriptions, elbv2types.TargetHealthDescription{
+				Target:       &targets[i].Target,
+				TargetHealth: targets[i].TargetHealth,
+			})
+		}
+		return output
+	}
+	type describeTargetHealthWithContextCall struct {
+		resp *elbv2sdk.DescribeTargetHealthOutput
+		err  error
+	}
+	type pollCall struct {
+		targetIDs  []string
+		invalidate bool
+		want       []TargetInfo
+		wantErr    error
+	}
+	tests := []struct {
+		name                                 string
+		describeTargetHealthWithContextCalls []describeTargetHealthWithContextCall
+		pollCalls                            []pollCall
+	}{
+		{
+			name: "polled health is cached for all targets",
+			describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+				{
+					resp: describeOutput(healthyTarget("192.168.1.1"), healthyTarget("192.168.1.2")),
+				},
+			},
+			pollCalls: []pollCall{
+				{
+					want: []TargetInfo{healthyTarget("192.168.1.1"), healthyTarget("192.168.1.2")},
+				},
+				{
+					targetIDs: []string{"192.168.1.2"},
+					want:      []TargetInfo{healthyTarget("192.168.1.2")},
+				},
+			},
+		},
+		{
+			name: "unknown targets are polled again",
+			describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+				{
+					resp: describeOutput(healthyTarget("192.168.1.1")),
+				},
+				{
+					resp: describeOutput(healthyTarget("192.168.1.1")),
+				},
+			},
+			pollCalls: []pollCall{
+				{
+					targetIDs: []string{"192.168.1.1"},
+					want:      []TargetInfo{healthyTarget("192.168.1.1")},
+				},
+				{
+					targetIDs: []string{"192.168.1.1", "192.168.1.2"},
+					want:      []TargetInfo{healthyTarget("192.168.1.1"), notRegisteredTarget("192.168.1.2")},
+				},
+			},
+		},
+		{
+			name: "invalidated health is polled again",
+			describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+				{
+					resp: describeOutput(healthyTarget("192.168.1.1"), healthyTarget("192.168.1.2")),
+				},
+				{
+					resp: describeOutput(healthyTarget("192.168.1.1")),
+				},
+			},
+			pollCalls: []pollCall{
+				{
+					want: []TargetInfo{healthyTarget("192.168.1.1"), healthyTarget("192.168.1.2")},
+				},
+				{
+					invalidate: true,
+					want:       []TargetInfo{healthyTarget("192.168.1.1")},
+				},
+			},
+		},
+		{
+			name: "describe target health failed",
+			describeTargetHealthWithContextCalls: []describeTargetHealthWithContextCall{
+				{
+					err: errors.New("some error"),
+				},
+			},
+			pollCalls: []pollCall{
+				{
+					targetIDs: []string{"192.168.1.1"},
+					wantErr:   errors.New("some error"),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			elbv2Client := services.NewMockELBV2(ctrl)
+			var calls []*gomock.Call
+			for _, call := range tt.describeTargetHealthWithContextCalls {
+				calls = append(calls, elbv2Client.EXPECT().DescribeTargetHealthWithContext(gomock.Any(), &elbv2sdk.DescribeTargetHealthInput{
+					TargetGroupArn: awssdk.String("my-tg"),
+				}).Return(call.resp, call.err))
+			}
+			gomock.InOrder(calls...)
+
+			p := NewCachedTargetHealthPoller(elbv2Client, time.Minute, logr.New(&log.NullLogSink{}))
+			for _, call := range tt.pollCalls {
+				if call.invalidate {
+					p.Invalidate("my-tg")
+				}
+				var targets []elbv2types.TargetDescription
+				for _, id := range call.targetIDs {
+					targets = append(targets, elbv2types.TargetDescription{Id: awssdk.String(id), Port: awssdk.Int32(8080)})
+				}
+				got, err := p.PollTargetHealth(context.Background(), "my-tg", targets)
+				if call.wantErr != nil {
+					assert.EqualError(t, err, call.wantErr.Error())
+				} else {
+					assert.NoError(t, err)
+					assert.Equal(t, call.want, got)
+				}
+			}
+		})
+	}
+}
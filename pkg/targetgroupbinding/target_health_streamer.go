@@ -0,0 +1,121 @@
+package targetgroupbinding
+
+import (
+	"sync"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+const (
+	metricWorkloadHealthyTargetsRatio = "workload_healthy_targets_ratio"
+
+	labelNamespace          = "namespace"
+	labelWorkloadKind       = "workload_kind"
+	labelWorkload           = "workload"
+	labelTargetGroupBinding = "target_group_binding"
+)
+
+// TargetHealthStreamer publishes the target health observed by the load balancer, aggregated per workload of pods,
+// so that workloads can be autoscaled via HPA external metrics based on the load balancer observed health rather than only the pod readiness.
+type TargetHealthStreamer struct {
+	healthyRatio *prometheus.GaugeVec
+
+	// mutex protects workloadsByTGB.
+	mutex sync.Mutex
+	// workloadsByTGB is the workloads observed per TargetGroupBinding, whose series are deleted once they're no longer observed.
+	workloadsByTGB map[types.NamespacedName][]k8s.PodWorkload
+}
+
+// NewTargetHealthStreamer allocates and register new TargetHealthStreamer to registerer.
+func NewTargetHealthStreamer(registerer prometheus.Registerer) (*TargetHealthStreamer, error) {
+	healthyRatio := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemTargetGroupBinding,
+		Name:      metricWorkloadHealthyTargetsRatio,
+		Help:      "Fraction of the targets of a workload that are healthy in the target group of TargetGroupBinding",
+	}, []string{labelNamespace, labelWorkloadKind, labelWorkload, labelTargetGroupBinding})
+	if err := registerer.Register(healthyRatio); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricWorkloadHealthyTargetsRatio)
+	}
+	return &TargetHealthStreamer{
+		healthyRatio:   healthyRatio,
+		workloadsByTGB: make(map[types.NamespacedName][]k8s.PodWorkload),
+	}, nil
+}
+
+// workloadTargetsCount is the number of targets of a workload.
+type workloadTargetsCount struct {
+	healthy int
+	total   int
+}
+
+// Observe records the health of targets for pod endpoints of TargetGroupBinding, the unmatched endpoints are not healthy as they're being registered.
+// Pods that aren't controlled by any workload are ignored.
+// A nil TargetHealthStreamer doesn't record target health.
+func (s *TargetHealthStreamer) Observe(tgb *elbv2api.TargetGroupBinding, matchedEndpointAndTargets []podEndpointAndTargetPair, unmatchedEndpoints []backend.PodEndpoint) {
+	if s == nil {
+		return
+	}
+	countByWorkload := make(map[k8s.PodWorkload]*workloadTargetsCount)
+	countTarget := func(pod k8s.PodInfo, healthy bool) {
+		if pod.Workload.Name == "" {
+			return
+		}
+		count, exists := countByWorkload[pod.Workload]
+		if !exists {
+			count = &workloadTargetsCount{}
+			countByWorkload[pod.Workload] = count
+		}
+		count.total++
+		if healthy {
+			count.healthy++
+		}
+	}
+	for _, endpointAndTarget := range matchedEndpointAndTargets {
+		targetHealth := endpointAndTarget.target.TargetHealth
+		healthy := targetHealth != nil && targetHealth.State == elbv2types.TargetHealthStateEnumHealthy
+		countTarget(endpointAndTarget.endpoint.Pod, healthy)
+	}
+	for _, endpoint := range unmatchedEndpoints {
+		countTarget(endpoint.Pod, false)
+	}
+
+	tgbKey := k8s.NamespacedName(tgb)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, workload := range s.workloadsByTGB[tgbKey] {
+		if _, exists := countByWorkload[workload]; !exists {
+			s.healthyRatio.DeleteLabelValues(tgbKey.Namespace, workload.Kind, workload.Name, tgbKey.Name)
+		}
+	}
+	workloads := make([]k8s.PodWorkload, 0, len(countByWorkload))
+	for workload, count := range countByWorkload {
+		s.healthyRatio.WithLabelValues(tgbKey.Namespace, workload.Kind, workload.Name, tgbKey.Name).Set(float64(count.healthy) / float64(count.total))
+		workloads = append(workloads, workload)
+	}
+	if len(workloads) == 0 {
+		delete(s.workloadsByTGB, tgbKey)
+		return
+	}
+	s.workloadsByTGB[tgbKey] = workloads
+}
+
+// Reset removes the target health of TargetGroupBinding, e.g. once it's deleted.
+// A nil TargetHealthStreamer doesn't record target health.
+func (s *TargetHealthStreamer) Reset(tgb *elbv2api.TargetGroupBinding) {
+	if s == nil {
+		return
+	}
+	tgbKey := k8s.NamespacedName(tgb)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, workload := range s.workloadsByTGB[tgbKey] {
+		s.healthyRatio.DeleteLabelValues(tgbKey.Namespace, workload.Kind, workload.Name, tgbKey.Name)
+	}
+	delete(s.workloadsByTGB, tgbKey)
+}
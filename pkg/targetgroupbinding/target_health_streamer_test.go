@@ -0,0 +1,64 @@
+package targetgroupbinding
+
+import (
+	"testing"
+
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/backend"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+)
+
+func TestTargetHealthStreamer(t *testing.T) {
+	streamer, err := NewTargetHealthStreamer(prometheus.NewRegistry())
+	assert.NoError(t, err)
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-tgb"},
+	}
+	web := k8s.PodWorkload{Kind: "Deployment", Name: "web"}
+	db := k8s.PodWorkload{Kind: "StatefulSet", Name: "db"}
+	endpointAndTarget := func(workload k8s.PodWorkload, state elbv2types.TargetHealthStateEnum) podEndpointAndTargetPair {
+		return podEndpointAndTargetPair{
+			endpoint: backend.PodEndpoint{Pod: k8s.PodInfo{Workload: workload}},
+			target: TargetInfo{
+				TargetHealth: &elbv2types.TargetHealth{State: state},
+			},
+		}
+	}
+
+	streamer.Observe(tgb, []podEndpointAndTargetPair{
+		endpointAndTarget(web, elbv2types.TargetHealthStateEnumHealthy),
+		endpointAndTarget(web, elbv2types.TargetHealthStateEnumHealthy),
+		endpointAndTarget(web, elbv2types.TargetHealthStateEnumUnhealthy),
+		endpointAndTarget(db, elbv2types.TargetHealthStateEnumHealthy),
+		endpointAndTarget(k8s.PodWorkload{}, elbv2types.TargetHealthStateEnumUnhealthy),
+	}, []backend.PodEndpoint{
+		{Pod: k8s.PodInfo{Workload: web}},
+	})
+	assert.Equal(t, 2, testutil.CollectAndCount(streamer.healthyRatio))
+	assert.Equal(t, 0.5, testutil.ToFloat64(streamer.healthyRatio.WithLabelValues("ns", "Deployment", "web", "my-tgb")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(streamer.healthyRatio.WithLabelValues("ns", "StatefulSet", "db", "my-tgb")))
+
+	streamer.Observe(tgb, []podEndpointAndTargetPair{
+		endpointAndTarget(web, elbv2types.TargetHealthStateEnumHealthy),
+	}, nil)
+	assert.Equal(t, 1, testutil.CollectAndCount(streamer.healthyRatio))
+	assert.Equal(t, float64(1), testutil.ToFloat64(streamer.healthyRatio.WithLabelValues("ns", "Deployment", "web", "my-tgb")))
+
+	streamer.Reset(tgb)
+	assert.Equal(t, 0, testutil.CollectAndCount(streamer.healthyRatio))
+	assert.Empty(t, streamer.workloadsByTGB)
+}
+
+func TestTargetHealthStreamer_nil(t *testing.T) {
+	var streamer *TargetHealthStreamer
+	tgb := &elbv2api.TargetGroupBinding{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "my-tgb"},
+	}
+	streamer.Observe(tgb, nil, nil)
+	streamer.Reset(tgb)
+}
@@ -0,0 +1,64 @@
+package targetgroupbinding
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricTargetsBatchQueueDepth = "targets_batch_queue_depth"
+	metricTargetsBatchSize       = "targets_batch_size"
+)
+
+const (
+	labelOperation = "operation"
+
+	operationRegisterTargets   = "register"
+	operationDeregisterTargets = "deregister"
+)
+
+// TargetsBatchMetrics contains the metrics shared by all batched TargetsManager instances.
+type TargetsBatchMetrics struct {
+	queueDepth *prometheus.GaugeVec
+	batchSize  *prometheus.HistogramVec
+}
+
+// NewTargetsBatchMetrics allocates and register new TargetsBatchMetrics to registerer.
+func NewTargetsBatchMetrics(registerer prometheus.Registerer) (*TargetsBatchMetrics, error) {
+	queueDepth := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Subsystem: metricSubsystemTargetGroupBinding,
+		Name:      metricTargetsBatchQueueDepth,
+		Help:      "Number of targets waiting to be registered or deregistered",
+	}, []string{labelOperation})
+	batchSize := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Subsystem: metricSubsystemTargetGroupBinding,
+		Name:      metricTargetsBatchSize,
+		Help:      "Number of targets registered or deregistered per flushed batch",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 7),
+	}, []string{labelOperation})
+
+	if err := registerer.Register(queueDepth); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricTargetsBatchQueueDepth)
+	}
+	if err := registerer.Register(batchSize); err != nil {
+		return nil, errors.Wrapf(err, "failed to register metric: %v", metricTargetsBatchSize)
+	}
+	return &TargetsBatchMetrics{
+		queueDepth: queueDepth,
+		batchSize:  batchSize,
+	}, nil
+}
+
+func (m *TargetsBatchMetrics) addQueueDepth(operation string, delta int) {
+	if m == nil || delta == 0 {
+		return
+	}
+	m.queueDepth.WithLabelValues(operation).Add(float64(delta))
+}
+
+func (m *TargetsBatchMetrics) observeBatchSize(operation string, size int) {
+	if m == nil || size == 0 {
+		return
+	}
+	m.batchSize.WithLabelValues(operation).Observe(float64(size))
+}
@@ -2,8 +2,10 @@ package targetgroupbinding
 
 import (
 	"context"
-	"github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
@@ -20,19 +22,23 @@ const (
 // TargetsManager is an abstraction around ELBV2's targets API.
 type TargetsManager interface {
 	// Register Targets into TargetGroup.
-	RegisterTargets(ctx context.Context, tgARN string, targets []elbv2sdk.TargetDescription) error
+	RegisterTargets(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) error
 
 	// Deregister Targets from TargetGroup.
-	DeregisterTargets(ctx context.Context, tgARN string, targets []elbv2sdk.TargetDescription) error
+	DeregisterTargets(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) error
 
 	// List Targets from TargetGroup.
 	ListTargets(ctx context.Context, tgARN string) ([]TargetInfo, error)
 }
 
 // NewCachedTargetsManager constructs new cachedTargetsManager
-func NewCachedTargetsManager(elbv2Client services.ELBV2, logger logr.Logger) *cachedTargetsManager {
+// the health of targets is polled via a TargetHealthPoller that caches for targetHealthPollInterval.
+// the health of healthy targets is polled as well if refreshHealthyTargets is set, e.g. when target health is streamed.
+func NewCachedTargetsManager(elbv2Client services.ELBV2, targetHealthPollInterval time.Duration, refreshHealthyTargets bool, logger logr.Logger) *cachedTargetsManager {
 	return &cachedTargetsManager{
 		elbv2Client:                elbv2Client,
+		targetHealthPoller:         NewCachedTargetHealthPoller(elbv2Client, targetHealthPollInterval, logger),
+		refreshHealthyTargets:      refreshHealthyTargets,
 		targetsCache:               cache.NewExpiring(),
 		targetsCacheTTL:            defaultTargetsCacheTTL,
 		registerTargetsChunkSize:   defaultRegisterTargetsChunkSize,
@@ -46,7 +52,8 @@ var _ TargetsManager = &cachedTargetsManager{}
 // an cached implementation for TargetsManager.
 // Targets for each TargetGroup will be refreshed per targetsCacheTTL.
 // When list Targets with RefreshTargets list Option set,
-// only targets with ongoing TargetHealth(unknown/initial/draining) TargetHealth will be refreshed.
+// only targets with ongoing TargetHealth(unknown/initial/draining) TargetHealth will be refreshed,
+// unless refreshHealthyTargets is set, in which case all targets will be refreshed.
 type cachedTargetsManager struct {
 	elbv2Client services.ELBV2
 
@@ -59,6 +66,11 @@ type cachedTargetsManager struct {
 	// targetsCacheMutex protects targetsCache
 	targetsCacheMutex sync.RWMutex
 
+	// targetHealthPoller is optional, targets health is described directly from ELBV2 API when it's nil.
+	targetHealthPoller TargetHealthPoller
+	// refreshHealthyTargets is whether to refresh healthy targets as well, so that healthy targets turning unhealthy are observed.
+	refreshHealthyTargets bool
+
 	// chunk size for registerTargets API call.
 	registerTargetsChunkSize int
 	// chunk size for deregisterTargets API call.
@@ -75,12 +87,12 @@ type targetsCacheItem struct {
 	targets []TargetInfo
 }
 
-func (m *cachedTargetsManager) RegisterTargets(ctx context.Context, tgARN string, targets []elbv2sdk.TargetDescription) error {
+func (m *cachedTargetsManager) RegisterTargets(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) error {
 	targetsChunks := chunkTargetDescriptions(targets, m.registerTargetsChunkSize)
 	for _, targetsChunk := range targetsChunks {
 		req := &elbv2sdk.RegisterTargetsInput{
 			TargetGroupArn: aws.String(tgARN),
-			Targets:        pointerizeTargetDescriptions(targetsChunk),
+			Targets:        targetsChunk,
 		}
 		m.logger.Info("registering targets",
 			"arn", tgARN,
@@ -96,12 +108,12 @@ func (m *cachedTargetsManager) RegisterTargets(ctx context.Context, tgARN string
 	return nil
 }
 
-func (m *cachedTargetsManager) DeregisterTargets(ctx context.Context, tgARN string, targets []elbv2sdk.TargetDescription) error {
+func (m *cachedTargetsManager) DeregisterTargets(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) error {
 	targetsChunks := chunkTargetDescriptions(targets, m.deregisterTargetsChunkSize)
 	for _, targetsChunk := range targetsChunks {
 		req := &elbv2sdk.DeregisterTargetsInput{
 			TargetGroupArn: aws.String(tgARN),
-			Targets:        pointerizeTargetDescriptions(targetsChunk),
+			Targets:        targetsChunk,
 		}
 		m.logger.Info("deRegistering targets",
 			"arn", tgARN,
@@ -125,7 +137,13 @@ func (m *cachedTargetsManager) ListTargets(ctx context.Context, tgARN string) ([
 		targetsCacheItem := rawTargetsCacheItem.(*targetsCacheItem)
 		targetsCacheItem.mutex.Lock()
 		defer targetsCacheItem.mutex.Unlock()
-		refreshedTargets, err := m.refreshUnhealthyTargets(ctx, tgARN, targetsCacheItem.targets)
+		var refreshedTargets []TargetInfo
+		var err error
+		if m.refreshHealthyTargets {
+			refreshedTargets, err = m.refreshAllTargets(ctx, tgARN)
+		} else {
+			refreshedTargets, err = m.refreshUnhealthyTargets(ctx, tgARN, targetsCacheItem.targets)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -156,10 +174,10 @@ func (m *cachedTargetsManager) refreshAllTargets(ctx context.Context, tgARN stri
 
 // refreshUnhealthyTargets will refresh targets that are not in healthy status for targetGroup.
 // To save API calls, we don't refresh targets that are already healthy since once a target turns healthy, we'll unblock it's readinessProbe.
-// we can do nothing from controller perspective when a healthy target becomes unhealthy.
+// we can do nothing from controller perspective when a healthy target becomes unhealthy, unless target health is streamed.
 func (m *cachedTargetsManager) refreshUnhealthyTargets(ctx context.Context, tgARN string, cachedTargets []TargetInfo) ([]TargetInfo, error) {
 	var refreshedTargets []TargetInfo
-	var unhealthyTargets []elbv2sdk.TargetDescription
+	var unhealthyTargets []elbv2types.TargetDescription
 	for _, cachedTarget := range cachedTargets {
 		if cachedTarget.IsHealthy() {
 			refreshedTargets = append(refreshedTargets, cachedTarget)
@@ -187,10 +205,13 @@ func (m *cachedTargetsManager) refreshUnhealthyTargets(ctx context.Context, tgAR
 // listTargetsFromAWS will list targets for TargetGroup using ELBV2API.
 // if specified targets is non-empty, only these targets will be listed.
 // otherwise, all targets for targetGroup will be listed.
-func (m *cachedTargetsManager) listTargetsFromAWS(ctx context.Context, tgARN string, targets []elbv2sdk.TargetDescription) ([]TargetInfo, error) {
+func (m *cachedTargetsManager) listTargetsFromAWS(ctx context.Context, tgARN string, targets []elbv2types.TargetDescription) ([]TargetInfo, error) {
+	if m.targetHealthPoller != nil {
+		return m.targetHealthPoller.PollTargetHealth(ctx, tgARN, targets)
+	}
 	req := &elbv2sdk.DescribeTargetHealthInput{
 		TargetGroupArn: aws.String(tgARN),
-		Targets:        pointerizeTargetDescriptions(targets),
+		Targets:        targets,
 	}
 	resp, err := m.elbv2Client.DescribeTargetHealthWithContext(ctx, req)
 	if err != nil {
@@ -208,7 +229,10 @@ func (m *cachedTargetsManager) listTargetsFromAWS(ctx context.Context, tgARN str
 }
 
 // recordSuccessfulRegisterTargetsOperation will record a successful deregisterTarget operation
-func (m *cachedTargetsManager) recordSuccessfulRegisterTargetsOperation(tgARN string, targets []elbv2sdk.TargetDescription) {
+func (m *cachedTargetsManager) recordSuccessfulRegisterTargetsOperation(tgARN string, targets []elbv2types.TargetDescription) {
+	if m.targetHealthPoller != nil {
+		m.targetHealthPoller.Invalidate(tgARN)
+	}
 	m.targetsCacheMutex.RLock()
 	rawTargetsCacheItem, exists := m.targetsCache.Get(tgARN)
 	m.targetsCacheMutex.RUnlock()
@@ -216,7 +240,7 @@ func (m *cachedTargetsManager) recordSuccessfulRegisterTargetsOperation(tgARN st
 	if !exists {
 		return
 	}
-	targetsByUniqueID := make(map[string]elbv2sdk.TargetDescription, len(targets))
+	targetsByUniqueID := make(map[string]elbv2types.TargetDescription, len(targets))
 	for _, target := range targets {
 		targetsByUniqueID[UniqueIDForTargetDescription(target)] = target
 	}
@@ -241,7 +265,10 @@ func (m *cachedTargetsManager) recordSuccessfulRegisterTargetsOperation(tgARN st
 }
 
 // recordSuccessfulDeregisterTargetsOperation will record a successful deregisterTarget operation
-func (m *cachedTargetsManager) recordSuccessfulDeregisterTargetsOperation(tgARN string, targets []elbv2sdk.TargetDescription) {
+func (m *cachedTargetsManager) recordSuccessfulDeregisterTargetsOperation(tgARN string, targets []elbv2types.TargetDescription) {
+	if m.targetHealthPoller != nil {
+		m.targetHealthPoller.Invalidate(tgARN)
+	}
 	m.targetsCacheMutex.RLock()
 	rawTargetsCacheItem, exists := m.targetsCache.Get(tgARN)
 	m.targetsCacheMutex.RUnlock()
@@ -249,7 +276,7 @@ func (m *cachedTargetsManager) recordSuccessfulDeregisterTargetsOperation(tgARN
 	if !exists {
 		return
 	}
-	targetsByUniqueID := make(map[string]elbv2sdk.TargetDescription, len(targets))
+	targetsByUniqueID := make(map[string]elbv2types.TargetDescription, len(targets))
 	for _, target := range targets {
 		targetsByUniqueID[UniqueIDForTargetDescription(target)] = target
 	}
@@ -267,8 +294,8 @@ func (m *cachedTargetsManager) recordSuccessfulDeregisterTargetsOperation(tgARN
 }
 
 // chunkTargetDescriptions will split slice of TargetDescription into chunks
-func chunkTargetDescriptions(targets []elbv2sdk.TargetDescription, chunkSize int) [][]elbv2sdk.TargetDescription {
-	var chunks [][]elbv2sdk.TargetDescription
+func chunkTargetDescriptions(targets []elbv2types.TargetDescription, chunkSize int) [][]elbv2types.TargetDescription {
+	var chunks [][]elbv2types.TargetDescription
 	for i := 0; i < len(targets); i += chunkSize {
 		end := i + chunkSize
 		if end > len(targets) {
@@ -279,19 +306,6 @@ func chunkTargetDescriptions(targets []elbv2sdk.TargetDescription, chunkSize int
 	return chunks
 }
 
-// pointerizeTargetDescriptions converts slice of TargetDescription into slice of pointers to TargetDescription
-// if targets is empty or nil, nil will be returned.
-func pointerizeTargetDescriptions(targets []elbv2sdk.TargetDescription) []*elbv2sdk.TargetDescription {
-	if len(targets) == 0 {
-		return nil
-	}
-	result := make([]*elbv2sdk.TargetDescription, 0, len(targets))
-	for i := range targets {
-		result = append(result, &targets[i])
-	}
-	return result
-}
-
 // cloneTargetInfoSlice returns a clone of TargetInfoSlice.
 func cloneTargetInfoSlice(targets []TargetInfo) []TargetInfo {
 	return append(targets[:0:0], targets...)
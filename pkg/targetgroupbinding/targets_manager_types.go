@@ -2,18 +2,19 @@ package targetgroupbinding
 
 import (
 	"fmt"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 )
 
 // TargetInfo contains information about a TargetGroup target.
 type TargetInfo struct {
 	// The target's description
-	Target elbv2sdk.TargetDescription
+	Target elbv2types.TargetDescription
 
 	// The target's health information.
 	// If absent, the target's health information is unknown.
-	TargetHealth *elbv2sdk.TargetHealth
+	TargetHealth *elbv2types.TargetHealth
 }
 
 // IsHealthy returns whether target is healthy.
@@ -21,7 +22,7 @@ func (t *TargetInfo) IsHealthy() bool {
 	if t.TargetHealth == nil {
 		return false
 	}
-	return awssdk.StringValue(t.TargetHealth.State) == elbv2sdk.TargetHealthStateEnumHealthy
+	return t.TargetHealth.State == elbv2types.TargetHealthStateEnumHealthy
 }
 
 // IsNotRegistered returns whether target is not registered.
@@ -29,8 +30,8 @@ func (t *TargetInfo) IsNotRegistered() bool {
 	if t.TargetHealth == nil {
 		return false
 	}
-	return awssdk.StringValue(t.TargetHealth.State) == elbv2sdk.TargetHealthStateEnumUnused &&
-		awssdk.StringValue(t.TargetHealth.Reason) == elbv2sdk.TargetHealthReasonEnumTargetNotRegistered
+	return t.TargetHealth.State == elbv2types.TargetHealthStateEnumUnused &&
+		t.TargetHealth.Reason == elbv2types.TargetHealthReasonEnumNotRegistered
 }
 
 // IsDraining returns whether target is in draining state.
@@ -38,7 +39,7 @@ func (t *TargetInfo) IsDraining() bool {
 	if t.TargetHealth == nil {
 		return false
 	}
-	return awssdk.StringValue(t.TargetHealth.State) == elbv2sdk.TargetHealthStateEnumDraining
+	return t.TargetHealth.State == elbv2types.TargetHealthStateEnumDraining
 }
 
 // IsInitial returns whether target is in initial state.
@@ -46,10 +47,10 @@ func (t *TargetInfo) IsInitial() bool {
 	if t.TargetHealth == nil {
 		return false
 	}
-	return awssdk.StringValue(t.TargetHealth.State) == elbv2sdk.TargetHealthStateEnumInitial
+	return t.TargetHealth.State == elbv2types.TargetHealthStateEnumInitial
 }
 
 // UniqueIDForTargetDescription generates a unique ID to differentiate targets.
-func UniqueIDForTargetDescription(target elbv2sdk.TargetDescription) string {
-	return fmt.Sprintf("%v:%v", awssdk.StringValue(target.Id), awssdk.Int64Value(target.Port))
+func UniqueIDForTargetDescription(target elbv2types.TargetDescription) string {
+	return fmt.Sprintf("%v:%v", awssdk.ToString(target.Id), awssdk.ToInt32(target.Port))
 }
@@ -8,7 +8,8 @@ import (
 type contextKey string
 
 const (
-	contextKeyAdmissionRequest contextKey = "admissionRequest"
+	contextKeyAdmissionRequest  contextKey = "admissionRequest"
+	contextKeyAdmissionWarnings contextKey = "admissionWarnings"
 )
 
 func ContextGetAdmissionRequest(ctx context.Context) *admission.Request {
@@ -21,3 +22,25 @@ func ContextGetAdmissionRequest(ctx context.Context) *admission.Request {
 func ContextWithAdmissionRequest(ctx context.Context, req admission.Request) context.Context {
 	return context.WithValue(ctx, contextKeyAdmissionRequest, &req)
 }
+
+// ContextAddAdmissionWarning adds a warning to be returned to the API client along with the admission response.
+// it's a no-op if ctx isn't from an admission request.
+func ContextAddAdmissionWarning(ctx context.Context, warning string) {
+	if v := ctx.Value(contextKeyAdmissionWarnings); v != nil {
+		warnings := v.(*[]string)
+		*warnings = append(*warnings, warning)
+	}
+}
+
+// ContextGetAdmissionWarnings returns the warnings added via ContextAddAdmissionWarning.
+func ContextGetAdmissionWarnings(ctx context.Context) []string {
+	if v := ctx.Value(contextKeyAdmissionWarnings); v != nil {
+		return *v.(*[]string)
+	}
+	return nil
+}
+
+// ContextWithAdmissionWarnings returns a context that collects the warnings added via ContextAddAdmissionWarning.
+func ContextWithAdmissionWarnings(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKeyAdmissionWarnings, &[]string{})
+}
@@ -51,3 +51,38 @@ func TestContextGetAdmissionRequestAndContextWithAdmissionRequest(t *testing.T)
 		})
 	}
 }
+
+func TestContextAddAdmissionWarning(t *testing.T) {
+	tests := []struct {
+		name            string
+		withWarnings    bool
+		warningsToAdd   []string
+		wantWarningList []string
+	}{
+		{
+			name:            "context with warnings",
+			withWarnings:    true,
+			warningsToAdd:   []string{"warning-a", "warning-b"},
+			wantWarningList: []string{"warning-a", "warning-b"},
+		},
+		{
+			name:            "context without warnings",
+			withWarnings:    false,
+			warningsToAdd:   []string{"warning-a"},
+			wantWarningList: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.withWarnings {
+				ctx = ContextWithAdmissionWarnings(ctx)
+			}
+			for _, warning := range tt.warningsToAdd {
+				ContextAddAdmissionWarning(ctx, warning)
+			}
+			got := ContextGetAdmissionWarnings(ctx)
+			assert.Equal(t, tt.wantWarningList, got)
+		})
+	}
+}
@@ -50,15 +50,16 @@ func (h *mutatingHandler) handleCreate(ctx context.Context, req admission.Reques
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
-	mutatedObj, err := h.mutator.MutateCreate(ContextWithAdmissionRequest(ctx, req), obj)
+	ctx = ContextWithAdmissionWarnings(ContextWithAdmissionRequest(ctx, req))
+	mutatedObj, err := h.mutator.MutateCreate(ctx, obj)
 	if err != nil {
-		return admission.Denied(err.Error())
+		return admission.Denied(err.Error()).WithWarnings(ContextGetAdmissionWarnings(ctx)...)
 	}
 	mutatedObjPayload, err := json.Marshal(mutatedObj)
 	if err != nil {
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
-	return admission.PatchResponseFromRaw(req.Object.Raw, mutatedObjPayload)
+	return admission.PatchResponseFromRaw(req.Object.Raw, mutatedObjPayload).WithWarnings(ContextGetAdmissionWarnings(ctx)...)
 }
 
 func (h *mutatingHandler) handleUpdate(ctx context.Context, req admission.Request) admission.Response {
@@ -75,13 +76,14 @@ func (h *mutatingHandler) handleUpdate(ctx context.Context, req admission.Reques
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
-	mutatedObj, err := h.mutator.MutateUpdate(ContextWithAdmissionRequest(ctx, req), obj, oldObj)
+	ctx = ContextWithAdmissionWarnings(ContextWithAdmissionRequest(ctx, req))
+	mutatedObj, err := h.mutator.MutateUpdate(ctx, obj, oldObj)
 	if err != nil {
-		return admission.Denied(err.Error())
+		return admission.Denied(err.Error()).WithWarnings(ContextGetAdmissionWarnings(ctx)...)
 	}
 	mutatedObjPayload, err := json.Marshal(mutatedObj)
 	if err != nil {
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
-	return admission.PatchResponseFromRaw(req.Object.Raw, mutatedObjPayload)
+	return admission.PatchResponseFromRaw(req.Object.Raw, mutatedObjPayload).WithWarnings(ContextGetAdmissionWarnings(ctx)...)
 }
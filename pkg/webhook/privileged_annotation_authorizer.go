@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+)
+
+const (
+	// privilegedAnnotationsGroup and privilegedAnnotationsResource form the virtual resource authorized against to set privileged annotations.
+	// the resource isn't served by the API server, it only exists to be referred by RBAC rules.
+	privilegedAnnotationsGroup    = "elbv2.k8s.aws"
+	privilegedAnnotationsResource = "privilegedannotations"
+	privilegedAnnotationsVerb     = "set"
+)
+
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+// PrivilegedAnnotationAuthorizer authorizes the users of admission requests to set privileged annotations,
+// which grant access to AWS resources beyond the ones managed for the object, e.g. attaching arbitrary security groups.
+// Users are authorized via SubjectAccessReviews to the "set" verb of the virtual "privilegedannotations" resource of
+// "elbv2.k8s.aws" group, named after the annotation key in the namespace of the object.
+type PrivilegedAnnotationAuthorizer struct {
+	sarClient authorizationv1client.SubjectAccessReviewInterface
+}
+
+// NewPrivilegedAnnotationAuthorizer constructs new PrivilegedAnnotationAuthorizer.
+func NewPrivilegedAnnotationAuthorizer(sarClient authorizationv1client.SubjectAccessReviewInterface) *PrivilegedAnnotationAuthorizer {
+	return &PrivilegedAnnotationAuthorizer{
+		sarClient: sarClient,
+	}
+}
+
+// Authorize checks the user of the admission request in ctx is allowed to set the privileged annotations with annotationKeys
+// on an object in namespace.
+// A nil PrivilegedAnnotationAuthorizer allows all annotations.
+func (a *PrivilegedAnnotationAuthorizer) Authorize(ctx context.Context, namespace string, annotationKeys []string) error {
+	if a == nil || len(annotationKeys) == 0 {
+		return nil
+	}
+	req := ContextGetAdmissionRequest(ctx)
+	if req == nil {
+		return errors.New("failed to authorize privileged annotations: admission request not found")
+	}
+	extra := make(map[string]authorizationv1.ExtraValue, len(req.UserInfo.Extra))
+	for key, value := range req.UserInfo.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+	var deniedKeys []string
+	for _, key := range annotationKeys {
+		sar := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace: namespace,
+					Verb:      privilegedAnnotationsVerb,
+					Group:     privilegedAnnotationsGroup,
+					Resource:  privilegedAnnotationsResource,
+					Name:      key,
+				},
+				User:   req.UserInfo.Username,
+				Groups: req.UserInfo.Groups,
+				UID:    req.UserInfo.UID,
+				Extra:  extra,
+			},
+		}
+		resp, err := a.sarClient.Create(ctx, sar, metav1.CreateOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to authorize privileged annotation `%s`", key)
+		}
+		if !resp.Status.Allowed {
+			deniedKeys = append(deniedKeys, fmt.Sprintf("`%s`", key))
+		}
+	}
+	if len(deniedKeys) != 0 {
+		return errors.Errorf("user %v isn't allowed to set privileged annotations %s, which requires %q permission on %q resource of %q group",
+			req.UserInfo.Username, strings.Join(deniedKeys, ", "), privilegedAnnotationsVerb, privilegedAnnotationsResource, privilegedAnnotationsGroup)
+	}
+	return nil
+}
+
+// ChangedAnnotationKeys returns the keys of the annotations with suffixes that are set in newAnnotations
+// with a different value from oldAnnotations, which is nil on creation.
+// unchanged annotations are excluded, so that other fields of objects carrying privileged annotations can be updated by any user.
+func ChangedAnnotationKeys(annotationParser annotations.Parser, annotationPrefix string, suffixes []string,
+	newAnnotations map[string]string, oldAnnotations map[string]string) []string {
+	var changedKeys []string
+	for _, suffix := range suffixes {
+		newValue := ""
+		oldValue := ""
+		newExists := annotationParser.ParseStringAnnotation(suffix, &newValue, newAnnotations)
+		oldExists := annotationParser.ParseStringAnnotation(suffix, &oldValue, oldAnnotations)
+		if !newExists || (oldExists && newValue == oldValue) {
+			continue
+		}
+		changedKeys = append(changedKeys, fmt.Sprintf("%s/%s", annotationPrefix, suffix))
+	}
+	return changedKeys
+}
@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestPrivilegedAnnotationAuthorizer_Authorize(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotationKeys []string
+		allowedKeys    []string
+		wantSARs       int
+		wantErr        string
+	}{
+		{
+			name:     "no privileged annotations",
+			wantSARs: 0,
+		},
+		{
+			name:           "allowed privileged annotation",
+			annotationKeys: []string{"alb.ingress.kubernetes.io/security-groups"},
+			allowedKeys:    []string{"alb.ingress.kubernetes.io/security-groups"},
+			wantSARs:       1,
+		},
+		{
+			name:           "denied privileged annotation",
+			annotationKeys: []string{"alb.ingress.kubernetes.io/security-groups", "alb.ingress.kubernetes.io/aws-role-arn"},
+			allowedKeys:    []string{"alb.ingress.kubernetes.io/security-groups"},
+			wantSARs:       2,
+			wantErr:        "user alice isn't allowed to set privileged annotations `alb.ingress.kubernetes.io/aws-role-arn`, which requires \"set\" permission on \"privilegedannotations\" resource of \"elbv2.k8s.aws\" group",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientSet := fake.NewSimpleClientset()
+			var sars []*authorizationv1.SubjectAccessReview
+			clientSet.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+				sars = append(sars, sar)
+				for _, key := range tt.allowedKeys {
+					if sar.Spec.ResourceAttributes.Name == key {
+						sar.Status.Allowed = true
+					}
+				}
+				return true, sar, nil
+			})
+			ctx := ContextWithAdmissionRequest(context.Background(), admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					UserInfo: authenticationv1.UserInfo{Username: "alice", Groups: []string{"developers"}},
+				},
+			})
+
+			authorizer := NewPrivilegedAnnotationAuthorizer(clientSet.AuthorizationV1().SubjectAccessReviews())
+			err := authorizer.Authorize(ctx, "awesome-ns", tt.annotationKeys)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, sars, tt.wantSARs)
+			for _, sar := range sars {
+				assert.Equal(t, "alice", sar.Spec.User)
+				assert.Equal(t, []string{"developers"}, sar.Spec.Groups)
+				assert.Equal(t, "awesome-ns", sar.Spec.ResourceAttributes.Namespace)
+				assert.Equal(t, "elbv2.k8s.aws", sar.Spec.ResourceAttributes.Group)
+				assert.Equal(t, "privilegedannotations", sar.Spec.ResourceAttributes.Resource)
+			}
+		})
+	}
+}
+
+func TestChangedAnnotationKeys(t *testing.T) {
+	suffixes := []string{annotations.IngressSuffixSecurityGroups, annotations.IngressSuffixAWSRoleARN}
+	tests := []struct {
+		name           string
+		newAnnotations map[string]string
+		oldAnnotations map[string]string
+		want           []string
+	}{
+		{
+			name: "added on creation",
+			newAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-groups": "sg-1",
+				"alb.ingress.kubernetes.io/scheme":          "internal",
+			},
+			want: []string{"alb.ingress.kubernetes.io/security-groups"},
+		},
+		{
+			name: "unchanged on update",
+			newAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-groups": "sg-1",
+			},
+			oldAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-groups": "sg-1",
+			},
+		},
+		{
+			name: "changed on update",
+			newAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-groups": "sg-1",
+				"alb.ingress.kubernetes.io/aws-role-arn":    "arn:aws:iam::123456789012:role/role-1",
+			},
+			oldAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-groups": "sg-2",
+			},
+			want: []string{"alb.ingress.kubernetes.io/security-groups", "alb.ingress.kubernetes.io/aws-role-arn"},
+		},
+		{
+			name: "removed on update",
+			oldAnnotations: map[string]string{
+				"alb.ingress.kubernetes.io/security-groups": "sg-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotationParser := annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress)
+			got := ChangedAnnotationKeys(annotationParser, annotations.AnnotationPrefixIngress, suffixes, tt.newAnnotations, tt.oldAnnotations)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -51,10 +51,11 @@ func (h *validatingHandler) handleCreate(ctx context.Context, req admission.Requ
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
-	if err := h.validator.ValidateCreate(ContextWithAdmissionRequest(ctx, req), obj); err != nil {
-		return admission.Denied(err.Error())
+	ctx = ContextWithAdmissionWarnings(ContextWithAdmissionRequest(ctx, req))
+	if err := h.validator.ValidateCreate(ctx, obj); err != nil {
+		return admission.Denied(err.Error()).WithWarnings(ContextGetAdmissionWarnings(ctx)...)
 	}
-	return admission.Allowed("")
+	return admission.Allowed("").WithWarnings(ContextGetAdmissionWarnings(ctx)...)
 }
 
 func (h *validatingHandler) handleUpdate(ctx context.Context, req admission.Request) admission.Response {
@@ -71,10 +72,11 @@ func (h *validatingHandler) handleUpdate(ctx context.Context, req admission.Requ
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
-	if err := h.validator.ValidateUpdate(ContextWithAdmissionRequest(ctx, req), obj, oldObj); err != nil {
-		return admission.Denied(err.Error())
+	ctx = ContextWithAdmissionWarnings(ContextWithAdmissionRequest(ctx, req))
+	if err := h.validator.ValidateUpdate(ctx, obj, oldObj); err != nil {
+		return admission.Denied(err.Error()).WithWarnings(ContextGetAdmissionWarnings(ctx)...)
 	}
-	return admission.Allowed("")
+	return admission.Allowed("").WithWarnings(ContextGetAdmissionWarnings(ctx)...)
 }
 
 func (h *validatingHandler) handleDelete(ctx context.Context, req admission.Request) admission.Response {
@@ -107,6 +107,38 @@ func Test_validatingHandler_Handle(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "[create] approve request with warnings",
+			fields: fields{
+				validatorPrototype: func(req admission.Request) (runtime.Object, error) {
+					return &corev1.Pod{}, nil
+				},
+				validatorValidateCreate: func(ctx context.Context, obj runtime.Object) error {
+					ContextAddAdmissionWarning(ctx, "some warning")
+					return nil
+				},
+				decoder: decoder,
+			},
+			args: args{
+				req: admission.Request{
+					AdmissionRequest: admissionv1.AdmissionRequest{
+						Operation: admissionv1.Create,
+						Object: runtime.RawExtension{
+							Raw: initialPodRaw,
+						},
+					},
+				},
+			},
+			want: admission.Response{
+				AdmissionResponse: admissionv1.AdmissionResponse{
+					Allowed: true,
+					Result: &metav1.Status{
+						Code: http.StatusOK,
+					},
+					Warnings: []string{"some warning"},
+				},
+			},
+		},
 		{
 			name: "[create] reject request",
 			fields: fields{
@@ -7,6 +7,9 @@ $MOCKGEN -package=mock_client -destination=./mocks/controller-runtime/client/cli
 $MOCKGEN -package=services -destination=./pkg/aws/services/elbv2_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services ELBV2
 $MOCKGEN -package=services -destination=./pkg/aws/services/ec2_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services EC2
 $MOCKGEN -package=services -destination=./pkg/aws/services/shield_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services Shield
+$MOCKGEN -package=services -destination=./pkg/aws/services/route53recoveryreadiness_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services Route53RecoveryReadiness
+$MOCKGEN -package=services -destination=./pkg/aws/services/globalaccelerator_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services GlobalAccelerator
+$MOCKGEN -package=services -destination=./pkg/aws/services/route53_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services Route53
 $MOCKGEN -package=webhook -destination=./pkg/webhook/mutator_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/webhook Mutator
 $MOCKGEN -package=webhook -destination=./pkg/webhook/validator_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/webhook Validator
 $MOCKGEN -package=k8s -destination=./pkg/k8s/finalizer_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/k8s FinalizerManager
@@ -17,6 +20,11 @@ $MOCKGEN -package=networking -destination=./pkg/networking/az_info_provider_mock
 $MOCKGEN -package=networking -destination=./pkg/networking/node_info_provider_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking NodeInfoProvider
 $MOCKGEN -package=networking -destination=./pkg/networking/vpc_info_provider_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking VPCInfoProvider
 $MOCKGEN -package=networking -destination=./pkg/networking/backend_sg_provider_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking BackendSGProvider
+$MOCKGEN -package=networking -destination=./pkg/networking/blocklist_prefix_list_provider_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking BlocklistPrefixListProvider
+$MOCKGEN -package=networking -destination=./pkg/networking/node_subnets_prefix_list_provider_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking NodeSubnetsPrefixListProvider
+$MOCKGEN -package=networking -destination=./pkg/networking/default_tags_provider_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking DefaultTagsProvider
 $MOCKGEN -package=networking -destination=./pkg/networking/security_group_resolver_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking SecurityGroupResolver
+$MOCKGEN -package=networking -destination=./pkg/networking/node_subnets_resolver_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/networking NodeSubnetsResolver
 $MOCKGEN -package=ingress -destination=./pkg/ingress/cert_discovery_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/ingress CertDiscovery
-$MOCKGEN -package=elbv2 -destination=./pkg/deploy/elbv2/tagging_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2 TaggingManager
\ No newline at end of file
+$MOCKGEN -package=elbv2 -destination=./pkg/deploy/elbv2/tagging_manager_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2 TaggingManager
+$MOCKGEN -package=elbv2 -destination=./pkg/deploy/elbv2/cross_zone_validator_mocks.go sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2 CrossZoneValidator
\ No newline at end of file
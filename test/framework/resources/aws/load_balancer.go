@@ -2,8 +2,10 @@ package aws
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
@@ -13,12 +15,12 @@ import (
 type LoadBalancerManager interface {
 	FindLoadBalancerByDNSName(ctx context.Context, dnsName string) (string, error)
 	WaitUntilLoadBalancerAvailable(ctx context.Context, lbARN string) error
-	GetLoadBalancerFromARN(ctx context.Context, lbARN string) (*elbv2sdk.LoadBalancer, error)
-	GetLoadBalancerListeners(ctx context.Context, lbARN string) ([]*elbv2sdk.Listener, error)
-	GetLoadBalancerListenerCertificates(ctx context.Context, listenerARN string) ([]*elbv2sdk.Certificate, error)
-	GetLoadBalancerAttributes(ctx context.Context, lbARN string) ([]*elbv2sdk.LoadBalancerAttribute, error)
-	GetLoadBalancerResourceTags(ctx context.Context, resARN string) ([]*elbv2sdk.Tag, error)
-	GetLoadBalancerListenerRules(ctx context.Context, lsARN string) ([]*elbv2sdk.Rule, error)
+	GetLoadBalancerFromARN(ctx context.Context, lbARN string) (*elbv2types.LoadBalancer, error)
+	GetLoadBalancerListeners(ctx context.Context, lbARN string) ([]elbv2types.Listener, error)
+	GetLoadBalancerListenerCertificates(ctx context.Context, listenerARN string) ([]elbv2types.Certificate, error)
+	GetLoadBalancerAttributes(ctx context.Context, lbARN string) ([]elbv2types.LoadBalancerAttribute, error)
+	GetLoadBalancerResourceTags(ctx context.Context, resARN string) ([]elbv2types.Tag, error)
+	GetLoadBalancerListenerRules(ctx context.Context, lsARN string) ([]elbv2types.Rule, error)
 }
 
 // NewDefaultLoadBalancerManager constructs new defaultLoadBalancerManager.
@@ -44,8 +46,8 @@ func (m *defaultLoadBalancerManager) FindLoadBalancerByDNSName(ctx context.Conte
 		return "", err
 	}
 	for _, lb := range lbs {
-		if awssdk.StringValue(lb.DNSName) == dnsName {
-			return awssdk.StringValue(lb.LoadBalancerArn), nil
+		if awssdk.ToString(lb.DNSName) == dnsName {
+			return awssdk.ToString(lb.LoadBalancerArn), nil
 		}
 	}
 	return "", errors.Errorf("couldn't find LoadBalancer with dnsName: %v", dnsName)
@@ -53,14 +55,14 @@ func (m *defaultLoadBalancerManager) FindLoadBalancerByDNSName(ctx context.Conte
 
 func (m *defaultLoadBalancerManager) WaitUntilLoadBalancerAvailable(ctx context.Context, lbARN string) error {
 	req := &elbv2sdk.DescribeLoadBalancersInput{
-		LoadBalancerArns: awssdk.StringSlice([]string{lbARN}),
+		LoadBalancerArns: []string{lbARN},
 	}
 	return m.elbv2Client.WaitUntilLoadBalancerAvailableWithContext(ctx, req)
 }
 
-func (m *defaultLoadBalancerManager) GetLoadBalancerFromARN(ctx context.Context, lbARN string) (*elbv2sdk.LoadBalancer, error) {
+func (m *defaultLoadBalancerManager) GetLoadBalancerFromARN(ctx context.Context, lbARN string) (*elbv2types.LoadBalancer, error) {
 	req := &elbv2sdk.DescribeLoadBalancersInput{
-		LoadBalancerArns: awssdk.StringSlice([]string{lbARN}),
+		LoadBalancerArns: []string{lbARN},
 	}
 	lbs, err := m.elbv2Client.DescribeLoadBalancersWithContext(ctx, req)
 	if err != nil {
@@ -69,10 +71,10 @@ func (m *defaultLoadBalancerManager) GetLoadBalancerFromARN(ctx context.Context,
 	if len(lbs.LoadBalancers) == 0 {
 		return nil, errors.Errorf("couldn't find LoadBalancer with ARN %v", lbARN)
 	}
-	return lbs.LoadBalancers[0], nil
+	return &lbs.LoadBalancers[0], nil
 }
 
-func (m *defaultLoadBalancerManager) GetLoadBalancerListeners(ctx context.Context, lbARN string) ([]*elbv2sdk.Listener, error) {
+func (m *defaultLoadBalancerManager) GetLoadBalancerListeners(ctx context.Context, lbARN string) ([]elbv2types.Listener, error) {
 	listeners, err := m.elbv2Client.DescribeListenersWithContext(ctx, &elbv2sdk.DescribeListenersInput{
 		LoadBalancerArn: awssdk.String(lbARN),
 	})
@@ -82,13 +84,13 @@ func (m *defaultLoadBalancerManager) GetLoadBalancerListeners(ctx context.Contex
 	return listeners.Listeners, nil
 }
 
-func (m *defaultLoadBalancerManager) GetLoadBalancerListenerCertificates(ctx context.Context, listenerARN string) ([]*elbv2sdk.Certificate, error) {
+func (m *defaultLoadBalancerManager) GetLoadBalancerListenerCertificates(ctx context.Context, listenerARN string) ([]elbv2types.Certificate, error) {
 	return m.elbv2Client.DescribeListenerCertificatesAsList(ctx, &elbv2sdk.DescribeListenerCertificatesInput{
 		ListenerArn: awssdk.String(listenerARN),
 	})
 }
 
-func (m *defaultLoadBalancerManager) GetLoadBalancerAttributes(ctx context.Context, lbARN string) ([]*elbv2sdk.LoadBalancerAttribute, error) {
+func (m *defaultLoadBalancerManager) GetLoadBalancerAttributes(ctx context.Context, lbARN string) ([]elbv2types.LoadBalancerAttribute, error) {
 	resp, err := m.elbv2Client.DescribeLoadBalancerAttributesWithContext(ctx, &elbv2sdk.DescribeLoadBalancerAttributesInput{
 		LoadBalancerArn: awssdk.String(lbARN),
 	})
@@ -98,9 +100,9 @@ func (m *defaultLoadBalancerManager) GetLoadBalancerAttributes(ctx context.Conte
 	return resp.Attributes, nil
 }
 
-func (m *defaultLoadBalancerManager) GetLoadBalancerResourceTags(ctx context.Context, resARN string) ([]*elbv2sdk.Tag, error) {
+func (m *defaultLoadBalancerManager) GetLoadBalancerResourceTags(ctx context.Context, resARN string) ([]elbv2types.Tag, error) {
 	resp, err := m.elbv2Client.DescribeTagsWithContext(ctx, &elbv2sdk.DescribeTagsInput{
-		ResourceArns: awssdk.StringSlice([]string{resARN}),
+		ResourceArns: []string{resARN},
 	})
 	if err != nil {
 		return nil, err
@@ -108,7 +110,7 @@ func (m *defaultLoadBalancerManager) GetLoadBalancerResourceTags(ctx context.Con
 	return resp.TagDescriptions[0].Tags, nil
 }
 
-func (m *defaultLoadBalancerManager) GetLoadBalancerListenerRules(ctx context.Context, lsARN string) ([]*elbv2sdk.Rule, error) {
+func (m *defaultLoadBalancerManager) GetLoadBalancerListenerRules(ctx context.Context, lsARN string) ([]elbv2types.Rule, error) {
 	listenersRules, err := m.elbv2Client.DescribeRulesWithContext(ctx, &elbv2sdk.DescribeRulesInput{
 		ListenerArn: awssdk.String(lsARN),
 	})
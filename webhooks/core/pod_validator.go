@@ -0,0 +1,65 @@
+package core
+
+import (
+	"context"
+
+	awssdk "github.com/aws/aws-sdk-go/aws"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	apiPathValidatePod = "/validate-v1-pod"
+)
+
+// NewPodValidator returns a validator for Pod.
+func NewPodValidator(deregistrationCoordinator targetgroupbinding.PodDeregistrationCoordinator, logger logr.Logger) *podValidator {
+	return &podValidator{
+		deregistrationCoordinator: deregistrationCoordinator,
+		logger:                    logger,
+	}
+}
+
+var _ webhook.Validator = &podValidator{}
+
+// podValidator delays the deletion of pods until their targets are deregistered, it never denies requests.
+type podValidator struct {
+	deregistrationCoordinator targetgroupbinding.PodDeregistrationCoordinator
+	logger                    logr.Logger
+}
+
+func (v *podValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &corev1.Pod{}, nil
+}
+
+func (v *podValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *podValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	return nil
+}
+
+func (v *podValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	pod := obj.(*corev1.Pod)
+	if req := webhook.ContextGetAdmissionRequest(ctx); req != nil && awssdk.BoolValue(req.DryRun) {
+		return nil
+	}
+	// the pod deletion proceeds regardless, its targets will be deregistered by reconciles once the pod is terminating.
+	if err := v.deregistrationCoordinator.Coordinate(ctx, pod); err != nil {
+		v.logger.Error(err, "failed to deregister targets of pod ahead of deletion", "pod", k8s.NamespacedName(pod))
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-v1-pod,mutating=false,failurePolicy=ignore,groups="",resources=pods,verbs=delete,versions=v1,name=vpod.elbv2.k8s.aws,sideEffects=NoneOnDryRun,webhookVersions=v1,admissionReviewVersions=v1beta1,timeoutSeconds=30
+
+func (v *podValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidatePod, webhook.ValidatingWebhookForValidator(v))
+}
@@ -2,24 +2,58 @@ package core
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 const (
-	apiPathMutateService = "/mutate-v1-service"
+	apiPathMutateService    = "/mutate-v1-service"
+	serviceAnnotationPrefix = "service.beta.kubernetes.io"
 )
 
+// deprecatedServiceAnnotations maps the suffixes of deprecated Service annotations to their replacement, the usage of which is warned about.
+var deprecatedServiceAnnotations = []struct {
+	suffix      string
+	replacement string
+}{
+	{
+		suffix:      annotations.SvcLBSuffixInternal,
+		replacement: fmt.Sprintf("`%s/%s` annotation", serviceAnnotationPrefix, annotations.SvcLBSuffixScheme),
+	},
+	{
+		suffix:      annotations.SvcLBSuffixAccessLogEnabled,
+		replacement: fmt.Sprintf("`%s/%s` annotation", serviceAnnotationPrefix, annotations.SvcLBSuffixLoadBalancerAttributes),
+	},
+	{
+		suffix:      annotations.SvcLBSuffixAccessLogS3BucketName,
+		replacement: fmt.Sprintf("`%s/%s` annotation", serviceAnnotationPrefix, annotations.SvcLBSuffixLoadBalancerAttributes),
+	},
+	{
+		suffix:      annotations.SvcLBSuffixAccessLogS3BucketPrefix,
+		replacement: fmt.Sprintf("`%s/%s` annotation", serviceAnnotationPrefix, annotations.SvcLBSuffixLoadBalancerAttributes),
+	},
+	{
+		suffix:      annotations.SvcLBSuffixCrossZoneLoadBalancingEnabled,
+		replacement: fmt.Sprintf("`%s/%s` annotation", serviceAnnotationPrefix, annotations.SvcLBSuffixLoadBalancerAttributes),
+	},
+}
+
 // NewServiceMutator returns a mutator for Service.
-func NewServiceMutator(lbClass string, logger logr.Logger) *serviceMutator {
+func NewServiceMutator(lbClass string, deniedTagKeyPrefixes []string, logger logr.Logger) *serviceMutator {
 	return &serviceMutator{
-		logger:            logger,
-		loadBalancerClass: lbClass,
+		logger:               logger,
+		loadBalancerClass:    lbClass,
+		annotationParser:     annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix),
+		deniedTagKeyPrefixes: deniedTagKeyPrefixes,
 	}
 }
 
@@ -28,6 +62,9 @@ var _ webhook.Mutator = &serviceMutator{}
 type serviceMutator struct {
 	logger            logr.Logger
 	loadBalancerClass string
+	annotationParser  annotations.Parser
+	// deniedTagKeyPrefixes are the tag key prefixes that are never applied on AWS resources.
+	deniedTagKeyPrefixes []string
 }
 
 func (m *serviceMutator) Prototype(_ admission.Request) (runtime.Object, error) {
@@ -39,6 +76,8 @@ func (m *serviceMutator) MutateCreate(ctx context.Context, obj runtime.Object) (
 	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
 		return svc, nil
 	}
+	m.checkDeniedTagsUsage(ctx, svc)
+	m.checkDeprecatedAnnotationsUsage(ctx, svc)
 
 	if svc.Spec.LoadBalancerClass != nil && *svc.Spec.LoadBalancerClass != "" {
 		m.logger.Info("service already has loadBalancerClass, skipping", "service", svc.Name, "loadBalancerClass", *svc.Spec.LoadBalancerClass)
@@ -55,6 +94,49 @@ func (m *serviceMutator) MutateUpdate(ctx context.Context, obj runtime.Object, o
 	return obj, nil
 }
 
+// checkDeniedTagsUsage warns about the tags with denied key prefixes in "aws-load-balancer-additional-resource-tags" annotation,
+// which are ignored by the controller.
+func (m *serviceMutator) checkDeniedTagsUsage(ctx context.Context, svc *corev1.Service) {
+	if len(m.deniedTagKeyPrefixes) == 0 {
+		return
+	}
+	var tags map[string]string
+	if _, err := m.annotationParser.ParseStringMapAnnotation(annotations.SvcLBSuffixAdditionalTags, &tags, svc.Annotations); err != nil {
+		// invalid annotations are reported by the service controller.
+		return
+	}
+	if deniedTagKeys := tracking.DeniedTagKeys(tags, m.deniedTagKeyPrefixes); len(deniedTagKeys) != 0 {
+		webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("tags %v in `%s/%s` annotation have denied key prefixes and will be ignored",
+			deniedTagKeys, serviceAnnotationPrefix, annotations.SvcLBSuffixAdditionalTags))
+	}
+}
+
+// checkDeprecatedAnnotationsUsage warns about the usage of deprecated annotations,
+// which are still supported but might be removed in future releases.
+func (m *serviceMutator) checkDeprecatedAnnotationsUsage(ctx context.Context, svc *corev1.Service) {
+	lbType := ""
+	if exists := m.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerType, &lbType, svc.Annotations); exists && lbType == service.LoadBalancerTypeNLBIP {
+		webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("`%s/%s: %s` annotation is deprecated, use `%s/%s: %s` annotation with `%s/%s: %s` instead",
+			serviceAnnotationPrefix, annotations.SvcLBSuffixLoadBalancerType, service.LoadBalancerTypeNLBIP,
+			serviceAnnotationPrefix, annotations.SvcLBSuffixLoadBalancerType, service.LoadBalancerTypeExternal,
+			serviceAnnotationPrefix, annotations.SvcLBSuffixTargetType, service.LoadBalancerTargetTypeIP))
+	}
+	for _, deprecated := range deprecatedServiceAnnotations {
+		rawValue := ""
+		if exists := m.annotationParser.ParseStringAnnotation(deprecated.suffix, &rawValue, svc.Annotations); exists {
+			webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("`%s/%s` annotation is deprecated, use %s instead",
+				serviceAnnotationPrefix, deprecated.suffix, deprecated.replacement))
+		}
+	}
+	rawScheme := ""
+	rawInternal := ""
+	if m.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixScheme, &rawScheme, svc.Annotations) &&
+		m.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixInternal, &rawInternal, svc.Annotations) {
+		webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("`%s/%s` annotation is ignored, as `%s/%s` annotation takes precedence",
+			serviceAnnotationPrefix, annotations.SvcLBSuffixInternal, serviceAnnotationPrefix, annotations.SvcLBSuffixScheme))
+	}
+}
+
 // +kubebuilder:webhook:path=/mutate-v1-service,mutating=true,failurePolicy=fail,groups="",resources=services,verbs=create,versions=v1,name=mservice.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (m *serviceMutator) SetupWithManager(mgr ctrl.Manager) {
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/service"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	apiPathValidateService = "/validate-v1-service"
+	// serviceFinalizer is added to Services whose load balancers are provisioned by the controller.
+	serviceFinalizer = "service.k8s.aws/resources"
+)
+
+// privilegedServiceAnnotations are the suffixes of Service annotations granting access to AWS resources beyond the ones managed
+// for the Service, which are only allowed to be set by authorized users when privileged annotations authorization is enabled.
+var privilegedServiceAnnotations = []string{
+	annotations.SvcLBSuffixLoadBalancerSecurityGroups,
+}
+
+// NewServiceValidator returns a validator for Service.
+func NewServiceValidator(k8sClient client.Client, privilegedAnnotationAuthorizer *webhook.PrivilegedAnnotationAuthorizer, logger logr.Logger) *serviceValidator {
+	annotationParser := annotations.NewSuffixAnnotationParser(serviceAnnotationPrefix)
+	return &serviceValidator{
+		annotationParser:               annotationParser,
+		lbConfigurationLoader:          service.NewDefaultLoadBalancerConfigurationLoader(k8sClient, annotationParser),
+		privilegedAnnotationAuthorizer: privilegedAnnotationAuthorizer,
+		logger:                         logger,
+	}
+}
+
+var _ webhook.Validator = &serviceValidator{}
+
+type serviceValidator struct {
+	annotationParser      annotations.Parser
+	lbConfigurationLoader service.LoadBalancerConfigurationLoader
+	// privilegedAnnotationAuthorizer authorizes the users setting privileged annotations, nil if disabled.
+	privilegedAnnotationAuthorizer *webhook.PrivilegedAnnotationAuthorizer
+	logger                         logr.Logger
+}
+
+func (v *serviceValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &corev1.Service{}, nil
+}
+
+func (v *serviceValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	svc := obj.(*corev1.Service)
+	if err := v.checkPrivilegedAnnotationsUsage(ctx, svc, nil); err != nil {
+		return err
+	}
+	return v.checkLoadBalancerConfigurationUsage(ctx, svc)
+}
+
+func (v *serviceValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	svc := obj.(*corev1.Service)
+	oldSvc := oldObj.(*corev1.Service)
+	if err := v.checkLoadBalancerNameChange(svc, oldSvc); err != nil {
+		return err
+	}
+	if err := v.checkPrivilegedAnnotationsUsage(ctx, svc, oldSvc); err != nil {
+		return err
+	}
+	return v.checkLoadBalancerConfigurationUsage(ctx, svc)
+}
+
+func (v *serviceValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// checkLoadBalancerConfigurationUsage checks the LoadBalancerConfiguration referenced by Service exists, allows the namespace
+// of Service, and isn't overridden by the Service annotations.
+func (v *serviceValidator) checkLoadBalancerConfigurationUsage(ctx context.Context, svc *corev1.Service) error {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return nil
+	}
+	lbConfiguration, err := v.lbConfigurationLoader.Load(ctx, svc)
+	if err != nil {
+		return err
+	}
+	if lbConfiguration == nil {
+		return nil
+	}
+	conflicts, err := service.FindLoadBalancerConfigurationConflicts(svc, lbConfiguration, v.annotationParser)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	conflictAnnotations := make([]string, 0, len(conflicts))
+	for _, suffix := range conflicts {
+		conflictAnnotations = append(conflictAnnotations, fmt.Sprintf("`%s/%s`", serviceAnnotationPrefix, suffix))
+	}
+	return errors.Errorf("%s annotations conflict with the settings enforced by LoadBalancerConfiguration %v",
+		strings.Join(conflictAnnotations, ", "), lbConfiguration.Name)
+}
+
+// checkPrivilegedAnnotationsUsage checks the user is authorized to set the privileged annotations added or changed on Service.
+func (v *serviceValidator) checkPrivilegedAnnotationsUsage(ctx context.Context, svc *corev1.Service, oldSvc *corev1.Service) error {
+	var oldAnnotations map[string]string
+	if oldSvc != nil {
+		oldAnnotations = oldSvc.Annotations
+	}
+	changedKeys := webhook.ChangedAnnotationKeys(v.annotationParser, serviceAnnotationPrefix, privilegedServiceAnnotations,
+		svc.Annotations, oldAnnotations)
+	return v.privilegedAnnotationAuthorizer.Authorize(ctx, svc.Namespace, changedKeys)
+}
+
+// checkLoadBalancerNameChange checks the change of "aws-load-balancer-name" annotation.
+// load balancers cannot be renamed in place, thus the name cannot be changed once the load balancer is provisioned by the controller.
+func (v *serviceValidator) checkLoadBalancerNameChange(svc *corev1.Service, oldSvc *corev1.Service) error {
+	if !k8s.HasFinalizer(svc, serviceFinalizer) {
+		return nil
+	}
+	newName := ""
+	oldName := ""
+	_ = v.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerName, &newName, svc.Annotations)
+	_ = v.annotationParser.ParseStringAnnotation(annotations.SvcLBSuffixLoadBalancerName, &oldName, oldSvc.Annotations)
+	if newName == oldName {
+		return nil
+	}
+	var lbDNSNames []string
+	for _, lbIngress := range svc.Status.LoadBalancer.Ingress {
+		if lbIngress.Hostname != "" {
+			lbDNSNames = append(lbDNSNames, lbIngress.Hostname)
+		}
+	}
+	if len(lbDNSNames) == 0 {
+		return nil
+	}
+	// the load balancer already has the new name, e.g. when the annotation is added with the current name.
+	for _, lbDNSName := range lbDNSNames {
+		if elbv2deploy.IsLoadBalancerDNSNameOf(lbDNSName, newName) {
+			return nil
+		}
+	}
+	return errors.Errorf("`%s/%s` annotation cannot be changed once the load balancer is provisioned, as load balancers cannot be renamed in place",
+		serviceAnnotationPrefix, annotations.SvcLBSuffixLoadBalancerName)
+}
+
+// +kubebuilder:webhook:path=/validate-v1-service,mutating=false,failurePolicy=fail,groups="",resources=services,verbs=create;update,versions=v1,name=vservice.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *serviceValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateService, webhook.ValidatingWebhookForValidator(v))
+}
@@ -4,17 +4,35 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"regexp"
+	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-const apiPathValidateELBv2IngressClassParams = "/validate-elbv2-k8s-aws-v1beta1-ingressclassparams"
+const (
+	apiPathValidateELBv2IngressClassParams = "/validate-elbv2-k8s-aws-v1beta1-ingressclassparams"
+	wafv2LogDestinationNamePrefix          = "aws-waf-logs-"
+)
+
+// wafv2LogDestinationResourcePrefixByService is the ARN resource prefix of supported WAFv2 log destinations, indexed by service.
+var wafv2LogDestinationResourcePrefixByService = map[string]string{
+	"logs":     "log-group:",
+	"firehose": "deliverystream/",
+	"s3":       "",
+}
+
+// wafv2RuleNamePattern is the valid pattern of WAFv2 rule names.
+var wafv2RuleNamePattern = regexp.MustCompile(`^[\w-]{1,128}$`)
 
 // NewIngressClassParamsValidator returns a validator for the IngressClassParams CRD.
 func NewIngressClassParamsValidator() *ingressClassParamsValidator {
@@ -34,7 +52,12 @@ func (v *ingressClassParamsValidator) ValidateCreate(ctx context.Context, obj ru
 	icp := obj.(*elbv2api.IngressClassParams)
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, v.checkInboundCIDRs(icp)...)
+	allErrs = append(allErrs, v.checkPrefixListsIDs(icp)...)
 	allErrs = append(allErrs, v.checkSubnetSelectors(icp)...)
+	allErrs = append(allErrs, v.checkWAFFailOpen(icp)...)
+	allErrs = append(allErrs, v.checkWAFv2LoggingConfiguration(icp)...)
+	allErrs = append(allErrs, v.checkWAFv2WebACL(icp)...)
+	allErrs = append(allErrs, v.checkDefaultAction(icp)...)
 
 	return allErrs.ToAggregate()
 }
@@ -43,7 +66,12 @@ func (v *ingressClassParamsValidator) ValidateUpdate(ctx context.Context, obj ru
 	icp := obj.(*elbv2api.IngressClassParams)
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, v.checkInboundCIDRs(icp)...)
+	allErrs = append(allErrs, v.checkPrefixListsIDs(icp)...)
 	allErrs = append(allErrs, v.checkSubnetSelectors(icp)...)
+	allErrs = append(allErrs, v.checkWAFFailOpen(icp)...)
+	allErrs = append(allErrs, v.checkWAFv2LoggingConfiguration(icp)...)
+	allErrs = append(allErrs, v.checkWAFv2WebACL(icp)...)
+	allErrs = append(allErrs, v.checkDefaultAction(icp)...)
 
 	return allErrs.ToAggregate()
 }
@@ -62,6 +90,18 @@ func (v *ingressClassParamsValidator) checkInboundCIDRs(icp *elbv2api.IngressCla
 	return allErrs
 }
 
+// checkPrefixListsIDs will check for valid prefixListsIDs.
+func (v *ingressClassParamsValidator) checkPrefixListsIDs(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	for idx, prefixListID := range icp.Spec.PrefixListsIDs {
+		fieldPath := field.NewPath("spec", "prefixListsIDs").Index(idx)
+		if !strings.HasPrefix(prefixListID, "pl-") {
+			allErrs = append(allErrs, field.Invalid(fieldPath, prefixListID, "must be a managed prefix list ID starting with \"pl-\""))
+		}
+	}
+
+	return allErrs
+}
+
 // validateCIDR will check for a valid CIDR.
 func validateCIDR(cidr string, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -94,11 +134,17 @@ func (v *ingressClassParamsValidator) checkSubnetSelectors(icp *elbv2api.Ingress
 	if icp.Spec.Subnets != nil {
 		subnets := icp.Spec.Subnets
 		fieldPath := field.NewPath("spec", "subnets")
-		if subnets.IDs == nil && subnets.Tags == nil {
-			allErrs = append(allErrs, field.Required(fieldPath, "must have either `ids` or `tags`"))
+		if subnets.IDs == nil && subnets.Tags == nil && subnets.Selector == nil {
+			allErrs = append(allErrs, field.Required(fieldPath, "must have either `ids`, `tags` or `selector`"))
 			return allErrs
 		}
-		if subnets.IDs != nil {
+		if subnets.Selector != nil {
+			if subnets.IDs != nil || subnets.Tags != nil {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("selector"), "may not have `selector` set with `ids` or `tags`"))
+				return allErrs
+			}
+			allErrs = append(allErrs, v.checkSubnetDiscoverySelector(subnets.Selector, fieldPath.Child("selector"))...)
+		} else if subnets.IDs != nil {
 			if subnets.Tags != nil {
 				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("tags"), "may not have both `ids` and `tags` set"))
 				return allErrs
@@ -132,6 +178,128 @@ func (v *ingressClassParamsValidator) checkSubnetSelectors(icp *elbv2api.Ingress
 	return allErrs
 }
 
+// checkSubnetDiscoverySelector will check for valid SubnetDiscoverySelector
+func (v *ingressClassParamsValidator) checkSubnetDiscoverySelector(selector *elbv2api.SubnetDiscoverySelector, fieldPath *field.Path) (allErrs field.ErrorList) {
+	for tagKey, tagValues := range selector.Tags {
+		fieldPath := fieldPath.Child("tags").Key(tagKey)
+		if len(tagValues) == 0 {
+			allErrs = append(allErrs, field.Required(fieldPath, "must have at least one tag value"))
+		}
+		valueSeen := map[string]bool{}
+		for i, value := range tagValues {
+			if valueSeen[value] {
+				allErrs = append(allErrs, field.Duplicate(fieldPath.Index(i), value))
+			}
+			valueSeen[value] = true
+		}
+	}
+	excludeZones := sets.NewString(selector.ExcludeZones...)
+	for i, zone := range selector.IncludeZones {
+		if excludeZones.Has(zone) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("includeZones").Index(i), zone, "may not be in both `includeZones` and `excludeZones`"))
+		}
+	}
+	if selector.MinFreeIPAddressCount != nil && *selector.MinFreeIPAddressCount < 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("minFreeIPAddressCount"), *selector.MinFreeIPAddressCount, "must be non-negative"))
+	}
+	return allErrs
+}
+
+// checkWAFFailOpen will check wafFailOpen doesn't conflict with loadBalancerAttributes
+func (v *ingressClassParamsValidator) checkWAFFailOpen(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.WAFFailOpen == nil {
+		return allErrs
+	}
+	wafFailOpen := strconv.FormatBool(*icp.Spec.WAFFailOpen)
+	for idx, attr := range icp.Spec.LoadBalancerAttributes {
+		if attr.Key == elbv2model.LBAttrsWAFFailOpenEnabled && attr.Value != wafFailOpen {
+			fieldPath := field.NewPath("spec", "loadBalancerAttributes").Index(idx).Child("value")
+			allErrs = append(allErrs, field.Invalid(fieldPath, attr.Value, "conflicts with `wafFailOpen`"))
+		}
+	}
+	return allErrs
+}
+
+// checkWAFv2LoggingConfiguration will check for valid WAFv2 log destination.
+// WAFv2 requires the log destination name to be prefixed with "aws-waf-logs-".
+func (v *ingressClassParamsValidator) checkWAFv2LoggingConfiguration(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	if icp.Spec.WAFv2LoggingConfiguration == nil {
+		return allErrs
+	}
+	logDestinationARN := icp.Spec.WAFv2LoggingConfiguration.LogDestinationARN
+	fieldPath := field.NewPath("spec", "wafv2LoggingConfiguration", "logDestinationARN")
+	parsedARN, err := arn.Parse(logDestinationARN)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fieldPath, logDestinationARN, "Could not be parsed as an ARN"))
+		return allErrs
+	}
+	resourcePrefix, ok := wafv2LogDestinationResourcePrefixByService[parsedARN.Service]
+	if !ok {
+		allErrs = append(allErrs, field.Invalid(fieldPath, logDestinationARN, "must be an Amazon CloudWatch Logs log group, Amazon Kinesis Data Firehose delivery stream or Amazon S3 bucket"))
+		return allErrs
+	}
+	if !strings.HasPrefix(parsedARN.Resource, resourcePrefix+wafv2LogDestinationNamePrefix) {
+		allErrs = append(allErrs, field.Invalid(fieldPath, logDestinationARN, fmt.Sprintf("name must start with %q", wafv2LogDestinationNamePrefix)))
+	}
+	return allErrs
+}
+
+// checkWAFv2WebACL will check the rules of controller managed WAFv2 WebACL have valid and unique names.
+// The rules of managed rule groups are named after the vendor and name of rule group.
+func (v *ingressClassParamsValidator) checkWAFv2WebACL(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	webACL := icp.Spec.WAFv2WebACL
+	if webACL == nil {
+		return allErrs
+	}
+	fieldPath := field.NewPath("spec", "wafv2WebACL")
+	ruleNames := sets.NewString()
+	for idx, rateLimit := range webACL.RateLimits {
+		namePath := fieldPath.Child("rateLimits").Index(idx).Child("name")
+		if !wafv2RuleNamePattern.MatchString(rateLimit.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, rateLimit.Name, "must consist of 1 to 128 alphanumeric, '_' or '-' characters"))
+		} else if ruleNames.Has(rateLimit.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, rateLimit.Name))
+		}
+		ruleNames.Insert(rateLimit.Name)
+	}
+	for idx, ruleGroup := range webACL.ManagedRuleGroups {
+		ruleName := fmt.Sprintf("%v-%v", ruleGroup.VendorName, ruleGroup.Name)
+		if ruleNames.Has(ruleName) {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("managedRuleGroups").Index(idx), ruleName))
+		}
+		ruleNames.Insert(ruleName)
+	}
+	return allErrs
+}
+
+// checkDefaultAction will check the defaultAction specifies the config required by its type only.
+func (v *ingressClassParamsValidator) checkDefaultAction(icp *elbv2api.IngressClassParams) (allErrs field.ErrorList) {
+	defaultAction := icp.Spec.DefaultAction
+	if defaultAction == nil {
+		return allErrs
+	}
+	fieldPath := field.NewPath("spec", "defaultAction")
+	switch defaultAction.Type {
+	case elbv2api.DefaultActionTypeRedirect:
+		if defaultAction.Redirect == nil {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("redirect"), "must be specified for redirect type"))
+		} else if defaultAction.Redirect.Host == "" {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("redirect", "host"), "must be specified for redirect type"))
+		}
+	case elbv2api.DefaultActionTypeForward:
+		if defaultAction.Forward == nil {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("forward"), "must be specified for forward type"))
+		}
+	}
+	if defaultAction.Type != elbv2api.DefaultActionTypeRedirect && defaultAction.Redirect != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("redirect"), "may only be specified for redirect type"))
+	}
+	if defaultAction.Type != elbv2api.DefaultActionTypeForward && defaultAction.Forward != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("forward"), "may only be specified for forward type"))
+	}
+	return allErrs
+}
+
 // +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-ingressclassparams,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=ingressclassparams,verbs=create;update,versions=v1beta1,name=vingressclassparams.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressClassParamsValidator) SetupWithManager(mgr ctrl.Manager) {
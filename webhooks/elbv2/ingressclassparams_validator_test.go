@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 )
@@ -84,6 +85,28 @@ func Test_ingressClassParamsValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErr: "spec.inboundCIDRs[0]: Invalid value: \"invalid.example.com\": Could not be parsed as a CIDR",
 		},
+		{
+			name: "prefixListsIDs is valid prefix list ID list",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					PrefixListsIDs: []string{
+						"pl-00000000000000000",
+						"pl-11111111111111111",
+					},
+				},
+			},
+		},
+		{
+			name: "prefixListsIDs invalid prefix list ID",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					PrefixListsIDs: []string{
+						"sg-00000000000000000",
+					},
+				},
+			},
+			wantErr: "spec.prefixListsIDs[0]: Invalid value: \"sg-00000000000000000\": must be a managed prefix list ID starting with \"pl-\"",
+		},
 		{
 			name: "subnet is valid ID list",
 			obj: &elbv2api.IngressClassParams{
@@ -113,7 +136,7 @@ func Test_ingressClassParamsValidator_ValidateCreate(t *testing.T) {
 					Subnets: &elbv2api.SubnetSelector{},
 				},
 			},
-			wantErr: "spec.subnets: Required value: must have either `ids` or `tags`",
+			wantErr: "spec.subnets: Required value: must have either `ids`, `tags` or `selector`",
 		},
 		{
 			name: "subnet selector with both id and tag",
@@ -165,6 +188,258 @@ func Test_ingressClassParamsValidator_ValidateCreate(t *testing.T) {
 			},
 			wantErr: "spec.subnets.tags: Required value: must have at least one tag key",
 		},
+		{
+			name: "subnet is valid discovery selector",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Subnets: &elbv2api.SubnetSelector{
+						Selector: &elbv2api.SubnetDiscoverySelector{
+							Tags: map[string][]string{
+								"tier": {"public-*"},
+							},
+							IncludeZones:          []string{"us-west-2a", "usw2-az2"},
+							ExcludeZones:          []string{"us-west-2-lax-1a"},
+							MinFreeIPAddressCount: awssdk.Int64(32),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "subnet discovery selector with ids",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Subnets: &elbv2api.SubnetSelector{
+						IDs:      []elbv2api.SubnetID{"subnet-1"},
+						Selector: &elbv2api.SubnetDiscoverySelector{},
+					},
+				},
+			},
+			wantErr: "spec.subnets.selector: Forbidden: may not have `selector` set with `ids` or `tags`",
+		},
+		{
+			name: "subnet discovery selector with zone both included and excluded",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Subnets: &elbv2api.SubnetSelector{
+						Selector: &elbv2api.SubnetDiscoverySelector{
+							IncludeZones: []string{"us-west-2a", "us-west-2b"},
+							ExcludeZones: []string{"us-west-2b"},
+						},
+					},
+				},
+			},
+			wantErr: "spec.subnets.selector.includeZones[1]: Invalid value: \"us-west-2b\": may not be in both `includeZones` and `excludeZones`",
+		},
+		{
+			name: "subnet discovery selector with empty tag values",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					Subnets: &elbv2api.SubnetSelector{
+						Selector: &elbv2api.SubnetDiscoverySelector{
+							Tags: map[string][]string{
+								"tier": {},
+							},
+						},
+					},
+				},
+			},
+			wantErr: "spec.subnets.selector.tags[tier]: Required value: must have at least one tag value",
+		},
+		{
+			name: "wafFailOpen matches loadBalancerAttributes",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFFailOpen: awssdk.Bool(false),
+					LoadBalancerAttributes: []elbv2api.Attribute{
+						{
+							Key:   "waf.fail_open.enabled",
+							Value: "false",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "wafFailOpen conflicts with loadBalancerAttributes",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFFailOpen: awssdk.Bool(false),
+					LoadBalancerAttributes: []elbv2api.Attribute{
+						{
+							Key:   "idle_timeout.timeout_seconds",
+							Value: "60",
+						},
+						{
+							Key:   "waf.fail_open.enabled",
+							Value: "true",
+						},
+					},
+				},
+			},
+			wantErr: "spec.loadBalancerAttributes[1].value: Invalid value: \"true\": conflicts with `wafFailOpen`",
+		},
+		{
+			name: "wafv2LoggingConfiguration with CloudWatch Logs log group",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2LoggingConfiguration: &elbv2api.WAFv2LoggingConfiguration{
+						LogDestinationARN: "arn:aws:logs:us-west-2:123456789012:log-group:aws-waf-logs-my-group",
+					},
+				},
+			},
+		},
+		{
+			name: "wafv2LoggingConfiguration with Kinesis Data Firehose delivery stream",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2LoggingConfiguration: &elbv2api.WAFv2LoggingConfiguration{
+						LogDestinationARN: "arn:aws:firehose:us-west-2:123456789012:deliverystream/aws-waf-logs-my-stream",
+					},
+				},
+			},
+		},
+		{
+			name: "wafv2LoggingConfiguration with S3 bucket",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2LoggingConfiguration: &elbv2api.WAFv2LoggingConfiguration{
+						LogDestinationARN: "arn:aws:s3:::aws-waf-logs-my-bucket",
+					},
+				},
+			},
+		},
+		{
+			name: "wafv2LoggingConfiguration with invalid ARN",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2LoggingConfiguration: &elbv2api.WAFv2LoggingConfiguration{
+						LogDestinationARN: "aws-waf-logs-my-bucket",
+					},
+				},
+			},
+			wantErr: "spec.wafv2LoggingConfiguration.logDestinationARN: Invalid value: \"aws-waf-logs-my-bucket\": Could not be parsed as an ARN",
+		},
+		{
+			name: "wafv2LoggingConfiguration with unsupported service",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2LoggingConfiguration: &elbv2api.WAFv2LoggingConfiguration{
+						LogDestinationARN: "arn:aws:sqs:us-west-2:123456789012:aws-waf-logs-my-queue",
+					},
+				},
+			},
+			wantErr: "spec.wafv2LoggingConfiguration.logDestinationARN: Invalid value: \"arn:aws:sqs:us-west-2:123456789012:aws-waf-logs-my-queue\": must be an Amazon CloudWatch Logs log group, Amazon Kinesis Data Firehose delivery stream or Amazon S3 bucket",
+		},
+		{
+			name: "wafv2LoggingConfiguration without required name prefix",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2LoggingConfiguration: &elbv2api.WAFv2LoggingConfiguration{
+						LogDestinationARN: "arn:aws:logs:us-west-2:123456789012:log-group:my-group",
+					},
+				},
+			},
+			wantErr: "spec.wafv2LoggingConfiguration.logDestinationARN: Invalid value: \"arn:aws:logs:us-west-2:123456789012:log-group:my-group\": name must start with \"aws-waf-logs-\"",
+		},
+		{
+			name: "wafv2WebACL with valid rules",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2WebACL: &elbv2api.WAFv2WebACL{
+						RateLimits: []elbv2api.WAFv2RateLimit{{Name: "per-ip", Limit: 1000}},
+						ManagedRuleGroups: []elbv2api.WAFv2ManagedRuleGroup{
+							{VendorName: "AWS", Name: "AWSManagedRulesCommonRuleSet"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "wafv2WebACL with invalid rate limit name",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2WebACL: &elbv2api.WAFv2WebACL{
+						RateLimits: []elbv2api.WAFv2RateLimit{{Name: "per ip", Limit: 1000}},
+					},
+				},
+			},
+			wantErr: "spec.wafv2WebACL.rateLimits[0].name: Invalid value: \"per ip\": must consist of 1 to 128 alphanumeric, '_' or '-' characters",
+		},
+		{
+			name: "wafv2WebACL with duplicate rule names",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFv2WebACL: &elbv2api.WAFv2WebACL{
+						RateLimits: []elbv2api.WAFv2RateLimit{{Name: "AWS-AWSManagedRulesCommonRuleSet", Limit: 1000}},
+						ManagedRuleGroups: []elbv2api.WAFv2ManagedRuleGroup{
+							{VendorName: "AWS", Name: "AWSManagedRulesCommonRuleSet"},
+						},
+					},
+				},
+			},
+			wantErr: "spec.wafv2WebACL.managedRuleGroups[0]: Duplicate value: \"AWS-AWSManagedRulesCommonRuleSet\"",
+		},
+		{
+			name: "defaultAction misdirected-request",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					DefaultAction: &elbv2api.DefaultAction{
+						Type: elbv2api.DefaultActionTypeMisdirectedRequest,
+					},
+				},
+			},
+		},
+		{
+			name: "defaultAction redirect",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					DefaultAction: &elbv2api.DefaultAction{
+						Type: elbv2api.DefaultActionTypeRedirect,
+						Redirect: &elbv2api.DefaultActionRedirectConfig{
+							Host: "www.example.com",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "defaultAction redirect without host",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					DefaultAction: &elbv2api.DefaultAction{
+						Type:     elbv2api.DefaultActionTypeRedirect,
+						Redirect: &elbv2api.DefaultActionRedirectConfig{},
+					},
+				},
+			},
+			wantErr: "spec.defaultAction.redirect.host: Required value: must be specified for redirect type",
+		},
+		{
+			name: "defaultAction forward without forward config",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					DefaultAction: &elbv2api.DefaultAction{
+						Type: elbv2api.DefaultActionTypeForward,
+					},
+				},
+			},
+			wantErr: "spec.defaultAction.forward: Required value: must be specified for forward type",
+		},
+		{
+			name: "defaultAction not-found with redirect config",
+			obj: &elbv2api.IngressClassParams{
+				Spec: elbv2api.IngressClassParamsSpec{
+					DefaultAction: &elbv2api.DefaultAction{
+						Type: elbv2api.DefaultActionTypeNotFound,
+						Redirect: &elbv2api.DefaultActionRedirectConfig{
+							Host: "www.example.com",
+						},
+					},
+				},
+			},
+			wantErr: "spec.defaultAction.redirect: Forbidden: may only be specified for redirect type",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
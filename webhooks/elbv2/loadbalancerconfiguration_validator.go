@@ -0,0 +1,118 @@
+package elbv2
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	apiPathValidateELBv2LoadBalancerConfiguration = "/validate-elbv2-k8s-aws-v1beta1-loadbalancerconfiguration"
+	// annotationMapSeparators are the separators of the Service annotations in "key1=value1,key2=value2" format,
+	// which cannot be used in attributes and tags, otherwise they cannot be compared against the Service annotations.
+	annotationMapSeparators = ",="
+)
+
+// NewLoadBalancerConfigurationValidator returns a validator for the LoadBalancerConfiguration CRD.
+func NewLoadBalancerConfigurationValidator() *loadBalancerConfigurationValidator {
+	return &loadBalancerConfigurationValidator{}
+}
+
+var _ webhook.Validator = &loadBalancerConfigurationValidator{}
+
+type loadBalancerConfigurationValidator struct {
+}
+
+func (v *loadBalancerConfigurationValidator) Prototype(_ admission.Request) (runtime.Object, error) {
+	return &elbv2api.LoadBalancerConfiguration{}, nil
+}
+
+func (v *loadBalancerConfigurationValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	lbCfg := obj.(*elbv2api.LoadBalancerConfiguration)
+	return v.validate(lbCfg).ToAggregate()
+}
+
+func (v *loadBalancerConfigurationValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, oldObj runtime.Object) error {
+	lbCfg := obj.(*elbv2api.LoadBalancerConfiguration)
+	return v.validate(lbCfg).ToAggregate()
+}
+
+func (v *loadBalancerConfigurationValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *loadBalancerConfigurationValidator) validate(lbCfg *elbv2api.LoadBalancerConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+	allErrs = append(allErrs, v.checkUniqueValues(lbCfg.Spec.Subnets, specPath.Child("subnets"))...)
+	allErrs = append(allErrs, v.checkUniqueValues(lbCfg.Spec.SecurityGroups, specPath.Child("securityGroups"))...)
+	allErrs = append(allErrs, v.checkAttributes(lbCfg.Spec.LoadBalancerAttributes, specPath.Child("loadBalancerAttributes"))...)
+	allErrs = append(allErrs, v.checkAttributes(lbCfg.Spec.TargetGroupAttributes, specPath.Child("targetGroupAttributes"))...)
+	allErrs = append(allErrs, v.checkTags(lbCfg.Spec.Tags, specPath.Child("tags"))...)
+	return allErrs
+}
+
+// checkUniqueValues will check the values are non-empty and unique.
+func (v *loadBalancerConfigurationValidator) checkUniqueValues(values []string, fieldPath *field.Path) (allErrs field.ErrorList) {
+	seen := sets.NewString()
+	for idx, value := range values {
+		if value == "" {
+			allErrs = append(allErrs, field.Required(fieldPath.Index(idx), "must be non-empty"))
+		} else if seen.Has(value) {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Index(idx), value))
+		}
+		seen.Insert(value)
+	}
+	return allErrs
+}
+
+// checkAttributes will check the attribute keys are unique, and the attributes can be compared against Service annotations.
+func (v *loadBalancerConfigurationValidator) checkAttributes(attributes []elbv2api.Attribute, fieldPath *field.Path) (allErrs field.ErrorList) {
+	seen := sets.NewString()
+	for idx, attr := range attributes {
+		attrPath := fieldPath.Index(idx)
+		if seen.Has(attr.Key) {
+			allErrs = append(allErrs, field.Duplicate(attrPath.Child("key"), attr.Key))
+		}
+		seen.Insert(attr.Key)
+		allErrs = append(allErrs, v.checkAnnotationMapEntry(attr.Key, attr.Value, attrPath)...)
+	}
+	return allErrs
+}
+
+// checkTags will check the tag keys are unique, and the tags can be compared against Service annotations.
+func (v *loadBalancerConfigurationValidator) checkTags(tags []elbv2api.Tag, fieldPath *field.Path) (allErrs field.ErrorList) {
+	seen := sets.NewString()
+	for idx, tag := range tags {
+		tagPath := fieldPath.Index(idx)
+		if seen.Has(tag.Key) {
+			allErrs = append(allErrs, field.Duplicate(tagPath.Child("key"), tag.Key))
+		}
+		seen.Insert(tag.Key)
+		allErrs = append(allErrs, v.checkAnnotationMapEntry(tag.Key, tag.Value, tagPath)...)
+	}
+	return allErrs
+}
+
+func (v *loadBalancerConfigurationValidator) checkAnnotationMapEntry(key string, value string, fieldPath *field.Path) (allErrs field.ErrorList) {
+	if strings.ContainsAny(key, annotationMapSeparators) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("key"), key, "may not contain ',' or '='"))
+	}
+	if strings.ContainsAny(value, annotationMapSeparators) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("value"), value, "may not contain ',' or '='"))
+	}
+	return allErrs
+}
+
+// +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-loadbalancerconfiguration,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=loadbalancerconfigurations,verbs=create;update,versions=v1beta1,name=vloadbalancerconfiguration.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
+
+func (v *loadBalancerConfigurationValidator) SetupWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(apiPathValidateELBv2LoadBalancerConfiguration, webhook.ValidatingWebhookForValidator(v))
+}
@@ -0,0 +1,112 @@
+package elbv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+)
+
+func Test_loadBalancerConfigurationValidator_ValidateCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		obj     *elbv2api.LoadBalancerConfiguration
+		wantErr string
+	}{
+		{
+			name: "empty",
+			obj:  &elbv2api.LoadBalancerConfiguration{},
+		},
+		{
+			name: "valid settings",
+			obj: &elbv2api.LoadBalancerConfiguration{
+				Spec: elbv2api.LoadBalancerConfigurationSpec{
+					Subnets:        []string{"subnet-a", "subnet-b"},
+					SecurityGroups: []string{"sg-a"},
+					LoadBalancerAttributes: []elbv2api.Attribute{
+						{
+							Key:   "deletion_protection.enabled",
+							Value: "true",
+						},
+					},
+					TargetGroupAttributes: []elbv2api.Attribute{
+						{
+							Key:   "deregistration_delay.timeout_seconds",
+							Value: "30",
+						},
+					},
+					Tags: []elbv2api.Tag{
+						{
+							Key:   "cost-center",
+							Value: "1234",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "duplicate subnets and empty securityGroup",
+			obj: &elbv2api.LoadBalancerConfiguration{
+				Spec: elbv2api.LoadBalancerConfigurationSpec{
+					Subnets:        []string{"subnet-a", "subnet-a"},
+					SecurityGroups: []string{""},
+				},
+			},
+			wantErr: "[spec.subnets[1]: Duplicate value: \"subnet-a\", spec.securityGroups[0]: Required value: must be non-empty]",
+		},
+		{
+			name: "duplicate attribute keys",
+			obj: &elbv2api.LoadBalancerConfiguration{
+				Spec: elbv2api.LoadBalancerConfigurationSpec{
+					TargetGroupAttributes: []elbv2api.Attribute{
+						{
+							Key:   "deregistration_delay.timeout_seconds",
+							Value: "30",
+						},
+						{
+							Key:   "deregistration_delay.timeout_seconds",
+							Value: "60",
+						},
+					},
+				},
+			},
+			wantErr: "spec.targetGroupAttributes[1].key: Duplicate value: \"deregistration_delay.timeout_seconds\"",
+		},
+		{
+			name: "tag with annotation separators",
+			obj: &elbv2api.LoadBalancerConfiguration{
+				Spec: elbv2api.LoadBalancerConfigurationSpec{
+					Tags: []elbv2api.Tag{
+						{
+							Key:   "owner",
+							Value: "team=a,team=b",
+						},
+					},
+				},
+			},
+			wantErr: "spec.tags[0].value: Invalid value: \"team=a,team=b\": may not contain ',' or '='",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &loadBalancerConfigurationValidator{}
+			t.Run("create", func(t *testing.T) {
+				err := v.ValidateCreate(context.Background(), tt.obj)
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+			t.Run("update", func(t *testing.T) {
+				err := v.ValidateUpdate(context.Background(), tt.obj, &elbv2api.LoadBalancerConfiguration{})
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			})
+		})
+	}
+}
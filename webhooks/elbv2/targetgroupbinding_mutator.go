@@ -2,12 +2,14 @@ package elbv2
 
 import (
 	"context"
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -17,9 +19,10 @@ import (
 const apiPathMutateELBv2TargetGroupBinding = "/mutate-elbv2-k8s-aws-v1beta1-targetgroupbinding"
 
 // NewTargetGroupBindingMutator returns a mutator for TargetGroupBinding CRD.
-func NewTargetGroupBindingMutator(elbv2Client services.ELBV2, logger logr.Logger) *targetGroupBindingMutator {
+func NewTargetGroupBindingMutator(cloud aws.Cloud, logger logr.Logger) *targetGroupBindingMutator {
 	return &targetGroupBindingMutator{
-		elbv2Client: elbv2Client,
+		cloud:       cloud,
+		elbv2Client: cloud.ELBV2(),
 		logger:      logger,
 	}
 }
@@ -27,6 +30,7 @@ func NewTargetGroupBindingMutator(elbv2Client services.ELBV2, logger logr.Logger
 var _ webhook.Mutator = &targetGroupBindingMutator{}
 
 type targetGroupBindingMutator struct {
+	cloud       aws.Cloud
 	elbv2Client services.ELBV2
 	logger      logr.Logger
 }
@@ -54,16 +58,15 @@ func (m *targetGroupBindingMutator) defaultingTargetType(ctx context.Context, tg
 	if tgb.Spec.TargetType != nil {
 		return nil
 	}
-	tgARN := tgb.Spec.TargetGroupARN
-	sdkTargetType, err := m.obtainSDKTargetTypeFromAWS(ctx, tgARN)
+	sdkTargetType, err := m.obtainSDKTargetTypeFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "couldn't determine TargetType")
 	}
 	var targetType elbv2api.TargetType
 	switch sdkTargetType {
-	case elbv2sdk.TargetTypeEnumInstance:
+	case elbv2types.TargetTypeEnumInstance:
 		targetType = elbv2api.TargetTypeInstance
-	case elbv2sdk.TargetTypeEnumIp:
+	case elbv2types.TargetTypeEnumIp:
 		targetType = elbv2api.TargetTypeIP
 	default:
 		return errors.Errorf("unsupported TargetType: %v", sdkTargetType)
@@ -77,7 +80,7 @@ func (m *targetGroupBindingMutator) defaultingIPAddressType(ctx context.Context,
 	if tgb.Spec.IPAddressType != nil {
 		return nil
 	}
-	targetGroupIPAddressType, err := m.getTargetGroupIPAddressTypeFromAWS(ctx, tgb.Spec.TargetGroupARN)
+	targetGroupIPAddressType, err := m.getTargetGroupIPAddressTypeFromAWS(ctx, tgb)
 	if err != nil {
 		return errors.Wrap(err, "unable to get target group IP address type")
 	}
@@ -85,44 +88,44 @@ func (m *targetGroupBindingMutator) defaultingIPAddressType(ctx context.Context,
 	return nil
 }
 
-func (m *targetGroupBindingMutator) obtainSDKTargetTypeFromAWS(ctx context.Context, tgARN string) (string, error) {
-	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgARN)
+func (m *targetGroupBindingMutator) obtainSDKTargetTypeFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (elbv2types.TargetTypeEnum, error) {
+	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
-	return awssdk.StringValue(targetGroup.TargetType), nil
+	return targetGroup.TargetType, nil
 }
 
 // getTargetGroupIPAddressTypeFromAWS returns the target group IP address type of AWS target group
-func (m *targetGroupBindingMutator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgARN string) (elbv2api.TargetGroupIPAddressType, error) {
-	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgARN)
+func (m *targetGroupBindingMutator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (elbv2api.TargetGroupIPAddressType, error) {
+	targetGroup, err := m.getTargetGroupFromAWS(ctx, tgb)
 	if err != nil {
 		return "", err
 	}
 	var ipAddressType elbv2api.TargetGroupIPAddressType
-	switch awssdk.StringValue(targetGroup.IpAddressType) {
-	case elbv2sdk.TargetGroupIpAddressTypeEnumIpv6:
+	switch targetGroup.IpAddressType {
+	case elbv2types.TargetGroupIpAddressTypeEnumIpv6:
 		ipAddressType = elbv2api.TargetGroupIPAddressTypeIPv6
-	case elbv2sdk.TargetGroupIpAddressTypeEnumIpv4, "":
+	case elbv2types.TargetGroupIpAddressTypeEnumIpv4, "":
 		ipAddressType = elbv2api.TargetGroupIPAddressTypeIPv4
 	default:
-		return "", errors.Errorf("unsupported IPAddressType: %v", awssdk.StringValue(targetGroup.IpAddressType))
+		return "", errors.Errorf("unsupported IPAddressType: %v", string(targetGroup.IpAddressType))
 	}
 	return ipAddressType, nil
 }
 
-func (m *targetGroupBindingMutator) getTargetGroupFromAWS(ctx context.Context, tgARN string) (*elbv2sdk.TargetGroup, error) {
+func (m *targetGroupBindingMutator) getTargetGroupFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2types.TargetGroup, error) {
 	req := &elbv2sdk.DescribeTargetGroupsInput{
-		TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+		TargetGroupArns: []string{tgb.Spec.TargetGroupARN},
 	}
-	tgList, err := m.elbv2Client.DescribeTargetGroupsAsList(ctx, req)
+	tgList, err := aws.ELBV2ForRole(m.cloud, m.elbv2Client, tgb.Spec.AWSRoleARN).DescribeTargetGroupsAsList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 	if len(tgList) != 1 {
 		return nil, errors.Errorf("expecting a single targetGroup but got %v", len(tgList))
 	}
-	return tgList[0], nil
+	return &tgList[0], nil
 }
 
 // +kubebuilder:webhook:path=/mutate-elbv2-k8s-aws-v1beta1-targetgroupbinding,mutating=true,failurePolicy=fail,groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=create;update,versions=v1beta1,name=mtargetgroupbinding.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
@@ -4,8 +4,9 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
@@ -18,7 +19,7 @@ import (
 func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 	type describeTargetGroupsAsListCall struct {
 		req  *elbv2sdk.DescribeTargetGroupsInput
-		resp []*elbv2sdk.TargetGroup
+		resp []elbv2types.TargetGroup
 		err  error
 	}
 
@@ -68,12 +69,12 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-1"),
-								TargetType:     awssdk.String("instance"),
+								TargetType:     elbv2types.TargetTypeEnumInstance,
 							},
 						},
 					},
@@ -101,12 +102,12 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-1"),
-								TargetType:     awssdk.String("ip"),
+								TargetType:     elbv2types.TargetTypeEnumIp,
 							},
 						},
 					},
@@ -134,12 +135,12 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-1"),
-								TargetType:     awssdk.String("lambda"),
+								TargetType:     elbv2types.TargetTypeEnumLambda,
 							},
 						},
 					},
@@ -205,7 +206,7 @@ func Test_targetGroupBindingMutator_MutateCreate(t *testing.T) {
 func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 	type describeTargetGroupsAsListCall struct {
 		req  *elbv2sdk.DescribeTargetGroupsInput
-		resp []*elbv2sdk.TargetGroup
+		resp []elbv2types.TargetGroup
 		err  error
 	}
 
@@ -219,7 +220,7 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 		name    string
 		fields  fields
 		args    args
-		want    string
+		want    elbv2types.TargetTypeEnum
 		wantErr error
 	}{
 		{
@@ -228,11 +229,11 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
-								TargetType: awssdk.String("instance"),
+								TargetType: elbv2types.TargetTypeEnumInstance,
 							},
 						},
 					},
@@ -241,7 +242,7 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 			args: args{
 				tgARN: "tg-1",
 			},
-			want: "instance",
+			want: elbv2types.TargetTypeEnumInstance,
 		},
 		{
 			name: "standard case - ip targetType",
@@ -249,11 +250,11 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
-								TargetType: awssdk.String("ip"),
+								TargetType: elbv2types.TargetTypeEnumIp,
 							},
 						},
 					},
@@ -262,7 +263,7 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 			args: args{
 				tgARN: "tg-1",
 			},
-			want: "ip",
+			want: elbv2types.TargetTypeEnumIp,
 		},
 		{
 			name: "some error during describeTargetGroupCall",
@@ -270,7 +271,7 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
 						err: errors.New("targetGroup not found"),
 					},
@@ -295,7 +296,9 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 				elbv2Client: elbv2Client,
 				logger:      logr.New(&log.NullLogSink{}),
 			}
-			got, err := m.obtainSDKTargetTypeFromAWS(context.Background(), tt.args.tgARN)
+			got, err := m.obtainSDKTargetTypeFromAWS(context.Background(), &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{TargetGroupARN: tt.args.tgARN},
+			})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
@@ -309,7 +312,7 @@ func Test_targetGroupBindingMutator_obtainSDKTargetTypeFromAWS(t *testing.T) {
 func Test_targetGroupBindingMutator_getIPAddressTypeFromAWS(t *testing.T) {
 	type describeTargetGroupsAsListCall struct {
 		req  *elbv2sdk.DescribeTargetGroupsInput
-		resp []*elbv2sdk.TargetGroup
+		resp []elbv2types.TargetGroup
 		err  error
 	}
 
@@ -332,11 +335,11 @@ func Test_targetGroupBindingMutator_getIPAddressTypeFromAWS(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
-								TargetType: awssdk.String("instance"),
+								TargetType: elbv2types.TargetTypeEnumInstance,
 							},
 						},
 					},
@@ -353,12 +356,12 @@ func Test_targetGroupBindingMutator_getIPAddressTypeFromAWS(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
-								TargetType:    awssdk.String("ip"),
-								IpAddressType: awssdk.String("ipv4"),
+								TargetType:    elbv2types.TargetTypeEnumIp,
+								IpAddressType: elbv2types.TargetGroupIpAddressTypeEnumIpv4,
 							},
 						},
 					},
@@ -375,12 +378,12 @@ func Test_targetGroupBindingMutator_getIPAddressTypeFromAWS(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
-								TargetType:    awssdk.String("ip"),
-								IpAddressType: awssdk.String("ipv6"),
+								TargetType:    elbv2types.TargetTypeEnumIp,
+								IpAddressType: elbv2types.TargetGroupIpAddressTypeEnumIpv6,
 							},
 						},
 					},
@@ -397,7 +400,7 @@ func Test_targetGroupBindingMutator_getIPAddressTypeFromAWS(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-1"}),
+							TargetGroupArns: []string{"tg-1"},
 						},
 						err: errors.New("targetGroup not found"),
 					},
@@ -422,7 +425,9 @@ func Test_targetGroupBindingMutator_getIPAddressTypeFromAWS(t *testing.T) {
 				elbv2Client: elbv2Client,
 				logger:      logr.New(&log.NullLogSink{}),
 			}
-			got, err := m.getTargetGroupIPAddressTypeFromAWS(context.Background(), tt.args.tgARN)
+			got, err := m.getTargetGroupIPAddressTypeFromAWS(context.Background(), &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{TargetGroupARN: tt.args.tgARN},
+			})
 			if tt.wantErr != nil {
 				assert.EqualError(t, err, tt.wantErr.Error())
 			} else {
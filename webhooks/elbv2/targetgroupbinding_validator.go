@@ -2,29 +2,45 @@ package elbv2
 
 import (
 	"context"
+	"net/netip"
+	"strconv"
 	"strings"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/k8s"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/targetgroupbinding"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-const apiPathValidateELBv2TargetGroupBinding = "/validate-elbv2-k8s-aws-v1beta1-targetgroupbinding"
+const (
+	apiPathValidateELBv2TargetGroupBinding = "/validate-elbv2-k8s-aws-v1beta1-targetgroupbinding"
+	healthCheckPortTrafficPort             = "traffic-port"
+)
 
 // NewTargetGroupBindingValidator returns a validator for TargetGroupBinding CRD.
-func NewTargetGroupBindingValidator(k8sClient client.Client, elbv2Client services.ELBV2, logger logr.Logger) *targetGroupBindingValidator {
+func NewTargetGroupBindingValidator(k8sClient client.Client, cloud aws.Cloud, logger logr.Logger) *targetGroupBindingValidator {
 	return &targetGroupBindingValidator{
 		k8sClient:   k8sClient,
-		elbv2Client: elbv2Client,
+		cloud:       cloud,
+		elbv2Client: cloud.ELBV2(),
+		vpcID:       cloud.VpcID(),
 		logger:      logger,
 	}
 }
@@ -33,7 +49,9 @@ var _ webhook.Validator = &targetGroupBindingValidator{}
 
 type targetGroupBindingValidator struct {
 	k8sClient   client.Client
+	cloud       aws.Cloud
 	elbv2Client services.ELBV2
+	vpcID       string
 	logger      logr.Logger
 }
 
@@ -49,10 +67,22 @@ func (v *targetGroupBindingValidator) ValidateCreate(ctx context.Context, obj ru
 	if err := v.checkNodeSelector(tgb); err != nil {
 		return err
 	}
+	if err := v.checkMaxTargetNodes(tgb); err != nil {
+		return err
+	}
+	if err := v.checkExternalTargets(tgb); err != nil {
+		return err
+	}
+	if err := v.checkTargetGroupAttributes(tgb); err != nil {
+		return err
+	}
+	if err := v.checkManageTargetGroupConfig(tgb); err != nil {
+		return err
+	}
 	if err := v.checkExistingTargetGroups(tgb); err != nil {
 		return err
 	}
-	if err := v.checkTargetGroupIPAddressType(ctx, tgb); err != nil {
+	if err := v.checkTargetGroup(ctx, tgb); err != nil {
 		return err
 	}
 	return nil
@@ -70,6 +100,19 @@ func (v *targetGroupBindingValidator) ValidateUpdate(ctx context.Context, obj ru
 	if err := v.checkNodeSelector(tgb); err != nil {
 		return err
 	}
+	if err := v.checkMaxTargetNodes(tgb); err != nil {
+		return err
+	}
+	if err := v.checkExternalTargets(tgb); err != nil {
+		return err
+	}
+	if err := v.checkTargetGroupAttributes(tgb); err != nil {
+		return err
+	}
+	if err := v.checkManageTargetGroupConfig(tgb); err != nil {
+		return err
+	}
+	v.checkMultiClusterTargetGroupUsage(ctx, tgb, oldTgb)
 	return nil
 }
 
@@ -114,6 +157,14 @@ func (v *targetGroupBindingValidator) checkImmutableFields(tgb *elbv2api.TargetG
 	return nil
 }
 
+// checkMultiClusterTargetGroupUsage warns about enabling multiClusterTargetGroup on an existing TargetGroupBinding,
+// as the targets registered earlier aren't tracked and must be deregistered manually.
+func (v *targetGroupBindingValidator) checkMultiClusterTargetGroupUsage(ctx context.Context, tgb *elbv2api.TargetGroupBinding, oldTGB *elbv2api.TargetGroupBinding) {
+	if tgb.Spec.MultiClusterTargetGroup && !oldTGB.Spec.MultiClusterTargetGroup {
+		webhook.ContextAddAdmissionWarning(ctx, "enabling spec.multiClusterTargetGroup doesn't track the targets registered earlier, those targets must be deregistered manually")
+	}
+}
+
 // checkExistingTargetGroups will check for unique TargetGroup per TargetGroupBinding
 func (v *targetGroupBindingValidator) checkExistingTargetGroups(tgb *elbv2api.TargetGroupBinding) error {
 	ctx := context.Background()
@@ -137,9 +188,136 @@ func (v *targetGroupBindingValidator) checkNodeSelector(tgb *elbv2api.TargetGrou
 	return nil
 }
 
+// checkMaxTargetNodes ensures that MaxTargetNodes is only set when TargetType is instance, and is a positive number or percentage
+func (v *targetGroupBindingValidator) checkMaxTargetNodes(tgb *elbv2api.TargetGroupBinding) error {
+	if tgb.Spec.MaxTargetNodes == nil {
+		return nil
+	}
+	if *tgb.Spec.TargetType == elbv2api.TargetTypeIP {
+		return errors.Errorf("TargetGroupBinding cannot set MaxTargetNodes when TargetType is ip")
+	}
+	maxTargetNodes := *tgb.Spec.MaxTargetNodes
+	if maxTargetNodes.Type == intstr.Int {
+		if maxTargetNodes.IntVal <= 0 {
+			return errors.Errorf("TargetGroupBinding MaxTargetNodes %v must be positive", maxTargetNodes.IntVal)
+		}
+		return nil
+	}
+	percentage, err := strconv.Atoi(strings.TrimSuffix(maxTargetNodes.StrVal, "%"))
+	if err != nil || !strings.HasSuffix(maxTargetNodes.StrVal, "%") || percentage <= 0 || percentage > 100 {
+		return errors.Errorf("TargetGroupBinding MaxTargetNodes %v must be a percentage between 1%% and 100%%", maxTargetNodes.StrVal)
+	}
+	return nil
+}
+
+// checkExternalTargets ensures that ExternalTargets are IP addresses when TargetType is ip, and EC2 instance IDs when TargetType is instance
+func (v *targetGroupBindingValidator) checkExternalTargets(tgb *elbv2api.TargetGroupBinding) error {
+	for _, externalTarget := range tgb.Spec.ExternalTargets {
+		switch *tgb.Spec.TargetType {
+		case elbv2api.TargetTypeIP:
+			if _, err := netip.ParseAddr(externalTarget.ID); err != nil {
+				return errors.Errorf("TargetGroupBinding externalTarget %v must be an IP address when TargetType is ip", externalTarget.ID)
+			}
+		case elbv2api.TargetTypeInstance:
+			if !strings.HasPrefix(externalTarget.ID, "i-") {
+				return errors.Errorf("TargetGroupBinding externalTarget %v must be an EC2 instance ID when TargetType is instance", externalTarget.ID)
+			}
+		}
+	}
+	return nil
+}
+
+// checkTargetGroupAttributes ensures that each TargetGroup attribute is specified at most once,
+// and the target health attributes are valid along with the ones derived from manageTargetGroupConfig.
+func (v *targetGroupBindingValidator) checkTargetGroupAttributes(tgb *elbv2api.TargetGroupBinding) error {
+	attrs := targetgroupbinding.BuildManagedTargetGroupConfigAttributes(tgb.Spec.ManageTargetGroupConfig)
+	attrKeys := sets.NewString()
+	for _, attr := range tgb.Spec.TargetGroupAttributes {
+		if attrKeys.Has(attr.Key) {
+			return errors.Errorf("TargetGroupBinding targetGroupAttribute %v is specified more than once", attr.Key)
+		}
+		attrKeys.Insert(attr.Key)
+		attrs[attr.Key] = attr.Value
+	}
+	if err := elbv2model.ValidateTargetGroupHealthAttributes(attrs); err != nil {
+		return errors.Wrap(err, "TargetGroupBinding has invalid target group attributes")
+	}
+	return nil
+}
+
+// checkManageTargetGroupConfig ensures the manageTargetGroupConfig is consistent, and doesn't manage the same
+// TargetGroup attribute as targetGroupAttributes.
+func (v *targetGroupBindingValidator) checkManageTargetGroupConfig(tgb *elbv2api.TargetGroupBinding) error {
+	tgConfig := tgb.Spec.ManageTargetGroupConfig
+	if tgConfig == nil {
+		return nil
+	}
+	if healthCheck := tgConfig.HealthCheck; healthCheck != nil {
+		if healthCheck.Port != nil {
+			if err := validateHealthCheckPort(*healthCheck.Port); err != nil {
+				return err
+			}
+		}
+		if healthCheck.IntervalSeconds != nil && healthCheck.TimeoutSeconds != nil &&
+			*healthCheck.TimeoutSeconds >= *healthCheck.IntervalSeconds {
+			return errors.Errorf("TargetGroupBinding manageTargetGroupConfig healthCheck timeoutSeconds must be less than intervalSeconds")
+		}
+	}
+	if stickiness := tgConfig.Stickiness; stickiness != nil && stickiness.DurationSeconds != nil {
+		if stickiness.Type == nil || (*stickiness.Type != elbv2api.ManagedTargetGroupStickinessTypeLBCookie &&
+			*stickiness.Type != elbv2api.ManagedTargetGroupStickinessTypeAppCookie) {
+			return errors.Errorf("TargetGroupBinding manageTargetGroupConfig stickiness durationSeconds requires type %v or %v",
+				elbv2api.ManagedTargetGroupStickinessTypeLBCookie, elbv2api.ManagedTargetGroupStickinessTypeAppCookie)
+		}
+	}
+	managedAttrs := targetgroupbinding.BuildManagedTargetGroupConfigAttributes(tgConfig)
+	for _, attr := range tgb.Spec.TargetGroupAttributes {
+		if _, ok := managedAttrs[attr.Key]; ok {
+			return errors.Errorf("TargetGroupBinding targetGroupAttribute %v conflicts with manageTargetGroupConfig", attr.Key)
+		}
+	}
+	return nil
+}
+
+// validateHealthCheckPort ensures the health check port is either a valid port number or "traffic-port".
+func validateHealthCheckPort(port intstr.IntOrString) error {
+	if port.Type == intstr.String {
+		if port.StrVal != healthCheckPortTrafficPort {
+			return errors.Errorf("TargetGroupBinding manageTargetGroupConfig healthCheck port must be a port number or %v", healthCheckPortTrafficPort)
+		}
+		return nil
+	}
+	if port.IntVal < 1 || port.IntVal > 65535 {
+		return errors.Errorf("TargetGroupBinding manageTargetGroupConfig healthCheck port %v is out of range", port.IntVal)
+	}
+	return nil
+}
+
+// checkTargetGroup ensures the AWS target group exists and is compatible with the TargetGroupBinding,
+// so that misconfigurations are rejected on apply instead of failing the reconciles.
+func (v *targetGroupBindingValidator) checkTargetGroup(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
+	targetGroup, err := v.getTargetGroupFromAWS(ctx, tgb)
+	if err != nil {
+		return errors.Wrapf(err, "unable to get TargetGroup %v", tgb.Spec.TargetGroupARN)
+	}
+	if err := v.checkTargetGroupIPAddressType(tgb, targetGroup); err != nil {
+		return err
+	}
+	if err := v.checkTargetGroupTargetType(tgb, targetGroup); err != nil {
+		return err
+	}
+	if err := v.checkTargetGroupVPC(tgb, targetGroup); err != nil {
+		return err
+	}
+	if err := v.checkTargetGroupProtocol(ctx, tgb, targetGroup); err != nil {
+		return err
+	}
+	return nil
+}
+
 // checkTargetGroupIPAddressType ensures IP address type matches with that on the AWS target group
-func (v *targetGroupBindingValidator) checkTargetGroupIPAddressType(ctx context.Context, tgb *elbv2api.TargetGroupBinding) error {
-	targetGroupIPAddressType, err := v.getTargetGroupIPAddressTypeFromAWS(ctx, tgb.Spec.TargetGroupARN)
+func (v *targetGroupBindingValidator) checkTargetGroupIPAddressType(tgb *elbv2api.TargetGroupBinding, targetGroup *elbv2types.TargetGroup) error {
+	targetGroupIPAddressType, err := buildTargetGroupIPAddressType(targetGroup)
 	if err != nil {
 		return errors.Wrap(err, "unable to get target group IP address type")
 	}
@@ -147,40 +325,100 @@ func (v *targetGroupBindingValidator) checkTargetGroupIPAddressType(ctx context.
 		(tgb.Spec.IPAddressType == nil && targetGroupIPAddressType != elbv2api.TargetGroupIPAddressTypeIPv4) {
 		return errors.Errorf("invalid IP address type %v for TargetGroup %v", targetGroupIPAddressType, tgb.Spec.TargetGroupARN)
 	}
+	// TargetGroups only accept targets of their IP address type, thus the pod IPs of other families cannot be registered.
+	if tgb.Spec.TargetIPFamilyPolicy != nil {
+		preferIPv6 := *tgb.Spec.TargetIPFamilyPolicy == elbv2api.TargetIPFamilyPolicyPreferIPv6
+		if preferIPv6 != (targetGroupIPAddressType == elbv2api.TargetGroupIPAddressTypeIPv6) {
+			return errors.Errorf("targetIPFamilyPolicy %v is incompatible with TargetGroup %v of IP address type %v",
+				*tgb.Spec.TargetIPFamilyPolicy, tgb.Spec.TargetGroupARN, targetGroupIPAddressType)
+		}
+	}
+	return nil
+}
+
+// checkTargetGroupTargetType ensures targetType matches with that on the AWS target group
+func (v *targetGroupBindingValidator) checkTargetGroupTargetType(tgb *elbv2api.TargetGroupBinding, targetGroup *elbv2types.TargetGroup) error {
+	targetGroupTargetType := string(targetGroup.TargetType)
+	if targetGroupTargetType != "" && targetGroupTargetType != string(*tgb.Spec.TargetType) {
+		return errors.Errorf("invalid targetType %v for TargetGroup %v with targetType %v", *tgb.Spec.TargetType, tgb.Spec.TargetGroupARN, targetGroupTargetType)
+	}
+	return nil
+}
+
+// checkTargetGroupVPC ensures the AWS target group is within the VPC of cluster.
+// TargetGroups within other AWS accounts are not checked, as they're registered with targets through VPC peering or transit gateways.
+func (v *targetGroupBindingValidator) checkTargetGroupVPC(tgb *elbv2api.TargetGroupBinding, targetGroup *elbv2types.TargetGroup) error {
+	if tgb.Spec.AWSRoleARN != "" {
+		return nil
+	}
+	targetGroupVPCID := awssdk.ToString(targetGroup.VpcId)
+	if targetGroupVPCID != "" && targetGroupVPCID != v.vpcID {
+		return errors.Errorf("TargetGroup %v is within VPC %v, not the VPC %v of cluster", tgb.Spec.TargetGroupARN, targetGroupVPCID, v.vpcID)
+	}
 	return nil
 }
 
-// getTargetGroupIPAddressTypeFromAWS returns the target group IP address type of AWS target group
-func (v *targetGroupBindingValidator) getTargetGroupIPAddressTypeFromAWS(ctx context.Context, tgARN string) (elbv2api.TargetGroupIPAddressType, error) {
-	targetGroup, err := v.getTargetGroupFromAWS(ctx, tgARN)
+// checkTargetGroupProtocol ensures the protocol of AWS target group is compatible with the referenced service port.
+// the check is skipped if the Service doesn't exist yet, e.g. when it's applied along with the TargetGroupBinding.
+func (v *targetGroupBindingValidator) checkTargetGroupProtocol(ctx context.Context, tgb *elbv2api.TargetGroupBinding, targetGroup *elbv2types.TargetGroup) error {
+	svc := &corev1.Service{}
+	svcKey := types.NamespacedName{Namespace: tgb.Namespace, Name: tgb.Spec.ServiceRef.Name}
+	if err := v.k8sClient.Get(ctx, svcKey, svc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get Service %v", svcKey.String())
+	}
+	svcPort, err := k8s.LookupServicePort(svc, tgb.Spec.ServiceRef.Port)
 	if err != nil {
-		return "", err
+		return errors.Wrapf(err, "invalid serviceRef for Service %v", svcKey.String())
+	}
+	targetGroupProtocol := string(targetGroup.Protocol)
+	if targetGroupProtocol == "" {
+		return nil
+	}
+	compatibleProtocols := compatibleTargetGroupProtocolsByServicePortProtocol[svcPort.Protocol]
+	if !compatibleProtocols.Has(targetGroupProtocol) {
+		return errors.Errorf("TargetGroup %v with protocol %v is incompatible with the %v port %v of Service %v",
+			tgb.Spec.TargetGroupARN, targetGroupProtocol, svcPort.Protocol, tgb.Spec.ServiceRef.Port.String(), svcKey.String())
 	}
+	return nil
+}
+
+// compatibleTargetGroupProtocolsByServicePortProtocol are the target group protocols that can forward traffic to service ports per protocol.
+var compatibleTargetGroupProtocolsByServicePortProtocol = map[corev1.Protocol]sets.String{
+	corev1.ProtocolTCP: sets.NewString(string(elbv2types.ProtocolEnumHttp), string(elbv2types.ProtocolEnumHttps), string(elbv2types.ProtocolEnumTcp),
+		string(elbv2types.ProtocolEnumTls), string(elbv2types.ProtocolEnumTcpUdp)),
+	corev1.ProtocolUDP: sets.NewString(string(elbv2types.ProtocolEnumUdp), string(elbv2types.ProtocolEnumTcpUdp)),
+}
+
+// buildTargetGroupIPAddressType returns the target group IP address type of AWS target group
+func buildTargetGroupIPAddressType(targetGroup *elbv2types.TargetGroup) (elbv2api.TargetGroupIPAddressType, error) {
 	var ipAddressType elbv2api.TargetGroupIPAddressType
-	switch awssdk.StringValue(targetGroup.IpAddressType) {
-	case elbv2sdk.TargetGroupIpAddressTypeEnumIpv6:
+	switch targetGroup.IpAddressType {
+	case elbv2types.TargetGroupIpAddressTypeEnumIpv6:
 		ipAddressType = elbv2api.TargetGroupIPAddressTypeIPv6
-	case elbv2sdk.TargetGroupIpAddressTypeEnumIpv4, "":
+	case elbv2types.TargetGroupIpAddressTypeEnumIpv4, "":
 		ipAddressType = elbv2api.TargetGroupIPAddressTypeIPv4
 	default:
-		return "", errors.Errorf("unsupported IPAddressType: %v", awssdk.StringValue(targetGroup.IpAddressType))
+		return "", errors.Errorf("unsupported IPAddressType: %v", string(targetGroup.IpAddressType))
 	}
 	return ipAddressType, nil
 }
 
 // getTargetGroupFromAWS returns the AWS target group corresponding to the ARN
-func (v *targetGroupBindingValidator) getTargetGroupFromAWS(ctx context.Context, tgARN string) (*elbv2sdk.TargetGroup, error) {
+func (v *targetGroupBindingValidator) getTargetGroupFromAWS(ctx context.Context, tgb *elbv2api.TargetGroupBinding) (*elbv2types.TargetGroup, error) {
 	req := &elbv2sdk.DescribeTargetGroupsInput{
-		TargetGroupArns: awssdk.StringSlice([]string{tgARN}),
+		TargetGroupArns: []string{tgb.Spec.TargetGroupARN},
 	}
-	tgList, err := v.elbv2Client.DescribeTargetGroupsAsList(ctx, req)
+	tgList, err := aws.ELBV2ForRole(v.cloud, v.elbv2Client, tgb.Spec.AWSRoleARN).DescribeTargetGroupsAsList(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 	if len(tgList) != 1 {
 		return nil, errors.Errorf("expecting a single targetGroup but got %v", len(tgList))
 	}
-	return tgList[0], nil
+	return &tgList[0], nil
 }
 
 // +kubebuilder:webhook:path=/validate-elbv2-k8s-aws-v1beta1-targetgroupbinding,mutating=false,failurePolicy=fail,groups=elbv2.k8s.aws,resources=targetgroupbindings,verbs=create;update,versions=v1beta1,name=vtargetgroupbinding.elbv2.k8s.aws,sideEffects=None,webhookVersions=v1,admissionReviewVersions=v1beta1
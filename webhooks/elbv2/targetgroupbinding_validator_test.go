@@ -4,20 +4,24 @@ import (
 	"context"
 	"testing"
 
-	awssdk "github.com/aws/aws-sdk-go/aws"
-	elbv2sdk "github.com/aws/aws-sdk-go/service/elbv2"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	elbv2sdk "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/go-logr/logr"
 	"github.com/golang/mock/gomock"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/aws/services"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -27,7 +31,7 @@ func Test_targetGroupBindingValidator_ValidateCreate(t *testing.T) {
 	targetGroupIPAddressTypeIPv6 := elbv2api.TargetGroupIPAddressTypeIPv6
 	type describeTargetGroupsAsListCall struct {
 		req  *elbv2sdk.DescribeTargetGroupsInput
-		resp []*elbv2sdk.TargetGroup
+		resp []elbv2types.TargetGroup
 		err  error
 	}
 
@@ -63,12 +67,12 @@ func Test_targetGroupBindingValidator_ValidateCreate(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-2"}),
+							TargetGroupArns: []string{"tg-2"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-2"),
-								TargetType:     awssdk.String("instance"),
+								TargetType:     elbv2types.TargetTypeEnumInstance,
 							},
 						},
 					},
@@ -102,12 +106,12 @@ func Test_targetGroupBindingValidator_ValidateCreate(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-2"}),
+							TargetGroupArns: []string{"tg-2"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-2"),
-								TargetType:     awssdk.String("instance"),
+								TargetType:     elbv2types.TargetTypeEnumInstance,
 							},
 						},
 					},
@@ -130,13 +134,13 @@ func Test_targetGroupBindingValidator_ValidateCreate(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-2"}),
+							TargetGroupArns: []string{"tg-2"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-2"),
-								TargetType:     awssdk.String("instance"),
-								IpAddressType:  awssdk.String("ipv4"),
+								TargetType:     elbv2types.TargetTypeEnumInstance,
+								IpAddressType:  elbv2types.TargetGroupIpAddressTypeEnumIpv4,
 							},
 						},
 					},
@@ -159,13 +163,13 @@ func Test_targetGroupBindingValidator_ValidateCreate(t *testing.T) {
 				describeTargetGroupsAsListCalls: []describeTargetGroupsAsListCall{
 					{
 						req: &elbv2sdk.DescribeTargetGroupsInput{
-							TargetGroupArns: awssdk.StringSlice([]string{"tg-2"}),
+							TargetGroupArns: []string{"tg-2"},
 						},
-						resp: []*elbv2sdk.TargetGroup{
+						resp: []elbv2types.TargetGroup{
 							{
 								TargetGroupArn: awssdk.String("tg-2"),
-								TargetType:     awssdk.String("instance"),
-								IpAddressType:  awssdk.String("ipv6"),
+								TargetType:     elbv2types.TargetTypeEnumInstance,
+								IpAddressType:  elbv2types.TargetGroupIpAddressTypeEnumIpv6,
 							},
 						},
 					},
@@ -690,6 +694,404 @@ func Test_targetGroupBindingValidator_checkNodeSelector(t *testing.T) {
 	}
 }
 
+func Test_targetGroupBindingValidator_checkMaxTargetNodes(t *testing.T) {
+	instanceTargetType := elbv2api.TargetTypeInstance
+	ipTargetType := elbv2api.TargetTypeIP
+	tests := []struct {
+		name           string
+		targetType     elbv2api.TargetType
+		maxTargetNodes *intstr.IntOrString
+		wantErr        error
+	}{
+		{
+			name:       "[ok] maxTargetNodes is nil",
+			targetType: ipTargetType,
+		},
+		{
+			name:           "[ok] maxTargetNodes is a number",
+			targetType:     instanceTargetType,
+			maxTargetNodes: &intstr.IntOrString{Type: intstr.Int, IntVal: 20},
+		},
+		{
+			name:           "[ok] maxTargetNodes is a percentage",
+			targetType:     instanceTargetType,
+			maxTargetNodes: &intstr.IntOrString{Type: intstr.String, StrVal: "100%"},
+		},
+		{
+			name:           "[err] targetType is ip, maxTargetNodes is set",
+			targetType:     ipTargetType,
+			maxTargetNodes: &intstr.IntOrString{Type: intstr.Int, IntVal: 20},
+			wantErr:        errors.New("TargetGroupBinding cannot set MaxTargetNodes when TargetType is ip"),
+		},
+		{
+			name:           "[err] maxTargetNodes is zero",
+			targetType:     instanceTargetType,
+			maxTargetNodes: &intstr.IntOrString{Type: intstr.Int, IntVal: 0},
+			wantErr:        errors.New("TargetGroupBinding MaxTargetNodes 0 must be positive"),
+		},
+		{
+			name:           "[err] maxTargetNodes is a percentage over 100%",
+			targetType:     instanceTargetType,
+			maxTargetNodes: &intstr.IntOrString{Type: intstr.String, StrVal: "120%"},
+			wantErr:        errors.New("TargetGroupBinding MaxTargetNodes 120% must be a percentage between 1% and 100%"),
+		},
+		{
+			name:           "[err] maxTargetNodes is a string without percent sign",
+			targetType:     instanceTargetType,
+			maxTargetNodes: &intstr.IntOrString{Type: intstr.String, StrVal: "20"},
+			wantErr:        errors.New("TargetGroupBinding MaxTargetNodes 20 must be a percentage between 1% and 100%"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &targetGroupBindingValidator{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			tgb := &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetType:     &tt.targetType,
+					MaxTargetNodes: tt.maxTargetNodes,
+				},
+			}
+			err := v.checkMaxTargetNodes(tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_targetGroupBindingValidator_checkExternalTargets(t *testing.T) {
+	type args struct {
+		tgb *elbv2api.TargetGroupBinding
+	}
+	instanceTargetType := elbv2api.TargetTypeInstance
+	ipTargetType := elbv2api.TargetTypeIP
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "[ok] targetType is ip, externalTargets are IP addresses",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType: &ipTargetType,
+						ExternalTargets: []elbv2api.ExternalTarget{
+							{
+								ID:   "10.100.0.1",
+								Port: 8080,
+							},
+							{
+								ID:   "2600:1f14:cc0:2300::1",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[ok] targetType is instance, externalTargets are instance IDs",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType: &instanceTargetType,
+						ExternalTargets: []elbv2api.ExternalTarget{
+							{
+								ID:   "i-0123456789abcdef0",
+								Port: 30080,
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[err] targetType is ip, externalTarget is instance ID",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType: &ipTargetType,
+						ExternalTargets: []elbv2api.ExternalTarget{
+							{
+								ID:   "i-0123456789abcdef0",
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding externalTarget i-0123456789abcdef0 must be an IP address when TargetType is ip"),
+		},
+		{
+			name: "[err] targetType is instance, externalTarget is IP address",
+			args: args{
+				tgb: &elbv2api.TargetGroupBinding{
+					Spec: elbv2api.TargetGroupBindingSpec{
+						TargetType: &instanceTargetType,
+						ExternalTargets: []elbv2api.ExternalTarget{
+							{
+								ID:   "10.100.0.1",
+								Port: 30080,
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding externalTarget 10.100.0.1 must be an EC2 instance ID when TargetType is instance"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &targetGroupBindingValidator{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			err := v.checkExternalTargets(tt.args.tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_targetGroupBindingValidator_checkTargetGroupAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		tgb     *elbv2api.TargetGroupBinding
+		wantErr error
+	}{
+		{
+			name: "[ok] no targetGroupAttributes",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[ok] distinct targetGroupAttributes",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupAttributes: []elbv2api.Attribute{
+						{
+							Key:   "load_balancing.cross_zone.enabled",
+							Value: "false",
+						},
+						{
+							Key:   "deregistration_delay.timeout_seconds",
+							Value: "30",
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[err] duplicate targetGroupAttributes",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupAttributes: []elbv2api.Attribute{
+						{
+							Key:   "load_balancing.cross_zone.enabled",
+							Value: "false",
+						},
+						{
+							Key:   "load_balancing.cross_zone.enabled",
+							Value: "true",
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding targetGroupAttribute load_balancing.cross_zone.enabled is specified more than once"),
+		},
+		{
+			name: "[ok] draining interval along with connection termination of manageTargetGroupConfig",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupAttributes: []elbv2api.Attribute{
+						{
+							Key:   "target_health_state.unhealthy.draining_interval_seconds",
+							Value: "300",
+						},
+					},
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						UnhealthyTargetState: &elbv2api.ManagedTargetGroupUnhealthyTargetState{
+							ConnectionTermination: awssdk.Bool(false),
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[err] invalid target health attributes",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						TargetGroupHealth: &elbv2api.ManagedTargetGroupHealth{
+							DNSFailover: &elbv2api.ManagedTargetGroupMinimumHealthyTargets{
+								Percentage: &intstr.IntOrString{Type: intstr.Int, IntVal: 150},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding has invalid target group attributes: target group attribute target_group_health.dns_failover.minimum_healthy_targets.percentage=150 is out of range"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &targetGroupBindingValidator{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			err := v.checkTargetGroupAttributes(tt.tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_targetGroupBindingValidator_checkManageTargetGroupConfig(t *testing.T) {
+	lbCookie := elbv2api.ManagedTargetGroupStickinessTypeLBCookie
+	sourceIP := elbv2api.ManagedTargetGroupStickinessType("source_ip")
+	tests := []struct {
+		name    string
+		tgb     *elbv2api.TargetGroupBinding
+		wantErr error
+	}{
+		{
+			name: "[ok] no manageTargetGroupConfig",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[ok] valid manageTargetGroupConfig along with distinct targetGroupAttributes",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupAttributes: []elbv2api.Attribute{
+						{
+							Key:   "load_balancing.cross_zone.enabled",
+							Value: "false",
+						},
+					},
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						HealthCheck: &elbv2api.ManagedTargetGroupHealthCheck{
+							Path:            awssdk.String("/healthz"),
+							Port:            &intstr.IntOrString{Type: intstr.String, StrVal: "traffic-port"},
+							IntervalSeconds: awssdk.Int64(10),
+							TimeoutSeconds:  awssdk.Int64(5),
+						},
+						DeregistrationDelaySeconds: awssdk.Int64(30),
+						Stickiness: &elbv2api.ManagedTargetGroupStickiness{
+							Enabled:         true,
+							Type:            &lbCookie,
+							DurationSeconds: awssdk.Int64(3600),
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "[err] invalid healthCheck port name",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						HealthCheck: &elbv2api.ManagedTargetGroupHealthCheck{
+							Port: &intstr.IntOrString{Type: intstr.String, StrVal: "http"},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding manageTargetGroupConfig healthCheck port must be a port number or traffic-port"),
+		},
+		{
+			name: "[err] healthCheck port out of range",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						HealthCheck: &elbv2api.ManagedTargetGroupHealthCheck{
+							Port: &intstr.IntOrString{Type: intstr.Int, IntVal: 70000},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding manageTargetGroupConfig healthCheck port 70000 is out of range"),
+		},
+		{
+			name: "[err] healthCheck timeout not less than interval",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						HealthCheck: &elbv2api.ManagedTargetGroupHealthCheck{
+							IntervalSeconds: awssdk.Int64(10),
+							TimeoutSeconds:  awssdk.Int64(10),
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding manageTargetGroupConfig healthCheck timeoutSeconds must be less than intervalSeconds"),
+		},
+		{
+			name: "[err] stickiness duration without cookie type",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						Stickiness: &elbv2api.ManagedTargetGroupStickiness{
+							Enabled:         true,
+							Type:            &sourceIP,
+							DurationSeconds: awssdk.Int64(3600),
+						},
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding manageTargetGroupConfig stickiness durationSeconds requires type lb_cookie or app_cookie"),
+		},
+		{
+			name: "[err] targetGroupAttributes conflicts with manageTargetGroupConfig",
+			tgb: &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupAttributes: []elbv2api.Attribute{
+						{
+							Key:   "deregistration_delay.timeout_seconds",
+							Value: "60",
+						},
+					},
+					ManageTargetGroupConfig: &elbv2api.ManagedTargetGroupConfig{
+						DeregistrationDelaySeconds: awssdk.Int64(30),
+					},
+				},
+			},
+			wantErr: errors.New("TargetGroupBinding targetGroupAttribute deregistration_delay.timeout_seconds conflicts with manageTargetGroupConfig"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &targetGroupBindingValidator{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			err := v.checkManageTargetGroupConfig(tt.tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_targetGroupBindingValidator_checkExistingTargetGroups(t *testing.T) {
 
 	type env struct {
@@ -906,3 +1308,249 @@ func Test_targetGroupBindingValidator_checkExistingTargetGroups(t *testing.T) {
 		})
 	}
 }
+
+func Test_targetGroupBindingValidator_checkMultiClusterTargetGroupUsage(t *testing.T) {
+	tests := []struct {
+		name                       string
+		oldMultiClusterTargetGroup bool
+		multiClusterTargetGroup    bool
+		wantWarnings               []string
+	}{
+		{
+			name:                       "multiClusterTargetGroup enabled on existing TargetGroupBinding",
+			oldMultiClusterTargetGroup: false,
+			multiClusterTargetGroup:    true,
+			wantWarnings: []string{
+				"enabling spec.multiClusterTargetGroup doesn't track the targets registered earlier, those targets must be deregistered manually",
+			},
+		},
+		{
+			name:                       "multiClusterTargetGroup unchanged",
+			oldMultiClusterTargetGroup: true,
+			multiClusterTargetGroup:    true,
+			wantWarnings:               []string{},
+		},
+		{
+			name:                       "multiClusterTargetGroup disabled",
+			oldMultiClusterTargetGroup: true,
+			multiClusterTargetGroup:    false,
+			wantWarnings:               []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := webhook.ContextWithAdmissionWarnings(context.Background())
+			v := &targetGroupBindingValidator{
+				logger: logr.New(&log.NullLogSink{}),
+			}
+			tgb := &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{MultiClusterTargetGroup: tt.multiClusterTargetGroup},
+			}
+			oldTGB := &elbv2api.TargetGroupBinding{
+				Spec: elbv2api.TargetGroupBindingSpec{MultiClusterTargetGroup: tt.oldMultiClusterTargetGroup},
+			}
+			v.checkMultiClusterTargetGroupUsage(ctx, tgb, oldTGB)
+			assert.Equal(t, tt.wantWarnings, webhook.ContextGetAdmissionWarnings(ctx))
+		})
+	}
+}
+
+func Test_targetGroupBindingValidator_checkTargetGroup(t *testing.T) {
+	instanceTargetType := elbv2api.TargetTypeInstance
+	ipTargetType := elbv2api.TargetTypeIP
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "awesome-ns",
+			Name:      "awesome-svc",
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+				{Name: "dns", Port: 53, Protocol: corev1.ProtocolUDP},
+			},
+		},
+	}
+	preferIPv4 := elbv2api.TargetIPFamilyPolicyPreferIPv4
+	preferIPv6 := elbv2api.TargetIPFamilyPolicyPreferIPv6
+	tests := []struct {
+		name                 string
+		targetType           *elbv2api.TargetType
+		targetIPFamilyPolicy *elbv2api.TargetIPFamilyPolicy
+		servicePort          intstr.IntOrString
+		targetGroup          *elbv2types.TargetGroup
+		describeErr          error
+		wantErr              error
+	}{
+		{
+			name:        "compatible TargetGroup",
+			targetType:  &instanceTargetType,
+			servicePort: intstr.FromString("http"),
+			targetGroup: &elbv2types.TargetGroup{
+				TargetGroupArn: awssdk.String("tg-1"),
+				TargetType:     elbv2types.TargetTypeEnumInstance,
+				VpcId:          awssdk.String("vpc-1"),
+				Protocol:       elbv2types.ProtocolEnumHttp,
+			},
+		},
+		{
+			name:        "TargetGroup not found",
+			targetType:  &instanceTargetType,
+			servicePort: intstr.FromString("http"),
+			describeErr: errors.New("TargetGroupNotFound"),
+			wantErr:     errors.New("unable to get TargetGroup tg-1: TargetGroupNotFound"),
+		},
+		{
+			name:        "targetType mismatch",
+			targetType:  &ipTargetType,
+			servicePort: intstr.FromString("http"),
+			targetGroup: &elbv2types.TargetGroup{
+				TargetGroupArn: awssdk.String("tg-1"),
+				TargetType:     elbv2types.TargetTypeEnumInstance,
+				VpcId:          awssdk.String("vpc-1"),
+				Protocol:       elbv2types.ProtocolEnumHttp,
+			},
+			wantErr: errors.New("invalid targetType ip for TargetGroup tg-1 with targetType instance"),
+		},
+		{
+			name:        "VPC mismatch",
+			targetType:  &instanceTargetType,
+			servicePort: intstr.FromString("http"),
+			targetGroup: &elbv2types.TargetGroup{
+				TargetGroupArn: awssdk.String("tg-1"),
+				TargetType:     elbv2types.TargetTypeEnumInstance,
+				VpcId:          awssdk.String("vpc-2"),
+				Protocol:       elbv2types.ProtocolEnumHttp,
+			},
+			wantErr: errors.New("TargetGroup tg-1 is within VPC vpc-2, not the VPC vpc-1 of cluster"),
+		},
+		{
+			name:        "protocol incompatible with service port",
+			targetType:  &ipTargetType,
+			servicePort: intstr.FromInt(53),
+			targetGroup: &elbv2types.TargetGroup{
+				TargetGroupArn: awssdk.String("tg-1"),
+				TargetType:     elbv2types.TargetTypeEnumIp,
+				VpcId:          awssdk.String("vpc-1"),
+				Protocol:       elbv2types.ProtocolEnumTcp,
+			},
+			wantErr: errors.New("TargetGroup tg-1 with protocol TCP is incompatible with the UDP port 53 of Service awesome-ns/awesome-svc"),
+		},
+		{
+			name:        "protocol compatible with UDP service port",
+			targetType:  &ipTargetType,
+			servicePort: intstr.FromString("dns"),
+			targetGroup: &elbv2types.TargetGroup{
+				TargetGroupArn: awssdk.String("tg-1"),
+				TargetType:     elbv2types.TargetTypeEnumIp,
+				VpcId:          awssdk.String("vpc-1"),
+				Protocol:       elbv2types.ProtocolEnumTcpUdp,
+			},
+		},
+		{
+			name:        "service port not found",
+			targetType:  &instanceTargetType,
+			servicePort: intstr.FromString("https"),
+			targetGroup: &elbv2types.TargetGroup{
+				TargetGroupArn: awssdk.String("tg-1"),
+				TargetType:     elbv2types.TargetTypeEnumInstance,
+				VpcId:          awssdk.String("vpc-1"),
+				Protocol:       elbv2types.ProtocolEnumHttps,
+			},
+			wantErr: errors.New("invalid serviceRef for Service awesome-ns/awesome-svc: unable to find port https on service awesome-ns/awesome-svc"),
+		},
+		{
+			name:                 "targetIPFamilyPolicy compatible with IP address type",
+			targetType:           &ipTargetType,
+			targetIPFamilyPolicy: &preferIPv4,
+			servicePort:          intstr.FromString("http"),
+			targetGroup: &elbv2types.TargetGroup{
+				TargetGroupArn: awssdk.String("tg-1"),
+				TargetType:     elbv2types.TargetTypeEnumIp,
+				VpcId:          awssdk.String("vpc-1"),
+				Protocol:       elbv2types.ProtocolEnumHttp,
+			},
+		},
+		{
+			name:                 "targetIPFamilyPolicy incompatible with IP address type",
+			targetType:           &ipTargetType,
+			targetIPFamilyPolicy: &preferIPv6,
+			servicePort:          intstr.FromString("http"),
+			targetGroup: &elbv2types.TargetGroup{
+				TargetGroupArn: awssdk.String("tg-1"),
+				TargetType:     elbv2types.TargetTypeEnumIp,
+				VpcId:          awssdk.String("vpc-1"),
+				Protocol:       elbv2types.ProtocolEnumHttp,
+				IpAddressType:  elbv2types.TargetGroupIpAddressTypeEnumIpv4,
+			},
+			wantErr: errors.New("targetIPFamilyPolicy prefer-ipv6 is incompatible with TargetGroup tg-1 of IP address type ipv4"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().WithScheme(k8sSchema).WithObjects(svc.DeepCopy()).Build()
+			elbv2Client := services.NewMockELBV2(ctrl)
+			var describeResp []elbv2types.TargetGroup
+			if tt.targetGroup != nil {
+				describeResp = []elbv2types.TargetGroup{*tt.targetGroup}
+			}
+			elbv2Client.EXPECT().DescribeTargetGroupsAsList(gomock.Any(), &elbv2sdk.DescribeTargetGroupsInput{
+				TargetGroupArns: []string{"tg-1"},
+			}).Return(describeResp, tt.describeErr)
+			v := &targetGroupBindingValidator{
+				k8sClient:   k8sClient,
+				elbv2Client: elbv2Client,
+				vpcID:       "vpc-1",
+				logger:      logr.New(&log.NullLogSink{}),
+			}
+			tgb := &elbv2api.TargetGroupBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-tgb",
+				},
+				Spec: elbv2api.TargetGroupBindingSpec{
+					TargetGroupARN: "tg-1",
+					TargetType:     tt.targetType,
+					ServiceRef: elbv2api.ServiceReference{
+						Name: "awesome-svc",
+						Port: tt.servicePort,
+					},
+					TargetIPFamilyPolicy: tt.targetIPFamilyPolicy,
+				},
+			}
+			err := v.checkTargetGroup(context.Background(), tgb)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_targetGroupBindingValidator_checkTargetGroupVPC(t *testing.T) {
+	targetGroup := &elbv2types.TargetGroup{
+		TargetGroupArn: awssdk.String("tg-1"),
+		VpcId:          awssdk.String("vpc-2"),
+	}
+	v := &targetGroupBindingValidator{
+		vpcID:  "vpc-1",
+		logger: logr.New(&log.NullLogSink{}),
+	}
+	err := v.checkTargetGroupVPC(&elbv2api.TargetGroupBinding{
+		Spec: elbv2api.TargetGroupBindingSpec{TargetGroupARN: "tg-1"},
+	}, targetGroup)
+	assert.EqualError(t, err, "TargetGroup tg-1 is within VPC vpc-2, not the VPC vpc-1 of cluster")
+
+	// TargetGroups within other AWS accounts are not checked.
+	err = v.checkTargetGroupVPC(&elbv2api.TargetGroupBinding{
+		Spec: elbv2api.TargetGroupBindingSpec{
+			TargetGroupARN: "tg-1",
+			AWSRoleARN:     "arn:aws:iam::123456789012:role/awesome-role",
+		},
+	}, targetGroup)
+	assert.NoError(t, err)
+}
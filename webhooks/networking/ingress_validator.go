@@ -3,6 +3,7 @@ package networking
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	awssdk "github.com/aws/aws-sdk-go/aws"
 	"github.com/go-logr/logr"
@@ -11,7 +12,10 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/config"
+	elbv2deploy "sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/elbv2"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/deploy/tracking"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	elbv2model "sigs.k8s.io/aws-load-balancer-controller/pkg/model/elbv2"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -22,15 +26,43 @@ const (
 	apiPathValidateNetworkingIngress = "/validate-networking-v1-ingress"
 )
 
+// deprecatedIngressAnnotations maps the suffixes of deprecated Ingress annotations to their replacement, the usage of which is warned about.
+var deprecatedIngressAnnotations = []struct {
+	suffix      string
+	replacement string
+}{
+	{
+		suffix:      annotations.IngressSuffixWebACLID,
+		replacement: fmt.Sprintf("`%s/%s` annotation with AWS WAFv2", annotations.AnnotationPrefixIngress, annotations.IngressSuffixWAFv2ACLARN),
+	},
+	{
+		suffix:      annotations.IngressSuffixWAFACLID,
+		replacement: fmt.Sprintf("`%s/%s` annotation with AWS WAFv2", annotations.AnnotationPrefixIngress, annotations.IngressSuffixWAFv2ACLARN),
+	},
+}
+
+// privilegedIngressAnnotations are the suffixes of Ingress annotations granting access to AWS resources beyond the ones managed
+// for the Ingress, which are only allowed to be set by authorized users when privileged annotations authorization is enabled.
+var privilegedIngressAnnotations = []string{
+	annotations.IngressSuffixSecurityGroups,
+	annotations.IngressSuffixAWSRoleARN,
+}
+
 // NewIngressValidator returns a validator for Ingress API.
-func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, logger logr.Logger) *ingressValidator {
+func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, deniedTagKeyPrefixes []string,
+	privilegedAnnotationAuthorizer *webhook.PrivilegedAnnotationAuthorizer, logger logr.Logger) *ingressValidator {
 	return &ingressValidator{
+		k8sClient:                          client,
 		annotationParser:                   annotations.NewSuffixAnnotationParser(annotations.AnnotationPrefixIngress),
 		classAnnotationMatcher:             ingress.NewDefaultClassAnnotationMatcher(ingConfig.IngressClass),
 		classLoader:                        ingress.NewDefaultClassLoader(client, false),
+		classParamsLoader:                  ingress.NewDefaultClassLoader(client, true),
+		inboundCIDRsPolicyChecker:          ingress.NewDefaultInboundCIDRsPolicyChecker(client),
 		disableIngressClassAnnotation:      ingConfig.DisableIngressClassAnnotation,
 		disableIngressGroupAnnotation:      ingConfig.DisableIngressGroupNameAnnotation,
 		manageIngressesWithoutIngressClass: ingConfig.IngressClass == "",
+		deniedTagKeyPrefixes:               deniedTagKeyPrefixes,
+		privilegedAnnotationAuthorizer:     privilegedAnnotationAuthorizer,
 		logger:                             logger,
 	}
 }
@@ -38,15 +70,22 @@ func NewIngressValidator(client client.Client, ingConfig config.IngressConfig, l
 var _ webhook.Validator = &ingressValidator{}
 
 type ingressValidator struct {
+	k8sClient                     client.Client
 	annotationParser              annotations.Parser
 	classAnnotationMatcher        ingress.ClassAnnotationMatcher
 	classLoader                   ingress.ClassLoader
+	classParamsLoader             ingress.ClassLoader
+	inboundCIDRsPolicyChecker     ingress.InboundCIDRsPolicyChecker
 	disableIngressClassAnnotation bool
 	disableIngressGroupAnnotation bool
 	// manageIngressesWithoutIngressClass specifies whether ingresses without "kubernetes.io/ingress.class" annotation
 	// and "spec.ingressClassName" should be managed or not.
 	manageIngressesWithoutIngressClass bool
-	logger                             logr.Logger
+	// deniedTagKeyPrefixes are the tag key prefixes that are never applied on AWS resources.
+	deniedTagKeyPrefixes []string
+	// privilegedAnnotationAuthorizer authorizes the users setting privileged annotations, nil if disabled.
+	privilegedAnnotationAuthorizer *webhook.PrivilegedAnnotationAuthorizer
+	logger                         logr.Logger
 }
 
 func (v *ingressValidator) Prototype(req admission.Request) (runtime.Object, error) {
@@ -70,6 +109,25 @@ func (v *ingressValidator) ValidateCreate(ctx context.Context, obj runtime.Objec
 	if err := v.checkIngressAnnotationConditions(ing); err != nil {
 		return err
 	}
+	if err := v.checkWAFFailOpenUsage(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkWAFv2ACLARNUsage(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkInboundCIDRsPolicy(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkDeniedTagsUsage(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkPrivilegedAnnotationsUsage(ctx, ing, nil); err != nil {
+		return err
+	}
+	v.checkDeprecatedAnnotationsUsage(ctx, ing)
+	if err := v.checkIgnoredGroupNameAnnotationUsage(ctx, ing); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -85,12 +143,34 @@ func (v *ingressValidator) ValidateUpdate(ctx context.Context, obj runtime.Objec
 	if err := v.checkGroupNameAnnotationUsage(ing, oldIng); err != nil {
 		return err
 	}
+	if err := v.checkLoadBalancerNameChange(ing, oldIng); err != nil {
+		return err
+	}
 	if err := v.checkIngressClassUsage(ctx, ing, oldIng); err != nil {
 		return err
 	}
 	if err := v.checkIngressAnnotationConditions(ing); err != nil {
 		return err
 	}
+	if err := v.checkWAFFailOpenUsage(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkWAFv2ACLARNUsage(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkInboundCIDRsPolicy(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkDeniedTagsUsage(ctx, ing); err != nil {
+		return err
+	}
+	if err := v.checkPrivilegedAnnotationsUsage(ctx, ing, oldIng); err != nil {
+		return err
+	}
+	v.checkDeprecatedAnnotationsUsage(ctx, ing)
+	if err := v.checkIgnoredGroupNameAnnotationUsage(ctx, ing); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -168,6 +248,39 @@ func (v *ingressValidator) checkGroupNameAnnotationUsage(ing *networking.Ingress
 	return nil
 }
 
+// checkLoadBalancerNameChange checks the change of "load-balancer-name" annotation.
+// load balancers cannot be renamed in place, thus the name cannot be changed once the load balancer is provisioned.
+func (v *ingressValidator) checkLoadBalancerNameChange(ing *networking.Ingress, oldIng *networking.Ingress) error {
+	newName := ""
+	oldName := ""
+	newNameExists := v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerName, &newName, ing.Annotations)
+	_ = v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixLoadBalancerName, &oldName, oldIng.Annotations)
+	if newName == oldName {
+		return nil
+	}
+	// the name can be still specified by other Ingresses of the IngressGroup.
+	if !newNameExists && v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, new(string), ing.Annotations) {
+		return nil
+	}
+	var lbDNSNames []string
+	for _, lbIngress := range ing.Status.LoadBalancer.Ingress {
+		if lbIngress.Hostname != "" {
+			lbDNSNames = append(lbDNSNames, lbIngress.Hostname)
+		}
+	}
+	if len(lbDNSNames) == 0 {
+		return nil
+	}
+	// the load balancer already has the new name, e.g. when the annotation is added with the current name.
+	for _, lbDNSName := range lbDNSNames {
+		if elbv2deploy.IsLoadBalancerDNSNameOf(lbDNSName, newName) {
+			return nil
+		}
+	}
+	return errors.Errorf("`%s/%s` annotation cannot be changed once the load balancer is provisioned, as load balancers cannot be renamed in place",
+		annotations.AnnotationPrefixIngress, annotations.IngressSuffixLoadBalancerName)
+}
+
 // checkIngressClassUsage checks the usage of "ingressClassName" field.
 // if ingressClassName is mutated, it must refer to a existing & valid IngressClass.
 func (v *ingressValidator) checkIngressClassUsage(ctx context.Context, ing *networking.Ingress, oldIng *networking.Ingress) error {
@@ -226,6 +339,182 @@ func (v *ingressValidator) checkIngressAnnotationConditions(ing *networking.Ingr
 	return nil
 }
 
+// checkWAFFailOpenUsage checks the usage of "waf-fail-open" annotation and "waf.fail_open.enabled" load balancer attribute.
+// when IngressClassParams specifies wafFailOpen, Ingresses cannot specify a different value.
+func (v *ingressValidator) checkWAFFailOpenUsage(ctx context.Context, ing *networking.Ingress) error {
+	explicitWAFFailOpens := make(map[bool]struct{})
+	var rawWAFFailOpen bool
+	exists, err := v.annotationParser.ParseBoolAnnotation(annotations.IngressSuffixWAFFailOpen, &rawWAFFailOpen, ing.Annotations)
+	if err != nil {
+		return err
+	}
+	if exists {
+		explicitWAFFailOpens[rawWAFFailOpen] = struct{}{}
+	}
+	var lbAttributes map[string]string
+	if _, err := v.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixLoadBalancerAttributes, &lbAttributes, ing.Annotations); err != nil {
+		return err
+	}
+	if rawAttrValue, ok := lbAttributes[elbv2model.LBAttrsWAFFailOpenEnabled]; ok {
+		attrValue, err := strconv.ParseBool(rawAttrValue)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse load balancer attribute %v", elbv2model.LBAttrsWAFFailOpenEnabled)
+		}
+		explicitWAFFailOpens[attrValue] = struct{}{}
+	}
+	if len(explicitWAFFailOpens) == 0 {
+		return nil
+	}
+	if len(explicitWAFFailOpens) > 1 {
+		return errors.Errorf("conflicting `%s/%s` annotation and %v load balancer attribute",
+			annotations.AnnotationPrefixIngress, annotations.IngressSuffixWAFFailOpen, elbv2model.LBAttrsWAFFailOpenEnabled)
+	}
+
+	classConfiguration, err := v.classParamsLoader.Load(ctx, ing)
+	if err != nil {
+		return err
+	}
+	if classConfiguration.IngClassParams == nil || classConfiguration.IngClassParams.Spec.WAFFailOpen == nil {
+		return nil
+	}
+	enforcedWAFFailOpen := *classConfiguration.IngClassParams.Spec.WAFFailOpen
+	if _, ok := explicitWAFFailOpens[enforcedWAFFailOpen]; !ok {
+		return errors.Errorf("waf fail open must be %v as enforced by IngressClassParams %v",
+			enforcedWAFFailOpen, classConfiguration.IngClassParams.Name)
+	}
+	return nil
+}
+
+// checkWAFv2ACLARNUsage checks the usage of "wafv2-acl-arn" annotation.
+// WAFv2 WebACLs are associated with the whole load balancer of the IngressGroup, thus individual Ingresses cannot opt out of
+// or override the WebACL of the IngressGroup: the annotation is warned about when the WebACL is enforced by IngressClassParams,
+// and denied when it conflicts with other Ingresses of the IngressGroup.
+func (v *ingressValidator) checkWAFv2ACLARNUsage(ctx context.Context, ing *networking.Ingress) error {
+	webACLARN := ""
+	if exists := v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &webACLARN, ing.Annotations); !exists {
+		return nil
+	}
+	classConfiguration, err := v.classParamsLoader.Load(ctx, ing)
+	if err != nil {
+		return err
+	}
+	if classConfiguration.IngClassParams != nil && classConfiguration.IngClassParams.Spec.WAFv2WebACL != nil {
+		webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("`%s/%s` annotation is ignored, as the WAFv2 WebACL of the load balancer is enforced by IngressClassParams %v",
+			annotations.AnnotationPrefixIngress, annotations.IngressSuffixWAFv2ACLARN, classConfiguration.IngClassParams.Name))
+		return nil
+	}
+
+	groupName := ""
+	if exists := v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &groupName, ing.Annotations); !exists {
+		return nil
+	}
+	ingList := &networking.IngressList{}
+	if err := v.k8sClient.List(ctx, ingList); err != nil {
+		return err
+	}
+	for i := range ingList.Items {
+		member := &ingList.Items[i]
+		if (member.Namespace == ing.Namespace && member.Name == ing.Name) || !member.DeletionTimestamp.IsZero() {
+			continue
+		}
+		memberGroupName := ""
+		if exists := v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &memberGroupName, member.Annotations); !exists || memberGroupName != groupName {
+			continue
+		}
+		if ingressClassOf(member) != ingressClassOf(ing) {
+			continue
+		}
+		memberWebACLARN := ""
+		if exists := v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixWAFv2ACLARN, &memberWebACLARN, member.Annotations); exists && memberWebACLARN != webACLARN {
+			return errors.Errorf("`%s/%s` annotation conflicts with Ingress %v/%v of IngressGroup %v, "+
+				"the WAFv2 WebACL is associated with the whole load balancer and cannot differ between Ingresses of an IngressGroup",
+				annotations.AnnotationPrefixIngress, annotations.IngressSuffixWAFv2ACLARN, member.Namespace, member.Name, groupName)
+		}
+	}
+	return nil
+}
+
+// ingressClassOf returns the IngressClass of Ingress, either via spec.ingressClassName or "kubernetes.io/ingress.class" annotation.
+func ingressClassOf(ing *networking.Ingress) string {
+	if ingClassAnnotation, exists := ing.Annotations[annotations.IngressClass]; exists {
+		return ingClassAnnotation
+	}
+	return awssdk.StringValue(ing.Spec.IngressClassName)
+}
+
+// checkInboundCIDRsPolicy checks the inbound CIDRs of Ingress are allowed by the InboundCIDRsPolicy of IngressClassParams.
+func (v *ingressValidator) checkInboundCIDRsPolicy(ctx context.Context, ing *networking.Ingress) error {
+	classConfiguration, err := v.classParamsLoader.Load(ctx, ing)
+	if err != nil {
+		return err
+	}
+	return v.inboundCIDRsPolicyChecker.Check(ctx, ing, classConfiguration.IngClassParams)
+}
+
+// checkDeniedTagsUsage checks the usage of tags with denied key prefixes in "tags" annotation.
+// such tags are ignored by the controller, thus a warning is returned instead of denying the Ingress.
+func (v *ingressValidator) checkDeniedTagsUsage(ctx context.Context, ing *networking.Ingress) error {
+	if len(v.deniedTagKeyPrefixes) == 0 {
+		return nil
+	}
+	var tags map[string]string
+	if _, err := v.annotationParser.ParseStringMapAnnotation(annotations.IngressSuffixTags, &tags, ing.Annotations); err != nil {
+		return err
+	}
+	if deniedTagKeys := tracking.DeniedTagKeys(tags, v.deniedTagKeyPrefixes); len(deniedTagKeys) != 0 {
+		webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("tags %v in `%s/%s` annotation have denied key prefixes and will be ignored",
+			deniedTagKeys, annotations.AnnotationPrefixIngress, annotations.IngressSuffixTags))
+	}
+	return nil
+}
+
+// checkPrivilegedAnnotationsUsage checks the user is authorized to set the privileged annotations added or changed on Ingress.
+func (v *ingressValidator) checkPrivilegedAnnotationsUsage(ctx context.Context, ing *networking.Ingress, oldIng *networking.Ingress) error {
+	var oldAnnotations map[string]string
+	if oldIng != nil {
+		oldAnnotations = oldIng.Annotations
+	}
+	changedKeys := webhook.ChangedAnnotationKeys(v.annotationParser, annotations.AnnotationPrefixIngress, privilegedIngressAnnotations,
+		ing.Annotations, oldAnnotations)
+	return v.privilegedAnnotationAuthorizer.Authorize(ctx, ing.Namespace, changedKeys)
+}
+
+// checkDeprecatedAnnotationsUsage warns about the usage of deprecated annotations,
+// which are still supported but might be removed in future releases.
+func (v *ingressValidator) checkDeprecatedAnnotationsUsage(ctx context.Context, ing *networking.Ingress) {
+	if ingClassAnnotation, exists := ing.Annotations[annotations.IngressClass]; exists && v.classAnnotationMatcher.Matches(ingClassAnnotation) {
+		webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("`%s` annotation is deprecated, use `spec.ingressClassName` instead",
+			annotations.IngressClass))
+	}
+	for _, deprecated := range deprecatedIngressAnnotations {
+		rawValue := ""
+		if exists := v.annotationParser.ParseStringAnnotation(deprecated.suffix, &rawValue, ing.Annotations); exists {
+			webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("`%s/%s` annotation is deprecated, use %s instead",
+				annotations.AnnotationPrefixIngress, deprecated.suffix, deprecated.replacement))
+		}
+	}
+}
+
+// checkIgnoredGroupNameAnnotationUsage warns about the usage of "group.name" annotation,
+// which is ignored when IngressClassParams specifies group.
+func (v *ingressValidator) checkIgnoredGroupNameAnnotationUsage(ctx context.Context, ing *networking.Ingress) error {
+	groupName := ""
+	if exists := v.annotationParser.ParseStringAnnotation(annotations.IngressSuffixGroupName, &groupName, ing.Annotations); !exists {
+		return nil
+	}
+	classConfiguration, err := v.classParamsLoader.Load(ctx, ing)
+	if err != nil {
+		return err
+	}
+	if classConfiguration.IngClassParams == nil || classConfiguration.IngClassParams.Spec.Group == nil {
+		return nil
+	}
+	webhook.ContextAddAdmissionWarning(ctx, fmt.Sprintf("`%s/%s` annotation is ignored, as group %v is enforced by IngressClassParams %v",
+		annotations.AnnotationPrefixIngress, annotations.IngressSuffixGroupName,
+		classConfiguration.IngClassParams.Spec.Group.Name, classConfiguration.IngClassParams.Name))
+	return nil
+}
+
 // +kubebuilder:webhook:path=/validate-networking-v1-ingress,mutating=false,failurePolicy=fail,groups=networking.k8s.io,resources=ingresses,verbs=create;update,versions=v1,name=vingress.elbv2.k8s.aws,sideEffects=None,matchPolicy=Equivalent,webhookVersions=v1,admissionReviewVersions=v1beta1
 
 func (v *ingressValidator) SetupWithManager(mgr ctrl.Manager) {
@@ -17,6 +17,7 @@ import (
 	elbv2api "sigs.k8s.io/aws-load-balancer-controller/apis/elbv2/v1beta1"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/annotations"
 	"sigs.k8s.io/aws-load-balancer-controller/pkg/ingress"
+	"sigs.k8s.io/aws-load-balancer-controller/pkg/webhook"
 	testclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -711,6 +712,262 @@ func Test_ingressValidator_checkGroupNameAnnotationUsage(t *testing.T) {
 	}
 }
 
+func Test_ingressValidator_checkLoadBalancerNameChange(t *testing.T) {
+	type args struct {
+		ing    *networking.Ingress
+		oldIng *networking.Ingress
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr error
+	}{
+		{
+			name: "name unchanged",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-name": "my-alb",
+						},
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				oldIng: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-name": "my-alb",
+						},
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "name changed before load balancer provisioned",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-name": "new-alb",
+						},
+					},
+				},
+				oldIng: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-name": "my-alb",
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "name added with current name of load balancer",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-name": "my-alb",
+						},
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				oldIng: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "name changed of provisioned load balancer",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-name": "new-alb",
+						},
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				oldIng: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-name": "my-alb",
+						},
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("`alb.ingress.kubernetes.io/load-balancer-name` annotation cannot be changed once the load balancer is provisioned, as load balancers cannot be renamed in place"),
+		},
+		{
+			name: "name removed of provisioned load balancer",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				oldIng: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/load-balancer-name": "my-alb",
+						},
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: errors.New("`alb.ingress.kubernetes.io/load-balancer-name` annotation cannot be changed once the load balancer is provisioned, as load balancers cannot be renamed in place"),
+		},
+		{
+			name: "name removed from Ingress in IngressGroup",
+			args: args{
+				ing: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/group.name": "awesome-group",
+						},
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+				oldIng: &networking.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "ns-1",
+						Name:      "ing-1",
+						Annotations: map[string]string{
+							"alb.ingress.kubernetes.io/group.name":         "awesome-group",
+							"alb.ingress.kubernetes.io/load-balancer-name": "my-alb",
+						},
+					},
+					Status: networking.IngressStatus{
+						LoadBalancer: networking.IngressLoadBalancerStatus{
+							Ingress: []networking.IngressLoadBalancerIngress{
+								{
+									Hostname: "my-alb-1234567890.us-west-2.elb.amazonaws.com",
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &ingressValidator{
+				annotationParser: annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				logger:           logr.New(&log.NullLogSink{}),
+			}
+			err := v.checkLoadBalancerNameChange(tt.args.ing, tt.args.oldIng)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func Test_ingressValidator_checkIngressClassUsage(t *testing.T) {
 	type env struct {
 		nsList             []*corev1.Namespace
@@ -1168,3 +1425,607 @@ func Test_ingressValidator_checkIngressAnnotationConditions(t *testing.T) {
 		})
 	}
 }
+
+func Test_ingressValidator_checkWAFFailOpenUsage(t *testing.T) {
+	type env struct {
+		ingClassList       []*networking.IngressClass
+		ingClassParamsList []*elbv2api.IngressClassParams
+	}
+	lockedEnv := env{
+		ingClassList: []*networking.IngressClass{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "awesome-class",
+				},
+				Spec: networking.IngressClassSpec{
+					Controller: "ingress.k8s.aws/alb",
+					Parameters: &networking.IngressClassParametersReference{
+						APIGroup: awssdk.String("elbv2.k8s.aws"),
+						Kind:     "IngressClassParams",
+						Name:     "awesome-class-params",
+					},
+				},
+			},
+		},
+		ingClassParamsList: []*elbv2api.IngressClassParams{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "awesome-class-params",
+				},
+				Spec: elbv2api.IngressClassParamsSpec{
+					WAFFailOpen: awssdk.Bool(false),
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		env     env
+		ing     *networking.Ingress
+		wantErr error
+	}{
+		{
+			name: "ingress without waf-fail-open",
+			env:  lockedEnv,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+				},
+				Spec: networking.IngressSpec{
+					IngressClassName: awssdk.String("awesome-class"),
+				},
+			},
+		},
+		{
+			name: "ingress with waf-fail-open, IngressClassParams without wafFailOpen",
+			env:  env{},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/waf-fail-open": "true",
+					},
+				},
+			},
+		},
+		{
+			name: "ingress with waf-fail-open matching IngressClassParams",
+			env:  lockedEnv,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/waf-fail-open": "false",
+					},
+				},
+				Spec: networking.IngressSpec{
+					IngressClassName: awssdk.String("awesome-class"),
+				},
+			},
+		},
+		{
+			name: "ingress with waf-fail-open mismatching IngressClassParams",
+			env:  lockedEnv,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/waf-fail-open": "true",
+					},
+				},
+				Spec: networking.IngressSpec{
+					IngressClassName: awssdk.String("awesome-class"),
+				},
+			},
+			wantErr: errors.New("waf fail open must be false as enforced by IngressClassParams awesome-class-params"),
+		},
+		{
+			name: "ingress with waf.fail_open.enabled attribute mismatching IngressClassParams",
+			env:  lockedEnv,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/load-balancer-attributes": "waf.fail_open.enabled=true",
+					},
+				},
+				Spec: networking.IngressSpec{
+					IngressClassName: awssdk.String("awesome-class"),
+				},
+			},
+			wantErr: errors.New("waf fail open must be false as enforced by IngressClassParams awesome-class-params"),
+		},
+		{
+			name: "ingress with conflicting waf-fail-open annotation and attribute",
+			env:  env{},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/load-balancer-attributes": "waf.fail_open.enabled=true",
+						"alb.ingress.kubernetes.io/waf-fail-open":            "false",
+					},
+				},
+			},
+			wantErr: errors.New("conflicting `alb.ingress.kubernetes.io/waf-fail-open` annotation and waf.fail_open.enabled load balancer attribute"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().
+				WithScheme(k8sSchema).
+				Build()
+			for _, ingClass := range tt.env.ingClassList {
+				assert.NoError(t, k8sClient.Create(ctx, ingClass.DeepCopy()))
+			}
+			for _, ingClassParams := range tt.env.ingClassParamsList {
+				assert.NoError(t, k8sClient.Create(ctx, ingClassParams.DeepCopy()))
+			}
+
+			v := &ingressValidator{
+				annotationParser:  annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				classParamsLoader: ingress.NewDefaultClassLoader(k8sClient, true),
+			}
+			err := v.checkWAFFailOpenUsage(ctx, tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_ingressValidator_checkDeniedTagsUsage(t *testing.T) {
+	tests := []struct {
+		name                 string
+		deniedTagKeyPrefixes []string
+		ing                  *networking.Ingress
+		wantWarnings         []string
+		wantErr              error
+	}{
+		{
+			name:                 "ingress without denied tags",
+			deniedTagKeyPrefixes: []string{"security/"},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/tags": "team=my-team",
+					},
+				},
+			},
+			wantWarnings: []string{},
+		},
+		{
+			name:                 "ingress with denied tags",
+			deniedTagKeyPrefixes: []string{"security/"},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/tags": "team=my-team,security/owner=me,security/compliance=pci",
+					},
+				},
+			},
+			wantWarnings: []string{"tags [security/compliance security/owner] in `alb.ingress.kubernetes.io/tags` annotation have denied key prefixes and will be ignored"},
+		},
+		{
+			name:                 "ingress with tags when no prefixes are denied",
+			deniedTagKeyPrefixes: nil,
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/tags": "security/owner=me",
+					},
+				},
+			},
+			wantWarnings: []string{},
+		},
+		{
+			name:                 "ingress with invalid tags annotation",
+			deniedTagKeyPrefixes: []string{"security/"},
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/tags": "security/owner",
+					},
+				},
+			},
+			wantWarnings: []string{},
+			wantErr:      errors.New("failed to parse stringMap annotation, alb.ingress.kubernetes.io/tags: security/owner"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := webhook.ContextWithAdmissionWarnings(context.Background())
+			v := &ingressValidator{
+				annotationParser:     annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				deniedTagKeyPrefixes: tt.deniedTagKeyPrefixes,
+			}
+			err := v.checkDeniedTagsUsage(ctx, tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantWarnings, webhook.ContextGetAdmissionWarnings(ctx))
+		})
+	}
+}
+
+func Test_ingressValidator_checkDeprecatedAnnotationsUsage(t *testing.T) {
+	tests := []struct {
+		name         string
+		ing          *networking.Ingress
+		wantWarnings []string
+	}{
+		{
+			name: "ingress without deprecated annotations",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/wafv2-acl-arn": "arn:aws:wafv2:us-west-2:xxxxx:regional/webacl/xxxxxxx/3ab78708-85b0-49d3-b4e1-7a9615a6613b",
+					},
+				},
+				Spec: networking.IngressSpec{
+					IngressClassName: awssdk.String("alb"),
+				},
+			},
+			wantWarnings: []string{},
+		},
+		{
+			name: "ingress with deprecated annotations",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"kubernetes.io/ingress.class":          "alb",
+						"alb.ingress.kubernetes.io/web-acl-id": "web-acl-id",
+					},
+				},
+			},
+			wantWarnings: []string{
+				"`kubernetes.io/ingress.class` annotation is deprecated, use `spec.ingressClassName` instead",
+				"`alb.ingress.kubernetes.io/web-acl-id` annotation is deprecated, use `alb.ingress.kubernetes.io/wafv2-acl-arn` annotation with AWS WAFv2 instead",
+			},
+		},
+		{
+			name: "ingress with ingress class annotation of other controller",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"kubernetes.io/ingress.class": "nginx",
+					},
+				},
+			},
+			wantWarnings: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := webhook.ContextWithAdmissionWarnings(context.Background())
+			v := &ingressValidator{
+				annotationParser:       annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				classAnnotationMatcher: ingress.NewDefaultClassAnnotationMatcher("alb"),
+			}
+			v.checkDeprecatedAnnotationsUsage(ctx, tt.ing)
+			assert.Equal(t, tt.wantWarnings, webhook.ContextGetAdmissionWarnings(ctx))
+		})
+	}
+}
+
+func Test_ingressValidator_checkIgnoredGroupNameAnnotationUsage(t *testing.T) {
+	ingClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: awssdk.String("elbv2.k8s.aws"),
+				Kind:     "IngressClassParams",
+				Name:     "awesome-class-params",
+			},
+		},
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class-params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			Group: &elbv2api.IngressGroup{
+				Name: "awesome-group",
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		ing          *networking.Ingress
+		wantWarnings []string
+	}{
+		{
+			name: "ingress without group.name annotation",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+				},
+				Spec: networking.IngressSpec{
+					IngressClassName: awssdk.String("awesome-class"),
+				},
+			},
+			wantWarnings: []string{},
+		},
+		{
+			name: "ingress with group.name annotation ignored due to IngressClassParams",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/group.name": "other-group",
+					},
+				},
+				Spec: networking.IngressSpec{
+					IngressClassName: awssdk.String("awesome-class"),
+				},
+			},
+			wantWarnings: []string{
+				"`alb.ingress.kubernetes.io/group.name` annotation is ignored, as group awesome-group is enforced by IngressClassParams awesome-class-params",
+			},
+		},
+		{
+			name: "ingress with group.name annotation without IngressClass",
+			ing: &networking.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "awesome-ns",
+					Name:      "awesome-ing",
+					Annotations: map[string]string{
+						"alb.ingress.kubernetes.io/group.name": "other-group",
+					},
+				},
+			},
+			wantWarnings: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := webhook.ContextWithAdmissionWarnings(context.Background())
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().
+				WithScheme(k8sSchema).
+				WithObjects(ingClass.DeepCopy(), ingClassParams.DeepCopy()).
+				Build()
+			v := &ingressValidator{
+				annotationParser:  annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				classParamsLoader: ingress.NewDefaultClassLoader(k8sClient, true),
+			}
+			err := v.checkIgnoredGroupNameAnnotationUsage(ctx, tt.ing)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantWarnings, webhook.ContextGetAdmissionWarnings(ctx))
+		})
+	}
+}
+
+func Test_ingressValidator_checkWAFv2ACLARNUsage(t *testing.T) {
+	ingClassWithParams := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "class-with-params",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: awssdk.String("elbv2.k8s.aws"),
+				Kind:     "IngressClassParams",
+				Name:     "awesome-class-params",
+			},
+		},
+	}
+	ingClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+		},
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class-params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			WAFv2WebACL: &elbv2api.WAFv2WebACL{},
+		},
+	}
+	newIngress := func(name string, ingClassName string, groupName string, webACLARN *string) *networking.Ingress {
+		ing := &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "awesome-ns",
+				Name:        name,
+				Annotations: map[string]string{},
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: awssdk.String(ingClassName),
+			},
+		}
+		if groupName != "" {
+			ing.Annotations["alb.ingress.kubernetes.io/group.name"] = groupName
+		}
+		if webACLARN != nil {
+			ing.Annotations["alb.ingress.kubernetes.io/wafv2-acl-arn"] = *webACLARN
+		}
+		return ing
+	}
+	webACLARN := "arn:aws:wafv2:us-west-2:123456789012:regional/webacl/acl-1/id-1"
+	optOut := ""
+	tests := []struct {
+		name         string
+		ing          *networking.Ingress
+		existingIngs []*networking.Ingress
+		wantWarnings []string
+		wantErr      error
+	}{
+		{
+			name:         "ingress without wafv2-acl-arn annotation",
+			ing:          newIngress("ing-1", "awesome-class", "awesome-group", nil),
+			existingIngs: []*networking.Ingress{newIngress("ing-2", "awesome-class", "awesome-group", &webACLARN)},
+			wantWarnings: []string{},
+		},
+		{
+			name: "ingress with wafv2-acl-arn annotation ignored due to IngressClassParams",
+			ing:  newIngress("ing-1", "class-with-params", "", &optOut),
+			wantWarnings: []string{
+				"`alb.ingress.kubernetes.io/wafv2-acl-arn` annotation is ignored, as the WAFv2 WebACL of the load balancer is enforced by IngressClassParams awesome-class-params",
+			},
+		},
+		{
+			name: "ingress with wafv2-acl-arn annotation matching the IngressGroup",
+			ing:  newIngress("ing-1", "awesome-class", "awesome-group", &webACLARN),
+			existingIngs: []*networking.Ingress{
+				newIngress("ing-2", "awesome-class", "awesome-group", &webACLARN),
+				newIngress("ing-3", "awesome-class", "awesome-group", nil),
+				newIngress("ing-4", "awesome-class", "other-group", &optOut),
+				newIngress("ing-5", "other-class", "awesome-group", &optOut),
+			},
+			wantWarnings: []string{},
+		},
+		{
+			name: "ingress opting out of the WebACL of the IngressGroup",
+			ing:  newIngress("ing-1", "awesome-class", "awesome-group", &optOut),
+			existingIngs: []*networking.Ingress{
+				newIngress("ing-2", "awesome-class", "awesome-group", &webACLARN),
+			},
+			wantWarnings: []string{},
+			wantErr: errors.New("`alb.ingress.kubernetes.io/wafv2-acl-arn` annotation conflicts with Ingress awesome-ns/ing-2 of IngressGroup awesome-group, " +
+				"the WAFv2 WebACL is associated with the whole load balancer and cannot differ between Ingresses of an IngressGroup"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := webhook.ContextWithAdmissionWarnings(context.Background())
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClientBuilder := testclient.NewClientBuilder().
+				WithScheme(k8sSchema).
+				WithObjects(ingClass.DeepCopy(), ingClassWithParams.DeepCopy(), ingClassParams.DeepCopy())
+			for _, ing := range tt.existingIngs {
+				k8sClientBuilder = k8sClientBuilder.WithObjects(ing.DeepCopy())
+			}
+			k8sClient := k8sClientBuilder.Build()
+			v := &ingressValidator{
+				k8sClient:         k8sClient,
+				annotationParser:  annotations.NewSuffixAnnotationParser("alb.ingress.kubernetes.io"),
+				classParamsLoader: ingress.NewDefaultClassLoader(k8sClient, true),
+			}
+			err := v.checkWAFv2ACLARNUsage(ctx, tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantWarnings, webhook.ContextGetAdmissionWarnings(ctx))
+		})
+	}
+}
+
+func Test_ingressValidator_checkInboundCIDRsPolicy(t *testing.T) {
+	ingClass := &networking.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class",
+		},
+		Spec: networking.IngressClassSpec{
+			Controller: "ingress.k8s.aws/alb",
+			Parameters: &networking.IngressClassParametersReference{
+				APIGroup: awssdk.String("elbv2.k8s.aws"),
+				Kind:     "IngressClassParams",
+				Name:     "awesome-class-params",
+			},
+		},
+	}
+	ingClassParams := &elbv2api.IngressClassParams{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "awesome-class-params",
+		},
+		Spec: elbv2api.IngressClassParamsSpec{
+			InboundCIDRsPolicy: &elbv2api.InboundCIDRsPolicy{
+				AllowedCIDRs: []string{"10.0.0.0/8"},
+			},
+		},
+	}
+	newIngress := func(inboundCIDRs string) *networking.Ingress {
+		return &networking.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "awesome-ns",
+				Name:      "awesome-ing",
+				Annotations: map[string]string{
+					"alb.ingress.kubernetes.io/inbound-cidrs": inboundCIDRs,
+				},
+			},
+			Spec: networking.IngressSpec{
+				IngressClassName: awssdk.String("awesome-class"),
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		ing     *networking.Ingress
+		wantErr error
+	}{
+		{
+			name: "inbound CIDRs allowed by policy",
+			ing:  newIngress("10.1.0.0/16"),
+		},
+		{
+			name:    "inbound CIDRs denied by policy",
+			ing:     newIngress("0.0.0.0/0"),
+			wantErr: errors.New("inbound CIDRs [0.0.0.0/0] of Ingress awesome-ns/awesome-ing aren't allowed by InboundCIDRsPolicy of IngressClassParams awesome-class-params, allowed CIDRs: [10.0.0.0/8]"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			k8sSchema := runtime.NewScheme()
+			clientgoscheme.AddToScheme(k8sSchema)
+			elbv2api.AddToScheme(k8sSchema)
+			k8sClient := testclient.NewClientBuilder().
+				WithScheme(k8sSchema).
+				WithObjects(ingClass.DeepCopy(), ingClassParams.DeepCopy()).
+				Build()
+			v := &ingressValidator{
+				classParamsLoader:         ingress.NewDefaultClassLoader(k8sClient, true),
+				inboundCIDRsPolicyChecker: ingress.NewDefaultInboundCIDRsPolicyChecker(k8sClient),
+			}
+			err := v.checkInboundCIDRsPolicy(ctx, tt.ing)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}